@@ -8,6 +8,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/bnb-chain/greenfield/internal/crosschainrecord"
 )
 
 // HandlerOptions are the options required for constructing a greenfield AnteHandler.
@@ -55,6 +57,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+		NewCrossChainPackageRecordDecorator(),
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
@@ -80,3 +83,31 @@ func (smd SignModeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate boo
 	}
 	return next(ctx, tx, simulate)
 }
+
+// NewPostHandler returns a PostHandler that writes the records of the failed cross-chain packages of the tx.
+func NewPostHandler() sdk.PostHandler {
+	return sdk.ChainPostDecorators(NewCrossChainPackageRecordDecorator())
+}
+
+// CrossChainPackageRecordDecorator collects the records of the failed cross-chain packages executed by the tx,
+// whose state changes are discarded by the oracle module, and writes them after the messages of the tx are executed.
+// It does nothing before the packages are recorded by the upgrade.
+type CrossChainPackageRecordDecorator struct{}
+
+func NewCrossChainPackageRecordDecorator() CrossChainPackageRecordDecorator {
+	return CrossChainPackageRecordDecorator{}
+}
+
+func (d CrossChainPackageRecordDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if !ctx.IsUpgraded(crosschainrecord.UpgradeName) {
+		return next(ctx, tx, simulate)
+	}
+	return next(crosschainrecord.WithPendingRecords(ctx), tx, simulate)
+}
+
+func (d CrossChainPackageRecordDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (newCtx sdk.Context, err error) {
+	if success && ctx.IsUpgraded(crosschainrecord.UpgradeName) {
+		crosschainrecord.WritePendingRecords(ctx)
+	}
+	return next(ctx, tx, simulate, success)
+}
@@ -168,15 +168,16 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:         nil,
-		distrtypes.ModuleName:              nil,
-		stakingtypes.BondedPoolName:        {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName:     {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:                {authtypes.Burner},
-		paymentmoduletypes.ModuleName:      {authtypes.Burner, authtypes.Staking},
-		crosschaintypes.ModuleName:         {authtypes.Minter},
-		permissionmoduletypes.ModuleName:   nil,
-		bridgemoduletypes.ModuleName:       nil,
+		authtypes.FeeCollectorName:       nil,
+		distrtypes.ModuleName:            nil,
+		stakingtypes.BondedPoolName:      {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:   {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:              {authtypes.Burner},
+		paymentmoduletypes.ModuleName:    {authtypes.Burner, authtypes.Staking},
+		crosschaintypes.ModuleName:       {authtypes.Minter},
+		permissionmoduletypes.ModuleName: nil,
+		// the bridge module only mints and burns the registered mintable tokens, which never include the native token
+		bridgemoduletypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
 		spmoduletypes.ModuleName:           {authtypes.Staking},
 		virtualgroupmoduletypes.ModuleName: nil,
 	}
@@ -452,8 +453,6 @@ func New(
 		app.AuthzKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
-	spModule := spmodule.NewAppModule(appCodec, app.SpKeeper, app.AccountKeeper, app.BankKeeper)
-
 	app.PaymentKeeper = *paymentmodulekeeper.NewKeeper(
 		appCodec,
 		keys[paymentmoduletypes.StoreKey],
@@ -497,6 +496,8 @@ func New(
 	storageModule := storagemodule.NewAppModule(appCodec, app.StorageKeeper, app.AccountKeeper, app.BankKeeper, app.SpKeeper)
 
 	app.VirtualgroupKeeper.SetStorageKeeper(&app.StorageKeeper)
+	app.SpKeeper.SetStorageKeeper(&app.StorageKeeper)
+	spModule := spmodule.NewAppModule(appCodec, app.SpKeeper, app.AccountKeeper, app.BankKeeper)
 	virtualgroupModule := virtualgroupmodule.NewAppModule(appCodec, app.VirtualgroupKeeper, app.SpKeeper)
 
 	app.ChallengeKeeper = *challengemodulekeeper.NewKeeper(
@@ -508,6 +509,7 @@ func New(
 		app.SpKeeper,
 		app.StakingKeeper,
 		app.PaymentKeeper,
+		app.VirtualgroupKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	challengeModule := challengemodule.NewAppModule(appCodec, app.ChallengeKeeper, app.AccountKeeper, app.BankKeeper)
@@ -676,6 +678,7 @@ func New(
 	}
 
 	app.SetAnteHandler(anteHandler)
+	app.SetPostHandler(ante.NewPostHandler())
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
@@ -788,8 +791,8 @@ func (app *App) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.Res
 	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
 
 	// init cross chain channel permissions
-	app.CrossChainKeeper.SetChannelSendPermission(ctx, sdk.ChainID(app.appConfig.CrossChain.DestBscChainId), bridgemoduletypes.TransferOutChannelID, sdk.ChannelAllow)
-	app.CrossChainKeeper.SetChannelSendPermission(ctx, sdk.ChainID(app.appConfig.CrossChain.DestBscChainId), bridgemoduletypes.TransferInChannelID, sdk.ChannelAllow)
+	app.setBridgeChannelSendPermissions(ctx, sdk.ChainID(app.appConfig.CrossChain.DestBscChainId))
+	app.setBridgeChannelSendPermissions(ctx, sdk.ChainID(app.appConfig.CrossChain.DestOpChainId))
 	app.CrossChainKeeper.SetChannelSendPermission(ctx, sdk.ChainID(app.appConfig.CrossChain.DestBscChainId), bridgemoduletypes.SyncParamsChannelID, sdk.ChannelAllow)
 	app.CrossChainKeeper.SetChannelSendPermission(ctx, sdk.ChainID(app.appConfig.CrossChain.DestBscChainId), storagemoduletypes.BucketChannelId, sdk.ChannelAllow)
 	app.CrossChainKeeper.SetChannelSendPermission(ctx, sdk.ChainID(app.appConfig.CrossChain.DestBscChainId), storagemoduletypes.ObjectChannelId, sdk.ChannelAllow)
@@ -798,6 +801,14 @@ func (app *App) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.Res
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
+// setBridgeChannelSendPermissions allows the transfers of BNB and the registered tokens to the destination chain
+func (app *App) setBridgeChannelSendPermissions(ctx sdk.Context, destChainId sdk.ChainID) {
+	app.CrossChainKeeper.SetChannelSendPermission(ctx, destChainId, bridgemoduletypes.TransferOutChannelID, sdk.ChannelAllow)
+	app.CrossChainKeeper.SetChannelSendPermission(ctx, destChainId, bridgemoduletypes.TransferInChannelID, sdk.ChannelAllow)
+	app.CrossChainKeeper.SetChannelSendPermission(ctx, destChainId, bridgemoduletypes.TokenTransferOutChannelID, sdk.ChannelAllow)
+	app.CrossChainKeeper.SetChannelSendPermission(ctx, destChainId, bridgemoduletypes.TokenTransferInChannelID, sdk.ChannelAllow)
+}
+
 // LoadHeight loads a particular height
 func (app *App) LoadHeight(height int64) error {
 	return app.LoadVersion(height)
@@ -1,12 +1,16 @@
 package app
 
 import (
+	"fmt"
+
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	"github.com/bnb-chain/greenfield/internal/crosschainrecord"
 	bridgemoduletypes "github.com/bnb-chain/greenfield/x/bridge/types"
 	paymentmodule "github.com/bnb-chain/greenfield/x/payment"
 	paymentmodulekeeper "github.com/bnb-chain/greenfield/x/payment/keeper"
@@ -17,6 +21,27 @@ import (
 	virtualgrouptypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
 )
 
+// GVGIndexes is the upgrade name for backfilling the indexes of the buckets by gvg family, the objects by gvg and the
+// secondary sps by gvg family, the height of the upgrade is set by the upgrade plans of the app config.
+const GVGIndexes = "GVGIndexes"
+
+// ScheduledDeposits is the upgrade name for enabling the scheduled deposits of the payment module on the chains
+// migrated to the payment v2 before they were introduced, the height of the upgrade is set by the upgrade plans
+// of the app config.
+const ScheduledDeposits = "ScheduledDeposits"
+
+// StreamRecordHistory is the upgrade name for enabling the history of the stream records on the chains migrated to
+// the payment v2 before it was introduced, the height of the upgrade is set by the upgrade plans of the app config.
+const StreamRecordHistory = "StreamRecordHistory"
+
+// BridgeTokens is the upgrade name for enabling the transfers of BNB and the registered tokens to both BSC and opBNB,
+// the height of the upgrade is set by the upgrade plans of the app config.
+const BridgeTokens = "BridgeTokens"
+
+// CrossChainPackageRecords is the upgrade name for recording the cross-chain packages received by the storage and
+// bridge modules, the height of the upgrade is set by the upgrade plans of the app config.
+const CrossChainPackageRecords = crosschainrecord.UpgradeName
+
 func (app *App) RegisterUpgradeHandlers(chainID string, serverCfg *serverconfig.Config) error {
 	// Register the plans from server config
 	err := app.UpgradeKeeper.RegisterUpgradePlan(chainID, serverCfg.Upgrade)
@@ -38,6 +63,11 @@ func (app *App) RegisterUpgradeHandlers(chainID string, serverCfg *serverconfig.
 	app.registerMongolianUpgradeHandler()
 	app.registerAltaiUpgradeHandler()
 	app.registerSavannaUpgradeHandler()
+	app.registerGVGIndexesUpgradeHandler()
+	app.registerBridgeTokensUpgradeHandler()
+	app.registerScheduledDepositsUpgradeHandler()
+	app.registerStreamRecordHistoryUpgradeHandler()
+	app.registerCrossChainPackageRecordsUpgradeHandler()
 	// app.register...()
 	// ...
 	return nil
@@ -330,3 +360,130 @@ func (app *App) registerSavannaUpgradeHandler() {
 			return nil
 		})
 }
+
+func (app *App) registerGVGIndexesUpgradeHandler() {
+	// Register the upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(GVGIndexes,
+		func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			app.Logger().Info("upgrade to ", plan.Name)
+
+			app.StorageKeeper.BackfillGVGIndexes(ctx)
+			app.VirtualgroupKeeper.BackfillFamilySecondarySPs(ctx)
+			return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		})
+
+	// Register the upgrade initializer
+	app.UpgradeKeeper.SetUpgradeInitializer(GVGIndexes,
+		func() error {
+			app.Logger().Info("Init GVGIndexes upgrade")
+			return nil
+		})
+}
+
+func (app *App) registerBridgeTokensUpgradeHandler() {
+	// Register the upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(BridgeTokens,
+		func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			app.Logger().Info("upgrade to ", plan.Name)
+
+			// open transfer channels for bsc and opbnb
+			app.setBridgeChannelSendPermissions(ctx, sdk.ChainID(app.appConfig.CrossChain.DestBscChainId))
+			app.setBridgeChannelSendPermissions(ctx, sdk.ChainID(app.appConfig.CrossChain.DestOpChainId))
+
+			// the permissions of the module account are kept in the store once it is created
+			macc, ok := app.AccountKeeper.GetModuleAccount(ctx, bridgemoduletypes.ModuleName).(*authtypes.ModuleAccount)
+			if !ok {
+				return nil, fmt.Errorf("module account of %s not found", bridgemoduletypes.ModuleName)
+			}
+			macc.Permissions = maccPerms[bridgemoduletypes.ModuleName]
+			app.AccountKeeper.SetModuleAccount(ctx, macc)
+
+			// the params of the queued transfers are unset for the params saved before they were introduced
+			bridgeParams := app.BridgeKeeper.GetParams(ctx)
+			bridgeParams.MaxQueuedTransfersPerBlock = bridgemoduletypes.DefaultMaxQueuedTransfersPerBlock
+			bridgeParams.QueuedTransferExpiryBlocks = bridgemoduletypes.DefaultQueuedTransferExpiryBlocks
+			if err := app.BridgeKeeper.SetParams(ctx, bridgeParams); err != nil {
+				return nil, err
+			}
+
+			return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		})
+
+	// Register the upgrade initializer
+	app.UpgradeKeeper.SetUpgradeInitializer(BridgeTokens,
+		func() error {
+			app.Logger().Info("Init BridgeTokens upgrade")
+			return nil
+		})
+}
+
+func (app *App) registerScheduledDepositsUpgradeHandler() {
+	// Register the upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(ScheduledDeposits,
+		func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			app.Logger().Info("upgrade to ", plan.Name)
+
+			// the params of the scheduled deposits are unset for the params saved before they were introduced
+			paymentParams := app.PaymentKeeper.GetParams(ctx)
+			if paymentParams.MaxAutoScheduledDepositCount == 0 {
+				paymentParams.MaxAutoScheduledDepositCount = paymenttypes.DefaultMaxAutoScheduledDepositCount
+			}
+			if paymentParams.ScheduledDepositCountLimit == 0 {
+				paymentParams.ScheduledDepositCountLimit = paymenttypes.DefaultScheduledDepositCountLimit
+			}
+			if err := app.PaymentKeeper.SetParams(ctx, paymentParams); err != nil {
+				return nil, err
+			}
+
+			return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		})
+
+	// Register the upgrade initializer
+	app.UpgradeKeeper.SetUpgradeInitializer(ScheduledDeposits,
+		func() error {
+			app.Logger().Info("Init ScheduledDeposits upgrade")
+			return nil
+		})
+}
+
+func (app *App) registerStreamRecordHistoryUpgradeHandler() {
+	// Register the upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(StreamRecordHistory,
+		func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			app.Logger().Info("upgrade to ", plan.Name)
+
+			// the history is disabled by the unset count for the params saved before it was introduced
+			paymentParams := app.PaymentKeeper.GetParams(ctx)
+			if paymentParams.MaxStreamRecordHistoryCount == 0 {
+				paymentParams.MaxStreamRecordHistoryCount = paymenttypes.DefaultMaxStreamRecordHistoryCount
+			}
+			if err := app.PaymentKeeper.SetParams(ctx, paymentParams); err != nil {
+				return nil, err
+			}
+
+			return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		})
+
+	// Register the upgrade initializer
+	app.UpgradeKeeper.SetUpgradeInitializer(StreamRecordHistory,
+		func() error {
+			app.Logger().Info("Init StreamRecordHistory upgrade")
+			return nil
+		})
+}
+
+func (app *App) registerCrossChainPackageRecordsUpgradeHandler() {
+	// Register the upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(CrossChainPackageRecords,
+		func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			app.Logger().Info("upgrade to ", plan.Name)
+			return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		})
+
+	// Register the upgrade initializer
+	app.UpgradeKeeper.SetUpgradeInitializer(CrossChainPackageRecords,
+		func() error {
+			app.Logger().Info("Init CrossChainPackageRecords upgrade")
+			return nil
+		})
+}
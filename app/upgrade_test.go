@@ -0,0 +1,138 @@
+package app_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	crosschaintypes "github.com/cosmos/cosmos-sdk/x/crosschain/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/app"
+	"github.com/bnb-chain/greenfield/sdk/client/test"
+	"github.com/bnb-chain/greenfield/testutil"
+	bridgekeeper "github.com/bnb-chain/greenfield/x/bridge/keeper"
+	bridgetypes "github.com/bnb-chain/greenfield/x/bridge/types"
+	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func TestBridgeTokensUpgrade(t *testing.T) {
+	nApp, _, err := testutil.NewTestApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, test.TEST_CHAIN_ID)
+	require.NoError(t, err)
+	ctx := nApp.BaseApp.NewUncachedContext(false, tmproto.Header{ChainID: test.TEST_CHAIN_ID, Height: nApp.LastBlockHeight() + 1})
+
+	appConfig := app.NewDefaultAppConfig()
+	bscChainId := sdk.ChainID(appConfig.CrossChain.DestBscChainId)
+	opChainId := sdk.ChainID(appConfig.CrossChain.DestOpChainId)
+	nApp.CrossChainKeeper.SetDestOpChainID(opChainId)
+	channels := []sdk.ChannelID{bridgetypes.TransferOutChannelID, bridgetypes.TokenTransferOutChannelID, bridgetypes.TokenTransferInChannelID}
+
+	// the state of the chain before the upgrade, the transfer channels of opbnb are not open, and the bridge module
+	// account has no permission
+	for _, channelId := range channels {
+		nApp.CrossChainKeeper.SetChannelSendPermission(ctx, bscChainId, channelId, sdk.ChannelForbidden)
+		nApp.CrossChainKeeper.SetChannelSendPermission(ctx, opChainId, channelId, sdk.ChannelForbidden)
+	}
+	macc, ok := nApp.AccountKeeper.GetModuleAccount(ctx, bridgetypes.ModuleName).(*authtypes.ModuleAccount)
+	require.True(t, ok)
+	macc.Permissions = nil
+	nApp.AccountKeeper.SetModuleAccount(ctx, macc)
+	bridgeParams := nApp.BridgeKeeper.GetParams(ctx)
+	bridgeParams.MaxQueuedTransfersPerBlock = 0
+	bridgeParams.QueuedTransferExpiryBlocks = 0
+	require.NoError(t, nApp.BridgeKeeper.SetParams(ctx, bridgeParams))
+
+	token := bridgetypes.TokenInfo{
+		Denom:           "usdt",
+		ChainId:         uint32(opChainId),
+		ContractAddress: "0x55d398326f99059fF775485246999027B3197955",
+		Decimals:        18,
+		Mintable:        true,
+	}
+	require.NoError(t, nApp.BridgeKeeper.SetToken(ctx, token))
+	amount := sdk.NewCoin(token.Denom, sdkmath.NewInt(1e18))
+	require.Panics(t, func() {
+		_ = nApp.BankKeeper.MintCoins(ctx, bridgetypes.ModuleName, sdk.Coins{amount})
+	})
+
+	nApp.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: app.BridgeTokens, Height: ctx.BlockHeight()})
+
+	bridgeParams = nApp.BridgeKeeper.GetParams(ctx)
+	require.Equal(t, bridgetypes.DefaultMaxQueuedTransfersPerBlock, bridgeParams.MaxQueuedTransfersPerBlock)
+	require.Equal(t, bridgetypes.DefaultQueuedTransferExpiryBlocks, bridgeParams.QueuedTransferExpiryBlocks)
+	for _, channelId := range channels {
+		require.Equal(t, sdk.ChannelAllow, nApp.CrossChainKeeper.GetChannelSendPermission(ctx, bscChainId, channelId))
+		require.Equal(t, sdk.ChannelAllow, nApp.CrossChainKeeper.GetChannelSendPermission(ctx, opChainId, channelId))
+	}
+
+	// the token is transferred out to opbnb through the crosschain module
+	bz, err := hex.DecodeString(test.TEST_PUBKEY)
+	require.NoError(t, err)
+	sender := sdk.AccAddress((&ethsecp256k1.PubKey{Key: bz}).Address())
+	require.NoError(t, nApp.BankKeeper.MintCoins(ctx, bridgetypes.ModuleName, sdk.Coins{amount}))
+	require.NoError(t, nApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, bridgetypes.ModuleName, sender, sdk.Coins{amount}))
+	relayerFee := sdk.Coins{sdk.NewCoin(nApp.StakingKeeper.BondDenom(ctx), bridgetypes.DefaultOpTransferOutRelayerFeeParam)}
+	require.NoError(t, nApp.BankKeeper.MintCoins(ctx, crosschaintypes.ModuleName, relayerFee))
+	require.NoError(t, nApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, crosschaintypes.ModuleName, sender, relayerFee))
+
+	sequence := nApp.CrossChainKeeper.GetSendSequence(ctx, opChainId, bridgetypes.TokenTransferOutChannelID)
+	msgServer := bridgekeeper.NewMsgServerImpl(nApp.BridgeKeeper)
+	_, err = msgServer.TransferOut(ctx, bridgetypes.NewMsgTransferOut(sender.String(), sender.String(), &amount))
+	require.NoError(t, err)
+	require.Equal(t, sequence+1, nApp.CrossChainKeeper.GetSendSequence(ctx, opChainId, bridgetypes.TokenTransferOutChannelID))
+	require.True(t, nApp.BankKeeper.GetBalance(ctx, sender, token.Denom).IsZero())
+	require.True(t, nApp.BankKeeper.GetSupply(ctx, token.Denom).IsZero())
+}
+
+func TestScheduledDepositsUpgrade(t *testing.T) {
+	nApp, _, err := testutil.NewTestApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, test.TEST_CHAIN_ID)
+	require.NoError(t, err)
+	ctx := nApp.BaseApp.NewUncachedContext(false, tmproto.Header{ChainID: test.TEST_CHAIN_ID, Height: nApp.LastBlockHeight() + 1})
+
+	// the params of the chain migrated to the payment v2 before the scheduled deposits were introduced
+	paymentParams := nApp.PaymentKeeper.GetParams(ctx)
+	paymentParams.MaxAutoScheduledDepositCount = 0
+	paymentParams.ScheduledDepositCountLimit = 0
+	require.NoError(t, nApp.PaymentKeeper.SetParams(ctx, paymentParams))
+
+	nApp.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: app.ScheduledDeposits, Height: ctx.BlockHeight()})
+
+	paymentParams = nApp.PaymentKeeper.GetParams(ctx)
+	require.Equal(t, paymenttypes.DefaultMaxAutoScheduledDepositCount, paymentParams.MaxAutoScheduledDepositCount)
+	require.Equal(t, paymenttypes.DefaultScheduledDepositCountLimit, paymentParams.ScheduledDepositCountLimit)
+}
+
+func TestStreamRecordHistoryUpgrade(t *testing.T) {
+	nApp, _, err := testutil.NewTestApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, test.TEST_CHAIN_ID)
+	require.NoError(t, err)
+	ctx := nApp.BaseApp.NewUncachedContext(false, tmproto.Header{ChainID: test.TEST_CHAIN_ID, Height: nApp.LastBlockHeight() + 1})
+
+	// the params of the chain migrated to the payment v2 before the history was introduced
+	paymentParams := nApp.PaymentKeeper.GetParams(ctx)
+	paymentParams.MaxStreamRecordHistoryCount = 0
+	require.NoError(t, nApp.PaymentKeeper.SetParams(ctx, paymentParams))
+
+	nApp.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: app.StreamRecordHistory, Height: ctx.BlockHeight()})
+
+	require.Equal(t, paymenttypes.DefaultMaxStreamRecordHistoryCount, nApp.PaymentKeeper.GetParams(ctx).MaxStreamRecordHistoryCount)
+}
+
+func TestCrossChainPackageRecordsUpgrade(t *testing.T) {
+	nApp, _, err := testutil.NewTestApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, test.TEST_CHAIN_ID)
+	require.NoError(t, err)
+	ctx := nApp.BaseApp.NewUncachedContext(false, tmproto.Header{ChainID: test.TEST_CHAIN_ID, Height: nApp.LastBlockHeight() + 1})
+
+	// the packages are not recorded before the upgrade
+	require.False(t, ctx.IsUpgraded(app.CrossChainPackageRecords))
+
+	nApp.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: app.CrossChainPackageRecords, Height: ctx.BlockHeight()})
+
+	require.True(t, ctx.IsUpgraded(app.CrossChainPackageRecords))
+}
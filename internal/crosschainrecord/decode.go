@@ -0,0 +1,38 @@
+package crosschainrecord
+
+import (
+	"encoding/hex"
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// Deserializer deserializes the payload of a package received on a channel.
+type Deserializer func(rawPack []byte, channelId sdk.ChannelID, packageType sdk.CrossChainPackageType) (interface{}, error)
+
+// DecodePackage decodes the payload of a package executed at the height into json, the hex of the payload is
+// returned if it fails to be decoded. The packages executed after the Pampas upgrade are deserialized by
+// deserializeV2, which is nil if the packages of the channel are not changed by the upgrade.
+func DecodePackage(ctx sdk.Context, height int64, rawPack []byte, channelId sdk.ChannelID,
+	packageType sdk.CrossChainPackageType, deserialize, deserializeV2 Deserializer,
+) string {
+	if len(rawPack) == 0 {
+		return ""
+	}
+
+	// the format of a package is the one of the chain version it is executed in, not the current one
+	if deserializeV2 != nil && ctx.WithBlockHeight(height).IsUpgraded(upgradetypes.Pampas) {
+		deserialize = deserializeV2
+	}
+	pack, err := deserialize(rawPack, channelId, packageType)
+	if err != nil {
+		return hex.EncodeToString(rawPack)
+	}
+
+	bz, err := json.Marshal(pack)
+	if err != nil {
+		return hex.EncodeToString(rawPack)
+	}
+	return string(bz)
+}
@@ -0,0 +1,40 @@
+package crosschainrecord_test
+
+import (
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/internal/crosschainrecord"
+)
+
+func TestDecodePackage(t *testing.T) {
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+	// the Pampas upgrade is done at height 10
+	upgradeChecker := func(ctx sdk.Context, name string) bool {
+		return name == upgradetypes.Pampas && ctx.BlockHeight() >= 10
+	}
+	header := ctx.BlockHeader()
+	header.Height = 20
+	ctx = sdk.NewContext(ctx.MultiStore(), header, false, upgradeChecker, ctx.Logger())
+
+	deserialize := func([]byte, sdk.ChannelID, sdk.CrossChainPackageType) (interface{}, error) {
+		return "v1", nil
+	}
+	deserializeV2 := func([]byte, sdk.ChannelID, sdk.CrossChainPackageType) (interface{}, error) {
+		return "v2", nil
+	}
+
+	// the package is decoded in the format of the height it is executed at
+	require.Equal(t, `"v1"`, crosschainrecord.DecodePackage(ctx, 5, []byte{0x01}, 1, sdk.SynCrossChainPackageType,
+		deserialize, deserializeV2))
+	require.Equal(t, `"v2"`, crosschainrecord.DecodePackage(ctx, 15, []byte{0x01}, 1, sdk.SynCrossChainPackageType,
+		deserialize, deserializeV2))
+	require.Equal(t, `"v1"`, crosschainrecord.DecodePackage(ctx, 15, []byte{0x01}, 1, sdk.SynCrossChainPackageType,
+		deserialize, nil))
+	require.Empty(t, crosschainrecord.DecodePackage(ctx, 15, nil, 1, sdk.SynCrossChainPackageType, deserialize, deserializeV2))
+}
@@ -0,0 +1,44 @@
+package crosschainrecord
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/types/common"
+)
+
+type pendingRecordsKey struct{}
+
+type pendingRecord struct {
+	store  Store
+	record *common.CrossChainPackageRecord
+}
+
+// WithPendingRecords returns the context of a tx which collects the records of the failed packages executed by the tx.
+// The oracle module discards the state changes of a failed package, including its record, so the record is kept
+// in the context and written by WritePendingRecords after the messages of the tx are executed.
+func WithPendingRecords(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(pendingRecordsKey{}, &[]pendingRecord{})
+}
+
+// WritePendingRecords writes the records collected in the context of the tx to the stores. The records are written
+// in the state of the tx, so they are discarded together with the packages if the tx fails.
+func WritePendingRecords(ctx sdk.Context) {
+	records, ok := ctx.Value(pendingRecordsKey{}).(*[]pendingRecord)
+	if !ok {
+		return
+	}
+	for _, pending := range *records {
+		pending.store.SetRecord(ctx, pending.record)
+	}
+	*records = (*records)[:0]
+}
+
+// addPendingRecord keeps the record of a failed package in the context, the record is dropped if the package
+// is not executed in a tx, since there is no state which survives the failure.
+func addPendingRecord(ctx sdk.Context, store Store, record *common.CrossChainPackageRecord) {
+	records, ok := ctx.Value(pendingRecordsKey{}).(*[]pendingRecord)
+	if !ok {
+		return
+	}
+	*records = append(*records, pendingRecord{store: store, record: record})
+}
@@ -0,0 +1,80 @@
+package crosschainrecord
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/types/common"
+)
+
+// UpgradeName is the name of the upgrade which enables the records of the packages, nothing is recorded before the
+// upgrade since the records change the state of the claim txs.
+const UpgradeName = "CrossChainPackageRecords"
+
+var _ sdk.CrossChainApplication = &recorder{}
+
+// recorder wraps the cross-chain app of a channel to record the packages received on the channel
+// and the results of their execution.
+type recorder struct {
+	store     Store
+	channelId sdk.ChannelID
+	app       sdk.CrossChainApplication
+}
+
+// NewRecorder returns the cross-chain app of a channel which records the packages in the store.
+func NewRecorder(store Store, channelId sdk.ChannelID, app sdk.CrossChainApplication) sdk.CrossChainApplication {
+	return &recorder{
+		store:     store,
+		channelId: channelId,
+		app:       app,
+	}
+}
+
+func (r *recorder) ExecuteSynPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	return r.execute(ctx, appCtx, sdk.SynCrossChainPackageType, payload, r.app.ExecuteSynPackage)
+}
+
+func (r *recorder) ExecuteAckPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	return r.execute(ctx, appCtx, sdk.AckCrossChainPackageType, payload, r.app.ExecuteAckPackage)
+}
+
+func (r *recorder) ExecuteFailAckPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	return r.execute(ctx, appCtx, sdk.FailAckCrossChainPackageType, payload, r.app.ExecuteFailAckPackage)
+}
+
+func (r *recorder) execute(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, packageType sdk.CrossChainPackageType,
+	payload []byte, execute func(sdk.Context, *sdk.CrossChainAppContext, []byte) sdk.ExecuteResult,
+) sdk.ExecuteResult {
+	if !ctx.IsUpgraded(UpgradeName) {
+		return execute(ctx, appCtx, payload)
+	}
+
+	record := &common.CrossChainPackageRecord{
+		SrcChainId:  uint32(appCtx.SrcChainId),
+		ChannelId:   uint32(r.channelId),
+		Sequence:    appCtx.Sequence,
+		PackageType: uint32(packageType),
+		Payload:     payload,
+		Height:      ctx.BlockHeight(),
+	}
+
+	// the panic is recovered by the oracle module, which treats the package as crashed
+	defer func() {
+		if p := recover(); p != nil {
+			record.Error = fmt.Sprintf("panic: %v", p)
+			addPendingRecord(ctx, r.store, record)
+			panic(p)
+		}
+	}()
+
+	result := execute(ctx, appCtx, payload)
+	record.AckPayload = result.Payload
+	if result.IsOk() {
+		r.store.SetRecord(ctx, record)
+	} else {
+		record.Error = result.ErrMsg()
+		addPendingRecord(ctx, r.store, record)
+	}
+	return result
+}
@@ -0,0 +1,61 @@
+package crosschainrecord
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/types/common"
+)
+
+// Window is the number of the latest packages whose records are kept for each channel, the record of a package
+// is pruned when the package which is the window sequences later is recorded.
+const Window uint64 = 10000
+
+// Store is the store of the records of the packages received on the channels of a module.
+type Store struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+	prefix   []byte
+}
+
+func NewStore(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, prefix []byte) Store {
+	return Store{
+		cdc:      cdc,
+		storeKey: storeKey,
+		prefix:   prefix,
+	}
+}
+
+// GetRecordKey returns the key of the record of a package received on a channel
+func GetRecordKey(srcChainId sdk.ChainID, channelId sdk.ChannelID, sequence uint64) []byte {
+	chainIdBytes := make([]byte, 2)
+	binary.BigEndian.PutUint16(chainIdBytes, uint16(srcChainId))
+	key := append(chainIdBytes, byte(channelId))
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// SetRecord stores the record of a package, and prunes the record of the package which is out of the window.
+func (s Store) SetRecord(ctx sdk.Context, record *common.CrossChainPackageRecord) {
+	store := prefix.NewStore(ctx.KVStore(s.storeKey), s.prefix)
+	srcChainId, channelId := sdk.ChainID(record.SrcChainId), sdk.ChannelID(record.ChannelId)
+	store.Set(GetRecordKey(srcChainId, channelId, record.Sequence), s.cdc.MustMarshal(record))
+	if record.Sequence >= Window {
+		store.Delete(GetRecordKey(srcChainId, channelId, record.Sequence-Window))
+	}
+}
+
+func (s Store) GetRecord(ctx sdk.Context, srcChainId sdk.ChainID, channelId sdk.ChannelID, sequence uint64) (*common.CrossChainPackageRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(s.storeKey), s.prefix)
+	bz := store.Get(GetRecordKey(srcChainId, channelId, sequence))
+	if bz == nil {
+		return nil, false
+	}
+
+	var record common.CrossChainPackageRecord
+	s.cdc.MustUnmarshal(bz, &record)
+	return &record, true
+}
@@ -0,0 +1,81 @@
+package crosschainrecord_test
+
+import (
+	"errors"
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/internal/crosschainrecord"
+)
+
+type testApp struct {
+	err error
+}
+
+func (a testApp) ExecuteSynPackage(sdk.Context, *sdk.CrossChainAppContext, []byte) sdk.ExecuteResult {
+	return sdk.ExecuteResult{Err: a.err}
+}
+
+func (a testApp) ExecuteAckPackage(sdk.Context, *sdk.CrossChainAppContext, []byte) sdk.ExecuteResult {
+	return sdk.ExecuteResult{Err: a.err}
+}
+
+func (a testApp) ExecuteFailAckPackage(sdk.Context, *sdk.CrossChainAppContext, []byte) sdk.ExecuteResult {
+	return sdk.ExecuteResult{Err: a.err}
+}
+
+func TestRecorder(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	store := crosschainrecord.NewStore(moduletestutil.MakeTestEncodingConfig().Codec, storeKey, []byte{0x01})
+
+	okApp := crosschainrecord.NewRecorder(store, 1, testApp{})
+	failApp := crosschainrecord.NewRecorder(store, 1, testApp{err: errors.New("failed")})
+
+	// nothing is recorded before the upgrade
+	okApp.ExecuteSynPackage(ctx, &sdk.CrossChainAppContext{SrcChainId: 56, Sequence: 1}, nil)
+	_, found := store.GetRecord(ctx, 56, 1, 1)
+	require.False(t, found)
+	txCtx := crosschainrecord.WithPendingRecords(ctx)
+	failApp.ExecuteSynPackage(txCtx, &sdk.CrossChainAppContext{SrcChainId: 56, Sequence: 2}, nil)
+	crosschainrecord.WritePendingRecords(txCtx)
+	_, found = store.GetRecord(ctx, 56, 1, 2)
+	require.False(t, found)
+
+	upgradeChecker := func(ctx sdk.Context, name string) bool {
+		return name == crosschainrecord.UpgradeName
+	}
+	ctx = sdk.NewContext(ctx.MultiStore(), ctx.BlockHeader(), false, upgradeChecker, ctx.Logger())
+
+	// the successful package is recorded at once
+	okApp.ExecuteSynPackage(ctx, &sdk.CrossChainAppContext{SrcChainId: 56, Sequence: 1}, nil)
+	_, found = store.GetRecord(ctx, 56, 1, 1)
+	require.True(t, found)
+
+	// the failed package is recorded when the pending records are written
+	txCtx = crosschainrecord.WithPendingRecords(ctx)
+	failApp.ExecuteSynPackage(txCtx, &sdk.CrossChainAppContext{SrcChainId: 56, Sequence: 2}, nil)
+	_, found = store.GetRecord(ctx, 56, 1, 2)
+	require.False(t, found)
+	crosschainrecord.WritePendingRecords(txCtx)
+	record, found := store.GetRecord(ctx, 56, 1, 2)
+	require.True(t, found)
+	require.Equal(t, "failed", record.Error)
+
+	// the failed package is not recorded outside a tx
+	failApp.ExecuteSynPackage(ctx, &sdk.CrossChainAppContext{SrcChainId: 56, Sequence: 3}, nil)
+	_, found = store.GetRecord(ctx, 56, 1, 3)
+	require.False(t, found)
+
+	// the record out of the window is pruned
+	okApp.ExecuteSynPackage(ctx, &sdk.CrossChainAppContext{SrcChainId: 56, Sequence: 1 + crosschainrecord.Window}, nil)
+	_, found = store.GetRecord(ctx, 56, 1, 1)
+	require.False(t, found)
+	_, found = store.GetRecord(ctx, 56, 1, 2)
+	require.True(t, found)
+}
@@ -72,6 +72,7 @@ type (
 	MsgUpdateGroupMember = storagetypes.MsgUpdateGroupMember
 	MsgLeaveGroup        = storagetypes.MsgLeaveGroup
 
-	MsgSubmit = challengetypes.MsgSubmit
-	MsgAttest = challengetypes.MsgAttest
+	MsgSubmit          = challengetypes.MsgSubmit
+	MsgAttest          = challengetypes.MsgAttest
+	MsgAppealChallenge = challengetypes.MsgAppealChallenge
 )
@@ -0,0 +1,616 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: greenfield/common/cross_chain_package.proto
+
+package common
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CrossChainPackageRecord is the record of a cross-chain package received on a channel and the result of its execution.
+type CrossChainPackageRecord struct {
+	// src_chain_id is the id of the chain the package is sent from
+	SrcChainId uint32 `protobuf:"varint,1,opt,name=src_chain_id,json=srcChainId,proto3" json:"src_chain_id,omitempty"`
+	// channel_id is the id of the channel the package is received on
+	ChannelId uint32 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the receive sequence of the package in the channel
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// package_type is the type of the package, i.e. syn, ack or fail ack
+	PackageType uint32 `protobuf:"varint,4,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
+	// payload is the payload of the package, without the package header
+	Payload []byte `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	// ack_payload is the payload of the ack package returned by the execution of a syn package
+	AckPayload []byte `protobuf:"bytes,6,opt,name=ack_payload,json=ackPayload,proto3" json:"ack_payload,omitempty"`
+	// error is the error returned by the execution, it is empty if the package is executed successfully
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// height is the block height at which the package is executed
+	Height int64 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *CrossChainPackageRecord) Reset()         { *m = CrossChainPackageRecord{} }
+func (m *CrossChainPackageRecord) String() string { return proto.CompactTextString(m) }
+func (*CrossChainPackageRecord) ProtoMessage()    {}
+func (*CrossChainPackageRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c58f4bd4ce5a4651, []int{0}
+}
+func (m *CrossChainPackageRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrossChainPackageRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrossChainPackageRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CrossChainPackageRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossChainPackageRecord.Merge(m, src)
+}
+func (m *CrossChainPackageRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrossChainPackageRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossChainPackageRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossChainPackageRecord proto.InternalMessageInfo
+
+func (m *CrossChainPackageRecord) GetSrcChainId() uint32 {
+	if m != nil {
+		return m.SrcChainId
+	}
+	return 0
+}
+
+func (m *CrossChainPackageRecord) GetChannelId() uint32 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *CrossChainPackageRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *CrossChainPackageRecord) GetPackageType() uint32 {
+	if m != nil {
+		return m.PackageType
+	}
+	return 0
+}
+
+func (m *CrossChainPackageRecord) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *CrossChainPackageRecord) GetAckPayload() []byte {
+	if m != nil {
+		return m.AckPayload
+	}
+	return nil
+}
+
+func (m *CrossChainPackageRecord) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *CrossChainPackageRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*CrossChainPackageRecord)(nil), "greenfield.common.CrossChainPackageRecord")
+}
+
+func init() {
+	proto.RegisterFile("greenfield/common/cross_chain_package.proto", fileDescriptor_c58f4bd4ce5a4651)
+}
+
+var fileDescriptor_c58f4bd4ce5a4651 = []byte{
+	// 308 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xbd, 0x4e, 0xf3, 0x30,
+	0x14, 0x86, 0xeb, 0xfe, 0xf7, 0xb4, 0xdf, 0xf0, 0x59, 0x08, 0x2c, 0x24, 0x42, 0x60, 0x8a, 0x04,
+	0x34, 0x03, 0x77, 0x40, 0x25, 0xa4, 0x6e, 0x55, 0xc4, 0xc4, 0x12, 0x39, 0xce, 0x21, 0x89, 0xda,
+	0xda, 0xc1, 0x4e, 0x87, 0xae, 0x5c, 0x01, 0x97, 0xc5, 0xd8, 0x91, 0x11, 0xb5, 0x37, 0x82, 0xe2,
+	0xb8, 0xc0, 0xf8, 0x9e, 0xf7, 0x39, 0x47, 0x47, 0x0f, 0xdc, 0x64, 0x1a, 0x51, 0xbe, 0x14, 0xb8,
+	0x4a, 0x43, 0xa1, 0xd6, 0x6b, 0x25, 0x43, 0xa1, 0x95, 0x31, 0xb1, 0xc8, 0x79, 0x21, 0xe3, 0x92,
+	0x8b, 0x25, 0xcf, 0x70, 0x5a, 0x6a, 0x55, 0x29, 0xfa, 0xff, 0x17, 0x9e, 0x36, 0xf0, 0xf5, 0x5b,
+	0x1b, 0xce, 0x66, 0xf5, 0xc2, 0xac, 0xe6, 0x17, 0x0d, 0x1e, 0xa1, 0x50, 0x3a, 0xa5, 0x3e, 0x4c,
+	0x8c, 0x16, 0xee, 0x52, 0x91, 0x32, 0xe2, 0x93, 0xe0, 0x5f, 0x04, 0x46, 0x0b, 0x0b, 0xcf, 0x53,
+	0x7a, 0x01, 0x20, 0x72, 0x2e, 0x25, 0xae, 0xea, 0xbe, 0x6d, 0xfb, 0x91, 0x9b, 0xcc, 0x53, 0x7a,
+	0x0e, 0x43, 0x83, 0xaf, 0x1b, 0x94, 0x02, 0x59, 0xc7, 0x27, 0x41, 0x37, 0xfa, 0xc9, 0xf4, 0x0a,
+	0x26, 0xee, 0xb9, 0xb8, 0xda, 0x96, 0xc8, 0xba, 0x76, 0x79, 0xec, 0x66, 0x4f, 0xdb, 0x12, 0x29,
+	0x83, 0x41, 0xc9, 0xb7, 0x2b, 0xc5, 0x53, 0xd6, 0xf3, 0x49, 0x30, 0x89, 0x8e, 0x91, 0x5e, 0xc2,
+	0x98, 0x8b, 0x65, 0x7c, 0x6c, 0xfb, 0xb6, 0x05, 0x2e, 0x96, 0x0b, 0x07, 0x9c, 0x40, 0x0f, 0xb5,
+	0x56, 0x9a, 0x0d, 0x7c, 0x12, 0x8c, 0xa2, 0x26, 0xd0, 0x53, 0xe8, 0xe7, 0x58, 0x64, 0x79, 0xc5,
+	0x86, 0x3e, 0x09, 0x3a, 0x91, 0x4b, 0x0f, 0x8f, 0x1f, 0x7b, 0x8f, 0xec, 0xf6, 0x1e, 0xf9, 0xda,
+	0x7b, 0xe4, 0xfd, 0xe0, 0xb5, 0x76, 0x07, 0xaf, 0xf5, 0x79, 0xf0, 0x5a, 0xcf, 0xb7, 0x59, 0x51,
+	0xe5, 0x9b, 0xa4, 0xb6, 0x15, 0x26, 0x32, 0xb9, 0xb3, 0x2e, 0xc2, 0x3f, 0xce, 0xeb, 0xdf, 0x8d,
+	0x33, 0x9f, 0xf4, 0xad, 0xe6, 0xfb, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x96, 0xfc, 0xa4,
+	0x95, 0x01, 0x00, 0x00,
+}
+
+func (m *CrossChainPackageRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrossChainPackageRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrossChainPackageRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintCrossChainPackage(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintCrossChainPackage(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.AckPayload) > 0 {
+		i -= len(m.AckPayload)
+		copy(dAtA[i:], m.AckPayload)
+		i = encodeVarintCrossChainPackage(dAtA, i, uint64(len(m.AckPayload)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintCrossChainPackage(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PackageType != 0 {
+		i = encodeVarintCrossChainPackage(dAtA, i, uint64(m.PackageType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintCrossChainPackage(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintCrossChainPackage(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SrcChainId != 0 {
+		i = encodeVarintCrossChainPackage(dAtA, i, uint64(m.SrcChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCrossChainPackage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCrossChainPackage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CrossChainPackageRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SrcChainId != 0 {
+		n += 1 + sovCrossChainPackage(uint64(m.SrcChainId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovCrossChainPackage(uint64(m.ChannelId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovCrossChainPackage(uint64(m.Sequence))
+	}
+	if m.PackageType != 0 {
+		n += 1 + sovCrossChainPackage(uint64(m.PackageType))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovCrossChainPackage(uint64(l))
+	}
+	l = len(m.AckPayload)
+	if l > 0 {
+		n += 1 + l + sovCrossChainPackage(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCrossChainPackage(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCrossChainPackage(uint64(m.Height))
+	}
+	return n
+}
+
+func sovCrossChainPackage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCrossChainPackage(x uint64) (n int) {
+	return sovCrossChainPackage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CrossChainPackageRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrossChainPackage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrossChainPackageRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrossChainPackageRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcChainId", wireType)
+			}
+			m.SrcChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainPackage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainPackage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainPackage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackageType", wireType)
+			}
+			m.PackageType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainPackage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PackageType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainPackage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCrossChainPackage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrossChainPackage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckPayload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainPackage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCrossChainPackage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrossChainPackage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckPayload = append(m.AckPayload[:0], dAtA[iNdEx:postIndex]...)
+			if m.AckPayload == nil {
+				m.AckPayload = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainPackage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrossChainPackage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrossChainPackage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainPackage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrossChainPackage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrossChainPackage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCrossChainPackage(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCrossChainPackage
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCrossChainPackage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCrossChainPackage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCrossChainPackage
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCrossChainPackage
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCrossChainPackage
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCrossChainPackage        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCrossChainPackage          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCrossChainPackage = fmt.Errorf("proto: unexpected end of group")
+)
@@ -22,6 +22,7 @@ var (
 	ErrInvalidPrincipalType  = errors.Register(RootCodespace, 1012, "Invalid principal type")
 	ErrInvalidBlsSignature   = errors.Register(RootCodespace, 1013, "bls signature is invalid")
 	ErrInvalidMessage        = errors.Register(RootCodespace, 1014, "Invalid message")
+	ErrInvalidPieceProof     = errors.Register(RootCodespace, 1015, "Invalid piece proof")
 
 	ErrGRNTypeMismatch = errors.Register(RootCodespace, 2000, "Greenfield resource type mismatch")
 )
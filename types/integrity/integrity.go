@@ -0,0 +1,56 @@
+// Package integrity verifies the segments/pieces of objects against the integrity hashes recorded in
+// ObjectInfo.checksums. The integrity hash of a replica is the sha256 hash of the concatenated sha256 hashes of all
+// its pieces, in the order of the pieces, as computed by storagetypes.GenerateHash, i.e. a merkle tree with one level
+// of leaves. So the proof of a piece is the hashes of all the other pieces of the replica.
+package integrity
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	"cosmossdk.io/errors"
+
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// ComputePieceHash computes the hash of a segment/piece
+func ComputePieceHash(piece []byte) []byte {
+	hash := sha256.Sum256(piece)
+	return hash[:]
+}
+
+// VerifyPieceHashes verifies the hashes of all the pieces of a replica against the integrity hash
+func VerifyPieceHashes(pieceHashes [][]byte, integrityHash []byte) error {
+	if len(pieceHashes) == 0 {
+		return errors.Wrap(gnfderrors.ErrInvalidPieceProof, "piece hashes cannot be empty")
+	}
+	for _, pieceHash := range pieceHashes {
+		if len(pieceHash) != sha256.Size {
+			return errors.Wrapf(gnfderrors.ErrInvalidPieceProof, "the length of piece hash should be %d", sha256.Size)
+		}
+	}
+	if !bytes.Equal(storagetypes.GenerateHash(pieceHashes), integrityHash) {
+		return errors.Wrap(gnfderrors.ErrInvalidPieceProof, "the piece hashes do not match the integrity hash")
+	}
+	return nil
+}
+
+// VerifyPieceHashProof verifies the hash of the piece at the index against the integrity hash, the proof is the
+// hashes of the other pieces of the replica in the order of the pieces.
+func VerifyPieceHashProof(pieceHash []byte, index uint32, proof [][]byte, integrityHash []byte) error {
+	if int(index) > len(proof) {
+		return errors.Wrapf(gnfderrors.ErrInvalidPieceProof, "piece index %d is out of the %d pieces", index, len(proof)+1)
+	}
+	pieceHashes := make([][]byte, 0, len(proof)+1)
+	pieceHashes = append(pieceHashes, proof[:index]...)
+	pieceHashes = append(pieceHashes, pieceHash)
+	pieceHashes = append(pieceHashes, proof[index:]...)
+	return VerifyPieceHashes(pieceHashes, integrityHash)
+}
+
+// VerifyPieceProof verifies the data of the piece at the index against the integrity hash, the proof is the
+// hashes of the other pieces of the replica in the order of the pieces.
+func VerifyPieceProof(piece []byte, index uint32, proof [][]byte, integrityHash []byte) error {
+	return VerifyPieceHashProof(ComputePieceHash(piece), index, proof, integrityHash)
+}
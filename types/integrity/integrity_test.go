@@ -0,0 +1,36 @@
+package integrity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func TestVerifyPieceProof(t *testing.T) {
+	pieces := [][]byte{[]byte("piece0"), []byte("piece1"), []byte("piece2")}
+	pieceHashes := make([][]byte, 0, len(pieces))
+	for _, piece := range pieces {
+		pieceHashes = append(pieceHashes, ComputePieceHash(piece))
+	}
+	integrityHash := storagetypes.GenerateHash(pieceHashes)
+	require.NoError(t, VerifyPieceHashes(pieceHashes, integrityHash))
+
+	proofOf := func(index int) [][]byte {
+		proof := append([][]byte{}, pieceHashes[:index]...)
+		return append(proof, pieceHashes[index+1:]...)
+	}
+	for i, piece := range pieces {
+		require.NoError(t, VerifyPieceProof(piece, uint32(i), proofOf(i), integrityHash))
+	}
+
+	// wrong piece data
+	require.ErrorIs(t, VerifyPieceProof([]byte("piece3"), 1, proofOf(1), integrityHash), gnfderrors.ErrInvalidPieceProof)
+	// wrong piece index
+	require.ErrorIs(t, VerifyPieceProof(pieces[0], 1, proofOf(0), integrityHash), gnfderrors.ErrInvalidPieceProof)
+	require.ErrorIs(t, VerifyPieceProof(pieces[0], 3, proofOf(0), integrityHash), gnfderrors.ErrInvalidPieceProof)
+	// invalid proof
+	require.ErrorIs(t, VerifyPieceProof(pieces[0], 0, [][]byte{{1, 2, 3}, pieceHashes[2]}, integrityHash), gnfderrors.ErrInvalidPieceProof)
+}
@@ -21,6 +21,10 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryTokens())
+	cmd.AddCommand(CmdQueryToken())
+	cmd.AddCommand(CmdQueryQueuedTransfers())
+	cmd.AddCommand(CmdQueryCrossChainPackage())
 
 	return cmd
 }
@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/bridge/types"
+)
+
+const FlagSrcChainId = "src-chain-id"
+
+func CmdQueryCrossChainPackage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cross-chain-package [channel-id] [sequence]",
+		Short: "shows a cross-chain package received by the bridge channels, decoded with the result of its execution",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			channelId, err := strconv.ParseUint(args[0], 10, 8)
+			if err != nil {
+				return err
+			}
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			srcChainId, err := cmd.Flags().GetUint32(FlagSrcChainId)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).CrossChainPackage(cmd.Context(), &types.QueryCrossChainPackageRequest{
+				SrcChainId: srcChainId,
+				ChannelId:  uint32(channelId),
+				Sequence:   sequence,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagSrcChainId, 0, "the id of the chain the package is sent from, bsc if it is not set")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -14,6 +14,8 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const FlagDestChainId = "dest-chain-id"
+
 func CmdTransferOut() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "transfer-out [to_address] [amount]",
@@ -40,6 +42,14 @@ func CmdTransferOut() *cobra.Command {
 				toAddr.String(),
 				&coin,
 			)
+			argDestChainId, _ := cmd.Flags().GetString(FlagDestChainId)
+			if argDestChainId != "" {
+				destChainId, err := strconv.ParseUint(argDestChainId, 10, 16)
+				if err != nil {
+					return err
+				}
+				msg.DestChainId = uint32(destChainId)
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -47,6 +57,7 @@ func CmdTransferOut() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagDestChainId, "", "the destination chain id, the transfer goes to bsc if it is not set")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -4,21 +4,35 @@ import (
 	"encoding/hex"
 
 	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	crosschaintypes "github.com/cosmos/cosmos-sdk/x/crosschain/types"
 
+	"github.com/bnb-chain/greenfield/internal/crosschainrecord"
 	"github.com/bnb-chain/greenfield/x/bridge/types"
 )
 
 func RegisterCrossApps(keeper Keeper) {
 	transferOutApp := NewTransferOutApp(keeper)
-	err := keeper.crossChainKeeper.RegisterChannel(types.TransferOutChannel, types.TransferOutChannelID, transferOutApp)
+	err := keeper.crossChainKeeper.RegisterChannel(types.TransferOutChannel, types.TransferOutChannelID, crosschainrecord.NewRecorder(keeper.packageRecords, types.TransferOutChannelID, transferOutApp))
 	if err != nil {
 		panic(err)
 	}
 
 	transferInApp := NewTransferInApp(keeper)
-	err = keeper.crossChainKeeper.RegisterChannel(types.TransferInChannel, types.TransferInChannelID, transferInApp)
+	err = keeper.crossChainKeeper.RegisterChannel(types.TransferInChannel, types.TransferInChannelID, crosschainrecord.NewRecorder(keeper.packageRecords, types.TransferInChannelID, transferInApp))
+	if err != nil {
+		panic(err)
+	}
+
+	tokenTransferOutApp := NewTokenTransferOutApp(keeper)
+	err = keeper.crossChainKeeper.RegisterChannel(types.TokenTransferOutChannel, types.TokenTransferOutChannelID, crosschainrecord.NewRecorder(keeper.packageRecords, types.TokenTransferOutChannelID, tokenTransferOutApp))
+	if err != nil {
+		panic(err)
+	}
+
+	tokenTransferInApp := NewTokenTransferInApp(keeper)
+	err = keeper.crossChainKeeper.RegisterChannel(types.TokenTransferInChannel, types.TokenTransferInChannelID, crosschainrecord.NewRecorder(keeper.packageRecords, types.TokenTransferInChannelID, tokenTransferInApp))
 	if err != nil {
 		panic(err)
 	}
@@ -163,10 +177,42 @@ func (app *TransferInApp) ExecuteSynPackage(ctx sdk.Context, appCtx *sdk.CrossCh
 		panic("decode transfer in claim error")
 	}
 
-	denom := app.bridgeKeeper.stakingKeeper.BondDenom(ctx)
-	amount := sdk.NewCoin(denom, sdk.NewIntFromBigInt(transferInPackage.Amount))
+	// the transfers in are refunded while the transfers are paused, to limit the damage of a compromised relayer
+	if app.bridgeKeeper.IsTransferPaused(ctx) {
+		refundPackage, refundErr := app.bridgeKeeper.GetRefundTransferInPayload(transferInPackage, uint32(types.REFUND_REASON_TRANSFER_PAUSED))
+		if refundErr != nil {
+			app.bridgeKeeper.Logger(ctx).Error("get refund transfer in payload error", "err", refundErr.Error())
+			panic(refundErr)
+		}
+		return sdk.ExecuteResult{
+			Payload: refundPackage,
+			Err:     types.ErrTransferPaused,
+		}
+	}
+
+	amount := sdk.NewIntFromBigInt(transferInPackage.Amount)
+
+	// the transfer over the rate limits is queued, and executed when the following windows have room for it
+	if !app.bridgeKeeper.consumeTransferQuota(ctx, types.TRANSFER_DIRECTION_IN, nil, transferInPackage.ReceiverAddress, amount, false) {
+		err = app.bridgeKeeper.queueTransfer(ctx, types.QueuedTransfer{
+			Direction:     types.TRANSFER_DIRECTION_IN,
+			RefundAddress: transferInPackage.RefundAddress.String(),
+			Recipient:     transferInPackage.ReceiverAddress.String(),
+			Amount:        amount,
+			RelayerFee:    sdkmath.ZeroInt(),
+			AckRelayerFee: sdkmath.ZeroInt(),
+			ChainId:       uint32(appCtx.SrcChainId),
+			Sequence:      appCtx.Sequence,
+		})
+		if err != nil {
+			app.bridgeKeeper.Logger(ctx).Error("queue transfer in error", "err", err.Error())
+			panic(err)
+		}
+		return sdk.ExecuteResult{}
+	}
 
-	err = app.bridgeKeeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, crosschaintypes.ModuleName, transferInPackage.ReceiverAddress, sdk.Coins{amount})
+	err = app.bridgeKeeper.transferIn(ctx, transferInPackage.ReceiverAddress, transferInPackage.RefundAddress, amount,
+		appCtx.Sequence, uint32(appCtx.SrcChainId))
 	if err != nil {
 		app.bridgeKeeper.Logger(ctx).Error("send coins error", "err", err.Error())
 		refundPackage, refundErr := app.bridgeKeeper.GetRefundTransferInPayload(transferInPackage, uint32(types.REFUND_REASON_INSUFFICIENT_BALANCE))
@@ -180,18 +226,5 @@ func (app *TransferInApp) ExecuteSynPackage(ctx sdk.Context, appCtx *sdk.CrossCh
 		}
 	}
 
-	// emit event
-	err = ctx.EventManager().EmitTypedEvent(&types.EventCrossTransferIn{
-		Amount:          &amount,
-		ReceiverAddress: transferInPackage.ReceiverAddress.String(),
-		RefundAddress:   transferInPackage.RefundAddress.String(),
-		Sequence:        appCtx.Sequence,
-		SrcChainId:      uint32(appCtx.SrcChainId),
-	})
-	if err != nil {
-		app.bridgeKeeper.Logger(ctx).Error("emit event error", "err", err.Error())
-		panic(err)
-	}
-
 	return sdk.ExecuteResult{}
 }
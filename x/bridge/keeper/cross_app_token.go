@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/bnb-chain/greenfield/x/bridge/types"
+)
+
+var _ sdk.CrossChainApplication = &TokenTransferOutApp{}
+
+// TokenTransferOutApp handles the ack and fail ack packages of the registered tokens transferred out
+type TokenTransferOutApp struct {
+	bridgeKeeper Keeper
+}
+
+func NewTokenTransferOutApp(keeper Keeper) *TokenTransferOutApp {
+	return &TokenTransferOutApp{
+		bridgeKeeper: keeper,
+	}
+}
+
+// refund gives back the registered token to the refund address of a failed transfer out
+func (app *TokenTransferOutApp) refund(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, contract common.Address,
+	contractAmount *big.Int, refundAddress sdk.AccAddress, refundReason types.RefundReason,
+) sdk.ExecuteResult {
+	token, found := app.bridgeKeeper.getRefundTokenByContract(ctx, appCtx.SrcChainId, contract)
+	if !found {
+		app.bridgeKeeper.Logger(ctx).Error("refund unregistered token", "contract", contract.String())
+		return sdk.ExecuteResult{
+			Err: errors.Wrapf(types.ErrTokenNotRegistered, "contract %s", contract),
+		}
+	}
+
+	amount := sdk.NewCoin(token.Denom, token.FromContractAmount(contractAmount))
+	err := app.bridgeKeeper.releaseToken(ctx, token, refundAddress, amount)
+	if err != nil {
+		app.bridgeKeeper.Logger(ctx).Error("send coins error", "err", err.Error())
+		return sdk.ExecuteResult{
+			Err: err,
+		}
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventCrossTransferOutRefund{
+		RefundAddress: refundAddress.String(),
+		Amount:        &amount,
+		RefundReason:  refundReason,
+		Sequence:      appCtx.Sequence,
+		DestChainId:   uint32(appCtx.SrcChainId),
+	})
+	if err != nil {
+		app.bridgeKeeper.Logger(ctx).Error("emit event error", "err", err.Error())
+		panic(err)
+	}
+
+	return sdk.ExecuteResult{}
+}
+
+func (app *TokenTransferOutApp) ExecuteAckPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	if len(payload) == 0 {
+		return sdk.ExecuteResult{}
+	}
+
+	app.bridgeKeeper.Logger(ctx).Info("receive token transfer out refund ack package")
+
+	refundPackage, err := types.DeserializeTokenTransferOutRefundPackage(payload)
+	if err != nil {
+		app.bridgeKeeper.Logger(ctx).Error("decode token transfer out refund claim error", "err", err.Error(), "claim", hex.EncodeToString(payload))
+		return sdk.ExecuteResult{
+			Err: err,
+		}
+	}
+
+	return app.refund(ctx, appCtx, refundPackage.Contract, refundPackage.RefundAmount, refundPackage.RefundAddress,
+		types.RefundReason(refundPackage.RefundReason))
+}
+
+func (app *TokenTransferOutApp) ExecuteFailAckPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	app.bridgeKeeper.Logger(ctx).Info("received token transfer out fail ack package")
+
+	transferOutPackage, err := types.DeserializeTokenTransferOutSynPackage(payload)
+	if err != nil {
+		return sdk.ExecuteResult{
+			Err: err,
+		}
+	}
+
+	return app.refund(ctx, appCtx, transferOutPackage.Contract, transferOutPackage.Amount, transferOutPackage.RefundAddress,
+		types.REFUND_REASON_FAIL_ACK)
+}
+
+func (app *TokenTransferOutApp) ExecuteSynPackage(ctx sdk.Context, header *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	app.bridgeKeeper.Logger(ctx).Error("received token transfer out syn package ")
+	return sdk.ExecuteResult{}
+}
+
+var _ sdk.CrossChainApplication = &TokenTransferInApp{}
+
+// TokenTransferInApp handles the syn packages of the registered tokens transferred in
+type TokenTransferInApp struct {
+	bridgeKeeper Keeper
+}
+
+func NewTokenTransferInApp(bridgeKeeper Keeper) *TokenTransferInApp {
+	return &TokenTransferInApp{
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (app *TokenTransferInApp) ExecuteAckPackage(ctx sdk.Context, header *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	app.bridgeKeeper.Logger(ctx).Error("received token transfer in ack package", "payload", hex.EncodeToString(payload))
+	return sdk.ExecuteResult{}
+}
+
+func (app *TokenTransferInApp) ExecuteFailAckPackage(ctx sdk.Context, header *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	app.bridgeKeeper.Logger(ctx).Error("received token transfer in fail ack package", "payload", hex.EncodeToString(payload))
+	return sdk.ExecuteResult{}
+}
+
+func (app *TokenTransferInApp) ExecuteSynPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	transferInPackage, err := types.DeserializeTokenTransferInSynPackage(payload)
+	if err != nil {
+		app.bridgeKeeper.Logger(ctx).Error("decode token transfer in claim error", "err", err.Error(), "claim", string(payload))
+		panic("decode token transfer in claim error")
+	}
+
+	if app.bridgeKeeper.IsTransferPaused(ctx) {
+		return app.refund(ctx, transferInPackage, types.REFUND_REASON_TRANSFER_PAUSED, types.ErrTransferPaused)
+	}
+
+	token, found := app.bridgeKeeper.GetTokenByContract(ctx, appCtx.SrcChainId, transferInPackage.Contract)
+	if !found {
+		return app.refund(ctx, transferInPackage, types.REFUND_REASON_UNREGISTERED_TOKEN,
+			errors.Wrapf(types.ErrTokenNotRegistered, "contract %s", transferInPackage.Contract))
+	}
+
+	amount := token.FromContractAmount(transferInPackage.Amount)
+
+	// the transfer over the rate limits of the token is queued, and executed when the following windows have room for it
+	if !app.bridgeKeeper.consumeTransferQuota(ctx, types.TRANSFER_DIRECTION_IN, &token, transferInPackage.ReceiverAddress, amount, false) {
+		err = app.bridgeKeeper.queueTransfer(ctx, types.QueuedTransfer{
+			Direction:     types.TRANSFER_DIRECTION_IN,
+			RefundAddress: transferInPackage.RefundAddress.String(),
+			Recipient:     transferInPackage.ReceiverAddress.String(),
+			Amount:        amount,
+			RelayerFee:    sdkmath.ZeroInt(),
+			AckRelayerFee: sdkmath.ZeroInt(),
+			ChainId:       uint32(appCtx.SrcChainId),
+			Sequence:      appCtx.Sequence,
+			Token:         &token,
+		})
+		if err != nil {
+			app.bridgeKeeper.Logger(ctx).Error("queue token transfer in error", "err", err.Error())
+			panic(err)
+		}
+		return sdk.ExecuteResult{}
+	}
+
+	err = app.bridgeKeeper.transferInToken(ctx, token, transferInPackage.ReceiverAddress, transferInPackage.RefundAddress, amount,
+		appCtx.Sequence, uint32(appCtx.SrcChainId))
+	if err != nil {
+		app.bridgeKeeper.Logger(ctx).Error("send coins error", "err", err.Error())
+		return app.refund(ctx, transferInPackage, types.REFUND_REASON_INSUFFICIENT_BALANCE,
+			errors.Wrapf(types.ErrInvalidPackage, "send coins error: %s", err.Error()))
+	}
+
+	return sdk.ExecuteResult{}
+}
+
+// refund returns the refund package of the token transfer in which can not be executed
+func (app *TokenTransferInApp) refund(ctx sdk.Context, transferInPackage *types.TokenTransferInSynPackage,
+	refundReason types.RefundReason, err error,
+) sdk.ExecuteResult {
+	refundPackage := &types.TokenTransferInRefundPackage{
+		Contract:      transferInPackage.Contract,
+		RefundAmount:  transferInPackage.Amount,
+		RefundAddress: transferInPackage.RefundAddress,
+		RefundReason:  uint32(refundReason),
+	}
+	encodedBytes, refundErr := refundPackage.Serialize()
+	if refundErr != nil {
+		app.bridgeKeeper.Logger(ctx).Error("get refund token transfer in payload error", "err", refundErr.Error())
+		panic(refundErr)
+	}
+	return sdk.ExecuteResult{
+		Payload: encodedBytes,
+		Err:     err,
+	}
+}
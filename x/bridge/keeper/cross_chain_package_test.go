@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/internal/crosschainrecord"
+	"github.com/bnb-chain/greenfield/x/bridge/keeper"
+	"github.com/bnb-chain/greenfield/x/bridge/types"
+)
+
+func (s *TestSuite) TestCrossChainPackageRecord() {
+	apps := make(map[sdk.ChannelID]sdk.CrossChainApplication)
+	s.crossChainKeeper.EXPECT().RegisterChannel(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ string, id sdk.ChannelID, app sdk.CrossChainApplication) error {
+			apps[id] = app
+			return nil
+		}).AnyTimes()
+	s.crossChainKeeper.EXPECT().GetDestBscChainID().Return(testBscChainId).AnyTimes()
+	keeper.RegisterCrossApps(*s.bridgeKeeper)
+
+	params := s.bridgeKeeper.GetParams(s.ctx)
+	params.TransferPaused = true
+	s.Require().NoError(s.bridgeKeeper.SetParams(s.ctx, params))
+
+	transferInPackage := types.TransferInSynPackage{
+		Amount:          big.NewInt(100),
+		ReceiverAddress: common.BytesToAddress([]byte("receiver")).Bytes(),
+		RefundAddress:   common.BytesToAddress([]byte("refund")).Bytes(),
+	}
+	payload, err := transferInPackage.Serialize()
+	s.Require().NoError(err)
+
+	// nothing is recorded before the upgrade
+	txCtx := crosschainrecord.WithPendingRecords(s.ctx)
+	appCtx := &sdk.CrossChainAppContext{SrcChainId: testBscChainId, Sequence: 7}
+	result := apps[types.TransferInChannelID].ExecuteSynPackage(txCtx, appCtx, payload)
+	s.Require().ErrorIs(result.Err, types.ErrTransferPaused)
+	crosschainrecord.WritePendingRecords(txCtx)
+
+	req := &types.QueryCrossChainPackageRequest{ChannelId: uint32(types.TransferInChannelID), Sequence: 7}
+	_, err = s.queryClient.CrossChainPackage(s.ctx, req)
+	s.Require().ErrorIs(err, types.ErrNoSuchCrossChainPackage)
+
+	upgradeChecker := func(ctx sdk.Context, name string) bool {
+		return name == crosschainrecord.UpgradeName
+	}
+	s.ctx = sdk.NewContext(s.ctx.MultiStore(), s.ctx.BlockHeader(), false, upgradeChecker, s.ctx.Logger())
+
+	// the failed package is recorded after the messages of the tx are executed
+	txCtx = crosschainrecord.WithPendingRecords(s.ctx)
+	result = apps[types.TransferInChannelID].ExecuteSynPackage(txCtx, appCtx, payload)
+	s.Require().ErrorIs(result.Err, types.ErrTransferPaused)
+
+	_, err = s.queryClient.CrossChainPackage(s.ctx, req)
+	s.Require().ErrorIs(err, types.ErrNoSuchCrossChainPackage)
+
+	crosschainrecord.WritePendingRecords(txCtx)
+	res, err := s.queryClient.CrossChainPackage(s.ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal(types.TransferInChannel, res.Operation)
+	s.Require().Equal(uint32(sdk.SynCrossChainPackageType), res.PackageType)
+	s.Require().Equal(types.ErrTransferPaused.Error(), res.Error)
+	s.Require().Contains(res.Payload, `"Amount":100`)
+	s.Require().Contains(res.AckPayload, `"RefundReason":4`)
+
+	// the successful package is recorded at once
+	appCtx = &sdk.CrossChainAppContext{SrcChainId: testBscChainId, Sequence: 8}
+	result = apps[types.TransferOutChannelID].ExecuteAckPackage(s.ctx, appCtx, nil)
+	s.Require().NoError(result.Err)
+
+	res, err = s.queryClient.CrossChainPackage(s.ctx, &types.QueryCrossChainPackageRequest{
+		SrcChainId: uint32(testBscChainId),
+		ChannelId:  uint32(types.TransferOutChannelID),
+		Sequence:   8,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(types.TransferOutChannel, res.Operation)
+	s.Require().Equal(uint32(sdk.AckCrossChainPackageType), res.PackageType)
+	s.Require().Empty(res.Error)
+
+	// the record is pruned when the package the window sequences later is recorded
+	appCtx = &sdk.CrossChainAppContext{SrcChainId: testBscChainId, Sequence: 8 + crosschainrecord.Window}
+	apps[types.TransferOutChannelID].ExecuteAckPackage(s.ctx, appCtx, nil)
+	_, err = s.queryClient.CrossChainPackage(s.ctx, &types.QueryCrossChainPackageRequest{
+		ChannelId: uint32(types.TransferOutChannelID),
+		Sequence:  8,
+	})
+	s.Require().ErrorIs(err, types.ErrNoSuchCrossChainPackage)
+	_, err = s.queryClient.CrossChainPackage(s.ctx, &types.QueryCrossChainPackageRequest{
+		ChannelId: uint32(types.TransferOutChannelID),
+		Sequence:  8 + crosschainrecord.Window,
+	})
+	s.Require().NoError(err)
+
+	// the record of a failed package is dropped if the tx fails
+	txCtx = crosschainrecord.WithPendingRecords(s.ctx)
+	appCtx = &sdk.CrossChainAppContext{SrcChainId: testBscChainId, Sequence: 9}
+	result = apps[types.TransferInChannelID].ExecuteSynPackage(txCtx, appCtx, payload)
+	s.Require().ErrorIs(result.Err, types.ErrTransferPaused)
+	_, err = s.queryClient.CrossChainPackage(s.ctx, &types.QueryCrossChainPackageRequest{
+		ChannelId: uint32(types.TransferInChannelID),
+		Sequence:  9,
+	})
+	s.Require().ErrorIs(err, types.ErrNoSuchCrossChainPackage)
+}
@@ -12,12 +12,22 @@ func InitGenesis(ctx sdk.Context, k Keeper, genState types.GenesisState) {
 	if err != nil {
 		panic(err)
 	}
+	for _, token := range genState.Tokens {
+		if err := k.SetToken(ctx, token); err != nil {
+			panic(err)
+		}
+	}
+	for _, transfer := range genState.QueuedTransfers {
+		k.SetQueuedTransfer(ctx, transfer)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
 func ExportGenesis(ctx sdk.Context, k Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.Tokens = k.GetAllTokens(ctx)
+	genesis.QueuedTransfers = k.GetAllQueuedTransfers(ctx)
 
 	return genesis
 }
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bnb-chain/greenfield/internal/crosschainrecord"
+	"github.com/bnb-chain/greenfield/x/bridge/types"
+)
+
+func (k Keeper) CrossChainPackage(goCtx context.Context, req *types.QueryCrossChainPackageRequest) (*types.QueryCrossChainPackageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	srcChainId := sdk.ChainID(req.SrcChainId)
+	if srcChainId == 0 {
+		srcChainId = k.crossChainKeeper.GetDestBscChainID()
+	}
+
+	record, found := k.packageRecords.GetRecord(ctx, srcChainId, sdk.ChannelID(req.ChannelId), req.Sequence)
+	if !found {
+		return nil, types.ErrNoSuchCrossChainPackage
+	}
+
+	channelId := sdk.ChannelID(record.ChannelId)
+	packageType := sdk.CrossChainPackageType(record.PackageType)
+	res := &types.QueryCrossChainPackageResponse{
+		Operation:   types.ChannelNames[channelId],
+		PackageType: record.PackageType,
+		Payload: crosschainrecord.DecodePackage(ctx, record.Height, record.Payload, channelId, packageType,
+			types.DeserializeCrossChainPackage, nil),
+		Error:  record.Error,
+		Height: record.Height,
+	}
+	// only the execution of a syn package returns an ack package
+	if packageType == sdk.SynCrossChainPackageType {
+		res.AckPayload = crosschainrecord.DecodePackage(ctx, record.Height, record.AckPayload, channelId, sdk.AckCrossChainPackageType,
+			types.DeserializeCrossChainPackage, nil)
+	}
+	return res, nil
+}
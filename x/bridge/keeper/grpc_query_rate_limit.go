@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bnb-chain/greenfield/x/bridge/types"
+)
+
+func (k Keeper) QueuedTransfers(c context.Context, req *types.QueryQueuedTransfersRequest) (*types.QueryQueuedTransfersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedTransferPrefix)
+	var transfers []types.QueuedTransfer
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var transfer types.QueuedTransfer
+		k.cdc.MustUnmarshal(value, &transfer)
+		transfers = append(transfers, transfer)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryQueuedTransfersResponse{Transfers: transfers, Pagination: pageRes}, nil
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bnb-chain/greenfield/x/bridge/types"
+)
+
+func (k Keeper) Tokens(c context.Context, req *types.QueryTokensRequest) (*types.QueryTokensResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TokenInfoPrefix)
+	var tokens []types.TokenInfo
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var token types.TokenInfo
+		k.cdc.MustUnmarshal(value, &token)
+		tokens = append(tokens, token)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryTokensResponse{Tokens: tokens, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Token(c context.Context, req *types.QueryTokenRequest) (*types.QueryTokenResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	token, found := k.GetToken(ctx, req.Denom)
+	if !found {
+		return nil, types.ErrTokenNotRegistered
+	}
+	return &types.QueryTokenResponse{Token: token}, nil
+}
@@ -9,6 +9,7 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/bnb-chain/greenfield/internal/crosschainrecord"
 	"github.com/bnb-chain/greenfield/x/bridge/types"
 )
 
@@ -22,6 +23,9 @@ type (
 		crossChainKeeper types.CrossChainKeeper
 
 		authority string
+
+		// records of the cross-chain packages received on the bridge channels
+		packageRecords crosschainrecord.Store
 	}
 )
 
@@ -40,6 +44,8 @@ func NewKeeper(
 		stakingKeeper:    stakingKeepr,
 		crossChainKeeper: crossChainKeeper,
 		authority:        authority,
+
+		packageRecords: crosschainrecord.NewStore(cdc, storeKey, types.CrossChainPackageRecordPrefix),
 	}
 }
 
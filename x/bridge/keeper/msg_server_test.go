@@ -114,3 +114,43 @@ func (s *TestSuite) TestCrossTransferOutWrong() {
 	s.Require().NotNil(err, "error should not be nil")
 	s.Require().Contains(err.Error(), "denom is not supported")
 }
+
+func (s *TestSuite) TestCrossTransferOutToOpChain() {
+	s.mockTokenChains()
+
+	addr1, _, err := testutil.GenerateCoinKey(hd.Secp256k1, s.cdc)
+	s.Require().NoError(err)
+	addr2, _, err := testutil.GenerateCoinKey(hd.Secp256k1, s.cdc)
+	s.Require().NoError(err)
+
+	params := types.DefaultParams()
+	amount := sdk.NewCoin("BNB", sdk.NewInt(1))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), addr1, gomock.Any(),
+		sdk.Coins{amount}.Add(sdk.NewCoin("BNB", params.OpTransferOutRelayerFee))).Return(nil).Times(1)
+	s.crossChainKeeper.EXPECT().CreateRawIBCPackageWithFee(gomock.Any(), testOpChainId, types.TransferOutChannelID, gomock.Any(),
+		gomock.Any(), params.OpTransferOutRelayerFee.BigInt(), params.OpTransferOutAckRelayerFee.BigInt()).Return(uint64(0), nil).Times(1)
+
+	msg := types.NewMsgTransferOut(addr1.String(), addr2.String(), &amount)
+	msg.DestChainId = uint32(testOpChainId)
+	_, err = s.msgServer.TransferOut(s.ctx, msg)
+	s.Require().NoError(err)
+
+	// unsupported chain
+	msg.DestChainId = 1
+	_, err = s.msgServer.TransferOut(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrUnsupportedChain)
+
+	// the relayer fees of op chain are not set
+	params.OpTransferOutRelayerFee = sdk.Int{}
+	s.Require().NoError(s.bridgeKeeper.SetParams(s.ctx, params))
+	msg.DestChainId = uint32(testOpChainId)
+	_, err = s.msgServer.TransferOut(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrUnsupportedChain)
+
+	// the registered token goes to its own chain
+	token := s.registerTestToken(true)
+	msg = types.NewMsgTransferOut(addr1.String(), addr2.String(), &sdk.Coin{Denom: token.Denom, Amount: sdk.NewInt(1e12)})
+	msg.DestChainId = uint32(testOpChainId)
+	_, err = s.msgServer.TransferOut(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrUnsupportedChain)
+}
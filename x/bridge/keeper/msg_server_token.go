@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/bnb-chain/greenfield/x/bridge/types"
+)
+
+func (k msgServer) RegisterToken(goCtx context.Context, req *types.MsgRegisterToken) (*types.MsgRegisterTokenResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	token := req.GetTokenInfo()
+	if err := k.SetToken(ctx, token); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRegisterToken{
+		Denom:              token.Denom,
+		ChainId:            token.ChainId,
+		ContractAddress:    token.ContractAddress,
+		Decimals:           token.Decimals,
+		Mintable:           token.Mintable,
+		GlobalTransferCap:  token.GlobalTransferCap,
+		AccountTransferCap: token.AccountTransferCap,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterTokenResponse{}, nil
+}
+
+func (k msgServer) DeregisterToken(goCtx context.Context, req *types.MsgDeregisterToken) (*types.MsgDeregisterTokenResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.DeleteToken(ctx, req.Denom); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDeregisterToken{Denom: req.Denom}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeregisterTokenResponse{}, nil
+}
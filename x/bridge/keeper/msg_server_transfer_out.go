@@ -13,12 +13,27 @@ import (
 func (k msgServer) TransferOut(goCtx context.Context, msg *types.MsgTransferOut) (*types.MsgTransferOutResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if k.IsTransferPaused(ctx) {
+		return nil, types.ErrTransferPaused
+	}
+
 	bondDenom := k.stakingKeeper.BondDenom(ctx)
 	if msg.Amount.Denom != bondDenom {
-		return nil, errors.Wrapf(types.ErrUnsupportedDenom, "denom is not supported")
+		token, found := k.GetToken(ctx, msg.Amount.Denom)
+		if !found {
+			return nil, errors.Wrapf(types.ErrUnsupportedDenom, "denom is not supported")
+		}
+		return k.transferOutToken(ctx, msg, token)
 	}
 
-	relayerFeeAmount, ackRelayerFeeAmount := k.GetTransferOutRelayerFee(ctx)
+	destChainId := k.crossChainKeeper.GetDestBscChainID()
+	if msg.DestChainId != 0 {
+		destChainId = sdk.ChainID(msg.DestChainId)
+	}
+	relayerFeeAmount, ackRelayerFeeAmount, err := k.GetTransferOutRelayerFee(ctx, destChainId)
+	if err != nil {
+		return nil, err
+	}
 	totalRelayerFee := relayerFeeAmount.Add(ackRelayerFeeAmount)
 
 	relayerFee := sdk.Coin{
@@ -28,40 +43,91 @@ func (k msgServer) TransferOut(goCtx context.Context, msg *types.MsgTransferOut)
 	transferAmount := sdk.Coins{*msg.Amount}.Add(relayerFee)
 
 	fromAddress := sdk.MustAccAddressFromHex(msg.From)
-	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, fromAddress, crosschaintypes.ModuleName, transferAmount)
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, fromAddress, crosschaintypes.ModuleName, transferAmount)
 	if err != nil {
 		return nil, err
 	}
 
 	toAddress := sdk.MustAccAddressFromHex(msg.To)
 
-	transferPackage := types.TransferOutSynPackage{
-		RefundAddress: fromAddress,
-		Recipient:     toAddress,
-		Amount:        msg.Amount.Amount.BigInt(),
+	// the transfer over the rate limits is queued, and sent when the following windows have room for it
+	if !k.consumeTransferQuota(ctx, types.TRANSFER_DIRECTION_OUT, nil, fromAddress, msg.Amount.Amount, false) {
+		err = k.queueTransfer(ctx, types.QueuedTransfer{
+			Direction:     types.TRANSFER_DIRECTION_OUT,
+			RefundAddress: fromAddress.String(),
+			Recipient:     toAddress.String(),
+			Amount:        msg.Amount.Amount,
+			RelayerFee:    relayerFeeAmount,
+			AckRelayerFee: ackRelayerFeeAmount,
+			ChainId:       uint32(destChainId),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &types.MsgTransferOutResponse{}, nil
 	}
 
-	encodedPackage, err := transferPackage.Serialize()
+	_, err = k.sendTransferOutPackage(ctx, destChainId, fromAddress, toAddress, msg.Amount.Amount, relayerFeeAmount, ackRelayerFeeAmount)
 	if err != nil {
-		return nil, errors.Wrapf(types.ErrInvalidPackage, "encode transfer out package error")
+		return nil, err
 	}
 
-	sendSeq, err := k.crossChainKeeper.CreateRawIBCPackageWithFee(ctx, k.crossChainKeeper.GetDestBscChainID(), types.TransferOutChannelID, sdk.SynCrossChainPackageType,
-		encodedPackage, relayerFeeAmount.BigInt(), ackRelayerFeeAmount.BigInt())
+	return &types.MsgTransferOutResponse{}, nil
+}
+
+// transferOutToken transfers out a registered token to its contract on the destination chain, the relayer fee is
+// still paid in the native token.
+func (k msgServer) transferOutToken(ctx sdk.Context, msg *types.MsgTransferOut, token types.TokenInfo) (*types.MsgTransferOutResponse, error) {
+	destChainId := sdk.ChainID(token.ChainId)
+	if msg.DestChainId != 0 && msg.DestChainId != token.ChainId {
+		return nil, errors.Wrapf(types.ErrUnsupportedChain, "token %s is registered on chain %d", token.Denom, token.ChainId)
+	}
+
+	if _, err := token.ToContractAmount(msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
+	relayerFeeAmount, ackRelayerFeeAmount, err := k.GetTransferOutRelayerFee(ctx, destChainId)
 	if err != nil {
 		return nil, err
 	}
+	relayerFee := sdk.Coin{
+		Denom:  k.stakingKeeper.BondDenom(ctx),
+		Amount: relayerFeeAmount.Add(ackRelayerFeeAmount),
+	}
+
+	fromAddress := sdk.MustAccAddressFromHex(msg.From)
+	if relayerFee.IsPositive() {
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, fromAddress, crosschaintypes.ModuleName, sdk.Coins{relayerFee})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err = k.lockToken(ctx, token, fromAddress, *msg.Amount); err != nil {
+		return nil, err
+	}
 
-	// emit event
-	transferOutEvent := types.EventCrossTransferOut{
-		From:        fromAddress.String(),
-		To:          toAddress.String(),
-		Amount:      msg.Amount,
-		RelayerFee:  &relayerFee,
-		Sequence:    sendSeq,
-		DestChainId: uint32(k.crossChainKeeper.GetDestBscChainID()),
+	toAddress := sdk.MustAccAddressFromHex(msg.To)
+
+	// the transfer over the rate limits of the token is queued, and sent when the following windows have room for it
+	if !k.consumeTransferQuota(ctx, types.TRANSFER_DIRECTION_OUT, &token, fromAddress, msg.Amount.Amount, false) {
+		err = k.queueTransfer(ctx, types.QueuedTransfer{
+			Direction:     types.TRANSFER_DIRECTION_OUT,
+			RefundAddress: fromAddress.String(),
+			Recipient:     toAddress.String(),
+			Amount:        msg.Amount.Amount,
+			RelayerFee:    relayerFeeAmount,
+			AckRelayerFee: ackRelayerFeeAmount,
+			ChainId:       uint32(destChainId),
+			Token:         &token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &types.MsgTransferOutResponse{}, nil
 	}
-	err = ctx.EventManager().EmitTypedEvent(&transferOutEvent)
+
+	_, err = k.sendTokenTransferOutPackage(ctx, token, fromAddress, toAddress, msg.Amount.Amount, relayerFeeAmount, ackRelayerFeeAmount)
 	if err != nil {
 		return nil, err
 	}
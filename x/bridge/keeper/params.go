@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -32,9 +33,20 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	return nil
 }
 
-// GetTransferOutRelayerFee gets the transfer out relayer fee params
-func (k Keeper) GetTransferOutRelayerFee(ctx sdk.Context) (sdkmath.Int, sdkmath.Int) {
+// GetTransferOutRelayerFee gets the transfer out relayer fee params of the destination chain
+func (k Keeper) GetTransferOutRelayerFee(ctx sdk.Context, destChainId sdk.ChainID) (sdkmath.Int, sdkmath.Int, error) {
 	params := k.GetParams(ctx)
 
-	return params.BscTransferOutRelayerFee, params.BscTransferOutAckRelayerFee
+	switch destChainId {
+	case k.crossChainKeeper.GetDestBscChainID():
+		return params.BscTransferOutRelayerFee, params.BscTransferOutAckRelayerFee, nil
+	case k.crossChainKeeper.GetDestOpChainID():
+		// the transfers to op chain are disabled until its relayer fees are set
+		if params.OpTransferOutRelayerFee.IsNil() || params.OpTransferOutRelayerFee.IsZero() || params.OpTransferOutAckRelayerFee.IsNil() {
+			return sdkmath.Int{}, sdkmath.Int{}, errors.Wrapf(types.ErrUnsupportedChain, "relayer fees of chain %d are not set", destChainId)
+		}
+		return params.OpTransferOutRelayerFee, params.OpTransferOutAckRelayerFee, nil
+	default:
+		return sdkmath.Int{}, sdkmath.Int{}, errors.Wrapf(types.ErrUnsupportedChain, "chain id %d", destChainId)
+	}
 }
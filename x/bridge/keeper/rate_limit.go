@@ -0,0 +1,419 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	crosschaintypes "github.com/cosmos/cosmos-sdk/x/crosschain/types"
+
+	"github.com/bnb-chain/greenfield/x/bridge/types"
+)
+
+// IsTransferPaused returns whether the cross chain transfers are paused by governance
+func (k Keeper) IsTransferPaused(ctx sdk.Context) bool {
+	return k.GetParams(ctx).TransferPaused
+}
+
+// isTransferCapped returns whether the transfer cap is set, the caps are unset for the params saved before they
+// were introduced
+func isTransferCapped(transferCap sdkmath.Int) bool {
+	return !transferCap.IsNil() && transferCap.IsPositive()
+}
+
+// transferCaps returns the denom and the transfer caps of the native token, or of the registered token if it is set
+func (k Keeper) transferCaps(ctx sdk.Context, params types.Params, token *types.TokenInfo) (string, sdkmath.Int, sdkmath.Int) {
+	if token == nil {
+		return k.stakingKeeper.BondDenom(ctx), params.GlobalTransferCap, params.AccountTransferCap
+	}
+	return token.Denom, token.GlobalTransferCap, token.AccountTransferCap
+}
+
+func (k Keeper) getTransferVolume(ctx sdk.Context, direction types.TransferDirection, windowStartHeight uint64, denom string, addr sdk.AccAddress) sdkmath.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetTransferVolumeKey(direction, windowStartHeight, denom, addr))
+	if bz == nil {
+		return sdkmath.ZeroInt()
+	}
+	var volume sdkmath.Int
+	if err := volume.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return volume
+}
+
+func (k Keeper) setTransferVolume(ctx sdk.Context, direction types.TransferDirection, windowStartHeight uint64, denom string, addr sdk.AccAddress, volume sdkmath.Int) {
+	bz, err := volume.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetTransferVolumeKey(direction, windowStartHeight, denom, addr), bz)
+}
+
+// consumeTransferQuota checks the transfer of the native token, or the registered token if it is set, is within the
+// global and the account transfer caps of the current window, and counts it to the transfer volumes if so. A transfer
+// over a cap on its own is allowed when nothing is transferred against the cap in the window yet, so that it is not
+// queued forever.
+func (k Keeper) consumeTransferQuota(ctx sdk.Context, direction types.TransferDirection, token *types.TokenInfo, addr sdk.AccAddress,
+	amount sdkmath.Int, allowOversize bool,
+) bool {
+	params := k.GetParams(ctx)
+	window := params.TransferRateLimitWindow
+	if window == 0 {
+		return true
+	}
+	height := uint64(ctx.BlockHeight())
+	windowStartHeight := height - height%window
+	denom, globalCap, accountCap := k.transferCaps(ctx, params, token)
+
+	withinCap := func(transferCap, volume sdkmath.Int) bool {
+		if !isTransferCapped(transferCap) {
+			return true
+		}
+		return volume.Add(amount).LTE(transferCap) || (allowOversize && volume.IsZero())
+	}
+
+	globalVolume := k.getTransferVolume(ctx, direction, windowStartHeight, denom, nil)
+	accountVolume := k.getTransferVolume(ctx, direction, windowStartHeight, denom, addr)
+	if !withinCap(globalCap, globalVolume) || !withinCap(accountCap, accountVolume) {
+		return false
+	}
+
+	k.setTransferVolume(ctx, direction, windowStartHeight, denom, nil, globalVolume.Add(amount))
+	k.setTransferVolume(ctx, direction, windowStartHeight, denom, addr, accountVolume.Add(amount))
+	return true
+}
+
+// isGlobalTransferCapReached returns whether nothing more of the native token, or the registered token if it is set,
+// can be transferred against the global cap in the current window
+func (k Keeper) isGlobalTransferCapReached(ctx sdk.Context, direction types.TransferDirection, token *types.TokenInfo) bool {
+	params := k.GetParams(ctx)
+	denom, globalCap, _ := k.transferCaps(ctx, params, token)
+	if params.TransferRateLimitWindow == 0 || !isTransferCapped(globalCap) {
+		return false
+	}
+	height := uint64(ctx.BlockHeight())
+	windowStartHeight := height - height%params.TransferRateLimitWindow
+	return k.getTransferVolume(ctx, direction, windowStartHeight, denom, nil).GTE(globalCap)
+}
+
+// removeTransferVolumesBefore removes the transfer volumes of the windows starting before the height
+func (k Keeper) removeTransferVolumesBefore(ctx sdk.Context, windowStartHeight uint64) {
+	store := ctx.KVStore(k.storeKey)
+	for _, direction := range []types.TransferDirection{types.TRANSFER_DIRECTION_OUT, types.TRANSFER_DIRECTION_IN} {
+		iterator := store.Iterator(types.GetTransferVolumePrefix(direction, 0), types.GetTransferVolumePrefix(direction, windowStartHeight))
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
+
+// GetQueuedTransfer gets the queued transfer by its id
+func (k Keeper) GetQueuedTransfer(ctx sdk.Context, id uint64) (types.QueuedTransfer, bool) {
+	var transfer types.QueuedTransfer
+	bz := ctx.KVStore(k.storeKey).Get(types.GetQueuedTransferKey(id))
+	if bz == nil {
+		return transfer, false
+	}
+	k.cdc.MustUnmarshal(bz, &transfer)
+	return transfer, true
+}
+
+// GetAllQueuedTransfers returns all the queued transfers in the order they are queued
+func (k Keeper) GetAllQueuedTransfers(ctx sdk.Context) []types.QueuedTransfer {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedTransferPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var transfers []types.QueuedTransfer
+	for ; iterator.Valid(); iterator.Next() {
+		var transfer types.QueuedTransfer
+		k.cdc.MustUnmarshal(iterator.Value(), &transfer)
+		transfers = append(transfers, transfer)
+	}
+	return transfers
+}
+
+// SetQueuedTransfer saves the queued transfer and indexes it by the height it is queued, and moves the id sequence
+// after its id
+func (k Keeper) SetQueuedTransfer(ctx sdk.Context, transfer types.QueuedTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetQueuedTransferKey(transfer.Id), k.cdc.MustMarshal(&transfer))
+	store.Set(types.GetQueuedTransferByHeightKey(transfer.QueuedHeight, transfer.Id), []byte{})
+	if transfer.Id >= k.getNextQueuedTransferId(ctx) {
+		store.Set(types.QueuedTransferSeqKey, sdk.Uint64ToBigEndian(transfer.Id+1))
+	}
+}
+
+func (k Keeper) deleteQueuedTransfer(ctx sdk.Context, transfer types.QueuedTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetQueuedTransferKey(transfer.Id))
+	store.Delete(types.GetQueuedTransferByHeightKey(transfer.QueuedHeight, transfer.Id))
+}
+
+// getQueuedTransfersByHeight returns at most limit queued transfers in the order of the heights they are queued, which
+// are queued before the end key of the index, or all the queued transfers if the end key is nil
+func (k Keeper) getQueuedTransfersByHeight(ctx sdk.Context, end []byte, limit uint64) []types.QueuedTransfer {
+	if end == nil {
+		end = sdk.PrefixEndBytes(types.QueuedTransferByHeightPrefix)
+	}
+	iterator := ctx.KVStore(k.storeKey).Iterator(types.QueuedTransferByHeightPrefix, end)
+	defer iterator.Close()
+
+	var transfers []types.QueuedTransfer
+	for ; iterator.Valid() && uint64(len(transfers)) < limit; iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Key()[len(types.QueuedTransferByHeightPrefix)+8:])
+		transfer, found := k.GetQueuedTransfer(ctx, id)
+		if !found {
+			panic(fmt.Sprintf("queued transfer %d is indexed but not found", id))
+		}
+		transfers = append(transfers, transfer)
+	}
+	return transfers
+}
+
+func (k Keeper) getNextQueuedTransferId(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.QueuedTransferSeqKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// queueTransfer queues a transfer over the transfer rate limits
+func (k Keeper) queueTransfer(ctx sdk.Context, transfer types.QueuedTransfer) error {
+	transfer.Id = k.getNextQueuedTransferId(ctx)
+	transfer.QueuedHeight = ctx.BlockHeight()
+	k.SetQueuedTransfer(ctx, transfer)
+
+	amount := k.queuedTransferAmount(ctx, transfer)
+	return ctx.EventManager().EmitTypedEvent(&types.EventCrossTransferQueued{
+		Id:            transfer.Id,
+		Direction:     transfer.Direction,
+		RefundAddress: transfer.RefundAddress,
+		Recipient:     transfer.Recipient,
+		Amount:        &amount,
+		ChainId:       transfer.ChainId,
+	})
+}
+
+func (k Keeper) queuedTransferAmount(ctx sdk.Context, transfer types.QueuedTransfer) sdk.Coin {
+	if transfer.Token == nil {
+		return sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), transfer.Amount)
+	}
+	return sdk.NewCoin(transfer.Token.Denom, transfer.Amount)
+}
+
+// getQueuedTransferToken returns the registered token of the queued transfer, which is nil for the native token. The
+// token is not found if it is deregistered or moved to another contract after the transfer is queued.
+func (k Keeper) getQueuedTransferToken(ctx sdk.Context, transfer types.QueuedTransfer) (*types.TokenInfo, bool) {
+	if transfer.Token == nil {
+		return nil, true
+	}
+	token, found := k.GetToken(ctx, transfer.Token.Denom)
+	if !found || token.ChainId != transfer.Token.ChainId || token.GetContract() != transfer.Token.GetContract() {
+		return nil, false
+	}
+	return &token, true
+}
+
+// ProcessQueuedTransfers refunds the expired queued transfers, and executes the other queued transfers in the order
+// they are queued as long as they are within the transfer rate limits of the current window. At most
+// MaxQueuedTransfersPerBlock transfers are processed in a block, and only the expired ones are processed while the
+// transfers are paused.
+func (k Keeper) ProcessQueuedTransfers(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.TransferRateLimitWindow != 0 {
+		height := uint64(ctx.BlockHeight())
+		k.removeTransferVolumesBefore(ctx, height-height%params.TransferRateLimitWindow)
+	}
+
+	limit := params.MaxQueuedTransfersPerBlock
+	expired := k.expireQueuedTransfers(ctx, params.QueuedTransferExpiryBlocks, limit)
+	if params.TransferPaused || expired >= limit {
+		return
+	}
+
+	for _, transfer := range k.getQueuedTransfersByHeight(ctx, nil, limit-expired) {
+		token, registered := k.getQueuedTransferToken(ctx, transfer)
+		if !registered {
+			// the token is deregistered after the transfer is queued
+			if _, err := k.refundQueuedTransfer(ctx, transfer); err != nil {
+				k.Logger(ctx).Error("refund queued transfer error", "id", transfer.Id, "err", err.Error())
+			}
+			continue
+		}
+		if k.isGlobalTransferCapReached(ctx, transfer.Direction, token) {
+			continue
+		}
+		addr := sdk.MustAccAddressFromHex(transfer.RefundAddress)
+		if transfer.Direction == types.TRANSFER_DIRECTION_IN {
+			addr = sdk.MustAccAddressFromHex(transfer.Recipient)
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if !k.consumeTransferQuota(cacheCtx, transfer.Direction, token, addr, transfer.Amount, true) {
+			continue
+		}
+		var err error
+		if transfer.Direction == types.TRANSFER_DIRECTION_OUT {
+			err = k.executeQueuedTransferOut(cacheCtx, transfer, token)
+		} else {
+			err = k.executeQueuedTransferIn(cacheCtx, transfer, token)
+		}
+		if err != nil {
+			k.Logger(ctx).Error("execute queued transfer error", "id", transfer.Id, "err", err.Error())
+			if transfer.Direction == types.TRANSFER_DIRECTION_IN {
+				// keep the transfer queued, it is retried later until it expires
+				continue
+			}
+			if _, err = k.refundQueuedTransfer(ctx, transfer); err != nil {
+				k.Logger(ctx).Error("refund queued transfer error", "id", transfer.Id, "err", err.Error())
+			}
+			continue
+		}
+		write()
+		k.deleteQueuedTransfer(ctx, transfer)
+	}
+}
+
+// expireQueuedTransfers refunds at most limit queued transfers which are queued for the expiry blocks, and returns the
+// number of the expired transfers processed
+func (k Keeper) expireQueuedTransfers(ctx sdk.Context, expiryBlocks, limit uint64) uint64 {
+	height := ctx.BlockHeight()
+	if expiryBlocks == 0 || height < 0 || uint64(height) < expiryBlocks {
+		return 0
+	}
+	transfers := k.getQueuedTransfersByHeight(ctx, types.GetQueuedTransferByHeightKey(height-int64(expiryBlocks)+1, 0), limit)
+	for _, transfer := range transfers {
+		sequence, err := k.refundQueuedTransfer(ctx, transfer)
+		if err != nil {
+			k.Logger(ctx).Error("refund expired queued transfer error", "id", transfer.Id, "err", err.Error())
+			continue
+		}
+		amount := k.queuedTransferAmount(ctx, transfer)
+		err = ctx.EventManager().EmitTypedEvent(&types.EventCrossTransferQueuedExpired{
+			Id:            transfer.Id,
+			Direction:     transfer.Direction,
+			RefundAddress: transfer.RefundAddress,
+			Amount:        &amount,
+			ChainId:       transfer.ChainId,
+			Sequence:      sequence,
+		})
+		if err != nil {
+			panic(err)
+		}
+	}
+	return uint64(len(transfers))
+}
+
+func (k Keeper) executeQueuedTransferOut(ctx sdk.Context, transfer types.QueuedTransfer, token *types.TokenInfo) error {
+	from := sdk.MustAccAddressFromHex(transfer.RefundAddress)
+	to := sdk.MustAccAddressFromHex(transfer.Recipient)
+	var err error
+	if token == nil {
+		_, err = k.sendTransferOutPackage(ctx, sdk.ChainID(transfer.ChainId), from, to, transfer.Amount, transfer.RelayerFee, transfer.AckRelayerFee)
+	} else {
+		_, err = k.sendTokenTransferOutPackage(ctx, *token, from, to, transfer.Amount, transfer.RelayerFee, transfer.AckRelayerFee)
+	}
+	return err
+}
+
+func (k Keeper) executeQueuedTransferIn(ctx sdk.Context, transfer types.QueuedTransfer, token *types.TokenInfo) error {
+	receiver := sdk.MustAccAddressFromHex(transfer.Recipient)
+	refundAddress := sdk.MustAccAddressFromHex(transfer.RefundAddress)
+	if token == nil {
+		return k.transferIn(ctx, receiver, refundAddress, transfer.Amount, transfer.Sequence, transfer.ChainId)
+	}
+	return k.transferInToken(ctx, *token, receiver, refundAddress, transfer.Amount, transfer.Sequence, transfer.ChainId)
+}
+
+// refundQueuedTransfer removes the queued transfer which can not be executed. A transfer out is refunded to the
+// sender on greenfield with its relayer fees, and a transfer in is sent back to the refund address on the source chain
+// with the relayer fees taken out of its amount, the sequence of the package sending it back is returned.
+func (k Keeper) refundQueuedTransfer(ctx sdk.Context, transfer types.QueuedTransfer) (uint64, error) {
+	cacheCtx, write := ctx.CacheContext()
+	var (
+		sequence uint64
+		err      error
+	)
+	if transfer.Direction == types.TRANSFER_DIRECTION_OUT {
+		err = k.refundQueuedTransferOut(cacheCtx, transfer)
+	} else {
+		sequence, err = k.sendBackQueuedTransferIn(cacheCtx, transfer)
+	}
+	if err != nil {
+		return 0, errors.Wrapf(err, "refund queued transfer %d", transfer.Id)
+	}
+	write()
+	k.deleteQueuedTransfer(ctx, transfer)
+	return sequence, nil
+}
+
+// refundQueuedTransferOut gives back the amount and the relayer fees of the queued transfer out which can not be sent
+func (k Keeper) refundQueuedTransferOut(ctx sdk.Context, transfer types.QueuedTransfer) error {
+	refundAddress := sdk.MustAccAddressFromHex(transfer.RefundAddress)
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, transfer.RelayerFee.Add(transfer.AckRelayerFee)))
+	refund := sdk.NewCoin(bondDenom, transfer.Amount)
+	if transfer.Token == nil {
+		coins = coins.Add(refund)
+		refund = coins[0]
+	} else {
+		// the token is refunded in the same way as the transfers out in flight
+		token, found := k.getRefundTokenByContract(ctx, sdk.ChainID(transfer.Token.ChainId), transfer.Token.GetContract())
+		if !found {
+			token = *transfer.Token
+		}
+		refund = sdk.NewCoin(token.Denom, transfer.Amount)
+		if err := k.releaseToken(ctx, token, refundAddress, refund); err != nil {
+			return err
+		}
+	}
+	if !coins.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, crosschaintypes.ModuleName, refundAddress, coins); err != nil {
+			return err
+		}
+	}
+	return ctx.EventManager().EmitTypedEvent(&types.EventCrossTransferOutRefund{
+		RefundAddress: refundAddress.String(),
+		Amount:        &refund,
+		RefundReason:  types.REFUND_REASON_UNKNOWN,
+		DestChainId:   transfer.ChainId,
+	})
+}
+
+// sendBackQueuedTransferIn sends the queued transfer in back to the refund address on the source chain, the amount is
+// neither given to the receiver nor minted yet, and the receiver gets the refund if the package fails on the source
+// chain. The relayer fees of a transfer out are taken out of the amount, so the amount is given to the refund address
+// on greenfield instead if it can not cover the relayer fees, or it is a registered token whose relayer fees can not be
+// taken out of it, and 0 is returned as the sequence.
+func (k Keeper) sendBackQueuedTransferIn(ctx sdk.Context, transfer types.QueuedTransfer) (uint64, error) {
+	receiver := sdk.MustAccAddressFromHex(transfer.Recipient)
+	refundAddress := sdk.MustAccAddressFromHex(transfer.RefundAddress)
+	if transfer.Token == nil {
+		relayerFee, ackRelayerFee, err := k.GetTransferOutRelayerFee(ctx, sdk.ChainID(transfer.ChainId))
+		if err == nil {
+			amount := transfer.Amount.Sub(relayerFee).Sub(ackRelayerFee)
+			if amount.IsPositive() {
+				return k.sendTransferOutPackage(ctx, sdk.ChainID(transfer.ChainId), receiver, refundAddress, amount,
+					relayerFee, ackRelayerFee)
+			}
+		}
+		return 0, k.transferIn(ctx, refundAddress, refundAddress, transfer.Amount, transfer.Sequence, transfer.ChainId)
+	}
+
+	// the token is refunded in the same way as the transfers out in flight
+	token, found := k.getRefundTokenByContract(ctx, sdk.ChainID(transfer.Token.ChainId), transfer.Token.GetContract())
+	if !found {
+		token = *transfer.Token
+	}
+	return 0, k.transferInToken(ctx, token, refundAddress, refundAddress, transfer.Amount, transfer.Sequence, transfer.ChainId)
+}
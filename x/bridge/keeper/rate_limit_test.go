@@ -0,0 +1,285 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	crosschaintypes "github.com/cosmos/cosmos-sdk/x/crosschain/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/x/bridge/keeper"
+	"github.com/bnb-chain/greenfield/x/bridge/types"
+)
+
+func (s *TestSuite) setRateLimits(window uint64, globalCap, accountCap int64, paused bool) {
+	params := types.DefaultParams()
+	params.TransferRateLimitWindow = window
+	params.GlobalTransferCap = sdkmath.NewInt(globalCap)
+	params.AccountTransferCap = sdkmath.NewInt(accountCap)
+	params.TransferPaused = paused
+	s.Require().NoError(s.bridgeKeeper.SetParams(s.ctx, params))
+}
+
+func (s *TestSuite) TestTransferOutRateLimit() {
+	s.mockTokenChains()
+	s.setRateLimits(10, 10, 5, false)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	addr1 := sdk.AccAddress(common.BytesToAddress([]byte("addr1")).Bytes())
+	addr2 := sdk.AccAddress(common.BytesToAddress([]byte("addr2")).Bytes())
+	recipient := sdk.AccAddress(common.BytesToAddress([]byte("recipient")).Bytes())
+	transferOut := func(ctx sdk.Context, from sdk.AccAddress, amount int64) {
+		msg := types.NewMsgTransferOut(from.String(), recipient.String(), &sdk.Coin{Denom: "BNB", Amount: sdkmath.NewInt(amount)})
+		_, err := s.msgServer.TransferOut(ctx, msg)
+		s.Require().NoError(err)
+	}
+
+	ctx := s.ctx.WithBlockHeight(21)
+	s.crossChainKeeper.EXPECT().CreateRawIBCPackageWithFee(gomock.Any(), testBscChainId, types.TransferOutChannelID, gomock.Any(),
+		gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(0), nil).Times(2)
+	transferOut(ctx, addr1, 3)
+	// over the account cap
+	transferOut(ctx, addr1, 3)
+	transferOut(ctx, addr2, 4)
+	// over the global cap
+	transferOut(ctx, addr2, 20)
+
+	res, err := s.queryClient.QueuedTransfers(ctx, &types.QueryQueuedTransfersRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Transfers, 2)
+	s.Require().Equal(uint64(1), res.Transfers[0].Id)
+	s.Require().Equal(addr1.String(), res.Transfers[0].RefundAddress)
+	s.Require().Equal(sdkmath.NewInt(3), res.Transfers[0].Amount)
+	s.Require().Equal(uint64(2), res.Transfers[1].Id)
+
+	// nothing is executed in the same window
+	s.bridgeKeeper.ProcessQueuedTransfers(ctx.WithBlockHeight(29))
+	s.Require().Len(s.bridgeKeeper.GetAllQueuedTransfers(ctx), 2)
+
+	// the transfer within the caps is executed in the next window
+	ctx = ctx.WithBlockHeight(30)
+	s.crossChainKeeper.EXPECT().CreateRawIBCPackageWithFee(gomock.Any(), testBscChainId, types.TransferOutChannelID, gomock.Any(),
+		gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(0), nil).Times(1)
+	s.bridgeKeeper.ProcessQueuedTransfers(ctx)
+	s.Require().Len(s.bridgeKeeper.GetAllQueuedTransfers(ctx), 1)
+
+	// the transfer over the global cap on its own is executed in an empty window
+	ctx = ctx.WithBlockHeight(40)
+	s.crossChainKeeper.EXPECT().CreateRawIBCPackageWithFee(gomock.Any(), testBscChainId, types.TransferOutChannelID, gomock.Any(),
+		gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(0), nil).Times(1)
+	s.bridgeKeeper.ProcessQueuedTransfers(ctx)
+	s.Require().Empty(s.bridgeKeeper.GetAllQueuedTransfers(ctx))
+}
+
+func (s *TestSuite) TestTransferInRateLimit() {
+	s.mockTokenChains()
+	s.setRateLimits(10, 0, 5, false)
+
+	receiver := sdk.AccAddress(common.BytesToAddress([]byte("receiverAddress")).Bytes())
+	packageBytes, err := (&types.TransferInSynPackage{
+		Amount:          big.NewInt(6),
+		ReceiverAddress: receiver,
+		RefundAddress:   sdk.AccAddress(common.BytesToAddress([]byte("refundAddress")).Bytes()),
+	}).Serialize()
+	s.Require().NoError(err)
+
+	app := keeper.NewTransferInApp(*s.bridgeKeeper)
+	ctx := s.ctx.WithBlockHeight(21)
+
+	// over the account cap, the transfer is queued without sending coins
+	result := app.ExecuteSynPackage(ctx, &sdk.CrossChainAppContext{Sequence: 7, SrcChainId: testBscChainId}, packageBytes)
+	s.Require().NoError(result.Err)
+	s.Require().Nil(result.Payload)
+
+	transfers := s.bridgeKeeper.GetAllQueuedTransfers(ctx)
+	s.Require().Len(transfers, 1)
+	s.Require().Equal(types.TRANSFER_DIRECTION_IN, transfers[0].Direction)
+	s.Require().Equal(uint64(7), transfers[0].Sequence)
+
+	// the transfer failed to be executed is kept queued
+	ctx = ctx.WithBlockHeight(30)
+	amount := sdk.Coins{sdk.NewCoin("BNB", sdkmath.NewInt(6))}
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Any(), receiver, amount).Return(types.ErrInvalidAmount).Times(1)
+	s.bridgeKeeper.ProcessQueuedTransfers(ctx)
+	s.Require().Len(s.bridgeKeeper.GetAllQueuedTransfers(ctx), 1)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Any(), receiver, amount).Return(nil).Times(1)
+	s.bridgeKeeper.ProcessQueuedTransfers(ctx.WithBlockHeight(41))
+	s.Require().Empty(s.bridgeKeeper.GetAllQueuedTransfers(ctx))
+}
+
+func (s *TestSuite) TestTransferPaused() {
+	s.mockTokenChains()
+	s.setRateLimits(10, 0, 5, false)
+
+	receiver := sdk.AccAddress(common.BytesToAddress([]byte("receiverAddress")).Bytes())
+	transferInPackage := types.TransferInSynPackage{
+		Amount:          big.NewInt(6),
+		ReceiverAddress: receiver,
+		RefundAddress:   sdk.AccAddress(common.BytesToAddress([]byte("refundAddress")).Bytes()),
+	}
+	packageBytes, err := transferInPackage.Serialize()
+	s.Require().NoError(err)
+
+	app := keeper.NewTransferInApp(*s.bridgeKeeper)
+	result := app.ExecuteSynPackage(s.ctx, &sdk.CrossChainAppContext{Sequence: 1, SrcChainId: testBscChainId}, packageBytes)
+	s.Require().NoError(result.Err)
+	s.Require().Len(s.bridgeKeeper.GetAllQueuedTransfers(s.ctx), 1)
+
+	s.setRateLimits(10, 0, 5, true)
+
+	// the transfers out are rejected
+	msg := types.NewMsgTransferOut(receiver.String(), receiver.String(), &sdk.Coin{Denom: "BNB", Amount: sdkmath.NewInt(1)})
+	_, err = s.msgServer.TransferOut(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrTransferPaused)
+
+	// the transfers in are refunded
+	result = app.ExecuteSynPackage(s.ctx, &sdk.CrossChainAppContext{Sequence: 2, SrcChainId: testBscChainId}, packageBytes)
+	s.Require().ErrorIs(result.Err, types.ErrTransferPaused)
+	unpacked, err := types.TransferInRefundPackageArgs.Unpack(result.Payload)
+	s.Require().NoError(err)
+	pkgStruct, ok := abi.ConvertType(unpacked[0], types.TransferInRefundPackageStruct{}).(types.TransferInRefundPackageStruct)
+	s.Require().True(ok)
+	s.Require().Equal(transferInPackage.Amount, pkgStruct.RefundAmount)
+	s.Require().Equal(uint32(types.REFUND_REASON_TRANSFER_PAUSED), pkgStruct.RefundReason)
+
+	// the queued transfers are held
+	s.bridgeKeeper.ProcessQueuedTransfers(s.ctx.WithBlockHeight(100))
+	s.Require().Len(s.bridgeKeeper.GetAllQueuedTransfers(s.ctx), 1)
+}
+
+func (s *TestSuite) TestTokenTransferRateLimit() {
+	s.mockTokenChains()
+	s.setRateLimits(10, 0, 0, false)
+	token := s.registerTestToken(true)
+	token.GlobalTransferCap = sdkmath.NewInt(5e12)
+	s.Require().NoError(s.bridgeKeeper.SetToken(s.ctx, token))
+
+	sender := sdk.AccAddress(common.BytesToAddress([]byte("sender")).Bytes())
+	receiver := sdk.AccAddress(common.BytesToAddress([]byte("receiverAddress")).Bytes())
+	amount := sdk.NewCoin(token.Denom, sdkmath.NewInt(3e12))
+	ctx := s.ctx.WithBlockHeight(21)
+
+	// the token is locked when the transfer out is queued, and the native token is not capped by the token
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sender, gomock.Any(), gomock.Any()).Return(nil).Times(8)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, sdk.Coins{amount}).Return(nil).Times(4)
+	s.crossChainKeeper.EXPECT().CreateRawIBCPackageWithFee(gomock.Any(), testBscChainId, types.TokenTransferOutChannelID, gomock.Any(),
+		gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(0), nil).Times(1)
+	_, err := s.msgServer.TransferOut(ctx, types.NewMsgTransferOut(sender.String(), receiver.String(), &amount))
+	s.Require().NoError(err)
+	_, err = s.msgServer.TransferOut(ctx, types.NewMsgTransferOut(sender.String(), receiver.String(), &amount))
+	s.Require().NoError(err)
+
+	transfers := s.bridgeKeeper.GetAllQueuedTransfers(ctx)
+	s.Require().Len(transfers, 1)
+	s.Require().Equal(types.TRANSFER_DIRECTION_OUT, transfers[0].Direction)
+	s.Require().Equal(token, *transfers[0].Token)
+
+	// the transfer in is counted separately
+	packageBytes, err := (&types.TokenTransferInSynPackage{
+		Contract:        testTokenContract,
+		Amount:          big.NewInt(6),
+		ReceiverAddress: receiver,
+		RefundAddress:   sdk.AccAddress(common.BytesToAddress([]byte("refundAddress")).Bytes()),
+	}).Serialize()
+	s.Require().NoError(err)
+	result := keeper.NewTokenTransferInApp(*s.bridgeKeeper).ExecuteSynPackage(ctx, &sdk.CrossChainAppContext{Sequence: 3, SrcChainId: testBscChainId}, packageBytes)
+	s.Require().NoError(result.Err)
+	s.Require().Nil(result.Payload)
+	s.Require().Len(s.bridgeKeeper.GetAllQueuedTransfers(ctx), 2)
+
+	// the queued transfers are executed in the next window
+	ctx = ctx.WithBlockHeight(30)
+	transferInAmount := sdk.Coins{sdk.NewCoin(token.Denom, sdkmath.NewInt(6e12))}
+	s.crossChainKeeper.EXPECT().CreateRawIBCPackageWithFee(gomock.Any(), testBscChainId, types.TokenTransferOutChannelID, gomock.Any(),
+		gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(0), nil).Times(1)
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, transferInAmount).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, receiver, transferInAmount).Return(nil).Times(1)
+	s.bridgeKeeper.ProcessQueuedTransfers(ctx)
+	s.Require().Empty(s.bridgeKeeper.GetAllQueuedTransfers(ctx))
+
+	// the queued transfer of a deregistered token is refunded
+	ctx = ctx.WithBlockHeight(40)
+	s.crossChainKeeper.EXPECT().CreateRawIBCPackageWithFee(gomock.Any(), testBscChainId, types.TokenTransferOutChannelID, gomock.Any(),
+		gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(0), nil).Times(1)
+	_, err = s.msgServer.TransferOut(ctx, types.NewMsgTransferOut(sender.String(), receiver.String(), &amount))
+	s.Require().NoError(err)
+	_, err = s.msgServer.TransferOut(ctx, types.NewMsgTransferOut(sender.String(), receiver.String(), &amount))
+	s.Require().NoError(err)
+	s.Require().Len(s.bridgeKeeper.GetAllQueuedTransfers(ctx), 1)
+	s.Require().NoError(s.bridgeKeeper.DeleteToken(ctx, token.Denom))
+
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, sdk.Coins{amount}).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sender, sdk.Coins{amount}).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), crosschaintypes.ModuleName, sender, gomock.Any()).Return(nil).Times(1)
+	s.bridgeKeeper.ProcessQueuedTransfers(ctx.WithBlockHeight(41))
+	s.Require().Empty(s.bridgeKeeper.GetAllQueuedTransfers(ctx))
+}
+
+func (s *TestSuite) TestQueuedTransferExpiry() {
+	s.mockTokenChains()
+	params := types.DefaultParams()
+	params.TransferRateLimitWindow = 10
+	params.AccountTransferCap = sdkmath.NewInt(5)
+	params.MaxQueuedTransfersPerBlock = 1
+	params.QueuedTransferExpiryBlocks = 100
+	params.BscTransferOutRelayerFee = sdkmath.NewInt(1)
+	params.BscTransferOutAckRelayerFee = sdkmath.NewInt(2)
+	s.Require().NoError(s.bridgeKeeper.SetParams(s.ctx, params))
+
+	receiver := sdk.AccAddress(common.BytesToAddress([]byte("receiverAddress")).Bytes())
+	refundAddress := sdk.AccAddress(common.BytesToAddress([]byte("refundAddress")).Bytes())
+	packageBytes, err := (&types.TransferInSynPackage{
+		Amount:          big.NewInt(6),
+		ReceiverAddress: receiver,
+		RefundAddress:   refundAddress,
+	}).Serialize()
+	s.Require().NoError(err)
+
+	app := keeper.NewTransferInApp(*s.bridgeKeeper)
+	for _, height := range []int64{21, 22} {
+		result := app.ExecuteSynPackage(s.ctx.WithBlockHeight(height), &sdk.CrossChainAppContext{Sequence: uint64(height), SrcChainId: testBscChainId}, packageBytes)
+		s.Require().NoError(result.Err)
+	}
+	s.Require().Len(s.bridgeKeeper.GetAllQueuedTransfers(s.ctx), 2)
+
+	// the queued transfers are not expired yet, and held while paused
+	params.TransferPaused = true
+	s.Require().NoError(s.bridgeKeeper.SetParams(s.ctx, params))
+	s.bridgeKeeper.ProcessQueuedTransfers(s.ctx.WithBlockHeight(120))
+	s.Require().Len(s.bridgeKeeper.GetAllQueuedTransfers(s.ctx), 2)
+
+	// the expired transfers in are sent back to the source chain even while paused, one in a block, and the relayer
+	// fees are taken out of the amount
+	s.crossChainKeeper.EXPECT().CreateRawIBCPackageWithFee(gomock.Any(), testBscChainId, types.TransferOutChannelID, gomock.Any(),
+		gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx sdk.Context, chainId sdk.ChainID, channelId sdk.ChannelID, packageType sdk.CrossChainPackageType,
+			payload []byte, relayerFee, ackRelayerFee *big.Int,
+		) (uint64, error) {
+			pkg, err := types.DeserializeTransferOutSynPackage(payload)
+			s.Require().NoError(err)
+			s.Require().Equal(refundAddress, pkg.Recipient)
+			s.Require().Equal(receiver, pkg.RefundAddress)
+			s.Require().Equal(big.NewInt(3), pkg.Amount)
+			s.Require().Equal(big.NewInt(1), relayerFee)
+			s.Require().Equal(big.NewInt(2), ackRelayerFee)
+			return 1, nil
+		}).Times(1)
+	s.bridgeKeeper.ProcessQueuedTransfers(s.ctx.WithBlockHeight(122))
+	transfers := s.bridgeKeeper.GetAllQueuedTransfers(s.ctx)
+	s.Require().Len(transfers, 1)
+	s.Require().Equal(int64(22), transfers[0].QueuedHeight)
+
+	// the amount is given to the refund address on greenfield if it can not cover the relayer fees
+	params.BscTransferOutAckRelayerFee = sdkmath.NewInt(5)
+	s.Require().NoError(s.bridgeKeeper.SetParams(s.ctx, params))
+	s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("BNB").AnyTimes()
+	refund := sdk.Coins{sdk.NewCoin("BNB", sdkmath.NewInt(6))}
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), crosschaintypes.ModuleName, refundAddress, refund).
+		Return(nil).Times(1)
+	s.bridgeKeeper.ProcessQueuedTransfers(s.ctx.WithBlockHeight(123))
+	s.Require().Empty(s.bridgeKeeper.GetAllQueuedTransfers(s.ctx))
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/bnb-chain/greenfield/x/bridge/types"
+)
+
+// GetToken gets the registered token by its denom
+func (k Keeper) GetToken(ctx sdk.Context, denom string) (types.TokenInfo, bool) {
+	var token types.TokenInfo
+	bz := ctx.KVStore(k.storeKey).Get(types.GetTokenInfoKey(denom))
+	if bz == nil {
+		return token, false
+	}
+	k.cdc.MustUnmarshal(bz, &token)
+	return token, true
+}
+
+// GetTokenByContract gets the registered token by the token contract on the destination chain
+func (k Keeper) GetTokenByContract(ctx sdk.Context, chainId sdk.ChainID, contract common.Address) (types.TokenInfo, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetTokenByContractKey(uint32(chainId), contract))
+	if bz == nil {
+		return types.TokenInfo{}, false
+	}
+	return k.GetToken(ctx, string(bz))
+}
+
+// GetAllTokens returns all the registered tokens
+func (k Keeper) GetAllTokens(ctx sdk.Context) []types.TokenInfo {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TokenInfoPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var tokens []types.TokenInfo
+	for ; iterator.Valid(); iterator.Next() {
+		var token types.TokenInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &token)
+		tokens = append(tokens, token)
+	}
+	return tokens
+}
+
+// SetToken registers a token, or updates the registered token of the same denom
+func (k Keeper) SetToken(ctx sdk.Context, token types.TokenInfo) error {
+	if err := token.Validate(); err != nil {
+		return err
+	}
+	if token.Denom == k.stakingKeeper.BondDenom(ctx) {
+		return errors.Wrapf(types.ErrInvalidToken, "the native token can not be registered")
+	}
+	chainId := sdk.ChainID(token.ChainId)
+	if chainId != k.crossChainKeeper.GetDestBscChainID() && chainId != k.crossChainKeeper.GetDestOpChainID() {
+		return errors.Wrapf(types.ErrInvalidToken, "unsupported chain id %d", token.ChainId)
+	}
+	if registered, found := k.GetTokenByContract(ctx, chainId, token.GetContract()); found && registered.Denom != token.Denom {
+		return errors.Wrapf(types.ErrTokenAlreadyRegistered, "contract %s is registered by %s", token.ContractAddress, registered.Denom)
+	}
+	// the contract of a deregistered token can not be taken by another denom, which would receive the refunds of the
+	// transfers of the deregistered token still in flight
+	if deregistered, found := k.getDeregisteredTokenByContract(ctx, chainId, token.GetContract()); found && deregistered.Denom != token.Denom {
+		return errors.Wrapf(types.ErrTokenAlreadyRegistered, "contract %s was registered by %s", token.ContractAddress, deregistered.Denom)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if prev, found := k.GetToken(ctx, token.Denom); found {
+		store.Delete(types.GetTokenByContractKey(prev.ChainId, prev.GetContract()))
+		k.setDeregisteredToken(ctx, prev)
+	}
+	store.Delete(types.GetDeregisteredTokenByContractKey(token.ChainId, token.GetContract()))
+	store.Set(types.GetTokenInfoKey(token.Denom), k.cdc.MustMarshal(&token))
+	store.Set(types.GetTokenByContractKey(token.ChainId, token.GetContract()), []byte(token.Denom))
+	return nil
+}
+
+// DeleteToken removes a registered token, a tombstone of the token is kept by its contract so that the transfers of
+// the token still in flight can be refunded
+func (k Keeper) DeleteToken(ctx sdk.Context, denom string) error {
+	token, found := k.GetToken(ctx, denom)
+	if !found {
+		return errors.Wrapf(types.ErrTokenNotRegistered, "denom %s", denom)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenInfoKey(denom))
+	store.Delete(types.GetTokenByContractKey(token.ChainId, token.GetContract()))
+	k.setDeregisteredToken(ctx, token)
+	return nil
+}
+
+func (k Keeper) setDeregisteredToken(ctx sdk.Context, token types.TokenInfo) {
+	ctx.KVStore(k.storeKey).Set(types.GetDeregisteredTokenByContractKey(token.ChainId, token.GetContract()), k.cdc.MustMarshal(&token))
+}
+
+func (k Keeper) getDeregisteredTokenByContract(ctx sdk.Context, chainId sdk.ChainID, contract common.Address) (types.TokenInfo, bool) {
+	var token types.TokenInfo
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDeregisteredTokenByContractKey(uint32(chainId), contract))
+	if bz == nil {
+		return token, false
+	}
+	k.cdc.MustUnmarshal(bz, &token)
+	return token, true
+}
+
+// getRefundTokenByContract gets the token to refund a transfer out of the token contract, which could have been
+// deregistered or moved to another contract after the transfer was sent
+func (k Keeper) getRefundTokenByContract(ctx sdk.Context, chainId sdk.ChainID, contract common.Address) (types.TokenInfo, bool) {
+	if token, found := k.GetTokenByContract(ctx, chainId, contract); found {
+		return token, true
+	}
+	return k.getDeregisteredTokenByContract(ctx, chainId, contract)
+}
+
+// lockToken takes the registered token transferred out from the account, the token is burned if it is mintable,
+// otherwise it is escrowed by the bridge module.
+func (k Keeper) lockToken(ctx sdk.Context, token types.TokenInfo, from sdk.AccAddress, amount sdk.Coin) error {
+	if err := k.checkBridgedCoin(ctx, token, amount); err != nil {
+		return err
+	}
+	coins := sdk.Coins{amount}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if token.Mintable {
+		return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+	}
+	return nil
+}
+
+// releaseToken gives the registered token transferred in or refunded to the account, the token is minted if it is
+// mintable, otherwise it is released from the escrow of the bridge module.
+func (k Keeper) releaseToken(ctx sdk.Context, token types.TokenInfo, to sdk.AccAddress, amount sdk.Coin) error {
+	if err := k.checkBridgedCoin(ctx, token, amount); err != nil {
+		return err
+	}
+	coins := sdk.Coins{amount}
+	if token.Mintable {
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, coins)
+}
+
+// checkBridgedCoin makes sure the bridge module only mints or burns the coins of the registered token, the native
+// token is never minted or burned by the bridge module.
+func (k Keeper) checkBridgedCoin(ctx sdk.Context, token types.TokenInfo, amount sdk.Coin) error {
+	if amount.Denom != token.Denom || amount.Denom == k.stakingKeeper.BondDenom(ctx) {
+		return errors.Wrapf(types.ErrInvalidToken, "coin %s of token %s can not be bridged", amount.Denom, token.Denom)
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/x/bridge/keeper"
+	"github.com/bnb-chain/greenfield/x/bridge/types"
+)
+
+const (
+	testBscChainId = sdk.ChainID(714)
+	testOpChainId  = sdk.ChainID(204)
+)
+
+var testTokenContract = common.HexToAddress("0x55d398326f99059fF775485246999027B3197955")
+
+func (s *TestSuite) mockTokenChains() {
+	s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("BNB").AnyTimes()
+	s.crossChainKeeper.EXPECT().GetDestBscChainID().Return(testBscChainId).AnyTimes()
+	s.crossChainKeeper.EXPECT().GetDestOpChainID().Return(testOpChainId).AnyTimes()
+}
+
+func (s *TestSuite) registerTestToken(mintable bool) types.TokenInfo {
+	token := types.TokenInfo{
+		Denom:              "usdt",
+		ChainId:            uint32(testBscChainId),
+		ContractAddress:    testTokenContract.String(),
+		Decimals:           6,
+		Mintable:           mintable,
+		GlobalTransferCap:  sdkmath.ZeroInt(),
+		AccountTransferCap: sdkmath.ZeroInt(),
+	}
+	s.Require().NoError(s.bridgeKeeper.SetToken(s.ctx, token))
+	return token
+}
+
+func (s *TestSuite) TestRegisterToken() {
+	s.mockTokenChains()
+	authority := authtypes.NewModuleAddress(types.ModuleName).String()
+
+	msg := &types.MsgRegisterToken{
+		Authority:          authority,
+		Denom:              "usdt",
+		ChainId:            uint32(testBscChainId),
+		ContractAddress:    testTokenContract.String(),
+		Decimals:           6,
+		Mintable:           true,
+		GlobalTransferCap:  sdkmath.ZeroInt(),
+		AccountTransferCap: sdkmath.ZeroInt(),
+	}
+
+	// invalid authority
+	_, err := s.msgServer.RegisterToken(s.ctx, &types.MsgRegisterToken{Authority: sdk.AccAddress("other").String()})
+	s.Require().Error(err)
+
+	// unsupported chain
+	invalid := *msg
+	invalid.ChainId = 1
+	_, err = s.msgServer.RegisterToken(s.ctx, &invalid)
+	s.Require().ErrorIs(err, types.ErrInvalidToken)
+
+	// native token
+	invalid = *msg
+	invalid.Denom = "BNB"
+	_, err = s.msgServer.RegisterToken(s.ctx, &invalid)
+	s.Require().ErrorIs(err, types.ErrInvalidToken)
+
+	_, err = s.msgServer.RegisterToken(s.ctx, msg)
+	s.Require().NoError(err)
+
+	token, found := s.bridgeKeeper.GetTokenByContract(s.ctx, testBscChainId, testTokenContract)
+	s.Require().True(found)
+	s.Require().Equal(msg.GetTokenInfo(), token)
+
+	// the contract is registered by another denom
+	invalid = *msg
+	invalid.Denom = "usdt2"
+	_, err = s.msgServer.RegisterToken(s.ctx, &invalid)
+	s.Require().ErrorIs(err, types.ErrTokenAlreadyRegistered)
+
+	// update the token to the op chain
+	updated := *msg
+	updated.ChainId = uint32(testOpChainId)
+	_, err = s.msgServer.RegisterToken(s.ctx, &updated)
+	s.Require().NoError(err)
+	_, found = s.bridgeKeeper.GetTokenByContract(s.ctx, testBscChainId, testTokenContract)
+	s.Require().False(found)
+	_, found = s.bridgeKeeper.GetTokenByContract(s.ctx, testOpChainId, testTokenContract)
+	s.Require().True(found)
+
+	res, err := s.queryClient.Tokens(s.ctx, &types.QueryTokensRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.TokenInfo{updated.GetTokenInfo()}, res.Tokens)
+
+	_, err = s.msgServer.DeregisterToken(s.ctx, &types.MsgDeregisterToken{Authority: authority, Denom: "usdt"})
+	s.Require().NoError(err)
+	_, err = s.queryClient.Token(s.ctx, &types.QueryTokenRequest{Denom: "usdt"})
+	s.Require().ErrorIs(err, types.ErrTokenNotRegistered)
+	_, found = s.bridgeKeeper.GetTokenByContract(s.ctx, testOpChainId, testTokenContract)
+	s.Require().False(found)
+
+	_, err = s.msgServer.DeregisterToken(s.ctx, &types.MsgDeregisterToken{Authority: authority, Denom: "usdt"})
+	s.Require().ErrorIs(err, types.ErrTokenNotRegistered)
+
+	// the contract of the deregistered token can not be taken by another denom
+	invalid = updated
+	invalid.Denom = "usdt2"
+	_, err = s.msgServer.RegisterToken(s.ctx, &invalid)
+	s.Require().ErrorIs(err, types.ErrTokenAlreadyRegistered)
+	invalid.ChainId = uint32(testBscChainId)
+	_, err = s.msgServer.RegisterToken(s.ctx, &invalid)
+	s.Require().ErrorIs(err, types.ErrTokenAlreadyRegistered)
+
+	// the deregistered token can be registered again
+	_, err = s.msgServer.RegisterToken(s.ctx, &updated)
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestTokenTransferOut() {
+	s.mockTokenChains()
+	token := s.registerTestToken(true)
+
+	addr1, _, err := testutil.GenerateCoinKey(hd.Secp256k1, s.cdc)
+	s.Require().NoError(err)
+	addr2, _, err := testutil.GenerateCoinKey(hd.Secp256k1, s.cdc)
+	s.Require().NoError(err)
+
+	// the amount exceeds the precision of the contract
+	msg := types.NewMsgTransferOut(addr1.String(), addr2.String(), &sdk.Coin{Denom: token.Denom, Amount: sdkmath.NewInt(1)})
+	_, err = s.msgServer.TransferOut(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrInvalidAmount)
+
+	amount := sdk.NewCoin(token.Denom, sdkmath.NewInt(3e12))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), addr1, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, sdk.Coins{amount}).Return(nil).Times(1)
+	s.crossChainKeeper.EXPECT().CreateRawIBCPackageWithFee(gomock.Any(), testBscChainId, types.TokenTransferOutChannelID, sdk.SynCrossChainPackageType,
+		gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx sdk.Context, chainId sdk.ChainID, channelId sdk.ChannelID, packageType sdk.CrossChainPackageType,
+			payload []byte, relayerFee, ackRelayerFee *big.Int,
+		) (uint64, error) {
+			pkg, err := types.DeserializeTokenTransferOutSynPackage(payload)
+			s.Require().NoError(err)
+			s.Require().Equal(testTokenContract, pkg.Contract)
+			s.Require().Equal(big.NewInt(3), pkg.Amount)
+			return 1, nil
+		}).Times(1)
+
+	msg = types.NewMsgTransferOut(addr1.String(), addr2.String(), &amount)
+	_, err = s.msgServer.TransferOut(s.ctx, msg)
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestTokenTransferOutRefund() {
+	s.mockTokenChains()
+	token := s.registerTestToken(false)
+	refundAddress := sdk.AccAddress(common.BytesToAddress([]byte("refundAddress")).Bytes())
+	amount := sdk.Coins{sdk.NewCoin(token.Denom, sdkmath.NewInt(2e12))}
+
+	ackPackage, err := (&types.TokenTransferOutRefundPackage{
+		Contract:      testTokenContract,
+		RefundAmount:  big.NewInt(2),
+		RefundAddress: refundAddress,
+		RefundReason:  uint32(types.REFUND_REASON_INSUFFICIENT_BALANCE),
+	}).Serialize()
+	s.Require().NoError(err)
+
+	app := keeper.NewTokenTransferOutApp(*s.bridgeKeeper)
+	appCtx := &sdk.CrossChainAppContext{Sequence: 1, SrcChainId: testBscChainId}
+
+	// the token is released from the escrow
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, refundAddress, amount).Return(fmt.Errorf("test send coins error")).Times(1)
+	result := app.ExecuteAckPackage(s.ctx, appCtx, ackPackage)
+	s.Require().Contains(result.Err.Error(), "test send coins error")
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, refundAddress, amount).Return(nil).Times(1)
+	result = app.ExecuteAckPackage(s.ctx, appCtx, ackPackage)
+	s.Require().NoError(result.Err)
+
+	// the token is minted back once it becomes mintable
+	token = s.registerTestToken(true)
+	synPackage, err := (&types.TokenTransferOutSynPackage{
+		Contract:      testTokenContract,
+		Amount:        big.NewInt(2),
+		Recipient:     sdk.AccAddress("recipient"),
+		RefundAddress: refundAddress,
+	}).Serialize()
+	s.Require().NoError(err)
+
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, amount).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, refundAddress, amount).Return(nil).Times(1)
+	result = app.ExecuteFailAckPackage(s.ctx, appCtx, synPackage)
+	s.Require().NoError(result.Err)
+
+	// the token of another chain is not registered
+	result = app.ExecuteFailAckPackage(s.ctx, &sdk.CrossChainAppContext{Sequence: 1, SrcChainId: testOpChainId}, synPackage)
+	s.Require().ErrorIs(result.Err, types.ErrTokenNotRegistered)
+
+	// the transfer in flight is still refunded after the token is deregistered
+	s.Require().NoError(s.bridgeKeeper.DeleteToken(s.ctx, token.Denom))
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, amount).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, refundAddress, amount).Return(nil).Times(1)
+	result = app.ExecuteFailAckPackage(s.ctx, appCtx, synPackage)
+	s.Require().NoError(result.Err)
+}
+
+func (s *TestSuite) TestTokenTransferIn() {
+	s.mockTokenChains()
+	token := s.registerTestToken(true)
+	receiver := sdk.AccAddress(common.BytesToAddress([]byte("receiverAddress")).Bytes())
+	amount := sdk.Coins{sdk.NewCoin(token.Denom, sdkmath.NewInt(5e12))}
+
+	transferInPackage := types.TokenTransferInSynPackage{
+		Contract:        testTokenContract,
+		Amount:          big.NewInt(5),
+		ReceiverAddress: receiver,
+		RefundAddress:   sdk.AccAddress(common.BytesToAddress([]byte("refundAddress")).Bytes()),
+	}
+	packageBytes, err := transferInPackage.Serialize()
+	s.Require().NoError(err)
+
+	app := keeper.NewTokenTransferInApp(*s.bridgeKeeper)
+
+	// wrong payload
+	s.Require().Panics(func() { app.ExecuteSynPackage(s.ctx, &sdk.CrossChainAppContext{Sequence: 1}, []byte{1}) })
+
+	// unregistered token is refunded
+	result := app.ExecuteSynPackage(s.ctx, &sdk.CrossChainAppContext{Sequence: 1, SrcChainId: testOpChainId}, packageBytes)
+	s.Require().ErrorIs(result.Err, types.ErrTokenNotRegistered)
+	unpacked, err := types.TokenTransferInRefundPackageArgs.Unpack(result.Payload)
+	s.Require().NoError(err)
+	refund, ok := unpacked[0].(struct {
+		Contract      common.Address `json:"Contract"`
+		RefundAmount  *big.Int       `json:"RefundAmount"`
+		RefundAddress common.Address `json:"RefundAddress"`
+		RefundReason  uint32         `json:"RefundReason"`
+	})
+	s.Require().True(ok)
+	s.Require().Equal(testTokenContract, refund.Contract)
+	s.Require().Equal(transferInPackage.Amount, refund.RefundAmount)
+	s.Require().Equal(uint32(types.REFUND_REASON_UNREGISTERED_TOKEN), refund.RefundReason)
+
+	// the token is minted
+	appCtx := &sdk.CrossChainAppContext{Sequence: 1, SrcChainId: testBscChainId}
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, amount).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, receiver, amount).Return(nil).Times(1)
+	result = app.ExecuteSynPackage(s.ctx, appCtx, packageBytes)
+	s.Require().NoError(result.Err)
+
+	// the escrow is insufficient
+	s.registerTestToken(false)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, receiver, amount).Return(fmt.Errorf("insufficient funds")).Times(1)
+	result = app.ExecuteSynPackage(s.ctx, appCtx, packageBytes)
+	s.Require().ErrorIs(result.Err, types.ErrInvalidPackage)
+	s.Require().NotEmpty(result.Payload)
+}
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	crosschaintypes "github.com/cosmos/cosmos-sdk/x/crosschain/types"
+
+	"github.com/bnb-chain/greenfield/x/bridge/types"
+)
+
+// sendTransferOutPackage sends the package of a transfer out of the native token, whose amount and relayer fees
+// are already paid to the crosschain module.
+func (k Keeper) sendTransferOutPackage(ctx sdk.Context, destChainId sdk.ChainID, from, to sdk.AccAddress, amount sdkmath.Int,
+	relayerFeeAmount, ackRelayerFeeAmount sdkmath.Int,
+) (uint64, error) {
+	transferPackage := types.TransferOutSynPackage{
+		RefundAddress: from,
+		Recipient:     to,
+		Amount:        amount.BigInt(),
+	}
+
+	encodedPackage, err := transferPackage.Serialize()
+	if err != nil {
+		return 0, errors.Wrapf(types.ErrInvalidPackage, "encode transfer out package error")
+	}
+
+	sendSeq, err := k.crossChainKeeper.CreateRawIBCPackageWithFee(ctx, destChainId, types.TransferOutChannelID, sdk.SynCrossChainPackageType,
+		encodedPackage, relayerFeeAmount.BigInt(), ackRelayerFeeAmount.BigInt())
+	if err != nil {
+		return 0, err
+	}
+
+	// emit event
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	transferOutEvent := types.EventCrossTransferOut{
+		From:        from.String(),
+		To:          to.String(),
+		Amount:      &sdk.Coin{Denom: bondDenom, Amount: amount},
+		RelayerFee:  &sdk.Coin{Denom: bondDenom, Amount: relayerFeeAmount.Add(ackRelayerFeeAmount)},
+		Sequence:    sendSeq,
+		DestChainId: uint32(destChainId),
+	}
+	err = ctx.EventManager().EmitTypedEvent(&transferOutEvent)
+	if err != nil {
+		return 0, err
+	}
+
+	return sendSeq, nil
+}
+
+// transferIn gives the native token transferred in to the receiver from the crosschain module
+func (k Keeper) transferIn(ctx sdk.Context, receiver, refundAddress sdk.AccAddress, amount sdkmath.Int, sequence uint64, srcChainId uint32) error {
+	coin := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), amount)
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, crosschaintypes.ModuleName, receiver, sdk.Coins{coin})
+	if err != nil {
+		return err
+	}
+
+	// emit event
+	return ctx.EventManager().EmitTypedEvent(&types.EventCrossTransferIn{
+		Amount:          &coin,
+		ReceiverAddress: receiver.String(),
+		RefundAddress:   refundAddress.String(),
+		Sequence:        sequence,
+		SrcChainId:      srcChainId,
+	})
+}
+
+// sendTokenTransferOutPackage sends the package of a transfer out of a registered token, whose amount is already
+// locked and whose relayer fees are already paid to the crosschain module.
+func (k Keeper) sendTokenTransferOutPackage(ctx sdk.Context, token types.TokenInfo, from, to sdk.AccAddress, amount sdkmath.Int,
+	relayerFeeAmount, ackRelayerFeeAmount sdkmath.Int,
+) (uint64, error) {
+	contractAmount, err := token.ToContractAmount(amount)
+	if err != nil {
+		return 0, err
+	}
+
+	transferPackage := types.TokenTransferOutSynPackage{
+		Contract:      token.GetContract(),
+		RefundAddress: from,
+		Recipient:     to,
+		Amount:        contractAmount,
+	}
+
+	encodedPackage, err := transferPackage.Serialize()
+	if err != nil {
+		return 0, errors.Wrapf(types.ErrInvalidPackage, "encode token transfer out package error")
+	}
+
+	destChainId := sdk.ChainID(token.ChainId)
+	sendSeq, err := k.crossChainKeeper.CreateRawIBCPackageWithFee(ctx, destChainId, types.TokenTransferOutChannelID, sdk.SynCrossChainPackageType,
+		encodedPackage, relayerFeeAmount.BigInt(), ackRelayerFeeAmount.BigInt())
+	if err != nil {
+		return 0, err
+	}
+
+	// emit event
+	err = ctx.EventManager().EmitTypedEvent(&types.EventCrossTransferOut{
+		From:        from.String(),
+		To:          to.String(),
+		Amount:      &sdk.Coin{Denom: token.Denom, Amount: amount},
+		RelayerFee:  &sdk.Coin{Denom: k.stakingKeeper.BondDenom(ctx), Amount: relayerFeeAmount.Add(ackRelayerFeeAmount)},
+		Sequence:    sendSeq,
+		DestChainId: uint32(destChainId),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return sendSeq, nil
+}
+
+// transferInToken gives the registered token transferred in to the receiver
+func (k Keeper) transferInToken(ctx sdk.Context, token types.TokenInfo, receiver, refundAddress sdk.AccAddress, amount sdkmath.Int,
+	sequence uint64, srcChainId uint32,
+) error {
+	coin := sdk.NewCoin(token.Denom, amount)
+	if err := k.releaseToken(ctx, token, receiver, coin); err != nil {
+		return err
+	}
+
+	// emit event
+	return ctx.EventManager().EmitTypedEvent(&types.EventCrossTransferIn{
+		Amount:          &coin,
+		ReceiverAddress: receiver.String(),
+		RefundAddress:   refundAddress.String(),
+		Sequence:        sequence,
+		SrcChainId:      srcChainId,
+	})
+}
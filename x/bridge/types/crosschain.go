@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type DeserializeFunc func(serializedPackage []byte) (interface{}, error)
+
+// DeserializeFuncMap holds the deserialize functions of the packages received by the bridge channels,
+// indexed by the package type, i.e. syn, ack and fail ack.
+var DeserializeFuncMap = map[sdk.ChannelID][3]DeserializeFunc{
+	TransferOutChannelID: {
+		func(bz []byte) (interface{}, error) { return DeserializeTransferOutSynPackage(bz) },
+		func(bz []byte) (interface{}, error) { return DeserializeTransferOutRefundPackage(bz) },
+		func(bz []byte) (interface{}, error) { return DeserializeTransferOutSynPackage(bz) },
+	},
+	TransferInChannelID: {
+		func(bz []byte) (interface{}, error) { return DeserializeTransferInSynPackage(bz) },
+		func(bz []byte) (interface{}, error) { return DeserializeTransferInRefundPackage(bz) },
+		func(bz []byte) (interface{}, error) { return DeserializeTransferInSynPackage(bz) },
+	},
+	TokenTransferOutChannelID: {
+		func(bz []byte) (interface{}, error) { return DeserializeTokenTransferOutSynPackage(bz) },
+		func(bz []byte) (interface{}, error) { return DeserializeTokenTransferOutRefundPackage(bz) },
+		func(bz []byte) (interface{}, error) { return DeserializeTokenTransferOutSynPackage(bz) },
+	},
+	TokenTransferInChannelID: {
+		func(bz []byte) (interface{}, error) { return DeserializeTokenTransferInSynPackage(bz) },
+		func(bz []byte) (interface{}, error) { return DeserializeTokenTransferInRefundPackage(bz) },
+		func(bz []byte) (interface{}, error) { return DeserializeTokenTransferInSynPackage(bz) },
+	},
+}
+
+// ChannelNames holds the names of the bridge channels, each of them carries a single operation.
+var ChannelNames = map[sdk.ChannelID]string{
+	TransferOutChannelID:      TransferOutChannel,
+	TransferInChannelID:       TransferInChannel,
+	TokenTransferOutChannelID: TokenTransferOutChannel,
+	TokenTransferInChannelID:  TokenTransferInChannel,
+}
+
+func DeserializeCrossChainPackage(rawPack []byte, channelId sdk.ChannelID, packageType sdk.CrossChainPackageType) (interface{}, error) {
+	if packageType >= 3 {
+		return nil, ErrInvalidPackage
+	}
+
+	deserializeFuncs, ok := DeserializeFuncMap[channelId]
+	if !ok {
+		return nil, ErrInvalidPackage
+	}
+
+	return deserializeFuncs[packageType](rawPack)
+}
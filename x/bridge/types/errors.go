@@ -13,4 +13,11 @@ var (
 	ErrInvalidAmount     = errors.Register(ModuleName, 5, "amount is invalid")
 	ErrInvalidLength     = errors.Register(ModuleName, 6, "length is invalid")
 	ErrPackageExpired    = errors.Register(ModuleName, 7, "package is expired")
+
+	ErrTokenNotRegistered      = errors.Register(ModuleName, 8, "token is not registered")
+	ErrTokenAlreadyRegistered  = errors.Register(ModuleName, 9, "token contract is already registered")
+	ErrInvalidToken            = errors.Register(ModuleName, 10, "token is invalid")
+	ErrTransferPaused          = errors.Register(ModuleName, 11, "cross chain transfer is paused")
+	ErrUnsupportedChain        = errors.Register(ModuleName, 12, "destination chain is not supported")
+	ErrNoSuchCrossChainPackage = errors.Register(ModuleName, 13, "no such cross chain package")
 )
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,18 +32,24 @@ const (
 	REFUND_REASON_UNKNOWN              RefundReason = 0
 	REFUND_REASON_INSUFFICIENT_BALANCE RefundReason = 1
 	REFUND_REASON_FAIL_ACK             RefundReason = 2
+	REFUND_REASON_UNREGISTERED_TOKEN   RefundReason = 3
+	REFUND_REASON_TRANSFER_PAUSED      RefundReason = 4
 )
 
 var RefundReason_name = map[int32]string{
 	0: "REFUND_REASON_UNKNOWN",
 	1: "REFUND_REASON_INSUFFICIENT_BALANCE",
 	2: "REFUND_REASON_FAIL_ACK",
+	3: "REFUND_REASON_UNREGISTERED_TOKEN",
+	4: "REFUND_REASON_TRANSFER_PAUSED",
 }
 
 var RefundReason_value = map[string]int32{
 	"REFUND_REASON_UNKNOWN":              0,
 	"REFUND_REASON_INSUFFICIENT_BALANCE": 1,
 	"REFUND_REASON_FAIL_ACK":             2,
+	"REFUND_REASON_UNREGISTERED_TOKEN":   3,
+	"REFUND_REASON_TRANSFER_PAUSED":      4,
 }
 
 func (x RefundReason) String() string {
@@ -307,51 +315,392 @@ func (m *EventCrossTransferIn) GetSrcChainId() uint32 {
 	return 0
 }
 
+// EventRegisterToken is emitted when a token is registered to the bridge, or a registered token is updated
+type EventRegisterToken struct {
+	// Denom of the token on greenfield
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Id of the destination chain where the token contract is deployed
+	ChainId uint32 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Address of the token contract
+	ContractAddress string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// Decimals of the token contract
+	Decimals uint32 `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// Whether the token is minted and burned on greenfield
+	Mintable bool `protobuf:"varint,5,opt,name=mintable,proto3" json:"mintable,omitempty"`
+	// Max amount of the token transferred in a window of the transfer rate limits
+	GlobalTransferCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=global_transfer_cap,json=globalTransferCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"global_transfer_cap"`
+	// Max amount of the token transferred by an account in a window of the transfer rate limits
+	AccountTransferCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=account_transfer_cap,json=accountTransferCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"account_transfer_cap"`
+}
+
+func (m *EventRegisterToken) Reset()         { *m = EventRegisterToken{} }
+func (m *EventRegisterToken) String() string { return proto.CompactTextString(m) }
+func (*EventRegisterToken) ProtoMessage()    {}
+func (*EventRegisterToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d0c64a57c5987e0, []int{3}
+}
+func (m *EventRegisterToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRegisterToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRegisterToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRegisterToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRegisterToken.Merge(m, src)
+}
+func (m *EventRegisterToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRegisterToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRegisterToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRegisterToken proto.InternalMessageInfo
+
+func (m *EventRegisterToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventRegisterToken) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *EventRegisterToken) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *EventRegisterToken) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *EventRegisterToken) GetMintable() bool {
+	if m != nil {
+		return m.Mintable
+	}
+	return false
+}
+
+// EventDeregisterToken is emitted when a token is removed from the bridge
+type EventDeregisterToken struct {
+	// Denom of the token on greenfield
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *EventDeregisterToken) Reset()         { *m = EventDeregisterToken{} }
+func (m *EventDeregisterToken) String() string { return proto.CompactTextString(m) }
+func (*EventDeregisterToken) ProtoMessage()    {}
+func (*EventDeregisterToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d0c64a57c5987e0, []int{4}
+}
+func (m *EventDeregisterToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDeregisterToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDeregisterToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDeregisterToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDeregisterToken.Merge(m, src)
+}
+func (m *EventDeregisterToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDeregisterToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDeregisterToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDeregisterToken proto.InternalMessageInfo
+
+func (m *EventDeregisterToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// EventCrossTransferQueued is emitted when a cross chain transfer is queued by the transfer rate limits
+type EventCrossTransferQueued struct {
+	// Id of the queued transfer
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Direction of the queued transfer
+	Direction TransferDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=greenfield.bridge.TransferDirection" json:"direction,omitempty"`
+	// Refund address of the queued transfer
+	RefundAddress string `protobuf:"bytes,3,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+	// Recipient of the queued transfer
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// Amount of the queued transfer
+	Amount *types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Destination chain id of a transfer out, or source chain id of a transfer in
+	ChainId uint32 `protobuf:"varint,6,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *EventCrossTransferQueued) Reset()         { *m = EventCrossTransferQueued{} }
+func (m *EventCrossTransferQueued) String() string { return proto.CompactTextString(m) }
+func (*EventCrossTransferQueued) ProtoMessage()    {}
+func (*EventCrossTransferQueued) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d0c64a57c5987e0, []int{5}
+}
+func (m *EventCrossTransferQueued) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCrossTransferQueued) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCrossTransferQueued.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCrossTransferQueued) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCrossTransferQueued.Merge(m, src)
+}
+func (m *EventCrossTransferQueued) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCrossTransferQueued) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCrossTransferQueued.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCrossTransferQueued proto.InternalMessageInfo
+
+func (m *EventCrossTransferQueued) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventCrossTransferQueued) GetDirection() TransferDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return TRANSFER_DIRECTION_OUT
+}
+
+func (m *EventCrossTransferQueued) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+func (m *EventCrossTransferQueued) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventCrossTransferQueued) GetAmount() *types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *EventCrossTransferQueued) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+// EventCrossTransferQueuedExpired is emitted when a queued transfer expires and is refunded, a transfer out is refunded
+// to the sender on greenfield, and a transfer in is sent back to the refund address on the source chain, or given to it
+// on greenfield if it can not be sent back
+type EventCrossTransferQueuedExpired struct {
+	// Id of the queued transfer
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Direction of the queued transfer
+	Direction TransferDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=greenfield.bridge.TransferDirection" json:"direction,omitempty"`
+	// Refund address of the queued transfer
+	RefundAddress string `protobuf:"bytes,3,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+	// Amount of the queued transfer
+	Amount *types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Destination chain id of a transfer out, or source chain id of a transfer in
+	ChainId uint32 `protobuf:"varint,5,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Sequence of the package sending back a transfer in, 0 if it is given to the refund address on greenfield
+	Sequence uint64 `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *EventCrossTransferQueuedExpired) Reset()         { *m = EventCrossTransferQueuedExpired{} }
+func (m *EventCrossTransferQueuedExpired) String() string { return proto.CompactTextString(m) }
+func (*EventCrossTransferQueuedExpired) ProtoMessage()    {}
+func (*EventCrossTransferQueuedExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d0c64a57c5987e0, []int{6}
+}
+func (m *EventCrossTransferQueuedExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCrossTransferQueuedExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCrossTransferQueuedExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCrossTransferQueuedExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCrossTransferQueuedExpired.Merge(m, src)
+}
+func (m *EventCrossTransferQueuedExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCrossTransferQueuedExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCrossTransferQueuedExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCrossTransferQueuedExpired proto.InternalMessageInfo
+
+func (m *EventCrossTransferQueuedExpired) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventCrossTransferQueuedExpired) GetDirection() TransferDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return TRANSFER_DIRECTION_OUT
+}
+
+func (m *EventCrossTransferQueuedExpired) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+func (m *EventCrossTransferQueuedExpired) GetAmount() *types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *EventCrossTransferQueuedExpired) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *EventCrossTransferQueuedExpired) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("greenfield.bridge.RefundReason", RefundReason_name, RefundReason_value)
 	proto.RegisterType((*EventCrossTransferOut)(nil), "greenfield.bridge.EventCrossTransferOut")
 	proto.RegisterType((*EventCrossTransferOutRefund)(nil), "greenfield.bridge.EventCrossTransferOutRefund")
 	proto.RegisterType((*EventCrossTransferIn)(nil), "greenfield.bridge.EventCrossTransferIn")
+	proto.RegisterType((*EventRegisterToken)(nil), "greenfield.bridge.EventRegisterToken")
+	proto.RegisterType((*EventDeregisterToken)(nil), "greenfield.bridge.EventDeregisterToken")
+	proto.RegisterType((*EventCrossTransferQueued)(nil), "greenfield.bridge.EventCrossTransferQueued")
+	proto.RegisterType((*EventCrossTransferQueuedExpired)(nil), "greenfield.bridge.EventCrossTransferQueuedExpired")
 }
 
 func init() { proto.RegisterFile("greenfield/bridge/event.proto", fileDescriptor_7d0c64a57c5987e0) }
 
 var fileDescriptor_7d0c64a57c5987e0 = []byte{
-	// 529 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xb3, 0x69, 0x1a, 0xc1, 0xe4, 0x0f, 0x61, 0xd5, 0x22, 0x37, 0x08, 0x13, 0x45, 0x02,
-	0x05, 0x24, 0x6c, 0xa5, 0xdc, 0xb8, 0xb9, 0x6e, 0x22, 0xac, 0x56, 0x8e, 0xe4, 0x36, 0x42, 0xe2,
-	0x62, 0xf9, 0xcf, 0x24, 0xb5, 0xd4, 0xec, 0x96, 0x5d, 0x27, 0xa2, 0x6f, 0xc0, 0x91, 0x77, 0xe0,
-	0x65, 0x38, 0xf6, 0x08, 0x37, 0x94, 0xdc, 0x78, 0x07, 0x24, 0x14, 0xdb, 0x29, 0x0e, 0xa9, 0xda,
-	0xde, 0x66, 0xbf, 0x99, 0x5d, 0x7d, 0xbf, 0xcf, 0x1e, 0x78, 0x36, 0x16, 0x88, 0x6c, 0x14, 0xe1,
-	0x79, 0xa8, 0xfb, 0x22, 0x0a, 0xc7, 0xa8, 0xe3, 0x0c, 0x59, 0xac, 0x5d, 0x08, 0x1e, 0x73, 0xfa,
-	0xf8, 0x5f, 0x5b, 0x4b, 0xdb, 0x4d, 0x35, 0xe0, 0x72, 0xc2, 0xa5, 0xee, 0x7b, 0x12, 0xf5, 0x59,
-	0xd7, 0xc7, 0xd8, 0xeb, 0xea, 0x01, 0x8f, 0x58, 0x7a, 0xa5, 0xb9, 0x33, 0xe6, 0x63, 0x9e, 0x94,
-	0xfa, 0xb2, 0x4a, 0xd5, 0xf6, 0x6f, 0x02, 0xbb, 0xbd, 0xe5, 0xc3, 0xa6, 0xe0, 0x52, 0x9e, 0x0a,
-	0x8f, 0xc9, 0x11, 0x8a, 0xc1, 0x34, 0xa6, 0x14, 0x4a, 0x23, 0xc1, 0x27, 0x0a, 0x69, 0x91, 0xce,
-	0x43, 0x27, 0xa9, 0x69, 0x1d, 0x8a, 0x31, 0x57, 0x8a, 0x89, 0x52, 0x8c, 0x39, 0xed, 0x42, 0xd9,
-	0x9b, 0xf0, 0x29, 0x8b, 0x95, 0xad, 0x16, 0xe9, 0x54, 0xf6, 0xf7, 0xb4, 0xd4, 0x84, 0xb6, 0x34,
-	0xa1, 0x65, 0x26, 0x34, 0x93, 0x47, 0xcc, 0xc9, 0x06, 0xe9, 0x3b, 0xa8, 0x08, 0x3c, 0xf7, 0x2e,
-	0x51, 0xb8, 0x23, 0x44, 0xa5, 0x74, 0xd7, 0x3d, 0xc8, 0xa6, 0xfb, 0x88, 0xb4, 0x09, 0x0f, 0x24,
-	0x7e, 0x9a, 0x22, 0x0b, 0x50, 0xd9, 0x6e, 0x91, 0x4e, 0xc9, 0xb9, 0x3e, 0xd3, 0x36, 0xd4, 0x42,
-	0x94, 0xb1, 0x1b, 0x9c, 0x79, 0x11, 0x73, 0xa3, 0x50, 0x29, 0xb7, 0x48, 0xa7, 0xe6, 0x54, 0x96,
-	0xa2, 0xb9, 0xd4, 0xac, 0xb0, 0xfd, 0x87, 0xc0, 0xd3, 0x1b, 0x61, 0x1d, 0x1c, 0x4d, 0x59, 0x48,
-	0x5f, 0x40, 0x5d, 0x24, 0x95, 0xeb, 0x85, 0xa1, 0x40, 0x29, 0x33, 0xf8, 0x5a, 0xaa, 0x1a, 0xa9,
-	0x98, 0xa3, 0x2e, 0xde, 0x97, 0xfa, 0x10, 0xb2, 0x37, 0x5c, 0x81, 0x9e, 0xe4, 0x2c, 0xc9, 0xab,
-	0xbe, 0xff, 0x5c, 0xdb, 0xf8, 0x8e, 0x5a, 0xea, 0xc5, 0x49, 0xc6, 0x9c, 0xaa, 0xc8, 0x9d, 0xd6,
-	0xf8, 0x4b, 0x77, 0xf1, 0x6f, 0x6f, 0xf2, 0xff, 0x24, 0xb0, 0xb3, 0xc9, 0x6f, 0xb1, 0x1c, 0x11,
-	0xb9, 0x2f, 0xd1, 0x2b, 0x68, 0x08, 0x0c, 0x30, 0x9a, 0xa1, 0xb8, 0x4e, 0x2b, 0xfd, 0x31, 0x1e,
-	0xad, 0xf4, 0x55, 0x5e, 0x9b, 0xb1, 0x6e, 0xdd, 0x14, 0xeb, 0x6d, 0x74, 0x2d, 0xa8, 0x4a, 0x11,
-	0xfc, 0x0f, 0x07, 0x52, 0x04, 0x19, 0xdb, 0x6b, 0x09, 0xd5, 0x7c, 0x72, 0x74, 0x0f, 0x76, 0x9d,
-	0x5e, 0x7f, 0x68, 0x1f, 0xba, 0x4e, 0xcf, 0x38, 0x19, 0xd8, 0xee, 0xd0, 0x3e, 0xb2, 0x07, 0x1f,
-	0xec, 0x46, 0x81, 0xbe, 0x84, 0xf6, 0x7a, 0xcb, 0xb2, 0x4f, 0x86, 0xfd, 0xbe, 0x65, 0x5a, 0x3d,
-	0xfb, 0xd4, 0x3d, 0x30, 0x8e, 0x0d, 0xdb, 0xec, 0x35, 0x08, 0x6d, 0xc2, 0x93, 0xf5, 0xb9, 0xbe,
-	0x61, 0x1d, 0xbb, 0x86, 0x79, 0xd4, 0x28, 0x36, 0x4b, 0x5f, 0xbe, 0xa9, 0x85, 0x83, 0xf7, 0xdf,
-	0xe7, 0x2a, 0xb9, 0x9a, 0xab, 0xe4, 0xd7, 0x5c, 0x25, 0x5f, 0x17, 0x6a, 0xe1, 0x6a, 0xa1, 0x16,
-	0x7e, 0x2c, 0xd4, 0xc2, 0x47, 0x6d, 0x1c, 0xc5, 0x67, 0x53, 0x5f, 0x0b, 0xf8, 0x44, 0xf7, 0x99,
-	0xff, 0x26, 0x71, 0xae, 0xe7, 0x96, 0xfa, 0xf3, 0x6a, 0xad, 0xe3, 0xcb, 0x0b, 0x94, 0x7e, 0x39,
-	0x59, 0xc7, 0xb7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x74, 0x71, 0x97, 0xf6, 0xf8, 0x03, 0x00,
-	0x00,
+	// 866 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0xf6, 0x4c, 0x6c, 0x6f, 0xdc, 0xf9, 0x59, 0x6f, 0x93, 0x45, 0x8e, 0x61, 0x1d, 0x63, 0x2d,
+	0xab, 0x80, 0xc8, 0x58, 0x09, 0x37, 0xc4, 0x65, 0x62, 0x8f, 0x61, 0x94, 0xd5, 0x04, 0xda, 0x8e,
+	0x90, 0xb8, 0x8c, 0x66, 0x7a, 0xca, 0xde, 0xd6, 0xda, 0xdd, 0xa6, 0xa7, 0x1d, 0xed, 0x1e, 0xb9,
+	0xac, 0x38, 0xf2, 0x0e, 0xbc, 0x02, 0x47, 0x1e, 0x60, 0x8f, 0x2b, 0x4e, 0xc0, 0x21, 0x42, 0xc9,
+	0x8d, 0x77, 0x40, 0x42, 0xf3, 0x63, 0x67, 0x26, 0x0e, 0xc4, 0x42, 0x48, 0x9c, 0x3c, 0xf5, 0x55,
+	0x75, 0x75, 0x7d, 0x55, 0x5f, 0xb9, 0xd1, 0xa3, 0x91, 0x04, 0xe0, 0x43, 0x06, 0xe3, 0xa0, 0xed,
+	0x4b, 0x16, 0x8c, 0xa0, 0x0d, 0xe7, 0xc0, 0x95, 0x31, 0x95, 0x42, 0x09, 0xfc, 0xe0, 0xda, 0x6d,
+	0x24, 0xee, 0x7a, 0x83, 0x8a, 0x70, 0x22, 0xc2, 0xb6, 0xef, 0x85, 0xd0, 0x3e, 0x3f, 0xf4, 0x41,
+	0x79, 0x87, 0x6d, 0x2a, 0x18, 0x4f, 0x8e, 0xd4, 0x77, 0x13, 0xbf, 0x1b, 0x5b, 0xed, 0xc4, 0x48,
+	0x5d, 0x3b, 0x23, 0x31, 0x12, 0x09, 0x1e, 0x7d, 0xa5, 0xe8, 0x2d, 0x25, 0xa8, 0x97, 0x53, 0x48,
+	0x0f, 0xb5, 0xfe, 0xd0, 0xd0, 0x43, 0x2b, 0x2a, 0xa9, 0x23, 0x45, 0x18, 0x0e, 0xa4, 0xc7, 0xc3,
+	0x21, 0xc8, 0xd3, 0x99, 0xc2, 0x18, 0x15, 0x87, 0x52, 0x4c, 0x6a, 0x5a, 0x53, 0xdb, 0xaf, 0x90,
+	0xf8, 0x1b, 0x6f, 0x23, 0x5d, 0x89, 0x9a, 0x1e, 0x23, 0xba, 0x12, 0xf8, 0x10, 0x95, 0xbd, 0x89,
+	0x98, 0x71, 0x55, 0x5b, 0x6b, 0x6a, 0xfb, 0x1b, 0x47, 0xbb, 0x46, 0x5a, 0x51, 0x54, 0xbe, 0x91,
+	0x96, 0x6f, 0x74, 0x04, 0xe3, 0x24, 0x0d, 0xc4, 0x9f, 0xa0, 0x0d, 0x09, 0x63, 0xef, 0x25, 0x48,
+	0x77, 0x08, 0x50, 0x2b, 0xde, 0x75, 0x0e, 0xa5, 0xd1, 0x3d, 0x00, 0x5c, 0x47, 0xeb, 0x21, 0x7c,
+	0x33, 0x03, 0x4e, 0xa1, 0x56, 0x6a, 0x6a, 0xfb, 0x45, 0xb2, 0xb0, 0x71, 0x0b, 0x6d, 0x05, 0x10,
+	0x2a, 0x97, 0x3e, 0xf3, 0x18, 0x77, 0x59, 0x50, 0x2b, 0x37, 0xb5, 0xfd, 0x2d, 0xb2, 0x11, 0x81,
+	0x9d, 0x08, 0xb3, 0x83, 0xd6, 0x9f, 0x1a, 0x7a, 0xe7, 0x56, 0xb2, 0x04, 0x86, 0x33, 0x1e, 0xe0,
+	0xf7, 0xd1, 0xb6, 0x8c, 0xbf, 0x5c, 0x2f, 0x08, 0x24, 0x84, 0x61, 0x4a, 0x7e, 0x2b, 0x41, 0xcd,
+	0x04, 0xcc, 0xb0, 0xd6, 0x57, 0x65, 0xdd, 0x45, 0x69, 0x0e, 0x57, 0x82, 0x17, 0x0a, 0x1e, 0xf7,
+	0x6b, 0xfb, 0x68, 0xcf, 0x58, 0x52, 0x80, 0x91, 0xd4, 0x42, 0xe2, 0x30, 0xb2, 0x29, 0x33, 0x56,
+	0x8e, 0x7f, 0xf1, 0x2e, 0xfe, 0xa5, 0x65, 0xfe, 0xbf, 0x6a, 0x68, 0x67, 0x99, 0xbf, 0xcd, 0x33,
+	0x8c, 0xb4, 0x55, 0x19, 0x7d, 0x80, 0xaa, 0x12, 0x28, 0xb0, 0x73, 0x90, 0x8b, 0x6e, 0x25, 0xc2,
+	0xb8, 0x3f, 0xc7, 0xe7, 0xfd, 0x5a, 0x6e, 0xeb, 0xda, 0x6d, 0x6d, 0xfd, 0x27, 0x76, 0x4d, 0xb4,
+	0x19, 0x4a, 0x7a, 0x93, 0x1c, 0x0a, 0x25, 0x9d, 0x73, 0x7b, 0xb5, 0x86, 0x70, 0xcc, 0x8d, 0xc0,
+	0x88, 0x85, 0x0a, 0xe4, 0x40, 0x3c, 0x07, 0x8e, 0x77, 0x50, 0x29, 0x00, 0xbe, 0x90, 0x71, 0x62,
+	0xe0, 0x5d, 0xb4, 0xbe, 0x48, 0xa5, 0xc7, 0xa9, 0xee, 0xd1, 0x24, 0x4f, 0xc4, 0x8b, 0x0a, 0xae,
+	0xa4, 0x47, 0xd5, 0x8d, 0x72, 0xef, 0xcf, 0xf1, 0x4c, 0xc1, 0x01, 0x50, 0x36, 0xf1, 0xc6, 0x61,
+	0x5c, 0xf0, 0x16, 0x59, 0xd8, 0x91, 0x6f, 0xc2, 0xb8, 0xf2, 0xfc, 0x71, 0x22, 0xd5, 0x75, 0xb2,
+	0xb0, 0xf1, 0x18, 0xbd, 0x35, 0x1a, 0x0b, 0xdf, 0x1b, 0xbb, 0x2a, 0x1d, 0x81, 0x4b, 0xbd, 0x69,
+	0x2c, 0xd8, 0xca, 0xf1, 0xa7, 0xaf, 0x2f, 0xf6, 0x0a, 0xbf, 0x5d, 0xec, 0x3d, 0x19, 0x31, 0xf5,
+	0x6c, 0xe6, 0x1b, 0x54, 0x4c, 0xd2, 0x35, 0x4f, 0x7f, 0x0e, 0xc2, 0xe0, 0x79, 0xba, 0xc2, 0x36,
+	0x57, 0x3f, 0xff, 0x78, 0x80, 0xd2, 0x59, 0xd9, 0x5c, 0x91, 0x07, 0x49, 0xe2, 0xf9, 0x68, 0x3b,
+	0xde, 0x14, 0x73, 0xb4, 0xe3, 0x51, 0x1a, 0xcd, 0x2c, 0x7f, 0xdd, 0xbd, 0xff, 0xe0, 0x3a, 0x9c,
+	0x66, 0xce, 0xdc, 0xd7, 0xfa, 0x28, 0xd5, 0x58, 0x17, 0xe4, 0xdd, 0x93, 0x68, 0x7d, 0xab, 0xa3,
+	0xda, 0xb2, 0x24, 0xbf, 0x9c, 0xc1, 0x0c, 0x82, 0xe8, 0xef, 0x86, 0x05, 0x71, 0x7c, 0x91, 0xe8,
+	0x2c, 0xc0, 0xc7, 0xa8, 0x12, 0x30, 0x09, 0x54, 0x31, 0xc1, 0xe3, 0xb9, 0x6d, 0x1f, 0x3d, 0xbe,
+	0x65, 0x83, 0xe6, 0x59, 0xba, 0xf3, 0x58, 0x72, 0x7d, 0x6c, 0x55, 0x31, 0xbe, 0x8b, 0x2a, 0x12,
+	0x28, 0x9b, 0x32, 0xe0, 0x2a, 0x1e, 0x6e, 0x85, 0x5c, 0x03, 0x99, 0x7d, 0x29, 0xad, 0xba, 0x2f,
+	0x59, 0xc9, 0x95, 0x73, 0x92, 0x6b, 0xbd, 0xd2, 0xd1, 0xde, 0xdf, 0xf5, 0xc0, 0x7a, 0x31, 0x65,
+	0xf2, 0xff, 0x6d, 0xc5, 0x35, 0xd9, 0xe2, 0xbf, 0x21, 0x5b, 0xca, 0xef, 0x57, 0x76, 0xcb, 0xcb,
+	0xf9, 0x2d, 0xff, 0xf0, 0x27, 0x0d, 0x6d, 0x66, 0xff, 0xfe, 0xf0, 0x2e, 0x7a, 0x48, 0xac, 0xde,
+	0x99, 0xd3, 0x75, 0x89, 0x65, 0xf6, 0x4f, 0x1d, 0xf7, 0xcc, 0x39, 0x71, 0x4e, 0xbf, 0x72, 0xaa,
+	0x05, 0xfc, 0x04, 0xb5, 0xf2, 0x2e, 0xdb, 0xe9, 0x9f, 0xf5, 0x7a, 0x76, 0xc7, 0xb6, 0x9c, 0x81,
+	0x7b, 0x6c, 0x3e, 0x35, 0x9d, 0x8e, 0x55, 0xd5, 0x70, 0x1d, 0xbd, 0x9d, 0x8f, 0xeb, 0x99, 0xf6,
+	0x53, 0xd7, 0xec, 0x9c, 0x54, 0x75, 0xfc, 0x18, 0x35, 0x6f, 0xa6, 0x27, 0xd6, 0x67, 0x76, 0x7f,
+	0x60, 0x11, 0xab, 0xeb, 0x0e, 0x4e, 0x4f, 0x2c, 0xa7, 0xba, 0x86, 0xdf, 0x43, 0x8f, 0xf2, 0x51,
+	0x03, 0x62, 0x3a, 0xfd, 0x9e, 0x45, 0xdc, 0x2f, 0xcc, 0xb3, 0xbe, 0xd5, 0xad, 0x16, 0xeb, 0xc5,
+	0xef, 0x7e, 0x68, 0x14, 0x8e, 0x3f, 0x7f, 0x7d, 0xd9, 0xd0, 0xde, 0x5c, 0x36, 0xb4, 0xdf, 0x2f,
+	0x1b, 0xda, 0xf7, 0x57, 0x8d, 0xc2, 0x9b, 0xab, 0x46, 0xe1, 0x97, 0xab, 0x46, 0xe1, 0x6b, 0x23,
+	0xb3, 0x5d, 0x3e, 0xf7, 0x0f, 0xe2, 0x66, 0xb4, 0x33, 0x2f, 0xf3, 0x8b, 0xdc, 0xdb, 0xec, 0x97,
+	0xe3, 0xc7, 0xf9, 0xe3, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x52, 0xe1, 0x9e, 0x4f, 0x40, 0x08,
+	0x00, 0x00,
 }
 
 func (m *EventCrossTransferOut) Marshal() (dAtA []byte, err error) {
@@ -541,58 +890,291 @@ func (m *EventCrossTransferIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventRegisterToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventCrossTransferOut) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventRegisterToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRegisterToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	{
+		size := m.AccountTransferCap.Size()
+		i -= size
+		if _, err := m.AccountTransferCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.GlobalTransferCap.Size()
+		i -= size
+		if _, err := m.GlobalTransferCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	if m.Mintable {
+		i--
+		if m.Mintable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Amount != nil {
-		l = m.Amount.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if m.Decimals != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.RelayerFee != nil {
-		l = m.RelayerFee.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovEvent(uint64(m.Sequence))
+	if m.ChainId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.DestChainId != 0 {
-		n += 1 + sovEvent(uint64(m.DestChainId))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCrossTransferOutRefund) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventDeregisterToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDeregisterToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeregisterToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RefundAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCrossTransferQueued) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCrossTransferQueued) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCrossTransferQueued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Direction != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCrossTransferQueuedExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCrossTransferQueuedExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCrossTransferQueuedExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Direction != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCrossTransferOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.RelayerFee != nil {
+		l = m.RelayerFee.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	if m.DestChainId != 0 {
+		n += 1 + sovEvent(uint64(m.DestChainId))
+	}
+	return n
+}
+
+func (m *EventCrossTransferOutRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
 	if m.Amount != nil {
 		l = m.Amount.Size()
 		n += 1 + l + sovEvent(uint64(l))
@@ -636,6 +1218,108 @@ func (m *EventCrossTransferIn) Size() (n int) {
 	return n
 }
 
+func (m *EventRegisterToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovEvent(uint64(m.ChainId))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovEvent(uint64(m.Decimals))
+	}
+	if m.Mintable {
+		n += 2
+	}
+	l = m.GlobalTransferCap.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.AccountTransferCap.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventDeregisterToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCrossTransferQueued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvent(uint64(m.Id))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovEvent(uint64(m.Direction))
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovEvent(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *EventCrossTransferQueuedExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvent(uint64(m.Id))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovEvent(uint64(m.Direction))
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovEvent(uint64(m.ChainId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -761,21 +1445,748 @@ func (m *EventCrossTransferOut) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RelayerFee == nil {
+				m.RelayerFee = &types.Coin{}
+			}
+			if err := m.RelayerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCrossTransferOutRefund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCrossTransferOutRefund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCrossTransferOutRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundReason", wireType)
+			}
+			m.RefundReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundReason |= RefundReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCrossTransferIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCrossTransferIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCrossTransferIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcChainId", wireType)
+			}
+			m.SrcChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRegisterToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRegisterToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRegisterToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mintable = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalTransferCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalTransferCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccountTransferCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeregisterToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Amount == nil {
-				m.Amount = &types.Coin{}
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeregisterToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeregisterToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -785,66 +2196,24 @@ func (m *EventCrossTransferOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RelayerFee == nil {
-				m.RelayerFee = &types.Coin{}
-			}
-			if err := m.RelayerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
-			}
-			m.DestChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DestChainId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -866,7 +2235,7 @@ func (m *EventCrossTransferOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCrossTransferOutRefund) Unmarshal(dAtA []byte) error {
+func (m *EventCrossTransferQueued) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -889,13 +2258,51 @@ func (m *EventCrossTransferOutRefund) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCrossTransferOutRefund: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCrossTransferQueued: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCrossTransferOutRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCrossTransferQueued: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= TransferDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
 			}
@@ -927,11 +2334,11 @@ func (m *EventCrossTransferOutRefund) Unmarshal(dAtA []byte) error {
 			}
 			m.RefundAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -941,33 +2348,29 @@ func (m *EventCrossTransferOutRefund) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Amount == nil {
-				m.Amount = &types.Coin{}
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundReason", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.RefundReason = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -977,35 +2380,33 @@ func (m *EventCrossTransferOutRefund) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RefundReason |= RefundReason(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
 			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
 			}
-		case 5:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.DestChainId = 0
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1015,7 +2416,7 @@ func (m *EventCrossTransferOutRefund) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestChainId |= uint32(b&0x7F) << shift
+				m.ChainId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1041,7 +2442,7 @@ func (m *EventCrossTransferOutRefund) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCrossTransferIn) Unmarshal(dAtA []byte) error {
+func (m *EventCrossTransferQueuedExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1064,17 +2465,17 @@ func (m *EventCrossTransferIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCrossTransferIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCrossTransferQueuedExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCrossTransferIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCrossTransferQueuedExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1084,31 +2485,33 @@ func (m *EventCrossTransferIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Amount == nil {
-				m.Amount = &types.Coin{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= TransferDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1136,13 +2539,13 @@ func (m *EventCrossTransferIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiverAddress = string(dAtA[iNdEx:postIndex])
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1152,29 +2555,33 @@ func (m *EventCrossTransferIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.Sequence = 0
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1184,16 +2591,16 @@ func (m *EventCrossTransferIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				m.ChainId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			m.SrcChainId = 0
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1203,7 +2610,7 @@ func (m *EventCrossTransferIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SrcChainId |= uint32(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
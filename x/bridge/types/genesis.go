@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -10,5 +16,42 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	denoms := make(map[string]bool)
+	contracts := make(map[string]bool)
+	for _, token := range gs.Tokens {
+		if err := token.Validate(); err != nil {
+			return err
+		}
+		if denoms[token.Denom] {
+			return fmt.Errorf("duplicated token denom %s", token.Denom)
+		}
+		denoms[token.Denom] = true
+		contract := fmt.Sprintf("%d/%s", token.ChainId, token.GetContract())
+		if contracts[contract] {
+			return fmt.Errorf("duplicated token contract %s", contract)
+		}
+		contracts[contract] = true
+	}
+	ids := make(map[uint64]bool)
+	for _, transfer := range gs.QueuedTransfers {
+		if ids[transfer.Id] {
+			return fmt.Errorf("duplicated queued transfer id %d", transfer.Id)
+		}
+		ids[transfer.Id] = true
+		if _, err := sdk.AccAddressFromHexUnsafe(transfer.RefundAddress); err != nil {
+			return fmt.Errorf("invalid refund address of queued transfer %d: %s", transfer.Id, err)
+		}
+		if _, err := sdk.AccAddressFromHexUnsafe(transfer.Recipient); err != nil {
+			return fmt.Errorf("invalid recipient of queued transfer %d: %s", transfer.Id, err)
+		}
+		if transfer.Amount.IsNil() || !transfer.Amount.IsPositive() {
+			return fmt.Errorf("invalid amount of queued transfer %d", transfer.Id)
+		}
+		if transfer.Token != nil {
+			if err := transfer.Token.Validate(); err != nil {
+				return fmt.Errorf("invalid token of queued transfer %d: %s", transfer.Id, err)
+			}
+		}
+	}
 	return gs.Params.Validate()
 }
@@ -30,6 +30,38 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "valid genesis state with tokens",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Tokens: []types.TokenInfo{
+					{Denom: "usdt", ChainId: 56, ContractAddress: "0x55d398326f99059fF775485246999027B3197955", Decimals: 18, Mintable: true},
+					{Denom: "usdc", ChainId: 56, ContractAddress: "0x8AC76a51cc950d9822D68b83fE1Ad97B32Cd580d", Decimals: 6, Mintable: true},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid token contract",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Tokens: []types.TokenInfo{
+					{Denom: "usdt", ChainId: 56, ContractAddress: "0x", Decimals: 18},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated token contract",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Tokens: []types.TokenInfo{
+					{Denom: "usdt", ChainId: 56, ContractAddress: "0x55d398326f99059fF775485246999027B3197955", Decimals: 18},
+					{Denom: "usdt2", ChainId: 56, ContractAddress: "0x55d398326f99059fF775485246999027B3197955", Decimals: 18},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "bridge"
@@ -14,8 +21,65 @@ const (
 	MemStoreKey = "mem_bridge"
 )
 
-var ParamsKey = []byte{0x01}
+var (
+	ParamsKey = []byte{0x01}
+
+	TokenInfoPrefix       = []byte{0x02}
+	TokenByContractPrefix = []byte{0x03}
+
+	QueuedTransferPrefix = []byte{0x04}
+	QueuedTransferSeqKey = []byte{0x05}
+	TransferVolumePrefix = []byte{0x06}
+
+	CrossChainPackageRecordPrefix = []byte{0x07}
+
+	DeregisteredTokenByContractPrefix = []byte{0x08}
+
+	QueuedTransferByHeightPrefix = []byte{0x09}
+)
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+// GetTokenInfoKey returns the key of the registered token by its denom
+func GetTokenInfoKey(denom string) []byte {
+	return append(TokenInfoPrefix, []byte(denom)...)
+}
+
+// GetTokenByContractKey returns the key of the index from the token contract on the destination chain to the denom
+func GetTokenByContractKey(chainId uint32, contract common.Address) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, chainId)
+	return append(append(TokenByContractPrefix, bz...), contract.Bytes()...)
+}
+
+// GetDeregisteredTokenByContractKey returns the key of the tombstone of the token which was registered by the token
+// contract on the destination chain
+func GetDeregisteredTokenByContractKey(chainId uint32, contract common.Address) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, chainId)
+	return append(append(DeregisteredTokenByContractPrefix, bz...), contract.Bytes()...)
+}
+
+// GetQueuedTransferKey returns the key of the queued transfer
+func GetQueuedTransferKey(id uint64) []byte {
+	return append(QueuedTransferPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetQueuedTransferByHeightKey returns the key of the index of the queued transfers by the height they are queued
+func GetQueuedTransferByHeightKey(queuedHeight int64, id uint64) []byte {
+	return append(append(QueuedTransferByHeightPrefix, sdk.Uint64ToBigEndian(uint64(queuedHeight))...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTransferVolumePrefix returns the prefix of the transfer volumes of a direction in the window starting at the height
+func GetTransferVolumePrefix(direction TransferDirection, windowStartHeight uint64) []byte {
+	return append(append(TransferVolumePrefix, byte(direction)), sdk.Uint64ToBigEndian(windowStartHeight)...)
+}
+
+// GetTransferVolumeKey returns the key of the transfer volume of a denom by an account, or the global volume of the
+// denom if the account is empty
+func GetTransferVolumeKey(direction TransferDirection, windowStartHeight uint64, denom string, addr sdk.AccAddress) []byte {
+	key := append(GetTransferVolumePrefix(direction, windowStartHeight), byte(len(denom)))
+	return append(append(key, denom...), addr...)
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgDeregisterToken{}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgDeregisterToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgDeregisterToken message.
+func (m *MsgDeregisterToken) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromHexUnsafe(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgDeregisterToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidToken, "invalid denom: %s", err)
+	}
+
+	return nil
+}
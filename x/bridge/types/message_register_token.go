@@ -0,0 +1,41 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgRegisterToken{}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRegisterToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRegisterToken message.
+func (m *MsgRegisterToken) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromHexUnsafe(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRegisterToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.GetTokenInfo().Validate()
+}
+
+// GetTokenInfo returns the token info to register
+func (m *MsgRegisterToken) GetTokenInfo() TokenInfo {
+	return TokenInfo{
+		Denom:              m.Denom,
+		ChainId:            m.ChainId,
+		ContractAddress:    m.ContractAddress,
+		Decimals:           m.Decimals,
+		Mintable:           m.Mintable,
+		GlobalTransferCap:  m.GlobalTransferCap,
+		AccountTransferCap: m.AccountTransferCap,
+	}
+}
@@ -9,6 +9,14 @@ import (
 var (
 	DefaultBscTransferOutRelayerFeeParam    = sdkmath.NewInt(780000000000000) // 0.00078
 	DefaultBscTransferOutAckRelayerFeeParam = sdkmath.NewInt(0)
+	DefaultOpTransferOutRelayerFeeParam     = sdkmath.NewInt(78000000000000) // 0.000078
+	DefaultOpTransferOutAckRelayerFeeParam  = sdkmath.NewInt(0)
+	DefaultTransferRateLimitWindow          = uint64(0)
+	DefaultGlobalTransferCap                = sdkmath.NewInt(0)
+	DefaultAccountTransferCap               = sdkmath.NewInt(0)
+	DefaultTransferPaused                   = false
+	DefaultMaxQueuedTransfersPerBlock       = uint64(100)
+	DefaultQueuedTransferExpiryBlocks       = uint64(302400) // 7 days
 )
 
 // DefaultParams returns a default set of parameters
@@ -16,6 +24,14 @@ func DefaultParams() Params {
 	return Params{
 		BscTransferOutRelayerFee:    DefaultBscTransferOutRelayerFeeParam,
 		BscTransferOutAckRelayerFee: DefaultBscTransferOutAckRelayerFeeParam,
+		TransferRateLimitWindow:     DefaultTransferRateLimitWindow,
+		GlobalTransferCap:           DefaultGlobalTransferCap,
+		AccountTransferCap:          DefaultAccountTransferCap,
+		TransferPaused:              DefaultTransferPaused,
+		OpTransferOutRelayerFee:     DefaultOpTransferOutRelayerFeeParam,
+		OpTransferOutAckRelayerFee:  DefaultOpTransferOutAckRelayerFeeParam,
+		MaxQueuedTransfersPerBlock:  DefaultMaxQueuedTransfersPerBlock,
+		QueuedTransferExpiryBlocks:  DefaultQueuedTransferExpiryBlocks,
 	}
 }
 
@@ -30,6 +46,31 @@ func (p Params) Validate() error {
 	if err != nil {
 		return err
 	}
+
+	err = validateOpRelayerFee(p.OpTransferOutRelayerFee)
+	if err != nil {
+		return err
+	}
+
+	err = validateOpRelayerFee(p.OpTransferOutAckRelayerFee)
+	if err != nil {
+		return err
+	}
+
+	err = validateTransferCap(p.GlobalTransferCap)
+	if err != nil {
+		return err
+	}
+
+	err = validateTransferCap(p.AccountTransferCap)
+	if err != nil {
+		return err
+	}
+
+	// the queued transfers are never processed without a limit per block
+	if p.TransferRateLimitWindow != 0 && p.MaxQueuedTransfersPerBlock == 0 {
+		return fmt.Errorf("max queued transfers per block should be positive if the rate limits are enabled")
+	}
 	return nil
 }
 
@@ -49,3 +90,32 @@ func validateRelayerFee(i interface{}) error {
 
 	return nil
 }
+
+// validateOpRelayerFee validates the relayer fee to op chain, it is unset for the params saved before it was
+// introduced, and the transfers to op chain are disabled until the relayer fee is set to a positive amount.
+func validateOpRelayerFee(i interface{}) error {
+	fee, ok := i.(sdkmath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if fee.IsNil() {
+		return nil
+	}
+
+	return validateRelayerFee(fee)
+}
+
+func validateTransferCap(i interface{}) error {
+	transferCap, ok := i.(sdkmath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// a nil cap is unset, the same as 0
+	if !transferCap.IsNil() && transferCap.IsNegative() {
+		return fmt.Errorf("transfer cap should not less than 0")
+	}
+
+	return nil
+}
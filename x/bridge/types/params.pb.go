@@ -31,6 +31,27 @@ type Params struct {
 	BscTransferOutRelayerFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=bsc_transfer_out_relayer_fee,json=bscTransferOutRelayerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bsc_transfer_out_relayer_fee"`
 	// Relayer fee for the ACK or FAIL_ACK package of the cross chain transfer out tx to bsc
 	BscTransferOutAckRelayerFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=bsc_transfer_out_ack_relayer_fee,json=bscTransferOutAckRelayerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bsc_transfer_out_ack_relayer_fee"`
+	// transfer_rate_limit_window defines the number of blocks of a window of the transfer rate limits, the rate
+	// limits are disabled if it is 0
+	TransferRateLimitWindow uint64 `protobuf:"varint,3,opt,name=transfer_rate_limit_window,json=transferRateLimitWindow,proto3" json:"transfer_rate_limit_window,omitempty"`
+	// global_transfer_cap defines the max amount of the native token transferred in a window, counted separately for
+	// transfers out and in, 0 for unlimited. The transfers over the cap are queued to the following windows.
+	GlobalTransferCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=global_transfer_cap,json=globalTransferCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"global_transfer_cap"`
+	// account_transfer_cap defines the max amount of the native token transferred by an account in a window, counted
+	// separately for transfers out and in, 0 for unlimited. The transfers over the cap are queued to the following windows.
+	AccountTransferCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=account_transfer_cap,json=accountTransferCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"account_transfer_cap"`
+	// transfer_paused pauses the transfers out and in, the packages transferred in are refunded while it is paused
+	TransferPaused bool `protobuf:"varint,6,opt,name=transfer_paused,json=transferPaused,proto3" json:"transfer_paused,omitempty"`
+	// Relayer fee for the cross chain transfer out tx to op chain, the transfers to op chain are disabled if it is 0
+	OpTransferOutRelayerFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=op_transfer_out_relayer_fee,json=opTransferOutRelayerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"op_transfer_out_relayer_fee"`
+	// Relayer fee for the ACK or FAIL_ACK package of the cross chain transfer out tx to op chain
+	OpTransferOutAckRelayerFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=op_transfer_out_ack_relayer_fee,json=opTransferOutAckRelayerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"op_transfer_out_ack_relayer_fee"`
+	// max_queued_transfers_per_block defines the max number of the queued transfers processed at the end of a block,
+	// it should be positive if the rate limits are enabled
+	MaxQueuedTransfersPerBlock uint64 `protobuf:"varint,9,opt,name=max_queued_transfers_per_block,json=maxQueuedTransfersPerBlock,proto3" json:"max_queued_transfers_per_block,omitempty"`
+	// queued_transfer_expiry_blocks defines the number of blocks after which a queued transfer is refunded, even while
+	// the transfers are paused, the queued transfers never expire if it is 0
+	QueuedTransferExpiryBlocks uint64 `protobuf:"varint,10,opt,name=queued_transfer_expiry_blocks,json=queuedTransferExpiryBlocks,proto3" json:"queued_transfer_expiry_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -66,6 +87,34 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetTransferRateLimitWindow() uint64 {
+	if m != nil {
+		return m.TransferRateLimitWindow
+	}
+	return 0
+}
+
+func (m *Params) GetTransferPaused() bool {
+	if m != nil {
+		return m.TransferPaused
+	}
+	return false
+}
+
+func (m *Params) GetMaxQueuedTransfersPerBlock() uint64 {
+	if m != nil {
+		return m.MaxQueuedTransfersPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetQueuedTransferExpiryBlocks() uint64 {
+	if m != nil {
+		return m.QueuedTransferExpiryBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "greenfield.bridge.Params")
 }
@@ -73,25 +122,38 @@ func init() {
 func init() { proto.RegisterFile("greenfield/bridge/params.proto", fileDescriptor_0968257d902d40e4) }
 
 var fileDescriptor_0968257d902d40e4 = []byte{
-	// 281 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x2f, 0x4a, 0x4d,
-	0xcd, 0x4b, 0xcb, 0x4c, 0xcd, 0x49, 0xd1, 0x4f, 0x2a, 0xca, 0x4c, 0x49, 0x4f, 0xd5, 0x2f, 0x48,
-	0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x44, 0xc8, 0xeb, 0x41,
-	0xe4, 0xa5, 0x24, 0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xe3, 0xc1, 0x0a, 0xf4, 0x21, 0x1c, 0x88,
-	0x6a, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x88, 0x38, 0x88, 0x05, 0x11, 0x55, 0xea, 0x61, 0xe2,
-	0x62, 0x0b, 0x00, 0x1b, 0x2a, 0x54, 0xc3, 0x25, 0x93, 0x54, 0x9c, 0x1c, 0x5f, 0x52, 0x94, 0x98,
-	0x57, 0x9c, 0x96, 0x5a, 0x14, 0x9f, 0x5f, 0x5a, 0x12, 0x5f, 0x94, 0x9a, 0x93, 0x58, 0x99, 0x5a,
-	0x14, 0x9f, 0x96, 0x9a, 0x2a, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x64, 0x73, 0xe2, 0x9e, 0x3c,
-	0xc3, 0xad, 0x7b, 0xf2, 0x6a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0x50,
-	0x7b, 0xa0, 0x94, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x49, 0x65, 0x41, 0x6a, 0xb1, 0x9e, 0x67, 0x5e,
-	0xc9, 0xa5, 0x2d, 0xba, 0x5c, 0x50, 0x67, 0x78, 0xe6, 0x95, 0x04, 0x49, 0x24, 0x15, 0x27, 0x87,
-	0x40, 0x2d, 0xf0, 0x2f, 0x2d, 0x09, 0x82, 0x18, 0xef, 0x96, 0x9a, 0x2a, 0xd4, 0xcc, 0xc8, 0xa5,
-	0x80, 0x61, 0x7d, 0x62, 0x72, 0x36, 0x8a, 0x13, 0x98, 0xa8, 0xe0, 0x04, 0x69, 0x54, 0x27, 0x38,
-	0x26, 0x67, 0x23, 0x5c, 0xe1, 0xe4, 0x71, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f,
-	0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c,
-	0x51, 0x7a, 0x48, 0x96, 0x25, 0xe5, 0x25, 0xe9, 0x26, 0x67, 0x24, 0x66, 0xe6, 0xe9, 0x23, 0xc5,
-	0x50, 0x05, 0x2c, 0x8e, 0xc0, 0x16, 0x27, 0xb1, 0x81, 0xc3, 0xd7, 0x18, 0x10, 0x00, 0x00, 0xff,
-	0xff, 0x90, 0x67, 0x42, 0x01, 0xc5, 0x01, 0x00, 0x00,
+	// 491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x63, 0x28, 0xfd, 0xb3, 0x07, 0x50, 0x4d, 0xa5, 0x1a, 0x17, 0x9c, 0x88, 0x03, 0xe4,
+	0x12, 0xfb, 0xc0, 0x11, 0x2e, 0x0d, 0x02, 0x51, 0x09, 0x89, 0x60, 0x21, 0x21, 0x71, 0x59, 0xad,
+	0xd7, 0x13, 0xd7, 0x8a, 0xed, 0xdd, 0xee, 0xae, 0xd5, 0x04, 0x71, 0x41, 0xbc, 0x00, 0x0f, 0xc3,
+	0x43, 0xf4, 0x58, 0x71, 0x01, 0x71, 0xa8, 0x50, 0xf2, 0x22, 0x28, 0xbb, 0x76, 0x1a, 0x07, 0xb8,
+	0xf9, 0x94, 0x78, 0xe6, 0xdb, 0xef, 0xfb, 0x8d, 0x34, 0x1a, 0xe4, 0x25, 0x02, 0xa0, 0x18, 0xa7,
+	0x90, 0xc5, 0x41, 0x24, 0xd2, 0x38, 0x81, 0x80, 0x13, 0x41, 0x72, 0xe9, 0x73, 0xc1, 0x14, 0xb3,
+	0xf7, 0xaf, 0xfb, 0xbe, 0xe9, 0xbb, 0xf7, 0x28, 0x93, 0x39, 0x93, 0x58, 0x0b, 0x02, 0xf3, 0x61,
+	0xd4, 0xee, 0x41, 0xc2, 0x12, 0x66, 0xea, 0xcb, 0x7f, 0xa6, 0xfa, 0xf0, 0xc7, 0x0e, 0xda, 0x1e,
+	0x69, 0x53, 0xfb, 0x13, 0xba, 0x1f, 0x49, 0x8a, 0x95, 0x20, 0x85, 0x1c, 0x83, 0xc0, 0xac, 0x54,
+	0x58, 0x40, 0x46, 0x66, 0x20, 0xf0, 0x18, 0xc0, 0xb1, 0x7a, 0x56, 0x7f, 0x6f, 0xf8, 0xec, 0xe2,
+	0xaa, 0xdb, 0xf9, 0x75, 0xd5, 0x7d, 0x94, 0xa4, 0xea, 0xb4, 0x8c, 0x7c, 0xca, 0xf2, 0x2a, 0xa7,
+	0xfa, 0x19, 0xc8, 0x78, 0x12, 0xa8, 0x19, 0x07, 0xe9, 0x9f, 0x14, 0xea, 0xfb, 0xb7, 0x01, 0xaa,
+	0x30, 0x4e, 0x0a, 0x15, 0x3a, 0x91, 0xa4, 0xef, 0xaa, 0x80, 0x37, 0xa5, 0x0a, 0x8d, 0xfd, 0x4b,
+	0x00, 0xfb, 0x8b, 0x85, 0x7a, 0x7f, 0xc5, 0x13, 0x3a, 0x69, 0x20, 0xdc, 0x68, 0x01, 0xe1, 0xa8,
+	0x89, 0x70, 0x4c, 0x27, 0x6b, 0x14, 0x4f, 0x91, 0xbb, 0x02, 0x10, 0x44, 0x01, 0xce, 0xd2, 0x3c,
+	0x55, 0xf8, 0x3c, 0x2d, 0x62, 0x76, 0xee, 0xdc, 0xec, 0x59, 0xfd, 0xad, 0xf0, 0xb0, 0x56, 0x84,
+	0x44, 0xc1, 0xeb, 0x65, 0xff, 0xbd, 0x6e, 0xdb, 0x19, 0xba, 0x9b, 0x64, 0x2c, 0x22, 0xd9, 0xf5,
+	0x10, 0x94, 0x70, 0x67, 0xab, 0x05, 0xe8, 0x7d, 0x63, 0x5c, 0x73, 0x3f, 0x27, 0xdc, 0x2e, 0xd0,
+	0x01, 0xa1, 0x94, 0x95, 0x85, 0x6a, 0xc6, 0xdd, 0x6a, 0x21, 0xce, 0xae, 0x9c, 0xd7, 0xf3, 0x1e,
+	0xa3, 0x3b, 0xab, 0x1c, 0x4e, 0x4a, 0x09, 0xb1, 0xb3, 0xdd, 0xb3, 0xfa, 0xbb, 0xe1, 0xed, 0xba,
+	0x3c, 0xd2, 0x55, 0xfb, 0x23, 0x3a, 0x62, 0xfc, 0xff, 0x6b, 0xb4, 0xd3, 0x02, 0xdf, 0x21, 0xe3,
+	0xff, 0xde, 0xa2, 0xcf, 0x16, 0xea, 0x6e, 0x86, 0x6f, 0x2e, 0xd1, 0x6e, 0x0b, 0x00, 0x6e, 0x03,
+	0xa0, 0xb9, 0x43, 0x43, 0xe4, 0xe5, 0x64, 0x8a, 0xcf, 0x4a, 0x28, 0x21, 0x5e, 0xa1, 0x48, 0xcc,
+	0x41, 0xe0, 0x28, 0x63, 0x74, 0xe2, 0xec, 0xe9, 0x3d, 0x72, 0x73, 0x32, 0x7d, 0xab, 0x45, 0xb5,
+	0x95, 0x1c, 0x81, 0x18, 0x2e, 0x15, 0xf6, 0x31, 0x7a, 0xb0, 0xf1, 0x1e, 0xc3, 0x94, 0xa7, 0x62,
+	0x66, 0x1c, 0xa4, 0x83, 0x8c, 0xc5, 0x59, 0xe3, 0xfd, 0x0b, 0x2d, 0xd1, 0x0e, 0x72, 0xf8, 0xea,
+	0x62, 0xee, 0x59, 0x97, 0x73, 0xcf, 0xfa, 0x3d, 0xf7, 0xac, 0xaf, 0x0b, 0xaf, 0x73, 0xb9, 0xf0,
+	0x3a, 0x3f, 0x17, 0x5e, 0xe7, 0x83, 0xbf, 0x36, 0x72, 0x54, 0x44, 0x03, 0x7a, 0x4a, 0xd2, 0x22,
+	0x58, 0x3b, 0x36, 0xd3, 0xfa, 0xdc, 0xe8, 0xf1, 0xa3, 0x6d, 0x7d, 0x2a, 0x9e, 0xfc, 0x09, 0x00,
+	0x00, 0xff, 0xff, 0xf6, 0x81, 0x7b, 0xea, 0x90, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -114,6 +176,71 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.QueuedTransferExpiryBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.QueuedTransferExpiryBlocks))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxQueuedTransfersPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxQueuedTransfersPerBlock))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.OpTransferOutAckRelayerFee.Size()
+		i -= size
+		if _, err := m.OpTransferOutAckRelayerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.OpTransferOutRelayerFee.Size()
+		i -= size
+		if _, err := m.OpTransferOutRelayerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.TransferPaused {
+		i--
+		if m.TransferPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.AccountTransferCap.Size()
+		i -= size
+		if _, err := m.AccountTransferCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.GlobalTransferCap.Size()
+		i -= size
+		if _, err := m.GlobalTransferCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TransferRateLimitWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TransferRateLimitWindow))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.BscTransferOutAckRelayerFee.Size()
 		i -= size
@@ -158,6 +285,26 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.BscTransferOutAckRelayerFee.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.TransferRateLimitWindow != 0 {
+		n += 1 + sovParams(uint64(m.TransferRateLimitWindow))
+	}
+	l = m.GlobalTransferCap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.AccountTransferCap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.TransferPaused {
+		n += 2
+	}
+	l = m.OpTransferOutRelayerFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.OpTransferOutAckRelayerFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxQueuedTransfersPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxQueuedTransfersPerBlock))
+	}
+	if m.QueuedTransferExpiryBlocks != 0 {
+		n += 1 + sovParams(uint64(m.QueuedTransferExpiryBlocks))
+	}
 	return n
 }
 
@@ -264,6 +411,219 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRateLimitWindow", wireType)
+			}
+			m.TransferRateLimitWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferRateLimitWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalTransferCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalTransferCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccountTransferCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TransferPaused = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpTransferOutRelayerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OpTransferOutRelayerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpTransferOutAckRelayerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OpTransferOutAckRelayerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueuedTransfersPerBlock", wireType)
+			}
+			m.MaxQueuedTransfersPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueuedTransfersPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedTransferExpiryBlocks", wireType)
+			}
+			m.QueuedTransferExpiryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedTransferExpiryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"math/big"
+
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TokenDecimals defines the decimals of the amounts of the registered tokens on greenfield, which is the same as
+// the native token
+const TokenDecimals = 18
+
+// Validate checks the fields of the token info
+func (t TokenInfo) Validate() error {
+	if err := sdk.ValidateDenom(t.Denom); err != nil {
+		return errors.Wrapf(ErrInvalidToken, "invalid denom: %s", err)
+	}
+	if t.ChainId == 0 {
+		return errors.Wrapf(ErrInvalidToken, "chain id should not be zero")
+	}
+	if !common.IsHexAddress(t.ContractAddress) || common.HexToAddress(t.ContractAddress) == (common.Address{}) {
+		return errors.Wrapf(ErrInvalidToken, "invalid contract address: %s", t.ContractAddress)
+	}
+	if t.Decimals > TokenDecimals {
+		return errors.Wrapf(ErrInvalidToken, "decimals should not be greater than %d", TokenDecimals)
+	}
+	if err := validateTransferCap(t.GlobalTransferCap); err != nil {
+		return errors.Wrapf(ErrInvalidToken, "invalid global transfer cap: %s", err)
+	}
+	if err := validateTransferCap(t.AccountTransferCap); err != nil {
+		return errors.Wrapf(ErrInvalidToken, "invalid account transfer cap: %s", err)
+	}
+	return nil
+}
+
+// GetContract returns the address of the token contract
+func (t TokenInfo) GetContract() common.Address {
+	return common.HexToAddress(t.ContractAddress)
+}
+
+func (t TokenInfo) decimalsFactor() *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(TokenDecimals-t.Decimals)), nil)
+}
+
+// ToContractAmount converts the amount on greenfield to the amount of the token contract, the amount with the
+// precision which can not be represented by the contract is rejected.
+func (t TokenInfo) ToContractAmount(amount sdkmath.Int) (*big.Int, error) {
+	quo, rem := new(big.Int).QuoRem(amount.BigInt(), t.decimalsFactor(), new(big.Int))
+	if rem.Sign() != 0 {
+		return nil, errors.Wrapf(ErrInvalidAmount, "amount %s exceeds the precision of %d decimals", amount, t.Decimals)
+	}
+	return quo, nil
+}
+
+// FromContractAmount converts the amount of the token contract to the amount on greenfield
+func (t TokenInfo) FromContractAmount(amount *big.Int) sdkmath.Int {
+	return sdkmath.NewIntFromBigInt(new(big.Int).Mul(SafeBigInt(amount), t.decimalsFactor()))
+}
@@ -0,0 +1,33 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/x/bridge/types"
+)
+
+func TestTokenInfo_ContractAmount(t *testing.T) {
+	token := types.TokenInfo{Denom: "usdc", ChainId: 56, ContractAddress: "0x8AC76a51cc950d9822D68b83fE1Ad97B32Cd580d", Decimals: 6}
+	require.NoError(t, token.Validate())
+
+	amount, err := token.ToContractAmount(sdkmath.NewInt(1_000_000_000_000))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1), amount)
+
+	_, err = token.ToContractAmount(sdkmath.NewInt(1_500_000_000_001))
+	require.ErrorIs(t, err, types.ErrInvalidAmount)
+
+	require.Equal(t, sdkmath.NewInt(2_000_000_000_000), token.FromContractAmount(big.NewInt(2)))
+
+	token.Decimals = 18
+	amount, err = token.ToContractAmount(sdkmath.NewInt(7))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(7), amount)
+
+	token.Decimals = 19
+	require.ErrorIs(t, token.Validate(), types.ErrInvalidToken)
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -39,6 +40,8 @@ type MsgTransferOut struct {
 	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
 	// transfer token amount
 	Amount *types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// destination chain id of the transfer, either bsc or op chain, the transfer goes to bsc if it is 0
+	DestChainId uint32 `protobuf:"varint,4,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
 }
 
 func (m *MsgTransferOut) Reset()         { *m = MsgTransferOut{} }
@@ -95,6 +98,13 @@ func (m *MsgTransferOut) GetAmount() *types.Coin {
 	return nil
 }
 
+func (m *MsgTransferOut) GetDestChainId() uint32 {
+	if m != nil {
+		return m.DestChainId
+	}
+	return 0
+}
+
 // MsgTransferOutResponse is the Msg/TransferOut response type.
 type MsgTransferOutResponse struct {
 }
@@ -227,45 +237,290 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRegisterToken is the Msg/RegisterToken request type.
+type MsgRegisterToken struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the denom of the token on greenfield
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// chain_id is the id of the destination chain where the token contract is deployed
+	ChainId uint32 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// contract_address is the hex address of the token contract on the destination chain
+	ContractAddress string `protobuf:"bytes,4,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// decimals is the decimals of the token contract
+	Decimals uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// mintable defines whether the token is minted and burned on greenfield, instead of being escrowed
+	Mintable bool `protobuf:"varint,6,opt,name=mintable,proto3" json:"mintable,omitempty"`
+	// global_transfer_cap is the max amount of the token transferred in a window of the transfer rate limits, 0 for unlimited
+	GlobalTransferCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=global_transfer_cap,json=globalTransferCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"global_transfer_cap"`
+	// account_transfer_cap is the max amount of the token transferred by an account in a window of the transfer rate
+	// limits, 0 for unlimited
+	AccountTransferCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=account_transfer_cap,json=accountTransferCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"account_transfer_cap"`
+}
+
+func (m *MsgRegisterToken) Reset()         { *m = MsgRegisterToken{} }
+func (m *MsgRegisterToken) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterToken) ProtoMessage()    {}
+func (*MsgRegisterToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5360e58e7e095845, []int{4}
+}
+func (m *MsgRegisterToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterToken.Merge(m, src)
+}
+func (m *MsgRegisterToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterToken proto.InternalMessageInfo
+
+func (m *MsgRegisterToken) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRegisterToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgRegisterToken) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *MsgRegisterToken) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgRegisterToken) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *MsgRegisterToken) GetMintable() bool {
+	if m != nil {
+		return m.Mintable
+	}
+	return false
+}
+
+// MsgRegisterTokenResponse defines the response structure for executing a MsgRegisterToken message.
+type MsgRegisterTokenResponse struct {
+}
+
+func (m *MsgRegisterTokenResponse) Reset()         { *m = MsgRegisterTokenResponse{} }
+func (m *MsgRegisterTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterTokenResponse) ProtoMessage()    {}
+func (*MsgRegisterTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5360e58e7e095845, []int{5}
+}
+func (m *MsgRegisterTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterTokenResponse.Merge(m, src)
+}
+func (m *MsgRegisterTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterTokenResponse proto.InternalMessageInfo
+
+// MsgDeregisterToken is the Msg/DeregisterToken request type.
+type MsgDeregisterToken struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the denom of the registered token
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgDeregisterToken) Reset()         { *m = MsgDeregisterToken{} }
+func (m *MsgDeregisterToken) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterToken) ProtoMessage()    {}
+func (*MsgDeregisterToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5360e58e7e095845, []int{6}
+}
+func (m *MsgDeregisterToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeregisterToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterToken.Merge(m, src)
+}
+func (m *MsgDeregisterToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterToken proto.InternalMessageInfo
+
+func (m *MsgDeregisterToken) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgDeregisterToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgDeregisterTokenResponse defines the response structure for executing a MsgDeregisterToken message.
+type MsgDeregisterTokenResponse struct {
+}
+
+func (m *MsgDeregisterTokenResponse) Reset()         { *m = MsgDeregisterTokenResponse{} }
+func (m *MsgDeregisterTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterTokenResponse) ProtoMessage()    {}
+func (*MsgDeregisterTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5360e58e7e095845, []int{7}
+}
+func (m *MsgDeregisterTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeregisterTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterTokenResponse.Merge(m, src)
+}
+func (m *MsgDeregisterTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterTokenResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgTransferOut)(nil), "greenfield.bridge.MsgTransferOut")
 	proto.RegisterType((*MsgTransferOutResponse)(nil), "greenfield.bridge.MsgTransferOutResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "greenfield.bridge.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "greenfield.bridge.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterToken)(nil), "greenfield.bridge.MsgRegisterToken")
+	proto.RegisterType((*MsgRegisterTokenResponse)(nil), "greenfield.bridge.MsgRegisterTokenResponse")
+	proto.RegisterType((*MsgDeregisterToken)(nil), "greenfield.bridge.MsgDeregisterToken")
+	proto.RegisterType((*MsgDeregisterTokenResponse)(nil), "greenfield.bridge.MsgDeregisterTokenResponse")
 }
 
 func init() { proto.RegisterFile("greenfield/bridge/tx.proto", fileDescriptor_5360e58e7e095845) }
 
 var fileDescriptor_5360e58e7e095845 = []byte{
-	// 441 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xeb, 0x6e, 0xaa, 0x54, 0x0f, 0x15, 0x61, 0x4d, 0x2c, 0xcd, 0xc1, 0x8c, 0x9c, 0xc6,
-	0xc4, 0x6c, 0x65, 0x48, 0x20, 0xed, 0x46, 0xb9, 0x70, 0xa9, 0x40, 0x01, 0x2e, 0x20, 0x81, 0x9c,
-	0xc4, 0x75, 0x2d, 0x11, 0x3b, 0xb2, 0x9d, 0x69, 0xbb, 0x72, 0xe6, 0x80, 0xf8, 0x24, 0x1c, 0xf8,
-	0x04, 0x9c, 0x76, 0x9c, 0x38, 0x71, 0x42, 0xa8, 0x3d, 0xf0, 0x35, 0x50, 0x12, 0x97, 0xb6, 0xdb,
-	0xa4, 0x9e, 0x12, 0xfb, 0xff, 0x7f, 0xef, 0xfd, 0xde, 0xcb, 0x0b, 0x0c, 0x85, 0xe1, 0x5c, 0x4d,
-	0x24, 0xff, 0x98, 0xd3, 0xd4, 0xc8, 0x5c, 0x70, 0xea, 0xce, 0x48, 0x69, 0xb4, 0xd3, 0xe8, 0xce,
-	0x52, 0x23, 0xad, 0x16, 0xe2, 0x4c, 0xdb, 0x42, 0x5b, 0x9a, 0x32, 0xcb, 0xe9, 0x69, 0x9c, 0x72,
-	0xc7, 0x62, 0x9a, 0x69, 0xa9, 0xda, 0x90, 0x70, 0xcf, 0xeb, 0x85, 0x15, 0xf4, 0x34, 0xae, 0x1f,
-	0x5e, 0x18, 0xb6, 0xc2, 0x87, 0xe6, 0x44, 0xdb, 0x83, 0x97, 0x76, 0x85, 0x16, 0xba, 0xbd, 0xaf,
-	0xdf, 0xfc, 0x2d, 0xbe, 0x0e, 0x56, 0x32, 0xc3, 0x0a, 0x1f, 0x15, 0x7d, 0x06, 0x70, 0x30, 0xb6,
-	0xe2, 0xb5, 0x61, 0xca, 0x4e, 0xb8, 0x79, 0x51, 0x39, 0xf4, 0x10, 0x6e, 0x4f, 0x8c, 0x2e, 0x02,
-	0xb0, 0x0f, 0x0e, 0xfa, 0xa3, 0xe0, 0xe7, 0xf7, 0xa3, 0x5d, 0x5f, 0xe8, 0x69, 0x9e, 0x1b, 0x6e,
-	0xed, 0x2b, 0x67, 0xa4, 0x12, 0x49, 0xe3, 0x42, 0x03, 0xd8, 0x75, 0x3a, 0xe8, 0xd6, 0xde, 0xa4,
-	0xeb, 0x34, 0x8a, 0x61, 0x8f, 0x15, 0xba, 0x52, 0x2e, 0xd8, 0xda, 0x07, 0x07, 0x3b, 0xc7, 0x43,
-	0xe2, 0x83, 0xeb, 0x5e, 0x89, 0xef, 0x95, 0x3c, 0xd3, 0x52, 0x25, 0xde, 0x78, 0xd2, 0xff, 0xf4,
-	0xf7, 0xdb, 0x61, 0x93, 0x2d, 0x0a, 0xe0, 0xdd, 0x75, 0x9a, 0x84, 0xdb, 0x52, 0x2b, 0xcb, 0xa3,
-	0xaf, 0x00, 0xde, 0x1e, 0x5b, 0xf1, 0xa6, 0xcc, 0x99, 0xe3, 0x2f, 0x9b, 0x16, 0xd0, 0x63, 0xd8,
-	0x67, 0x95, 0x9b, 0x6a, 0x23, 0xdd, 0xf9, 0x46, 0xdc, 0xa5, 0x15, 0x3d, 0x81, 0xbd, 0x76, 0x08,
-	0x0d, 0x77, 0xcd, 0x78, 0xed, 0x13, 0x91, 0xb6, 0xc4, 0x68, 0xfb, 0xe2, 0xf7, 0xbd, 0x4e, 0xe2,
-	0xed, 0x27, 0x83, 0x9a, 0x74, 0x99, 0x28, 0x1a, 0xc2, 0xbd, 0x2b, 0x4c, 0x0b, 0xde, 0xe3, 0x1f,
-	0x00, 0x6e, 0x8d, 0xad, 0x40, 0xef, 0xe0, 0xce, 0xea, 0x70, 0xef, 0xdf, 0x50, 0x6a, 0xbd, 0xe3,
-	0xf0, 0xc1, 0x46, 0xcb, 0xa2, 0x08, 0x7a, 0x0f, 0x6f, 0xad, 0x0d, 0x24, 0xba, 0x39, 0x74, 0xd5,
-	0x13, 0x1e, 0x6e, 0xf6, 0x2c, 0xf2, 0x8f, 0x9e, 0x5f, 0xcc, 0x30, 0xb8, 0x9c, 0x61, 0xf0, 0x67,
-	0x86, 0xc1, 0x97, 0x39, 0xee, 0x5c, 0xce, 0x71, 0xe7, 0xd7, 0x1c, 0x77, 0xde, 0x12, 0x21, 0xdd,
-	0xb4, 0x4a, 0x49, 0xa6, 0x0b, 0x9a, 0xaa, 0xf4, 0x28, 0x9b, 0x32, 0xa9, 0xe8, 0xca, 0xb2, 0x9d,
-	0xfd, 0xff, 0x0f, 0xce, 0x4b, 0x6e, 0xd3, 0x5e, 0xb3, 0x6e, 0x8f, 0xfe, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0x4a, 0xc3, 0xe3, 0xa7, 0x29, 0x03, 0x00, 0x00,
+	// 699 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8e, 0x9b, 0x36, 0x4d, 0xa6, 0x7f, 0x6f, 0xf3, 0x47, 0xd4, 0xb1, 0x90, 0x1b, 0x82, 0x40,
+	0x69, 0x21, 0xb6, 0x52, 0x24, 0x90, 0x2a, 0x36, 0xb4, 0x2c, 0xe8, 0x22, 0x02, 0x99, 0xb2, 0x01,
+	0x89, 0x68, 0x6c, 0x4f, 0xa7, 0x56, 0xe3, 0x19, 0x6b, 0x66, 0x52, 0xb5, 0x5b, 0x1e, 0x00, 0x21,
+	0x9e, 0xa4, 0x8b, 0x3e, 0x44, 0x17, 0x2c, 0xaa, 0xae, 0x10, 0x8b, 0x0a, 0xb5, 0x0b, 0x5e, 0x03,
+	0xd9, 0x9e, 0xb8, 0xb9, 0x49, 0xa9, 0x10, 0xac, 0x9c, 0x73, 0xce, 0x77, 0xce, 0x77, 0xae, 0x13,
+	0x60, 0x10, 0x8e, 0x31, 0xdd, 0x0b, 0x70, 0xc7, 0xb7, 0x5d, 0x1e, 0xf8, 0x04, 0xdb, 0xf2, 0xc8,
+	0x8a, 0x38, 0x93, 0x0c, 0x2e, 0xdf, 0xd8, 0xac, 0xd4, 0x66, 0x98, 0x1e, 0x13, 0x21, 0x13, 0xb6,
+	0x8b, 0x04, 0xb6, 0x0f, 0x9b, 0x2e, 0x96, 0xa8, 0x69, 0x7b, 0x2c, 0xa0, 0xa9, 0x8b, 0xb1, 0xa2,
+	0xec, 0xa1, 0x20, 0xf6, 0x61, 0x33, 0xfe, 0x28, 0x43, 0x25, 0x35, 0xb4, 0x13, 0xc9, 0x4e, 0x05,
+	0x65, 0x2a, 0x13, 0x46, 0x58, 0xaa, 0x8f, 0x7f, 0x29, 0xad, 0x39, 0x9a, 0x58, 0x84, 0x38, 0x0a,
+	0x95, 0x57, 0xed, 0x44, 0x03, 0x0b, 0x2d, 0x41, 0x76, 0x39, 0xa2, 0x62, 0x0f, 0xf3, 0xd7, 0x5d,
+	0x09, 0x1f, 0x83, 0xe9, 0x3d, 0xce, 0x42, 0x5d, 0xab, 0x6a, 0xf5, 0xd2, 0x96, 0x7e, 0x71, 0xda,
+	0x28, 0x2b, 0xa2, 0x17, 0xbe, 0xcf, 0xb1, 0x10, 0x6f, 0x25, 0x0f, 0x28, 0x71, 0x12, 0x14, 0x5c,
+	0x00, 0x53, 0x92, 0xe9, 0x53, 0x31, 0xd6, 0x99, 0x92, 0x0c, 0x36, 0x41, 0x01, 0x85, 0xac, 0x4b,
+	0xa5, 0x9e, 0xaf, 0x6a, 0xf5, 0xb9, 0x8d, 0x8a, 0xa5, 0x9c, 0xe3, 0x5a, 0x2d, 0x55, 0xab, 0xb5,
+	0xcd, 0x02, 0xea, 0x28, 0x20, 0xac, 0x81, 0x79, 0x1f, 0x0b, 0xd9, 0xf6, 0xf6, 0x51, 0x40, 0xdb,
+	0x81, 0xaf, 0x4f, 0x57, 0xb5, 0xfa, 0xbc, 0x33, 0x17, 0x2b, 0xb7, 0x63, 0xdd, 0x8e, 0xbf, 0x59,
+	0xfa, 0xf4, 0xeb, 0x64, 0x3d, 0x61, 0xac, 0xe9, 0xe0, 0xce, 0x60, 0xc6, 0x0e, 0x16, 0x11, 0xa3,
+	0x02, 0xd7, 0xbe, 0x6a, 0x60, 0xb1, 0x25, 0xc8, 0xbb, 0xc8, 0x47, 0x12, 0xbf, 0x49, 0xca, 0x84,
+	0x4f, 0x41, 0x09, 0x75, 0xe5, 0x3e, 0xe3, 0x81, 0x3c, 0x9e, 0x58, 0xd2, 0x0d, 0x14, 0x3e, 0x03,
+	0x85, 0xb4, 0x51, 0x49, 0x6d, 0x71, 0x1d, 0x23, 0x63, 0xb4, 0x52, 0x8a, 0xad, 0xe9, 0xb3, 0xcb,
+	0xd5, 0x9c, 0xa3, 0xe0, 0x9b, 0x0b, 0x71, 0xa6, 0x37, 0x81, 0x6a, 0x15, 0xb0, 0x32, 0x94, 0x53,
+	0x96, 0xef, 0xb7, 0x3c, 0x58, 0x6a, 0x09, 0xe2, 0x60, 0x12, 0x08, 0x89, 0xf9, 0x2e, 0x3b, 0xc0,
+	0xf4, 0x8f, 0x13, 0x2e, 0x83, 0x19, 0x1f, 0x53, 0x16, 0xaa, 0x59, 0xa4, 0x02, 0xac, 0x80, 0x62,
+	0xd6, 0xd6, 0x7c, 0xd2, 0xd6, 0x59, 0x2f, 0x6d, 0x29, 0x5c, 0x03, 0x4b, 0x1e, 0xa3, 0x92, 0x23,
+	0x4f, 0xb6, 0x51, 0x1a, 0x35, 0xe9, 0x7c, 0xc9, 0x59, 0xec, 0xe9, 0x15, 0x19, 0x34, 0x40, 0xd1,
+	0xc7, 0x5e, 0x10, 0xa2, 0x8e, 0xd0, 0x67, 0x92, 0x28, 0x99, 0x1c, 0xdb, 0xc2, 0x80, 0x4a, 0xe4,
+	0x76, 0xb0, 0x5e, 0xa8, 0x6a, 0xf5, 0xa2, 0x93, 0xc9, 0xb0, 0x03, 0xfe, 0x27, 0x1d, 0xe6, 0xa2,
+	0x4e, 0x5b, 0xaa, 0x71, 0xb5, 0x3d, 0x14, 0xe9, 0xb3, 0x49, 0x55, 0xcf, 0xe3, 0xb6, 0xfd, 0xb8,
+	0x5c, 0x7d, 0x48, 0x02, 0xb9, 0xdf, 0x75, 0x2d, 0x8f, 0x85, 0x6a, 0xa3, 0xd5, 0xa7, 0x21, 0xfc,
+	0x03, 0x5b, 0x1e, 0x47, 0x58, 0x58, 0x3b, 0x54, 0x5e, 0x9c, 0x36, 0x80, 0xea, 0xc1, 0x0e, 0x95,
+	0xce, 0x72, 0x1a, 0xb8, 0xb7, 0x06, 0xdb, 0x28, 0x82, 0x14, 0x94, 0x91, 0xe7, 0xc5, 0x2b, 0x35,
+	0x48, 0x57, 0xfc, 0x0b, 0x74, 0x50, 0x45, 0xee, 0xe3, 0x1b, 0x99, 0xb4, 0x01, 0xf4, 0xe1, 0x69,
+	0x66, 0xa3, 0xe6, 0x00, 0xb6, 0x04, 0x79, 0x89, 0xf9, 0xbf, 0x9b, 0xf5, 0x48, 0x3e, 0x77, 0x81,
+	0x31, 0xca, 0xd9, 0xcb, 0x68, 0xe3, 0x73, 0x1e, 0xe4, 0x5b, 0x82, 0xc0, 0x0f, 0x60, 0xae, 0xff,
+	0xfa, 0xef, 0x8d, 0xd9, 0xf3, 0xc1, 0x73, 0x33, 0xd6, 0x26, 0x42, 0x7a, 0x24, 0xf0, 0x23, 0xf8,
+	0x6f, 0xe0, 0x1a, 0x6b, 0xe3, 0x5d, 0xfb, 0x31, 0xc6, 0xfa, 0x64, 0x4c, 0x16, 0x1f, 0x81, 0xf9,
+	0xc1, 0xeb, 0xb9, 0x3f, 0xde, 0x79, 0x00, 0x64, 0x3c, 0xba, 0x05, 0x28, 0xa3, 0x20, 0x60, 0x71,
+	0x78, 0x6c, 0x0f, 0xc6, 0xfb, 0x0f, 0xc1, 0x8c, 0xc6, 0xad, 0x60, 0x3d, 0xa2, 0xad, 0x57, 0x67,
+	0x57, 0xa6, 0x76, 0x7e, 0x65, 0x6a, 0x3f, 0xaf, 0x4c, 0xed, 0xcb, 0xb5, 0x99, 0x3b, 0xbf, 0x36,
+	0x73, 0xdf, 0xaf, 0xcd, 0xdc, 0x7b, 0xab, 0x6f, 0x65, 0x5d, 0xea, 0x36, 0x92, 0x0b, 0xb6, 0xfb,
+	0x5e, 0xf6, 0xa3, 0xec, 0x4f, 0x27, 0x5e, 0x5f, 0xb7, 0x90, 0xbc, 0xed, 0x4f, 0x7e, 0x07, 0x00,
+	0x00, 0xff, 0xff, 0x4a, 0xe9, 0x77, 0xf5, 0x96, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -286,6 +541,10 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterToken defines a governance operation for registering a token to the bridge, or updating a registered one.
+	RegisterToken(ctx context.Context, in *MsgRegisterToken, opts ...grpc.CallOption) (*MsgRegisterTokenResponse, error)
+	// DeregisterToken defines a governance operation for removing a token from the bridge.
+	DeregisterToken(ctx context.Context, in *MsgDeregisterToken, opts ...grpc.CallOption) (*MsgDeregisterTokenResponse, error)
 }
 
 type msgClient struct {
@@ -314,6 +573,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RegisterToken(ctx context.Context, in *MsgRegisterToken, opts ...grpc.CallOption) (*MsgRegisterTokenResponse, error) {
+	out := new(MsgRegisterTokenResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.bridge.Msg/RegisterToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeregisterToken(ctx context.Context, in *MsgDeregisterToken, opts ...grpc.CallOption) (*MsgDeregisterTokenResponse, error) {
+	out := new(MsgDeregisterTokenResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.bridge.Msg/DeregisterToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	TransferOut(context.Context, *MsgTransferOut) (*MsgTransferOutResponse, error)
@@ -322,6 +599,10 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterToken defines a governance operation for registering a token to the bridge, or updating a registered one.
+	RegisterToken(context.Context, *MsgRegisterToken) (*MsgRegisterTokenResponse, error)
+	// DeregisterToken defines a governance operation for removing a token from the bridge.
+	DeregisterToken(context.Context, *MsgDeregisterToken) (*MsgDeregisterTokenResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -334,6 +615,12 @@ func (*UnimplementedMsgServer) TransferOut(ctx context.Context, req *MsgTransfer
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RegisterToken(ctx context.Context, req *MsgRegisterToken) (*MsgRegisterTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterToken not implemented")
+}
+func (*UnimplementedMsgServer) DeregisterToken(ctx context.Context, req *MsgDeregisterToken) (*MsgDeregisterTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterToken not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -375,6 +662,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.bridge.Msg/RegisterToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterToken(ctx, req.(*MsgRegisterToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeregisterToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.bridge.Msg/DeregisterToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterToken(ctx, req.(*MsgDeregisterToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "greenfield.bridge.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -387,6 +710,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RegisterToken",
+			Handler:    _Msg_RegisterToken_Handler,
+		},
+		{
+			MethodName: "DeregisterToken",
+			Handler:    _Msg_DeregisterToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "greenfield/bridge/tx.proto",
@@ -412,6 +743,11 @@ func (m *MsgTransferOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DestChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Amount != nil {
 		{
 			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
@@ -527,64 +863,303 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRegisterToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgTransferOut) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRegisterToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.AccountTransferCap.Size()
+		i -= size
+		if _, err := m.AccountTransferCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.GlobalTransferCap.Size()
+		i -= size
+		if _, err := m.GlobalTransferCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.Amount != nil {
-		l = m.Amount.Size()
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	if m.Mintable {
+		i--
+		if m.Mintable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
-}
-
-func (m *MsgTransferOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Decimals != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x28
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgTransferOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DestChainId != 0 {
+		n += 1 + sovTx(uint64(m.DestChainId))
+	}
+	return n
+}
+
+func (m *MsgTransferOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovTx(uint64(m.Decimals))
+	}
+	if m.Mintable {
+		n += 2
+	}
+	l = m.GlobalTransferCap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.AccountTransferCap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeregisterToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeregisterTokenResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -727,6 +1302,25 @@ func (m *MsgTransferOut) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -963,6 +1557,492 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRegisterToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mintable = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalTransferCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalTransferCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccountTransferCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,9 +14,14 @@ const (
 	TransferOutChannel = "transferOut"
 	TransferInChannel  = "transferIn"
 
-	TransferOutChannelID sdk.ChannelID = 1
-	TransferInChannelID  sdk.ChannelID = 2
-	SyncParamsChannelID                = types.SyncParamsChannelID
+	TokenTransferOutChannel = "tokenTransferOut"
+	TokenTransferInChannel  = "tokenTransferIn"
+
+	TransferOutChannelID      sdk.ChannelID = 1
+	TransferInChannelID       sdk.ChannelID = 2
+	SyncParamsChannelID                     = types.SyncParamsChannelID
+	TokenTransferOutChannelID sdk.ChannelID = 10
+	TokenTransferInChannelID  sdk.ChannelID = 11
 )
 
 func SafeBigInt(input *big.Int) *big.Int {
@@ -221,3 +226,259 @@ func (pkg *TransferInRefundPackage) Serialize() ([]byte, error) {
 		pkg.RefundReason,
 	})
 }
+
+func DeserializeTransferInRefundPackage(serializedPackage []byte) (*TransferInRefundPackage, error) {
+	unpacked, err := TransferInRefundPackageArgs.Unpack(serializedPackage)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidPackage, "deserialize transfer in refund package failed")
+	}
+
+	unpackedStruct := abi.ConvertType(unpacked[0], TransferInRefundPackageStruct{})
+	pkgStruct, ok := unpackedStruct.(TransferInRefundPackageStruct)
+	if !ok {
+		return nil, errors.Wrapf(ErrInvalidPackage, "reflect transfer in refund package failed")
+	}
+
+	tp := TransferInRefundPackage{
+		pkgStruct.RefundAmount,
+		pkgStruct.RefundAddress.Bytes(),
+		pkgStruct.RefundReason,
+	}
+	return &tp, nil
+}
+
+type TokenTransferOutSynPackage struct {
+	Contract      common.Address
+	Amount        *big.Int
+	Recipient     sdk.AccAddress
+	RefundAddress sdk.AccAddress
+}
+
+type TokenTransferOutSynPackageStruct struct {
+	Contract      common.Address
+	Amount        *big.Int
+	Recipient     common.Address
+	RefundAddress common.Address
+}
+
+var (
+	TokenTransferOutSynPackageType, _ = abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "Contract", Type: "address"},
+		{Name: "Amount", Type: "uint256"},
+		{Name: "Recipient", Type: "address"},
+		{Name: "RefundAddress", Type: "address"},
+	})
+
+	TokenTransferOutSynPackageArgs = abi.Arguments{
+		{Type: TokenTransferOutSynPackageType},
+	}
+)
+
+func (pkg *TokenTransferOutSynPackage) Serialize() ([]byte, error) {
+	return TokenTransferOutSynPackageArgs.Pack(&TokenTransferOutSynPackageStruct{
+		pkg.Contract,
+		SafeBigInt(pkg.Amount),
+		common.BytesToAddress(pkg.Recipient),
+		common.BytesToAddress(pkg.RefundAddress),
+	})
+}
+
+func DeserializeTokenTransferOutSynPackage(serializedPackage []byte) (*TokenTransferOutSynPackage, error) {
+	unpacked, err := TokenTransferOutSynPackageArgs.Unpack(serializedPackage)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidPackage, "deserialize token transfer out syn package failed")
+	}
+
+	unpackedStruct := abi.ConvertType(unpacked[0], TokenTransferOutSynPackageStruct{})
+	pkgStruct, ok := unpackedStruct.(TokenTransferOutSynPackageStruct)
+	if !ok {
+		return nil, errors.Wrapf(ErrInvalidPackage, "reflect token transfer out sync package failed")
+	}
+
+	tp := TokenTransferOutSynPackage{
+		pkgStruct.Contract,
+		pkgStruct.Amount,
+		pkgStruct.Recipient.Bytes(),
+		pkgStruct.RefundAddress.Bytes(),
+	}
+	return &tp, nil
+}
+
+type TokenTransferOutRefundPackage struct {
+	Contract      common.Address
+	RefundAmount  *big.Int
+	RefundAddress sdk.AccAddress
+	RefundReason  uint32
+}
+
+type TokenTransferOutRefundPackageStruct struct {
+	Contract      common.Address
+	RefundAmount  *big.Int
+	RefundAddress common.Address
+	RefundReason  uint32
+}
+
+var (
+	TokenTransferOutRefundPackageType, _ = abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "Contract", Type: "address"},
+		{Name: "RefundAmount", Type: "uint256"},
+		{Name: "RefundAddress", Type: "address"},
+		{Name: "RefundReason", Type: "uint32"},
+	})
+
+	TokenTransferOutRefundPackageArgs = abi.Arguments{
+		{Type: TokenTransferOutRefundPackageType},
+	}
+)
+
+func (pkg *TokenTransferOutRefundPackage) Serialize() ([]byte, error) {
+	if pkg.RefundAmount.Cmp(big.NewInt(0)) < 0 {
+		return nil, errors.Wrapf(ErrInvalidPackage, "refund amount should not be negative")
+	}
+
+	return TokenTransferOutRefundPackageArgs.Pack(&TokenTransferOutRefundPackageStruct{
+		pkg.Contract,
+		SafeBigInt(pkg.RefundAmount),
+		common.BytesToAddress(pkg.RefundAddress),
+		pkg.RefundReason,
+	})
+}
+
+func DeserializeTokenTransferOutRefundPackage(serializedPackage []byte) (*TokenTransferOutRefundPackage, error) {
+	unpacked, err := TokenTransferOutRefundPackageArgs.Unpack(serializedPackage)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidPackage, "deserialize token transfer out refund package failed")
+	}
+
+	unpackedStruct := abi.ConvertType(unpacked[0], TokenTransferOutRefundPackageStruct{})
+	pkgStruct, ok := unpackedStruct.(TokenTransferOutRefundPackageStruct)
+	if !ok {
+		return nil, errors.Wrapf(ErrInvalidPackage, "reflect token transfer out refund package failed")
+	}
+
+	tp := TokenTransferOutRefundPackage{
+		pkgStruct.Contract,
+		pkgStruct.RefundAmount,
+		pkgStruct.RefundAddress.Bytes(),
+		pkgStruct.RefundReason,
+	}
+	return &tp, nil
+}
+
+type TokenTransferInSynPackage struct {
+	Contract        common.Address
+	Amount          *big.Int
+	ReceiverAddress sdk.AccAddress
+	RefundAddress   sdk.AccAddress
+}
+
+type TokenTransferInSynPackageStruct struct {
+	Contract        common.Address
+	Amount          *big.Int
+	ReceiverAddress common.Address
+	RefundAddress   common.Address
+}
+
+var (
+	TokenTransferInSynPackageType, _ = abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "Contract", Type: "address"},
+		{Name: "Amount", Type: "uint256"},
+		{Name: "ReceiverAddress", Type: "address"},
+		{Name: "RefundAddress", Type: "address"},
+	})
+
+	TokenTransferInSynPackageArgs = abi.Arguments{
+		{Type: TokenTransferInSynPackageType},
+	}
+)
+
+func (pkg *TokenTransferInSynPackage) Serialize() ([]byte, error) {
+	return TokenTransferInSynPackageArgs.Pack(&TokenTransferInSynPackageStruct{
+		pkg.Contract,
+		SafeBigInt(pkg.Amount),
+		common.BytesToAddress(pkg.ReceiverAddress),
+		common.BytesToAddress(pkg.RefundAddress),
+	})
+}
+
+func DeserializeTokenTransferInSynPackage(serializedPackage []byte) (*TokenTransferInSynPackage, error) {
+	unpacked, err := TokenTransferInSynPackageArgs.Unpack(serializedPackage)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidPackage, "deserialize token transfer in sync package failed")
+	}
+
+	unpackedStruct := abi.ConvertType(unpacked[0], TokenTransferInSynPackageStruct{})
+	pkgStruct, ok := unpackedStruct.(TokenTransferInSynPackageStruct)
+	if !ok {
+		return nil, errors.Wrapf(ErrInvalidPackage, "reflect token transfer in sync package failed")
+	}
+
+	tp := TokenTransferInSynPackage{
+		pkgStruct.Contract,
+		pkgStruct.Amount,
+		pkgStruct.ReceiverAddress.Bytes(),
+		pkgStruct.RefundAddress.Bytes(),
+	}
+	return &tp, nil
+}
+
+type TokenTransferInRefundPackage struct {
+	Contract      common.Address
+	RefundAmount  *big.Int
+	RefundAddress sdk.AccAddress
+	RefundReason  uint32
+}
+
+type TokenTransferInRefundPackageStruct struct {
+	Contract      common.Address
+	RefundAmount  *big.Int
+	RefundAddress common.Address
+	RefundReason  uint32
+}
+
+var (
+	TokenTransferInRefundPackageType, _ = abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "Contract", Type: "address"},
+		{Name: "RefundAmount", Type: "uint256"},
+		{Name: "RefundAddress", Type: "address"},
+		{Name: "RefundReason", Type: "uint32"},
+	})
+
+	TokenTransferInRefundPackageArgs = abi.Arguments{
+		{Type: TokenTransferInRefundPackageType},
+	}
+)
+
+func (pkg *TokenTransferInRefundPackage) Serialize() ([]byte, error) {
+	if pkg.RefundAmount.Cmp(big.NewInt(0)) < 0 {
+		return nil, errors.Wrapf(ErrInvalidPackage, "refund amount should not be negative")
+	}
+
+	return TokenTransferInRefundPackageArgs.Pack(&TokenTransferInRefundPackageStruct{
+		pkg.Contract,
+		SafeBigInt(pkg.RefundAmount),
+		common.BytesToAddress(pkg.RefundAddress),
+		pkg.RefundReason,
+	})
+}
+
+func DeserializeTokenTransferInRefundPackage(serializedPackage []byte) (*TokenTransferInRefundPackage, error) {
+	unpacked, err := TokenTransferInRefundPackageArgs.Unpack(serializedPackage)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidPackage, "deserialize token transfer in refund package failed")
+	}
+
+	unpackedStruct := abi.ConvertType(unpacked[0], TokenTransferInRefundPackageStruct{})
+	pkgStruct, ok := unpackedStruct.(TokenTransferInRefundPackageStruct)
+	if !ok {
+		return nil, errors.Wrapf(ErrInvalidPackage, "reflect token transfer in refund package failed")
+	}
+
+	tp := TokenTransferInRefundPackage{
+		pkgStruct.Contract,
+		pkgStruct.RefundAmount,
+		pkgStruct.RefundAddress.Bytes(),
+		pkgStruct.RefundReason,
+	}
+	return &tp, nil
+}
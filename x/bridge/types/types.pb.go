@@ -0,0 +1,1252 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: greenfield/bridge/types.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TransferDirection defines the direction of a cross chain transfer.
+type TransferDirection int32
+
+const (
+	TRANSFER_DIRECTION_OUT TransferDirection = 0
+	TRANSFER_DIRECTION_IN  TransferDirection = 1
+)
+
+var TransferDirection_name = map[int32]string{
+	0: "TRANSFER_DIRECTION_OUT",
+	1: "TRANSFER_DIRECTION_IN",
+}
+
+var TransferDirection_value = map[string]int32{
+	"TRANSFER_DIRECTION_OUT": 0,
+	"TRANSFER_DIRECTION_IN":  1,
+}
+
+func (x TransferDirection) String() string {
+	return proto.EnumName(TransferDirection_name, int32(x))
+}
+
+func (TransferDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7d028b1e147c0d6e, []int{0}
+}
+
+// TokenInfo defines a token registered to the bridge, which maps a greenfield denom to the token contract on a
+// destination chain.
+type TokenInfo struct {
+	// denom is the denom of the token on greenfield
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// chain_id is the id of the destination chain where the token contract is deployed, either bsc or op chain
+	ChainId uint32 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// contract_address is the hex address of the token contract on the destination chain
+	ContractAddress string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// decimals is the decimals of the token contract, the amounts on greenfield are always in 18 decimals
+	Decimals uint32 `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// mintable defines whether the token is minted on greenfield when transferred in and burned when transferred out,
+	// otherwise the token is escrowed by the bridge when transferred out and released when transferred in
+	Mintable bool `protobuf:"varint,5,opt,name=mintable,proto3" json:"mintable,omitempty"`
+	// global_transfer_cap is the max amount of the token transferred in a window of the transfer rate limits, counted
+	// separately for transfers out and in, 0 for unlimited
+	GlobalTransferCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=global_transfer_cap,json=globalTransferCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"global_transfer_cap"`
+	// account_transfer_cap is the max amount of the token transferred by an account in a window of the transfer rate
+	// limits, counted separately for transfers out and in, 0 for unlimited
+	AccountTransferCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=account_transfer_cap,json=accountTransferCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"account_transfer_cap"`
+}
+
+func (m *TokenInfo) Reset()         { *m = TokenInfo{} }
+func (m *TokenInfo) String() string { return proto.CompactTextString(m) }
+func (*TokenInfo) ProtoMessage()    {}
+func (*TokenInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d028b1e147c0d6e, []int{0}
+}
+func (m *TokenInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenInfo.Merge(m, src)
+}
+func (m *TokenInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenInfo proto.InternalMessageInfo
+
+func (m *TokenInfo) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TokenInfo) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *TokenInfo) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *TokenInfo) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *TokenInfo) GetMintable() bool {
+	if m != nil {
+		return m.Mintable
+	}
+	return false
+}
+
+// QueuedTransfer defines a transfer of the native token or a registered token which is over the transfer rate limits,
+// it is executed in the following windows.
+type QueuedTransfer struct {
+	// id is the unique id of the queued transfer
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// direction is the direction of the transfer
+	Direction TransferDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=greenfield.bridge.TransferDirection" json:"direction,omitempty"`
+	// refund_address is the sender on greenfield of a transfer out, or the refund address on the source chain of a
+	// transfer in
+	RefundAddress string `protobuf:"bytes,3,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+	// recipient is the recipient on the destination chain of a transfer out, or the receiver on greenfield of a
+	// transfer in
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount of the transfer
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// relayer_fee is the relayer fee paid by a transfer out
+	RelayerFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=relayer_fee,json=relayerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"relayer_fee"`
+	// ack_relayer_fee is the ack relayer fee paid by a transfer out
+	AckRelayerFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=ack_relayer_fee,json=ackRelayerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"ack_relayer_fee"`
+	// chain_id is the destination chain of a transfer out, or the source chain of a transfer in
+	ChainId uint32 `protobuf:"varint,8,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// sequence is the sequence of the package of a transfer in
+	Sequence uint64 `protobuf:"varint,9,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// queued_height is the height when the transfer is queued
+	QueuedHeight int64 `protobuf:"varint,10,opt,name=queued_height,json=queuedHeight,proto3" json:"queued_height,omitempty"`
+	// token is the registered token when the transfer is queued, it is not set for a transfer of the native token
+	Token *TokenInfo `protobuf:"bytes,11,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *QueuedTransfer) Reset()         { *m = QueuedTransfer{} }
+func (m *QueuedTransfer) String() string { return proto.CompactTextString(m) }
+func (*QueuedTransfer) ProtoMessage()    {}
+func (*QueuedTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d028b1e147c0d6e, []int{1}
+}
+func (m *QueuedTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedTransfer.Merge(m, src)
+}
+func (m *QueuedTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedTransfer proto.InternalMessageInfo
+
+func (m *QueuedTransfer) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueuedTransfer) GetDirection() TransferDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return TRANSFER_DIRECTION_OUT
+}
+
+func (m *QueuedTransfer) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+func (m *QueuedTransfer) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *QueuedTransfer) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueuedTransfer) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *QueuedTransfer) GetQueuedHeight() int64 {
+	if m != nil {
+		return m.QueuedHeight
+	}
+	return 0
+}
+
+func (m *QueuedTransfer) GetToken() *TokenInfo {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("greenfield.bridge.TransferDirection", TransferDirection_name, TransferDirection_value)
+	proto.RegisterType((*TokenInfo)(nil), "greenfield.bridge.TokenInfo")
+	proto.RegisterType((*QueuedTransfer)(nil), "greenfield.bridge.QueuedTransfer")
+}
+
+func init() { proto.RegisterFile("greenfield/bridge/types.proto", fileDescriptor_7d028b1e147c0d6e) }
+
+var fileDescriptor_7d028b1e147c0d6e = []byte{
+	// 604 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x4e, 0x1b, 0x3d,
+	0x14, 0xcd, 0x84, 0x00, 0xc9, 0xe5, 0x4b, 0x00, 0x7f, 0xb4, 0x1a, 0x22, 0x1a, 0x22, 0xfa, 0xa3,
+	0xb4, 0x12, 0x89, 0x44, 0xb7, 0xdd, 0xf0, 0x2b, 0x66, 0x03, 0xad, 0x9b, 0x6e, 0x2a, 0x55, 0x23,
+	0x8f, 0x7d, 0x33, 0xb1, 0x32, 0xb1, 0xc3, 0x8c, 0x23, 0x95, 0x37, 0xe8, 0xb2, 0xef, 0xd0, 0x57,
+	0xe8, 0x43, 0xb0, 0x44, 0x55, 0x17, 0x55, 0x17, 0xa8, 0x82, 0xe7, 0xa8, 0x54, 0xc5, 0x33, 0x21,
+	0xa1, 0xb0, 0x2b, 0xab, 0xe4, 0x9c, 0x7b, 0x7d, 0x8e, 0x7c, 0xcf, 0x5c, 0xc3, 0xa3, 0x30, 0x46,
+	0x54, 0x1d, 0x89, 0x91, 0x68, 0x05, 0xb1, 0x14, 0x21, 0xb6, 0xcc, 0xe9, 0x00, 0x93, 0xe6, 0x20,
+	0xd6, 0x46, 0x93, 0xe5, 0x49, 0xb9, 0x99, 0x96, 0xab, 0xab, 0x5c, 0x27, 0x7d, 0x9d, 0xf8, 0xb6,
+	0xa1, 0x95, 0x82, 0xb4, 0xbb, 0xba, 0x12, 0xea, 0x50, 0xa7, 0xfc, 0xe8, 0x5f, 0xca, 0x6e, 0xfc,
+	0xce, 0x43, 0xa9, 0xad, 0x7b, 0xa8, 0x3c, 0xd5, 0xd1, 0x64, 0x05, 0x66, 0x05, 0x2a, 0xdd, 0x77,
+	0x9d, 0xba, 0xd3, 0x28, 0xd1, 0x14, 0x90, 0x55, 0x28, 0xf2, 0x2e, 0x93, 0xca, 0x97, 0xc2, 0xcd,
+	0xd7, 0x9d, 0x46, 0x99, 0xce, 0x5b, 0xec, 0x09, 0xf2, 0x1c, 0x96, 0xb8, 0x56, 0x26, 0x66, 0xdc,
+	0xf8, 0x4c, 0x88, 0x18, 0x93, 0xc4, 0x9d, 0xb1, 0x67, 0x17, 0xc7, 0xfc, 0x76, 0x4a, 0x93, 0x2a,
+	0x14, 0x05, 0x72, 0xd9, 0x67, 0x51, 0xe2, 0x16, 0xac, 0xca, 0x35, 0x1e, 0xd5, 0xfa, 0x52, 0x19,
+	0x16, 0x44, 0xe8, 0xce, 0xd6, 0x9d, 0x46, 0x91, 0x5e, 0x63, 0x12, 0xc1, 0xff, 0x61, 0xa4, 0x03,
+	0x16, 0xf9, 0x26, 0x66, 0x2a, 0xe9, 0x60, 0xec, 0x73, 0x36, 0x70, 0xe7, 0x46, 0x2e, 0x3b, 0xaf,
+	0xce, 0x2e, 0xd6, 0x73, 0x3f, 0x2f, 0xd6, 0x9f, 0x85, 0xd2, 0x74, 0x87, 0x41, 0x93, 0xeb, 0x7e,
+	0x76, 0xeb, 0xec, 0x67, 0x33, 0x11, 0xbd, 0x6c, 0x68, 0x9e, 0x32, 0xdf, 0xbe, 0x6e, 0x42, 0x36,
+	0x14, 0x4f, 0x19, 0xba, 0x9c, 0x0a, 0xb7, 0x33, 0xdd, 0x5d, 0x36, 0x20, 0x0a, 0x56, 0x18, 0xe7,
+	0x7a, 0xa8, 0xcc, 0x4d, 0xbb, 0xf9, 0x7b, 0xb0, 0x23, 0x99, 0xf2, 0x94, 0xdf, 0xc6, 0xf7, 0x02,
+	0x54, 0xde, 0x0c, 0x71, 0x88, 0x62, 0xcc, 0x92, 0x0a, 0xe4, 0xa5, 0xb0, 0x09, 0x14, 0x68, 0x5e,
+	0x0a, 0xb2, 0x03, 0x25, 0x21, 0x63, 0xe4, 0x46, 0x6a, 0x65, 0xe7, 0x5f, 0xd9, 0x7a, 0xd2, 0xbc,
+	0x15, 0x7d, 0x73, 0x7c, 0x7e, 0x6f, 0xdc, 0x4b, 0x27, 0xc7, 0xc8, 0x53, 0xa8, 0xc4, 0xd8, 0x19,
+	0x2a, 0xf1, 0x57, 0x4a, 0xe5, 0x94, 0x1d, 0x67, 0xb4, 0x06, 0xa5, 0x18, 0xb9, 0x1c, 0x48, 0x54,
+	0xc6, 0x86, 0x54, 0xa2, 0x13, 0x82, 0xb4, 0x61, 0x8e, 0xf5, 0x47, 0x17, 0xb0, 0x19, 0xfd, 0xeb,
+	0x34, 0x32, 0x2d, 0xf2, 0x01, 0x16, 0x62, 0x8c, 0xd8, 0x29, 0xc6, 0x7e, 0x07, 0xf1, 0x5e, 0x72,
+	0x85, 0x4c, 0xf0, 0x00, 0x91, 0x08, 0x58, 0x64, 0xbc, 0xe7, 0x4f, 0x5b, 0xdc, 0x47, 0x96, 0x65,
+	0xc6, 0x7b, 0x74, 0xe2, 0x32, 0xbd, 0x22, 0xc5, 0x9b, 0x2b, 0x52, 0x85, 0x62, 0x82, 0x27, 0x43,
+	0x54, 0x1c, 0xdd, 0x92, 0x0d, 0xf5, 0x1a, 0x93, 0xc7, 0x50, 0x3e, 0xb1, 0xe1, 0xfb, 0x5d, 0x94,
+	0x61, 0xd7, 0xb8, 0x50, 0x77, 0x1a, 0x33, 0xf4, 0xbf, 0x94, 0x3c, 0xb4, 0x1c, 0xd9, 0x82, 0x59,
+	0x33, 0xda, 0x50, 0x77, 0xa1, 0xee, 0x34, 0x16, 0xb6, 0xd6, 0xee, 0xca, 0x7e, 0xbc, 0xc1, 0x34,
+	0x6d, 0x7d, 0xf1, 0x1a, 0x96, 0x6f, 0x7d, 0x0f, 0xa4, 0x0a, 0x0f, 0xdb, 0x74, 0xfb, 0xe8, 0xed,
+	0xc1, 0x3e, 0xf5, 0xf7, 0x3c, 0xba, 0xbf, 0xdb, 0xf6, 0x8e, 0x8f, 0xfc, 0xe3, 0x77, 0xed, 0xa5,
+	0x1c, 0x59, 0x85, 0x07, 0x77, 0xd4, 0xbc, 0xa3, 0x25, 0xa7, 0x5a, 0xf8, 0xf4, 0xa5, 0x96, 0xdb,
+	0x39, 0x3c, 0xbb, 0xac, 0x39, 0xe7, 0x97, 0x35, 0xe7, 0xd7, 0x65, 0xcd, 0xf9, 0x7c, 0x55, 0xcb,
+	0x9d, 0x5f, 0xd5, 0x72, 0x3f, 0xae, 0x6a, 0xb9, 0xf7, 0xcd, 0xa9, 0x01, 0x06, 0x2a, 0xd8, 0xb4,
+	0x17, 0x6f, 0x4d, 0x3d, 0x5d, 0x1f, 0x6f, 0x3c, 0x5e, 0xc1, 0x9c, 0x7d, 0x79, 0x5e, 0xfe, 0x09,
+	0x00, 0x00, 0xff, 0xff, 0xe9, 0xfa, 0x80, 0x17, 0xde, 0x04, 0x00, 0x00,
+}
+
+func (m *TokenInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AccountTransferCap.Size()
+		i -= size
+		if _, err := m.AccountTransferCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.GlobalTransferCap.Size()
+		i -= size
+		if _, err := m.GlobalTransferCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Mintable {
+		i--
+		if m.Mintable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Token != nil {
+		{
+			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.QueuedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.QueuedHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.AckRelayerFee.Size()
+		i -= size
+		if _, err := m.AckRelayerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.RelayerFee.Size()
+		i -= size
+		if _, err := m.RelayerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Direction != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTypes(uint64(m.ChainId))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovTypes(uint64(m.Decimals))
+	}
+	if m.Mintable {
+		n += 2
+	}
+	l = m.GlobalTransferCap.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.AccountTransferCap.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *QueuedTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovTypes(uint64(m.Direction))
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.RelayerFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.AckRelayerFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.ChainId != 0 {
+		n += 1 + sovTypes(uint64(m.ChainId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTypes(uint64(m.Sequence))
+	}
+	if m.QueuedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.QueuedHeight))
+	}
+	if m.Token != nil {
+		l = m.Token.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TokenInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mintable = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalTransferCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalTransferCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccountTransferCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= TransferDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RelayerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckRelayerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AckRelayerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedHeight", wireType)
+			}
+			m.QueuedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Token == nil {
+				m.Token = &TokenInfo{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypes = fmt.Errorf("proto: unexpected end of group")
+)
@@ -28,10 +28,31 @@ func BeginBlocker(ctx sdk.Context, keeper k.Keeper) {
 	// delete storage provider slash amount records
 	if blockHeight > 0 && blockHeight%params.SpSlashCountingWindow == 0 {
 		keeper.ClearSpSlashAmount(ctx)
+		keeper.ClearSpSlashCount(ctx)
+	}
+
+	// delete archived challenges out of the kept period
+	keptPeriod := params.ChallengeArchiveKeptPeriod
+	if blockHeight > keptPeriod {
+		keeper.RemoveArchivedChallengeUntil(ctx, blockHeight-keptPeriod)
+	}
+
+	// delete the last passed challenges out of the risk age period
+	if params.RiskAgePeriod > 0 && blockHeight > params.RiskAgePeriod {
+		keeper.RemoveLastPassedChallengeUntil(ctx, blockHeight-params.RiskAgePeriod)
+	}
+
+	// delete challenge statistics out of the kept windows when a new window starts
+	window, keptWindows := params.SpChallengeStatsWindow, params.SpChallengeStatsKeptWindows
+	if window > 0 && keptWindows > 0 && blockHeight%window == 0 && blockHeight/window > keptWindows {
+		keeper.RemoveSpChallengeStatsUntil(ctx, blockHeight-keptWindows*window)
 	}
 }
 
 func EndBlocker(ctx sdk.Context, keeper k.Keeper) {
+	// execute the slashes whose appeal windows end
+	keeper.ProcessPendingSlashes(ctx)
+
 	count := keeper.GetChallengeCountCurrentBlock(ctx)
 
 	params := keeper.GetParams(ctx)
@@ -47,9 +68,11 @@ func EndBlocker(ctx sdk.Context, keeper k.Keeper) {
 
 	expiredHeight := params.ChallengeKeepAlivePeriod + uint64(ctx.BlockHeight())
 
-	events := make([]proto.Message, 0)                      // for events
-	objectMap := make(map[string]struct{})                  // for de-duplication
-	iteration, maxIteration := uint64(0), 10*(needed-count) // to prevent endless loop
+	sampler := keeper.GetChallengeSampler(ctx)
+
+	events := make([]proto.Message, 0)                                                   // for events
+	objectMap := make(map[string]struct{})                                               // for de-duplication
+	iteration, maxIteration := uint64(0), 10*(needed-count)*sampler.MaxIterationFactor() // to prevent endless loop
 	for count < needed && iteration < maxIteration {
 		iteration++
 		seed := k.SeedFromRandaoMix(ctx.BlockHeader().RandaoMix, iteration)
@@ -102,6 +125,11 @@ func EndBlocker(ctx sdk.Context, keeper k.Keeper) {
 			continue
 		}
 
+		// sample by the sampling mode
+		if !sampler.Sample(ctx, seed, objectInfo, gvg.Id, sp.Id) {
+			continue
+		}
+
 		// random segment/piece index
 		segmentSize, err := keeper.StorageKeeper.MaxSegmentSize(ctx, objectInfo.GetLatestUpdatedTime())
 		if err != nil {
@@ -111,6 +139,11 @@ func EndBlocker(ctx sdk.Context, keeper k.Keeper) {
 		}
 		segments := k.CalculateSegments(objectInfo.PayloadSize, segmentSize)
 		segmentIndex := k.RandomSegmentIndex(seed, segments)
+		segmentIndexes := []uint32{segmentIndex}
+		if segmentCount := params.MaxSegmentsPerChallenge(); segmentCount > 1 {
+			segmentIndexes = k.RandomSegmentIndexes(seed, segments, segmentCount)
+			segmentIndex = segmentIndexes[0]
+		}
 
 		objectMap[mapKey] = struct{}{}
 
@@ -118,6 +151,21 @@ func EndBlocker(ctx sdk.Context, keeper k.Keeper) {
 		keeper.SaveChallenge(ctx, types.Challenge{
 			Id:            challengeId,
 			ExpiredHeight: expiredHeight,
+			SpId:          sp.Id,
+			ObjectId:      objectInfo.Id,
+		})
+		keeper.ArchiveChallenge(ctx, types.ArchivedChallenge{
+			Id:              challengeId,
+			SpId:            sp.Id,
+			ObjectId:        objectInfo.Id,
+			SegmentIndex:    segmentIndex,
+			RedundancyIndex: redundancyIndex,
+			Height:          uint64(ctx.BlockHeight()),
+			SegmentIndexes:  segmentIndexes,
+		})
+		keeper.SaveChallengeSegments(ctx, challengeId, types.ChallengeSegments{
+			RedundancyIndex: redundancyIndex,
+			SegmentIndexes:  segmentIndexes,
 		})
 		events = append(events, &types.EventStartChallenge{
 			ChallengeId:       challengeId,
@@ -128,6 +176,7 @@ func EndBlocker(ctx sdk.Context, keeper k.Keeper) {
 			RedundancyIndex:   redundancyIndex,
 			ChallengerAddress: "",
 			ExpiredHeight:     expiredHeight,
+			SegmentIndexes:    segmentIndexes,
 		})
 
 		count++
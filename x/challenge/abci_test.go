@@ -29,11 +29,12 @@ type TestSuite struct {
 	cdc             codec.Codec
 	challengeKeeper *keeper.Keeper
 
-	bankKeeper    *types.MockBankKeeper
-	storageKeeper *types.MockStorageKeeper
-	spKeeper      *types.MockSpKeeper
-	stakingKeeper *types.MockStakingKeeper
-	paymentKeeper *types.MockPaymentKeeper
+	bankKeeper         *types.MockBankKeeper
+	storageKeeper      *types.MockStorageKeeper
+	spKeeper           *types.MockSpKeeper
+	stakingKeeper      *types.MockStakingKeeper
+	paymentKeeper      *types.MockPaymentKeeper
+	virtualGroupKeeper *types.MockVirtualGroupKeeper
 
 	ctx         sdk.Context
 	queryClient types.QueryClient
@@ -65,6 +66,7 @@ func (s *TestSuite) SetupTest() {
 	spKeeper := types.NewMockSpKeeper(ctrl)
 	stakingKeeper := types.NewMockStakingKeeper(ctrl)
 	paymentKeeper := types.NewMockPaymentKeeper(ctrl)
+	virtualGroupKeeper := types.NewMockVirtualGroupKeeper(ctrl)
 
 	s.challengeKeeper = keeper.NewKeeper(
 		encCfg.Codec,
@@ -75,6 +77,7 @@ func (s *TestSuite) SetupTest() {
 		spKeeper,
 		stakingKeeper,
 		paymentKeeper,
+		virtualGroupKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -84,6 +87,7 @@ func (s *TestSuite) SetupTest() {
 	s.spKeeper = spKeeper
 	s.stakingKeeper = stakingKeeper
 	s.paymentKeeper = paymentKeeper
+	s.virtualGroupKeeper = virtualGroupKeeper
 
 	err := s.challengeKeeper.SetParams(s.ctx, types.DefaultParams())
 	s.Require().NoError(err)
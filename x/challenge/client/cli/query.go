@@ -26,6 +26,9 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdLatestAttestedChallenges())
 	cmd.AddCommand(CmdAttestedChallenge())
 	cmd.AddCommand(CmdInturnChallenger())
+	cmd.AddCommand(CmdChallengesBySp())
+	cmd.AddCommand(CmdChallengesByObject())
+	cmd.AddCommand(CmdSpChallengeStats())
 
 	return cmd
 }
@@ -145,3 +148,127 @@ func CmdInturnChallenger() *cobra.Command {
 
 	return cmd
 }
+
+func CmdChallengesBySp() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "challenges-by-sp [sp-id]",
+		Short: "Query the archived challenges of a storage provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSpId, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("sp-id %s not a valid uint, please input a valid sp-id", args[0])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryChallengesBySpRequest{
+				SpId:       uint32(argSpId),
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ChallengesBySp(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdChallengesByObject() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "challenges-by-object [object-id]",
+		Short: "Query the archived challenges of an object",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryChallengesByObjectRequest{
+				ObjectId:   args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ChallengesByObject(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdSpChallengeStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sp-challenge-stats [sp-id]",
+		Short: "Query the challenge statistics of a storage provider in the kept windows",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSpId, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("sp-id %s not a valid uint, please input a valid sp-id", args[0])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySpChallengeStatsRequest{
+				SpId:       uint32(argSpId),
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.SpChallengeStats(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
@@ -31,6 +31,7 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdSubmit())
 	cmd.AddCommand(CmdAttest())
+	cmd.AddCommand(CmdAppealChallenge())
 
 	return cmd
 }
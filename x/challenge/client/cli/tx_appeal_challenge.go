@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+)
+
+func CmdAppealChallenge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "appeal [challenge-id] [piece-proofs-file]",
+		Short: "Broadcast message appeal challenge, piece-proofs-file is a json file of the proofs of all challenged pieces",
+		Long: `Broadcast message appeal challenge with the proofs of all challenged pieces in a json file, e.g.
+[{"object_id": "1", "segment_index": 2, "piece_hash": "<base64 encoded hash of piece>", "proof": ["<base64 encoded hash of other piece>", ...]}]`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChallengeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("challenge-id %s not a valid uint, please input a valid challenge-id", args[0])
+			}
+
+			contents, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			var argPieceProofs []*types.PieceProof
+			if err := json.Unmarshal(contents, &argPieceProofs); err != nil {
+				return fmt.Errorf("piece-proofs-file %s not a valid json file of piece proofs, please input a valid piece-proofs-file", args[1])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAppealChallenge(
+				clientCtx.GetFromAddress(),
+				argChallengeId,
+				argPieceProofs,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -17,6 +17,11 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const (
+	FlagSegmentCount      = "segment-count"
+	FlagAdditionalObjects = "additional-objects"
+)
+
 func CmdSubmit() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "submit [sp-operator-address] [bucket-name] [object-name] [random-index] [segment-index]",
@@ -64,6 +69,24 @@ func CmdSubmit() *cobra.Command {
 				argRandomIndex,
 				uint32(argSegmentIndex),
 			)
+			msg.SegmentCount, err = cmd.Flags().GetUint32(FlagSegmentCount)
+			if err != nil {
+				return err
+			}
+			additionalObjects, err := cmd.Flags().GetStringSlice(FlagAdditionalObjects)
+			if err != nil {
+				return err
+			}
+			for _, object := range additionalObjects {
+				bucketName, objectName, ok := strings.Cut(strings.TrimSpace(object), "/")
+				if !ok {
+					return fmt.Errorf("additional object %s not in the format of bucket-name/object-name", object)
+				}
+				msg.AdditionalObjects = append(msg.AdditionalObjects, &types.ObjectToChallenge{
+					BucketName: bucketName,
+					ObjectName: objectName,
+				})
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -71,6 +94,8 @@ func CmdSubmit() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint32(FlagSegmentCount, 0, "The number of random segments/pieces to challenge under the challenge, the segment index is ignored if it is more than one")
+	cmd.Flags().StringSlice(FlagAdditionalObjects, nil, "The other objects stored on the same sp to challenge under the challenge, in the format of bucket-name/object-name")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+)
+
+// SaveChallengeSegments saves the challenged segments of an ongoing challenge, which are checked when the challenge is
+// attested, and are needed to appeal the slash of the challenge.
+func (k Keeper) SaveChallengeSegments(ctx sdk.Context, challengeId uint64, segments types.ChallengeSegments) {
+	ctx.KVStore(k.storeKey).Set(types.GetChallengeSegmentsKey(challengeId), k.cdc.MustMarshal(&segments))
+}
+
+// GetChallengeSegments gets the challenged segments of an ongoing challenge
+func (k Keeper) GetChallengeSegments(ctx sdk.Context, challengeId uint64) (types.ChallengeSegments, bool) {
+	var segments types.ChallengeSegments
+	bz := ctx.KVStore(k.storeKey).Get(types.GetChallengeSegmentsKey(challengeId))
+	if bz == nil {
+		return segments, false
+	}
+	k.cdc.MustUnmarshal(bz, &segments)
+	return segments, true
+}
+
+// SetPendingSlash sets a pending slash and puts it into the queue ordered by the execute height
+func (k Keeper) SetPendingSlash(ctx sdk.Context, pendingSlash types.PendingSlash) {
+	ctx.KVStore(k.storeKey).Set(types.GetPendingSlashKey(pendingSlash.ChallengeId), k.cdc.MustMarshal(&pendingSlash))
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSlashQueuePrefix)
+	queueStore.Set(types.GetPendingSlashQueueKey(pendingSlash.ExecuteHeight, pendingSlash.ChallengeId), []byte{})
+}
+
+// GetPendingSlash gets the pending slash of a challenge
+func (k Keeper) GetPendingSlash(ctx sdk.Context, challengeId uint64) (types.PendingSlash, bool) {
+	var pendingSlash types.PendingSlash
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPendingSlashKey(challengeId))
+	if bz == nil {
+		return pendingSlash, false
+	}
+	k.cdc.MustUnmarshal(bz, &pendingSlash)
+	return pendingSlash, true
+}
+
+func (k Keeper) deletePendingSlash(ctx sdk.Context, pendingSlash types.PendingSlash) {
+	ctx.KVStore(k.storeKey).Delete(types.GetPendingSlashKey(pendingSlash.ChallengeId))
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSlashQueuePrefix)
+	queueStore.Delete(types.GetPendingSlashQueueKey(pendingSlash.ExecuteHeight, pendingSlash.ChallengeId))
+}
+
+// ProcessPendingSlashes executes the pending slashes whose appeal windows end
+func (k Keeper) ProcessPendingSlashes(ctx sdk.Context) {
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSlashQueuePrefix)
+
+	var challengeIds []uint64
+	iterator := queueStore.Iterator(nil, types.GetPendingSlashQueueKey(uint64(ctx.BlockHeight())+1, 0))
+	for ; iterator.Valid(); iterator.Next() {
+		challengeIds = append(challengeIds, binary.BigEndian.Uint64(iterator.Key()[8:]))
+	}
+	iterator.Close()
+
+	for _, challengeId := range challengeIds {
+		pendingSlash, found := k.GetPendingSlash(ctx, challengeId)
+		if !found {
+			continue
+		}
+		k.deletePendingSlash(ctx, pendingSlash)
+		// the challenge is not appealed in the window, so it is counted as succeed
+		k.countSpChallenge(ctx, pendingSlash.SpId, types.CHALLENGE_SUCCEED)
+
+		challenger := sdk.AccAddress{}
+		if pendingSlash.Challenger != "" {
+			challenger = sdk.MustAccAddressFromHex(pendingSlash.Challenger)
+		}
+		// a failed slash, e.g. the sp has not enough deposit, should not block the others
+		cacheCtx, write := ctx.CacheContext()
+		err := k.doSlashAndRewards(cacheCtx, challengeId, types.CHALLENGE_SUCCEED, pendingSlash.SlashAmount, pendingSlash.SpId,
+			sdk.MustAccAddressFromHex(pendingSlash.Submitter), challenger, pendingSlash.Validators)
+		if err != nil {
+			ctx.Logger().Error("fail to execute pending slash", "challenge", challengeId, "sp", pendingSlash.SpId, "err", err)
+			continue
+		}
+		write()
+		k.SpKeeper.RecordSpChallengeResult(ctx, pendingSlash.SpId, true)
+		k.jailSpIfRepeatedOffender(ctx, pendingSlash.SpId)
+	}
+}
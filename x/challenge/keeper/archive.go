@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+)
+
+// ArchiveChallenge archives a new challenge and indexes it by the sp and the objects, if the archive is enabled
+func (k Keeper) ArchiveChallenge(ctx sdk.Context, challenge types.ArchivedChallenge) {
+	if k.GetParams(ctx).ChallengeArchiveKeptPeriod == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetArchivedChallengeKey(challenge.Id), k.cdc.MustMarshal(&challenge))
+	store.Set(types.GetChallengeBySpKey(challenge.SpId, challenge.Id), []byte{})
+	store.Set(types.GetChallengeByObjectKey(challenge.ObjectId, challenge.Id), []byte{})
+	for _, object := range challenge.AdditionalObjects {
+		store.Set(types.GetChallengeByObjectKey(object.ObjectId, challenge.Id), []byte{})
+	}
+}
+
+// GetArchivedChallenge gets an archived challenge by the challenge id
+func (k Keeper) GetArchivedChallenge(ctx sdk.Context, challengeId uint64) (types.ArchivedChallenge, bool) {
+	var challenge types.ArchivedChallenge
+	bz := ctx.KVStore(k.storeKey).Get(types.GetArchivedChallengeKey(challengeId))
+	if bz == nil {
+		return challenge, false
+	}
+	k.cdc.MustUnmarshal(bz, &challenge)
+	return challenge, true
+}
+
+// RecordChallengeAttestation records the attestation result to the archived challenge and the challenge statistics of the sp
+func (k Keeper) RecordChallengeAttestation(ctx sdk.Context, challengeId uint64, spId uint32, result types.VoteResult) {
+	k.archiveChallengeResult(ctx, challengeId, result)
+	k.countSpChallenge(ctx, spId, result)
+}
+
+// archiveChallengeResult records the result to the archived challenge, the attested height is kept when the result of
+// an attested challenge is changed by an appeal
+func (k Keeper) archiveChallengeResult(ctx sdk.Context, challengeId uint64, result types.VoteResult) {
+	challenge, found := k.GetArchivedChallenge(ctx, challengeId)
+	if !found {
+		return
+	}
+	if !challenge.Attested {
+		challenge.Attested = true
+		challenge.AttestedHeight = uint64(ctx.BlockHeight())
+	}
+	challenge.Result = result
+	ctx.KVStore(k.storeKey).Set(types.GetArchivedChallengeKey(challengeId), k.cdc.MustMarshal(&challenge))
+}
+
+// countSpChallenge counts an attested or expired challenge in the current statistics window of the sp
+func (k Keeper) countSpChallenge(ctx sdk.Context, spId uint32, result types.VoteResult) {
+	window := k.GetParams(ctx).SpChallengeStatsWindow
+	if window == 0 {
+		return
+	}
+	blockHeight := uint64(ctx.BlockHeight())
+	stats := k.GetSpChallengeStats(ctx, spId, blockHeight-blockHeight%window)
+	if result == types.CHALLENGE_SUCCEED {
+		stats.SucceedCount++
+	} else {
+		stats.FailedCount++
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSpChallengeStatsKey(spId, stats.WindowStartHeight), k.cdc.MustMarshal(&stats))
+	windowStore := prefix.NewStore(store, types.SpChallengeStatsByWindowPrefix)
+	windowStore.Set(types.GetSpChallengeStatsByWindowKey(stats.WindowStartHeight, spId), []byte{})
+}
+
+// GetSpChallengeStats gets the challenge statistics of a sp in the window starting at the height
+func (k Keeper) GetSpChallengeStats(ctx sdk.Context, spId uint32, windowStartHeight uint64) types.SpChallengeStats {
+	stats := types.SpChallengeStats{SpId: spId, WindowStartHeight: windowStartHeight}
+	bz := ctx.KVStore(k.storeKey).Get(types.GetSpChallengeStatsKey(spId, windowStartHeight))
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &stats)
+	}
+	return stats
+}
+
+// RemoveArchivedChallengeUntil removes the archived challenges which are created at or before the height
+func (k Keeper) RemoveArchivedChallengeUntil(ctx sdk.Context, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	archiveStore := prefix.NewStore(store, types.ArchivedChallengePrefix)
+	iterator := storetypes.KVStorePrefixIterator(archiveStore, []byte{})
+	defer iterator.Close()
+
+	// the challenge ids increase with the heights, so the iteration stops at the first challenge to keep
+	for ; iterator.Valid(); iterator.Next() {
+		var challenge types.ArchivedChallenge
+		k.cdc.MustUnmarshal(iterator.Value(), &challenge)
+		if challenge.Height > height {
+			break
+		}
+		archiveStore.Delete(iterator.Key())
+		store.Delete(types.GetChallengeBySpKey(challenge.SpId, challenge.Id))
+		store.Delete(types.GetChallengeByObjectKey(challenge.ObjectId, challenge.Id))
+		for _, object := range challenge.AdditionalObjects {
+			store.Delete(types.GetChallengeByObjectKey(object.ObjectId, challenge.Id))
+		}
+	}
+}
+
+// RemoveSpChallengeStatsUntil removes the challenge statistics of the windows which end at or before the height
+func (k Keeper) RemoveSpChallengeStatsUntil(ctx sdk.Context, height uint64) {
+	window := k.GetParams(ctx).SpChallengeStatsWindow
+	if window == 0 || height < window {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	windowStore := prefix.NewStore(store, types.SpChallengeStatsByWindowPrefix)
+	// the windows starting at or before height-window end at or before the height
+	iterator := windowStore.Iterator(nil, types.GetSpChallengeStatsByWindowKey(height-window+1, 0))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		windowStartHeight := binary.BigEndian.Uint64(iterator.Key())
+		spId := binary.BigEndian.Uint32(iterator.Key()[8:])
+		store.Delete(types.GetSpChallengeStatsKey(spId, windowStartHeight))
+		windowStore.Delete(iterator.Key())
+	}
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+)
+
+func TestArchivedChallenges(t *testing.T) {
+	keeper, ctx := makeKeeper(t)
+
+	// the challenges are not archived by default
+	keeper.ArchiveChallenge(ctx, types.ArchivedChallenge{Id: 1, SpId: 1, ObjectId: math.NewUint(1), Height: 1})
+	_, found := keeper.GetArchivedChallenge(ctx, 1)
+	require.False(t, found)
+
+	params := types.DefaultParams()
+	params.ChallengeArchiveKeptPeriod = 100
+	params.SpChallengeStatsWindow = 10
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	keeper.ArchiveChallenge(ctx, types.ArchivedChallenge{Id: 2, SpId: 1, ObjectId: math.NewUint(1), Height: 5})
+	keeper.ArchiveChallenge(ctx, types.ArchivedChallenge{Id: 3, SpId: 2, ObjectId: math.NewUint(1), Height: 8})
+	keeper.ArchiveChallenge(ctx, types.ArchivedChallenge{Id: 4, SpId: 1, ObjectId: math.NewUint(2), Height: 12})
+
+	res, err := keeper.ChallengesBySp(ctx, &types.QueryChallengesBySpRequest{SpId: 1})
+	require.NoError(t, err)
+	require.Len(t, res.Challenges, 2)
+	require.Equal(t, uint64(2), res.Challenges[0].Id)
+	require.Equal(t, uint64(4), res.Challenges[1].Id)
+
+	objectRes, err := keeper.ChallengesByObject(ctx, &types.QueryChallengesByObjectRequest{
+		ObjectId:   "1",
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, objectRes.Challenges, 1)
+	require.Equal(t, uint64(3), objectRes.Challenges[0].Id)
+
+	_, err = keeper.ChallengesByObject(ctx, &types.QueryChallengesByObjectRequest{
+		ObjectId:   "1",
+		Pagination: &query.PageRequest{Limit: types.MaxPaginationLimit + 1},
+	})
+	require.Error(t, err)
+
+	ctx = ctx.WithBlockHeight(15)
+	keeper.RecordChallengeAttestation(ctx, 4, 1, types.CHALLENGE_SUCCEED)
+	keeper.RecordChallengeAttestation(ctx, 2, 1, types.CHALLENGE_FAILED)
+	ctx = ctx.WithBlockHeight(21)
+	keeper.RecordChallengeAttestation(ctx, 100, 1, types.CHALLENGE_FAILED)
+
+	// the expired challenges are counted as passed, while the attested ones are removed and not counted again
+	keeper.SaveChallenge(ctx, types.Challenge{Id: 101, ExpiredHeight: 22, SpId: 1, ObjectId: math.NewUint(1)})
+	keeper.SaveChallenge(ctx, types.Challenge{Id: 102, ExpiredHeight: 22, SpId: 2, ObjectId: math.NewUint(1)})
+	keeper.SaveChallenge(ctx, types.Challenge{Id: 103, ExpiredHeight: 22, SpId: 1, ObjectId: math.NewUint(1)})
+	keeper.RemoveChallenge(ctx, 103)
+	require.False(t, keeper.ExistsChallenge(ctx, 103))
+	keeper.RemoveChallengeUntil(ctx, 22)
+	require.False(t, keeper.ExistsChallenge(ctx, 101))
+	require.Equal(t, uint64(1), keeper.GetSpChallengeStats(ctx, 2, 20).FailedCount)
+
+	challenge, found := keeper.GetArchivedChallenge(ctx, 4)
+	require.True(t, found)
+	require.True(t, challenge.Attested)
+	require.Equal(t, types.CHALLENGE_SUCCEED, challenge.Result)
+	require.Equal(t, uint64(15), challenge.AttestedHeight)
+
+	statsRes, err := keeper.SpChallengeStats(ctx, &types.QuerySpChallengeStatsRequest{SpId: 1})
+	require.NoError(t, err)
+	require.Equal(t, []*types.SpChallengeStats{
+		{SpId: 1, WindowStartHeight: 10, SucceedCount: 1, FailedCount: 1},
+		{SpId: 1, WindowStartHeight: 20, FailedCount: 2},
+	}, statsRes.Stats)
+
+	// prune the challenges created at or before height 8 and the stats windows ended at or before height 20
+	keeper.RemoveArchivedChallengeUntil(ctx, 8)
+	keeper.RemoveSpChallengeStatsUntil(ctx, 20)
+
+	_, found = keeper.GetArchivedChallenge(ctx, 3)
+	require.False(t, found)
+	_, found = keeper.GetArchivedChallenge(ctx, 4)
+	require.True(t, found)
+	objectRes, err = keeper.ChallengesByObject(ctx, &types.QueryChallengesByObjectRequest{ObjectId: "1"})
+	require.NoError(t, err)
+	require.Len(t, objectRes.Challenges, 0)
+
+	statsRes, err = keeper.SpChallengeStats(ctx, &types.QuerySpChallengeStatsRequest{SpId: 1})
+	require.NoError(t, err)
+	require.Len(t, statsRes.Stats, 1)
+	require.Equal(t, uint64(20), statsRes.Stats[0].WindowStartHeight)
+
+	keeper.RemoveSpChallengeStatsUntil(ctx, 30)
+	statsRes, err = keeper.SpChallengeStats(ctx, &types.QuerySpChallengeStatsRequest{SpId: 1})
+	require.NoError(t, err)
+	require.Len(t, statsRes.Stats, 0)
+	statsRes, err = keeper.SpChallengeStats(ctx, &types.QuerySpChallengeStatsRequest{SpId: 2})
+	require.NoError(t, err)
+	require.Len(t, statsRes.Stats, 0)
+}
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"encoding/binary"
+	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -36,24 +38,71 @@ func (k Keeper) SaveChallenge(ctx sdk.Context, challenge types.Challenge) {
 
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChallengeKeyPrefix)
 
-	heightBytes := make([]byte, 8)
-	binary.BigEndian.PutUint64(heightBytes, challenge.ExpiredHeight)
+	// the challenges saved before the sp and the object are introduced only have the expired height
+	bz := make([]byte, 8, 12)
+	binary.BigEndian.PutUint64(bz, challenge.ExpiredHeight)
+	if !challenge.ObjectId.IsNil() {
+		bz = binary.BigEndian.AppendUint32(bz, challenge.SpId)
+		bz = append(bz, challenge.ObjectId.Bytes()...)
+	}
 
-	store.Set(getChallengeKeyBytes(challenge.Id), heightBytes)
+	store.Set(getChallengeKeyBytes(challenge.Id), bz)
 }
 
-// RemoveChallengeUntil removes challenges which are expired
+// RemoveChallengeUntil removes challenges which are expired, the expired challenges are counted as passed by the sp
 func (k Keeper) RemoveChallengeUntil(ctx sdk.Context, height uint64) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChallengeKeyPrefix)
 	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		expiredHeight := binary.BigEndian.Uint64(iterator.Value())
-		if expiredHeight <= height {
-			store.Delete(iterator.Key())
+		challenge := decodeChallenge(binary.BigEndian.Uint64(iterator.Key()), iterator.Value())
+		if challenge.ExpiredHeight > height {
+			continue
+		}
+		if !challenge.ObjectId.IsNil() {
+			k.countSpChallenge(ctx, challenge.SpId, types.CHALLENGE_FAILED)
+			k.setLastPassedChallengeHeight(ctx, challenge.ObjectId, challenge.SpId)
+			if segments, found := k.GetChallengeSegments(ctx, challenge.Id); found {
+				for _, object := range segments.AdditionalObjects {
+					k.setLastPassedChallengeHeight(ctx, object.ObjectId, challenge.SpId)
+				}
+			}
 		}
+		store.Delete(iterator.Key())
+		ctx.KVStore(k.storeKey).Delete(types.GetChallengeSegmentsKey(challenge.Id))
+	}
+}
+
+// GetChallenge gets an ongoing challenge, the sp and the object are not recorded for the challenges created before
+// they are introduced, whose object id is nil
+func (k Keeper) GetChallenge(ctx sdk.Context, challengeId uint64) (types.Challenge, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChallengeKeyPrefix)
+	bz := store.Get(getChallengeKeyBytes(challengeId))
+	if bz == nil {
+		return types.Challenge{}, false
 	}
+	return decodeChallenge(challengeId, bz), true
+}
+
+// decodeChallenge decodes a challenge from the expired height, the sp id and the object id in the stored value
+func decodeChallenge(challengeId uint64, bz []byte) types.Challenge {
+	challenge := types.Challenge{
+		Id:            challengeId,
+		ExpiredHeight: binary.BigEndian.Uint64(bz),
+	}
+	if len(bz) >= 12 {
+		challenge.SpId = binary.BigEndian.Uint32(bz[8:])
+		challenge.ObjectId = sdkmath.NewUintFromBigInt(new(big.Int).SetBytes(bz[12:]))
+	}
+	return challenge
+}
+
+// RemoveChallenge removes an attested challenge, so that it is neither attested again nor counted when it expires
+func (k Keeper) RemoveChallenge(ctx sdk.Context, challengeId uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChallengeKeyPrefix)
+	store.Delete(getChallengeKeyBytes(challengeId))
+	ctx.KVStore(k.storeKey).Delete(types.GetChallengeSegmentsKey(challengeId))
 }
 
 // ExistsChallenge check whether there exists ongoing challenge for an id
@@ -2,9 +2,13 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/hex"
 
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -79,3 +83,89 @@ func (k Keeper) InturnAttestationSubmitter(goCtx context.Context, req *types.Que
 	}, nil
 
 }
+
+func (k Keeper) ChallengesBySp(goCtx context.Context, req *types.QueryChallengesBySpRequest) (*types.QueryChallengesBySpResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Pagination != nil && req.Pagination.Limit > types.MaxPaginationLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "exceed pagination limit %d", types.MaxPaginationLimit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	challenges, pageRes, err := k.paginateArchivedChallenges(ctx, types.GetChallengesBySpPrefix(req.SpId), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryChallengesBySpResponse{Challenges: challenges, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ChallengesByObject(goCtx context.Context, req *types.QueryChallengesByObjectRequest) (*types.QueryChallengesByObjectResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Pagination != nil && req.Pagination.Limit > types.MaxPaginationLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "exceed pagination limit %d", types.MaxPaginationLimit)
+	}
+	objectId, err := sdkmath.ParseUint(req.ObjectId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid object id: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	challenges, pageRes, err := k.paginateArchivedChallenges(ctx, types.GetChallengesByObjectPrefix(objectId), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryChallengesByObjectResponse{Challenges: challenges, Pagination: pageRes}, nil
+}
+
+// paginateArchivedChallenges paginates the archived challenges of an index, the keys of which end with the challenge ids
+func (k Keeper) paginateArchivedChallenges(ctx sdk.Context, indexPrefix []byte, pagination *query.PageRequest) ([]*types.ArchivedChallenge, *query.PageResponse, error) {
+	if err := query.CheckOffsetQueryNotAllowed(ctx, pagination); err != nil {
+		return nil, nil, err
+	}
+
+	var challenges []*types.ArchivedChallenge
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+	pageRes, err := query.Paginate(indexStore, pagination, func(key, _ []byte) error {
+		challenge, found := k.GetArchivedChallenge(ctx, binary.BigEndian.Uint64(key))
+		if found {
+			challenges = append(challenges, &challenge)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	return challenges, pageRes, nil
+}
+
+func (k Keeper) SpChallengeStats(goCtx context.Context, req *types.QuerySpChallengeStatsRequest) (*types.QuerySpChallengeStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Pagination != nil && req.Pagination.Limit > types.MaxPaginationLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "exceed pagination limit %d", types.MaxPaginationLimit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := query.CheckOffsetQueryNotAllowed(ctx, req.Pagination); err != nil {
+		return nil, err
+	}
+
+	var stats []*types.SpChallengeStats
+	statsStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSpChallengeStatsPrefix(req.SpId))
+	pageRes, err := query.Paginate(statsStore, req.Pagination, func(_, value []byte) error {
+		var s types.SpChallengeStats
+		k.cdc.MustUnmarshal(value, &s)
+		stats = append(stats, &s)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySpChallengeStatsResponse{Stats: stats, Pagination: pageRes}, nil
+}
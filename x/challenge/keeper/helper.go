@@ -3,6 +3,7 @@ package keeper
 import (
 	"encoding/binary"
 	"math/big"
+	"sort"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -59,6 +60,40 @@ func RandomSegmentIndex(seed []byte, segments uint64) uint32 {
 	return uint32(index.Uint64())
 }
 
+// RandomSegmentIndexes generates the distinct random segment indexes for a challenge of multiple segments, in ascending order.
+// All the segments are challenged if the count is not less than the number of segments.
+func RandomSegmentIndexes(seed []byte, segments uint64, count uint32) []uint32 {
+	if uint64(count) >= segments {
+		indexes := make([]uint32, segments)
+		for i := range indexes {
+			indexes[i] = uint32(i)
+		}
+		return indexes
+	}
+
+	picked := make(map[uint32]struct{}, count)
+	for i := uint32(0); i < count; i++ {
+		iBytes := make([]byte, 4)
+		binary.BigEndian.PutUint32(iBytes, i)
+		index := RandomSegmentIndex(sdk.Keccak256(seed, iBytes), segments)
+		// probe the next segment if the segment is picked already
+		for {
+			if _, ok := picked[index]; !ok {
+				break
+			}
+			index = uint32((uint64(index) + 1) % segments)
+		}
+		picked[index] = struct{}{}
+	}
+
+	indexes := make([]uint32, 0, count)
+	for index := range picked {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+	return indexes
+}
+
 // RandomRedundancyIndex generates a random redundancy index (storage provider) for challenge.
 // Be noted: RedundancyIndex starts from -1 (the primary sp).
 func RandomRedundancyIndex(seed []byte, sps uint64) int32 {
@@ -67,3 +102,29 @@ func RandomRedundancyIndex(seed []byte, sps uint64) int32 {
 	index := new(big.Int).Mod(number, big.NewInt(int64(sps)))
 	return int32(index.Uint64()) - 1
 }
+
+const (
+	// RiskWeightBase is the weight of an object without any risk in basis points
+	RiskWeightBase = uint64(10000)
+	// RiskWeightMax is the max weight of an object, i.e., the base weight plus the full weights of all the risk factors
+	RiskWeightMax = 5 * RiskWeightBase
+)
+
+// RiskFactor calculates a risk factor in basis points, which grows linearly with the value until the value reaches the reference.
+func RiskFactor(value, reference sdkmath.Int) uint64 {
+	if !reference.IsPositive() || value.GTE(reference) {
+		return RiskWeightBase
+	}
+	if !value.IsPositive() {
+		return 0
+	}
+	return value.MulRaw(int64(RiskWeightBase)).Quo(reference).Uint64()
+}
+
+// AcceptByWeight decides whether a sampled candidate with the weight in basis points is accepted, the candidate is
+// accepted with the probability of weight/RiskWeightMax. It is deterministic for the seed.
+func AcceptByWeight(seed []byte, weight uint64) bool {
+	number := new(big.Int).SetBytes(sdk.Keccak256(seed, []byte("weight")))
+	roll := new(big.Int).Mod(number, new(big.Int).SetUint64(RiskWeightMax)).Uint64()
+	return roll < weight
+}
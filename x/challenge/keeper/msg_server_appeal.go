@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"golang.org/x/exp/slices"
+
+	"github.com/bnb-chain/greenfield/types/integrity"
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+)
+
+// AppealChallenge handles the appeal of a storage provider against the pending slash of a succeed challenge.
+// The storage provider proves the integrity of the challenged pieces by providing the hashes of all the challenged pieces,
+// with the hashes of the other pieces of its replica, which should hash to the integrity hash of the object.
+// The pending slash is canceled and the challenge is counted as failed if the appeal succeeds.
+func (k msgServer) AppealChallenge(goCtx context.Context, msg *types.MsgAppealChallenge) (*types.MsgAppealChallengeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	spOperator := sdk.MustAccAddressFromHex(msg.SpOperatorAddress)
+	sp, found := k.SpKeeper.GetStorageProviderByOperatorAddr(ctx, spOperator)
+	if !found {
+		return nil, errors.Wrapf(types.ErrUnknownSp, "cannot find sp with operator address: %s", msg.SpOperatorAddress)
+	}
+
+	pendingSlash, found := k.GetPendingSlash(ctx, msg.ChallengeId)
+	if !found || pendingSlash.SpId != sp.Id {
+		return nil, errors.Wrapf(types.ErrNoPendingSlash, "no pending slash of challenge %d for sp %d", msg.ChallengeId, sp.Id)
+	}
+
+	challengedObjects := append([]*types.ChallengedObject{{
+		ObjectId:        pendingSlash.ObjectId,
+		RedundancyIndex: pendingSlash.RedundancyIndex,
+		SegmentIndexes:  pendingSlash.SegmentIndexes,
+	}}, pendingSlash.AdditionalObjects...)
+	for _, object := range challengedObjects {
+		if err := k.verifyChallengedPieces(ctx, object, msg.PieceProofs); err != nil {
+			return nil, err
+		}
+	}
+
+	k.deletePendingSlash(ctx, pendingSlash)
+	for _, object := range challengedObjects {
+		k.deleteSlash(ctx, pendingSlash.SpId, object.ObjectId)
+		k.setLastPassedChallengeHeight(ctx, object.ObjectId, sp.Id)
+	}
+	slashedAmount := k.GetSpSlashAmount(ctx, sp.Id).Sub(pendingSlash.SlashAmount)
+	if slashedAmount.IsNegative() { // the slash amount could be cleared at the end of the counting window
+		slashedAmount = sdk.ZeroInt()
+	}
+	k.SetSpSlashAmount(ctx, sp.Id, slashedAmount)
+	if slashCount := k.GetSpSlashCount(ctx, sp.Id); slashCount > 0 {
+		k.SetSpSlashCount(ctx, sp.Id, slashCount-1)
+	}
+	k.RecordChallengeAttestation(ctx, msg.ChallengeId, sp.Id, types.CHALLENGE_FAILED)
+	k.SpKeeper.RecordSpChallengeResult(ctx, sp.Id, false)
+
+	if err := ctx.EventManager().EmitTypedEvents(&types.EventAppealChallenge{
+		ChallengeId: msg.ChallengeId,
+		SpId:        sp.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAppealChallengeResponse{}, nil
+}
+
+// verifyChallengedPieces verifies the proofs of all the challenged pieces of an object against the integrity hash of
+// the challenged storage provider.
+func (k msgServer) verifyChallengedPieces(ctx sdk.Context, object *types.ChallengedObject, pieceProofs []*types.PieceProof) error {
+	objectInfo, found := k.StorageKeeper.GetObjectInfoById(ctx, object.ObjectId)
+	if !found {
+		return types.ErrUnknownBucketObject
+	}
+
+	// the checksum of the primary sp is the first one, then the ones of the secondary sps
+	checksumIndex := int(object.RedundancyIndex) + 1
+	if checksumIndex < 0 || checksumIndex >= len(objectInfo.Checksums) {
+		return errors.Wrapf(types.ErrInvalidAppeal, "no checksum for redundancy index %d of object %s", object.RedundancyIndex, object.ObjectId)
+	}
+	for _, segmentIndex := range object.SegmentIndexes {
+		index := slices.IndexFunc(pieceProofs, func(pieceProof *types.PieceProof) bool {
+			return pieceProof.ObjectId.Equal(object.ObjectId) && pieceProof.SegmentIndex == segmentIndex
+		})
+		if index < 0 {
+			return errors.Wrapf(types.ErrInvalidAppeal, "the challenged piece %d of object %s is not provided", segmentIndex, object.ObjectId)
+		}
+		pieceProof := pieceProofs[index]
+		if err := integrity.VerifyPieceHashProof(pieceProof.PieceHash, segmentIndex, pieceProof.Proof, objectInfo.Checksums[checksumIndex]); err != nil {
+			return errors.Wrapf(types.ErrInvalidAppeal, "the challenged piece %d of object %s is invalid: %s", segmentIndex, object.ObjectId, err)
+		}
+	}
+	return nil
+}
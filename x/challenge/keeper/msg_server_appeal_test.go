@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+
+	"cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/types/integrity"
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func (s *TestSuite) TestAppealChallenge() {
+	params := types.DefaultParams()
+	params.AppealWindow = 100
+	params.SpChallengeStatsWindow = 1000
+	s.Require().NoError(s.challengeKeeper.SetParams(s.ctx, params))
+
+	sp := &sptypes.StorageProvider{Id: 1, OperatorAddress: sample.RandAccAddressHex()}
+	s.spKeeper.EXPECT().GetStorageProviderByOperatorAddr(gomock.Any(), gomock.Any()).
+		Return(sp, true).AnyTimes()
+	s.spKeeper.EXPECT().RecordSpChallengeResult(gomock.Any(), gomock.Eq(sp.Id), false).Times(1)
+
+	pieces := [][]byte{{1}, {2}, {3}}
+	pieceHashes := make([][]byte, 0, len(pieces))
+	for _, piece := range pieces {
+		pieceHashes = append(pieceHashes, integrity.ComputePieceHash(piece))
+	}
+	// the object 10 is challenged on the secondary sp, and the object 11 on the primary sp
+	for _, objectInfo := range []*storagetypes.ObjectInfo{
+		{Id: math.NewUint(10), Checksums: [][]byte{sha256.New().Sum(nil), storagetypes.GenerateHash(pieceHashes)}},
+		{Id: math.NewUint(11), Checksums: [][]byte{storagetypes.GenerateHash(pieceHashes)}},
+	} {
+		s.storageKeeper.EXPECT().GetObjectInfoById(gomock.Any(), gomock.Eq(objectInfo.Id)).
+			Return(objectInfo, true).AnyTimes()
+	}
+	pieceProof := func(objectId uint64, index uint32, pieceHash []byte) *types.PieceProof {
+		proof := make([][]byte, 0, len(pieceHashes)-1)
+		proof = append(proof, pieceHashes[:index]...)
+		proof = append(proof, pieceHashes[index+1:]...)
+		return &types.PieceProof{ObjectId: math.NewUint(objectId), SegmentIndex: index, PieceHash: pieceHash, Proof: proof}
+	}
+
+	s.challengeKeeper.SetPendingSlash(s.ctx, types.PendingSlash{
+		ChallengeId:     1,
+		SpId:            sp.Id,
+		ObjectId:        math.NewUint(10),
+		SlashAmount:     math.NewInt(100),
+		Submitter:       sample.RandAccAddressHex(),
+		RedundancyIndex: 0,
+		SegmentIndexes:  []uint32{2},
+		ExecuteHeight:   100,
+		AdditionalObjects: []*types.ChallengedObject{{
+			ObjectId:        math.NewUint(11),
+			RedundancyIndex: types.RedundancyIndexPrimary,
+			SegmentIndexes:  []uint32{0, 1},
+		}},
+	})
+	for _, objectId := range []uint64{10, 11} {
+		s.challengeKeeper.SaveSlash(s.ctx, types.Slash{SpId: sp.Id, ObjectId: math.NewUint(objectId), Height: 1})
+	}
+	s.challengeKeeper.SetSpSlashAmount(s.ctx, sp.Id, math.NewInt(300))
+
+	validProofs := []*types.PieceProof{pieceProof(10, 2, pieceHashes[2]), pieceProof(11, 0, pieceHashes[0]), pieceProof(11, 1, pieceHashes[1])}
+	tests := []struct {
+		name string
+		msg  types.MsgAppealChallenge
+		err  error
+	}{
+		{
+			name: "no pending slash",
+			msg: types.MsgAppealChallenge{
+				SpOperatorAddress: sp.OperatorAddress,
+				ChallengeId:       2,
+				PieceProofs:       validProofs,
+			},
+			err: types.ErrNoPendingSlash,
+		}, {
+			name: "challenged piece of additional object not provided",
+			msg: types.MsgAppealChallenge{
+				SpOperatorAddress: sp.OperatorAddress,
+				ChallengeId:       1,
+				PieceProofs:       validProofs[:2],
+			},
+			err: types.ErrInvalidAppeal,
+		}, {
+			name: "mismatched piece hash",
+			msg: types.MsgAppealChallenge{
+				SpOperatorAddress: sp.OperatorAddress,
+				ChallengeId:       1,
+				PieceProofs:       []*types.PieceProof{pieceProof(10, 2, pieceHashes[1]), validProofs[1], validProofs[2]},
+			},
+			err: types.ErrInvalidAppeal,
+		}, {
+			name: "proof of another piece",
+			msg: types.MsgAppealChallenge{
+				SpOperatorAddress: sp.OperatorAddress,
+				ChallengeId:       1,
+				PieceProofs:       []*types.PieceProof{pieceProof(10, 1, pieceHashes[1]), validProofs[1], validProofs[2]},
+			},
+			err: types.ErrInvalidAppeal,
+		}, {
+			name: "success",
+			msg: types.MsgAppealChallenge{
+				SpOperatorAddress: sp.OperatorAddress,
+				ChallengeId:       1,
+				PieceProofs:       validProofs,
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			_, err := s.msgServer.AppealChallenge(s.ctx, &tt.msg)
+			if tt.err != nil {
+				s.Require().ErrorIs(err, tt.err)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	_, found := s.challengeKeeper.GetPendingSlash(s.ctx, 1)
+	s.Require().False(found)
+	s.Require().False(s.challengeKeeper.ExistsSlash(s.ctx, sp.Id, math.NewUint(10)))
+	s.Require().False(s.challengeKeeper.ExistsSlash(s.ctx, sp.Id, math.NewUint(11)))
+	s.Require().Equal(math.NewInt(200), s.challengeKeeper.GetSpSlashAmount(s.ctx, sp.Id))
+	// the appealed challenge is counted as failed
+	stats := s.challengeKeeper.GetSpChallengeStats(s.ctx, sp.Id, 0)
+	s.Require().Equal(uint64(0), stats.SucceedCount)
+	s.Require().Equal(uint64(1), stats.FailedCount)
+}
+
+func (s *TestSuite) TestProcessPendingSlashes() {
+	params := types.DefaultParams()
+	params.SpChallengeStatsWindow = 1000
+	s.Require().NoError(s.challengeKeeper.SetParams(s.ctx, params))
+
+	s.spKeeper.EXPECT().DepositDenomForSP(gomock.Any()).
+		Return("BNB").AnyTimes()
+	s.spKeeper.EXPECT().Slash(gomock.Any(), gomock.Eq(uint32(1)), gomock.Any()).
+		Return(nil).Times(1)
+	s.spKeeper.EXPECT().RecordSpChallengeResult(gomock.Any(), gomock.Eq(uint32(1)), true).Times(1)
+
+	for _, executeHeight := range []uint64{10, 20} {
+		s.challengeKeeper.SetPendingSlash(s.ctx, types.PendingSlash{
+			ChallengeId:   executeHeight,
+			SpId:          1,
+			ObjectId:      math.NewUint(10),
+			SlashAmount:   math.NewInt(100),
+			Submitter:     sample.RandAccAddressHex(),
+			Validators:    []string{sample.RandAccAddressHex()},
+			ExecuteHeight: executeHeight,
+		})
+	}
+
+	s.challengeKeeper.ProcessPendingSlashes(s.ctx.WithBlockHeight(15))
+
+	_, found := s.challengeKeeper.GetPendingSlash(s.ctx, 10)
+	s.Require().False(found)
+	_, found = s.challengeKeeper.GetPendingSlash(s.ctx, 20)
+	s.Require().True(found)
+	// only the executed slash is counted as succeed
+	stats := s.challengeKeeper.GetSpChallengeStats(s.ctx, 1, 0)
+	s.Require().Equal(uint64(1), stats.SucceedCount)
+}
+
+func (s *TestSuite) TestProcessPendingSlashes_JailRepeatedOffender() {
+	params := types.DefaultParams()
+	params.SpJailSlashCount = 2
+	s.Require().NoError(s.challengeKeeper.SetParams(s.ctx, params))
+
+	s.spKeeper.EXPECT().DepositDenomForSP(gomock.Any()).
+		Return("BNB").AnyTimes()
+	s.spKeeper.EXPECT().Slash(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).AnyTimes()
+	s.spKeeper.EXPECT().RecordSpChallengeResult(gomock.Any(), gomock.Any(), true).AnyTimes()
+	s.spKeeper.EXPECT().JailStorageProvider(gomock.Any(), gomock.Eq(uint32(2))).
+		Return(nil).Times(1)
+
+	for spId, slashCount := range map[uint32]uint64{1: 1, 2: 2} {
+		s.challengeKeeper.SetSpSlashCount(s.ctx, spId, slashCount)
+		s.challengeKeeper.SetPendingSlash(s.ctx, types.PendingSlash{
+			ChallengeId:   uint64(spId),
+			SpId:          spId,
+			ObjectId:      math.NewUint(10),
+			SlashAmount:   math.NewInt(100),
+			Submitter:     sample.RandAccAddressHex(),
+			Validators:    []string{sample.RandAccAddressHex()},
+			ExecuteHeight: 10,
+		})
+	}
+
+	s.challengeKeeper.ProcessPendingSlashes(s.ctx.WithBlockHeight(10))
+}
@@ -10,6 +10,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"golang.org/x/exp/slices"
 
 	"github.com/bnb-chain/greenfield/x/challenge/types"
 	paymentmoduletypes "github.com/bnb-chain/greenfield/x/payment/types"
@@ -40,9 +41,19 @@ func (k msgServer) Attest(goCtx context.Context, msg *types.MsgAttest) (*types.M
 		challenger = sdk.MustAccAddressFromHex(msg.ChallengerAddress)
 	}
 
-	if !k.ExistsChallenge(ctx, msg.ChallengeId) {
+	challenge, found := k.GetChallenge(ctx, msg.ChallengeId)
+	if !found {
 		return nil, errors.Wrapf(types.ErrInvalidChallengeId, "challenge %d cannot be found, it could be expired", msg.ChallengeId)
 	}
+	// the attested sp, objects and segments should be the challenged ones
+	if !challenge.ObjectId.IsNil() && (challenge.SpId != sp.Id || !challenge.ObjectId.Equal(msg.ObjectId)) {
+		return nil, errors.Wrapf(types.ErrMismatchedAttestation, "challenge %d is for object %s on sp %d",
+			msg.ChallengeId, challenge.ObjectId, challenge.SpId)
+	}
+	segments, segmentsFound := k.GetChallengeSegments(ctx, msg.ChallengeId)
+	if segmentsFound && !attestedSegmentsMatch(msg, segments) {
+		return nil, errors.Wrapf(types.ErrMismatchedAttestation, "the attested segments do not match challenge %d", msg.ChallengeId)
+	}
 
 	historicalInfo, ok := k.stakingKeeper.GetHistoricalInfo(ctx, ctx.BlockHeight())
 	if !ok {
@@ -91,15 +102,22 @@ func (k msgServer) Attest(goCtx context.Context, msg *types.MsgAttest) (*types.M
 		}
 	}
 
+	pending := false
 	if msg.VoteResult == types.CHALLENGE_SUCCEED {
 		// check slash
 		if k.ExistsSlash(ctx, sp.Id, msg.ObjectId) {
 			return nil, types.ErrDuplicatedSlash
 		}
 
-		// check slash amount
+		// check slash amount, all the challenged objects are counted
 		objectSize := objectInfo.PayloadSize
-		toSlashAmount := k.calculateSlashAmount(ctx, objectSize)
+		for _, object := range segments.AdditionalObjects {
+			if additionalObjectInfo, found := k.StorageKeeper.GetObjectInfoById(ctx, object.ObjectId); found {
+				objectSize += additionalObjectInfo.PayloadSize
+			}
+		}
+		slashCount := k.GetSpSlashCount(ctx, sp.Id)
+		toSlashAmount := k.escalateSlashAmount(ctx, k.calculateSlashAmount(ctx, objectSize), slashCount)
 
 		slashedAmount := k.GetSpSlashAmount(ctx, sp.Id)
 		if !slashedAmount.IsZero() { // if it is the first time to slash, do not check the amount
@@ -111,19 +129,54 @@ func (k msgServer) Attest(goCtx context.Context, msg *types.MsgAttest) (*types.M
 			}
 		}
 
-		// do slash & reward
-		err = k.doSlashAndRewards(ctx, msg.ChallengeId, msg.VoteResult, toSlashAmount, sp.Id, submitter, challenger, validators)
-		if err != nil {
-			return nil, err
-		}
-
 		slash := types.Slash{
 			SpId:     sp.Id,
 			ObjectId: msg.ObjectId,
 			Height:   uint64(ctx.BlockHeight()),
 		}
 		k.SaveSlash(ctx, slash)
+		for _, object := range segments.AdditionalObjects {
+			k.SaveSlash(ctx, types.Slash{SpId: sp.Id, ObjectId: object.ObjectId, Height: slash.Height})
+		}
 		k.SetSpSlashAmount(ctx, sp.Id, slashedAmount.Add(toSlashAmount))
+		k.SetSpSlashCount(ctx, sp.Id, slashCount+1)
+
+		// the slash is executed after the appeal window, if the challenged segments are recorded
+		appealWindow := k.GetParams(ctx).AppealWindow
+		pending = appealWindow > 0 && segmentsFound
+		if pending {
+			pendingSlash := types.PendingSlash{
+				ChallengeId:       msg.ChallengeId,
+				SpId:              sp.Id,
+				ObjectId:          msg.ObjectId,
+				SlashAmount:       toSlashAmount,
+				Submitter:         submitter.String(),
+				Challenger:        msg.ChallengerAddress,
+				Validators:        validators,
+				RedundancyIndex:   segments.RedundancyIndex,
+				SegmentIndexes:    segments.SegmentIndexes,
+				ExecuteHeight:     uint64(ctx.BlockHeight()) + appealWindow,
+				AdditionalObjects: segments.AdditionalObjects,
+			}
+			k.SetPendingSlash(ctx, pendingSlash)
+			err = ctx.EventManager().EmitTypedEvents(&types.EventPendingSlash{
+				ChallengeId:   msg.ChallengeId,
+				SpId:          sp.Id,
+				SlashAmount:   toSlashAmount.String(),
+				ExecuteHeight: pendingSlash.ExecuteHeight,
+			})
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			// do slash & reward
+			err = k.doSlashAndRewards(ctx, msg.ChallengeId, msg.VoteResult, toSlashAmount, sp.Id, submitter, challenger, validators)
+			if err != nil {
+				return nil, err
+			}
+			k.SpKeeper.RecordSpChallengeResult(ctx, sp.Id, true)
+			k.jailSpIfRepeatedOffender(ctx, sp.Id)
+		}
 	} else {
 		// check whether it is a heartbeat attest
 		heartbeatInterval := k.GetParams(ctx).HeartbeatInterval
@@ -136,11 +189,23 @@ func (k msgServer) Attest(goCtx context.Context, msg *types.MsgAttest) (*types.M
 		if err != nil {
 			return nil, err
 		}
+		k.SpKeeper.RecordSpChallengeResult(ctx, sp.Id, false)
+		k.setLastPassedChallengeHeight(ctx, msg.ObjectId, sp.Id)
+		for _, object := range segments.AdditionalObjects {
+			k.setLastPassedChallengeHeight(ctx, object.ObjectId, sp.Id)
+		}
 	}
 	k.AppendAttestedChallenge(ctx, &types.AttestedChallenge{
 		Id:     msg.ChallengeId,
 		Result: msg.VoteResult,
 	})
+	k.RemoveChallenge(ctx, msg.ChallengeId)
+	if pending {
+		// the challenge is counted for the sp when the pending slash is executed or appealed
+		k.archiveChallengeResult(ctx, msg.ChallengeId, msg.VoteResult)
+	} else {
+		k.RecordChallengeAttestation(ctx, msg.ChallengeId, sp.Id, msg.VoteResult)
+	}
 
 	return &types.MsgAttestResponse{}, nil
 }
@@ -163,8 +228,18 @@ func (k msgServer) calculateSlashAmount(ctx sdk.Context, objectSize uint64) sdkm
 	return slashAmount
 }
 
+// escalateSlashAmount escalates the slash amount by the number of earlier slashes of the sp in the current counting window.
+func (k msgServer) escalateSlashAmount(ctx sdk.Context, slashAmount sdkmath.Int, slashCount uint64) sdkmath.Int {
+	ratio := k.GetParams(ctx).SlashEscalationRatio
+	if slashCount == 0 || ratio.IsNil() || !ratio.IsPositive() {
+		return slashAmount
+	}
+	escalation := ratio.MulInt64(int64(slashCount)).Add(sdk.OneDec())
+	return escalation.MulInt(slashAmount).TruncateInt()
+}
+
 // calculateSlashRewards calculates the rewards to challenger, submitter and validators when the total slash amount.
-func (k msgServer) calculateSlashRewards(ctx sdk.Context, total sdkmath.Int, challenger sdk.AccAddress, validators int64) (sdkmath.Int, sdkmath.Int, sdkmath.Int) {
+func (k Keeper) calculateSlashRewards(ctx sdk.Context, total sdkmath.Int, challenger sdk.AccAddress, validators int64) (sdkmath.Int, sdkmath.Int, sdkmath.Int) {
 	challengerReward := sdkmath.ZeroInt()
 	var eachValidatorReward sdkmath.Int
 
@@ -196,7 +271,7 @@ func (k msgServer) calculateSlashRewards(ctx sdk.Context, total sdkmath.Int, cha
 }
 
 // doSlashAndRewards will execute the slash, transfer the rewards and emit events.
-func (k msgServer) doSlashAndRewards(ctx sdk.Context, challengeId uint64, voteResult types.VoteResult, slashAmount sdkmath.Int,
+func (k Keeper) doSlashAndRewards(ctx sdk.Context, challengeId uint64, voteResult types.VoteResult, slashAmount sdkmath.Int,
 	spID uint32, submitter, challenger sdk.AccAddress, validators []string) error {
 
 	challengerReward, eachValidatorReward, submitterReward := sdkmath.ZeroInt(), sdkmath.ZeroInt(), sdkmath.ZeroInt()
@@ -305,3 +380,18 @@ func (k msgServer) doHeartbeatAndRewards(ctx sdk.Context, challengeId uint64, vo
 		ValidatorRewardAmount:  validatorReward.String(),
 	})
 }
+
+// attestedSegmentsMatch returns whether the attested segments are the challenged segments
+func attestedSegmentsMatch(msg *types.MsgAttest, segments types.ChallengeSegments) bool {
+	if !slices.Equal(msg.SegmentIndexes, segments.SegmentIndexes) || len(msg.AdditionalObjects) != len(segments.AdditionalObjects) {
+		return false
+	}
+	for i, object := range msg.AdditionalObjects {
+		challenged := segments.AdditionalObjects[i]
+		if !object.ObjectId.Equal(challenged.ObjectId) || object.RedundancyIndex != challenged.RedundancyIndex ||
+			!slices.Equal(object.SegmentIndexes, challenged.SegmentIndexes) {
+			return false
+		}
+	}
+	return true
+}
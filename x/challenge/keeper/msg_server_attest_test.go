@@ -157,6 +157,7 @@ func (s *TestSuite) TestAttest_Heartbeat() {
 
 	s.spKeeper.EXPECT().GetStorageProviderByOperatorAddr(gomock.Any(), gomock.Any()).
 		Return(sp, true).AnyTimes()
+	s.spKeeper.EXPECT().RecordSpChallengeResult(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 
 	s.storageKeeper.EXPECT().MustGetPrimarySPForBucket(gomock.Any(), gomock.Any()).Return(sp).AnyTimes()
 
@@ -250,6 +251,7 @@ func (s *TestSuite) TestAttest_Normal() {
 		Return(nil).AnyTimes()
 	s.spKeeper.EXPECT().GetStorageProviderByOperatorAddr(gomock.Any(), gomock.Any()).
 		Return(sp, true).AnyTimes()
+	s.spKeeper.EXPECT().RecordSpChallengeResult(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	s.storageKeeper.EXPECT().MustGetPrimarySPForBucket(gomock.Any(), gomock.Any()).Return(sp).AnyTimes()
 
 	// success attestation
@@ -326,3 +328,118 @@ func (s *TestSuite) TestAttest_Normal() {
 	_, err = s.msgServer.Attest(s.ctx, attestMsg3)
 	require.Error(s.T(), err)
 }
+
+func (s *TestSuite) TestAttest_MultipleObjects() {
+	params := s.challengeKeeper.GetParams(s.ctx)
+	params.MaxChallengeObjects = 3
+	params.MaxChallengeSegments = 2
+	s.Require().NoError(s.challengeKeeper.SetParams(s.ctx, params))
+
+	spOperatorAcc := sample.RandAccAddress()
+	sp := &sptypes.StorageProvider{Status: sptypes.STATUS_IN_SERVICE, Id: 1, OperatorAddress: spOperatorAcc.String()}
+	otherSp := &sptypes.StorageProvider{Status: sptypes.STATUS_IN_SERVICE, Id: 2, OperatorAddress: sample.RandAccAddressHex()}
+	s.spKeeper.EXPECT().GetStorageProviderByOperatorAddr(gomock.Any(), gomock.Any()).Return(sp, true).AnyTimes()
+	s.spKeeper.EXPECT().DepositDenomForSP(gomock.Any()).Return("BNB").AnyTimes()
+	s.spKeeper.EXPECT().Slash(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.spKeeper.EXPECT().RecordSpChallengeResult(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	// the objects are stored on the sp as the primary sp, or as the first secondary sp of the other bucket
+	bucket := &storagetypes.BucketInfo{Id: math.NewUint(1), BucketName: "bucket"}
+	otherBucket := &storagetypes.BucketInfo{Id: math.NewUint(2), BucketName: "otherbucket"}
+	objects := []*storagetypes.ObjectInfo{
+		{Id: math.NewUint(10), BucketName: bucket.BucketName, ObjectName: "object1", PayloadSize: 50000},
+		{Id: math.NewUint(11), BucketName: bucket.BucketName, ObjectName: "object2", PayloadSize: 50000},
+		{Id: math.NewUint(12), BucketName: otherBucket.BucketName, ObjectName: "object3", PayloadSize: 50000},
+	}
+	for _, objectInfo := range objects {
+		objectInfo.ObjectStatus = storagetypes.OBJECT_STATUS_SEALED
+		s.storageKeeper.EXPECT().GetObjectInfo(gomock.Any(), objectInfo.BucketName, objectInfo.ObjectName).Return(objectInfo, true).AnyTimes()
+		s.storageKeeper.EXPECT().GetObjectInfoById(gomock.Any(), objectInfo.Id).Return(objectInfo, true).AnyTimes()
+	}
+	s.storageKeeper.EXPECT().GetBucketInfo(gomock.Any(), bucket.BucketName).Return(bucket, true).AnyTimes()
+	s.storageKeeper.EXPECT().GetBucketInfo(gomock.Any(), otherBucket.BucketName).Return(otherBucket, true).AnyTimes()
+	s.storageKeeper.EXPECT().MustGetPrimarySPForBucket(gomock.Any(), bucket).Return(sp).AnyTimes()
+	s.storageKeeper.EXPECT().MustGetPrimarySPForBucket(gomock.Any(), otherBucket).Return(otherSp).AnyTimes()
+	s.storageKeeper.EXPECT().GetObjectGVG(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&virtualgrouptypes.GlobalVirtualGroup{PrimarySpId: otherSp.Id, SecondarySpIds: []uint32{sp.Id}}, true).AnyTimes()
+	s.storageKeeper.EXPECT().MaxSegmentSize(gomock.Any(), gomock.Any()).Return(uint64(10000), nil).AnyTimes()
+
+	submitMsg := &types.MsgSubmit{
+		Challenger:        sample.RandAccAddressHex(),
+		SpOperatorAddress: spOperatorAcc.String(),
+		BucketName:        bucket.BucketName,
+		ObjectName:        objects[0].ObjectName,
+		SegmentCount:      2,
+		AdditionalObjects: []*types.ObjectToChallenge{
+			{BucketName: bucket.BucketName, ObjectName: objects[1].ObjectName},
+			{BucketName: otherBucket.BucketName, ObjectName: objects[2].ObjectName},
+			{BucketName: otherBucket.BucketName, ObjectName: "object4"},
+		},
+	}
+	_, err := s.msgServer.Submit(s.ctx, submitMsg)
+	s.Require().ErrorIs(err, types.ErrInvalidObjectCount)
+
+	submitMsg.AdditionalObjects = submitMsg.AdditionalObjects[:2]
+	res, err := s.msgServer.Submit(s.ctx, submitMsg)
+	s.Require().NoError(err)
+	challenge, found := s.challengeKeeper.GetChallenge(s.ctx, res.ChallengeId)
+	s.Require().True(found)
+	s.Require().Equal(sp.Id, challenge.SpId)
+	s.Require().Equal(objects[0].Id, challenge.ObjectId)
+	segments, found := s.challengeKeeper.GetChallengeSegments(s.ctx, res.ChallengeId)
+	s.Require().True(found)
+	s.Require().Len(segments.SegmentIndexes, 2)
+	s.Require().Len(segments.AdditionalObjects, 2)
+	s.Require().Equal(types.RedundancyIndexPrimary, segments.AdditionalObjects[0].RedundancyIndex)
+	s.Require().Equal(objects[2].Id, segments.AdditionalObjects[1].ObjectId)
+	s.Require().Equal(int32(0), segments.AdditionalObjects[1].RedundancyIndex)
+	s.Require().Len(segments.AdditionalObjects[1].SegmentIndexes, 2)
+
+	validSubmitter := sample.RandAccAddress()
+	blsKey, _ := bls.RandKey()
+	s.stakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), gomock.Any()).Return(stakingtypes.HistoricalInfo{
+		Header: tmproto.Header{},
+		Valset: []stakingtypes.Validator{{
+			BlsKey:            blsKey.PublicKey().Marshal(),
+			ChallengerAddress: validSubmitter.String(),
+		}},
+	}, true).AnyTimes()
+	attest := func(attestMsg *types.MsgAttest) error {
+		toSign := attestMsg.GetBlsSignBytes(s.ctx.ChainID())
+		attestMsg.VoteAggSignature = blsKey.Sign(toSign[:]).Marshal()
+		_, err := s.msgServer.Attest(s.ctx, attestMsg)
+		return err
+	}
+	newAttestMsg := func() *types.MsgAttest {
+		return &types.MsgAttest{
+			Submitter:         validSubmitter.String(),
+			ChallengeId:       res.ChallengeId,
+			ObjectId:          objects[0].Id,
+			SpOperatorAddress: spOperatorAcc.String(),
+			VoteResult:        types.CHALLENGE_SUCCEED,
+			ChallengerAddress: submitMsg.Challenger,
+			VoteValidatorSet:  []uint64{1},
+			SegmentIndexes:    segments.SegmentIndexes,
+			AdditionalObjects: segments.AdditionalObjects,
+		}
+	}
+
+	// the attested object and segments should be the challenged ones
+	attestMsg := newAttestMsg()
+	attestMsg.ObjectId = objects[1].Id
+	s.Require().ErrorIs(attest(attestMsg), types.ErrMismatchedAttestation)
+	attestMsg = newAttestMsg()
+	attestMsg.SegmentIndexes = nil
+	s.Require().ErrorIs(attest(attestMsg), types.ErrMismatchedAttestation)
+	attestMsg = newAttestMsg()
+	attestMsg.AdditionalObjects = segments.AdditionalObjects[:1]
+	s.Require().ErrorIs(attest(attestMsg), types.ErrMismatchedAttestation)
+
+	// all the challenged objects are slashed
+	s.Require().NoError(attest(newAttestMsg()))
+	for _, objectInfo := range objects {
+		s.Require().True(s.challengeKeeper.ExistsSlash(s.ctx, sp.Id, objectInfo.Id))
+	}
+	// the attested challenge can not be attested again
+	s.Require().ErrorIs(attest(newAttestMsg()), types.ErrInvalidChallengeId)
+}
@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"golang.org/x/exp/slices"
 
 	"github.com/bnb-chain/greenfield/x/challenge/types"
 	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
@@ -44,6 +45,7 @@ func (k msgServer) Submit(goCtx context.Context, msg *types.MsgSubmit) (*types.M
 	// check whether the sp stores the object info, generate redundancy index
 	stored := false
 	redundancyIndex := types.RedundancyIndexPrimary
+	challengedSpId := sp.Id
 
 	if spOperator.Equals(sdk.MustAccAddressFromHex(sp.OperatorAddress)) {
 		stored = true
@@ -63,6 +65,7 @@ func (k msgServer) Submit(goCtx context.Context, msg *types.MsgSubmit) (*types.M
 			}
 			if spOperator.Equals(sdk.MustAccAddressFromHex(tmpSp.OperatorAddress)) {
 				redundancyIndex = int32(i)
+				challengedSpId = spId
 				stored = true
 				break
 			}
@@ -84,12 +87,39 @@ func (k msgServer) Submit(goCtx context.Context, msg *types.MsgSubmit) (*types.M
 	}
 	segmentIndex := msg.SegmentIndex
 	segments := CalculateSegments(objectInfo.PayloadSize, segmentSize)
-	if msg.RandomIndex {
-		segmentIndex = RandomSegmentIndex(ctx.BlockHeader().RandaoMix, segments)
+	segmentCount := msg.SegmentCount
+	if segmentCount > k.GetParams(ctx).MaxSegmentsPerChallenge() {
+		return nil, errors.Wrapf(types.ErrInvalidSegmentCount, "at most %d segments can be challenged, requested: %d",
+			k.GetParams(ctx).MaxSegmentsPerChallenge(), segmentCount)
+	}
+	var segmentIndexes []uint32
+	if segmentCount > 1 { // the segments of a multi-segment challenge are always picked randomly
+		segmentIndexes = RandomSegmentIndexes(ctx.BlockHeader().RandaoMix, segments, segmentCount)
+		segmentIndex = segmentIndexes[0]
 	} else {
-		if uint64(segmentIndex) > segments-1 {
-			return nil, types.ErrInvalidSegmentIndex
+		if msg.RandomIndex {
+			segmentIndex = RandomSegmentIndex(ctx.BlockHeader().RandaoMix, segments)
+		} else {
+			if uint64(segmentIndex) > segments-1 {
+				return nil, types.ErrInvalidSegmentIndex
+			}
+		}
+		segmentIndexes = []uint32{segmentIndex}
+	}
+
+	// the other objects on the same sp
+	if maxObjects := k.GetParams(ctx).MaxObjectsPerChallenge(); uint32(len(msg.AdditionalObjects))+1 > maxObjects {
+		return nil, errors.Wrapf(types.ErrInvalidObjectCount, "at most %d objects can be challenged, requested: %d",
+			maxObjects, len(msg.AdditionalObjects)+1)
+	}
+	additionalObjects := make([]*types.ChallengedObject, 0, len(msg.AdditionalObjects))
+	for i, object := range msg.AdditionalObjects {
+		seed := SeedFromRandaoMix(ctx.BlockHeader().RandaoMix, uint64(i+1))
+		challengedObject, err := k.getChallengedObject(ctx, object, challengedSpId, seed, segmentCount)
+		if err != nil {
+			return nil, err
 		}
+		additionalObjects = append(additionalObjects, challengedObject)
 	}
 
 	k.IncrChallengeCountCurrentBlock(ctx)
@@ -98,6 +128,24 @@ func (k msgServer) Submit(goCtx context.Context, msg *types.MsgSubmit) (*types.M
 	k.SaveChallenge(ctx, types.Challenge{
 		Id:            challengeId,
 		ExpiredHeight: expiredHeight,
+		SpId:          challengedSpId,
+		ObjectId:      objectInfo.Id,
+	})
+	k.ArchiveChallenge(ctx, types.ArchivedChallenge{
+		Id:                challengeId,
+		SpId:              challengedSpId,
+		ObjectId:          objectInfo.Id,
+		SegmentIndex:      segmentIndex,
+		RedundancyIndex:   redundancyIndex,
+		ChallengerAddress: challenger.String(),
+		Height:            uint64(ctx.BlockHeight()),
+		SegmentIndexes:    segmentIndexes,
+		AdditionalObjects: additionalObjects,
+	})
+	k.SaveChallengeSegments(ctx, challengeId, types.ChallengeSegments{
+		RedundancyIndex:   redundancyIndex,
+		SegmentIndexes:    segmentIndexes,
+		AdditionalObjects: additionalObjects,
 	})
 
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventStartChallenge{
@@ -109,9 +157,61 @@ func (k msgServer) Submit(goCtx context.Context, msg *types.MsgSubmit) (*types.M
 		RedundancyIndex:   redundancyIndex,
 		ChallengerAddress: challenger.String(),
 		ExpiredHeight:     expiredHeight,
+		SegmentIndexes:    segmentIndexes,
+		AdditionalObjects: additionalObjects,
 	}); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgSubmitResponse{ChallengeId: challengeId}, nil
 }
+
+// getChallengedObject checks an additional object to challenge on the sp, and picks its segments randomly
+func (k msgServer) getChallengedObject(ctx sdk.Context, object *types.ObjectToChallenge, spId uint32, seed []byte,
+	segmentCount uint32,
+) (*types.ChallengedObject, error) {
+	bucketInfo, found := k.StorageKeeper.GetBucketInfo(ctx, object.BucketName)
+	if !found {
+		return nil, types.ErrUnknownBucketObject
+	}
+	objectInfo, found := k.StorageKeeper.GetObjectInfo(ctx, object.BucketName, object.ObjectName)
+	if !found {
+		return nil, types.ErrUnknownBucketObject
+	}
+	if objectInfo.ObjectStatus != storagetypes.OBJECT_STATUS_SEALED {
+		return nil, types.ErrInvalidObjectStatus
+	}
+	if objectInfo.PayloadSize == 0 {
+		return nil, errors.Wrapf(types.ErrInvalidSegmentIndex, "the object %s is empty, no segment", object.ObjectName)
+	}
+
+	redundancyIndex := types.RedundancyIndexPrimary
+	if k.StorageKeeper.MustGetPrimarySPForBucket(ctx, bucketInfo).Id != spId {
+		gvg, found := k.StorageKeeper.GetObjectGVG(ctx, bucketInfo.Id, objectInfo.LocalVirtualGroupId)
+		if !found {
+			return nil, errors.Wrapf(types.ErrCannotFindGVG, "no GVG binding for LVG: %d", objectInfo.LocalVirtualGroupId)
+		}
+		index := slices.Index(gvg.SecondarySpIds, spId)
+		if index < 0 {
+			return nil, errors.Wrapf(types.ErrNotStoredOnSp, "the object %s is not stored on the sp", object.ObjectName)
+		}
+		redundancyIndex = int32(index)
+	}
+
+	if k.ExistsSlash(ctx, spId, objectInfo.Id) {
+		return nil, types.ErrExistsRecentSlash
+	}
+
+	segmentSize, err := k.StorageKeeper.MaxSegmentSize(ctx, objectInfo.GetLatestUpdatedTime())
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidSegmentIndex, "cannot get segment size: %s", err.Error())
+	}
+	if segmentCount == 0 {
+		segmentCount = 1
+	}
+	return &types.ChallengedObject{
+		ObjectId:        objectInfo.Id,
+		RedundancyIndex: redundancyIndex,
+		SegmentIndexes:  RandomSegmentIndexes(seed, CalculateSegments(objectInfo.PayloadSize, segmentSize), segmentCount),
+	}, nil
+}
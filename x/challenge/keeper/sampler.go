@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// ChallengeSampler decides whether a randomly picked object on a storage provider is challenged.
+type ChallengeSampler interface {
+	// Sample returns whether the object on the storage provider is challenged, it should be deterministic for the seed.
+	Sample(ctx sdk.Context, seed []byte, objectInfo *storagetypes.ObjectInfo, gvgId, spId uint32) bool
+	// MaxIterationFactor returns how many more times the candidates should be picked than the uniform sampling,
+	// to generate the same number of challenges.
+	MaxIterationFactor() uint64
+}
+
+// GetChallengeSampler returns the sampler of the sampling mode in the params
+func (k Keeper) GetChallengeSampler(ctx sdk.Context) ChallengeSampler {
+	switch k.GetParams(ctx).SamplingMode {
+	case types.SAMPLING_MODE_RISK_WEIGHTED:
+		return riskWeightedSampler{k: k}
+	default:
+		return uniformSampler{}
+	}
+}
+
+// uniformSampler challenges all the picked objects, so that the objects are challenged uniformly.
+type uniformSampler struct{}
+
+func (uniformSampler) Sample(_ sdk.Context, _ []byte, _ *storagetypes.ObjectInfo, _, _ uint32) bool {
+	return true
+}
+
+func (uniformSampler) MaxIterationFactor() uint64 {
+	return 1
+}
+
+// riskWeightedSampler challenges the picked objects with the probabilities weighted by the risks, including the object size,
+// the blocks since the object passed a challenge last, the recent slashes of the storage provider, and the recent swap
+// of the gvg.
+type riskWeightedSampler struct {
+	k Keeper
+}
+
+func (s riskWeightedSampler) Sample(ctx sdk.Context, seed []byte, objectInfo *storagetypes.ObjectInfo, gvgId, spId uint32) bool {
+	return AcceptByWeight(seed, s.k.GetChallengeRiskWeight(ctx, objectInfo, gvgId, spId))
+}
+
+func (riskWeightedSampler) MaxIterationFactor() uint64 {
+	return RiskWeightMax / RiskWeightBase
+}
+
+// GetChallengeRiskWeight calculates the risk weight in basis points of an object in a gvg on a storage provider,
+// which is the base weight plus the weights of the risk factors:
+//   - the object size, up to 1GB;
+//   - the blocks since the storage provider passed a challenge of the object last, up to the risk age period, an
+//     object is treated as never challenged if it has not passed any challenge in the period;
+//   - the amount the storage provider has been slashed in the current counting window, up to the max slash amount;
+//   - the blocks since an sp of the gvg was swapped, which decreases to zero at the end of the risk swap period.
+func (k Keeper) GetChallengeRiskWeight(ctx sdk.Context, objectInfo *storagetypes.ObjectInfo, gvgId, spId uint32) uint64 {
+	params := k.GetParams(ctx)
+	blockHeight := uint64(ctx.BlockHeight())
+
+	sizeFactor := RiskFactor(sdkmath.NewIntFromUint64(objectInfo.PayloadSize), sdkmath.NewInt(one_gb_bytes))
+
+	ageFactor := RiskWeightBase
+	if lastHeight, found := k.getLastPassedChallengeHeight(ctx, objectInfo.Id, spId); found {
+		ageFactor = RiskFactor(sdkmath.NewIntFromUint64(blockHeight-lastHeight), sdkmath.NewIntFromUint64(params.RiskAgePeriod))
+	}
+
+	slashFactor := RiskFactor(k.GetSpSlashAmount(ctx, spId), params.SpSlashMaxAmount)
+
+	swapFactor := uint64(0)
+	if swappedHeight, found := k.virtualGroupKeeper.GetGVGSwappedHeight(ctx, gvgId); found && params.RiskSwapPeriod > 0 &&
+		blockHeight-swappedHeight < params.RiskSwapPeriod {
+		swapFactor = RiskWeightBase - RiskFactor(sdkmath.NewIntFromUint64(blockHeight-swappedHeight),
+			sdkmath.NewIntFromUint64(params.RiskSwapPeriod))
+	}
+
+	return RiskWeightBase + sizeFactor + ageFactor + slashFactor + swapFactor
+}
+
+// setLastPassedChallengeHeight records the current height as the height of the last challenge passed by the sp on
+// the object, and queues it to be pruned after the risk age period
+func (k Keeper) setLastPassedChallengeHeight(ctx sdk.Context, objectId sdkmath.Uint, spId uint32) {
+	store := ctx.KVStore(k.storeKey)
+	queueStore := prefix.NewStore(store, types.LastPassedChallengeQueuePrefix)
+	if lastHeight, found := k.getLastPassedChallengeHeight(ctx, objectId, spId); found {
+		queueStore.Delete(types.GetLastPassedChallengeQueueKey(lastHeight, spId, objectId))
+	}
+
+	blockHeight := uint64(ctx.BlockHeight())
+	store.Set(types.GetLastPassedChallengeKey(objectId, spId), sdk.Uint64ToBigEndian(blockHeight))
+	queueStore.Set(types.GetLastPassedChallengeQueueKey(blockHeight, spId, objectId), []byte{})
+}
+
+// getLastPassedChallengeHeight returns the height of the last challenge passed by the sp on the object
+func (k Keeper) getLastPassedChallengeHeight(ctx sdk.Context, objectId sdkmath.Uint, spId uint32) (uint64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetLastPassedChallengeKey(objectId, spId))
+	if bz == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(bz), true
+}
+
+// RemoveLastPassedChallengeUntil removes the last passed challenges at or before the height, which get the full age
+// risk weight the same as the objects never challenged
+func (k Keeper) RemoveLastPassedChallengeUntil(ctx sdk.Context, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	queueStore := prefix.NewStore(store, types.LastPassedChallengeQueuePrefix)
+	iterator := queueStore.Iterator(nil, types.GetLastPassedChallengeQueueKey(height+1, 0, sdkmath.ZeroUint()))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		spId := binary.BigEndian.Uint32(iterator.Key()[8:])
+		objectId := sdkmath.NewUintFromBigInt(new(big.Int).SetBytes(iterator.Key()[12:]))
+		store.Delete(types.GetLastPassedChallengeKey(objectId, spId))
+		queueStore.Delete(iterator.Key())
+	}
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/x/challenge/keeper"
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func TestRiskFactor(t *testing.T) {
+	require.Equal(t, uint64(0), keeper.RiskFactor(math.ZeroInt(), math.NewInt(100)))
+	require.Equal(t, uint64(2500), keeper.RiskFactor(math.NewInt(25), math.NewInt(100)))
+	require.Equal(t, keeper.RiskWeightBase, keeper.RiskFactor(math.NewInt(200), math.NewInt(100)))
+	require.Equal(t, keeper.RiskWeightBase, keeper.RiskFactor(math.NewInt(1), math.ZeroInt()))
+}
+
+func TestAcceptByWeight(t *testing.T) {
+	randaoMix := make([]byte, keeper.RandaoMixLength)
+	accepted := 0
+	for i := uint64(0); i < 1000; i++ {
+		seed := keeper.SeedFromRandaoMix(randaoMix, i)
+		require.Equal(t, keeper.AcceptByWeight(seed, keeper.RiskWeightBase), keeper.AcceptByWeight(seed, keeper.RiskWeightBase))
+		require.True(t, keeper.AcceptByWeight(seed, keeper.RiskWeightMax))
+		if keeper.AcceptByWeight(seed, keeper.RiskWeightBase) {
+			accepted++
+		}
+	}
+	// the base weight is accepted with the probability of 1/5
+	require.Greater(t, accepted, 100)
+	require.Less(t, accepted, 300)
+}
+
+func TestChallengeRiskWeight(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(mint.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx.WithBlockHeight(1000)
+	virtualGroupKeeper := types.NewMockVirtualGroupKeeper(gomock.NewController(t))
+	k := keeper.NewKeeper(encCfg.Codec, key, key, &types.MockBankKeeper{}, &types.MockStorageKeeper{}, &types.MockSpKeeper{},
+		&types.MockStakingKeeper{}, &types.MockPaymentKeeper{}, virtualGroupKeeper, authtypes.NewModuleAddress(types.ModuleName).String())
+
+	params := types.DefaultParams()
+	params.RiskAgePeriod = 1000
+	params.RiskSwapPeriod = 100
+	params.SamplingMode = types.SAMPLING_MODE_RISK_WEIGHTED
+	require.NoError(t, k.SetParams(ctx, params))
+	require.Equal(t, keeper.RiskWeightMax/keeper.RiskWeightBase, k.GetChallengeSampler(ctx).MaxIterationFactor())
+
+	virtualGroupKeeper.EXPECT().GetGVGSwappedHeight(gomock.Any(), uint32(1)).Return(uint64(0), false).AnyTimes()
+	virtualGroupKeeper.EXPECT().GetGVGSwappedHeight(gomock.Any(), uint32(2)).Return(uint64(975), true).AnyTimes()
+
+	// a small object never challenged on a sp never slashed
+	objectInfo := &storagetypes.ObjectInfo{Id: math.NewUint(1), PayloadSize: 1}
+	require.Equal(t, 2*keeper.RiskWeightBase, k.GetChallengeRiskWeight(ctx, objectInfo, 1, 1))
+
+	// the sp passed a challenge of the object 500 blocks ago, and the sp was slashed half of the max amount
+	k.SaveChallenge(ctx.WithBlockHeight(400), types.Challenge{Id: 1, ExpiredHeight: 500, SpId: 1, ObjectId: objectInfo.Id})
+	k.RemoveChallengeUntil(ctx.WithBlockHeight(500), 500)
+	k.SetSpSlashAmount(ctx, 1, params.SpSlashMaxAmount.QuoRaw(2))
+	require.Equal(t, keeper.RiskWeightBase+5000+5000, k.GetChallengeRiskWeight(ctx, objectInfo, 1, 1))
+	// the challenges passed by the other sps do not count
+	require.Equal(t, 2*keeper.RiskWeightBase, k.GetChallengeRiskWeight(ctx, objectInfo, 1, 2))
+
+	// the gvg was swapped 25 blocks ago
+	require.Equal(t, keeper.RiskWeightBase+5000+5000+7500, k.GetChallengeRiskWeight(ctx, objectInfo, 2, 1))
+
+	// a large object
+	objectInfo.PayloadSize = 2 * 1024 * 1024 * 1024
+	require.Equal(t, 2*keeper.RiskWeightBase+5000+5000, k.GetChallengeRiskWeight(ctx, objectInfo, 1, 1))
+
+	// the last passed challenge out of the risk age period is pruned
+	k.RemoveLastPassedChallengeUntil(ctx, 500)
+	require.Equal(t, 3*keeper.RiskWeightBase+5000, k.GetChallengeRiskWeight(ctx, objectInfo, 1, 1))
+}
+
+func TestRandomSegmentIndexes(t *testing.T) {
+	seed := keeper.SeedFromRandaoMix(make([]byte, keeper.RandaoMixLength), 1)
+
+	// all the segments are challenged
+	require.Equal(t, []uint32{0, 1, 2}, keeper.RandomSegmentIndexes(seed, 3, 5))
+
+	indexes := keeper.RandomSegmentIndexes(seed, 10, 9)
+	require.Len(t, indexes, 9)
+	for i := 1; i < len(indexes); i++ {
+		require.Less(t, indexes[i-1], indexes[i])
+	}
+	require.Less(t, indexes[len(indexes)-1], uint32(10))
+	require.Equal(t, indexes, keeper.RandomSegmentIndexes(seed, 10, 9))
+}
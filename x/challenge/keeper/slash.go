@@ -42,6 +42,13 @@ func (k Keeper) ExistsSlash(ctx sdk.Context, spId uint32, objectId sdkmath.Uint)
 	return store.Has(getSlashKeyBytes(spId, objectId))
 }
 
+// deleteSlash deletes the recent slash for a pair of sp and object info
+func (k Keeper) deleteSlash(ctx sdk.Context, spId uint32, objectId sdkmath.Uint) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashKeyPrefix)
+
+	store.Delete(getSlashKeyBytes(spId, objectId))
+}
+
 // getSlashKeyBytes returns the byte representation of Slash key
 func getSlashKeyBytes(spId uint32, objectId sdkmath.Uint) []byte {
 	idBytes := make([]byte, 4)
@@ -88,3 +95,41 @@ func (k Keeper) ClearSpSlashAmount(ctx sdk.Context) {
 		store.Delete(iterator.Key())
 	}
 }
+
+// SetSpSlashCount sets the number of slashes of a sp in the current counting window
+func (k Keeper) SetSpSlashCount(ctx sdk.Context, spId uint32, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashCountKeyPrefix)
+	idBz := make([]byte, 4)
+	binary.BigEndian.PutUint32(idBz, spId)
+	store.Set(idBz, sdk.Uint64ToBigEndian(count))
+}
+
+// GetSpSlashCount gets the number of slashes of a sp in the current counting window
+func (k Keeper) GetSpSlashCount(ctx sdk.Context, spId uint32) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashCountKeyPrefix)
+	idBz := make([]byte, 4)
+	binary.BigEndian.PutUint32(idBz, spId)
+	return sdk.BigEndianToUint64(store.Get(idBz))
+}
+
+func (k Keeper) ClearSpSlashCount(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashCountKeyPrefix)
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		store.Delete(iterator.Key())
+	}
+}
+
+// jailSpIfRepeatedOffender jails the sp if it has been slashed too many times in the current counting window
+func (k Keeper) jailSpIfRepeatedOffender(ctx sdk.Context, spId uint32) {
+	jailSlashCount := k.GetParams(ctx).SpJailSlashCount
+	if jailSlashCount == 0 || k.GetSpSlashCount(ctx, spId) < jailSlashCount {
+		return
+	}
+	if err := k.SpKeeper.JailStorageProvider(ctx, spId); err != nil {
+		ctx.Logger().Info("fail to jail storage provider", "sp", spId, "err", err)
+	}
+}
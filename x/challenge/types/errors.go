@@ -24,4 +24,9 @@ var (
 	ErrNotInturnChallenger     = errors.Register(ModuleName, 16, "challenger is not in turn")
 	ErrInvalidParams           = errors.Register(ModuleName, 17, "invalid params")
 	ErrCannotFindGVG           = errors.Register(ModuleName, 18, "fail to find global virtual group for the object")
+	ErrInvalidSegmentCount     = errors.Register(ModuleName, 19, "the number of segments/pieces to challenge is invalid")
+	ErrNoPendingSlash          = errors.Register(ModuleName, 20, "no pending slash of the challenge to appeal")
+	ErrInvalidAppeal           = errors.Register(ModuleName, 21, "the appeal cannot prove the integrity of the challenged pieces")
+	ErrInvalidObjectCount      = errors.Register(ModuleName, 22, "the number of objects to challenge is invalid")
+	ErrMismatchedAttestation   = errors.Register(ModuleName, 23, "the attestation does not match the challenge")
 )
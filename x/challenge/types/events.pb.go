@@ -42,6 +42,11 @@ type EventStartChallenge struct {
 	ChallengerAddress string `protobuf:"bytes,7,opt,name=challenger_address,json=challengerAddress,proto3" json:"challenger_address,omitempty"`
 	// The challenge will be expired after this height
 	ExpiredHeight uint64 `protobuf:"varint,8,opt,name=expired_height,json=expiredHeight,proto3" json:"expired_height,omitempty"`
+	// All the segment/piece indexes challenged, the challenge succeeds if any of the segments/pieces fails.
+	SegmentIndexes []uint32 `protobuf:"varint,9,rep,packed,name=segment_indexes,json=segmentIndexes,proto3" json:"segment_indexes,omitempty"`
+	// The other objects on the same storage provider challenged under the challenge, the challenge succeeds if any of
+	// the segments/pieces of any object fails.
+	AdditionalObjects []*ChallengedObject `protobuf:"bytes,10,rep,name=additional_objects,json=additionalObjects,proto3" json:"additional_objects,omitempty"`
 }
 
 func (m *EventStartChallenge) Reset()         { *m = EventStartChallenge{} }
@@ -126,6 +131,20 @@ func (m *EventStartChallenge) GetExpiredHeight() uint64 {
 	return 0
 }
 
+func (m *EventStartChallenge) GetSegmentIndexes() []uint32 {
+	if m != nil {
+		return m.SegmentIndexes
+	}
+	return nil
+}
+
+func (m *EventStartChallenge) GetAdditionalObjects() []*ChallengedObject {
+	if m != nil {
+		return m.AdditionalObjects
+	}
+	return nil
+}
+
 // EventAttestChallenge to indicate a challenge has been attested.
 type EventAttestChallenge struct {
 	// The id of challenge.
@@ -244,49 +263,186 @@ func (m *EventAttestChallenge) GetValidatorRewardAmount() string {
 	return ""
 }
 
+// EventPendingSlash to indicate a succeed challenge is attested, and the storage provider will be slashed after the appeal window.
+type EventPendingSlash struct {
+	// The id of challenge.
+	ChallengeId uint64 `protobuf:"varint,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// The storage provider to be slashed.
+	SpId uint32 `protobuf:"varint,2,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// The amount to slash.
+	SlashAmount string `protobuf:"bytes,3,opt,name=slash_amount,json=slashAmount,proto3" json:"slash_amount,omitempty"`
+	// The height at which the appeal window ends and the slash is executed.
+	ExecuteHeight uint64 `protobuf:"varint,4,opt,name=execute_height,json=executeHeight,proto3" json:"execute_height,omitempty"`
+}
+
+func (m *EventPendingSlash) Reset()         { *m = EventPendingSlash{} }
+func (m *EventPendingSlash) String() string { return proto.CompactTextString(m) }
+func (*EventPendingSlash) ProtoMessage()    {}
+func (*EventPendingSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9eaa4bfadaa20f8, []int{2}
+}
+func (m *EventPendingSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPendingSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPendingSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPendingSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPendingSlash.Merge(m, src)
+}
+func (m *EventPendingSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPendingSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPendingSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPendingSlash proto.InternalMessageInfo
+
+func (m *EventPendingSlash) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
+	}
+	return 0
+}
+
+func (m *EventPendingSlash) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *EventPendingSlash) GetSlashAmount() string {
+	if m != nil {
+		return m.SlashAmount
+	}
+	return ""
+}
+
+func (m *EventPendingSlash) GetExecuteHeight() uint64 {
+	if m != nil {
+		return m.ExecuteHeight
+	}
+	return 0
+}
+
+// EventAppealChallenge to indicate a storage provider appealed a succeed challenge, and the pending slash is cancelled.
+type EventAppealChallenge struct {
+	// The id of challenge.
+	ChallengeId uint64 `protobuf:"varint,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// The storage provider appealing the challenge.
+	SpId uint32 `protobuf:"varint,2,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+}
+
+func (m *EventAppealChallenge) Reset()         { *m = EventAppealChallenge{} }
+func (m *EventAppealChallenge) String() string { return proto.CompactTextString(m) }
+func (*EventAppealChallenge) ProtoMessage()    {}
+func (*EventAppealChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9eaa4bfadaa20f8, []int{3}
+}
+func (m *EventAppealChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAppealChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAppealChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAppealChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAppealChallenge.Merge(m, src)
+}
+func (m *EventAppealChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAppealChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAppealChallenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAppealChallenge proto.InternalMessageInfo
+
+func (m *EventAppealChallenge) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
+	}
+	return 0
+}
+
+func (m *EventAppealChallenge) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventStartChallenge)(nil), "greenfield.challenge.EventStartChallenge")
 	proto.RegisterType((*EventAttestChallenge)(nil), "greenfield.challenge.EventAttestChallenge")
+	proto.RegisterType((*EventPendingSlash)(nil), "greenfield.challenge.EventPendingSlash")
+	proto.RegisterType((*EventAppealChallenge)(nil), "greenfield.challenge.EventAppealChallenge")
 }
 
 func init() { proto.RegisterFile("greenfield/challenge/events.proto", fileDescriptor_e9eaa4bfadaa20f8) }
 
 var fileDescriptor_e9eaa4bfadaa20f8 = []byte{
-	// 542 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcb, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8e, 0xff, 0x5c, 0xfe, 0x66, 0x92, 0x94, 0xd6, 0x09, 0xd4, 0x14, 0xc9, 0x75, 0x8a, 0x90,
-	0xc2, 0x22, 0x89, 0x00, 0xa9, 0xea, 0x36, 0x45, 0x15, 0x8d, 0x58, 0x20, 0xb9, 0x82, 0x05, 0x1b,
-	0xcb, 0xf6, 0x1c, 0x6c, 0x23, 0x67, 0xc6, 0x9a, 0x99, 0x94, 0xf4, 0x2d, 0xe0, 0x5d, 0xfa, 0x10,
-	0x5d, 0x56, 0x5d, 0x21, 0x16, 0x15, 0x4a, 0xc4, 0x7b, 0x20, 0x8f, 0x27, 0x76, 0x82, 0x8a, 0x50,
-	0x76, 0x9e, 0xef, 0x32, 0xdf, 0xf1, 0x39, 0x73, 0x50, 0x37, 0x60, 0x00, 0xe4, 0x53, 0x04, 0x31,
-	0x1e, 0xfa, 0xa1, 0x1b, 0xc7, 0x40, 0x02, 0x18, 0xc2, 0x05, 0x10, 0xc1, 0x07, 0x09, 0xa3, 0x82,
-	0xea, 0x9d, 0x42, 0x32, 0xc8, 0x25, 0xfb, 0x8f, 0x7d, 0xca, 0x27, 0x94, 0x3b, 0x52, 0x33, 0xcc,
-	0x0e, 0x99, 0x61, 0xbf, 0x13, 0xd0, 0x80, 0x66, 0x78, 0xfa, 0xa5, 0x50, 0xeb, 0xde, 0x24, 0x71,
-	0x99, 0x80, 0xf2, 0x1d, 0x7e, 0x2b, 0xa3, 0xf6, 0x69, 0x9a, 0x7c, 0x2e, 0x5c, 0x26, 0x5e, 0x2f,
-	0x35, 0x7a, 0x17, 0x35, 0x73, 0x83, 0x13, 0x61, 0x43, 0xb3, 0xb4, 0x5e, 0xc5, 0x6e, 0xe4, 0xd8,
-	0x18, 0xeb, 0xc7, 0xa8, 0x4e, 0xbd, 0xcf, 0xe0, 0x8b, 0x94, 0xff, 0xcf, 0xd2, 0x7a, 0xf5, 0x93,
-	0x27, 0xd7, 0x77, 0x07, 0xa5, 0x1f, 0x77, 0x07, 0x95, 0xf7, 0x11, 0x11, 0xb7, 0x57, 0xfd, 0x86,
-	0xaa, 0x31, 0x3d, 0xda, 0x5b, 0x99, 0x7a, 0x8c, 0xf5, 0xa7, 0xa8, 0xc5, 0x21, 0x98, 0x00, 0x11,
-	0x4e, 0x44, 0x30, 0xcc, 0x8c, 0xb2, 0xa5, 0xf5, 0x5a, 0x76, 0x53, 0x81, 0xe3, 0x14, 0xd3, 0xdb,
-	0xa8, 0xca, 0x93, 0xf4, 0xea, 0x8a, 0x24, 0x2b, 0x3c, 0x19, 0x63, 0xfd, 0x0c, 0xb5, 0x79, 0xe2,
-	0xd0, 0x04, 0x98, 0x2b, 0x28, 0x73, 0x5c, 0x8c, 0x19, 0x70, 0x6e, 0x54, 0x65, 0xba, 0x71, 0x7b,
-	0xd5, 0xef, 0xa8, 0xc4, 0x51, 0xc6, 0x9c, 0x0b, 0x16, 0x91, 0xc0, 0xde, 0xe5, 0xc9, 0x3b, 0xe5,
-	0x51, 0x84, 0xfe, 0x1c, 0xed, 0x30, 0xc0, 0x53, 0x82, 0x5d, 0xe2, 0x5f, 0xaa, 0x32, 0x6a, 0x96,
-	0xd6, 0xab, 0xda, 0x0f, 0x0a, 0x3c, 0xab, 0xe4, 0x0d, 0xd2, 0xf3, 0xff, 0x2e, 0x32, 0xff, 0xff,
-	0x57, 0x66, 0xe1, 0x59, 0x66, 0x3e, 0x43, 0xdb, 0x30, 0x4b, 0x22, 0x06, 0xd8, 0x09, 0x21, 0x0a,
-	0x42, 0x61, 0x6c, 0xc9, 0xb6, 0xb6, 0x14, 0x7a, 0x26, 0xc1, 0xc3, 0x5f, 0x65, 0xd4, 0x91, 0x33,
-	0x19, 0x09, 0x01, 0x7c, 0xd3, 0xa1, 0xd4, 0x18, 0xf0, 0x69, 0x2c, 0xe4, 0x44, 0xb6, 0x5f, 0x5a,
-	0x83, 0xfb, 0x5e, 0xd2, 0xe0, 0x03, 0x15, 0x60, 0x4b, 0x9d, 0xad, 0xf4, 0x45, 0xbf, 0xcb, 0x2b,
-	0xfd, 0xee, 0xa2, 0x26, 0x8f, 0x5d, 0x1e, 0x3a, 0xee, 0x84, 0x4e, 0x89, 0x90, 0xb3, 0xa8, 0xdb,
-	0x0d, 0x89, 0x8d, 0x24, 0xf4, 0x97, 0xee, 0x54, 0x37, 0xef, 0xce, 0x31, 0x32, 0x56, 0x2e, 0x62,
-	0xf0, 0xc5, 0x65, 0x78, 0x99, 0x5b, 0x93, 0xb9, 0x8f, 0x0a, 0xde, 0x96, 0xb4, 0x2a, 0xe1, 0x14,
-	0xed, 0xf2, 0xa9, 0x37, 0x89, 0x84, 0xd8, 0x60, 0x3e, 0x3b, 0xb9, 0x65, 0x59, 0xc0, 0x11, 0xda,
-	0x2b, 0xae, 0x59, 0xcf, 0xdf, 0x92, 0xf9, 0x0f, 0x73, 0x7a, 0x2d, 0xfe, 0x08, 0xed, 0x5d, 0xb8,
-	0x71, 0x84, 0xe5, 0x93, 0x5c, 0xf7, 0xa1, 0xcc, 0x97, 0xd3, 0xab, 0xbe, 0x93, 0xb7, 0xd7, 0x73,
-	0x53, 0xbb, 0x99, 0x9b, 0xda, 0xcf, 0xb9, 0xa9, 0x7d, 0x5d, 0x98, 0xa5, 0x9b, 0x85, 0x59, 0xfa,
-	0xbe, 0x30, 0x4b, 0x1f, 0x5f, 0x04, 0x91, 0x08, 0xa7, 0xde, 0xc0, 0xa7, 0x93, 0xa1, 0x47, 0xbc,
-	0xbe, 0x1f, 0xba, 0x11, 0x19, 0xae, 0x2c, 0xf3, 0xec, 0xcf, 0x75, 0xf6, 0x6a, 0x72, 0x9f, 0x5f,
-	0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x86, 0xd6, 0xb3, 0x28, 0x5d, 0x04, 0x00, 0x00,
+	// 650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x5d, 0x4f, 0x13, 0x41,
+	0x14, 0xed, 0xd2, 0x0f, 0xe9, 0x94, 0x02, 0x5d, 0xaa, 0xac, 0x98, 0x94, 0xa5, 0x46, 0xad, 0x0f,
+	0xb4, 0x11, 0x13, 0xc2, 0x2b, 0x18, 0x22, 0x8d, 0x89, 0x98, 0x25, 0xf8, 0xe0, 0xcb, 0x66, 0xba,
+	0x73, 0xdd, 0x8e, 0xd9, 0xce, 0x6c, 0x76, 0x66, 0x11, 0xfe, 0x85, 0x3e, 0xf8, 0x4f, 0xf8, 0x11,
+	0x3c, 0x12, 0x9e, 0x8c, 0x0f, 0xc4, 0x40, 0xfc, 0x1f, 0x66, 0x67, 0xbf, 0x5a, 0xad, 0x1f, 0x7d,
+	0xeb, 0x9c, 0x7b, 0xcf, 0x9c, 0xdb, 0x7b, 0xce, 0x0e, 0xda, 0x70, 0x03, 0x00, 0xf6, 0x9e, 0x82,
+	0x47, 0x7a, 0xce, 0x10, 0x7b, 0x1e, 0x30, 0x17, 0x7a, 0x70, 0x02, 0x4c, 0x8a, 0xae, 0x1f, 0x70,
+	0xc9, 0xf5, 0x66, 0xde, 0xd2, 0xcd, 0x5a, 0xd6, 0xee, 0x3b, 0x5c, 0x8c, 0xb8, 0xb0, 0x55, 0x4f,
+	0x2f, 0x3e, 0xc4, 0x84, 0xb5, 0xa6, 0xcb, 0x5d, 0x1e, 0xe3, 0xd1, 0xaf, 0x04, 0x35, 0xa7, 0x2a,
+	0xc9, 0x33, 0x1f, 0x12, 0x5e, 0xfb, 0x73, 0x09, 0xad, 0xec, 0x47, 0xca, 0x47, 0x12, 0x07, 0xf2,
+	0x45, 0xda, 0xa3, 0x6f, 0xa0, 0x85, 0x8c, 0x60, 0x53, 0x62, 0x68, 0xa6, 0xd6, 0x29, 0x59, 0xb5,
+	0x0c, 0xeb, 0x13, 0x7d, 0x07, 0x55, 0xf9, 0xe0, 0x03, 0x38, 0x32, 0xaa, 0xcf, 0x99, 0x5a, 0xa7,
+	0xba, 0xf7, 0xe0, 0xe2, 0x7a, 0xbd, 0xf0, 0xed, 0x7a, 0xbd, 0x74, 0x4c, 0x99, 0xbc, 0x3a, 0xdf,
+	0xac, 0x25, 0x33, 0x46, 0x47, 0x6b, 0x3e, 0xee, 0xee, 0x13, 0xfd, 0x21, 0xaa, 0x0b, 0x70, 0x47,
+	0xc0, 0xa4, 0x4d, 0x19, 0x81, 0x53, 0xa3, 0x68, 0x6a, 0x9d, 0xba, 0xb5, 0x90, 0x80, 0xfd, 0x08,
+	0xd3, 0x57, 0x50, 0x59, 0xf8, 0xd1, 0xd5, 0x25, 0x55, 0x2c, 0x09, 0xbf, 0x4f, 0xf4, 0x03, 0xb4,
+	0x22, 0x7c, 0x9b, 0xfb, 0x10, 0x60, 0xc9, 0x03, 0x1b, 0x13, 0x12, 0x80, 0x10, 0x46, 0x59, 0xa9,
+	0x1b, 0x57, 0xe7, 0x9b, 0xcd, 0x44, 0x71, 0x37, 0xae, 0x1c, 0xc9, 0x80, 0x32, 0xd7, 0x6a, 0x08,
+	0xff, 0x30, 0xe1, 0x24, 0x05, 0xfd, 0x29, 0x5a, 0x0e, 0x80, 0x84, 0x8c, 0x60, 0xe6, 0x9c, 0x25,
+	0x63, 0x54, 0x4c, 0xad, 0x53, 0xb6, 0x96, 0x72, 0x3c, 0x9e, 0xe4, 0x25, 0xd2, 0xb3, 0xff, 0x9d,
+	0x6b, 0xde, 0xf9, 0x97, 0x66, 0xce, 0x49, 0x35, 0x1f, 0xa1, 0x45, 0x38, 0xf5, 0x69, 0x00, 0xc4,
+	0x1e, 0x02, 0x75, 0x87, 0xd2, 0x98, 0x57, 0x6b, 0xad, 0x27, 0xe8, 0x81, 0x02, 0xf5, 0x27, 0x68,
+	0x69, 0x62, 0x3d, 0x20, 0x8c, 0xaa, 0x59, 0xec, 0xd4, 0xad, 0xc5, 0xf1, 0x05, 0x81, 0xd0, 0x8f,
+	0x91, 0x8e, 0x09, 0xa1, 0x92, 0x72, 0x86, 0x3d, 0x3b, 0x5e, 0xaf, 0x30, 0x90, 0x59, 0xec, 0xd4,
+	0xb6, 0x1e, 0x77, 0xa7, 0x45, 0xa8, 0x9b, 0x39, 0x4c, 0x0e, 0x55, 0xbb, 0xd5, 0xc8, 0x6f, 0x88,
+	0x11, 0xd1, 0xfe, 0x51, 0x44, 0x4d, 0x95, 0x89, 0x5d, 0x29, 0x41, 0xcc, 0x1a, 0x8a, 0x4a, 0x00,
+	0x22, 0xf4, 0xa4, 0x4a, 0xc4, 0xe2, 0x96, 0x39, 0x7d, 0x8c, 0xb7, 0x5c, 0x82, 0xa5, 0xfa, 0xac,
+	0xa4, 0x3f, 0xf7, 0xbb, 0x38, 0xe6, 0xf7, 0x06, 0x5a, 0x10, 0x1e, 0x16, 0x43, 0x1b, 0x8f, 0x78,
+	0xc8, 0xa4, 0xca, 0x42, 0xd5, 0xaa, 0x29, 0x6c, 0x57, 0x41, 0x7f, 0x70, 0xa7, 0x3c, 0xbb, 0x3b,
+	0x3b, 0xc8, 0x18, 0xbb, 0x28, 0x80, 0x8f, 0x38, 0x20, 0xa9, 0x6e, 0x45, 0xe9, 0xde, 0xcb, 0xeb,
+	0x96, 0x2a, 0x27, 0x23, 0xec, 0xa3, 0x86, 0x08, 0x07, 0x23, 0x2a, 0xe5, 0x0c, 0xf9, 0x58, 0xce,
+	0x28, 0xe9, 0x00, 0xdb, 0x68, 0x35, 0xbf, 0x66, 0x52, 0x7f, 0x5e, 0xe9, 0xdf, 0xcd, 0xca, 0x13,
+	0xf2, 0xdb, 0x68, 0xf5, 0x04, 0x7b, 0x94, 0xa8, 0x4f, 0x62, 0x92, 0x87, 0x62, 0x5e, 0x56, 0x1e,
+	0xe7, 0xb5, 0xbf, 0x68, 0xa8, 0xa1, 0x7c, 0x7e, 0x03, 0x8c, 0x50, 0xe6, 0x1e, 0x45, 0x5b, 0xfd,
+	0x1f, 0x93, 0x33, 0xab, 0xe6, 0xfe, 0x62, 0x55, 0xf1, 0x77, 0xab, 0x54, 0xfe, 0xc1, 0x09, 0x25,
+	0xa4, 0xf9, 0x2f, 0xa5, 0xf9, 0x57, 0x68, 0x9c, 0xff, 0xf6, 0xeb, 0x34, 0x7e, 0xbe, 0x0f, 0xd8,
+	0x9b, 0x29, 0x7e, 0xd3, 0x26, 0xdb, 0x7b, 0x75, 0x71, 0xd3, 0xd2, 0x2e, 0x6f, 0x5a, 0xda, 0xf7,
+	0x9b, 0x96, 0xf6, 0xe9, 0xb6, 0x55, 0xb8, 0xbc, 0x6d, 0x15, 0xbe, 0xde, 0xb6, 0x0a, 0xef, 0x9e,
+	0xb9, 0x54, 0x0e, 0xc3, 0x41, 0xd7, 0xe1, 0xa3, 0xde, 0x80, 0x0d, 0x36, 0x9d, 0x21, 0xa6, 0xac,
+	0x37, 0xf6, 0x68, 0x9e, 0xfe, 0xfa, 0x6c, 0x0e, 0x2a, 0xea, 0xdd, 0x7c, 0xfe, 0x33, 0x00, 0x00,
+	0xff, 0xff, 0x5c, 0x78, 0xf4, 0x5d, 0xc5, 0x05, 0x00, 0x00,
 }
 
 func (m *EventStartChallenge) Marshal() (dAtA []byte, err error) {
@@ -309,6 +465,38 @@ func (m *EventStartChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AdditionalObjects) > 0 {
+		for iNdEx := len(m.AdditionalObjects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalObjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SegmentIndexes) > 0 {
+		dAtA2 := make([]byte, len(m.SegmentIndexes)*10)
+		var j1 int
+		for _, num := range m.SegmentIndexes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintEvents(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.ExpiredHeight != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.ExpiredHeight))
 		i--
@@ -441,6 +629,84 @@ func (m *EventAttestChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventPendingSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPendingSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPendingSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecuteHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExecuteHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SlashAmount) > 0 {
+		i -= len(m.SlashAmount)
+		copy(dAtA[i:], m.SlashAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SlashAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAppealChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAppealChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAppealChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -483,6 +749,19 @@ func (m *EventStartChallenge) Size() (n int) {
 	if m.ExpiredHeight != 0 {
 		n += 1 + sovEvents(uint64(m.ExpiredHeight))
 	}
+	if len(m.SegmentIndexes) > 0 {
+		l = 0
+		for _, e := range m.SegmentIndexes {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	if len(m.AdditionalObjects) > 0 {
+		for _, e := range m.AdditionalObjects {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -528,6 +807,43 @@ func (m *EventAttestChallenge) Size() (n int) {
 	return n
 }
 
+func (m *EventPendingSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		n += 1 + sovEvents(uint64(m.ChallengeId))
+	}
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	l = len(m.SlashAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ExecuteHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ExecuteHeight))
+	}
+	return n
+}
+
+func (m *EventAppealChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		n += 1 + sovEvents(uint64(m.ChallengeId))
+	}
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -756,6 +1072,116 @@ func (m *EventStartChallenge) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SegmentIndexes = append(m.SegmentIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SegmentIndexes) == 0 {
+					m.SegmentIndexes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SegmentIndexes = append(m.SegmentIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentIndexes", wireType)
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalObjects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalObjects = append(m.AdditionalObjects, &ChallengedObject{})
+			if err := m.AdditionalObjects[len(m.AdditionalObjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1076,6 +1502,233 @@ func (m *EventAttestChallenge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventPendingSlash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPendingSlash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPendingSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteHeight", wireType)
+			}
+			m.ExecuteHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAppealChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAppealChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAppealChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,6 +16,8 @@ type SpKeeper interface {
 	GetStorageProviderByOperatorAddr(ctx sdk.Context, opAddr sdk.AccAddress) (sp *sp.StorageProvider, found bool)
 	DepositDenomForSP(ctx sdk.Context) (res string)
 	Slash(ctx sdk.Context, spID uint32, rewardInfos []sp.RewardInfo) error
+	RecordSpChallengeResult(ctx sdk.Context, spId uint32, slashed bool)
+	JailStorageProvider(ctx sdk.Context, spId uint32) error
 }
 
 type StakingKeeper interface {
@@ -33,6 +35,10 @@ type StorageKeeper interface {
 	MustGetPrimarySPForBucket(ctx sdk.Context, bucketInfo *storage.BucketInfo) *sp.StorageProvider
 }
 
+type VirtualGroupKeeper interface {
+	GetGVGSwappedHeight(ctx sdk.Context, gvgID uint32) (uint64, bool)
+}
+
 type PaymentKeeper interface {
 	QueryDynamicBalance(ctx sdk.Context, addr sdk.AccAddress) (amount sdkmath.Int, err error)
 	Withdraw(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amount sdkmath.Int) error
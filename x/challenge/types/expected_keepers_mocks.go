@@ -84,6 +84,32 @@ func (mr *MockSpKeeperMockRecorder) GetStorageProviderByOperatorAddr(ctx, opAddr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageProviderByOperatorAddr", reflect.TypeOf((*MockSpKeeper)(nil).GetStorageProviderByOperatorAddr), ctx, opAddr)
 }
 
+// JailStorageProvider mocks base method.
+func (m *MockSpKeeper) JailStorageProvider(ctx types2.Context, spId uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JailStorageProvider", ctx, spId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JailStorageProvider indicates an expected call of JailStorageProvider.
+func (mr *MockSpKeeperMockRecorder) JailStorageProvider(ctx, spId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailStorageProvider", reflect.TypeOf((*MockSpKeeper)(nil).JailStorageProvider), ctx, spId)
+}
+
+// RecordSpChallengeResult mocks base method.
+func (m *MockSpKeeper) RecordSpChallengeResult(ctx types2.Context, spId uint32, slashed bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordSpChallengeResult", ctx, spId, slashed)
+}
+
+// RecordSpChallengeResult indicates an expected call of RecordSpChallengeResult.
+func (mr *MockSpKeeperMockRecorder) RecordSpChallengeResult(ctx, spId, slashed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSpChallengeResult", reflect.TypeOf((*MockSpKeeper)(nil).RecordSpChallengeResult), ctx, spId, slashed)
+}
+
 // Slash mocks base method.
 func (m *MockSpKeeper) Slash(ctx types2.Context, spID uint32, rewardInfos []types.RewardInfo) error {
 	m.ctrl.T.Helper()
@@ -276,6 +302,44 @@ func (mr *MockStorageKeeperMockRecorder) MustGetPrimarySPForBucket(ctx, bucketIn
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MustGetPrimarySPForBucket", reflect.TypeOf((*MockStorageKeeper)(nil).MustGetPrimarySPForBucket), ctx, bucketInfo)
 }
 
+// MockVirtualGroupKeeper is a mock of VirtualGroupKeeper interface.
+type MockVirtualGroupKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockVirtualGroupKeeperMockRecorder
+}
+
+// MockVirtualGroupKeeperMockRecorder is the mock recorder for MockVirtualGroupKeeper.
+type MockVirtualGroupKeeperMockRecorder struct {
+	mock *MockVirtualGroupKeeper
+}
+
+// NewMockVirtualGroupKeeper creates a new mock instance.
+func NewMockVirtualGroupKeeper(ctrl *gomock.Controller) *MockVirtualGroupKeeper {
+	mock := &MockVirtualGroupKeeper{ctrl: ctrl}
+	mock.recorder = &MockVirtualGroupKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVirtualGroupKeeper) EXPECT() *MockVirtualGroupKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetGVGSwappedHeight mocks base method.
+func (m *MockVirtualGroupKeeper) GetGVGSwappedHeight(ctx types2.Context, gvgID uint32) (uint64, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGVGSwappedHeight", ctx, gvgID)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetGVGSwappedHeight indicates an expected call of GetGVGSwappedHeight.
+func (mr *MockVirtualGroupKeeperMockRecorder) GetGVGSwappedHeight(ctx, gvgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGVGSwappedHeight", reflect.TypeOf((*MockVirtualGroupKeeper)(nil).GetGVGSwappedHeight), ctx, gvgID)
+}
+
 // MockPaymentKeeper is a mock of PaymentKeeper interface.
 type MockPaymentKeeper struct {
 	ctrl     *gomock.Controller
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/math"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "challenge"
@@ -48,4 +54,136 @@ var (
 
 	// SlashAmountKeyPrefix is the prefix to count the amount of Slash for a sp.
 	SlashAmountKeyPrefix = []byte{0x18}
+
+	// ArchivedChallengePrefix is the prefix to retrieve ArchivedChallenge by challenge id.
+	ArchivedChallengePrefix = []byte{0x19}
+
+	// ChallengeBySpPrefix is the prefix to index the archived challenges by sp id.
+	ChallengeBySpPrefix = []byte{0x1A}
+
+	// ChallengeByObjectPrefix is the prefix to index the archived challenges by object id.
+	ChallengeByObjectPrefix = []byte{0x1B}
+
+	// SpChallengeStatsPrefix is the prefix to retrieve SpChallengeStats by sp id and window.
+	SpChallengeStatsPrefix = []byte{0x1C}
+
+	// ChallengeSegmentsPrefix is the prefix to retrieve ChallengeSegments of an ongoing challenge.
+	ChallengeSegmentsPrefix = []byte{0x1D}
+
+	// PendingSlashPrefix is the prefix to retrieve PendingSlash by challenge id.
+	PendingSlashPrefix = []byte{0x1E}
+
+	// PendingSlashQueuePrefix is the prefix of the queue of pending slashes ordered by the execute heights.
+	PendingSlashQueuePrefix = []byte{0x1F}
+
+	// SlashCountKeyPrefix is the prefix to count the number of Slash for a sp.
+	SlashCountKeyPrefix = []byte{0x20}
+
+	// SpChallengeStatsByWindowPrefix is the prefix to index SpChallengeStats by window, which is used for pruning.
+	SpChallengeStatsByWindowPrefix = []byte{0x21}
+
+	// LastPassedChallengePrefix is the prefix to retrieve the height of the last challenge passed by a sp on an object.
+	LastPassedChallengePrefix = []byte{0x22}
+
+	// LastPassedChallengeQueuePrefix is the prefix of the queue of the last passed challenges ordered by the heights.
+	LastPassedChallengeQueuePrefix = []byte{0x23}
 )
+
+// GetChallengeSegmentsKey returns the key of the challenged segments of an ongoing challenge
+func GetChallengeSegmentsKey(challengeId uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, challengeId)
+	return append(ChallengeSegmentsPrefix, bz...)
+}
+
+// GetPendingSlashKey returns the key of the pending slash of a challenge
+func GetPendingSlashKey(challengeId uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, challengeId)
+	return append(PendingSlashPrefix, bz...)
+}
+
+// GetPendingSlashQueueKey returns the key of a pending slash in the queue, which is ordered by the execute height first.
+// The key does not include the prefix, it is used in a prefix store.
+func GetPendingSlashQueueKey(executeHeight, challengeId uint64) []byte {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz, executeHeight)
+	binary.BigEndian.PutUint64(bz[8:], challengeId)
+	return bz
+}
+
+// GetArchivedChallengeKey returns the key of an archived challenge, which is ordered by the challenge id
+func GetArchivedChallengeKey(challengeId uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, challengeId)
+	return append(ArchivedChallengePrefix, bz...)
+}
+
+// GetChallengesBySpPrefix returns the prefix of the archived challenges of a sp
+func GetChallengesBySpPrefix(spId uint32) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, spId)
+	return append(ChallengeBySpPrefix, bz...)
+}
+
+// GetChallengeBySpKey returns the key to index an archived challenge by the sp id
+func GetChallengeBySpKey(spId uint32, challengeId uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, challengeId)
+	return append(GetChallengesBySpPrefix(spId), bz...)
+}
+
+// GetChallengesByObjectPrefix returns the prefix of the archived challenges of an object
+func GetChallengesByObjectPrefix(objectId math.Uint) []byte {
+	bz := objectId.Bytes()
+	return append(append(ChallengeByObjectPrefix, byte(len(bz))), bz...)
+}
+
+// GetChallengeByObjectKey returns the key to index an archived challenge by the object id
+func GetChallengeByObjectKey(objectId math.Uint, challengeId uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, challengeId)
+	return append(GetChallengesByObjectPrefix(objectId), bz...)
+}
+
+// GetSpChallengeStatsPrefix returns the prefix of the challenge statistics of a sp
+func GetSpChallengeStatsPrefix(spId uint32) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, spId)
+	return append(SpChallengeStatsPrefix, bz...)
+}
+
+// GetSpChallengeStatsKey returns the key of the challenge statistics of a sp in the window starting at the height
+func GetSpChallengeStatsKey(spId uint32, windowStartHeight uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, windowStartHeight)
+	return append(GetSpChallengeStatsPrefix(spId), bz...)
+}
+
+// GetSpChallengeStatsByWindowKey returns the key to index the challenge statistics of a sp by the window, which is
+// ordered by the window start height first.
+// The key does not include the prefix, it is used in a prefix store.
+func GetSpChallengeStatsByWindowKey(windowStartHeight uint64, spId uint32) []byte {
+	bz := make([]byte, 12)
+	binary.BigEndian.PutUint64(bz, windowStartHeight)
+	binary.BigEndian.PutUint32(bz[8:], spId)
+	return bz
+}
+
+// GetLastPassedChallengeKey returns the key of the height of the last challenge passed by a sp on an object
+func GetLastPassedChallengeKey(objectId math.Uint, spId uint32) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, spId)
+	objectBz := objectId.Bytes()
+	return append(append(append(LastPassedChallengePrefix, byte(len(objectBz))), objectBz...), bz...)
+}
+
+// GetLastPassedChallengeQueueKey returns the key of a last passed challenge in the queue, which is ordered by the
+// height first.
+// The key does not include the prefix, it is used in a prefix store.
+func GetLastPassedChallengeQueueKey(height uint64, spId uint32, objectId math.Uint) []byte {
+	bz := make([]byte, 12)
+	binary.BigEndian.PutUint64(bz, height)
+	binary.BigEndian.PutUint32(bz[8:], spId)
+	return append(bz, objectId.Bytes()...)
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAppealChallenge = "appeal_challenge"
+
+const (
+	// MaxAppealPieceProofs is the max number of the piece proofs of an appeal, which covers the challenged pieces of
+	// a challenge with the most objects and segments.
+	MaxAppealPieceProofs = MaxChallengeSegmentsLimit * MaxChallengeObjectsLimit
+
+	// MaxPieceProofLength is the max number of the hashes in the proof of a piece, i.e. the pieces of an object of
+	// the max payload size minus one.
+	MaxPieceProofLength = 4096
+)
+
+var _ sdk.Msg = &MsgAppealChallenge{}
+
+func NewMsgAppealChallenge(spOperatorAddress sdk.AccAddress, challengeId uint64, pieceProofs []*PieceProof) *MsgAppealChallenge {
+	return &MsgAppealChallenge{
+		SpOperatorAddress: spOperatorAddress.String(),
+		ChallengeId:       challengeId,
+		PieceProofs:       pieceProofs,
+	}
+}
+
+func (msg *MsgAppealChallenge) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAppealChallenge) Type() string {
+	return TypeMsgAppealChallenge
+}
+
+func (msg *MsgAppealChallenge) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromHexUnsafe(msg.SpOperatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAppealChallenge) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAppealChallenge) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.SpOperatorAddress)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sp operator address (%s)", err)
+	}
+
+	if len(msg.PieceProofs) == 0 {
+		return errors.Wrap(ErrInvalidAppeal, "piece proofs cannot be empty")
+	}
+	if len(msg.PieceProofs) > MaxAppealPieceProofs {
+		return errors.Wrapf(ErrInvalidAppeal, "the number of piece proofs cannot be larger than %d", MaxAppealPieceProofs)
+	}
+
+	for _, pieceProof := range msg.PieceProofs {
+		if pieceProof.ObjectId.IsNil() {
+			return errors.Wrap(ErrInvalidAppeal, "object id of piece proof cannot be empty")
+		}
+		if len(pieceProof.PieceHash) != sha256.Size {
+			return errors.Wrapf(ErrInvalidAppeal, "the length of piece hash should be %d", sha256.Size)
+		}
+		if len(pieceProof.Proof) > MaxPieceProofLength {
+			return errors.Wrapf(ErrInvalidAppeal, "the number of hashes in piece proof cannot be larger than %d", MaxPieceProofLength)
+		}
+		for _, pieceHash := range pieceProof.Proof {
+			if len(pieceHash) != sha256.Size {
+				return errors.Wrapf(ErrInvalidAppeal, "the length of piece hash should be %d", sha256.Size)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+)
+
+func TestMsgAppealChallenge_ValidateBasic(t *testing.T) {
+	pieceHash := make([]byte, sha256.Size)
+	tooManyPieceProofs := make([]*PieceProof, MaxAppealPieceProofs+1)
+	for i := range tooManyPieceProofs {
+		tooManyPieceProofs[i] = &PieceProof{ObjectId: math.NewUint(1), PieceHash: pieceHash}
+	}
+	tests := []struct {
+		name string
+		msg  MsgAppealChallenge
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAppealChallenge{
+				SpOperatorAddress: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty piece proofs",
+			msg: MsgAppealChallenge{
+				SpOperatorAddress: sample.RandAccAddressHex(),
+				ChallengeId:       1,
+			},
+			err: ErrInvalidAppeal,
+		}, {
+			name: "too many piece proofs",
+			msg: MsgAppealChallenge{
+				SpOperatorAddress: sample.RandAccAddressHex(),
+				ChallengeId:       1,
+				PieceProofs:       tooManyPieceProofs,
+			},
+			err: ErrInvalidAppeal,
+		}, {
+			name: "empty piece hash",
+			msg: MsgAppealChallenge{
+				SpOperatorAddress: sample.RandAccAddressHex(),
+				ChallengeId:       1,
+				PieceProofs:       []*PieceProof{{ObjectId: math.NewUint(1)}},
+			},
+			err: ErrInvalidAppeal,
+		}, {
+			name: "piece data instead of piece hash",
+			msg: MsgAppealChallenge{
+				SpOperatorAddress: sample.RandAccAddressHex(),
+				ChallengeId:       1,
+				PieceProofs:       []*PieceProof{{ObjectId: math.NewUint(1), PieceHash: make([]byte, 1024)}},
+			},
+			err: ErrInvalidAppeal,
+		}, {
+			name: "invalid hash in proof",
+			msg: MsgAppealChallenge{
+				SpOperatorAddress: sample.RandAccAddressHex(),
+				ChallengeId:       1,
+				PieceProofs:       []*PieceProof{{ObjectId: math.NewUint(1), PieceHash: pieceHash, Proof: [][]byte{{1, 2, 3}}}},
+			},
+			err: ErrInvalidAppeal,
+		}, {
+			name: "too long proof",
+			msg: MsgAppealChallenge{
+				SpOperatorAddress: sample.RandAccAddressHex(),
+				ChallengeId:       1,
+				PieceProofs:       []*PieceProof{{ObjectId: math.NewUint(1), PieceHash: pieceHash, Proof: make([][]byte, MaxPieceProofLength+1)}},
+			},
+			err: ErrInvalidAppeal,
+		}, {
+			name: "valid message",
+			msg: MsgAppealChallenge{
+				SpOperatorAddress: sample.RandAccAddressHex(),
+				ChallengeId:       1,
+				PieceProofs:       []*PieceProof{{ObjectId: math.NewUint(1), PieceHash: pieceHash, Proof: [][]byte{make([]byte, sha256.Size)}}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -77,6 +77,19 @@ func (msg *MsgAttest) ValidateBasic() error {
 		return errors.Wrap(ErrInvalidVoteAggSignature, "length of aggregated signature is invalid")
 	}
 
+	if len(msg.SegmentIndexes) > MaxChallengeSegmentsLimit {
+		return errors.Wrapf(ErrInvalidSegmentCount, "at most %d segments can be challenged", MaxChallengeSegmentsLimit)
+	}
+
+	if len(msg.AdditionalObjects)+1 > MaxChallengeObjectsLimit {
+		return errors.Wrapf(ErrInvalidObjectCount, "at most %d objects can be challenged", MaxChallengeObjectsLimit)
+	}
+	for _, object := range msg.AdditionalObjects {
+		if len(object.SegmentIndexes) > MaxChallengeSegmentsLimit {
+			return errors.Wrapf(ErrInvalidSegmentCount, "at most %d segments can be challenged", MaxChallengeSegmentsLimit)
+		}
+	}
+
 	return nil
 }
 
@@ -100,6 +113,27 @@ func (msg *MsgAttest) GetBlsSignBytes(chainId string) [32]byte {
 	bs = append(bs, resultBz...)
 	bs = append(bs, spOperatorBz...)
 	bs = append(bs, challengerBz...)
+	// the challenged segments are signed as well, so that the validators attest the segments they checked
+	bs = appendSegmentIndexes(bs, msg.SegmentIndexes)
+	for _, object := range msg.AdditionalObjects {
+		objectIdBz := object.ObjectId.Bytes()
+		bs = append(bs, byte(len(objectIdBz)))
+		bs = append(bs, objectIdBz...)
+		bs = binary.BigEndian.AppendUint32(bs, uint32(object.RedundancyIndex))
+		bs = appendSegmentIndexes(bs, object.SegmentIndexes)
+	}
 	hash := sdk.Keccak256Hash(bs)
 	return hash
 }
+
+// appendSegmentIndexes appends the number of the segment indexes and the indexes to the bytes
+func appendSegmentIndexes(bs []byte, segmentIndexes []uint32) []byte {
+	if len(segmentIndexes) == 0 {
+		return bs
+	}
+	bs = binary.BigEndian.AppendUint32(bs, uint32(len(segmentIndexes)))
+	for _, segmentIndex := range segmentIndexes {
+		bs = binary.BigEndian.AppendUint32(bs, segmentIndex)
+	}
+	return bs
+}
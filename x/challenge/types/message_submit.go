@@ -63,5 +63,26 @@ func (msg *MsgSubmit) ValidateBasic() error {
 		return err
 	}
 
+	if msg.SegmentCount > MaxChallengeSegmentsLimit {
+		return errors.Wrapf(ErrInvalidSegmentCount, "at most %d segments can be challenged", MaxChallengeSegmentsLimit)
+	}
+
+	if len(msg.AdditionalObjects)+1 > MaxChallengeObjectsLimit {
+		return errors.Wrapf(ErrInvalidObjectCount, "at most %d objects can be challenged", MaxChallengeObjectsLimit)
+	}
+	objects := map[ObjectToChallenge]struct{}{{BucketName: msg.BucketName, ObjectName: msg.ObjectName}: {}}
+	for _, object := range msg.AdditionalObjects {
+		if err = s3util.CheckValidBucketName(object.BucketName); err != nil {
+			return err
+		}
+		if err = s3util.CheckValidObjectName(object.ObjectName); err != nil {
+			return err
+		}
+		if _, ok := objects[*object]; ok {
+			return errors.Wrapf(ErrInvalidObjectCount, "duplicated object %s/%s", object.BucketName, object.ObjectName)
+		}
+		objects[*object] = struct{}{}
+	}
+
 	return nil
 }
@@ -59,6 +59,26 @@ func TestMsgSubmit_ValidateBasic(t *testing.T) {
 				RandomIndex:       false,
 				SegmentIndex:      2,
 			},
+		}, {
+			name: "too many segments",
+			msg: MsgSubmit{
+				Challenger:        sample.RandAccAddressHex(),
+				SpOperatorAddress: sample.RandAccAddressHex(),
+				BucketName:        "bucket",
+				ObjectName:        "object",
+				SegmentCount:      MaxChallengeSegmentsLimit + 1,
+			},
+			err: ErrInvalidSegmentCount,
+		}, {
+			name: "valid message with multiple segments",
+			msg: MsgSubmit{
+				Challenger:        sample.RandAccAddressHex(),
+				SpOperatorAddress: sample.RandAccAddressHex(),
+				BucketName:        "bucket",
+				ObjectName:        "object",
+				RandomIndex:       true,
+				SegmentCount:      4,
+			},
 		},
 	}
 	for _, tt := range tests {
@@ -83,6 +83,67 @@ var (
 	DefaultSpSlashCountingWindow = uint64(43200) // about one day
 )
 
+var (
+	KeyChallengeArchiveKeptPeriod            = []byte("ChallengeArchiveKeptPeriod")
+	DefaultChallengeArchiveKeptPeriod uint64 = 0
+)
+
+var (
+	KeySpChallengeStatsWindow            = []byte("SpChallengeStatsWindow")
+	DefaultSpChallengeStatsWindow uint64 = 0
+)
+
+var (
+	KeySamplingMode     = []byte("SamplingMode")
+	DefaultSamplingMode = SAMPLING_MODE_UNIFORM
+)
+
+var (
+	KeyMaxChallengeSegments            = []byte("MaxChallengeSegments")
+	DefaultMaxChallengeSegments uint32 = 1
+)
+
+var (
+	KeyAppealWindow            = []byte("AppealWindow")
+	DefaultAppealWindow uint64 = 0
+)
+
+var (
+	KeySlashEscalationRatio     = []byte("SlashEscalationRatio")
+	DefaultSlashEscalationRatio = sdk.ZeroDec()
+)
+
+var (
+	KeySpJailSlashCount            = []byte("SpJailSlashCount")
+	DefaultSpJailSlashCount uint64 = 0
+)
+
+var (
+	KeySpChallengeStatsKeptWindows            = []byte("SpChallengeStatsKeptWindows")
+	DefaultSpChallengeStatsKeptWindows uint64 = 30
+)
+
+var (
+	KeyRiskAgePeriod            = []byte("RiskAgePeriod")
+	DefaultRiskAgePeriod uint64 = 1296000 // about 30 days
+)
+
+var (
+	KeyRiskSwapPeriod            = []byte("RiskSwapPeriod")
+	DefaultRiskSwapPeriod uint64 = 302400 // about 7 days
+)
+
+var (
+	KeyMaxChallengeObjects            = []byte("MaxChallengeObjects")
+	DefaultMaxChallengeObjects uint32 = 1
+)
+
+// MaxChallengeSegmentsLimit is the upper bound of the MaxChallengeSegments param
+const MaxChallengeSegmentsLimit = 32
+
+// MaxChallengeObjectsLimit is the upper bound of the MaxChallengeObjects param
+const MaxChallengeObjectsLimit = 8
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -104,22 +165,44 @@ func NewParams(
 	attestationKeptCount uint64,
 	spSlashMaxAmount math.Int,
 	spSlashCountingWindow uint64,
+	challengeArchiveKeptPeriod uint64,
+	spChallengeStatsWindow uint64,
+	samplingMode SamplingMode,
+	maxChallengeSegments uint32,
+	appealWindow uint64,
+	slashEscalationRatio sdk.Dec,
+	spJailSlashCount uint64,
+	spChallengeStatsKeptWindows uint64,
+	riskAgePeriod uint64,
+	riskSwapPeriod uint64,
+	maxChallengeObjects uint32,
 ) Params {
 	return Params{
-		ChallengeCountPerBlock:    challengeCountPerBlock,
-		ChallengeKeepAlivePeriod:  challengeKeepAlivePeriod,
-		SlashCoolingOffPeriod:     slashCoolingOffPeriod,
-		SlashAmountSizeRate:       slashAmountSizeRate,
-		SlashAmountMin:            slashAmountMin,
-		SlashAmountMax:            slashAmountMax,
-		RewardValidatorRatio:      rewardValidatorRatio,
-		RewardSubmitterRatio:      rewardSubmitterRatio,
-		RewardSubmitterThreshold:  rewardSubmitterThreshold,
-		HeartbeatInterval:         heartbeatInterval,
-		AttestationInturnInterval: attestationInturnInterval,
-		AttestationKeptCount:      attestationKeptCount,
-		SpSlashMaxAmount:          spSlashMaxAmount,
-		SpSlashCountingWindow:     spSlashCountingWindow,
+		ChallengeCountPerBlock:      challengeCountPerBlock,
+		ChallengeKeepAlivePeriod:    challengeKeepAlivePeriod,
+		SlashCoolingOffPeriod:       slashCoolingOffPeriod,
+		SlashAmountSizeRate:         slashAmountSizeRate,
+		SlashAmountMin:              slashAmountMin,
+		SlashAmountMax:              slashAmountMax,
+		RewardValidatorRatio:        rewardValidatorRatio,
+		RewardSubmitterRatio:        rewardSubmitterRatio,
+		RewardSubmitterThreshold:    rewardSubmitterThreshold,
+		HeartbeatInterval:           heartbeatInterval,
+		AttestationInturnInterval:   attestationInturnInterval,
+		AttestationKeptCount:        attestationKeptCount,
+		SpSlashMaxAmount:            spSlashMaxAmount,
+		SpSlashCountingWindow:       spSlashCountingWindow,
+		ChallengeArchiveKeptPeriod:  challengeArchiveKeptPeriod,
+		SpChallengeStatsWindow:      spChallengeStatsWindow,
+		SamplingMode:                samplingMode,
+		MaxChallengeSegments:        maxChallengeSegments,
+		AppealWindow:                appealWindow,
+		SlashEscalationRatio:        slashEscalationRatio,
+		SpJailSlashCount:            spJailSlashCount,
+		SpChallengeStatsKeptWindows: spChallengeStatsKeptWindows,
+		RiskAgePeriod:               riskAgePeriod,
+		RiskSwapPeriod:              riskSwapPeriod,
+		MaxChallengeObjects:         maxChallengeObjects,
 	}
 }
 
@@ -140,6 +223,17 @@ func DefaultParams() Params {
 		DefaultAttestationKeptCount,
 		DefaultSpSlashMaxAmount,
 		DefaultSpSlashCountingWindow,
+		DefaultChallengeArchiveKeptPeriod,
+		DefaultSpChallengeStatsWindow,
+		DefaultSamplingMode,
+		DefaultMaxChallengeSegments,
+		DefaultAppealWindow,
+		DefaultSlashEscalationRatio,
+		DefaultSpJailSlashCount,
+		DefaultSpChallengeStatsKeptWindows,
+		DefaultRiskAgePeriod,
+		DefaultRiskSwapPeriod,
+		DefaultMaxChallengeObjects,
 	)
 }
 
@@ -160,6 +254,17 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyAttestationKeptCount, &p.AttestationKeptCount, validateAttestationKeptCount),
 		paramtypes.NewParamSetPair(KeySpSlashMaxAmount, &p.SpSlashMaxAmount, validateSpSlashMaxAmount),
 		paramtypes.NewParamSetPair(KeySpSlashCountingWindow, &p.SpSlashCountingWindow, validateSpSlashCountingWindow),
+		paramtypes.NewParamSetPair(KeyChallengeArchiveKeptPeriod, &p.ChallengeArchiveKeptPeriod, validateChallengeArchiveKeptPeriod),
+		paramtypes.NewParamSetPair(KeySpChallengeStatsWindow, &p.SpChallengeStatsWindow, validateSpChallengeStatsWindow),
+		paramtypes.NewParamSetPair(KeySamplingMode, &p.SamplingMode, validateSamplingMode),
+		paramtypes.NewParamSetPair(KeyMaxChallengeSegments, &p.MaxChallengeSegments, validateMaxChallengeSegments),
+		paramtypes.NewParamSetPair(KeyAppealWindow, &p.AppealWindow, validateAppealWindow),
+		paramtypes.NewParamSetPair(KeySlashEscalationRatio, &p.SlashEscalationRatio, validateSlashEscalationRatio),
+		paramtypes.NewParamSetPair(KeySpJailSlashCount, &p.SpJailSlashCount, validateSpJailSlashCount),
+		paramtypes.NewParamSetPair(KeySpChallengeStatsKeptWindows, &p.SpChallengeStatsKeptWindows, validateSpChallengeStatsKeptWindows),
+		paramtypes.NewParamSetPair(KeyRiskAgePeriod, &p.RiskAgePeriod, validateRiskAgePeriod),
+		paramtypes.NewParamSetPair(KeyRiskSwapPeriod, &p.RiskSwapPeriod, validateRiskSwapPeriod),
+		paramtypes.NewParamSetPair(KeyMaxChallengeObjects, &p.MaxChallengeObjects, validateMaxChallengeObjects),
 	}
 }
 
@@ -229,6 +334,50 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateChallengeArchiveKeptPeriod(p.ChallengeArchiveKeptPeriod); err != nil {
+		return err
+	}
+
+	if err := validateSpChallengeStatsWindow(p.SpChallengeStatsWindow); err != nil {
+		return err
+	}
+
+	if err := validateSamplingMode(p.SamplingMode); err != nil {
+		return err
+	}
+
+	if err := validateMaxChallengeSegments(p.MaxChallengeSegments); err != nil {
+		return err
+	}
+
+	if err := validateAppealWindow(p.AppealWindow); err != nil {
+		return err
+	}
+
+	if err := validateSlashEscalationRatio(p.SlashEscalationRatio); err != nil {
+		return err
+	}
+
+	if err := validateSpJailSlashCount(p.SpJailSlashCount); err != nil {
+		return err
+	}
+
+	if err := validateSpChallengeStatsKeptWindows(p.SpChallengeStatsKeptWindows); err != nil {
+		return err
+	}
+
+	if err := validateRiskAgePeriod(p.RiskAgePeriod); err != nil {
+		return err
+	}
+
+	if err := validateRiskSwapPeriod(p.RiskSwapPeriod); err != nil {
+		return err
+	}
+
+	if err := validateMaxChallengeObjects(p.MaxChallengeObjects); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -450,3 +599,149 @@ func validateSpSlashCountingWindow(v interface{}) error {
 
 	return nil
 }
+
+// validateChallengeArchiveKeptPeriod validates the ChallengeArchiveKeptPeriod param
+func validateChallengeArchiveKeptPeriod(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateSpChallengeStatsWindow validates the SpChallengeStatsWindow param
+func validateSpChallengeStatsWindow(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateSamplingMode validates the SamplingMode param
+func validateSamplingMode(v interface{}) error {
+	samplingMode, ok := v.(SamplingMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if _, ok := SamplingMode_name[int32(samplingMode)]; !ok {
+		return fmt.Errorf("invalid sampling mode: %d", samplingMode)
+	}
+
+	return nil
+}
+
+// validateMaxChallengeSegments validates the MaxChallengeSegments param
+func validateMaxChallengeSegments(v interface{}) error {
+	maxChallengeSegments, ok := v.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxChallengeSegments > MaxChallengeSegmentsLimit {
+		return fmt.Errorf("max challenge segments cannot be larger than %d", MaxChallengeSegmentsLimit)
+	}
+
+	return nil
+}
+
+// validateAppealWindow validates the AppealWindow param
+func validateAppealWindow(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateSlashEscalationRatio validates the SlashEscalationRatio param
+func validateSlashEscalationRatio(v interface{}) error {
+	slashEscalationRatio, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if slashEscalationRatio.IsNil() {
+		return errors.New("slash escalation ratio cannot be nil")
+	}
+
+	if slashEscalationRatio.LT(sdk.ZeroDec()) {
+		return errors.New("slash escalation ratio cannot be lower than zero")
+	}
+
+	return nil
+}
+
+// validateSpJailSlashCount validates the SpJailSlashCount param
+func validateSpJailSlashCount(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateSpChallengeStatsKeptWindows validates the SpChallengeStatsKeptWindows param
+func validateSpChallengeStatsKeptWindows(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateRiskAgePeriod validates the RiskAgePeriod param
+func validateRiskAgePeriod(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateRiskSwapPeriod validates the RiskSwapPeriod param
+func validateRiskSwapPeriod(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxChallengeObjects validates the MaxChallengeObjects param
+func validateMaxChallengeObjects(v interface{}) error {
+	maxChallengeObjects, ok := v.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxChallengeObjects > MaxChallengeObjectsLimit {
+		return fmt.Errorf("max challenge objects cannot be larger than %d", MaxChallengeObjectsLimit)
+	}
+
+	return nil
+}
+
+// MaxSegmentsPerChallenge returns the max number of segments challenged under one challenge, zero stands for one
+func (p Params) MaxSegmentsPerChallenge() uint32 {
+	if p.MaxChallengeSegments == 0 {
+		return 1
+	}
+	return p.MaxChallengeSegments
+}
+
+// MaxObjectsPerChallenge returns the max number of objects challenged under one challenge, zero stands for one
+func (p Params) MaxObjectsPerChallenge() uint32 {
+	if p.MaxChallengeObjects == 0 {
+		return 1
+	}
+	return p.MaxChallengeObjects
+}
@@ -25,6 +25,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SamplingMode defines how the objects are sampled for the random challenges.
+type SamplingMode int32
+
+const (
+	// The objects are sampled uniformly.
+	SAMPLING_MODE_UNIFORM SamplingMode = 0
+	// The objects are sampled with weights of the object size, the time since the object was challenged last,
+	// and the recent slashes of the storage provider.
+	SAMPLING_MODE_RISK_WEIGHTED SamplingMode = 1
+)
+
+var SamplingMode_name = map[int32]string{
+	0: "SAMPLING_MODE_UNIFORM",
+	1: "SAMPLING_MODE_RISK_WEIGHTED",
+}
+
+var SamplingMode_value = map[string]int32{
+	"SAMPLING_MODE_UNIFORM":       0,
+	"SAMPLING_MODE_RISK_WEIGHTED": 1,
+}
+
+func (x SamplingMode) String() string {
+	return proto.EnumName(SamplingMode_name, int32(x))
+}
+
+func (SamplingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2396367ee53edf57, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	// Challenges which will be emitted in each block, including user submitted or randomly triggered.
@@ -55,6 +84,34 @@ type Params struct {
 	SpSlashMaxAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=sp_slash_max_amount,json=spSlashMaxAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"sp_slash_max_amount"`
 	// The number of blocks to count how much a sp had been slashed.
 	SpSlashCountingWindow uint64 `protobuf:"varint,14,opt,name=sp_slash_counting_window,json=spSlashCountingWindow,proto3" json:"sp_slash_counting_window,omitempty" yaml:"sp_slash_counting_window"`
+	// The number of blocks to keep the archived challenges and the challenge statistics, 0 means the challenges are not archived.
+	ChallengeArchiveKeptPeriod uint64 `protobuf:"varint,15,opt,name=challenge_archive_kept_period,json=challengeArchiveKeptPeriod,proto3" json:"challenge_archive_kept_period,omitempty" yaml:"challenge_archive_kept_period"`
+	// The number of blocks of a window to count the attested challenges of a sp, 0 means the challenges are not counted.
+	SpChallengeStatsWindow uint64 `protobuf:"varint,16,opt,name=sp_challenge_stats_window,json=spChallengeStatsWindow,proto3" json:"sp_challenge_stats_window,omitempty" yaml:"sp_challenge_stats_window"`
+	// The mode to sample the objects for the random challenges.
+	SamplingMode SamplingMode `protobuf:"varint,17,opt,name=sampling_mode,json=samplingMode,proto3,enum=greenfield.challenge.SamplingMode" json:"sampling_mode,omitempty" yaml:"sampling_mode"`
+	// The max number of segments/pieces challenged under one challenge, the random challenges also challenge the number of segments/pieces.
+	// Zero stands for one segment/piece.
+	MaxChallengeSegments uint32 `protobuf:"varint,18,opt,name=max_challenge_segments,json=maxChallengeSegments,proto3" json:"max_challenge_segments,omitempty" yaml:"max_challenge_segments"`
+	// The number of blocks in which the challenged storage provider can appeal a succeed challenge before it is slashed,
+	// 0 means the storage provider is slashed immediately.
+	AppealWindow uint64 `protobuf:"varint,19,opt,name=appeal_window,json=appealWindow,proto3" json:"appeal_window,omitempty" yaml:"appeal_window"`
+	// The extra ratio of the slash amount for each earlier slash of the storage provider in the current counting window,
+	// e.g. 0.5 means the second slash is 1.5 times and the third slash is 2 times of the normal amount.
+	SlashEscalationRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,20,opt,name=slash_escalation_ratio,json=slashEscalationRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_escalation_ratio" yaml:"slash_escalation_ratio"`
+	// The number of slashes in the current counting window at which the storage provider is jailed, 0 means never jail.
+	SpJailSlashCount uint64 `protobuf:"varint,21,opt,name=sp_jail_slash_count,json=spJailSlashCount,proto3" json:"sp_jail_slash_count,omitempty" yaml:"sp_jail_slash_count"`
+	// The number of the latest statistics windows to keep the challenge statistics of the sps, 0 means never pruned.
+	SpChallengeStatsKeptWindows uint64 `protobuf:"varint,22,opt,name=sp_challenge_stats_kept_windows,json=spChallengeStatsKeptWindows,proto3" json:"sp_challenge_stats_kept_windows,omitempty" yaml:"sp_challenge_stats_kept_windows"`
+	// The number of blocks after which an object on a sp not passing any challenge gets the full age risk weight
+	// in the risk weighted sampling.
+	RiskAgePeriod uint64 `protobuf:"varint,23,opt,name=risk_age_period,json=riskAgePeriod,proto3" json:"risk_age_period,omitempty" yaml:"risk_age_period"`
+	// The number of blocks after a gvg is swapped, during which the objects in it get the swap risk weight in the risk
+	// weighted sampling, the weight decreases linearly to zero at the end of the period.
+	RiskSwapPeriod uint64 `protobuf:"varint,24,opt,name=risk_swap_period,json=riskSwapPeriod,proto3" json:"risk_swap_period,omitempty" yaml:"risk_swap_period"`
+	// The max number of objects on the same storage provider challenged under one challenge submitted by a challenger.
+	// Zero stands for one object.
+	MaxChallengeObjects uint32 `protobuf:"varint,25,opt,name=max_challenge_objects,json=maxChallengeObjects,proto3" json:"max_challenge_objects,omitempty" yaml:"max_challenge_objects"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -138,58 +195,154 @@ func (m *Params) GetSpSlashCountingWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetChallengeArchiveKeptPeriod() uint64 {
+	if m != nil {
+		return m.ChallengeArchiveKeptPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetSpChallengeStatsWindow() uint64 {
+	if m != nil {
+		return m.SpChallengeStatsWindow
+	}
+	return 0
+}
+
+func (m *Params) GetSamplingMode() SamplingMode {
+	if m != nil {
+		return m.SamplingMode
+	}
+	return SAMPLING_MODE_UNIFORM
+}
+
+func (m *Params) GetMaxChallengeSegments() uint32 {
+	if m != nil {
+		return m.MaxChallengeSegments
+	}
+	return 0
+}
+
+func (m *Params) GetAppealWindow() uint64 {
+	if m != nil {
+		return m.AppealWindow
+	}
+	return 0
+}
+
+func (m *Params) GetSpJailSlashCount() uint64 {
+	if m != nil {
+		return m.SpJailSlashCount
+	}
+	return 0
+}
+
+func (m *Params) GetSpChallengeStatsKeptWindows() uint64 {
+	if m != nil {
+		return m.SpChallengeStatsKeptWindows
+	}
+	return 0
+}
+
+func (m *Params) GetRiskAgePeriod() uint64 {
+	if m != nil {
+		return m.RiskAgePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetRiskSwapPeriod() uint64 {
+	if m != nil {
+		return m.RiskSwapPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxChallengeObjects() uint32 {
+	if m != nil {
+		return m.MaxChallengeObjects
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("greenfield.challenge.SamplingMode", SamplingMode_name, SamplingMode_value)
 	proto.RegisterType((*Params)(nil), "greenfield.challenge.Params")
 }
 
 func init() { proto.RegisterFile("greenfield/challenge/params.proto", fileDescriptor_2396367ee53edf57) }
 
 var fileDescriptor_2396367ee53edf57 = []byte{
-	// 692 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xc1, 0x4e, 0xdb, 0x4a,
-	0x14, 0x8d, 0xdf, 0xe3, 0xf1, 0xca, 0xd0, 0x22, 0x6a, 0xd2, 0x68, 0x02, 0x22, 0x06, 0xb7, 0x42,
-	0x6c, 0x48, 0x54, 0x75, 0x87, 0xba, 0x21, 0x74, 0x13, 0x51, 0x44, 0x64, 0xaa, 0x22, 0x55, 0x95,
-	0xac, 0x89, 0x73, 0x13, 0x8f, 0x62, 0xcf, 0x58, 0xf6, 0x04, 0x02, 0xeb, 0xb6, 0xeb, 0x2e, 0xbb,
-	0xec, 0x47, 0xf4, 0x23, 0x58, 0xa2, 0xae, 0xaa, 0x2e, 0xac, 0x0a, 0xfe, 0x20, 0x5f, 0x50, 0x79,
-	0xc6, 0x71, 0x4c, 0x02, 0x95, 0x50, 0x59, 0x25, 0xb9, 0xe7, 0xdc, 0x7b, 0xce, 0xcd, 0xcc, 0xbd,
-	0x83, 0xd6, 0xbb, 0x21, 0x00, 0xeb, 0x50, 0xf0, 0xda, 0x35, 0xc7, 0x25, 0x9e, 0x07, 0xac, 0x0b,
-	0xb5, 0x80, 0x84, 0xc4, 0x8f, 0xaa, 0x41, 0xc8, 0x05, 0xd7, 0x8b, 0x63, 0x4a, 0x35, 0xa3, 0x2c,
-	0x97, 0x1d, 0x1e, 0xf9, 0x3c, 0xb2, 0x25, 0xa7, 0xa6, 0x7e, 0xa8, 0x84, 0xe5, 0x62, 0x97, 0x77,
-	0xb9, 0x8a, 0x27, 0xdf, 0x54, 0xd4, 0x1c, 0xce, 0xa3, 0xd9, 0xa6, 0xac, 0xab, 0xdb, 0xa8, 0x9c,
-	0x15, 0xb2, 0x1d, 0xde, 0x67, 0xc2, 0x0e, 0x20, 0xb4, 0x5b, 0x1e, 0x77, 0x7a, 0x58, 0x5b, 0xd3,
-	0x36, 0x67, 0xea, 0xcf, 0x86, 0xb1, 0xb1, 0x76, 0x4a, 0x7c, 0x6f, 0xdb, 0xbc, 0x95, 0x6a, 0x5a,
-	0xa5, 0x0c, 0xdb, 0x4d, 0xa0, 0x26, 0x84, 0xf5, 0x04, 0xd0, 0x01, 0xad, 0x8c, 0xb3, 0x7a, 0x00,
-	0x81, 0x4d, 0x3c, 0x7a, 0x0c, 0x49, 0x2a, 0xe5, 0x6d, 0xfc, 0x8f, 0x94, 0xd8, 0x18, 0xc6, 0x86,
-	0x39, 0x29, 0x31, 0x45, 0x36, 0x2d, 0x9c, 0xa1, 0x7b, 0x00, 0xc1, 0x4e, 0x82, 0x35, 0x25, 0xa4,
-	0xbf, 0x47, 0x38, 0xf2, 0x48, 0xe4, 0xda, 0x0e, 0xe7, 0x1e, 0x65, 0x5d, 0x9b, 0x77, 0x3a, 0x23,
-	0x8d, 0x7f, 0xa5, 0xc6, 0xd3, 0x61, 0x6c, 0x18, 0x4a, 0xe3, 0x36, 0xa6, 0x69, 0x3d, 0x91, 0xd0,
-	0xae, 0x42, 0x0e, 0x3a, 0x9d, 0xb4, 0xfa, 0x07, 0x0d, 0x95, 0x54, 0x12, 0xf1, 0x65, 0xe3, 0x11,
-	0x3d, 0x03, 0x3b, 0x24, 0x02, 0xf0, 0xcc, 0x9a, 0xb6, 0x39, 0x57, 0x3f, 0x38, 0x8f, 0x8d, 0xc2,
-	0xcf, 0xd8, 0xd8, 0xe8, 0x52, 0xe1, 0xf6, 0x5b, 0x55, 0x87, 0xfb, 0xe9, 0x41, 0xa4, 0x1f, 0x5b,
-	0x51, 0xbb, 0x57, 0x13, 0xa7, 0x01, 0x44, 0xd5, 0x57, 0xe0, 0x0c, 0x63, 0x63, 0x35, 0x6f, 0x65,
-	0xb2, 0xaa, 0x69, 0x2d, 0x49, 0x60, 0x47, 0xc6, 0x0f, 0xe9, 0x19, 0x58, 0x44, 0x80, 0xde, 0x41,
-	0x8b, 0xd7, 0xf8, 0x3e, 0x65, 0xf8, 0x3f, 0xa9, 0xff, 0xf2, 0x0e, 0xfa, 0x0d, 0x26, 0xbe, 0x7f,
-	0xdb, 0x42, 0xe9, 0x3d, 0x69, 0x30, 0x61, 0x2d, 0xe4, 0xc4, 0xf6, 0x29, 0x9b, 0xd6, 0x21, 0x03,
-	0x3c, 0x7b, 0xdf, 0x3a, 0x64, 0xa0, 0x7f, 0xd4, 0x50, 0x29, 0x84, 0x13, 0x12, 0xb6, 0xed, 0x63,
-	0xe2, 0xd1, 0x36, 0x11, 0x3c, 0x4c, 0xfa, 0xa7, 0x1c, 0xff, 0xff, 0x77, 0x7f, 0xeb, 0xcd, 0x55,
-	0x4d, 0xab, 0xa8, 0x80, 0xb7, 0xa3, 0xb8, 0x95, 0x84, 0xf5, 0x4f, 0x63, 0x1f, 0x51, 0xbf, 0xe5,
-	0x53, 0x21, 0x60, 0xe4, 0xe3, 0x81, 0xf4, 0xd1, 0xbc, 0xb3, 0x8f, 0xca, 0x35, 0x1f, 0xd9, 0xb5,
-	0x9d, 0x34, 0x72, 0x38, 0x92, 0x53, 0x46, 0xce, 0xd0, 0xf2, 0x94, 0x0f, 0xe1, 0x86, 0x10, 0xb9,
-	0xdc, 0x6b, 0xe3, 0xb9, 0x7b, 0x38, 0x02, 0x3c, 0xa1, 0xfb, 0x66, 0x54, 0x5d, 0x7f, 0x8d, 0x74,
-	0x17, 0x48, 0x28, 0x5a, 0x40, 0x84, 0x4d, 0x99, 0x80, 0xf0, 0x98, 0x78, 0x18, 0xc9, 0xd9, 0x59,
-	0x1d, 0xc6, 0x46, 0x59, 0x75, 0x34, 0xcd, 0x31, 0xad, 0xc7, 0x59, 0xb0, 0x91, 0xc6, 0xf4, 0x0e,
-	0x5a, 0x21, 0x42, 0x40, 0x24, 0x92, 0xbe, 0x58, 0xc2, 0xed, 0x87, 0x6c, 0x5c, 0x76, 0x7e, 0x72,
-	0xec, 0xff, 0x40, 0x36, 0xad, 0x72, 0x0e, 0x6d, 0x48, 0x30, 0xd3, 0x39, 0x42, 0xa5, 0x7c, 0x6a,
-	0x0f, 0x02, 0xa1, 0x76, 0x13, 0x7e, 0x28, 0x25, 0xd6, 0xc7, 0x77, 0xe2, 0x66, 0x9e, 0x69, 0x15,
-	0x73, 0xc0, 0x1e, 0x04, 0x42, 0xee, 0x2f, 0xbd, 0x87, 0x96, 0xa2, 0xc0, 0x56, 0x63, 0xe0, 0x93,
-	0x41, 0x3a, 0x0a, 0xf8, 0xd1, 0x3d, 0x9c, 0xc1, 0x62, 0x14, 0x1c, 0x26, 0x75, 0xf7, 0xc9, 0x40,
-	0xcd, 0x82, 0xdc, 0x5e, 0x23, 0x31, 0xe9, 0x2a, 0xd9, 0x4b, 0x27, 0x94, 0xb5, 0xf9, 0x09, 0x5e,
-	0x98, 0xda, 0x5e, 0xb7, 0x30, 0x93, 0xed, 0xa5, 0x0a, 0xef, 0xa6, 0xc0, 0x91, 0x8c, 0x6f, 0xcf,
-	0x7c, 0xf9, 0x6a, 0x14, 0xea, 0x7b, 0xe7, 0x97, 0x15, 0xed, 0xe2, 0xb2, 0xa2, 0xfd, 0xba, 0xac,
-	0x68, 0x9f, 0xaf, 0x2a, 0x85, 0x8b, 0xab, 0x4a, 0xe1, 0xc7, 0x55, 0xa5, 0xf0, 0xee, 0x79, 0xae,
-	0x8b, 0x16, 0x6b, 0x6d, 0x39, 0x2e, 0xa1, 0xac, 0x96, 0x7b, 0x8d, 0x06, 0xb9, 0xf7, 0x48, 0x36,
-	0xd5, 0x9a, 0x95, 0x0f, 0xc9, 0x8b, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x14, 0x44, 0x79, 0xdf,
-	0xb4, 0x06, 0x00, 0x00,
+	// 1097 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x42, 0x28, 0x74, 0x48, 0x52, 0x77, 0xe3, 0xb8, 0x63, 0x87, 0x7a, 0xdd, 0xa1, 0xaa,
+	0xa2, 0x4a, 0x71, 0x04, 0xdc, 0x2a, 0x38, 0xc4, 0x49, 0x28, 0x26, 0x75, 0x63, 0x8d, 0x03, 0x91,
+	0x10, 0xd2, 0x6a, 0xbc, 0x1e, 0xdb, 0x5b, 0xef, 0x3f, 0xed, 0x4c, 0x12, 0x37, 0x67, 0x8a, 0x38,
+	0x72, 0xe4, 0x88, 0xc4, 0x57, 0xe0, 0x43, 0xf4, 0x58, 0x71, 0x42, 0x1c, 0x56, 0x28, 0x11, 0x5f,
+	0x60, 0x3f, 0x01, 0x9a, 0x99, 0xdd, 0xf5, 0xda, 0x71, 0x90, 0x2a, 0x72, 0x4a, 0xfc, 0x7e, 0xbf,
+	0x79, 0xbf, 0x37, 0x6f, 0x7e, 0xf3, 0x76, 0xc0, 0x83, 0x61, 0x48, 0xa9, 0x37, 0xb0, 0xa9, 0xd3,
+	0xdf, 0xb6, 0x46, 0xc4, 0x71, 0xa8, 0x37, 0xa4, 0xdb, 0x01, 0x09, 0x89, 0xcb, 0x1a, 0x41, 0xe8,
+	0x73, 0x5f, 0x2f, 0x4d, 0x29, 0x8d, 0x8c, 0x52, 0xad, 0x58, 0x3e, 0x73, 0x7d, 0x66, 0x4a, 0xce,
+	0xb6, 0xfa, 0xa1, 0x16, 0x54, 0x4b, 0x43, 0x7f, 0xe8, 0xab, 0xb8, 0xf8, 0x4f, 0x45, 0xd1, 0x3f,
+	0x6b, 0xe0, 0x56, 0x47, 0xe6, 0xd5, 0x4d, 0x50, 0xc9, 0x12, 0x99, 0x96, 0x7f, 0xe2, 0x71, 0x33,
+	0xa0, 0xa1, 0xd9, 0x73, 0x7c, 0x6b, 0x0c, 0xb5, 0xba, 0xb6, 0xb9, 0xd4, 0x7c, 0x18, 0x47, 0x46,
+	0xfd, 0x25, 0x71, 0x9d, 0x27, 0xe8, 0x5a, 0x2a, 0xc2, 0xe5, 0x0c, 0xdb, 0x15, 0x50, 0x87, 0x86,
+	0x4d, 0x01, 0xe8, 0x14, 0x6c, 0x4c, 0x57, 0x8d, 0x29, 0x0d, 0x4c, 0xe2, 0xd8, 0xa7, 0x54, 0x2c,
+	0xb5, 0xfd, 0x3e, 0x7c, 0x47, 0x4a, 0x3c, 0x8a, 0x23, 0x03, 0xcd, 0x4b, 0x5c, 0x21, 0x23, 0x0c,
+	0x33, 0xf4, 0x80, 0xd2, 0x60, 0x47, 0x60, 0x1d, 0x09, 0xe9, 0xdf, 0x03, 0xc8, 0x1c, 0xc2, 0x46,
+	0xa6, 0xe5, 0xfb, 0x8e, 0xed, 0x0d, 0x4d, 0x7f, 0x30, 0x48, 0x35, 0xde, 0x95, 0x1a, 0x1f, 0xc7,
+	0x91, 0x61, 0x28, 0x8d, 0xeb, 0x98, 0x08, 0xaf, 0x4b, 0x68, 0x57, 0x21, 0x87, 0x83, 0x41, 0x92,
+	0xfd, 0x07, 0x0d, 0x94, 0xd5, 0x22, 0xe2, 0xca, 0x8d, 0x33, 0xfb, 0x9c, 0x9a, 0x21, 0xe1, 0x14,
+	0x2e, 0xd5, 0xb5, 0xcd, 0xdb, 0xcd, 0xc3, 0xd7, 0x91, 0x51, 0xf8, 0x2b, 0x32, 0x1e, 0x0d, 0x6d,
+	0x3e, 0x3a, 0xe9, 0x35, 0x2c, 0xdf, 0x4d, 0x0e, 0x22, 0xf9, 0xb3, 0xc5, 0xfa, 0xe3, 0x6d, 0xfe,
+	0x32, 0xa0, 0xac, 0xb1, 0x47, 0xad, 0x38, 0x32, 0xee, 0xe7, 0x4b, 0x99, 0xcf, 0x8a, 0xf0, 0x9a,
+	0x04, 0x76, 0x64, 0xbc, 0x6b, 0x9f, 0x53, 0x4c, 0x38, 0xd5, 0x07, 0xa0, 0x38, 0xc3, 0x77, 0x6d,
+	0x0f, 0xbe, 0x27, 0xf5, 0x3f, 0x7f, 0x0b, 0xfd, 0x96, 0xc7, 0xff, 0xf8, 0x7d, 0x0b, 0x24, 0x3e,
+	0x69, 0x79, 0x1c, 0xaf, 0xe6, 0xc4, 0xda, 0xb6, 0x77, 0x55, 0x87, 0x4c, 0xe0, 0xad, 0x9b, 0xd6,
+	0x21, 0x13, 0xfd, 0x95, 0x06, 0xca, 0x21, 0x3d, 0x23, 0x61, 0xdf, 0x3c, 0x25, 0x8e, 0xdd, 0x27,
+	0xdc, 0x0f, 0xc5, 0xfe, 0x6d, 0x1f, 0xbe, 0xff, 0xff, 0xda, 0xba, 0x38, 0x2b, 0xc2, 0x25, 0x05,
+	0x7c, 0x9b, 0xc6, 0xb1, 0x08, 0xeb, 0x3f, 0x4e, 0xeb, 0x60, 0x27, 0x3d, 0xd7, 0xe6, 0x9c, 0xa6,
+	0x75, 0x7c, 0x20, 0xeb, 0xe8, 0xbc, 0x75, 0x1d, 0xb5, 0x99, 0x3a, 0x32, 0xdb, 0xce, 0x17, 0xd2,
+	0x4d, 0xe5, 0x54, 0x21, 0xe7, 0xa0, 0x7a, 0xa5, 0x0e, 0x3e, 0x0a, 0x29, 0x1b, 0xf9, 0x4e, 0x1f,
+	0xde, 0xbe, 0x81, 0x23, 0x80, 0x73, 0xba, 0x47, 0x69, 0x76, 0xfd, 0x19, 0xd0, 0x47, 0x94, 0x84,
+	0xbc, 0x47, 0x09, 0x37, 0x6d, 0x8f, 0xd3, 0xf0, 0x94, 0x38, 0x10, 0xc8, 0xbb, 0x73, 0x3f, 0x8e,
+	0x8c, 0x8a, 0xda, 0xd1, 0x55, 0x0e, 0xc2, 0x77, 0xb3, 0x60, 0x2b, 0x89, 0xe9, 0x03, 0xb0, 0x41,
+	0x38, 0xa7, 0x8c, 0x8b, 0x7d, 0x79, 0x82, 0x7b, 0x12, 0x7a, 0xd3, 0xb4, 0x1f, 0xce, 0x5f, 0xfb,
+	0xff, 0x20, 0x23, 0x5c, 0xc9, 0xa1, 0x2d, 0x09, 0x66, 0x3a, 0xc7, 0xa0, 0x9c, 0x5f, 0x3a, 0xa6,
+	0x01, 0x57, 0xb3, 0x09, 0x2e, 0x4b, 0x89, 0x07, 0x53, 0x4f, 0x2c, 0xe6, 0x21, 0x5c, 0xca, 0x01,
+	0x07, 0x34, 0xe0, 0x72, 0x7e, 0xe9, 0x63, 0xb0, 0xc6, 0x02, 0x53, 0x5d, 0x03, 0x97, 0x4c, 0x92,
+	0xab, 0x00, 0x57, 0x6e, 0xe0, 0x0c, 0x8a, 0x2c, 0xe8, 0x8a, 0xbc, 0x6d, 0x32, 0x51, 0x77, 0x41,
+	0x4e, 0xaf, 0x54, 0x4c, 0x56, 0x25, 0xe6, 0xd2, 0x99, 0xed, 0xf5, 0xfd, 0x33, 0xb8, 0x7a, 0x65,
+	0x7a, 0x5d, 0xc3, 0x14, 0xd3, 0x4b, 0x25, 0xde, 0x4d, 0x80, 0x63, 0x19, 0xd7, 0xc7, 0xe0, 0xfe,
+	0x74, 0xaa, 0x92, 0xd0, 0x1a, 0x89, 0x91, 0x2a, 0x3b, 0x90, 0x0c, 0xc8, 0x3b, 0x52, 0x62, 0x33,
+	0x8e, 0x8c, 0x87, 0xf3, 0x43, 0x78, 0x01, 0x1d, 0xe1, 0x6a, 0x86, 0xef, 0x28, 0x58, 0xb4, 0x2d,
+	0x19, 0x95, 0x26, 0xa8, 0xb0, 0x60, 0x6a, 0x78, 0x53, 0x34, 0x96, 0xa5, 0x7b, 0x29, 0xce, 0x7f,
+	0x50, 0xae, 0xa5, 0x22, 0x5c, 0x66, 0xc1, 0x6e, 0x0a, 0x75, 0x05, 0x92, 0xec, 0x86, 0x80, 0x15,
+	0x46, 0xdc, 0x40, 0x8e, 0x6e, 0xd7, 0xef, 0x53, 0x78, 0xb7, 0xae, 0x6d, 0xae, 0x7e, 0x8a, 0x1a,
+	0x8b, 0xbe, 0x8d, 0x8d, 0x6e, 0x42, 0x6d, 0xfb, 0x7d, 0xda, 0x84, 0x71, 0x64, 0x94, 0x12, 0xe1,
+	0x7c, 0x0a, 0x84, 0x97, 0x59, 0x8e, 0x27, 0x4c, 0x25, 0x8e, 0x3c, 0x57, 0x19, 0x1d, 0xba, 0xd4,
+	0xe3, 0x0c, 0xea, 0x75, 0x6d, 0x73, 0x25, 0x6f, 0xaa, 0xc5, 0x3c, 0x84, 0x4b, 0x2e, 0x99, 0x4c,
+	0xcb, 0x4f, 0xc2, 0xfa, 0x17, 0x60, 0x85, 0x04, 0x01, 0x25, 0x4e, 0xda, 0x90, 0x35, 0xd9, 0x90,
+	0x5c, 0x5d, 0x33, 0x30, 0xc2, 0xcb, 0xea, 0x77, 0xb2, 0xf5, 0x57, 0xd9, 0x67, 0x88, 0x32, 0x8b,
+	0x38, 0xca, 0xca, 0x6a, 0x4e, 0x95, 0x6e, 0xe2, 0x33, 0x34, 0x9f, 0x15, 0xe1, 0x92, 0x04, 0xf6,
+	0xb3, 0xb8, 0x1a, 0x53, 0x6d, 0x79, 0x37, 0x5e, 0x10, 0xdb, 0xc9, 0x3b, 0x11, 0xae, 0xcb, 0xcd,
+	0xd4, 0xe2, 0xc8, 0xa8, 0x66, 0xa7, 0x3b, 0x4f, 0x42, 0xc2, 0xfd, 0x5f, 0x13, 0xdb, 0x99, 0x1a,
+	0x55, 0x0f, 0x80, 0xb1, 0xc0, 0x07, 0xd2, 0x71, 0xaa, 0x0f, 0x0c, 0x96, 0x65, 0xea, 0xc7, 0x71,
+	0x64, 0x3c, 0xba, 0xd6, 0x38, 0xf9, 0x05, 0x08, 0x6f, 0xcc, 0xdb, 0x47, 0x78, 0x54, 0xf5, 0x91,
+	0xe9, 0x4d, 0x70, 0x27, 0xb4, 0xd9, 0xd8, 0x24, 0xc3, 0xec, 0x21, 0x72, 0x4f, 0x2a, 0x54, 0xe3,
+	0xc8, 0x28, 0x27, 0xa3, 0x7b, 0x96, 0x80, 0xf0, 0x8a, 0x88, 0xec, 0x0c, 0xd3, 0x17, 0xc7, 0x3e,
+	0x28, 0x4a, 0x0a, 0x3b, 0x23, 0x41, 0x9a, 0x04, 0xca, 0x24, 0x1b, 0x71, 0x64, 0xdc, 0xcb, 0x25,
+	0xc9, 0x31, 0x10, 0x5e, 0x15, 0xa1, 0xee, 0x19, 0x09, 0x92, 0x34, 0x47, 0x60, 0x7d, 0xd6, 0x43,
+	0x7e, 0xef, 0x05, 0xb5, 0x38, 0x83, 0x15, 0x69, 0xb5, 0x7a, 0x1c, 0x19, 0x1f, 0x2d, 0xb2, 0x5a,
+	0x42, 0x43, 0x78, 0x2d, 0xef, 0xb4, 0x43, 0x15, 0x7d, 0xb2, 0xf4, 0xcb, 0xaf, 0x46, 0xe1, 0x71,
+	0x07, 0x2c, 0xe7, 0xfd, 0xaf, 0x57, 0xc0, 0x7a, 0x77, 0xa7, 0xdd, 0x79, 0xd6, 0x7a, 0xfe, 0xd4,
+	0x6c, 0x1f, 0xee, 0xed, 0x9b, 0xdf, 0x3c, 0x6f, 0x7d, 0x79, 0x88, 0xdb, 0xc5, 0x82, 0x6e, 0x80,
+	0x8d, 0x59, 0x08, 0xb7, 0xba, 0x07, 0xe6, 0xf1, 0x7e, 0xeb, 0xe9, 0x57, 0x47, 0xfb, 0x7b, 0x45,
+	0xad, 0xba, 0xf4, 0xd3, 0x6f, 0xb5, 0x42, 0xf3, 0xe0, 0xf5, 0x45, 0x4d, 0x7b, 0x73, 0x51, 0xd3,
+	0xfe, 0xbe, 0xa8, 0x69, 0x3f, 0x5f, 0xd6, 0x0a, 0x6f, 0x2e, 0x6b, 0x85, 0x3f, 0x2f, 0x6b, 0x85,
+	0xef, 0x3e, 0xc9, 0x59, 0xae, 0xe7, 0xf5, 0xb6, 0xac, 0x11, 0xb1, 0xbd, 0xed, 0xdc, 0x93, 0x76,
+	0x92, 0x7b, 0xd4, 0x4a, 0x07, 0xf6, 0x6e, 0xc9, 0xd7, 0xe8, 0x67, 0xff, 0x06, 0x00, 0x00, 0xff,
+	0xff, 0x88, 0x47, 0x3a, 0xca, 0xf9, 0x0a, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -212,6 +365,86 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxChallengeObjects != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxChallengeObjects))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.RiskSwapPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RiskSwapPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.RiskAgePeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RiskAgePeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.SpChallengeStatsKeptWindows != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SpChallengeStatsKeptWindows))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.SpJailSlashCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SpJailSlashCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	{
+		size := m.SlashEscalationRatio.Size()
+		i -= size
+		if _, err := m.SlashEscalationRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if m.AppealWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AppealWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.MaxChallengeSegments != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxChallengeSegments))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.SamplingMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SamplingMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.SpChallengeStatsWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SpChallengeStatsWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ChallengeArchiveKeptPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChallengeArchiveKeptPeriod))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.SpSlashCountingWindow != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.SpSlashCountingWindow))
 		i--
@@ -372,6 +605,38 @@ func (m *Params) Size() (n int) {
 	if m.SpSlashCountingWindow != 0 {
 		n += 1 + sovParams(uint64(m.SpSlashCountingWindow))
 	}
+	if m.ChallengeArchiveKeptPeriod != 0 {
+		n += 1 + sovParams(uint64(m.ChallengeArchiveKeptPeriod))
+	}
+	if m.SpChallengeStatsWindow != 0 {
+		n += 2 + sovParams(uint64(m.SpChallengeStatsWindow))
+	}
+	if m.SamplingMode != 0 {
+		n += 2 + sovParams(uint64(m.SamplingMode))
+	}
+	if m.MaxChallengeSegments != 0 {
+		n += 2 + sovParams(uint64(m.MaxChallengeSegments))
+	}
+	if m.AppealWindow != 0 {
+		n += 2 + sovParams(uint64(m.AppealWindow))
+	}
+	l = m.SlashEscalationRatio.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.SpJailSlashCount != 0 {
+		n += 2 + sovParams(uint64(m.SpJailSlashCount))
+	}
+	if m.SpChallengeStatsKeptWindows != 0 {
+		n += 2 + sovParams(uint64(m.SpChallengeStatsKeptWindows))
+	}
+	if m.RiskAgePeriod != 0 {
+		n += 2 + sovParams(uint64(m.RiskAgePeriod))
+	}
+	if m.RiskSwapPeriod != 0 {
+		n += 2 + sovParams(uint64(m.RiskSwapPeriod))
+	}
+	if m.MaxChallengeObjects != 0 {
+		n += 2 + sovParams(uint64(m.MaxChallengeObjects))
+	}
 	return n
 }
 
@@ -781,6 +1046,230 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeArchiveKeptPeriod", wireType)
+			}
+			m.ChallengeArchiveKeptPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeArchiveKeptPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpChallengeStatsWindow", wireType)
+			}
+			m.SpChallengeStatsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpChallengeStatsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SamplingMode", wireType)
+			}
+			m.SamplingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SamplingMode |= SamplingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChallengeSegments", wireType)
+			}
+			m.MaxChallengeSegments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxChallengeSegments |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealWindow", wireType)
+			}
+			m.AppealWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppealWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashEscalationRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashEscalationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpJailSlashCount", wireType)
+			}
+			m.SpJailSlashCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpJailSlashCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpChallengeStatsKeptWindows", wireType)
+			}
+			m.SpChallengeStatsKeptWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpChallengeStatsKeptWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskAgePeriod", wireType)
+			}
+			m.RiskAgePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RiskAgePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskSwapPeriod", wireType)
+			}
+			m.RiskSwapPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RiskSwapPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChallengeObjects", wireType)
+			}
+			m.MaxChallengeObjects = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxChallengeObjects |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
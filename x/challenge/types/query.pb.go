@@ -7,7 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -434,6 +434,327 @@ func (m *SubmitInterval) GetEnd() uint64 {
 	return 0
 }
 
+// QueryChallengesBySpRequest is request type for the Query/ChallengesBySp RPC method.
+type QueryChallengesBySpRequest struct {
+	// The id of the storage provider.
+	SpId       uint32             `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChallengesBySpRequest) Reset()         { *m = QueryChallengesBySpRequest{} }
+func (m *QueryChallengesBySpRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChallengesBySpRequest) ProtoMessage()    {}
+func (*QueryChallengesBySpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6f1807fa0a2b619, []int{9}
+}
+func (m *QueryChallengesBySpRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChallengesBySpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChallengesBySpRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChallengesBySpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChallengesBySpRequest.Merge(m, src)
+}
+func (m *QueryChallengesBySpRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChallengesBySpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChallengesBySpRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChallengesBySpRequest proto.InternalMessageInfo
+
+func (m *QueryChallengesBySpRequest) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *QueryChallengesBySpRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryChallengesBySpResponse is response type for the Query/ChallengesBySp RPC method.
+type QueryChallengesBySpResponse struct {
+	Challenges []*ArchivedChallenge `protobuf:"bytes,1,rep,name=challenges,proto3" json:"challenges,omitempty"`
+	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChallengesBySpResponse) Reset()         { *m = QueryChallengesBySpResponse{} }
+func (m *QueryChallengesBySpResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChallengesBySpResponse) ProtoMessage()    {}
+func (*QueryChallengesBySpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6f1807fa0a2b619, []int{10}
+}
+func (m *QueryChallengesBySpResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChallengesBySpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChallengesBySpResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChallengesBySpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChallengesBySpResponse.Merge(m, src)
+}
+func (m *QueryChallengesBySpResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChallengesBySpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChallengesBySpResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChallengesBySpResponse proto.InternalMessageInfo
+
+func (m *QueryChallengesBySpResponse) GetChallenges() []*ArchivedChallenge {
+	if m != nil {
+		return m.Challenges
+	}
+	return nil
+}
+
+func (m *QueryChallengesBySpResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryChallengesByObjectRequest is request type for the Query/ChallengesByObject RPC method.
+type QueryChallengesByObjectRequest struct {
+	// The id of the object info.
+	ObjectId   string             `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChallengesByObjectRequest) Reset()         { *m = QueryChallengesByObjectRequest{} }
+func (m *QueryChallengesByObjectRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChallengesByObjectRequest) ProtoMessage()    {}
+func (*QueryChallengesByObjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6f1807fa0a2b619, []int{11}
+}
+func (m *QueryChallengesByObjectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChallengesByObjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChallengesByObjectRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChallengesByObjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChallengesByObjectRequest.Merge(m, src)
+}
+func (m *QueryChallengesByObjectRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChallengesByObjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChallengesByObjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChallengesByObjectRequest proto.InternalMessageInfo
+
+func (m *QueryChallengesByObjectRequest) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *QueryChallengesByObjectRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryChallengesByObjectResponse is response type for the Query/ChallengesByObject RPC method.
+type QueryChallengesByObjectResponse struct {
+	Challenges []*ArchivedChallenge `protobuf:"bytes,1,rep,name=challenges,proto3" json:"challenges,omitempty"`
+	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChallengesByObjectResponse) Reset()         { *m = QueryChallengesByObjectResponse{} }
+func (m *QueryChallengesByObjectResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChallengesByObjectResponse) ProtoMessage()    {}
+func (*QueryChallengesByObjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6f1807fa0a2b619, []int{12}
+}
+func (m *QueryChallengesByObjectResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChallengesByObjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChallengesByObjectResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChallengesByObjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChallengesByObjectResponse.Merge(m, src)
+}
+func (m *QueryChallengesByObjectResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChallengesByObjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChallengesByObjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChallengesByObjectResponse proto.InternalMessageInfo
+
+func (m *QueryChallengesByObjectResponse) GetChallenges() []*ArchivedChallenge {
+	if m != nil {
+		return m.Challenges
+	}
+	return nil
+}
+
+func (m *QueryChallengesByObjectResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySpChallengeStatsRequest is request type for the Query/SpChallengeStats RPC method.
+type QuerySpChallengeStatsRequest struct {
+	// The id of the storage provider.
+	SpId       uint32             `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySpChallengeStatsRequest) Reset()         { *m = QuerySpChallengeStatsRequest{} }
+func (m *QuerySpChallengeStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpChallengeStatsRequest) ProtoMessage()    {}
+func (*QuerySpChallengeStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6f1807fa0a2b619, []int{13}
+}
+func (m *QuerySpChallengeStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpChallengeStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpChallengeStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpChallengeStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpChallengeStatsRequest.Merge(m, src)
+}
+func (m *QuerySpChallengeStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpChallengeStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpChallengeStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpChallengeStatsRequest proto.InternalMessageInfo
+
+func (m *QuerySpChallengeStatsRequest) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *QuerySpChallengeStatsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySpChallengeStatsResponse is response type for the Query/SpChallengeStats RPC method.
+type QuerySpChallengeStatsResponse struct {
+	Stats      []*SpChallengeStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySpChallengeStatsResponse) Reset()         { *m = QuerySpChallengeStatsResponse{} }
+func (m *QuerySpChallengeStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpChallengeStatsResponse) ProtoMessage()    {}
+func (*QuerySpChallengeStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6f1807fa0a2b619, []int{14}
+}
+func (m *QuerySpChallengeStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpChallengeStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpChallengeStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpChallengeStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpChallengeStatsResponse.Merge(m, src)
+}
+func (m *QuerySpChallengeStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpChallengeStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpChallengeStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpChallengeStatsResponse proto.InternalMessageInfo
+
+func (m *QuerySpChallengeStatsResponse) GetStats() []*SpChallengeStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *QuerySpChallengeStatsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "greenfield.challenge.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "greenfield.challenge.QueryParamsResponse")
@@ -444,52 +765,75 @@ func init() {
 	proto.RegisterType((*QueryInturnAttestationSubmitterRequest)(nil), "greenfield.challenge.QueryInturnAttestationSubmitterRequest")
 	proto.RegisterType((*QueryInturnAttestationSubmitterResponse)(nil), "greenfield.challenge.QueryInturnAttestationSubmitterResponse")
 	proto.RegisterType((*SubmitInterval)(nil), "greenfield.challenge.SubmitInterval")
+	proto.RegisterType((*QueryChallengesBySpRequest)(nil), "greenfield.challenge.QueryChallengesBySpRequest")
+	proto.RegisterType((*QueryChallengesBySpResponse)(nil), "greenfield.challenge.QueryChallengesBySpResponse")
+	proto.RegisterType((*QueryChallengesByObjectRequest)(nil), "greenfield.challenge.QueryChallengesByObjectRequest")
+	proto.RegisterType((*QueryChallengesByObjectResponse)(nil), "greenfield.challenge.QueryChallengesByObjectResponse")
+	proto.RegisterType((*QuerySpChallengeStatsRequest)(nil), "greenfield.challenge.QuerySpChallengeStatsRequest")
+	proto.RegisterType((*QuerySpChallengeStatsResponse)(nil), "greenfield.challenge.QuerySpChallengeStatsResponse")
 }
 
 func init() { proto.RegisterFile("greenfield/challenge/query.proto", fileDescriptor_f6f1807fa0a2b619) }
 
 var fileDescriptor_f6f1807fa0a2b619 = []byte{
-	// 635 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0xfb, 0x25, 0x75, 0x8a, 0x0a, 0x2c, 0x39, 0x14, 0x2b, 0x98, 0xd6, 0x0a, 0x6d, 0xa8,
-	0x44, 0xdc, 0xa4, 0x3d, 0x54, 0x01, 0x0e, 0x04, 0x21, 0x14, 0x15, 0xa4, 0xd6, 0xdc, 0xb8, 0x58,
-	0xeb, 0x64, 0x71, 0x2c, 0x9c, 0x5d, 0xd7, 0xbb, 0xae, 0xc8, 0x15, 0xfe, 0x00, 0x12, 0x17, 0x7e,
-	0x06, 0x3f, 0xa3, 0x17, 0xa4, 0x4a, 0x08, 0x89, 0x13, 0x42, 0x09, 0x07, 0x7e, 0x06, 0xca, 0x7a,
-	0xe3, 0x36, 0xc4, 0x49, 0x68, 0x6f, 0xde, 0xf1, 0x7b, 0x6f, 0xde, 0x1b, 0xcf, 0x1a, 0xd6, 0xbd,
-	0x88, 0x10, 0xfa, 0xc6, 0x27, 0x41, 0xcb, 0x6a, 0xb6, 0x71, 0x10, 0x10, 0xea, 0x11, 0xeb, 0x38,
-	0x26, 0x51, 0xb7, 0x1c, 0x46, 0x4c, 0x30, 0x94, 0x3f, 0x47, 0x94, 0x53, 0x84, 0xbe, 0xdd, 0x64,
-	0xbc, 0xc3, 0xb8, 0xe5, 0x62, 0xae, 0xe0, 0xd6, 0x49, 0xc5, 0x25, 0x02, 0x57, 0xac, 0x10, 0x7b,
-	0x3e, 0xc5, 0xc2, 0x67, 0x34, 0x51, 0xd0, 0x6f, 0x27, 0x58, 0x47, 0x9e, 0xac, 0xe4, 0xa0, 0x5e,
-	0xe5, 0x3d, 0xe6, 0xb1, 0xa4, 0x3e, 0x78, 0x52, 0xd5, 0x82, 0xc7, 0x98, 0x17, 0x10, 0x0b, 0x87,
-	0xbe, 0x85, 0x29, 0x65, 0x42, 0xaa, 0x0d, 0x39, 0x1b, 0x99, 0x96, 0x43, 0x1c, 0xe1, 0xce, 0x10,
-	0x92, 0x9d, 0x4a, 0x74, 0x43, 0xa2, 0x10, 0x66, 0x1e, 0xd0, 0xd1, 0xc0, 0xf5, 0xa1, 0xa4, 0xd9,
-	0xe4, 0x38, 0x26, 0x5c, 0x98, 0x47, 0x70, 0x6b, 0xa4, 0xca, 0x43, 0x46, 0x39, 0x41, 0x35, 0x58,
-	0x4a, 0xe4, 0xd7, 0xb4, 0x75, 0xad, 0xb4, 0x52, 0x2d, 0x94, 0xb3, 0x66, 0x52, 0x4e, 0x58, 0xf5,
-	0x85, 0xd3, 0x9f, 0x77, 0x73, 0xb6, 0x62, 0x98, 0x75, 0xb8, 0x23, 0x25, 0x9f, 0x08, 0x41, 0xb8,
-	0x20, 0xad, 0xa7, 0x43, 0xb8, 0xea, 0x89, 0x36, 0xe0, 0x5a, 0x2a, 0xe1, 0xf8, 0x2d, 0xd9, 0x62,
-	0xc1, 0x5e, 0x49, 0x6b, 0x8d, 0x96, 0xe9, 0x81, 0x31, 0x49, 0x43, 0x39, 0x7c, 0x06, 0xcb, 0x29,
-	0x41, 0x99, 0xdc, 0xca, 0x36, 0x39, 0xae, 0x71, 0xce, 0x34, 0x37, 0xa1, 0x28, 0x1b, 0xbd, 0xc0,
-	0x03, 0xd0, 0x18, 0x34, 0x9d, 0x53, 0x08, 0xf7, 0x66, 0xe0, 0x94, 0xaf, 0xe7, 0x00, 0xa9, 0xfa,
-	0x60, 0x7a, 0xf3, 0x97, 0x31, 0x76, 0x81, 0x6a, 0x96, 0x60, 0x53, 0x76, 0x6c, 0x50, 0x11, 0x47,
-	0x34, 0xc1, 0xca, 0xad, 0x78, 0x15, 0xbb, 0x1d, 0x5f, 0x08, 0x12, 0x0d, 0xbd, 0x7d, 0xd6, 0x60,
-	0x6b, 0x26, 0x54, 0xd9, 0x33, 0x60, 0xc5, 0x0d, 0xb8, 0x13, 0xc6, 0xae, 0xf3, 0x96, 0x74, 0xe5,
-	0xe0, 0x96, 0xed, 0x65, 0x37, 0xe0, 0x87, 0xb1, 0x7b, 0x40, 0xba, 0xe8, 0x25, 0x5c, 0xe7, 0x92,
-	0xe4, 0xf8, 0x54, 0x90, 0xe8, 0x04, 0x07, 0x6b, 0x73, 0x72, 0xb8, 0xc5, 0xec, 0x0c, 0x49, 0x87,
-	0x86, 0xc2, 0xda, 0xab, 0x7c, 0xe4, 0x6c, 0xee, 0xc3, 0xea, 0x28, 0x02, 0xe5, 0x61, 0x91, 0x0b,
-	0x1c, 0x09, 0xf5, 0xd5, 0x93, 0x03, 0xba, 0x01, 0xf3, 0x84, 0xb6, 0x64, 0xab, 0x05, 0x7b, 0xf0,
-	0x58, 0xfd, 0xb3, 0x08, 0x8b, 0x32, 0x14, 0xfa, 0xa0, 0xc1, 0x52, 0xb2, 0x68, 0xa8, 0x94, 0x6d,
-	0x62, 0x7c, 0xaf, 0xf5, 0xfb, 0xff, 0x81, 0x4c, 0x46, 0x62, 0x16, 0xdf, 0x7f, 0xfb, 0xfd, 0x69,
-	0xce, 0x40, 0x05, 0x6b, 0xca, 0x35, 0x43, 0x5f, 0x34, 0xb8, 0x39, 0xf6, 0xc1, 0xd0, 0xee, 0x94,
-	0x36, 0x93, 0xf6, 0x5f, 0xdf, 0xbb, 0x1c, 0x49, 0xd9, 0xdc, 0x91, 0x36, 0xb7, 0x51, 0x29, 0xdb,
-	0x26, 0x56, 0x44, 0x27, 0x2d, 0xa1, 0xaf, 0x1a, 0xac, 0x4d, 0xda, 0x57, 0x54, 0x9b, 0x62, 0x62,
-	0xc6, 0x65, 0xd0, 0x1f, 0x5e, 0x89, 0xab, 0x72, 0xec, 0xcb, 0x1c, 0x55, 0xb4, 0x93, 0x9d, 0x23,
-	0x90, 0x7c, 0x67, 0x3c, 0x0e, 0x47, 0xdf, 0x35, 0xd0, 0x27, 0xaf, 0x38, 0x7a, 0x34, 0xc5, 0xd5,
-	0xcc, 0x4b, 0xa4, 0x3f, 0xbe, 0x22, 0x5b, 0xa5, 0xaa, 0xc9, 0x54, 0x7b, 0xa8, 0x9a, 0x9d, 0xca,
-	0x97, 0x0a, 0x2a, 0x95, 0x94, 0x70, 0xf8, 0x50, 0xa3, 0x7e, 0x70, 0xda, 0x33, 0xb4, 0xb3, 0x9e,
-	0xa1, 0xfd, 0xea, 0x19, 0xda, 0xc7, 0xbe, 0x91, 0x3b, 0xeb, 0x1b, 0xb9, 0x1f, 0x7d, 0x23, 0xf7,
-	0xba, 0xe2, 0xf9, 0xa2, 0x1d, 0xbb, 0xe5, 0x26, 0xeb, 0x58, 0x2e, 0x75, 0x1f, 0x34, 0xdb, 0xd8,
-	0xa7, 0x17, 0x3b, 0xbc, 0xfb, 0xf7, 0x67, 0xef, 0x2e, 0xc9, 0xbf, 0xfd, 0xee, 0xdf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x0f, 0x4b, 0xb6, 0x01, 0xe7, 0x06, 0x00, 0x00,
+	// 912 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0x3b, 0xbb, 0x6d, 0x45, 0x5e, 0xa1, 0x2c, 0xb3, 0x3d, 0x14, 0x6f, 0xf1, 0xee, 0x5a,
+	0xa5, 0x2d, 0x2b, 0x61, 0x27, 0x69, 0x59, 0x55, 0x65, 0x41, 0x22, 0x08, 0x50, 0xb4, 0x20, 0xba,
+	0xce, 0x8d, 0x8b, 0x35, 0x4e, 0x06, 0xc7, 0xe0, 0xd8, 0x5e, 0xcf, 0xa4, 0x22, 0xaa, 0xf6, 0x02,
+	0xe2, 0x8e, 0x04, 0x07, 0xfe, 0x02, 0x6e, 0x08, 0x24, 0xc4, 0x1f, 0xc0, 0x6d, 0x2f, 0x48, 0x2b,
+	0x21, 0x24, 0x4e, 0x08, 0xb5, 0xfc, 0x21, 0x28, 0x33, 0x63, 0x27, 0xa9, 0xed, 0xfc, 0xa8, 0x56,
+	0x68, 0x6f, 0xf1, 0xe4, 0x7d, 0xdf, 0xfb, 0xbc, 0xe7, 0x79, 0xef, 0x19, 0x6e, 0x79, 0x09, 0xa5,
+	0xe1, 0xa7, 0x3e, 0x0d, 0x3a, 0x56, 0xbb, 0x4b, 0x82, 0x80, 0x86, 0x1e, 0xb5, 0x1e, 0xf6, 0x69,
+	0x32, 0x30, 0xe3, 0x24, 0xe2, 0x11, 0xde, 0x18, 0x59, 0x98, 0x99, 0x85, 0x76, 0xa7, 0x1d, 0xb1,
+	0x5e, 0xc4, 0x2c, 0x97, 0x30, 0x65, 0x6e, 0x9d, 0xd4, 0x5c, 0xca, 0x49, 0xcd, 0x8a, 0x89, 0xe7,
+	0x87, 0x84, 0xfb, 0x51, 0x28, 0x3d, 0x68, 0x2f, 0x4b, 0x5b, 0x47, 0x3c, 0x59, 0xf2, 0x41, 0xfd,
+	0xb5, 0xe1, 0x45, 0x5e, 0x24, 0xcf, 0x87, 0xbf, 0xd4, 0xe9, 0x96, 0x17, 0x45, 0x5e, 0x40, 0x2d,
+	0x12, 0xfb, 0x16, 0x09, 0xc3, 0x88, 0x0b, 0x6f, 0xa9, 0xe6, 0x76, 0x21, 0x72, 0x4c, 0x12, 0xd2,
+	0x4b, 0x4d, 0x8a, 0xb3, 0xe2, 0x83, 0x98, 0x2a, 0x0b, 0x63, 0x03, 0xf0, 0x83, 0x21, 0xf5, 0xb1,
+	0x90, 0xd9, 0xf4, 0x61, 0x9f, 0x32, 0x6e, 0x3c, 0x80, 0xeb, 0x13, 0xa7, 0x2c, 0x8e, 0x42, 0x46,
+	0xf1, 0x11, 0xac, 0x4a, 0xf7, 0x9b, 0xe8, 0x16, 0xda, 0x5b, 0xab, 0x6f, 0x99, 0x45, 0x35, 0x31,
+	0xa5, 0xaa, 0xb1, 0xfc, 0xf8, 0xef, 0x9b, 0x4b, 0xb6, 0x52, 0x18, 0x0d, 0x78, 0x45, 0xb8, 0x7c,
+	0x87, 0x73, 0xca, 0x38, 0xed, 0xbc, 0x9b, 0x9a, 0xab, 0x98, 0xf8, 0x36, 0x3c, 0x9f, 0xb9, 0x70,
+	0xfc, 0x8e, 0x08, 0xb1, 0x6c, 0xaf, 0x65, 0x67, 0xcd, 0x8e, 0xe1, 0x81, 0x5e, 0xe6, 0x43, 0x11,
+	0xbe, 0x07, 0x95, 0x4c, 0xa0, 0x20, 0x77, 0x8b, 0x21, 0xf3, 0x3e, 0x46, 0x4a, 0x63, 0x07, 0xb6,
+	0x45, 0xa0, 0x0f, 0xc9, 0xd0, 0x28, 0x67, 0x9a, 0xd5, 0x29, 0x86, 0x57, 0x67, 0xd8, 0x29, 0xae,
+	0x0f, 0x00, 0x32, 0xef, 0xc3, 0xea, 0x5d, 0x5d, 0x04, 0x6c, 0x4c, 0x6a, 0xec, 0xc1, 0x8e, 0x88,
+	0xd8, 0x0c, 0x79, 0x3f, 0x09, 0xa5, 0xad, 0xb8, 0x15, 0xad, 0xbe, 0xdb, 0xf3, 0x39, 0xa7, 0x49,
+	0xca, 0xf6, 0x3d, 0x82, 0xdd, 0x99, 0xa6, 0x0a, 0x4f, 0x87, 0x35, 0x37, 0x60, 0x4e, 0xdc, 0x77,
+	0x9d, 0xcf, 0xe9, 0x40, 0x14, 0xae, 0x62, 0x57, 0xdc, 0x80, 0x1d, 0xf7, 0xdd, 0xfb, 0x74, 0x80,
+	0x3f, 0x82, 0x17, 0x99, 0x10, 0x39, 0x7e, 0xc8, 0x69, 0x72, 0x42, 0x82, 0xcd, 0x2b, 0xa2, 0xb8,
+	0xdb, 0xc5, 0x39, 0xc8, 0x08, 0x4d, 0x65, 0x6b, 0xaf, 0xb3, 0x89, 0x67, 0xe3, 0x10, 0xd6, 0x27,
+	0x2d, 0xf0, 0x06, 0xac, 0x30, 0x4e, 0x12, 0xae, 0xde, 0xba, 0x7c, 0xc0, 0xd7, 0xe0, 0x2a, 0x0d,
+	0x3b, 0x22, 0xd4, 0xb2, 0x3d, 0xfc, 0x69, 0x0c, 0x40, 0x13, 0x39, 0x8d, 0x4a, 0xdc, 0x18, 0xb4,
+	0xe2, 0xf4, 0x0a, 0x5d, 0x87, 0x15, 0x16, 0xa7, 0x77, 0xe7, 0x05, 0x7b, 0x99, 0xc5, 0xcd, 0x0e,
+	0x7e, 0x1f, 0x60, 0xd4, 0x89, 0x0a, 0x7b, 0xc7, 0x54, 0xdd, 0x37, 0x6c, 0x5b, 0x53, 0x76, 0xb9,
+	0x6a, 0x5b, 0xf3, 0x98, 0x64, 0x77, 0xd2, 0x1e, 0x53, 0x1a, 0x3f, 0x21, 0xb8, 0x51, 0x18, 0xfb,
+	0x12, 0xaf, 0x38, 0x69, 0x77, 0xfd, 0x93, 0x92, 0x57, 0x3c, 0x74, 0x94, 0x03, 0xde, 0x9d, 0x09,
+	0x2c, 0x29, 0x26, 0x88, 0xbf, 0x46, 0xaa, 0x5f, 0xc6, 0x89, 0x3f, 0x76, 0x3f, 0xa3, 0x6d, 0x9e,
+	0x56, 0xec, 0x06, 0x54, 0x22, 0x71, 0x90, 0x56, 0xad, 0x62, 0x3f, 0x27, 0x0f, 0x9e, 0x62, 0xe5,
+	0x7e, 0x41, 0x70, 0xb3, 0x94, 0xe3, 0x99, 0xad, 0xde, 0x29, 0x6c, 0x09, 0xe8, 0x56, 0x9c, 0x05,
+	0x6a, 0x71, 0xc2, 0xd9, 0xff, 0x72, 0xd9, 0x7e, 0x40, 0x6a, 0x5c, 0xe6, 0xa3, 0xab, 0x82, 0xdd,
+	0x13, 0x1d, 0xc3, 0xd3, 0x5a, 0xed, 0x94, 0x34, 0xe2, 0x45, 0xb9, 0x14, 0x3d, 0xb5, 0x2a, 0xd5,
+	0xbf, 0x03, 0x58, 0x11, 0xa0, 0xf8, 0x2b, 0x04, 0xab, 0x72, 0xf2, 0xe3, 0xbd, 0x62, 0x98, 0xfc,
+	0xa2, 0xd1, 0x5e, 0x9b, 0xc3, 0x52, 0x46, 0x35, 0xb6, 0xbf, 0xfc, 0xe3, 0xdf, 0x6f, 0xaf, 0xe8,
+	0x78, 0xcb, 0x9a, 0xb2, 0xf7, 0xf0, 0xcf, 0x08, 0x5e, 0xca, 0x4d, 0x50, 0xbc, 0x3f, 0x25, 0x4c,
+	0xd9, 0x42, 0xd2, 0x0e, 0x16, 0x13, 0x29, 0xcc, 0xaa, 0xc0, 0xbc, 0x83, 0xf7, 0x8a, 0x31, 0x89,
+	0x12, 0x3a, 0xd9, 0x11, 0xfe, 0x1d, 0xc1, 0x66, 0xd9, 0x02, 0xc1, 0x47, 0x53, 0x20, 0x66, 0x6c,
+	0x27, 0xed, 0xcd, 0x4b, 0x69, 0x55, 0x1e, 0x87, 0x22, 0x8f, 0x3a, 0xae, 0x16, 0xe7, 0x11, 0x08,
+	0xbd, 0x93, 0x4f, 0x87, 0xe1, 0x3f, 0x11, 0x68, 0xe5, 0x3b, 0x07, 0xdf, 0x9b, 0x42, 0x35, 0x73,
+	0xab, 0x69, 0x6f, 0x5d, 0x52, 0xad, 0xb2, 0x3a, 0x12, 0x59, 0x1d, 0xe0, 0x7a, 0x71, 0x56, 0xbe,
+	0xf0, 0xa0, 0xb2, 0x12, 0x2e, 0x1c, 0x96, 0x81, 0xff, 0x88, 0x60, 0x7d, 0x72, 0xf6, 0xe3, 0xea,
+	0x14, 0x9a, 0xc2, 0x15, 0xa5, 0xd5, 0x16, 0x50, 0x28, 0xe6, 0xbb, 0x82, 0xb9, 0x8a, 0xcd, 0x62,
+	0xe6, 0x51, 0xe5, 0x1d, 0x77, 0xe0, 0xb0, 0xd8, 0x3a, 0x15, 0x63, 0xe9, 0x11, 0xfe, 0x0d, 0x01,
+	0xce, 0x4f, 0x5c, 0x7c, 0x30, 0x27, 0xc1, 0xc4, 0xa2, 0xd0, 0xde, 0x58, 0x50, 0xa5, 0xd8, 0xdf,
+	0x16, 0xec, 0x87, 0xf8, 0xee, 0x3c, 0xec, 0x72, 0xf1, 0x58, 0xa7, 0xd9, 0x46, 0x7a, 0x84, 0x7f,
+	0x45, 0x70, 0xed, 0xe2, 0x0c, 0xc3, 0xf5, 0x29, 0x2c, 0x25, 0xd3, 0x5a, 0xdb, 0x5f, 0x48, 0x33,
+	0x5f, 0x0f, 0xb0, 0x78, 0x74, 0xed, 0x1d, 0x31, 0x57, 0xd3, 0xda, 0x37, 0xee, 0x3f, 0x3e, 0xd3,
+	0xd1, 0x93, 0x33, 0x1d, 0xfd, 0x73, 0xa6, 0xa3, 0x6f, 0xce, 0xf5, 0xa5, 0x27, 0xe7, 0xfa, 0xd2,
+	0x5f, 0xe7, 0xfa, 0xd2, 0x27, 0x35, 0xcf, 0xe7, 0xdd, 0xbe, 0x6b, 0xb6, 0xa3, 0x9e, 0xe5, 0x86,
+	0xee, 0xeb, 0xed, 0x2e, 0xf1, 0xc3, 0x71, 0xff, 0x5f, 0x5c, 0xfc, 0x52, 0x77, 0x57, 0xc5, 0xa7,
+	0xfa, 0xfe, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xca, 0x90, 0x42, 0x67, 0xa4, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -512,6 +856,12 @@ type QueryClient interface {
 	LatestAttestedChallenges(ctx context.Context, in *QueryLatestAttestedChallengesRequest, opts ...grpc.CallOption) (*QueryLatestAttestedChallengesResponse, error)
 	// Queries the inturn challenger.
 	InturnAttestationSubmitter(ctx context.Context, in *QueryInturnAttestationSubmitterRequest, opts ...grpc.CallOption) (*QueryInturnAttestationSubmitterResponse, error)
+	// Queries the archived challenges of a storage provider.
+	ChallengesBySp(ctx context.Context, in *QueryChallengesBySpRequest, opts ...grpc.CallOption) (*QueryChallengesBySpResponse, error)
+	// Queries the archived challenges of an object.
+	ChallengesByObject(ctx context.Context, in *QueryChallengesByObjectRequest, opts ...grpc.CallOption) (*QueryChallengesByObjectResponse, error)
+	// Queries the challenge statistics of a storage provider in the kept windows.
+	SpChallengeStats(ctx context.Context, in *QuerySpChallengeStatsRequest, opts ...grpc.CallOption) (*QuerySpChallengeStatsResponse, error)
 }
 
 type queryClient struct {
@@ -558,6 +908,33 @@ func (c *queryClient) InturnAttestationSubmitter(ctx context.Context, in *QueryI
 	return out, nil
 }
 
+func (c *queryClient) ChallengesBySp(ctx context.Context, in *QueryChallengesBySpRequest, opts ...grpc.CallOption) (*QueryChallengesBySpResponse, error) {
+	out := new(QueryChallengesBySpResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.challenge.Query/ChallengesBySp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChallengesByObject(ctx context.Context, in *QueryChallengesByObjectRequest, opts ...grpc.CallOption) (*QueryChallengesByObjectResponse, error) {
+	out := new(QueryChallengesByObjectResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.challenge.Query/ChallengesByObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpChallengeStats(ctx context.Context, in *QuerySpChallengeStatsRequest, opts ...grpc.CallOption) (*QuerySpChallengeStatsResponse, error) {
+	out := new(QuerySpChallengeStatsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.challenge.Query/SpChallengeStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -568,6 +945,12 @@ type QueryServer interface {
 	LatestAttestedChallenges(context.Context, *QueryLatestAttestedChallengesRequest) (*QueryLatestAttestedChallengesResponse, error)
 	// Queries the inturn challenger.
 	InturnAttestationSubmitter(context.Context, *QueryInturnAttestationSubmitterRequest) (*QueryInturnAttestationSubmitterResponse, error)
+	// Queries the archived challenges of a storage provider.
+	ChallengesBySp(context.Context, *QueryChallengesBySpRequest) (*QueryChallengesBySpResponse, error)
+	// Queries the archived challenges of an object.
+	ChallengesByObject(context.Context, *QueryChallengesByObjectRequest) (*QueryChallengesByObjectResponse, error)
+	// Queries the challenge statistics of a storage provider in the kept windows.
+	SpChallengeStats(context.Context, *QuerySpChallengeStatsRequest) (*QuerySpChallengeStatsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -586,6 +969,15 @@ func (*UnimplementedQueryServer) LatestAttestedChallenges(ctx context.Context, r
 func (*UnimplementedQueryServer) InturnAttestationSubmitter(ctx context.Context, req *QueryInturnAttestationSubmitterRequest) (*QueryInturnAttestationSubmitterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InturnAttestationSubmitter not implemented")
 }
+func (*UnimplementedQueryServer) ChallengesBySp(ctx context.Context, req *QueryChallengesBySpRequest) (*QueryChallengesBySpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChallengesBySp not implemented")
+}
+func (*UnimplementedQueryServer) ChallengesByObject(ctx context.Context, req *QueryChallengesByObjectRequest) (*QueryChallengesByObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChallengesByObject not implemented")
+}
+func (*UnimplementedQueryServer) SpChallengeStats(ctx context.Context, req *QuerySpChallengeStatsRequest) (*QuerySpChallengeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpChallengeStats not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -663,6 +1055,60 @@ func _Query_InturnAttestationSubmitter_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ChallengesBySp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChallengesBySpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChallengesBySp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.challenge.Query/ChallengesBySp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChallengesBySp(ctx, req.(*QueryChallengesBySpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChallengesByObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChallengesByObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChallengesByObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.challenge.Query/ChallengesByObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChallengesByObject(ctx, req.(*QueryChallengesByObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpChallengeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpChallengeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpChallengeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.challenge.Query/SpChallengeStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpChallengeStats(ctx, req.(*QuerySpChallengeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "greenfield.challenge.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -683,6 +1129,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InturnAttestationSubmitter",
 			Handler:    _Query_InturnAttestationSubmitter_Handler,
 		},
+		{
+			MethodName: "ChallengesBySp",
+			Handler:    _Query_ChallengesBySp_Handler,
+		},
+		{
+			MethodName: "ChallengesByObject",
+			Handler:    _Query_ChallengesByObject_Handler,
+		},
+		{
+			MethodName: "SpChallengeStats",
+			Handler:    _Query_SpChallengeStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "greenfield/challenge/query.proto",
@@ -965,8 +1423,277 @@ func (m *SubmitInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
+func (m *QueryChallengesBySpRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChallengesBySpRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChallengesBySpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChallengesBySpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChallengesBySpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChallengesBySpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Challenges) > 0 {
+		for iNdEx := len(m.Challenges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Challenges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChallengesByObjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChallengesByObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChallengesByObjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChallengesByObjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChallengesByObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChallengesByObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Challenges) > 0 {
+		for iNdEx := len(m.Challenges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Challenges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpChallengeStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpChallengeStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpChallengeStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpChallengeStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpChallengeStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpChallengeStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
 	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -1042,57 +1769,585 @@ func (m *QueryLatestAttestedChallengesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *QueryInturnAttestationSubmitterRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryInturnAttestationSubmitterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BlsPubKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.SubmitInterval != nil {
-		l = m.SubmitInterval.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	return n
+}
+
+func (m *QueryInturnAttestationSubmitterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInturnAttestationSubmitterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlsPubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SubmitInterval != nil {
+		l = m.SubmitInterval.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SubmitInterval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovQuery(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovQuery(uint64(m.End))
+	}
+	return n
+}
+
+func (m *QueryChallengesBySpRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovQuery(uint64(m.SpId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChallengesBySpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Challenges) > 0 {
+		for _, e := range m.Challenges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChallengesByObjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChallengesByObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Challenges) > 0 {
+		for _, e := range m.Challenges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpChallengeStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovQuery(uint64(m.SpId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpChallengeStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestedChallengeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestedChallengeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestedChallengeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestedChallengeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestedChallengeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestedChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Challenge == nil {
+				m.Challenge = &AttestedChallenge{}
+			}
+			if err := m.Challenge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestAttestedChallengesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestAttestedChallengesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestAttestedChallengesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestAttestedChallengesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestAttestedChallengesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestAttestedChallengesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenges = append(m.Challenges, &AttestedChallenge{})
+			if err := m.Challenges[len(m.Challenges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *SubmitInterval) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Start != 0 {
-		n += 1 + sovQuery(uint64(m.Start))
-	}
-	if m.End != 0 {
-		n += 1 + sovQuery(uint64(m.End))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInturnAttestationSubmitterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1115,10 +2370,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInturnAttestationSubmitterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInturnAttestationSubmitterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1142,7 +2397,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInturnAttestationSubmitterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1165,15 +2420,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInturnAttestationSubmitterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInturnAttestationSubmitterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsPubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitInterval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1200,7 +2487,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SubmitInterval == nil {
+				m.SubmitInterval = &SubmitInterval{}
+			}
+			if err := m.SubmitInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1225,7 +2515,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttestedChallengeRequest) Unmarshal(dAtA []byte) error {
+func (m *SubmitInterval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1248,17 +2538,17 @@ func (m *QueryAttestedChallengeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttestedChallengeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubmitInterval: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttestedChallengeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubmitInterval: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			m.ChallengeId = 0
+			m.Start = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1268,11 +2558,135 @@ func (m *QueryAttestedChallengeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChallengeId |= uint64(b&0x7F) << shift
+				m.Start |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChallengesBySpRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChallengesBySpRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChallengesBySpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1294,7 +2708,7 @@ func (m *QueryAttestedChallengeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttestedChallengeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChallengesBySpResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1317,15 +2731,15 @@ func (m *QueryAttestedChallengeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttestedChallengeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChallengesBySpResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttestedChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChallengesBySpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1352,10 +2766,44 @@ func (m *QueryAttestedChallengeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Challenge == nil {
-				m.Challenge = &AttestedChallenge{}
+			m.Challenges = append(m.Challenges, &ArchivedChallenge{})
+			if err := m.Challenges[len(m.Challenges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Challenge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1380,7 +2828,7 @@ func (m *QueryAttestedChallengeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestAttestedChallengesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChallengesByObjectRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1403,12 +2851,80 @@ func (m *QueryLatestAttestedChallengesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestAttestedChallengesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChallengesByObjectRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestAttestedChallengesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChallengesByObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1430,7 +2946,7 @@ func (m *QueryLatestAttestedChallengesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestAttestedChallengesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChallengesByObjectResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1453,10 +2969,10 @@ func (m *QueryLatestAttestedChallengesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestAttestedChallengesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChallengesByObjectResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestAttestedChallengesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChallengesByObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1488,61 +3004,47 @@ func (m *QueryLatestAttestedChallengesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Challenges = append(m.Challenges, &AttestedChallenge{})
+			m.Challenges = append(m.Challenges, &ArchivedChallenge{})
 			if err := m.Challenges[len(m.Challenges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryInturnAttestationSubmitterRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInturnAttestationSubmitterRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInturnAttestationSubmitterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1564,7 +3066,7 @@ func (m *QueryInturnAttestationSubmitterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInturnAttestationSubmitterResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySpChallengeStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1587,17 +3089,17 @@ func (m *QueryInturnAttestationSubmitterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInturnAttestationSubmitterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpChallengeStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInturnAttestationSubmitterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpChallengeStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
 			}
-			var stringLen uint64
+			m.SpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1607,27 +3109,14 @@ func (m *QueryInturnAttestationSubmitterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlsPubKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1654,10 +3143,10 @@ func (m *QueryInturnAttestationSubmitterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SubmitInterval == nil {
-				m.SubmitInterval = &SubmitInterval{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.SubmitInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1682,7 +3171,7 @@ func (m *QueryInturnAttestationSubmitterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubmitInterval) Unmarshal(dAtA []byte) error {
+func (m *QuerySpChallengeStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1705,17 +3194,17 @@ func (m *SubmitInterval) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubmitInterval: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpChallengeStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubmitInterval: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpChallengeStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			m.Start = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1725,16 +3214,31 @@ func (m *SubmitInterval) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Start |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, &SpChallengeStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.End = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1744,11 +3248,28 @@ func (m *SubmitInterval) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.End |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -45,6 +45,12 @@ type MsgSubmit struct {
 	SegmentIndex uint32 `protobuf:"varint,5,opt,name=segment_index,json=segmentIndex,proto3" json:"segment_index,omitempty"`
 	// Randomly pick a segment/piece to challenge or not.
 	RandomIndex bool `protobuf:"varint,6,opt,name=random_index,json=randomIndex,proto3" json:"random_index,omitempty"`
+	// The number of segments/pieces to challenge under the challenge, which are picked randomly if it is more than one.
+	// Zero stands for one segment/piece.
+	SegmentCount uint32 `protobuf:"varint,7,opt,name=segment_count,json=segmentCount,proto3" json:"segment_count,omitempty"`
+	// The other objects stored on the same storage provider to challenge under the challenge, the segment_count
+	// segments/pieces of each of them are picked randomly.
+	AdditionalObjects []*ObjectToChallenge `protobuf:"bytes,8,rep,name=additional_objects,json=additionalObjects,proto3" json:"additional_objects,omitempty"`
 }
 
 func (m *MsgSubmit) Reset()         { *m = MsgSubmit{} }
@@ -122,6 +128,75 @@ func (m *MsgSubmit) GetRandomIndex() bool {
 	return false
 }
 
+func (m *MsgSubmit) GetSegmentCount() uint32 {
+	if m != nil {
+		return m.SegmentCount
+	}
+	return 0
+}
+
+func (m *MsgSubmit) GetAdditionalObjects() []*ObjectToChallenge {
+	if m != nil {
+		return m.AdditionalObjects
+	}
+	return nil
+}
+
+// ObjectToChallenge identifies an object to challenge.
+type ObjectToChallenge struct {
+	// The bucket of the object info to be challenged.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// The name of the object info to be challenged.
+	ObjectName string `protobuf:"bytes,2,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+}
+
+func (m *ObjectToChallenge) Reset()         { *m = ObjectToChallenge{} }
+func (m *ObjectToChallenge) String() string { return proto.CompactTextString(m) }
+func (*ObjectToChallenge) ProtoMessage()    {}
+func (*ObjectToChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_516ed0ec90010e48, []int{1}
+}
+func (m *ObjectToChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObjectToChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObjectToChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObjectToChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectToChallenge.Merge(m, src)
+}
+func (m *ObjectToChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObjectToChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectToChallenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectToChallenge proto.InternalMessageInfo
+
+func (m *ObjectToChallenge) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
+}
+
+func (m *ObjectToChallenge) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
+}
+
 // MsgSubmitResponse defines the response of MsgSubmit.
 type MsgSubmitResponse struct {
 	// The id of the challenge.
@@ -132,7 +207,7 @@ func (m *MsgSubmitResponse) Reset()         { *m = MsgSubmitResponse{} }
 func (m *MsgSubmitResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitResponse) ProtoMessage()    {}
 func (*MsgSubmitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_516ed0ec90010e48, []int{1}
+	return fileDescriptor_516ed0ec90010e48, []int{2}
 }
 func (m *MsgSubmitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -186,13 +261,17 @@ type MsgAttest struct {
 	VoteValidatorSet []uint64 `protobuf:"fixed64,7,rep,packed,name=vote_validator_set,json=voteValidatorSet,proto3" json:"vote_validator_set,omitempty"`
 	// The aggregated BLS signature from the validators.
 	VoteAggSignature []byte `protobuf:"bytes,8,opt,name=vote_agg_signature,json=voteAggSignature,proto3" json:"vote_agg_signature,omitempty"`
+	// The challenged segment/piece indexes of the object, which should match the ones of the challenge.
+	SegmentIndexes []uint32 `protobuf:"varint,9,rep,packed,name=segment_indexes,json=segmentIndexes,proto3" json:"segment_indexes,omitempty"`
+	// The other challenged objects of the challenge, which should match the ones of the challenge.
+	AdditionalObjects []*ChallengedObject `protobuf:"bytes,10,rep,name=additional_objects,json=additionalObjects,proto3" json:"additional_objects,omitempty"`
 }
 
 func (m *MsgAttest) Reset()         { *m = MsgAttest{} }
 func (m *MsgAttest) String() string { return proto.CompactTextString(m) }
 func (*MsgAttest) ProtoMessage()    {}
 func (*MsgAttest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_516ed0ec90010e48, []int{2}
+	return fileDescriptor_516ed0ec90010e48, []int{3}
 }
 func (m *MsgAttest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -270,6 +349,20 @@ func (m *MsgAttest) GetVoteAggSignature() []byte {
 	return nil
 }
 
+func (m *MsgAttest) GetSegmentIndexes() []uint32 {
+	if m != nil {
+		return m.SegmentIndexes
+	}
+	return nil
+}
+
+func (m *MsgAttest) GetAdditionalObjects() []*ChallengedObject {
+	if m != nil {
+		return m.AdditionalObjects
+	}
+	return nil
+}
+
 // MsgAttest defines the response of MsgAttestResponse.
 type MsgAttestResponse struct {
 }
@@ -278,7 +371,7 @@ func (m *MsgAttestResponse) Reset()         { *m = MsgAttestResponse{} }
 func (m *MsgAttestResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAttestResponse) ProtoMessage()    {}
 func (*MsgAttestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_516ed0ec90010e48, []int{3}
+	return fileDescriptor_516ed0ec90010e48, []int{4}
 }
 func (m *MsgAttestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -307,6 +400,174 @@ func (m *MsgAttestResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAttestResponse proto.InternalMessageInfo
 
+// MsgAppealChallenge defines the message for a storage provider to appeal a succeed challenge in the appeal window.
+type MsgAppealChallenge struct {
+	// The operator address of the challenged storage provider.
+	SpOperatorAddress string `protobuf:"bytes,1,opt,name=sp_operator_address,json=spOperatorAddress,proto3" json:"sp_operator_address,omitempty"`
+	// The id of the challenge.
+	ChallengeId uint64 `protobuf:"varint,2,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// The integrity proofs of all the challenged pieces, including the ones of the additional objects.
+	PieceProofs []*PieceProof `protobuf:"bytes,4,rep,name=piece_proofs,json=pieceProofs,proto3" json:"piece_proofs,omitempty"`
+}
+
+func (m *MsgAppealChallenge) Reset()         { *m = MsgAppealChallenge{} }
+func (m *MsgAppealChallenge) String() string { return proto.CompactTextString(m) }
+func (*MsgAppealChallenge) ProtoMessage()    {}
+func (*MsgAppealChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_516ed0ec90010e48, []int{5}
+}
+func (m *MsgAppealChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppealChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppealChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAppealChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppealChallenge.Merge(m, src)
+}
+func (m *MsgAppealChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppealChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppealChallenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAppealChallenge proto.InternalMessageInfo
+
+func (m *MsgAppealChallenge) GetSpOperatorAddress() string {
+	if m != nil {
+		return m.SpOperatorAddress
+	}
+	return ""
+}
+
+func (m *MsgAppealChallenge) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
+	}
+	return 0
+}
+
+func (m *MsgAppealChallenge) GetPieceProofs() []*PieceProof {
+	if m != nil {
+		return m.PieceProofs
+	}
+	return nil
+}
+
+// PieceProof defines the hash of a challenged piece and the merkle proof of the hash against the integrity hash.
+type PieceProof struct {
+	// The id of the object.
+	ObjectId Uint `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+	// The index of the challenged piece.
+	SegmentIndex uint32 `protobuf:"varint,2,opt,name=segment_index,json=segmentIndex,proto3" json:"segment_index,omitempty"`
+	// The hash of the challenged piece.
+	PieceHash []byte `protobuf:"bytes,3,opt,name=piece_hash,json=pieceHash,proto3" json:"piece_hash,omitempty"`
+	// The hashes of the other pieces stored by the storage provider in order, which together with the hash of the piece
+	// should hash to the integrity hash of the storage provider in the checksums of the object info.
+	Proof [][]byte `protobuf:"bytes,4,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *PieceProof) Reset()         { *m = PieceProof{} }
+func (m *PieceProof) String() string { return proto.CompactTextString(m) }
+func (*PieceProof) ProtoMessage()    {}
+func (*PieceProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_516ed0ec90010e48, []int{6}
+}
+func (m *PieceProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PieceProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PieceProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PieceProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PieceProof.Merge(m, src)
+}
+func (m *PieceProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *PieceProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_PieceProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PieceProof proto.InternalMessageInfo
+
+func (m *PieceProof) GetSegmentIndex() uint32 {
+	if m != nil {
+		return m.SegmentIndex
+	}
+	return 0
+}
+
+func (m *PieceProof) GetPieceHash() []byte {
+	if m != nil {
+		return m.PieceHash
+	}
+	return nil
+}
+
+func (m *PieceProof) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// MsgAppealChallengeResponse defines the response of MsgAppealChallenge.
+type MsgAppealChallengeResponse struct {
+}
+
+func (m *MsgAppealChallengeResponse) Reset()         { *m = MsgAppealChallengeResponse{} }
+func (m *MsgAppealChallengeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAppealChallengeResponse) ProtoMessage()    {}
+func (*MsgAppealChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_516ed0ec90010e48, []int{7}
+}
+func (m *MsgAppealChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppealChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppealChallengeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAppealChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppealChallengeResponse.Merge(m, src)
+}
+func (m *MsgAppealChallengeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppealChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppealChallengeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAppealChallengeResponse proto.InternalMessageInfo
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 type MsgUpdateParams struct {
 	// authority is the address that controls the module (defaults to x/gov unless overwritten).
@@ -320,7 +581,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_516ed0ec90010e48, []int{4}
+	return fileDescriptor_516ed0ec90010e48, []int{8}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -371,7 +632,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_516ed0ec90010e48, []int{5}
+	return fileDescriptor_516ed0ec90010e48, []int{9}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -402,9 +663,13 @@ var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*MsgSubmit)(nil), "greenfield.challenge.MsgSubmit")
+	proto.RegisterType((*ObjectToChallenge)(nil), "greenfield.challenge.ObjectToChallenge")
 	proto.RegisterType((*MsgSubmitResponse)(nil), "greenfield.challenge.MsgSubmitResponse")
 	proto.RegisterType((*MsgAttest)(nil), "greenfield.challenge.MsgAttest")
 	proto.RegisterType((*MsgAttestResponse)(nil), "greenfield.challenge.MsgAttestResponse")
+	proto.RegisterType((*MsgAppealChallenge)(nil), "greenfield.challenge.MsgAppealChallenge")
+	proto.RegisterType((*PieceProof)(nil), "greenfield.challenge.PieceProof")
+	proto.RegisterType((*MsgAppealChallengeResponse)(nil), "greenfield.challenge.MsgAppealChallengeResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "greenfield.challenge.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "greenfield.challenge.MsgUpdateParamsResponse")
 }
@@ -412,51 +677,65 @@ func init() {
 func init() { proto.RegisterFile("greenfield/challenge/tx.proto", fileDescriptor_516ed0ec90010e48) }
 
 var fileDescriptor_516ed0ec90010e48 = []byte{
-	// 694 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x41, 0x4f, 0xdb, 0x3c,
-	0x18, 0xc7, 0x9b, 0xb6, 0x6f, 0x5f, 0xea, 0x16, 0x78, 0x31, 0x48, 0x84, 0xbe, 0x5b, 0x1b, 0x3a,
-	0x4d, 0xab, 0xa6, 0xb5, 0x11, 0x4c, 0x42, 0x88, 0x5b, 0x7b, 0x1a, 0x9a, 0xd8, 0xa6, 0x54, 0x70,
-	0xd8, 0x25, 0x72, 0x1a, 0xcf, 0xcd, 0xd6, 0xc4, 0x51, 0xec, 0x56, 0x70, 0xdd, 0x27, 0x98, 0x34,
-	0xed, 0x4b, 0xec, 0x30, 0xed, 0xc0, 0x87, 0xe0, 0x88, 0x38, 0x4d, 0x3b, 0xa0, 0x09, 0x0e, 0xfb,
-	0x1a, 0x53, 0x6c, 0x27, 0x2d, 0x10, 0x06, 0xa7, 0xc6, 0xcf, 0xf3, 0xf3, 0xf3, 0x7f, 0xfa, 0x7f,
-	0x6c, 0x83, 0x87, 0x24, 0xc2, 0x38, 0x78, 0xe7, 0xe1, 0x91, 0x6b, 0x0e, 0x86, 0x68, 0x34, 0xc2,
-	0x01, 0xc1, 0x26, 0x3f, 0xec, 0x84, 0x11, 0xe5, 0x14, 0xae, 0x4c, 0xd3, 0x9d, 0x34, 0x5d, 0x5b,
-	0x1d, 0x50, 0xe6, 0x53, 0x66, 0xfa, 0x8c, 0x98, 0x93, 0x8d, 0xf8, 0x47, 0xe2, 0xb5, 0x35, 0x99,
-	0xb0, 0xc5, 0xca, 0x94, 0x0b, 0x95, 0x5a, 0x21, 0x94, 0x50, 0x19, 0x8f, 0xbf, 0x54, 0x74, 0x3d,
-	0x53, 0x3e, 0x44, 0x11, 0xf2, 0x93, 0x8d, 0x46, 0x76, 0x87, 0x47, 0x21, 0x56, 0x44, 0xf3, 0x6b,
-	0x1e, 0x94, 0xf7, 0x18, 0xe9, 0x8f, 0x1d, 0xdf, 0xe3, 0x70, 0x1b, 0x80, 0x14, 0x8b, 0x74, 0xcd,
-	0xd0, 0x5a, 0xe5, 0x9e, 0x7e, 0x76, 0xdc, 0x5e, 0x51, 0xed, 0x74, 0x5d, 0x37, 0xc2, 0x8c, 0xf5,
-	0x79, 0xe4, 0x05, 0xc4, 0x9a, 0x61, 0xe1, 0x0b, 0xb0, 0xcc, 0x42, 0x9b, 0x86, 0x38, 0x42, 0x9c,
-	0x46, 0x36, 0x92, 0xa0, 0x9e, 0xbf, 0xa3, 0xc4, 0x12, 0x0b, 0x5f, 0xab, 0x3d, 0x2a, 0x01, 0x1b,
-	0xa0, 0xe2, 0x8c, 0x07, 0x1f, 0x30, 0xb7, 0x03, 0xe4, 0x63, 0xbd, 0x10, 0x57, 0xb0, 0x80, 0x0c,
-	0xbd, 0x42, 0x3e, 0x8e, 0x01, 0xea, 0xbc, 0xc7, 0x03, 0x05, 0x14, 0x25, 0x20, 0x43, 0x02, 0x78,
-	0x04, 0xe6, 0x19, 0x26, 0x3e, 0x0e, 0xb8, 0xed, 0x05, 0x2e, 0x3e, 0xd4, 0xff, 0x31, 0xb4, 0xd6,
-	0xbc, 0x55, 0x55, 0xc1, 0xdd, 0x38, 0x06, 0xd7, 0x41, 0x35, 0x42, 0x81, 0x4b, 0x7d, 0xc5, 0x94,
-	0x0c, 0xad, 0x35, 0x67, 0x55, 0x64, 0x4c, 0x20, 0x3b, 0x8b, 0x1f, 0x7f, 0x7f, 0x7f, 0x3a, 0xf3,
-	0x27, 0x9b, 0x5b, 0x60, 0x29, 0xf5, 0xca, 0xc2, 0x2c, 0xa4, 0x01, 0xc3, 0x71, 0xa1, 0x14, 0xb1,
-	0x3d, 0x57, 0xb8, 0x56, 0xb4, 0x2a, 0x69, 0x6c, 0xd7, 0x6d, 0x7e, 0x2b, 0x08, 0x93, 0xbb, 0x9c,
-	0x63, 0xc6, 0xe1, 0x16, 0x28, 0x33, 0x51, 0x82, 0xdf, 0xc3, 0xe3, 0x29, 0x7a, 0x43, 0x28, 0x7f,
-	0x43, 0x08, 0x6e, 0x83, 0xb2, 0xb2, 0xc6, 0x73, 0xa5, 0x73, 0xbd, 0xff, 0x4f, 0xce, 0x1b, 0xb9,
-	0x9f, 0xe7, 0x8d, 0xe2, 0xbe, 0x17, 0xf0, 0xb3, 0xe3, 0x76, 0x45, 0xc9, 0xc4, 0x4b, 0x6b, 0x4e,
-	0xd2, 0xbb, 0x2e, 0xec, 0x64, 0xcf, 0x4f, 0x9a, 0x9b, 0x31, 0xa5, 0x2e, 0xa8, 0x4c, 0x28, 0xc7,
-	0x76, 0x84, 0xd9, 0x78, 0xc4, 0x85, 0xc3, 0x0b, 0x9b, 0x46, 0x27, 0xeb, 0xc8, 0x77, 0x0e, 0x28,
-	0xc7, 0x96, 0xe0, 0x2c, 0x30, 0x49, 0xbf, 0x61, 0x1b, 0xc0, 0xa9, 0xb7, 0xa9, 0x62, 0x49, 0x2a,
-	0x4e, 0x33, 0x89, 0xe2, 0x33, 0x00, 0x85, 0xe2, 0x04, 0x8d, 0x3c, 0x57, 0x34, 0xc9, 0x30, 0xd7,
-	0xff, 0x35, 0x0a, 0xad, 0x92, 0xf5, 0x5f, 0x9c, 0x39, 0x48, 0x12, 0x7d, 0xcc, 0x53, 0x1a, 0x11,
-	0x62, 0x33, 0x8f, 0x04, 0x88, 0x8f, 0x23, 0xac, 0xcf, 0x19, 0x5a, 0xab, 0x2a, 0xe9, 0x2e, 0x21,
-	0xfd, 0x24, 0xbe, 0xb3, 0x10, 0x4f, 0x7a, 0x6a, 0x75, 0x73, 0x59, 0x0c, 0x5a, 0xce, 0x2b, 0x19,
-	0x74, 0xf3, 0x8b, 0x06, 0x16, 0xf7, 0x18, 0xd9, 0x0f, 0x5d, 0xc4, 0xf1, 0x1b, 0x71, 0xcd, 0xe2,
-	0x59, 0xa2, 0x31, 0x1f, 0xd2, 0xc8, 0xe3, 0x47, 0x77, 0xcf, 0x32, 0x45, 0xe1, 0x0e, 0x28, 0xc9,
-	0x8b, 0x2a, 0xa6, 0x58, 0xd9, 0x7c, 0x90, 0xed, 0x9c, 0x54, 0xe9, 0x15, 0xe3, 0x19, 0x5a, 0x6a,
-	0x87, 0x6a, 0x36, 0xad, 0xd5, 0x5c, 0x03, 0xab, 0xd7, 0xda, 0x4a, 0x5a, 0xde, 0xfc, 0x9c, 0x07,
-	0x85, 0x3d, 0x46, 0xa0, 0x05, 0x4a, 0xea, 0x86, 0x37, 0xb2, 0x85, 0xd2, 0x63, 0x5d, 0x7b, 0x72,
-	0x07, 0x90, 0x9e, 0x7b, 0x0b, 0x94, 0xd4, 0x81, 0xbe, 0xbd, 0xa6, 0x04, 0xfe, 0x52, 0xf3, 0xaa,
-	0xc5, 0xd0, 0x05, 0xd5, 0x2b, 0xf6, 0x3e, 0xbe, 0x75, 0xe3, 0x2c, 0x56, 0x6b, 0xdf, 0x0b, 0x4b,
-	0x54, 0x7a, 0x2f, 0x4f, 0x2e, 0xea, 0xda, 0xe9, 0x45, 0x5d, 0xfb, 0x75, 0x51, 0xd7, 0x3e, 0x5d,
-	0xd6, 0x73, 0xa7, 0x97, 0xf5, 0xdc, 0x8f, 0xcb, 0x7a, 0xee, 0xed, 0x06, 0xf1, 0xf8, 0x70, 0xec,
-	0x74, 0x06, 0xd4, 0x37, 0x9d, 0xc0, 0x69, 0x0f, 0x86, 0xc8, 0x0b, 0xcc, 0x99, 0x47, 0xf4, 0xf0,
-	0xfa, 0x33, 0xea, 0x94, 0xc4, 0x3b, 0xfa, 0xfc, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x08, 0x8b,
-	0xeb, 0x8a, 0x0d, 0x06, 0x00, 0x00,
+	// 927 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x3f, 0x6f, 0xdb, 0x46,
+	0x14, 0x37, 0x2d, 0x45, 0x95, 0x9e, 0x64, 0x3b, 0xbe, 0x18, 0x08, 0xa3, 0x26, 0xb2, 0xc2, 0xa2,
+	0xb5, 0x50, 0x54, 0x52, 0xe3, 0x02, 0x41, 0xe0, 0xcd, 0xf6, 0x12, 0xb7, 0x70, 0x63, 0x9c, 0x6b,
+	0x0f, 0x5d, 0x88, 0x93, 0x78, 0xa1, 0xd8, 0x8a, 0x3c, 0x82, 0x77, 0x32, 0x9c, 0x35, 0x9f, 0xa0,
+	0x4b, 0xf7, 0x6e, 0xdd, 0x8a, 0x0e, 0xf9, 0x10, 0x19, 0x83, 0x4c, 0x45, 0x87, 0xa0, 0xb0, 0x81,
+	0xf6, 0x6b, 0x14, 0x77, 0x47, 0x1d, 0xf5, 0x87, 0x8a, 0xe5, 0x89, 0xbc, 0xf7, 0x7e, 0xf7, 0xfe,
+	0xfe, 0xde, 0x23, 0xe1, 0x91, 0x9f, 0x50, 0x1a, 0xbd, 0x0c, 0xe8, 0xd0, 0xeb, 0xf6, 0x07, 0x64,
+	0x38, 0xa4, 0x91, 0x4f, 0xbb, 0xe2, 0xb2, 0x13, 0x27, 0x4c, 0x30, 0xb4, 0x95, 0xa9, 0x3b, 0x46,
+	0x5d, 0xbf, 0xdf, 0x67, 0x3c, 0x64, 0xbc, 0x1b, 0x72, 0xbf, 0x7b, 0xf1, 0x44, 0x3e, 0x34, 0xbc,
+	0xfe, 0x40, 0x2b, 0x5c, 0x75, 0xea, 0xea, 0x43, 0xaa, 0xda, 0xf2, 0x99, 0xcf, 0xb4, 0x5c, 0xbe,
+	0xa5, 0xd2, 0xc7, 0xb9, 0xee, 0x63, 0x92, 0x90, 0x70, 0x7c, 0xb1, 0x99, 0x1f, 0xe1, 0xab, 0x98,
+	0xa6, 0x08, 0xe7, 0xf7, 0x02, 0x54, 0x8e, 0xb9, 0x7f, 0x3a, 0xea, 0x85, 0x81, 0x40, 0xcf, 0x00,
+	0x0c, 0x2c, 0xb1, 0xad, 0xa6, 0xd5, 0xaa, 0x1c, 0xd8, 0xef, 0xdf, 0xb4, 0xb7, 0xd2, 0x70, 0xf6,
+	0x3d, 0x2f, 0xa1, 0x9c, 0x9f, 0x8a, 0x24, 0x88, 0x7c, 0x3c, 0x81, 0x45, 0xcf, 0xe1, 0x1e, 0x8f,
+	0x5d, 0x16, 0xd3, 0x84, 0x08, 0x96, 0xb8, 0x44, 0x03, 0xed, 0xd5, 0x1b, 0x4c, 0x6c, 0xf2, 0xf8,
+	0x45, 0x7a, 0x27, 0x55, 0xa0, 0x6d, 0xa8, 0xf6, 0x46, 0xfd, 0x9f, 0xa9, 0x70, 0x23, 0x12, 0x52,
+	0xbb, 0x20, 0x2d, 0x60, 0xd0, 0xa2, 0xef, 0x49, 0x48, 0x25, 0x80, 0xf5, 0x7e, 0xa2, 0xfd, 0x14,
+	0x50, 0xd4, 0x00, 0x2d, 0x52, 0x80, 0xcf, 0x60, 0x8d, 0x53, 0x3f, 0xa4, 0x91, 0x70, 0x83, 0xc8,
+	0xa3, 0x97, 0xf6, 0x9d, 0xa6, 0xd5, 0x5a, 0xc3, 0xb5, 0x54, 0x78, 0x24, 0x65, 0xe8, 0x31, 0xd4,
+	0x12, 0x12, 0x79, 0x2c, 0x4c, 0x31, 0xa5, 0xa6, 0xd5, 0x2a, 0xe3, 0xaa, 0x96, 0x69, 0xc8, 0x84,
+	0x9d, 0x3e, 0x1b, 0x45, 0xc2, 0xfe, 0x64, 0xca, 0xce, 0xa1, 0x94, 0xa1, 0x73, 0x40, 0xc4, 0xf3,
+	0x02, 0x11, 0xb0, 0x88, 0x0c, 0x5d, 0x1d, 0x05, 0xb7, 0xcb, 0xcd, 0x42, 0xab, 0xba, 0xbb, 0xd3,
+	0xc9, 0xa3, 0x40, 0xe7, 0x85, 0x02, 0xfd, 0xc0, 0x0e, 0xc7, 0x12, 0xbc, 0x99, 0x99, 0xd0, 0x4a,
+	0xbe, 0xb7, 0xf1, 0xfa, 0xbf, 0x3f, 0xbf, 0x9c, 0xa8, 0xb0, 0x73, 0x06, 0x9b, 0x73, 0x17, 0x67,
+	0x8b, 0x65, 0xdd, 0x54, 0xac, 0xd5, 0xd9, 0x62, 0x39, 0x4f, 0x61, 0xd3, 0xf4, 0x1f, 0x53, 0x1e,
+	0xb3, 0x88, 0x53, 0x59, 0x1c, 0xe3, 0xd9, 0x0d, 0x3c, 0x65, 0xb7, 0x88, 0xab, 0x46, 0x76, 0xe4,
+	0x39, 0x7f, 0x14, 0x15, 0x71, 0xf6, 0x85, 0xa0, 0x5c, 0xa0, 0xa7, 0x50, 0xe1, 0xca, 0x84, 0x58,
+	0x82, 0x37, 0x19, 0x74, 0xce, 0xd1, 0xea, 0x9c, 0x23, 0xf4, 0x0c, 0x2a, 0x69, 0x06, 0x81, 0xa7,
+	0xd9, 0x70, 0xf0, 0xe9, 0xdb, 0x0f, 0xdb, 0x2b, 0x7f, 0x7f, 0xd8, 0x2e, 0x9e, 0x05, 0x91, 0x78,
+	0xff, 0xa6, 0x5d, 0x4d, 0xdd, 0xc8, 0x23, 0x2e, 0x6b, 0xf4, 0x91, 0x87, 0x3a, 0xf9, 0x9c, 0xd4,
+	0x84, 0xc9, 0x61, 0xde, 0x3e, 0x54, 0x2f, 0x98, 0xa0, 0x6e, 0x42, 0xf9, 0x68, 0x28, 0x14, 0x6b,
+	0xd6, 0x77, 0x9b, 0xf9, 0x3d, 0x3c, 0x67, 0x82, 0x62, 0x85, 0xc3, 0x70, 0x61, 0xde, 0x51, 0x1b,
+	0x50, 0xd6, 0x32, 0xe3, 0xb1, 0xa4, 0x3d, 0x66, 0x9a, 0xb1, 0xc7, 0xaf, 0x00, 0x29, 0x8f, 0x17,
+	0x64, 0x18, 0x78, 0x2a, 0x48, 0x4e, 0x25, 0xcd, 0x0a, 0xad, 0x12, 0xbe, 0x2b, 0x35, 0xe7, 0x63,
+	0xc5, 0x29, 0x15, 0x06, 0x4d, 0x7c, 0xdf, 0xe5, 0x81, 0x1f, 0x11, 0x31, 0x4a, 0xa8, 0x5d, 0x6e,
+	0x5a, 0xad, 0x9a, 0x46, 0xef, 0xfb, 0xfe, 0xe9, 0x58, 0x8e, 0x76, 0x60, 0x63, 0x6a, 0x0a, 0x28,
+	0xb7, 0x2b, 0xcd, 0x42, 0x6b, 0x0d, 0xaf, 0x4f, 0xce, 0x01, 0xe5, 0xe8, 0x2c, 0x97, 0xc1, 0xa0,
+	0x18, 0xfc, 0x45, 0x7e, 0xf6, 0x86, 0x80, 0x9e, 0xa6, 0x64, 0x1e, 0x81, 0xd7, 0x25, 0x81, 0xb3,
+	0x56, 0x3b, 0xf7, 0x14, 0xd1, 0x34, 0x5f, 0xc6, 0x44, 0x73, 0xfe, 0xb5, 0x00, 0x49, 0x69, 0x1c,
+	0x53, 0x32, 0xcc, 0x68, 0xbd, 0x60, 0x9b, 0x58, 0xb7, 0xdf, 0x26, 0x4b, 0x10, 0xec, 0x10, 0x6a,
+	0x71, 0x40, 0xfb, 0x54, 0x6e, 0x5e, 0xf6, 0x52, 0xf2, 0x43, 0x66, 0xbe, 0xa0, 0xef, 0x27, 0x12,
+	0x79, 0x22, 0x81, 0xb8, 0x1a, 0x9b, 0x77, 0xbe, 0x67, 0xcb, 0x6c, 0xf3, 0x82, 0xfe, 0xb6, 0x58,
+	0x2e, 0xdc, 0x2d, 0x3a, 0xbf, 0x59, 0x00, 0xd9, 0xdd, 0x69, 0x52, 0x5b, 0xb7, 0x21, 0xf5, 0xdc,
+	0x72, 0x5b, 0xcd, 0x59, 0x6e, 0x8f, 0x00, 0x74, 0x4a, 0x03, 0xc2, 0x07, 0x6a, 0x68, 0x6a, 0xb8,
+	0xa2, 0x24, 0xcf, 0x09, 0x1f, 0xa0, 0x2d, 0xb8, 0xa3, 0x72, 0x55, 0xa9, 0xd6, 0xb0, 0x3e, 0x38,
+	0x0f, 0xa1, 0x3e, 0xdf, 0x0a, 0xd3, 0xa9, 0x5f, 0x2d, 0xd8, 0x38, 0xe6, 0xfe, 0x59, 0xec, 0x11,
+	0x41, 0x4f, 0xd4, 0x47, 0x46, 0x4e, 0x3d, 0x19, 0x89, 0x01, 0x4b, 0x02, 0xf1, 0xea, 0xe6, 0xa9,
+	0x37, 0x50, 0xb4, 0x07, 0x25, 0xfd, 0x99, 0x52, 0xc1, 0x57, 0x77, 0x1f, 0x2e, 0xa8, 0xb5, 0xc2,
+	0x1c, 0x14, 0x65, 0x61, 0x70, 0x7a, 0x23, 0xa5, 0x95, 0xb1, 0xe5, 0x3c, 0x80, 0xfb, 0x33, 0x61,
+	0x8d, 0x43, 0xde, 0x7d, 0x5d, 0x80, 0xc2, 0x31, 0xf7, 0x11, 0x86, 0x52, 0xfa, 0x7d, 0xdb, 0xce,
+	0x77, 0x64, 0x16, 0x60, 0x7d, 0xe7, 0x06, 0x80, 0xd9, 0x90, 0x18, 0x4a, 0xe9, 0xea, 0x5b, 0x6c,
+	0x53, 0x03, 0x3e, 0x62, 0x73, 0x7a, 0x18, 0x50, 0x08, 0x1b, 0xb3, 0x83, 0xd0, 0x5a, 0x7c, 0x77,
+	0x1a, 0x59, 0xff, 0x7a, 0x59, 0xa4, 0x71, 0xe7, 0x41, 0x6d, 0xaa, 0x9b, 0x9f, 0x2f, 0xb4, 0x30,
+	0x09, 0xab, 0xb7, 0x97, 0x82, 0x8d, 0xbd, 0x1c, 0x7c, 0xf7, 0xf6, 0xaa, 0x61, 0xbd, 0xbb, 0x6a,
+	0x58, 0xff, 0x5c, 0x35, 0xac, 0x5f, 0xae, 0x1b, 0x2b, 0xef, 0xae, 0x1b, 0x2b, 0x7f, 0x5d, 0x37,
+	0x56, 0x7e, 0x7c, 0xe2, 0x07, 0x62, 0x30, 0xea, 0x75, 0xfa, 0x2c, 0xec, 0xf6, 0xa2, 0x5e, 0xbb,
+	0x3f, 0x20, 0x41, 0xd4, 0x9d, 0xf8, 0x63, 0xb9, 0x9c, 0xfd, 0x67, 0xe9, 0x95, 0xd4, 0x4f, 0xcb,
+	0x37, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xff, 0xda, 0x11, 0x83, 0x7a, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -473,6 +752,7 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	Submit(ctx context.Context, in *MsgSubmit, opts ...grpc.CallOption) (*MsgSubmitResponse, error)
 	Attest(ctx context.Context, in *MsgAttest, opts ...grpc.CallOption) (*MsgAttestResponse, error)
+	AppealChallenge(ctx context.Context, in *MsgAppealChallenge, opts ...grpc.CallOption) (*MsgAppealChallengeResponse, error)
 	// UpdateParams defines a governance operation for updating the x/challenge module parameters.
 	// The authority is defined in the keeper.
 	//
@@ -506,6 +786,15 @@ func (c *msgClient) Attest(ctx context.Context, in *MsgAttest, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) AppealChallenge(ctx context.Context, in *MsgAppealChallenge, opts ...grpc.CallOption) (*MsgAppealChallengeResponse, error) {
+	out := new(MsgAppealChallengeResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.challenge.Msg/AppealChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/greenfield.challenge.Msg/UpdateParams", in, out, opts...)
@@ -519,6 +808,7 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 type MsgServer interface {
 	Submit(context.Context, *MsgSubmit) (*MsgSubmitResponse, error)
 	Attest(context.Context, *MsgAttest) (*MsgAttestResponse, error)
+	AppealChallenge(context.Context, *MsgAppealChallenge) (*MsgAppealChallengeResponse, error)
 	// UpdateParams defines a governance operation for updating the x/challenge module parameters.
 	// The authority is defined in the keeper.
 	//
@@ -536,6 +826,9 @@ func (*UnimplementedMsgServer) Submit(ctx context.Context, req *MsgSubmit) (*Msg
 func (*UnimplementedMsgServer) Attest(ctx context.Context, req *MsgAttest) (*MsgAttestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Attest not implemented")
 }
+func (*UnimplementedMsgServer) AppealChallenge(ctx context.Context, req *MsgAppealChallenge) (*MsgAppealChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppealChallenge not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -580,6 +873,24 @@ func _Msg_Attest_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AppealChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAppealChallenge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AppealChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.challenge.Msg/AppealChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AppealChallenge(ctx, req.(*MsgAppealChallenge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -610,6 +921,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Attest",
 			Handler:    _Msg_Attest_Handler,
 		},
+		{
+			MethodName: "AppealChallenge",
+			Handler:    _Msg_AppealChallenge_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -639,6 +954,25 @@ func (m *MsgSubmit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AdditionalObjects) > 0 {
+		for iNdEx := len(m.AdditionalObjects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalObjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.SegmentCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SegmentCount))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.RandomIndex {
 		i--
 		if m.RandomIndex {
@@ -685,6 +1019,43 @@ func (m *MsgSubmit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ObjectToChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectToChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectToChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSubmitResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -733,6 +1104,38 @@ func (m *MsgAttest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AdditionalObjects) > 0 {
+		for iNdEx := len(m.AdditionalObjects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalObjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SegmentIndexes) > 0 {
+		dAtA2 := make([]byte, len(m.SegmentIndexes)*10)
+		var j1 int
+		for _, num := range m.SegmentIndexes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.VoteAggSignature) > 0 {
 		i -= len(m.VoteAggSignature)
 		copy(dAtA[i:], m.VoteAggSignature)
@@ -816,7 +1219,7 @@ func (m *MsgAttestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgAppealChallenge) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -826,36 +1229,162 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAppealChallenge) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAppealChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.PieceProofs) > 0 {
+		for iNdEx := len(m.PieceProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PieceProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if m.ChallengeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChallengeId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
 	}
-	return len(dAtA) - i, nil
-}
-
+	if len(m.SpOperatorAddress) > 0 {
+		i -= len(m.SpOperatorAddress)
+		copy(dAtA[i:], m.SpOperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpOperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PieceProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PieceProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PieceProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PieceHash) > 0 {
+		i -= len(m.PieceHash)
+		copy(dAtA[i:], m.PieceHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PieceHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SegmentIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SegmentIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppealChallengeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppealChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppealChallengeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -918,6 +1447,32 @@ func (m *MsgSubmit) Size() (n int) {
 	if m.RandomIndex {
 		n += 2
 	}
+	if m.SegmentCount != 0 {
+		n += 1 + sovTx(uint64(m.SegmentCount))
+	}
+	if len(m.AdditionalObjects) > 0 {
+		for _, e := range m.AdditionalObjects {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ObjectToChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -966,6 +1521,19 @@ func (m *MsgAttest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.SegmentIndexes) > 0 {
+		l = 0
+		for _, e := range m.SegmentIndexes {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.AdditionalObjects) > 0 {
+		for _, e := range m.AdditionalObjects {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -978,6 +1546,61 @@ func (m *MsgAttestResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAppealChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpOperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChallengeId != 0 {
+		n += 1 + sovTx(uint64(m.ChallengeId))
+	}
+	if len(m.PieceProofs) > 0 {
+		for _, e := range m.PieceProofs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PieceProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SegmentIndex != 0 {
+		n += 1 + sovTx(uint64(m.SegmentIndex))
+	}
+	l = len(m.PieceHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAppealChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1204,6 +1827,59 @@ func (m *MsgSubmit) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RandomIndex = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentCount", wireType)
+			}
+			m.SegmentCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SegmentCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalObjects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalObjects = append(m.AdditionalObjects, &ObjectToChallenge{})
+			if err := m.AdditionalObjects[len(m.AdditionalObjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1225,7 +1901,7 @@ func (m *MsgSubmit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitResponse) Unmarshal(dAtA []byte) error {
+func (m *ObjectToChallenge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1248,17 +1924,17 @@ func (m *MsgSubmitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ObjectToChallenge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ObjectToChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
-			m.ChallengeId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1268,33 +1944,147 @@ func (m *MsgSubmitResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChallengeId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAttest) Unmarshal(dAtA []byte) error {
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAttest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1577,6 +2367,116 @@ func (m *MsgAttest) Unmarshal(dAtA []byte) error {
 				m.VoteAggSignature = []byte{}
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SegmentIndexes = append(m.SegmentIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SegmentIndexes) == 0 {
+					m.SegmentIndexes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SegmentIndexes = append(m.SegmentIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentIndexes", wireType)
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalObjects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalObjects = append(m.AdditionalObjects, &ChallengedObject{})
+			if err := m.AdditionalObjects[len(m.AdditionalObjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1648,6 +2548,360 @@ func (m *MsgAttestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgAppealChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAppealChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAppealChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpOperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpOperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PieceProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PieceProofs = append(m.PieceProofs, &PieceProof{})
+			if err := m.PieceProofs[len(m.PieceProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PieceProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PieceProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PieceProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentIndex", wireType)
+			}
+			m.SegmentIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SegmentIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PieceHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PieceHash = append(m.PieceHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.PieceHash == nil {
+				m.PieceHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAppealChallengeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAppealChallengeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAppealChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
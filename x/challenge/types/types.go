@@ -14,3 +14,6 @@ const RedundancyIndexPrimary = int32(-1)
 
 // BlsSignatureLength defines the length of bls signature
 const BlsSignatureLength = 96
+
+// MaxPaginationLimit defines the max limit of the paginated queries of archived challenges and statistics
+const MaxPaginationLimit = 200
@@ -115,6 +115,10 @@ type Challenge struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// The height at which the challenge will be expired.
 	ExpiredHeight uint64 `protobuf:"varint,2,opt,name=expired_height,json=expiredHeight,proto3" json:"expired_height,omitempty"`
+	// The challenged storage provider.
+	SpId uint32 `protobuf:"varint,3,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// The challenged object.
+	ObjectId Uint `protobuf:"bytes,4,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
 }
 
 func (m *Challenge) Reset()         { *m = Challenge{} }
@@ -164,6 +168,13 @@ func (m *Challenge) GetExpiredHeight() uint64 {
 	return 0
 }
 
+func (m *Challenge) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
 // AttestedChallenge records the challenge which are attested.
 type AttestedChallenge struct {
 	// The id of the challenge.
@@ -284,290 +295,2701 @@ func (m *AttestedChallengeIds) GetCursor() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("greenfield.challenge.VoteResult", VoteResult_name, VoteResult_value)
-	proto.RegisterType((*Slash)(nil), "greenfield.challenge.Slash")
-	proto.RegisterType((*Challenge)(nil), "greenfield.challenge.Challenge")
-	proto.RegisterType((*AttestedChallenge)(nil), "greenfield.challenge.AttestedChallenge")
-	proto.RegisterType((*AttestedChallengeIds)(nil), "greenfield.challenge.AttestedChallengeIds")
+// ArchivedChallenge records a challenge in the challenge archive, which will be pruned periodically.
+type ArchivedChallenge struct {
+	// The id of the challenge.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The challenged storage provider.
+	SpId uint32 `protobuf:"varint,2,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// The challenged object info.
+	ObjectId Uint `protobuf:"bytes,3,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+	// The challenged segment index.
+	SegmentIndex uint32 `protobuf:"varint,4,opt,name=segment_index,json=segmentIndex,proto3" json:"segment_index,omitempty"`
+	// The redundancy index of the challenged storage provider, -1 stands for the primary storage provider.
+	RedundancyIndex int32 `protobuf:"varint,5,opt,name=redundancy_index,json=redundancyIndex,proto3" json:"redundancy_index,omitempty"`
+	// The challenger address, which is empty when the challenge is triggered by blockchain automatically.
+	ChallengerAddress string `protobuf:"bytes,6,opt,name=challenger_address,json=challengerAddress,proto3" json:"challenger_address,omitempty"`
+	// The height at which the challenge is created.
+	Height uint64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	// Whether the challenge is attested.
+	Attested bool `protobuf:"varint,8,opt,name=attested,proto3" json:"attested,omitempty"`
+	// The attestation result of the challenge.
+	Result VoteResult `protobuf:"varint,9,opt,name=result,proto3,enum=greenfield.challenge.VoteResult" json:"result,omitempty"`
+	// The height at which the challenge is attested.
+	AttestedHeight uint64 `protobuf:"varint,10,opt,name=attested_height,json=attestedHeight,proto3" json:"attested_height,omitempty"`
+	// All the challenged segment indexes.
+	SegmentIndexes []uint32 `protobuf:"varint,11,rep,packed,name=segment_indexes,json=segmentIndexes,proto3" json:"segment_indexes,omitempty"`
+	// The other objects on the same storage provider challenged under the challenge.
+	AdditionalObjects []*ChallengedObject `protobuf:"bytes,12,rep,name=additional_objects,json=additionalObjects,proto3" json:"additional_objects,omitempty"`
 }
 
-func init() { proto.RegisterFile("greenfield/challenge/types.proto", fileDescriptor_9c297f0764c47d40) }
-
-var fileDescriptor_9c297f0764c47d40 = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xc1, 0x6a, 0xdb, 0x40,
-	0x10, 0xd5, 0xca, 0x8a, 0xa9, 0x27, 0xc4, 0x38, 0x5b, 0xb7, 0xb8, 0x2e, 0x28, 0xc2, 0x50, 0x6a,
-	0x0a, 0x91, 0x68, 0x7a, 0xc9, 0xad, 0xd8, 0x8e, 0x9a, 0x98, 0x9a, 0x1e, 0x36, 0xa4, 0x87, 0x42,
-	0x11, 0x96, 0x76, 0x2b, 0x6d, 0x51, 0xb4, 0x42, 0xbb, 0x86, 0xb4, 0x5f, 0x50, 0xe8, 0xa5, 0xff,
-	0xd0, 0x5f, 0xe8, 0x47, 0xe4, 0x18, 0x7a, 0x2a, 0x3d, 0x84, 0x62, 0xff, 0x48, 0x91, 0xb4, 0x8d,
-	0x43, 0x93, 0xdc, 0xe6, 0xbd, 0x79, 0xf3, 0xde, 0x30, 0x0c, 0x38, 0x71, 0xc1, 0x58, 0xf6, 0x81,
-	0xb3, 0x94, 0x7a, 0x51, 0x32, 0x4f, 0x53, 0x96, 0xc5, 0xcc, 0x53, 0x9f, 0x72, 0x26, 0xdd, 0xbc,
-	0x10, 0x4a, 0xe0, 0xee, 0x5a, 0xe1, 0x5e, 0x29, 0xfa, 0x8f, 0x22, 0x21, 0x4f, 0x85, 0x0c, 0x2a,
-	0x8d, 0x57, 0x83, 0x7a, 0xa0, 0xdf, 0x8d, 0x45, 0x2c, 0x6a, 0xbe, 0xac, 0x6a, 0x76, 0x90, 0xc1,
-	0xc6, 0x71, 0x3a, 0x97, 0x09, 0xbe, 0x0f, 0x1b, 0x32, 0x0f, 0x38, 0xed, 0x21, 0x07, 0x0d, 0xb7,
-	0x88, 0x25, 0xf3, 0x29, 0xc5, 0xfb, 0xd0, 0x12, 0xe1, 0x47, 0x16, 0xa9, 0xb2, 0x61, 0x3a, 0x68,
-	0xd8, 0x1a, 0x3f, 0x3e, 0xbf, 0xdc, 0x31, 0x7e, 0x5f, 0xee, 0x58, 0x27, 0x3c, 0x53, 0x3f, 0x7f,
-	0xec, 0x6e, 0xea, 0x90, 0x12, 0x92, 0x7b, 0xb5, 0x7a, 0x4a, 0xf1, 0x43, 0x68, 0x26, 0x8c, 0xc7,
-	0x89, 0xea, 0x35, 0x1c, 0x34, 0xb4, 0x88, 0x46, 0x83, 0x31, 0xb4, 0x26, 0xff, 0xb6, 0xc5, 0x6d,
-	0x30, 0x75, 0xa0, 0x45, 0x4c, 0x4e, 0xf1, 0x13, 0x68, 0xb3, 0xb3, 0x9c, 0x17, 0x8c, 0x06, 0x7a,
-	0xd8, 0xac, 0x7a, 0x5b, 0x9a, 0x3d, 0xaa, 0x3d, 0xde, 0xc3, 0xf6, 0x48, 0x29, 0x26, 0x15, 0xa3,
-	0x77, 0x7b, 0xed, 0x43, 0xb3, 0x60, 0x72, 0x91, 0xd6, 0x1e, 0xed, 0x3d, 0xc7, 0xbd, 0xed, 0x60,
-	0xee, 0x5b, 0xa1, 0x18, 0xa9, 0x74, 0x44, 0xeb, 0x07, 0x5f, 0x11, 0x74, 0x6f, 0xf8, 0x4f, 0xa9,
-	0xc4, 0x18, 0x2c, 0xc9, 0x3f, 0x33, 0x1d, 0x52, 0xd5, 0xf8, 0x10, 0xe0, 0xca, 0x4c, 0xf6, 0x4c,
-	0xa7, 0x31, 0xdc, 0xdc, 0x7b, 0x7a, 0x7b, 0xd4, 0x0d, 0x4f, 0x72, 0x6d, 0xb4, 0x3c, 0x58, 0xb4,
-	0x28, 0xa4, 0x28, 0xaa, 0x83, 0x35, 0x88, 0x46, 0xcf, 0x5e, 0x02, 0xac, 0x77, 0xc4, 0x5d, 0xe8,
-	0x4c, 0x8e, 0x46, 0xb3, 0x99, 0xff, 0xe6, 0xd0, 0x0f, 0x5e, 0x8d, 0xa6, 0x33, 0xff, 0xa0, 0x63,
-	0xe0, 0x07, 0xb0, 0xbd, 0x66, 0x8f, 0x4f, 0x26, 0x13, 0xdf, 0x3f, 0xe8, 0xa0, 0xbe, 0xf5, 0xe5,
-	0xbb, 0x6d, 0x8c, 0x5f, 0x9f, 0x2f, 0x6d, 0x74, 0xb1, 0xb4, 0xd1, 0x9f, 0xa5, 0x8d, 0xbe, 0xad,
-	0x6c, 0xe3, 0x62, 0x65, 0x1b, 0xbf, 0x56, 0xb6, 0xf1, 0xee, 0x79, 0xcc, 0x55, 0xb2, 0x08, 0xdd,
-	0x48, 0x9c, 0x7a, 0x61, 0x16, 0xee, 0x46, 0xc9, 0x9c, 0x67, 0xde, 0xb5, 0xcf, 0x3b, 0xfb, 0xff,
-	0xf7, 0xc2, 0x66, 0xf5, 0x35, 0x2f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x68, 0xaf, 0x39, 0x21,
-	0xa0, 0x02, 0x00, 0x00,
+func (m *ArchivedChallenge) Reset()         { *m = ArchivedChallenge{} }
+func (m *ArchivedChallenge) String() string { return proto.CompactTextString(m) }
+func (*ArchivedChallenge) ProtoMessage()    {}
+func (*ArchivedChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c297f0764c47d40, []int{4}
 }
-
-func (m *Slash) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ArchivedChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Slash) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ArchivedChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedChallenge.Merge(m, src)
+}
+func (m *ArchivedChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedChallenge.DiscardUnknown(m)
 }
 
-func (m *Slash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size := m.ObjectId.Size()
-		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+var xxx_messageInfo_ArchivedChallenge proto.InternalMessageInfo
+
+func (m *ArchivedChallenge) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.SpId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ArchivedChallenge) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Challenge) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ArchivedChallenge) GetSegmentIndex() uint32 {
+	if m != nil {
+		return m.SegmentIndex
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Challenge) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ArchivedChallenge) GetRedundancyIndex() int32 {
+	if m != nil {
+		return m.RedundancyIndex
+	}
+	return 0
 }
 
-func (m *Challenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExpiredHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiredHeight))
-		i--
-		dAtA[i] = 0x10
+func (m *ArchivedChallenge) GetChallengerAddress() string {
+	if m != nil {
+		return m.ChallengerAddress
 	}
-	if m.Id != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *ArchivedChallenge) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *AttestedChallenge) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ArchivedChallenge) GetAttested() bool {
+	if m != nil {
+		return m.Attested
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *AttestedChallenge) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ArchivedChallenge) GetResult() VoteResult {
+	if m != nil {
+		return m.Result
+	}
+	return CHALLENGE_FAILED
 }
 
-func (m *AttestedChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Result != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Result))
-		i--
-		dAtA[i] = 0x10
+func (m *ArchivedChallenge) GetAttestedHeight() uint64 {
+	if m != nil {
+		return m.AttestedHeight
 	}
-	if m.Id != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ArchivedChallenge) GetSegmentIndexes() []uint32 {
+	if m != nil {
+		return m.SegmentIndexes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *AttestedChallengeIds) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ArchivedChallenge) GetAdditionalObjects() []*ChallengedObject {
+	if m != nil {
+		return m.AdditionalObjects
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AttestedChallengeIds) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SpChallengeStats counts the challenges of a storage provider which are attested or expired in a statistics window.
+type SpChallengeStats struct {
+	// The storage provider.
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// The start height of the statistics window.
+	WindowStartHeight uint64 `protobuf:"varint,2,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty"`
+	// The number of challenges attested as succeed, i.e., the storage provider failed to serve the challenged data.
+	SucceedCount uint64 `protobuf:"varint,3,opt,name=succeed_count,json=succeedCount,proto3" json:"succeed_count,omitempty"`
+	// The number of challenges attested as failed or expired without attestation, i.e., the storage provider passed
+	// the challenges.
+	FailedCount uint64 `protobuf:"varint,4,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
 }
 
-func (m *AttestedChallengeIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Cursor != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Cursor))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Challenges) > 0 {
-		for iNdEx := len(m.Challenges) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Challenges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *SpChallengeStats) Reset()         { *m = SpChallengeStats{} }
+func (m *SpChallengeStats) String() string { return proto.CompactTextString(m) }
+func (*SpChallengeStats) ProtoMessage()    {}
+func (*SpChallengeStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c297f0764c47d40, []int{5}
+}
+func (m *SpChallengeStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpChallengeStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpChallengeStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Size_ != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Size_))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *SpChallengeStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpChallengeStats.Merge(m, src)
+}
+func (m *SpChallengeStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpChallengeStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpChallengeStats.DiscardUnknown(m)
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_SpChallengeStats proto.InternalMessageInfo
+
+func (m *SpChallengeStats) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Slash) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SpId != 0 {
-		n += 1 + sovTypes(uint64(m.SpId))
-	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.Height != 0 {
-		n += 1 + sovTypes(uint64(m.Height))
+
+func (m *SpChallengeStats) GetWindowStartHeight() uint64 {
+	if m != nil {
+		return m.WindowStartHeight
 	}
-	return n
+	return 0
 }
 
-func (m *Challenge) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTypes(uint64(m.Id))
-	}
-	if m.ExpiredHeight != 0 {
-		n += 1 + sovTypes(uint64(m.ExpiredHeight))
+func (m *SpChallengeStats) GetSucceedCount() uint64 {
+	if m != nil {
+		return m.SucceedCount
 	}
-	return n
+	return 0
 }
 
-func (m *AttestedChallenge) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTypes(uint64(m.Id))
+func (m *SpChallengeStats) GetFailedCount() uint64 {
+	if m != nil {
+		return m.FailedCount
 	}
-	if m.Result != 0 {
-		n += 1 + sovTypes(uint64(m.Result))
+	return 0
+}
+
+// ChallengeSegments records the challenged segments of an ongoing challenge, which are checked when the challenge is
+// attested, and are used to verify the appeals.
+type ChallengeSegments struct {
+	// The redundancy index of the challenged storage provider, -1 stands for the primary storage provider.
+	RedundancyIndex int32 `protobuf:"varint,1,opt,name=redundancy_index,json=redundancyIndex,proto3" json:"redundancy_index,omitempty"`
+	// The challenged segment indexes.
+	SegmentIndexes []uint32 `protobuf:"varint,2,rep,packed,name=segment_indexes,json=segmentIndexes,proto3" json:"segment_indexes,omitempty"`
+	// The other objects on the same storage provider challenged under the challenge.
+	AdditionalObjects []*ChallengedObject `protobuf:"bytes,3,rep,name=additional_objects,json=additionalObjects,proto3" json:"additional_objects,omitempty"`
+}
+
+func (m *ChallengeSegments) Reset()         { *m = ChallengeSegments{} }
+func (m *ChallengeSegments) String() string { return proto.CompactTextString(m) }
+func (*ChallengeSegments) ProtoMessage()    {}
+func (*ChallengeSegments) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c297f0764c47d40, []int{6}
+}
+func (m *ChallengeSegments) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChallengeSegments) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChallengeSegments.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChallengeSegments) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChallengeSegments.Merge(m, src)
+}
+func (m *ChallengeSegments) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChallengeSegments) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChallengeSegments.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChallengeSegments proto.InternalMessageInfo
+
+func (m *ChallengeSegments) GetRedundancyIndex() int32 {
+	if m != nil {
+		return m.RedundancyIndex
+	}
+	return 0
+}
+
+func (m *ChallengeSegments) GetSegmentIndexes() []uint32 {
+	if m != nil {
+		return m.SegmentIndexes
+	}
+	return nil
+}
+
+func (m *ChallengeSegments) GetAdditionalObjects() []*ChallengedObject {
+	if m != nil {
+		return m.AdditionalObjects
+	}
+	return nil
+}
+
+// ChallengedObject records an object challenged under a challenge together with other objects on the same storage
+// provider.
+type ChallengedObject struct {
+	// The challenged object info.
+	ObjectId Uint `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+	// The redundancy index of the challenged storage provider for the object, -1 stands for the primary storage provider.
+	RedundancyIndex int32 `protobuf:"varint,2,opt,name=redundancy_index,json=redundancyIndex,proto3" json:"redundancy_index,omitempty"`
+	// The challenged segment indexes of the object.
+	SegmentIndexes []uint32 `protobuf:"varint,3,rep,packed,name=segment_indexes,json=segmentIndexes,proto3" json:"segment_indexes,omitempty"`
+}
+
+func (m *ChallengedObject) Reset()         { *m = ChallengedObject{} }
+func (m *ChallengedObject) String() string { return proto.CompactTextString(m) }
+func (*ChallengedObject) ProtoMessage()    {}
+func (*ChallengedObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c297f0764c47d40, []int{7}
+}
+func (m *ChallengedObject) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChallengedObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChallengedObject.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChallengedObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChallengedObject.Merge(m, src)
+}
+func (m *ChallengedObject) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChallengedObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChallengedObject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChallengedObject proto.InternalMessageInfo
+
+func (m *ChallengedObject) GetRedundancyIndex() int32 {
+	if m != nil {
+		return m.RedundancyIndex
+	}
+	return 0
+}
+
+func (m *ChallengedObject) GetSegmentIndexes() []uint32 {
+	if m != nil {
+		return m.SegmentIndexes
+	}
+	return nil
+}
+
+// PendingSlash records a slash in the appeal window, which is executed at the end of the window unless it is appealed.
+type PendingSlash struct {
+	// The id of the challenge.
+	ChallengeId uint64 `protobuf:"varint,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// The storage provider to be slashed.
+	SpId uint32 `protobuf:"varint,2,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// The challenged object info.
+	ObjectId Uint `protobuf:"bytes,3,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+	// The amount to slash.
+	SlashAmount Int `protobuf:"bytes,4,opt,name=slash_amount,json=slashAmount,proto3,customtype=Int" json:"slash_amount"`
+	// The submitter of the challenge attestation.
+	Submitter string `protobuf:"bytes,5,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// The challenger, which is empty when the challenge is triggered by blockchain automatically.
+	Challenger string `protobuf:"bytes,6,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	// The validators participated in the attestation.
+	Validators []string `protobuf:"bytes,7,rep,name=validators,proto3" json:"validators,omitempty"`
+	// The redundancy index of the challenged storage provider, -1 stands for the primary storage provider.
+	RedundancyIndex int32 `protobuf:"varint,8,opt,name=redundancy_index,json=redundancyIndex,proto3" json:"redundancy_index,omitempty"`
+	// The challenged segment indexes.
+	SegmentIndexes []uint32 `protobuf:"varint,9,rep,packed,name=segment_indexes,json=segmentIndexes,proto3" json:"segment_indexes,omitempty"`
+	// The height at which the appeal window ends and the slash is executed.
+	ExecuteHeight uint64 `protobuf:"varint,10,opt,name=execute_height,json=executeHeight,proto3" json:"execute_height,omitempty"`
+	// The other objects on the same storage provider challenged under the challenge.
+	AdditionalObjects []*ChallengedObject `protobuf:"bytes,11,rep,name=additional_objects,json=additionalObjects,proto3" json:"additional_objects,omitempty"`
+}
+
+func (m *PendingSlash) Reset()         { *m = PendingSlash{} }
+func (m *PendingSlash) String() string { return proto.CompactTextString(m) }
+func (*PendingSlash) ProtoMessage()    {}
+func (*PendingSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c297f0764c47d40, []int{8}
+}
+func (m *PendingSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSlash.Merge(m, src)
+}
+func (m *PendingSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSlash proto.InternalMessageInfo
+
+func (m *PendingSlash) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
+	}
+	return 0
+}
+
+func (m *PendingSlash) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *PendingSlash) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *PendingSlash) GetChallenger() string {
+	if m != nil {
+		return m.Challenger
+	}
+	return ""
+}
+
+func (m *PendingSlash) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *PendingSlash) GetRedundancyIndex() int32 {
+	if m != nil {
+		return m.RedundancyIndex
+	}
+	return 0
+}
+
+func (m *PendingSlash) GetSegmentIndexes() []uint32 {
+	if m != nil {
+		return m.SegmentIndexes
+	}
+	return nil
+}
+
+func (m *PendingSlash) GetExecuteHeight() uint64 {
+	if m != nil {
+		return m.ExecuteHeight
+	}
+	return 0
+}
+
+func (m *PendingSlash) GetAdditionalObjects() []*ChallengedObject {
+	if m != nil {
+		return m.AdditionalObjects
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("greenfield.challenge.VoteResult", VoteResult_name, VoteResult_value)
+	proto.RegisterType((*Slash)(nil), "greenfield.challenge.Slash")
+	proto.RegisterType((*Challenge)(nil), "greenfield.challenge.Challenge")
+	proto.RegisterType((*AttestedChallenge)(nil), "greenfield.challenge.AttestedChallenge")
+	proto.RegisterType((*AttestedChallengeIds)(nil), "greenfield.challenge.AttestedChallengeIds")
+	proto.RegisterType((*ArchivedChallenge)(nil), "greenfield.challenge.ArchivedChallenge")
+	proto.RegisterType((*SpChallengeStats)(nil), "greenfield.challenge.SpChallengeStats")
+	proto.RegisterType((*ChallengeSegments)(nil), "greenfield.challenge.ChallengeSegments")
+	proto.RegisterType((*ChallengedObject)(nil), "greenfield.challenge.ChallengedObject")
+	proto.RegisterType((*PendingSlash)(nil), "greenfield.challenge.PendingSlash")
+}
+
+func init() { proto.RegisterFile("greenfield/challenge/types.proto", fileDescriptor_9c297f0764c47d40) }
+
+var fileDescriptor_9c297f0764c47d40 = []byte{
+	// 892 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0x8f, 0x63, 0xef, 0x36, 0x79, 0xf9, 0xb3, 0xc9, 0x34, 0x20, 0x77, 0x91, 0x52, 0x37, 0x08,
+	0x36, 0x20, 0x6d, 0x22, 0x8a, 0x84, 0xf6, 0x80, 0x84, 0xb2, 0x69, 0xd8, 0x46, 0xac, 0x00, 0x39,
+	0x5a, 0x0e, 0x48, 0xc8, 0x72, 0x3c, 0x53, 0x67, 0x50, 0x32, 0x8e, 0x3c, 0x93, 0x76, 0xcb, 0x27,
+	0x40, 0xe2, 0x02, 0x9f, 0x00, 0x04, 0x5f, 0xa1, 0x67, 0xce, 0x3d, 0x56, 0x3d, 0x21, 0x0e, 0x15,
+	0xda, 0xfd, 0x22, 0xc8, 0xe3, 0x89, 0xed, 0xb4, 0xde, 0xd2, 0x68, 0xb9, 0x79, 0x7e, 0xf3, 0x7b,
+	0x33, 0xef, 0xbd, 0xf9, 0xcd, 0xcf, 0x03, 0x96, 0x1f, 0x12, 0xc2, 0x1e, 0x50, 0x32, 0xc7, 0x7d,
+	0x6f, 0xe6, 0xce, 0xe7, 0x84, 0xf9, 0xa4, 0x2f, 0x1e, 0x2f, 0x09, 0xef, 0x2d, 0xc3, 0x40, 0x04,
+	0xa8, 0x95, 0x32, 0x7a, 0x09, 0x63, 0xff, 0x96, 0x17, 0xf0, 0x45, 0xc0, 0x1d, 0xc9, 0xe9, 0xc7,
+	0x83, 0x38, 0x60, 0xbf, 0xe5, 0x07, 0x7e, 0x10, 0xe3, 0xd1, 0x57, 0x8c, 0x76, 0x18, 0xec, 0x4c,
+	0xe6, 0x2e, 0x9f, 0xa1, 0x9b, 0xb0, 0xc3, 0x97, 0x0e, 0xc5, 0xa6, 0x66, 0x69, 0xdd, 0x9a, 0x6d,
+	0xf0, 0xe5, 0x18, 0xa3, 0x23, 0x28, 0x07, 0xd3, 0xef, 0x89, 0x27, 0xa2, 0x89, 0xa2, 0xa5, 0x75,
+	0xcb, 0xc7, 0xef, 0x3c, 0x7d, 0x71, 0xbb, 0xf0, 0xf7, 0x8b, 0xdb, 0xc6, 0x19, 0x65, 0xe2, 0xf9,
+	0x93, 0xc3, 0x8a, 0xda, 0x24, 0x1a, 0xda, 0xa5, 0x98, 0x3d, 0xc6, 0xe8, 0x6d, 0xd8, 0x9d, 0x11,
+	0xea, 0xcf, 0x84, 0xa9, 0x5b, 0x5a, 0xd7, 0xb0, 0xd5, 0xa8, 0xf3, 0x8b, 0x06, 0xe5, 0xe1, 0x3a,
+	0x5d, 0x54, 0x87, 0xa2, 0xda, 0xd1, 0xb0, 0x8b, 0x14, 0xa3, 0xf7, 0xa0, 0x4e, 0xce, 0x97, 0x34,
+	0x24, 0xd8, 0x51, 0xd1, 0x45, 0x39, 0x57, 0x53, 0xe8, 0x7d, 0x09, 0xa6, 0xb9, 0xea, 0x57, 0xe5,
+	0x6a, 0x6c, 0x91, 0x6b, 0xe7, 0x3b, 0x68, 0x0e, 0x84, 0x20, 0x5c, 0x10, 0x7c, 0x75, 0x6a, 0x47,
+	0xb0, 0x1b, 0x12, 0xbe, 0x9a, 0xc7, 0x29, 0xd5, 0xef, 0x5a, 0xbd, 0xbc, 0x03, 0xe8, 0x7d, 0x13,
+	0x08, 0x62, 0x4b, 0x9e, 0xad, 0xf8, 0x9d, 0x9f, 0x34, 0x68, 0xbd, 0xb2, 0xfe, 0x18, 0x73, 0x84,
+	0xc0, 0xe0, 0xf4, 0x07, 0xa2, 0x36, 0x91, 0xdf, 0xe8, 0x04, 0x20, 0x59, 0x8c, 0x9b, 0x45, 0x4b,
+	0xef, 0x56, 0xee, 0x1e, 0xe4, 0x6f, 0xf5, 0xca, 0x9a, 0x76, 0x26, 0x34, 0x3a, 0x00, 0x6f, 0x15,
+	0xf2, 0x20, 0x94, 0x4d, 0xd2, 0x6d, 0x35, 0xea, 0xfc, 0x6e, 0x40, 0x73, 0x10, 0x7a, 0x33, 0xfa,
+	0xf0, 0x75, 0xd5, 0x26, 0x1d, 0x2e, 0x5e, 0xd5, 0x61, 0x7d, 0x1b, 0x35, 0xbc, 0x0b, 0x35, 0x4e,
+	0xfc, 0x05, 0x61, 0xc2, 0xa1, 0x0c, 0x93, 0x73, 0x79, 0x3e, 0x35, 0xbb, 0xaa, 0xc0, 0x71, 0x84,
+	0xa1, 0x0f, 0xa0, 0x11, 0x12, 0xbc, 0x62, 0xd8, 0x65, 0xde, 0x63, 0xc5, 0xdb, 0xb1, 0xb4, 0xee,
+	0x8e, 0xbd, 0x97, 0xe2, 0x31, 0xf5, 0x04, 0x50, 0x52, 0x6a, 0xe8, 0xb8, 0x18, 0x87, 0x84, 0x73,
+	0x73, 0x57, 0xa6, 0x64, 0x3e, 0x7f, 0x72, 0xd8, 0x52, 0x69, 0x0c, 0xe2, 0x99, 0x89, 0x08, 0x29,
+	0xf3, 0xed, 0x66, 0x1a, 0xa3, 0x26, 0x32, 0x32, 0xbd, 0x91, 0x95, 0x29, 0xda, 0x87, 0x92, 0xab,
+	0xda, 0x6b, 0x96, 0x2c, 0xad, 0x5b, 0xb2, 0x93, 0x71, 0x46, 0x09, 0xe5, 0xed, 0x94, 0x80, 0x0e,
+	0x60, 0x6f, 0xbd, 0xca, 0x5a, 0xdf, 0x20, 0xb7, 0xad, 0xaf, 0x61, 0x25, 0xf0, 0x03, 0xd8, 0xdb,
+	0xe8, 0x17, 0xe1, 0x66, 0xc5, 0xd2, 0xbb, 0x35, 0xbb, 0x9e, 0xed, 0x18, 0xe1, 0xe8, 0x0c, 0x90,
+	0x8b, 0x31, 0x15, 0x34, 0x60, 0xee, 0xdc, 0x89, 0xfb, 0xcd, 0xcd, 0xaa, 0x94, 0xcd, 0xfb, 0xf9,
+	0x79, 0x25, 0x87, 0x8e, 0xbf, 0x92, 0x74, 0xbb, 0x99, 0xae, 0x10, 0x23, 0xbc, 0xf3, 0xab, 0x06,
+	0x8d, 0xc9, 0x32, 0x61, 0x4e, 0x84, 0x2b, 0x78, 0xbe, 0x43, 0xf4, 0xe0, 0xe6, 0x23, 0xca, 0x70,
+	0xf0, 0xc8, 0xe1, 0xc2, 0x0d, 0xc5, 0xe6, 0xb5, 0x6d, 0xc6, 0x53, 0x93, 0x68, 0x46, 0x55, 0x16,
+	0x29, 0x61, 0xe5, 0x79, 0x84, 0x60, 0xc7, 0x0b, 0x56, 0x6c, 0x6d, 0x0f, 0x55, 0x05, 0x0e, 0x23,
+	0x0c, 0xdd, 0x81, 0xea, 0x03, 0x97, 0xce, 0x13, 0x8e, 0x21, 0x39, 0x95, 0x18, 0x93, 0x94, 0xce,
+	0x9f, 0x1a, 0x34, 0xd3, 0xfc, 0xe2, 0xa6, 0xf0, 0x5c, 0x09, 0x69, 0xf9, 0x12, 0xca, 0x69, 0x71,
+	0x71, 0x8b, 0x16, 0xeb, 0xd7, 0x6d, 0xf1, 0x6f, 0x1a, 0x34, 0x5e, 0xe6, 0x6d, 0xde, 0x30, 0x6d,
+	0x9b, 0x1b, 0x96, 0x57, 0x79, 0xf1, 0x8d, 0x2b, 0xd7, 0xf3, 0x2a, 0x8f, 0xac, 0xa2, 0xfa, 0x35,
+	0x61, 0x98, 0x32, 0x3f, 0xfe, 0x47, 0xdc, 0x81, 0x6a, 0x52, 0xa4, 0x93, 0xf8, 0x45, 0xc5, 0x4b,
+	0x4d, 0xed, 0xff, 0x36, 0x8e, 0x4f, 0xa1, 0xca, 0xa3, 0xad, 0x1d, 0x77, 0x91, 0x28, 0xa1, 0x7c,
+	0x7c, 0x4b, 0x05, 0xeb, 0x63, 0x19, 0x0b, 0x2a, 0x76, 0xcc, 0x84, 0x5d, 0x91, 0xf4, 0x81, 0x64,
+	0xa3, 0x4f, 0xa0, 0xcc, 0x57, 0xd3, 0x05, 0x15, 0x82, 0x84, 0xd2, 0x4a, 0x5e, 0xe7, 0x0e, 0x29,
+	0x15, 0x1d, 0x65, 0x4c, 0x38, 0xfc, 0x4f, 0x5b, 0xc9, 0x70, 0x51, 0x1b, 0xe0, 0xa1, 0x3b, 0xa7,
+	0xd8, 0x15, 0x41, 0xc8, 0xcd, 0x1b, 0x96, 0xde, 0x2d, 0xdb, 0x19, 0x24, 0xf7, 0x98, 0x4a, 0x6f,
+	0x7c, 0x4c, 0xe5, 0x5c, 0x81, 0xca, 0x9f, 0x26, 0xf1, 0x56, 0x82, 0x6c, 0x9a, 0x4a, 0x4d, 0xa1,
+	0xea, 0xe6, 0xe5, 0xeb, 0xb8, 0x72, 0x4d, 0x1d, 0x7f, 0xf8, 0x19, 0x40, 0xea, 0x74, 0xa8, 0x05,
+	0x8d, 0xe1, 0xfd, 0xc1, 0xe9, 0xe9, 0xe8, 0xcb, 0x93, 0x91, 0xf3, 0xf9, 0x60, 0x7c, 0x3a, 0xba,
+	0xd7, 0x28, 0xa0, 0xb7, 0xa0, 0x99, 0xa2, 0x93, 0xb3, 0xe1, 0x70, 0x34, 0xba, 0xd7, 0xd0, 0xf6,
+	0x8d, 0x1f, 0xff, 0x68, 0x17, 0x8e, 0xbf, 0x78, 0x7a, 0xd1, 0xd6, 0x9e, 0x5d, 0xb4, 0xb5, 0x7f,
+	0x2e, 0xda, 0xda, 0xcf, 0x97, 0xed, 0xc2, 0xb3, 0xcb, 0x76, 0xe1, 0xaf, 0xcb, 0x76, 0xe1, 0xdb,
+	0x8f, 0x7c, 0x2a, 0x66, 0xab, 0x69, 0xcf, 0x0b, 0x16, 0xfd, 0x29, 0x9b, 0x1e, 0x7a, 0x33, 0x97,
+	0xb2, 0x7e, 0xe6, 0x65, 0x74, 0xfe, 0xf2, 0xdb, 0x68, 0xba, 0x2b, 0x5f, 0x35, 0x1f, 0xff, 0x1b,
+	0x00, 0x00, 0xff, 0xff, 0x5d, 0x8d, 0x0d, 0x33, 0x40, 0x09, 0x00, 0x00,
+}
+
+func (m *Slash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Slash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Slash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Challenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Challenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Challenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExpiredHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiredHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestedChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestedChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestedChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestedChallengeIds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestedChallengeIds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestedChallengeIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cursor != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Cursor))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Challenges) > 0 {
+		for iNdEx := len(m.Challenges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Challenges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AdditionalObjects) > 0 {
+		for iNdEx := len(m.AdditionalObjects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalObjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.SegmentIndexes) > 0 {
+		dAtA2 := make([]byte, len(m.SegmentIndexes)*10)
+		var j1 int
+		for _, num := range m.SegmentIndexes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTypes(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.AttestedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AttestedHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Result != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Attested {
+		i--
+		if m.Attested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ChallengerAddress) > 0 {
+		i -= len(m.ChallengerAddress)
+		copy(dAtA[i:], m.ChallengerAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChallengerAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RedundancyIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RedundancyIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SegmentIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SegmentIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpChallengeStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpChallengeStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpChallengeStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FailedCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SucceedCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SucceedCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChallengeSegments) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChallengeSegments) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChallengeSegments) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AdditionalObjects) > 0 {
+		for iNdEx := len(m.AdditionalObjects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalObjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SegmentIndexes) > 0 {
+		dAtA4 := make([]byte, len(m.SegmentIndexes)*10)
+		var j3 int
+		for _, num := range m.SegmentIndexes {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTypes(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RedundancyIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RedundancyIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChallengedObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChallengedObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChallengedObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SegmentIndexes) > 0 {
+		dAtA6 := make([]byte, len(m.SegmentIndexes)*10)
+		var j5 int
+		for _, num := range m.SegmentIndexes {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTypes(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RedundancyIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RedundancyIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AdditionalObjects) > 0 {
+		for iNdEx := len(m.AdditionalObjects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalObjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.ExecuteHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecuteHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.SegmentIndexes) > 0 {
+		dAtA8 := make([]byte, len(m.SegmentIndexes)*10)
+		var j7 int
+		for _, num := range m.SegmentIndexes {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTypes(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.RedundancyIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RedundancyIndex))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Challenger) > 0 {
+		i -= len(m.Challenger)
+		copy(dAtA[i:], m.Challenger)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Challenger)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.SlashAmount.Size()
+		i -= size
+		if _, err := m.SlashAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Slash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *Challenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.ExpiredHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiredHeight))
+	}
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *AttestedChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.Result != 0 {
+		n += 1 + sovTypes(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *AttestedChallengeIds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		n += 1 + sovTypes(uint64(m.Size_))
+	}
+	if len(m.Challenges) > 0 {
+		for _, e := range m.Challenges {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Cursor != 0 {
+		n += 1 + sovTypes(uint64(m.Cursor))
+	}
+	return n
+}
+
+func (m *ArchivedChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.SegmentIndex != 0 {
+		n += 1 + sovTypes(uint64(m.SegmentIndex))
+	}
+	if m.RedundancyIndex != 0 {
+		n += 1 + sovTypes(uint64(m.RedundancyIndex))
+	}
+	l = len(m.ChallengerAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Attested {
+		n += 2
+	}
+	if m.Result != 0 {
+		n += 1 + sovTypes(uint64(m.Result))
+	}
+	if m.AttestedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.AttestedHeight))
+	}
+	if len(m.SegmentIndexes) > 0 {
+		l = 0
+		for _, e := range m.SegmentIndexes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.AdditionalObjects) > 0 {
+		for _, e := range m.AdditionalObjects {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpChallengeStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovTypes(uint64(m.WindowStartHeight))
+	}
+	if m.SucceedCount != 0 {
+		n += 1 + sovTypes(uint64(m.SucceedCount))
+	}
+	if m.FailedCount != 0 {
+		n += 1 + sovTypes(uint64(m.FailedCount))
+	}
+	return n
+}
+
+func (m *ChallengeSegments) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RedundancyIndex != 0 {
+		n += 1 + sovTypes(uint64(m.RedundancyIndex))
+	}
+	if len(m.SegmentIndexes) > 0 {
+		l = 0
+		for _, e := range m.SegmentIndexes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.AdditionalObjects) > 0 {
+		for _, e := range m.AdditionalObjects {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChallengedObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.RedundancyIndex != 0 {
+		n += 1 + sovTypes(uint64(m.RedundancyIndex))
+	}
+	if len(m.SegmentIndexes) > 0 {
+		l = 0
+		for _, e := range m.SegmentIndexes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *PendingSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		n += 1 + sovTypes(uint64(m.ChallengeId))
+	}
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.SlashAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Challenger)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.RedundancyIndex != 0 {
+		n += 1 + sovTypes(uint64(m.RedundancyIndex))
+	}
+	if len(m.SegmentIndexes) > 0 {
+		l = 0
+		for _, e := range m.SegmentIndexes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if m.ExecuteHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExecuteHeight))
+	}
+	if len(m.AdditionalObjects) > 0 {
+		for _, e := range m.AdditionalObjects {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Slash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Slash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Slash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Challenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Challenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Challenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredHeight", wireType)
+			}
+			m.ExpiredHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiredHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestedChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestedChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestedChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= VoteResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestedChallengeIds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestedChallengeIds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestedChallengeIds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenges = append(m.Challenges, &AttestedChallenge{})
+			if err := m.Challenges[len(m.Challenges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			m.Cursor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cursor |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivedChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentIndex", wireType)
+			}
+			m.SegmentIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SegmentIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyIndex", wireType)
+			}
+			m.RedundancyIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attested", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Attested = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= VoteResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestedHeight", wireType)
+			}
+			m.AttestedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SegmentIndexes = append(m.SegmentIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SegmentIndexes) == 0 {
+					m.SegmentIndexes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SegmentIndexes = append(m.SegmentIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentIndexes", wireType)
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalObjects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalObjects = append(m.AdditionalObjects, &ChallengedObject{})
+			if err := m.AdditionalObjects[len(m.AdditionalObjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpChallengeStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpChallengeStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpChallengeStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
+			}
+			m.WindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SucceedCount", wireType)
+			}
+			m.SucceedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SucceedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedCount", wireType)
+			}
+			m.FailedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *AttestedChallengeIds) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Size_ != 0 {
-		n += 1 + sovTypes(uint64(m.Size_))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.Challenges) > 0 {
-		for _, e := range m.Challenges {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+func (m *ChallengeSegments) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChallengeSegments: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChallengeSegments: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyIndex", wireType)
+			}
+			m.RedundancyIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SegmentIndexes = append(m.SegmentIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SegmentIndexes) == 0 {
+					m.SegmentIndexes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SegmentIndexes = append(m.SegmentIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentIndexes", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalObjects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalObjects = append(m.AdditionalObjects, &ChallengedObject{})
+			if err := m.AdditionalObjects[len(m.AdditionalObjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Cursor != 0 {
-		n += 1 + sovTypes(uint64(m.Cursor))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *ChallengedObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChallengedObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChallengedObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyIndex", wireType)
+			}
+			m.RedundancyIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SegmentIndexes = append(m.SegmentIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SegmentIndexes) == 0 {
+					m.SegmentIndexes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SegmentIndexes = append(m.SegmentIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentIndexes", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Slash) Unmarshal(dAtA []byte) error {
+func (m *PendingSlash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -590,13 +3012,32 @@ func (m *Slash) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Slash: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingSlash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Slash: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingSlash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
 			}
@@ -615,7 +3056,7 @@ func (m *Slash) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
@@ -649,11 +3090,11 @@ func (m *Slash) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmount", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -663,66 +3104,31 @@ func (m *Slash) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Challenge) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.SlashAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Challenge: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Challenge: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -732,16 +3138,29 @@ func (m *Challenge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredHeight", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.ExpiredHeight = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -751,66 +3170,29 @@ func (m *Challenge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiredHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AttestedChallenge) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AttestedChallenge: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestedChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			m.Challenger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -820,16 +3202,29 @@ func (m *AttestedChallenge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyIndex", wireType)
 			}
-			m.Result = 0
+			m.RedundancyIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -839,66 +3234,92 @@ func (m *AttestedChallenge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Result |= VoteResult(b&0x7F) << shift
+				m.RedundancyIndex |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AttestedChallengeIds) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 9:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SegmentIndexes = append(m.SegmentIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SegmentIndexes) == 0 {
+					m.SegmentIndexes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SegmentIndexes = append(m.SegmentIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentIndexes", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AttestedChallengeIds: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestedChallengeIds: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteHeight", wireType)
 			}
-			m.Size_ = 0
+			m.ExecuteHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -908,14 +3329,14 @@ func (m *AttestedChallengeIds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Size_ |= uint64(b&0x7F) << shift
+				m.ExecuteHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Challenges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalObjects", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -942,30 +3363,11 @@ func (m *AttestedChallengeIds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Challenges = append(m.Challenges, &AttestedChallenge{})
-			if err := m.Challenges[len(m.Challenges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AdditionalObjects = append(m.AdditionalObjects, &ChallengedObject{})
+			if err := m.AdditionalObjects[len(m.AdditionalObjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
-			}
-			m.Cursor = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Cursor |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
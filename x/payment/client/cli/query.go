@@ -30,6 +30,10 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdDynamicBalance())
 	cmd.AddCommand(CmdGetPaymentAccountsByOwner())
 	cmd.AddCommand(CmdListAutoSettleRecord())
+	cmd.AddCommand(CmdListScheduledDeposit())
+	cmd.AddCommand(CmdListDelayedWithdrawal())
+	cmd.AddCommand(CmdShowDelayedWithdrawal())
+	cmd.AddCommand(CmdStreamRecordHistory())
 
 	return cmd
 }
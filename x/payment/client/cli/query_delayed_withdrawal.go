@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func CmdListDelayedWithdrawal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-delayed-withdrawal",
+		Short: "list all delayed withdrawals",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryDelayedWithdrawalsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DelayedWithdrawals(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowDelayedWithdrawal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-delayed-withdrawal [account]",
+		Short: "shows the delayed withdrawal of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryDelayedWithdrawalRequest{
+				Account: args[0],
+			}
+
+			res, err := queryClient.DelayedWithdrawal(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func CmdListScheduledDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-scheduled-deposit [owner]",
+		Short: "list all scheduled deposits, or the ones of the owner if it is provided",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryScheduledDepositsRequest{
+				Pagination: pageReq,
+			}
+			if len(args) > 0 {
+				params.Owner = args[0]
+			}
+
+			res, err := queryClient.ScheduledDeposits(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
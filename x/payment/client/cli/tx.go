@@ -34,6 +34,9 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdDeposit())
 	cmd.AddCommand(CmdWithdraw())
 	cmd.AddCommand(CmdDisableRefund())
+	cmd.AddCommand(CmdCreateScheduledDeposit())
+	cmd.AddCommand(CmdCancelScheduledDeposit())
+	cmd.AddCommand(CmdCancelDelayedWithdrawal())
 
 	return cmd
 }
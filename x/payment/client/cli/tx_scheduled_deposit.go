@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+const (
+	FlagStartTime     = "start-time"
+	FlagMaxExecutions = "max-executions"
+)
+
+func CmdCreateScheduledDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-scheduled-deposit [to] [amount] [interval]",
+		Short: "Broadcast message create-scheduled-deposit, the interval is in seconds",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argTo := args[0]
+			argAmount, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+			argInterval, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid interval %s", args[2])
+			}
+			startTime, err := cmd.Flags().GetInt64(FlagStartTime)
+			if err != nil {
+				return err
+			}
+			maxExecutions, err := cmd.Flags().GetUint64(FlagMaxExecutions)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateScheduledDeposit(
+				clientCtx.GetFromAddress().String(),
+				argTo,
+				argAmount,
+				argInterval,
+				startTime,
+				maxExecutions,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartTime, 0, "The unix timestamp of the first execution, the current block time is used by default")
+	cmd.Flags().Uint64(FlagMaxExecutions, 0, "The total number of executions, 0 means no limit")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCancelScheduledDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-scheduled-deposit [id]",
+		Short: "Broadcast message cancel-scheduled-deposit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid id %s", args[0])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelScheduledDeposit(
+				clientCtx.GetFromAddress().String(),
+				argId,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -25,6 +25,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.AutoSettleRecordList {
 		k.SetAutoSettleRecord(ctx, &elem)
 	}
+	// Set all the scheduledDeposit
+	for _, elem := range genState.ScheduledDepositList {
+		k.SetScheduledDeposit(ctx, &elem)
+	}
+	k.SetScheduledDepositSequence(ctx, genState.ScheduledDepositSequence)
 	err := k.SetParams(ctx, genState.Params)
 	if err != nil {
 		panic(err)
@@ -40,6 +45,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.PaymentAccountCountList = k.GetAllPaymentAccountCount(ctx)
 	genesis.PaymentAccountList = k.GetAllPaymentAccount(ctx)
 	genesis.AutoSettleRecordList = k.GetAllAutoSettleRecord(ctx)
+	genesis.ScheduledDepositList = k.GetAllScheduledDeposits(ctx)
+	genesis.ScheduledDepositSequence = k.GetScheduledDepositSequence(ctx)
 
 	return genesis
 }
@@ -7,11 +7,19 @@ import (
 	"github.com/bnb-chain/greenfield/x/payment/types"
 )
 
-// SetDelayedWithdrawalRecord set a specific delayedWithdrawal in the store from its index
+// SetDelayedWithdrawalRecord set a specific delayedWithdrawal in the store from its index,
+// it also maintains the unlock queue
 func (k Keeper) SetDelayedWithdrawalRecord(ctx sdk.Context, delayedWithdrawalRecord *types.DelayedWithdrawalRecord) {
+	account := sdk.MustAccAddressFromHex(delayedWithdrawalRecord.Addr)
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedWithdrawalQueueKeyPrefix)
+	if prev, found := k.GetDelayedWithdrawalRecord(ctx, account); found {
+		queueStore.Delete(types.DelayedWithdrawalQueueKey(prev.UnlockTimestamp, account))
+	}
+	queueStore.Set(types.DelayedWithdrawalQueueKey(delayedWithdrawalRecord.UnlockTimestamp, account), []byte{0x00})
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedWithdrawalKeyPrefix)
 	key := types.DelayedWithdrawalKey(
-		sdk.MustAccAddressFromHex(delayedWithdrawalRecord.Addr),
+		account,
 	)
 
 	addr := delayedWithdrawalRecord.Addr
@@ -41,13 +49,49 @@ func (k Keeper) GetDelayedWithdrawalRecord(
 	return delayedWithdrawal, true
 }
 
-// RemoveDelayedWithdrawalRecord removes a delayedWithdrawal from the store
+// RemoveDelayedWithdrawalRecord removes a delayedWithdrawal and its unlock queue entry from the store
 func (k Keeper) RemoveDelayedWithdrawalRecord(
 	ctx sdk.Context,
 	addr sdk.AccAddress,
 ) {
+	if prev, found := k.GetDelayedWithdrawalRecord(ctx, addr); found {
+		queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedWithdrawalQueueKeyPrefix)
+		queueStore.Delete(types.DelayedWithdrawalQueueKey(prev.UnlockTimestamp, addr))
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedWithdrawalKeyPrefix)
 	store.Delete(types.DelayedWithdrawalKey(
 		addr,
 	))
 }
+
+// UnlockDelayedWithdrawals emits an event for each delayed withdrawal whose time lock expires, then the receiver
+// can withdraw it. The records are kept until they are withdrawn or cancelled, only the queue entries are removed.
+func (k Keeper) UnlockDelayedWithdrawals(ctx sdk.Context) {
+	currentTimestamp := ctx.BlockTime().Unix()
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedWithdrawalQueueKeyPrefix)
+	// the withdrawal can be proceeded when the block time is after the unlock timestamp
+	iterator := store.Iterator(nil, types.DelayedWithdrawalQueueKey(currentTimestamp, nil))
+
+	unlockedKeys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		unlockedKeys = append(unlockedKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range unlockedKeys {
+		store.Delete(key)
+		_, addr := types.ParseDelayedWithdrawalQueueKey(key)
+		delayedWithdrawal, found := k.GetDelayedWithdrawalRecord(ctx, addr)
+		if !found { // should not happen
+			ctx.Logger().Error("unlock delayed withdrawal, record not found", "addr", addr.String())
+			continue
+		}
+		_ = ctx.EventManager().EmitTypedEvents(&types.EventDelayedWithdrawalUnlocked{
+			Addr:            delayedWithdrawal.Addr,
+			From:            delayedWithdrawal.From,
+			Amount:          delayedWithdrawal.Amount,
+			UnlockTimestamp: delayedWithdrawal.UnlockTimestamp,
+		})
+	}
+}
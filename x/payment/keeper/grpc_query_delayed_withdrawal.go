@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -32,3 +34,32 @@ func (k Keeper) DelayedWithdrawal(goCtx context.Context, req *types.QueryDelayed
 
 	return &types.QueryDelayedWithdrawalResponse{DelayedWithdrawal: *delayedWithdrawal}, nil
 }
+
+func (k Keeper) DelayedWithdrawals(goCtx context.Context, req *types.QueryDelayedWithdrawalsRequest) (*types.QueryDelayedWithdrawalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := query.CheckOffsetQueryNotAllowed(ctx, req.Pagination); err != nil {
+		return nil, err
+	}
+
+	var delayedWithdrawals []types.DelayedWithdrawalRecord
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedWithdrawalKeyPrefix)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var delayedWithdrawal types.DelayedWithdrawalRecord
+		if err := k.cdc.Unmarshal(value, &delayedWithdrawal); err != nil {
+			return err
+		}
+		delayedWithdrawal.Addr = sdk.AccAddress(key).String()
+		delayedWithdrawals = append(delayedWithdrawals, delayedWithdrawal)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelayedWithdrawalsResponse{DelayedWithdrawals: delayedWithdrawals, Pagination: pageRes}, nil
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (k Keeper) ScheduledDeposits(c context.Context, req *types.QueryScheduledDepositsRequest) (*types.QueryScheduledDepositsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := query.CheckOffsetQueryNotAllowed(ctx, req.Pagination); err != nil {
+		return nil, err
+	}
+
+	var scheduledDeposits []types.ScheduledDeposit
+	store := ctx.KVStore(k.storeKey)
+
+	var pageRes *query.PageResponse
+	var err error
+	if req.Owner == "" {
+		depositStore := prefix.NewStore(store, types.ScheduledDepositKeyPrefix)
+		pageRes, err = query.Paginate(depositStore, req.Pagination, func(key []byte, value []byte) error {
+			var scheduledDeposit types.ScheduledDeposit
+			if err := k.cdc.Unmarshal(value, &scheduledDeposit); err != nil {
+				return err
+			}
+			scheduledDeposits = append(scheduledDeposits, scheduledDeposit)
+			return nil
+		})
+	} else {
+		owner, addrErr := sdk.AccAddressFromHexUnsafe(req.Owner)
+		if addrErr != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid owner address")
+		}
+		ownerStore := prefix.NewStore(store, append(types.ScheduledDepositByOwnerKeyPrefix, owner.Bytes()...))
+		pageRes, err = query.Paginate(ownerStore, req.Pagination, func(key []byte, value []byte) error {
+			scheduledDeposit, found := k.GetScheduledDeposit(ctx, binary.BigEndian.Uint64(key))
+			if found {
+				scheduledDeposits = append(scheduledDeposits, *scheduledDeposit)
+			}
+			return nil
+		})
+	}
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScheduledDepositsResponse{ScheduledDeposits: scheduledDeposits, Pagination: pageRes}, nil
+}
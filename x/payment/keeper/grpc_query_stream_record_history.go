@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (k Keeper) StreamRecordHistory(goCtx context.Context, req *types.QueryStreamRecordHistoryRequest) (*types.QueryStreamRecordHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := query.CheckOffsetQueryNotAllowed(ctx, req.Pagination); err != nil {
+		return nil, err
+	}
+
+	account, err := sdk.AccAddressFromHexUnsafe(req.Account)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid account")
+	}
+
+	var entries []types.StreamRecordHistoryEntry
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StreamRecordHistoryKeyPrefix)
+	historyStore := prefix.NewStore(store, account.Bytes())
+
+	pageRes, err := query.Paginate(historyStore, req.Pagination, func(key []byte, value []byte) error {
+		var entry types.StreamRecordHistoryEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStreamRecordHistoryResponse{Entries: entries, Pagination: pageRes}, nil
+}
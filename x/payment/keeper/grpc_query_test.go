@@ -6,6 +6,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
@@ -335,3 +336,53 @@ func TestDelayedWithdrawalQuery(t *testing.T) {
 	require.True(t, response.DelayedWithdrawal.Amount.Equal(delayedWithdrawal.Amount))
 	require.True(t, response.DelayedWithdrawal.UnlockTimestamp == delayedWithdrawal.UnlockTimestamp)
 }
+
+func TestStreamRecordHistoryQuery(t *testing.T) {
+	keeper, ctx, _ := makePaymentKeeper(t)
+	ctx = ctx.WithBlockTime(time.Now())
+	params := types.DefaultParams()
+	params.MaxStreamRecordHistoryCount = 3
+	err := keeper.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	owner := sample.RandAccAddress()
+	streamRecord := types.NewStreamRecord(owner, ctx.BlockTime().Unix())
+	streamRecord.StaticBalance = sdkmath.NewInt(100)
+	keeper.SetStreamRecord(types.WithStreamRecordChangeReason(ctx, types.STREAM_RECORD_CHANGE_REASON_DEPOSIT, owner.String()), streamRecord)
+
+	for i := 0; i < 3; i++ {
+		change := types.NewDefaultStreamRecordChangeWithAddr(owner).WithLockBalanceChange(sdkmath.NewInt(10))
+		_, err = keeper.UpdateStreamRecordByAddr(types.WithStreamRecordChangeReason(ctx, types.STREAM_RECORD_CHANGE_REASON_BUCKET_FLOW_CHANGE, "bucket"), change)
+		require.NoError(t, err)
+	}
+
+	// the oldest entry is pruned
+	response, err := keeper.StreamRecordHistory(ctx, &types.QueryStreamRecordHistoryRequest{
+		Account: owner.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(response.Entries))
+	for i, entry := range response.Entries {
+		require.Equal(t, uint64(i+1), entry.Sequence)
+		require.Equal(t, types.STREAM_RECORD_CHANGE_REASON_BUCKET_FLOW_CHANGE, entry.Reason)
+		require.Equal(t, "bucket", entry.Reference)
+		require.Equal(t, sdkmath.NewInt(int64(10*(i+1))), entry.LockBalance)
+		require.Equal(t, sdkmath.NewInt(int64(100-10*(i+1))), entry.StaticBalance)
+	}
+
+	// paginate in reverse order to get the latest entry
+	response, err = keeper.StreamRecordHistory(ctx, &types.QueryStreamRecordHistoryRequest{
+		Account:    owner.String(),
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(response.Entries))
+	require.Equal(t, uint64(3), response.Entries[0].Sequence)
+
+	// the history of other accounts is not affected
+	response, err = keeper.StreamRecordHistory(ctx, &types.QueryStreamRecordHistoryRequest{
+		Account: sample.RandAccAddress().String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(response.Entries))
+}
@@ -10,6 +10,7 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/bnb-chain/greenfield/internal/sequence"
 	"github.com/bnb-chain/greenfield/x/payment/types"
 )
 
@@ -21,6 +22,8 @@ type (
 		bankKeeper    types.BankKeeper
 		accountKeeper types.AccountKeeper
 		authority     string
+
+		scheduledDepositSequence sequence.Sequence[sdkmath.Uint]
 	}
 )
 
@@ -31,13 +34,16 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	authority string,
 ) *Keeper {
-	return &Keeper{
+	k := &Keeper{
 		cdc:           cdc,
 		storeKey:      storeKey,
 		bankKeeper:    bankKeeper,
 		accountKeeper: accountKeeper,
 		authority:     authority,
 	}
+
+	k.scheduledDepositSequence = sequence.NewSequence[sdkmath.Uint](types.ScheduledDepositSequenceKey)
+	return k
 }
 
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
@@ -58,6 +64,7 @@ func (k Keeper) QueryDynamicBalance(ctx sdk.Context, addr sdk.AccAddress) (amoun
 }
 
 func (k Keeper) Withdraw(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amount sdkmath.Int) error {
+	ctx = types.WithStreamRecordChangeReason(ctx, types.STREAM_RECORD_CHANGE_REASON_WITHDRAW, toAddr.String())
 	streamRecord, found := k.GetStreamRecord(ctx, fromAddr)
 	if !found {
 		return errors.Wrapf(types.ErrStreamRecordNotFound, "stream record not found %s", fromAddr.String())
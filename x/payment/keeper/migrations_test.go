@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/x/payment"
+	"github.com/bnb-chain/greenfield/x/payment/keeper"
+	v1 "github.com/bnb-chain/greenfield/x/payment/keeper/v1"
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func TestMigrateV1toV2(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(payment.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockTime(time.Now())
+
+	accountKeeper := types.NewMockAccountKeeper(gomock.NewController(t))
+	accountKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+	k := keeper.NewKeeper(encCfg.Codec, key, nil, accountKeeper, authtypes.NewModuleAddress(types.ModuleName).String())
+
+	// the params saved by the payment v1
+	defaultParams := types.DefaultParams()
+	oldParams := v1.Params{
+		VersionedParams: v1.VersionedParams{
+			ReserveTime:      defaultParams.VersionedParams.ReserveTime,
+			ValidatorTaxRate: defaultParams.VersionedParams.ValidatorTaxRate,
+		},
+		PaymentAccountCountLimit: defaultParams.PaymentAccountCountLimit,
+		ForcedSettleTime:         defaultParams.ForcedSettleTime,
+		MaxAutoSettleFlowCount:   defaultParams.MaxAutoSettleFlowCount,
+		MaxAutoResumeFlowCount:   defaultParams.MaxAutoResumeFlowCount,
+		FeeDenom:                 defaultParams.FeeDenom,
+	}
+	ctx.KVStore(key).Set(types.ParamsKey, encCfg.Codec.MustMarshal(&oldParams))
+
+	require.NoError(t, keeper.NewMigrator(*k).MigrateV1toV2(ctx))
+	params := k.GetParams(ctx)
+	require.NoError(t, params.Validate())
+	require.Equal(t, types.DefaultMaxAutoScheduledDepositCount, params.MaxAutoScheduledDepositCount)
+	require.Equal(t, types.DefaultScheduledDepositCountLimit, params.ScheduledDepositCountLimit)
+	require.Equal(t, types.DefaultMaxStreamRecordHistoryCount, params.MaxStreamRecordHistoryCount)
+
+	// the scheduled deposits can be created after the migration
+	msg := types.NewMsgCreateScheduledDeposit(sample.RandAccAddress().String(), sample.RandAccAddress().String(),
+		sdkmath.NewInt(1000), types.MinScheduledDepositInterval, 0, 0)
+	_, err := keeper.NewMsgServerImpl(*k).CreateScheduledDeposit(ctx, msg)
+	require.NoError(t, err)
+
+	// the changes of the stream records are recorded in the history after the migration
+	owner := sample.RandAccAddress()
+	streamRecord := types.NewStreamRecord(owner, ctx.BlockTime().Unix())
+	k.SetStreamRecord(types.WithStreamRecordChangeReason(ctx, types.STREAM_RECORD_CHANGE_REASON_DEPOSIT, owner.String()), streamRecord)
+	res, err := k.StreamRecordHistory(ctx, &types.QueryStreamRecordHistoryRequest{Account: owner.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (k msgServer) CancelDelayedWithdrawal(goCtx context.Context, msg *types.MsgCancelDelayedWithdrawal) (*types.MsgCancelDelayedWithdrawalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	creator := sdk.MustAccAddressFromHex(msg.Creator)
+
+	delayedWithdrawal, found := k.GetDelayedWithdrawalRecord(ctx, creator)
+	if !found {
+		return nil, errors.Wrapf(types.ErrNoDelayedWithdrawal, "delayed withdrawal not found %s", creator.String())
+	}
+	k.RemoveDelayedWithdrawalRecord(ctx, creator)
+
+	// the amount is still in the module account, return it to the static balance of the stream record
+	from := sdk.MustAccAddressFromHex(delayedWithdrawal.From)
+	streamRecord, found := k.GetStreamRecord(ctx, from)
+	if !found {
+		return nil, types.ErrStreamRecordNotFound
+	}
+	ctx = types.WithStreamRecordChangeReason(ctx, types.STREAM_RECORD_CHANGE_REASON_CANCEL_WITHDRAWAL, creator.String())
+	if streamRecord.Status == types.STREAM_ACCOUNT_STATUS_ACTIVE {
+		change := types.NewDefaultStreamRecordChangeWithAddr(from).WithStaticBalanceChange(delayedWithdrawal.Amount)
+		err := k.UpdateStreamRecord(ctx, streamRecord, change)
+		if err != nil {
+			return nil, err
+		}
+		k.SetStreamRecord(ctx, streamRecord)
+	} else if streamRecord.Status == types.STREAM_ACCOUNT_STATUS_FROZEN {
+		err := k.TryResumeStreamRecord(ctx, streamRecord, delayedWithdrawal.Amount)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// status can only be normal or frozen
+		return nil, types.ErrInvalidStreamAccountStatus
+	}
+
+	err := ctx.EventManager().EmitTypedEvents(&types.EventCancelDelayedWithdrawal{
+		Addr:   delayedWithdrawal.Addr,
+		From:   delayedWithdrawal.From,
+		Amount: delayedWithdrawal.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgCancelDelayedWithdrawalResponse{}, nil
+}
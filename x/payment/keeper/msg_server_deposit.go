@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
@@ -12,21 +13,32 @@ import (
 func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types.MsgDepositResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// bank transfer
 	creator := sdk.MustAccAddressFromHex(msg.Creator)
 	to := sdk.MustAccAddressFromHex(msg.To)
-	depositAmount := msg.Amount
+	err := k.deposit(ctx, creator, to, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgDepositResponse{}, nil
+}
+
+// deposit transfers the amount from the creator's bank account to the stream record of the receiver,
+// it is shared by MsgDeposit and the scheduled deposits executed in EndBlocker.
+func (k Keeper) deposit(ctx sdk.Context, creator, to sdk.AccAddress, amount sdkmath.Int) error {
+	ctx = types.WithStreamRecordChangeReason(ctx, types.STREAM_RECORD_CHANGE_REASON_DEPOSIT, creator.String())
+	// bank transfer
+	depositAmount := amount
 	coinsToDeposit := sdk.NewCoins(sdk.NewCoin(k.GetParams(ctx).FeeDenom, depositAmount))
 	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, coinsToDeposit)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if ctx.IsUpgraded(upgradetypes.Nagqu) && k.IsPaymentAccount(ctx, to) {
 		balanceOfToAccount := k.bankKeeper.GetBalance(ctx, to, k.GetParams(ctx).FeeDenom)
 		if balanceOfToAccount.IsPositive() {
 			err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, to, types.ModuleName, sdk.NewCoins(balanceOfToAccount))
 			if err != nil {
-				return nil, err
+				return err
 			}
 			depositAmount = depositAmount.Add(balanceOfToAccount.Amount)
 		}
@@ -39,9 +51,9 @@ func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 		// if not found, check whether the account exists, if exists, create a new record, otherwise, return error
 		_, paymentAccountExists := k.GetPaymentAccount(ctx, to)
 		if !paymentAccountExists && !k.accountKeeper.HasAccount(ctx, to) {
-			return nil, types.ErrReceiveAccountNotExist
+			return types.ErrReceiveAccountNotExist
 		}
-		streamRecord.Account = msg.To
+		streamRecord.Account = to.String()
 		streamRecord.CrudTimestamp = ctx.BlockTime().Unix()
 		streamRecord.StaticBalance = depositAmount
 		k.SetStreamRecord(ctx, streamRecord)
@@ -51,18 +63,18 @@ func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 			change := types.NewDefaultStreamRecordChangeWithAddr(to).WithStaticBalanceChange(depositAmount)
 			err = k.UpdateStreamRecord(ctx, streamRecord, change)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			k.SetStreamRecord(ctx, streamRecord)
 		} else if streamRecord.Status == types.STREAM_ACCOUNT_STATUS_FROZEN {
 			// deposit and try resume the account
 			err = k.TryResumeStreamRecord(ctx, streamRecord, depositAmount)
 			if err != nil {
-				return nil, err
+				return err
 			}
 		} else {
 			// status can only be normal or frozen
-			return nil, types.ErrInvalidStreamAccountStatus
+			return types.ErrInvalidStreamAccountStatus
 		}
 	}
 
@@ -71,9 +83,5 @@ func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 		To:     to.String(),
 		Amount: depositAmount,
 	}
-	err = ctx.EventManager().EmitTypedEvents(&event)
-	if err != nil {
-		return nil, err
-	}
-	return &types.MsgDepositResponse{}, nil
+	return ctx.EventManager().EmitTypedEvents(&event)
 }
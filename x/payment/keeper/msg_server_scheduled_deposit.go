@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (k msgServer) CreateScheduledDeposit(goCtx context.Context, msg *types.MsgCreateScheduledDeposit) (*types.MsgCreateScheduledDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator := sdk.MustAccAddressFromHex(msg.Creator)
+	to := sdk.MustAccAddressFromHex(msg.To)
+
+	limit := k.GetParams(ctx).ScheduledDepositCountLimit
+	if count := k.GetScheduledDepositCount(ctx, creator, limit); count >= limit {
+		return nil, errors.Wrapf(types.ErrReachScheduledDepositLimit, "current count: %d, limit: %d", count, limit)
+	}
+
+	// the receiver should be able to hold a stream record
+	_, streamRecordExists := k.GetStreamRecord(ctx, to)
+	_, paymentAccountExists := k.GetPaymentAccount(ctx, to)
+	if !streamRecordExists && !paymentAccountExists && !k.accountKeeper.HasAccount(ctx, to) {
+		return nil, types.ErrReceiveAccountNotExist
+	}
+
+	nextExecutionTime := msg.StartTime
+	if nextExecutionTime < ctx.BlockTime().Unix() {
+		nextExecutionTime = ctx.BlockTime().Unix()
+	}
+
+	scheduledDeposit := &types.ScheduledDeposit{
+		Id:                k.GenNextScheduledDepositID(ctx),
+		Owner:             creator.String(),
+		To:                to.String(),
+		Amount:            msg.Amount,
+		Interval:          msg.Interval,
+		NextExecutionTime: nextExecutionTime,
+		MaxExecutions:     msg.MaxExecutions,
+	}
+	k.SetScheduledDeposit(ctx, scheduledDeposit)
+
+	err := ctx.EventManager().EmitTypedEvents(&types.EventCreateScheduledDeposit{
+		Id:                scheduledDeposit.Id,
+		Owner:             scheduledDeposit.Owner,
+		To:                scheduledDeposit.To,
+		Amount:            scheduledDeposit.Amount,
+		Interval:          scheduledDeposit.Interval,
+		NextExecutionTime: scheduledDeposit.NextExecutionTime,
+		MaxExecutions:     scheduledDeposit.MaxExecutions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgCreateScheduledDepositResponse{Id: scheduledDeposit.Id}, nil
+}
+
+func (k msgServer) CancelScheduledDeposit(goCtx context.Context, msg *types.MsgCancelScheduledDeposit) (*types.MsgCancelScheduledDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator := sdk.MustAccAddressFromHex(msg.Creator)
+	scheduledDeposit, found := k.GetScheduledDeposit(ctx, msg.Id)
+	if !found {
+		return nil, errors.Wrapf(types.ErrScheduledDepositNotFound, "scheduled deposit not found %d", msg.Id)
+	}
+	if !creator.Equals(sdk.MustAccAddressFromHex(scheduledDeposit.Owner)) {
+		return nil, types.ErrNotScheduledDepositOwner
+	}
+
+	k.RemoveScheduledDeposit(ctx, scheduledDeposit)
+
+	err := ctx.EventManager().EmitTypedEvents(&types.EventCancelScheduledDeposit{
+		Id:    scheduledDeposit.Id,
+		Owner: scheduledDeposit.Owner,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgCancelScheduledDepositResponse{}, nil
+}
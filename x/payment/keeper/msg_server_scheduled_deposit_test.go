@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (s *TestSuite) TestCreateAndCancelScheduledDeposit() {
+	s.ctx = s.ctx.WithBlockTime(time.Now())
+	s.accountKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).
+		Return(true).AnyTimes()
+
+	owner := sample.RandAccAddress()
+	to := sample.RandAccAddress()
+	msg := types.NewMsgCreateScheduledDeposit(owner.String(), to.String(), sdkmath.NewInt(1000), types.MinScheduledDepositInterval, 0, 0)
+	res, err := s.msgServer.CreateScheduledDeposit(s.ctx, msg)
+	s.Require().NoError(err)
+
+	scheduledDeposit, found := s.paymentKeeper.GetScheduledDeposit(s.ctx, res.Id)
+	s.Require().True(found)
+	s.Require().Equal(owner.String(), scheduledDeposit.Owner)
+	s.Require().Equal(s.ctx.BlockTime().Unix(), scheduledDeposit.NextExecutionTime)
+
+	queryRes, err := s.queryClient.ScheduledDeposits(s.ctx, &types.QueryScheduledDepositsRequest{Owner: owner.String()})
+	s.Require().NoError(err)
+	s.Require().Len(queryRes.ScheduledDeposits, 1)
+
+	// only the owner can cancel
+	_, err = s.msgServer.CancelScheduledDeposit(s.ctx, types.NewMsgCancelScheduledDeposit(to.String(), res.Id))
+	s.Require().ErrorIs(err, types.ErrNotScheduledDepositOwner)
+
+	_, err = s.msgServer.CancelScheduledDeposit(s.ctx, types.NewMsgCancelScheduledDeposit(owner.String(), res.Id))
+	s.Require().NoError(err)
+	_, found = s.paymentKeeper.GetScheduledDeposit(s.ctx, res.Id)
+	s.Require().False(found)
+
+	queryRes, err = s.queryClient.ScheduledDeposits(s.ctx, &types.QueryScheduledDepositsRequest{Owner: owner.String()})
+	s.Require().NoError(err)
+	s.Require().Len(queryRes.ScheduledDeposits, 0)
+}
+
+func (s *TestSuite) TestAutoScheduledDeposit() {
+	s.ctx = s.ctx.WithBlockTime(time.Now())
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).AnyTimes()
+	s.accountKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).
+		Return(true).AnyTimes()
+
+	owner := sample.RandAccAddress()
+	to := sample.RandAccAddress()
+	interval := types.MinScheduledDepositInterval
+	msg := types.NewMsgCreateScheduledDeposit(owner.String(), to.String(), sdkmath.NewInt(1000), interval, 0, 2)
+	res, err := s.msgServer.CreateScheduledDeposit(s.ctx, msg)
+	s.Require().NoError(err)
+
+	// the first execution
+	s.paymentKeeper.AutoScheduledDeposit(s.ctx)
+	record, _ := s.paymentKeeper.GetStreamRecord(s.ctx, to)
+	s.Require().Equal(sdkmath.NewInt(1000), record.StaticBalance)
+	scheduledDeposit, found := s.paymentKeeper.GetScheduledDeposit(s.ctx, res.Id)
+	s.Require().True(found)
+	s.Require().Equal(uint64(1), scheduledDeposit.Executions)
+	s.Require().Equal(s.ctx.BlockTime().Unix()+int64(interval), scheduledDeposit.NextExecutionTime)
+
+	// not due yet
+	s.paymentKeeper.AutoScheduledDeposit(s.ctx)
+	record, _ = s.paymentKeeper.GetStreamRecord(s.ctx, to)
+	s.Require().Equal(sdkmath.NewInt(1000), record.StaticBalance)
+
+	// the last execution removes the scheduled deposit
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Duration(interval) * time.Second))
+	s.paymentKeeper.AutoScheduledDeposit(ctx)
+	record, _ = s.paymentKeeper.GetStreamRecord(ctx, to)
+	s.Require().Equal(sdkmath.NewInt(2000), record.StaticBalance)
+	_, found = s.paymentKeeper.GetScheduledDeposit(ctx, res.Id)
+	s.Require().False(found)
+}
+
+func (s *TestSuite) TestScheduledDepositCountLimit() {
+	s.ctx = s.ctx.WithBlockTime(time.Now())
+	s.accountKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).
+		Return(true).AnyTimes()
+
+	params := s.paymentKeeper.GetParams(s.ctx)
+	params.ScheduledDepositCountLimit = 2
+	s.Require().NoError(s.paymentKeeper.SetParams(s.ctx, params))
+
+	owner := sample.RandAccAddress()
+	to := sample.RandAccAddress()
+	msg := types.NewMsgCreateScheduledDeposit(owner.String(), to.String(), sdkmath.NewInt(1000), types.MinScheduledDepositInterval, 0, 0)
+	for i := 0; i < 2; i++ {
+		_, err := s.msgServer.CreateScheduledDeposit(s.ctx, msg)
+		s.Require().NoError(err)
+	}
+	_, err := s.msgServer.CreateScheduledDeposit(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrReachScheduledDepositLimit)
+
+	// the limit is per owner
+	other := sample.RandAccAddress()
+	_, err = s.msgServer.CreateScheduledDeposit(s.ctx, types.NewMsgCreateScheduledDeposit(other.String(), to.String(), sdkmath.NewInt(1000), types.MinScheduledDepositInterval, 0, 0))
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestFailedScheduledDepositNotCounted() {
+	s.ctx = s.ctx.WithBlockTime(time.Now())
+	s.accountKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).
+		Return(true).AnyTimes()
+
+	owner := sample.RandAccAddress()
+	to := sample.RandAccAddress()
+	interval := types.MinScheduledDepositInterval
+	msg := types.NewMsgCreateScheduledDeposit(owner.String(), to.String(), sdkmath.NewInt(1000), interval, 0, 1)
+	res, err := s.msgServer.CreateScheduledDeposit(s.ctx, msg)
+	s.Require().NoError(err)
+
+	// the failed execution does not finish the scheduled deposit
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(types.ErrInsufficientBalance)
+	s.paymentKeeper.AutoScheduledDeposit(s.ctx)
+	scheduledDeposit, found := s.paymentKeeper.GetScheduledDeposit(s.ctx, res.Id)
+	s.Require().True(found)
+	s.Require().Equal(uint64(0), scheduledDeposit.Executions)
+	s.Require().Equal(uint64(1), scheduledDeposit.FailedExecutions)
+
+	// the successful execution finishes it
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Duration(interval) * time.Second))
+	s.paymentKeeper.AutoScheduledDeposit(ctx)
+	_, found = s.paymentKeeper.GetScheduledDeposit(ctx, res.Id)
+	s.Require().False(found)
+}
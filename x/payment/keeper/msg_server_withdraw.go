@@ -68,6 +68,7 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*typ
 		}
 	}
 	change := types.NewDefaultStreamRecordChangeWithAddr(from).WithStaticBalanceChange(msg.Amount.Neg())
+	ctx = types.WithStreamRecordChangeReason(ctx, types.STREAM_RECORD_CHANGE_REASON_WITHDRAW, creator.String())
 	err := k.UpdateStreamRecord(ctx, streamRecord, change)
 	if err != nil {
 		return nil, err
@@ -1,7 +1,10 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/golang/mock/gomock"
 
 	"github.com/bnb-chain/greenfield/testutil/sample"
@@ -84,3 +87,67 @@ func (s *TestSuite) TestWithdraw_Success() {
 	_, err = s.msgServer.Withdraw(s.ctx, msg)
 	s.Require().NoError(err)
 }
+
+func (s *TestSuite) TestCancelDelayedWithdrawal() {
+	s.ctx = s.ctx.WithBlockTime(time.Now())
+
+	creator := sample.RandAccAddress()
+	_, err := s.msgServer.CancelDelayedWithdrawal(s.ctx, types.NewMsgCancelDelayedWithdrawal(creator.String()))
+	s.Require().ErrorIs(err, types.ErrNoDelayedWithdrawal)
+
+	record := types.NewStreamRecord(creator, s.ctx.BlockTime().Unix())
+	record.StaticBalance = sdkmath.NewInt(100)
+	s.paymentKeeper.SetStreamRecord(s.ctx, record)
+	s.paymentKeeper.SetDelayedWithdrawalRecord(s.ctx, &types.DelayedWithdrawalRecord{
+		Addr:            creator.String(),
+		Amount:          sdkmath.NewInt(1000),
+		From:            creator.String(),
+		UnlockTimestamp: s.ctx.BlockTime().Unix() + 100,
+	})
+
+	queryRes, err := s.queryClient.DelayedWithdrawals(s.ctx, &types.QueryDelayedWithdrawalsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(queryRes.DelayedWithdrawals, 1)
+	s.Require().Equal(creator.String(), queryRes.DelayedWithdrawals[0].Addr)
+
+	_, err = s.msgServer.CancelDelayedWithdrawal(s.ctx, types.NewMsgCancelDelayedWithdrawal(creator.String()))
+	s.Require().NoError(err)
+	_, found := s.paymentKeeper.GetDelayedWithdrawalRecord(s.ctx, creator)
+	s.Require().False(found)
+	record, _ = s.paymentKeeper.GetStreamRecord(s.ctx, creator)
+	s.Require().Equal(sdkmath.NewInt(1100), record.StaticBalance)
+
+	// the cancelled withdrawal will not be unlocked
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	s.paymentKeeper.UnlockDelayedWithdrawals(ctx)
+	s.Require().Len(ctx.EventManager().Events(), 0)
+}
+
+func (s *TestSuite) TestUnlockDelayedWithdrawals() {
+	s.ctx = s.ctx.WithBlockTime(time.Now())
+
+	creator := sample.RandAccAddress()
+	s.paymentKeeper.SetDelayedWithdrawalRecord(s.ctx, &types.DelayedWithdrawalRecord{
+		Addr:            creator.String(),
+		Amount:          sdkmath.NewInt(1000),
+		From:            creator.String(),
+		UnlockTimestamp: s.ctx.BlockTime().Unix() + 100,
+	})
+
+	// the time lock is not expired
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(100 * time.Second)).WithEventManager(sdk.NewEventManager())
+	s.paymentKeeper.UnlockDelayedWithdrawals(ctx)
+	s.Require().Len(ctx.EventManager().Events(), 0)
+
+	ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(101 * time.Second)).WithEventManager(sdk.NewEventManager())
+	s.paymentKeeper.UnlockDelayedWithdrawals(ctx)
+	s.Require().Len(ctx.EventManager().Events(), 1)
+	s.Require().Equal("greenfield.payment.EventDelayedWithdrawalUnlocked", ctx.EventManager().Events()[0].Type)
+
+	// the event is emitted only once, and the record is kept for withdrawal
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	s.paymentKeeper.UnlockDelayedWithdrawals(ctx)
+	s.Require().Len(ctx.EventManager().Events(), 0)
+	_, found := s.paymentKeeper.GetDelayedWithdrawalRecord(ctx, creator)
+	s.Require().True(found)
+}
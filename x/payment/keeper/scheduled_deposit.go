@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+// GenNextScheduledDepositID generates the id for a new scheduled deposit
+func (k Keeper) GenNextScheduledDepositID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	seq := k.scheduledDepositSequence.NextVal(store)
+	return seq.Uint64()
+}
+
+// SetScheduledDeposit set a specific scheduledDeposit in the store from its index,
+// it also maintains the execution queue and the owner index
+func (k Keeper) SetScheduledDeposit(ctx sdk.Context, scheduledDeposit *types.ScheduledDeposit) {
+	store := ctx.KVStore(k.storeKey)
+
+	if prev, found := k.GetScheduledDeposit(ctx, scheduledDeposit.Id); found {
+		queueStore := prefix.NewStore(store, types.ScheduledDepositQueueKeyPrefix)
+		queueStore.Delete(types.ScheduledDepositQueueKey(prev.NextExecutionTime, prev.Id))
+	}
+
+	depositStore := prefix.NewStore(store, types.ScheduledDepositKeyPrefix)
+	depositStore.Set(types.ScheduledDepositKey(scheduledDeposit.Id), k.cdc.MustMarshal(scheduledDeposit))
+
+	queueStore := prefix.NewStore(store, types.ScheduledDepositQueueKeyPrefix)
+	queueStore.Set(types.ScheduledDepositQueueKey(scheduledDeposit.NextExecutionTime, scheduledDeposit.Id), []byte{0x00})
+
+	ownerStore := prefix.NewStore(store, types.ScheduledDepositByOwnerKeyPrefix)
+	ownerStore.Set(types.ScheduledDepositByOwnerKey(sdk.MustAccAddressFromHex(scheduledDeposit.Owner), scheduledDeposit.Id), []byte{0x00})
+}
+
+// GetScheduledDeposit returns a scheduledDeposit from its index
+func (k Keeper) GetScheduledDeposit(ctx sdk.Context, id uint64) (*types.ScheduledDeposit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledDepositKeyPrefix)
+
+	b := store.Get(types.ScheduledDepositKey(id))
+	if b == nil {
+		return nil, false
+	}
+
+	scheduledDeposit := &types.ScheduledDeposit{}
+	k.cdc.MustUnmarshal(b, scheduledDeposit)
+	return scheduledDeposit, true
+}
+
+// RemoveScheduledDeposit removes a scheduledDeposit and its indexes from the store
+func (k Keeper) RemoveScheduledDeposit(ctx sdk.Context, scheduledDeposit *types.ScheduledDeposit) {
+	store := ctx.KVStore(k.storeKey)
+
+	depositStore := prefix.NewStore(store, types.ScheduledDepositKeyPrefix)
+	depositStore.Delete(types.ScheduledDepositKey(scheduledDeposit.Id))
+
+	queueStore := prefix.NewStore(store, types.ScheduledDepositQueueKeyPrefix)
+	queueStore.Delete(types.ScheduledDepositQueueKey(scheduledDeposit.NextExecutionTime, scheduledDeposit.Id))
+
+	ownerStore := prefix.NewStore(store, types.ScheduledDepositByOwnerKeyPrefix)
+	ownerStore.Delete(types.ScheduledDepositByOwnerKey(sdk.MustAccAddressFromHex(scheduledDeposit.Owner), scheduledDeposit.Id))
+}
+
+// GetScheduledDepositCount returns the number of the scheduled deposits of the owner, it stops counting at the max
+func (k Keeper) GetScheduledDepositCount(ctx sdk.Context, owner sdk.AccAddress, max uint64) uint64 {
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.ScheduledDepositByOwnerKeyPrefix, owner.Bytes()...))
+	iterator := ownerStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	count := uint64(0)
+	for ; iterator.Valid() && count < max; iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// GetAllScheduledDeposits returns all the scheduled deposits
+func (k Keeper) GetAllScheduledDeposits(ctx sdk.Context) (list []types.ScheduledDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledDepositKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var scheduledDeposit types.ScheduledDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &scheduledDeposit)
+		list = append(list, scheduledDeposit)
+	}
+	return
+}
+
+// GetScheduledDepositSequence returns the last id generated for the scheduled deposits
+func (k Keeper) GetScheduledDepositSequence(ctx sdk.Context) uint64 {
+	return k.scheduledDepositSequence.CurVal(ctx.KVStore(k.storeKey)).Uint64()
+}
+
+// SetScheduledDepositSequence sets the last id generated for the scheduled deposits, it is used by the genesis import
+func (k Keeper) SetScheduledDepositSequence(ctx sdk.Context, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ScheduledDepositSequenceKey, k.scheduledDepositSequence.EncodeSequence(sdkmath.NewUint(seq)))
+}
+
+// AutoScheduledDeposit executes the due scheduled deposits in EndBlocker, the number of executions in one block
+// is limited by MaxAutoScheduledDepositCount. A failed execution, e.g. the owner has no enough balance,
+// will not be retried and the scheduled deposit will wait for the next execution time. Only the successful
+// executions are counted against the MaxExecutions, so that an underfunded schedule does not finish without paying.
+func (k Keeper) AutoScheduledDeposit(ctx sdk.Context) {
+	max := k.GetParams(ctx).MaxAutoScheduledDepositCount
+	if max == 0 {
+		return
+	}
+
+	currentTimestamp := ctx.BlockTime().Unix()
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledDepositQueueKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	dueIds := make([]uint64, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(dueIds)) >= max {
+			break
+		}
+		timestamp, id := types.ParseScheduledDepositQueueKey(iterator.Key())
+		if timestamp > currentTimestamp {
+			break
+		}
+		dueIds = append(dueIds, id)
+	}
+	iterator.Close()
+
+	for _, id := range dueIds {
+		scheduledDeposit, found := k.GetScheduledDeposit(ctx, id)
+		if !found { // should not happen
+			ctx.Logger().Error("auto scheduled deposit, scheduled deposit not found", "id", id)
+			continue
+		}
+		k.executeScheduledDeposit(ctx, scheduledDeposit)
+	}
+}
+
+func (k Keeper) executeScheduledDeposit(ctx sdk.Context, scheduledDeposit *types.ScheduledDeposit) {
+	owner := sdk.MustAccAddressFromHex(scheduledDeposit.Owner)
+	to := sdk.MustAccAddressFromHex(scheduledDeposit.To)
+
+	// the deposit is executed in a cached context, so that a failed deposit will not leave any partial state
+	cacheCtx, write := ctx.CacheContext()
+	err := k.deposit(cacheCtx, owner, to, scheduledDeposit.Amount)
+	if err == nil {
+		write()
+	} else {
+		ctx.Logger().Info("auto scheduled deposit failed", "id", scheduledDeposit.Id, "err", err.Error())
+	}
+
+	event := &types.EventScheduledDepositExecuted{
+		Id:      scheduledDeposit.Id,
+		Owner:   scheduledDeposit.Owner,
+		To:      scheduledDeposit.To,
+		Amount:  scheduledDeposit.Amount,
+		Success: err == nil,
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+
+	if err == nil {
+		scheduledDeposit.Executions++
+	} else {
+		scheduledDeposit.FailedExecutions++
+	}
+	if scheduledDeposit.MaxExecutions > 0 && scheduledDeposit.Executions >= scheduledDeposit.MaxExecutions {
+		k.RemoveScheduledDeposit(ctx, scheduledDeposit)
+		event.Finished = true
+	} else {
+		updated := *scheduledDeposit
+		updated.NextExecutionTime = nextScheduledDepositTime(scheduledDeposit, ctx.BlockTime().Unix())
+		k.SetScheduledDeposit(ctx, &updated)
+	}
+
+	_ = ctx.EventManager().EmitTypedEvents(event)
+}
+
+// nextScheduledDepositTime returns the first execution time after now, so that the missed executions
+// (e.g. the chain halts for a long time) will not be caught up in a row.
+func nextScheduledDepositTime(scheduledDeposit *types.ScheduledDeposit, now int64) int64 {
+	interval := int64(scheduledDeposit.Interval)
+	next := scheduledDeposit.NextExecutionTime + interval
+	if next <= now {
+		next += ((now-next)/interval + 1) * interval
+	}
+	return next
+}
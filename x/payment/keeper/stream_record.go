@@ -66,6 +66,7 @@ func (k Keeper) SetStreamRecord(ctx sdk.Context, streamRecord *types.StreamRecor
 		SettleTimestamp:   streamRecord.SettleTimestamp,
 	}
 	_ = ctx.EventManager().EmitTypedEvents(event)
+	k.AppendStreamRecordHistory(ctx, streamRecord)
 }
 
 // GetStreamRecord returns a streamRecord from its index
@@ -243,7 +244,7 @@ func (k Keeper) UpdateStreamRecordByAddr(ctx sdk.Context, change *types.StreamRe
 func (k Keeper) ForceSettle(ctx sdk.Context, streamRecord *types.StreamRecord) error {
 	totalBalance := streamRecord.StaticBalance.Add(streamRecord.BufferBalance)
 	change := types.NewDefaultStreamRecordChangeWithAddr(types.GovernanceAddress).WithStaticBalanceChange(totalBalance)
-	_, err := k.UpdateStreamRecordByAddr(ctx, change)
+	_, err := k.UpdateStreamRecordByAddr(types.WithStreamRecordChangeReason(ctx, types.STREAM_RECORD_CHANGE_REASON_FORCE_SETTLE, streamRecord.Account), change)
 	if err != nil {
 		telemetry.IncrCounter(1, types.GovernanceAddressLackBalanceLabel)
 		return fmt.Errorf("update governance stream record failed: %w", err)
@@ -261,6 +262,7 @@ func (k Keeper) ForceSettle(ctx sdk.Context, streamRecord *types.StreamRecord) e
 }
 
 func (k Keeper) AutoSettle(ctx sdk.Context) {
+	ctx = types.WithStreamRecordChangeReason(ctx, types.STREAM_RECORD_CHANGE_REASON_SETTLE, "")
 	currentTimestamp := ctx.BlockTime().Unix()
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoSettleRecordKeyPrefix)
 	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
@@ -456,6 +458,7 @@ func (k Keeper) TryResumeStreamRecord(ctx sdk.Context, streamRecord *types.Strea
 }
 
 func (k Keeper) AutoResume(ctx sdk.Context) {
+	ctx = types.WithStreamRecordChangeReason(ctx, types.STREAM_RECORD_CHANGE_REASON_RESUME, "")
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoResumeRecordKeyPrefix)
 	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
 	defer iterator.Close()
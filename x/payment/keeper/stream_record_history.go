@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+// getStreamRecordHistoryRange returns the sequence range [first, next) of the history entries of a stream record
+func (k Keeper) getStreamRecordHistoryRange(ctx sdk.Context, account sdk.AccAddress) (first, next uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StreamRecordHistoryMetaKeyPrefix)
+	bz := store.Get(types.StreamRecordHistoryMetaKey(account))
+	if bz == nil {
+		return 0, 0
+	}
+	return binary.BigEndian.Uint64(bz[0:8]), binary.BigEndian.Uint64(bz[8:16])
+}
+
+func (k Keeper) setStreamRecordHistoryRange(ctx sdk.Context, account sdk.AccAddress, first, next uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StreamRecordHistoryMetaKeyPrefix)
+	bz := append(sdk.Uint64ToBigEndian(first), sdk.Uint64ToBigEndian(next)...)
+	store.Set(types.StreamRecordHistoryMetaKey(account), bz)
+}
+
+// AppendStreamRecordHistory records the resulting balances of a stream record change with the reason carried by the context,
+// the oldest entries will be pruned when the number of entries exceeds MaxStreamRecordHistoryCount.
+func (k Keeper) AppendStreamRecordHistory(ctx sdk.Context, streamRecord *types.StreamRecord) {
+	maxCount := k.GetParams(ctx).MaxStreamRecordHistoryCount
+	if maxCount == 0 {
+		return
+	}
+
+	account := sdk.MustAccAddressFromHex(streamRecord.Account)
+	changeCtx := types.GetStreamRecordChangeReason(ctx)
+	first, next := k.getStreamRecordHistoryRange(ctx, account)
+
+	entry := &types.StreamRecordHistoryEntry{
+		Sequence:          next,
+		Height:            ctx.BlockHeight(),
+		Timestamp:         ctx.BlockTime().Unix(),
+		Reason:            changeCtx.Reason,
+		Reference:         changeCtx.Reference,
+		StaticBalance:     streamRecord.StaticBalance,
+		BufferBalance:     streamRecord.BufferBalance,
+		LockBalance:       streamRecord.LockBalance,
+		NetflowRate:       streamRecord.NetflowRate,
+		FrozenNetflowRate: streamRecord.FrozenNetflowRate,
+		Status:            streamRecord.Status,
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StreamRecordHistoryKeyPrefix)
+	store.Set(types.StreamRecordHistoryKey(account, next), k.cdc.MustMarshal(entry))
+	next++
+
+	for ; next-first > maxCount; first++ {
+		store.Delete(types.StreamRecordHistoryKey(account, first))
+	}
+	k.setStreamRecordHistoryRange(ctx, account, first, next)
+}
+
+// GetStreamRecordHistory returns all the history entries of a stream record in the order of the sequence
+func (k Keeper) GetStreamRecordHistory(ctx sdk.Context, account sdk.AccAddress) (list []types.StreamRecordHistoryEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StreamRecordHistoryKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, account.Bytes())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.StreamRecordHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
@@ -25,7 +25,10 @@ func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.Binar
 		oldParams.MaxAutoResumeFlowCount,
 		oldParams.FeeDenom,
 		types.DefaultWithdrawTimeLockThreshold,
-		types.DefaultWithdrawTimeLockDuration)
+		types.DefaultWithdrawTimeLockDuration,
+		types.DefaultMaxAutoScheduledDepositCount,
+		types.DefaultMaxStreamRecordHistoryCount,
+		types.DefaultScheduledDepositCountLimit)
 
 	store.Set(types.ParamsKey, cdc.MustMarshal(&newParams))
 
@@ -154,6 +154,9 @@ func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock contains the logic that is automatically triggered at the end of each block
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	// scheduled deposits are executed as normal deposits, so they are processed before forcing the update
+	am.keeper.AutoScheduledDeposit(ctx)
+	am.keeper.UnlockDelayedWithdrawals(ctx)
 	// set ForceUpdateStreamRecordKey to true in context to force update frozen stream record
 	ctx = ctx.WithValue(types.ForceUpdateStreamRecordKey, true)
 	am.keeper.AutoResume(ctx)
@@ -12,6 +12,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDeposit{}, "payment/Deposit", nil)
 	cdc.RegisterConcrete(&MsgWithdraw{}, "payment/Withdraw", nil)
 	cdc.RegisterConcrete(&MsgDisableRefund{}, "payment/DisableRefund", nil)
+	cdc.RegisterConcrete(&MsgCreateScheduledDeposit{}, "payment/CreateScheduledDeposit", nil)
+	cdc.RegisterConcrete(&MsgCancelScheduledDeposit{}, "payment/CancelScheduledDeposit", nil)
+	cdc.RegisterConcrete(&MsgCancelDelayedWithdrawal{}, "payment/CancelDelayedWithdrawal", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -30,6 +33,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateScheduledDeposit{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelScheduledDeposit{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelDelayedWithdrawal{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
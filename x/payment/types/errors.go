@@ -20,4 +20,7 @@ var (
 	ErrIncorrectWithdrawAmount            = errorsmod.Register(ModuleName, 1211, "the withdrawal amount is not equal to the delayed one")
 	ErrNotReachTimeLockDuration           = errorsmod.Register(ModuleName, 1212, "the withdrawal does not reach to the delayed duration")
 	ErrExistsDelayedWithdrawal            = errorsmod.Register(ModuleName, 1213, "delayed withdrawal already exists")
+	ErrScheduledDepositNotFound           = errorsmod.Register(ModuleName, 1214, "scheduled deposit not found")
+	ErrNotScheduledDepositOwner           = errorsmod.Register(ModuleName, 1215, "not scheduled deposit owner")
+	ErrReachScheduledDepositLimit         = errorsmod.Register(ModuleName, 1216, "reach scheduled deposit count limit")
 )
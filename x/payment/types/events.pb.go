@@ -361,6 +361,370 @@ func (m *EventWithdraw) GetFrom() string {
 	return ""
 }
 
+// EventCreateScheduledDeposit is emitted when a scheduled deposit is created
+type EventCreateScheduledDeposit struct {
+	// id is the unique identifier of the scheduled deposit
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the account to deposit from
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// to is the address of the stream account to deposit to
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// amount is the amount to deposit in each execution
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// interval is the duration in seconds between two executions
+	Interval uint64 `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	// next_execution_time is the unix timestamp of the first execution
+	NextExecutionTime int64 `protobuf:"varint,6,opt,name=next_execution_time,json=nextExecutionTime,proto3" json:"next_execution_time,omitempty"`
+	// max_executions is the total number of executions, 0 means no limit
+	MaxExecutions uint64 `protobuf:"varint,7,opt,name=max_executions,json=maxExecutions,proto3" json:"max_executions,omitempty"`
+}
+
+func (m *EventCreateScheduledDeposit) Reset()         { *m = EventCreateScheduledDeposit{} }
+func (m *EventCreateScheduledDeposit) String() string { return proto.CompactTextString(m) }
+func (*EventCreateScheduledDeposit) ProtoMessage()    {}
+func (*EventCreateScheduledDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_befcc80e27bc8df9, []int{5}
+}
+func (m *EventCreateScheduledDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreateScheduledDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreateScheduledDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCreateScheduledDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreateScheduledDeposit.Merge(m, src)
+}
+func (m *EventCreateScheduledDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreateScheduledDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreateScheduledDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreateScheduledDeposit proto.InternalMessageInfo
+
+func (m *EventCreateScheduledDeposit) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventCreateScheduledDeposit) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventCreateScheduledDeposit) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *EventCreateScheduledDeposit) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *EventCreateScheduledDeposit) GetNextExecutionTime() int64 {
+	if m != nil {
+		return m.NextExecutionTime
+	}
+	return 0
+}
+
+func (m *EventCreateScheduledDeposit) GetMaxExecutions() uint64 {
+	if m != nil {
+		return m.MaxExecutions
+	}
+	return 0
+}
+
+// EventCancelScheduledDeposit is emitted when a scheduled deposit is cancelled by its owner
+type EventCancelScheduledDeposit struct {
+	// id is the unique identifier of the scheduled deposit
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the account to deposit from
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *EventCancelScheduledDeposit) Reset()         { *m = EventCancelScheduledDeposit{} }
+func (m *EventCancelScheduledDeposit) String() string { return proto.CompactTextString(m) }
+func (*EventCancelScheduledDeposit) ProtoMessage()    {}
+func (*EventCancelScheduledDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_befcc80e27bc8df9, []int{6}
+}
+func (m *EventCancelScheduledDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelScheduledDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelScheduledDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCancelScheduledDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelScheduledDeposit.Merge(m, src)
+}
+func (m *EventCancelScheduledDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelScheduledDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelScheduledDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelScheduledDeposit proto.InternalMessageInfo
+
+func (m *EventCancelScheduledDeposit) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventCancelScheduledDeposit) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// EventScheduledDepositExecuted is emitted in EndBlocker when a due scheduled deposit is processed
+type EventScheduledDepositExecuted struct {
+	// id is the unique identifier of the scheduled deposit
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the account to deposit from
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// to is the address of the stream account to deposit to
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// amount is the amount to deposit
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// success indicates whether the deposit is executed successfully
+	Success bool `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// error is the reason of the failure
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// finished indicates whether the scheduled deposit is completed and removed
+	Finished bool `protobuf:"varint,7,opt,name=finished,proto3" json:"finished,omitempty"`
+}
+
+func (m *EventScheduledDepositExecuted) Reset()         { *m = EventScheduledDepositExecuted{} }
+func (m *EventScheduledDepositExecuted) String() string { return proto.CompactTextString(m) }
+func (*EventScheduledDepositExecuted) ProtoMessage()    {}
+func (*EventScheduledDepositExecuted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_befcc80e27bc8df9, []int{7}
+}
+func (m *EventScheduledDepositExecuted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScheduledDepositExecuted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScheduledDepositExecuted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScheduledDepositExecuted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScheduledDepositExecuted.Merge(m, src)
+}
+func (m *EventScheduledDepositExecuted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScheduledDepositExecuted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScheduledDepositExecuted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScheduledDepositExecuted proto.InternalMessageInfo
+
+func (m *EventScheduledDepositExecuted) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventScheduledDepositExecuted) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventScheduledDepositExecuted) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *EventScheduledDepositExecuted) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *EventScheduledDepositExecuted) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *EventScheduledDepositExecuted) GetFinished() bool {
+	if m != nil {
+		return m.Finished
+	}
+	return false
+}
+
+// EventCancelDelayedWithdrawal is emitted when a delayed withdrawal is cancelled by the receiver
+type EventCancelDelayedWithdrawal struct {
+	// addr is the address of the withdrawal receiver
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// from is the address of the stream account the amount is returned to
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// amount is the amount of the cancelled withdrawal
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *EventCancelDelayedWithdrawal) Reset()         { *m = EventCancelDelayedWithdrawal{} }
+func (m *EventCancelDelayedWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*EventCancelDelayedWithdrawal) ProtoMessage()    {}
+func (*EventCancelDelayedWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_befcc80e27bc8df9, []int{8}
+}
+func (m *EventCancelDelayedWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelDelayedWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelDelayedWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCancelDelayedWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelDelayedWithdrawal.Merge(m, src)
+}
+func (m *EventCancelDelayedWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelDelayedWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelDelayedWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelDelayedWithdrawal proto.InternalMessageInfo
+
+func (m *EventCancelDelayedWithdrawal) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *EventCancelDelayedWithdrawal) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+// EventDelayedWithdrawalUnlocked is emitted in EndBlocker when the time lock of a delayed withdrawal expires
+type EventDelayedWithdrawalUnlocked struct {
+	// addr is the address of the withdrawal receiver
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// from is the address of the stream account to withdraw from
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// amount is the amount of the withdrawal
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// unlock_timestamp is the unix timestamp the withdrawal is unlocked
+	UnlockTimestamp int64 `protobuf:"varint,4,opt,name=unlock_timestamp,json=unlockTimestamp,proto3" json:"unlock_timestamp,omitempty"`
+}
+
+func (m *EventDelayedWithdrawalUnlocked) Reset()         { *m = EventDelayedWithdrawalUnlocked{} }
+func (m *EventDelayedWithdrawalUnlocked) String() string { return proto.CompactTextString(m) }
+func (*EventDelayedWithdrawalUnlocked) ProtoMessage()    {}
+func (*EventDelayedWithdrawalUnlocked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_befcc80e27bc8df9, []int{9}
+}
+func (m *EventDelayedWithdrawalUnlocked) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDelayedWithdrawalUnlocked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDelayedWithdrawalUnlocked.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDelayedWithdrawalUnlocked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDelayedWithdrawalUnlocked.Merge(m, src)
+}
+func (m *EventDelayedWithdrawalUnlocked) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDelayedWithdrawalUnlocked) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDelayedWithdrawalUnlocked.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDelayedWithdrawalUnlocked proto.InternalMessageInfo
+
+func (m *EventDelayedWithdrawalUnlocked) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *EventDelayedWithdrawalUnlocked) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *EventDelayedWithdrawalUnlocked) GetUnlockTimestamp() int64 {
+	if m != nil {
+		return m.UnlockTimestamp
+	}
+	return 0
+}
+
 // emit when upload/cancel/delete object, used for frontend to preview the fee changed
 // only emit in tx simulation
 type EventFeePreview struct {
@@ -373,7 +737,7 @@ func (m *EventFeePreview) Reset()         { *m = EventFeePreview{} }
 func (m *EventFeePreview) String() string { return proto.CompactTextString(m) }
 func (*EventFeePreview) ProtoMessage()    {}
 func (*EventFeePreview) Descriptor() ([]byte, []int) {
-	return fileDescriptor_befcc80e27bc8df9, []int{5}
+	return fileDescriptor_befcc80e27bc8df9, []int{10}
 }
 func (m *EventFeePreview) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -423,59 +787,78 @@ func init() {
 	proto.RegisterType((*EventForceSettle)(nil), "greenfield.payment.EventForceSettle")
 	proto.RegisterType((*EventDeposit)(nil), "greenfield.payment.EventDeposit")
 	proto.RegisterType((*EventWithdraw)(nil), "greenfield.payment.EventWithdraw")
+	proto.RegisterType((*EventCreateScheduledDeposit)(nil), "greenfield.payment.EventCreateScheduledDeposit")
+	proto.RegisterType((*EventCancelScheduledDeposit)(nil), "greenfield.payment.EventCancelScheduledDeposit")
+	proto.RegisterType((*EventScheduledDepositExecuted)(nil), "greenfield.payment.EventScheduledDepositExecuted")
+	proto.RegisterType((*EventCancelDelayedWithdrawal)(nil), "greenfield.payment.EventCancelDelayedWithdrawal")
+	proto.RegisterType((*EventDelayedWithdrawalUnlocked)(nil), "greenfield.payment.EventDelayedWithdrawalUnlocked")
 	proto.RegisterType((*EventFeePreview)(nil), "greenfield.payment.EventFeePreview")
 }
 
 func init() { proto.RegisterFile("greenfield/payment/events.proto", fileDescriptor_befcc80e27bc8df9) }
 
 var fileDescriptor_befcc80e27bc8df9 = []byte{
-	// 724 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xc1, 0x4e, 0xdb, 0x4a,
-	0x14, 0x86, 0xe3, 0x00, 0x01, 0xe6, 0x42, 0xc8, 0xf5, 0x45, 0xba, 0x01, 0xe9, 0x1a, 0x88, 0x74,
-	0x69, 0x5a, 0x35, 0x89, 0x44, 0xb7, 0x95, 0x2a, 0x52, 0x8c, 0x14, 0x15, 0xd1, 0xc8, 0x09, 0x45,
-	0xad, 0x54, 0x59, 0x13, 0xfb, 0x38, 0x58, 0xc4, 0x33, 0xd6, 0x78, 0x4c, 0x4a, 0x9f, 0xa0, 0xcb,
-	0x6e, 0xbb, 0xee, 0xa2, 0x2f, 0xc0, 0xb2, 0xdd, 0xb3, 0x44, 0xac, 0x2a, 0x16, 0xa8, 0x82, 0x55,
-	0xdf, 0xa2, 0xf2, 0xcc, 0x24, 0x04, 0x81, 0x14, 0x5a, 0x85, 0x55, 0x32, 0x27, 0x7f, 0xfe, 0xff,
-	0x3b, 0xe3, 0x33, 0x1e, 0xb4, 0xd4, 0x66, 0x00, 0xc4, 0xf3, 0xa1, 0xe3, 0x56, 0x42, 0x7c, 0x18,
-	0x00, 0xe1, 0x15, 0x38, 0x00, 0xc2, 0xa3, 0x72, 0xc8, 0x28, 0xa7, 0xba, 0x7e, 0x25, 0x28, 0x2b,
-	0xc1, 0xe2, 0x82, 0x43, 0xa3, 0x80, 0x46, 0xb6, 0x50, 0x54, 0xe4, 0x42, 0xca, 0x17, 0xe7, 0xdb,
-	0xb4, 0x4d, 0x65, 0x3d, 0xf9, 0xa6, 0xaa, 0x2b, 0xb7, 0xa4, 0xd0, 0x98, 0xdb, 0x5e, 0x87, 0x76,
-	0x95, 0x64, 0xf5, 0x16, 0x49, 0xc4, 0x19, 0xe0, 0xc0, 0x66, 0xe0, 0x50, 0xe6, 0x4a, 0x5d, 0xe1,
-	0x93, 0x86, 0x16, 0xcc, 0x04, 0xb0, 0x2e, 0x45, 0xeb, 0x8e, 0x43, 0x63, 0xc2, 0x77, 0x42, 0x17,
-	0x73, 0xd0, 0x1f, 0xa3, 0x71, 0xec, 0xba, 0x2c, 0xaf, 0x2d, 0x6b, 0xc5, 0xe9, 0x6a, 0xfe, 0xf4,
-	0xa8, 0x34, 0xaf, 0xf0, 0xd6, 0x5d, 0x97, 0x41, 0x14, 0x35, 0x38, 0xf3, 0x49, 0xdb, 0x12, 0x2a,
-	0xbd, 0x8c, 0x26, 0x68, 0x97, 0x00, 0xcb, 0xa7, 0x87, 0xc8, 0xa5, 0x4c, 0x37, 0x10, 0x62, 0xe0,
-	0xc5, 0xc4, 0xc5, 0xad, 0x0e, 0xe4, 0xc7, 0x96, 0xb5, 0xe2, 0x94, 0x35, 0x50, 0x29, 0x9c, 0x4d,
-	0xa0, 0x7f, 0x05, 0x5b, 0x43, 0x80, 0x5b, 0x82, 0x5b, 0x91, 0xad, 0xa1, 0x49, 0x2c, 0x51, 0x87,
-	0xc2, 0xf5, 0x84, 0xfa, 0xff, 0x28, 0xeb, 0xb0, 0xd8, 0xb5, 0xb9, 0x1f, 0x40, 0xc4, 0x71, 0x10,
-	0x0a, 0xd0, 0x31, 0x6b, 0x36, 0xa9, 0x36, 0x7b, 0x45, 0xdd, 0x46, 0x33, 0x04, 0x78, 0xb2, 0x97,
-	0x36, 0xc3, 0x5c, 0x82, 0x4d, 0x57, 0x9f, 0x1e, 0x9f, 0x2f, 0xa5, 0xce, 0xce, 0x97, 0x56, 0xdb,
-	0x3e, 0xdf, 0x8b, 0x5b, 0x65, 0x87, 0x06, 0xea, 0x51, 0xa9, 0x8f, 0x52, 0xe4, 0xee, 0x57, 0xf8,
-	0x61, 0x08, 0x51, 0xb9, 0x46, 0xf8, 0xe9, 0x51, 0x09, 0x29, 0x9a, 0x1a, 0xe1, 0xd6, 0x5f, 0xca,
-	0xd1, 0x4a, 0xd8, 0x3b, 0xe8, 0x1f, 0x8f, 0xd1, 0xf7, 0x40, 0xec, 0x6b, 0x39, 0xe3, 0x23, 0xc8,
-	0xf9, 0x5b, 0x1a, 0x6f, 0x0f, 0xa4, 0x39, 0x28, 0x1b, 0x71, 0xcc, 0x7d, 0xc7, 0x6e, 0xe1, 0x0e,
-	0x26, 0x0e, 0xe4, 0x27, 0x46, 0x10, 0x34, 0x2b, 0x3d, 0xab, 0xd2, 0x32, 0x09, 0x69, 0xc5, 0x9e,
-	0x07, 0xac, 0x1f, 0x92, 0x19, 0x45, 0x88, 0xf4, 0xec, 0x85, 0xd8, 0x68, 0xa6, 0x43, 0x9d, 0xfd,
-	0x7e, 0xc4, 0xe4, 0x28, 0x1e, 0x4c, 0xe2, 0xd8, 0x0b, 0x78, 0x86, 0x32, 0x49, 0x5b, 0x71, 0x94,
-	0x9f, 0x5a, 0xd6, 0x8a, 0xd9, 0xb5, 0x07, 0xe5, 0x9b, 0xa7, 0xb5, 0x2c, 0x87, 0x51, 0x9d, 0x93,
-	0x86, 0x90, 0x5b, 0xea, 0x6f, 0xfa, 0x43, 0x94, 0x8b, 0x80, 0xf3, 0x0e, 0x0c, 0xcc, 0xd8, 0xb4,
-	0x98, 0xb1, 0x39, 0x59, 0xef, 0x4f, 0x59, 0xe1, 0x8b, 0x86, 0x72, 0x62, 0xb8, 0x37, 0x29, 0x73,
-	0xa0, 0x21, 0x7e, 0xfd, 0xcd, 0xf3, 0x06, 0x48, 0xb9, 0xba, 0xfd, 0x2d, 0x49, 0x8f, 0x60, 0x4b,
-	0xb2, 0xca, 0x54, 0xed, 0x4a, 0xe1, 0xab, 0x86, 0x66, 0x04, 0xe9, 0x06, 0x84, 0x34, 0xf2, 0x79,
-	0x42, 0xe9, 0x31, 0x1a, 0x0c, 0xa7, 0x4c, 0x54, 0x7a, 0x11, 0xa5, 0x39, 0x1d, 0xfa, 0x4a, 0x48,
-	0x73, 0xaa, 0x37, 0x51, 0x06, 0x07, 0xe2, 0x48, 0x8f, 0xe2, 0xc8, 0x29, 0xaf, 0xc2, 0x37, 0x0d,
-	0xcd, 0x0a, 0xfc, 0x5d, 0x9f, 0xef, 0xb9, 0x0c, 0x77, 0x15, 0x91, 0x76, 0x07, 0xa2, 0x5e, 0xa7,
-	0xe9, 0x3b, 0x75, 0x7a, 0x3f, 0xfc, 0x3f, 0x35, 0x34, 0x27, 0x07, 0x05, 0xa0, 0xce, 0xe0, 0xc0,
-	0x87, 0xee, 0x1f, 0xbd, 0xfd, 0xb6, 0x50, 0xce, 0x03, 0xb0, 0x43, 0x69, 0x61, 0x27, 0xb1, 0xa2,
-	0xaf, 0xec, 0x5a, 0xe1, 0xb6, 0x31, 0xbf, 0x4a, 0x6b, 0x1e, 0x86, 0x60, 0x65, 0xbd, 0x6b, 0xeb,
-	0xfb, 0xe9, 0xf5, 0xd1, 0x5b, 0x94, 0xbd, 0x9e, 0xab, 0x17, 0x90, 0xb1, 0x69, 0x9a, 0x76, 0xdd,
-	0x32, 0x5f, 0xd5, 0xcc, 0x5d, 0xbb, 0xf9, 0xba, 0x2e, 0x16, 0x5b, 0x2f, 0x9f, 0xbf, 0x30, 0x37,
-	0xec, 0x4d, 0xd3, 0xcc, 0xa5, 0xf4, 0x15, 0xf4, 0xdf, 0x0d, 0xcd, 0xce, 0xf6, 0x80, 0x44, 0x5b,
-	0x1c, 0xff, 0xf0, 0xd9, 0x48, 0x55, 0x6b, 0xc7, 0x17, 0x86, 0x76, 0x72, 0x61, 0x68, 0x3f, 0x2e,
-	0x0c, 0xed, 0xe3, 0xa5, 0x91, 0x3a, 0xb9, 0x34, 0x52, 0xdf, 0x2f, 0x8d, 0xd4, 0x9b, 0xca, 0x00,
-	0x76, 0x8b, 0xb4, 0x4a, 0xce, 0x1e, 0xf6, 0x49, 0x65, 0xe0, 0x0e, 0x7d, 0xd7, 0xbf, 0x45, 0x45,
-	0x0f, 0xad, 0x8c, 0xb8, 0x3e, 0x9f, 0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x68, 0xb8, 0x45, 0x52,
-	0xf1, 0x07, 0x00, 0x00,
+	// 948 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x41, 0x4f, 0x1b, 0x47,
+	0x14, 0xf6, 0x1a, 0x03, 0xe6, 0x15, 0x1c, 0xb2, 0x41, 0xaa, 0x43, 0x9b, 0x85, 0xac, 0xd4, 0x94,
+	0x56, 0xc5, 0x96, 0xe8, 0xb5, 0x52, 0x15, 0xc2, 0x22, 0xa1, 0x46, 0x29, 0x5a, 0x43, 0xa3, 0x56,
+	0x8a, 0x56, 0xe3, 0x9d, 0xb7, 0xf6, 0x2a, 0xbb, 0x33, 0xd6, 0xcc, 0x2c, 0x98, 0xfe, 0x82, 0x1e,
+	0x7b, 0xed, 0xb9, 0x87, 0x1e, 0x7a, 0xcd, 0xb1, 0xbd, 0xe7, 0x88, 0x72, 0xaa, 0x72, 0x88, 0x2a,
+	0x38, 0xf5, 0x47, 0x54, 0xaa, 0x76, 0x66, 0xd7, 0x98, 0x04, 0x89, 0x50, 0x39, 0x52, 0x38, 0xc1,
+	0xbc, 0xfd, 0xfc, 0xbe, 0xef, 0xbd, 0x37, 0xef, 0xbd, 0x81, 0x95, 0x9e, 0x40, 0x64, 0x51, 0x8c,
+	0x09, 0x6d, 0x0f, 0xc8, 0x51, 0x8a, 0x4c, 0xb5, 0xf1, 0x00, 0x99, 0x92, 0xad, 0x81, 0xe0, 0x8a,
+	0xdb, 0xf6, 0x19, 0xa0, 0x55, 0x00, 0x96, 0x6f, 0x87, 0x5c, 0xa6, 0x5c, 0x06, 0x1a, 0xd1, 0x36,
+	0x07, 0x03, 0x5f, 0x5e, 0xea, 0xf1, 0x1e, 0x37, 0xf6, 0xfc, 0xbf, 0xc2, 0x7a, 0xf7, 0x02, 0x16,
+	0x9e, 0xa9, 0x20, 0x4a, 0xf8, 0x61, 0x01, 0xb9, 0x77, 0x01, 0x44, 0x2a, 0x81, 0x24, 0x0d, 0x04,
+	0x86, 0x5c, 0x50, 0x83, 0x73, 0x7f, 0xb1, 0xe0, 0xb6, 0x97, 0x0b, 0xdc, 0x35, 0xa0, 0xfb, 0x61,
+	0xc8, 0x33, 0xa6, 0xf6, 0x07, 0x94, 0x28, 0xb4, 0xbf, 0x80, 0x1a, 0xa1, 0x54, 0x34, 0xad, 0x55,
+	0x6b, 0x6d, 0x6e, 0xb3, 0xf9, 0xe2, 0xd9, 0xfa, 0x52, 0x21, 0xef, 0x3e, 0xa5, 0x02, 0xa5, 0xec,
+	0x28, 0x11, 0xb3, 0x9e, 0xaf, 0x51, 0x76, 0x0b, 0xa6, 0xf9, 0x21, 0x43, 0xd1, 0xac, 0x5e, 0x02,
+	0x37, 0x30, 0xdb, 0x01, 0x10, 0x18, 0x65, 0x8c, 0x92, 0x6e, 0x82, 0xcd, 0xa9, 0x55, 0x6b, 0xad,
+	0xee, 0x8f, 0x59, 0xdc, 0x97, 0xd3, 0xf0, 0xa1, 0xd6, 0xd6, 0xd1, 0xc2, 0x7d, 0xad, 0xbb, 0x50,
+	0xb6, 0x01, 0xb3, 0xc4, 0x48, 0xbd, 0x54, 0x5c, 0x09, 0xb4, 0x3f, 0x81, 0x46, 0x28, 0x32, 0x1a,
+	0xa8, 0x38, 0x45, 0xa9, 0x48, 0x3a, 0xd0, 0x42, 0xa7, 0xfc, 0x85, 0xdc, 0xba, 0x57, 0x1a, 0xed,
+	0x00, 0xe6, 0x19, 0xaa, 0x3c, 0x97, 0x81, 0x20, 0xca, 0x08, 0x9b, 0xdb, 0xfc, 0xea, 0xf9, 0xab,
+	0x95, 0xca, 0xcb, 0x57, 0x2b, 0xf7, 0x7a, 0xb1, 0xea, 0x67, 0xdd, 0x56, 0xc8, 0xd3, 0xa2, 0x54,
+	0xc5, 0x9f, 0x75, 0x49, 0x9f, 0xb6, 0xd5, 0xd1, 0x00, 0x65, 0x6b, 0x87, 0xa9, 0x17, 0xcf, 0xd6,
+	0xa1, 0x50, 0xb3, 0xc3, 0x94, 0xff, 0x41, 0xe1, 0xd1, 0xcf, 0xb5, 0x27, 0x70, 0x2b, 0x12, 0xfc,
+	0x47, 0x64, 0xc1, 0x39, 0x9e, 0xda, 0x04, 0x78, 0x6e, 0x1a, 0xc7, 0x8f, 0xc6, 0xd8, 0x42, 0x68,
+	0x48, 0x45, 0x54, 0x1c, 0x06, 0x5d, 0x92, 0x10, 0x16, 0x62, 0x73, 0x7a, 0x02, 0x44, 0x0b, 0xc6,
+	0xe7, 0xa6, 0x71, 0x99, 0x93, 0x74, 0xb3, 0x28, 0x42, 0x31, 0x22, 0x99, 0x99, 0x04, 0x89, 0xf1,
+	0x59, 0x92, 0x04, 0x30, 0x9f, 0xf0, 0xf0, 0xe9, 0x88, 0x62, 0x76, 0x12, 0x85, 0xc9, 0x3d, 0x96,
+	0x04, 0x5f, 0xc3, 0x4c, 0x1e, 0x56, 0x26, 0x9b, 0xf5, 0x55, 0x6b, 0xad, 0xb1, 0xf1, 0x69, 0xeb,
+	0xcd, 0x6e, 0x6d, 0x99, 0xcb, 0x58, 0xf4, 0x49, 0x47, 0xc3, 0xfd, 0xe2, 0x67, 0xf6, 0x67, 0xb0,
+	0x28, 0x51, 0xa9, 0x04, 0xc7, 0xee, 0xd8, 0x9c, 0xbe, 0x63, 0x37, 0x8c, 0x7d, 0x74, 0xcb, 0xdc,
+	0xdf, 0x2c, 0x58, 0xd4, 0x97, 0x7b, 0x9b, 0x8b, 0x10, 0x3b, 0xfa, 0xeb, 0x15, 0xfb, 0x0d, 0xa1,
+	0xf0, 0x4a, 0x47, 0x29, 0xa9, 0x4e, 0x20, 0x25, 0x8d, 0xc2, 0x69, 0x91, 0x15, 0xf7, 0x0f, 0x0b,
+	0xe6, 0xb5, 0xd2, 0x2d, 0x1c, 0x70, 0x19, 0xab, 0x5c, 0x65, 0x24, 0x78, 0x7a, 0xb9, 0xca, 0x1c,
+	0x65, 0xaf, 0x41, 0x55, 0xf1, 0x4b, 0x47, 0x42, 0x55, 0x71, 0x7b, 0x0f, 0x66, 0x48, 0xaa, 0x5b,
+	0x7a, 0x12, 0x2d, 0x57, 0xf8, 0x72, 0xff, 0xb4, 0x60, 0x41, 0xcb, 0x7f, 0x1c, 0xab, 0x3e, 0x15,
+	0xe4, 0xb0, 0x50, 0x64, 0xbd, 0x85, 0xa2, 0x32, 0xd2, 0xea, 0x5b, 0x45, 0xfa, 0x6e, 0xf4, 0x1f,
+	0x57, 0xe1, 0x23, 0xad, 0xff, 0x81, 0x40, 0xa2, 0xb0, 0x13, 0xf6, 0x91, 0x66, 0x09, 0xd2, 0xb2,
+	0x1a, 0x0d, 0xa8, 0xc6, 0x54, 0x47, 0x53, 0xf3, 0xab, 0x31, 0xbd, 0xf2, 0x14, 0x36, 0xd9, 0x98,
+	0xba, 0x52, 0x7d, 0x6a, 0x93, 0x8b, 0xcf, 0x5e, 0x86, 0x7a, 0xcc, 0x14, 0x8a, 0x03, 0x92, 0xe8,
+	0xc9, 0x54, 0xf3, 0x47, 0x67, 0xbb, 0x05, 0xb7, 0x18, 0x0e, 0x55, 0x80, 0x43, 0x0c, 0x33, 0x15,
+	0x73, 0xa6, 0xfb, 0x4a, 0xcf, 0x96, 0x29, 0xff, 0x66, 0xfe, 0xc9, 0x2b, 0xbf, 0xe4, 0x9d, 0x95,
+	0x4f, 0xf8, 0x94, 0x0c, 0xcf, 0xe0, 0x52, 0xcf, 0x88, 0x9a, 0xbf, 0x90, 0x92, 0xe1, 0x08, 0x29,
+	0xdd, 0x27, 0x65, 0x46, 0xf3, 0xfb, 0x9d, 0x4c, 0x3a, 0xa3, 0xee, 0xef, 0x55, 0xb8, 0x63, 0xf6,
+	0xd6, 0x6b, 0x9e, 0x8d, 0x04, 0xa4, 0xd7, 0xbe, 0x66, 0x4d, 0x98, 0x95, 0x59, 0x18, 0xa2, 0x94,
+	0xba, 0x64, 0x75, 0xbf, 0x3c, 0xda, 0x4b, 0x30, 0x8d, 0x42, 0x70, 0x61, 0xe6, 0xbf, 0x6f, 0x0e,
+	0x79, 0x8d, 0xa3, 0x98, 0xc5, 0xb2, 0x8f, 0x54, 0x57, 0xa4, 0xee, 0x8f, 0xce, 0xee, 0xb1, 0x05,
+	0x1f, 0x8f, 0x55, 0x63, 0x0b, 0x13, 0x72, 0x84, 0xb4, 0x6c, 0x56, 0x92, 0x5c, 0x71, 0x28, 0xbe,
+	0x0f, 0x2d, 0xfb, 0xaf, 0x05, 0x4e, 0x31, 0x31, 0x5f, 0x0b, 0x66, 0x9f, 0xe5, 0xfb, 0x06, 0xe9,
+	0xf5, 0x0b, 0x2a, 0xdf, 0x6d, 0x99, 0x56, 0x3f, 0xb6, 0xdb, 0x6a, 0x66, 0xb7, 0x19, 0xfb, 0xd9,
+	0x6e, 0xfb, 0xc7, 0x82, 0x1b, 0x66, 0xb7, 0x21, 0xee, 0x0a, 0x3c, 0x88, 0xf1, 0xf0, 0x7f, 0x3d,
+	0xd8, 0x1e, 0xc2, 0x62, 0x84, 0x18, 0x0c, 0x8c, 0x8b, 0x20, 0x57, 0xa8, 0x53, 0xd0, 0xd8, 0x70,
+	0x2f, 0xda, 0xcc, 0x67, 0x6c, 0x7b, 0x47, 0x03, 0xf4, 0x1b, 0xd1, 0xb9, 0xf3, 0xbb, 0x49, 0xcb,
+	0xe7, 0x4f, 0xa0, 0x71, 0x9e, 0xd7, 0x76, 0xc1, 0xd9, 0xf6, 0xbc, 0x60, 0xd7, 0xf7, 0xbe, 0xdb,
+	0xf1, 0x1e, 0x07, 0x7b, 0xdf, 0xef, 0xea, 0xc3, 0xc3, 0x6f, 0x1f, 0x7c, 0xe3, 0x6d, 0x05, 0xdb,
+	0x9e, 0xb7, 0x58, 0xb1, 0xef, 0xc2, 0x9d, 0x37, 0x30, 0xfb, 0x8f, 0xc6, 0x20, 0xd6, 0x72, 0xed,
+	0xa7, 0x5f, 0x9d, 0xca, 0xe6, 0xce, 0xf3, 0x13, 0xc7, 0x3a, 0x3e, 0x71, 0xac, 0xbf, 0x4f, 0x1c,
+	0xeb, 0xe7, 0x53, 0xa7, 0x72, 0x7c, 0xea, 0x54, 0xfe, 0x3a, 0x75, 0x2a, 0x3f, 0xb4, 0xc7, 0x64,
+	0x77, 0x59, 0x77, 0x3d, 0xec, 0x93, 0x98, 0xb5, 0xc7, 0x9e, 0xfd, 0xc3, 0xd1, 0xc3, 0x5f, 0xc7,
+	0xd0, 0x9d, 0xd1, 0x2f, 0xfe, 0x2f, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x48, 0x27, 0x14, 0xeb,
+	0xa4, 0x0c, 0x00, 0x00,
 }
 
 func (m *EventPaymentAccountUpdate) Marshal() (dAtA []byte, err error) {
@@ -754,7 +1137,7 @@ func (m *EventWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventFeePreview) Marshal() (dAtA []byte, err error) {
+func (m *EventCreateScheduledDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -764,16 +1147,31 @@ func (m *EventFeePreview) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventFeePreview) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCreateScheduledDeposit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventFeePreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCreateScheduledDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.MaxExecutions != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MaxExecutions))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NextExecutionTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NextExecutionTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Interval != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.Amount.Size()
 		i -= size
@@ -783,29 +1181,294 @@ func (m *EventFeePreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if m.FeePreviewType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.FeePreviewType))
+	dAtA[i] = 0x22
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.To)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventCancelScheduledDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelScheduledDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelScheduledDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScheduledDepositExecuted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScheduledDepositExecuted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScheduledDepositExecuted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Finished {
+		i--
+		if m.Finished {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelDelayedWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelDelayedWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelDelayedWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDelayedWithdrawalUnlocked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDelayedWithdrawalUnlocked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDelayedWithdrawalUnlocked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnlockTimestamp != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.UnlockTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFeePreview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeePreview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeePreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.FeePreviewType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FeePreviewType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
 	dAtA[offset] = uint8(v)
 	return base
@@ -910,36 +1573,863 @@ func (m *EventWithdraw) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventCreateScheduledDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Interval != 0 {
+		n += 1 + sovEvents(uint64(m.Interval))
+	}
+	if m.NextExecutionTime != 0 {
+		n += 1 + sovEvents(uint64(m.NextExecutionTime))
+	}
+	if m.MaxExecutions != 0 {
+		n += 1 + sovEvents(uint64(m.MaxExecutions))
+	}
+	return n
+}
+
+func (m *EventCancelScheduledDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScheduledDepositExecuted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Finished {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventCancelDelayedWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventDelayedWithdrawalUnlocked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.UnlockTimestamp != 0 {
+		n += 1 + sovEvents(uint64(m.UnlockTimestamp))
+	}
+	return n
+}
+
+func (m *EventFeePreview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.FeePreviewType != 0 {
+		n += 1 + sovEvents(uint64(m.FeePreviewType))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventPaymentAccountUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPaymentAccountUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPaymentAccountUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refundable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Refundable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStreamRecordUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStreamRecordUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrudTimestamp", wireType)
+			}
+			m.CrudTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CrudTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetflowRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetflowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenNetflowRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FrozenNetflowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaticBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StaticBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BufferBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= StreamAccountStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettleTimestamp", wireType)
+			}
+			m.SettleTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettleTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventForceSettle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventForceSettle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventForceSettle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SettledBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventFeePreview) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.FeePreviewType != 0 {
-		n += 1 + sovEvents(uint64(m.FeePreviewType))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventPaymentAccountUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -962,15 +2452,15 @@ func (m *EventPaymentAccountUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPaymentAccountUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventWithdraw: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPaymentAccountUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -998,11 +2488,11 @@ func (m *EventPaymentAccountUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1030,13 +2520,13 @@ func (m *EventPaymentAccountUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Refundable", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1046,12 +2536,26 @@ func (m *EventPaymentAccountUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Refundable = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1073,7 +2577,7 @@ func (m *EventPaymentAccountUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventCreateScheduledDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1096,15 +2600,98 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventStreamRecordUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCreateScheduledDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventStreamRecordUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCreateScheduledDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1132,13 +2719,122 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecutionTime", wireType)
+			}
+			m.NextExecutionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextExecutionTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutions", wireType)
+			}
+			m.MaxExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelScheduledDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelScheduledDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelScheduledDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrudTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.CrudTimestamp = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1148,14 +2844,14 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CrudTimestamp |= int64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetflowRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1183,15 +2879,63 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetflowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrozenNetflowRate", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
 			}
-			var stringLen uint64
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScheduledDepositExecuted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScheduledDepositExecuted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScheduledDepositExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1201,29 +2945,14 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FrozenNetflowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StaticBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1251,13 +2980,11 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StaticBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BufferBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1285,13 +3012,11 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BufferBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1319,34 +3044,15 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LockBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= StreamAccountStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettleTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			m.SettleTimestamp = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1356,64 +3062,15 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SettleTimestamp |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventForceSettle) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventForceSettle: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventForceSettle: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Success = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1441,13 +3098,13 @@ func (m *EventForceSettle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettledBalance", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1457,26 +3114,12 @@ func (m *EventForceSettle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SettledBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Finished = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1498,7 +3141,7 @@ func (m *EventForceSettle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDeposit) Unmarshal(dAtA []byte) error {
+func (m *EventCancelDelayedWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1521,15 +3164,15 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCancelDelayedWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCancelDelayedWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1557,11 +3200,11 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1589,7 +3232,7 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -1646,7 +3289,7 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
+func (m *EventDelayedWithdrawalUnlocked) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1669,15 +3312,15 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDelayedWithdrawalUnlocked: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDelayedWithdrawalUnlocked: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1705,7 +3348,7 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1773,6 +3416,25 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTimestamp", wireType)
+			}
+			m.UnlockTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
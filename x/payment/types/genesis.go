@@ -16,6 +16,7 @@ func DefaultGenesis() *GenesisState {
 		PaymentAccountCountList: []PaymentAccountCount{},
 		PaymentAccountList:      []PaymentAccount{},
 		AutoSettleRecordList:    []AutoSettleRecord{},
+		ScheduledDepositList:    []ScheduledDeposit{},
 		Params:                  DefaultParams(),
 	}
 }
@@ -65,5 +66,18 @@ func (gs GenesisState) Validate() error {
 		autoSettleRecordIndexMap[index] = struct{}{}
 	}
 
+	// Check for duplicated id in scheduledDeposit
+	scheduledDepositIdMap := make(map[uint64]struct{})
+
+	for _, elem := range gs.ScheduledDepositList {
+		if _, ok := scheduledDepositIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for scheduledDeposit")
+		}
+		if elem.Id > gs.ScheduledDepositSequence {
+			return fmt.Errorf("scheduledDeposit id %d is greater than the sequence %d", elem.Id, gs.ScheduledDepositSequence)
+		}
+		scheduledDepositIdMap[elem.Id] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
@@ -30,6 +30,9 @@ type GenesisState struct {
 	PaymentAccountCountList []PaymentAccountCount `protobuf:"bytes,3,rep,name=payment_account_count_list,json=paymentAccountCountList,proto3" json:"payment_account_count_list"`
 	PaymentAccountList      []PaymentAccount      `protobuf:"bytes,4,rep,name=payment_account_list,json=paymentAccountList,proto3" json:"payment_account_list"`
 	AutoSettleRecordList    []AutoSettleRecord    `protobuf:"bytes,5,rep,name=auto_settle_record_list,json=autoSettleRecordList,proto3" json:"auto_settle_record_list"`
+	ScheduledDepositList    []ScheduledDeposit    `protobuf:"bytes,6,rep,name=scheduled_deposit_list,json=scheduledDepositList,proto3" json:"scheduled_deposit_list"`
+	// the last id generated for the scheduled deposits
+	ScheduledDepositSequence uint64 `protobuf:"varint,7,opt,name=scheduled_deposit_sequence,json=scheduledDepositSequence,proto3" json:"scheduled_deposit_sequence,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +103,20 @@ func (m *GenesisState) GetAutoSettleRecordList() []AutoSettleRecord {
 	return nil
 }
 
+func (m *GenesisState) GetScheduledDepositList() []ScheduledDeposit {
+	if m != nil {
+		return m.ScheduledDepositList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledDepositSequence() uint64 {
+	if m != nil {
+		return m.ScheduledDepositSequence
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "greenfield.payment.GenesisState")
 }
@@ -107,30 +124,34 @@ func init() {
 func init() { proto.RegisterFile("greenfield/payment/genesis.proto", fileDescriptor_88f7a8547128dee5) }
 
 var fileDescriptor_88f7a8547128dee5 = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xb1, 0x6b, 0xfa, 0x40,
-	0x14, 0xc7, 0x93, 0x9f, 0xfe, 0x1c, 0x62, 0x87, 0x72, 0x08, 0x4a, 0x86, 0x18, 0xa4, 0xb4, 0x42,
-	0x69, 0x02, 0x76, 0xe9, 0xaa, 0x1d, 0x4a, 0xa1, 0x43, 0xd1, 0x4e, 0x2e, 0xe1, 0x12, 0xaf, 0x31,
-	0xc5, 0xe4, 0x42, 0xee, 0x05, 0xea, 0x7f, 0xd1, 0x3f, 0xcb, 0x6e, 0x8e, 0x9d, 0x4a, 0xd1, 0x7f,
-	0xa4, 0xf8, 0x72, 0x6d, 0x13, 0x3d, 0x70, 0xc9, 0x85, 0xbb, 0xcf, 0x7d, 0x3f, 0xef, 0x1e, 0xcf,
-	0xb0, 0xc3, 0x8c, 0xb1, 0xe4, 0x39, 0x62, 0x8b, 0x99, 0x9b, 0xd2, 0x65, 0xcc, 0x12, 0x70, 0x43,
-	0x96, 0x30, 0x11, 0x09, 0x27, 0xcd, 0x38, 0x70, 0x42, 0xfe, 0x08, 0x47, 0x12, 0x66, 0x2b, 0xe4,
-	0x21, 0xc7, 0x63, 0x77, 0xf7, 0x57, 0x90, 0xe6, 0xa5, 0x22, 0x8b, 0xe6, 0xc0, 0x3d, 0xc1, 0x00,
-	0x16, 0xcc, 0xcb, 0x58, 0xc0, 0xb3, 0x99, 0x84, 0xbb, 0x0a, 0x38, 0xa5, 0x19, 0x8d, 0xa5, 0xd7,
-	0xec, 0x2b, 0x01, 0x5c, 0x3d, 0x1a, 0x04, 0x3c, 0x4f, 0x40, 0x92, 0xce, 0x71, 0xd2, 0x2b, 0xf3,
-	0xe7, 0x0a, 0x5e, 0x40, 0xc6, 0x68, 0x5c, 0x29, 0xb1, 0xf7, 0x5e, 0x33, 0x4e, 0xee, 0x8a, 0x5e,
-	0x4c, 0x80, 0x02, 0x23, 0x37, 0x46, 0xa3, 0x28, 0xb1, 0xa3, 0xdb, 0x7a, 0xbf, 0x39, 0x28, 0x9b,
-	0x7f, 0x7a, 0xe3, 0x3c, 0x22, 0x31, 0xaa, 0xaf, 0x3e, 0xbb, 0xda, 0x58, 0xf2, 0xe4, 0xc9, 0x20,
-	0x15, 0x83, 0xb7, 0x88, 0x04, 0x74, 0xfe, 0xd9, 0xb5, 0x7e, 0x73, 0x60, 0xab, 0x52, 0x26, 0x48,
-	0x8f, 0x11, 0x96, 0x59, 0xa7, 0xa2, 0xb4, 0xf7, 0x10, 0x09, 0x20, 0x2f, 0x86, 0xa9, 0x7c, 0x67,
-	0x91, 0x5e, 0xc3, 0xf4, 0x0b, 0x75, 0x8d, 0xb8, 0x0e, 0x8b, 0x4b, 0xb7, 0xbb, 0x8f, 0x94, 0xb4,
-	0xd3, 0xc3, 0x23, 0x74, 0x4d, 0x8d, 0xd6, 0xbe, 0x0b, 0x2d, 0x75, 0xb4, 0xf4, 0x8e, 0x5b, 0xa4,
-	0x80, 0x54, 0x05, 0x98, 0x4d, 0x8d, 0xf6, 0xe1, 0x9c, 0x14, 0xf1, 0xff, 0x31, 0xfe, 0x4c, 0x15,
-	0x3f, 0xcc, 0x81, 0x4f, 0xf0, 0x46, 0xa5, 0x4d, 0x2d, 0xba, 0xb7, 0xbf, 0x53, 0x8c, 0xee, 0x57,
-	0x1b, 0x4b, 0x5f, 0x6f, 0x2c, 0xfd, 0x6b, 0x63, 0xe9, 0x6f, 0x5b, 0x4b, 0x5b, 0x6f, 0x2d, 0xed,
-	0x63, 0x6b, 0x69, 0x53, 0x37, 0x8c, 0x60, 0x9e, 0xfb, 0x4e, 0xc0, 0x63, 0xd7, 0x4f, 0xfc, 0xab,
-	0x60, 0x4e, 0xa3, 0xc4, 0x2d, 0x8d, 0xc8, 0xeb, 0xef, 0x90, 0xc0, 0x32, 0x65, 0xc2, 0x6f, 0xe0,
-	0x74, 0x5c, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x05, 0x75, 0x66, 0xef, 0x3b, 0x03, 0x00, 0x00,
+	// 431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcf, 0x6e, 0x95, 0x40,
+	0x14, 0x87, 0xc1, 0xe2, 0x35, 0x99, 0xba, 0x30, 0xe4, 0xc6, 0x12, 0x16, 0x94, 0x34, 0x46, 0x89,
+	0x46, 0x48, 0xea, 0xc6, 0x85, 0x9b, 0x56, 0x13, 0x63, 0xe2, 0xc2, 0x5c, 0x5c, 0x75, 0x83, 0x03,
+	0x1c, 0xb9, 0x18, 0x60, 0x90, 0x19, 0x12, 0xfb, 0x16, 0x3e, 0x56, 0x97, 0x5d, 0xba, 0x32, 0xe6,
+	0xde, 0x95, 0x6f, 0x61, 0x7a, 0x66, 0x50, 0xfe, 0x8c, 0xe9, 0x86, 0x21, 0x33, 0xdf, 0xf9, 0x7d,
+	0x33, 0x27, 0x87, 0xf8, 0x45, 0x07, 0xd0, 0x7c, 0x2e, 0xa1, 0xca, 0xa3, 0x96, 0x5e, 0xd6, 0xd0,
+	0x88, 0xa8, 0x80, 0x06, 0x78, 0xc9, 0xc3, 0xb6, 0x63, 0x82, 0xd9, 0xf6, 0x3f, 0x22, 0x54, 0x84,
+	0xbb, 0x2e, 0x58, 0xc1, 0xf0, 0x38, 0xba, 0xf9, 0x93, 0xa4, 0xfb, 0x4c, 0x93, 0x45, 0x7b, 0xc1,
+	0x12, 0x0e, 0x42, 0x54, 0x90, 0x74, 0x90, 0xb1, 0x2e, 0x57, 0xf0, 0xb1, 0x06, 0x6e, 0x69, 0x47,
+	0x6b, 0xe5, 0x75, 0x03, 0x2d, 0x80, 0x6b, 0x42, 0xb3, 0x8c, 0xf5, 0x8d, 0x50, 0x64, 0x78, 0x3b,
+	0x99, 0x8c, 0xf9, 0xa7, 0x1a, 0x9e, 0x67, 0x5b, 0xc8, 0xfb, 0x0a, 0xf2, 0x24, 0x87, 0x96, 0xf1,
+	0x72, 0x60, 0x1f, 0xeb, 0x58, 0xd1, 0x01, 0xad, 0x27, 0xcf, 0x39, 0xf9, 0x6d, 0x91, 0xfb, 0x6f,
+	0x65, 0xdf, 0x62, 0x41, 0x05, 0xd8, 0x2f, 0xc9, 0x4a, 0x3e, 0xc7, 0x31, 0x7d, 0x33, 0x38, 0x3c,
+	0x1d, 0xdf, 0x72, 0xe8, 0x63, 0xf8, 0x01, 0x89, 0x73, 0xeb, 0xea, 0xe7, 0xb1, 0xb1, 0x51, 0xbc,
+	0xfd, 0x91, 0xd8, 0x13, 0x43, 0x52, 0x95, 0x5c, 0x38, 0x77, 0xfc, 0x83, 0xe0, 0xf0, 0xd4, 0xd7,
+	0xa5, 0xc4, 0x48, 0x6f, 0x10, 0x56, 0x59, 0x0f, 0xf8, 0x68, 0xef, 0x7d, 0xc9, 0x85, 0xfd, 0x85,
+	0xb8, 0xda, 0x9e, 0xc8, 0xf4, 0x03, 0x4c, 0x7f, 0xa2, 0xbf, 0x23, 0xae, 0x67, 0xb2, 0xe8, 0xf5,
+	0xcd, 0x47, 0x49, 0x8e, 0xda, 0xe5, 0x11, 0xba, 0x2e, 0xc8, 0x7a, 0xee, 0x42, 0x8b, 0x85, 0x96,
+	0x93, 0xdb, 0x2d, 0x4a, 0x60, 0x4f, 0x05, 0x98, 0x4d, 0xc9, 0xd1, 0x72, 0xa6, 0x64, 0xfc, 0x5d,
+	0x8c, 0x7f, 0xa4, 0x8b, 0x3f, 0xeb, 0x05, 0x8b, 0xb1, 0x62, 0xd2, 0xa6, 0x35, 0x9d, 0xed, 0xa3,
+	0xe2, 0x13, 0x79, 0xb8, 0x18, 0x07, 0x69, 0x58, 0xfd, 0xdf, 0x10, 0x0f, 0x15, 0x6f, 0x64, 0xc1,
+	0x60, 0xe0, 0xb3, 0x7d, 0x34, 0xbc, 0x22, 0xee, 0xd2, 0xc0, 0xe1, 0x6b, 0x0f, 0x4d, 0x06, 0xce,
+	0x3d, 0xdf, 0x0c, 0xac, 0x8d, 0x33, 0xaf, 0x8c, 0xd5, 0xf9, 0xf9, 0xbb, 0xab, 0x9d, 0x67, 0x5e,
+	0xef, 0x3c, 0xf3, 0xd7, 0xce, 0x33, 0xbf, 0xef, 0x3d, 0xe3, 0x7a, 0xef, 0x19, 0x3f, 0xf6, 0x9e,
+	0x71, 0x11, 0x15, 0xa5, 0xd8, 0xf6, 0x69, 0x98, 0xb1, 0x3a, 0x4a, 0x9b, 0xf4, 0x79, 0xb6, 0xa5,
+	0x65, 0x13, 0x8d, 0x46, 0xf8, 0xdb, 0xdf, 0x21, 0x16, 0x97, 0x2d, 0xf0, 0x74, 0x85, 0xd3, 0xfb,
+	0xe2, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x79, 0x8d, 0xd6, 0x5d, 0x07, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -153,6 +174,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ScheduledDepositSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ScheduledDepositSequence))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ScheduledDepositList) > 0 {
+		for iNdEx := len(m.ScheduledDepositList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledDepositList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.AutoSettleRecordList) > 0 {
 		for iNdEx := len(m.AutoSettleRecordList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -265,6 +305,15 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ScheduledDepositList) > 0 {
+		for _, e := range m.ScheduledDepositList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ScheduledDepositSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.ScheduledDepositSequence))
+	}
 	return n
 }
 
@@ -472,6 +521,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledDepositList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledDepositList = append(m.ScheduledDepositList, ScheduledDeposit{})
+			if err := m.ScheduledDepositList[len(m.ScheduledDepositList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledDepositSequence", wireType)
+			}
+			m.ScheduledDepositSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledDepositSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -31,6 +31,16 @@ var (
 	ParamsKey                    = []byte{0x07}
 	VersionedParamsKeyPrefix     = []byte{0x08}
 	DelayedWithdrawalKeyPrefix   = []byte{0x09}
+
+	ScheduledDepositKeyPrefix        = []byte{0x0A}
+	ScheduledDepositQueueKeyPrefix   = []byte{0x0B}
+	ScheduledDepositByOwnerKeyPrefix = []byte{0x0C}
+	ScheduledDepositSequenceKey      = []byte{0x0D}
+
+	DelayedWithdrawalQueueKeyPrefix = []byte{0x0E}
+
+	StreamRecordHistoryKeyPrefix     = []byte{0x0F}
+	StreamRecordHistoryMetaKeyPrefix = []byte{0x10}
 )
 
 // AutoSettleRecordKey returns the store key to retrieve a AutoSettleRecord from the index fields
@@ -148,3 +158,81 @@ func DelayedWithdrawalKey(
 ) []byte {
 	return account
 }
+
+// DelayedWithdrawalQueueKey returns the store key of a DelayedWithdrawal in the unlock queue,
+// the queue is ordered by the unlock timestamp
+func DelayedWithdrawalQueueKey(
+	timestamp int64,
+	addr sdk.AccAddress,
+) []byte {
+	var key []byte
+
+	timestampBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(timestampBytes, uint64(timestamp))
+	key = append(key, timestampBytes...)
+
+	addrBytes := []byte(addr)
+	key = append(key, addrBytes...)
+
+	return key
+}
+
+func ParseDelayedWithdrawalQueueKey(key []byte) (timestamp int64, addr sdk.AccAddress) {
+	timestamp = int64(binary.BigEndian.Uint64(key[0:8]))
+	addr = sdk.AccAddress(key[8:])
+	return
+}
+
+// ScheduledDepositKey returns the store key to retrieve a ScheduledDeposit from the index fields
+func ScheduledDepositKey(
+	id uint64,
+) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// ScheduledDepositQueueKey returns the store key of a ScheduledDeposit in the execution queue,
+// the queue is ordered by the next execution time
+func ScheduledDepositQueueKey(
+	timestamp int64,
+	id uint64,
+) []byte {
+	var key []byte
+
+	timestampBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(timestampBytes, uint64(timestamp))
+	key = append(key, timestampBytes...)
+	key = append(key, sdk.Uint64ToBigEndian(id)...)
+
+	return key
+}
+
+func ParseScheduledDepositQueueKey(key []byte) (timestamp int64, id uint64) {
+	timestamp = int64(binary.BigEndian.Uint64(key[0:8]))
+	id = binary.BigEndian.Uint64(key[8:16])
+	return
+}
+
+// ScheduledDepositByOwnerKey returns the store key to index a ScheduledDeposit by its owner
+func ScheduledDepositByOwnerKey(
+	owner sdk.AccAddress,
+	id uint64,
+) []byte {
+	key := append([]byte{}, owner.Bytes()...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// StreamRecordHistoryKey returns the store key to retrieve a StreamRecordHistoryEntry from the index fields
+func StreamRecordHistoryKey(
+	account sdk.AccAddress,
+	sequence uint64,
+) []byte {
+	key := append([]byte{}, account.Bytes()...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// StreamRecordHistoryMetaKey returns the store key to retrieve the sequence range of the history of a stream record
+func StreamRecordHistoryMetaKey(
+	account sdk.AccAddress,
+) []byte {
+	return account
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelScheduledDeposit = "cancel_scheduled_deposit"
+
+var _ sdk.Msg = &MsgCancelScheduledDeposit{}
+
+func NewMsgCancelScheduledDeposit(creator string, id uint64) *MsgCancelScheduledDeposit {
+	return &MsgCancelScheduledDeposit{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgCancelScheduledDeposit) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelScheduledDeposit) Type() string {
+	return TypeMsgCancelScheduledDeposit
+}
+
+func (msg *MsgCancelScheduledDeposit) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromHexUnsafe(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelScheduledDeposit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelScheduledDeposit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Creator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCreateScheduledDeposit = "create_scheduled_deposit"
+
+// MinScheduledDepositInterval is the minimum duration in seconds between two executions of a scheduled deposit
+const MinScheduledDepositInterval uint64 = 60 * 60 // 1 hour
+
+var _ sdk.Msg = &MsgCreateScheduledDeposit{}
+
+func NewMsgCreateScheduledDeposit(creator string, to string, amount sdkmath.Int, interval uint64, startTime int64, maxExecutions uint64) *MsgCreateScheduledDeposit {
+	return &MsgCreateScheduledDeposit{
+		Creator:       creator,
+		To:            to,
+		Amount:        amount,
+		Interval:      interval,
+		StartTime:     startTime,
+		MaxExecutions: maxExecutions,
+	}
+}
+
+func (msg *MsgCreateScheduledDeposit) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateScheduledDeposit) Type() string {
+	return TypeMsgCreateScheduledDeposit
+}
+
+func (msg *MsgCreateScheduledDeposit) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromHexUnsafe(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateScheduledDeposit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateScheduledDeposit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Creator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromHexUnsafe(msg.To)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid to address (%s)", err)
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount (%s)", msg.Amount)
+	}
+	if msg.Interval < MinScheduledDepositInterval {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "interval should be no less than %d seconds", MinScheduledDepositInterval)
+	}
+	if msg.StartTime < 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid start time (%d)", msg.StartTime)
+	}
+	return nil
+}
@@ -13,26 +13,32 @@ import (
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 var (
-	KeyReserveTime               = []byte("ReserveTime")
-	KeyForcedSettleTime          = []byte("ForcedSettleTime")
-	KeyPaymentAccountCountLimit  = []byte("PaymentAccountCountLimit")
-	KeyMaxAutoSettleFlowCount    = []byte("MaxAutoSettleFlowCount")
-	KeyMaxAutoResumeFlowCount    = []byte("MaxAutoResumeFlowCount")
-	KeyFeeDenom                  = []byte("FeeDenom")
-	KeyValidatorTaxRate          = []byte("ValidatorTaxRate")
-	KeyWithdrawTimeLockThreshold = []byte("WithdrawTimeLockThreshold")
-	KeyWithdrawTimeLockDuration  = []byte("WithdrawTimeLockDuration")
+	KeyReserveTime                  = []byte("ReserveTime")
+	KeyForcedSettleTime             = []byte("ForcedSettleTime")
+	KeyPaymentAccountCountLimit     = []byte("PaymentAccountCountLimit")
+	KeyMaxAutoSettleFlowCount       = []byte("MaxAutoSettleFlowCount")
+	KeyMaxAutoResumeFlowCount       = []byte("MaxAutoResumeFlowCount")
+	KeyFeeDenom                     = []byte("FeeDenom")
+	KeyValidatorTaxRate             = []byte("ValidatorTaxRate")
+	KeyWithdrawTimeLockThreshold    = []byte("WithdrawTimeLockThreshold")
+	KeyWithdrawTimeLockDuration     = []byte("WithdrawTimeLockDuration")
+	KeyMaxAutoScheduledDepositCount = []byte("MaxAutoScheduledDepositCount")
+	KeyMaxStreamRecordHistoryCount  = []byte("MaxStreamRecordHistoryCount")
+	KeyScheduledDepositCountLimit   = []byte("ScheduledDepositCountLimit")
 
 	DefaultReserveTime      uint64  = 180 * 24 * 60 * 60       // 180 days
 	DefaultValidatorTaxRate sdk.Dec = sdk.NewDecWithPrec(1, 2) // 1%
 
-	DefaultForcedSettleTime          uint64 = 24 * 60 * 60 // 1 day
-	DefaultPaymentAccountCountLimit  uint64 = 200
-	DefaultMaxAutoSettleFlowCount    uint64 = 100
-	DefaultMaxAutoResumeFlowCount    uint64 = 100
-	DefaultFeeDenom                  string = "BNB"
-	DefaultWithdrawTimeLockThreshold        = math.NewIntFromBigInt(big.NewInt(1e18)).MulRaw(100) // 100 BNB
-	DefaultWithdrawTimeLockDuration  uint64 = 24 * 60 * 60                                        // 1 day
+	DefaultForcedSettleTime             uint64 = 24 * 60 * 60 // 1 day
+	DefaultPaymentAccountCountLimit     uint64 = 200
+	DefaultMaxAutoSettleFlowCount       uint64 = 100
+	DefaultMaxAutoResumeFlowCount       uint64 = 100
+	DefaultFeeDenom                     string = "BNB"
+	DefaultWithdrawTimeLockThreshold           = math.NewIntFromBigInt(big.NewInt(1e18)).MulRaw(100) // 100 BNB
+	DefaultWithdrawTimeLockDuration     uint64 = 24 * 60 * 60                                        // 1 day
+	DefaultMaxAutoScheduledDepositCount uint64 = 100
+	DefaultMaxStreamRecordHistoryCount  uint64 = 20
+	DefaultScheduledDepositCountLimit   uint64 = 10
 )
 
 // ParamKeyTable the param key table for launch module
@@ -51,16 +57,22 @@ func NewParams(
 	feeDenom string,
 	withdrawTimeLockThreshold math.Int,
 	withdrawTimeLockDuration uint64,
+	maxAutoScheduledDepositCount uint64,
+	maxStreamRecordHistoryCount uint64,
+	scheduledDepositCountLimit uint64,
 ) Params {
 	return Params{
-		VersionedParams:           VersionedParams{ReserveTime: reserveTime, ValidatorTaxRate: validatorTaxRate},
-		ForcedSettleTime:          forcedSettleTime,
-		PaymentAccountCountLimit:  paymentAccountCountLimit,
-		MaxAutoSettleFlowCount:    MaxAutoSettleFlowCount,
-		MaxAutoResumeFlowCount:    maxAutoResumeFlowCount,
-		FeeDenom:                  feeDenom,
-		WithdrawTimeLockThreshold: &withdrawTimeLockThreshold,
-		WithdrawTimeLockDuration:  withdrawTimeLockDuration,
+		VersionedParams:              VersionedParams{ReserveTime: reserveTime, ValidatorTaxRate: validatorTaxRate},
+		ForcedSettleTime:             forcedSettleTime,
+		PaymentAccountCountLimit:     paymentAccountCountLimit,
+		MaxAutoSettleFlowCount:       MaxAutoSettleFlowCount,
+		MaxAutoResumeFlowCount:       maxAutoResumeFlowCount,
+		FeeDenom:                     feeDenom,
+		WithdrawTimeLockThreshold:    &withdrawTimeLockThreshold,
+		WithdrawTimeLockDuration:     withdrawTimeLockDuration,
+		MaxAutoScheduledDepositCount: maxAutoScheduledDepositCount,
+		MaxStreamRecordHistoryCount:  maxStreamRecordHistoryCount,
+		ScheduledDepositCountLimit:   scheduledDepositCountLimit,
 	}
 }
 
@@ -76,6 +88,9 @@ func DefaultParams() Params {
 		DefaultFeeDenom,
 		DefaultWithdrawTimeLockThreshold,
 		DefaultWithdrawTimeLockDuration,
+		DefaultMaxAutoScheduledDepositCount,
+		DefaultMaxStreamRecordHistoryCount,
+		DefaultScheduledDepositCountLimit,
 	)
 }
 
@@ -91,6 +106,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyFeeDenom, &p.FeeDenom, validateFeeDenom),
 		paramtypes.NewParamSetPair(KeyWithdrawTimeLockThreshold, &p.WithdrawTimeLockThreshold, validateWithdrawTimeLockThreshold),
 		paramtypes.NewParamSetPair(KeyWithdrawTimeLockDuration, &p.WithdrawTimeLockDuration, validateWithdrawTimeLockDuration),
+		paramtypes.NewParamSetPair(KeyMaxAutoScheduledDepositCount, &p.MaxAutoScheduledDepositCount, validateMaxAutoScheduledDepositCount),
+		paramtypes.NewParamSetPair(KeyMaxStreamRecordHistoryCount, &p.MaxStreamRecordHistoryCount, validateMaxStreamRecordHistoryCount),
+		paramtypes.NewParamSetPair(KeyScheduledDepositCountLimit, &p.ScheduledDepositCountLimit, validateScheduledDepositCountLimit),
 	}
 }
 
@@ -132,6 +150,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMaxAutoScheduledDepositCount(p.MaxAutoScheduledDepositCount); err != nil {
+		return err
+	}
+
+	if err := validateMaxStreamRecordHistoryCount(p.MaxStreamRecordHistoryCount); err != nil {
+		return err
+	}
+
+	if err := validateScheduledDepositCountLimit(p.ScheduledDepositCountLimit); err != nil {
+		return err
+	}
+
 	if p.VersionedParams.ReserveTime <= p.ForcedSettleTime {
 		return fmt.Errorf("reserve time must be greater than force settle time")
 	}
@@ -261,3 +291,36 @@ func validateWithdrawTimeLockDuration(v interface{}) error {
 
 	return nil
 }
+
+// validateMaxAutoScheduledDepositCount validates the MaxAutoScheduledDepositCount param,
+// zero means that the scheduled deposits will not be executed
+func validateMaxAutoScheduledDepositCount(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxStreamRecordHistoryCount validates the MaxStreamRecordHistoryCount param,
+// zero means that the history of stream records will not be recorded
+func validateMaxStreamRecordHistoryCount(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateScheduledDepositCountLimit validates the ScheduledDepositCountLimit param,
+// zero means that no scheduled deposit can be created
+func validateScheduledDepositCountLimit(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
@@ -43,6 +43,12 @@ type Params struct {
 	WithdrawTimeLockThreshold *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=withdraw_time_lock_threshold,json=withdrawTimeLockThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"withdraw_time_lock_threshold,omitempty"`
 	// The duration of the time lock for a big amount withdrawal
 	WithdrawTimeLockDuration uint64 `protobuf:"varint,8,opt,name=withdraw_time_lock_duration,json=withdrawTimeLockDuration,proto3" json:"withdraw_time_lock_duration,omitempty" yaml:"withdraw_time_lock_duration"`
+	// the maximum number of scheduled deposits that will be executed in one block
+	MaxAutoScheduledDepositCount uint64 `protobuf:"varint,9,opt,name=max_auto_scheduled_deposit_count,json=maxAutoScheduledDepositCount,proto3" json:"max_auto_scheduled_deposit_count,omitempty" yaml:"max_auto_scheduled_deposit_count"`
+	// the maximum number of history entries kept for each stream record, 0 means the history is disabled
+	MaxStreamRecordHistoryCount uint64 `protobuf:"varint,10,opt,name=max_stream_record_history_count,json=maxStreamRecordHistoryCount,proto3" json:"max_stream_record_history_count,omitempty" yaml:"max_stream_record_history_count"`
+	// the maximum number of scheduled deposits that can be created by one user
+	ScheduledDepositCountLimit uint64 `protobuf:"varint,11,opt,name=scheduled_deposit_count_limit,json=scheduledDepositCountLimit,proto3" json:"scheduled_deposit_count_limit,omitempty" yaml:"scheduled_deposit_count_limit"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -127,6 +133,27 @@ func (m *Params) GetWithdrawTimeLockDuration() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxAutoScheduledDepositCount() uint64 {
+	if m != nil {
+		return m.MaxAutoScheduledDepositCount
+	}
+	return 0
+}
+
+func (m *Params) GetMaxStreamRecordHistoryCount() uint64 {
+	if m != nil {
+		return m.MaxStreamRecordHistoryCount
+	}
+	return 0
+}
+
+func (m *Params) GetScheduledDepositCountLimit() uint64 {
+	if m != nil {
+		return m.ScheduledDepositCountLimit
+	}
+	return 0
+}
+
 // VersionedParams defines the parameters with multiple versions, each version is stored with different timestamp.
 type VersionedParams struct {
 	// Time duration which the buffer balance need to be reserved for NetOutFlow e.g. 6 month
@@ -183,44 +210,51 @@ func init() {
 func init() { proto.RegisterFile("greenfield/payment/params.proto", fileDescriptor_bd7d37632356c8f4) }
 
 var fileDescriptor_bd7d37632356c8f4 = []byte{
-	// 584 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcb, 0x6e, 0xd3, 0x4c,
-	0x18, 0x8d, 0xff, 0xbf, 0x2d, 0xed, 0x14, 0xa9, 0x91, 0xa9, 0xc0, 0x2d, 0x60, 0x17, 0x23, 0xaa,
-	0x6e, 0x6a, 0x0b, 0xd8, 0xa0, 0x8a, 0x4d, 0x43, 0x84, 0x14, 0xd1, 0x05, 0x32, 0x11, 0x0b, 0x36,
-	0xa3, 0x89, 0xfd, 0x25, 0x31, 0xf1, 0x78, 0xa2, 0xf1, 0x38, 0x97, 0xb7, 0xe0, 0x61, 0xd8, 0xf0,
-	0x06, 0x5d, 0x56, 0xac, 0x10, 0x0b, 0x0b, 0x25, 0x6b, 0x36, 0x79, 0x02, 0xe4, 0x19, 0xbb, 0xb9,
-	0xd1, 0x8a, 0xcd, 0x5c, 0xbe, 0x73, 0xe6, 0x9c, 0xd1, 0xa7, 0x33, 0x83, 0xac, 0x0e, 0x07, 0x88,
-	0xdb, 0x21, 0x44, 0x81, 0xdb, 0x27, 0x63, 0x0a, 0xb1, 0x70, 0xfb, 0x84, 0x13, 0x9a, 0x38, 0x7d,
-	0xce, 0x04, 0xd3, 0xf5, 0x39, 0xc1, 0x29, 0x08, 0x87, 0x07, 0x3e, 0x4b, 0x28, 0x4b, 0xb0, 0x64,
-	0xb8, 0x6a, 0xa3, 0xe8, 0x87, 0xfb, 0x1d, 0xd6, 0x61, 0xaa, 0x9e, 0xaf, 0x54, 0xd5, 0xfe, 0xbd,
-	0x89, 0xb6, 0xde, 0x4b, 0x55, 0xbd, 0x89, 0xaa, 0x03, 0xe0, 0x49, 0xc8, 0x62, 0x08, 0xb0, 0x72,
-	0x32, 0xb4, 0x23, 0xed, 0x64, 0xf7, 0xc5, 0x53, 0x67, 0xdd, 0xca, 0xf9, 0x58, 0x72, 0xd5, 0xf1,
-	0xda, 0xc6, 0x65, 0x66, 0x55, 0xbc, 0xbd, 0xc1, 0x72, 0x59, 0x07, 0xf4, 0xb0, 0x38, 0x81, 0x89,
-	0xef, 0xb3, 0x34, 0x16, 0x58, 0x8d, 0x51, 0x48, 0x43, 0x61, 0xfc, 0x77, 0xa4, 0x9d, 0x6c, 0xd4,
-	0x8e, 0x67, 0x99, 0x65, 0x8f, 0x09, 0x8d, 0xce, 0xec, 0x5b, 0xc8, 0xb6, 0x67, 0x14, 0xe8, 0xb9,
-	0x02, 0xdf, 0xe4, 0xc3, 0x45, 0x0e, 0xe9, 0xef, 0x90, 0xde, 0x66, 0xdc, 0x87, 0x00, 0x27, 0x20,
-	0x44, 0x04, 0x58, 0x84, 0x14, 0x8c, 0xff, 0xa5, 0xfa, 0xe3, 0x59, 0x66, 0x1d, 0x28, 0xf5, 0x75,
-	0x8e, 0xed, 0x55, 0x55, 0xf1, 0x83, 0xac, 0x35, 0x43, 0x0a, 0x3a, 0x41, 0x87, 0x94, 0x8c, 0x30,
-	0x49, 0x05, 0x2b, 0xa9, 0xed, 0x88, 0x0d, 0xd5, 0x5d, 0x8c, 0x0d, 0x29, 0xfa, 0x6c, 0x96, 0x59,
-	0x4f, 0x94, 0xe8, 0xcd, 0x5c, 0xdb, 0xbb, 0x4f, 0xc9, 0xe8, 0x3c, 0x15, 0x4c, 0xa9, 0xbf, 0x8d,
-	0xd8, 0x50, 0x5e, 0x7a, 0xc9, 0x82, 0x43, 0x92, 0xd2, 0x25, 0x8b, 0xcd, 0x1b, 0x2d, 0xd6, 0xb8,
-	0x73, 0x0b, 0x4f, 0x42, 0x73, 0x8b, 0xe7, 0x68, 0xa7, 0x0d, 0x80, 0x03, 0x88, 0x19, 0x35, 0xb6,
-	0x8e, 0xb4, 0x93, 0x9d, 0xda, 0xfe, 0x2c, 0xb3, 0xaa, 0x45, 0x27, 0x4a, 0xc8, 0xf6, 0xb6, 0xdb,
-	0x00, 0xf5, 0x7c, 0xa9, 0x8f, 0xd1, 0xa3, 0x61, 0x28, 0xba, 0x01, 0x27, 0x43, 0xd9, 0x1c, 0x1c,
-	0x31, 0xbf, 0x87, 0x45, 0x97, 0x43, 0xd2, 0x65, 0x51, 0x60, 0xdc, 0x91, 0x2a, 0xaf, 0x7e, 0x66,
-	0xd6, 0x71, 0x27, 0x14, 0xdd, 0xb4, 0xe5, 0xf8, 0x8c, 0x16, 0x31, 0x2b, 0xa6, 0xd3, 0x24, 0xe8,
-	0xb9, 0x62, 0xdc, 0x87, 0xc4, 0x69, 0xc4, 0xe2, 0xfb, 0xd7, 0x53, 0x54, 0xa4, 0xb0, 0x11, 0x0b,
-	0xef, 0xa0, 0x54, 0xcf, 0xdb, 0x7c, 0xc1, 0xfc, 0x5e, 0xb3, 0x94, 0xce, 0x73, 0xf2, 0x17, 0xeb,
-	0x20, 0xe5, 0x44, 0x84, 0x2c, 0x36, 0xb6, 0x57, 0x73, 0x72, 0x0b, 0xd9, 0xf6, 0x8c, 0x55, 0x9f,
-	0x7a, 0x09, 0x7d, 0xd3, 0xd0, 0xde, 0x4a, 0x72, 0xf5, 0x33, 0x74, 0x97, 0x43, 0x02, 0x7c, 0x50,
-	0xa4, 0x46, 0x93, 0x5e, 0x0f, 0x66, 0x99, 0x75, 0x4f, 0x79, 0x2d, 0xa2, 0xb6, 0xb7, 0x5b, 0x6c,
-	0x65, 0x54, 0x3e, 0x23, 0x7d, 0x40, 0xa2, 0x30, 0x20, 0x82, 0x71, 0x2c, 0xc8, 0x08, 0x73, 0x22,
-	0x40, 0xa6, 0x7a, 0xa7, 0xf6, 0x3a, 0x7f, 0x11, 0xff, 0xd8, 0xab, 0x3a, 0xf8, 0x0b, 0xbd, 0xaa,
-	0x83, 0xef, 0x55, 0xaf, 0x75, 0x9b, 0x64, 0xe4, 0x11, 0x01, 0xb5, 0xc6, 0xe5, 0xc4, 0xd4, 0xae,
-	0x26, 0xa6, 0xf6, 0x6b, 0x62, 0x6a, 0x5f, 0xa6, 0x66, 0xe5, 0x6a, 0x6a, 0x56, 0x7e, 0x4c, 0xcd,
-	0xca, 0x27, 0x77, 0xc1, 0xa1, 0x15, 0xb7, 0x4e, 0xfd, 0x2e, 0x09, 0x63, 0x77, 0xe1, 0x03, 0x19,
-	0x5d, 0x7f, 0x21, 0xd2, 0xae, 0xb5, 0x25, 0x5f, 0xff, 0xcb, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x71, 0xb1, 0x0e, 0x3a, 0x65, 0x04, 0x00, 0x00,
+	// 701 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc6, 0xe3, 0x7b, 0x7b, 0x7b, 0x9b, 0x29, 0x52, 0x23, 0x53, 0x81, 0x9b, 0xb6, 0x71, 0x30,
+	0x50, 0x22, 0x50, 0x13, 0x01, 0x1b, 0x54, 0xb1, 0x69, 0x88, 0x10, 0x15, 0x5d, 0xa0, 0x69, 0xc4,
+	0x82, 0xcd, 0x68, 0x62, 0x9f, 0x24, 0x26, 0xb6, 0x27, 0x9a, 0x99, 0xfc, 0x7b, 0x0b, 0x1e, 0x86,
+	0x0d, 0x4b, 0x76, 0x5d, 0x56, 0xac, 0x10, 0x0b, 0x0b, 0xb5, 0x6f, 0xe0, 0x27, 0x40, 0x9e, 0x71,
+	0x9a, 0x34, 0x6d, 0x23, 0x36, 0x8e, 0x3d, 0xdf, 0x37, 0xdf, 0x6f, 0x32, 0x73, 0xe6, 0x20, 0xbb,
+	0xc3, 0x01, 0xa2, 0xb6, 0x0f, 0x81, 0x57, 0xeb, 0xd3, 0x49, 0x08, 0x91, 0xac, 0xf5, 0x29, 0xa7,
+	0xa1, 0xa8, 0xf6, 0x39, 0x93, 0xcc, 0x34, 0x67, 0x86, 0x6a, 0x66, 0x28, 0x6e, 0xb9, 0x4c, 0x84,
+	0x4c, 0x10, 0xe5, 0xa8, 0xe9, 0x0f, 0x6d, 0x2f, 0x6e, 0x76, 0x58, 0x87, 0xe9, 0xf1, 0xf4, 0x4d,
+	0x8f, 0x3a, 0xdf, 0xd7, 0xd0, 0xea, 0x07, 0x95, 0x6a, 0x36, 0x51, 0x61, 0x08, 0x5c, 0xf8, 0x2c,
+	0x02, 0x8f, 0x68, 0x92, 0x65, 0x94, 0x8d, 0xca, 0xfa, 0x8b, 0x87, 0xd5, 0xeb, 0xa8, 0xea, 0xc7,
+	0xa9, 0x57, 0x4f, 0xaf, 0xaf, 0x9c, 0xc6, 0x76, 0x0e, 0x6f, 0x0c, 0xaf, 0x0e, 0x9b, 0x80, 0xb6,
+	0xb3, 0x19, 0x84, 0xba, 0x2e, 0x1b, 0x44, 0x92, 0xe8, 0x67, 0xe0, 0x87, 0xbe, 0xb4, 0xfe, 0x29,
+	0x1b, 0x95, 0x95, 0xfa, 0x5e, 0x12, 0xdb, 0xce, 0x84, 0x86, 0xc1, 0x81, 0xb3, 0xc4, 0xec, 0x60,
+	0x2b, 0x53, 0x0f, 0xb5, 0xf8, 0x26, 0x7d, 0x1c, 0xa7, 0x92, 0xf9, 0x1e, 0x99, 0x6d, 0xc6, 0x5d,
+	0xf0, 0x88, 0x00, 0x29, 0x03, 0x20, 0xd2, 0x0f, 0xc1, 0xfa, 0x57, 0xa5, 0xef, 0x26, 0xb1, 0xbd,
+	0xa5, 0xd3, 0xaf, 0x7b, 0x1c, 0x5c, 0xd0, 0x83, 0x27, 0x6a, 0xac, 0xe9, 0x87, 0x60, 0x52, 0x54,
+	0x0c, 0xe9, 0x98, 0xd0, 0x81, 0x64, 0x53, 0x6b, 0x3b, 0x60, 0x23, 0xbd, 0x16, 0x6b, 0x45, 0x85,
+	0x3e, 0x4e, 0x62, 0xfb, 0x81, 0x0e, 0xbd, 0xdd, 0xeb, 0xe0, 0x7b, 0x21, 0x1d, 0x1f, 0x0e, 0x24,
+	0xd3, 0xe9, 0x6f, 0x03, 0x36, 0x52, 0x8b, 0xbe, 0x82, 0xe0, 0x20, 0x06, 0xe1, 0x15, 0xc4, 0x7f,
+	0xb7, 0x22, 0xae, 0x79, 0x67, 0x08, 0xac, 0xa4, 0x19, 0xe2, 0x39, 0xca, 0xb7, 0x01, 0x88, 0x07,
+	0x11, 0x0b, 0xad, 0xd5, 0xb2, 0x51, 0xc9, 0xd7, 0x37, 0x93, 0xd8, 0x2e, 0x64, 0x3b, 0x31, 0x95,
+	0x1c, 0xbc, 0xd6, 0x06, 0x68, 0xa4, 0xaf, 0xe6, 0x04, 0xed, 0x8c, 0x7c, 0xd9, 0xf5, 0x38, 0x1d,
+	0xa9, 0xcd, 0x21, 0x01, 0x73, 0x7b, 0x44, 0x76, 0x39, 0x88, 0x2e, 0x0b, 0x3c, 0xeb, 0x7f, 0x95,
+	0xf2, 0xea, 0x57, 0x6c, 0xef, 0x75, 0x7c, 0xd9, 0x1d, 0xb4, 0xaa, 0x2e, 0x0b, 0xb3, 0x32, 0xcb,
+	0x7e, 0xf6, 0x85, 0xd7, 0xab, 0xc9, 0x49, 0x1f, 0x44, 0xf5, 0x28, 0x92, 0x3f, 0xbe, 0xee, 0xa3,
+	0xac, 0x0a, 0x8f, 0x22, 0x89, 0xb7, 0xa6, 0xe9, 0xe9, 0x36, 0x1f, 0x33, 0xb7, 0xd7, 0x9c, 0x46,
+	0xa7, 0x75, 0x72, 0x03, 0xda, 0x1b, 0x70, 0x2a, 0x7d, 0x16, 0x59, 0x6b, 0x8b, 0x75, 0xb2, 0xc4,
+	0xec, 0x60, 0x6b, 0x91, 0xd3, 0xc8, 0x24, 0x53, 0xa0, 0xf2, 0xec, 0xb8, 0xdc, 0x2e, 0x78, 0x83,
+	0x00, 0x3c, 0xe2, 0x41, 0x9f, 0x09, 0x3f, 0x2b, 0x36, 0x2b, 0xaf, 0x58, 0xcf, 0x92, 0xd8, 0x7e,
+	0xb2, 0x78, 0xc0, 0x37, 0xcf, 0x70, 0xf0, 0xce, 0xf4, 0x98, 0xa7, 0x86, 0x86, 0xd6, 0xf5, 0x49,
+	0xf4, 0x91, 0x9d, 0x46, 0x08, 0xc9, 0x81, 0x86, 0x84, 0x83, 0xcb, 0xb8, 0x47, 0xba, 0xbe, 0x90,
+	0x8c, 0x4f, 0x32, 0x26, 0x52, 0xcc, 0xa7, 0x49, 0x6c, 0xef, 0xcd, 0x98, 0x4b, 0x26, 0x38, 0x78,
+	0x3b, 0xa4, 0xe3, 0x13, 0x65, 0xc0, 0x4a, 0x7f, 0xa7, 0x65, 0x4d, 0xec, 0xa1, 0xdd, 0x5b, 0xd6,
+	0x9a, 0xdd, 0xbb, 0x75, 0xc5, 0xab, 0x24, 0xb1, 0xfd, 0x48, 0xf3, 0x96, 0xda, 0x1d, 0x5c, 0x14,
+	0x37, 0xfd, 0x33, 0x75, 0xf7, 0x9c, 0x6f, 0x06, 0xda, 0x58, 0xe8, 0x06, 0xe6, 0x01, 0xba, 0xc3,
+	0x41, 0x00, 0x1f, 0x66, 0x37, 0xd1, 0x50, 0xbc, 0xfb, 0x49, 0x6c, 0xdf, 0xd5, 0xbc, 0x79, 0xd5,
+	0xc1, 0xeb, 0xd9, 0xa7, 0xba, 0x7e, 0x9f, 0x91, 0x39, 0xa4, 0x81, 0xef, 0x51, 0xc9, 0x38, 0x91,
+	0x74, 0x4c, 0x38, 0x95, 0xa0, 0x3a, 0x45, 0xbe, 0xfe, 0x3a, 0xed, 0x32, 0x7f, 0x59, 0x7f, 0x0d,
+	0x70, 0xe7, 0xea, 0xaf, 0x01, 0x2e, 0x2e, 0x5c, 0xe6, 0x36, 0xe9, 0x18, 0x53, 0x09, 0xf5, 0xa3,
+	0xd3, 0xf3, 0x92, 0x71, 0x76, 0x5e, 0x32, 0x7e, 0x9f, 0x97, 0x8c, 0x2f, 0x17, 0xa5, 0xdc, 0xd9,
+	0x45, 0x29, 0xf7, 0xf3, 0xa2, 0x94, 0xfb, 0x54, 0x9b, 0x23, 0xb4, 0xa2, 0xd6, 0xbe, 0xdb, 0xa5,
+	0x7e, 0x54, 0x9b, 0x6b, 0xca, 0xe3, 0xcb, 0xb6, 0xac, 0x70, 0xad, 0x55, 0xd5, 0x51, 0x5f, 0xfe,
+	0x09, 0x00, 0x00, 0xff, 0xff, 0xb0, 0x2d, 0xe6, 0xa0, 0xb9, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -243,6 +277,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ScheduledDepositCountLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ScheduledDepositCountLimit))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxStreamRecordHistoryCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxStreamRecordHistoryCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxAutoScheduledDepositCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAutoScheduledDepositCount))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.WithdrawTimeLockDuration != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.WithdrawTimeLockDuration))
 		i--
@@ -380,6 +429,15 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawTimeLockDuration != 0 {
 		n += 1 + sovParams(uint64(m.WithdrawTimeLockDuration))
 	}
+	if m.MaxAutoScheduledDepositCount != 0 {
+		n += 1 + sovParams(uint64(m.MaxAutoScheduledDepositCount))
+	}
+	if m.MaxStreamRecordHistoryCount != 0 {
+		n += 1 + sovParams(uint64(m.MaxStreamRecordHistoryCount))
+	}
+	if m.ScheduledDepositCountLimit != 0 {
+		n += 1 + sovParams(uint64(m.ScheduledDepositCountLimit))
+	}
 	return n
 }
 
@@ -628,6 +686,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoScheduledDepositCount", wireType)
+			}
+			m.MaxAutoScheduledDepositCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoScheduledDepositCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStreamRecordHistoryCount", wireType)
+			}
+			m.MaxStreamRecordHistoryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStreamRecordHistoryCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledDepositCountLimit", wireType)
+			}
+			m.ScheduledDepositCountLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledDepositCountLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1227,6 +1227,311 @@ func (m *QueryDelayedWithdrawalResponse) GetDelayedWithdrawal() DelayedWithdrawa
 	return DelayedWithdrawalRecord{}
 }
 
+type QueryDelayedWithdrawalsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelayedWithdrawalsRequest) Reset()         { *m = QueryDelayedWithdrawalsRequest{} }
+func (m *QueryDelayedWithdrawalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelayedWithdrawalsRequest) ProtoMessage()    {}
+func (*QueryDelayedWithdrawalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{26}
+}
+func (m *QueryDelayedWithdrawalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelayedWithdrawalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelayedWithdrawalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelayedWithdrawalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelayedWithdrawalsRequest.Merge(m, src)
+}
+func (m *QueryDelayedWithdrawalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelayedWithdrawalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelayedWithdrawalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelayedWithdrawalsRequest proto.InternalMessageInfo
+
+func (m *QueryDelayedWithdrawalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryDelayedWithdrawalsResponse struct {
+	DelayedWithdrawals []DelayedWithdrawalRecord `protobuf:"bytes,1,rep,name=delayed_withdrawals,json=delayedWithdrawals,proto3" json:"delayed_withdrawals"`
+	Pagination         *query.PageResponse       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelayedWithdrawalsResponse) Reset()         { *m = QueryDelayedWithdrawalsResponse{} }
+func (m *QueryDelayedWithdrawalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelayedWithdrawalsResponse) ProtoMessage()    {}
+func (*QueryDelayedWithdrawalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{27}
+}
+func (m *QueryDelayedWithdrawalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelayedWithdrawalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelayedWithdrawalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelayedWithdrawalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelayedWithdrawalsResponse.Merge(m, src)
+}
+func (m *QueryDelayedWithdrawalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelayedWithdrawalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelayedWithdrawalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelayedWithdrawalsResponse proto.InternalMessageInfo
+
+func (m *QueryDelayedWithdrawalsResponse) GetDelayedWithdrawals() []DelayedWithdrawalRecord {
+	if m != nil {
+		return m.DelayedWithdrawals
+	}
+	return nil
+}
+
+func (m *QueryDelayedWithdrawalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryScheduledDepositsRequest struct {
+	// owner is the optional address to filter the scheduled deposits
+	Owner      string             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledDepositsRequest) Reset()         { *m = QueryScheduledDepositsRequest{} }
+func (m *QueryScheduledDepositsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledDepositsRequest) ProtoMessage()    {}
+func (*QueryScheduledDepositsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{28}
+}
+func (m *QueryScheduledDepositsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledDepositsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledDepositsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledDepositsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledDepositsRequest.Merge(m, src)
+}
+func (m *QueryScheduledDepositsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledDepositsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledDepositsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledDepositsRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledDepositsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryScheduledDepositsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryScheduledDepositsResponse struct {
+	ScheduledDeposits []ScheduledDeposit  `protobuf:"bytes,1,rep,name=scheduled_deposits,json=scheduledDeposits,proto3" json:"scheduled_deposits"`
+	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledDepositsResponse) Reset()         { *m = QueryScheduledDepositsResponse{} }
+func (m *QueryScheduledDepositsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledDepositsResponse) ProtoMessage()    {}
+func (*QueryScheduledDepositsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{29}
+}
+func (m *QueryScheduledDepositsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledDepositsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledDepositsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledDepositsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledDepositsResponse.Merge(m, src)
+}
+func (m *QueryScheduledDepositsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledDepositsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledDepositsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledDepositsResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledDepositsResponse) GetScheduledDeposits() []ScheduledDeposit {
+	if m != nil {
+		return m.ScheduledDeposits
+	}
+	return nil
+}
+
+func (m *QueryScheduledDepositsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryStreamRecordHistoryRequest struct {
+	Account    string             `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStreamRecordHistoryRequest) Reset()         { *m = QueryStreamRecordHistoryRequest{} }
+func (m *QueryStreamRecordHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamRecordHistoryRequest) ProtoMessage()    {}
+func (*QueryStreamRecordHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{30}
+}
+func (m *QueryStreamRecordHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamRecordHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamRecordHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamRecordHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamRecordHistoryRequest.Merge(m, src)
+}
+func (m *QueryStreamRecordHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamRecordHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamRecordHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamRecordHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryStreamRecordHistoryRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *QueryStreamRecordHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryStreamRecordHistoryResponse struct {
+	Entries    []StreamRecordHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	Pagination *query.PageResponse        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStreamRecordHistoryResponse) Reset()         { *m = QueryStreamRecordHistoryResponse{} }
+func (m *QueryStreamRecordHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamRecordHistoryResponse) ProtoMessage()    {}
+func (*QueryStreamRecordHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{31}
+}
+func (m *QueryStreamRecordHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamRecordHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamRecordHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamRecordHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamRecordHistoryResponse.Merge(m, src)
+}
+func (m *QueryStreamRecordHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamRecordHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamRecordHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamRecordHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryStreamRecordHistoryResponse) GetEntries() []StreamRecordHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryStreamRecordHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "greenfield.payment.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "greenfield.payment.QueryParamsResponse")
@@ -1254,101 +1559,122 @@ func init() {
 	proto.RegisterType((*QueryAutoSettleRecordsResponse)(nil), "greenfield.payment.QueryAutoSettleRecordsResponse")
 	proto.RegisterType((*QueryDelayedWithdrawalRequest)(nil), "greenfield.payment.QueryDelayedWithdrawalRequest")
 	proto.RegisterType((*QueryDelayedWithdrawalResponse)(nil), "greenfield.payment.QueryDelayedWithdrawalResponse")
+	proto.RegisterType((*QueryDelayedWithdrawalsRequest)(nil), "greenfield.payment.QueryDelayedWithdrawalsRequest")
+	proto.RegisterType((*QueryDelayedWithdrawalsResponse)(nil), "greenfield.payment.QueryDelayedWithdrawalsResponse")
+	proto.RegisterType((*QueryScheduledDepositsRequest)(nil), "greenfield.payment.QueryScheduledDepositsRequest")
+	proto.RegisterType((*QueryScheduledDepositsResponse)(nil), "greenfield.payment.QueryScheduledDepositsResponse")
+	proto.RegisterType((*QueryStreamRecordHistoryRequest)(nil), "greenfield.payment.QueryStreamRecordHistoryRequest")
+	proto.RegisterType((*QueryStreamRecordHistoryResponse)(nil), "greenfield.payment.QueryStreamRecordHistoryResponse")
 }
 
 func init() { proto.RegisterFile("greenfield/payment/query.proto", fileDescriptor_f62e6684473ccf4a) }
 
 var fileDescriptor_f62e6684473ccf4a = []byte{
-	// 1424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x13, 0x47,
-	0x14, 0xcf, 0x02, 0x09, 0xe4, 0x05, 0x12, 0x3c, 0x09, 0x28, 0x98, 0xd4, 0x81, 0x2d, 0x4a, 0x02,
-	0x21, 0xde, 0xc4, 0x29, 0x04, 0xaa, 0x52, 0x89, 0x14, 0x81, 0x50, 0x55, 0x05, 0x4c, 0x25, 0x24,
-	0xaa, 0x6a, 0x3b, 0xde, 0x1d, 0x1c, 0x37, 0xf6, 0xae, 0xd9, 0x1d, 0x93, 0x5a, 0x51, 0x2e, 0x48,
-	0xed, 0x19, 0xa9, 0xb7, 0x1e, 0x2b, 0xb5, 0xaa, 0xda, 0x1e, 0x91, 0x7a, 0x68, 0xaf, 0x95, 0x38,
-	0xd2, 0xf6, 0x52, 0xf5, 0x80, 0x2a, 0xd2, 0x3f, 0xa4, 0xf2, 0xec, 0x5b, 0x67, 0x3f, 0x66, 0xd7,
-	0x6b, 0xea, 0x5e, 0x12, 0xef, 0xce, 0xfb, 0xf8, 0xfd, 0xde, 0x9b, 0x37, 0xf3, 0xde, 0x42, 0xa1,
-	0xea, 0x30, 0x66, 0x3d, 0xac, 0xb1, 0xba, 0xa9, 0x35, 0x69, 0xbb, 0xc1, 0x2c, 0xae, 0x3d, 0x6a,
-	0x31, 0xa7, 0x5d, 0x6c, 0x3a, 0x36, 0xb7, 0x09, 0xd9, 0x5f, 0x2f, 0xe2, 0x7a, 0xfe, 0x82, 0x61,
-	0xbb, 0x0d, 0xdb, 0xd5, 0x2a, 0xd4, 0x65, 0x9e, 0xb0, 0xf6, 0x78, 0xa5, 0xc2, 0x38, 0x5d, 0xd1,
-	0x9a, 0xb4, 0x5a, 0xb3, 0x28, 0xaf, 0xd9, 0x96, 0xa7, 0x9f, 0x3f, 0xe5, 0xc9, 0xea, 0xe2, 0x49,
-	0xf3, 0x1e, 0x70, 0x69, 0xaa, 0x6a, 0x57, 0x6d, 0xef, 0x7d, 0xe7, 0x17, 0xbe, 0x9d, 0xa9, 0xda,
-	0x76, 0xb5, 0xce, 0x34, 0xda, 0xac, 0x69, 0xd4, 0xb2, 0x6c, 0x2e, 0xac, 0xf9, 0x3a, 0x8b, 0x12,
-	0xb8, 0xb4, 0xc5, 0x6d, 0xdd, 0x65, 0x9c, 0xd7, 0x99, 0xee, 0x30, 0xc3, 0x76, 0x4c, 0x14, 0x2e,
-	0x49, 0x84, 0x4d, 0x56, 0xa7, 0x6d, 0x66, 0xea, 0xdb, 0x35, 0xbe, 0x69, 0x3a, 0x74, 0x9b, 0xd6,
-	0xc3, 0x3a, 0x67, 0x25, 0x3a, 0x76, 0x8b, 0xeb, 0x0f, 0xeb, 0xf6, 0x36, 0x8a, 0xcc, 0x4a, 0x44,
-	0x9a, 0xd4, 0xa1, 0x0d, 0x1f, 0xe4, 0x82, 0x54, 0x40, 0xfc, 0xd7, 0xa9, 0x61, 0xd8, 0x2d, 0x8b,
-	0xa3, 0x64, 0xb1, 0xb7, 0xa4, 0x1e, 0x94, 0x9f, 0x93, 0xc8, 0xbb, 0xdc, 0x61, 0xb4, 0x11, 0x62,
-	0xa1, 0x4e, 0x01, 0xb9, 0xdb, 0xc9, 0xcb, 0x1d, 0x01, 0xab, 0xcc, 0x1e, 0xb5, 0x98, 0xcb, 0xd5,
-	0x0d, 0x98, 0x0c, 0xbd, 0x75, 0x9b, 0xb6, 0xe5, 0x32, 0x72, 0x05, 0x46, 0x3c, 0xf8, 0xd3, 0xca,
-	0x19, 0x65, 0x61, 0xac, 0x14, 0x44, 0xe5, 0xe7, 0xbc, 0xe8, 0xe9, 0xac, 0x1f, 0x7a, 0xfe, 0x72,
-	0x76, 0xa8, 0x8c, 0xf2, 0xea, 0x35, 0x78, 0x23, 0x60, 0x70, 0xbd, 0xfd, 0x61, 0xad, 0xc1, 0x5c,
-	0x4e, 0x1b, 0x4d, 0xf4, 0x48, 0x66, 0x60, 0x94, 0xfb, 0xef, 0x84, 0xf5, 0x83, 0xe5, 0xfd, 0x17,
-	0xea, 0x03, 0x28, 0x24, 0xa9, 0xff, 0x67, 0x68, 0xcb, 0x30, 0x25, 0x6c, 0x6f, 0xb4, 0xf8, 0xcd,
-	0xba, 0xbd, 0xed, 0xc7, 0x80, 0x4c, 0xc3, 0x61, 0x0c, 0xac, 0x30, 0x39, 0x5a, 0xf6, 0x1f, 0xd5,
-	0xfb, 0x70, 0x22, 0xa2, 0x81, 0x20, 0xde, 0x85, 0x51, 0x7f, 0x07, 0x74, 0x70, 0x1c, 0x5c, 0x18,
-	0x2b, 0x9d, 0x96, 0xe1, 0x40, 0x45, 0x04, 0x72, 0xc4, 0x46, 0x3b, 0xea, 0x1a, 0x9c, 0x16, 0x86,
-	0x6f, 0x31, 0x7e, 0x4f, 0xe4, 0xaa, 0x2c, 0x52, 0xd5, 0x1b, 0xd1, 0x16, 0xcc, 0xc8, 0x15, 0x11,
-	0xd8, 0xfb, 0x70, 0x2c, 0x94, 0x7c, 0x0c, 0xd2, 0x19, 0x19, 0xb8, 0xa0, 0x01, 0x44, 0x78, 0xd4,
-	0x0d, 0xbc, 0x53, 0x0d, 0x38, 0x25, 0x9c, 0x05, 0x05, 0xbb, 0x51, 0xbb, 0x09, 0xb0, 0x5f, 0xd9,
-	0xe8, 0x66, 0xae, 0x88, 0xd5, 0xdc, 0x39, 0x06, 0x8a, 0xde, 0x99, 0x81, 0xc7, 0x40, 0xf1, 0x0e,
-	0xad, 0x32, 0xd4, 0x2d, 0x07, 0x34, 0xd5, 0x67, 0x0a, 0xe4, 0x65, 0x5e, 0x90, 0xd0, 0x07, 0x30,
-	0x1e, 0x22, 0xe4, 0x87, 0x3b, 0x2b, 0xa3, 0x63, 0x41, 0x46, 0x2e, 0xb9, 0x15, 0x42, 0x7d, 0x40,
-	0xa0, 0x9e, 0xef, 0x89, 0xda, 0xc3, 0x12, 0x82, 0xbd, 0x06, 0xb3, 0xb8, 0x51, 0x85, 0xeb, 0xeb,
-	0x5e, 0x7e, 0xde, 0xeb, 0xfc, 0xf1, 0x23, 0x34, 0x05, 0xc3, 0xf6, 0xb6, 0xc5, 0x1c, 0xcc, 0xa1,
-	0xf7, 0xa0, 0x7e, 0xae, 0xc0, 0x99, 0x64, 0x4d, 0x64, 0x4d, 0xe1, 0x84, 0xb4, 0xe6, 0x31, 0xce,
-	0xf3, 0xf2, 0x3d, 0x1f, 0xb3, 0x87, 0x31, 0x98, 0x6c, 0xc6, 0x97, 0xd4, 0x4f, 0x93, 0x61, 0x0c,
-	0x3c, 0xc7, 0xbf, 0x29, 0x70, 0x36, 0xc5, 0x19, 0x92, 0x36, 0xe0, 0xa4, 0x94, 0xb4, 0x9f, 0xf2,
-	0x3e, 0x59, 0x4f, 0x49, 0x58, 0x0f, 0x70, 0x03, 0x2c, 0xe3, 0xb6, 0x0d, 0x03, 0xf0, 0x23, 0x47,
-	0xe0, 0x10, 0x35, 0x4d, 0x3f, 0xf5, 0xe2, 0xb7, 0xda, 0xc4, 0xa2, 0x8f, 0x6a, 0x20, 0xfd, 0xbb,
-	0x30, 0x11, 0xa1, 0x8f, 0x11, 0x57, 0x7b, 0xf3, 0x46, 0xca, 0xe3, 0x61, 0xca, 0x2a, 0x93, 0x7a,
-	0x1c, 0x78, 0x7a, 0x7f, 0x51, 0xf0, 0x54, 0x8a, 0xf9, 0x41, 0x6a, 0xf7, 0xe0, 0x78, 0x84, 0x9a,
-	0x9f, 0xd3, 0xec, 0xdc, 0x26, 0xc2, 0xdc, 0x06, 0x98, 0xc9, 0xcb, 0x98, 0xc9, 0x1b, 0x6d, 0x8b,
-	0x36, 0x6a, 0xc6, 0x3a, 0xad, 0x53, 0xcb, 0x60, 0xbd, 0xcf, 0xe2, 0x2f, 0x86, 0x31, 0xbc, 0x51,
-	0x45, 0x64, 0xcd, 0x60, 0xc2, 0xf4, 0x56, 0xf4, 0x8a, 0xb7, 0xe4, 0x59, 0x58, 0x7f, 0xa7, 0x43,
-	0xe8, 0xaf, 0x97, 0xb3, 0x73, 0xd5, 0x1a, 0xdf, 0x6c, 0x55, 0x8a, 0x86, 0xdd, 0xc0, 0x36, 0x08,
-	0xff, 0x2d, 0xb9, 0xe6, 0x96, 0xc6, 0xdb, 0x4d, 0xe6, 0x16, 0x6f, 0x5b, 0xfc, 0xf7, 0x67, 0x4b,
-	0x80, 0xb4, 0x6e, 0x5b, 0xbc, 0x3c, 0x6e, 0x86, 0xdc, 0xc5, 0x8f, 0xfc, 0x03, 0xaf, 0x7f, 0xe4,
-	0x93, 0x45, 0xc8, 0x19, 0x2d, 0xc7, 0xe9, 0x64, 0x6a, 0xff, 0x96, 0x3e, 0x28, 0x6e, 0xe9, 0xe3,
-	0xb8, 0xd0, 0xbd, 0x92, 0x89, 0x0e, 0x47, 0x2b, 0xd4, 0xda, 0xea, 0xb2, 0x3b, 0x34, 0x00, 0x76,
-	0x63, 0x1d, 0x8b, 0x3e, 0xb5, 0x1a, 0xe4, 0xe8, 0x63, 0x5a, 0xab, 0xd3, 0x4a, 0x9d, 0x75, 0xbd,
-	0x0c, 0x0f, 0xc0, 0xcb, 0xf1, 0xae, 0x59, 0xdf, 0xd5, 0x47, 0x00, 0x75, 0xdb, 0xd8, 0x62, 0xa6,
-	0xfe, 0x90, 0xb1, 0xe9, 0x91, 0x01, 0xf8, 0x18, 0xf5, 0xec, 0xdd, 0x64, 0x8c, 0x7c, 0x0c, 0x63,
-	0xc6, 0x26, 0xb5, 0xaa, 0x4c, 0x77, 0x28, 0x67, 0xd3, 0x87, 0x07, 0x60, 0x1d, 0x3c, 0x83, 0x65,
-	0xca, 0x99, 0xfa, 0x36, 0xa8, 0xb2, 0xf2, 0x5b, 0x6f, 0x6f, 0x74, 0x6e, 0x9c, 0xf4, 0xeb, 0x68,
-	0x03, 0xde, 0x4c, 0xd5, 0xc5, 0xbd, 0xbc, 0x00, 0xd1, 0xfa, 0x13, 0x05, 0x3c, 0x1a, 0x2b, 0x4b,
-	0xb5, 0x8a, 0x0d, 0xe0, 0xf5, 0x16, 0xb7, 0xef, 0x89, 0x0e, 0xfc, 0x7f, 0x6a, 0x1c, 0x7e, 0x55,
-	0xb0, 0x57, 0x94, 0x78, 0x42, 0xd4, 0x0f, 0x60, 0x32, 0x3e, 0x09, 0xf8, 0x47, 0xcf, 0x39, 0x59,
-	0x81, 0x44, 0x6d, 0x61, 0x91, 0xe4, 0x68, 0xd4, 0xc7, 0xe0, 0x8e, 0x9f, 0xab, 0x18, 0xb0, 0x1b,
-	0xde, 0x18, 0x72, 0xbf, 0x3b, 0x85, 0xf4, 0x3e, 0x81, 0x9e, 0xf8, 0x21, 0x90, 0xe8, 0x62, 0x08,
-	0x3e, 0x01, 0x12, 0x9f, 0x6f, 0x30, 0xea, 0x8b, 0xb2, 0x08, 0x48, 0x4c, 0x05, 0x03, 0x61, 0x46,
-	0x97, 0x4b, 0x3f, 0xe6, 0x60, 0x58, 0x80, 0x20, 0xbb, 0x30, 0xe2, 0x35, 0xde, 0x64, 0x4e, 0x66,
-	0x39, 0x3e, 0x7e, 0xe4, 0xe7, 0x7b, 0xca, 0x79, 0x34, 0x54, 0xf5, 0xc9, 0x1f, 0xff, 0x7c, 0x79,
-	0x60, 0x86, 0xe4, 0xb5, 0xc4, 0x49, 0x8b, 0x7c, 0xaf, 0x40, 0x2e, 0x36, 0x37, 0x90, 0x95, 0x1e,
-	0x2e, 0xe2, 0x23, 0x4a, 0xbe, 0xd4, 0x8f, 0x0a, 0x02, 0x2c, 0x0a, 0x80, 0x0b, 0x64, 0x2e, 0x19,
-	0xa0, 0xb6, 0xd3, 0x3d, 0x52, 0x77, 0xc9, 0x53, 0x05, 0x8e, 0xf8, 0x63, 0x05, 0x59, 0x48, 0x74,
-	0x18, 0x99, 0x55, 0xf2, 0xe7, 0x33, 0x48, 0x22, 0x22, 0x4d, 0x20, 0x3a, 0x4f, 0xe6, 0xb5, 0x94,
-	0xf9, 0xd5, 0xd5, 0x76, 0x70, 0x33, 0xed, 0x92, 0x6f, 0x15, 0x38, 0x1a, 0xbc, 0x20, 0x88, 0x96,
-	0xe8, 0x4c, 0x3e, 0xb7, 0xe4, 0x97, 0xb3, 0x2b, 0x20, 0xc8, 0x55, 0x01, 0x72, 0x89, 0x2c, 0x6a,
-	0xbd, 0xc6, 0xd8, 0x00, 0xd0, 0xaf, 0x14, 0x38, 0x16, 0x9a, 0x16, 0xc8, 0x52, 0xa2, 0x63, 0xd9,
-	0xec, 0x92, 0x2f, 0x66, 0x15, 0x47, 0x94, 0x17, 0x04, 0xca, 0x73, 0x44, 0xed, 0x89, 0xd2, 0x25,
-	0x3f, 0x2b, 0x30, 0x29, 0x69, 0x4a, 0xc9, 0x6a, 0xca, 0xa6, 0x4a, 0x1a, 0x21, 0xf2, 0x6f, 0xf5,
-	0xa7, 0x84, 0x70, 0xaf, 0x0a, 0xb8, 0xab, 0x64, 0x45, 0xcb, 0xfa, 0x2d, 0x41, 0xdb, 0x11, 0xb7,
-	0xc1, 0x2e, 0xf9, 0x49, 0x81, 0x29, 0x59, 0x93, 0x4e, 0xfa, 0x42, 0xd2, 0x0d, 0xf4, 0xa5, 0x3e,
-	0xb5, 0x90, 0x40, 0x49, 0x10, 0xb8, 0x48, 0x2e, 0x64, 0x26, 0xe0, 0x92, 0x6f, 0x14, 0x18, 0x0f,
-	0x1b, 0x25, 0xc5, 0x8c, 0xde, 0x7d, 0xb4, 0x5a, 0x66, 0xf9, 0xd7, 0xc0, 0xa9, 0xed, 0x74, 0x86,
-	0x80, 0x5d, 0xf2, 0xb5, 0x02, 0x13, 0x91, 0xcb, 0x96, 0x64, 0x75, 0xec, 0xf6, 0x2e, 0xb4, 0x84,
-	0x16, 0x5c, 0xbd, 0x28, 0xa0, 0xce, 0x91, 0x73, 0x19, 0xa0, 0xba, 0xe4, 0x3b, 0x05, 0xc6, 0xc3,
-	0x5d, 0x6d, 0x4a, 0x30, 0xa5, 0x7d, 0x73, 0x4a, 0x30, 0xe5, 0xed, 0xb2, 0x7a, 0x49, 0x20, 0xd4,
-	0xc8, 0x92, 0x0c, 0x61, 0xa4, 0x91, 0x0e, 0x1c, 0x06, 0xcf, 0x15, 0x38, 0x29, 0x6f, 0x5e, 0xc8,
-	0xe5, 0xac, 0x51, 0x0a, 0x77, 0x4a, 0xf9, 0xb5, 0xbe, 0xf5, 0x90, 0xc2, 0x35, 0x41, 0x61, 0x8d,
-	0x5c, 0xca, 0x12, 0x64, 0xbd, 0xd2, 0xd6, 0x45, 0xd5, 0x75, 0x8b, 0xef, 0x07, 0x05, 0x72, 0xb1,
-	0x66, 0x26, 0xe5, 0x02, 0x4b, 0x6a, 0xb1, 0x52, 0x2e, 0xb0, 0xc4, 0x5e, 0x29, 0xfd, 0xba, 0x90,
-	0x74, 0x51, 0xe4, 0x99, 0x02, 0xb9, 0x58, 0xb3, 0x90, 0x82, 0x36, 0xa9, 0xbf, 0x49, 0x41, 0x9b,
-	0xd8, 0xd6, 0xa8, 0x57, 0x04, 0xda, 0x12, 0x59, 0xd6, 0x32, 0x7d, 0xd0, 0xdd, 0xdf, 0x2f, 0xeb,
-	0xb7, 0x9f, 0xbf, 0x2a, 0x28, 0x2f, 0x5e, 0x15, 0x94, 0xbf, 0x5f, 0x15, 0x94, 0xa7, 0x7b, 0x85,
-	0xa1, 0x17, 0x7b, 0x85, 0xa1, 0x3f, 0xf7, 0x0a, 0x43, 0x0f, 0xb4, 0x40, 0x23, 0x5e, 0xb1, 0x2a,
-	0x4b, 0xc6, 0x26, 0xad, 0x59, 0x41, 0xfb, 0x9f, 0x75, 0x3d, 0x88, 0xae, 0xbc, 0x32, 0x22, 0xbe,
-	0xac, 0xae, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x73, 0x20, 0x3f, 0x0e, 0x31, 0x17, 0x00, 0x00,
+	// 1656 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcd, 0x6f, 0x1b, 0xd5,
+	0x16, 0xcf, 0xa4, 0x4d, 0xda, 0x9c, 0xb4, 0x49, 0x73, 0x93, 0x56, 0xa9, 0x9b, 0xe7, 0xb4, 0xf3,
+	0xaa, 0x24, 0x4d, 0x1a, 0x4f, 0x3e, 0x5e, 0x9a, 0xf6, 0xbd, 0x57, 0xa4, 0x86, 0xd2, 0x52, 0x01,
+	0x4a, 0xeb, 0x20, 0x55, 0x14, 0xa1, 0xe1, 0xda, 0x73, 0xeb, 0x98, 0xd8, 0x33, 0xae, 0x67, 0xdc,
+	0x60, 0x45, 0xd9, 0x14, 0xc1, 0xba, 0x12, 0x3b, 0x96, 0x48, 0x20, 0x04, 0x12, 0xab, 0x4a, 0x08,
+	0x01, 0x3b, 0x90, 0x2a, 0x16, 0xa8, 0xc0, 0x06, 0xb1, 0xa8, 0x50, 0xcb, 0x1f, 0x82, 0x7c, 0xe7,
+	0x5c, 0x67, 0x3e, 0xee, 0x8c, 0xc7, 0x61, 0xd8, 0x24, 0xf6, 0xcc, 0xf9, 0xf8, 0xfd, 0x7e, 0xf7,
+	0xeb, 0x9c, 0x6b, 0xc8, 0x96, 0xea, 0x8c, 0x99, 0x77, 0xcb, 0xac, 0x62, 0x68, 0x35, 0xda, 0xac,
+	0x32, 0xd3, 0xd1, 0xee, 0x35, 0x58, 0xbd, 0x99, 0xab, 0xd5, 0x2d, 0xc7, 0x22, 0x64, 0xef, 0x7d,
+	0x0e, 0xdf, 0x67, 0x66, 0x8b, 0x96, 0x5d, 0xb5, 0x6c, 0xad, 0x40, 0x6d, 0xe6, 0x1a, 0x6b, 0xf7,
+	0x17, 0x0b, 0xcc, 0xa1, 0x8b, 0x5a, 0x8d, 0x96, 0xca, 0x26, 0x75, 0xca, 0x96, 0xe9, 0xfa, 0x67,
+	0x4e, 0xba, 0xb6, 0x3a, 0xff, 0xa6, 0xb9, 0x5f, 0xf0, 0xd5, 0x58, 0xc9, 0x2a, 0x59, 0xee, 0xf3,
+	0xd6, 0x27, 0x7c, 0x3a, 0x51, 0xb2, 0xac, 0x52, 0x85, 0x69, 0xb4, 0x56, 0xd6, 0xa8, 0x69, 0x5a,
+	0x0e, 0x8f, 0x26, 0x7c, 0xe6, 0x24, 0x70, 0x69, 0xc3, 0xb1, 0x74, 0x9b, 0x39, 0x4e, 0x85, 0xe9,
+	0x75, 0x56, 0xb4, 0xea, 0x06, 0x1a, 0x2f, 0x49, 0x8c, 0x0d, 0x56, 0xa1, 0x4d, 0x66, 0xe8, 0xdb,
+	0x65, 0x67, 0xd3, 0xa8, 0xd3, 0x6d, 0x5a, 0xf1, 0xfb, 0x9c, 0x91, 0xf8, 0x58, 0x0d, 0x47, 0xbf,
+	0x5b, 0xb1, 0xb6, 0xd1, 0x64, 0x52, 0x62, 0x52, 0xa3, 0x75, 0x5a, 0x15, 0x20, 0x67, 0xa4, 0x06,
+	0xfc, 0xbf, 0x4e, 0x8b, 0x45, 0xab, 0x61, 0x3a, 0x68, 0x99, 0xeb, 0x6c, 0xa9, 0x7b, 0xed, 0x67,
+	0x25, 0xf6, 0x76, 0x71, 0x93, 0x19, 0x8d, 0x0a, 0x33, 0x74, 0x83, 0xd5, 0x2c, 0xbb, 0x2c, 0x6c,
+	0xa7, 0x64, 0xb6, 0x4e, 0x9d, 0xd1, 0xaa, 0x9f, 0x71, 0xae, 0x93, 0x9d, 0xbe, 0x59, 0xb6, 0x1d,
+	0x4b, 0xcc, 0x08, 0x75, 0x0c, 0xc8, 0xad, 0xd6, 0x98, 0xdf, 0xe4, 0x94, 0xf3, 0xec, 0x5e, 0x83,
+	0xd9, 0x8e, 0xba, 0x0e, 0xa3, 0xbe, 0xa7, 0x76, 0xcd, 0x32, 0x6d, 0x46, 0x2e, 0x42, 0xbf, 0x2b,
+	0xcd, 0xb8, 0x72, 0x5a, 0x99, 0x19, 0x5c, 0xf2, 0x66, 0x13, 0xf3, 0x29, 0xe7, 0xfa, 0xac, 0x1d,
+	0x7c, 0xfc, 0x74, 0xb2, 0x27, 0x8f, 0xf6, 0xea, 0x65, 0xf8, 0x97, 0x27, 0xe0, 0x5a, 0xf3, 0xf5,
+	0x72, 0x95, 0xd9, 0x0e, 0xad, 0xd6, 0x30, 0x23, 0x99, 0x80, 0x01, 0x47, 0x3c, 0xe3, 0xd1, 0x0f,
+	0xe4, 0xf7, 0x1e, 0xa8, 0x77, 0x20, 0x1b, 0xe5, 0xfe, 0xb7, 0xa1, 0x2d, 0xc0, 0x18, 0x8f, 0xbd,
+	0xde, 0x70, 0xae, 0x55, 0xac, 0x6d, 0xa1, 0x01, 0x19, 0x87, 0x43, 0x38, 0x68, 0x3c, 0xe4, 0x40,
+	0x5e, 0x7c, 0x55, 0x6f, 0xc3, 0xf1, 0x80, 0x07, 0x82, 0x78, 0x01, 0x06, 0xc4, 0xec, 0x6a, 0xe1,
+	0x38, 0x30, 0x33, 0xb8, 0x74, 0x4a, 0x86, 0x03, 0x1d, 0x11, 0xc8, 0x61, 0x0b, 0xe3, 0xa8, 0xab,
+	0x70, 0x8a, 0x07, 0xbe, 0xce, 0x9c, 0x0d, 0x3e, 0x66, 0x79, 0x3e, 0x64, 0x9d, 0x11, 0x6d, 0xc1,
+	0x84, 0xdc, 0x11, 0x81, 0xbd, 0x02, 0x47, 0x7d, 0x93, 0x00, 0x45, 0x3a, 0x2d, 0x03, 0xe7, 0x0d,
+	0x80, 0x08, 0x8f, 0xd8, 0x9e, 0x67, 0x6a, 0x11, 0x4e, 0xf2, 0x64, 0x5e, 0xc3, 0xb6, 0x6a, 0xd7,
+	0x00, 0xf6, 0x76, 0x0d, 0x4c, 0x33, 0x95, 0xc3, 0x9d, 0xa2, 0xb5, 0xc5, 0xe4, 0xdc, 0xfd, 0x08,
+	0xb7, 0x98, 0xdc, 0x4d, 0x5a, 0x62, 0xe8, 0x9b, 0xf7, 0x78, 0xaa, 0x8f, 0x14, 0xc8, 0xc8, 0xb2,
+	0x20, 0xa1, 0xd7, 0x60, 0xc8, 0x47, 0x48, 0xc8, 0x9d, 0x94, 0xd1, 0x51, 0x2f, 0x23, 0x9b, 0x5c,
+	0xf7, 0xa1, 0xee, 0xe5, 0xa8, 0xa7, 0x3b, 0xa2, 0x76, 0xb1, 0xf8, 0x60, 0xaf, 0xc2, 0x24, 0x4e,
+	0x54, 0x9e, 0xfa, 0x8a, 0x3b, 0x3e, 0x2f, 0xb6, 0xfe, 0x08, 0x85, 0xc6, 0xa0, 0xcf, 0xda, 0x36,
+	0x59, 0x1d, 0xc7, 0xd0, 0xfd, 0xa2, 0xbe, 0xaf, 0xc0, 0xe9, 0x68, 0x4f, 0x64, 0x4d, 0xe1, 0xb8,
+	0x74, 0x3f, 0x41, 0x9d, 0xa7, 0xe5, 0x73, 0x3e, 0x14, 0x0f, 0x35, 0x18, 0xad, 0x85, 0x5f, 0xa9,
+	0xef, 0x44, 0xc3, 0x48, 0x7d, 0x8c, 0x7f, 0x56, 0xe0, 0x4c, 0x4c, 0x32, 0x24, 0x5d, 0x84, 0x13,
+	0x52, 0xd2, 0x62, 0xc8, 0xbb, 0x64, 0x3d, 0x26, 0x61, 0x9d, 0xe2, 0x04, 0x58, 0xc0, 0x69, 0xeb,
+	0x07, 0x20, 0x94, 0x23, 0x70, 0x90, 0x1a, 0x86, 0x18, 0x7a, 0xfe, 0x59, 0xad, 0xe1, 0xa2, 0x0f,
+	0x7a, 0x20, 0xfd, 0x5b, 0x30, 0x1c, 0xa0, 0x8f, 0x8a, 0xab, 0x9d, 0x79, 0x23, 0xe5, 0x21, 0x3f,
+	0x65, 0x95, 0x49, 0x33, 0xa6, 0x3e, 0xbc, 0xdf, 0x2a, 0xb8, 0x2b, 0x85, 0xf2, 0x20, 0xb5, 0x0d,
+	0x38, 0x16, 0xa0, 0x26, 0xc6, 0x34, 0x39, 0xb7, 0x61, 0x3f, 0xb7, 0x14, 0x47, 0xf2, 0x02, 0x8e,
+	0xe4, 0xd5, 0xa6, 0x49, 0xab, 0xe5, 0xe2, 0x1a, 0xad, 0x50, 0xb3, 0xc8, 0x3a, 0xef, 0xc5, 0x1f,
+	0xf4, 0xa1, 0xbc, 0x41, 0x47, 0x64, 0xcd, 0x60, 0xd8, 0x70, 0xdf, 0xe8, 0x05, 0xf7, 0x95, 0x1b,
+	0x61, 0xed, 0xff, 0x2d, 0x42, 0xbf, 0x3f, 0x9d, 0x9c, 0x2a, 0x95, 0x9d, 0xcd, 0x46, 0x21, 0x57,
+	0xb4, 0xaa, 0x58, 0x62, 0xe1, 0xbf, 0x79, 0xdb, 0xd8, 0xd2, 0x9c, 0x66, 0x8d, 0xd9, 0xb9, 0x1b,
+	0xa6, 0xf3, 0xcb, 0xa3, 0x79, 0x40, 0x5a, 0x37, 0x4c, 0x27, 0x3f, 0x64, 0xf8, 0xd2, 0x85, 0xb7,
+	0xfc, 0xde, 0xfd, 0x6f, 0xf9, 0x64, 0x0e, 0x46, 0x8a, 0x8d, 0x7a, 0xbd, 0x35, 0x52, 0x7b, 0xa7,
+	0xf4, 0x01, 0x7e, 0x4a, 0x1f, 0xc3, 0x17, 0xed, 0x23, 0x99, 0xe8, 0x70, 0xa4, 0x40, 0xcd, 0xad,
+	0x36, 0xbb, 0x83, 0x29, 0xb0, 0x1b, 0x6c, 0x45, 0x14, 0xd4, 0xca, 0x30, 0x42, 0xef, 0xd3, 0x72,
+	0x85, 0x16, 0x2a, 0xac, 0x9d, 0xa5, 0x2f, 0x85, 0x2c, 0xc7, 0xda, 0x61, 0x45, 0xaa, 0x37, 0x01,
+	0x2a, 0x56, 0x71, 0x8b, 0x19, 0xfa, 0x5d, 0xc6, 0xc6, 0xfb, 0x53, 0xc8, 0x31, 0xe0, 0xc6, 0xbb,
+	0xc6, 0x18, 0x79, 0x0b, 0x06, 0x8b, 0x9b, 0xd4, 0x2c, 0x31, 0xbd, 0x4e, 0x1d, 0x36, 0x7e, 0x28,
+	0x85, 0xe8, 0xe0, 0x06, 0xcc, 0x53, 0x87, 0xa9, 0xff, 0x05, 0x55, 0xb6, 0xfc, 0xd6, 0x9a, 0xeb,
+	0xad, 0x13, 0x27, 0xfe, 0x38, 0x5a, 0x87, 0x7f, 0xc7, 0xfa, 0xe2, 0x5c, 0x9e, 0x81, 0xe0, 0xfa,
+	0xe3, 0x0b, 0x78, 0x20, 0xb4, 0x2c, 0xd5, 0x12, 0x16, 0x80, 0x57, 0x1a, 0x8e, 0xb5, 0xc1, 0xab,
+	0xfb, 0x7f, 0xa8, 0x70, 0xf8, 0x41, 0xc1, 0x5a, 0x51, 0x92, 0x09, 0x51, 0xdf, 0x81, 0xd1, 0x70,
+	0x97, 0x21, 0xb6, 0x9e, 0xb3, 0xb2, 0x05, 0x12, 0x8c, 0x85, 0x8b, 0x64, 0x84, 0x06, 0x73, 0xa4,
+	0xb7, 0xfd, 0x5c, 0x42, 0xc1, 0xae, 0xba, 0x2d, 0xce, 0xed, 0x76, 0x87, 0xd3, 0x79, 0x07, 0x7a,
+	0x20, 0x24, 0x90, 0xf8, 0xa2, 0x04, 0x6f, 0x03, 0x09, 0xf7, 0x4e, 0xa8, 0xfa, 0x9c, 0x4c, 0x01,
+	0x49, 0x28, 0xaf, 0x10, 0x46, 0xf0, 0xb5, 0xba, 0x19, 0x85, 0x21, 0xf5, 0x11, 0xff, 0x49, 0xc1,
+	0xa2, 0x4b, 0x96, 0x0a, 0xf9, 0x16, 0x60, 0x34, 0xcc, 0x57, 0x0c, 0xf9, 0x3e, 0x08, 0x93, 0x10,
+	0xe1, 0x14, 0x87, 0x7e, 0x17, 0x87, 0x7e, 0x43, 0xf4, 0x82, 0x57, 0xdd, 0x56, 0xd0, 0x8e, 0x5d,
+	0xb3, 0x01, 0x3d, 0x7b, 0xf7, 0xad, 0xe7, 0xf7, 0x62, 0xfa, 0x48, 0xf2, 0xa3, 0x9c, 0x6f, 0x00,
+	0x09, 0x35, 0xaa, 0xb1, 0x0b, 0x28, 0x18, 0x4a, 0xcc, 0x1b, 0x3b, 0x98, 0x22, 0x3d, 0x15, 0xdf,
+	0x13, 0xd3, 0xc2, 0x7b, 0xba, 0xbd, 0xec, 0x36, 0xbf, 0x1d, 0xd7, 0x50, 0x6a, 0x62, 0x7e, 0x2d,
+	0xea, 0x7a, 0x29, 0x0a, 0x94, 0xf3, 0x55, 0x38, 0xc4, 0x4c, 0xa7, 0x5e, 0x66, 0x42, 0xc3, 0xf3,
+	0x9d, 0x4e, 0x69, 0x8c, 0xf0, 0x92, 0xe9, 0xd4, 0x9b, 0xa8, 0xa5, 0x08, 0x91, 0x9a, 0x82, 0x4b,
+	0x3f, 0x1e, 0x87, 0x3e, 0x8e, 0x9d, 0xec, 0x42, 0xbf, 0xdb, 0x3b, 0x93, 0x29, 0x19, 0xb2, 0xf0,
+	0x0d, 0x42, 0x66, 0xba, 0xa3, 0x9d, 0x9b, 0x50, 0x55, 0x1f, 0xfc, 0xfa, 0xe7, 0x87, 0xbd, 0x13,
+	0x24, 0xa3, 0x45, 0x5e, 0xc4, 0x90, 0xcf, 0x15, 0x18, 0x09, 0xb5, 0xfe, 0x64, 0xb1, 0x43, 0x8a,
+	0xf0, 0x2d, 0x43, 0x66, 0xa9, 0x1b, 0x17, 0x04, 0x98, 0xe3, 0x00, 0x67, 0xc8, 0x54, 0x34, 0x40,
+	0x6d, 0xa7, 0x5d, 0x15, 0xed, 0x92, 0x87, 0x0a, 0x1c, 0x16, 0x37, 0x03, 0x64, 0x26, 0x32, 0x61,
+	0xe0, 0xba, 0x21, 0x73, 0x2e, 0x81, 0x25, 0x22, 0xd2, 0x38, 0xa2, 0x73, 0x64, 0x5a, 0x8b, 0xb9,
+	0xde, 0xb2, 0xb5, 0x1d, 0x9c, 0xcb, 0xbb, 0xe4, 0x53, 0x05, 0x8e, 0x78, 0x67, 0x0f, 0xd1, 0x22,
+	0x93, 0xc9, 0xaf, 0x1e, 0x32, 0x0b, 0xc9, 0x1d, 0x10, 0xe4, 0x32, 0x07, 0x39, 0x4f, 0xe6, 0xb4,
+	0x4e, 0x37, 0x52, 0x1e, 0xa0, 0x1f, 0x29, 0x70, 0xd4, 0xd7, 0xf0, 0x93, 0xf9, 0xc8, 0xc4, 0xb2,
+	0xeb, 0x87, 0x4c, 0x2e, 0xa9, 0x39, 0xa2, 0x9c, 0xe5, 0x28, 0xcf, 0x12, 0xb5, 0x23, 0x4a, 0x9b,
+	0x7c, 0xa3, 0xc0, 0xa8, 0xa4, 0xaf, 0x24, 0xcb, 0x31, 0x93, 0x2a, 0xea, 0x16, 0x20, 0xf3, 0x9f,
+	0xee, 0x9c, 0x10, 0xee, 0x25, 0x0e, 0x77, 0x99, 0x2c, 0x6a, 0x49, 0xaf, 0x1a, 0xb5, 0x1d, 0x7e,
+	0x38, 0xec, 0x92, 0xaf, 0x14, 0x18, 0x93, 0xf5, 0xd9, 0xa4, 0x2b, 0x24, 0x6d, 0xa1, 0x57, 0xba,
+	0xf4, 0x42, 0x02, 0x4b, 0x9c, 0xc0, 0x79, 0x32, 0x9b, 0x98, 0x80, 0x4d, 0x3e, 0x51, 0x60, 0xc8,
+	0x1f, 0x94, 0xe4, 0x12, 0x66, 0x17, 0x68, 0xb5, 0xc4, 0xf6, 0xfb, 0xc0, 0xa9, 0xed, 0xb4, 0xfa,
+	0xf8, 0x5d, 0xf2, 0xb1, 0x02, 0xc3, 0x81, 0x7a, 0x99, 0x24, 0x4d, 0x6c, 0x77, 0x5e, 0x68, 0x11,
+	0x5d, 0xb4, 0x7a, 0x9e, 0x43, 0x9d, 0x22, 0x67, 0x13, 0x40, 0xb5, 0xc9, 0x67, 0x0a, 0x0c, 0xf9,
+	0x1b, 0xd3, 0x18, 0x31, 0xa5, 0xad, 0x6f, 0x8c, 0x98, 0xf2, 0x8e, 0x57, 0x5d, 0xe1, 0x08, 0x35,
+	0x32, 0x2f, 0x43, 0x18, 0xe8, 0x85, 0x3d, 0x9b, 0xc1, 0x63, 0x05, 0x4e, 0xc8, 0xfb, 0x0f, 0x72,
+	0x21, 0xa9, 0x4a, 0xfe, 0x66, 0x27, 0xb3, 0xda, 0xb5, 0x1f, 0x52, 0xb8, 0xcc, 0x29, 0xac, 0x92,
+	0x95, 0x24, 0x22, 0xeb, 0x85, 0xa6, 0xce, 0x57, 0x5d, 0x7b, 0xf1, 0x7d, 0xa1, 0xc0, 0x48, 0xa8,
+	0x1f, 0x89, 0x39, 0xc0, 0xa2, 0xba, 0xa4, 0x98, 0x03, 0x2c, 0xb2, 0xdd, 0x89, 0x3f, 0x2e, 0x24,
+	0x8d, 0x10, 0x79, 0xa4, 0xc0, 0x48, 0xa8, 0xfc, 0x8d, 0x41, 0x1b, 0xd5, 0xa2, 0xc4, 0xa0, 0x8d,
+	0xec, 0x4c, 0xd4, 0x8b, 0x1c, 0xed, 0x12, 0x59, 0xd0, 0x12, 0xfd, 0xde, 0xe3, 0x99, 0x2f, 0x5f,
+	0x2a, 0x40, 0xc2, 0x2d, 0x00, 0xe9, 0x02, 0x44, 0x5b, 0xe6, 0xe5, 0xae, 0x7c, 0x92, 0xe8, 0x2c,
+	0xe9, 0x3e, 0x78, 0x59, 0x13, 0xaa, 0xb1, 0x63, 0x74, 0x8e, 0xea, 0x07, 0x62, 0x74, 0x8e, 0x2c,
+	0xe1, 0xe3, 0xcb, 0x9a, 0x70, 0x71, 0x4f, 0xbe, 0x53, 0x60, 0x54, 0x52, 0x81, 0xc6, 0x9c, 0x7e,
+	0xd1, 0x75, 0x77, 0xcc, 0xe9, 0x17, 0x53, 0x26, 0xab, 0xff, 0xe3, 0x90, 0x57, 0xc8, 0xb2, 0x96,
+	0xf4, 0x47, 0xae, 0xbd, 0xd9, 0xb1, 0x76, 0xe3, 0xf1, 0xb3, 0xac, 0xf2, 0xe4, 0x59, 0x56, 0xf9,
+	0xe3, 0x59, 0x56, 0x79, 0xf8, 0x3c, 0xdb, 0xf3, 0xe4, 0x79, 0xb6, 0xe7, 0xb7, 0xe7, 0xd9, 0x9e,
+	0x3b, 0x9a, 0xe7, 0xa6, 0xa5, 0x60, 0x16, 0xe6, 0x8b, 0x9b, 0xb4, 0x6c, 0x7a, 0x53, 0xbc, 0xdb,
+	0x4e, 0xc2, 0xaf, 0x5d, 0x0a, 0xfd, 0xfc, 0xa7, 0xb3, 0xe5, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff,
+	0xcd, 0x5b, 0x7a, 0x7f, 0x6e, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1389,6 +1715,12 @@ type QueryClient interface {
 	AutoSettleRecords(ctx context.Context, in *QueryAutoSettleRecordsRequest, opts ...grpc.CallOption) (*QueryAutoSettleRecordsResponse, error)
 	// Queries delayed withdrawal of a account.
 	DelayedWithdrawal(ctx context.Context, in *QueryDelayedWithdrawalRequest, opts ...grpc.CallOption) (*QueryDelayedWithdrawalResponse, error)
+	// Queries all delayed withdrawals.
+	DelayedWithdrawals(ctx context.Context, in *QueryDelayedWithdrawalsRequest, opts ...grpc.CallOption) (*QueryDelayedWithdrawalsResponse, error)
+	// Queries all scheduled deposits, or the ones of a specific owner.
+	ScheduledDeposits(ctx context.Context, in *QueryScheduledDepositsRequest, opts ...grpc.CallOption) (*QueryScheduledDepositsResponse, error)
+	// Queries the recent change history of a stream record.
+	StreamRecordHistory(ctx context.Context, in *QueryStreamRecordHistoryRequest, opts ...grpc.CallOption) (*QueryStreamRecordHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -1516,6 +1848,33 @@ func (c *queryClient) DelayedWithdrawal(ctx context.Context, in *QueryDelayedWit
 	return out, nil
 }
 
+func (c *queryClient) DelayedWithdrawals(ctx context.Context, in *QueryDelayedWithdrawalsRequest, opts ...grpc.CallOption) (*QueryDelayedWithdrawalsResponse, error) {
+	out := new(QueryDelayedWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/DelayedWithdrawals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledDeposits(ctx context.Context, in *QueryScheduledDepositsRequest, opts ...grpc.CallOption) (*QueryScheduledDepositsResponse, error) {
+	out := new(QueryScheduledDepositsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/ScheduledDeposits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamRecordHistory(ctx context.Context, in *QueryStreamRecordHistoryRequest, opts ...grpc.CallOption) (*QueryStreamRecordHistoryResponse, error) {
+	out := new(QueryStreamRecordHistoryResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/StreamRecordHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -1544,6 +1903,12 @@ type QueryServer interface {
 	AutoSettleRecords(context.Context, *QueryAutoSettleRecordsRequest) (*QueryAutoSettleRecordsResponse, error)
 	// Queries delayed withdrawal of a account.
 	DelayedWithdrawal(context.Context, *QueryDelayedWithdrawalRequest) (*QueryDelayedWithdrawalResponse, error)
+	// Queries all delayed withdrawals.
+	DelayedWithdrawals(context.Context, *QueryDelayedWithdrawalsRequest) (*QueryDelayedWithdrawalsResponse, error)
+	// Queries all scheduled deposits, or the ones of a specific owner.
+	ScheduledDeposits(context.Context, *QueryScheduledDepositsRequest) (*QueryScheduledDepositsResponse, error)
+	// Queries the recent change history of a stream record.
+	StreamRecordHistory(context.Context, *QueryStreamRecordHistoryRequest) (*QueryStreamRecordHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1589,6 +1954,15 @@ func (*UnimplementedQueryServer) AutoSettleRecords(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) DelayedWithdrawal(ctx context.Context, req *QueryDelayedWithdrawalRequest) (*QueryDelayedWithdrawalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelayedWithdrawal not implemented")
 }
+func (*UnimplementedQueryServer) DelayedWithdrawals(ctx context.Context, req *QueryDelayedWithdrawalsRequest) (*QueryDelayedWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelayedWithdrawals not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledDeposits(ctx context.Context, req *QueryScheduledDepositsRequest) (*QueryScheduledDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledDeposits not implemented")
+}
+func (*UnimplementedQueryServer) StreamRecordHistory(ctx context.Context, req *QueryStreamRecordHistoryRequest) (*QueryStreamRecordHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamRecordHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1828,6 +2202,60 @@ func _Query_DelayedWithdrawal_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelayedWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelayedWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelayedWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/DelayedWithdrawals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelayedWithdrawals(ctx, req.(*QueryDelayedWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/ScheduledDeposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledDeposits(ctx, req.(*QueryScheduledDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamRecordHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamRecordHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamRecordHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/StreamRecordHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamRecordHistory(ctx, req.(*QueryStreamRecordHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "greenfield.payment.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1884,11 +2312,23 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelayedWithdrawal",
 			Handler:    _Query_DelayedWithdrawal_Handler,
 		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "greenfield/payment/query.proto",
-}
-
+		{
+			MethodName: "DelayedWithdrawals",
+			Handler:    _Query_DelayedWithdrawals_Handler,
+		},
+		{
+			MethodName: "ScheduledDeposits",
+			Handler:    _Query_ScheduledDeposits_Handler,
+		},
+		{
+			MethodName: "StreamRecordHistory",
+			Handler:    _Query_StreamRecordHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "greenfield/payment/query.proto",
+}
+
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2841,6 +3281,272 @@ func (m *QueryDelayedWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDelayedWithdrawalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedWithdrawalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedWithdrawalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayedWithdrawalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedWithdrawalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedWithdrawalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelayedWithdrawals) > 0 {
+		for iNdEx := len(m.DelayedWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelayedWithdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledDepositsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduledDeposits) > 0 {
+		for iNdEx := len(m.ScheduledDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamRecordHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamRecordHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamRecordHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamRecordHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamRecordHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamRecordHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3212,12 +3918,116 @@ func (m *QueryDelayedWithdrawalResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
+func (m *QueryDelayedWithdrawalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelayedWithdrawalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DelayedWithdrawals) > 0 {
+		for _, e := range m.DelayedWithdrawals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScheduledDeposits) > 0 {
+		for _, e := range m.ScheduledDeposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStreamRecordHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStreamRecordHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5671,6 +6481,688 @@ func (m *QueryDelayedWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDelayedWithdrawalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelayedWithdrawalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelayedWithdrawalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelayedWithdrawalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelayedWithdrawalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelayedWithdrawalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedWithdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelayedWithdrawals = append(m.DelayedWithdrawals, DelayedWithdrawalRecord{})
+			if err := m.DelayedWithdrawals[len(m.DelayedWithdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledDeposits = append(m.ScheduledDeposits, ScheduledDeposit{})
+			if err := m.ScheduledDeposits[len(m.ScheduledDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamRecordHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamRecordHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamRecordHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamRecordHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamRecordHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamRecordHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, StreamRecordHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
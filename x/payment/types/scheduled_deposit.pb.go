@@ -0,0 +1,654 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: greenfield/payment/scheduled_deposit.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ScheduledDeposit is the record keeps the recurring deposit information.
+// The EndBlocker of payment module will scan the due ScheduledDeposits
+// and deposit the amount from the owner's bank account to the stream account.
+type ScheduledDeposit struct {
+	// id is the unique identifier of the scheduled deposit
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the account which authorizes the recurring deposit
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// to is the address of the stream account to deposit to
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// amount is the amount to deposit in each execution
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// interval is the duration in seconds between two executions
+	Interval uint64 `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	// next_execution_time is the unix timestamp of the next execution
+	NextExecutionTime int64 `protobuf:"varint,6,opt,name=next_execution_time,json=nextExecutionTime,proto3" json:"next_execution_time,omitempty"`
+	// max_executions is the total number of successful executions, 0 means no limit
+	MaxExecutions uint64 `protobuf:"varint,7,opt,name=max_executions,json=maxExecutions,proto3" json:"max_executions,omitempty"`
+	// executions is the number of successful executions
+	Executions uint64 `protobuf:"varint,8,opt,name=executions,proto3" json:"executions,omitempty"`
+	// failed_executions is the number of failed executions, which are not counted against max_executions
+	FailedExecutions uint64 `protobuf:"varint,9,opt,name=failed_executions,json=failedExecutions,proto3" json:"failed_executions,omitempty"`
+}
+
+func (m *ScheduledDeposit) Reset()         { *m = ScheduledDeposit{} }
+func (m *ScheduledDeposit) String() string { return proto.CompactTextString(m) }
+func (*ScheduledDeposit) ProtoMessage()    {}
+func (*ScheduledDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6d4656c9d3879da, []int{0}
+}
+func (m *ScheduledDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledDeposit.Merge(m, src)
+}
+func (m *ScheduledDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledDeposit proto.InternalMessageInfo
+
+func (m *ScheduledDeposit) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduledDeposit) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ScheduledDeposit) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *ScheduledDeposit) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *ScheduledDeposit) GetNextExecutionTime() int64 {
+	if m != nil {
+		return m.NextExecutionTime
+	}
+	return 0
+}
+
+func (m *ScheduledDeposit) GetMaxExecutions() uint64 {
+	if m != nil {
+		return m.MaxExecutions
+	}
+	return 0
+}
+
+func (m *ScheduledDeposit) GetExecutions() uint64 {
+	if m != nil {
+		return m.Executions
+	}
+	return 0
+}
+
+func (m *ScheduledDeposit) GetFailedExecutions() uint64 {
+	if m != nil {
+		return m.FailedExecutions
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ScheduledDeposit)(nil), "greenfield.payment.ScheduledDeposit")
+}
+
+func init() {
+	proto.RegisterFile("greenfield/payment/scheduled_deposit.proto", fileDescriptor_a6d4656c9d3879da)
+}
+
+var fileDescriptor_a6d4656c9d3879da = []byte{
+	// 392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x3f, 0x8f, 0xd3, 0x30,
+	0x14, 0xc0, 0x93, 0xf4, 0xae, 0xdc, 0x59, 0xe2, 0x74, 0x67, 0x6e, 0x30, 0x1d, 0x72, 0x15, 0x12,
+	0x28, 0x02, 0x25, 0x19, 0x58, 0x59, 0xa8, 0x60, 0xe8, 0x9a, 0x76, 0x62, 0x89, 0x92, 0xf8, 0x35,
+	0xb5, 0xa8, 0xed, 0x28, 0x76, 0x20, 0xfd, 0x16, 0x88, 0xcf, 0xd2, 0x0f, 0xd1, 0xb1, 0xea, 0x84,
+	0x18, 0x2a, 0xd4, 0x7e, 0x11, 0x54, 0x27, 0x6d, 0xb3, 0xdd, 0x94, 0xbc, 0xf7, 0x7e, 0xfa, 0x59,
+	0xef, 0x0f, 0x7a, 0x9f, 0x97, 0x00, 0x62, 0xc6, 0x60, 0x41, 0xc3, 0x22, 0x59, 0x72, 0x10, 0x3a,
+	0x54, 0xd9, 0x1c, 0x68, 0xb5, 0x00, 0x1a, 0x53, 0x28, 0xa4, 0x62, 0x3a, 0x28, 0x4a, 0xa9, 0x25,
+	0xc6, 0x17, 0x36, 0x68, 0xd9, 0xc1, 0xeb, 0x4c, 0x2a, 0x2e, 0x55, 0x6c, 0x88, 0xb0, 0x09, 0x1a,
+	0x7c, 0xf0, 0x98, 0xcb, 0x5c, 0x36, 0xf9, 0xe3, 0x5f, 0x93, 0x7d, 0xf3, 0xbb, 0x87, 0xee, 0x27,
+	0xa7, 0x07, 0xbe, 0x34, 0x7e, 0x7c, 0x87, 0x1c, 0x46, 0x89, 0x3d, 0xb4, 0xbd, 0xab, 0xc8, 0x61,
+	0x14, 0x07, 0xe8, 0x5a, 0xfe, 0x14, 0x50, 0x12, 0x67, 0x68, 0x7b, 0xb7, 0x23, 0xb2, 0x5d, 0xf9,
+	0x8f, 0xad, 0xfb, 0x33, 0xa5, 0x25, 0x28, 0x35, 0xd1, 0x25, 0x13, 0x79, 0xd4, 0x60, 0xd8, 0x43,
+	0x8e, 0x96, 0xa4, 0xf7, 0x0c, 0xec, 0x68, 0x89, 0xa7, 0xa8, 0x9f, 0x70, 0x59, 0x09, 0x4d, 0xae,
+	0x0c, 0xfd, 0x69, 0xbd, 0x7b, 0xb2, 0xfe, 0xee, 0x9e, 0xde, 0xe5, 0x4c, 0xcf, 0xab, 0x34, 0xc8,
+	0x24, 0x6f, 0xbb, 0x68, 0x3f, 0xbe, 0xa2, 0xdf, 0x43, 0xbd, 0x2c, 0x40, 0x05, 0x63, 0xa1, 0xb7,
+	0x2b, 0x1f, 0xb5, 0xee, 0xb1, 0xd0, 0x51, 0xeb, 0xc2, 0x03, 0x74, 0xc3, 0x84, 0x86, 0xf2, 0x47,
+	0xb2, 0x20, 0xd7, 0xa6, 0x8b, 0x73, 0x8c, 0x03, 0xf4, 0x4a, 0x40, 0xad, 0x63, 0xa8, 0x21, 0xab,
+	0x34, 0x93, 0x22, 0xd6, 0x8c, 0x03, 0xe9, 0x0f, 0x6d, 0xaf, 0x17, 0x3d, 0x1c, 0x4b, 0x5f, 0x4f,
+	0x95, 0x29, 0xe3, 0x80, 0xdf, 0xa2, 0x3b, 0x9e, 0xd4, 0x17, 0x5c, 0x91, 0x17, 0xc6, 0xf8, 0x92,
+	0x27, 0xf5, 0x99, 0x54, 0xd8, 0x45, 0xa8, 0x83, 0xdc, 0x18, 0xa4, 0x93, 0xc1, 0x1f, 0xd0, 0xc3,
+	0x2c, 0x61, 0xc7, 0x25, 0x76, 0xb0, 0x5b, 0x83, 0xdd, 0x37, 0x85, 0x8b, 0x6c, 0x34, 0x5e, 0xef,
+	0x5d, 0x7b, 0xb3, 0x77, 0xed, 0x7f, 0x7b, 0xd7, 0xfe, 0x75, 0x70, 0xad, 0xcd, 0xc1, 0xb5, 0xfe,
+	0x1c, 0x5c, 0xeb, 0x5b, 0xd8, 0x99, 0x4b, 0x2a, 0x52, 0x3f, 0x9b, 0x27, 0x4c, 0x84, 0x9d, 0xa3,
+	0xa9, 0xcf, 0x67, 0x63, 0x86, 0x94, 0xf6, 0xcd, 0x9a, 0x3f, 0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff,
+	0x13, 0x44, 0x5f, 0x16, 0x59, 0x02, 0x00, 0x00,
+}
+
+func (m *ScheduledDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedExecutions != 0 {
+		i = encodeVarintScheduledDeposit(dAtA, i, uint64(m.FailedExecutions))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Executions != 0 {
+		i = encodeVarintScheduledDeposit(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxExecutions != 0 {
+		i = encodeVarintScheduledDeposit(dAtA, i, uint64(m.MaxExecutions))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NextExecutionTime != 0 {
+		i = encodeVarintScheduledDeposit(dAtA, i, uint64(m.NextExecutionTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Interval != 0 {
+		i = encodeVarintScheduledDeposit(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScheduledDeposit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintScheduledDeposit(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintScheduledDeposit(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintScheduledDeposit(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScheduledDeposit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScheduledDeposit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScheduledDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovScheduledDeposit(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovScheduledDeposit(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovScheduledDeposit(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovScheduledDeposit(uint64(l))
+	if m.Interval != 0 {
+		n += 1 + sovScheduledDeposit(uint64(m.Interval))
+	}
+	if m.NextExecutionTime != 0 {
+		n += 1 + sovScheduledDeposit(uint64(m.NextExecutionTime))
+	}
+	if m.MaxExecutions != 0 {
+		n += 1 + sovScheduledDeposit(uint64(m.MaxExecutions))
+	}
+	if m.Executions != 0 {
+		n += 1 + sovScheduledDeposit(uint64(m.Executions))
+	}
+	if m.FailedExecutions != 0 {
+		n += 1 + sovScheduledDeposit(uint64(m.FailedExecutions))
+	}
+	return n
+}
+
+func sovScheduledDeposit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScheduledDeposit(x uint64) (n int) {
+	return sovScheduledDeposit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ScheduledDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledDeposit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledDeposit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledDeposit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledDeposit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecutionTime", wireType)
+			}
+			m.NextExecutionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextExecutionTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutions", wireType)
+			}
+			m.MaxExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedExecutions", wireType)
+			}
+			m.FailedExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledDeposit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledDeposit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipScheduledDeposit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowScheduledDeposit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduledDeposit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduledDeposit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthScheduledDeposit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupScheduledDeposit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthScheduledDeposit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthScheduledDeposit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowScheduledDeposit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupScheduledDeposit = fmt.Errorf("proto: unexpected end of group")
+)
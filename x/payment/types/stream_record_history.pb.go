@@ -0,0 +1,837 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: greenfield/payment/stream_record_history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StreamRecordChangeReason defines the reason why a stream record is changed
+type StreamRecordChangeReason int32
+
+const (
+	// STREAM_RECORD_CHANGE_REASON_UNSPECIFIED defines a change without a known reason.
+	STREAM_RECORD_CHANGE_REASON_UNSPECIFIED StreamRecordChangeReason = 0
+	// STREAM_RECORD_CHANGE_REASON_DEPOSIT defines a deposit to the stream account.
+	STREAM_RECORD_CHANGE_REASON_DEPOSIT StreamRecordChangeReason = 1
+	// STREAM_RECORD_CHANGE_REASON_WITHDRAW defines a withdrawal from the stream account.
+	STREAM_RECORD_CHANGE_REASON_WITHDRAW StreamRecordChangeReason = 2
+	// STREAM_RECORD_CHANGE_REASON_CANCEL_WITHDRAWAL defines the cancellation of a delayed withdrawal.
+	STREAM_RECORD_CHANGE_REASON_CANCEL_WITHDRAWAL StreamRecordChangeReason = 3
+	// STREAM_RECORD_CHANGE_REASON_SETTLE defines a settlement of the stream account.
+	STREAM_RECORD_CHANGE_REASON_SETTLE StreamRecordChangeReason = 4
+	// STREAM_RECORD_CHANGE_REASON_FORCE_SETTLE defines a forced settlement, the stream account will be frozen.
+	STREAM_RECORD_CHANGE_REASON_FORCE_SETTLE StreamRecordChangeReason = 5
+	// STREAM_RECORD_CHANGE_REASON_RESUME defines the resuming of a frozen stream account.
+	STREAM_RECORD_CHANGE_REASON_RESUME StreamRecordChangeReason = 6
+	// STREAM_RECORD_CHANGE_REASON_BUCKET_FLOW_CHANGE defines a change of the flows of a bucket,
+	// the bucket name is recorded as the reference.
+	STREAM_RECORD_CHANGE_REASON_BUCKET_FLOW_CHANGE StreamRecordChangeReason = 7
+	// STREAM_RECORD_CHANGE_REASON_OBJECT_LOCK_FEE defines the locking or unlocking of the store fee of an object,
+	// the bucket name is recorded as the reference.
+	STREAM_RECORD_CHANGE_REASON_OBJECT_LOCK_FEE StreamRecordChangeReason = 8
+	// STREAM_RECORD_CHANGE_REASON_EARLY_DELETION_FEE defines the charge of an object deleted before the minimum
+	// storage time, the bucket name is recorded as the reference.
+	STREAM_RECORD_CHANGE_REASON_EARLY_DELETION_FEE StreamRecordChangeReason = 9
+)
+
+var StreamRecordChangeReason_name = map[int32]string{
+	0: "STREAM_RECORD_CHANGE_REASON_UNSPECIFIED",
+	1: "STREAM_RECORD_CHANGE_REASON_DEPOSIT",
+	2: "STREAM_RECORD_CHANGE_REASON_WITHDRAW",
+	3: "STREAM_RECORD_CHANGE_REASON_CANCEL_WITHDRAWAL",
+	4: "STREAM_RECORD_CHANGE_REASON_SETTLE",
+	5: "STREAM_RECORD_CHANGE_REASON_FORCE_SETTLE",
+	6: "STREAM_RECORD_CHANGE_REASON_RESUME",
+	7: "STREAM_RECORD_CHANGE_REASON_BUCKET_FLOW_CHANGE",
+	8: "STREAM_RECORD_CHANGE_REASON_OBJECT_LOCK_FEE",
+	9: "STREAM_RECORD_CHANGE_REASON_EARLY_DELETION_FEE",
+}
+
+var StreamRecordChangeReason_value = map[string]int32{
+	"STREAM_RECORD_CHANGE_REASON_UNSPECIFIED":        0,
+	"STREAM_RECORD_CHANGE_REASON_DEPOSIT":            1,
+	"STREAM_RECORD_CHANGE_REASON_WITHDRAW":           2,
+	"STREAM_RECORD_CHANGE_REASON_CANCEL_WITHDRAWAL":  3,
+	"STREAM_RECORD_CHANGE_REASON_SETTLE":             4,
+	"STREAM_RECORD_CHANGE_REASON_FORCE_SETTLE":       5,
+	"STREAM_RECORD_CHANGE_REASON_RESUME":             6,
+	"STREAM_RECORD_CHANGE_REASON_BUCKET_FLOW_CHANGE": 7,
+	"STREAM_RECORD_CHANGE_REASON_OBJECT_LOCK_FEE":    8,
+	"STREAM_RECORD_CHANGE_REASON_EARLY_DELETION_FEE": 9,
+}
+
+func (x StreamRecordChangeReason) String() string {
+	return proto.EnumName(StreamRecordChangeReason_name, int32(x))
+}
+
+func (StreamRecordChangeReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2c7cef25414caa21, []int{0}
+}
+
+// StreamRecordHistoryEntry records a change of a stream record and the resulting balances
+type StreamRecordHistoryEntry struct {
+	// sequence is the per account sequence of the entry
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// height is the block height of the change
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// timestamp is the block time of the change
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// reason is the reason of the change
+	Reason StreamRecordChangeReason `protobuf:"varint,4,opt,name=reason,proto3,enum=greenfield.payment.StreamRecordChangeReason" json:"reason,omitempty"`
+	// reference is the optional context of the reason, e.g. the bucket name of a flow change
+	Reference string `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+	// static_balance is the static balance after the change
+	StaticBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=static_balance,json=staticBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"static_balance"`
+	// buffer_balance is the buffer balance after the change
+	BufferBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=buffer_balance,json=bufferBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"buffer_balance"`
+	// lock_balance is the lock balance after the change
+	LockBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=lock_balance,json=lockBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"lock_balance"`
+	// netflow_rate is the netflow rate after the change
+	NetflowRate github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=netflow_rate,json=netflowRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"netflow_rate"`
+	// frozen_netflow_rate is the frozen netflow rate after the change
+	FrozenNetflowRate github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=frozen_netflow_rate,json=frozenNetflowRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"frozen_netflow_rate"`
+	// status is the status of the stream account after the change
+	Status StreamAccountStatus `protobuf:"varint,11,opt,name=status,proto3,enum=greenfield.payment.StreamAccountStatus" json:"status,omitempty"`
+}
+
+func (m *StreamRecordHistoryEntry) Reset()         { *m = StreamRecordHistoryEntry{} }
+func (m *StreamRecordHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*StreamRecordHistoryEntry) ProtoMessage()    {}
+func (*StreamRecordHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c7cef25414caa21, []int{0}
+}
+func (m *StreamRecordHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamRecordHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamRecordHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamRecordHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamRecordHistoryEntry.Merge(m, src)
+}
+func (m *StreamRecordHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamRecordHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamRecordHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamRecordHistoryEntry proto.InternalMessageInfo
+
+func (m *StreamRecordHistoryEntry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *StreamRecordHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *StreamRecordHistoryEntry) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *StreamRecordHistoryEntry) GetReason() StreamRecordChangeReason {
+	if m != nil {
+		return m.Reason
+	}
+	return STREAM_RECORD_CHANGE_REASON_UNSPECIFIED
+}
+
+func (m *StreamRecordHistoryEntry) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *StreamRecordHistoryEntry) GetStatus() StreamAccountStatus {
+	if m != nil {
+		return m.Status
+	}
+	return STREAM_ACCOUNT_STATUS_ACTIVE
+}
+
+func init() {
+	proto.RegisterEnum("greenfield.payment.StreamRecordChangeReason", StreamRecordChangeReason_name, StreamRecordChangeReason_value)
+	proto.RegisterType((*StreamRecordHistoryEntry)(nil), "greenfield.payment.StreamRecordHistoryEntry")
+}
+
+func init() {
+	proto.RegisterFile("greenfield/payment/stream_record_history.proto", fileDescriptor_2c7cef25414caa21)
+}
+
+var fileDescriptor_2c7cef25414caa21 = []byte{
+	// 658 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0xc0, 0x9b, 0xb5, 0xeb, 0x56, 0x0f, 0xa6, 0x60, 0x10, 0x2a, 0x15, 0xca, 0xaa, 0x81, 0xb6,
+	0x8a, 0xad, 0x89, 0x18, 0x57, 0x24, 0x94, 0xa6, 0x2e, 0x0b, 0xcb, 0x9a, 0xc9, 0xc9, 0x34, 0xc1,
+	0xc5, 0x4a, 0x33, 0xb7, 0x8d, 0xd6, 0xc6, 0x25, 0x71, 0x05, 0xe5, 0x09, 0x90, 0xb8, 0xf0, 0x0e,
+	0xbc, 0x02, 0x0f, 0xb1, 0xe3, 0xc4, 0x09, 0x71, 0x98, 0xd0, 0x76, 0xe3, 0x29, 0x50, 0xfe, 0xac,
+	0xeb, 0x84, 0xc8, 0x2e, 0x3d, 0x25, 0xfe, 0xfc, 0xfb, 0xbe, 0x9f, 0xed, 0x7c, 0x31, 0x90, 0x7b,
+	0x01, 0xa5, 0x7e, 0xd7, 0xa3, 0x83, 0x63, 0x65, 0xe4, 0x4c, 0x86, 0xd4, 0xe7, 0x4a, 0xc8, 0x03,
+	0xea, 0x0c, 0x49, 0x40, 0x5d, 0x16, 0x1c, 0x93, 0xbe, 0x17, 0x72, 0x16, 0x4c, 0xe4, 0x51, 0xc0,
+	0x38, 0x83, 0xf0, 0x9a, 0x97, 0x53, 0xbe, 0xf2, 0xc8, 0x65, 0xe1, 0x90, 0x85, 0x24, 0x26, 0x94,
+	0x64, 0x90, 0xe0, 0x95, 0x07, 0x3d, 0xd6, 0x63, 0x49, 0x3c, 0x7a, 0x4b, 0xa3, 0x1b, 0xb7, 0x49,
+	0x13, 0x6e, 0xfd, 0x4b, 0x11, 0x94, 0xad, 0x38, 0x8e, 0xe3, 0xf0, 0x6e, 0xb2, 0x14, 0xe4, 0xf3,
+	0x60, 0x02, 0x2b, 0x60, 0x39, 0xa4, 0xef, 0xc7, 0xd4, 0x77, 0x69, 0x59, 0xa8, 0x0a, 0xb5, 0x02,
+	0x9e, 0x8e, 0xe1, 0x43, 0x50, 0xec, 0x53, 0xaf, 0xd7, 0xe7, 0xe5, 0x85, 0xaa, 0x50, 0xcb, 0xe3,
+	0x74, 0x04, 0x1f, 0x83, 0x12, 0xf7, 0x86, 0x34, 0xe4, 0xce, 0x70, 0x54, 0xce, 0xc7, 0x53, 0xd7,
+	0x01, 0xd8, 0x04, 0xc5, 0x80, 0x3a, 0x21, 0xf3, 0xcb, 0x85, 0xaa, 0x50, 0x5b, 0xdd, 0xd9, 0x96,
+	0xff, 0xdd, 0xac, 0x3c, 0xbb, 0x1e, 0xad, 0xef, 0xf8, 0x3d, 0x8a, 0xe3, 0x1c, 0x9c, 0xe6, 0x46,
+	0x8e, 0x80, 0x76, 0x69, 0x10, 0x2f, 0x6c, 0xb1, 0x2a, 0xd4, 0x4a, 0xf8, 0x3a, 0x00, 0x5d, 0xb0,
+	0x1a, 0x72, 0x87, 0x7b, 0x2e, 0xe9, 0x38, 0x03, 0x27, 0x42, 0x8a, 0x11, 0xd2, 0x78, 0x79, 0x7a,
+	0xbe, 0x96, 0xfb, 0x75, 0xbe, 0xb6, 0xd1, 0xf3, 0x78, 0x7f, 0xdc, 0x91, 0x5d, 0x36, 0x4c, 0x4f,
+	0x32, 0x7d, 0xd4, 0xc3, 0xe3, 0x13, 0x85, 0x4f, 0x46, 0x34, 0x94, 0x75, 0x9f, 0xff, 0xf8, 0x5e,
+	0x07, 0xe9, 0x41, 0xeb, 0x3e, 0xc7, 0x77, 0x93, 0x9a, 0x8d, 0xa4, 0x64, 0x24, 0xe9, 0x8c, 0xbb,
+	0x5d, 0x1a, 0x4c, 0x25, 0x4b, 0xf3, 0x90, 0x24, 0x35, 0xaf, 0x24, 0x04, 0xdc, 0x19, 0x30, 0xf7,
+	0x64, 0xaa, 0x58, 0x9e, 0x83, 0x62, 0x25, 0xaa, 0x38, 0x23, 0xf0, 0x29, 0xef, 0x0e, 0xd8, 0x07,
+	0x12, 0x38, 0x9c, 0x96, 0x4b, 0xf3, 0x10, 0xa4, 0x15, 0xb1, 0xc3, 0x29, 0x1c, 0x80, 0xfb, 0xdd,
+	0x80, 0x7d, 0xa2, 0x3e, 0xb9, 0xe1, 0x01, 0x73, 0xf0, 0xdc, 0x4b, 0x0a, 0xb7, 0x67, 0x6c, 0xaf,
+	0x40, 0x31, 0xfa, 0x4a, 0xe3, 0xb0, 0xbc, 0x12, 0x77, 0xd7, 0xe6, 0xff, 0xbb, 0x4b, 0x75, 0x5d,
+	0x36, 0xf6, 0xb9, 0x15, 0xe3, 0x38, 0x4d, 0x7b, 0xf6, 0x27, 0x7f, 0xf3, 0x6f, 0x98, 0xed, 0x3e,
+	0xb8, 0x05, 0x36, 0x2d, 0x1b, 0x23, 0x75, 0x9f, 0x60, 0xa4, 0x99, 0xb8, 0x49, 0xb4, 0x5d, 0xb5,
+	0xfd, 0x1a, 0x11, 0x8c, 0x54, 0xcb, 0x6c, 0x93, 0xc3, 0xb6, 0x75, 0x80, 0x34, 0xbd, 0xa5, 0xa3,
+	0xa6, 0x98, 0x83, 0x9b, 0xe0, 0x49, 0x16, 0xdc, 0x44, 0x07, 0xa6, 0xa5, 0xdb, 0xa2, 0x00, 0x6b,
+	0xe0, 0x69, 0x16, 0x78, 0xa4, 0xdb, 0xbb, 0x4d, 0xac, 0x1e, 0x89, 0x0b, 0xf0, 0x39, 0xa8, 0x67,
+	0x91, 0x9a, 0xda, 0xd6, 0x90, 0x31, 0x4d, 0x50, 0x0d, 0x31, 0x0f, 0x37, 0xc0, 0x7a, 0x56, 0x8a,
+	0x85, 0x6c, 0xdb, 0x40, 0x62, 0x01, 0x6e, 0x83, 0x5a, 0x16, 0xd7, 0x32, 0xb1, 0x86, 0xae, 0xe8,
+	0xc5, 0xdb, 0xaa, 0x62, 0x64, 0x1d, 0xee, 0x23, 0xb1, 0x08, 0x77, 0x80, 0x9c, 0xc5, 0x35, 0x0e,
+	0xb5, 0x3d, 0x64, 0x93, 0x96, 0x61, 0x1e, 0xa5, 0x33, 0xe2, 0x12, 0x54, 0xc0, 0x56, 0x56, 0x8e,
+	0xd9, 0x78, 0x83, 0x34, 0x9b, 0x18, 0xa6, 0xb6, 0x47, 0x5a, 0x08, 0x89, 0xcb, 0xb7, 0x49, 0x90,
+	0x8a, 0x8d, 0xb7, 0xa4, 0x89, 0x0c, 0x64, 0xeb, 0xd1, 0x4e, 0x10, 0x12, 0x4b, 0x95, 0xc2, 0xe7,
+	0x6f, 0x52, 0xae, 0xa1, 0x9f, 0x5e, 0x48, 0xc2, 0xd9, 0x85, 0x24, 0xfc, 0xbe, 0x90, 0x84, 0xaf,
+	0x97, 0x52, 0xee, 0xec, 0x52, 0xca, 0xfd, 0xbc, 0x94, 0x72, 0xef, 0x94, 0x99, 0x86, 0xec, 0xf8,
+	0x9d, 0xba, 0xdb, 0x77, 0x3c, 0x5f, 0x99, 0xb9, 0x51, 0x3f, 0x4e, 0xef, 0xd4, 0xb8, 0x3b, 0x3b,
+	0xc5, 0xf8, 0x32, 0x7d, 0xf1, 0x37, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x32, 0x0a, 0xab, 0xeb, 0x05,
+	0x00, 0x00,
+}
+
+func (m *StreamRecordHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamRecordHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamRecordHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintStreamRecordHistory(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.FrozenNetflowRate.Size()
+		i -= size
+		if _, err := m.FrozenNetflowRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStreamRecordHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.NetflowRate.Size()
+		i -= size
+		if _, err := m.NetflowRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStreamRecordHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.LockBalance.Size()
+		i -= size
+		if _, err := m.LockBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStreamRecordHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.BufferBalance.Size()
+		i -= size
+		if _, err := m.BufferBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStreamRecordHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.StaticBalance.Size()
+		i -= size
+		if _, err := m.StaticBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStreamRecordHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintStreamRecordHistory(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Reason != 0 {
+		i = encodeVarintStreamRecordHistory(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintStreamRecordHistory(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintStreamRecordHistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintStreamRecordHistory(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStreamRecordHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStreamRecordHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StreamRecordHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovStreamRecordHistory(uint64(m.Sequence))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStreamRecordHistory(uint64(m.Height))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovStreamRecordHistory(uint64(m.Timestamp))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovStreamRecordHistory(uint64(m.Reason))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovStreamRecordHistory(uint64(l))
+	}
+	l = m.StaticBalance.Size()
+	n += 1 + l + sovStreamRecordHistory(uint64(l))
+	l = m.BufferBalance.Size()
+	n += 1 + l + sovStreamRecordHistory(uint64(l))
+	l = m.LockBalance.Size()
+	n += 1 + l + sovStreamRecordHistory(uint64(l))
+	l = m.NetflowRate.Size()
+	n += 1 + l + sovStreamRecordHistory(uint64(l))
+	l = m.FrozenNetflowRate.Size()
+	n += 1 + l + sovStreamRecordHistory(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovStreamRecordHistory(uint64(m.Status))
+	}
+	return n
+}
+
+func sovStreamRecordHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStreamRecordHistory(x uint64) (n int) {
+	return sovStreamRecordHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StreamRecordHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreamRecordHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamRecordHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamRecordHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreamRecordHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreamRecordHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreamRecordHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreamRecordHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= StreamRecordChangeReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreamRecordHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStreamRecordHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreamRecordHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaticBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreamRecordHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStreamRecordHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreamRecordHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StaticBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreamRecordHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStreamRecordHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreamRecordHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BufferBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreamRecordHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStreamRecordHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreamRecordHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetflowRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreamRecordHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStreamRecordHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreamRecordHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetflowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenNetflowRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreamRecordHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStreamRecordHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreamRecordHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FrozenNetflowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreamRecordHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= StreamAccountStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreamRecordHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreamRecordHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStreamRecordHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStreamRecordHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStreamRecordHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStreamRecordHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStreamRecordHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStreamRecordHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStreamRecordHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStreamRecordHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStreamRecordHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStreamRecordHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -479,6 +479,305 @@ func (m *MsgDisableRefundResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDisableRefundResponse proto.InternalMessageInfo
 
+type MsgCreateScheduledDeposit struct {
+	// creator is the message signer for MsgCreateScheduledDeposit and the address of the account to deposit from
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// to is the address of the stream account to deposit to
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// amount is the amount to deposit in each execution
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// interval is the duration in seconds between two executions
+	Interval uint64 `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	// start_time is the unix timestamp of the first execution, the current block time is used if it is not set
+	StartTime int64 `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// max_executions is the total number of successful executions, 0 means no limit
+	MaxExecutions uint64 `protobuf:"varint,6,opt,name=max_executions,json=maxExecutions,proto3" json:"max_executions,omitempty"`
+}
+
+func (m *MsgCreateScheduledDeposit) Reset()         { *m = MsgCreateScheduledDeposit{} }
+func (m *MsgCreateScheduledDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateScheduledDeposit) ProtoMessage()    {}
+func (*MsgCreateScheduledDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{10}
+}
+func (m *MsgCreateScheduledDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateScheduledDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateScheduledDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateScheduledDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateScheduledDeposit.Merge(m, src)
+}
+func (m *MsgCreateScheduledDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateScheduledDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateScheduledDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateScheduledDeposit proto.InternalMessageInfo
+
+func (m *MsgCreateScheduledDeposit) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCreateScheduledDeposit) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *MsgCreateScheduledDeposit) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *MsgCreateScheduledDeposit) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *MsgCreateScheduledDeposit) GetMaxExecutions() uint64 {
+	if m != nil {
+		return m.MaxExecutions
+	}
+	return 0
+}
+
+type MsgCreateScheduledDepositResponse struct {
+	// id is the unique identifier of the created scheduled deposit
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCreateScheduledDepositResponse) Reset()         { *m = MsgCreateScheduledDepositResponse{} }
+func (m *MsgCreateScheduledDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateScheduledDepositResponse) ProtoMessage()    {}
+func (*MsgCreateScheduledDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{11}
+}
+func (m *MsgCreateScheduledDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateScheduledDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateScheduledDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateScheduledDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateScheduledDepositResponse.Merge(m, src)
+}
+func (m *MsgCreateScheduledDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateScheduledDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateScheduledDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateScheduledDepositResponse proto.InternalMessageInfo
+
+func (m *MsgCreateScheduledDepositResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgCancelScheduledDeposit struct {
+	// creator is the message signer for MsgCancelScheduledDeposit and the owner of the scheduled deposit
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// id is the unique identifier of the scheduled deposit to cancel
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelScheduledDeposit) Reset()         { *m = MsgCancelScheduledDeposit{} }
+func (m *MsgCancelScheduledDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledDeposit) ProtoMessage()    {}
+func (*MsgCancelScheduledDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{12}
+}
+func (m *MsgCancelScheduledDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledDeposit.Merge(m, src)
+}
+func (m *MsgCancelScheduledDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledDeposit proto.InternalMessageInfo
+
+func (m *MsgCancelScheduledDeposit) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCancelScheduledDeposit) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgCancelScheduledDepositResponse struct {
+}
+
+func (m *MsgCancelScheduledDepositResponse) Reset()         { *m = MsgCancelScheduledDepositResponse{} }
+func (m *MsgCancelScheduledDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledDepositResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{13}
+}
+func (m *MsgCancelScheduledDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledDepositResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledDepositResponse proto.InternalMessageInfo
+
+type MsgCancelDelayedWithdrawal struct {
+	// creator is the message signer for MsgCancelDelayedWithdrawal and the address of the withdrawal receiver
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *MsgCancelDelayedWithdrawal) Reset()         { *m = MsgCancelDelayedWithdrawal{} }
+func (m *MsgCancelDelayedWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDelayedWithdrawal) ProtoMessage()    {}
+func (*MsgCancelDelayedWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{14}
+}
+func (m *MsgCancelDelayedWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDelayedWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDelayedWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDelayedWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDelayedWithdrawal.Merge(m, src)
+}
+func (m *MsgCancelDelayedWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDelayedWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDelayedWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDelayedWithdrawal proto.InternalMessageInfo
+
+func (m *MsgCancelDelayedWithdrawal) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+type MsgCancelDelayedWithdrawalResponse struct {
+}
+
+func (m *MsgCancelDelayedWithdrawalResponse) Reset()         { *m = MsgCancelDelayedWithdrawalResponse{} }
+func (m *MsgCancelDelayedWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDelayedWithdrawalResponse) ProtoMessage()    {}
+func (*MsgCancelDelayedWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{15}
+}
+func (m *MsgCancelDelayedWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDelayedWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDelayedWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDelayedWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDelayedWithdrawalResponse.Merge(m, src)
+}
+func (m *MsgCancelDelayedWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDelayedWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDelayedWithdrawalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDelayedWithdrawalResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "greenfield.payment.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "greenfield.payment.MsgUpdateParamsResponse")
@@ -490,50 +789,69 @@ func init() {
 	proto.RegisterType((*MsgWithdrawResponse)(nil), "greenfield.payment.MsgWithdrawResponse")
 	proto.RegisterType((*MsgDisableRefund)(nil), "greenfield.payment.MsgDisableRefund")
 	proto.RegisterType((*MsgDisableRefundResponse)(nil), "greenfield.payment.MsgDisableRefundResponse")
+	proto.RegisterType((*MsgCreateScheduledDeposit)(nil), "greenfield.payment.MsgCreateScheduledDeposit")
+	proto.RegisterType((*MsgCreateScheduledDepositResponse)(nil), "greenfield.payment.MsgCreateScheduledDepositResponse")
+	proto.RegisterType((*MsgCancelScheduledDeposit)(nil), "greenfield.payment.MsgCancelScheduledDeposit")
+	proto.RegisterType((*MsgCancelScheduledDepositResponse)(nil), "greenfield.payment.MsgCancelScheduledDepositResponse")
+	proto.RegisterType((*MsgCancelDelayedWithdrawal)(nil), "greenfield.payment.MsgCancelDelayedWithdrawal")
+	proto.RegisterType((*MsgCancelDelayedWithdrawalResponse)(nil), "greenfield.payment.MsgCancelDelayedWithdrawalResponse")
 }
 
 func init() { proto.RegisterFile("greenfield/payment/tx.proto", fileDescriptor_a2b4041b20abde0a) }
 
 var fileDescriptor_a2b4041b20abde0a = []byte{
-	// 603 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6f, 0x12, 0x41,
-	0x1c, 0x65, 0xa1, 0x52, 0xfb, 0x6b, 0xfd, 0x93, 0x15, 0x53, 0xba, 0x26, 0x4b, 0x45, 0x53, 0x89,
-	0x96, 0xdd, 0x48, 0x13, 0x63, 0x88, 0x97, 0xa2, 0x17, 0x0e, 0x24, 0x8a, 0x35, 0x26, 0x7a, 0xd0,
-	0x65, 0x77, 0x58, 0x36, 0x76, 0x67, 0x36, 0x33, 0x83, 0x85, 0xc4, 0x93, 0x9f, 0xc0, 0xc4, 0x2f,
-	0xe2, 0xa1, 0x1f, 0xa2, 0x27, 0xd3, 0xd4, 0x8b, 0xf1, 0xd0, 0x18, 0x38, 0x78, 0xf4, 0x2b, 0x98,
-	0xd9, 0x9d, 0x5d, 0x28, 0x42, 0xa9, 0x3d, 0x78, 0x5a, 0x66, 0xde, 0xfb, 0xbd, 0xf7, 0x7b, 0x93,
-	0xf9, 0x0d, 0x70, 0xc3, 0xa5, 0x08, 0xe1, 0xb6, 0x87, 0x76, 0x1d, 0x33, 0xb0, 0xfa, 0x3e, 0xc2,
-	0xdc, 0xe4, 0x3d, 0x23, 0xa0, 0x84, 0x13, 0x55, 0x1d, 0x81, 0x86, 0x04, 0xb5, 0x55, 0x9b, 0x30,
-	0x9f, 0x30, 0xd3, 0x67, 0xae, 0xf9, 0xfe, 0xbe, 0xf8, 0x44, 0x64, 0x6d, 0x2d, 0x02, 0xde, 0x84,
-	0x2b, 0x33, 0x5a, 0x48, 0x28, 0xe7, 0x12, 0x97, 0x44, 0xfb, 0xe2, 0x97, 0xdc, 0x2d, 0x4c, 0xb1,
-	0x0e, 0x2c, 0x6a, 0xf9, 0xb2, 0xac, 0xf8, 0x59, 0x81, 0x2b, 0x0d, 0xe6, 0xbe, 0x08, 0x1c, 0x8b,
-	0xa3, 0xa7, 0x21, 0xa2, 0x3e, 0x80, 0x25, 0xab, 0xcb, 0x3b, 0x84, 0x7a, 0xbc, 0x9f, 0x57, 0xd6,
-	0x95, 0xd2, 0x52, 0x2d, 0x7f, 0xb4, 0x5f, 0xce, 0x49, 0xbf, 0x6d, 0xc7, 0xa1, 0x88, 0xb1, 0xe7,
-	0x9c, 0x7a, 0xd8, 0x6d, 0x8e, 0xa8, 0xea, 0x43, 0xc8, 0x46, 0xda, 0xf9, 0xf4, 0xba, 0x52, 0x5a,
-	0xae, 0x68, 0xc6, 0xdf, 0xd9, 0x8c, 0xc8, 0xa3, 0xb6, 0x70, 0x70, 0x5c, 0x48, 0x35, 0x25, 0xbf,
-	0x7a, 0xf9, 0xe3, 0xaf, 0x2f, 0x77, 0x47, 0x4a, 0xc5, 0x35, 0x58, 0x9d, 0x68, 0xaa, 0x89, 0x58,
-	0x40, 0x30, 0x43, 0xc5, 0xd7, 0x21, 0xf4, 0x98, 0xa2, 0x10, 0x0a, 0x35, 0xb7, 0x6d, 0x9b, 0x74,
-	0x31, 0x57, 0x2b, 0xb0, 0x68, 0x8b, 0x7d, 0x42, 0xe7, 0x76, 0x1d, 0x13, 0xab, 0x2b, 0xc2, 0x39,
-	0x5e, 0x15, 0x6f, 0x42, 0x61, 0x86, 0x78, 0xe2, 0xff, 0x55, 0x01, 0x68, 0x30, 0xf7, 0x09, 0x0a,
-	0x08, 0xf3, 0xce, 0xe5, 0xa9, 0x96, 0x20, 0xcd, 0x49, 0x78, 0x46, 0xa7, 0xd1, 0xd3, 0x9c, 0xa8,
-	0x3b, 0x90, 0xb5, 0x7c, 0x61, 0x9f, 0xcf, 0x84, 0xec, 0x47, 0xe2, 0xd4, 0x7e, 0x1c, 0x17, 0x36,
-	0x5c, 0x8f, 0x77, 0xba, 0x2d, 0xc3, 0x26, 0xbe, 0xbc, 0x05, 0xf2, 0x53, 0x66, 0xce, 0x3b, 0x93,
-	0xf7, 0x03, 0xc4, 0x8c, 0x3a, 0xe6, 0x47, 0xfb, 0x65, 0x90, 0xda, 0x75, 0xcc, 0x9b, 0x52, 0x6b,
-	0x22, 0x73, 0x0e, 0xd4, 0x51, 0x9e, 0x24, 0xe6, 0x37, 0x05, 0x96, 0x1b, 0xcc, 0x7d, 0xe9, 0xf1,
-	0x8e, 0x43, 0xad, 0xbd, 0x73, 0xe5, 0xdc, 0x84, 0x85, 0x36, 0x25, 0xfe, 0xdc, 0xa4, 0x21, 0xeb,
-	0xbf, 0x64, 0xbd, 0x0e, 0xd7, 0xc6, 0x42, 0x25, 0x61, 0x3f, 0xc0, 0x55, 0x71, 0x04, 0x1e, 0xb3,
-	0x5a, 0xbb, 0xa8, 0x89, 0xda, 0x5d, 0xec, 0xa8, 0x06, 0x5c, 0x20, 0x7b, 0x18, 0xcd, 0x8f, 0x1b,
-	0xd1, 0x44, 0x58, 0xcb, 0x71, 0xe8, 0xfc, 0xb0, 0x82, 0x55, 0x05, 0xd1, 0x56, 0x54, 0x59, 0xd4,
-	0x20, 0x3f, 0xe9, 0x1e, 0x77, 0x56, 0xf9, 0x9d, 0x81, 0x4c, 0x83, 0xb9, 0xea, 0x5b, 0x58, 0x39,
-	0x31, 0xa2, 0xb7, 0xa6, 0x8d, 0xd6, 0xc4, 0xc8, 0x68, 0xf7, 0xce, 0x40, 0x8a, 0x9d, 0xd4, 0x1e,
-	0xe4, 0xa6, 0x0e, 0xd5, 0x2c, 0x91, 0x69, 0x64, 0x6d, 0xeb, 0x1f, 0xc8, 0x89, 0xf3, 0x33, 0x58,
-	0x8c, 0xa7, 0x49, 0x9f, 0x51, 0x2f, 0x71, 0x6d, 0xe3, 0x74, 0x3c, 0x91, 0xdc, 0x81, 0x8b, 0xc9,
-	0xcd, 0x2d, 0xcc, 0xa8, 0x89, 0x09, 0xda, 0x9d, 0x39, 0x84, 0x44, 0xd5, 0x86, 0x4b, 0x27, 0xef,
-	0xc8, 0xed, 0x59, 0xed, 0x8c, 0xb3, 0xb4, 0xcd, 0xb3, 0xb0, 0x62, 0x93, 0x5a, 0xfd, 0x60, 0xa0,
-	0x2b, 0x87, 0x03, 0x5d, 0xf9, 0x39, 0xd0, 0x95, 0x4f, 0x43, 0x3d, 0x75, 0x38, 0xd4, 0x53, 0xdf,
-	0x87, 0x7a, 0xea, 0x95, 0x39, 0x36, 0x08, 0x2d, 0xdc, 0x2a, 0xdb, 0x1d, 0xcb, 0xc3, 0xe6, 0xd8,
-	0x03, 0xdf, 0x1b, 0xfd, 0xbb, 0x88, 0xa9, 0x68, 0x65, 0xc3, 0x27, 0x7e, 0xeb, 0x4f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x89, 0xdc, 0x13, 0x0e, 0x80, 0x06, 0x00, 0x00,
+	// 808 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4d, 0x4f, 0xdb, 0x48,
+	0x18, 0x8e, 0x43, 0x08, 0xf0, 0xf2, 0xb1, 0x2b, 0x6f, 0x76, 0x31, 0x5e, 0x6d, 0x12, 0x02, 0xcb,
+	0x46, 0xbb, 0xc4, 0xd6, 0x82, 0x8a, 0x2a, 0xd4, 0x0b, 0x94, 0x1e, 0x38, 0x44, 0x6a, 0x0d, 0x55,
+	0xa5, 0x56, 0x2a, 0x9d, 0xd8, 0x83, 0x63, 0x35, 0xf6, 0x44, 0x9e, 0x09, 0x24, 0x52, 0x55, 0xa9,
+	0xfd, 0x05, 0x95, 0xfa, 0x47, 0x7a, 0xe0, 0xde, 0x2b, 0xa7, 0x0a, 0xd1, 0x4b, 0xd5, 0x03, 0xaa,
+	0xe0, 0xd0, 0x1f, 0xd0, 0x3f, 0x50, 0xf9, 0x6b, 0x12, 0x42, 0x9c, 0x00, 0x42, 0x95, 0x7a, 0x72,
+	0x66, 0xde, 0x67, 0x9e, 0xe7, 0x7d, 0xde, 0x99, 0x79, 0x33, 0xf0, 0xa7, 0xe9, 0x62, 0xec, 0xec,
+	0x5a, 0xb8, 0x66, 0xa8, 0x75, 0xd4, 0xb2, 0xb1, 0xc3, 0x54, 0xd6, 0x54, 0xea, 0x2e, 0x61, 0x44,
+	0x14, 0xdb, 0x41, 0x25, 0x0c, 0xca, 0xd3, 0x3a, 0xa1, 0x36, 0xa1, 0xaa, 0x4d, 0x4d, 0x75, 0xef,
+	0x7f, 0xef, 0x13, 0x80, 0xe5, 0x99, 0x20, 0xb0, 0xe3, 0x8f, 0xd4, 0x60, 0x10, 0x86, 0x32, 0x26,
+	0x31, 0x49, 0x30, 0xef, 0xfd, 0x0a, 0x67, 0x73, 0x3d, 0xa4, 0xeb, 0xc8, 0x45, 0x76, 0xb8, 0xac,
+	0xf0, 0x56, 0x80, 0x5f, 0xca, 0xd4, 0x7c, 0x58, 0x37, 0x10, 0xc3, 0xf7, 0xfd, 0x88, 0xb8, 0x02,
+	0x63, 0xa8, 0xc1, 0xaa, 0xc4, 0xb5, 0x58, 0x4b, 0x12, 0xf2, 0x42, 0x71, 0x6c, 0x5d, 0x3a, 0x3e,
+	0x28, 0x65, 0x42, 0xbd, 0x35, 0xc3, 0x70, 0x31, 0xa5, 0x5b, 0xcc, 0xb5, 0x1c, 0x53, 0x6b, 0x43,
+	0xc5, 0xdb, 0x90, 0x0e, 0xb8, 0xa5, 0x64, 0x5e, 0x28, 0x8e, 0x2f, 0xc9, 0xca, 0x45, 0x6f, 0x4a,
+	0xa0, 0xb1, 0x9e, 0x3a, 0x3c, 0xc9, 0x25, 0xb4, 0x10, 0xbf, 0x3a, 0xf5, 0xfa, 0xeb, 0xbb, 0x7f,
+	0xdb, 0x4c, 0x85, 0x19, 0x98, 0xee, 0x4a, 0x4a, 0xc3, 0xb4, 0x4e, 0x1c, 0x8a, 0x0b, 0x4f, 0xfc,
+	0xd0, 0x5d, 0x17, 0xfb, 0x21, 0x9f, 0x73, 0x4d, 0xd7, 0x49, 0xc3, 0x61, 0xe2, 0x12, 0x8c, 0xe8,
+	0xde, 0x3c, 0x71, 0x07, 0x66, 0x1d, 0x01, 0x57, 0x27, 0x3c, 0xe5, 0x68, 0x54, 0x98, 0x85, 0x5c,
+	0x0c, 0x39, 0xd7, 0xff, 0x20, 0x00, 0x94, 0xa9, 0xb9, 0x81, 0xeb, 0x84, 0x5a, 0xd7, 0xd2, 0x14,
+	0x8b, 0x90, 0x64, 0xc4, 0xaf, 0x51, 0x3f, 0x78, 0x92, 0x11, 0x71, 0x1b, 0xd2, 0xc8, 0xf6, 0xe4,
+	0xa5, 0x21, 0x1f, 0x7d, 0xc7, 0xab, 0xda, 0xe7, 0x93, 0xdc, 0x82, 0x69, 0xb1, 0x6a, 0xa3, 0xa2,
+	0xe8, 0xc4, 0x0e, 0x4f, 0x41, 0xf8, 0x29, 0x51, 0xe3, 0xb9, 0xca, 0x5a, 0x75, 0x4c, 0x95, 0x4d,
+	0x87, 0x1d, 0x1f, 0x94, 0x20, 0xe4, 0xde, 0x74, 0x98, 0x16, 0x72, 0x75, 0x79, 0xce, 0x80, 0xd8,
+	0xf6, 0xc3, 0x6d, 0x7e, 0x14, 0x60, 0xbc, 0x4c, 0xcd, 0x47, 0x16, 0xab, 0x1a, 0x2e, 0xda, 0xbf,
+	0x96, 0xcf, 0x45, 0x48, 0xed, 0xba, 0xc4, 0x1e, 0xe8, 0xd4, 0x47, 0xfd, 0x10, 0xaf, 0xbf, 0xc3,
+	0x6f, 0x1d, 0xa6, 0xb8, 0xd9, 0x17, 0xf0, 0xab, 0x57, 0x02, 0x8b, 0xa2, 0x4a, 0x0d, 0x6b, 0x78,
+	0xb7, 0xe1, 0x18, 0xa2, 0x02, 0xc3, 0x64, 0xdf, 0xc1, 0x83, 0xed, 0x06, 0x30, 0xcf, 0x2c, 0x32,
+	0x0c, 0x77, 0xb0, 0x59, 0x0f, 0xb5, 0x0a, 0x5e, 0x5a, 0xc1, 0xca, 0x82, 0x0c, 0x52, 0xb7, 0x3a,
+	0xcf, 0xec, 0x7d, 0x12, 0x66, 0xf8, 0x89, 0xdc, 0xd2, 0xab, 0xd8, 0x68, 0xd4, 0xb0, 0xf1, 0x13,
+	0x1f, 0x3e, 0x51, 0x86, 0x51, 0xcb, 0x61, 0xd8, 0xdd, 0x43, 0x35, 0x29, 0x95, 0x17, 0x8a, 0x29,
+	0x8d, 0x8f, 0xc5, 0xbf, 0x00, 0x28, 0x43, 0x2e, 0xdb, 0x61, 0x96, 0x8d, 0xa5, 0xe1, 0xbc, 0x50,
+	0x1c, 0xd2, 0xc6, 0xfc, 0x99, 0x6d, 0xcb, 0xc6, 0xe2, 0xdf, 0x30, 0x65, 0xa3, 0xe6, 0x0e, 0x6e,
+	0x62, 0xbd, 0xc1, 0x2c, 0xe2, 0x50, 0x29, 0xed, 0x13, 0x4c, 0xda, 0xa8, 0x79, 0x8f, 0x4f, 0x76,
+	0x6d, 0xf9, 0x32, 0xcc, 0xc6, 0x16, 0x30, 0x2a, 0xb3, 0x38, 0x05, 0x49, 0xcb, 0xf0, 0x6b, 0x98,
+	0xd2, 0x92, 0x96, 0x51, 0xb0, 0x83, 0xaa, 0x23, 0x47, 0xc7, 0xb5, 0x1b, 0xa9, 0x7a, 0x20, 0x90,
+	0x8c, 0x04, 0xba, 0x72, 0x9c, 0x0b, 0x72, 0xec, 0x29, 0xc7, 0x8f, 0xc2, 0x53, 0x90, 0x39, 0x68,
+	0x03, 0xd7, 0x50, 0x0b, 0x1b, 0xd1, 0x41, 0x46, 0xb5, 0x1b, 0xe8, 0x7d, 0xf3, 0x50, 0x88, 0xe7,
+	0x8f, 0xb2, 0x58, 0xfa, 0x96, 0x86, 0xa1, 0x32, 0x35, 0xc5, 0x67, 0x30, 0x71, 0xee, 0x3f, 0x63,
+	0xae, 0x57, 0xaf, 0xef, 0xea, 0xe1, 0xf2, 0x7f, 0x97, 0x00, 0xf1, 0x3d, 0x69, 0x42, 0xa6, 0x67,
+	0x97, 0x8f, 0x23, 0xe9, 0x05, 0x96, 0x97, 0xaf, 0x00, 0xe6, 0xca, 0x0f, 0x60, 0x24, 0xda, 0xeb,
+	0x6c, 0xcc, 0xfa, 0x30, 0x2e, 0x2f, 0xf4, 0x8f, 0x73, 0xca, 0x6d, 0x18, 0xe5, 0xad, 0x34, 0x17,
+	0xb3, 0x26, 0x02, 0xc8, 0xff, 0x0c, 0x00, 0x70, 0x56, 0x1d, 0x26, 0xcf, 0x37, 0xad, 0xf9, 0xb8,
+	0x74, 0x3a, 0x51, 0xf2, 0xe2, 0x65, 0x50, 0x5c, 0xe4, 0x25, 0xfc, 0x11, 0xd3, 0x7e, 0x4a, 0x7d,
+	0x8b, 0xdb, 0x0d, 0x97, 0x6f, 0x5d, 0x09, 0x7e, 0x4e, 0xbf, 0xf7, 0x45, 0x8c, 0xd5, 0xef, 0x09,
+	0x8f, 0xd7, 0xef, 0x7b, 0xef, 0xc4, 0x57, 0x02, 0x4c, 0xc7, 0xdd, 0x3a, 0xa5, 0x2f, 0xe5, 0x05,
+	0xbc, 0xbc, 0x72, 0x35, 0x7c, 0x94, 0xc3, 0xfa, 0xe6, 0xe1, 0x69, 0x56, 0x38, 0x3a, 0xcd, 0x0a,
+	0x5f, 0x4e, 0xb3, 0xc2, 0x9b, 0xb3, 0x6c, 0xe2, 0xe8, 0x2c, 0x9b, 0xf8, 0x74, 0x96, 0x4d, 0x3c,
+	0x56, 0x3b, 0x9a, 0x71, 0xc5, 0xa9, 0x94, 0xf4, 0x2a, 0xb2, 0x1c, 0xb5, 0xe3, 0xd5, 0xd7, 0x6c,
+	0x3f, 0x39, 0xbd, 0xce, 0x5c, 0x49, 0xfb, 0xef, 0xbe, 0xe5, 0xef, 0x01, 0x00, 0x00, 0xff, 0xff,
+	0xc2, 0x5c, 0xd5, 0x93, 0x95, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -557,6 +875,9 @@ type MsgClient interface {
 	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
 	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
 	DisableRefund(ctx context.Context, in *MsgDisableRefund, opts ...grpc.CallOption) (*MsgDisableRefundResponse, error)
+	CreateScheduledDeposit(ctx context.Context, in *MsgCreateScheduledDeposit, opts ...grpc.CallOption) (*MsgCreateScheduledDepositResponse, error)
+	CancelScheduledDeposit(ctx context.Context, in *MsgCancelScheduledDeposit, opts ...grpc.CallOption) (*MsgCancelScheduledDepositResponse, error)
+	CancelDelayedWithdrawal(ctx context.Context, in *MsgCancelDelayedWithdrawal, opts ...grpc.CallOption) (*MsgCancelDelayedWithdrawalResponse, error)
 }
 
 type msgClient struct {
@@ -612,6 +933,33 @@ func (c *msgClient) DisableRefund(ctx context.Context, in *MsgDisableRefund, opt
 	return out, nil
 }
 
+func (c *msgClient) CreateScheduledDeposit(ctx context.Context, in *MsgCreateScheduledDeposit, opts ...grpc.CallOption) (*MsgCreateScheduledDepositResponse, error) {
+	out := new(MsgCreateScheduledDepositResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/CreateScheduledDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledDeposit(ctx context.Context, in *MsgCancelScheduledDeposit, opts ...grpc.CallOption) (*MsgCancelScheduledDepositResponse, error) {
+	out := new(MsgCancelScheduledDepositResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/CancelScheduledDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelDelayedWithdrawal(ctx context.Context, in *MsgCancelDelayedWithdrawal, opts ...grpc.CallOption) (*MsgCancelDelayedWithdrawalResponse, error) {
+	out := new(MsgCancelDelayedWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/CancelDelayedWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/payment module parameters.
@@ -623,6 +971,9 @@ type MsgServer interface {
 	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
 	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
 	DisableRefund(context.Context, *MsgDisableRefund) (*MsgDisableRefundResponse, error)
+	CreateScheduledDeposit(context.Context, *MsgCreateScheduledDeposit) (*MsgCreateScheduledDepositResponse, error)
+	CancelScheduledDeposit(context.Context, *MsgCancelScheduledDeposit) (*MsgCancelScheduledDepositResponse, error)
+	CancelDelayedWithdrawal(context.Context, *MsgCancelDelayedWithdrawal) (*MsgCancelDelayedWithdrawalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -644,6 +995,15 @@ func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (
 func (*UnimplementedMsgServer) DisableRefund(ctx context.Context, req *MsgDisableRefund) (*MsgDisableRefundResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisableRefund not implemented")
 }
+func (*UnimplementedMsgServer) CreateScheduledDeposit(ctx context.Context, req *MsgCreateScheduledDeposit) (*MsgCreateScheduledDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScheduledDeposit not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledDeposit(ctx context.Context, req *MsgCancelScheduledDeposit) (*MsgCancelScheduledDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledDeposit not implemented")
+}
+func (*UnimplementedMsgServer) CancelDelayedWithdrawal(ctx context.Context, req *MsgCancelDelayedWithdrawal) (*MsgCancelDelayedWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDelayedWithdrawal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -739,6 +1099,60 @@ func _Msg_DisableRefund_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateScheduledDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateScheduledDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateScheduledDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Msg/CreateScheduledDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateScheduledDeposit(ctx, req.(*MsgCreateScheduledDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Msg/CancelScheduledDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledDeposit(ctx, req.(*MsgCancelScheduledDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelDelayedWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDelayedWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDelayedWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Msg/CancelDelayedWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDelayedWithdrawal(ctx, req.(*MsgCancelDelayedWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "greenfield.payment.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -763,6 +1177,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DisableRefund",
 			Handler:    _Msg_DisableRefund_Handler,
 		},
+		{
+			MethodName: "CreateScheduledDeposit",
+			Handler:    _Msg_CreateScheduledDeposit_Handler,
+		},
+		{
+			MethodName: "CancelScheduledDeposit",
+			Handler:    _Msg_CancelScheduledDeposit_Handler,
+		},
+		{
+			MethodName: "CancelDelayedWithdrawal",
+			Handler:    _Msg_CancelDelayedWithdrawal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "greenfield/payment/tx.proto",
@@ -1084,16 +1510,217 @@ func (m *MsgDisableRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCreateScheduledDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateScheduledDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateScheduledDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxExecutions != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxExecutions))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateScheduledDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateScheduledDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateScheduledDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDelayedWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDelayedWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDelayedWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDelayedWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDelayedWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDelayedWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
@@ -1223,6 +1850,93 @@ func (m *MsgDisableRefundResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreateScheduledDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovTx(uint64(m.StartTime))
+	}
+	if m.MaxExecutions != 0 {
+		n += 1 + sovTx(uint64(m.MaxExecutions))
+	}
+	return n
+}
+
+func (m *MsgCreateScheduledDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelDelayedWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelDelayedWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1248,19 +1962,546 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePaymentAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePaymentAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePaymentAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePaymentAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePaymentAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePaymentAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1288,13 +2529,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1304,22 +2545,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1344,7 +2586,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1367,10 +2609,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1394,7 +2636,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePaymentAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableRefund) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1417,15 +2659,15 @@ func (m *MsgCreatePaymentAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePaymentAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableRefund: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePaymentAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableRefund: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1453,7 +2695,39 @@ func (m *MsgCreatePaymentAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1476,7 +2750,7 @@ func (m *MsgCreatePaymentAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePaymentAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableRefundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1499,10 +2773,10 @@ func (m *MsgCreatePaymentAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePaymentAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableRefundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePaymentAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1526,7 +2800,7 @@ func (m *MsgCreatePaymentAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateScheduledDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1549,10 +2823,10 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateScheduledDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateScheduledDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1653,6 +2927,63 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutions", wireType)
+			}
+			m.MaxExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1674,7 +3005,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateScheduledDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1697,12 +3028,31 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateScheduledDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateScheduledDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1724,7 +3074,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1747,10 +3097,10 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1786,42 +3136,10 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.From = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1831,26 +3149,11 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1872,7 +3175,7 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1895,10 +3198,10 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1922,7 +3225,7 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableRefund) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelDelayedWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1945,47 +3248,15 @@ func (m *MsgDisableRefund) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableRefund: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelDelayedWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelDelayedWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2013,7 +3284,7 @@ func (m *MsgDisableRefund) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2036,7 +3307,7 @@ func (m *MsgDisableRefund) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableRefundResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelDelayedWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2059,10 +3330,10 @@ func (m *MsgDisableRefundResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableRefundResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelDelayedWithdrawalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelDelayedWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
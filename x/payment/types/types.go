@@ -13,7 +13,8 @@ var (
 )
 
 const (
-	ForceUpdateStreamRecordKey = "force_update_stream_record"
+	ForceUpdateStreamRecordKey  = "force_update_stream_record"
+	StreamRecordChangeReasonKey = "stream_record_change_reason"
 )
 
 const (
@@ -21,6 +22,24 @@ const (
 	GovernanceAddressLackBalanceLabel = "governance_address_lack_balance"
 )
 
+// StreamRecordChangeContext is the reason of the stream record changes carried by the context,
+// it will be recorded in the history of the changed stream records.
+type StreamRecordChangeContext struct {
+	Reason    StreamRecordChangeReason
+	Reference string
+}
+
+// WithStreamRecordChangeReason returns a new context with the reason of the following stream record changes
+func WithStreamRecordChangeReason(ctx sdk.Context, reason StreamRecordChangeReason, reference string) sdk.Context {
+	return ctx.WithValue(StreamRecordChangeReasonKey, StreamRecordChangeContext{Reason: reason, Reference: reference})
+}
+
+// GetStreamRecordChangeReason returns the reason of the stream record changes carried by the context
+func GetStreamRecordChangeReason(ctx sdk.Context) StreamRecordChangeContext {
+	changeCtx, _ := ctx.Value(StreamRecordChangeReasonKey).(StreamRecordChangeContext)
+	return changeCtx
+}
+
 type StreamRecordChange struct {
 	Addr                sdk.AccAddress
 	RateChange          sdkmath.Int
@@ -13,6 +13,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	if ctx.BlockHeight()%types.MaintenanceRecordsGCFrequencyInBlocks == 0 {
 		k.ForceUpdateMaintenanceRecords(ctx)
 	}
+	k.ProcessScheduledMaintenances(ctx)
+	k.ProcessPendingSpStoragePrices(ctx)
+	k.ProcessExpiredSpKeyRotations(ctx)
 
 	needUpdate := false
 	price, err := k.GetGlobalSpStorePriceByTime(ctx, ctx.BlockTime().Unix()+1)
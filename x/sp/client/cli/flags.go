@@ -24,11 +24,18 @@ const (
 	FlagNodeID = "node-id"
 	FlagIP     = "ip"
 
-	FlagReadPrice     = "read-price"
-	FlagStorePrice    = "store-price"
-	FlagFreeReadQuota = "free-read-quota"
+	FlagReadPrice       = "read-price"
+	FlagStorePrice      = "store-price"
+	FlagFreeReadQuota   = "free-read-quota"
+	FlagStorePriceTiers = "store-price-tiers"
+	FlagEffectiveTime   = "effective-time"
 
 	FlagSecurityContact = "security-contact"
 
 	FlagDuration = "duration"
+
+	FlagRegion       = "region"
+	FlagJurisdiction = "jurisdiction"
+
+	FlagRevokeReplacedKeys = "revoke-replaced-keys"
 )
@@ -31,6 +31,11 @@ func GetQueryCmd() *cobra.Command {
 		CmdMaintenanceRecordsBySPOperatorAddress(),
 		CmdStorageProviderPrice(),
 		CmdStorageProviderGlobalPrice(),
+		CmdStorageProviderScore(),
+		CmdStorageProviderCapacity(),
+		CmdScheduledMaintenance(),
+		CmdPendingSpStoragePrices(),
+		CmdSpKeyRotation(),
 	)
 
 	return cmd
@@ -230,3 +235,155 @@ func CmdStorageProviderGlobalPrice() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdStorageProviderScore() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "score [sp-id]",
+		Short: "Query the scorecard of storage provider with specify sp id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			spID, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).
+				StorageProviderScore(cmd.Context(), &types.QueryStorageProviderScoreRequest{
+					Id: uint32(spID),
+				})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdStorageProviderCapacity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "capacity [sp-id]",
+		Short: "Query the declared capacity of storage provider with specify sp id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			spID, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).
+				StorageProviderCapacity(cmd.Context(), &types.QueryStorageProviderCapacityRequest{
+					Id: uint32(spID),
+				})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdScheduledMaintenance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-maintenance",
+		Short: "Query the upcoming scheduled maintenance windows of all storage providers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).
+				ScheduledMaintenance(cmd.Context(), &types.QueryScheduledMaintenanceRequest{
+					Pagination: pageReq,
+				})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	return cmd
+}
+
+func CmdPendingSpStoragePrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-prices",
+		Short: "Query the pending storage prices announced by all storage providers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).
+				PendingSpStoragePrices(cmd.Context(), &types.QueryPendingSpStoragePricesRequest{
+					Pagination: pageReq,
+				})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	return cmd
+}
+
+func CmdSpKeyRotation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "key-rotation [sp-id]",
+		Short: "Query the replaced keys of storage provider with specify sp id, which are still accepted in the overlap period",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			spID, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).
+				SpKeyRotation(cmd.Context(), &types.QuerySpKeyRotationRequest{
+					Id: uint32(spID),
+				})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
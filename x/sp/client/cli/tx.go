@@ -39,6 +39,10 @@ func GetTxCmd() *cobra.Command {
 		CmdGrantDepositAuthorization(),
 		CmdUpdateStorageProviderStatus(),
 		CmdUpdateStorageProviderStoragePrice(),
+		CmdUpdateStorageProviderCapacity(),
+		CmdScheduleMaintenance(),
+		CmdCancelScheduledMaintenance(),
+		CmdUnjailStorageProvider(),
 	)
 
 	return spTxCmd
@@ -250,6 +254,17 @@ func CmdEditStorageProvider() *cobra.Command {
 				blsPubKey,
 				blsProof,
 			)
+
+			// location, the region and jurisdiction are updated together
+			if cmd.Flags().Changed(FlagRegion) || cmd.Flags().Changed(FlagJurisdiction) {
+				region, _ := cmd.Flags().GetString(FlagRegion)
+				jurisdiction, _ := cmd.Flags().GetString(FlagJurisdiction)
+				msg.Location = &types.SpLocation{
+					Region:       region,
+					Jurisdiction: jurisdiction,
+				}
+			}
+			msg.RevokeReplacedKeys, _ = cmd.Flags().GetBool(FlagRevokeReplacedKeys)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -271,6 +286,9 @@ func CmdEditStorageProvider() *cobra.Command {
 	cmd.Flags().String(FlagApprovalAddress, "", "The approval address of storage provider")
 	cmd.Flags().String(FlagGcAddress, "", "The gc address of storage provider")
 	cmd.Flags().String(FlagMaintenanceAddress, "", "The maintenance address of storage provider")
+	cmd.Flags().String(FlagRegion, "", "The region code of storage provider, e.g. eu-west, should be provided together with the jurisdiction")
+	cmd.Flags().String(FlagJurisdiction, "", "The jurisdiction code of storage provider, e.g. DE, should be provided together with the region")
+	cmd.Flags().Bool(FlagRevokeReplacedKeys, false, "Drop the replaced keys at once instead of keeping them for the overlap period, e.g. when they are compromised")
 
 	return cmd
 }
@@ -729,9 +747,17 @@ E.g. the price is 0.02183945725, means approximately $0.018 / GB / Month.
 
 The free-read-quota unit is bytes, for 1GB free quota, it is 1073741824.
 
+The optional store price tiers are volume discounts, which are comma separated charge size thresholds in bytes and
+store prices. A tier applies to a bucket when its total charge size reaches the threshold.
+
+The optional effective time is a unix timestamp at which the price takes effect, a price increase should be announced
+at least price_increase_notice_days in advance.
+
 Examples:
  $ %s tx %s update-price 0x... 0.1469890427 0.02183945725 1073741824
-	`, version.AppName, types.ModuleName),
+ $ %s tx %s update-price 0x... 0.1469890427 0.02183945725 1073741824 --store-price-tiers 1099511627776:0.02,10995116277760:0.018
+ $ %s tx %s update-price 0x... 0.1469890427 0.02183945725 1073741824 --effective-time 1700000000
+	`, version.AppName, types.ModuleName, version.AppName, types.ModuleName, version.AppName, types.ModuleName),
 		),
 		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -755,11 +781,19 @@ Examples:
 			if err != nil {
 				return err
 			}
+			tiersStr, _ := cmd.Flags().GetString(FlagStorePriceTiers)
+			tiers, err := parseStorePriceTiers(tiersStr)
+			if err != nil {
+				return err
+			}
+			effectiveTime, _ := cmd.Flags().GetInt64(FlagEffectiveTime)
 			msg := types.MsgUpdateSpStoragePrice{
-				SpAddress:     spAddress.String(),
-				ReadPrice:     readPrice,
-				StorePrice:    storePrice,
-				FreeReadQuota: quota,
+				SpAddress:       spAddress.String(),
+				ReadPrice:       readPrice,
+				StorePrice:      storePrice,
+				FreeReadQuota:   quota,
+				StorePriceTiers: tiers,
+				EffectiveTime:   effectiveTime,
 			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
@@ -767,6 +801,183 @@ Examples:
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+	cmd.Flags().String(FlagStorePriceTiers, "", "The store price tiers, e.g. 1099511627776:0.02,10995116277760:0.018")
+	cmd.Flags().Int64(FlagEffectiveTime, 0, "The unix timestamp at which the price takes effect, 0 means immediately")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdUpdateStorageProviderCapacity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-capacity [sp-address] [total-capacity] [free-capacity] [max-gvg-families]",
+		Short: "Declare the storage capacity of a storage provider",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`declare the total and free storage capacity in bytes, and the maximum number of global virtual group families
+the storage provider serves as primary sp, 0 means no limit on the families.
+
+The free capacity is reserved when the storage provider joins new global virtual groups, the storage provider should declare
+its capacity again after more disk is provisioned.
+
+Examples:
+ $ %s tx %s update-capacity 0x... 109951162777600 54975581388800 100
+	`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			spAddress, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+			totalCapacity, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			freeCapacity, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			maxGvgFamilies, err := strconv.ParseUint(args[3], 10, 32)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgUpdateSpCapacity(spAddress, totalCapacity, freeCapacity, uint32(maxGvgFamilies))
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdScheduleMaintenance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-maintenance [sp-address] [start-time] [duration]",
+		Short: "Schedule a future maintenance window of a storage provider",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`pre-announce a maintenance window, the start time is a unix timestamp and the duration is in seconds.
+The storage provider will be put into STATUS_IN_MAINTENANCE at the start of the window and back to STATUS_IN_SERVICE at the end.
+
+Examples:
+ $ %s tx %s schedule-maintenance 0x... 1700000000 21600
+	`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			spAddress, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+			startTime, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			duration, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgScheduleMaintenance(spAddress, startTime, duration)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdCancelScheduledMaintenance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-maintenance [sp-address] [start-time]",
+		Short: "Cancel an upcoming maintenance window of a storage provider",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			spAddress, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+			startTime, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgCancelScheduledMaintenance(spAddress, startTime)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdUnjailStorageProvider() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail [sp-address] [fund-address] [value]",
+		Short: "Top up the deposit of a jailed storage provider from funding account and put it back in service",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			spAddress, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+			fundAddress, err := sdk.AccAddressFromHexUnsafe(args[1])
+			if err != nil {
+				return err
+			}
+			coin, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgUnjailStorageProvider(fundAddress, spAddress, coin)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseStorePriceTiers parses the store price tiers in the format of threshold:price,threshold:price
+func parseStorePriceTiers(str string) ([]types.SpStorePriceTier, error) {
+	tiers := make([]types.SpStorePriceTier, 0)
+	if str == "" {
+		return tiers, nil
+	}
+	for _, tierStr := range strings.Split(str, ",") {
+		parts := strings.Split(strings.TrimSpace(tierStr), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid store price tier %s", tierStr)
+		}
+		threshold, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid store price tier threshold %s: %w", parts[0], err)
+		}
+		price, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid store price tier price %s: %w", parts[1], err)
+		}
+		tiers = append(tiers, types.SpStorePriceTier{ChargeSizeThreshold: threshold, StorePrice: price})
+	}
+	return tiers, nil
+}
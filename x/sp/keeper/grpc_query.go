@@ -138,3 +138,85 @@ func (k Keeper) StorageProviderMaintenanceRecordsByOperatorAddress(goCtx context
 	}
 	return &types.QueryStorageProviderMaintenanceRecordsResponse{Records: records}, nil
 }
+
+func (k Keeper) StorageProviderScore(goCtx context.Context, req *types.QueryStorageProviderScoreRequest) (*types.QueryStorageProviderScoreResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	score, found := k.GetStorageProviderScore(ctx, req.Id)
+	if !found {
+		return nil, types.ErrStorageProviderNotFound
+	}
+	return &types.QueryStorageProviderScoreResponse{Score: score}, nil
+}
+
+func (k Keeper) StorageProviderCapacity(goCtx context.Context, req *types.QueryStorageProviderCapacityRequest) (*types.QueryStorageProviderCapacityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetStorageProvider(ctx, req.Id); !found {
+		return nil, types.ErrStorageProviderNotFound
+	}
+	capacity, found := k.GetSpCapacity(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "capacity of sp %d is not declared", req.Id)
+	}
+	return &types.QueryStorageProviderCapacityResponse{Capacity: capacity}, nil
+}
+
+func (k Keeper) ScheduledMaintenance(goCtx context.Context, req *types.QueryScheduledMaintenanceRequest) (*types.QueryScheduledMaintenanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledMaintenancePrefix)
+	var windows []types.ScheduledMaintenance
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var window types.ScheduledMaintenance
+		k.cdc.MustUnmarshal(value, &window)
+		windows = append(windows, window)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryScheduledMaintenanceResponse{Upcoming: windows, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PendingSpStoragePrices(goCtx context.Context, req *types.QueryPendingSpStoragePricesRequest) (*types.QueryPendingSpStoragePricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSpStoragePricePrefix)
+	var prices []types.PendingSpStoragePrice
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var price types.PendingSpStoragePrice
+		k.cdc.MustUnmarshal(value, &price)
+		prices = append(prices, price)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryPendingSpStoragePricesResponse{PendingPrices: prices, Pagination: pageRes}, nil
+}
+
+func (k Keeper) SpKeyRotation(goCtx context.Context, req *types.QuerySpKeyRotationRequest) (*types.QuerySpKeyRotationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rotation, found := k.GetSpKeyRotation(ctx, req.Id)
+	if !found {
+		return nil, types.ErrSpKeyRotationNotFound
+	}
+	return &types.QuerySpKeyRotationResponse{KeyRotation: *rotation}, nil
+}
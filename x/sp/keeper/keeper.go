@@ -19,6 +19,7 @@ type (
 		accountKeeper types.AccountKeeper
 		bankKeeper    types.BankKeeper
 		authzKeeper   types.AuthzKeeper
+		storageKeeper types.StorageKeeper
 
 		spSequence sequence.Sequence[uint32]
 		authority  string
@@ -48,6 +49,10 @@ func NewKeeper(
 	return k
 }
 
+func (k *Keeper) SetStorageKeeper(storageKeeper types.StorageKeeper) {
+	k.storageKeeper = storageKeeper
+}
+
 func (k Keeper) GetAuthority() string {
 	return k.authority
 }
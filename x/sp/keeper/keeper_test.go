@@ -28,6 +28,7 @@ type KeeperTestSuite struct {
 	bankKeeper    *types.MockBankKeeper
 	accountKeeper *types.MockAccountKeeper
 	authzKeeper   *types.MockAuthzKeeper
+	storageKeeper *types.MockStorageKeeper
 
 	ctx         sdk.Context
 	queryClient types.QueryClient
@@ -45,6 +46,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	bankKeeper := types.NewMockBankKeeper(ctrl)
 	accountKeeper := types.NewMockAccountKeeper(ctrl)
 	authzKeeper := types.NewMockAuthzKeeper(ctrl)
+	storageKeeper := types.NewMockStorageKeeper(ctrl)
 
 	s.spKeeper = keeper.NewKeeper(
 		encCfg.Codec,
@@ -54,12 +56,14 @@ func (s *KeeperTestSuite) SetupTest() {
 		authzKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	s.spKeeper.SetStorageKeeper(storageKeeper)
 
 	s.cdc = encCfg.Codec
 
 	s.bankKeeper = bankKeeper
 	s.accountKeeper = accountKeeper
 	s.authzKeeper = authzKeeper
+	s.storageKeeper = storageKeeper
 
 	err := s.spKeeper.SetParams(s.ctx, types.DefaultParams())
 	s.Require().NoError(err)
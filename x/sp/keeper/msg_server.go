@@ -188,6 +188,7 @@ func (k msgServer) EditStorageProvider(goCtx context.Context, msg *types.MsgEdit
 	if !found {
 		return nil, types.ErrStorageProviderNotFound
 	}
+	prev := *sp
 
 	changed := false
 
@@ -240,10 +241,28 @@ func (k msgServer) EditStorageProvider(goCtx context.Context, msg *types.MsgEdit
 		changed = true
 	}
 
+	if msg.Location != nil {
+		// the sp can't move out of the locations allowed by the buckets it serves
+		if err := k.storageKeeper.VerifySPLocation(ctx, sp.Id, *msg.Location); err != nil {
+			return nil, err
+		}
+		sp.Location = *msg.Location
+		changed = true
+	}
+
+	// the replaced keys can be revoked without replacing the keys again
+	if msg.RevokeReplacedKeys {
+		changed = true
+	}
+
 	if !changed {
 		return nil, types.ErrStorageProviderNotChanged
 	}
 
+	if err := k.RotateSpKeys(ctx, &prev, sp, msg.RevokeReplacedKeys); err != nil {
+		return nil, err
+	}
+
 	k.SetStorageProvider(ctx, sp)
 	k.SetStorageProviderByFundingAddr(ctx, sp)
 	k.SetStorageProviderBySealAddr(ctx, sp)
@@ -261,6 +280,7 @@ func (k msgServer) EditStorageProvider(goCtx context.Context, msg *types.MsgEdit
 		GcAddress:          sp.GcAddress,
 		MaintenanceAddress: sp.MaintenanceAddress,
 		BlsKey:             hex.EncodeToString(sp.BlsKey),
+		Location:           sp.Location,
 	}); err != nil {
 		return nil, err
 	}
@@ -331,17 +351,124 @@ func (k msgServer) UpdateSpStoragePrice(goCtx context.Context, msg *types.MsgUpd
 
 	current := ctx.BlockTime().Unix()
 	spStorePrice := types.SpStoragePrice{
-		UpdateTimeSec: current,
-		SpId:          sp.Id,
-		ReadPrice:     msg.ReadPrice,
-		StorePrice:    msg.StorePrice,
-		FreeReadQuota: msg.FreeReadQuota,
+		UpdateTimeSec:   current,
+		SpId:            sp.Id,
+		ReadPrice:       msg.ReadPrice,
+		StorePrice:      msg.StorePrice,
+		FreeReadQuota:   msg.FreeReadQuota,
+		StorePriceTiers: msg.StorePriceTiers,
 	}
-	k.SetSpStoragePrice(ctx, spStorePrice)
+
+	// a price increase should be announced in advance, so that the users can react before it takes effect
+	noticePeriod := int64(params.PriceIncreaseNoticeDays) * 24 * 3600
+	isIncrease := false
+	if prevPrice, found := k.GetSpStoragePrice(ctx, sp.Id); found {
+		isIncrease = spStorePrice.IsIncreaseOf(prevPrice)
+	}
+
+	if msg.EffectiveTime == 0 {
+		if isIncrease && noticePeriod > 0 {
+			return nil, errors.Wrapf(types.ErrPriceIncreaseNoticeRequired, "the price increase should take effect no earlier than %d", current+noticePeriod)
+		}
+		k.CancelPendingSpStoragePrice(ctx, sp.Id)
+		k.SetSpStoragePrice(ctx, spStorePrice)
+		return &types.MsgUpdateSpStoragePriceResponse{}, nil
+	}
+
+	if msg.EffectiveTime <= current {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "effective time %d is not in the future", msg.EffectiveTime)
+	}
+	if isIncrease && msg.EffectiveTime < current+noticePeriod {
+		return nil, errors.Wrapf(types.ErrPriceIncreaseNoticeRequired, "the price increase should take effect no earlier than %d", current+noticePeriod)
+	}
+	k.SetPendingSpStoragePrice(ctx, types.PendingSpStoragePrice{
+		SpId:            sp.Id,
+		AnnounceTime:    current,
+		EffectiveTime:   msg.EffectiveTime,
+		ReadPrice:       msg.ReadPrice,
+		FreeReadQuota:   msg.FreeReadQuota,
+		StorePrice:      msg.StorePrice,
+		StorePriceTiers: msg.StorePriceTiers,
+	})
 
 	return &types.MsgUpdateSpStoragePriceResponse{}, nil
 }
 
+func (k msgServer) UpdateSpCapacity(goCtx context.Context, msg *types.MsgUpdateSpCapacity) (*types.MsgUpdateSpCapacityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	spAcc := sdk.MustAccAddressFromHex(msg.SpAddress)
+
+	sp, found := k.GetStorageProviderByOperatorAddr(ctx, spAcc)
+	if !found {
+		return nil, types.ErrStorageProviderNotFound
+	}
+
+	capacity := types.SpCapacity{
+		SpId:           sp.Id,
+		UpdateTimeSec:  ctx.BlockTime().Unix(),
+		TotalCapacity:  msg.TotalCapacity,
+		FreeCapacity:   msg.FreeCapacity,
+		MaxGvgFamilies: msg.MaxGvgFamilies,
+	}
+	k.SetSpCapacity(ctx, capacity)
+
+	if err := ctx.EventManager().EmitTypedEvents(&types.EventSpCapacityUpdate{
+		SpId:           capacity.SpId,
+		UpdateTimeSec:  capacity.UpdateTimeSec,
+		TotalCapacity:  capacity.TotalCapacity,
+		FreeCapacity:   capacity.FreeCapacity,
+		MaxGvgFamilies: capacity.MaxGvgFamilies,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateSpCapacityResponse{}, nil
+}
+
+func (k msgServer) ScheduleMaintenance(goCtx context.Context, msg *types.MsgScheduleMaintenance) (*types.MsgScheduleMaintenanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	spAcc := sdk.MustAccAddressFromHex(msg.SpAddress)
+
+	sp, found := k.GetStorageProviderByOperatorAddr(ctx, spAcc)
+	if !found {
+		return nil, types.ErrStorageProviderNotFound
+	}
+
+	if err := k.Keeper.ScheduleMaintenance(ctx, sp, msg.StartTime, msg.Duration); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvents(&types.EventScheduleMaintenance{
+		SpId:      sp.Id,
+		StartTime: msg.StartTime,
+		Duration:  msg.Duration,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgScheduleMaintenanceResponse{}, nil
+}
+
+func (k msgServer) CancelScheduledMaintenance(goCtx context.Context, msg *types.MsgCancelScheduledMaintenance) (*types.MsgCancelScheduledMaintenanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	spAcc := sdk.MustAccAddressFromHex(msg.SpAddress)
+
+	sp, found := k.GetStorageProviderByOperatorAddr(ctx, spAcc)
+	if !found {
+		return nil, types.ErrStorageProviderNotFound
+	}
+
+	if err := k.Keeper.CancelScheduledMaintenance(ctx, sp.Id, msg.StartTime); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvents(&types.EventCancelScheduledMaintenance{
+		SpId:      sp.Id,
+		StartTime: msg.StartTime,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgCancelScheduledMaintenanceResponse{}, nil
+}
+
 func IsLastDaysOfTheMonth(now time.Time, days int) bool {
 	now = now.UTC()
 	year, month, _ := now.Date()
@@ -439,3 +566,65 @@ func (k msgServer) UpdateSpStatus(goCtx context.Context, msg *types.MsgUpdateSto
 	}
 	return &types.MsgUpdateStorageProviderStatusResponse{}, nil
 }
+
+// UnjailStorageProvider tops up the deposit of a jailed storage provider and puts it back to STATUS_IN_SERVICE,
+// the storage provider should have stayed in jail for the min jail blocks, the top-up should be no less than the
+// unjail deposit, and the total deposit after the top-up should be no less than the min deposit.
+func (k msgServer) UnjailStorageProvider(goCtx context.Context, msg *types.MsgUnjailStorageProvider) (*types.MsgUnjailStorageProviderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fundAcc := sdk.MustAccAddressFromHex(msg.Creator)
+
+	sp, found := k.GetStorageProviderByFundingAddr(ctx, fundAcc)
+	if !found {
+		return nil, types.ErrStorageProviderNotFound
+	}
+
+	if !sdk.MustAccAddressFromHex(sp.OperatorAddress).Equals(sdk.MustAccAddressFromHex(msg.SpAddress)) {
+		return nil, types.ErrDepositAccountNotAllowed.Wrap("the sp address mismatch")
+	}
+
+	if sp.Status != types.STATUS_IN_JAILED {
+		return nil, types.ErrStorageProviderWrongStatus.Wrapf("sp is not jailed, status: %s", sp.Status.String())
+	}
+
+	params := k.GetParams(ctx)
+	if jailedHeight, found := k.GetStorageProviderJailedHeight(ctx, sp.Id); found && uint64(ctx.BlockHeight()) < jailedHeight+params.MinJailBlocks {
+		return nil, types.ErrStorageProviderWrongStatus.Wrapf("sp is jailed until height %d", jailedHeight+params.MinJailBlocks)
+	}
+
+	depositDenom := k.DepositDenomForSP(ctx)
+	if depositDenom != msg.Deposit.GetDenom() {
+		return nil, errors.Wrapf(types.ErrInvalidDenom, "invalid coin denomination: got %s, expected %s", msg.Deposit.Denom, depositDenom)
+	}
+	if !params.UnjailDeposit.IsNil() && msg.Deposit.Amount.LT(params.UnjailDeposit) {
+		return nil, errors.Wrapf(types.ErrInsufficientDepositAmount, "the top-up should be no less than the unjail deposit %s", params.UnjailDeposit)
+	}
+	totalDeposit := sp.TotalDeposit.Add(msg.Deposit.Amount)
+	if minDeposit := k.MinDeposit(ctx); totalDeposit.LT(minDeposit) {
+		return nil, errors.Wrapf(types.ErrInsufficientDepositAmount, "the total deposit after the top-up should be no less than %s", minDeposit)
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(depositDenom, msg.Deposit.Amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sp.GetFundingAccAddress(), types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	sp.TotalDeposit = totalDeposit
+	sp.Status = types.STATUS_IN_SERVICE
+	k.SetStorageProvider(ctx, sp)
+	ctx.KVStore(k.storeKey).Delete(types.GetStorageProviderJailedHeightKey(sp.Id))
+
+	if err := ctx.EventManager().EmitTypedEvents(&types.EventDeposit{
+		FundingAddress: msg.Creator,
+		Deposit:        msg.Deposit.String(),
+		TotalDeposit:   sp.TotalDeposit.String(),
+	}, &types.EventUpdateStorageProviderStatus{
+		SpId:      sp.Id,
+		SpAddress: sp.OperatorAddress,
+		PreStatus: types.STATUS_IN_JAILED.String(),
+		NewStatus: types.STATUS_IN_SERVICE.String(),
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgUnjailStorageProviderResponse{}, nil
+}
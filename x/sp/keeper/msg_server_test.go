@@ -157,3 +157,33 @@ func (s *KeeperTestSuite) TestIsLastDaysOfTheMonth() {
 	s.Require().True(keeper.IsLastDaysOfTheMonth(time.Unix(1693414861, 0), 2))  // 2023-08-30 UTC
 	s.Require().True(!keeper.IsLastDaysOfTheMonth(time.Unix(1693587661, 0), 2)) // 2023-09-01 UTC
 }
+
+func (s *KeeperTestSuite) TestEditStorageProviderLocation() {
+	sp := &sptypes.StorageProvider{
+		Id:              100,
+		OperatorAddress: sample.RandAccAddressHex(),
+		FundingAddress:  sample.RandAccAddressHex(),
+		SealAddress:     sample.RandAccAddressHex(),
+		ApprovalAddress: sample.RandAccAddressHex(),
+		GcAddress:       sample.RandAccAddressHex(),
+		BlsKey:          sample.RandBlsPubKey(),
+		Location:        sptypes.SpLocation{Region: "eu-west", Jurisdiction: "DE"},
+	}
+	s.setSpWithIndexes(sp)
+	s.spKeeper.SetStorageProviderByOperatorAddr(s.ctx, sp)
+
+	// the sp can't move out of the locations allowed by the buckets it serves
+	usUS := sptypes.SpLocation{Region: "us-east", Jurisdiction: "US"}
+	s.storageKeeper.EXPECT().VerifySPLocation(gomock.Any(), sp.Id, usUS).Return(sptypes.ErrStorageProviderWrongStatus)
+	_, err := s.msgServer.EditStorageProvider(s.ctx, &sptypes.MsgEditStorageProvider{SpAddress: sp.OperatorAddress, Location: &usUS})
+	require.ErrorIs(s.T(), err, sptypes.ErrStorageProviderWrongStatus)
+	found, _ := s.spKeeper.GetStorageProvider(s.ctx, sp.Id)
+	require.Equal(s.T(), "DE", found.Location.Jurisdiction)
+
+	euFR := sptypes.SpLocation{Region: "eu-west", Jurisdiction: "FR"}
+	s.storageKeeper.EXPECT().VerifySPLocation(gomock.Any(), sp.Id, euFR).Return(nil)
+	_, err = s.msgServer.EditStorageProvider(s.ctx, &sptypes.MsgEditStorageProvider{SpAddress: sp.OperatorAddress, Location: &euFR})
+	require.NoError(s.T(), err)
+	found, _ = s.spKeeper.GetStorageProvider(s.ctx, sp.Id)
+	require.Equal(s.T(), euFR, found.Location)
+}
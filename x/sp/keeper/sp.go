@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"math"
+
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -141,6 +143,11 @@ func (k Keeper) Exit(ctx sdk.Context, sp *types.StorageProvider) error {
 	store.Delete(types.GetStorageProviderByGcAddrKey(sdk.MustAccAddressFromHex(sp.GcAddress)))
 	store.Delete(types.GetStorageProviderKey(k.spSequence.EncodeSequence(sp.Id)))
 	store.Delete(types.GetStorageProviderByBlsKeyKey(types.GetStorageProviderByBlsKeyKey(sp.GetBlsKey())))
+	store.Delete(types.GetStorageProviderJailedHeightKey(sp.Id))
+	if rotation, found := k.GetSpKeyRotation(ctx, sp.Id); found {
+		k.deleteSpKeyRotation(ctx, rotation)
+		return k.dropExpiredSpKeys(ctx, sp, rotation, math.MaxInt64)
+	}
 	return nil
 }
 
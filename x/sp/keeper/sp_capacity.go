@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+// GetSpCapacity returns the capacity declared by a storage provider,
+// found is false if the storage provider has never declared its capacity, in which case no admission control applies.
+func (k Keeper) GetSpCapacity(ctx sdk.Context, spId uint32) (capacity types.SpCapacity, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetStorageProviderCapacityKey(spId))
+	if bz == nil {
+		return capacity, false
+	}
+	k.cdc.MustUnmarshal(bz, &capacity)
+	return capacity, true
+}
+
+func (k Keeper) SetSpCapacity(ctx sdk.Context, capacity types.SpCapacity) {
+	ctx.KVStore(k.storeKey).Set(types.GetStorageProviderCapacityKey(capacity.SpId), k.cdc.MustMarshal(&capacity))
+}
+
+// ReserveSpCapacity reserves the size from the free capacity declared by a storage provider,
+// it fails if the storage provider would be committed beyond its declared free capacity.
+func (k Keeper) ReserveSpCapacity(ctx sdk.Context, spId uint32, size uint64) error {
+	capacity, found := k.GetSpCapacity(ctx, spId)
+	if !found {
+		return nil
+	}
+	if size > capacity.FreeCapacity {
+		return errors.Wrapf(types.ErrStorageProviderCapacityExceeded, "sp %d, free capacity: %d, required: %d", spId, capacity.FreeCapacity, size)
+	}
+	capacity.FreeCapacity -= size
+	k.SetSpCapacity(ctx, capacity)
+	return nil
+}
+
+// ReleaseSpCapacity gives the size back to the free capacity declared by a storage provider once it is no longer
+// committed to it, the free capacity never exceeds the total capacity.
+func (k Keeper) ReleaseSpCapacity(ctx sdk.Context, spId uint32, size uint64) {
+	capacity, found := k.GetSpCapacity(ctx, spId)
+	if !found {
+		return
+	}
+	if size > capacity.TotalCapacity-capacity.FreeCapacity {
+		capacity.FreeCapacity = capacity.TotalCapacity
+	} else {
+		capacity.FreeCapacity += size
+	}
+	k.SetSpCapacity(ctx, capacity)
+}
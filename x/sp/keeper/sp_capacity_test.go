@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateSpCapacity() {
+	k := s.spKeeper
+	ctx := s.ctx.WithBlockTime(time.Unix(1700000000, 0))
+
+	sp := &types.StorageProvider{
+		Id:              101,
+		OperatorAddress: sample.RandAccAddressHex(),
+		Status:          types.STATUS_IN_SERVICE,
+	}
+	k.SetStorageProvider(ctx, sp)
+	k.SetStorageProviderByOperatorAddr(ctx, sp)
+
+	// no admission control before the capacity is declared
+	err := k.ReserveSpCapacity(ctx, sp.Id, 1<<40)
+	require.NoError(s.T(), err)
+	_, err = k.StorageProviderCapacity(ctx, &types.QueryStorageProviderCapacityRequest{Id: sp.Id})
+	require.Error(s.T(), err)
+
+	msg := &types.MsgUpdateSpCapacity{
+		SpAddress:      sp.OperatorAddress,
+		TotalCapacity:  1000,
+		FreeCapacity:   2000,
+		MaxGvgFamilies: 2,
+	}
+	require.Error(s.T(), msg.ValidateBasic())
+
+	msg.FreeCapacity = 800
+	require.NoError(s.T(), msg.ValidateBasic())
+	_, err = s.msgServer.UpdateSpCapacity(ctx, msg)
+	require.NoError(s.T(), err)
+
+	err = k.ReserveSpCapacity(ctx, sp.Id, 500)
+	require.NoError(s.T(), err)
+	err = k.ReserveSpCapacity(ctx, sp.Id, 500)
+	require.ErrorIs(s.T(), err, types.ErrStorageProviderCapacityExceeded)
+
+	res, err := k.StorageProviderCapacity(ctx, &types.QueryStorageProviderCapacityRequest{Id: sp.Id})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), types.SpCapacity{
+		SpId:           sp.Id,
+		UpdateTimeSec:  ctx.BlockTime().Unix(),
+		TotalCapacity:  1000,
+		FreeCapacity:   300,
+		MaxGvgFamilies: 2,
+	}, res.Capacity)
+
+	// only the operator of a sp can declare the capacity
+	msg.SpAddress = sample.RandAccAddressHex()
+	_, err = s.msgServer.UpdateSpCapacity(ctx, msg)
+	require.ErrorIs(s.T(), err, types.ErrStorageProviderNotFound)
+}
+
+func (s *KeeperTestSuite) TestReleaseSpCapacity() {
+	k := s.spKeeper
+	ctx := s.ctx
+
+	// nothing to release before the capacity is declared
+	k.ReleaseSpCapacity(ctx, 102, 100)
+	_, found := k.GetSpCapacity(ctx, 102)
+	require.False(s.T(), found)
+
+	k.SetSpCapacity(ctx, types.SpCapacity{SpId: 102, TotalCapacity: 1000, FreeCapacity: 1000})
+	require.NoError(s.T(), k.ReserveSpCapacity(ctx, 102, 600))
+	k.ReleaseSpCapacity(ctx, 102, 200)
+	capacity, _ := k.GetSpCapacity(ctx, 102)
+	require.Equal(s.T(), uint64(600), capacity.FreeCapacity)
+
+	// the free capacity never exceeds the total capacity
+	k.ReleaseSpCapacity(ctx, 102, 1000)
+	capacity, _ = k.GetSpCapacity(ctx, 102)
+	require.Equal(s.T(), uint64(1000), capacity.FreeCapacity)
+}
@@ -0,0 +1,259 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+// GetSpKeyRotation returns the key rotation of the storage provider in its overlap period
+func (k Keeper) GetSpKeyRotation(ctx sdk.Context, spId uint32) (*types.SpKeyRotation, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetSpKeyRotationKey(spId))
+	if bz == nil {
+		return nil, false
+	}
+	var rotation types.SpKeyRotation
+	k.cdc.MustUnmarshal(bz, &rotation)
+	return &rotation, true
+}
+
+func (k Keeper) setSpKeyRotation(ctx sdk.Context, rotation *types.SpKeyRotation) {
+	ctx.KVStore(k.storeKey).Set(types.GetSpKeyRotationKey(rotation.SpId), k.cdc.MustMarshal(rotation))
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SpKeyRotationQueuePrefix)
+	for _, expireHeight := range spKeyRotationExpireHeights(rotation) {
+		queueStore.Set(types.GetSpKeyRotationQueueKey(expireHeight, rotation.SpId), []byte{})
+	}
+}
+
+func (k Keeper) deleteSpKeyRotation(ctx sdk.Context, rotation *types.SpKeyRotation) {
+	ctx.KVStore(k.storeKey).Delete(types.GetSpKeyRotationKey(rotation.SpId))
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SpKeyRotationQueuePrefix)
+	for _, expireHeight := range spKeyRotationExpireHeights(rotation) {
+		queueStore.Delete(types.GetSpKeyRotationQueueKey(expireHeight, rotation.SpId))
+	}
+}
+
+// spKeyRotationExpireHeights returns the expiration heights of the replaced keys of the key rotation,
+// each replaced key is queued by its own expiration height
+func spKeyRotationExpireHeights(rotation *types.SpKeyRotation) []int64 {
+	var heights []int64
+	if rotation.SealAddress != "" {
+		heights = append(heights, rotation.SealAddressExpireHeight)
+	}
+	if rotation.ApprovalAddress != "" {
+		heights = append(heights, rotation.ApprovalAddressExpireHeight)
+	}
+	if rotation.GcAddress != "" {
+		heights = append(heights, rotation.GcAddressExpireHeight)
+	}
+	if len(rotation.BlsKey) != 0 {
+		heights = append(heights, rotation.BlsKeyExpireHeight)
+	}
+	return heights
+}
+
+// deleteSpKeyIndex deletes the index of a replaced key, unless the key is in use again or it belongs to another sp
+func (k Keeper) deleteSpKeyIndex(ctx sdk.Context, sp *types.StorageProvider, key []byte, current []byte) {
+	store := ctx.KVStore(k.storeKey)
+	if bytes.Equal(key, current) {
+		return
+	}
+	if id := store.Get(key); id != nil && k.spSequence.DecodeSequence(id) == sp.Id {
+		store.Delete(key)
+	}
+}
+
+// checkSpKeyIndex checks the new key of a sp is not used by another sp, including the replaced keys in overlap periods
+func (k Keeper) checkSpKeyIndex(ctx sdk.Context, sp *types.StorageProvider, key []byte, err error) error {
+	if id := ctx.KVStore(k.storeKey).Get(key); id != nil && k.spSequence.DecodeSequence(id) != sp.Id {
+		return err
+	}
+	return nil
+}
+
+// RotateSpKeys replaces the seal, approval, gc addresses and bls key of a storage provider. The replaced keys are
+// still resolved to the storage provider and accepted for the key rotation overlap blocks, so that the approvals
+// signed and the seals made with the replaced keys are not broken at the moment of the replacement. If revoke is set,
+// the replaced keys, including those replaced earlier and still in their overlap periods, are dropped at once.
+func (k Keeper) RotateSpKeys(ctx sdk.Context, prev, sp *types.StorageProvider, revoke bool) error {
+	sealChanged := prev.SealAddress != sp.SealAddress
+	approvalChanged := prev.ApprovalAddress != sp.ApprovalAddress
+	gcChanged := prev.GcAddress != sp.GcAddress
+	blsChanged := !bytes.Equal(prev.BlsKey, sp.BlsKey)
+	if !sealChanged && !approvalChanged && !gcChanged && !blsChanged && !revoke {
+		return nil
+	}
+
+	if sealChanged {
+		if err := k.checkSpKeyIndex(ctx, sp, types.GetStorageProviderBySealAddrKey(sp.GetSealAccAddress()), types.ErrStorageProviderSealAddrExists); err != nil {
+			return err
+		}
+	}
+	if approvalChanged {
+		if err := k.checkSpKeyIndex(ctx, sp, types.GetStorageProviderByApprovalAddrKey(sp.GetApprovalAccAddress()), types.ErrStorageProviderApprovalAddrExists); err != nil {
+			return err
+		}
+	}
+	if gcChanged {
+		if err := k.checkSpKeyIndex(ctx, sp, types.GetStorageProviderByGcAddrKey(sp.GetGcAccAddress()), types.ErrStorageProviderGcAddrExists); err != nil {
+			return err
+		}
+	}
+	if blsChanged {
+		if err := k.checkSpKeyIndex(ctx, sp, types.GetStorageProviderByBlsKeyKey(sp.BlsKey), types.ErrStorageProviderBlsKeyExists); err != nil {
+			return err
+		}
+	}
+
+	rotation, found := k.GetSpKeyRotation(ctx, sp.Id)
+	if found {
+		k.deleteSpKeyRotation(ctx, rotation)
+	} else {
+		rotation = &types.SpKeyRotation{SpId: sp.Id}
+	}
+	overlap := k.GetParams(ctx).KeyRotationOverlapBlocks
+	expireHeight := ctx.BlockHeight() + int64(overlap)
+	event := &types.EventSpKeyRotation{SpId: sp.Id, ExpireHeight: expireHeight}
+	// a key replaced earlier is dropped once it is replaced again, the other replaced keys keep their expiration heights
+	if sealChanged {
+		if rotation.SealAddress != "" {
+			k.deleteSpKeyIndex(ctx, sp, types.GetStorageProviderBySealAddrKey(sdk.MustAccAddressFromHex(rotation.SealAddress)),
+				types.GetStorageProviderBySealAddrKey(sp.GetSealAccAddress()))
+		}
+		rotation.SealAddress = prev.SealAddress
+		rotation.SealAddressExpireHeight = expireHeight
+		event.SealAddress = prev.SealAddress
+	}
+	if approvalChanged {
+		if rotation.ApprovalAddress != "" {
+			k.deleteSpKeyIndex(ctx, sp, types.GetStorageProviderByApprovalAddrKey(sdk.MustAccAddressFromHex(rotation.ApprovalAddress)),
+				types.GetStorageProviderByApprovalAddrKey(sp.GetApprovalAccAddress()))
+		}
+		rotation.ApprovalAddress = prev.ApprovalAddress
+		rotation.ApprovalAddressExpireHeight = expireHeight
+		event.ApprovalAddress = prev.ApprovalAddress
+	}
+	if gcChanged {
+		if rotation.GcAddress != "" {
+			k.deleteSpKeyIndex(ctx, sp, types.GetStorageProviderByGcAddrKey(sdk.MustAccAddressFromHex(rotation.GcAddress)),
+				types.GetStorageProviderByGcAddrKey(sp.GetGcAccAddress()))
+		}
+		rotation.GcAddress = prev.GcAddress
+		rotation.GcAddressExpireHeight = expireHeight
+		event.GcAddress = prev.GcAddress
+	}
+	if blsChanged {
+		if len(rotation.BlsKey) != 0 {
+			k.deleteSpKeyIndex(ctx, sp, types.GetStorageProviderByBlsKeyKey(rotation.BlsKey), types.GetStorageProviderByBlsKeyKey(sp.BlsKey))
+		}
+		rotation.BlsKey = prev.BlsKey
+		rotation.BlsKeyExpireHeight = expireHeight
+		event.BlsKey = hex.EncodeToString(prev.BlsKey)
+	}
+
+	// the revoked keys are dropped as if their overlap periods end, so are the keys replaced without an overlap period
+	dropHeight := ctx.BlockHeight()
+	if revoke {
+		dropHeight = math.MaxInt64
+	}
+	if err := k.dropExpiredSpKeys(ctx, sp, rotation, dropHeight); err != nil {
+		return err
+	}
+	if len(spKeyRotationExpireHeights(rotation)) != 0 {
+		k.setSpKeyRotation(ctx, rotation)
+	}
+
+	if overlap == 0 || revoke || (!sealChanged && !approvalChanged && !gcChanged && !blsChanged) {
+		return nil
+	}
+	return ctx.EventManager().EmitTypedEvents(event)
+}
+
+// dropExpiredSpKeys deletes the indexes of the replaced keys of the key rotation which expire at the height,
+// and clears them from the key rotation.
+func (k Keeper) dropExpiredSpKeys(ctx sdk.Context, sp *types.StorageProvider, rotation *types.SpKeyRotation, height int64) error {
+	event := &types.EventCompleteSpKeyRotation{SpId: rotation.SpId}
+	if rotation.SealAddress != "" && rotation.SealAddressExpireHeight <= height {
+		k.deleteSpKeyIndex(ctx, sp, types.GetStorageProviderBySealAddrKey(sdk.MustAccAddressFromHex(rotation.SealAddress)),
+			types.GetStorageProviderBySealAddrKey(sp.GetSealAccAddress()))
+		event.SealAddress = rotation.SealAddress
+		rotation.SealAddress, rotation.SealAddressExpireHeight = "", 0
+	}
+	if rotation.ApprovalAddress != "" && rotation.ApprovalAddressExpireHeight <= height {
+		k.deleteSpKeyIndex(ctx, sp, types.GetStorageProviderByApprovalAddrKey(sdk.MustAccAddressFromHex(rotation.ApprovalAddress)),
+			types.GetStorageProviderByApprovalAddrKey(sp.GetApprovalAccAddress()))
+		event.ApprovalAddress = rotation.ApprovalAddress
+		rotation.ApprovalAddress, rotation.ApprovalAddressExpireHeight = "", 0
+	}
+	if rotation.GcAddress != "" && rotation.GcAddressExpireHeight <= height {
+		k.deleteSpKeyIndex(ctx, sp, types.GetStorageProviderByGcAddrKey(sdk.MustAccAddressFromHex(rotation.GcAddress)),
+			types.GetStorageProviderByGcAddrKey(sp.GetGcAccAddress()))
+		event.GcAddress = rotation.GcAddress
+		rotation.GcAddress, rotation.GcAddressExpireHeight = "", 0
+	}
+	if len(rotation.BlsKey) != 0 && rotation.BlsKeyExpireHeight <= height {
+		k.deleteSpKeyIndex(ctx, sp, types.GetStorageProviderByBlsKeyKey(rotation.BlsKey), types.GetStorageProviderByBlsKeyKey(sp.BlsKey))
+		event.BlsKey = hex.EncodeToString(rotation.BlsKey)
+		rotation.BlsKey, rotation.BlsKeyExpireHeight = nil, 0
+	}
+	if event.SealAddress == "" && event.ApprovalAddress == "" && event.GcAddress == "" && event.BlsKey == "" {
+		return nil
+	}
+	return ctx.EventManager().EmitTypedEvents(event)
+}
+
+// ProcessExpiredSpKeyRotations drops the replaced keys whose overlap periods end
+func (k Keeper) ProcessExpiredSpKeyRotations(ctx sdk.Context) {
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SpKeyRotationQueuePrefix)
+
+	var spIds []uint32
+	iterator := queueStore.Iterator(nil, types.GetSpKeyRotationQueueKey(ctx.BlockHeight()+1, 0))
+	for ; iterator.Valid(); iterator.Next() {
+		spIds = append(spIds, binary.BigEndian.Uint32(iterator.Key()[8:]))
+	}
+	iterator.Close()
+
+	for _, spId := range spIds {
+		rotation, found := k.GetSpKeyRotation(ctx, spId)
+		if !found {
+			continue
+		}
+		k.deleteSpKeyRotation(ctx, rotation)
+		sp, found := k.GetStorageProvider(ctx, spId)
+		if !found {
+			continue
+		}
+		if err := k.dropExpiredSpKeys(ctx, sp, rotation, ctx.BlockHeight()); err != nil {
+			ctx.Logger().Error("fail to emit complete sp key rotation event", "sp", spId, "err", err)
+		}
+		if len(spKeyRotationExpireHeights(rotation)) != 0 {
+			k.setSpKeyRotation(ctx, rotation)
+		}
+	}
+}
+
+// GetSpApprovalAddresses returns the approval addresses whose signatures are accepted for the storage provider,
+// the replaced approval address is included during the overlap period of a key rotation.
+func (k Keeper) GetSpApprovalAddresses(ctx sdk.Context, sp *types.StorageProvider) []sdk.AccAddress {
+	addrs := []sdk.AccAddress{sp.GetApprovalAccAddress()}
+	if rotation, found := k.GetSpKeyRotation(ctx, sp.Id); found && rotation.ApprovalAddress != "" {
+		addrs = append(addrs, sdk.MustAccAddressFromHex(rotation.ApprovalAddress))
+	}
+	return addrs
+}
+
+// GetSpBlsKeys returns the bls public keys whose signatures are accepted for the storage provider,
+// the replaced bls key is included during the overlap period of a key rotation.
+func (k Keeper) GetSpBlsKeys(ctx sdk.Context, sp *types.StorageProvider) [][]byte {
+	keys := [][]byte{sp.BlsKey}
+	if rotation, found := k.GetSpKeyRotation(ctx, sp.Id); found && len(rotation.BlsKey) != 0 {
+		keys = append(keys, rotation.BlsKey)
+	}
+	return keys
+}
@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+func (s *KeeperTestSuite) setSpWithIndexes(sp *types.StorageProvider) {
+	s.spKeeper.SetStorageProvider(s.ctx, sp)
+	s.spKeeper.SetStorageProviderBySealAddr(s.ctx, sp)
+	s.spKeeper.SetStorageProviderByApprovalAddr(s.ctx, sp)
+	s.spKeeper.SetStorageProviderByGcAddr(s.ctx, sp)
+	s.spKeeper.SetStorageProviderByBlsKey(s.ctx, sp)
+}
+
+func (s *KeeperTestSuite) TestRotateSpKeys() {
+	params := s.spKeeper.GetParams(s.ctx)
+	params.KeyRotationOverlapBlocks = 10
+	s.Require().NoError(s.spKeeper.SetParams(s.ctx, params))
+
+	sp := &types.StorageProvider{
+		Id:              100,
+		OperatorAddress: sample.RandAccAddressHex(),
+		SealAddress:     sample.RandAccAddressHex(),
+		ApprovalAddress: sample.RandAccAddressHex(),
+		GcAddress:       sample.RandAccAddressHex(),
+		BlsKey:          sample.RandBlsPubKey(),
+	}
+	s.setSpWithIndexes(sp)
+	other := &types.StorageProvider{
+		Id:              101,
+		OperatorAddress: sample.RandAccAddressHex(),
+		SealAddress:     sample.RandAccAddressHex(),
+		ApprovalAddress: sample.RandAccAddressHex(),
+		GcAddress:       sample.RandAccAddressHex(),
+		BlsKey:          sample.RandBlsPubKey(),
+	}
+	s.setSpWithIndexes(other)
+
+	// the new key is used by another sp
+	prev := *sp
+	edited := *sp
+	edited.SealAddress = other.SealAddress
+	s.Require().ErrorIs(s.spKeeper.RotateSpKeys(s.ctx, &prev, &edited, false), types.ErrStorageProviderSealAddrExists)
+
+	ctx := s.ctx.WithBlockHeight(100)
+	edited = *sp
+	edited.SealAddress = sample.RandAccAddressHex()
+	edited.ApprovalAddress = sample.RandAccAddressHex()
+	edited.BlsKey = sample.RandBlsPubKey()
+	s.Require().NoError(s.spKeeper.RotateSpKeys(ctx, &prev, &edited, false))
+	s.setSpWithIndexes(&edited)
+
+	rotation, found := s.spKeeper.GetSpKeyRotation(ctx, sp.Id)
+	s.Require().True(found)
+	s.Require().Equal(prev.SealAddress, rotation.SealAddress)
+	s.Require().Equal(prev.ApprovalAddress, rotation.ApprovalAddress)
+	s.Require().Equal("", rotation.GcAddress)
+	s.Require().Equal(prev.BlsKey, rotation.BlsKey)
+	s.Require().Equal(int64(110), rotation.SealAddressExpireHeight)
+	s.Require().Equal(int64(110), rotation.ApprovalAddressExpireHeight)
+	s.Require().Equal(int64(110), rotation.BlsKeyExpireHeight)
+
+	// both of the old and new keys are resolved during the overlap period
+	for _, addr := range []string{prev.SealAddress, edited.SealAddress} {
+		found, ok := s.spKeeper.GetStorageProviderBySealAddr(ctx, sdk.MustAccAddressFromHex(addr))
+		s.Require().True(ok)
+		s.Require().Equal(sp.Id, found.Id)
+	}
+	for _, blsKey := range [][]byte{prev.BlsKey, edited.BlsKey} {
+		_, ok := s.spKeeper.GetStorageProviderByBlsKey(ctx, blsKey)
+		s.Require().True(ok)
+	}
+	s.Require().Len(s.spKeeper.GetSpApprovalAddresses(ctx, &edited), 2)
+	s.Require().Len(s.spKeeper.GetSpBlsKeys(ctx, &edited), 2)
+
+	s.spKeeper.ProcessExpiredSpKeyRotations(ctx.WithBlockHeight(109))
+	_, found = s.spKeeper.GetSpKeyRotation(ctx, sp.Id)
+	s.Require().True(found)
+
+	s.spKeeper.ProcessExpiredSpKeyRotations(ctx.WithBlockHeight(110))
+	_, found = s.spKeeper.GetSpKeyRotation(ctx, sp.Id)
+	s.Require().False(found)
+	_, found = s.spKeeper.GetStorageProviderBySealAddr(ctx, sdk.MustAccAddressFromHex(prev.SealAddress))
+	s.Require().False(found)
+	_, found = s.spKeeper.GetStorageProviderBySealAddr(ctx, sdk.MustAccAddressFromHex(edited.SealAddress))
+	s.Require().True(found)
+	_, found = s.spKeeper.GetStorageProviderByBlsKey(ctx, prev.BlsKey)
+	s.Require().False(found)
+	s.Require().Len(s.spKeeper.GetSpApprovalAddresses(ctx, &edited), 1)
+}
+
+func (s *KeeperTestSuite) TestRotateSpKeysWithoutOverlap() {
+	sp := &types.StorageProvider{
+		Id:              200,
+		OperatorAddress: sample.RandAccAddressHex(),
+		SealAddress:     sample.RandAccAddressHex(),
+		ApprovalAddress: sample.RandAccAddressHex(),
+		GcAddress:       sample.RandAccAddressHex(),
+		BlsKey:          sample.RandBlsPubKey(),
+	}
+	s.setSpWithIndexes(sp)
+
+	prev := *sp
+	edited := *sp
+	edited.GcAddress = sample.RandAccAddressHex()
+	s.Require().NoError(s.spKeeper.RotateSpKeys(s.ctx, &prev, &edited, false))
+	s.setSpWithIndexes(&edited)
+
+	_, found := s.spKeeper.GetSpKeyRotation(s.ctx, sp.Id)
+	s.Require().False(found)
+	_, found = s.spKeeper.GetStorageProviderByGcAddr(s.ctx, sdk.MustAccAddressFromHex(prev.GcAddress))
+	s.Require().False(found)
+	_, found = s.spKeeper.GetStorageProviderByGcAddr(s.ctx, sdk.MustAccAddressFromHex(edited.GcAddress))
+	s.Require().True(found)
+}
+
+func (s *KeeperTestSuite) TestRotateSpKeysKeepExpireHeights() {
+	params := s.spKeeper.GetParams(s.ctx)
+	params.KeyRotationOverlapBlocks = 10
+	s.Require().NoError(s.spKeeper.SetParams(s.ctx, params))
+
+	sp := &types.StorageProvider{
+		Id:              300,
+		OperatorAddress: sample.RandAccAddressHex(),
+		SealAddress:     sample.RandAccAddressHex(),
+		ApprovalAddress: sample.RandAccAddressHex(),
+		GcAddress:       sample.RandAccAddressHex(),
+		BlsKey:          sample.RandBlsPubKey(),
+	}
+	s.setSpWithIndexes(sp)
+
+	first := *sp
+	edited := *sp
+	edited.SealAddress = sample.RandAccAddressHex()
+	s.Require().NoError(s.spKeeper.RotateSpKeys(s.ctx.WithBlockHeight(100), &first, &edited, false))
+	s.setSpWithIndexes(&edited)
+
+	// rotating another key does not extend the overlap period of the key replaced earlier
+	second := edited
+	edited.ApprovalAddress = sample.RandAccAddressHex()
+	s.Require().NoError(s.spKeeper.RotateSpKeys(s.ctx.WithBlockHeight(105), &second, &edited, false))
+	s.setSpWithIndexes(&edited)
+
+	rotation, found := s.spKeeper.GetSpKeyRotation(s.ctx, sp.Id)
+	s.Require().True(found)
+	s.Require().Equal(first.SealAddress, rotation.SealAddress)
+	s.Require().Equal(int64(110), rotation.SealAddressExpireHeight)
+	s.Require().Equal(first.ApprovalAddress, rotation.ApprovalAddress)
+	s.Require().Equal(int64(115), rotation.ApprovalAddressExpireHeight)
+
+	s.spKeeper.ProcessExpiredSpKeyRotations(s.ctx.WithBlockHeight(110))
+	rotation, found = s.spKeeper.GetSpKeyRotation(s.ctx, sp.Id)
+	s.Require().True(found)
+	s.Require().Equal("", rotation.SealAddress)
+	s.Require().Equal(first.ApprovalAddress, rotation.ApprovalAddress)
+	_, found = s.spKeeper.GetStorageProviderBySealAddr(s.ctx, sdk.MustAccAddressFromHex(first.SealAddress))
+	s.Require().False(found)
+	s.Require().Len(s.spKeeper.GetSpApprovalAddresses(s.ctx, &edited), 2)
+
+	s.spKeeper.ProcessExpiredSpKeyRotations(s.ctx.WithBlockHeight(115))
+	_, found = s.spKeeper.GetSpKeyRotation(s.ctx, sp.Id)
+	s.Require().False(found)
+	s.Require().Len(s.spKeeper.GetSpApprovalAddresses(s.ctx, &edited), 1)
+}
+
+func (s *KeeperTestSuite) TestRotateSpKeysRevoke() {
+	params := s.spKeeper.GetParams(s.ctx)
+	params.KeyRotationOverlapBlocks = 10
+	s.Require().NoError(s.spKeeper.SetParams(s.ctx, params))
+
+	sp := &types.StorageProvider{
+		Id:              400,
+		OperatorAddress: sample.RandAccAddressHex(),
+		SealAddress:     sample.RandAccAddressHex(),
+		ApprovalAddress: sample.RandAccAddressHex(),
+		GcAddress:       sample.RandAccAddressHex(),
+		BlsKey:          sample.RandBlsPubKey(),
+	}
+	s.setSpWithIndexes(sp)
+
+	first := *sp
+	edited := *sp
+	edited.SealAddress = sample.RandAccAddressHex()
+	s.Require().NoError(s.spKeeper.RotateSpKeys(s.ctx, &first, &edited, false))
+	s.setSpWithIndexes(&edited)
+
+	// the keys replaced by the edit and earlier are dropped at once
+	second := edited
+	edited.ApprovalAddress = sample.RandAccAddressHex()
+	s.Require().NoError(s.spKeeper.RotateSpKeys(s.ctx, &second, &edited, true))
+	s.setSpWithIndexes(&edited)
+
+	_, found := s.spKeeper.GetSpKeyRotation(s.ctx, sp.Id)
+	s.Require().False(found)
+	_, found = s.spKeeper.GetStorageProviderBySealAddr(s.ctx, sdk.MustAccAddressFromHex(first.SealAddress))
+	s.Require().False(found)
+	_, found = s.spKeeper.GetStorageProviderByApprovalAddr(s.ctx, sdk.MustAccAddressFromHex(first.ApprovalAddress))
+	s.Require().False(found)
+	_, found = s.spKeeper.GetStorageProviderBySealAddr(s.ctx, sdk.MustAccAddressFromHex(edited.SealAddress))
+	s.Require().True(found)
+	s.Require().Len(s.spKeeper.GetSpApprovalAddresses(s.ctx, &edited), 1)
+}
@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+// GetScheduledMaintenancesOfSp returns the upcoming maintenance windows of a storage provider in the order of the start time
+func (k Keeper) GetScheduledMaintenancesOfSp(ctx sdk.Context, spId uint32) (windows []types.ScheduledMaintenance) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledMaintenancePrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var window types.ScheduledMaintenance
+		k.cdc.MustUnmarshal(iterator.Value(), &window)
+		if window.SpId == spId {
+			windows = append(windows, window)
+		}
+	}
+	return windows
+}
+
+// getActiveScheduledMaintenanceOfSp returns the started maintenance window of a storage provider, if any
+func (k Keeper) getActiveScheduledMaintenanceOfSp(ctx sdk.Context, spId uint32) (window types.ScheduledMaintenance, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ActiveScheduledMaintenancePrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		k.cdc.MustUnmarshal(iterator.Value(), &window)
+		if window.SpId == spId {
+			return window, true
+		}
+	}
+	return window, false
+}
+
+// IsSpInScheduledMaintenance returns whether a storage provider is in a started maintenance window it pre-announced
+func (k Keeper) IsSpInScheduledMaintenance(ctx sdk.Context, spId uint32) bool {
+	_, found := k.getActiveScheduledMaintenanceOfSp(ctx, spId)
+	return found
+}
+
+// ScheduleMaintenance pre-announces a future maintenance window of a storage provider,
+// the window should not overlap with the other windows of the storage provider.
+func (k Keeper) ScheduleMaintenance(ctx sdk.Context, sp *types.StorageProvider, startTime, duration int64) error {
+	if sp.Status != types.STATUS_IN_SERVICE && sp.Status != types.STATUS_IN_MAINTENANCE {
+		return types.ErrStorageProviderWrongStatus.Wrapf("sp is not in service or in maintenance, status: %s", sp.Status.String())
+	}
+	if startTime <= ctx.BlockTime().Unix() {
+		return errors.Wrapf(types.ErrInvalidScheduledMaintenance, "start time %d is not in the future", startTime)
+	}
+	quota := k.GetParams(ctx).MaintenanceDurationQuota
+	if duration > quota {
+		return errors.Wrapf(types.ErrInvalidScheduledMaintenance, "duration %d exceeds the maintenance quota %d", duration, quota)
+	}
+
+	windows := k.GetScheduledMaintenancesOfSp(ctx, sp.Id)
+	if len(windows) >= types.MaxScheduledMaintenancesPerSp {
+		return errors.Wrapf(types.ErrInvalidScheduledMaintenance, "at most %d maintenance windows can be scheduled", types.MaxScheduledMaintenancesPerSp)
+	}
+	if active, found := k.getActiveScheduledMaintenanceOfSp(ctx, sp.Id); found {
+		windows = append(windows, active)
+	}
+	for _, w := range windows {
+		if startTime < w.StartTime+w.Duration && w.StartTime < startTime+duration {
+			return errors.Wrapf(types.ErrInvalidScheduledMaintenance, "overlaps with the maintenance window starting at %d", w.StartTime)
+		}
+	}
+
+	window := types.ScheduledMaintenance{
+		SpId:      sp.Id,
+		StartTime: startTime,
+		Duration:  duration,
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledMaintenancePrefix)
+	store.Set(types.GetScheduledMaintenanceKey(startTime, sp.Id), k.cdc.MustMarshal(&window))
+	return nil
+}
+
+// CancelScheduledMaintenance removes an upcoming maintenance window of a storage provider
+func (k Keeper) CancelScheduledMaintenance(ctx sdk.Context, spId uint32, startTime int64) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledMaintenancePrefix)
+	key := types.GetScheduledMaintenanceKey(startTime, spId)
+	if !store.Has(key) {
+		return types.ErrScheduledMaintenanceNotFound
+	}
+	store.Delete(key)
+	return nil
+}
+
+// ProcessScheduledMaintenances puts the storage providers into maintenance at the start of their scheduled windows,
+// and back to service at the end of the windows.
+func (k Keeper) ProcessScheduledMaintenances(ctx sdk.Context) {
+	now := ctx.BlockTime().Unix()
+
+	// start the due windows
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledMaintenancePrefix)
+	activeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ActiveScheduledMaintenancePrefix)
+	for _, window := range k.collectDueMaintenances(store, now) {
+		store.Delete(types.GetScheduledMaintenanceKey(window.StartTime, window.SpId))
+
+		sp, found := k.GetStorageProvider(ctx, window.SpId)
+		if !found {
+			continue
+		}
+		if sp.Status != types.STATUS_IN_SERVICE {
+			k.dropScheduledMaintenance(ctx, window, "sp is not in service, status: "+sp.Status.String())
+			continue
+		}
+		if err := k.UpdateToInMaintenance(ctx, sp, window.Duration); err != nil {
+			k.dropScheduledMaintenance(ctx, window, err.Error())
+			continue
+		}
+		k.SetStorageProvider(ctx, sp)
+
+		// the window is tracked by its actual start time, which matches the maintenance record
+		active := types.ScheduledMaintenance{SpId: sp.Id, StartTime: now, Duration: window.Duration}
+		activeStore.Set(types.GetScheduledMaintenanceKey(now+window.Duration, sp.Id), k.cdc.MustMarshal(&active))
+		_ = ctx.EventManager().EmitTypedEvents(&types.EventUpdateStorageProviderStatus{
+			SpId:      sp.Id,
+			SpAddress: sp.OperatorAddress,
+			PreStatus: types.STATUS_IN_SERVICE.String(),
+			NewStatus: types.STATUS_IN_MAINTENANCE.String(),
+		})
+	}
+
+	// end the finished windows
+	for _, active := range k.collectDueMaintenances(activeStore, now) {
+		activeStore.Delete(types.GetScheduledMaintenanceKey(active.StartTime+active.Duration, active.SpId))
+
+		sp, found := k.GetStorageProvider(ctx, active.SpId)
+		if !found || sp.Status != types.STATUS_IN_MAINTENANCE || !k.isInMaintenanceSince(ctx, sp, active.StartTime) {
+			// the sp has left the maintenance started by the window by itself
+			continue
+		}
+		k.UpdateToInService(ctx, sp)
+		k.SetStorageProvider(ctx, sp)
+		_ = ctx.EventManager().EmitTypedEvents(&types.EventUpdateStorageProviderStatus{
+			SpId:      sp.Id,
+			SpAddress: sp.OperatorAddress,
+			PreStatus: types.STATUS_IN_MAINTENANCE.String(),
+			NewStatus: types.STATUS_IN_SERVICE.String(),
+		})
+	}
+}
+
+// collectDueMaintenances returns the windows whose key time is not after now
+func (k Keeper) collectDueMaintenances(store prefix.Store, now int64) (windows []types.ScheduledMaintenance) {
+	iterator := store.Iterator(nil, types.GetScheduledMaintenanceKey(now+1, 0))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var window types.ScheduledMaintenance
+		k.cdc.MustUnmarshal(iterator.Value(), &window)
+		windows = append(windows, window)
+	}
+	return windows
+}
+
+// isInMaintenanceSince checks the ongoing maintenance of the storage provider is requested at the time
+func (k Keeper) isInMaintenanceSince(ctx sdk.Context, sp *types.StorageProvider, requestAt int64) bool {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetStorageProviderMaintenanceRecordsKey(sdk.MustAccAddressFromHex(sp.OperatorAddress)))
+	if bz == nil {
+		return false
+	}
+	var stats types.SpMaintenanceStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	if len(stats.Records) == 0 {
+		return false
+	}
+	lastRecord := stats.Records[len(stats.Records)-1]
+	return lastRecord.RequestAt == requestAt && lastRecord.ActualDuration == 0
+}
+
+func (k Keeper) dropScheduledMaintenance(ctx sdk.Context, window types.ScheduledMaintenance, reason string) {
+	ctx.Logger().Info("drop scheduled maintenance", "sp", window.SpId, "start", window.StartTime, "reason", reason)
+	_ = ctx.EventManager().EmitTypedEvents(&types.EventCancelScheduledMaintenance{
+		SpId:      window.SpId,
+		StartTime: window.StartTime,
+		Reason:    reason,
+	})
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+func (s *KeeperTestSuite) TestScheduledMaintenance() {
+	k := s.spKeeper
+	now := int64(1700000000)
+	ctx := s.ctx.WithBlockTime(time.Unix(now, 0)).WithBlockHeight(100)
+
+	sp := &types.StorageProvider{
+		Id:              102,
+		OperatorAddress: sample.RandAccAddressHex(),
+		Status:          types.STATUS_IN_SERVICE,
+	}
+	k.SetStorageProvider(ctx, sp)
+	k.SetStorageProviderByOperatorAddr(ctx, sp)
+
+	schedule := func(startTime, duration int64) error {
+		_, err := s.msgServer.ScheduleMaintenance(ctx, types.NewMsgScheduleMaintenance(sp.GetOperatorAccAddress(), startTime, duration))
+		return err
+	}
+
+	// invalid windows
+	require.ErrorIs(s.T(), schedule(now, 3600), types.ErrInvalidScheduledMaintenance)
+	require.ErrorIs(s.T(), schedule(now+100, types.DefaultMaintenanceDurationQuota+1), types.ErrInvalidScheduledMaintenance)
+
+	require.NoError(s.T(), schedule(now+100, 3600))
+	require.NoError(s.T(), schedule(now+7200, 600))
+	// overlapping window
+	require.ErrorIs(s.T(), schedule(now+3000, 3600), types.ErrInvalidScheduledMaintenance)
+
+	_, err := s.msgServer.CancelScheduledMaintenance(ctx, types.NewMsgCancelScheduledMaintenance(sp.GetOperatorAccAddress(), now+7200))
+	require.NoError(s.T(), err)
+	_, err = s.msgServer.CancelScheduledMaintenance(ctx, types.NewMsgCancelScheduledMaintenance(sp.GetOperatorAccAddress(), now+7200))
+	require.ErrorIs(s.T(), err, types.ErrScheduledMaintenanceNotFound)
+
+	res, err := k.ScheduledMaintenance(ctx, &types.QueryScheduledMaintenanceRequest{})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []types.ScheduledMaintenance{{SpId: sp.Id, StartTime: now + 100, Duration: 3600}}, res.Upcoming)
+
+	// nothing happens before the window starts
+	k.ProcessScheduledMaintenances(ctx)
+	sp, _ = k.GetStorageProvider(ctx, sp.Id)
+	require.Equal(s.T(), types.STATUS_IN_SERVICE, sp.Status)
+
+	// the sp enters maintenance at the start of the window
+	ctx = ctx.WithBlockTime(time.Unix(now+100, 0))
+	k.ProcessScheduledMaintenances(ctx)
+	sp, _ = k.GetStorageProvider(ctx, sp.Id)
+	require.Equal(s.T(), types.STATUS_IN_MAINTENANCE, sp.Status)
+	res, err = k.ScheduledMaintenance(ctx, &types.QueryScheduledMaintenanceRequest{})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), res.Upcoming, 0)
+
+	// and back to service at the end of the window
+	ctx = ctx.WithBlockTime(time.Unix(now+3700, 0))
+	k.ProcessScheduledMaintenances(ctx)
+	sp, _ = k.GetStorageProvider(ctx, sp.Id)
+	require.Equal(s.T(), types.STATUS_IN_SERVICE, sp.Status)
+	require.Equal(s.T(), int64(3600), k.GetMaintenanceUsedDuration(ctx, sp))
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+// GetSpScoreStats returns the service history of a storage provider
+func (k Keeper) GetSpScoreStats(ctx sdk.Context, spId uint32) types.SpScoreStats {
+	var stats types.SpScoreStats
+	bz := ctx.KVStore(k.storeKey).Get(types.GetStorageProviderScoreStatsKey(spId))
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &stats)
+	}
+	return stats
+}
+
+func (k Keeper) updateSpScoreStats(ctx sdk.Context, spId uint32, update func(stats *types.SpScoreStats)) {
+	stats := k.GetSpScoreStats(ctx, spId)
+	update(&stats)
+	ctx.KVStore(k.storeKey).Set(types.GetStorageProviderScoreStatsKey(spId), k.cdc.MustMarshal(&stats))
+}
+
+// RecordSpChallengeResult records the result of an attested challenge (including heartbeat) of a storage provider
+func (k Keeper) RecordSpChallengeResult(ctx sdk.Context, spId uint32, slashed bool) {
+	k.updateSpScoreStats(ctx, spId, func(stats *types.SpScoreStats) {
+		if slashed {
+			stats.ChallengeSlashedCount++
+		} else {
+			stats.ChallengePassedCount++
+		}
+	})
+}
+
+// RecordSpSealResult records an object sealed or rejected to seal by a primary storage provider
+func (k Keeper) RecordSpSealResult(ctx sdk.Context, spId uint32, rejected bool) {
+	k.updateSpScoreStats(ctx, spId, func(stats *types.SpScoreStats) {
+		if rejected {
+			stats.SealRejectionCount++
+		} else {
+			stats.SealCount++
+		}
+	})
+}
+
+// RecordSpSwapOut records a swap-out requested by a storage provider
+func (k Keeper) RecordSpSwapOut(ctx sdk.Context, spId uint32) {
+	k.updateSpScoreStats(ctx, spId, func(stats *types.SpScoreStats) {
+		stats.SwapOutCount++
+	})
+}
+
+// RecordSpExit records a graceful exit requested by a storage provider or a forced exit by governance
+func (k Keeper) RecordSpExit(ctx sdk.Context, spId uint32, forced bool) {
+	k.updateSpScoreStats(ctx, spId, func(stats *types.SpScoreStats) {
+		if forced {
+			stats.ForcedExitCount++
+		} else {
+			stats.ExitCount++
+		}
+	})
+}
+
+// GetMaintenanceUsedDuration returns the maintenance duration used in the kept maintenance records of a storage provider,
+// the ongoing maintenance is counted until now.
+func (k Keeper) GetMaintenanceUsedDuration(ctx sdk.Context, sp *types.StorageProvider) int64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetStorageProviderMaintenanceRecordsKey(sdk.MustAccAddressFromHex(sp.OperatorAddress)))
+	if bz == nil {
+		return 0
+	}
+	var stats types.SpMaintenanceStats
+	k.cdc.MustUnmarshal(bz, &stats)
+
+	used := int64(0)
+	for _, record := range stats.Records {
+		if record.ActualDuration != 0 {
+			used += record.ActualDuration
+			continue
+		}
+		ongoing := ctx.BlockTime().Unix() - record.RequestAt
+		if ongoing > record.RequestDuration {
+			ongoing = record.RequestDuration
+		}
+		if ongoing > 0 {
+			used += ongoing
+		}
+	}
+	return used
+}
+
+// GetStorageProviderScore returns the scorecard of a storage provider
+func (k Keeper) GetStorageProviderScore(ctx sdk.Context, spId uint32) (types.StorageProviderScore, bool) {
+	sp, found := k.GetStorageProvider(ctx, spId)
+	if !found {
+		return types.StorageProviderScore{}, false
+	}
+	return types.NewStorageProviderScore(spId, k.GetSpScoreStats(ctx, spId),
+		k.GetMaintenanceUsedDuration(ctx, sp), k.GetParams(ctx).MaintenanceDurationQuota), true
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+func (s *KeeperTestSuite) TestStorageProviderScore() {
+	k := s.spKeeper
+	ctx := s.ctx.WithBlockTime(time.Unix(1700000000, 0)).WithBlockHeight(100)
+
+	_, err := k.StorageProviderScore(ctx, &types.QueryStorageProviderScoreRequest{Id: 100})
+	require.ErrorIs(s.T(), err, types.ErrStorageProviderNotFound)
+
+	sp := &types.StorageProvider{
+		Id:              100,
+		OperatorAddress: sample.RandAccAddressHex(),
+		Status:          types.STATUS_IN_SERVICE,
+	}
+	k.SetStorageProvider(ctx, sp)
+
+	// a new sp has the full score
+	res, err := k.StorageProviderScore(ctx, &types.QueryStorageProviderScoreRequest{Id: sp.Id})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), types.MaxSpScore, res.Score.Score)
+
+	for i := 0; i < 3; i++ {
+		k.RecordSpChallengeResult(ctx, sp.Id, false)
+	}
+	k.RecordSpChallengeResult(ctx, sp.Id, true)
+	k.RecordSpSealResult(ctx, sp.Id, false)
+	k.RecordSpSealResult(ctx, sp.Id, true)
+	k.RecordSpSwapOut(ctx, sp.Id)
+
+	// the ongoing maintenance is counted until now
+	err = k.UpdateToInMaintenance(ctx, sp, 3600)
+	require.NoError(s.T(), err)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(1800 * time.Second))
+
+	res, err = k.StorageProviderScore(ctx, &types.QueryStorageProviderScoreRequest{Id: sp.Id})
+	require.NoError(s.T(), err)
+	score := res.Score
+	require.Equal(s.T(), types.SpScoreStats{
+		ChallengePassedCount:  3,
+		ChallengeSlashedCount: 1,
+		SealCount:             1,
+		SealRejectionCount:    1,
+		SwapOutCount:          1,
+	}, score.Stats)
+	require.Equal(s.T(), int64(1800), score.MaintenanceUsedDuration)
+	require.Equal(s.T(), uint32(7500), score.ChallengeScore)
+	require.Equal(s.T(), uint32(5000), score.SealScore)
+	require.Equal(s.T(), uint32(10000-1800*10000/types.DefaultMaintenanceDurationQuota), score.MaintenanceScore)
+	require.Equal(s.T(), uint32(9500), score.StabilityScore)
+	require.Equal(s.T(), (7500*40+5000*25+score.MaintenanceScore*20+9500*15)/100, score.Score)
+
+	// the exits decrease the stability score further
+	k.RecordSpExit(ctx, sp.Id, true)
+	k.RecordSpExit(ctx, sp.Id, false)
+	score, found := k.GetStorageProviderScore(ctx, sp.Id)
+	require.True(s.T(), found)
+	require.Equal(s.T(), uint32(10000-types.SwapOutPenalty-types.ExitPenalty-types.ForcedExitPenalty), score.StabilityScore)
+}
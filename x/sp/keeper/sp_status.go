@@ -114,3 +114,40 @@ func (k Keeper) ForceUpdateMaintenanceRecords(ctx sdk.Context) {
 		}
 	}
 }
+
+// JailStorageProvider puts a storage provider in service or in maintenance to STATUS_IN_JAILED, e.g. when it is
+// slashed too many times by the data availability challenges. A jailed storage provider can not serve new buckets
+// until it is unjailed by topping up its deposit after staying in jail for the min jail blocks.
+func (k Keeper) JailStorageProvider(ctx sdk.Context, spId uint32) error {
+	sp, found := k.GetStorageProvider(ctx, spId)
+	if !found {
+		return types.ErrStorageProviderNotFound
+	}
+	preStatus := sp.Status
+	switch preStatus {
+	case types.STATUS_IN_SERVICE:
+	case types.STATUS_IN_MAINTENANCE:
+		k.UpdateToInService(ctx, sp)
+	default:
+		return types.ErrStorageProviderWrongStatus.Wrapf("sp is not in service or in maintenance, status: %s", preStatus.String())
+	}
+	sp.Status = types.STATUS_IN_JAILED
+	k.SetStorageProvider(ctx, sp)
+	ctx.KVStore(k.storeKey).Set(types.GetStorageProviderJailedHeightKey(sp.Id), sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventUpdateStorageProviderStatus{
+		SpId:      sp.Id,
+		SpAddress: sp.OperatorAddress,
+		PreStatus: preStatus.String(),
+		NewStatus: types.STATUS_IN_JAILED.String(),
+	})
+}
+
+// GetStorageProviderJailedHeight returns the height at which a jailed storage provider is jailed
+func (k Keeper) GetStorageProviderJailedHeight(ctx sdk.Context, spId uint32) (uint64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetStorageProviderJailedHeightKey(spId))
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
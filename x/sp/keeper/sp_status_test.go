@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+func (s *KeeperTestSuite) TestJailAndUnjailStorageProvider() {
+	k := s.spKeeper
+	ctx := s.ctx
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	params := k.GetParams(ctx)
+	params.UnjailDeposit = k.MinDeposit(ctx).QuoRaw(4)
+	params.MinJailBlocks = 100
+	require.NoError(s.T(), k.SetParams(ctx, params))
+
+	minDeposit := k.MinDeposit(ctx)
+	sp := &types.StorageProvider{
+		Id:              103,
+		OperatorAddress: sample.RandAccAddressHex(),
+		FundingAddress:  sample.RandAccAddressHex(),
+		Status:          types.STATUS_IN_SERVICE,
+		TotalDeposit:    minDeposit.QuoRaw(2),
+	}
+	k.SetStorageProvider(ctx, sp)
+	k.SetStorageProviderByFundingAddr(ctx, sp)
+
+	unjail := func(amount sdkmath.Int) error {
+		_, err := s.msgServer.UnjailStorageProvider(ctx, types.NewMsgUnjailStorageProvider(sp.GetFundingAccAddress(),
+			sp.GetOperatorAccAddress(), sdk.NewCoin(k.DepositDenomForSP(ctx), amount)))
+		return err
+	}
+
+	// the sp is not jailed
+	require.ErrorIs(s.T(), unjail(minDeposit), types.ErrStorageProviderWrongStatus)
+
+	require.NoError(s.T(), k.JailStorageProvider(ctx, sp.Id))
+	sp, _ = k.GetStorageProvider(ctx, sp.Id)
+	require.Equal(s.T(), types.STATUS_IN_JAILED, sp.Status)
+	// a jailed sp can not be jailed again
+	require.ErrorIs(s.T(), k.JailStorageProvider(ctx, sp.Id), types.ErrStorageProviderWrongStatus)
+
+	// the sp should stay in jail for the min jail blocks
+	require.ErrorIs(s.T(), unjail(minDeposit.QuoRaw(2)), types.ErrStorageProviderWrongStatus)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 100)
+
+	// the top-up is less than the unjail deposit
+	sp.TotalDeposit = minDeposit
+	k.SetStorageProvider(ctx, sp)
+	require.ErrorIs(s.T(), unjail(minDeposit.QuoRaw(8)), types.ErrInsufficientDepositAmount)
+	sp.TotalDeposit = minDeposit.QuoRaw(2)
+	k.SetStorageProvider(ctx, sp)
+
+	// the top-up is not enough to restore the min deposit
+	require.ErrorIs(s.T(), unjail(minDeposit.QuoRaw(4)), types.ErrInsufficientDepositAmount)
+
+	require.NoError(s.T(), unjail(minDeposit.QuoRaw(2)))
+	sp, _ = k.GetStorageProvider(ctx, sp.Id)
+	require.Equal(s.T(), types.STATUS_IN_SERVICE, sp.Status)
+	require.Equal(s.T(), minDeposit, sp.TotalDeposit)
+	_, found := k.GetStorageProviderJailedHeight(ctx, sp.Id)
+	require.False(s.T(), found)
+}
@@ -1,8 +1,10 @@
 package keeper
 
 import (
+	"encoding/binary"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -14,11 +16,12 @@ import (
 // SetSpStoragePrice set a specific SpStoragePrice in the store from its index
 func (k Keeper) SetSpStoragePrice(ctx sdk.Context, spStoragePrice types.SpStoragePrice) {
 	event := &types.EventSpStoragePriceUpdate{
-		SpId:          spStoragePrice.SpId,
-		UpdateTimeSec: spStoragePrice.UpdateTimeSec,
-		ReadPrice:     spStoragePrice.ReadPrice,
-		StorePrice:    spStoragePrice.StorePrice,
-		FreeReadQuota: spStoragePrice.FreeReadQuota,
+		SpId:            spStoragePrice.SpId,
+		UpdateTimeSec:   spStoragePrice.UpdateTimeSec,
+		ReadPrice:       spStoragePrice.ReadPrice,
+		StorePrice:      spStoragePrice.StorePrice,
+		FreeReadQuota:   spStoragePrice.FreeReadQuota,
+		StorePriceTiers: spStoragePrice.StorePriceTiers,
 	}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SpStoragePriceKeyPrefix)
 	key := types.SpStoragePriceKey(spStoragePrice.SpId)
@@ -69,6 +72,7 @@ func (k Keeper) SetGlobalSpStorePrice(ctx sdk.Context, globalSpStorePrice types.
 		PrimaryStorePrice:   globalSpStorePrice.PrimaryStorePrice,
 		SecondaryStorePrice: globalSpStorePrice.SecondaryStorePrice,
 		ReadPrice:           globalSpStorePrice.ReadPrice,
+		StorePriceTiers:     globalSpStorePrice.StorePriceTiers,
 	}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GlobalSpStorePriceKeyPrefix)
 	key := types.GlobalSpStorePriceKey(
@@ -84,6 +88,7 @@ func (k Keeper) SetGlobalSpStorePrice(ctx sdk.Context, globalSpStorePrice types.
 func (k Keeper) UpdateGlobalSpStorePrice(ctx sdk.Context) error {
 	sps := k.GetAllStorageProviders(ctx)
 	current := ctx.BlockTime().Unix()
+	spPrices := make([]types.SpStoragePrice, 0)
 	storePrices := make([]sdk.Dec, 0)
 	readPrices := make([]sdk.Dec, 0)
 	for _, sp := range sps {
@@ -92,6 +97,7 @@ func (k Keeper) UpdateGlobalSpStorePrice(ctx sdk.Context) error {
 			if !found {
 				return fmt.Errorf("cannot find price for storage provider %d", sp.Id)
 			}
+			spPrices = append(spPrices, price)
 			storePrices = append(storePrices, price.StorePrice)
 			readPrices = append(readPrices, price.ReadPrice)
 		}
@@ -101,8 +107,9 @@ func (k Keeper) UpdateGlobalSpStorePrice(ctx sdk.Context) error {
 		return nil
 	}
 
+	secondarySpStorePriceRatio := k.SecondarySpStorePriceRatio(ctx)
 	primaryStorePrice := k.calculateMedian(storePrices)
-	secondaryStorePrice := k.SecondarySpStorePriceRatio(ctx).Mul(primaryStorePrice)
+	secondaryStorePrice := secondarySpStorePriceRatio.Mul(primaryStorePrice)
 	readPrice := k.calculateMedian(readPrices)
 
 	globalSpStorePrice := types.GlobalSpStorePrice{
@@ -110,11 +117,49 @@ func (k Keeper) UpdateGlobalSpStorePrice(ctx sdk.Context) error {
 		SecondaryStorePrice: secondaryStorePrice,
 		ReadPrice:           readPrice,
 		UpdateTimeSec:       current,
+		StorePriceTiers:     k.calculateGlobalStorePriceTiers(spPrices, primaryStorePrice, secondarySpStorePriceRatio),
 	}
 	k.SetGlobalSpStorePrice(ctx, globalSpStorePrice)
 	return nil
 }
 
+// calculateGlobalStorePriceTiers calculates the global tiers at each charge size threshold published by any sp,
+// the price of a tier is the median of the prices all sps apply at the threshold, and the tiers without
+// a discount compared to the previous one are skipped.
+func (k Keeper) calculateGlobalStorePriceTiers(spPrices []types.SpStoragePrice, primaryStorePrice, secondarySpStorePriceRatio sdk.Dec) []types.GlobalStorePriceTier {
+	thresholdSet := make(map[uint64]struct{})
+	for _, spPrice := range spPrices {
+		for _, tier := range spPrice.StorePriceTiers {
+			thresholdSet[tier.ChargeSizeThreshold] = struct{}{}
+		}
+	}
+	thresholds := make([]uint64, 0, len(thresholdSet))
+	for threshold := range thresholdSet {
+		thresholds = append(thresholds, threshold)
+	}
+	sort.Slice(thresholds, func(i, j int) bool { return thresholds[i] < thresholds[j] })
+
+	tiers := make([]types.GlobalStorePriceTier, 0)
+	prevPrice := primaryStorePrice
+	for _, threshold := range thresholds {
+		prices := make([]sdk.Dec, 0, len(spPrices))
+		for _, spPrice := range spPrices {
+			prices = append(prices, spPrice.GetStorePrice(threshold))
+		}
+		price := k.calculateMedian(prices)
+		if !price.LT(prevPrice) {
+			continue
+		}
+		tiers = append(tiers, types.GlobalStorePriceTier{
+			ChargeSizeThreshold: threshold,
+			PrimaryStorePrice:   price,
+			SecondaryStorePrice: secondarySpStorePriceRatio.Mul(price),
+		})
+		prevPrice = price
+	}
+	return tiers
+}
+
 func (k Keeper) calculateMedian(prices []sdk.Dec) sdk.Dec {
 	l := len(prices)
 	sort.Slice(prices, func(i, j int) bool { return prices[i].LT(prices[j]) })
@@ -144,3 +189,103 @@ func (k Keeper) GetGlobalSpStorePriceByTime(ctx sdk.Context, time int64) (val ty
 	val.UpdateTimeSec = updateTimeSec
 	return val, nil
 }
+
+// GetPendingSpStoragePrice returns the pending storage price announced by a storage provider, if any
+func (k Keeper) GetPendingSpStoragePrice(ctx sdk.Context, spId uint32) (val types.PendingSpStoragePrice, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPendingSpStoragePriceKey(spId))
+	if bz == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(bz, &val)
+	return val, true
+}
+
+func (k Keeper) setPendingSpStoragePrice(ctx sdk.Context, pending types.PendingSpStoragePrice) {
+	ctx.KVStore(k.storeKey).Set(types.GetPendingSpStoragePriceKey(pending.SpId), k.cdc.MustMarshal(&pending))
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSpStoragePriceQueuePrefix)
+	queueStore.Set(types.GetPendingSpStoragePriceQueueKey(pending.EffectiveTime, pending.SpId), []byte{})
+	_ = ctx.EventManager().EmitTypedEvents(&types.EventPendingSpStoragePrice{
+		SpId:            pending.SpId,
+		EffectiveTime:   pending.EffectiveTime,
+		ReadPrice:       pending.ReadPrice,
+		FreeReadQuota:   pending.FreeReadQuota,
+		StorePrice:      pending.StorePrice,
+		StorePriceTiers: pending.StorePriceTiers,
+	})
+}
+
+func (k Keeper) deletePendingSpStoragePrice(ctx sdk.Context, pending types.PendingSpStoragePrice) {
+	ctx.KVStore(k.storeKey).Delete(types.GetPendingSpStoragePriceKey(pending.SpId))
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSpStoragePriceQueuePrefix)
+	queueStore.Delete(types.GetPendingSpStoragePriceQueueKey(pending.EffectiveTime, pending.SpId))
+}
+
+// SetPendingSpStoragePrice stores a storage price to take effect at its effective time,
+// a storage provider has at most one pending price and the previous one is superseded.
+func (k Keeper) SetPendingSpStoragePrice(ctx sdk.Context, pending types.PendingSpStoragePrice) {
+	k.CancelPendingSpStoragePrice(ctx, pending.SpId)
+	k.setPendingSpStoragePrice(ctx, pending)
+}
+
+// CancelPendingSpStoragePrice removes the pending storage price of a storage provider, if any
+func (k Keeper) CancelPendingSpStoragePrice(ctx sdk.Context, spId uint32) {
+	pending, found := k.GetPendingSpStoragePrice(ctx, spId)
+	if !found {
+		return
+	}
+	k.deletePendingSpStoragePrice(ctx, pending)
+	_ = ctx.EventManager().EmitTypedEvents(&types.EventCancelPendingSpStoragePrice{
+		SpId:          pending.SpId,
+		EffectiveTime: pending.EffectiveTime,
+	})
+}
+
+// ProcessPendingSpStoragePrices applies the pending storage prices which reach their effective time. The price of
+// a storage provider which is neither in service nor in maintenance is dropped, and the price reaching its effective time within
+// the last update_price_disallowed_days of the month is postponed to the beginning of the next month.
+func (k Keeper) ProcessPendingSpStoragePrices(ctx sdk.Context) {
+	now := ctx.BlockTime().Unix()
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSpStoragePriceQueuePrefix)
+
+	var spIds []uint32
+	iterator := queueStore.Iterator(nil, types.GetPendingSpStoragePriceQueueKey(now+1, 0))
+	for ; iterator.Valid(); iterator.Next() {
+		spIds = append(spIds, binary.BigEndian.Uint32(iterator.Key()[8:]))
+	}
+	iterator.Close()
+
+	params := k.GetParams(ctx)
+	for _, spId := range spIds {
+		pending, found := k.GetPendingSpStoragePrice(ctx, spId)
+		if !found {
+			continue
+		}
+		sp, found := k.GetStorageProvider(ctx, spId)
+		if !found {
+			k.deletePendingSpStoragePrice(ctx, pending)
+			continue
+		}
+		// the sp in a maintenance window still counts in the global price, so its announced price is kept
+		if sp.Status != types.STATUS_IN_SERVICE && sp.Status != types.STATUS_IN_MAINTENANCE {
+			k.CancelPendingSpStoragePrice(ctx, spId)
+			continue
+		}
+		if params.UpdateGlobalPriceInterval == 0 && IsLastDaysOfTheMonth(ctx.BlockTime(), int(params.UpdatePriceDisallowedDays)) {
+			k.deletePendingSpStoragePrice(ctx, pending)
+			year, month, _ := ctx.BlockTime().UTC().Date()
+			pending.EffectiveTime = time.Date(year, month+1, 1, 0, 0, 0, 0, time.UTC).Unix()
+			k.setPendingSpStoragePrice(ctx, pending)
+			continue
+		}
+
+		k.deletePendingSpStoragePrice(ctx, pending)
+		k.SetSpStoragePrice(ctx, types.SpStoragePrice{
+			SpId:            pending.SpId,
+			UpdateTimeSec:   now,
+			ReadPrice:       pending.ReadPrice,
+			FreeReadQuota:   pending.FreeReadQuota,
+			StorePrice:      pending.StorePrice,
+			StorePriceTiers: pending.StorePriceTiers,
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/bnb-chain/greenfield/testutil/sample"
 	"github.com/bnb-chain/greenfield/x/sp/types"
 )
 
@@ -87,3 +88,162 @@ func (s *KeeperTestSuite) TestGetGlobalSpStorePriceByTime() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateGlobalSpStorePriceTiers() {
+	keeper := s.spKeeper
+	ctx := s.ctx.WithBlockTime(time.Unix(100, 0))
+
+	spTiers := [][]types.SpStorePriceTier{
+		{{ChargeSizeThreshold: 1000, StorePrice: sdk.NewDec(80)}, {ChargeSizeThreshold: 2000, StorePrice: sdk.NewDec(60)}},
+		{{ChargeSizeThreshold: 1000, StorePrice: sdk.NewDec(90)}},
+		nil,
+	}
+	for i, tiers := range spTiers {
+		spId := uint32(i + 1)
+		keeper.SetStorageProvider(ctx, &types.StorageProvider{Id: spId, Status: types.STATUS_IN_SERVICE})
+		keeper.SetSpStoragePrice(ctx, types.SpStoragePrice{
+			SpId:            spId,
+			UpdateTimeSec:   1,
+			ReadPrice:       sdk.NewDec(100),
+			StorePrice:      sdk.NewDec(100),
+			StorePriceTiers: tiers,
+		})
+	}
+
+	err := keeper.UpdateGlobalSpStorePrice(ctx)
+	s.Require().NoError(err)
+	price, err := keeper.GetGlobalSpStorePriceByTime(ctx, 101)
+	s.Require().NoError(err)
+
+	// the median at 2000 is the same as the one at 1000, so there is only one tier
+	ratio := keeper.SecondarySpStorePriceRatio(ctx)
+	s.Require().Len(price.StorePriceTiers, 1)
+	s.Require().Equal(uint64(1000), price.StorePriceTiers[0].ChargeSizeThreshold)
+	s.Require().Equal(sdk.NewDec(90), price.StorePriceTiers[0].PrimaryStorePrice)
+	s.Require().Equal(ratio.MulInt64(90), price.StorePriceTiers[0].SecondaryStorePrice)
+
+	primary, secondary := price.GetStorePrice(999)
+	s.Require().Equal(sdk.NewDec(100), primary)
+	s.Require().Equal(ratio.MulInt64(100), secondary)
+	primary, _ = price.GetStorePrice(5000)
+	s.Require().Equal(sdk.NewDec(90), primary)
+}
+
+func (s *KeeperTestSuite) TestPendingSpStoragePrice() {
+	k := s.spKeeper
+	now := int64(1700000000)
+	ctx := s.ctx.WithBlockTime(time.Unix(now, 0))
+
+	params := k.GetParams(ctx)
+	params.PriceIncreaseNoticeDays = 7
+	s.Require().NoError(k.SetParams(ctx, params))
+	noticePeriod := int64(7 * 24 * 3600)
+
+	sp := &types.StorageProvider{
+		Id:              103,
+		OperatorAddress: sample.RandAccAddressHex(),
+		Status:          types.STATUS_IN_SERVICE,
+	}
+	k.SetStorageProvider(ctx, sp)
+	k.SetStorageProviderByOperatorAddr(ctx, sp)
+	k.SetSpStoragePrice(ctx, types.SpStoragePrice{
+		SpId:          sp.Id,
+		UpdateTimeSec: now,
+		ReadPrice:     sdk.NewDec(100),
+		StorePrice:    sdk.NewDec(100),
+		FreeReadQuota: 1000,
+	})
+
+	updatePrice := func(readPrice, storePrice int64, effectiveTime int64) error {
+		_, err := s.msgServer.UpdateSpStoragePrice(ctx, &types.MsgUpdateSpStoragePrice{
+			SpAddress:     sp.OperatorAddress,
+			ReadPrice:     sdk.NewDec(readPrice),
+			StorePrice:    sdk.NewDec(storePrice),
+			FreeReadQuota: 1000,
+			EffectiveTime: effectiveTime,
+		})
+		return err
+	}
+
+	// an increase should be announced in advance
+	s.Require().ErrorIs(updatePrice(100, 200, 0), types.ErrPriceIncreaseNoticeRequired)
+	s.Require().ErrorIs(updatePrice(100, 200, now+noticePeriod-1), types.ErrPriceIncreaseNoticeRequired)
+	s.Require().NoError(updatePrice(100, 200, now+noticePeriod))
+
+	res, err := k.PendingSpStoragePrices(ctx, &types.QueryPendingSpStoragePricesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.PendingPrices, 1)
+	s.Require().Equal(now+noticePeriod, res.PendingPrices[0].EffectiveTime)
+	s.Require().Equal(sdk.NewDec(200), res.PendingPrices[0].StorePrice)
+
+	// the pending price takes effect at the effective time
+	k.ProcessPendingSpStoragePrices(ctx.WithBlockTime(time.Unix(now+noticePeriod-1, 0)))
+	price, _ := k.GetSpStoragePrice(ctx, sp.Id)
+	s.Require().Equal(sdk.NewDec(100), price.StorePrice)
+
+	k.ProcessPendingSpStoragePrices(ctx.WithBlockTime(time.Unix(now+noticePeriod, 0)))
+	price, _ = k.GetSpStoragePrice(ctx, sp.Id)
+	s.Require().Equal(sdk.NewDec(200), price.StorePrice)
+	_, found := k.GetPendingSpStoragePrice(ctx, sp.Id)
+	s.Require().False(found)
+
+	// a decrease takes effect immediately and supersedes the pending price
+	s.Require().NoError(updatePrice(300, 200, now+2*noticePeriod))
+	s.Require().NoError(updatePrice(100, 150, 0))
+	price, _ = k.GetSpStoragePrice(ctx, sp.Id)
+	s.Require().Equal(sdk.NewDec(150), price.StorePrice)
+	_, found = k.GetPendingSpStoragePrice(ctx, sp.Id)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestProcessPendingSpStoragePrices() {
+	k := s.spKeeper
+	// 2023-08-20 UTC
+	now := int64(1692489600)
+	ctx := s.ctx.WithBlockTime(time.Unix(now, 0))
+
+	params := k.GetParams(ctx)
+	params.UpdateGlobalPriceInterval = 0
+	params.UpdatePriceDisallowedDays = 2
+	s.Require().NoError(k.SetParams(ctx, params))
+
+	inService := &types.StorageProvider{Id: 104, Status: types.STATUS_IN_SERVICE}
+	jailed := &types.StorageProvider{Id: 105, Status: types.STATUS_IN_JAILED}
+	inMaintenance := &types.StorageProvider{Id: 106, Status: types.STATUS_IN_MAINTENANCE}
+	for _, sp := range []*types.StorageProvider{inService, jailed, inMaintenance} {
+		k.SetStorageProvider(ctx, sp)
+		k.SetSpStoragePrice(ctx, types.SpStoragePrice{SpId: sp.Id, UpdateTimeSec: now, ReadPrice: sdk.NewDec(100), StorePrice: sdk.NewDec(100)})
+	}
+
+	// the prices are announced to take effect at 2023-08-30 01:00 UTC, within the last 2 days of the month
+	effectiveTime := int64(1693357200)
+	for _, sp := range []*types.StorageProvider{inService, jailed, inMaintenance} {
+		k.SetPendingSpStoragePrice(ctx, types.PendingSpStoragePrice{
+			SpId: sp.Id, EffectiveTime: effectiveTime, ReadPrice: sdk.NewDec(200), StorePrice: sdk.NewDec(200),
+		})
+	}
+
+	// the price of the jailed sp is dropped, the other ones are postponed to 2023-09-01 UTC
+	k.ProcessPendingSpStoragePrices(ctx.WithBlockTime(time.Unix(effectiveTime, 0)))
+	_, found := k.GetPendingSpStoragePrice(ctx, jailed.Id)
+	s.Require().False(found)
+	price, _ := k.GetSpStoragePrice(ctx, jailed.Id)
+	s.Require().Equal(sdk.NewDec(100), price.StorePrice)
+
+	for _, sp := range []*types.StorageProvider{inService, inMaintenance} {
+		pending, found := k.GetPendingSpStoragePrice(ctx, sp.Id)
+		s.Require().True(found)
+		s.Require().Equal(int64(1693526400), pending.EffectiveTime)
+		price, _ = k.GetSpStoragePrice(ctx, sp.Id)
+		s.Require().Equal(sdk.NewDec(100), price.StorePrice)
+	}
+
+	// the price of the sp in maintenance takes effect as well
+	k.ProcessPendingSpStoragePrices(ctx.WithBlockTime(time.Unix(1693526400, 0)))
+	for _, sp := range []*types.StorageProvider{inService, inMaintenance} {
+		price, _ = k.GetSpStoragePrice(ctx, sp.Id)
+		s.Require().Equal(sdk.NewDec(200), price.StorePrice)
+		_, found = k.GetPendingSpStoragePrice(ctx, sp.Id)
+		s.Require().False(found)
+	}
+}
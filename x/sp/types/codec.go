@@ -15,6 +15,10 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateSpStoragePrice{}, "sp/UpdateSpStoragePrice", nil)
 	cdc.RegisterConcrete(&DepositAuthorization{}, "sp/DepositAuthorization", nil)
 	cdc.RegisterConcrete(&MsgUpdateStorageProviderStatus{}, "sp/UpdateSpStatus", nil)
+	cdc.RegisterConcrete(&MsgUpdateSpCapacity{}, "sp/UpdateSpCapacity", nil)
+	cdc.RegisterConcrete(&MsgScheduleMaintenance{}, "sp/ScheduleMaintenance", nil)
+	cdc.RegisterConcrete(&MsgCancelScheduledMaintenance{}, "sp/CancelScheduledMaintenance", nil)
+	cdc.RegisterConcrete(&MsgUnjailStorageProvider{}, "sp/UnjailStorageProvider", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -33,6 +37,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateStorageProviderStatus{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateSpCapacity{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgScheduleMaintenance{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelScheduledMaintenance{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUnjailStorageProvider{},
+	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 	)
@@ -25,6 +25,11 @@ var (
 	ErrStorageProviderMaintenanceAddrExists = errors.Register(ModuleName, 17, "StorageProvider already exist for this maintenance address; must use new StorageProvider maintenance address.")
 	ErrStorageProviderPriceUpdateNotAllow   = errors.Register(ModuleName, 18, "StorageProvider update price is disallowed")
 	ErrStorageProviderWrongStatus           = errors.Register(ModuleName, 19, "StorageProvider is in wrong status")
+	ErrStorageProviderCapacityExceeded      = errors.Register(ModuleName, 20, "StorageProvider declared capacity is exceeded")
+	ErrInvalidScheduledMaintenance          = errors.Register(ModuleName, 21, "invalid scheduled maintenance")
+	ErrScheduledMaintenanceNotFound         = errors.Register(ModuleName, 22, "scheduled maintenance not found")
+	ErrPriceIncreaseNoticeRequired          = errors.Register(ModuleName, 23, "StorageProvider price increase should be announced in advance")
+	ErrSpKeyRotationNotFound                = errors.Register(ModuleName, 24, "StorageProvider key rotation not found")
 
 	ErrSignerNotGovModule  = errors.Register(ModuleName, 40, "signer is not gov module account")
 	ErrSignerEmpty         = errors.Register(ModuleName, 41, "signer is empty")
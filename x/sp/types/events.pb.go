@@ -191,6 +191,8 @@ type EventEditStorageProvider struct {
 	MaintenanceAddress string `protobuf:"bytes,8,opt,name=maintenance_address,json=maintenanceAddress,proto3" json:"maintenance_address,omitempty"`
 	// bls_key defines the bls pub key owned by storage provider used when sealing object
 	BlsKey string `protobuf:"bytes,9,opt,name=bls_key,json=blsKey,proto3" json:"bls_key,omitempty"`
+	// location defines the location attributes of the storage provider
+	Location SpLocation `protobuf:"bytes,10,opt,name=location,proto3" json:"location"`
 }
 
 func (m *EventEditStorageProvider) Reset()         { *m = EventEditStorageProvider{} }
@@ -289,6 +291,13 @@ func (m *EventEditStorageProvider) GetBlsKey() string {
 	return ""
 }
 
+func (m *EventEditStorageProvider) GetLocation() SpLocation {
+	if m != nil {
+		return m.Location
+	}
+	return SpLocation{}
+}
+
 // EventDeposit is emitted when sp deposit tokens.
 type EventDeposit struct {
 	// funding_address is the funding account address of the storage provider
@@ -364,6 +373,8 @@ type EventSpStoragePriceUpdate struct {
 	FreeReadQuota uint64 `protobuf:"varint,4,opt,name=free_read_quota,json=freeReadQuota,proto3" json:"free_read_quota,omitempty"`
 	// store price, in bnb wei per charge byte
 	StorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=store_price,json=storePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"store_price"`
+	// store price tiers, in ascending order of the charge size threshold
+	StorePriceTiers []SpStorePriceTier `protobuf:"bytes,6,rep,name=store_price_tiers,json=storePriceTiers,proto3" json:"store_price_tiers"`
 }
 
 func (m *EventSpStoragePriceUpdate) Reset()         { *m = EventSpStoragePriceUpdate{} }
@@ -420,6 +431,13 @@ func (m *EventSpStoragePriceUpdate) GetFreeReadQuota() uint64 {
 	return 0
 }
 
+func (m *EventSpStoragePriceUpdate) GetStorePriceTiers() []SpStorePriceTier {
+	if m != nil {
+		return m.StorePriceTiers
+	}
+	return nil
+}
+
 type EventGlobalSpStorePriceUpdate struct {
 	// update time, in unix timestamp
 	UpdateTimeSec int64 `protobuf:"varint,1,opt,name=update_time_sec,json=updateTimeSec,proto3" json:"update_time_sec,omitempty"`
@@ -429,6 +447,8 @@ type EventGlobalSpStorePriceUpdate struct {
 	PrimaryStorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=primary_store_price,json=primaryStorePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"primary_store_price"`
 	// secondary store price, in bnb wei per charge byte
 	SecondaryStorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=secondary_store_price,json=secondaryStorePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"secondary_store_price"`
+	// store price tiers, in ascending order of the charge size threshold
+	StorePriceTiers []GlobalStorePriceTier `protobuf:"bytes,5,rep,name=store_price_tiers,json=storePriceTiers,proto3" json:"store_price_tiers"`
 }
 
 func (m *EventGlobalSpStorePriceUpdate) Reset()         { *m = EventGlobalSpStorePriceUpdate{} }
@@ -471,6 +491,13 @@ func (m *EventGlobalSpStorePriceUpdate) GetUpdateTimeSec() int64 {
 	return 0
 }
 
+func (m *EventGlobalSpStorePriceUpdate) GetStorePriceTiers() []GlobalStorePriceTier {
+	if m != nil {
+		return m.StorePriceTiers
+	}
+	return nil
+}
+
 // EventUpdateStorageProviderStatus is emitted when the SP update its status successfully
 type EventUpdateStorageProviderStatus struct {
 	// sp_id defines the identifier of storage provider which generated on-chain
@@ -544,432 +571,617 @@ func (m *EventUpdateStorageProviderStatus) GetNewStatus() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventCreateStorageProvider)(nil), "greenfield.sp.EventCreateStorageProvider")
-	proto.RegisterType((*EventEditStorageProvider)(nil), "greenfield.sp.EventEditStorageProvider")
-	proto.RegisterType((*EventDeposit)(nil), "greenfield.sp.EventDeposit")
-	proto.RegisterType((*EventSpStoragePriceUpdate)(nil), "greenfield.sp.EventSpStoragePriceUpdate")
-	proto.RegisterType((*EventGlobalSpStorePriceUpdate)(nil), "greenfield.sp.EventGlobalSpStorePriceUpdate")
-	proto.RegisterType((*EventUpdateStorageProviderStatus)(nil), "greenfield.sp.EventUpdateStorageProviderStatus")
+type EventSpCapacityUpdate struct {
+	// sp id
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// update time, in unix timestamp
+	UpdateTimeSec int64 `protobuf:"varint,2,opt,name=update_time_sec,json=updateTimeSec,proto3" json:"update_time_sec,omitempty"`
+	// total storage capacity, in bytes
+	TotalCapacity uint64 `protobuf:"varint,3,opt,name=total_capacity,json=totalCapacity,proto3" json:"total_capacity,omitempty"`
+	// free storage capacity, in bytes
+	FreeCapacity uint64 `protobuf:"varint,4,opt,name=free_capacity,json=freeCapacity,proto3" json:"free_capacity,omitempty"`
+	// maximum number of global virtual group families as primary sp
+	MaxGvgFamilies uint32 `protobuf:"varint,5,opt,name=max_gvg_families,json=maxGvgFamilies,proto3" json:"max_gvg_families,omitempty"`
 }
 
-func init() { proto.RegisterFile("greenfield/sp/events.proto", fileDescriptor_685cbfa50fdf0841) }
-
-var fileDescriptor_685cbfa50fdf0841 = []byte{
-	// 819 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0x1b, 0x37,
-	0x10, 0xf6, 0x5a, 0x3f, 0xb6, 0x46, 0x56, 0xdc, 0xae, 0x13, 0x74, 0x2d, 0xc0, 0x8a, 0xa0, 0x02,
-	0x81, 0x51, 0x40, 0x12, 0x92, 0x1e, 0x72, 0x68, 0x51, 0x20, 0xb6, 0x82, 0x22, 0xe8, 0xa5, 0x5d,
-	0xb5, 0x97, 0x16, 0xc5, 0x82, 0xbb, 0x1c, 0x6f, 0x88, 0x48, 0x24, 0x4b, 0x52, 0x4e, 0x7d, 0xef,
-	0x03, 0xe4, 0xde, 0x77, 0xe8, 0x29, 0xc7, 0x3e, 0x40, 0x8e, 0x41, 0x4e, 0x45, 0x0f, 0x41, 0x61,
-	0x1f, 0xfa, 0x1a, 0xc5, 0x72, 0xb9, 0xd2, 0x4a, 0x30, 0x20, 0xd4, 0x56, 0x4e, 0x36, 0x39, 0xfc,
-	0xbe, 0x19, 0x7e, 0xf3, 0x71, 0xb4, 0xd0, 0x4e, 0x15, 0x22, 0x3f, 0x63, 0x38, 0xa1, 0x43, 0x2d,
-	0x87, 0x78, 0x8e, 0xdc, 0xe8, 0x81, 0x54, 0xc2, 0x08, 0xbf, 0xb5, 0x88, 0x0d, 0xb4, 0x6c, 0x77,
-	0x12, 0xa1, 0xa7, 0x42, 0x0f, 0x63, 0xa2, 0x71, 0x78, 0xfe, 0x30, 0x46, 0x43, 0x1e, 0x0e, 0x13,
-	0xc1, 0x78, 0x7e, 0xbc, 0x7d, 0x98, 0xc7, 0x23, 0xbb, 0x1a, 0xe6, 0x0b, 0x17, 0xba, 0x9b, 0x8a,
-	0x54, 0xe4, 0xfb, 0xd9, 0x7f, 0x05, 0x60, 0x39, 0xb7, 0xb9, 0x90, 0xe8, 0x00, 0xbd, 0xdf, 0x6b,
-	0xd0, 0x7e, 0x9a, 0xd5, 0x72, 0xaa, 0x90, 0x18, 0x1c, 0x1b, 0xa1, 0x48, 0x8a, 0xdf, 0x2a, 0x71,
-	0xce, 0x28, 0x2a, 0xff, 0x00, 0x6a, 0x5a, 0x46, 0x8c, 0x06, 0x5e, 0xd7, 0x3b, 0x6e, 0x85, 0x55,
-	0x2d, 0x9f, 0x51, 0xff, 0x31, 0x80, 0x96, 0x11, 0xa1, 0x54, 0xa1, 0xd6, 0xc1, 0x76, 0xd7, 0x3b,
-	0x6e, 0x9c, 0x04, 0xef, 0x5e, 0xf7, 0xef, 0xba, 0x52, 0x9e, 0xe4, 0x91, 0xb1, 0x51, 0x8c, 0xa7,
-	0x61, 0x43, 0x4b, 0xb7, 0xe1, 0x3f, 0x81, 0xfd, 0xb3, 0x19, 0xa7, 0x8c, 0xa7, 0x73, 0x74, 0x65,
-	0x0d, 0xfa, 0x8e, 0x03, 0x14, 0x14, 0x5f, 0xc0, 0x9e, 0x46, 0x32, 0x99, 0xe3, 0xab, 0x6b, 0xf0,
-	0xcd, 0xec, 0x74, 0x01, 0x3e, 0x85, 0x8f, 0x88, 0x94, 0x4a, 0x9c, 0x97, 0x08, 0x6a, 0x6b, 0x08,
-	0xf6, 0x0b, 0x44, 0x41, 0xf2, 0x18, 0x20, 0x4d, 0xe6, 0xf0, 0xfa, 0xba, 0xdb, 0xa7, 0x49, 0x01,
-	0x7c, 0x06, 0x07, 0x53, 0xc2, 0xb8, 0x41, 0x4e, 0x78, 0x82, 0x73, 0x86, 0x9d, 0x35, 0x0c, 0x7e,
-	0x09, 0x54, 0x50, 0xb5, 0x61, 0x17, 0x39, 0x95, 0x82, 0x71, 0x13, 0xec, 0x66, 0xf8, 0x70, 0xbe,
-	0xf6, 0xbf, 0x82, 0x96, 0x11, 0x86, 0x4c, 0x22, 0x8a, 0x52, 0x68, 0x66, 0x82, 0x46, 0xd7, 0x3b,
-	0x6e, 0x3e, 0x3a, 0x1c, 0x38, 0xf6, 0xcc, 0x55, 0x03, 0xe7, 0xaa, 0xc1, 0xa9, 0x60, 0x3c, 0xdc,
-	0xb3, 0xe7, 0x47, 0xf9, 0x71, 0xbf, 0x0f, 0x75, 0x6d, 0x88, 0x99, 0xe9, 0x00, 0xba, 0xde, 0xf1,
-	0x9d, 0x47, 0xf7, 0x06, 0x4b, 0xee, 0x1c, 0x8c, 0x6d, 0x30, 0x74, 0x87, 0xfc, 0x13, 0x68, 0x52,
-	0xd4, 0x89, 0x62, 0xd2, 0x30, 0xc1, 0x83, 0xa6, 0x4d, 0xd6, 0x5e, 0xc1, 0x8c, 0x16, 0x27, 0x4e,
-	0xaa, 0x6f, 0xde, 0xdf, 0xdf, 0x0a, 0xcb, 0x20, 0xff, 0x13, 0xd8, 0x89, 0x27, 0x3a, 0x7a, 0x81,
-	0x17, 0xc1, 0x9e, 0xbd, 0x4d, 0x3d, 0x9e, 0xe8, 0x6f, 0xf0, 0xa2, 0xf7, 0x6f, 0x05, 0x02, 0xeb,
-	0xce, 0xa7, 0x94, 0x99, 0x0f, 0xeb, 0xcd, 0xb2, 0xa4, 0x95, 0x15, 0x49, 0x57, 0xee, 0x58, 0xbd,
-	0xc9, 0x1d, 0x57, 0x8d, 0x5b, 0xbb, 0xad, 0x71, 0xeb, 0xb7, 0x33, 0xee, 0xce, 0xad, 0x8d, 0xbb,
-	0x7b, 0x03, 0xe3, 0x96, 0x3a, 0xdd, 0x58, 0xea, 0xf4, 0x2b, 0x0f, 0xf6, 0x6c, 0xa7, 0x0b, 0x1b,
-	0x5e, 0x33, 0x2b, 0xbc, 0xff, 0x39, 0x2b, 0x02, 0xd8, 0x29, 0xde, 0x80, 0x35, 0x42, 0x58, 0x2c,
-	0xfd, 0x4f, 0x57, 0xdf, 0x48, 0xde, 0xf1, 0xa5, 0x87, 0xd0, 0xfb, 0x73, 0x1b, 0x0e, 0x6d, 0x49,
-	0x63, 0x39, 0xb7, 0x1e, 0x4b, 0xf0, 0x07, 0x49, 0x89, 0xc1, 0xeb, 0xdd, 0xf7, 0x00, 0xf6, 0x67,
-	0x36, 0x1c, 0x19, 0x36, 0xc5, 0x48, 0x63, 0x62, 0x33, 0x57, 0xc2, 0x56, 0xbe, 0xfd, 0x3d, 0x9b,
-	0xe2, 0x18, 0x13, 0xff, 0x27, 0x00, 0x85, 0x84, 0x46, 0x32, 0x23, 0x74, 0x33, 0xf0, 0xcb, 0xcc,
-	0x33, 0x7f, 0xbf, 0xbf, 0xff, 0x20, 0x65, 0xe6, 0xf9, 0x2c, 0x1e, 0x24, 0x62, 0xea, 0x66, 0xbb,
-	0xfb, 0xd3, 0xd7, 0xf4, 0x85, 0x9b, 0xdd, 0x23, 0x4c, 0xde, 0xbd, 0xee, 0x83, 0x53, 0x61, 0x84,
-	0x49, 0xd8, 0xc8, 0xf8, 0x6c, 0x7d, 0x59, 0x11, 0x67, 0x0a, 0x31, 0xb2, 0x19, 0x7e, 0x99, 0x09,
-	0x43, 0xac, 0x63, 0xab, 0x61, 0x2b, 0xdb, 0x0e, 0x91, 0xd0, 0xef, 0xb2, 0x4d, 0xff, 0x67, 0x68,
-	0x6a, 0x23, 0x14, 0xba, 0x2a, 0x6a, 0x1b, 0xa8, 0x02, 0x2c, 0xa1, 0x2d, 0xa3, 0xf7, 0x5b, 0x05,
-	0x8e, 0xac, 0x7c, 0x5f, 0x4f, 0x44, 0x4c, 0x26, 0xb9, 0x88, 0x4b, 0x12, 0x5e, 0xa3, 0x96, 0xb7,
-	0x5e, 0xad, 0xed, 0xcd, 0xaa, 0x35, 0x81, 0x03, 0xa9, 0xd8, 0x94, 0xa8, 0x8b, 0xa8, 0xac, 0xc6,
-	0x26, 0x7a, 0xf2, 0xb1, 0x23, 0x5e, 0x5c, 0xdc, 0x97, 0x70, 0x4f, 0x63, 0x22, 0x38, 0x5d, 0xcd,
-	0x57, 0xdd, 0x40, 0xbe, 0x83, 0x39, 0xf5, 0x22, 0x63, 0xef, 0x0f, 0x0f, 0xba, 0xb6, 0x0d, 0xb9,
-	0xe8, 0x2b, 0x43, 0x34, 0x1f, 0xe6, 0x1b, 0x1e, 0xa5, 0x47, 0x00, 0x52, 0x61, 0xe4, 0x7e, 0x45,
-	0xf2, 0xa7, 0xd5, 0x90, 0x0a, 0x5d, 0xb2, 0x23, 0x00, 0x8e, 0x2f, 0x8b, 0x70, 0x35, 0x0f, 0x73,
-	0x7c, 0x99, 0x87, 0x4f, 0x46, 0x6f, 0x2e, 0x3b, 0xde, 0xdb, 0xcb, 0x8e, 0xf7, 0xcf, 0x65, 0xc7,
-	0x7b, 0x75, 0xd5, 0xd9, 0x7a, 0x7b, 0xd5, 0xd9, 0xfa, 0xeb, 0xaa, 0xb3, 0xf5, 0xe3, 0x67, 0x25,
-	0x55, 0x62, 0x1e, 0xf7, 0x93, 0xe7, 0x84, 0xf1, 0x61, 0xe9, 0xdb, 0xe6, 0xd7, 0xf9, 0xd7, 0x4d,
-	0x5c, 0xb7, 0x9f, 0x37, 0x9f, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x8d, 0xb2, 0x3d, 0x77,
-	0x09, 0x00, 0x00,
+func (m *EventSpCapacityUpdate) Reset()         { *m = EventSpCapacityUpdate{} }
+func (m *EventSpCapacityUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventSpCapacityUpdate) ProtoMessage()    {}
+func (*EventSpCapacityUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_685cbfa50fdf0841, []int{6}
+}
+func (m *EventSpCapacityUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSpCapacityUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSpCapacityUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSpCapacityUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSpCapacityUpdate.Merge(m, src)
+}
+func (m *EventSpCapacityUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSpCapacityUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSpCapacityUpdate.DiscardUnknown(m)
 }
 
-func (m *EventCreateStorageProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventSpCapacityUpdate proto.InternalMessageInfo
+
+func (m *EventSpCapacityUpdate) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventCreateStorageProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSpCapacityUpdate) GetUpdateTimeSec() int64 {
+	if m != nil {
+		return m.UpdateTimeSec
+	}
+	return 0
 }
 
-func (m *EventCreateStorageProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BlsKey) > 0 {
-		i -= len(m.BlsKey)
-		copy(dAtA[i:], m.BlsKey)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BlsKey)))
-		i--
-		dAtA[i] = 0x62
+func (m *EventSpCapacityUpdate) GetTotalCapacity() uint64 {
+	if m != nil {
+		return m.TotalCapacity
 	}
-	{
-		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *EventSpCapacityUpdate) GetFreeCapacity() uint64 {
+	if m != nil {
+		return m.FreeCapacity
 	}
-	i--
-	dAtA[i] = 0x5a
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x50
+	return 0
+}
+
+func (m *EventSpCapacityUpdate) GetMaxGvgFamilies() uint32 {
+	if m != nil {
+		return m.MaxGvgFamilies
 	}
-	if m.TotalDeposit != nil {
-		{
-			size, err := m.TotalDeposit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+	return 0
+}
+
+// EventScheduleMaintenance is emitted when a SP schedules a maintenance window
+type EventScheduleMaintenance struct {
+	// sp id
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// start of the maintenance window, in unix timestamp
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// duration of the maintenance window, in seconds
+	Duration int64 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (m *EventScheduleMaintenance) Reset()         { *m = EventScheduleMaintenance{} }
+func (m *EventScheduleMaintenance) String() string { return proto.CompactTextString(m) }
+func (*EventScheduleMaintenance) ProtoMessage()    {}
+func (*EventScheduleMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_685cbfa50fdf0841, []int{7}
+}
+func (m *EventScheduleMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScheduleMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScheduleMaintenance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Endpoint) > 0 {
-		i -= len(m.Endpoint)
-		copy(dAtA[i:], m.Endpoint)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Endpoint)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.MaintenanceAddress) > 0 {
-		i -= len(m.MaintenanceAddress)
-		copy(dAtA[i:], m.MaintenanceAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MaintenanceAddress)))
-		i--
-		dAtA[i] = 0x3a
+		return b[:n], nil
 	}
-	if len(m.GcAddress) > 0 {
-		i -= len(m.GcAddress)
-		copy(dAtA[i:], m.GcAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.GcAddress)))
-		i--
-		dAtA[i] = 0x32
+}
+func (m *EventScheduleMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScheduleMaintenance.Merge(m, src)
+}
+func (m *EventScheduleMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScheduleMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScheduleMaintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScheduleMaintenance proto.InternalMessageInfo
+
+func (m *EventScheduleMaintenance) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
 	}
-	if len(m.ApprovalAddress) > 0 {
-		i -= len(m.ApprovalAddress)
-		copy(dAtA[i:], m.ApprovalAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ApprovalAddress)))
-		i--
-		dAtA[i] = 0x2a
+	return 0
+}
+
+func (m *EventScheduleMaintenance) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
 	}
-	if len(m.SealAddress) > 0 {
-		i -= len(m.SealAddress)
-		copy(dAtA[i:], m.SealAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SealAddress)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *EventScheduleMaintenance) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
 	}
-	if len(m.FundingAddress) > 0 {
-		i -= len(m.FundingAddress)
-		copy(dAtA[i:], m.FundingAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.FundingAddress)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+// EventCancelScheduledMaintenance is emitted when a scheduled maintenance window is canceled by the SP,
+// or dropped at its start because the SP can not enter maintenance
+type EventCancelScheduledMaintenance struct {
+	// sp id
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// start of the maintenance window, in unix timestamp
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// reason of the cancellation, empty if canceled by the SP
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventCancelScheduledMaintenance) Reset()         { *m = EventCancelScheduledMaintenance{} }
+func (m *EventCancelScheduledMaintenance) String() string { return proto.CompactTextString(m) }
+func (*EventCancelScheduledMaintenance) ProtoMessage()    {}
+func (*EventCancelScheduledMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_685cbfa50fdf0841, []int{8}
+}
+func (m *EventCancelScheduledMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelScheduledMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelScheduledMaintenance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.SpAddress) > 0 {
-		i -= len(m.SpAddress)
-		copy(dAtA[i:], m.SpAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SpAddress)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventCancelScheduledMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelScheduledMaintenance.Merge(m, src)
+}
+func (m *EventCancelScheduledMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelScheduledMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelScheduledMaintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelScheduledMaintenance proto.InternalMessageInfo
+
+func (m *EventCancelScheduledMaintenance) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
 	}
-	if m.SpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventCancelScheduledMaintenance) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventEditStorageProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventCancelScheduledMaintenance) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventEditStorageProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventPendingSpStoragePrice is emitted when a storage provider announces a storage price to take effect at a future time
+type EventPendingSpStoragePrice struct {
+	// sp id
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// effective time, in unix timestamp
+	EffectiveTime int64 `protobuf:"varint,2,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"`
+	// read price, in bnb wei per charge byte
+	ReadPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=read_price,json=readPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"read_price"`
+	// free read quota, in byte
+	FreeReadQuota uint64 `protobuf:"varint,4,opt,name=free_read_quota,json=freeReadQuota,proto3" json:"free_read_quota,omitempty"`
+	// store price, in bnb wei per charge byte
+	StorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=store_price,json=storePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"store_price"`
+	// store price tiers, in ascending order of the charge size threshold
+	StorePriceTiers []SpStorePriceTier `protobuf:"bytes,6,rep,name=store_price_tiers,json=storePriceTiers,proto3" json:"store_price_tiers"`
 }
 
-func (m *EventEditStorageProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BlsKey) > 0 {
-		i -= len(m.BlsKey)
-		copy(dAtA[i:], m.BlsKey)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BlsKey)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.MaintenanceAddress) > 0 {
-		i -= len(m.MaintenanceAddress)
-		copy(dAtA[i:], m.MaintenanceAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MaintenanceAddress)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.GcAddress) > 0 {
-		i -= len(m.GcAddress)
-		copy(dAtA[i:], m.GcAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.GcAddress)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.ApprovalAddress) > 0 {
-		i -= len(m.ApprovalAddress)
-		copy(dAtA[i:], m.ApprovalAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ApprovalAddress)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.SealAddress) > 0 {
-		i -= len(m.SealAddress)
-		copy(dAtA[i:], m.SealAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SealAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventPendingSpStoragePrice) Reset()         { *m = EventPendingSpStoragePrice{} }
+func (m *EventPendingSpStoragePrice) String() string { return proto.CompactTextString(m) }
+func (*EventPendingSpStoragePrice) ProtoMessage()    {}
+func (*EventPendingSpStoragePrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_685cbfa50fdf0841, []int{9}
+}
+func (m *EventPendingSpStoragePrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPendingSpStoragePrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPendingSpStoragePrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Endpoint) > 0 {
-		i -= len(m.Endpoint)
-		copy(dAtA[i:], m.Endpoint)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Endpoint)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *EventPendingSpStoragePrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPendingSpStoragePrice.Merge(m, src)
+}
+func (m *EventPendingSpStoragePrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPendingSpStoragePrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPendingSpStoragePrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPendingSpStoragePrice proto.InternalMessageInfo
+
+func (m *EventPendingSpStoragePrice) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
 	}
-	if len(m.SpAddress) > 0 {
-		i -= len(m.SpAddress)
-		copy(dAtA[i:], m.SpAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SpAddress)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *EventPendingSpStoragePrice) GetEffectiveTime() int64 {
+	if m != nil {
+		return m.EffectiveTime
 	}
-	if m.SpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventPendingSpStoragePrice) GetFreeReadQuota() uint64 {
+	if m != nil {
+		return m.FreeReadQuota
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventPendingSpStoragePrice) GetStorePriceTiers() []SpStorePriceTier {
+	if m != nil {
+		return m.StorePriceTiers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventCancelPendingSpStoragePrice is emitted when a pending storage price is superseded by a new price update of the SP
+type EventCancelPendingSpStoragePrice struct {
+	// sp id
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// effective time of the canceled price, in unix timestamp
+	EffectiveTime int64 `protobuf:"varint,2,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"`
 }
 
-func (m *EventDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalDeposit) > 0 {
-		i -= len(m.TotalDeposit)
-		copy(dAtA[i:], m.TotalDeposit)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.TotalDeposit)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Deposit) > 0 {
-		i -= len(m.Deposit)
-		copy(dAtA[i:], m.Deposit)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Deposit)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventCancelPendingSpStoragePrice) Reset()         { *m = EventCancelPendingSpStoragePrice{} }
+func (m *EventCancelPendingSpStoragePrice) String() string { return proto.CompactTextString(m) }
+func (*EventCancelPendingSpStoragePrice) ProtoMessage()    {}
+func (*EventCancelPendingSpStoragePrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_685cbfa50fdf0841, []int{10}
+}
+func (m *EventCancelPendingSpStoragePrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelPendingSpStoragePrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelPendingSpStoragePrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.FundingAddress) > 0 {
-		i -= len(m.FundingAddress)
-		copy(dAtA[i:], m.FundingAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.FundingAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventCancelPendingSpStoragePrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelPendingSpStoragePrice.Merge(m, src)
+}
+func (m *EventCancelPendingSpStoragePrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelPendingSpStoragePrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelPendingSpStoragePrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelPendingSpStoragePrice proto.InternalMessageInfo
+
+func (m *EventCancelPendingSpStoragePrice) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventSpStoragePriceUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventCancelPendingSpStoragePrice) GetEffectiveTime() int64 {
+	if m != nil {
+		return m.EffectiveTime
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventSpStoragePriceUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventSpKeyRotation is emitted when a storage provider replaces its keys and the replaced keys are kept for an overlap period
+type EventSpKeyRotation struct {
+	// sp id
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// the seal address replaced by the edit, empty if it is not replaced
+	SealAddress string `protobuf:"bytes,2,opt,name=seal_address,json=sealAddress,proto3" json:"seal_address,omitempty"`
+	// the approval address replaced by the edit, empty if it is not replaced
+	ApprovalAddress string `protobuf:"bytes,3,opt,name=approval_address,json=approvalAddress,proto3" json:"approval_address,omitempty"`
+	// the gc address replaced by the edit, empty if it is not replaced
+	GcAddress string `protobuf:"bytes,4,opt,name=gc_address,json=gcAddress,proto3" json:"gc_address,omitempty"`
+	// the bls public key in hex replaced by the edit, empty if it is not replaced
+	BlsKey string `protobuf:"bytes,5,opt,name=bls_key,json=blsKey,proto3" json:"bls_key,omitempty"`
+	// the block height from which the keys replaced by the edit are no longer accepted, the keys replaced by
+	// earlier edits keep their own expiration heights
+	ExpireHeight int64 `protobuf:"varint,6,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty"`
 }
 
-func (m *EventSpStoragePriceUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.StorePrice.Size()
-		i -= size
-		if _, err := m.StorePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.FreeReadQuota != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.FreeReadQuota))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size := m.ReadPrice.Size()
-		i -= size
-		if _, err := m.ReadPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventSpKeyRotation) Reset()         { *m = EventSpKeyRotation{} }
+func (m *EventSpKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*EventSpKeyRotation) ProtoMessage()    {}
+func (*EventSpKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_685cbfa50fdf0841, []int{11}
+}
+func (m *EventSpKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSpKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSpKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.UpdateTimeSec != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.UpdateTimeSec))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *EventSpKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSpKeyRotation.Merge(m, src)
+}
+func (m *EventSpKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSpKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSpKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSpKeyRotation proto.InternalMessageInfo
+
+func (m *EventSpKeyRotation) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
 	}
-	if m.SpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventSpKeyRotation) GetSealAddress() string {
+	if m != nil {
+		return m.SealAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventGlobalSpStorePriceUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSpKeyRotation) GetApprovalAddress() string {
+	if m != nil {
+		return m.ApprovalAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventGlobalSpStorePriceUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSpKeyRotation) GetGcAddress() string {
+	if m != nil {
+		return m.GcAddress
+	}
+	return ""
 }
 
-func (m *EventGlobalSpStorePriceUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.SecondaryStorePrice.Size()
-		i -= size
-		if _, err := m.SecondaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *EventSpKeyRotation) GetBlsKey() string {
+	if m != nil {
+		return m.BlsKey
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.PrimaryStorePrice.Size()
-		i -= size
-		if _, err := m.PrimaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *EventSpKeyRotation) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.ReadPrice.Size()
-		i -= size
-		if _, err := m.ReadPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// EventCompleteSpKeyRotation is emitted when the replaced keys are dropped, either their overlap periods end or they are revoked
+type EventCompleteSpKeyRotation struct {
+	// sp id
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// the dropped seal address, empty if it is not dropped
+	SealAddress string `protobuf:"bytes,2,opt,name=seal_address,json=sealAddress,proto3" json:"seal_address,omitempty"`
+	// the dropped approval address, empty if it is not dropped
+	ApprovalAddress string `protobuf:"bytes,3,opt,name=approval_address,json=approvalAddress,proto3" json:"approval_address,omitempty"`
+	// the dropped gc address, empty if it is not dropped
+	GcAddress string `protobuf:"bytes,4,opt,name=gc_address,json=gcAddress,proto3" json:"gc_address,omitempty"`
+	// the dropped bls public key in hex, empty if it is not dropped
+	BlsKey string `protobuf:"bytes,5,opt,name=bls_key,json=blsKey,proto3" json:"bls_key,omitempty"`
+}
+
+func (m *EventCompleteSpKeyRotation) Reset()         { *m = EventCompleteSpKeyRotation{} }
+func (m *EventCompleteSpKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*EventCompleteSpKeyRotation) ProtoMessage()    {}
+func (*EventCompleteSpKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_685cbfa50fdf0841, []int{12}
+}
+func (m *EventCompleteSpKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCompleteSpKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCompleteSpKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.UpdateTimeSec != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.UpdateTimeSec))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EventCompleteSpKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCompleteSpKeyRotation.Merge(m, src)
+}
+func (m *EventCompleteSpKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCompleteSpKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCompleteSpKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCompleteSpKeyRotation proto.InternalMessageInfo
+
+func (m *EventCompleteSpKeyRotation) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventUpdateStorageProviderStatus) Marshal() (dAtA []byte, err error) {
+func (m *EventCompleteSpKeyRotation) GetSealAddress() string {
+	if m != nil {
+		return m.SealAddress
+	}
+	return ""
+}
+
+func (m *EventCompleteSpKeyRotation) GetApprovalAddress() string {
+	if m != nil {
+		return m.ApprovalAddress
+	}
+	return ""
+}
+
+func (m *EventCompleteSpKeyRotation) GetGcAddress() string {
+	if m != nil {
+		return m.GcAddress
+	}
+	return ""
+}
+
+func (m *EventCompleteSpKeyRotation) GetBlsKey() string {
+	if m != nil {
+		return m.BlsKey
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventCreateStorageProvider)(nil), "greenfield.sp.EventCreateStorageProvider")
+	proto.RegisterType((*EventEditStorageProvider)(nil), "greenfield.sp.EventEditStorageProvider")
+	proto.RegisterType((*EventDeposit)(nil), "greenfield.sp.EventDeposit")
+	proto.RegisterType((*EventSpStoragePriceUpdate)(nil), "greenfield.sp.EventSpStoragePriceUpdate")
+	proto.RegisterType((*EventGlobalSpStorePriceUpdate)(nil), "greenfield.sp.EventGlobalSpStorePriceUpdate")
+	proto.RegisterType((*EventUpdateStorageProviderStatus)(nil), "greenfield.sp.EventUpdateStorageProviderStatus")
+	proto.RegisterType((*EventSpCapacityUpdate)(nil), "greenfield.sp.EventSpCapacityUpdate")
+	proto.RegisterType((*EventScheduleMaintenance)(nil), "greenfield.sp.EventScheduleMaintenance")
+	proto.RegisterType((*EventCancelScheduledMaintenance)(nil), "greenfield.sp.EventCancelScheduledMaintenance")
+	proto.RegisterType((*EventPendingSpStoragePrice)(nil), "greenfield.sp.EventPendingSpStoragePrice")
+	proto.RegisterType((*EventCancelPendingSpStoragePrice)(nil), "greenfield.sp.EventCancelPendingSpStoragePrice")
+	proto.RegisterType((*EventSpKeyRotation)(nil), "greenfield.sp.EventSpKeyRotation")
+	proto.RegisterType((*EventCompleteSpKeyRotation)(nil), "greenfield.sp.EventCompleteSpKeyRotation")
+}
+
+func init() { proto.RegisterFile("greenfield/sp/events.proto", fileDescriptor_685cbfa50fdf0841) }
+
+var fileDescriptor_685cbfa50fdf0841 = []byte{
+	// 1143 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6e, 0xdb, 0x46,
+	0x13, 0x37, 0xad, 0x3f, 0xb6, 0x46, 0x92, 0x9d, 0xd0, 0xc9, 0xf7, 0x29, 0x02, 0x2c, 0x1b, 0x32,
+	0x12, 0x08, 0x05, 0x2c, 0x21, 0xee, 0x21, 0x87, 0x14, 0x05, 0x62, 0x3b, 0x4d, 0x83, 0xb4, 0x40,
+	0x42, 0x25, 0x97, 0x16, 0x2d, 0xb1, 0x22, 0x47, 0xf4, 0x36, 0x14, 0xb9, 0xe5, 0xae, 0x14, 0xeb,
+	0x01, 0x7a, 0x6e, 0xee, 0xbd, 0x15, 0x3d, 0xf7, 0x94, 0x87, 0x08, 0x7a, 0x0a, 0x72, 0x2a, 0x5a,
+	0x20, 0x28, 0xec, 0x17, 0x29, 0xb8, 0xbb, 0xa4, 0x29, 0x55, 0x80, 0x52, 0x4b, 0x3d, 0xb5, 0x27,
+	0x69, 0x67, 0xf6, 0x37, 0xb3, 0xb3, 0xf3, 0xdb, 0x99, 0x21, 0xd4, 0xbd, 0x08, 0x31, 0xe8, 0x53,
+	0xf4, 0xdd, 0x0e, 0x67, 0x1d, 0x1c, 0x61, 0x20, 0x78, 0x9b, 0x45, 0xa1, 0x08, 0xcd, 0xea, 0x85,
+	0xae, 0xcd, 0x59, 0xbd, 0xe1, 0x84, 0x7c, 0x10, 0xf2, 0x4e, 0x8f, 0x70, 0xec, 0x8c, 0x6e, 0xf7,
+	0x50, 0x90, 0xdb, 0x1d, 0x27, 0xa4, 0x81, 0xda, 0x5e, 0xbf, 0xa1, 0xf4, 0xb6, 0x5c, 0x75, 0xd4,
+	0x42, 0xab, 0xae, 0x79, 0xa1, 0x17, 0x2a, 0x79, 0xfc, 0x2f, 0x01, 0x4c, 0xfa, 0x16, 0x63, 0x86,
+	0x1a, 0xd0, 0xfc, 0xa1, 0x00, 0xf5, 0xfb, 0xf1, 0x59, 0x8e, 0x22, 0x24, 0x02, 0xbb, 0x22, 0x8c,
+	0x88, 0x87, 0x8f, 0xa3, 0x70, 0x44, 0x5d, 0x8c, 0xcc, 0x2d, 0x28, 0x70, 0x66, 0x53, 0xb7, 0x66,
+	0xec, 0x1a, 0xad, 0xaa, 0x95, 0xe7, 0xec, 0xa1, 0x6b, 0xde, 0x01, 0xe0, 0xcc, 0x26, 0xae, 0x1b,
+	0x21, 0xe7, 0xb5, 0xd5, 0x5d, 0xa3, 0x55, 0x3a, 0xac, 0xbd, 0x7d, 0xb5, 0x7f, 0x4d, 0x1f, 0xe5,
+	0x9e, 0xd2, 0x74, 0x45, 0x44, 0x03, 0xcf, 0x2a, 0x71, 0xa6, 0x05, 0xe6, 0x3d, 0xd8, 0xec, 0x0f,
+	0x03, 0x97, 0x06, 0x5e, 0x8a, 0xce, 0xcd, 0x41, 0x6f, 0x68, 0x40, 0x62, 0xe2, 0x2e, 0x54, 0x38,
+	0x12, 0x3f, 0xc5, 0xe7, 0xe7, 0xe0, 0xcb, 0xf1, 0xee, 0x04, 0x7c, 0x04, 0x57, 0x08, 0x63, 0x51,
+	0x38, 0xca, 0x18, 0x28, 0xcc, 0x31, 0xb0, 0x99, 0x20, 0x12, 0x23, 0x77, 0x00, 0x3c, 0x27, 0x85,
+	0x17, 0xe7, 0x45, 0xef, 0x39, 0x09, 0xf0, 0x21, 0x6c, 0x0d, 0x08, 0x0d, 0x04, 0x06, 0x24, 0x70,
+	0x30, 0xb5, 0xb0, 0x36, 0xc7, 0x82, 0x99, 0x01, 0x25, 0xa6, 0xea, 0xb0, 0x8e, 0x81, 0xcb, 0x42,
+	0x1a, 0x88, 0xda, 0x7a, 0x8c, 0xb7, 0xd2, 0xb5, 0xf9, 0x31, 0x54, 0x45, 0x28, 0x88, 0x6f, 0xbb,
+	0xc8, 0x42, 0x4e, 0x45, 0xad, 0xb4, 0x6b, 0xb4, 0xca, 0x07, 0x37, 0xda, 0xda, 0x7a, 0xcc, 0xaa,
+	0xb6, 0x66, 0x55, 0xfb, 0x28, 0xa4, 0x81, 0x55, 0x91, 0xfb, 0x8f, 0xd5, 0x76, 0x73, 0x1f, 0x8a,
+	0x5c, 0x10, 0x31, 0xe4, 0x35, 0xd8, 0x35, 0x5a, 0x1b, 0x07, 0xd7, 0xdb, 0x13, 0xec, 0x6c, 0x77,
+	0xa5, 0xd2, 0xd2, 0x9b, 0xcc, 0x43, 0x28, 0xbb, 0xc8, 0x9d, 0x88, 0x32, 0x41, 0xc3, 0xa0, 0x56,
+	0x96, 0xce, 0xea, 0x53, 0x98, 0xe3, 0x8b, 0x1d, 0x87, 0xf9, 0xd7, 0xef, 0x76, 0x56, 0xac, 0x2c,
+	0xc8, 0xfc, 0x3f, 0xac, 0xf5, 0x7c, 0x6e, 0x3f, 0xc7, 0x71, 0xad, 0x22, 0xa3, 0x29, 0xf6, 0x7c,
+	0xfe, 0x08, 0xc7, 0xcd, 0x9f, 0xf2, 0x50, 0x93, 0xec, 0xbc, 0xef, 0x52, 0xf1, 0xcf, 0x72, 0x33,
+	0x7b, 0xa5, 0xb9, 0xa9, 0x2b, 0x9d, 0x8a, 0x31, 0x7f, 0x99, 0x18, 0xa7, 0x89, 0x5b, 0x58, 0x94,
+	0xb8, 0xc5, 0xc5, 0x88, 0xbb, 0xb6, 0x30, 0x71, 0xd7, 0x2f, 0x41, 0xdc, 0x4c, 0xa6, 0x4b, 0xd9,
+	0x4c, 0x9b, 0x77, 0x61, 0xdd, 0x0f, 0x1d, 0x22, 0xef, 0x17, 0x34, 0x61, 0xa7, 0x78, 0xc7, 0x3e,
+	0xd3, 0x1b, 0xf4, 0xf5, 0xa6, 0x80, 0xe6, 0x4b, 0x03, 0x2a, 0x92, 0x26, 0x09, 0x87, 0x67, 0x14,
+	0x1a, 0xe3, 0x6f, 0x16, 0x9a, 0x1a, 0xac, 0x25, 0x0f, 0x48, 0xb2, 0xc8, 0x4a, 0x96, 0xe6, 0xde,
+	0xf4, 0x03, 0x53, 0x74, 0x99, 0x78, 0x45, 0xcd, 0xef, 0x73, 0x70, 0x43, 0x1e, 0xa9, 0xcb, 0x52,
+	0xde, 0x52, 0x07, 0x9f, 0x31, 0x97, 0x08, 0x9c, 0x4d, 0xdd, 0x5b, 0xb0, 0x39, 0x94, 0x6a, 0x5b,
+	0xd0, 0x01, 0xda, 0x1c, 0x1d, 0xe9, 0x39, 0x67, 0x55, 0x95, 0xf8, 0x29, 0x1d, 0x60, 0x17, 0x1d,
+	0xf3, 0x4b, 0x80, 0x08, 0x89, 0x6b, 0xb3, 0xd8, 0xa0, 0x2e, 0xa0, 0x1f, 0xc5, 0x37, 0xf2, 0xdb,
+	0xbb, 0x9d, 0x5b, 0x1e, 0x15, 0x27, 0xc3, 0x5e, 0xdb, 0x09, 0x07, 0xba, 0x31, 0xe8, 0x9f, 0x7d,
+	0xee, 0x3e, 0xd7, 0x85, 0xff, 0x18, 0x9d, 0xb7, 0xaf, 0xf6, 0x41, 0xdf, 0xc2, 0x31, 0x3a, 0x56,
+	0x29, 0xb6, 0x27, 0xcf, 0x17, 0x1f, 0xa2, 0x1f, 0x21, 0xda, 0xd2, 0xc3, 0xb7, 0xc3, 0x50, 0x10,
+	0x49, 0xf7, 0xbc, 0x55, 0x8d, 0xc5, 0x16, 0x12, 0xf7, 0x49, 0x2c, 0x34, 0xbf, 0x82, 0x32, 0x17,
+	0x61, 0x84, 0xfa, 0x14, 0x85, 0x25, 0x9c, 0x02, 0xa4, 0x41, 0x75, 0x8c, 0x27, 0x70, 0x35, 0x63,
+	0xde, 0x16, 0x14, 0xa3, 0x98, 0xf1, 0xb9, 0x56, 0xf9, 0x60, 0xe7, 0x2f, 0xbc, 0xe8, 0xa6, 0xb8,
+	0xa7, 0x14, 0x23, 0xcd, 0x8e, 0x4d, 0x3e, 0x21, 0xe5, 0xcd, 0xdf, 0x73, 0xb0, 0x2d, 0x33, 0xf2,
+	0xc0, 0x0f, 0x7b, 0xc4, 0xcf, 0xc2, 0x74, 0x56, 0x66, 0x24, 0xc0, 0x98, 0x9f, 0x80, 0xd5, 0xe5,
+	0x26, 0xc0, 0x87, 0x2d, 0x16, 0xd1, 0x01, 0x89, 0xc6, 0x76, 0xf6, 0x82, 0x97, 0x91, 0xe6, 0xab,
+	0xda, 0xf0, 0x45, 0xe0, 0x26, 0x83, 0xeb, 0x1c, 0x9d, 0x30, 0x70, 0xa7, 0xfd, 0xe5, 0x97, 0xe0,
+	0x6f, 0x2b, 0x35, 0x9d, 0xf1, 0xf8, 0x6c, 0x56, 0x66, 0x0b, 0x32, 0xb3, 0x7b, 0x53, 0x99, 0xd5,
+	0x89, 0x7a, 0xaf, 0xec, 0xfe, 0x6c, 0xc0, 0xae, 0xcc, 0xae, 0xca, 0xe5, 0x54, 0xaf, 0x50, 0x3d,
+	0x6b, 0xc9, 0x1d, 0x63, 0x1b, 0x80, 0x45, 0x68, 0xeb, 0x66, 0xa9, 0x8a, 0x40, 0x89, 0x45, 0xa8,
+	0x9d, 0x6d, 0x03, 0x04, 0xf8, 0x22, 0x51, 0xe7, 0x95, 0x3a, 0xc0, 0x17, 0x4a, 0xdd, 0xfc, 0xc5,
+	0x80, 0xeb, 0xba, 0x40, 0x1c, 0x11, 0x46, 0x1c, 0x2a, 0xc6, 0xcb, 0x28, 0x0e, 0x37, 0x61, 0x43,
+	0x15, 0x27, 0x47, 0x1b, 0x95, 0x07, 0xcb, 0x5b, 0xaa, 0x64, 0x25, 0x9e, 0xe2, 0x1a, 0x26, 0x9f,
+	0x79, 0xba, 0x4b, 0x3d, 0xf2, 0x4a, 0x2c, 0x4c, 0x37, 0xb5, 0xe0, 0xca, 0x80, 0x9c, 0xda, 0xde,
+	0xc8, 0xb3, 0xfb, 0x64, 0x40, 0x7d, 0x8a, 0xaa, 0x6d, 0x55, 0xad, 0x8d, 0x01, 0x39, 0x7d, 0x30,
+	0xf2, 0x3e, 0xd1, 0xd2, 0xe6, 0x37, 0xba, 0x4d, 0x77, 0x9d, 0x13, 0x74, 0x87, 0x3e, 0x7e, 0x7e,
+	0x51, 0xf9, 0x67, 0x87, 0xb3, 0x0d, 0xc0, 0x05, 0x89, 0x84, 0x8c, 0x46, 0x47, 0x52, 0x92, 0x92,
+	0x38, 0x90, 0xb8, 0x19, 0xbb, 0xc3, 0x48, 0x75, 0x83, 0x9c, 0x54, 0xa6, 0xeb, 0xe6, 0x00, 0x76,
+	0xd4, 0xc0, 0x1a, 0x5b, 0xf7, 0x13, 0x8f, 0xee, 0xa2, 0x2e, 0xff, 0x07, 0xc5, 0x08, 0x09, 0xd7,
+	0x0e, 0x4b, 0x96, 0x5e, 0xc5, 0x85, 0x5c, 0x0d, 0xc8, 0x8f, 0x51, 0xf6, 0x87, 0xc9, 0x7a, 0x3e,
+	0xdb, 0xd5, 0x4d, 0xd8, 0xc0, 0x7e, 0x1f, 0x1d, 0x41, 0x47, 0x98, 0x75, 0x57, 0x4d, 0xa5, 0xd2,
+	0xe5, 0x7f, 0x85, 0xfc, 0x52, 0x85, 0xfc, 0x6b, 0xfd, 0xd2, 0x15, 0x01, 0x96, 0x9e, 0x96, 0xe6,
+	0x8f, 0xab, 0x60, 0xea, 0x97, 0xf9, 0x08, 0xc7, 0x56, 0x28, 0x24, 0xef, 0x66, 0x9b, 0x9c, 0x9e,
+	0xea, 0x56, 0x17, 0x9d, 0xea, 0x72, 0x8b, 0x4d, 0x75, 0xf9, 0xf7, 0x9f, 0xea, 0x32, 0xa3, 0x58,
+	0x61, 0x62, 0x14, 0xdb, 0x83, 0x2a, 0x9e, 0x32, 0x1a, 0xa1, 0x7d, 0x82, 0xd4, 0x3b, 0x11, 0x72,
+	0xd2, 0xcc, 0x59, 0x15, 0x25, 0xfc, 0x54, 0xca, 0x9a, 0xdf, 0xad, 0x26, 0xdf, 0x8d, 0xe1, 0x80,
+	0xf9, 0x28, 0xf0, 0xdf, 0x79, 0x59, 0x87, 0xc7, 0xaf, 0xcf, 0x1a, 0xc6, 0x9b, 0xb3, 0x86, 0xf1,
+	0xc7, 0x59, 0xc3, 0x78, 0x79, 0xde, 0x58, 0x79, 0x73, 0xde, 0x58, 0xf9, 0xf5, 0xbc, 0xb1, 0xf2,
+	0xc5, 0x07, 0x99, 0xb7, 0xd3, 0x0b, 0x7a, 0xfb, 0xce, 0x09, 0xa1, 0x41, 0x27, 0xf3, 0x25, 0x7e,
+	0x9a, 0x7e, 0x8b, 0xf7, 0x8a, 0xf2, 0x63, 0xfc, 0xc3, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xd6,
+	0xfe, 0xeb, 0x50, 0x25, 0x10, 0x00, 0x00,
+}
+
+func (m *EventCreateStorageProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -979,27 +1191,89 @@ func (m *EventUpdateStorageProviderStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventUpdateStorageProviderStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCreateStorageProvider) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventUpdateStorageProviderStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCreateStorageProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NewStatus) > 0 {
-		i -= len(m.NewStatus)
-		copy(dAtA[i:], m.NewStatus)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewStatus)))
+	if len(m.BlsKey) > 0 {
+		i -= len(m.BlsKey)
+		copy(dAtA[i:], m.BlsKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BlsKey)))
+		i--
+		dAtA[i] = 0x62
+	}
+	{
+		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TotalDeposit != nil {
+		{
+			size, err := m.TotalDeposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.MaintenanceAddress) > 0 {
+		i -= len(m.MaintenanceAddress)
+		copy(dAtA[i:], m.MaintenanceAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MaintenanceAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.GcAddress) > 0 {
+		i -= len(m.GcAddress)
+		copy(dAtA[i:], m.GcAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GcAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ApprovalAddress) > 0 {
+		i -= len(m.ApprovalAddress)
+		copy(dAtA[i:], m.ApprovalAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ApprovalAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SealAddress) > 0 {
+		i -= len(m.SealAddress)
+		copy(dAtA[i:], m.SealAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SealAddress)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.PreStatus) > 0 {
-		i -= len(m.PreStatus)
-		copy(dAtA[i:], m.PreStatus)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreStatus)))
+	if len(m.FundingAddress) > 0 {
+		i -= len(m.FundingAddress)
+		copy(dAtA[i:], m.FundingAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FundingAddress)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1018,204 +1292,1981 @@ func (m *EventUpdateStorageProviderStatus) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventEditStorageProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventCreateStorageProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventEditStorageProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEditStorageProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SpId != 0 {
-		n += 1 + sovEvents(uint64(m.SpId))
-	}
-	l = len(m.SpAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.FundingAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.Location.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.SealAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x52
+	if len(m.BlsKey) > 0 {
+		i -= len(m.BlsKey)
+		copy(dAtA[i:], m.BlsKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BlsKey)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.ApprovalAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.MaintenanceAddress) > 0 {
+		i -= len(m.MaintenanceAddress)
+		copy(dAtA[i:], m.MaintenanceAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MaintenanceAddress)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.GcAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.GcAddress) > 0 {
+		i -= len(m.GcAddress)
+		copy(dAtA[i:], m.GcAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GcAddress)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.MaintenanceAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ApprovalAddress) > 0 {
+		i -= len(m.ApprovalAddress)
+		copy(dAtA[i:], m.ApprovalAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ApprovalAddress)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Endpoint)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.SealAddress) > 0 {
+		i -= len(m.SealAddress)
+		copy(dAtA[i:], m.SealAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SealAddress)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.TotalDeposit != nil {
-		l = m.TotalDeposit.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
+	{
+		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = m.Description.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = len(m.BlsKey)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *EventEditStorageProvider) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.SpAddress) > 0 {
+		i -= len(m.SpAddress)
+		copy(dAtA[i:], m.SpAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SpAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
 	if m.SpId != 0 {
-		n += 1 + sovEvents(uint64(m.SpId))
-	}
-	l = len(m.SpAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Endpoint)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.Description.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = len(m.SealAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ApprovalAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.GcAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.MaintenanceAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BlsKey)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FundingAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.TotalDeposit) > 0 {
+		i -= len(m.TotalDeposit)
+		copy(dAtA[i:], m.TotalDeposit)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TotalDeposit)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Deposit)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.TotalDeposit)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.FundingAddress) > 0 {
+		i -= len(m.FundingAddress)
+		copy(dAtA[i:], m.FundingAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FundingAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSpStoragePriceUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSpStoragePriceUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSpStoragePriceUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSpStoragePriceUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SpId != 0 {
-		n += 1 + sovEvents(uint64(m.SpId))
+	if len(m.StorePriceTiers) > 0 {
+		for iNdEx := len(m.StorePriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorePriceTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if m.UpdateTimeSec != 0 {
-		n += 1 + sovEvents(uint64(m.UpdateTimeSec))
+	{
+		size := m.StorePrice.Size()
+		i -= size
+		if _, err := m.StorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = m.ReadPrice.Size()
-	n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x2a
 	if m.FreeReadQuota != 0 {
-		n += 1 + sovEvents(uint64(m.FreeReadQuota))
+		i = encodeVarintEvents(dAtA, i, uint64(m.FreeReadQuota))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.StorePrice.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
-
-func (m *EventGlobalSpStorePriceUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.ReadPrice.Size()
+		i -= size
+		if _, err := m.ReadPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
+	i--
+	dAtA[i] = 0x1a
 	if m.UpdateTimeSec != 0 {
-		n += 1 + sovEvents(uint64(m.UpdateTimeSec))
+		i = encodeVarintEvents(dAtA, i, uint64(m.UpdateTimeSec))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.ReadPrice.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.PrimaryStorePrice.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.SecondaryStorePrice.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateStorageProviderStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventGlobalSpStorePriceUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGlobalSpStorePriceUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGlobalSpStorePriceUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SpId != 0 {
-		n += 1 + sovEvents(uint64(m.SpId))
-	}
-	l = len(m.SpAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.StorePriceTiers) > 0 {
+		for iNdEx := len(m.StorePriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorePriceTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.PreStatus)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.SecondaryStorePrice.Size()
+		i -= size
+		if _, err := m.SecondaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.NewStatus)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PrimaryStorePrice.Size()
+		i -= size
+		if _, err := m.PrimaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
-}
-
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ReadPrice.Size()
+		i -= size
+		if _, err := m.ReadPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.UpdateTimeSec != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.UpdateTimeSec))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateStorageProviderStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateStorageProviderStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateStorageProviderStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewStatus) > 0 {
+		i -= len(m.NewStatus)
+		copy(dAtA[i:], m.NewStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewStatus)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PreStatus) > 0 {
+		i -= len(m.PreStatus)
+		copy(dAtA[i:], m.PreStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreStatus)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SpAddress) > 0 {
+		i -= len(m.SpAddress)
+		copy(dAtA[i:], m.SpAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SpAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSpCapacityUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSpCapacityUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSpCapacityUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGvgFamilies != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MaxGvgFamilies))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FreeCapacity != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FreeCapacity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalCapacity != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TotalCapacity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UpdateTimeSec != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.UpdateTimeSec))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScheduleMaintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScheduleMaintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScheduleMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelScheduledMaintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelScheduledMaintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelScheduledMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPendingSpStoragePrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPendingSpStoragePrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPendingSpStoragePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorePriceTiers) > 0 {
+		for iNdEx := len(m.StorePriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorePriceTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.StorePrice.Size()
+		i -= size
+		if _, err := m.StorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.FreeReadQuota != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FreeReadQuota))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ReadPrice.Size()
+		i -= size
+		if _, err := m.ReadPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EffectiveTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EffectiveTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelPendingSpStoragePrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelPendingSpStoragePrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelPendingSpStoragePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EffectiveTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSpKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSpKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSpKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpireHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpireHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.BlsKey) > 0 {
+		i -= len(m.BlsKey)
+		copy(dAtA[i:], m.BlsKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BlsKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.GcAddress) > 0 {
+		i -= len(m.GcAddress)
+		copy(dAtA[i:], m.GcAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GcAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ApprovalAddress) > 0 {
+		i -= len(m.ApprovalAddress)
+		copy(dAtA[i:], m.ApprovalAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ApprovalAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SealAddress) > 0 {
+		i -= len(m.SealAddress)
+		copy(dAtA[i:], m.SealAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SealAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCompleteSpKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCompleteSpKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCompleteSpKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlsKey) > 0 {
+		i -= len(m.BlsKey)
+		copy(dAtA[i:], m.BlsKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BlsKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.GcAddress) > 0 {
+		i -= len(m.GcAddress)
+		copy(dAtA[i:], m.GcAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GcAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ApprovalAddress) > 0 {
+		i -= len(m.ApprovalAddress)
+		copy(dAtA[i:], m.ApprovalAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ApprovalAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SealAddress) > 0 {
+		i -= len(m.SealAddress)
+		copy(dAtA[i:], m.SealAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SealAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCreateStorageProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	l = len(m.SpAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FundingAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SealAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ApprovalAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.GcAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MaintenanceAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.TotalDeposit != nil {
+		l = m.TotalDeposit.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	l = m.Description.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.BlsKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventEditStorageProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	l = len(m.SpAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Description.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.SealAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ApprovalAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.GcAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MaintenanceAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BlsKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Location.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FundingAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TotalDeposit)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSpStoragePriceUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	if m.UpdateTimeSec != 0 {
+		n += 1 + sovEvents(uint64(m.UpdateTimeSec))
+	}
+	l = m.ReadPrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.FreeReadQuota != 0 {
+		n += 1 + sovEvents(uint64(m.FreeReadQuota))
+	}
+	l = m.StorePrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.StorePriceTiers) > 0 {
+		for _, e := range m.StorePriceTiers {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventGlobalSpStorePriceUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateTimeSec != 0 {
+		n += 1 + sovEvents(uint64(m.UpdateTimeSec))
+	}
+	l = m.ReadPrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.PrimaryStorePrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.SecondaryStorePrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.StorePriceTiers) > 0 {
+		for _, e := range m.StorePriceTiers {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventUpdateStorageProviderStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	l = len(m.SpAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PreStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSpCapacityUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	if m.UpdateTimeSec != 0 {
+		n += 1 + sovEvents(uint64(m.UpdateTimeSec))
+	}
+	if m.TotalCapacity != 0 {
+		n += 1 + sovEvents(uint64(m.TotalCapacity))
+	}
+	if m.FreeCapacity != 0 {
+		n += 1 + sovEvents(uint64(m.FreeCapacity))
+	}
+	if m.MaxGvgFamilies != 0 {
+		n += 1 + sovEvents(uint64(m.MaxGvgFamilies))
+	}
+	return n
+}
+
+func (m *EventScheduleMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovEvents(uint64(m.StartTime))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovEvents(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *EventCancelScheduledMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovEvents(uint64(m.StartTime))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPendingSpStoragePrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	if m.EffectiveTime != 0 {
+		n += 1 + sovEvents(uint64(m.EffectiveTime))
+	}
+	l = m.ReadPrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.FreeReadQuota != 0 {
+		n += 1 + sovEvents(uint64(m.FreeReadQuota))
+	}
+	l = m.StorePrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.StorePriceTiers) > 0 {
+		for _, e := range m.StorePriceTiers {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventCancelPendingSpStoragePrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	if m.EffectiveTime != 0 {
+		n += 1 + sovEvents(uint64(m.EffectiveTime))
+	}
+	return n
+}
+
+func (m *EventSpKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	l = len(m.SealAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ApprovalAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.GcAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BlsKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ExpireHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ExpireHeight))
+	}
+	return n
+}
+
+func (m *EventCompleteSpKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	l = len(m.SealAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ApprovalAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.GcAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BlsKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCreateStorageProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateStorageProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateStorageProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SealAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovalAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaintenanceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TotalDeposit == nil {
+				m.TotalDeposit = &types.Coin{}
+			}
+			if err := m.TotalDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventEditStorageProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEditStorageProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEditStorageProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SealAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovalAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaintenanceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Location.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalDeposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventCreateStorageProvider) Unmarshal(dAtA []byte) error {
+func (m *EventSpStoragePriceUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1238,10 +3289,10 @@ func (m *EventCreateStorageProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreateStorageProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSpStoragePriceUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreateStorageProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSpStoragePriceUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1264,10 +3315,10 @@ func (m *EventCreateStorageProvider) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimeSec", wireType)
 			}
-			var stringLen uint64
+			m.UpdateTimeSec = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1277,27 +3328,14 @@ func (m *EventCreateStorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UpdateTimeSec |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SpAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1325,11 +3363,32 @@ func (m *EventCreateStorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FundingAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.ReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeReadQuota", wireType)
+			}
+			m.FreeReadQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SealAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1357,13 +3416,15 @@ func (m *EventCreateStorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SealAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.StorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePriceTiers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1373,27 +3434,98 @@ func (m *EventCreateStorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApprovalAddress = string(dAtA[iNdEx:postIndex])
+			m.StorePriceTiers = append(m.StorePriceTiers, SpStorePriceTier{})
+			if err := m.StorePriceTiers[len(m.StorePriceTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGlobalSpStorePriceUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGlobalSpStorePriceUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGlobalSpStorePriceUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimeSec", wireType)
+			}
+			m.UpdateTimeSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateTimeSec |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1421,11 +3553,13 @@ func (m *EventCreateStorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GcAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.ReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryStorePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1453,11 +3587,13 @@ func (m *EventCreateStorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaintenanceAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.PrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryStorePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1485,11 +3621,13 @@ func (m *EventCreateStorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			if err := m.SecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePriceTiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1516,18 +3654,66 @@ func (m *EventCreateStorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TotalDeposit == nil {
-				m.TotalDeposit = &types.Coin{}
-			}
-			if err := m.TotalDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StorePriceTiers = append(m.StorePriceTiers, GlobalStorePriceTier{})
+			if err := m.StorePriceTiers[len(m.StorePriceTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateStorageProviderStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateStorageProviderStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateStorageProviderStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
 			}
-			m.Status = 0
+			m.SpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1537,16 +3723,48 @@ func (m *EventCreateStorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= Status(b&0x7F) << shift
+				m.SpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreStatus", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1556,28 +3774,27 @@ func (m *EventCreateStorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PreStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1605,7 +3822,7 @@ func (m *EventCreateStorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlsKey = string(dAtA[iNdEx:postIndex])
+			m.NewStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1628,7 +3845,7 @@ func (m *EventCreateStorageProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventEditStorageProvider) Unmarshal(dAtA []byte) error {
+func (m *EventSpCapacityUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1651,10 +3868,10 @@ func (m *EventEditStorageProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventEditStorageProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSpCapacityUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventEditStorageProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSpCapacityUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1677,10 +3894,10 @@ func (m *EventEditStorageProvider) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimeSec", wireType)
 			}
-			var stringLen uint64
+			m.UpdateTimeSec = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1690,29 +3907,16 @@ func (m *EventEditStorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UpdateTimeSec |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SpAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCapacity", wireType)
 			}
-			var stringLen uint64
+			m.TotalCapacity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1722,29 +3926,16 @@ func (m *EventEditStorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TotalCapacity |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeCapacity", wireType)
 			}
-			var msglen int
+			m.FreeCapacity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1754,30 +3945,16 @@ func (m *EventEditStorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FreeCapacity |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SealAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGvgFamilies", wireType)
 			}
-			var stringLen uint64
+			m.MaxGvgFamilies = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1787,61 +3964,66 @@ func (m *EventEditStorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxGvgFamilies |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SealAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScheduleMaintenance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApprovalAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GcAddress", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScheduleMaintenance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScheduleMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1851,29 +4033,16 @@ func (m *EventEditStorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GcAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceAddress", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			m.StartTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1883,29 +4052,16 @@ func (m *EventEditStorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MaintenanceAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var stringLen uint64
+			m.Duration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1915,24 +4071,11 @@ func (m *EventEditStorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Duration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlsKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1954,7 +4097,7 @@ func (m *EventEditStorageProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDeposit) Unmarshal(dAtA []byte) error {
+func (m *EventCancelScheduledMaintenance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1977,17 +4120,17 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCancelScheduledMaintenance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCancelScheduledMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
 			}
-			var stringLen uint64
+			m.SpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1997,29 +4140,16 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FundingAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			m.StartTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2029,27 +4159,14 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Deposit = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2077,7 +4194,7 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDeposit = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2100,7 +4217,7 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSpStoragePriceUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventPendingSpStoragePrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2123,10 +4240,10 @@ func (m *EventSpStoragePriceUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSpStoragePriceUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPendingSpStoragePrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSpStoragePriceUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPendingSpStoragePrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2150,9 +4267,9 @@ func (m *EventSpStoragePriceUpdate) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimeSec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
 			}
-			m.UpdateTimeSec = 0
+			m.EffectiveTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2162,7 +4279,7 @@ func (m *EventSpStoragePriceUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdateTimeSec |= int64(b&0x7F) << shift
+				m.EffectiveTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2254,6 +4371,40 @@ func (m *EventSpStoragePriceUpdate) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePriceTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorePriceTiers = append(m.StorePriceTiers, SpStorePriceTier{})
+			if err := m.StorePriceTiers[len(m.StorePriceTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2275,7 +4426,7 @@ func (m *EventSpStoragePriceUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventGlobalSpStorePriceUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventCancelPendingSpStoragePrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2298,17 +4449,17 @@ func (m *EventGlobalSpStorePriceUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventGlobalSpStorePriceUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCancelPendingSpStoragePrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventGlobalSpStorePriceUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCancelPendingSpStoragePrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimeSec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
 			}
-			m.UpdateTimeSec = 0
+			m.SpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2318,14 +4469,102 @@ func (m *EventGlobalSpStorePriceUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdateTimeSec |= int64(b&0x7F) << shift
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			m.EffectiveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSpKeyRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSpKeyRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSpKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SealAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2353,13 +4592,11 @@ func (m *EventGlobalSpStorePriceUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SealAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryStorePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2387,13 +4624,11 @@ func (m *EventGlobalSpStorePriceUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ApprovalAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryStorePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2421,10 +4656,59 @@ func (m *EventGlobalSpStorePriceUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.GcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.BlsKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
+			}
+			m.ExpireHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2446,7 +4730,7 @@ func (m *EventGlobalSpStorePriceUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateStorageProviderStatus) Unmarshal(dAtA []byte) error {
+func (m *EventCompleteSpKeyRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2469,10 +4753,10 @@ func (m *EventUpdateStorageProviderStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateStorageProviderStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCompleteSpKeyRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateStorageProviderStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCompleteSpKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2496,7 +4780,7 @@ func (m *EventUpdateStorageProviderStatus) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SealAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2524,11 +4808,11 @@ func (m *EventUpdateStorageProviderStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpAddress = string(dAtA[iNdEx:postIndex])
+			m.SealAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2556,11 +4840,11 @@ func (m *EventUpdateStorageProviderStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreStatus = string(dAtA[iNdEx:postIndex])
+			m.ApprovalAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2588,7 +4872,39 @@ func (m *EventUpdateStorageProviderStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewStatus = string(dAtA[iNdEx:postIndex])
+			m.GcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
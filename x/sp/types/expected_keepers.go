@@ -32,3 +32,9 @@ type AuthzKeeper interface {
 	Update(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, updated authz.Authorization) error
 	DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) error
 }
+
+// StorageKeeper defines the expected storage keeper used to check the location of a storage provider against
+// the placement constraints of the buckets it serves.
+type StorageKeeper interface {
+	VerifySPLocation(ctx sdk.Context, spID uint32, location SpLocation) error
+}
@@ -274,3 +274,40 @@ func (mr *MockAuthzKeeperMockRecorder) Update(ctx, grantee, granter, updated int
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAuthzKeeper)(nil).Update), ctx, grantee, granter, updated)
 }
+
+// MockStorageKeeper is a mock of StorageKeeper interface.
+type MockStorageKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageKeeperMockRecorder
+}
+
+// MockStorageKeeperMockRecorder is the mock recorder for MockStorageKeeper.
+type MockStorageKeeperMockRecorder struct {
+	mock *MockStorageKeeper
+}
+
+// NewMockStorageKeeper creates a new mock instance.
+func NewMockStorageKeeper(ctrl *gomock.Controller) *MockStorageKeeper {
+	mock := &MockStorageKeeper{ctrl: ctrl}
+	mock.recorder = &MockStorageKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageKeeper) EXPECT() *MockStorageKeeperMockRecorder {
+	return m.recorder
+}
+
+// VerifySPLocation mocks base method.
+func (m *MockStorageKeeper) VerifySPLocation(ctx types.Context, spID uint32, location SpLocation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifySPLocation", ctx, spID, location)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifySPLocation indicates an expected call of VerifySPLocation.
+func (mr *MockStorageKeeperMockRecorder) VerifySPLocation(ctx, spID, location interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifySPLocation", reflect.TypeOf((*MockStorageKeeper)(nil).VerifySPLocation), ctx, spID, location)
+}
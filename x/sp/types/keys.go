@@ -36,6 +36,15 @@ var (
 	StorageProviderSequenceKey       = []byte{0x31}
 
 	StorageProviderMaintenanceRecordPrefix = []byte{0x41}
+	StorageProviderScoreStatsPrefix        = []byte{0x42}
+	StorageProviderCapacityPrefix          = []byte{0x43}
+	ScheduledMaintenancePrefix             = []byte{0x44}
+	ActiveScheduledMaintenancePrefix       = []byte{0x45}
+	PendingSpStoragePricePrefix            = []byte{0x46}
+	SpKeyRotationPrefix                    = []byte{0x47}
+	SpKeyRotationQueuePrefix               = []byte{0x48}
+	PendingSpStoragePriceQueuePrefix       = []byte{0x49}
+	StorageProviderJailedHeightPrefix      = []byte{0x4A}
 )
 
 // GetStorageProviderKey creates the key for the provider with address
@@ -123,3 +132,57 @@ func ParseGlobalSpStorePriceKey(key []byte) (timestamp int64) {
 func GetStorageProviderMaintenanceRecordsKey(spAddr sdk.AccAddress) []byte {
 	return append(StorageProviderMaintenanceRecordPrefix, spAddr.Bytes()...)
 }
+
+func GetStorageProviderScoreStatsKey(spId uint32) []byte {
+	idBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(idBytes, spId)
+	return append(StorageProviderScoreStatsPrefix, idBytes...)
+}
+
+func GetStorageProviderCapacityKey(spId uint32) []byte {
+	idBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(idBytes, spId)
+	return append(StorageProviderCapacityPrefix, idBytes...)
+}
+
+// GetScheduledMaintenanceKey returns the key of a maintenance window, which is ordered by the time first
+func GetScheduledMaintenanceKey(timestamp int64, spId uint32) []byte {
+	key := make([]byte, 12)
+	binary.BigEndian.PutUint64(key, uint64(timestamp))
+	binary.BigEndian.PutUint32(key[8:], spId)
+	return key
+}
+
+func GetPendingSpStoragePriceKey(spId uint32) []byte {
+	idBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(idBytes, spId)
+	return append(PendingSpStoragePricePrefix, idBytes...)
+}
+
+// GetPendingSpStoragePriceQueueKey returns the key of a pending storage price in the queue, which is ordered by the effective time first
+func GetPendingSpStoragePriceQueueKey(effectiveTime int64, spId uint32) []byte {
+	key := make([]byte, 12)
+	binary.BigEndian.PutUint64(key, uint64(effectiveTime))
+	binary.BigEndian.PutUint32(key[8:], spId)
+	return key
+}
+
+func GetSpKeyRotationKey(spId uint32) []byte {
+	idBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(idBytes, spId)
+	return append(SpKeyRotationPrefix, idBytes...)
+}
+
+// GetSpKeyRotationQueueKey returns the key of a key rotation in the queue, which is ordered by the expiration height first
+func GetSpKeyRotationQueueKey(expireHeight int64, spId uint32) []byte {
+	key := make([]byte, 12)
+	binary.BigEndian.PutUint64(key, uint64(expireHeight))
+	binary.BigEndian.PutUint32(key[8:], spId)
+	return key
+}
+
+func GetStorageProviderJailedHeightKey(spId uint32) []byte {
+	idBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(idBytes, spId)
+	return append(StorageProviderJailedHeightPrefix, idBytes...)
+}
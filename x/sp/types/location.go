@@ -0,0 +1,32 @@
+package types
+
+import (
+	"regexp"
+
+	"cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxLocationCodeLength is the max length of a region or jurisdiction code
+const MaxLocationCodeLength = 32
+
+var locationCodeRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]*$`)
+
+// ValidateLocationCode checks a region or jurisdiction code, an empty code means the attribute is not declared.
+func ValidateLocationCode(code string) error {
+	if len(code) > MaxLocationCodeLength {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "location code (%s) is longer than %d", code, MaxLocationCodeLength)
+	}
+	if !locationCodeRegexp.MatchString(code) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "location code (%s) contains invalid characters", code)
+	}
+	return nil
+}
+
+// Validate checks the region and jurisdiction codes of the location
+func (l SpLocation) Validate() error {
+	if err := ValidateLocationCode(l.Region); err != nil {
+		return err
+	}
+	return ValidateLocationCode(l.Jurisdiction)
+}
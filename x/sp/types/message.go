@@ -19,6 +19,10 @@ const (
 	TypeMsgUpdateSpStoragePrice        = "update_sp_storage_price"
 	TypeMsgUpdateParams                = "update_params"
 	TypeMsgUpdateStorageProviderStatus = "update_storage_provider_status"
+	TypeMsgUpdateSpCapacity            = "update_sp_capacity"
+	TypeMsgScheduleMaintenance         = "schedule_maintenance"
+	TypeMsgCancelScheduledMaintenance  = "cancel_scheduled_maintenance"
+	TypeMsgUnjailStorageProvider       = "unjail_storage_provider"
 )
 
 var (
@@ -29,6 +33,10 @@ var (
 	_ sdk.Msg = &MsgUpdateSpStoragePrice{}
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgUpdateStorageProviderStatus{}
+	_ sdk.Msg = &MsgUpdateSpCapacity{}
+	_ sdk.Msg = &MsgScheduleMaintenance{}
+	_ sdk.Msg = &MsgCancelScheduledMaintenance{}
+	_ sdk.Msg = &MsgUnjailStorageProvider{}
 )
 
 // NewMsgCreateStorageProvider creates a new MsgCreateStorageProvider instance.
@@ -213,6 +221,11 @@ func (msg *MsgEditStorageProvider) ValidateBasic() error {
 			return err
 		}
 	}
+	if msg.Location != nil {
+		if err := msg.Location.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -299,7 +312,10 @@ func (msg *MsgUpdateSpStoragePrice) ValidateBasic() error {
 	if msg.StorePrice.IsNil() || msg.StorePrice.IsNegative() {
 		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid store price (%s)", msg.StorePrice)
 	}
-	return nil
+	if msg.EffectiveTime < 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid effective time (%d)", msg.EffectiveTime)
+	}
+	return ValidateStorePriceTiers(msg.StorePrice, msg.StorePriceTiers)
 }
 
 // GetSignBytes implements the LegacyMsg interface.
@@ -374,6 +390,195 @@ func (msg *MsgUpdateStorageProviderStatus) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgUpdateSpCapacity creates a new MsgUpdateSpCapacity instance
+func NewMsgUpdateSpCapacity(spAddress sdk.AccAddress, totalCapacity, freeCapacity uint64, maxGvgFamilies uint32) *MsgUpdateSpCapacity {
+	return &MsgUpdateSpCapacity{
+		SpAddress:      spAddress.String(),
+		TotalCapacity:  totalCapacity,
+		FreeCapacity:   freeCapacity,
+		MaxGvgFamilies: maxGvgFamilies,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg *MsgUpdateSpCapacity) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface.
+func (msg *MsgUpdateSpCapacity) Type() string {
+	return TypeMsgUpdateSpCapacity
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg *MsgUpdateSpCapacity) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.SpAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg *MsgUpdateSpCapacity) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgUpdateSpCapacity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.SpAddress); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if msg.FreeCapacity > msg.TotalCapacity {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "free capacity (%d) exceeds total capacity (%d)", msg.FreeCapacity, msg.TotalCapacity)
+	}
+	return nil
+}
+
+// NewMsgScheduleMaintenance creates a new MsgScheduleMaintenance instance
+func NewMsgScheduleMaintenance(spAddress sdk.AccAddress, startTime, duration int64) *MsgScheduleMaintenance {
+	return &MsgScheduleMaintenance{
+		SpAddress: spAddress.String(),
+		StartTime: startTime,
+		Duration:  duration,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg *MsgScheduleMaintenance) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface.
+func (msg *MsgScheduleMaintenance) Type() string {
+	return TypeMsgScheduleMaintenance
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg *MsgScheduleMaintenance) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.SpAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg *MsgScheduleMaintenance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgScheduleMaintenance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.SpAddress); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if msg.StartTime <= 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid maintenance start time (%d)", msg.StartTime)
+	}
+	if msg.Duration <= 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid maintenance duration (%d)", msg.Duration)
+	}
+	return nil
+}
+
+// NewMsgCancelScheduledMaintenance creates a new MsgCancelScheduledMaintenance instance
+func NewMsgCancelScheduledMaintenance(spAddress sdk.AccAddress, startTime int64) *MsgCancelScheduledMaintenance {
+	return &MsgCancelScheduledMaintenance{
+		SpAddress: spAddress.String(),
+		StartTime: startTime,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg *MsgCancelScheduledMaintenance) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface.
+func (msg *MsgCancelScheduledMaintenance) Type() string {
+	return TypeMsgCancelScheduledMaintenance
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg *MsgCancelScheduledMaintenance) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.SpAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg *MsgCancelScheduledMaintenance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgCancelScheduledMaintenance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.SpAddress); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if msg.StartTime <= 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid maintenance start time (%d)", msg.StartTime)
+	}
+	return nil
+}
+
+// NewMsgUnjailStorageProvider creates a new MsgUnjailStorageProvider instance
+func NewMsgUnjailStorageProvider(fundAddress sdk.AccAddress, spAddress sdk.AccAddress, deposit sdk.Coin) *MsgUnjailStorageProvider {
+	return &MsgUnjailStorageProvider{
+		Creator:   fundAddress.String(),
+		SpAddress: spAddress.String(),
+		Deposit:   deposit,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg *MsgUnjailStorageProvider) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface.
+func (msg *MsgUnjailStorageProvider) Type() string {
+	return TypeMsgUnjailStorageProvider
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg *MsgUnjailStorageProvider) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromHexUnsafe(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg *MsgUnjailStorageProvider) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgUnjailStorageProvider) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.SpAddress); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sp address (%s)", err)
+	}
+
+	if !msg.Deposit.IsValid() || !msg.Deposit.Amount.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "invalid deposit amount")
+	}
+
+	return nil
+}
+
 func validateBlsKeyAndProof(blsKey, blsProof string) error {
 	blsPk, err := hex.DecodeString(blsKey)
 	if err != nil || len(blsPk) != sdk.BLSPubKeyLength {
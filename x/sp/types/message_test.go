@@ -117,3 +117,34 @@ func TestMsgDeposit_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateSpStoragePrice_ValidateBasic(t *testing.T) {
+	spAddr := sample.RandAccAddress()
+	tests := []struct {
+		name  string
+		tiers []SpStorePriceTier
+		err   error
+	}{
+		{"no tiers", nil, nil},
+		{"valid tiers", []SpStorePriceTier{{ChargeSizeThreshold: 1000, StorePrice: sdk.NewDec(90)}, {ChargeSizeThreshold: 2000, StorePrice: sdk.NewDec(80)}}, nil},
+		{"zero threshold", []SpStorePriceTier{{ChargeSizeThreshold: 0, StorePrice: sdk.NewDec(90)}}, sdkerrors.ErrInvalidRequest},
+		{"unordered thresholds", []SpStorePriceTier{{ChargeSizeThreshold: 2000, StorePrice: sdk.NewDec(90)}, {ChargeSizeThreshold: 1000, StorePrice: sdk.NewDec(80)}}, sdkerrors.ErrInvalidRequest},
+		{"price increase", []SpStorePriceTier{{ChargeSizeThreshold: 1000, StorePrice: sdk.NewDec(110)}}, sdkerrors.ErrInvalidRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := MsgUpdateSpStoragePrice{
+				SpAddress:       spAddr.String(),
+				ReadPrice:       sdk.NewDec(100),
+				StorePrice:      sdk.NewDec(100),
+				StorePriceTiers: tt.tiers,
+			}
+			err := msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
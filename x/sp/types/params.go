@@ -26,6 +26,12 @@ const (
 	DefaultUpdateGlobalPriceInterval uint64 = 0 // 0 means the global price will be updated at the first day of each month
 	// UpdatePriceDisallowedDays defines the days, counting backward from the end of a month, in which sp is not allowed to update its price
 	DefaultUpdatePriceDisallowedDays uint32 = 2
+	// DefaultPriceIncreaseNoticeDays defines the days in advance a sp should announce a price increase, 0 means no notice is required
+	DefaultPriceIncreaseNoticeDays uint32 = 7
+	// DefaultKeyRotationOverlapBlocks defines the blocks the replaced keys of a sp are still accepted, 0 means they are dropped immediately
+	DefaultKeyRotationOverlapBlocks uint64 = 0
+	// DefaultMinJailBlocks defines the blocks a jailed sp should stay in jail before it can be unjailed
+	DefaultMinJailBlocks uint64 = 302400
 )
 
 var (
@@ -33,6 +39,8 @@ var (
 	DefaultMinDeposit = math.NewIntFromBigInt(new(big.Int).Mul(big.NewInt(10000), big.NewInt(1e18)))
 	// DefaultSecondarySpStorePriceRatio is 12%
 	DefaultSecondarySpStorePriceRatio = sdk.NewDecFromIntWithPrec(sdk.NewInt(12), 2)
+	// DefaultUnjailDeposit defines the min deposit a jailed sp should top up to be unjailed
+	DefaultUnjailDeposit = math.NewIntFromBigInt(new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18)))
 )
 
 var (
@@ -44,6 +52,10 @@ var (
 	KeyNumOfLockUpBlocksForMaintenance            = []byte("NumOfLockUpBlocksForMaintenance")
 	KeyUpdateGlobalPriceInterval                  = []byte("UpdateGlobalPriceInterval")
 	KeyUpdatePriceDisallowedDays                  = []byte("UpdatePriceDisallowedDays")
+	KeyPriceIncreaseNoticeDays                    = []byte("PriceIncreaseNoticeDays")
+	KeyKeyRotationOverlapBlocks                   = []byte("KeyRotationOverlapBlocks")
+	KeyUnjailDeposit                              = []byte("UnjailDeposit")
+	KeyMinJailBlocks                              = []byte("MinJailBlocks")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -56,7 +68,8 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params instance
 func NewParams(depositDenom string, minDeposit math.Int, secondarySpStorePriceRatio sdk.Dec,
 	historicalBlocksForMaintenanceRecords, maintenanceDurationQuota, lockUpBlocksForMaintenance int64,
-	updateGlobalPriceInterval uint64, updatePriceDisallowedDays uint32) Params {
+	updateGlobalPriceInterval uint64, updatePriceDisallowedDays, priceIncreaseNoticeDays uint32, keyRotationOverlapBlocks uint64,
+	unjailDeposit math.Int, minJailBlocks uint64) Params {
 	return Params{
 		DepositDenom:               depositDenom,
 		MinDeposit:                 minDeposit,
@@ -66,6 +79,10 @@ func NewParams(depositDenom string, minDeposit math.Int, secondarySpStorePriceRa
 		NumOfLockupBlocksForMaintenance:            lockUpBlocksForMaintenance,
 		UpdateGlobalPriceInterval:                  updateGlobalPriceInterval,
 		UpdatePriceDisallowedDays:                  updatePriceDisallowedDays,
+		PriceIncreaseNoticeDays:                    priceIncreaseNoticeDays,
+		KeyRotationOverlapBlocks:                   keyRotationOverlapBlocks,
+		UnjailDeposit:                              unjailDeposit,
+		MinJailBlocks:                              minJailBlocks,
 	}
 }
 
@@ -73,7 +90,8 @@ func NewParams(depositDenom string, minDeposit math.Int, secondarySpStorePriceRa
 func DefaultParams() Params {
 	return NewParams(DefaultDepositDenom, DefaultMinDeposit, DefaultSecondarySpStorePriceRatio,
 		DefaultNumOfHistoricalBlocksForMaintenanceRecords, DefaultMaintenanceDurationQuota, DefaultNumOfLockUpBlocksForMaintenance,
-		DefaultUpdateGlobalPriceInterval, DefaultUpdatePriceDisallowedDays)
+		DefaultUpdateGlobalPriceInterval, DefaultUpdatePriceDisallowedDays, DefaultPriceIncreaseNoticeDays, DefaultKeyRotationOverlapBlocks,
+		DefaultUnjailDeposit, DefaultMinJailBlocks)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -87,6 +105,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyNumOfLockUpBlocksForMaintenance, &p.NumOfLockupBlocksForMaintenance, validateLockUpBlocksForMaintenance),
 		paramtypes.NewParamSetPair(KeyUpdateGlobalPriceInterval, &p.UpdateGlobalPriceInterval, validateUpdateGlobalPriceInterval),
 		paramtypes.NewParamSetPair(KeyUpdatePriceDisallowedDays, &p.UpdatePriceDisallowedDays, validateUpdatePriceDisallowedDays),
+		paramtypes.NewParamSetPair(KeyPriceIncreaseNoticeDays, &p.PriceIncreaseNoticeDays, validatePriceIncreaseNoticeDays),
+		paramtypes.NewParamSetPair(KeyKeyRotationOverlapBlocks, &p.KeyRotationOverlapBlocks, validateKeyRotationOverlapBlocks),
+		paramtypes.NewParamSetPair(KeyUnjailDeposit, &p.UnjailDeposit, validateUnjailDeposit),
+		paramtypes.NewParamSetPair(KeyMinJailBlocks, &p.MinJailBlocks, validateMinJailBlocks),
 	}
 }
 
@@ -118,6 +140,18 @@ func (p Params) Validate() error {
 	if err := validateUpdatePriceDisallowedDays(p.UpdatePriceDisallowedDays); err != nil {
 		return err
 	}
+	if err := validatePriceIncreaseNoticeDays(p.PriceIncreaseNoticeDays); err != nil {
+		return err
+	}
+	if err := validateKeyRotationOverlapBlocks(p.KeyRotationOverlapBlocks); err != nil {
+		return err
+	}
+	if err := validateUnjailDeposit(p.UnjailDeposit); err != nil {
+		return err
+	}
+	if err := validateMinJailBlocks(p.MinJailBlocks); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -221,3 +255,44 @@ func validateUpdatePriceDisallowedDays(i interface{}) error {
 	}
 	return nil
 }
+
+func validatePriceIncreaseNoticeDays(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateKeyRotationOverlapBlocks(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateUnjailDeposit(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("unjail deposit amount cannot be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("unjail deposit amount cannot be lower than 0")
+	}
+
+	return nil
+}
+
+func validateMinJailBlocks(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
@@ -43,6 +43,14 @@ type Params struct {
 	UpdateGlobalPriceInterval uint64 `protobuf:"varint,7,opt,name=update_global_price_interval,json=updateGlobalPriceInterval,proto3" json:"update_global_price_interval,omitempty" yaml:"update_global_price_interval"`
 	// the days counting backwards from end of a month in which a sp cannot update its price
 	UpdatePriceDisallowedDays uint32 `protobuf:"varint,8,opt,name=update_price_disallowed_days,json=updatePriceDisallowedDays,proto3" json:"update_price_disallowed_days,omitempty" yaml:"update_price_disallowed_days"`
+	// the days in advance a sp should announce a price increase, 0 means a price increase takes effect immediately
+	PriceIncreaseNoticeDays uint32 `protobuf:"varint,9,opt,name=price_increase_notice_days,json=priceIncreaseNoticeDays,proto3" json:"price_increase_notice_days,omitempty" yaml:"price_increase_notice_days"`
+	// the number of blocks the replaced seal, approval, gc addresses and bls key of a sp are still accepted, 0 means they are dropped immediately
+	KeyRotationOverlapBlocks uint64 `protobuf:"varint,10,opt,name=key_rotation_overlap_blocks,json=keyRotationOverlapBlocks,proto3" json:"key_rotation_overlap_blocks,omitempty" yaml:"key_rotation_overlap_blocks"`
+	// the min deposit a jailed sp should top up to be unjailed, in addition to restoring the min deposit
+	UnjailDeposit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=unjail_deposit,json=unjailDeposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"unjail_deposit"`
+	// the number of blocks a jailed sp should stay in jail before it can be unjailed
+	MinJailBlocks uint64 `protobuf:"varint,12,opt,name=min_jail_blocks,json=minJailBlocks,proto3" json:"min_jail_blocks,omitempty" yaml:"min_jail_blocks"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -119,6 +127,27 @@ func (m *Params) GetUpdatePriceDisallowedDays() uint32 {
 	return 0
 }
 
+func (m *Params) GetPriceIncreaseNoticeDays() uint32 {
+	if m != nil {
+		return m.PriceIncreaseNoticeDays
+	}
+	return 0
+}
+
+func (m *Params) GetKeyRotationOverlapBlocks() uint64 {
+	if m != nil {
+		return m.KeyRotationOverlapBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMinJailBlocks() uint64 {
+	if m != nil {
+		return m.MinJailBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "greenfield.sp.Params")
 }
@@ -126,43 +155,50 @@ func init() {
 func init() { proto.RegisterFile("greenfield/sp/params.proto", fileDescriptor_a5353d8e6e407d7e) }
 
 var fileDescriptor_a5353d8e6e407d7e = []byte{
-	// 561 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x63, 0x5a, 0x42, 0x31, 0x74, 0xb1, 0x18, 0xdc, 0x08, 0xd9, 0xc1, 0xe5, 0x47, 0x54,
-	0xd4, 0x18, 0x89, 0x01, 0xa9, 0x30, 0x45, 0x16, 0x10, 0x09, 0x44, 0x71, 0x37, 0x24, 0x74, 0x3a,
-	0xfb, 0x2e, 0xc9, 0xa9, 0xf6, 0xdd, 0x71, 0x77, 0x06, 0xb2, 0x20, 0xfe, 0x04, 0x46, 0xc6, 0xf2,
-	0x3f, 0xf0, 0x47, 0x74, 0xac, 0x98, 0x10, 0x83, 0x85, 0x92, 0x85, 0x39, 0x03, 0x33, 0xba, 0xb3,
-	0x95, 0x1a, 0x29, 0xad, 0xd4, 0xc9, 0xf6, 0xfb, 0x7e, 0xdf, 0xfb, 0xbc, 0x67, 0x3f, 0x9f, 0xdd,
-	0x19, 0x0b, 0x8c, 0xe9, 0x88, 0xe0, 0x0c, 0x85, 0x92, 0x87, 0x1c, 0x0a, 0x98, 0xcb, 0x3e, 0x17,
-	0x4c, 0x31, 0x67, 0xf3, 0x54, 0xeb, 0x4b, 0xde, 0xd9, 0x4a, 0x99, 0xcc, 0x99, 0x04, 0x46, 0x0c,
-	0xab, 0x87, 0xca, 0xd9, 0xb9, 0x31, 0x66, 0x63, 0x56, 0xc5, 0xf5, 0x5d, 0x15, 0x0d, 0xfe, 0xb6,
-	0xed, 0xf6, 0xbe, 0x29, 0xe8, 0x6c, 0xdb, 0x9b, 0x08, 0x73, 0x26, 0x89, 0x02, 0x08, 0x53, 0x96,
-	0xbb, 0x56, 0xd7, 0xea, 0x5d, 0x8d, 0xaf, 0xd7, 0xc1, 0x48, 0xc7, 0x9c, 0xb7, 0xf6, 0xb5, 0x9c,
-	0x50, 0x50, 0xc7, 0xdc, 0x4b, 0xda, 0x32, 0x78, 0x72, 0x5c, 0xfa, 0xad, 0x5f, 0xa5, 0x7f, 0x77,
-	0x4c, 0xd4, 0xa4, 0x48, 0xfa, 0x29, 0xcb, 0x6b, 0x76, 0x7d, 0xd9, 0x95, 0xe8, 0x30, 0x54, 0x53,
-	0x8e, 0x65, 0x7f, 0x48, 0xd5, 0x8f, 0xef, 0xbb, 0x76, 0xdd, 0xda, 0x90, 0xaa, 0xd8, 0xce, 0x09,
-	0x8d, 0xaa, 0x7a, 0xce, 0x67, 0xcb, 0xf6, 0x24, 0x4e, 0x19, 0x45, 0x50, 0x4c, 0x81, 0xe4, 0x40,
-	0x2a, 0x26, 0x30, 0xe0, 0x82, 0xa4, 0x18, 0x08, 0xa8, 0x08, 0x73, 0xd7, 0x2e, 0x8c, 0x8c, 0x70,
-	0xda, 0x40, 0x46, 0x38, 0x8d, 0x3b, 0x4b, 0xc6, 0x01, 0x3f, 0xd0, 0x84, 0x7d, 0x0d, 0x88, 0x75,
-	0x7d, 0xe7, 0x9b, 0x65, 0x3f, 0xa0, 0x45, 0x0e, 0xd8, 0x08, 0x4c, 0x88, 0xc6, 0x93, 0x14, 0x66,
-	0x20, 0xc9, 0x58, 0x7a, 0x28, 0xc1, 0x88, 0x09, 0x90, 0x43, 0x42, 0x15, 0xa6, 0x90, 0xea, 0x96,
-	0x70, 0xca, 0x04, 0x92, 0xee, 0x7a, 0xd7, 0xea, 0xad, 0x0d, 0x1e, 0x2f, 0x4a, 0xff, 0xd1, 0x14,
-	0xe6, 0xd9, 0x5e, 0x70, 0xd1, 0x0a, 0x41, 0xbc, 0x43, 0x8b, 0xfc, 0xd5, 0xe8, 0xf9, 0x32, 0x61,
-	0x60, 0xfc, 0x4f, 0x99, 0x78, 0x79, 0xea, 0x8e, 0x2b, 0xb3, 0x93, 0xda, 0x9d, 0x66, 0x0d, 0x54,
-	0x98, 0x57, 0x43, 0xc1, 0xbb, 0x82, 0x29, 0xe8, 0x5e, 0x36, 0xcd, 0xdc, 0x59, 0x94, 0xfe, 0xad,
-	0xaa, 0x99, 0xb3, 0xbd, 0x41, 0xec, 0x36, 0xc4, 0xa8, 0xd6, 0x5e, 0x6b, 0xc9, 0xf9, 0x64, 0xdf,
-	0xae, 0xa7, 0xd0, 0x9d, 0x14, 0xfc, 0x8c, 0x09, 0xdc, 0xb6, 0xc1, 0x85, 0x8b, 0xd2, 0xbf, 0xff,
-	0xdf, 0xec, 0xe7, 0x66, 0x05, 0xb1, 0x6f, 0xe6, 0x7d, 0x61, 0x4c, 0xab, 0x66, 0x75, 0x26, 0xf6,
-	0xcd, 0x82, 0x23, 0xa8, 0x30, 0x18, 0x67, 0x2c, 0x81, 0x59, 0xbd, 0x05, 0xda, 0x20, 0xde, 0xc3,
-	0xcc, 0xbd, 0xd2, 0xb5, 0x7a, 0xeb, 0x83, 0x7b, 0x8b, 0xd2, 0xdf, 0xae, 0xb8, 0xe7, 0xb9, 0x83,
-	0x78, 0xab, 0x92, 0x9f, 0x19, 0xd5, 0x7c, 0xef, 0x61, 0xad, 0x35, 0x48, 0x55, 0x12, 0x22, 0x12,
-	0x66, 0x19, 0xfb, 0x80, 0x11, 0x40, 0x70, 0x2a, 0xdd, 0x8d, 0xae, 0xd5, 0xdb, 0x5c, 0x41, 0x5a,
-	0xe9, 0x5e, 0x92, 0x0c, 0x23, 0x5a, 0x8a, 0x11, 0x9c, 0xca, 0xbd, 0x8d, 0xaf, 0x47, 0x7e, 0xeb,
-	0xcf, 0x91, 0x6f, 0x0d, 0xa2, 0xe3, 0x99, 0x67, 0x9d, 0xcc, 0x3c, 0xeb, 0xf7, 0xcc, 0xb3, 0xbe,
-	0xcc, 0xbd, 0xd6, 0xc9, 0xdc, 0x6b, 0xfd, 0x9c, 0x7b, 0xad, 0x37, 0x3b, 0x8d, 0x95, 0x4e, 0x68,
-	0xb2, 0x9b, 0x4e, 0x20, 0xa1, 0x61, 0xe3, 0x0c, 0xf8, 0xa8, 0x4f, 0x01, 0xb3, 0xda, 0x49, 0xdb,
-	0xfc, 0xc5, 0x0f, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xd4, 0x74, 0x7e, 0x23, 0x04, 0x00,
-	0x00,
+	// 685 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0x36, 0xc6, 0xe6, 0xad, 0x20, 0x45, 0x08, 0xb2, 0x82, 0x9a, 0x92, 0xc1, 0xa8,
+	0x86, 0xd6, 0x22, 0x71, 0x40, 0x1a, 0x9c, 0xaa, 0x08, 0x18, 0x02, 0x36, 0xb2, 0x1b, 0x12, 0xb2,
+	0x9c, 0xc4, 0x6d, 0x4d, 0x13, 0x3b, 0xd8, 0xc9, 0x20, 0x17, 0xc4, 0x23, 0x70, 0xe4, 0x38, 0xde,
+	0x81, 0x87, 0xd8, 0x71, 0xe2, 0x84, 0x38, 0x44, 0x68, 0xbb, 0x20, 0x8e, 0x7d, 0x02, 0x64, 0x3b,
+	0xeb, 0x32, 0x69, 0x9b, 0x34, 0x89, 0x53, 0x5b, 0xff, 0xff, 0xfe, 0x7e, 0xff, 0x2f, 0x5f, 0xfa,
+	0x81, 0xc6, 0x80, 0x63, 0x4c, 0xfb, 0x04, 0x47, 0x61, 0x57, 0x24, 0xdd, 0x04, 0x71, 0x14, 0x8b,
+	0x4e, 0xc2, 0x59, 0xca, 0xcc, 0xfa, 0x91, 0xd6, 0x11, 0x49, 0x63, 0x31, 0x60, 0x22, 0x66, 0x02,
+	0x2a, 0xb1, 0xab, 0x7f, 0x68, 0x67, 0xe3, 0xea, 0x80, 0x0d, 0x98, 0x3e, 0x97, 0xdf, 0xf4, 0xa9,
+	0xf3, 0x77, 0x0e, 0xcc, 0x6c, 0xaa, 0x82, 0xe6, 0x12, 0xa8, 0x87, 0x38, 0x61, 0x82, 0xa4, 0x30,
+	0xc4, 0x94, 0xc5, 0x96, 0xd1, 0x32, 0xda, 0x73, 0xde, 0x42, 0x79, 0xe8, 0xca, 0x33, 0xf3, 0x2d,
+	0x98, 0x8f, 0x09, 0x85, 0xe5, 0x99, 0x75, 0x41, 0x5a, 0x7a, 0x8f, 0x77, 0x0b, 0xbb, 0xf6, 0xab,
+	0xb0, 0x97, 0x07, 0x24, 0x1d, 0x66, 0x7e, 0x27, 0x60, 0x71, 0xc9, 0x2e, 0x3f, 0x56, 0x45, 0x38,
+	0xea, 0xa6, 0x79, 0x82, 0x45, 0x67, 0x9d, 0xa6, 0x3f, 0xbe, 0xaf, 0x82, 0x32, 0xda, 0x3a, 0x4d,
+	0x3d, 0x10, 0x13, 0xea, 0xea, 0x7a, 0xe6, 0x67, 0x03, 0x34, 0x05, 0x0e, 0x18, 0x0d, 0x11, 0xcf,
+	0xa1, 0x48, 0xa0, 0x48, 0x19, 0xc7, 0x30, 0xe1, 0x24, 0xc0, 0x90, 0xa3, 0x94, 0x30, 0x6b, 0xea,
+	0xdc, 0x48, 0x17, 0x07, 0x15, 0xa4, 0x8b, 0x03, 0xaf, 0x31, 0x61, 0x6c, 0x25, 0x5b, 0x92, 0xb0,
+	0x29, 0x01, 0x9e, 0xac, 0x6f, 0x7e, 0x33, 0xc0, 0x7d, 0x9a, 0xc5, 0x90, 0xf5, 0xe1, 0x90, 0x48,
+	0x3c, 0x09, 0x50, 0x04, 0xfd, 0x88, 0x05, 0x23, 0x01, 0xfb, 0x8c, 0xc3, 0x18, 0x11, 0x9a, 0x62,
+	0x8a, 0xa8, 0x8c, 0x84, 0x03, 0xc6, 0x43, 0x61, 0x4d, 0xb7, 0x8c, 0xf6, 0x54, 0xef, 0xd1, 0xb8,
+	0xb0, 0x1f, 0xe6, 0x28, 0x8e, 0xd6, 0x9c, 0xf3, 0x56, 0x70, 0xbc, 0x15, 0x9a, 0xc5, 0x1b, 0xfd,
+	0x67, 0x93, 0x0b, 0x3d, 0xe5, 0x7f, 0xc2, 0xf8, 0xcb, 0x23, 0xb7, 0xa7, 0xcd, 0x66, 0x00, 0x1a,
+	0xd5, 0x1a, 0x61, 0xa6, 0x1e, 0x0d, 0x85, 0xef, 0x33, 0x96, 0x22, 0xeb, 0xa2, 0x0a, 0x73, 0x67,
+	0x5c, 0xd8, 0xb7, 0x74, 0x98, 0xd3, 0xbd, 0x8e, 0x67, 0x55, 0x44, 0xb7, 0xd4, 0x5e, 0x4b, 0xc9,
+	0xfc, 0x04, 0x6e, 0x97, 0x5d, 0xc8, 0x24, 0x59, 0x72, 0x4a, 0x07, 0xd6, 0x8c, 0xc2, 0x75, 0xc7,
+	0x85, 0x7d, 0xef, 0x58, 0xef, 0x67, 0xde, 0x72, 0x3c, 0x5b, 0xf5, 0xfb, 0x42, 0x99, 0x4e, 0xea,
+	0xd5, 0x1c, 0x82, 0x9b, 0x59, 0x12, 0xa2, 0x14, 0xc3, 0x41, 0xc4, 0x7c, 0x14, 0x95, 0x6f, 0x81,
+	0x34, 0xf0, 0x6d, 0x14, 0x59, 0x97, 0x5a, 0x46, 0x7b, 0xba, 0x77, 0x77, 0x5c, 0xd8, 0x4b, 0x9a,
+	0x7b, 0x96, 0xdb, 0xf1, 0x16, 0xb5, 0xfc, 0x54, 0xa9, 0x6a, 0xde, 0xeb, 0xa5, 0x56, 0x21, 0xe9,
+	0x4b, 0x21, 0x11, 0x28, 0x8a, 0xd8, 0x07, 0x1c, 0xc2, 0x10, 0xe5, 0xc2, 0x9a, 0x6d, 0x19, 0xed,
+	0xfa, 0x09, 0xa4, 0x13, 0xdd, 0x13, 0x92, 0x62, 0xb8, 0x13, 0xd1, 0x45, 0xb9, 0x30, 0x7d, 0xd0,
+	0x38, 0xcc, 0x15, 0x70, 0x8c, 0x04, 0x86, 0x94, 0xa5, 0xaa, 0x88, 0xe4, 0xcc, 0x29, 0x4e, 0x65,
+	0x70, 0xa7, 0x7b, 0x1d, 0xef, 0x7a, 0xa2, 0x7b, 0xd0, 0xda, 0x2b, 0x25, 0x29, 0x06, 0x06, 0x37,
+	0x46, 0x38, 0x87, 0x9c, 0xa5, 0x7a, 0xd0, 0x6c, 0x1b, 0xf3, 0x08, 0x1d, 0x0e, 0xc2, 0x02, 0xea,
+	0xb1, 0x2d, 0x8f, 0x0b, 0xdb, 0xd1, 0x90, 0x33, 0xcc, 0x8e, 0x67, 0x8d, 0x70, 0xee, 0x95, 0xe2,
+	0x86, 0xd6, 0xf4, 0xb0, 0xcc, 0x00, 0x5c, 0xce, 0xe8, 0x3b, 0x44, 0xa2, 0xc9, 0x32, 0x98, 0xff,
+	0x0f, 0xcb, 0xa0, 0xae, 0x6b, 0x1e, 0xee, 0x83, 0x1e, 0xb8, 0x22, 0xd7, 0x8d, 0xc2, 0x94, 0xf9,
+	0x17, 0x54, 0xfe, 0xc6, 0xb8, 0xb0, 0xaf, 0x95, 0x6f, 0xf7, 0x71, 0x83, 0xe3, 0xd5, 0x63, 0x42,
+	0x9f, 0x23, 0x52, 0xfe, 0x83, 0xd6, 0x66, 0xbf, 0xee, 0xd8, 0xb5, 0x3f, 0x3b, 0xb6, 0xd1, 0x73,
+	0x77, 0xf7, 0x9b, 0xc6, 0xde, 0x7e, 0xd3, 0xf8, 0xbd, 0xdf, 0x34, 0xbe, 0x1c, 0x34, 0x6b, 0x7b,
+	0x07, 0xcd, 0xda, 0xcf, 0x83, 0x66, 0xed, 0xcd, 0x4a, 0x25, 0xac, 0x4f, 0xfd, 0xd5, 0x60, 0x88,
+	0x08, 0xed, 0x56, 0xf6, 0xee, 0x47, 0xb9, 0x79, 0x55, 0x68, 0x7f, 0x46, 0x6d, 0xce, 0x07, 0xff,
+	0x02, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x29, 0xd4, 0x42, 0x97, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -208,6 +244,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.UpdatePriceDisallowedDays != that1.UpdatePriceDisallowedDays {
 		return false
 	}
+	if this.PriceIncreaseNoticeDays != that1.PriceIncreaseNoticeDays {
+		return false
+	}
+	if this.KeyRotationOverlapBlocks != that1.KeyRotationOverlapBlocks {
+		return false
+	}
+	if !this.UnjailDeposit.Equal(that1.UnjailDeposit) {
+		return false
+	}
+	if this.MinJailBlocks != that1.MinJailBlocks {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -230,6 +278,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinJailBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinJailBlocks))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.UnjailDeposit.Size()
+		i -= size
+		if _, err := m.UnjailDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.KeyRotationOverlapBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.KeyRotationOverlapBlocks))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.PriceIncreaseNoticeDays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PriceIncreaseNoticeDays))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.UpdatePriceDisallowedDays != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.UpdatePriceDisallowedDays))
 		i--
@@ -325,6 +398,17 @@ func (m *Params) Size() (n int) {
 	if m.UpdatePriceDisallowedDays != 0 {
 		n += 1 + sovParams(uint64(m.UpdatePriceDisallowedDays))
 	}
+	if m.PriceIncreaseNoticeDays != 0 {
+		n += 1 + sovParams(uint64(m.PriceIncreaseNoticeDays))
+	}
+	if m.KeyRotationOverlapBlocks != 0 {
+		n += 1 + sovParams(uint64(m.KeyRotationOverlapBlocks))
+	}
+	l = m.UnjailDeposit.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MinJailBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MinJailBlocks))
+	}
 	return n
 }
 
@@ -558,6 +642,97 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceIncreaseNoticeDays", wireType)
+			}
+			m.PriceIncreaseNoticeDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceIncreaseNoticeDays |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationOverlapBlocks", wireType)
+			}
+			m.KeyRotationOverlapBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyRotationOverlapBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnjailDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinJailBlocks", wireType)
+			}
+			m.MinJailBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinJailBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxStorePriceTiers is the max number of store price tiers a SP can publish
+const MaxStorePriceTiers = 5
+
+// ValidateStorePriceTiers checks the tiers are in strictly ascending order of the charge size threshold,
+// and the price of each tier does not exceed the price of the previous one, i.e. the tiers are volume discounts.
+func ValidateStorePriceTiers(storePrice sdk.Dec, tiers []SpStorePriceTier) error {
+	if len(tiers) > MaxStorePriceTiers {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "too many store price tiers, got: %d, max: %d", len(tiers), MaxStorePriceTiers)
+	}
+	prevThreshold, prevPrice := uint64(0), storePrice
+	for _, tier := range tiers {
+		if tier.ChargeSizeThreshold <= prevThreshold {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid store price tier threshold (%d), should be in ascending order", tier.ChargeSizeThreshold)
+		}
+		if tier.StorePrice.IsNil() || tier.StorePrice.IsNegative() || tier.StorePrice.GT(prevPrice) {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid store price tier price (%s), should not exceed the previous price", tier.StorePrice)
+		}
+		prevThreshold, prevPrice = tier.ChargeSizeThreshold, tier.StorePrice
+	}
+	return nil
+}
+
+// GetStorePrice returns the store price of the SP for a bucket with the total charge size
+func (p SpStoragePrice) GetStorePrice(chargeSize uint64) sdk.Dec {
+	price := p.StorePrice
+	for _, tier := range p.StorePriceTiers {
+		if chargeSize < tier.ChargeSizeThreshold {
+			break
+		}
+		price = tier.StorePrice
+	}
+	return price
+}
+
+// IsIncreaseOf returns whether the price charges more than the previous price in any aspect,
+// i.e. a higher read price, a lower free read quota, or a higher store price for any charge size.
+func (p SpStoragePrice) IsIncreaseOf(prev SpStoragePrice) bool {
+	if p.ReadPrice.GT(prev.ReadPrice) || p.FreeReadQuota < prev.FreeReadQuota {
+		return true
+	}
+	// the store price is a step function of the charge size, it is enough to compare at every step
+	thresholds := []uint64{0}
+	for _, tier := range p.StorePriceTiers {
+		thresholds = append(thresholds, tier.ChargeSizeThreshold)
+	}
+	for _, tier := range prev.StorePriceTiers {
+		thresholds = append(thresholds, tier.ChargeSizeThreshold)
+	}
+	for _, threshold := range thresholds {
+		if p.GetStorePrice(threshold).GT(prev.GetStorePrice(threshold)) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetStorePriceTierIndex returns the index of the tier applied to a bucket with the total charge size,
+// -1 means no tier is applied and the base store prices are used.
+func (p GlobalSpStorePrice) GetStorePriceTierIndex(chargeSize uint64) int {
+	index := -1
+	for i, tier := range p.StorePriceTiers {
+		if chargeSize < tier.ChargeSizeThreshold {
+			break
+		}
+		index = i
+	}
+	return index
+}
+
+// GetStorePrice returns the primary and secondary store prices for a bucket with the total charge size
+func (p GlobalSpStorePrice) GetStorePrice(chargeSize uint64) (primaryStorePrice sdk.Dec, secondaryStorePrice sdk.Dec) {
+	index := p.GetStorePriceTierIndex(chargeSize)
+	if index < 0 {
+		return p.PrimaryStorePrice, p.SecondaryStorePrice
+	}
+	tier := p.StorePriceTiers[index]
+	return tier.PrimaryStorePrice, tier.SecondaryStorePrice
+}
+
+// StorePriceTiersEqual returns whether the store price tiers of two global prices are the same
+func (p GlobalSpStorePrice) StorePriceTiersEqual(other GlobalSpStorePrice) bool {
+	if len(p.StorePriceTiers) != len(other.StorePriceTiers) {
+		return false
+	}
+	for i, tier := range p.StorePriceTiers {
+		otherTier := other.StorePriceTiers[i]
+		if tier.ChargeSizeThreshold != otherTier.ChargeSizeThreshold ||
+			!tier.PrimaryStorePrice.Equal(otherTier.PrimaryStorePrice) ||
+			!tier.SecondaryStorePrice.Equal(otherTier.SecondaryStorePrice) {
+			return false
+		}
+	}
+	return true
+}
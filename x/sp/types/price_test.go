@@ -0,0 +1,31 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpStoragePriceIsIncreaseOf(t *testing.T) {
+	prev := SpStoragePrice{
+		ReadPrice:     sdk.NewDec(10),
+		StorePrice:    sdk.NewDec(10),
+		FreeReadQuota: 100,
+		StorePriceTiers: []SpStorePriceTier{
+			{ChargeSizeThreshold: 1000, StorePrice: sdk.NewDec(8)},
+		},
+	}
+	same := prev
+	require.False(t, same.IsIncreaseOf(prev))
+
+	lowerQuota := prev
+	lowerQuota.FreeReadQuota = 50
+	require.True(t, lowerQuota.IsIncreaseOf(prev))
+
+	// removing a discount tier increases the price for large buckets
+	noTiers := prev
+	noTiers.StorePriceTiers = nil
+	require.True(t, noTiers.IsIncreaseOf(prev))
+	require.False(t, prev.IsIncreaseOf(noTiers))
+}
@@ -670,1049 +670,2874 @@ func (m *QueryStorageProviderMaintenanceRecordsResponse) GetRecords() []*Mainten
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "greenfield.sp.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "greenfield.sp.QueryParamsResponse")
-	proto.RegisterType((*QueryStorageProvidersRequest)(nil), "greenfield.sp.QueryStorageProvidersRequest")
-	proto.RegisterType((*QueryStorageProvidersResponse)(nil), "greenfield.sp.QueryStorageProvidersResponse")
-	proto.RegisterType((*QuerySpStoragePriceRequest)(nil), "greenfield.sp.QuerySpStoragePriceRequest")
-	proto.RegisterType((*QuerySpStoragePriceResponse)(nil), "greenfield.sp.QuerySpStoragePriceResponse")
-	proto.RegisterType((*QueryGlobalSpStorePriceByTimeRequest)(nil), "greenfield.sp.QueryGlobalSpStorePriceByTimeRequest")
-	proto.RegisterType((*QueryGlobalSpStorePriceByTimeResponse)(nil), "greenfield.sp.QueryGlobalSpStorePriceByTimeResponse")
-	proto.RegisterType((*QueryStorageProviderRequest)(nil), "greenfield.sp.QueryStorageProviderRequest")
-	proto.RegisterType((*QueryStorageProviderResponse)(nil), "greenfield.sp.QueryStorageProviderResponse")
-	proto.RegisterType((*QueryStorageProviderByOperatorAddressRequest)(nil), "greenfield.sp.QueryStorageProviderByOperatorAddressRequest")
-	proto.RegisterType((*QueryStorageProviderByOperatorAddressResponse)(nil), "greenfield.sp.QueryStorageProviderByOperatorAddressResponse")
-	proto.RegisterType((*QueryStorageProviderMaintenanceRecordsRequest)(nil), "greenfield.sp.QueryStorageProviderMaintenanceRecordsRequest")
-	proto.RegisterType((*QueryStorageProviderMaintenanceRecordsResponse)(nil), "greenfield.sp.QueryStorageProviderMaintenanceRecordsResponse")
+type QueryStorageProviderScoreRequest struct {
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func init() { proto.RegisterFile("greenfield/sp/query.proto", fileDescriptor_48dd9c8aad3b7a6d) }
-
-var fileDescriptor_48dd9c8aad3b7a6d = []byte{
-	// 874 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x4f, 0xf4, 0x44,
-	0x18, 0xde, 0x2e, 0xba, 0x5f, 0x98, 0x2f, 0xdf, 0x07, 0x19, 0x21, 0x48, 0x65, 0x17, 0xa8, 0xa2,
-	0xec, 0x02, 0xad, 0xec, 0x4a, 0x62, 0x40, 0x13, 0x25, 0x44, 0xd4, 0x84, 0x80, 0xd5, 0x8b, 0x5c,
-	0x9a, 0xe9, 0x76, 0x28, 0x4d, 0x76, 0x3b, 0x43, 0xa7, 0x10, 0x37, 0x84, 0x0b, 0xfe, 0x01, 0x13,
-	0xf5, 0xe2, 0xc5, 0xbf, 0xc3, 0xc1, 0x03, 0x89, 0x17, 0xe3, 0xc1, 0x18, 0xf0, 0x4f, 0x78, 0x33,
-	0x9d, 0x99, 0xee, 0xd2, 0xd9, 0x2e, 0x5b, 0xc9, 0x77, 0xdb, 0xce, 0xfb, 0x3e, 0xef, 0xfb, 0x3c,
-	0xef, 0xbc, 0x7d, 0xb6, 0x60, 0xde, 0x8f, 0x30, 0x0e, 0x4f, 0x02, 0xdc, 0xf1, 0x2c, 0x46, 0xad,
-	0xb3, 0x73, 0x1c, 0xf5, 0x4c, 0x1a, 0x91, 0x98, 0xc0, 0x17, 0x83, 0x90, 0xc9, 0xa8, 0xde, 0x68,
-	0x13, 0xd6, 0x25, 0xcc, 0x72, 0x11, 0xc3, 0x22, 0xcf, 0xba, 0xd8, 0x74, 0x71, 0x8c, 0x36, 0x2d,
-	0x8a, 0xfc, 0x20, 0x44, 0x71, 0x40, 0x42, 0x01, 0xd5, 0xe7, 0x45, 0xae, 0xc3, 0x9f, 0x2c, 0xf1,
-	0x20, 0x43, 0x33, 0x3e, 0xf1, 0x89, 0x38, 0x4f, 0x7e, 0xc9, 0xd3, 0x05, 0x9f, 0x10, 0xbf, 0x83,
-	0x2d, 0x44, 0x03, 0x0b, 0x85, 0x21, 0x89, 0x79, 0xb5, 0x14, 0xa3, 0x67, 0x49, 0x52, 0x14, 0xa1,
-	0x6e, 0x1a, 0x53, 0x04, 0xc4, 0x3d, 0x8a, 0x65, 0xc8, 0x98, 0x01, 0xf0, 0xab, 0x84, 0xe7, 0x11,
-	0xcf, 0xb7, 0xf1, 0xd9, 0x39, 0x66, 0xb1, 0xf1, 0x25, 0x78, 0x23, 0x73, 0xca, 0x28, 0x09, 0x19,
-	0x86, 0x2d, 0x50, 0x11, 0x75, 0xdf, 0xd4, 0x96, 0xb4, 0xd5, 0xe7, 0xcd, 0x59, 0x33, 0x23, 0xdf,
-	0x14, 0xe9, 0xbb, 0xaf, 0xdd, 0xfc, 0xb5, 0x58, 0xb2, 0x65, 0xaa, 0x71, 0x02, 0x16, 0x78, 0xad,
-	0xaf, 0x63, 0x12, 0x21, 0x1f, 0x1f, 0x45, 0xe4, 0x22, 0xf0, 0x70, 0x94, 0xf6, 0x82, 0x9f, 0x01,
-	0x30, 0x98, 0x8d, 0x2c, 0xfc, 0xae, 0x29, 0xe7, 0x91, 0x0c, 0xd2, 0x14, 0x03, 0x97, 0x83, 0x34,
-	0x8f, 0x90, 0x8f, 0x25, 0xd6, 0x7e, 0x80, 0x34, 0x7e, 0xd1, 0x40, 0x75, 0x44, 0x23, 0x49, 0xff,
-	0x7d, 0x30, 0xc1, 0x68, 0xc2, 0x7d, 0x62, 0xf5, 0x79, 0xb3, 0xa6, 0x70, 0x57, 0x50, 0x76, 0x92,
-	0x0a, 0xf7, 0x33, 0xdc, 0xca, 0x9c, 0xdb, 0x7b, 0x63, 0xb9, 0x89, 0x76, 0x19, 0x72, 0x5b, 0x40,
-	0x17, 0xdc, 0x68, 0xbf, 0x4f, 0xd0, 0x4e, 0x65, 0xc0, 0x39, 0xf0, 0x8c, 0x51, 0x07, 0x79, 0x5e,
-	0xc4, 0xf5, 0x4f, 0xda, 0x15, 0x46, 0x3f, 0xf5, 0xbc, 0xc8, 0xe8, 0x80, 0xb7, 0x72, 0x61, 0x52,
-	0xd0, 0x01, 0x98, 0x66, 0xd4, 0x61, 0x22, 0xe4, 0xd0, 0x24, 0x26, 0x07, 0x58, 0x55, 0xd5, 0x65,
-	0x0a, 0xc8, 0x1b, 0x7a, 0xc9, 0x32, 0xa7, 0xc6, 0x1e, 0x78, 0x87, 0x77, 0xdb, 0xef, 0x10, 0x17,
-	0x75, 0x04, 0x44, 0x02, 0x7a, 0xdf, 0x04, 0xdd, 0x3e, 0xdd, 0x05, 0x30, 0x19, 0x07, 0x5d, 0xcc,
-	0x62, 0xd4, 0xa5, 0xbc, 0xdf, 0x84, 0x3d, 0x38, 0x30, 0xbe, 0xd7, 0xc0, 0xca, 0x98, 0x32, 0x92,
-	0xfe, 0x31, 0x98, 0xf5, 0x79, 0x8e, 0x23, 0x55, 0x64, 0x35, 0x2c, 0x2b, 0x1a, 0x72, 0xea, 0x09,
-	0x1d, 0xd0, 0x1f, 0x8a, 0x18, 0x1b, 0xe9, 0xe4, 0x94, 0x6b, 0x95, 0x12, 0x5e, 0x82, 0x72, 0xe0,
-	0xf1, 0x3e, 0x2f, 0xec, 0x72, 0xe0, 0x19, 0xa7, 0xf9, 0x4b, 0xda, 0xa7, 0xfa, 0x39, 0x98, 0x62,
-	0xd9, 0x90, 0x24, 0x39, 0x6e, 0x8d, 0x54, 0x98, 0xf1, 0x2d, 0x58, 0xcf, 0xeb, 0xb4, 0xdb, 0x3b,
-	0xa4, 0x38, 0x42, 0x31, 0x89, 0x92, 0x8b, 0xc7, 0xac, 0xff, 0x7a, 0xd4, 0xc1, 0x34, 0x91, 0x11,
-	0xbe, 0x21, 0x98, 0x31, 0xb9, 0x24, 0x53, 0x24, 0x8b, 0x30, 0x7a, 0x60, 0xa3, 0x60, 0xe9, 0x57,
-	0xae, 0xea, 0x38, 0xbf, 0xf5, 0x01, 0x0a, 0xc2, 0x18, 0x87, 0x28, 0x4c, 0x96, 0xb6, 0x4d, 0x22,
-	0xef, 0x29, 0xb2, 0x3a, 0xc0, 0x2c, 0x5a, 0x5b, 0xea, 0xda, 0x06, 0xcf, 0x22, 0x71, 0x24, 0x5f,
-	0xf6, 0x25, 0x45, 0xcf, 0x10, 0xd6, 0x4e, 0x01, 0xcd, 0x7f, 0x27, 0xc1, 0xeb, 0xbc, 0x1d, 0x0c,
-	0x41, 0x45, 0x18, 0x1a, 0x54, 0x37, 0x71, 0xd8, 0x31, 0x75, 0xe3, 0xb1, 0x14, 0x41, 0xcb, 0xa8,
-	0x5e, 0xff, 0xfe, 0xcf, 0x8f, 0xe5, 0x39, 0x38, 0x6b, 0xe5, 0x79, 0x35, 0xfc, 0x49, 0x03, 0xd3,
-	0xaa, 0x77, 0xc1, 0xb5, 0xbc, 0xba, 0x23, 0xac, 0x54, 0x5f, 0x2f, 0x96, 0x2c, 0xe9, 0xac, 0x70,
-	0x3a, 0x8b, 0xb0, 0x9a, 0xa1, 0xd3, 0x37, 0x93, 0x94, 0xc1, 0xaf, 0x9a, 0xfc, 0x33, 0xc8, 0x7a,
-	0x08, 0xac, 0xe7, 0x36, 0xcb, 0xf3, 0x37, 0xbd, 0x51, 0x24, 0x55, 0xb2, 0xda, 0xe4, 0xac, 0xd6,
-	0x60, 0x5d, 0x19, 0x92, 0x6a, 0x74, 0xd6, 0xa5, 0xb4, 0xcc, 0x2b, 0xf8, 0x5b, 0xea, 0xfc, 0xa3,
-	0x1c, 0x07, 0xb6, 0xf2, 0x08, 0x8c, 0xb1, 0x39, 0xfd, 0x83, 0xff, 0x07, 0x92, 0xfc, 0x3f, 0xe1,
-	0xfc, 0xb7, 0xe1, 0x87, 0x0a, 0xff, 0x5c, 0xa7, 0x73, 0xdc, 0x9e, 0x93, 0x38, 0xa7, 0x75, 0xd9,
-	0xf7, 0xcf, 0x2b, 0xf8, 0xb3, 0x06, 0xa6, 0x94, 0x4b, 0x83, 0x8d, 0x02, 0x37, 0x9b, 0xf2, 0x5e,
-	0x2b, 0x94, 0x2b, 0xe9, 0xd6, 0x39, 0xdd, 0xb7, 0xe1, 0xf2, 0x63, 0x4b, 0x60, 0x5d, 0x06, 0xde,
-	0x15, 0xfc, 0x53, 0x03, 0x4b, 0xe3, 0xac, 0x05, 0xee, 0x14, 0x68, 0x3e, 0xca, 0xeb, 0xf4, 0x8f,
-	0x9e, 0x06, 0x96, 0x52, 0x76, 0xb8, 0x94, 0x2d, 0xd8, 0x52, 0x37, 0x47, 0x51, 0x93, 0x0c, 0x5d,
-	0xf5, 0x1e, 0x78, 0x5d, 0x06, 0xcd, 0xb1, 0x06, 0x33, 0x2c, 0xb7, 0x08, 0xe3, 0x91, 0x26, 0xa8,
-	0x7f, 0xfc, 0x44, 0xb4, 0x14, 0x7c, 0xc8, 0x05, 0x7f, 0x01, 0xf7, 0xc7, 0x09, 0xee, 0x0e, 0x6a,
-	0x38, 0xd2, 0xe7, 0xf2, 0x86, 0xb0, 0xbb, 0x77, 0x73, 0x57, 0xd3, 0x6e, 0xef, 0x6a, 0xda, 0xdf,
-	0x77, 0x35, 0xed, 0x87, 0xfb, 0x5a, 0xe9, 0xf6, 0xbe, 0x56, 0xfa, 0xe3, 0xbe, 0x56, 0x3a, 0x6e,
-	0xf8, 0x41, 0x7c, 0x7a, 0xee, 0x9a, 0x6d, 0xd2, 0xb5, 0xdc, 0xd0, 0xdd, 0x68, 0x9f, 0xa2, 0x20,
-	0x7c, 0xd8, 0xf6, 0xbb, 0xfe, 0x87, 0xa5, 0x5b, 0xe1, 0x5f, 0x96, 0xad, 0xff, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x57, 0x64, 0xa6, 0xac, 0x37, 0x0b, 0x00, 0x00,
+func (m *QueryStorageProviderScoreRequest) Reset()         { *m = QueryStorageProviderScoreRequest{} }
+func (m *QueryStorageProviderScoreRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageProviderScoreRequest) ProtoMessage()    {}
+func (*QueryStorageProviderScoreRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{14}
+}
+func (m *QueryStorageProviderScoreRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageProviderScoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageProviderScoreRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageProviderScoreRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageProviderScoreRequest.Merge(m, src)
+}
+func (m *QueryStorageProviderScoreRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageProviderScoreRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageProviderScoreRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryStorageProviderScoreRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of GetStorageProviders items.
-	StorageProviders(ctx context.Context, in *QueryStorageProvidersRequest, opts ...grpc.CallOption) (*QueryStorageProvidersResponse, error)
-	// get the latest storage price of specific sp
-	QuerySpStoragePrice(ctx context.Context, in *QuerySpStoragePriceRequest, opts ...grpc.CallOption) (*QuerySpStoragePriceResponse, error)
-	// get global store price by time
-	QueryGlobalSpStorePriceByTime(ctx context.Context, in *QueryGlobalSpStorePriceByTimeRequest, opts ...grpc.CallOption) (*QueryGlobalSpStorePriceByTimeResponse, error)
-	// Queries a storage provider with specify id
-	StorageProvider(ctx context.Context, in *QueryStorageProviderRequest, opts ...grpc.CallOption) (*QueryStorageProviderResponse, error)
-	// Queries a StorageProvider by specify operator address.
-	StorageProviderByOperatorAddress(ctx context.Context, in *QueryStorageProviderByOperatorAddressRequest, opts ...grpc.CallOption) (*QueryStorageProviderByOperatorAddressResponse, error)
-	// Queries a StorageProvider by specify operator address.
-	StorageProviderMaintenanceRecordsByOperatorAddress(ctx context.Context, in *QueryStorageProviderMaintenanceRecordsRequest, opts ...grpc.CallOption) (*QueryStorageProviderMaintenanceRecordsResponse, error)
+func (m *QueryStorageProviderScoreRequest) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryStorageProviderScoreResponse struct {
+	Score StorageProviderScore `protobuf:"bytes,1,opt,name=score,proto3" json:"score"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryStorageProviderScoreResponse) Reset()         { *m = QueryStorageProviderScoreResponse{} }
+func (m *QueryStorageProviderScoreResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageProviderScoreResponse) ProtoMessage()    {}
+func (*QueryStorageProviderScoreResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{15}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryStorageProviderScoreResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageProviderScoreResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageProviderScoreResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryStorageProviderScoreResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageProviderScoreResponse.Merge(m, src)
+}
+func (m *QueryStorageProviderScoreResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageProviderScoreResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageProviderScoreResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) StorageProviders(ctx context.Context, in *QueryStorageProvidersRequest, opts ...grpc.CallOption) (*QueryStorageProvidersResponse, error) {
-	out := new(QueryStorageProvidersResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/StorageProviders", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStorageProviderScoreResponse proto.InternalMessageInfo
+
+func (m *QueryStorageProviderScoreResponse) GetScore() StorageProviderScore {
+	if m != nil {
+		return m.Score
 	}
-	return out, nil
+	return StorageProviderScore{}
 }
 
-func (c *queryClient) QuerySpStoragePrice(ctx context.Context, in *QuerySpStoragePriceRequest, opts ...grpc.CallOption) (*QuerySpStoragePriceResponse, error) {
-	out := new(QuerySpStoragePriceResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/QuerySpStoragePrice", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryStorageProviderCapacityRequest struct {
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (c *queryClient) QueryGlobalSpStorePriceByTime(ctx context.Context, in *QueryGlobalSpStorePriceByTimeRequest, opts ...grpc.CallOption) (*QueryGlobalSpStorePriceByTimeResponse, error) {
-	out := new(QueryGlobalSpStorePriceByTimeResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/QueryGlobalSpStorePriceByTime", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryStorageProviderCapacityRequest) Reset()         { *m = QueryStorageProviderCapacityRequest{} }
+func (m *QueryStorageProviderCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageProviderCapacityRequest) ProtoMessage()    {}
+func (*QueryStorageProviderCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{16}
+}
+func (m *QueryStorageProviderCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageProviderCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageProviderCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryStorageProviderCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageProviderCapacityRequest.Merge(m, src)
+}
+func (m *QueryStorageProviderCapacityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageProviderCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageProviderCapacityRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) StorageProvider(ctx context.Context, in *QueryStorageProviderRequest, opts ...grpc.CallOption) (*QueryStorageProviderResponse, error) {
-	out := new(QueryStorageProviderResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/StorageProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStorageProviderCapacityRequest proto.InternalMessageInfo
+
+func (m *QueryStorageProviderCapacityRequest) GetId() uint32 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) StorageProviderByOperatorAddress(ctx context.Context, in *QueryStorageProviderByOperatorAddressRequest, opts ...grpc.CallOption) (*QueryStorageProviderByOperatorAddressResponse, error) {
-	out := new(QueryStorageProviderByOperatorAddressResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/StorageProviderByOperatorAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryStorageProviderCapacityResponse struct {
+	Capacity SpCapacity `protobuf:"bytes,1,opt,name=capacity,proto3" json:"capacity"`
 }
 
-func (c *queryClient) StorageProviderMaintenanceRecordsByOperatorAddress(ctx context.Context, in *QueryStorageProviderMaintenanceRecordsRequest, opts ...grpc.CallOption) (*QueryStorageProviderMaintenanceRecordsResponse, error) {
-	out := new(QueryStorageProviderMaintenanceRecordsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/StorageProviderMaintenanceRecordsByOperatorAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryStorageProviderCapacityResponse) Reset()         { *m = QueryStorageProviderCapacityResponse{} }
+func (m *QueryStorageProviderCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageProviderCapacityResponse) ProtoMessage()    {}
+func (*QueryStorageProviderCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{17}
+}
+func (m *QueryStorageProviderCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageProviderCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageProviderCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryStorageProviderCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageProviderCapacityResponse.Merge(m, src)
+}
+func (m *QueryStorageProviderCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageProviderCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageProviderCapacityResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of GetStorageProviders items.
-	StorageProviders(context.Context, *QueryStorageProvidersRequest) (*QueryStorageProvidersResponse, error)
-	// get the latest storage price of specific sp
-	QuerySpStoragePrice(context.Context, *QuerySpStoragePriceRequest) (*QuerySpStoragePriceResponse, error)
-	// get global store price by time
-	QueryGlobalSpStorePriceByTime(context.Context, *QueryGlobalSpStorePriceByTimeRequest) (*QueryGlobalSpStorePriceByTimeResponse, error)
-	// Queries a storage provider with specify id
-	StorageProvider(context.Context, *QueryStorageProviderRequest) (*QueryStorageProviderResponse, error)
-	// Queries a StorageProvider by specify operator address.
-	StorageProviderByOperatorAddress(context.Context, *QueryStorageProviderByOperatorAddressRequest) (*QueryStorageProviderByOperatorAddressResponse, error)
-	// Queries a StorageProvider by specify operator address.
-	StorageProviderMaintenanceRecordsByOperatorAddress(context.Context, *QueryStorageProviderMaintenanceRecordsRequest) (*QueryStorageProviderMaintenanceRecordsResponse, error)
+var xxx_messageInfo_QueryStorageProviderCapacityResponse proto.InternalMessageInfo
+
+func (m *QueryStorageProviderCapacityResponse) GetCapacity() SpCapacity {
+	if m != nil {
+		return m.Capacity
+	}
+	return SpCapacity{}
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryScheduledMaintenanceRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryScheduledMaintenanceRequest) Reset()         { *m = QueryScheduledMaintenanceRequest{} }
+func (m *QueryScheduledMaintenanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledMaintenanceRequest) ProtoMessage()    {}
+func (*QueryScheduledMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{18}
 }
-func (*UnimplementedQueryServer) StorageProviders(ctx context.Context, req *QueryStorageProvidersRequest) (*QueryStorageProvidersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StorageProviders not implemented")
+func (m *QueryScheduledMaintenanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QuerySpStoragePrice(ctx context.Context, req *QuerySpStoragePriceRequest) (*QuerySpStoragePriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuerySpStoragePrice not implemented")
+func (m *QueryScheduledMaintenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledMaintenanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryGlobalSpStorePriceByTime(ctx context.Context, req *QueryGlobalSpStorePriceByTimeRequest) (*QueryGlobalSpStorePriceByTimeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryGlobalSpStorePriceByTime not implemented")
+func (m *QueryScheduledMaintenanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledMaintenanceRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) StorageProvider(ctx context.Context, req *QueryStorageProviderRequest) (*QueryStorageProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StorageProvider not implemented")
+func (m *QueryScheduledMaintenanceRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) StorageProviderByOperatorAddress(ctx context.Context, req *QueryStorageProviderByOperatorAddressRequest) (*QueryStorageProviderByOperatorAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StorageProviderByOperatorAddress not implemented")
+func (m *QueryScheduledMaintenanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledMaintenanceRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) StorageProviderMaintenanceRecordsByOperatorAddress(ctx context.Context, req *QueryStorageProviderMaintenanceRecordsRequest) (*QueryStorageProviderMaintenanceRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StorageProviderMaintenanceRecordsByOperatorAddress not implemented")
+
+var xxx_messageInfo_QueryScheduledMaintenanceRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledMaintenanceRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+type QueryScheduledMaintenanceResponse struct {
+	// upcoming windows which are not started yet
+	Upcoming []ScheduledMaintenance `protobuf:"bytes,1,rep,name=upcoming,proto3" json:"upcoming"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.sp.Query/Params",
+func (m *QueryScheduledMaintenanceResponse) Reset()         { *m = QueryScheduledMaintenanceResponse{} }
+func (m *QueryScheduledMaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledMaintenanceResponse) ProtoMessage()    {}
+func (*QueryScheduledMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{19}
+}
+func (m *QueryScheduledMaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledMaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledMaintenanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+}
+func (m *QueryScheduledMaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledMaintenanceResponse.Merge(m, src)
+}
+func (m *QueryScheduledMaintenanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledMaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledMaintenanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledMaintenanceResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledMaintenanceResponse) GetUpcoming() []ScheduledMaintenance {
+	if m != nil {
+		return m.Upcoming
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_StorageProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStorageProvidersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StorageProviders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.sp.Query/StorageProviders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StorageProviders(ctx, req.(*QueryStorageProvidersRequest))
+func (m *QueryScheduledMaintenanceResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QuerySpStoragePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySpStoragePriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuerySpStoragePrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.sp.Query/QuerySpStoragePrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuerySpStoragePrice(ctx, req.(*QuerySpStoragePriceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryPendingSpStoragePricesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_QueryGlobalSpStorePriceByTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGlobalSpStorePriceByTimeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryGlobalSpStorePriceByTime(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.sp.Query/QueryGlobalSpStorePriceByTime",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryGlobalSpStorePriceByTime(ctx, req.(*QueryGlobalSpStorePriceByTimeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryPendingSpStoragePricesRequest) Reset()         { *m = QueryPendingSpStoragePricesRequest{} }
+func (m *QueryPendingSpStoragePricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingSpStoragePricesRequest) ProtoMessage()    {}
+func (*QueryPendingSpStoragePricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{20}
 }
-
-func _Query_StorageProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStorageProviderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StorageProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.sp.Query/StorageProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StorageProvider(ctx, req.(*QueryStorageProviderRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryPendingSpStoragePricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_StorageProviderByOperatorAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStorageProviderByOperatorAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StorageProviderByOperatorAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.sp.Query/StorageProviderByOperatorAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StorageProviderByOperatorAddress(ctx, req.(*QueryStorageProviderByOperatorAddressRequest))
+func (m *QueryPendingSpStoragePricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingSpStoragePricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPendingSpStoragePricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingSpStoragePricesRequest.Merge(m, src)
+}
+func (m *QueryPendingSpStoragePricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingSpStoragePricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingSpStoragePricesRequest.DiscardUnknown(m)
 }
 
-func _Query_StorageProviderMaintenanceRecordsByOperatorAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStorageProviderMaintenanceRecordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StorageProviderMaintenanceRecordsByOperatorAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.sp.Query/StorageProviderMaintenanceRecordsByOperatorAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StorageProviderMaintenanceRecordsByOperatorAddress(ctx, req.(*QueryStorageProviderMaintenanceRecordsRequest))
+var xxx_messageInfo_QueryPendingSpStoragePricesRequest proto.InternalMessageInfo
+
+func (m *QueryPendingSpStoragePricesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "greenfield.sp.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "StorageProviders",
-			Handler:    _Query_StorageProviders_Handler,
-		},
-		{
-			MethodName: "QuerySpStoragePrice",
-			Handler:    _Query_QuerySpStoragePrice_Handler,
-		},
-		{
-			MethodName: "QueryGlobalSpStorePriceByTime",
-			Handler:    _Query_QueryGlobalSpStorePriceByTime_Handler,
-		},
-		{
-			MethodName: "StorageProvider",
-			Handler:    _Query_StorageProvider_Handler,
-		},
-		{
-			MethodName: "StorageProviderByOperatorAddress",
-			Handler:    _Query_StorageProviderByOperatorAddress_Handler,
-		},
-		{
-			MethodName: "StorageProviderMaintenanceRecordsByOperatorAddress",
-			Handler:    _Query_StorageProviderMaintenanceRecordsByOperatorAddress_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "greenfield/sp/query.proto",
+type QueryPendingSpStoragePricesResponse struct {
+	// pending prices which are not effective yet
+	PendingPrices []PendingSpStoragePrice `protobuf:"bytes,1,rep,name=pending_prices,json=pendingPrices,proto3" json:"pending_prices"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingSpStoragePricesResponse) Reset()         { *m = QueryPendingSpStoragePricesResponse{} }
+func (m *QueryPendingSpStoragePricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingSpStoragePricesResponse) ProtoMessage()    {}
+func (*QueryPendingSpStoragePricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{21}
+}
+func (m *QueryPendingSpStoragePricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingSpStoragePricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingSpStoragePricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingSpStoragePricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingSpStoragePricesResponse.Merge(m, src)
+}
+func (m *QueryPendingSpStoragePricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingSpStoragePricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingSpStoragePricesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryPendingSpStoragePricesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingSpStoragePricesResponse) GetPendingPrices() []PendingSpStoragePrice {
+	if m != nil {
+		return m.PendingPrices
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingSpStoragePricesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuerySpKeyRotationRequest struct {
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QuerySpKeyRotationRequest) Reset()         { *m = QuerySpKeyRotationRequest{} }
+func (m *QuerySpKeyRotationRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpKeyRotationRequest) ProtoMessage()    {}
+func (*QuerySpKeyRotationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{22}
+}
+func (m *QuerySpKeyRotationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpKeyRotationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpKeyRotationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QuerySpKeyRotationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpKeyRotationRequest.Merge(m, src)
+}
+func (m *QuerySpKeyRotationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpKeyRotationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpKeyRotationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStorageProvidersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySpKeyRotationRequest proto.InternalMessageInfo
+
+func (m *QuerySpKeyRotationRequest) GetId() uint32 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryStorageProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuerySpKeyRotationResponse struct {
+	KeyRotation SpKeyRotation `protobuf:"bytes,1,opt,name=key_rotation,json=keyRotation,proto3" json:"key_rotation"`
 }
 
-func (m *QueryStorageProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySpKeyRotationResponse) Reset()         { *m = QuerySpKeyRotationResponse{} }
+func (m *QuerySpKeyRotationResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpKeyRotationResponse) ProtoMessage()    {}
+func (*QuerySpKeyRotationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{23}
+}
+func (m *QuerySpKeyRotationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpKeyRotationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpKeyRotationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySpKeyRotationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpKeyRotationResponse.Merge(m, src)
+}
+func (m *QuerySpKeyRotationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpKeyRotationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpKeyRotationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStorageProvidersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySpKeyRotationResponse proto.InternalMessageInfo
+
+func (m *QuerySpKeyRotationResponse) GetKeyRotation() SpKeyRotation {
+	if m != nil {
+		return m.KeyRotation
 	}
-	return dAtA[:n], nil
+	return SpKeyRotation{}
 }
 
-func (m *QueryStorageProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "greenfield.sp.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "greenfield.sp.QueryParamsResponse")
+	proto.RegisterType((*QueryStorageProvidersRequest)(nil), "greenfield.sp.QueryStorageProvidersRequest")
+	proto.RegisterType((*QueryStorageProvidersResponse)(nil), "greenfield.sp.QueryStorageProvidersResponse")
+	proto.RegisterType((*QuerySpStoragePriceRequest)(nil), "greenfield.sp.QuerySpStoragePriceRequest")
+	proto.RegisterType((*QuerySpStoragePriceResponse)(nil), "greenfield.sp.QuerySpStoragePriceResponse")
+	proto.RegisterType((*QueryGlobalSpStorePriceByTimeRequest)(nil), "greenfield.sp.QueryGlobalSpStorePriceByTimeRequest")
+	proto.RegisterType((*QueryGlobalSpStorePriceByTimeResponse)(nil), "greenfield.sp.QueryGlobalSpStorePriceByTimeResponse")
+	proto.RegisterType((*QueryStorageProviderRequest)(nil), "greenfield.sp.QueryStorageProviderRequest")
+	proto.RegisterType((*QueryStorageProviderResponse)(nil), "greenfield.sp.QueryStorageProviderResponse")
+	proto.RegisterType((*QueryStorageProviderByOperatorAddressRequest)(nil), "greenfield.sp.QueryStorageProviderByOperatorAddressRequest")
+	proto.RegisterType((*QueryStorageProviderByOperatorAddressResponse)(nil), "greenfield.sp.QueryStorageProviderByOperatorAddressResponse")
+	proto.RegisterType((*QueryStorageProviderMaintenanceRecordsRequest)(nil), "greenfield.sp.QueryStorageProviderMaintenanceRecordsRequest")
+	proto.RegisterType((*QueryStorageProviderMaintenanceRecordsResponse)(nil), "greenfield.sp.QueryStorageProviderMaintenanceRecordsResponse")
+	proto.RegisterType((*QueryStorageProviderScoreRequest)(nil), "greenfield.sp.QueryStorageProviderScoreRequest")
+	proto.RegisterType((*QueryStorageProviderScoreResponse)(nil), "greenfield.sp.QueryStorageProviderScoreResponse")
+	proto.RegisterType((*QueryStorageProviderCapacityRequest)(nil), "greenfield.sp.QueryStorageProviderCapacityRequest")
+	proto.RegisterType((*QueryStorageProviderCapacityResponse)(nil), "greenfield.sp.QueryStorageProviderCapacityResponse")
+	proto.RegisterType((*QueryScheduledMaintenanceRequest)(nil), "greenfield.sp.QueryScheduledMaintenanceRequest")
+	proto.RegisterType((*QueryScheduledMaintenanceResponse)(nil), "greenfield.sp.QueryScheduledMaintenanceResponse")
+	proto.RegisterType((*QueryPendingSpStoragePricesRequest)(nil), "greenfield.sp.QueryPendingSpStoragePricesRequest")
+	proto.RegisterType((*QueryPendingSpStoragePricesResponse)(nil), "greenfield.sp.QueryPendingSpStoragePricesResponse")
+	proto.RegisterType((*QuerySpKeyRotationRequest)(nil), "greenfield.sp.QuerySpKeyRotationRequest")
+	proto.RegisterType((*QuerySpKeyRotationResponse)(nil), "greenfield.sp.QuerySpKeyRotationResponse")
 }
 
-func (m *QueryStorageProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sps) > 0 {
-		for iNdEx := len(m.Sps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("greenfield/sp/query.proto", fileDescriptor_48dd9c8aad3b7a6d) }
+
+var fileDescriptor_48dd9c8aad3b7a6d = []byte{
+	// 1245 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x53, 0xe4, 0x44,
+	0x14, 0x26, 0xac, 0xcb, 0xee, 0x3e, 0xe4, 0x47, 0xb5, 0x20, 0x12, 0x61, 0x16, 0x02, 0xac, 0x30,
+	0xc0, 0x04, 0x06, 0xa9, 0xb2, 0x16, 0x2d, 0x15, 0x57, 0xf1, 0x47, 0x6d, 0x2d, 0x3b, 0xeb, 0x45,
+	0x2e, 0x53, 0x99, 0xa4, 0x37, 0xc4, 0x9d, 0x49, 0xb2, 0x49, 0xd8, 0x72, 0x8a, 0xe2, 0xb2, 0xfe,
+	0x03, 0x5a, 0xea, 0xc5, 0x8b, 0x47, 0x4f, 0x1e, 0x2c, 0x4f, 0x1e, 0xbd, 0xed, 0xc1, 0xc3, 0x56,
+	0x79, 0xd0, 0xf2, 0x60, 0x59, 0xe0, 0x1f, 0x62, 0xa5, 0xfb, 0x65, 0x98, 0xf4, 0x74, 0x66, 0x02,
+	0x35, 0x37, 0xe8, 0x7e, 0xdf, 0x7b, 0xdf, 0xf7, 0xf2, 0x92, 0xf7, 0xd5, 0xc0, 0xb4, 0x1d, 0x50,
+	0xea, 0x3e, 0x74, 0x68, 0xdd, 0xd2, 0x43, 0x5f, 0x7f, 0x7c, 0x44, 0x83, 0x66, 0xc9, 0x0f, 0xbc,
+	0xc8, 0x23, 0x23, 0xe7, 0x57, 0xa5, 0xd0, 0x57, 0x8b, 0xa6, 0x17, 0x36, 0xbc, 0x50, 0xaf, 0x19,
+	0x21, 0xe5, 0x71, 0xfa, 0x93, 0xcd, 0x1a, 0x8d, 0x8c, 0x4d, 0xdd, 0x37, 0x6c, 0xc7, 0x35, 0x22,
+	0xc7, 0x73, 0x39, 0x54, 0x9d, 0xe6, 0xb1, 0x55, 0xf6, 0x9f, 0xce, 0xff, 0xc1, 0xab, 0x09, 0xdb,
+	0xb3, 0x3d, 0x7e, 0x1e, 0xff, 0x85, 0xa7, 0x33, 0xb6, 0xe7, 0xd9, 0x75, 0xaa, 0x1b, 0xbe, 0xa3,
+	0x1b, 0xae, 0xeb, 0x45, 0x2c, 0x5b, 0x82, 0x51, 0xd3, 0x24, 0x7d, 0x23, 0x30, 0x1a, 0xc9, 0x9d,
+	0x20, 0x20, 0x6a, 0xfa, 0x14, 0xaf, 0xb4, 0x09, 0x20, 0xf7, 0x63, 0x9e, 0xfb, 0x2c, 0xbe, 0x42,
+	0x1f, 0x1f, 0xd1, 0x30, 0xd2, 0x3e, 0x86, 0x97, 0x52, 0xa7, 0xa1, 0xef, 0xb9, 0x21, 0x25, 0x5b,
+	0x30, 0xc4, 0xf3, 0xbe, 0xa2, 0xcc, 0x29, 0xcb, 0xc3, 0xe5, 0xc9, 0x52, 0x4a, 0x7e, 0x89, 0x87,
+	0xef, 0xbe, 0xf0, 0xec, 0x9f, 0x9b, 0x03, 0x15, 0x0c, 0xd5, 0x1e, 0xc2, 0x0c, 0xcb, 0xf5, 0x20,
+	0xf2, 0x02, 0xc3, 0xa6, 0xfb, 0x81, 0xf7, 0xc4, 0xb1, 0x68, 0x90, 0xd4, 0x22, 0x1f, 0x00, 0x9c,
+	0xf7, 0x06, 0x13, 0xdf, 0x2a, 0x61, 0x3f, 0xe2, 0x46, 0x96, 0x78, 0xc3, 0xb1, 0x91, 0xa5, 0x7d,
+	0xc3, 0xa6, 0x88, 0xad, 0xb4, 0x21, 0xb5, 0xef, 0x15, 0x98, 0xcd, 0x28, 0x84, 0xf4, 0x37, 0xe0,
+	0x4a, 0xe8, 0xc7, 0xdc, 0xaf, 0x2c, 0x0f, 0x97, 0x0b, 0x02, 0x77, 0x01, 0x55, 0x89, 0x43, 0xc9,
+	0x5e, 0x8a, 0xdb, 0x20, 0xe3, 0xf6, 0x5a, 0x4f, 0x6e, 0xbc, 0x5c, 0x8a, 0xdc, 0x36, 0xa8, 0x9c,
+	0x9b, 0xdf, 0xaa, 0xe3, 0x98, 0x89, 0x0c, 0x32, 0x05, 0xd7, 0x42, 0xbf, 0x6a, 0x58, 0x56, 0xc0,
+	0xf4, 0xdf, 0xa8, 0x0c, 0x85, 0xfe, 0xbb, 0x96, 0x15, 0x68, 0x75, 0x78, 0x55, 0x0a, 0x43, 0x41,
+	0x77, 0x61, 0x3c, 0xf4, 0xab, 0x21, 0xbf, 0xaa, 0xfa, 0xf1, 0x1d, 0x36, 0x70, 0x56, 0x54, 0x97,
+	0x4a, 0x80, 0x4f, 0x68, 0x34, 0x4c, 0x9d, 0x6a, 0x77, 0x60, 0x91, 0x55, 0xdb, 0xab, 0x7b, 0x35,
+	0xa3, 0xce, 0x21, 0x08, 0x68, 0x7e, 0xea, 0x34, 0x5a, 0x74, 0x67, 0xe0, 0x46, 0xe4, 0x34, 0x68,
+	0x18, 0x19, 0x0d, 0x9f, 0xd5, 0xbb, 0x52, 0x39, 0x3f, 0xd0, 0xbe, 0x54, 0x60, 0xa9, 0x47, 0x1a,
+	0xa4, 0x7f, 0x00, 0x93, 0x36, 0x8b, 0xa9, 0xa2, 0x8a, 0xb4, 0x86, 0x79, 0x41, 0x83, 0x24, 0x1f,
+	0xd7, 0x41, 0xec, 0x8e, 0x1b, 0x6d, 0x3d, 0xe9, 0x9c, 0xf0, 0x58, 0x51, 0xc2, 0x28, 0x0c, 0x3a,
+	0x16, 0xab, 0x33, 0x52, 0x19, 0x74, 0x2c, 0xed, 0x50, 0x3e, 0xa4, 0x2d, 0xaa, 0x1f, 0xc2, 0x58,
+	0x98, 0xbe, 0x42, 0x92, 0xbd, 0xc6, 0x48, 0x84, 0x69, 0x9f, 0xc1, 0x9a, 0xac, 0xd2, 0x6e, 0xf3,
+	0x9e, 0x4f, 0x03, 0x23, 0xf2, 0x82, 0xf8, 0xc1, 0xd3, 0xb0, 0xf5, 0x7a, 0xac, 0xc0, 0xb8, 0x87,
+	0x37, 0x6c, 0x42, 0x68, 0x18, 0xe2, 0x90, 0x8c, 0x79, 0x69, 0x84, 0xd6, 0x84, 0xf5, 0x9c, 0xa9,
+	0xfb, 0xae, 0xea, 0x40, 0x5e, 0xfa, 0xae, 0xe1, 0xb8, 0x11, 0x75, 0x0d, 0x37, 0x1e, 0x5a, 0xd3,
+	0x0b, 0xac, 0xcb, 0xc8, 0xaa, 0x43, 0x29, 0x6f, 0x6e, 0xd4, 0x75, 0x1b, 0xae, 0x05, 0xfc, 0x08,
+	0x5f, 0xf6, 0x39, 0x41, 0x4f, 0x07, 0xb6, 0x92, 0x00, 0xb4, 0x32, 0xcc, 0xc9, 0xaa, 0x3d, 0x30,
+	0xbd, 0x80, 0x66, 0x4d, 0x8f, 0x05, 0xf3, 0x5d, 0x30, 0x48, 0xea, 0x6d, 0xb8, 0x1a, 0xc6, 0x07,
+	0xd8, 0xe2, 0x85, 0xee, 0x2d, 0x66, 0x58, 0x9c, 0x6f, 0x8e, 0xd3, 0xb6, 0x61, 0x41, 0x56, 0xe5,
+	0x3d, 0xc3, 0x37, 0x4c, 0x27, 0x6a, 0x66, 0x91, 0x33, 0xf1, 0xad, 0xce, 0x84, 0x21, 0xbf, 0x1d,
+	0xb8, 0x6e, 0xe2, 0x19, 0x52, 0x9c, 0xee, 0xf8, 0x88, 0x24, 0x20, 0x24, 0xd6, 0x02, 0x68, 0x9f,
+	0x27, 0x5d, 0x33, 0x0f, 0xa9, 0x75, 0x54, 0xa7, 0x56, 0xaa, 0xc3, 0xfd, 0xfd, 0xd0, 0xff, 0xa2,
+	0x24, 0xed, 0x96, 0x16, 0x43, 0x39, 0xef, 0xc3, 0xf5, 0x23, 0xdf, 0xf4, 0x1a, 0x8e, 0x6b, 0xe3,
+	0x10, 0x74, 0x74, 0x5c, 0x02, 0x4f, 0x84, 0x25, 0xd0, 0xfe, 0x6d, 0x80, 0x3a, 0x68, 0x7c, 0xa5,
+	0x52, 0xd7, 0x72, 0x5c, 0x3b, 0xfd, 0x41, 0xee, 0xfb, 0x32, 0xfc, 0x4d, 0xc1, 0x61, 0xc9, 0x2a,
+	0x87, 0x5d, 0xba, 0x0f, 0xa3, 0x3e, 0x8f, 0xe0, 0x9f, 0xde, 0xe4, 0x85, 0x59, 0x14, 0x37, 0xbb,
+	0x2c, 0x0d, 0x36, 0x6b, 0x04, 0x33, 0xf0, 0xd4, 0xfd, 0xeb, 0xd8, 0x2a, 0x4c, 0xe3, 0xf2, 0xfb,
+	0x84, 0x36, 0x2b, 0x68, 0x77, 0xb2, 0xa7, 0x5c, 0x95, 0x05, 0xb7, 0x86, 0xe1, 0xc5, 0x47, 0xb4,
+	0x59, 0x0d, 0xf0, 0x1c, 0x1b, 0x3b, 0xd3, 0x31, 0xdf, 0x6d, 0x58, 0x14, 0x37, 0xfc, 0xe8, 0xfc,
+	0xa8, 0xfc, 0xe7, 0x38, 0x5c, 0x65, 0x55, 0x88, 0x0b, 0x43, 0xdc, 0xec, 0x10, 0x71, 0x4b, 0x75,
+	0xba, 0x29, 0x55, 0xeb, 0x16, 0xc2, 0x19, 0x6a, 0xb3, 0x4f, 0xff, 0xf8, 0xef, 0x9b, 0xc1, 0x29,
+	0x32, 0xa9, 0xcb, 0x7c, 0x1c, 0xf9, 0x56, 0x81, 0x71, 0xd1, 0xd7, 0x90, 0x55, 0x59, 0xde, 0x0c,
+	0x9b, 0xa5, 0xae, 0xe5, 0x0b, 0x46, 0x3a, 0x4b, 0x8c, 0xce, 0x4d, 0x32, 0x9b, 0xa2, 0xd3, 0x32,
+	0x1a, 0x09, 0x83, 0x1f, 0x14, 0x34, 0x8a, 0xe9, 0xc1, 0x20, 0x2b, 0xd2, 0x62, 0x32, 0xef, 0xa3,
+	0x16, 0xf3, 0x84, 0x22, 0xab, 0x4d, 0xc6, 0x6a, 0x95, 0xac, 0x08, 0x4d, 0x12, 0x4d, 0x90, 0x7e,
+	0x8c, 0x76, 0xea, 0x84, 0xfc, 0x9e, 0xb8, 0xc2, 0x2c, 0x37, 0x42, 0xb6, 0x64, 0x04, 0x7a, 0x58,
+	0x20, 0xf5, 0xf5, 0x8b, 0x81, 0x90, 0xff, 0x3b, 0x8c, 0xff, 0x6d, 0xf2, 0x86, 0xc0, 0x5f, 0xea,
+	0x82, 0xaa, 0xb5, 0x66, 0x35, 0x76, 0x55, 0xfa, 0x71, 0xcb, 0x5b, 0x9d, 0x90, 0xef, 0x14, 0x18,
+	0x13, 0x1e, 0x1a, 0x29, 0xe6, 0x78, 0xb2, 0x09, 0xef, 0xd5, 0x5c, 0xb1, 0x48, 0x77, 0x85, 0xd1,
+	0x5d, 0x20, 0xf3, 0xdd, 0x86, 0x40, 0x3f, 0x76, 0xac, 0x13, 0xf2, 0xb7, 0x02, 0x73, 0xbd, 0x6c,
+	0x07, 0xd9, 0xc9, 0x51, 0x3c, 0xcb, 0x07, 0xa9, 0x6f, 0x5e, 0x0e, 0x8c, 0x52, 0x76, 0x98, 0x94,
+	0x6d, 0xb2, 0x25, 0x4e, 0x8e, 0xa0, 0x26, 0x6e, 0xba, 0xe8, 0x4b, 0xc8, 0xd3, 0x41, 0x28, 0xf7,
+	0x34, 0x1f, 0x9d, 0x72, 0xf3, 0x30, 0xce, 0x34, 0x48, 0xea, 0x5b, 0x97, 0x44, 0xa3, 0xe0, 0x7b,
+	0x4c, 0xf0, 0x47, 0x64, 0xaf, 0x97, 0xe0, 0xc6, 0x79, 0x8e, 0x2a, 0x7a, 0x20, 0x69, 0x13, 0x7e,
+	0x52, 0x60, 0x42, 0xe6, 0x51, 0x88, 0x9e, 0x83, 0x68, 0xbb, 0x7b, 0x52, 0x37, 0xf2, 0x03, 0x50,
+	0x4c, 0x99, 0x89, 0x59, 0x23, 0xc5, 0x5e, 0x62, 0x98, 0x51, 0xe2, 0x13, 0xf9, 0xab, 0x02, 0x53,
+	0x19, 0x96, 0x87, 0x94, 0x73, 0x30, 0x10, 0x6c, 0x95, 0xba, 0x75, 0x21, 0x0c, 0x12, 0xdf, 0x66,
+	0xc4, 0x75, 0xb2, 0xde, 0x8b, 0x78, 0x62, 0xa4, 0x38, 0xf7, 0x1f, 0xe3, 0x5e, 0x4b, 0xdc, 0x49,
+	0x46, 0xaf, 0xb3, 0x3d, 0x57, 0x46, 0xaf, 0xbb, 0xf8, 0x26, 0x6d, 0x8d, 0x51, 0xbe, 0x45, 0x16,
+	0x45, 0xca, 0x09, 0xa8, 0x7d, 0x62, 0xc8, 0xcf, 0x0a, 0xbc, 0x2c, 0xb7, 0x18, 0x64, 0x53, 0xba,
+	0xf5, 0xba, 0xb9, 0x1f, 0xb5, 0x7c, 0x11, 0x08, 0xf2, 0xdd, 0x60, 0x7c, 0x8b, 0x64, 0x59, 0x5c,
+	0x9c, 0x68, 0x6b, 0xc4, 0xdd, 0x10, 0x92, 0xaf, 0x15, 0x18, 0x49, 0xad, 0x7a, 0xb2, 0x2c, 0xdf,
+	0x41, 0x9d, 0xb6, 0x43, 0x5d, 0xc9, 0x11, 0x89, 0xc4, 0x56, 0x19, 0xb1, 0x25, 0xb2, 0xd0, 0xb9,
+	0xac, 0xda, 0xbd, 0x08, 0x7b, 0xe2, 0xbb, 0x77, 0x9e, 0x9d, 0x16, 0x94, 0xe7, 0xa7, 0x05, 0xe5,
+	0xdf, 0xd3, 0x82, 0xf2, 0xd5, 0x59, 0x61, 0xe0, 0xf9, 0x59, 0x61, 0xe0, 0xaf, 0xb3, 0xc2, 0xc0,
+	0x41, 0xd1, 0x76, 0xa2, 0xc3, 0xa3, 0x5a, 0xc9, 0xf4, 0x1a, 0x7a, 0xcd, 0xad, 0xad, 0x9b, 0x87,
+	0x86, 0xe3, 0xb6, 0xa7, 0xfc, 0xa2, 0xf5, 0x93, 0x4e, 0x6d, 0x88, 0xfd, 0xa6, 0xb3, 0xf5, 0x7f,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xdc, 0x29, 0xa6, 0xb1, 0x12, 0x00, 0x00,
 }
 
-func (m *QuerySpStoragePriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of GetStorageProviders items.
+	StorageProviders(ctx context.Context, in *QueryStorageProvidersRequest, opts ...grpc.CallOption) (*QueryStorageProvidersResponse, error)
+	// get the latest storage price of specific sp
+	QuerySpStoragePrice(ctx context.Context, in *QuerySpStoragePriceRequest, opts ...grpc.CallOption) (*QuerySpStoragePriceResponse, error)
+	// get global store price by time
+	QueryGlobalSpStorePriceByTime(ctx context.Context, in *QueryGlobalSpStorePriceByTimeRequest, opts ...grpc.CallOption) (*QueryGlobalSpStorePriceByTimeResponse, error)
+	// Queries a storage provider with specify id
+	StorageProvider(ctx context.Context, in *QueryStorageProviderRequest, opts ...grpc.CallOption) (*QueryStorageProviderResponse, error)
+	// Queries a StorageProvider by specify operator address.
+	StorageProviderByOperatorAddress(ctx context.Context, in *QueryStorageProviderByOperatorAddressRequest, opts ...grpc.CallOption) (*QueryStorageProviderByOperatorAddressResponse, error)
+	// Queries a StorageProvider by specify operator address.
+	StorageProviderMaintenanceRecordsByOperatorAddress(ctx context.Context, in *QueryStorageProviderMaintenanceRecordsRequest, opts ...grpc.CallOption) (*QueryStorageProviderMaintenanceRecordsResponse, error)
+	// Queries the scorecard of a StorageProvider by specify id.
+	StorageProviderScore(ctx context.Context, in *QueryStorageProviderScoreRequest, opts ...grpc.CallOption) (*QueryStorageProviderScoreResponse, error)
+	// Queries the declared capacity of a StorageProvider by specify id.
+	StorageProviderCapacity(ctx context.Context, in *QueryStorageProviderCapacityRequest, opts ...grpc.CallOption) (*QueryStorageProviderCapacityResponse, error)
+	// Queries the upcoming scheduled maintenance windows of all the StorageProviders, in the order of the start time.
+	ScheduledMaintenance(ctx context.Context, in *QueryScheduledMaintenanceRequest, opts ...grpc.CallOption) (*QueryScheduledMaintenanceResponse, error)
+	// Queries the pending storage prices announced by the StorageProviders, in the order of the effective time.
+	PendingSpStoragePrices(ctx context.Context, in *QueryPendingSpStoragePricesRequest, opts ...grpc.CallOption) (*QueryPendingSpStoragePricesResponse, error)
+	// Queries the keys replaced by a storage provider which are still accepted
+	SpKeyRotation(ctx context.Context, in *QuerySpKeyRotationRequest, opts ...grpc.CallOption) (*QuerySpKeyRotationResponse, error)
 }
 
-func (m *QuerySpStoragePriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QuerySpStoragePriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpAddr) > 0 {
-		i -= len(m.SpAddr)
-		copy(dAtA[i:], m.SpAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QuerySpStoragePriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QuerySpStoragePriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) StorageProviders(ctx context.Context, in *QueryStorageProvidersRequest, opts ...grpc.CallOption) (*QueryStorageProvidersResponse, error) {
+	out := new(QueryStorageProvidersResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/StorageProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QuerySpStoragePriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.SpStoragePrice.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) QuerySpStoragePrice(ctx context.Context, in *QuerySpStoragePriceRequest, opts ...grpc.CallOption) (*QuerySpStoragePriceResponse, error) {
+	out := new(QuerySpStoragePriceResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/QuerySpStoragePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGlobalSpStorePriceByTimeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryGlobalSpStorePriceByTime(ctx context.Context, in *QueryGlobalSpStorePriceByTimeRequest, opts ...grpc.CallOption) (*QueryGlobalSpStorePriceByTimeResponse, error) {
+	out := new(QueryGlobalSpStorePriceByTimeResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/QueryGlobalSpStorePriceByTime", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGlobalSpStorePriceByTimeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) StorageProvider(ctx context.Context, in *QueryStorageProviderRequest, opts ...grpc.CallOption) (*QueryStorageProviderResponse, error) {
+	out := new(QueryStorageProviderResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/StorageProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGlobalSpStorePriceByTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) StorageProviderByOperatorAddress(ctx context.Context, in *QueryStorageProviderByOperatorAddressRequest, opts ...grpc.CallOption) (*QueryStorageProviderByOperatorAddressResponse, error) {
+	out := new(QueryStorageProviderByOperatorAddressResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/StorageProviderByOperatorAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGlobalSpStorePriceByTimeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) StorageProviderMaintenanceRecordsByOperatorAddress(ctx context.Context, in *QueryStorageProviderMaintenanceRecordsRequest, opts ...grpc.CallOption) (*QueryStorageProviderMaintenanceRecordsResponse, error) {
+	out := new(QueryStorageProviderMaintenanceRecordsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/StorageProviderMaintenanceRecordsByOperatorAddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGlobalSpStorePriceByTimeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) StorageProviderScore(ctx context.Context, in *QueryStorageProviderScoreRequest, opts ...grpc.CallOption) (*QueryStorageProviderScoreResponse, error) {
+	out := new(QueryStorageProviderScoreResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/StorageProviderScore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGlobalSpStorePriceByTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.GlobalSpStorePrice.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) StorageProviderCapacity(ctx context.Context, in *QueryStorageProviderCapacityRequest, opts ...grpc.CallOption) (*QueryStorageProviderCapacityResponse, error) {
+	out := new(QueryStorageProviderCapacityResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/StorageProviderCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryStorageProviderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ScheduledMaintenance(ctx context.Context, in *QueryScheduledMaintenanceRequest, opts ...grpc.CallOption) (*QueryScheduledMaintenanceResponse, error) {
+	out := new(QueryScheduledMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/ScheduledMaintenance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryStorageProviderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PendingSpStoragePrices(ctx context.Context, in *QueryPendingSpStoragePricesRequest, opts ...grpc.CallOption) (*QueryPendingSpStoragePricesResponse, error) {
+	out := new(QueryPendingSpStoragePricesResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/PendingSpStoragePrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryStorageProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryStorageProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SpKeyRotation(ctx context.Context, in *QuerySpKeyRotationRequest, opts ...grpc.CallOption) (*QuerySpKeyRotationResponse, error) {
+	out := new(QuerySpKeyRotationResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/SpKeyRotation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryStorageProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of GetStorageProviders items.
+	StorageProviders(context.Context, *QueryStorageProvidersRequest) (*QueryStorageProvidersResponse, error)
+	// get the latest storage price of specific sp
+	QuerySpStoragePrice(context.Context, *QuerySpStoragePriceRequest) (*QuerySpStoragePriceResponse, error)
+	// get global store price by time
+	QueryGlobalSpStorePriceByTime(context.Context, *QueryGlobalSpStorePriceByTimeRequest) (*QueryGlobalSpStorePriceByTimeResponse, error)
+	// Queries a storage provider with specify id
+	StorageProvider(context.Context, *QueryStorageProviderRequest) (*QueryStorageProviderResponse, error)
+	// Queries a StorageProvider by specify operator address.
+	StorageProviderByOperatorAddress(context.Context, *QueryStorageProviderByOperatorAddressRequest) (*QueryStorageProviderByOperatorAddressResponse, error)
+	// Queries a StorageProvider by specify operator address.
+	StorageProviderMaintenanceRecordsByOperatorAddress(context.Context, *QueryStorageProviderMaintenanceRecordsRequest) (*QueryStorageProviderMaintenanceRecordsResponse, error)
+	// Queries the scorecard of a StorageProvider by specify id.
+	StorageProviderScore(context.Context, *QueryStorageProviderScoreRequest) (*QueryStorageProviderScoreResponse, error)
+	// Queries the declared capacity of a StorageProvider by specify id.
+	StorageProviderCapacity(context.Context, *QueryStorageProviderCapacityRequest) (*QueryStorageProviderCapacityResponse, error)
+	// Queries the upcoming scheduled maintenance windows of all the StorageProviders, in the order of the start time.
+	ScheduledMaintenance(context.Context, *QueryScheduledMaintenanceRequest) (*QueryScheduledMaintenanceResponse, error)
+	// Queries the pending storage prices announced by the StorageProviders, in the order of the effective time.
+	PendingSpStoragePrices(context.Context, *QueryPendingSpStoragePricesRequest) (*QueryPendingSpStoragePricesResponse, error)
+	// Queries the keys replaced by a storage provider which are still accepted
+	SpKeyRotation(context.Context, *QuerySpKeyRotationRequest) (*QuerySpKeyRotationResponse, error)
 }
 
-func (m *QueryStorageProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StorageProvider != nil {
-		{
-			size, err := m.StorageProvider.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryStorageProviderByOperatorAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryStorageProviderByOperatorAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) StorageProviders(ctx context.Context, req *QueryStorageProvidersRequest) (*QueryStorageProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageProviders not implemented")
 }
-
-func (m *QueryStorageProviderByOperatorAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) QuerySpStoragePrice(ctx context.Context, req *QuerySpStoragePriceRequest) (*QuerySpStoragePriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySpStoragePrice not implemented")
 }
-
-func (m *QueryStorageProviderByOperatorAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) QueryGlobalSpStorePriceByTime(ctx context.Context, req *QueryGlobalSpStorePriceByTimeRequest) (*QueryGlobalSpStorePriceByTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryGlobalSpStorePriceByTime not implemented")
+}
+func (*UnimplementedQueryServer) StorageProvider(ctx context.Context, req *QueryStorageProviderRequest) (*QueryStorageProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageProvider not implemented")
+}
+func (*UnimplementedQueryServer) StorageProviderByOperatorAddress(ctx context.Context, req *QueryStorageProviderByOperatorAddressRequest) (*QueryStorageProviderByOperatorAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageProviderByOperatorAddress not implemented")
+}
+func (*UnimplementedQueryServer) StorageProviderMaintenanceRecordsByOperatorAddress(ctx context.Context, req *QueryStorageProviderMaintenanceRecordsRequest) (*QueryStorageProviderMaintenanceRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageProviderMaintenanceRecordsByOperatorAddress not implemented")
+}
+func (*UnimplementedQueryServer) StorageProviderScore(ctx context.Context, req *QueryStorageProviderScoreRequest) (*QueryStorageProviderScoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageProviderScore not implemented")
+}
+func (*UnimplementedQueryServer) StorageProviderCapacity(ctx context.Context, req *QueryStorageProviderCapacityRequest) (*QueryStorageProviderCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageProviderCapacity not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledMaintenance(ctx context.Context, req *QueryScheduledMaintenanceRequest) (*QueryScheduledMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledMaintenance not implemented")
+}
+func (*UnimplementedQueryServer) PendingSpStoragePrices(ctx context.Context, req *QueryPendingSpStoragePricesRequest) (*QueryPendingSpStoragePricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingSpStoragePrices not implemented")
+}
+func (*UnimplementedQueryServer) SpKeyRotation(ctx context.Context, req *QuerySpKeyRotationRequest) (*QuerySpKeyRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpKeyRotation not implemented")
 }
 
-func (m *QueryStorageProviderByOperatorAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryStorageProviderByOperatorAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StorageProvider != nil {
-		{
-			size, err := m.StorageProvider.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStorageProviderMaintenanceRecordsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_StorageProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageProvidersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).StorageProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Query/StorageProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageProviders(ctx, req.(*QueryStorageProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStorageProviderMaintenanceRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QuerySpStoragePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpStoragePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySpStoragePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Query/QuerySpStoragePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySpStoragePrice(ctx, req.(*QuerySpStoragePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStorageProviderMaintenanceRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func _Query_QueryGlobalSpStorePriceByTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGlobalSpStorePriceByTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryGlobalSpStorePriceByTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Query/QueryGlobalSpStorePriceByTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryGlobalSpStorePriceByTime(ctx, req.(*QueryGlobalSpStorePriceByTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStorageProviderMaintenanceRecordsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_StorageProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageProviderRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).StorageProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Query/StorageProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageProvider(ctx, req.(*QueryStorageProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStorageProviderMaintenanceRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_StorageProviderByOperatorAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageProviderByOperatorAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageProviderByOperatorAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Query/StorageProviderByOperatorAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageProviderByOperatorAddress(ctx, req.(*QueryStorageProviderByOperatorAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStorageProviderMaintenanceRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_StorageProviderMaintenanceRecordsByOperatorAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageProviderMaintenanceRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).StorageProviderMaintenanceRecordsByOperatorAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Query/StorageProviderMaintenanceRecordsByOperatorAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageProviderMaintenanceRecordsByOperatorAddress(ctx, req.(*QueryStorageProviderMaintenanceRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Query_StorageProviderScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageProviderScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).StorageProviderScore(ctx, in)
 	}
-	var l int
-	_ = l
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Query/StorageProviderScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageProviderScore(ctx, req.(*QueryStorageProviderScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_StorageProviderCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageProviderCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).StorageProviderCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Query/StorageProviderCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageProviderCapacity(ctx, req.(*QueryStorageProviderCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStorageProvidersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ScheduledMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledMaintenance(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Query/ScheduledMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledMaintenance(ctx, req.(*QueryScheduledMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStorageProvidersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PendingSpStoragePrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingSpStoragePricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Sps) > 0 {
-		for _, e := range m.Sps {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingSpStoragePrices(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Query/PendingSpStoragePrices",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingSpStoragePrices(ctx, req.(*QueryPendingSpStoragePricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySpStoragePriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_SpKeyRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpKeyRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.SpAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).SpKeyRotation(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Query/SpKeyRotation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpKeyRotation(ctx, req.(*QuerySpKeyRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySpStoragePriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.SpStoragePrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "greenfield.sp.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "StorageProviders",
+			Handler:    _Query_StorageProviders_Handler,
+		},
+		{
+			MethodName: "QuerySpStoragePrice",
+			Handler:    _Query_QuerySpStoragePrice_Handler,
+		},
+		{
+			MethodName: "QueryGlobalSpStorePriceByTime",
+			Handler:    _Query_QueryGlobalSpStorePriceByTime_Handler,
+		},
+		{
+			MethodName: "StorageProvider",
+			Handler:    _Query_StorageProvider_Handler,
+		},
+		{
+			MethodName: "StorageProviderByOperatorAddress",
+			Handler:    _Query_StorageProviderByOperatorAddress_Handler,
+		},
+		{
+			MethodName: "StorageProviderMaintenanceRecordsByOperatorAddress",
+			Handler:    _Query_StorageProviderMaintenanceRecordsByOperatorAddress_Handler,
+		},
+		{
+			MethodName: "StorageProviderScore",
+			Handler:    _Query_StorageProviderScore_Handler,
+		},
+		{
+			MethodName: "StorageProviderCapacity",
+			Handler:    _Query_StorageProviderCapacity_Handler,
+		},
+		{
+			MethodName: "ScheduledMaintenance",
+			Handler:    _Query_ScheduledMaintenance_Handler,
+		},
+		{
+			MethodName: "PendingSpStoragePrices",
+			Handler:    _Query_PendingSpStoragePrices_Handler,
+		},
+		{
+			MethodName: "SpKeyRotation",
+			Handler:    _Query_SpKeyRotation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "greenfield/sp/query.proto",
 }
 
-func (m *QueryGlobalSpStorePriceByTimeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovQuery(uint64(m.Timestamp))
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGlobalSpStorePriceByTimeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.GlobalSpStorePrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStorageProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStorageProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStorageProvidersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StorageProvider != nil {
-		l = m.StorageProvider.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStorageProviderByOperatorAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStorageProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Sps) > 0 {
+		for iNdEx := len(m.Sps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStorageProviderByOperatorAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySpStoragePriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpStoragePriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpStoragePriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StorageProvider != nil {
-		l = m.StorageProvider.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.SpAddr) > 0 {
+		i -= len(m.SpAddr)
+		copy(dAtA[i:], m.SpAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStorageProviderMaintenanceRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySpStoragePriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpStoragePriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpStoragePriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SpStoragePrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalSpStorePriceByTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalSpStorePriceByTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalSpStorePriceByTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStorageProviderMaintenanceRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGlobalSpStorePriceByTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalSpStorePriceByTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalSpStorePriceByTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GlobalSpStorePrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StorageProvider != nil {
+		{
+			size, err := m.StorageProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageProviderByOperatorAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProviderByOperatorAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProviderByOperatorAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageProviderByOperatorAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProviderByOperatorAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProviderByOperatorAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StorageProvider != nil {
+		{
+			size, err := m.StorageProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageProviderMaintenanceRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProviderMaintenanceRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProviderMaintenanceRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageProviderMaintenanceRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProviderMaintenanceRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProviderMaintenanceRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageProviderScoreRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProviderScoreRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProviderScoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageProviderScoreResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProviderScoreResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProviderScoreResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Score.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageProviderCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProviderCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProviderCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageProviderCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProviderCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProviderCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Capacity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledMaintenanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledMaintenanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledMaintenanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledMaintenanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledMaintenanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledMaintenanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Upcoming) > 0 {
+		for iNdEx := len(m.Upcoming) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Upcoming[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingSpStoragePricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingSpStoragePricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingSpStoragePricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingSpStoragePricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingSpStoragePricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingSpStoragePricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingPrices) > 0 {
+		for iNdEx := len(m.PendingPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpKeyRotationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpKeyRotationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpKeyRotationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpKeyRotationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpKeyRotationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpKeyRotationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.KeyRotation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStorageProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sps) > 0 {
+		for _, e := range m.Sps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpStoragePriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpStoragePriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpStoragePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGlobalSpStorePriceByTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *QueryGlobalSpStorePriceByTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GlobalSpStorePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStorageProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryStorageProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageProvider != nil {
+		l = m.StorageProvider.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageProviderByOperatorAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageProviderByOperatorAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageProvider != nil {
+		l = m.StorageProvider.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageProviderMaintenanceRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageProviderMaintenanceRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStorageProviderScoreRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryStorageProviderScoreResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Score.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStorageProviderCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryStorageProviderCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Capacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryScheduledMaintenanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledMaintenanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Upcoming) > 0 {
+		for _, e := range m.Upcoming {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingSpStoragePricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingSpStoragePricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingPrices) > 0 {
+		for _, e := range m.PendingPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpKeyRotationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QuerySpKeyRotationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.KeyRotation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageProvidersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageProvidersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sps = append(m.Sps, &StorageProvider{})
+			if err := m.Sps[len(m.Sps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpStoragePriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpStoragePriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpStoragePriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpStoragePriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpStoragePriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpStoragePriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpStoragePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpStoragePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalSpStorePriceByTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalSpStorePriceByTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalSpStorePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalSpStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1735,12 +3560,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1762,7 +3606,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1785,15 +3629,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProvider", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1820,7 +3664,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StorageProvider == nil {
+				m.StorageProvider = &StorageProvider{}
+			}
+			if err := m.StorageProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1845,7 +3692,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageProvidersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderByOperatorAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1868,15 +3715,97 @@ func (m *QueryStorageProvidersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProvidersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageProviderByOperatorAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProvider", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1903,10 +3832,10 @@ func (m *QueryStorageProvidersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.StorageProvider == nil {
+				m.StorageProvider = &StorageProvider{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StorageProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1931,7 +3860,89 @@ func (m *QueryStorageProvidersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageProvidersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderMaintenanceRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageProviderMaintenanceRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1954,49 +3965,15 @@ func (m *QueryStorageProvidersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProvidersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sps", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sps = append(m.Sps, &StorageProvider{})
-			if err := m.Sps[len(m.Sps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2023,10 +4000,8 @@ func (m *QueryStorageProvidersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, &MaintenanceRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2051,7 +4026,7 @@ func (m *QueryStorageProvidersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpStoragePriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderScoreRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2074,17 +4049,17 @@ func (m *QuerySpStoragePriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpStoragePriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderScoreRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpStoragePriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderScoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2094,24 +4069,11 @@ func (m *QuerySpStoragePriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SpAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2133,7 +4095,7 @@ func (m *QuerySpStoragePriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpStoragePriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderScoreResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2156,15 +4118,15 @@ func (m *QuerySpStoragePriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpStoragePriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderScoreResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpStoragePriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderScoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpStoragePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2191,7 +4153,7 @@ func (m *QuerySpStoragePriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpStoragePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2216,7 +4178,7 @@ func (m *QuerySpStoragePriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGlobalSpStorePriceByTimeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderCapacityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2239,17 +4201,17 @@ func (m *QueryGlobalSpStorePriceByTimeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderCapacityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Timestamp = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2259,7 +4221,7 @@ func (m *QueryGlobalSpStorePriceByTimeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				m.Id |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2285,7 +4247,7 @@ func (m *QueryGlobalSpStorePriceByTimeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGlobalSpStorePriceByTimeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderCapacityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2308,15 +4270,15 @@ func (m *QueryGlobalSpStorePriceByTimeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderCapacityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalSpStorePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2343,7 +4305,7 @@ func (m *QueryGlobalSpStorePriceByTimeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GlobalSpStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Capacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2368,7 +4330,7 @@ func (m *QueryGlobalSpStorePriceByTimeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageProviderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledMaintenanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2391,17 +4353,17 @@ func (m *QueryStorageProviderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProviderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledMaintenanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledMaintenanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2411,11 +4373,28 @@ func (m *QueryStorageProviderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2437,7 +4416,7 @@ func (m *QueryStorageProviderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledMaintenanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2460,15 +4439,15 @@ func (m *QueryStorageProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledMaintenanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledMaintenanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upcoming", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2495,10 +4474,44 @@ func (m *QueryStorageProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StorageProvider == nil {
-				m.StorageProvider = &StorageProvider{}
+			m.Upcoming = append(m.Upcoming, ScheduledMaintenance{})
+			if err := m.Upcoming[len(m.Upcoming)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.StorageProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2523,7 +4536,7 @@ func (m *QueryStorageProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageProviderByOperatorAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingSpStoragePricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2546,17 +4559,17 @@ func (m *QueryStorageProviderByOperatorAddressRequest) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingSpStoragePricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingSpStoragePricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2566,23 +4579,27 @@ func (m *QueryStorageProviderByOperatorAddressRequest) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2605,7 +4622,7 @@ func (m *QueryStorageProviderByOperatorAddressRequest) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QueryStorageProviderByOperatorAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingSpStoragePricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2628,15 +4645,15 @@ func (m *QueryStorageProviderByOperatorAddressResponse) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingSpStoragePricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingSpStoragePricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPrices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2663,10 +4680,44 @@ func (m *QueryStorageProviderByOperatorAddressResponse) Unmarshal(dAtA []byte) e
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StorageProvider == nil {
-				m.StorageProvider = &StorageProvider{}
+			m.PendingPrices = append(m.PendingPrices, PendingSpStoragePrice{})
+			if err := m.PendingPrices[len(m.PendingPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.StorageProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2691,7 +4742,7 @@ func (m *QueryStorageProviderByOperatorAddressResponse) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *QueryStorageProviderMaintenanceRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySpKeyRotationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2714,17 +4765,17 @@ func (m *QueryStorageProviderMaintenanceRecordsRequest) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpKeyRotationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpKeyRotationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2734,24 +4785,11 @@ func (m *QueryStorageProviderMaintenanceRecordsRequest) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2773,7 +4811,7 @@ func (m *QueryStorageProviderMaintenanceRecordsRequest) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *QueryStorageProviderMaintenanceRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySpKeyRotationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2796,15 +4834,15 @@ func (m *QueryStorageProviderMaintenanceRecordsResponse) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpKeyRotationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpKeyRotationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2831,8 +4869,7 @@ func (m *QueryStorageProviderMaintenanceRecordsResponse) Unmarshal(dAtA []byte)
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &MaintenanceRecord{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.KeyRotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,55 @@
+package types
+
+const (
+	// MaxSpScore is the full score of a storage provider, all the scores are in basis points
+	MaxSpScore uint32 = 10000
+
+	// the weights (in percent) of the scores in the total score
+	ChallengeScoreWeight   = 40
+	SealScoreWeight        = 25
+	MaintenanceScoreWeight = 20
+	StabilityScoreWeight   = 15
+
+	// the penalties of the stability score for each swap-out and exit
+	SwapOutPenalty    = 500
+	ExitPenalty       = 2500
+	ForcedExitPenalty = 5000
+)
+
+// ratioScore returns the score of success / (success + failure), a storage provider without any history has the full score
+func ratioScore(success, failure uint64) uint32 {
+	total := success + failure
+	if total == 0 {
+		return MaxSpScore
+	}
+	return uint32(success * uint64(MaxSpScore) / total)
+}
+
+// penaltyScore returns the full score minus the penalty, the score is not less than zero
+func penaltyScore(penalty uint64) uint32 {
+	if penalty >= uint64(MaxSpScore) {
+		return 0
+	}
+	return MaxSpScore - uint32(penalty)
+}
+
+// NewStorageProviderScore computes the scorecard of a storage provider from its service history and
+// the maintenance duration used within the maintenance quota.
+func NewStorageProviderScore(spId uint32, stats SpScoreStats, maintenanceUsedDuration, maintenanceQuota int64) StorageProviderScore {
+	score := StorageProviderScore{
+		SpId:                    spId,
+		Stats:                   stats,
+		MaintenanceUsedDuration: maintenanceUsedDuration,
+		ChallengeScore:          ratioScore(stats.ChallengePassedCount, stats.ChallengeSlashedCount),
+		SealScore:               ratioScore(stats.SealCount, stats.SealRejectionCount),
+		MaintenanceScore:        MaxSpScore,
+		StabilityScore: penaltyScore(stats.SwapOutCount*SwapOutPenalty +
+			stats.ExitCount*ExitPenalty + stats.ForcedExitCount*ForcedExitPenalty),
+	}
+	if maintenanceQuota > 0 && maintenanceUsedDuration > 0 {
+		score.MaintenanceScore = penaltyScore(uint64(maintenanceUsedDuration) * uint64(MaxSpScore) / uint64(maintenanceQuota))
+	}
+	score.Score = (score.ChallengeScore*ChallengeScoreWeight + score.SealScore*SealScoreWeight +
+		score.MaintenanceScore*MaintenanceScoreWeight + score.StabilityScore*StabilityScoreWeight) / 100
+	return score
+}
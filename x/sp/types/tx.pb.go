@@ -344,6 +344,11 @@ type MsgEditStorageProvider struct {
 	// bls_key defines the bls pub key of the Storage provider for sealing object
 	BlsKey   string `protobuf:"bytes,8,opt,name=bls_key,json=blsKey,proto3" json:"bls_key,omitempty"`
 	BlsProof string `protobuf:"bytes,9,opt,name=bls_proof,json=blsProof,proto3" json:"bls_proof,omitempty"`
+	// location defines the location attributes of the storage provider, leave it empty if there is no change
+	Location *SpLocation `protobuf:"bytes,10,opt,name=location,proto3" json:"location,omitempty"`
+	// revoke_replaced_keys drops the replaced keys at once instead of keeping them for the overlap period, including
+	// the keys replaced by this edit and the keys replaced earlier which are still accepted, e.g. when they are compromised
+	RevokeReplacedKeys bool `protobuf:"varint,11,opt,name=revoke_replaced_keys,json=revokeReplacedKeys,proto3" json:"revoke_replaced_keys,omitempty"`
 }
 
 func (m *MsgEditStorageProvider) Reset()         { *m = MsgEditStorageProvider{} }
@@ -442,6 +447,20 @@ func (m *MsgEditStorageProvider) GetBlsProof() string {
 	return ""
 }
 
+func (m *MsgEditStorageProvider) GetLocation() *SpLocation {
+	if m != nil {
+		return m.Location
+	}
+	return nil
+}
+
+func (m *MsgEditStorageProvider) GetRevokeReplacedKeys() bool {
+	if m != nil {
+		return m.RevokeReplacedKeys
+	}
+	return false
+}
+
 // MsgEditStorageProviderResponse defines the Msg/EditStorageProvider response type.
 type MsgEditStorageProviderResponse struct {
 }
@@ -488,6 +507,11 @@ type MsgUpdateSpStoragePrice struct {
 	FreeReadQuota uint64 `protobuf:"varint,3,opt,name=free_read_quota,json=freeReadQuota,proto3" json:"free_read_quota,omitempty"`
 	// store price, in bnb wei per charge byte
 	StorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=store_price,json=storePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"store_price"`
+	// store price tiers, in ascending order of the charge size threshold, each tier price should not exceed the previous one
+	StorePriceTiers []SpStorePriceTier `protobuf:"bytes,5,rep,name=store_price_tiers,json=storePriceTiers,proto3" json:"store_price_tiers"`
+	// effective time of the price, in unix timestamp, 0 means the price takes effect immediately.
+	// A price increase should be announced at least price_increase_notice_days ahead.
+	EffectiveTime int64 `protobuf:"varint,6,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"`
 }
 
 func (m *MsgUpdateSpStoragePrice) Reset()         { *m = MsgUpdateSpStoragePrice{} }
@@ -537,6 +561,20 @@ func (m *MsgUpdateSpStoragePrice) GetFreeReadQuota() uint64 {
 	return 0
 }
 
+func (m *MsgUpdateSpStoragePrice) GetStorePriceTiers() []SpStorePriceTier {
+	if m != nil {
+		return m.StorePriceTiers
+	}
+	return nil
+}
+
+func (m *MsgUpdateSpStoragePrice) GetEffectiveTime() int64 {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return 0
+}
+
 type MsgUpdateSpStoragePriceResponse struct {
 }
 
@@ -573,6 +611,115 @@ func (m *MsgUpdateSpStoragePriceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateSpStoragePriceResponse proto.InternalMessageInfo
 
+// MsgUpdateSpCapacity defines message for a storage provider to declare its storage capacity.
+type MsgUpdateSpCapacity struct {
+	// sp_address is the operator address of the storage provider
+	SpAddress string `protobuf:"bytes,1,opt,name=sp_address,json=spAddress,proto3" json:"sp_address,omitempty"`
+	// total_capacity is the total storage capacity of the storage provider, in bytes
+	TotalCapacity uint64 `protobuf:"varint,2,opt,name=total_capacity,json=totalCapacity,proto3" json:"total_capacity,omitempty"`
+	// free_capacity is the free storage capacity of the storage provider, in bytes
+	FreeCapacity uint64 `protobuf:"varint,3,opt,name=free_capacity,json=freeCapacity,proto3" json:"free_capacity,omitempty"`
+	// max_gvg_families is the maximum number of global virtual group families the storage provider serves as primary sp
+	MaxGvgFamilies uint32 `protobuf:"varint,4,opt,name=max_gvg_families,json=maxGvgFamilies,proto3" json:"max_gvg_families,omitempty"`
+}
+
+func (m *MsgUpdateSpCapacity) Reset()         { *m = MsgUpdateSpCapacity{} }
+func (m *MsgUpdateSpCapacity) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSpCapacity) ProtoMessage()    {}
+func (*MsgUpdateSpCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f630c2933caa1bce, []int{8}
+}
+func (m *MsgUpdateSpCapacity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSpCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSpCapacity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSpCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSpCapacity.Merge(m, src)
+}
+func (m *MsgUpdateSpCapacity) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSpCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSpCapacity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSpCapacity proto.InternalMessageInfo
+
+func (m *MsgUpdateSpCapacity) GetSpAddress() string {
+	if m != nil {
+		return m.SpAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateSpCapacity) GetTotalCapacity() uint64 {
+	if m != nil {
+		return m.TotalCapacity
+	}
+	return 0
+}
+
+func (m *MsgUpdateSpCapacity) GetFreeCapacity() uint64 {
+	if m != nil {
+		return m.FreeCapacity
+	}
+	return 0
+}
+
+func (m *MsgUpdateSpCapacity) GetMaxGvgFamilies() uint32 {
+	if m != nil {
+		return m.MaxGvgFamilies
+	}
+	return 0
+}
+
+type MsgUpdateSpCapacityResponse struct {
+}
+
+func (m *MsgUpdateSpCapacityResponse) Reset()         { *m = MsgUpdateSpCapacityResponse{} }
+func (m *MsgUpdateSpCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSpCapacityResponse) ProtoMessage()    {}
+func (*MsgUpdateSpCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f630c2933caa1bce, []int{9}
+}
+func (m *MsgUpdateSpCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSpCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSpCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSpCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSpCapacityResponse.Merge(m, src)
+}
+func (m *MsgUpdateSpCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSpCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSpCapacityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSpCapacityResponse proto.InternalMessageInfo
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 type MsgUpdateParams struct {
 	// authority is the address that controls the module (defaults to x/gov unless overwritten).
@@ -587,7 +734,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f630c2933caa1bce, []int{8}
+	return fileDescriptor_f630c2933caa1bce, []int{10}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +786,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f630c2933caa1bce, []int{9}
+	return fileDescriptor_f630c2933caa1bce, []int{11}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -682,7 +829,7 @@ func (m *MsgUpdateStorageProviderStatus) Reset()         { *m = MsgUpdateStorage
 func (m *MsgUpdateStorageProviderStatus) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateStorageProviderStatus) ProtoMessage()    {}
 func (*MsgUpdateStorageProviderStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f630c2933caa1bce, []int{10}
+	return fileDescriptor_f630c2933caa1bce, []int{12}
 }
 func (m *MsgUpdateStorageProviderStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -742,7 +889,7 @@ func (m *MsgUpdateStorageProviderStatusResponse) Reset() {
 func (m *MsgUpdateStorageProviderStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateStorageProviderStatusResponse) ProtoMessage()    {}
 func (*MsgUpdateStorageProviderStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f630c2933caa1bce, []int{11}
+	return fileDescriptor_f630c2933caa1bce, []int{13}
 }
 func (m *MsgUpdateStorageProviderStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -771,325 +918,779 @@ func (m *MsgUpdateStorageProviderStatusResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateStorageProviderStatusResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateStorageProvider)(nil), "greenfield.sp.MsgCreateStorageProvider")
-	proto.RegisterType((*MsgCreateStorageProviderResponse)(nil), "greenfield.sp.MsgCreateStorageProviderResponse")
-	proto.RegisterType((*MsgDeposit)(nil), "greenfield.sp.MsgDeposit")
-	proto.RegisterType((*MsgDepositResponse)(nil), "greenfield.sp.MsgDepositResponse")
-	proto.RegisterType((*MsgEditStorageProvider)(nil), "greenfield.sp.MsgEditStorageProvider")
-	proto.RegisterType((*MsgEditStorageProviderResponse)(nil), "greenfield.sp.MsgEditStorageProviderResponse")
-	proto.RegisterType((*MsgUpdateSpStoragePrice)(nil), "greenfield.sp.MsgUpdateSpStoragePrice")
-	proto.RegisterType((*MsgUpdateSpStoragePriceResponse)(nil), "greenfield.sp.MsgUpdateSpStoragePriceResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "greenfield.sp.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "greenfield.sp.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgUpdateStorageProviderStatus)(nil), "greenfield.sp.MsgUpdateStorageProviderStatus")
-	proto.RegisterType((*MsgUpdateStorageProviderStatusResponse)(nil), "greenfield.sp.MsgUpdateStorageProviderStatusResponse")
+// MsgScheduleMaintenance is used by a SP to pre-announce a future maintenance window,
+// the SP will be put into STATUS_IN_MAINTENANCE at the start of the window and back to STATUS_IN_SERVICE at the end.
+type MsgScheduleMaintenance struct {
+	// sp_address defines the operator address
+	SpAddress string `protobuf:"bytes,1,opt,name=sp_address,json=spAddress,proto3" json:"sp_address,omitempty"`
+	// start_time defines the start of the maintenance window, in unix timestamp
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// duration defines the duration of the maintenance window, in seconds
+	Duration int64 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
 }
 
-func init() { proto.RegisterFile("greenfield/sp/tx.proto", fileDescriptor_f630c2933caa1bce) }
-
-var fileDescriptor_f630c2933caa1bce = []byte{
-	// 992 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xc6, 0xae, 0x1d, 0x3f, 0x27, 0x36, 0xda, 0xa6, 0xcd, 0x7a, 0x91, 0x1c, 0xd7, 0x12,
-	0x26, 0xaa, 0x64, 0x5b, 0x49, 0x05, 0x15, 0xa5, 0x97, 0x26, 0x46, 0x08, 0x21, 0x4b, 0xc1, 0x11,
-	0x1c, 0x40, 0xc8, 0x9a, 0xdd, 0x9d, 0x6c, 0x56, 0xb1, 0x77, 0xb6, 0x33, 0x63, 0xab, 0xb9, 0xf2,
-	0x07, 0x20, 0x24, 0xfe, 0x11, 0x0e, 0xe5, 0x1f, 0xe0, 0x42, 0x8f, 0x55, 0x4f, 0x88, 0x43, 0x85,
-	0x92, 0x03, 0x37, 0xce, 0x1c, 0xd1, 0xec, 0xce, 0x8e, 0x77, 0xfd, 0xa3, 0x76, 0x93, 0x72, 0xb2,
-	0x67, 0xde, 0xf7, 0xbe, 0x79, 0x33, 0xf3, 0xbd, 0x6f, 0x77, 0xe1, 0xae, 0x4b, 0x31, 0xf6, 0x4f,
-	0x3d, 0x3c, 0x70, 0xda, 0x2c, 0x68, 0xf3, 0x67, 0xad, 0x80, 0x12, 0x4e, 0xf4, 0xad, 0xc9, 0x7c,
-	0x8b, 0x05, 0x66, 0xd5, 0x26, 0x6c, 0x48, 0x58, 0xdb, 0x42, 0x0c, 0xb7, 0xc7, 0xfb, 0x16, 0xe6,
-	0x68, 0xbf, 0x6d, 0x13, 0xcf, 0x8f, 0xe0, 0xe6, 0x8e, 0x8c, 0x0f, 0x99, 0xdb, 0x1e, 0xef, 0x8b,
-	0x1f, 0x19, 0xa8, 0x44, 0x81, 0x7e, 0x38, 0x6a, 0x47, 0x03, 0x19, 0xda, 0x76, 0x89, 0x4b, 0xa2,
-	0x79, 0xf1, 0x4f, 0xce, 0x9a, 0xe9, 0x82, 0x02, 0x44, 0xd1, 0x30, 0xce, 0xa8, 0x4c, 0x15, 0x7b,
-	0x11, 0x60, 0x19, 0xaa, 0xff, 0x9c, 0x07, 0xa3, 0xcb, 0xdc, 0x23, 0x8a, 0x11, 0xc7, 0x27, 0x9c,
-	0x50, 0xe4, 0xe2, 0x63, 0x4a, 0xc6, 0x9e, 0x83, 0xa9, 0x7e, 0x00, 0x79, 0x5b, 0x04, 0x08, 0x35,
-	0xb4, 0x9a, 0xb6, 0x57, 0x38, 0x34, 0x5e, 0x3d, 0x6f, 0x6e, 0xcb, 0x62, 0x9e, 0x38, 0x0e, 0xc5,
-	0x8c, 0x9d, 0x70, 0xea, 0xf9, 0x6e, 0x2f, 0x06, 0xea, 0x87, 0x50, 0x74, 0x30, 0xb3, 0xa9, 0x17,
-	0x70, 0x8f, 0xf8, 0xc6, 0x7a, 0x4d, 0xdb, 0x2b, 0x1e, 0x98, 0xad, 0xd4, 0xb1, 0xb4, 0x3a, 0x13,
-	0xc4, 0x61, 0xf6, 0xc5, 0xeb, 0xdd, 0xb5, 0x5e, 0x32, 0x49, 0x7f, 0x08, 0xc0, 0x82, 0x3e, 0x8a,
-	0x16, 0x30, 0x32, 0x4b, 0x96, 0x2e, 0xb0, 0x40, 0x4e, 0xe8, 0x4f, 0xa0, 0x7c, 0x3a, 0xf2, 0x1d,
-	0xcf, 0x77, 0x55, 0x76, 0x76, 0x49, 0x76, 0x49, 0x26, 0xc4, 0x14, 0x9f, 0xc2, 0x26, 0xc3, 0x68,
-	0xa0, 0xf2, 0x6f, 0x2d, 0xc9, 0x2f, 0x0a, 0x74, 0x9c, 0x7c, 0x04, 0xef, 0xa1, 0x20, 0xa0, 0x64,
-	0x9c, 0x20, 0xc8, 0x2d, 0x21, 0x28, 0xc7, 0x19, 0x31, 0xc9, 0x43, 0x00, 0xd7, 0x56, 0xe9, 0xf9,
-	0x65, 0xbb, 0x77, 0xed, 0x38, 0xf1, 0x0b, 0xb8, 0x3d, 0x44, 0x9e, 0xcf, 0xb1, 0x8f, 0x7c, 0x1b,
-	0x2b, 0x86, 0x8d, 0x25, 0x0c, 0x7a, 0x22, 0x29, 0xa6, 0x32, 0x61, 0x03, 0xfb, 0x4e, 0x40, 0x3c,
-	0x9f, 0x1b, 0x05, 0x91, 0xdf, 0x53, 0x63, 0xfd, 0x13, 0xc8, 0x3b, 0x38, 0x20, 0xcc, 0xe3, 0x06,
-	0x84, 0xb7, 0x5b, 0x69, 0x49, 0x5e, 0xa1, 0xf2, 0x96, 0x54, 0x79, 0xeb, 0x88, 0x78, 0xf1, 0xe5,
-	0xc6, 0x78, 0xfd, 0x3b, 0x00, 0x8a, 0x91, 0xd3, 0x0f, 0xa8, 0x67, 0x63, 0xa3, 0x18, 0x16, 0xf6,
-	0x58, 0x40, 0xfe, 0x7c, 0xbd, 0xdb, 0x70, 0x3d, 0x7e, 0x36, 0xb2, 0x5a, 0x36, 0x19, 0x4a, 0xbd,
-	0xcb, 0x9f, 0x26, 0x73, 0xce, 0xa5, 0x66, 0x3b, 0xd8, 0x7e, 0xf5, 0xbc, 0x09, 0x72, 0xb9, 0x0e,
-	0xb6, 0x7b, 0x05, 0xc1, 0x77, 0x2c, 0xe8, 0xf4, 0x06, 0x94, 0x4f, 0x29, 0xc6, 0xfd, 0x70, 0x85,
-	0xa7, 0x23, 0xc2, 0x91, 0xb1, 0x59, 0xd3, 0xf6, 0xb2, 0xbd, 0x2d, 0x31, 0xdd, 0xc3, 0xc8, 0xf9,
-	0x4a, 0x4c, 0xea, 0xdf, 0x43, 0x91, 0x71, 0x42, 0xb1, 0xac, 0x62, 0xeb, 0x1d, 0x54, 0x01, 0x21,
-	0x61, 0x54, 0xc6, 0x0e, 0xe4, 0xad, 0x01, 0xeb, 0x9f, 0xe3, 0x0b, 0xa3, 0x14, 0x9e, 0x5c, 0xce,
-	0x1a, 0xb0, 0x2f, 0xf1, 0x85, 0xfe, 0x3e, 0x14, 0x44, 0x20, 0xa0, 0x84, 0x9c, 0x1a, 0xe5, 0xe8,
-	0x50, 0xad, 0x01, 0x3b, 0x16, 0xe3, 0x47, 0x9b, 0x3f, 0xfc, 0xfd, 0xcb, 0xfd, 0xb8, 0x89, 0xea,
-	0x75, 0xa8, 0x2d, 0x6a, 0xca, 0x1e, 0x66, 0x01, 0xf1, 0x19, 0xae, 0xff, 0xa6, 0x01, 0x74, 0x99,
-	0xdb, 0x91, 0x47, 0x7b, 0x9d, 0x5e, 0x4d, 0xf7, 0xd9, 0xfa, 0xea, 0x7d, 0x96, 0x90, 0x40, 0xe6,
-	0xed, 0x24, 0x30, 0xb5, 0xd1, 0x6d, 0xd0, 0x27, 0x7b, 0x50, 0x5b, 0xfb, 0x37, 0x03, 0x77, 0xbb,
-	0xcc, 0xfd, 0xcc, 0xf1, 0xf8, 0xb4, 0x25, 0xa5, 0x4b, 0xd6, 0x56, 0x2f, 0x39, 0xa9, 0xe8, 0xf5,
-	0x29, 0x45, 0x3f, 0x4e, 0x7b, 0x56, 0x66, 0x99, 0x67, 0xa5, 0xdd, 0x6a, 0xda, 0x31, 0xb2, 0x37,
-	0x75, 0x8c, 0x5b, 0x37, 0x73, 0x8c, 0xdc, 0x8d, 0x1d, 0x23, 0x7f, 0x0d, 0xc7, 0x48, 0xc8, 0x7e,
-	0x63, 0xb1, 0xec, 0x0b, 0x53, 0xb2, 0x2f, 0x0b, 0x35, 0x24, 0x6e, 0xb4, 0x5e, 0x83, 0xea, 0xfc,
-	0x9b, 0x57, 0xe2, 0xf8, 0x7d, 0x1d, 0x76, 0xba, 0xcc, 0xfd, 0x3a, 0x70, 0x44, 0x73, 0x04, 0x0a,
-	0x26, 0x7a, 0xef, 0xda, 0xea, 0x48, 0x1b, 0xd3, 0xfa, 0xff, 0x6e, 0x4c, 0x99, 0x15, 0x8c, 0x29,
-	0xfb, 0x6e, 0x8d, 0x69, 0xf6, 0xac, 0xef, 0xc1, 0xee, 0x82, 0x83, 0x54, 0x87, 0xfd, 0xa3, 0x06,
-	0x65, 0x85, 0x39, 0x0e, 0xdf, 0x29, 0xf4, 0x8f, 0xa1, 0x80, 0x46, 0xfc, 0x8c, 0x50, 0x8f, 0x5f,
-	0x2c, 0x3f, 0x63, 0x05, 0xd5, 0x1f, 0x40, 0x2e, 0x7a, 0x2b, 0x91, 0x2f, 0x05, 0x77, 0xa6, 0x1a,
-	0x2c, 0xa2, 0x97, 0x7e, 0x21, 0xa1, 0x8f, 0x4a, 0xa2, 0xe8, 0x09, 0x49, 0xbd, 0x92, 0xb8, 0xfc,
-	0x28, 0x41, 0xd5, 0xfa, 0xab, 0x16, 0x6a, 0x47, 0xee, 0x27, 0xad, 0x9e, 0x13, 0x8e, 0xf8, 0x88,
-	0x5d, 0x5f, 0x1f, 0x4d, 0xc8, 0xb1, 0x90, 0x22, 0xac, 0xbd, 0x34, 0x53, 0x7b, 0xc4, 0xdf, 0x93,
-	0x20, 0x61, 0x36, 0xce, 0x88, 0x22, 0xe5, 0x26, 0x99, 0x9e, 0x1a, 0xcf, 0x5e, 0xc3, 0x1e, 0x34,
-	0xde, 0x5c, 0x76, 0xbc, 0xc3, 0x83, 0x7f, 0xb2, 0x90, 0xe9, 0x32, 0x57, 0x7f, 0x0a, 0x77, 0xe6,
-	0xbf, 0xb0, 0x7d, 0x38, 0x55, 0xd6, 0xa2, 0x87, 0x88, 0xd9, 0x5e, 0x11, 0x18, 0x2f, 0xad, 0x7f,
-	0x0e, 0xf9, 0xf8, 0x49, 0x53, 0x99, 0xcd, 0x95, 0x21, 0xf3, 0xde, 0xc2, 0x90, 0x22, 0x3a, 0x87,
-	0xdb, 0xf3, 0x7c, 0xfd, 0x83, 0xd9, 0xcc, 0x39, 0x30, 0xb3, 0xb9, 0x12, 0x4c, 0x2d, 0xe6, 0xc3,
-	0xf6, 0x5c, 0x9f, 0x68, 0xcc, 0xd2, 0xcc, 0xc3, 0x99, 0xad, 0xd5, 0x70, 0x6a, 0xbd, 0x31, 0x94,
-	0x26, 0xf1, 0x50, 0x09, 0xcd, 0x85, 0x0c, 0xf3, 0x6e, 0xda, 0xfc, 0xe8, 0xad, 0xe0, 0x6a, 0xdd,
-	0x6f, 0x60, 0x33, 0xd5, 0xa2, 0xd5, 0x45, 0x34, 0x51, 0xdc, 0x6c, 0xbc, 0x39, 0x1e, 0xf3, 0x1e,
-	0x76, 0x5e, 0x5c, 0x56, 0xb5, 0x97, 0x97, 0x55, 0xed, 0xaf, 0xcb, 0xaa, 0xf6, 0xd3, 0x55, 0x75,
-	0xed, 0xe5, 0x55, 0x75, 0xed, 0x8f, 0xab, 0xea, 0xda, 0xb7, 0xf7, 0x13, 0x76, 0x64, 0xf9, 0x56,
-	0xd3, 0x3e, 0x43, 0x9e, 0xdf, 0x4e, 0x7c, 0x67, 0x3c, 0x53, 0x5f, 0x1a, 0x56, 0x2e, 0xfc, 0xd4,
-	0x78, 0xf0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x44, 0x22, 0xdb, 0xd4, 0x34, 0x0d, 0x00, 0x00,
+func (m *MsgScheduleMaintenance) Reset()         { *m = MsgScheduleMaintenance{} }
+func (m *MsgScheduleMaintenance) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMaintenance) ProtoMessage()    {}
+func (*MsgScheduleMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f630c2933caa1bce, []int{14}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	CreateStorageProvider(ctx context.Context, in *MsgCreateStorageProvider, opts ...grpc.CallOption) (*MsgCreateStorageProviderResponse, error)
-	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
-	EditStorageProvider(ctx context.Context, in *MsgEditStorageProvider, opts ...grpc.CallOption) (*MsgEditStorageProviderResponse, error)
-	UpdateSpStoragePrice(ctx context.Context, in *MsgUpdateSpStoragePrice, opts ...grpc.CallOption) (*MsgUpdateSpStoragePriceResponse, error)
-	UpdateSpStatus(ctx context.Context, in *MsgUpdateStorageProviderStatus, opts ...grpc.CallOption) (*MsgUpdateStorageProviderStatusResponse, error)
-	// UpdateParams defines a governance operation for updating the x/sp module parameters.
-	// The authority is defined in the keeper.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgScheduleMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgScheduleMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMaintenance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgScheduleMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMaintenance.Merge(m, src)
 }
-
-func (c *msgClient) CreateStorageProvider(ctx context.Context, in *MsgCreateStorageProvider, opts ...grpc.CallOption) (*MsgCreateStorageProviderResponse, error) {
-	out := new(MsgCreateStorageProviderResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.sp.Msg/CreateStorageProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgScheduleMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMaintenance.DiscardUnknown(m)
 }
 
-func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
-	out := new(MsgDepositResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.sp.Msg/Deposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgScheduleMaintenance proto.InternalMessageInfo
+
+func (m *MsgScheduleMaintenance) GetSpAddress() string {
+	if m != nil {
+		return m.SpAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) EditStorageProvider(ctx context.Context, in *MsgEditStorageProvider, opts ...grpc.CallOption) (*MsgEditStorageProviderResponse, error) {
-	out := new(MsgEditStorageProviderResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.sp.Msg/EditStorageProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleMaintenance) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateSpStoragePrice(ctx context.Context, in *MsgUpdateSpStoragePrice, opts ...grpc.CallOption) (*MsgUpdateSpStoragePriceResponse, error) {
-	out := new(MsgUpdateSpStoragePriceResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.sp.Msg/UpdateSpStoragePrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleMaintenance) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateSpStatus(ctx context.Context, in *MsgUpdateStorageProviderStatus, opts ...grpc.CallOption) (*MsgUpdateStorageProviderStatusResponse, error) {
-	out := new(MsgUpdateStorageProviderStatusResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.sp.Msg/UpdateSpStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgScheduleMaintenanceResponse defines the MsgScheduleMaintenance response type.
+type MsgScheduleMaintenanceResponse struct {
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.sp.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleMaintenanceResponse) Reset()         { *m = MsgScheduleMaintenanceResponse{} }
+func (m *MsgScheduleMaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMaintenanceResponse) ProtoMessage()    {}
+func (*MsgScheduleMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f630c2933caa1bce, []int{15}
+}
+func (m *MsgScheduleMaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleMaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMaintenanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	CreateStorageProvider(context.Context, *MsgCreateStorageProvider) (*MsgCreateStorageProviderResponse, error)
-	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
-	EditStorageProvider(context.Context, *MsgEditStorageProvider) (*MsgEditStorageProviderResponse, error)
-	UpdateSpStoragePrice(context.Context, *MsgUpdateSpStoragePrice) (*MsgUpdateSpStoragePriceResponse, error)
-	UpdateSpStatus(context.Context, *MsgUpdateStorageProviderStatus) (*MsgUpdateStorageProviderStatusResponse, error)
-	// UpdateParams defines a governance operation for updating the x/sp module parameters.
-	// The authority is defined in the keeper.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgScheduleMaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMaintenanceResponse.Merge(m, src)
+}
+func (m *MsgScheduleMaintenanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMaintenanceResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgScheduleMaintenanceResponse proto.InternalMessageInfo
+
+// MsgCancelScheduledMaintenance is used by a SP to cancel an upcoming maintenance window
+type MsgCancelScheduledMaintenance struct {
+	// sp_address defines the operator address
+	SpAddress string `protobuf:"bytes,1,opt,name=sp_address,json=spAddress,proto3" json:"sp_address,omitempty"`
+	// start_time defines the start of the maintenance window to cancel, in unix timestamp
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
 }
 
-func (*UnimplementedMsgServer) CreateStorageProvider(ctx context.Context, req *MsgCreateStorageProvider) (*MsgCreateStorageProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateStorageProvider not implemented")
+func (m *MsgCancelScheduledMaintenance) Reset()         { *m = MsgCancelScheduledMaintenance{} }
+func (m *MsgCancelScheduledMaintenance) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledMaintenance) ProtoMessage()    {}
+func (*MsgCancelScheduledMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f630c2933caa1bce, []int{16}
 }
-func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+func (m *MsgCancelScheduledMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) EditStorageProvider(ctx context.Context, req *MsgEditStorageProvider) (*MsgEditStorageProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditStorageProvider not implemented")
+func (m *MsgCancelScheduledMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledMaintenance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateSpStoragePrice(ctx context.Context, req *MsgUpdateSpStoragePrice) (*MsgUpdateSpStoragePriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpStoragePrice not implemented")
+func (m *MsgCancelScheduledMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledMaintenance.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateSpStatus(ctx context.Context, req *MsgUpdateStorageProviderStatus) (*MsgUpdateStorageProviderStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpStatus not implemented")
+func (m *MsgCancelScheduledMaintenance) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgCancelScheduledMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledMaintenance.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgCancelScheduledMaintenance proto.InternalMessageInfo
 
-func _Msg_CreateStorageProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateStorageProvider)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCancelScheduledMaintenance) GetSpAddress() string {
+	if m != nil {
+		return m.SpAddress
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateStorageProvider(ctx, in)
+	return ""
+}
+
+func (m *MsgCancelScheduledMaintenance) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.sp.Msg/CreateStorageProvider",
+	return 0
+}
+
+// MsgCancelScheduledMaintenanceResponse defines the MsgCancelScheduledMaintenance response type.
+type MsgCancelScheduledMaintenanceResponse struct {
+}
+
+func (m *MsgCancelScheduledMaintenanceResponse) Reset()         { *m = MsgCancelScheduledMaintenanceResponse{} }
+func (m *MsgCancelScheduledMaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledMaintenanceResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f630c2933caa1bce, []int{17}
+}
+func (m *MsgCancelScheduledMaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledMaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledMaintenanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateStorageProvider(ctx, req.(*MsgCreateStorageProvider))
+}
+func (m *MsgCancelScheduledMaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledMaintenanceResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledMaintenanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledMaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledMaintenanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledMaintenanceResponse proto.InternalMessageInfo
+
+// MsgUnjailStorageProvider is used by a jailed SP to top up its deposit and return to STATUS_IN_SERVICE.
+type MsgUnjailStorageProvider struct {
+	// creator is the msg signer, it should be sp's fund address
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// sp_address is the operator address of sp
+	SpAddress string `protobuf:"bytes,2,opt,name=sp_address,json=spAddress,proto3" json:"sp_address,omitempty"`
+	// deposit is the amount of token to top up, which should be no less than the unjail deposit, and the total deposit
+	// after the top-up should be no less than the min deposit
+	Deposit types.Coin `protobuf:"bytes,3,opt,name=deposit,proto3" json:"deposit"`
+}
+
+func (m *MsgUnjailStorageProvider) Reset()         { *m = MsgUnjailStorageProvider{} }
+func (m *MsgUnjailStorageProvider) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailStorageProvider) ProtoMessage()    {}
+func (*MsgUnjailStorageProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f630c2933caa1bce, []int{18}
+}
+func (m *MsgUnjailStorageProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailStorageProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailStorageProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUnjailStorageProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailStorageProvider.Merge(m, src)
+}
+func (m *MsgUnjailStorageProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailStorageProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailStorageProvider.DiscardUnknown(m)
 }
 
-func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeposit)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUnjailStorageProvider proto.InternalMessageInfo
+
+func (m *MsgUnjailStorageProvider) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).Deposit(ctx, in)
+	return ""
+}
+
+func (m *MsgUnjailStorageProvider) GetSpAddress() string {
+	if m != nil {
+		return m.SpAddress
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.sp.Msg/Deposit",
+	return ""
+}
+
+func (m *MsgUnjailStorageProvider) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
+	return types.Coin{}
+}
+
+// MsgUnjailStorageProviderResponse defines the MsgUnjailStorageProvider response type.
+type MsgUnjailStorageProviderResponse struct {
+}
+
+func (m *MsgUnjailStorageProviderResponse) Reset()         { *m = MsgUnjailStorageProviderResponse{} }
+func (m *MsgUnjailStorageProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailStorageProviderResponse) ProtoMessage()    {}
+func (*MsgUnjailStorageProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f630c2933caa1bce, []int{19}
+}
+func (m *MsgUnjailStorageProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailStorageProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailStorageProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUnjailStorageProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailStorageProviderResponse.Merge(m, src)
+}
+func (m *MsgUnjailStorageProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailStorageProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailStorageProviderResponse.DiscardUnknown(m)
 }
 
-func _Msg_EditStorageProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEditStorageProvider)
-	if err := dec(in); err != nil {
+var xxx_messageInfo_MsgUnjailStorageProviderResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateStorageProvider)(nil), "greenfield.sp.MsgCreateStorageProvider")
+	proto.RegisterType((*MsgCreateStorageProviderResponse)(nil), "greenfield.sp.MsgCreateStorageProviderResponse")
+	proto.RegisterType((*MsgDeposit)(nil), "greenfield.sp.MsgDeposit")
+	proto.RegisterType((*MsgDepositResponse)(nil), "greenfield.sp.MsgDepositResponse")
+	proto.RegisterType((*MsgEditStorageProvider)(nil), "greenfield.sp.MsgEditStorageProvider")
+	proto.RegisterType((*MsgEditStorageProviderResponse)(nil), "greenfield.sp.MsgEditStorageProviderResponse")
+	proto.RegisterType((*MsgUpdateSpStoragePrice)(nil), "greenfield.sp.MsgUpdateSpStoragePrice")
+	proto.RegisterType((*MsgUpdateSpStoragePriceResponse)(nil), "greenfield.sp.MsgUpdateSpStoragePriceResponse")
+	proto.RegisterType((*MsgUpdateSpCapacity)(nil), "greenfield.sp.MsgUpdateSpCapacity")
+	proto.RegisterType((*MsgUpdateSpCapacityResponse)(nil), "greenfield.sp.MsgUpdateSpCapacityResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "greenfield.sp.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "greenfield.sp.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateStorageProviderStatus)(nil), "greenfield.sp.MsgUpdateStorageProviderStatus")
+	proto.RegisterType((*MsgUpdateStorageProviderStatusResponse)(nil), "greenfield.sp.MsgUpdateStorageProviderStatusResponse")
+	proto.RegisterType((*MsgScheduleMaintenance)(nil), "greenfield.sp.MsgScheduleMaintenance")
+	proto.RegisterType((*MsgScheduleMaintenanceResponse)(nil), "greenfield.sp.MsgScheduleMaintenanceResponse")
+	proto.RegisterType((*MsgCancelScheduledMaintenance)(nil), "greenfield.sp.MsgCancelScheduledMaintenance")
+	proto.RegisterType((*MsgCancelScheduledMaintenanceResponse)(nil), "greenfield.sp.MsgCancelScheduledMaintenanceResponse")
+	proto.RegisterType((*MsgUnjailStorageProvider)(nil), "greenfield.sp.MsgUnjailStorageProvider")
+	proto.RegisterType((*MsgUnjailStorageProviderResponse)(nil), "greenfield.sp.MsgUnjailStorageProviderResponse")
+}
+
+func init() { proto.RegisterFile("greenfield/sp/tx.proto", fileDescriptor_f630c2933caa1bce) }
+
+var fileDescriptor_f630c2933caa1bce = []byte{
+	// 1335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x6e, 0xdb, 0x46,
+	0x13, 0x37, 0x2d, 0xc5, 0xb2, 0xc6, 0xb6, 0x94, 0x8f, 0x71, 0x12, 0x99, 0x41, 0x64, 0x47, 0x1f,
+	0xec, 0x18, 0x41, 0x25, 0x35, 0x4e, 0xd3, 0xa0, 0x69, 0x2e, 0xb1, 0xdd, 0x06, 0x45, 0x6a, 0xc0,
+	0xa1, 0x93, 0x1e, 0x5a, 0x14, 0xc2, 0x8a, 0x5c, 0xd1, 0xac, 0x29, 0x2e, 0xc3, 0x5d, 0x09, 0xf6,
+	0xad, 0xe8, 0xa9, 0xa7, 0xa2, 0x40, 0xcf, 0x7d, 0x87, 0x1e, 0x92, 0x07, 0x68, 0x4f, 0x39, 0x06,
+	0x01, 0x0a, 0x14, 0x3d, 0x04, 0x6d, 0x72, 0xe8, 0x6b, 0x14, 0x4b, 0x2e, 0x57, 0xa4, 0x44, 0xfd,
+	0xb1, 0x92, 0xb6, 0x27, 0x9b, 0x33, 0xbf, 0x99, 0x9d, 0xd9, 0x99, 0xf9, 0x71, 0x44, 0xb8, 0x60,
+	0xf9, 0x18, 0xbb, 0x2d, 0x1b, 0x3b, 0x66, 0x9d, 0x7a, 0x75, 0x76, 0x5c, 0xf3, 0x7c, 0xc2, 0x88,
+	0xba, 0xd4, 0x93, 0xd7, 0xa8, 0xa7, 0x95, 0x0d, 0x42, 0xdb, 0x84, 0xd6, 0x9b, 0x88, 0xe2, 0x7a,
+	0xf7, 0x7a, 0x13, 0x33, 0x74, 0xbd, 0x6e, 0x10, 0xdb, 0x0d, 0xe1, 0xda, 0x45, 0xa1, 0x6f, 0x53,
+	0xab, 0xde, 0xbd, 0xce, 0xff, 0x08, 0xc5, 0x4a, 0xa8, 0x68, 0x04, 0x4f, 0xf5, 0xf0, 0x41, 0xa8,
+	0x96, 0x2d, 0x62, 0x91, 0x50, 0xce, 0xff, 0x13, 0x52, 0x2d, 0x19, 0x90, 0x87, 0x7c, 0xd4, 0x8e,
+	0x2c, 0x56, 0xfa, 0x82, 0x3d, 0xf1, 0xb0, 0x50, 0x55, 0x7e, 0xc8, 0x41, 0x69, 0x8f, 0x5a, 0x3b,
+	0x3e, 0x46, 0x0c, 0x1f, 0x30, 0xe2, 0x23, 0x0b, 0xef, 0xfb, 0xa4, 0x6b, 0x9b, 0xd8, 0x57, 0xb7,
+	0x20, 0x67, 0x70, 0x05, 0xf1, 0x4b, 0xca, 0x9a, 0xb2, 0x99, 0xdf, 0x2e, 0xbd, 0x78, 0x52, 0x5d,
+	0x16, 0xc1, 0xdc, 0x35, 0x4d, 0x1f, 0x53, 0x7a, 0xc0, 0x7c, 0xdb, 0xb5, 0xf4, 0x08, 0xa8, 0x6e,
+	0xc3, 0x82, 0x89, 0xa9, 0xe1, 0xdb, 0x1e, 0xb3, 0x89, 0x5b, 0x9a, 0x5d, 0x53, 0x36, 0x17, 0xb6,
+	0xb4, 0x5a, 0xe2, 0x5a, 0x6a, 0xbb, 0x3d, 0xc4, 0x76, 0xf6, 0xd9, 0xcb, 0xd5, 0x19, 0x3d, 0x6e,
+	0xa4, 0xde, 0x02, 0xa0, 0x5e, 0x03, 0x85, 0x07, 0x94, 0x32, 0x63, 0x8e, 0xce, 0x53, 0x4f, 0x08,
+	0xd4, 0xbb, 0x50, 0x6c, 0x75, 0x5c, 0xd3, 0x76, 0x2d, 0x69, 0x9d, 0x1d, 0x63, 0x5d, 0x10, 0x06,
+	0x91, 0x8b, 0x0f, 0x61, 0x91, 0x62, 0xe4, 0x48, 0xfb, 0x33, 0x63, 0xec, 0x17, 0x38, 0x3a, 0x32,
+	0xde, 0x81, 0xb3, 0xc8, 0xf3, 0x7c, 0xd2, 0x8d, 0x39, 0x98, 0x1b, 0xe3, 0xa0, 0x18, 0x59, 0x44,
+	0x4e, 0x6e, 0x01, 0x58, 0x86, 0x34, 0xcf, 0x8d, 0xcb, 0xde, 0x32, 0x22, 0xc3, 0x4f, 0xe0, 0x5c,
+	0x1b, 0xd9, 0x2e, 0xc3, 0x2e, 0x72, 0x0d, 0x2c, 0x3d, 0xcc, 0x8f, 0xf1, 0xa0, 0xc6, 0x8c, 0x22,
+	0x57, 0x1a, 0xcc, 0x63, 0xd7, 0xf4, 0x88, 0xed, 0xb2, 0x52, 0x9e, 0xdb, 0xeb, 0xf2, 0x59, 0xfd,
+	0x00, 0x72, 0x26, 0xf6, 0x08, 0xb5, 0x59, 0x09, 0x82, 0xea, 0xae, 0xd4, 0x84, 0x5f, 0xde, 0xe5,
+	0x35, 0xd1, 0xe5, 0xb5, 0x1d, 0x62, 0x47, 0xc5, 0x8d, 0xf0, 0xea, 0x17, 0x00, 0x3e, 0x46, 0x66,
+	0xc3, 0xf3, 0x6d, 0x03, 0x97, 0x16, 0x82, 0xc0, 0xee, 0x70, 0xc8, 0xef, 0x2f, 0x57, 0x37, 0x2c,
+	0x9b, 0x1d, 0x76, 0x9a, 0x35, 0x83, 0xb4, 0x45, 0xbf, 0x8b, 0x3f, 0x55, 0x6a, 0x1e, 0x89, 0x9e,
+	0xdd, 0xc5, 0xc6, 0x8b, 0x27, 0x55, 0x10, 0xc7, 0xed, 0x62, 0x43, 0xcf, 0x73, 0x7f, 0xfb, 0xdc,
+	0x9d, 0xba, 0x01, 0xc5, 0x96, 0x8f, 0x71, 0x23, 0x38, 0xe1, 0x71, 0x87, 0x30, 0x54, 0x5a, 0x5c,
+	0x53, 0x36, 0xb3, 0xfa, 0x12, 0x17, 0xeb, 0x18, 0x99, 0x0f, 0xb8, 0x50, 0xfd, 0x12, 0x16, 0x28,
+	0x23, 0x3e, 0x16, 0x51, 0x2c, 0xbd, 0x85, 0x28, 0x20, 0x70, 0x18, 0x86, 0x71, 0x11, 0x72, 0x4d,
+	0x87, 0x36, 0x8e, 0xf0, 0x49, 0xa9, 0x10, 0xdc, 0xdc, 0x5c, 0xd3, 0xa1, 0xf7, 0xf1, 0x89, 0x7a,
+	0x09, 0xf2, 0x5c, 0xe1, 0xf9, 0x84, 0xb4, 0x4a, 0xc5, 0xf0, 0x52, 0x9b, 0x0e, 0xdd, 0xe7, 0xcf,
+	0xb7, 0x17, 0xbf, 0xf9, 0xeb, 0xa7, 0x6b, 0xd1, 0x10, 0x55, 0x2a, 0xb0, 0x36, 0x6c, 0x28, 0x75,
+	0x4c, 0x3d, 0xe2, 0x52, 0x5c, 0xf9, 0x45, 0x01, 0xd8, 0xa3, 0xd6, 0xae, 0xb8, 0xda, 0x69, 0x66,
+	0x35, 0x39, 0x67, 0xb3, 0x93, 0xcf, 0x59, 0xac, 0x05, 0x32, 0xa7, 0x6b, 0x81, 0xbe, 0x44, 0x97,
+	0x41, 0xed, 0xe5, 0x20, 0x53, 0xfb, 0x33, 0x0b, 0x17, 0xf6, 0xa8, 0xf5, 0x91, 0x69, 0xb3, 0x7e,
+	0x4a, 0x4a, 0x86, 0xac, 0x4c, 0x1e, 0x72, 0xbc, 0xa3, 0x67, 0xfb, 0x3a, 0xfa, 0x4e, 0x92, 0xb3,
+	0x32, 0xe3, 0x38, 0x2b, 0xc9, 0x56, 0xfd, 0x8c, 0x91, 0x7d, 0x53, 0xc6, 0x38, 0xf3, 0x66, 0x8c,
+	0x31, 0xf7, 0xc6, 0x8c, 0x91, 0x9b, 0x82, 0x31, 0x62, 0x6d, 0x3f, 0x3f, 0xbc, 0xed, 0xf3, 0xc9,
+	0xb6, 0x57, 0x6f, 0xc2, 0xbc, 0x43, 0x0c, 0x14, 0x5c, 0x7b, 0x44, 0x26, 0xc9, 0x6b, 0x3f, 0xf0,
+	0x3e, 0x15, 0x00, 0x5d, 0x42, 0xd5, 0x77, 0x61, 0xd9, 0xc7, 0x5d, 0x72, 0xc4, 0x87, 0xdd, 0x73,
+	0x90, 0x81, 0x4d, 0x7e, 0x30, 0x0d, 0x18, 0x65, 0x5e, 0x57, 0x43, 0x9d, 0x2e, 0x54, 0xf7, 0xf1,
+	0x09, 0xbd, 0x5d, 0xe4, 0x6d, 0x17, 0x6b, 0x9d, 0xca, 0x1a, 0x94, 0xd3, 0x5b, 0x4c, 0x76, 0xe1,
+	0xcf, 0x19, 0xb8, 0xb8, 0x47, 0xad, 0x47, 0x9e, 0xc9, 0xa7, 0xd0, 0x93, 0x30, 0x3e, 0xe4, 0x53,
+	0xb7, 0x61, 0x92, 0x01, 0x67, 0xff, 0x71, 0x06, 0xcc, 0x4c, 0xc0, 0x80, 0xd9, 0xb7, 0xcc, 0x80,
+	0x0f, 0xe0, 0x7f, 0x31, 0xf7, 0x0d, 0x66, 0x63, 0x9f, 0x37, 0x75, 0x66, 0x73, 0x61, 0x6b, 0x75,
+	0xa0, 0xba, 0x07, 0xd2, 0xee, 0xa1, 0x8d, 0x7d, 0xc1, 0x16, 0x45, 0x9a, 0x90, 0x52, 0x75, 0x1d,
+	0x0a, 0xb8, 0xd5, 0xc2, 0x06, 0xb3, 0xbb, 0xdc, 0x61, 0x1b, 0x07, 0x5d, 0x9e, 0xd1, 0x97, 0xa4,
+	0xf4, 0xa1, 0xdd, 0xc6, 0x83, 0x55, 0xbe, 0x02, 0xab, 0x43, 0x4a, 0x28, 0xcb, 0xfc, 0xab, 0x02,
+	0xe7, 0x62, 0x98, 0x1d, 0xe4, 0x21, 0xc3, 0x66, 0x27, 0xd3, 0x97, 0x78, 0x1d, 0x0a, 0x8c, 0x30,
+	0xe4, 0x34, 0x0c, 0xe1, 0x2a, 0x28, 0x73, 0x56, 0x5f, 0x0a, 0xa4, 0xd2, 0xff, 0xff, 0x21, 0xa8,
+	0x4a, 0x0f, 0x15, 0x96, 0x6a, 0x91, 0x0b, 0x25, 0x68, 0x13, 0xce, 0xb6, 0xd1, 0x71, 0xc3, 0xea,
+	0x5a, 0x8d, 0x16, 0x6a, 0xdb, 0x8e, 0x8d, 0x43, 0x7e, 0x59, 0xd2, 0x0b, 0x6d, 0x74, 0x7c, 0xaf,
+	0x6b, 0x7d, 0x2c, 0xa4, 0x83, 0xa9, 0x5f, 0x86, 0x4b, 0x29, 0x69, 0xc9, 0xb4, 0xbf, 0x53, 0xa0,
+	0x28, 0xf5, 0xfb, 0xc1, 0xb6, 0xa8, 0xbe, 0x0f, 0x79, 0xd4, 0x61, 0x87, 0xc4, 0xe7, 0xe1, 0x8c,
+	0xcd, 0x58, 0x42, 0xd5, 0x1b, 0x30, 0x17, 0xee, 0x9b, 0x62, 0xdd, 0x3b, 0xdf, 0x57, 0xe5, 0xd0,
+	0xbd, 0xa8, 0xad, 0x80, 0xde, 0x2e, 0xf0, 0x80, 0x7b, 0x4e, 0x2a, 0x2b, 0xb1, 0x69, 0x0b, 0x0d,
+	0x64, 0xac, 0x4f, 0x95, 0x60, 0x58, 0x45, 0x2e, 0xc9, 0x71, 0x3d, 0x60, 0x88, 0x75, 0xe8, 0xf4,
+	0xd5, 0xaa, 0xc2, 0x1c, 0x0d, 0x5c, 0x04, 0xb1, 0x17, 0x06, 0x62, 0x0f, 0xfd, 0xeb, 0x02, 0xc4,
+	0x5f, 0x23, 0x66, 0xc7, 0x47, 0xf2, 0x3d, 0x91, 0xd1, 0xe5, 0xf3, 0x60, 0x09, 0x36, 0x61, 0x63,
+	0x74, 0xd8, 0x32, 0xc3, 0x1f, 0x95, 0xe0, 0x8d, 0x77, 0x60, 0x1c, 0x62, 0xb3, 0xe3, 0xe0, 0xbd,
+	0x1e, 0xbf, 0x4e, 0x9f, 0xd9, 0x65, 0x00, 0xca, 0x90, 0xcf, 0xc2, 0x79, 0x99, 0x0d, 0x82, 0xcd,
+	0x07, 0x12, 0x3e, 0x2b, 0xa7, 0xcb, 0x24, 0x64, 0xcb, 0x94, 0xf0, 0x64, 0x06, 0xdf, 0x2a, 0x70,
+	0x99, 0xef, 0x2c, 0x5c, 0xe8, 0x44, 0x40, 0xf3, 0x5f, 0x48, 0x64, 0x30, 0xd8, 0xab, 0xb0, 0x3e,
+	0x32, 0x12, 0x19, 0xf3, 0x73, 0x25, 0xf8, 0xf1, 0xf3, 0xc8, 0xfd, 0x0a, 0xd9, 0xce, 0xdb, 0xf8,
+	0xf1, 0xf3, 0xdf, 0x2f, 0x54, 0xe1, 0xe6, 0x98, 0x9a, 0x51, 0x94, 0xf6, 0xd6, 0xd3, 0x79, 0xc8,
+	0xec, 0x51, 0x4b, 0x7d, 0x0c, 0xe7, 0xd3, 0x7f, 0xf7, 0x5d, 0xed, 0x9b, 0x81, 0x61, 0xbb, 0xa8,
+	0x56, 0x9f, 0x10, 0x18, 0x1d, 0xad, 0xde, 0x83, 0x5c, 0xb4, 0xb0, 0xae, 0x0c, 0xda, 0x0a, 0x95,
+	0x76, 0x65, 0xa8, 0x4a, 0x3a, 0x3a, 0x82, 0x73, 0x69, 0xeb, 0xe1, 0xfa, 0xa0, 0x65, 0x0a, 0x4c,
+	0xab, 0x4e, 0x04, 0x93, 0x87, 0xb9, 0xb0, 0x9c, 0xba, 0x05, 0x6c, 0x0c, 0xba, 0x49, 0xc3, 0x69,
+	0xb5, 0xc9, 0x70, 0xf2, 0xbc, 0x2e, 0x14, 0x7a, 0xfa, 0x80, 0x76, 0xaa, 0x43, 0x3d, 0xa4, 0xd1,
+	0x8a, 0x76, 0xf3, 0x54, 0x70, 0x79, 0x6e, 0x13, 0xce, 0x0e, 0xbc, 0x06, 0x2b, 0xc3, 0x63, 0x8f,
+	0x30, 0xda, 0xb5, 0xf1, 0x98, 0x78, 0xe1, 0xd2, 0x58, 0x2e, 0xa5, 0x70, 0x29, 0xb0, 0xb4, 0xc2,
+	0x8d, 0x20, 0x25, 0xf5, 0x6b, 0x05, 0xb4, 0x11, 0x8c, 0xf4, 0x4e, 0x4a, 0xfb, 0x0e, 0x45, 0x6b,
+	0xef, 0x9d, 0x06, 0x2d, 0x43, 0x78, 0x0c, 0xe7, 0xd3, 0xf9, 0x25, 0x65, 0xc8, 0x52, 0x81, 0x69,
+	0x43, 0x36, 0x72, 0xbe, 0xd5, 0xcf, 0x60, 0x31, 0xf1, 0x5a, 0x2f, 0x0f, 0x2b, 0x4f, 0xa8, 0xd7,
+	0x36, 0x46, 0xeb, 0x23, 0xbf, 0xdb, 0xbb, 0xcf, 0x5e, 0x95, 0x95, 0xe7, 0xaf, 0xca, 0xca, 0x1f,
+	0xaf, 0xca, 0xca, 0xf7, 0xaf, 0xcb, 0x33, 0xcf, 0x5f, 0x97, 0x67, 0x7e, 0x7b, 0x5d, 0x9e, 0xf9,
+	0xfc, 0x5a, 0x6c, 0x67, 0x6c, 0xba, 0xcd, 0xaa, 0x71, 0x88, 0x6c, 0xb7, 0x1e, 0xfb, 0xea, 0x74,
+	0x2c, 0xbf, 0x3b, 0x35, 0xe7, 0x82, 0x0f, 0x4f, 0x37, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x07,
+	0x40, 0x53, 0xd1, 0x42, 0x13, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	CreateStorageProvider(ctx context.Context, in *MsgCreateStorageProvider, opts ...grpc.CallOption) (*MsgCreateStorageProviderResponse, error)
+	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
+	EditStorageProvider(ctx context.Context, in *MsgEditStorageProvider, opts ...grpc.CallOption) (*MsgEditStorageProviderResponse, error)
+	UpdateSpStoragePrice(ctx context.Context, in *MsgUpdateSpStoragePrice, opts ...grpc.CallOption) (*MsgUpdateSpStoragePriceResponse, error)
+	UpdateSpStatus(ctx context.Context, in *MsgUpdateStorageProviderStatus, opts ...grpc.CallOption) (*MsgUpdateStorageProviderStatusResponse, error)
+	UpdateSpCapacity(ctx context.Context, in *MsgUpdateSpCapacity, opts ...grpc.CallOption) (*MsgUpdateSpCapacityResponse, error)
+	ScheduleMaintenance(ctx context.Context, in *MsgScheduleMaintenance, opts ...grpc.CallOption) (*MsgScheduleMaintenanceResponse, error)
+	CancelScheduledMaintenance(ctx context.Context, in *MsgCancelScheduledMaintenance, opts ...grpc.CallOption) (*MsgCancelScheduledMaintenanceResponse, error)
+	UnjailStorageProvider(ctx context.Context, in *MsgUnjailStorageProvider, opts ...grpc.CallOption) (*MsgUnjailStorageProviderResponse, error)
+	// UpdateParams defines a governance operation for updating the x/sp module parameters.
+	// The authority is defined in the keeper.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateStorageProvider(ctx context.Context, in *MsgCreateStorageProvider, opts ...grpc.CallOption) (*MsgCreateStorageProviderResponse, error) {
+	out := new(MsgCreateStorageProviderResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Msg/CreateStorageProvider", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).EditStorageProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.sp.Msg/EditStorageProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).EditStorageProvider(ctx, req.(*MsgEditStorageProvider))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_UpdateSpStoragePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateSpStoragePrice)
-	if err := dec(in); err != nil {
+func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
+	out := new(MsgDepositResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Msg/Deposit", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateSpStoragePrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.sp.Msg/UpdateSpStoragePrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateSpStoragePrice(ctx, req.(*MsgUpdateSpStoragePrice))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_UpdateSpStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateStorageProviderStatus)
-	if err := dec(in); err != nil {
+func (c *msgClient) EditStorageProvider(ctx context.Context, in *MsgEditStorageProvider, opts ...grpc.CallOption) (*MsgEditStorageProviderResponse, error) {
+	out := new(MsgEditStorageProviderResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Msg/EditStorageProvider", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateSpStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.sp.Msg/UpdateSpStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateSpStatus(ctx, req.(*MsgUpdateStorageProviderStatus))
+	return out, nil
+}
+
+func (c *msgClient) UpdateSpStoragePrice(ctx context.Context, in *MsgUpdateSpStoragePrice, opts ...grpc.CallOption) (*MsgUpdateSpStoragePriceResponse, error) {
+	out := new(MsgUpdateSpStoragePriceResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Msg/UpdateSpStoragePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
+func (c *msgClient) UpdateSpStatus(ctx context.Context, in *MsgUpdateStorageProviderStatus, opts ...grpc.CallOption) (*MsgUpdateStorageProviderStatusResponse, error) {
+	out := new(MsgUpdateStorageProviderStatusResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Msg/UpdateSpStatus", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) UpdateSpCapacity(ctx context.Context, in *MsgUpdateSpCapacity, opts ...grpc.CallOption) (*MsgUpdateSpCapacityResponse, error) {
+	out := new(MsgUpdateSpCapacityResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Msg/UpdateSpCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.sp.Msg/UpdateParams",
+	return out, nil
+}
+
+func (c *msgClient) ScheduleMaintenance(ctx context.Context, in *MsgScheduleMaintenance, opts ...grpc.CallOption) (*MsgScheduleMaintenanceResponse, error) {
+	out := new(MsgScheduleMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Msg/ScheduleMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledMaintenance(ctx context.Context, in *MsgCancelScheduledMaintenance, opts ...grpc.CallOption) (*MsgCancelScheduledMaintenanceResponse, error) {
+	out := new(MsgCancelScheduledMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Msg/CancelScheduledMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
+func (c *msgClient) UnjailStorageProvider(ctx context.Context, in *MsgUnjailStorageProvider, opts ...grpc.CallOption) (*MsgUnjailStorageProviderResponse, error) {
+	out := new(MsgUnjailStorageProviderResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Msg/UnjailStorageProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	CreateStorageProvider(context.Context, *MsgCreateStorageProvider) (*MsgCreateStorageProviderResponse, error)
+	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
+	EditStorageProvider(context.Context, *MsgEditStorageProvider) (*MsgEditStorageProviderResponse, error)
+	UpdateSpStoragePrice(context.Context, *MsgUpdateSpStoragePrice) (*MsgUpdateSpStoragePriceResponse, error)
+	UpdateSpStatus(context.Context, *MsgUpdateStorageProviderStatus) (*MsgUpdateStorageProviderStatusResponse, error)
+	UpdateSpCapacity(context.Context, *MsgUpdateSpCapacity) (*MsgUpdateSpCapacityResponse, error)
+	ScheduleMaintenance(context.Context, *MsgScheduleMaintenance) (*MsgScheduleMaintenanceResponse, error)
+	CancelScheduledMaintenance(context.Context, *MsgCancelScheduledMaintenance) (*MsgCancelScheduledMaintenanceResponse, error)
+	UnjailStorageProvider(context.Context, *MsgUnjailStorageProvider) (*MsgUnjailStorageProviderResponse, error)
+	// UpdateParams defines a governance operation for updating the x/sp module parameters.
+	// The authority is defined in the keeper.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateStorageProvider(ctx context.Context, req *MsgCreateStorageProvider) (*MsgCreateStorageProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStorageProvider not implemented")
+}
+func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (*UnimplementedMsgServer) EditStorageProvider(ctx context.Context, req *MsgEditStorageProvider) (*MsgEditStorageProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditStorageProvider not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSpStoragePrice(ctx context.Context, req *MsgUpdateSpStoragePrice) (*MsgUpdateSpStoragePriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpStoragePrice not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSpStatus(ctx context.Context, req *MsgUpdateStorageProviderStatus) (*MsgUpdateStorageProviderStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpStatus not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSpCapacity(ctx context.Context, req *MsgUpdateSpCapacity) (*MsgUpdateSpCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpCapacity not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleMaintenance(ctx context.Context, req *MsgScheduleMaintenance) (*MsgScheduleMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMaintenance not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledMaintenance(ctx context.Context, req *MsgCancelScheduledMaintenance) (*MsgCancelScheduledMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMaintenance not implemented")
+}
+func (*UnimplementedMsgServer) UnjailStorageProvider(ctx context.Context, req *MsgUnjailStorageProvider) (*MsgUnjailStorageProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailStorageProvider not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateStorageProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateStorageProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateStorageProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Msg/CreateStorageProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateStorageProvider(ctx, req.(*MsgCreateStorageProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Msg/Deposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EditStorageProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditStorageProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EditStorageProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Msg/EditStorageProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditStorageProvider(ctx, req.(*MsgEditStorageProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSpStoragePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSpStoragePrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSpStoragePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Msg/UpdateSpStoragePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSpStoragePrice(ctx, req.(*MsgUpdateSpStoragePrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSpStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateStorageProviderStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSpStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Msg/UpdateSpStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSpStatus(ctx, req.(*MsgUpdateStorageProviderStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSpCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSpCapacity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSpCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Msg/UpdateSpCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSpCapacity(ctx, req.(*MsgUpdateSpCapacity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleMaintenance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Msg/ScheduleMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleMaintenance(ctx, req.(*MsgScheduleMaintenance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledMaintenance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Msg/CancelScheduledMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledMaintenance(ctx, req.(*MsgCancelScheduledMaintenance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnjailStorageProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailStorageProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailStorageProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Msg/UnjailStorageProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailStorageProvider(ctx, req.(*MsgUnjailStorageProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "greenfield.sp.Msg",
 	HandlerType: (*MsgServer)(nil),
 	Methods: []grpc.MethodDesc{
@@ -1113,6 +1714,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSpStatus",
 			Handler:    _Msg_UpdateSpStatus_Handler,
 		},
+		{
+			MethodName: "UpdateSpCapacity",
+			Handler:    _Msg_UpdateSpCapacity_Handler,
+		},
+		{
+			MethodName: "ScheduleMaintenance",
+			Handler:    _Msg_ScheduleMaintenance_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMaintenance",
+			Handler:    _Msg_CancelScheduledMaintenance_Handler,
+		},
+		{
+			MethodName: "UnjailStorageProvider",
+			Handler:    _Msg_UnjailStorageProvider_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -1373,6 +1990,28 @@ func (m *MsgEditStorageProvider) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.RevokeReplacedKeys {
+		i--
+		if m.RevokeReplacedKeys {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Location != nil {
+		{
+			size, err := m.Location.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.BlsProof) > 0 {
 		i -= len(m.BlsProof)
 		copy(dAtA[i:], m.BlsProof)
@@ -1487,6 +2126,25 @@ func (m *MsgUpdateSpStoragePrice) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.EffectiveTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EffectiveTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.StorePriceTiers) > 0 {
+		for iNdEx := len(m.StorePriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorePriceTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size := m.StorePrice.Size()
 		i -= size
@@ -1545,7 +2203,7 @@ func (m *MsgUpdateSpStoragePriceResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateSpCapacity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1555,22 +2213,90 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateSpCapacity) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateSpCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
+	if m.MaxGvgFamilies != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxGvgFamilies))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FreeCapacity != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FreeCapacity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalCapacity != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalCapacity))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SpAddress) > 0 {
+		i -= len(m.SpAddress)
+		copy(dAtA[i:], m.SpAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSpCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSpCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSpCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -1671,6 +2397,197 @@ func (m *MsgUpdateStorageProviderStatusResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgScheduleMaintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleMaintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SpAddress) > 0 {
+		i -= len(m.SpAddress)
+		copy(dAtA[i:], m.SpAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleMaintenanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleMaintenanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMaintenanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledMaintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledMaintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SpAddress) > 0 {
+		i -= len(m.SpAddress)
+		copy(dAtA[i:], m.SpAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledMaintenanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledMaintenanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledMaintenanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailStorageProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailStorageProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailStorageProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SpAddress) > 0 {
+		i -= len(m.SpAddress)
+		copy(dAtA[i:], m.SpAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailStorageProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailStorageProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailStorageProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1821,6 +2738,13 @@ func (m *MsgEditStorageProvider) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Location != nil {
+		l = m.Location.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RevokeReplacedKeys {
+		n += 2
+	}
 	return n
 }
 
@@ -1850,6 +2774,15 @@ func (m *MsgUpdateSpStoragePrice) Size() (n int) {
 	}
 	l = m.StorePrice.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if len(m.StorePriceTiers) > 0 {
+		for _, e := range m.StorePriceTiers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.EffectiveTime != 0 {
+		n += 1 + sovTx(uint64(m.EffectiveTime))
+	}
 	return n
 }
 
@@ -1862,22 +2795,29 @@ func (m *MsgUpdateSpStoragePriceResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
+func (m *MsgUpdateSpCapacity) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = len(m.SpAddress)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
+	if m.TotalCapacity != 0 {
+		n += 1 + sovTx(uint64(m.TotalCapacity))
+	}
+	if m.FreeCapacity != 0 {
+		n += 1 + sovTx(uint64(m.FreeCapacity))
+	}
+	if m.MaxGvgFamilies != 0 {
+		n += 1 + sovTx(uint64(m.MaxGvgFamilies))
+	}
 	return n
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
+func (m *MsgUpdateSpCapacityResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1886,41 +2826,916 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgUpdateStorageProviderStatus) Size() (n int) {
+func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.SpAddress)
+	l = len(m.Authority)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
-	}
-	if m.Duration != 0 {
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateStorageProviderStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *MsgUpdateStorageProviderStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovTx(uint64(m.StartTime))
+	}
+	if m.Duration != 0 {
 		n += 1 + sovTx(uint64(m.Duration))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgScheduleMaintenanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelScheduledMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovTx(uint64(m.StartTime))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledMaintenanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnjailStorageProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SpAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUnjailStorageProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateStorageProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateStorageProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateStorageProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SealAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovalAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaintenanceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeReadQuota", wireType)
+			}
+			m.FreeReadQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsProof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsProof = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateStorageProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateStorageProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateStorageProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateStorageProviderStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateStorageProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgEditStorageProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1943,15 +3758,15 @@ func (m *MsgCreateStorageProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateStorageProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEditStorageProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateStorageProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEditStorageProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1979,13 +3794,13 @@ func (m *MsgCreateStorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.SpAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1995,30 +3810,29 @@ func (m *MsgCreateStorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2028,27 +3842,31 @@ func (m *MsgCreateStorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpAddress = string(dAtA[iNdEx:postIndex])
+			if m.Description == nil {
+				m.Description = &Description{}
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SealAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2076,11 +3894,11 @@ func (m *MsgCreateStorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FundingAddress = string(dAtA[iNdEx:postIndex])
+			m.SealAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SealAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2108,11 +3926,11 @@ func (m *MsgCreateStorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SealAddress = string(dAtA[iNdEx:postIndex])
+			m.ApprovalAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2140,11 +3958,11 @@ func (m *MsgCreateStorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApprovalAddress = string(dAtA[iNdEx:postIndex])
+			m.GcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2172,11 +3990,11 @@ func (m *MsgCreateStorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GcAddress = string(dAtA[iNdEx:postIndex])
+			m.MaintenanceAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2204,11 +4022,11 @@ func (m *MsgCreateStorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaintenanceAddress = string(dAtA[iNdEx:postIndex])
+			m.BlsKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsProof", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2236,11 +4054,11 @@ func (m *MsgCreateStorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.BlsProof = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2267,11 +4085,166 @@ func (m *MsgCreateStorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Location == nil {
+				m.Location = &SpLocation{}
+			}
+			if err := m.Location.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokeReplacedKeys", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RevokeReplacedKeys = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditStorageProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditStorageProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditStorageProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSpStoragePrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSpStoragePrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSpStoragePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrice", wireType)
 			}
@@ -2305,7 +4278,7 @@ func (m *MsgCreateStorageProvider) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FreeReadQuota", wireType)
 			}
@@ -2324,7 +4297,7 @@ func (m *MsgCreateStorageProvider) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 13:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StorePrice", wireType)
 			}
@@ -2358,11 +4331,11 @@ func (m *MsgCreateStorageProvider) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePriceTiers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2372,29 +4345,31 @@ func (m *MsgCreateStorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlsKey = string(dAtA[iNdEx:postIndex])
+			m.StorePriceTiers = append(m.StorePriceTiers, SpStorePriceTier{})
+			if err := m.StorePriceTiers[len(m.StorePriceTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlsProof", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
 			}
-			var stringLen uint64
+			m.EffectiveTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2404,24 +4379,11 @@ func (m *MsgCreateStorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EffectiveTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlsProof = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2443,7 +4405,7 @@ func (m *MsgCreateStorageProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateStorageProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSpStoragePriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2466,10 +4428,10 @@ func (m *MsgCreateStorageProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateStorageProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSpStoragePriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateStorageProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSpStoragePriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2493,7 +4455,7 @@ func (m *MsgCreateStorageProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSpCapacity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2516,15 +4478,15 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSpCapacity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSpCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2552,13 +4514,13 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.SpAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCapacity", wireType)
 			}
-			var stringLen uint64
+			m.TotalCapacity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2568,29 +4530,16 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TotalCapacity |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SpAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeCapacity", wireType)
 			}
-			var msglen int
+			m.FreeCapacity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2600,25 +4549,30 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FreeCapacity |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGvgFamilies", wireType)
 			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MaxGvgFamilies = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGvgFamilies |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2640,7 +4594,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSpCapacityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2663,10 +4617,10 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSpCapacityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSpCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2690,7 +4644,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditStorageProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2713,15 +4667,15 @@ func (m *MsgEditStorageProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditStorageProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditStorageProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2749,43 +4703,11 @@ func (m *MsgEditStorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2812,112 +4734,113 @@ func (m *MsgEditStorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Description == nil {
-				m.Description = &Description{}
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SealAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SealAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApprovalAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GcAddress", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateStorageProviderStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GcAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateStorageProviderStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateStorageProviderStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2945,13 +4868,13 @@ func (m *MsgEditStorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaintenanceAddress = string(dAtA[iNdEx:postIndex])
+			m.SpAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2961,29 +4884,16 @@ func (m *MsgEditStorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= Status(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlsKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlsProof", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var stringLen uint64
+			m.Duration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2993,24 +4903,11 @@ func (m *MsgEditStorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Duration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlsProof = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3032,7 +4929,7 @@ func (m *MsgEditStorageProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditStorageProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateStorageProviderStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3055,10 +4952,10 @@ func (m *MsgEditStorageProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditStorageProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateStorageProviderStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditStorageProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateStorageProviderStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3082,7 +4979,7 @@ func (m *MsgEditStorageProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSpStoragePrice) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleMaintenance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3105,10 +5002,10 @@ func (m *MsgUpdateSpStoragePrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSpStoragePrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleMaintenance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSpStoragePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3144,10 +5041,10 @@ func (m *MsgUpdateSpStoragePrice) Unmarshal(dAtA []byte) error {
 			m.SpAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrice", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			m.StartTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3157,50 +5054,16 @@ func (m *MsgUpdateSpStoragePrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FreeReadQuota", wireType)
-			}
-			m.FreeReadQuota = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FreeReadQuota |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var stringLen uint64
+			m.Duration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3210,26 +5073,11 @@ func (m *MsgUpdateSpStoragePrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Duration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.StorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3251,7 +5099,7 @@ func (m *MsgUpdateSpStoragePrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSpStoragePriceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleMaintenanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3274,10 +5122,10 @@ func (m *MsgUpdateSpStoragePriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSpStoragePriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleMaintenanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSpStoragePriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleMaintenanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3301,7 +5149,7 @@ func (m *MsgUpdateSpStoragePriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledMaintenance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3324,15 +5172,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledMaintenance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3360,13 +5208,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.SpAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var msglen int
+			m.StartTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3376,25 +5224,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StartTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3416,7 +5250,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledMaintenanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3439,10 +5273,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledMaintenanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledMaintenanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3466,7 +5300,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateStorageProviderStatus) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailStorageProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3489,15 +5323,15 @@ func (m *MsgUpdateStorageProviderStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateStorageProviderStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailStorageProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateStorageProviderStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailStorageProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3525,13 +5359,13 @@ func (m *MsgUpdateStorageProviderStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpAddress = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3541,16 +5375,29 @@ func (m *MsgUpdateStorageProviderStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= Status(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			m.Duration = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3560,11 +5407,25 @@ func (m *MsgUpdateStorageProviderStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Duration |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3586,7 +5447,7 @@ func (m *MsgUpdateStorageProviderStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateStorageProviderStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailStorageProviderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3609,10 +5470,10 @@ func (m *MsgUpdateStorageProviderStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateStorageProviderStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailStorageProviderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateStorageProviderStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailStorageProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
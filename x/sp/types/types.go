@@ -15,6 +15,7 @@ const (
 	MaxWebsiteLength                      = 140
 	MaxDetailsLength                      = 280
 	MaintenanceRecordsGCFrequencyInBlocks = 100
+	MaxScheduledMaintenancesPerSp         = 5
 )
 
 // NewStorageProvider constructs a new StorageProvider
@@ -169,6 +169,8 @@ type StorageProvider struct {
 	Description Description `protobuf:"bytes,11,opt,name=description,proto3" json:"description"`
 	// bls_key defines the bls pub key of the Storage provider for sealing object and completing migration
 	BlsKey []byte `protobuf:"bytes,12,opt,name=bls_key,json=blsKey,proto3" json:"bls_key,omitempty"`
+	// location defines where the storage provider stores the data, used for the placement constraints of buckets
+	Location SpLocation `protobuf:"bytes,13,opt,name=location,proto3" json:"location"`
 }
 
 func (m *StorageProvider) Reset()         { *m = StorageProvider{} }
@@ -281,6 +283,68 @@ func (m *StorageProvider) GetBlsKey() []byte {
 	return nil
 }
 
+func (m *StorageProvider) GetLocation() SpLocation {
+	if m != nil {
+		return m.Location
+	}
+	return SpLocation{}
+}
+
+// SpLocation defines the structured location attributes of a storage provider.
+type SpLocation struct {
+	// region is the code of the region where the data is stored, e.g. eu-west
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	// jurisdiction is the code of the legal jurisdiction which the data is subject to, e.g. DE
+	Jurisdiction string `protobuf:"bytes,2,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+}
+
+func (m *SpLocation) Reset()         { *m = SpLocation{} }
+func (m *SpLocation) String() string { return proto.CompactTextString(m) }
+func (*SpLocation) ProtoMessage()    {}
+func (*SpLocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{2}
+}
+func (m *SpLocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpLocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpLocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpLocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpLocation.Merge(m, src)
+}
+func (m *SpLocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpLocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpLocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpLocation proto.InternalMessageInfo
+
+func (m *SpLocation) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *SpLocation) GetJurisdiction() string {
+	if m != nil {
+		return m.Jurisdiction
+	}
+	return ""
+}
+
 type RewardInfo struct {
 	Address string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Amount  types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
@@ -290,7 +354,7 @@ func (m *RewardInfo) Reset()         { *m = RewardInfo{} }
 func (m *RewardInfo) String() string { return proto.CompactTextString(m) }
 func (*RewardInfo) ProtoMessage()    {}
 func (*RewardInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a9af9b5be8c2eeb, []int{2}
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{3}
 }
 func (m *RewardInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -345,13 +409,15 @@ type SpStoragePrice struct {
 	FreeReadQuota uint64 `protobuf:"varint,4,opt,name=free_read_quota,json=freeReadQuota,proto3" json:"free_read_quota,omitempty"`
 	// store price, in bnb wei per charge byte
 	StorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=store_price,json=storePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"store_price"`
+	// store price tiers, in ascending order of the charge size threshold, the store price is used below the first tier
+	StorePriceTiers []SpStorePriceTier `protobuf:"bytes,6,rep,name=store_price_tiers,json=storePriceTiers,proto3" json:"store_price_tiers"`
 }
 
 func (m *SpStoragePrice) Reset()         { *m = SpStoragePrice{} }
 func (m *SpStoragePrice) String() string { return proto.CompactTextString(m) }
 func (*SpStoragePrice) ProtoMessage()    {}
 func (*SpStoragePrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a9af9b5be8c2eeb, []int{3}
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{4}
 }
 func (m *SpStoragePrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -401,6 +467,111 @@ func (m *SpStoragePrice) GetFreeReadQuota() uint64 {
 	return 0
 }
 
+func (m *SpStoragePrice) GetStorePriceTiers() []SpStorePriceTier {
+	if m != nil {
+		return m.StorePriceTiers
+	}
+	return nil
+}
+
+// SpStorePriceTier is a volume discounted store price of a SP
+type SpStorePriceTier struct {
+	// the tier applies when the total charge size of a bucket reaches the threshold, in byte
+	ChargeSizeThreshold uint64 `protobuf:"varint,1,opt,name=charge_size_threshold,json=chargeSizeThreshold,proto3" json:"charge_size_threshold,omitempty"`
+	// store price, in bnb wei per charge byte
+	StorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=store_price,json=storePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"store_price"`
+}
+
+func (m *SpStorePriceTier) Reset()         { *m = SpStorePriceTier{} }
+func (m *SpStorePriceTier) String() string { return proto.CompactTextString(m) }
+func (*SpStorePriceTier) ProtoMessage()    {}
+func (*SpStorePriceTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{5}
+}
+func (m *SpStorePriceTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpStorePriceTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpStorePriceTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpStorePriceTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpStorePriceTier.Merge(m, src)
+}
+func (m *SpStorePriceTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpStorePriceTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpStorePriceTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpStorePriceTier proto.InternalMessageInfo
+
+func (m *SpStorePriceTier) GetChargeSizeThreshold() uint64 {
+	if m != nil {
+		return m.ChargeSizeThreshold
+	}
+	return 0
+}
+
+// GlobalStorePriceTier is a volume discounted store price for all sps
+type GlobalStorePriceTier struct {
+	// the tier applies when the total charge size of a bucket reaches the threshold, in byte
+	ChargeSizeThreshold uint64 `protobuf:"varint,1,opt,name=charge_size_threshold,json=chargeSizeThreshold,proto3" json:"charge_size_threshold,omitempty"`
+	// primary store price, in bnb wei per charge byte
+	PrimaryStorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=primary_store_price,json=primaryStorePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"primary_store_price"`
+	// secondary store price, in bnb wei per charge byte
+	SecondaryStorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=secondary_store_price,json=secondaryStorePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"secondary_store_price"`
+}
+
+func (m *GlobalStorePriceTier) Reset()         { *m = GlobalStorePriceTier{} }
+func (m *GlobalStorePriceTier) String() string { return proto.CompactTextString(m) }
+func (*GlobalStorePriceTier) ProtoMessage()    {}
+func (*GlobalStorePriceTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{6}
+}
+func (m *GlobalStorePriceTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GlobalStorePriceTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GlobalStorePriceTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GlobalStorePriceTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlobalStorePriceTier.Merge(m, src)
+}
+func (m *GlobalStorePriceTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *GlobalStorePriceTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlobalStorePriceTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GlobalStorePriceTier proto.InternalMessageInfo
+
+func (m *GlobalStorePriceTier) GetChargeSizeThreshold() uint64 {
+	if m != nil {
+		return m.ChargeSizeThreshold
+	}
+	return 0
+}
+
 // global sp store price, the price for all sps
 type GlobalSpStorePrice struct {
 	// update time, unix timestamp in seconds
@@ -411,13 +582,15 @@ type GlobalSpStorePrice struct {
 	PrimaryStorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=primary_store_price,json=primaryStorePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"primary_store_price"`
 	// secondary store price, in bnb wei per charge byte
 	SecondaryStorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=secondary_store_price,json=secondaryStorePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"secondary_store_price"`
+	// store price tiers, in ascending order of the charge size threshold
+	StorePriceTiers []GlobalStorePriceTier `protobuf:"bytes,5,rep,name=store_price_tiers,json=storePriceTiers,proto3" json:"store_price_tiers"`
 }
 
 func (m *GlobalSpStorePrice) Reset()         { *m = GlobalSpStorePrice{} }
 func (m *GlobalSpStorePrice) String() string { return proto.CompactTextString(m) }
 func (*GlobalSpStorePrice) ProtoMessage()    {}
 func (*GlobalSpStorePrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a9af9b5be8c2eeb, []int{4}
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{7}
 }
 func (m *GlobalSpStorePrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,6 +626,13 @@ func (m *GlobalSpStorePrice) GetUpdateTimeSec() int64 {
 	return 0
 }
 
+func (m *GlobalSpStorePrice) GetStorePriceTiers() []GlobalStorePriceTier {
+	if m != nil {
+		return m.StorePriceTiers
+	}
+	return nil
+}
+
 type SpMaintenanceStats struct {
 	Records []*MaintenanceRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
 }
@@ -461,7 +641,7 @@ func (m *SpMaintenanceStats) Reset()         { *m = SpMaintenanceStats{} }
 func (m *SpMaintenanceStats) String() string { return proto.CompactTextString(m) }
 func (*SpMaintenanceStats) ProtoMessage()    {}
 func (*SpMaintenanceStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a9af9b5be8c2eeb, []int{5}
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{8}
 }
 func (m *SpMaintenanceStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -513,7 +693,7 @@ func (m *MaintenanceRecord) Reset()         { *m = MaintenanceRecord{} }
 func (m *MaintenanceRecord) String() string { return proto.CompactTextString(m) }
 func (*MaintenanceRecord) ProtoMessage()    {}
 func (*MaintenanceRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a9af9b5be8c2eeb, []int{6}
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{9}
 }
 func (m *MaintenanceRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -570,411 +750,701 @@ func (m *MaintenanceRecord) GetRequestAt() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("greenfield.sp.Status", Status_name, Status_value)
-	proto.RegisterType((*Description)(nil), "greenfield.sp.Description")
-	proto.RegisterType((*StorageProvider)(nil), "greenfield.sp.StorageProvider")
-	proto.RegisterType((*RewardInfo)(nil), "greenfield.sp.RewardInfo")
-	proto.RegisterType((*SpStoragePrice)(nil), "greenfield.sp.SpStoragePrice")
-	proto.RegisterType((*GlobalSpStorePrice)(nil), "greenfield.sp.GlobalSpStorePrice")
-	proto.RegisterType((*SpMaintenanceStats)(nil), "greenfield.sp.SpMaintenanceStats")
-	proto.RegisterType((*MaintenanceRecord)(nil), "greenfield.sp.MaintenanceRecord")
+// SpScoreStats keeps the counters of the service history of a storage provider, which are used to compute its score
+type SpScoreStats struct {
+	// the number of challenges (including heartbeats) that the sp passed
+	ChallengePassedCount uint64 `protobuf:"varint,1,opt,name=challenge_passed_count,json=challengePassedCount,proto3" json:"challenge_passed_count,omitempty"`
+	// the number of challenges that the sp failed and was slashed for
+	ChallengeSlashedCount uint64 `protobuf:"varint,2,opt,name=challenge_slashed_count,json=challengeSlashedCount,proto3" json:"challenge_slashed_count,omitempty"`
+	// the number of objects sealed by the sp as primary sp
+	SealCount uint64 `protobuf:"varint,3,opt,name=seal_count,json=sealCount,proto3" json:"seal_count,omitempty"`
+	// the number of objects rejected to seal by the sp as primary sp
+	SealRejectionCount uint64 `protobuf:"varint,4,opt,name=seal_rejection_count,json=sealRejectionCount,proto3" json:"seal_rejection_count,omitempty"`
+	// the number of swap-outs requested by the sp
+	SwapOutCount uint64 `protobuf:"varint,5,opt,name=swap_out_count,json=swapOutCount,proto3" json:"swap_out_count,omitempty"`
+	// the number of graceful exits requested by the sp
+	ExitCount uint64 `protobuf:"varint,6,opt,name=exit_count,json=exitCount,proto3" json:"exit_count,omitempty"`
+	// the number of forced exits of the sp by governance
+	ForcedExitCount uint64 `protobuf:"varint,7,opt,name=forced_exit_count,json=forcedExitCount,proto3" json:"forced_exit_count,omitempty"`
 }
 
-func init() { proto.RegisterFile("greenfield/sp/types.proto", fileDescriptor_7a9af9b5be8c2eeb) }
-
-var fileDescriptor_7a9af9b5be8c2eeb = []byte{
-	// 1013 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xc1, 0x6e, 0xdb, 0x46,
-	0x13, 0x16, 0x25, 0x59, 0x8e, 0x47, 0xb6, 0xa5, 0xac, 0xed, 0x3f, 0xb4, 0x7f, 0x54, 0x31, 0x74,
-	0x70, 0xdd, 0x00, 0x96, 0x10, 0xf7, 0x10, 0xa0, 0xed, 0x45, 0x96, 0x14, 0x83, 0x6d, 0xe2, 0xa6,
-	0x94, 0x53, 0x14, 0x2d, 0x0a, 0x62, 0x45, 0x8e, 0xe5, 0x85, 0xa5, 0x5d, 0x66, 0x77, 0xe5, 0x54,
-	0x6f, 0xd0, 0x53, 0xd1, 0x27, 0xe8, 0xa1, 0x7d, 0x82, 0x02, 0x39, 0xf5, 0x09, 0x72, 0x0c, 0x72,
-	0x2a, 0x7a, 0x08, 0x0a, 0xfb, 0x29, 0x7a, 0x2b, 0x48, 0x2e, 0x29, 0xd6, 0x3d, 0x08, 0x05, 0x7c,
-	0x92, 0xe6, 0x9b, 0xf9, 0xbe, 0x1d, 0xce, 0xce, 0x0c, 0x09, 0xdb, 0x23, 0x89, 0xc8, 0xcf, 0x18,
-	0x8e, 0x83, 0xb6, 0x0a, 0xdb, 0x7a, 0x16, 0xa2, 0x6a, 0x85, 0x52, 0x68, 0x41, 0xd6, 0xe6, 0xae,
-	0x96, 0x0a, 0x77, 0x1a, 0xbe, 0x50, 0x13, 0xa1, 0xda, 0x43, 0xaa, 0xb0, 0x7d, 0xf9, 0x70, 0x88,
-	0x9a, 0x3e, 0x6c, 0xfb, 0x82, 0xf1, 0x24, 0x7c, 0x67, 0x3b, 0xf1, 0x7b, 0xb1, 0xd5, 0x4e, 0x0c,
-	0xe3, 0xda, 0x1c, 0x89, 0x91, 0x48, 0xf0, 0xe8, 0x5f, 0x82, 0x36, 0x7f, 0xb6, 0xa0, 0xda, 0x43,
-	0xe5, 0x4b, 0x16, 0x6a, 0x26, 0x38, 0xb1, 0x61, 0x79, 0x22, 0x38, 0xbb, 0x40, 0x69, 0x5b, 0xbb,
-	0xd6, 0xfe, 0x8a, 0x9b, 0x9a, 0x64, 0x07, 0xee, 0xb0, 0x00, 0xb9, 0x66, 0x7a, 0x66, 0x17, 0x63,
-	0x57, 0x66, 0x47, 0xac, 0x97, 0x38, 0x54, 0x4c, 0xa3, 0x5d, 0x4a, 0x58, 0xc6, 0x24, 0x1f, 0x40,
-	0x5d, 0xa1, 0x3f, 0x95, 0x4c, 0xcf, 0x3c, 0x5f, 0x70, 0x4d, 0x7d, 0x6d, 0x97, 0xe3, 0x90, 0x5a,
-	0x8a, 0x77, 0x13, 0x38, 0x12, 0x09, 0x50, 0x53, 0x36, 0x56, 0xf6, 0x52, 0x22, 0x62, 0xcc, 0xe6,
-	0x6f, 0x4b, 0x50, 0x1b, 0x68, 0x21, 0xe9, 0x08, 0x9f, 0x49, 0x71, 0xc9, 0x02, 0x94, 0x64, 0x1d,
-	0x8a, 0x2c, 0x88, 0x73, 0x5c, 0x73, 0x8b, 0x2c, 0x20, 0x5d, 0xa8, 0x8b, 0x10, 0x25, 0xd5, 0x42,
-	0x7a, 0x34, 0x08, 0x24, 0x2a, 0x95, 0xa4, 0x79, 0x64, 0xbf, 0x7d, 0x75, 0xb0, 0x69, 0x4a, 0xd1,
-	0x49, 0x3c, 0x03, 0x2d, 0x19, 0x1f, 0xb9, 0xb5, 0x94, 0x61, 0x60, 0xd2, 0x81, 0xda, 0xd9, 0x94,
-	0x07, 0x8c, 0x8f, 0x32, 0x8d, 0xd2, 0x02, 0x8d, 0x75, 0x43, 0x48, 0x25, 0x3e, 0x86, 0x55, 0x85,
-	0x74, 0x9c, 0xf1, 0xcb, 0x0b, 0xf8, 0xd5, 0x28, 0x3a, 0x25, 0x77, 0xa1, 0x4e, 0xc3, 0x50, 0x8a,
-	0xcb, 0x9c, 0xc0, 0xd2, 0xa2, 0x87, 0x48, 0x19, 0xa9, 0xc8, 0x23, 0x80, 0x91, 0x9f, 0xd1, 0x2b,
-	0x0b, 0xe8, 0x2b, 0x23, 0x3f, 0x25, 0x3a, 0xb0, 0x31, 0xa1, 0x8c, 0x6b, 0xe4, 0x94, 0xfb, 0x98,
-	0x29, 0x2c, 0x2f, 0x50, 0x20, 0x39, 0x52, 0x2a, 0x45, 0x61, 0x4d, 0x0b, 0x4d, 0xc7, 0x5e, 0x80,
-	0xa1, 0x50, 0x4c, 0xdb, 0x77, 0x62, 0x91, 0x4f, 0x5e, 0xbf, 0xbb, 0x5f, 0xf8, 0xe3, 0xdd, 0xfd,
-	0xbd, 0x11, 0xd3, 0xe7, 0xd3, 0x61, 0xcb, 0x17, 0x13, 0xd3, 0xa4, 0xe6, 0xe7, 0x40, 0x05, 0x17,
-	0xa6, 0xff, 0x1d, 0xae, 0xdf, 0xbe, 0x3a, 0x00, 0x73, 0xa4, 0xc3, 0xb5, 0xbb, 0x1a, 0x4b, 0xf6,
-	0x12, 0x45, 0x72, 0x00, 0x15, 0xa5, 0xa9, 0x9e, 0x2a, 0x7b, 0x65, 0xd7, 0xda, 0x5f, 0x3f, 0xdc,
-	0x6a, 0xfd, 0x63, 0x54, 0x5a, 0x83, 0xd8, 0xe9, 0x9a, 0xa0, 0xa8, 0x7d, 0x91, 0x07, 0xa1, 0x60,
-	0x5c, 0xdb, 0x90, 0xb4, 0x6f, 0x6a, 0x93, 0x23, 0xa8, 0x06, 0xf3, 0x19, 0xb0, 0xab, 0xbb, 0xd6,
-	0x7e, 0xf5, 0x70, 0xe7, 0x86, 0x5e, 0x6e, 0x4a, 0x8e, 0xca, 0xd1, 0x73, 0xb8, 0x79, 0x12, 0xb9,
-	0x07, 0xcb, 0xc3, 0xb1, 0xf2, 0x2e, 0x70, 0x66, 0xaf, 0xee, 0x5a, 0xfb, 0xab, 0x6e, 0x65, 0x38,
-	0x56, 0x9f, 0xe1, 0xac, 0x39, 0x03, 0x70, 0xf1, 0x25, 0x95, 0x81, 0xc3, 0xcf, 0x04, 0x39, 0x84,
-	0xe5, 0xb4, 0xae, 0xd6, 0x82, 0xba, 0xa6, 0x81, 0xe4, 0x11, 0x54, 0xe8, 0x44, 0x4c, 0xb9, 0x8e,
-	0x1b, 0xba, 0x7a, 0xb8, 0xdd, 0x32, 0xf1, 0xd1, 0x16, 0x68, 0x99, 0x2d, 0xd0, 0xea, 0x0a, 0x96,
-	0x26, 0x66, 0xc2, 0x9b, 0xbf, 0x16, 0x61, 0x7d, 0x10, 0x66, 0x93, 0xc3, 0x7c, 0x24, 0x1b, 0xb0,
-	0xa4, 0x42, 0x2f, 0x9b, 0x9c, 0xb2, 0x0a, 0x9d, 0x80, 0xec, 0x41, 0x6d, 0x1a, 0x06, 0x54, 0xa3,
-	0xa7, 0xd9, 0x04, 0x3d, 0x85, 0x7e, 0x7c, 0x52, 0xc9, 0x5d, 0x4b, 0xe0, 0x53, 0x36, 0xc1, 0x01,
-	0xfa, 0xe4, 0x1b, 0x00, 0x89, 0x34, 0xf0, 0xc2, 0x48, 0xca, 0x4c, 0xc6, 0x7f, 0xb9, 0xd2, 0x1e,
-	0xfa, 0xb9, 0x2b, 0xed, 0xa1, 0xef, 0xae, 0x44, 0x7a, 0x49, 0x66, 0x7b, 0x50, 0x3b, 0x93, 0x88,
-	0x5e, 0x7c, 0xc2, 0x8b, 0xa9, 0xd0, 0x34, 0x9e, 0x9d, 0xb2, 0xbb, 0x16, 0xc1, 0x2e, 0xd2, 0xe0,
-	0x8b, 0x08, 0x24, 0xdf, 0x42, 0x55, 0x69, 0x21, 0xd1, 0x64, 0xb1, 0x74, 0x0b, 0x59, 0x40, 0x2c,
-	0x18, 0xa7, 0xd1, 0xfc, 0xab, 0x08, 0xe4, 0x78, 0x2c, 0x86, 0x74, 0x9c, 0x54, 0x0e, 0xb3, 0xec,
-	0x6e, 0x96, 0xc8, 0x5a, 0x5c, 0xa2, 0xe2, 0xed, 0x96, 0x68, 0x0c, 0x1b, 0xa1, 0x64, 0x13, 0x2a,
-	0x67, 0x5e, 0xbe, 0x04, 0xb7, 0x71, 0x11, 0x77, 0x8d, 0x70, 0xee, 0x91, 0x43, 0xd8, 0x52, 0xe8,
-	0x0b, 0x1e, 0xdc, 0x3c, 0xaf, 0x7c, 0x0b, 0xe7, 0x6d, 0x64, 0xd2, 0xf3, 0x13, 0x9b, 0xcf, 0x80,
-	0x0c, 0xc2, 0xa7, 0xf3, 0x6d, 0x12, 0x8d, 0xb0, 0x22, 0x1f, 0xc1, 0xb2, 0x44, 0x5f, 0xc8, 0x20,
-	0x1a, 0x99, 0xd2, 0x7e, 0xf5, 0x70, 0xf7, 0xc6, 0x64, 0xe6, 0x18, 0x6e, 0x1c, 0xe8, 0xa6, 0x84,
-	0xe6, 0x4f, 0x16, 0xdc, 0xfd, 0x97, 0x9b, 0xfc, 0x0f, 0x2a, 0xe7, 0xc8, 0x46, 0xe7, 0xda, 0xdc,
-	0xa1, 0xb1, 0xa2, 0x97, 0x95, 0xc4, 0x17, 0x53, 0x54, 0xda, 0x0b, 0xa6, 0x92, 0xc6, 0xcb, 0x20,
-	0x19, 0x84, 0x9a, 0xc1, 0x7b, 0x06, 0x26, 0xef, 0x43, 0x8d, 0xfa, 0x7a, 0x1a, 0x6d, 0xb8, 0x34,
-	0xb2, 0x14, 0x47, 0xae, 0x27, 0x70, 0x16, 0xf8, 0x5e, 0xd4, 0x10, 0x89, 0x26, 0x4d, 0x5e, 0x7d,
-	0xa5, 0xe8, 0x4a, 0x63, 0xa4, 0xa3, 0x1f, 0xfc, 0x60, 0x41, 0x25, 0xd9, 0x54, 0x64, 0x0b, 0xee,
-	0x0e, 0x4e, 0x3b, 0xa7, 0xcf, 0x07, 0x9e, 0x73, 0xe2, 0x0d, 0xfa, 0xee, 0x97, 0x4e, 0xb7, 0x5f,
-	0x2f, 0x90, 0x4d, 0xa8, 0xcf, 0xe1, 0x4f, 0x3b, 0xce, 0x93, 0x7e, 0xaf, 0x6e, 0x91, 0xff, 0xc3,
-	0x3d, 0x83, 0x1e, 0xbb, 0x9d, 0x6e, 0xff, 0xf1, 0xf3, 0x27, 0x5e, 0xff, 0x2b, 0xe7, 0xd4, 0x39,
-	0x39, 0xae, 0x17, 0xc9, 0x36, 0x6c, 0xcd, 0x29, 0x4f, 0x3b, 0xce, 0xc9, 0x69, 0xff, 0xa4, 0x73,
-	0xd2, 0xed, 0xd7, 0x4b, 0x39, 0xd7, 0xe3, 0xcf, 0xdd, 0x6e, 0xbf, 0x97, 0xb1, 0xca, 0x3b, 0xe5,
-	0xef, 0x7f, 0x69, 0x14, 0x8e, 0x7a, 0xaf, 0xaf, 0x1a, 0xd6, 0x9b, 0xab, 0x86, 0xf5, 0xe7, 0x55,
-	0xc3, 0xfa, 0xf1, 0xba, 0x51, 0x78, 0x73, 0xdd, 0x28, 0xfc, 0x7e, 0xdd, 0x28, 0x7c, 0xfd, 0x20,
-	0x77, 0xd1, 0x43, 0x3e, 0x3c, 0xf0, 0xcf, 0x29, 0xe3, 0xed, 0xdc, 0xa7, 0xcb, 0x77, 0xd9, 0xc7,
-	0xcb, 0xb0, 0x12, 0x7f, 0x5d, 0x7c, 0xf8, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xc1, 0x3c,
-	0xf4, 0xda, 0x08, 0x00, 0x00,
+func (m *SpScoreStats) Reset()         { *m = SpScoreStats{} }
+func (m *SpScoreStats) String() string { return proto.CompactTextString(m) }
+func (*SpScoreStats) ProtoMessage()    {}
+func (*SpScoreStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{10}
 }
-
-func (m *Description) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SpScoreStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpScoreStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpScoreStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *SpScoreStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpScoreStats.Merge(m, src)
+}
+func (m *SpScoreStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpScoreStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpScoreStats.DiscardUnknown(m)
 }
 
-func (m *Description) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_SpScoreStats proto.InternalMessageInfo
+
+func (m *SpScoreStats) GetChallengePassedCount() uint64 {
+	if m != nil {
+		return m.ChallengePassedCount
+	}
+	return 0
 }
 
-func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Details) > 0 {
-		i -= len(m.Details)
-		copy(dAtA[i:], m.Details)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Details)))
-		i--
-		dAtA[i] = 0x2a
+func (m *SpScoreStats) GetChallengeSlashedCount() uint64 {
+	if m != nil {
+		return m.ChallengeSlashedCount
 	}
-	if len(m.SecurityContact) > 0 {
-		i -= len(m.SecurityContact)
-		copy(dAtA[i:], m.SecurityContact)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.SecurityContact)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *SpScoreStats) GetSealCount() uint64 {
+	if m != nil {
+		return m.SealCount
 	}
-	if len(m.Website) > 0 {
-		i -= len(m.Website)
-		copy(dAtA[i:], m.Website)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Website)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *SpScoreStats) GetSealRejectionCount() uint64 {
+	if m != nil {
+		return m.SealRejectionCount
 	}
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Identity)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *SpScoreStats) GetSwapOutCount() uint64 {
+	if m != nil {
+		return m.SwapOutCount
 	}
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Moniker)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *SpScoreStats) GetExitCount() uint64 {
+	if m != nil {
+		return m.ExitCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *StorageProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SpScoreStats) GetForcedExitCount() uint64 {
+	if m != nil {
+		return m.ForcedExitCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *StorageProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// StorageProviderScore is the scorecard of a storage provider, all the scores are in basis points, from 0 to 10000
+type StorageProviderScore struct {
+	// id is the identifier of the storage provider
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// stats is the service history of the storage provider
+	Stats SpScoreStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats"`
+	// maintenance_used_duration is the maintenance duration used in the recent maintenance records, in seconds
+	MaintenanceUsedDuration int64 `protobuf:"varint,3,opt,name=maintenance_used_duration,json=maintenanceUsedDuration,proto3" json:"maintenance_used_duration,omitempty"`
+	// challenge_score is the ratio of the passed challenges
+	ChallengeScore uint32 `protobuf:"varint,4,opt,name=challenge_score,json=challengeScore,proto3" json:"challenge_score,omitempty"`
+	// seal_score is the ratio of the sealed objects
+	SealScore uint32 `protobuf:"varint,5,opt,name=seal_score,json=sealScore,proto3" json:"seal_score,omitempty"`
+	// maintenance_score is the ratio of the unused maintenance duration quota
+	MaintenanceScore uint32 `protobuf:"varint,6,opt,name=maintenance_score,json=maintenanceScore,proto3" json:"maintenance_score,omitempty"`
+	// stability_score decreases with the swap-out and exit history
+	StabilityScore uint32 `protobuf:"varint,7,opt,name=stability_score,json=stabilityScore,proto3" json:"stability_score,omitempty"`
+	// score is the weighted sum of all the scores
+	Score uint32 `protobuf:"varint,8,opt,name=score,proto3" json:"score,omitempty"`
 }
 
-func (m *StorageProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BlsKey) > 0 {
-		i -= len(m.BlsKey)
-		copy(dAtA[i:], m.BlsKey)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.BlsKey)))
-		i--
-		dAtA[i] = 0x62
-	}
-	{
-		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+func (m *StorageProviderScore) Reset()         { *m = StorageProviderScore{} }
+func (m *StorageProviderScore) String() string { return proto.CompactTextString(m) }
+func (*StorageProviderScore) ProtoMessage()    {}
+func (*StorageProviderScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{11}
+}
+func (m *StorageProviderScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageProviderScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageProviderScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x5a
-	if len(m.Endpoint) > 0 {
-		i -= len(m.Endpoint)
-		copy(dAtA[i:], m.Endpoint)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Endpoint)))
-		i--
-		dAtA[i] = 0x52
+		return b[:n], nil
 	}
-	if m.Status != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x48
-	}
-	{
-		size := m.TotalDeposit.Size()
-		i -= size
-		if _, err := m.TotalDeposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	if len(m.MaintenanceAddress) > 0 {
-		i -= len(m.MaintenanceAddress)
-		copy(dAtA[i:], m.MaintenanceAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.MaintenanceAddress)))
-		i--
-		dAtA[i] = 0x3a
+}
+func (m *StorageProviderScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageProviderScore.Merge(m, src)
+}
+func (m *StorageProviderScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageProviderScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageProviderScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageProviderScore proto.InternalMessageInfo
+
+func (m *StorageProviderScore) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
 	}
-	if len(m.GcAddress) > 0 {
-		i -= len(m.GcAddress)
-		copy(dAtA[i:], m.GcAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.GcAddress)))
-		i--
-		dAtA[i] = 0x32
+	return 0
+}
+
+func (m *StorageProviderScore) GetStats() SpScoreStats {
+	if m != nil {
+		return m.Stats
 	}
-	if len(m.ApprovalAddress) > 0 {
-		i -= len(m.ApprovalAddress)
-		copy(dAtA[i:], m.ApprovalAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ApprovalAddress)))
-		i--
-		dAtA[i] = 0x2a
+	return SpScoreStats{}
+}
+
+func (m *StorageProviderScore) GetMaintenanceUsedDuration() int64 {
+	if m != nil {
+		return m.MaintenanceUsedDuration
 	}
-	if len(m.SealAddress) > 0 {
-		i -= len(m.SealAddress)
-		copy(dAtA[i:], m.SealAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.SealAddress)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *StorageProviderScore) GetChallengeScore() uint32 {
+	if m != nil {
+		return m.ChallengeScore
 	}
-	if len(m.FundingAddress) > 0 {
-		i -= len(m.FundingAddress)
-		copy(dAtA[i:], m.FundingAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.FundingAddress)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *StorageProviderScore) GetSealScore() uint32 {
+	if m != nil {
+		return m.SealScore
 	}
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *StorageProviderScore) GetMaintenanceScore() uint32 {
+	if m != nil {
+		return m.MaintenanceScore
 	}
-	if m.Id != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *StorageProviderScore) GetStabilityScore() uint32 {
+	if m != nil {
+		return m.StabilityScore
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *RewardInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StorageProviderScore) GetScore() uint32 {
+	if m != nil {
+		return m.Score
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RewardInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SpCapacity is the storage capacity declared by a storage provider.
+// The free capacity is reserved when the storage provider is committed to more storage,
+// e.g. joining a new global virtual group, and released once it is no longer committed to it.
+type SpCapacity struct {
+	// sp id
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// update time, in unix timestamp
+	UpdateTimeSec int64 `protobuf:"varint,2,opt,name=update_time_sec,json=updateTimeSec,proto3" json:"update_time_sec,omitempty"`
+	// total storage capacity, in bytes
+	TotalCapacity uint64 `protobuf:"varint,3,opt,name=total_capacity,json=totalCapacity,proto3" json:"total_capacity,omitempty"`
+	// free storage capacity which is not committed yet, in bytes
+	FreeCapacity uint64 `protobuf:"varint,4,opt,name=free_capacity,json=freeCapacity,proto3" json:"free_capacity,omitempty"`
+	// maximum number of global virtual group families as primary sp, 0 means no limit
+	MaxGvgFamilies uint32 `protobuf:"varint,5,opt,name=max_gvg_families,json=maxGvgFamilies,proto3" json:"max_gvg_families,omitempty"`
 }
 
-func (m *RewardInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *SpCapacity) Reset()         { *m = SpCapacity{} }
+func (m *SpCapacity) String() string { return proto.CompactTextString(m) }
+func (*SpCapacity) ProtoMessage()    {}
+func (*SpCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{12}
+}
+func (m *SpCapacity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpCapacity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *SpCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpCapacity.Merge(m, src)
+}
+func (m *SpCapacity) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpCapacity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpCapacity proto.InternalMessageInfo
+
+func (m *SpCapacity) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *SpStoragePrice) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SpCapacity) GetUpdateTimeSec() int64 {
+	if m != nil {
+		return m.UpdateTimeSec
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SpStoragePrice) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SpCapacity) GetTotalCapacity() uint64 {
+	if m != nil {
+		return m.TotalCapacity
+	}
+	return 0
 }
 
-func (m *SpStoragePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.StorePrice.Size()
-		i -= size
-		if _, err := m.StorePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+func (m *SpCapacity) GetFreeCapacity() uint64 {
+	if m != nil {
+		return m.FreeCapacity
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.FreeReadQuota != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.FreeReadQuota))
-		i--
-		dAtA[i] = 0x20
+	return 0
+}
+
+func (m *SpCapacity) GetMaxGvgFamilies() uint32 {
+	if m != nil {
+		return m.MaxGvgFamilies
 	}
-	{
-		size := m.ReadPrice.Size()
-		i -= size
-		if _, err := m.ReadPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// ScheduledMaintenance is a maintenance window pre-announced by a storage provider
+type ScheduledMaintenance struct {
+	// sp id
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// start of the maintenance window, in unix timestamp
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// duration of the maintenance window, in seconds
+	Duration int64 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (m *ScheduledMaintenance) Reset()         { *m = ScheduledMaintenance{} }
+func (m *ScheduledMaintenance) String() string { return proto.CompactTextString(m) }
+func (*ScheduledMaintenance) ProtoMessage()    {}
+func (*ScheduledMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{13}
+}
+func (m *ScheduledMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledMaintenance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.UpdateTimeSec != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.UpdateTimeSec))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *ScheduledMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledMaintenance.Merge(m, src)
+}
+func (m *ScheduledMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledMaintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledMaintenance proto.InternalMessageInfo
+
+func (m *ScheduledMaintenance) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
 	}
-	if m.SpId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ScheduledMaintenance) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GlobalSpStorePrice) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScheduledMaintenance) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GlobalSpStorePrice) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PendingSpStoragePrice is a storage price announced by a storage provider to take effect at a future time
+type PendingSpStoragePrice struct {
+	// sp id
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// announce time, unix timestamp in seconds
+	AnnounceTime int64 `protobuf:"varint,2,opt,name=announce_time,json=announceTime,proto3" json:"announce_time,omitempty"`
+	// effective time, unix timestamp in seconds
+	EffectiveTime int64 `protobuf:"varint,3,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"`
+	// read price, in bnb wei per charge byte
+	ReadPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=read_price,json=readPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"read_price"`
+	// free read quota, in byte
+	FreeReadQuota uint64 `protobuf:"varint,5,opt,name=free_read_quota,json=freeReadQuota,proto3" json:"free_read_quota,omitempty"`
+	// store price, in bnb wei per charge byte
+	StorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=store_price,json=storePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"store_price"`
+	// store price tiers, in ascending order of the charge size threshold
+	StorePriceTiers []SpStorePriceTier `protobuf:"bytes,7,rep,name=store_price_tiers,json=storePriceTiers,proto3" json:"store_price_tiers"`
 }
 
-func (m *GlobalSpStorePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.SecondaryStorePrice.Size()
-		i -= size
-		if _, err := m.SecondaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.PrimaryStorePrice.Size()
-		i -= size
-		if _, err := m.PrimaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *PendingSpStoragePrice) Reset()         { *m = PendingSpStoragePrice{} }
+func (m *PendingSpStoragePrice) String() string { return proto.CompactTextString(m) }
+func (*PendingSpStoragePrice) ProtoMessage()    {}
+func (*PendingSpStoragePrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{14}
+}
+func (m *PendingSpStoragePrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSpStoragePrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSpStoragePrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.ReadPrice.Size()
-		i -= size
-		if _, err := m.ReadPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *PendingSpStoragePrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSpStoragePrice.Merge(m, src)
+}
+func (m *PendingSpStoragePrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSpStoragePrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSpStoragePrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSpStoragePrice proto.InternalMessageInfo
+
+func (m *PendingSpStoragePrice) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *PendingSpStoragePrice) GetAnnounceTime() int64 {
+	if m != nil {
+		return m.AnnounceTime
+	}
+	return 0
+}
+
+func (m *PendingSpStoragePrice) GetEffectiveTime() int64 {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return 0
+}
+
+func (m *PendingSpStoragePrice) GetFreeReadQuota() uint64 {
+	if m != nil {
+		return m.FreeReadQuota
+	}
+	return 0
+}
+
+func (m *PendingSpStoragePrice) GetStorePriceTiers() []SpStorePriceTier {
+	if m != nil {
+		return m.StorePriceTiers
+	}
+	return nil
+}
+
+// SpKeyRotation records the keys replaced by a storage provider, which are still accepted along with the new keys
+// until their own expiration heights. A key is empty if it is not replaced.
+type SpKeyRotation struct {
+	// sp id
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// the replaced seal address
+	SealAddress string `protobuf:"bytes,2,opt,name=seal_address,json=sealAddress,proto3" json:"seal_address,omitempty"`
+	// the replaced approval address
+	ApprovalAddress string `protobuf:"bytes,3,opt,name=approval_address,json=approvalAddress,proto3" json:"approval_address,omitempty"`
+	// the replaced gc address
+	GcAddress string `protobuf:"bytes,4,opt,name=gc_address,json=gcAddress,proto3" json:"gc_address,omitempty"`
+	// the replaced bls public key
+	BlsKey []byte `protobuf:"bytes,5,opt,name=bls_key,json=blsKey,proto3" json:"bls_key,omitempty"`
+	// the block height from which the replaced seal address is no longer accepted
+	SealAddressExpireHeight int64 `protobuf:"varint,6,opt,name=seal_address_expire_height,json=sealAddressExpireHeight,proto3" json:"seal_address_expire_height,omitempty"`
+	// the block height from which the replaced approval address is no longer accepted
+	ApprovalAddressExpireHeight int64 `protobuf:"varint,7,opt,name=approval_address_expire_height,json=approvalAddressExpireHeight,proto3" json:"approval_address_expire_height,omitempty"`
+	// the block height from which the replaced gc address is no longer accepted
+	GcAddressExpireHeight int64 `protobuf:"varint,8,opt,name=gc_address_expire_height,json=gcAddressExpireHeight,proto3" json:"gc_address_expire_height,omitempty"`
+	// the block height from which the replaced bls public key is no longer accepted
+	BlsKeyExpireHeight int64 `protobuf:"varint,9,opt,name=bls_key_expire_height,json=blsKeyExpireHeight,proto3" json:"bls_key_expire_height,omitempty"`
+}
+
+func (m *SpKeyRotation) Reset()         { *m = SpKeyRotation{} }
+func (m *SpKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*SpKeyRotation) ProtoMessage()    {}
+func (*SpKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{15}
+}
+func (m *SpKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.UpdateTimeSec != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.UpdateTimeSec))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *SpKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpKeyRotation.Merge(m, src)
+}
+func (m *SpKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpKeyRotation proto.InternalMessageInfo
+
+func (m *SpKeyRotation) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *SpMaintenanceStats) Marshal() (dAtA []byte, err error) {
+func (m *SpKeyRotation) GetSealAddress() string {
+	if m != nil {
+		return m.SealAddress
+	}
+	return ""
+}
+
+func (m *SpKeyRotation) GetApprovalAddress() string {
+	if m != nil {
+		return m.ApprovalAddress
+	}
+	return ""
+}
+
+func (m *SpKeyRotation) GetGcAddress() string {
+	if m != nil {
+		return m.GcAddress
+	}
+	return ""
+}
+
+func (m *SpKeyRotation) GetBlsKey() []byte {
+	if m != nil {
+		return m.BlsKey
+	}
+	return nil
+}
+
+func (m *SpKeyRotation) GetSealAddressExpireHeight() int64 {
+	if m != nil {
+		return m.SealAddressExpireHeight
+	}
+	return 0
+}
+
+func (m *SpKeyRotation) GetApprovalAddressExpireHeight() int64 {
+	if m != nil {
+		return m.ApprovalAddressExpireHeight
+	}
+	return 0
+}
+
+func (m *SpKeyRotation) GetGcAddressExpireHeight() int64 {
+	if m != nil {
+		return m.GcAddressExpireHeight
+	}
+	return 0
+}
+
+func (m *SpKeyRotation) GetBlsKeyExpireHeight() int64 {
+	if m != nil {
+		return m.BlsKeyExpireHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("greenfield.sp.Status", Status_name, Status_value)
+	proto.RegisterType((*Description)(nil), "greenfield.sp.Description")
+	proto.RegisterType((*StorageProvider)(nil), "greenfield.sp.StorageProvider")
+	proto.RegisterType((*SpLocation)(nil), "greenfield.sp.SpLocation")
+	proto.RegisterType((*RewardInfo)(nil), "greenfield.sp.RewardInfo")
+	proto.RegisterType((*SpStoragePrice)(nil), "greenfield.sp.SpStoragePrice")
+	proto.RegisterType((*SpStorePriceTier)(nil), "greenfield.sp.SpStorePriceTier")
+	proto.RegisterType((*GlobalStorePriceTier)(nil), "greenfield.sp.GlobalStorePriceTier")
+	proto.RegisterType((*GlobalSpStorePrice)(nil), "greenfield.sp.GlobalSpStorePrice")
+	proto.RegisterType((*SpMaintenanceStats)(nil), "greenfield.sp.SpMaintenanceStats")
+	proto.RegisterType((*MaintenanceRecord)(nil), "greenfield.sp.MaintenanceRecord")
+	proto.RegisterType((*SpScoreStats)(nil), "greenfield.sp.SpScoreStats")
+	proto.RegisterType((*StorageProviderScore)(nil), "greenfield.sp.StorageProviderScore")
+	proto.RegisterType((*SpCapacity)(nil), "greenfield.sp.SpCapacity")
+	proto.RegisterType((*ScheduledMaintenance)(nil), "greenfield.sp.ScheduledMaintenance")
+	proto.RegisterType((*PendingSpStoragePrice)(nil), "greenfield.sp.PendingSpStoragePrice")
+	proto.RegisterType((*SpKeyRotation)(nil), "greenfield.sp.SpKeyRotation")
+}
+
+func init() { proto.RegisterFile("greenfield/sp/types.proto", fileDescriptor_7a9af9b5be8c2eeb) }
+
+var fileDescriptor_7a9af9b5be8c2eeb = []byte{
+	// 1695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0x17, 0xbf, 0xa5, 0x27, 0x7e, 0x69, 0x44, 0xc6, 0x94, 0x8c, 0xd0, 0x02, 0xdd, 0x38, 0xaa,
+	0x0b, 0x49, 0xb5, 0x5a, 0xd4, 0x40, 0xd2, 0x8b, 0x4c, 0xd1, 0x0e, 0x1b, 0x47, 0x71, 0x96, 0x72,
+	0x51, 0xb4, 0x28, 0x16, 0xc3, 0xdd, 0x21, 0x39, 0x31, 0xb9, 0xb3, 0x99, 0x19, 0xca, 0x52, 0xce,
+	0x3d, 0xf4, 0x54, 0xf4, 0x2f, 0xe8, 0xa1, 0x45, 0x2f, 0xbd, 0xf4, 0x92, 0x73, 0x7b, 0xcd, 0xd1,
+	0x08, 0x7a, 0x28, 0x7a, 0x08, 0x0a, 0x1b, 0xe8, 0xbf, 0xd0, 0x6b, 0x31, 0x1f, 0xbb, 0x5c, 0xad,
+	0x15, 0xc8, 0x85, 0x94, 0x9c, 0xa4, 0x79, 0xef, 0xf7, 0xfb, 0xcd, 0xbc, 0x99, 0xf7, 0x76, 0xde,
+	0x10, 0x36, 0xc6, 0x9c, 0x90, 0x60, 0x44, 0xc9, 0xd4, 0xdf, 0x13, 0xe1, 0x9e, 0x3c, 0x0b, 0x89,
+	0xd8, 0x0d, 0x39, 0x93, 0x0c, 0x55, 0x16, 0xae, 0x5d, 0x11, 0x6e, 0xb6, 0x3d, 0x26, 0x66, 0x4c,
+	0xec, 0x0d, 0xb1, 0x20, 0x7b, 0x27, 0xf7, 0x86, 0x44, 0xe2, 0x7b, 0x7b, 0x1e, 0xa3, 0x81, 0x81,
+	0x6f, 0x6e, 0x18, 0xbf, 0xab, 0x47, 0x7b, 0x66, 0x60, 0x5d, 0x8d, 0x31, 0x1b, 0x33, 0x63, 0x57,
+	0xff, 0x19, 0x6b, 0xe7, 0x8f, 0x19, 0x58, 0x3d, 0x24, 0xc2, 0xe3, 0x34, 0x94, 0x94, 0x05, 0xa8,
+	0x05, 0xa5, 0x19, 0x0b, 0xe8, 0x33, 0xc2, 0x5b, 0x99, 0xad, 0xcc, 0xf6, 0x8a, 0x13, 0x0d, 0xd1,
+	0x26, 0x2c, 0x53, 0x9f, 0x04, 0x92, 0xca, 0xb3, 0x56, 0x56, 0xbb, 0xe2, 0xb1, 0x62, 0x3d, 0x27,
+	0x43, 0x41, 0x25, 0x69, 0xe5, 0x0c, 0xcb, 0x0e, 0xd1, 0xf7, 0xa1, 0x2e, 0x88, 0x37, 0xe7, 0x54,
+	0x9e, 0xb9, 0x1e, 0x0b, 0x24, 0xf6, 0x64, 0x2b, 0xaf, 0x21, 0xb5, 0xc8, 0xde, 0x35, 0x66, 0x25,
+	0xe2, 0x13, 0x89, 0xe9, 0x54, 0xb4, 0x0a, 0x46, 0xc4, 0x0e, 0x3b, 0xff, 0x2d, 0x40, 0x6d, 0x20,
+	0x19, 0xc7, 0x63, 0xf2, 0x84, 0xb3, 0x13, 0xea, 0x13, 0x8e, 0xaa, 0x90, 0xa5, 0xbe, 0x5e, 0x63,
+	0xc5, 0xc9, 0x52, 0x1f, 0x75, 0xa1, 0xce, 0x42, 0xc2, 0xb1, 0x64, 0xdc, 0xc5, 0xbe, 0xcf, 0x89,
+	0x10, 0x66, 0x99, 0x0f, 0x5a, 0x5f, 0x7d, 0xb1, 0xd3, 0xb0, 0x5b, 0x71, 0x60, 0x3c, 0x03, 0xc9,
+	0x69, 0x30, 0x76, 0x6a, 0x11, 0xc3, 0x9a, 0xd1, 0x01, 0xd4, 0x46, 0xf3, 0xc0, 0xa7, 0xc1, 0x38,
+	0xd6, 0xc8, 0x5d, 0xa2, 0x51, 0xb5, 0x84, 0x48, 0xe2, 0x7d, 0x28, 0x0b, 0x82, 0xa7, 0x31, 0x3f,
+	0x7f, 0x09, 0x7f, 0x55, 0xa1, 0x23, 0x72, 0x17, 0xea, 0x38, 0x0c, 0x39, 0x3b, 0x49, 0x08, 0x14,
+	0x2e, 0x0b, 0x22, 0x62, 0x44, 0x22, 0xf7, 0x01, 0xc6, 0x5e, 0x4c, 0x2f, 0x5e, 0x42, 0x5f, 0x19,
+	0x7b, 0x11, 0xb1, 0x0f, 0xeb, 0x33, 0x4c, 0x03, 0x49, 0x02, 0x1c, 0x78, 0x24, 0x56, 0x28, 0x5d,
+	0xa2, 0x80, 0x12, 0xa4, 0x48, 0x0a, 0x43, 0x45, 0x32, 0x89, 0xa7, 0xae, 0x4f, 0x42, 0x26, 0xa8,
+	0x6c, 0x2d, 0x6b, 0x91, 0x9f, 0x7e, 0xf9, 0xf5, 0xad, 0xa5, 0x7f, 0x7d, 0x7d, 0xeb, 0xce, 0x98,
+	0xca, 0xc9, 0x7c, 0xb8, 0xeb, 0xb1, 0x99, 0x4d, 0x52, 0xfb, 0x67, 0x47, 0xf8, 0xcf, 0x6c, 0xfe,
+	0xf7, 0x03, 0xf9, 0xd5, 0x17, 0x3b, 0x60, 0xa7, 0xec, 0x07, 0xd2, 0x29, 0x6b, 0xc9, 0x43, 0xa3,
+	0x88, 0x76, 0xa0, 0x28, 0x24, 0x96, 0x73, 0xd1, 0x5a, 0xd9, 0xca, 0x6c, 0x57, 0xf7, 0x9b, 0xbb,
+	0xe7, 0x4a, 0x65, 0x77, 0xa0, 0x9d, 0x8e, 0x05, 0xa9, 0xf4, 0x25, 0x81, 0x1f, 0x32, 0x1a, 0xc8,
+	0x16, 0x98, 0xf4, 0x8d, 0xc6, 0xe8, 0x01, 0xac, 0xfa, 0x8b, 0x1a, 0x68, 0xad, 0x6e, 0x65, 0xb6,
+	0x57, 0xf7, 0x37, 0x53, 0x7a, 0x89, 0x2a, 0x79, 0x90, 0x57, 0x71, 0x38, 0x49, 0x12, 0xba, 0x01,
+	0xa5, 0xe1, 0x54, 0xb8, 0xcf, 0xc8, 0x59, 0xab, 0xbc, 0x95, 0xd9, 0x2e, 0x3b, 0xc5, 0xe1, 0x54,
+	0x7c, 0x48, 0xce, 0xd0, 0xfb, 0xb0, 0x3c, 0x65, 0x1e, 0xd6, 0xca, 0x15, 0xad, 0xbc, 0x91, 0x5e,
+	0x69, 0xf8, 0xd8, 0x02, 0xac, 0x70, 0x4c, 0xe8, 0x7c, 0x00, 0xb0, 0xf0, 0xa2, 0xb7, 0xa0, 0xc8,
+	0xc9, 0x58, 0x09, 0x99, 0xda, 0xb4, 0x23, 0xd4, 0x81, 0xf2, 0xa7, 0x73, 0x4e, 0x85, 0x4f, 0x3d,
+	0x3d, 0x8d, 0x29, 0xcf, 0x73, 0xb6, 0xce, 0x19, 0x80, 0x43, 0x9e, 0x63, 0xee, 0xf7, 0x83, 0x11,
+	0x43, 0xfb, 0x50, 0x8a, 0x8e, 0x37, 0x73, 0xc9, 0xf1, 0x46, 0x40, 0x74, 0x1f, 0x8a, 0x78, 0xc6,
+	0xe6, 0x81, 0xd4, 0xfa, 0x2a, 0x0c, 0x8b, 0x57, 0x1f, 0xa3, 0x5d, 0xfb, 0x31, 0xda, 0xed, 0x32,
+	0x1a, 0x85, 0x61, 0xe1, 0x9d, 0xdf, 0xe4, 0xa0, 0x3a, 0x08, 0xe3, 0x02, 0xa6, 0x1e, 0x41, 0xeb,
+	0x50, 0x10, 0xa1, 0x1b, 0x17, 0x70, 0x5e, 0x84, 0x7d, 0x1f, 0xdd, 0x81, 0xda, 0x3c, 0xf4, 0xb1,
+	0x24, 0xae, 0xa4, 0x33, 0xe2, 0x0a, 0xe2, 0xe9, 0x99, 0x72, 0x4e, 0xc5, 0x98, 0x8f, 0xe9, 0x8c,
+	0x0c, 0x88, 0x87, 0x7e, 0x05, 0xc0, 0x09, 0xf6, 0xdd, 0x50, 0x49, 0xd9, 0x02, 0xfd, 0x7f, 0x32,
+	0xeb, 0x90, 0x78, 0x89, 0xcc, 0x3a, 0x24, 0x9e, 0xb3, 0xa2, 0xf4, 0xcc, 0xca, 0xee, 0x40, 0x6d,
+	0xc4, 0x09, 0x71, 0xf5, 0x0c, 0x9f, 0xcd, 0x99, 0xc4, 0xba, 0x84, 0xf3, 0x4e, 0x45, 0x99, 0x1d,
+	0x82, 0xfd, 0x4f, 0x94, 0x11, 0xfd, 0x1a, 0x56, 0x85, 0x64, 0x9c, 0xd8, 0x55, 0x14, 0xae, 0x61,
+	0x15, 0xa0, 0x05, 0xcd, 0x32, 0x3e, 0x81, 0xb5, 0x84, 0xbc, 0x2b, 0x29, 0xe1, 0xaa, 0x96, 0x73,
+	0xdb, 0xab, 0xfb, 0xb7, 0x5e, 0x4b, 0x9f, 0x41, 0xcc, 0x3b, 0xa6, 0x84, 0xdb, 0xdd, 0xaf, 0x89,
+	0x73, 0x56, 0xd1, 0xf9, 0x73, 0x06, 0xea, 0x69, 0x2c, 0xda, 0x87, 0xa6, 0x37, 0xc1, 0x7c, 0x4c,
+	0x5c, 0x41, 0x3f, 0x27, 0xae, 0x9c, 0x70, 0x22, 0x26, 0x6c, 0x6a, 0x0e, 0x26, 0xef, 0xac, 0x1b,
+	0xe7, 0x80, 0x7e, 0x4e, 0x8e, 0x23, 0x57, 0x3a, 0xf4, 0xec, 0xf5, 0x86, 0xde, 0xf9, 0x4b, 0x16,
+	0x1a, 0x8f, 0xa6, 0x6c, 0x88, 0xa7, 0xd7, 0xb0, 0xd6, 0x29, 0xac, 0x87, 0x9c, 0xce, 0x30, 0x3f,
+	0x73, 0xaf, 0x7b, 0xcd, 0x6b, 0x56, 0x78, 0xb1, 0x4a, 0x14, 0x42, 0x53, 0x10, 0x8f, 0x05, 0x7e,
+	0x7a, 0xbe, 0xeb, 0x48, 0xd2, 0xf5, 0x58, 0x7a, 0x31, 0x63, 0xe7, 0x45, 0x0e, 0x90, 0xdd, 0xac,
+	0xc4, 0xd1, 0x5e, 0x54, 0x4a, 0x99, 0xcb, 0x4b, 0x29, 0x7b, 0xbd, 0xa5, 0xf4, 0x0d, 0x7b, 0x9f,
+	0xfb, 0x8e, 0xf7, 0x3e, 0xff, 0x2d, 0xed, 0x3d, 0x7a, 0x7a, 0x51, 0x8d, 0x16, 0x74, 0x8d, 0xde,
+	0x4e, 0xd5, 0xe8, 0x45, 0xf9, 0xfc, 0x4d, 0x75, 0xfa, 0x04, 0xd0, 0x20, 0xfc, 0x68, 0x71, 0xa7,
+	0xaa, 0x8b, 0x4c, 0xa0, 0xf7, 0xa0, 0xc4, 0x89, 0xc7, 0xb8, 0xaf, 0xbe, 0xd8, 0x6a, 0x8a, 0xad,
+	0xd4, 0x14, 0x09, 0x86, 0xa3, 0x81, 0x4e, 0x44, 0xe8, 0xfc, 0x21, 0x03, 0x6b, 0xaf, 0xb9, 0xd5,
+	0x6d, 0x32, 0x21, 0x74, 0x3c, 0x91, 0x36, 0x35, 0xec, 0x48, 0xb5, 0x6c, 0x9c, 0x7c, 0x36, 0x27,
+	0x42, 0xba, 0xfe, 0x9c, 0xe3, 0xf8, 0x46, 0xc9, 0x39, 0x35, 0x6b, 0x3f, 0xb4, 0x66, 0xf4, 0x2e,
+	0xd4, 0xb0, 0x27, 0xe7, 0xea, 0x9e, 0x8f, 0x90, 0x39, 0x8d, 0xac, 0x1a, 0x73, 0x0c, 0x7c, 0x5b,
+	0xe5, 0x99, 0xd1, 0xc4, 0xa6, 0x01, 0xcc, 0xa9, 0x4c, 0xd1, 0x96, 0x03, 0xd9, 0xf9, 0x5b, 0x16,
+	0xca, 0x83, 0x70, 0xe0, 0x31, 0x6e, 0xa3, 0xfd, 0x31, 0xbc, 0xe5, 0x4d, 0xf0, 0x74, 0x4a, 0x82,
+	0x31, 0x71, 0x43, 0x2c, 0x04, 0xf1, 0x5d, 0x4f, 0xdf, 0x3d, 0xa6, 0xd6, 0x1b, 0xb1, 0xf7, 0x89,
+	0x76, 0x76, 0x95, 0x0f, 0xfd, 0x04, 0x6e, 0x2c, 0x58, 0x62, 0x8a, 0xc5, 0x24, 0xa6, 0x65, 0x35,
+	0xad, 0x19, 0xbb, 0x07, 0xc6, 0x6b, 0x78, 0x6f, 0x03, 0xe8, 0x9e, 0xcd, 0x40, 0x73, 0x1a, 0xba,
+	0xa2, 0x2c, 0xc6, 0xfd, 0x43, 0x68, 0x68, 0x37, 0x27, 0x9f, 0x12, 0x7d, 0x99, 0x5a, 0xa0, 0xb9,
+	0x17, 0x90, 0xf2, 0x39, 0x91, 0xcb, 0x30, 0xbe, 0x07, 0x55, 0xf1, 0x1c, 0x87, 0x2e, 0x9b, 0x4b,
+	0x8b, 0x2d, 0x68, 0x6c, 0x59, 0x59, 0x3f, 0x9e, 0xcb, 0x78, 0x5a, 0x72, 0x4a, 0x23, 0x44, 0xd1,
+	0x4c, 0xab, 0x2c, 0xc6, 0x7d, 0x17, 0xd6, 0x46, 0x8c, 0x7b, 0xc4, 0x77, 0x13, 0xa8, 0x92, 0x46,
+	0xd5, 0x8c, 0xa3, 0x17, 0x61, 0x3b, 0xff, 0xc8, 0x42, 0x23, 0xd5, 0x21, 0xeb, 0xdd, 0xbc, 0xf8,
+	0xa2, 0xbd, 0x0f, 0x05, 0xd5, 0x15, 0x09, 0x7b, 0x91, 0xdf, 0x7c, 0xfd, 0x42, 0x89, 0x4f, 0xc2,
+	0x26, 0xa9, 0xc1, 0xa3, 0xf7, 0x60, 0x23, 0xd9, 0x21, 0xce, 0xd5, 0xb1, 0xa4, 0x4e, 0xfe, 0x46,
+	0x02, 0xf0, 0x54, 0x10, 0x3f, 0x99, 0x2b, 0x89, 0xc3, 0x51, 0x13, 0xe8, 0x0d, 0xac, 0x38, 0xd5,
+	0xc5, 0xa1, 0xe8, 0x25, 0x47, 0xa7, 0x61, 0x30, 0x05, 0x8d, 0xd1, 0xa7, 0x61, 0xdc, 0x3f, 0x80,
+	0xb5, 0xe4, 0x1a, 0x0c, 0xaa, 0xa8, 0x51, 0xf5, 0x84, 0xc3, 0x80, 0xdf, 0x85, 0x9a, 0x90, 0x78,
+	0x48, 0xa7, 0xea, 0xfd, 0x61, 0xa0, 0x25, 0x33, 0x69, 0x6c, 0x36, 0xc0, 0x06, 0x14, 0x8c, 0x7b,
+	0x59, 0xbb, 0xcd, 0xa0, 0xf3, 0xf7, 0x8c, 0xea, 0xbf, 0xba, 0x38, 0xc4, 0x9e, 0x7a, 0xe6, 0x5c,
+	0xa9, 0x6b, 0x79, 0x07, 0xaa, 0xa6, 0x25, 0xf6, 0xac, 0x9c, 0x4d, 0x34, 0xd3, 0x28, 0xc7, 0x73,
+	0xdc, 0x06, 0xdd, 0x68, 0x2c, 0x50, 0x26, 0xcb, 0xca, 0xca, 0x18, 0x83, 0xb6, 0xa1, 0x3e, 0xc3,
+	0xa7, 0xee, 0xf8, 0x64, 0xec, 0x8e, 0xf0, 0x8c, 0x4e, 0x29, 0x11, 0x76, 0xa3, 0xaa, 0x33, 0x7c,
+	0xfa, 0xe8, 0x64, 0xfc, 0xd0, 0x5a, 0x3b, 0x23, 0x68, 0x0c, 0xbc, 0x09, 0xf1, 0xe7, 0x53, 0xe2,
+	0x27, 0x3e, 0x01, 0x17, 0x87, 0xa2, 0x76, 0x5e, 0x62, 0x2e, 0x75, 0x24, 0x36, 0x8a, 0x15, 0x6d,
+	0x51, 0x41, 0xa8, 0x16, 0x3a, 0x75, 0xd8, 0xf1, 0xb8, 0xf3, 0xd7, 0x1c, 0x34, 0x9f, 0x10, 0xfd,
+	0x12, 0x7a, 0x93, 0x56, 0xef, 0x36, 0x54, 0x70, 0x10, 0xb0, 0x79, 0xe0, 0x91, 0xe4, 0x64, 0xe5,
+	0xc8, 0xa8, 0xe7, 0x7b, 0x07, 0xaa, 0x64, 0x34, 0x52, 0x75, 0x75, 0x62, 0x51, 0x66, 0xd6, 0x4a,
+	0x6c, 0xd5, 0xb0, 0xf3, 0x77, 0x58, 0xfe, 0x5b, 0x6f, 0x07, 0x0b, 0x6f, 0xd0, 0x0e, 0x16, 0xbf,
+	0x8b, 0x76, 0xb0, 0x74, 0xa5, 0x76, 0xf0, 0x3f, 0x39, 0xa8, 0x0c, 0xc2, 0x0f, 0xc9, 0x99, 0xc3,
+	0xa4, 0xa9, 0xd0, 0x0b, 0x4f, 0x2a, 0xfd, 0x9e, 0xcd, 0x5e, 0xf5, 0x3d, 0x9b, 0xbb, 0xda, 0x7b,
+	0x36, 0xff, 0xe6, 0xef, 0xd9, 0xc4, 0x93, 0xac, 0x90, 0x7a, 0x92, 0x6d, 0x26, 0x63, 0x72, 0xc9,
+	0x69, 0x48, 0x39, 0x71, 0xed, 0x6d, 0x58, 0x34, 0xdf, 0xb1, 0x44, 0x1c, 0x3d, 0xed, 0xff, 0xc0,
+	0x5c, 0x8f, 0x5d, 0x68, 0xa7, 0x63, 0x4a, 0x09, 0x94, 0xb4, 0xc0, 0xcd, 0x54, 0x1c, 0xe7, 0x44,
+	0xee, 0x43, 0x6b, 0x11, 0x53, 0x8a, 0xbe, 0xac, 0xe9, 0xcd, 0x38, 0x8e, 0x73, 0xc4, 0x7b, 0xd0,
+	0xb4, 0x31, 0xa5, 0x58, 0x2b, 0x9a, 0x85, 0x4c, 0x84, 0x49, 0xca, 0xdd, 0xdf, 0x65, 0xa0, 0x68,
+	0x1e, 0xc3, 0xa8, 0x09, 0x6b, 0x83, 0xe3, 0x83, 0xe3, 0xa7, 0x03, 0xb7, 0x7f, 0xe4, 0x0e, 0x7a,
+	0xce, 0xcf, 0xfb, 0xdd, 0x5e, 0x7d, 0x09, 0x35, 0xa0, 0xbe, 0x30, 0xff, 0xec, 0xa0, 0xff, 0xb8,
+	0x77, 0x58, 0xcf, 0xa0, 0x9b, 0x70, 0xc3, 0x5a, 0x1f, 0x39, 0x07, 0xdd, 0xde, 0xc3, 0xa7, 0x8f,
+	0xdd, 0xde, 0x2f, 0xfa, 0xc7, 0xfd, 0xa3, 0x47, 0xf5, 0x2c, 0xda, 0x80, 0xe6, 0x82, 0xf2, 0xd1,
+	0x41, 0xff, 0xe8, 0xb8, 0x77, 0x74, 0x70, 0xd4, 0xed, 0xd5, 0x73, 0x09, 0xd7, 0xc3, 0x8f, 0x9d,
+	0x6e, 0xef, 0x30, 0x66, 0xe5, 0x37, 0xf3, 0xbf, 0xfd, 0x53, 0x7b, 0xe9, 0xc1, 0xe1, 0x97, 0x2f,
+	0xdb, 0x99, 0x17, 0x2f, 0xdb, 0x99, 0x7f, 0xbf, 0x6c, 0x67, 0x7e, 0xff, 0xaa, 0xbd, 0xf4, 0xe2,
+	0x55, 0x7b, 0xe9, 0x9f, 0xaf, 0xda, 0x4b, 0xbf, 0xbc, 0x9b, 0x28, 0x94, 0x61, 0x30, 0xdc, 0xf1,
+	0x26, 0x98, 0x06, 0x7b, 0x89, 0x5f, 0xc7, 0x4e, 0xe3, 0xdf, 0xc7, 0x86, 0x45, 0xfd, 0x03, 0xd6,
+	0x8f, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x6f, 0x60, 0xe4, 0x3d, 0x13, 0x00, 0x00,
+}
+
+func (m *Description) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -984,34 +1454,55 @@ func (m *SpMaintenanceStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SpMaintenanceStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *Description) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SpMaintenanceStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SecurityContact) > 0 {
+		i -= len(m.SecurityContact)
+		copy(dAtA[i:], m.SecurityContact)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SecurityContact)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MaintenanceRecord) Marshal() (dAtA []byte, err error) {
+func (m *StorageProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1021,228 +1512,2915 @@ func (m *MaintenanceRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MaintenanceRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *StorageProvider) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MaintenanceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StorageProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RequestAt != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.RequestAt))
-		i--
-		dAtA[i] = 0x20
+	{
+		size, err := m.Location.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if m.ActualDuration != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ActualDuration))
+	i--
+	dAtA[i] = 0x6a
+	if len(m.BlsKey) > 0 {
+		i -= len(m.BlsKey)
+		copy(dAtA[i:], m.BlsKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BlsKey)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x62
 	}
-	if m.RequestDuration != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.RequestDuration))
+	{
+		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Endpoint)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x52
 	}
-	if m.Height != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x48
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Description) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.TotalDeposit.Size()
+		i -= size
+		if _, err := m.TotalDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	if len(m.MaintenanceAddress) > 0 {
+		i -= len(m.MaintenanceAddress)
+		copy(dAtA[i:], m.MaintenanceAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MaintenanceAddress)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.GcAddress) > 0 {
+		i -= len(m.GcAddress)
+		copy(dAtA[i:], m.GcAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GcAddress)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.ApprovalAddress) > 0 {
+		i -= len(m.ApprovalAddress)
+		copy(dAtA[i:], m.ApprovalAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ApprovalAddress)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.SecurityContact)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.SealAddress) > 0 {
+		i -= len(m.SealAddress)
+		copy(dAtA[i:], m.SealAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SealAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Details)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.FundingAddress) > 0 {
+		i -= len(m.FundingAddress)
+		copy(dAtA[i:], m.FundingAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FundingAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *StorageProvider) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
 	if m.Id != 0 {
-		n += 1 + sovTypes(uint64(m.Id))
-	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.FundingAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.SealAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.ApprovalAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.GcAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.MaintenanceAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = m.TotalDeposit.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovTypes(uint64(m.Status))
-	}
-	l = len(m.Endpoint)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = m.Description.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.BlsKey)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RewardInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func (m *SpLocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SpStoragePrice) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SpLocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpLocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SpId != 0 {
-		n += 1 + sovTypes(uint64(m.SpId))
-	}
-	if m.UpdateTimeSec != 0 {
-		n += 1 + sovTypes(uint64(m.UpdateTimeSec))
+	if len(m.Jurisdiction) > 0 {
+		i -= len(m.Jurisdiction)
+		copy(dAtA[i:], m.Jurisdiction)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Jurisdiction)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.ReadPrice.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.FreeReadQuota != 0 {
-		n += 1 + sovTypes(uint64(m.FreeReadQuota))
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Region)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.StorePrice.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GlobalSpStorePrice) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.UpdateTimeSec != 0 {
-		n += 1 + sovTypes(uint64(m.UpdateTimeSec))
+func (m *RewardInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.ReadPrice.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.PrimaryStorePrice.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.SecondaryStorePrice.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SpMaintenanceStats) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RewardInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MaintenanceRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SpStoragePrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SpStoragePrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpStoragePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovTypes(uint64(m.Height))
-	}
-	if m.RequestDuration != 0 {
-		n += 1 + sovTypes(uint64(m.RequestDuration))
-	}
-	if m.ActualDuration != 0 {
+	if len(m.StorePriceTiers) > 0 {
+		for iNdEx := len(m.StorePriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorePriceTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.StorePrice.Size()
+		i -= size
+		if _, err := m.StorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.FreeReadQuota != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FreeReadQuota))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ReadPrice.Size()
+		i -= size
+		if _, err := m.ReadPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.UpdateTimeSec != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UpdateTimeSec))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpStorePriceTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpStorePriceTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpStorePriceTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.StorePrice.Size()
+		i -= size
+		if _, err := m.StorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ChargeSizeThreshold != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChargeSizeThreshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GlobalStorePriceTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobalStorePriceTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalStorePriceTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SecondaryStorePrice.Size()
+		i -= size
+		if _, err := m.SecondaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PrimaryStorePrice.Size()
+		i -= size
+		if _, err := m.PrimaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ChargeSizeThreshold != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChargeSizeThreshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GlobalSpStorePrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobalSpStorePrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalSpStorePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorePriceTiers) > 0 {
+		for iNdEx := len(m.StorePriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorePriceTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.SecondaryStorePrice.Size()
+		i -= size
+		if _, err := m.SecondaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PrimaryStorePrice.Size()
+		i -= size
+		if _, err := m.PrimaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ReadPrice.Size()
+		i -= size
+		if _, err := m.ReadPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.UpdateTimeSec != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UpdateTimeSec))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpMaintenanceStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpMaintenanceStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpMaintenanceStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaintenanceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequestAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RequestAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ActualDuration != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ActualDuration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RequestDuration != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RequestDuration))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpScoreStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpScoreStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpScoreStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForcedExitCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ForcedExitCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExitCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExitCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SwapOutCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SwapOutCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SealRejectionCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SealRejectionCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SealCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SealCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChallengeSlashedCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChallengeSlashedCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChallengePassedCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChallengePassedCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageProviderScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageProviderScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageProviderScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Score != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.StabilityScore != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StabilityScore))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaintenanceScore != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaintenanceScore))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SealScore != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SealScore))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ChallengeScore != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChallengeScore))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaintenanceUsedDuration != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaintenanceUsedDuration))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpCapacity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGvgFamilies != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxGvgFamilies))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FreeCapacity != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FreeCapacity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalCapacity != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalCapacity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UpdateTimeSec != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UpdateTimeSec))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledMaintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledMaintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingSpStoragePrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSpStoragePrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSpStoragePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorePriceTiers) > 0 {
+		for iNdEx := len(m.StorePriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorePriceTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.StorePrice.Size()
+		i -= size
+		if _, err := m.StorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.FreeReadQuota != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FreeReadQuota))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.ReadPrice.Size()
+		i -= size
+		if _, err := m.ReadPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.EffectiveTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EffectiveTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AnnounceTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AnnounceTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlsKeyExpireHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlsKeyExpireHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.GcAddressExpireHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GcAddressExpireHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ApprovalAddressExpireHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ApprovalAddressExpireHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SealAddressExpireHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SealAddressExpireHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.BlsKey) > 0 {
+		i -= len(m.BlsKey)
+		copy(dAtA[i:], m.BlsKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BlsKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.GcAddress) > 0 {
+		i -= len(m.GcAddress)
+		copy(dAtA[i:], m.GcAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GcAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ApprovalAddress) > 0 {
+		i -= len(m.ApprovalAddress)
+		copy(dAtA[i:], m.ApprovalAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ApprovalAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SealAddress) > 0 {
+		i -= len(m.SealAddress)
+		copy(dAtA[i:], m.SealAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SealAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Description) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *StorageProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.FundingAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.SealAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ApprovalAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.GcAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.MaintenanceAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.TotalDeposit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Description.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.BlsKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Location.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *SpLocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Jurisdiction)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *RewardInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *SpStoragePrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	if m.UpdateTimeSec != 0 {
+		n += 1 + sovTypes(uint64(m.UpdateTimeSec))
+	}
+	l = m.ReadPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.FreeReadQuota != 0 {
+		n += 1 + sovTypes(uint64(m.FreeReadQuota))
+	}
+	l = m.StorePrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.StorePriceTiers) > 0 {
+		for _, e := range m.StorePriceTiers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpStorePriceTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChargeSizeThreshold != 0 {
+		n += 1 + sovTypes(uint64(m.ChargeSizeThreshold))
+	}
+	l = m.StorePrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *GlobalStorePriceTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChargeSizeThreshold != 0 {
+		n += 1 + sovTypes(uint64(m.ChargeSizeThreshold))
+	}
+	l = m.PrimaryStorePrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.SecondaryStorePrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *GlobalSpStorePrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateTimeSec != 0 {
+		n += 1 + sovTypes(uint64(m.UpdateTimeSec))
+	}
+	l = m.ReadPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.PrimaryStorePrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.SecondaryStorePrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.StorePriceTiers) > 0 {
+		for _, e := range m.StorePriceTiers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpMaintenanceStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MaintenanceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.RequestDuration != 0 {
+		n += 1 + sovTypes(uint64(m.RequestDuration))
+	}
+	if m.ActualDuration != 0 {
 		n += 1 + sovTypes(uint64(m.ActualDuration))
 	}
-	if m.RequestAt != 0 {
-		n += 1 + sovTypes(uint64(m.RequestAt))
+	if m.RequestAt != 0 {
+		n += 1 + sovTypes(uint64(m.RequestAt))
+	}
+	return n
+}
+
+func (m *SpScoreStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengePassedCount != 0 {
+		n += 1 + sovTypes(uint64(m.ChallengePassedCount))
+	}
+	if m.ChallengeSlashedCount != 0 {
+		n += 1 + sovTypes(uint64(m.ChallengeSlashedCount))
+	}
+	if m.SealCount != 0 {
+		n += 1 + sovTypes(uint64(m.SealCount))
+	}
+	if m.SealRejectionCount != 0 {
+		n += 1 + sovTypes(uint64(m.SealRejectionCount))
+	}
+	if m.SwapOutCount != 0 {
+		n += 1 + sovTypes(uint64(m.SwapOutCount))
+	}
+	if m.ExitCount != 0 {
+		n += 1 + sovTypes(uint64(m.ExitCount))
+	}
+	if m.ForcedExitCount != 0 {
+		n += 1 + sovTypes(uint64(m.ForcedExitCount))
+	}
+	return n
+}
+
+func (m *StorageProviderScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	l = m.Stats.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.MaintenanceUsedDuration != 0 {
+		n += 1 + sovTypes(uint64(m.MaintenanceUsedDuration))
+	}
+	if m.ChallengeScore != 0 {
+		n += 1 + sovTypes(uint64(m.ChallengeScore))
+	}
+	if m.SealScore != 0 {
+		n += 1 + sovTypes(uint64(m.SealScore))
+	}
+	if m.MaintenanceScore != 0 {
+		n += 1 + sovTypes(uint64(m.MaintenanceScore))
+	}
+	if m.StabilityScore != 0 {
+		n += 1 + sovTypes(uint64(m.StabilityScore))
+	}
+	if m.Score != 0 {
+		n += 1 + sovTypes(uint64(m.Score))
+	}
+	return n
+}
+
+func (m *SpCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	if m.UpdateTimeSec != 0 {
+		n += 1 + sovTypes(uint64(m.UpdateTimeSec))
+	}
+	if m.TotalCapacity != 0 {
+		n += 1 + sovTypes(uint64(m.TotalCapacity))
+	}
+	if m.FreeCapacity != 0 {
+		n += 1 + sovTypes(uint64(m.FreeCapacity))
+	}
+	if m.MaxGvgFamilies != 0 {
+		n += 1 + sovTypes(uint64(m.MaxGvgFamilies))
+	}
+	return n
+}
+
+func (m *ScheduledMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovTypes(uint64(m.StartTime))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTypes(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *PendingSpStoragePrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	if m.AnnounceTime != 0 {
+		n += 1 + sovTypes(uint64(m.AnnounceTime))
+	}
+	if m.EffectiveTime != 0 {
+		n += 1 + sovTypes(uint64(m.EffectiveTime))
+	}
+	l = m.ReadPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.FreeReadQuota != 0 {
+		n += 1 + sovTypes(uint64(m.FreeReadQuota))
+	}
+	l = m.StorePrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.StorePriceTiers) > 0 {
+		for _, e := range m.StorePriceTiers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	l = len(m.SealAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ApprovalAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.GcAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.BlsKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SealAddressExpireHeight != 0 {
+		n += 1 + sovTypes(uint64(m.SealAddressExpireHeight))
+	}
+	if m.ApprovalAddressExpireHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ApprovalAddressExpireHeight))
+	}
+	if m.GcAddressExpireHeight != 0 {
+		n += 1 + sovTypes(uint64(m.GcAddressExpireHeight))
+	}
+	if m.BlsKeyExpireHeight != 0 {
+		n += 1 + sovTypes(uint64(m.BlsKeyExpireHeight))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Description) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SealAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovalAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaintenanceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsKey = append(m.BlsKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsKey == nil {
+				m.BlsKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Location.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpLocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpLocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpLocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpStoragePrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpStoragePrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpStoragePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimeSec", wireType)
+			}
+			m.UpdateTimeSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateTimeSec |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeReadQuota", wireType)
+			}
+			m.FreeReadQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePriceTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorePriceTiers = append(m.StorePriceTiers, SpStorePriceTier{})
+			if err := m.StorePriceTiers[len(m.StorePriceTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpStorePriceTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpStorePriceTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpStorePriceTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargeSizeThreshold", wireType)
+			}
+			m.ChargeSizeThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargeSizeThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GlobalStorePriceTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalStorePriceTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalStorePriceTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargeSizeThreshold", wireType)
+			}
+			m.ChargeSizeThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargeSizeThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryStorePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryStorePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GlobalSpStorePrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalSpStorePrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalSpStorePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimeSec", wireType)
+			}
+			m.UpdateTimeSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateTimeSec |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryStorePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryStorePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePriceTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorePriceTiers = append(m.StorePriceTiers, GlobalStorePriceTier{})
+			if err := m.StorePriceTiers[len(m.StorePriceTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *SpMaintenanceStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpMaintenanceStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpMaintenanceStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &MaintenanceRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Description) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1265,17 +4443,17 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1285,29 +4463,54 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestDuration", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			m.RequestDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualDuration", wireType)
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			m.ActualDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActualDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestAt", wireType)
+			}
+			m.RequestAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1317,29 +4520,66 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RequestAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpScoreStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpScoreStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpScoreStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengePassedCount", wireType)
+			}
+			m.ChallengePassedCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1349,29 +4589,35 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChallengePassedCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeSlashedCount", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ChallengeSlashedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeSlashedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealCount", wireType)
 			}
-			var stringLen uint64
+			m.SealCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1381,29 +4627,35 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SealCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealRejectionCount", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SealRejectionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SealRejectionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.SecurityContact = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapOutCount", wireType)
 			}
-			var stringLen uint64
+			m.SwapOutCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1413,24 +4665,49 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SwapOutCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCount", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			m.ExitCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForcedExitCount", wireType)
+			}
+			m.ForcedExitCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForcedExitCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Details = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1452,7 +4729,7 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StorageProvider) Unmarshal(dAtA []byte) error {
+func (m *StorageProviderScore) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1475,17 +4752,17 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StorageProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: StorageProviderScore: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StorageProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StorageProviderScore: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
 			}
-			m.Id = 0
+			m.SpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1495,16 +4772,16 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				m.SpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1514,29 +4791,30 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceUsedDuration", wireType)
 			}
-			var stringLen uint64
+			m.MaintenanceUsedDuration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1546,29 +4824,16 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaintenanceUsedDuration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FundingAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SealAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeScore", wireType)
 			}
-			var stringLen uint64
+			m.ChallengeScore = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1578,29 +4843,16 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChallengeScore |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SealAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealScore", wireType)
 			}
-			var stringLen uint64
+			m.SealScore = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1610,29 +4862,16 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SealScore |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ApprovalAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GcAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceScore", wireType)
 			}
-			var stringLen uint64
+			m.MaintenanceScore = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1642,29 +4881,16 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaintenanceScore |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GcAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilityScore", wireType)
 			}
-			var stringLen uint64
+			m.StabilityScore = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1674,29 +4900,16 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StabilityScore |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MaintenanceAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
-			var stringLen uint64
+			m.Score = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1706,31 +4919,66 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Score |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpCapacity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-			iNdEx = postIndex
-		case 9:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpCapacity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
 			}
-			m.Status = 0
+			m.SpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1740,16 +4988,16 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= Status(b&0x7F) << shift
+				m.SpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimeSec", wireType)
 			}
-			var stringLen uint64
+			m.UpdateTimeSec = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1759,29 +5007,16 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UpdateTimeSec |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCapacity", wireType)
 			}
-			var msglen int
+			m.TotalCapacity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1791,30 +5026,16 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TotalCapacity |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeCapacity", wireType)
 			}
-			var byteLen int
+			m.FreeCapacity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1824,26 +5045,30 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.FreeCapacity |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGvgFamilies", wireType)
 			}
-			m.BlsKey = append(m.BlsKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlsKey == nil {
-				m.BlsKey = []byte{}
+			m.MaxGvgFamilies = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGvgFamilies |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1865,7 +5090,7 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardInfo) Unmarshal(dAtA []byte) error {
+func (m *ScheduledMaintenance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1888,17 +5113,17 @@ func (m *RewardInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledMaintenance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
 			}
-			var stringLen uint64
+			m.SpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1908,29 +5133,16 @@ func (m *RewardInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				}
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var msglen int
+			m.StartTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1940,25 +5152,30 @@ func (m *RewardInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StartTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1980,7 +5197,7 @@ func (m *RewardInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpStoragePrice) Unmarshal(dAtA []byte) error {
+func (m *PendingSpStoragePrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2003,10 +5220,10 @@ func (m *SpStoragePrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpStoragePrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingSpStoragePrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpStoragePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingSpStoragePrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2030,9 +5247,9 @@ func (m *SpStoragePrice) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimeSec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnounceTime", wireType)
 			}
-			m.UpdateTimeSec = 0
+			m.AnnounceTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2042,12 +5259,31 @@ func (m *SpStoragePrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdateTimeSec |= int64(b&0x7F) << shift
+				m.AnnounceTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			m.EffectiveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrice", wireType)
 			}
@@ -2081,7 +5317,7 @@ func (m *SpStoragePrice) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FreeReadQuota", wireType)
 			}
@@ -2100,7 +5336,7 @@ func (m *SpStoragePrice) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StorePrice", wireType)
 			}
@@ -2134,6 +5370,40 @@ func (m *SpStoragePrice) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePriceTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorePriceTiers = append(m.StorePriceTiers, SpStorePriceTier{})
+			if err := m.StorePriceTiers[len(m.StorePriceTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2155,7 +5425,7 @@ func (m *SpStoragePrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GlobalSpStorePrice) Unmarshal(dAtA []byte) error {
+func (m *SpKeyRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2178,17 +5448,17 @@ func (m *GlobalSpStorePrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GlobalSpStorePrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpKeyRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobalSpStorePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimeSec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
 			}
-			m.UpdateTimeSec = 0
+			m.SpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2198,14 +5468,14 @@ func (m *GlobalSpStorePrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdateTimeSec |= int64(b&0x7F) << shift
+				m.SpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SealAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2233,13 +5503,11 @@ func (m *GlobalSpStorePrice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SealAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryStorePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2267,13 +5535,11 @@ func (m *GlobalSpStorePrice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ApprovalAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryStorePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2301,65 +5567,13 @@ func (m *GlobalSpStorePrice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SpMaintenanceStats) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SpMaintenanceStats: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpMaintenanceStats: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2369,81 +5583,31 @@ func (m *SpMaintenanceStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &MaintenanceRecord{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BlsKey = append(m.BlsKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsKey == nil {
+				m.BlsKey = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MaintenanceRecord) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MaintenanceRecord: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaintenanceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SealAddressExpireHeight", wireType)
 			}
-			m.Height = 0
+			m.SealAddressExpireHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2453,16 +5617,16 @@ func (m *MaintenanceRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.SealAddressExpireHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalAddressExpireHeight", wireType)
 			}
-			m.RequestDuration = 0
+			m.ApprovalAddressExpireHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2472,16 +5636,16 @@ func (m *MaintenanceRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestDuration |= int64(b&0x7F) << shift
+				m.ApprovalAddressExpireHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GcAddressExpireHeight", wireType)
 			}
-			m.ActualDuration = 0
+			m.GcAddressExpireHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2491,16 +5655,16 @@ func (m *MaintenanceRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActualDuration |= int64(b&0x7F) << shift
+				m.GcAddressExpireHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsKeyExpireHeight", wireType)
 			}
-			m.RequestAt = 0
+			m.BlsKeyExpireHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2510,7 +5674,7 @@ func (m *MaintenanceRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestAt |= int64(b&0x7F) << shift
+				m.BlsKeyExpireHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -31,6 +31,12 @@ const (
 	FlagGroupName            = "group-name"
 	FlagExtra                = "extra"
 	FlagTags                 = "tags"
+	FlagAllowedRegions       = "allowed-regions"
+	FlagAllowedJurisdictions = "allowed-jurisdictions"
+	FlagECProfile            = "ec-profile"
+	FlagRedundancyIndex      = "redundancy-index"
+	FlagProof                = "proof"
+	FlagSrcChainId           = "src-chain-id"
 )
 
 func GetVisibilityType(str string) (storagetypes.VisibilityType, error) {
@@ -2,7 +2,10 @@ package cli
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"os"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -38,6 +41,11 @@ func GetQueryCmd() *cobra.Command {
 		CmdHeadGroupMember(),
 		CmdQueryAccountPolicy(),
 		CmdQueryGroupPolicy(),
+		CmdEstimateCost(),
+		CmdListBucketsByGlobalVirtualGroupFamily(),
+		CmdListObjectsByGlobalVirtualGroup(),
+		CmdVerifyPieceIntegrity(),
+		CmdCrossChainPackage(),
 	)
 
 	return storageQueryCmd
@@ -175,6 +183,90 @@ func CmdListObjects() *cobra.Command {
 	return cmd
 }
 
+func CmdListBucketsByGlobalVirtualGroupFamily() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-buckets-by-gvg-family [gvg-family-id]",
+		Short: "Query list buckets served by the global virtual group family",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			familyID, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryListBucketsByGlobalVirtualGroupFamilyRequest{
+				GlobalVirtualGroupFamilyId: uint32(familyID),
+				Pagination:                 pageReq,
+			}
+
+			res, err := queryClient.ListBucketsByGlobalVirtualGroupFamily(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdListObjectsByGlobalVirtualGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-objects-by-gvg [gvg-id]",
+		Short: "Query list objects stored on the global virtual group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			gvgID, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryListObjectsByGlobalVirtualGroupRequest{
+				GlobalVirtualGroupId: uint32(gvgID),
+				Pagination:           pageReq,
+			}
+
+			res, err := queryClient.ListObjectsByGlobalVirtualGroup(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
 func CmdVerifyPermission() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "verify-permission [operator] [bucket-name] [object-name] [action-type]",
@@ -414,3 +506,196 @@ Examples:
 
 	return cmd
 }
+
+func CmdEstimateCost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-cost [primary-sp-address] [object-sizes]",
+		Short: "Estimate the flow rate and the required balances of a bucket with the given objects",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Estimate the flow rate, the monthly cost, the lock fee and the buffer balance of a bucket
+with the given comma separated object sizes
+
+Examples:
+ $ %s query %s estimate-cost 0x... 1024,2048 --charged-read-quota 1000 --ec-profile 6+3
+	`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var objectSizes []uint64
+			for _, sizeStr := range strings.Split(args[1], ",") {
+				size, err := strconv.ParseUint(strings.TrimSpace(sizeStr), 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid object size %s: %w", sizeStr, err)
+				}
+				objectSizes = append(objectSizes, size)
+			}
+
+			chargedReadQuota, err := cmd.Flags().GetUint64(FlagChargedReadQuota)
+			if err != nil {
+				return err
+			}
+			ecProfile := &types.ECProfile{}
+			if ecProfileStr, _ := cmd.Flags().GetString(FlagECProfile); ecProfileStr != "" {
+				ecProfile, err = types.ParseECProfile(ecProfileStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			params := &types.QueryEstimateCostRequest{
+				PrimarySpAddress:        args[0],
+				ObjectSizes:             objectSizes,
+				ChargedReadQuota:        chargedReadQuota,
+				RedundantDataChunkNum:   ecProfile.DataChunkNum,
+				RedundantParityChunkNum: ecProfile.ParityChunkNum,
+			}
+			res, err := queryClient.EstimateCost(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagChargedReadQuota, 0, "The charged read quota of the bucket")
+	cmd.Flags().String(FlagECProfile, "", "The EC redundancy profile of the bucket in the format of data+parity, e.g. 6+3. The default profile is used if not set")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdVerifyPieceIntegrity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-piece-integrity [object-id] [piece-index] [piece-file]",
+		Short: "Verify a piece of the object against the integrity hash of the replica stored on-chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Verify a piece of the object against the integrity hash of the replica stored on-chain.
+The proof is the comma seperated hex encoded hashes of the other pieces of the replica, in the order of the pieces.
+
+Example:
+$ %s query %s verify-piece-integrity 1 0 ./piece0 --redundancy-index 0 --proof [hash1],[hash2]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			pieceIndex, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+			pieceData, err := os.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+			redundancyIndex, err := cmd.Flags().GetInt32(FlagRedundancyIndex)
+			if err != nil {
+				return err
+			}
+			proofStr, err := cmd.Flags().GetString(FlagProof)
+			if err != nil {
+				return err
+			}
+			proof := make([][]byte, 0)
+			if proofStr != "" {
+				for _, split := range strings.Split(proofStr, ",") {
+					hash, err := hex.DecodeString(split)
+					if err != nil {
+						return fmt.Errorf("proof %s not comma seperated hex encoded bytes", proofStr)
+					}
+					proof = append(proof, hash)
+				}
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryVerifyPieceIntegrityRequest{
+				ObjectId:        args[0],
+				RedundancyIndex: redundancyIndex,
+				PieceIndex:      uint32(pieceIndex),
+				PieceData:       pieceData,
+				Proof:           proof,
+			}
+
+			res, err := queryClient.VerifyPieceIntegrity(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int32(FlagRedundancyIndex, -1, "The redundancy index of the replica, -1 stands for the replica of the primary sp")
+	cmd.Flags().String(FlagProof, "", "The comma seperated hex encoded hashes of the other pieces of the replica")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCrossChainPackage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cross-chain-package [channel-id] [sequence]",
+		Short: "Query a cross-chain package received by the storage channels, decoded with the result of its execution",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a cross-chain package received by the storage channels, decoded with the result of its execution.
+The package is the one received with the sequence from the source chain, which is bsc if it is not set.
+
+Example:
+$ %s query %s cross-chain-package 4 100 --src-chain-id 56
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			channelId, err := strconv.ParseUint(args[0], 10, 8)
+			if err != nil {
+				return err
+			}
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			srcChainId, err := cmd.Flags().GetUint32(FlagSrcChainId)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryCrossChainPackageRequest{
+				SrcChainId: srcChainId,
+				ChannelId:  uint32(channelId),
+				Sequence:   sequence,
+			}
+
+			res, err := queryClient.CrossChainPackage(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagSrcChainId, 0, "The id of the chain the package is sent from, bsc if it is not set")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
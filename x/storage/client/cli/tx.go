@@ -136,6 +136,19 @@ func CmdCreateBucket() *cobra.Command {
 				approveSignatureBytes,
 				chargedReadQuota,
 			)
+
+			allowedRegions, _ := cmd.Flags().GetStringSlice(FlagAllowedRegions)
+			allowedJurisdictions, _ := cmd.Flags().GetStringSlice(FlagAllowedJurisdictions)
+			msgCreateBucket.AllowedRegions = allowedRegions
+			msgCreateBucket.AllowedJurisdictions = allowedJurisdictions
+			if ecProfileStr, _ := cmd.Flags().GetString(FlagECProfile); ecProfileStr != "" {
+				ecProfile, err := types.ParseECProfile(ecProfileStr)
+				if err != nil {
+					return err
+				}
+				msgCreateBucket.RedundantDataChunkNum = ecProfile.DataChunkNum
+				msgCreateBucket.RedundantParityChunkNum = ecProfile.ParityChunkNum
+			}
 			if err := msgCreateBucket.ValidateBasic(); err != nil {
 				return err
 			}
@@ -158,6 +171,9 @@ func CmdCreateBucket() *cobra.Command {
 	cmd.Flags().String(FlagPaymentAccount, "", "The address of the account used to pay for the read fee. The default is the sender account.")
 	cmd.Flags().String(FlagPrimarySP, "", "The operator account address of primarySp")
 	cmd.Flags().String(FlagTags, "", "The tags of the resource. It should be like: `key1=value1,key2=value2`")
+	cmd.Flags().StringSlice(FlagAllowedRegions, []string{}, "The region codes the storage providers of the bucket must be located in, e.g. eu-west,eu-central")
+	cmd.Flags().StringSlice(FlagAllowedJurisdictions, []string{}, "The jurisdiction codes the storage providers of the bucket must be subject to, e.g. DE,FR")
+	cmd.Flags().String(FlagECProfile, "", "The EC redundancy profile of the bucket in the format of data+parity, e.g. 6+3. The default profile is used if not set")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
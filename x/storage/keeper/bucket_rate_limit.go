@@ -44,6 +44,7 @@ func (k Keeper) SetBucketFlowRateLimit(ctx sdk.Context, operator sdk.AccAddress,
 
 func (k Keeper) unChargeBucketReadStoreFee(ctx sdk.Context, bucketInfo *types.BucketInfo,
 	internalBucketInfo *types.InternalBucketInfo) error {
+	ctx = paymenttypes.WithStreamRecordChangeReason(ctx, paymenttypes.STREAM_RECORD_CHANGE_REASON_BUCKET_FLOW_CHANGE, bucketInfo.BucketName)
 	bill, err := k.GetBucketReadStoreBill(ctx, bucketInfo, internalBucketInfo)
 	if err != nil {
 		return fmt.Errorf("get bucket bill failed: %s %s", bucketInfo.BucketName, err.Error())
@@ -58,6 +59,7 @@ func (k Keeper) unChargeBucketReadStoreFee(ctx sdk.Context, bucketInfo *types.Bu
 
 func (k Keeper) chargeBucketReadStoreFee(ctx sdk.Context, bucketInfo *types.BucketInfo,
 	internalBucketInfo *types.InternalBucketInfo) error {
+	ctx = paymenttypes.WithStreamRecordChangeReason(ctx, paymenttypes.STREAM_RECORD_CHANGE_REASON_BUCKET_FLOW_CHANGE, bucketInfo.BucketName)
 	internalBucketInfo.PriceTime = ctx.BlockTime().Unix()
 	bill, err := k.GetBucketReadStoreBill(ctx, bucketInfo, internalBucketInfo)
 	if err != nil {
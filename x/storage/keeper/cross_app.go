@@ -1,30 +1,31 @@
 package keeper
 
 import (
+	"github.com/bnb-chain/greenfield/internal/crosschainrecord"
 	"github.com/bnb-chain/greenfield/x/storage/types"
 )
 
 func RegisterCrossApps(keeper Keeper) {
 	bucketApp := NewBucketApp(keeper)
-	err := keeper.crossChainKeeper.RegisterChannel(types.BucketChannel, types.BucketChannelId, bucketApp)
+	err := keeper.crossChainKeeper.RegisterChannel(types.BucketChannel, types.BucketChannelId, crosschainrecord.NewRecorder(keeper.packageRecords, types.BucketChannelId, bucketApp))
 	if err != nil {
 		panic(err)
 	}
 
 	objectApp := NewObjectApp(keeper)
-	err = keeper.crossChainKeeper.RegisterChannel(types.ObjectChannel, types.ObjectChannelId, objectApp)
+	err = keeper.crossChainKeeper.RegisterChannel(types.ObjectChannel, types.ObjectChannelId, crosschainrecord.NewRecorder(keeper.packageRecords, types.ObjectChannelId, objectApp))
 	if err != nil {
 		panic(err)
 	}
 
 	groupApp := NewGroupApp(keeper)
-	err = keeper.crossChainKeeper.RegisterChannel(types.GroupChannel, types.GroupChannelId, groupApp)
+	err = keeper.crossChainKeeper.RegisterChannel(types.GroupChannel, types.GroupChannelId, crosschainrecord.NewRecorder(keeper.packageRecords, types.GroupChannelId, groupApp))
 	if err != nil {
 		panic(err)
 	}
 
 	permissionApp := NewPermissionApp(keeper, keeper.permKeeper)
-	err = keeper.crossChainKeeper.RegisterChannel(types.PermissionChannel, types.PermissionChannelId, permissionApp)
+	err = keeper.crossChainKeeper.RegisterChannel(types.PermissionChannel, types.PermissionChannelId, crosschainrecord.NewRecorder(keeper.packageRecords, types.PermissionChannelId, permissionApp))
 	if err != nil {
 		panic(err)
 	}
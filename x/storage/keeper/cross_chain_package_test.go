@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/internal/crosschainrecord"
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/x/storage/keeper"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func (s *TestSuite) TestCrossChainPackageRecord() {
+	apps := make(map[sdk.ChannelID]sdk.CrossChainApplication)
+	s.crossChainKeeper.EXPECT().RegisterChannel(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ string, id sdk.ChannelID, app sdk.CrossChainApplication) error {
+			apps[id] = app
+			return nil
+		}).AnyTimes()
+	s.crossChainKeeper.EXPECT().GetDestBscChainID().Return(sdk.ChainID(56)).AnyTimes()
+	keeper.RegisterCrossApps(*s.storageKeeper)
+
+	createSynPackage := types.CreateBucketSynPackage{
+		Creator:          sample.RandAccAddress(),
+		BucketName:       "bucketname",
+		ExtraData:        []byte("extra data"),
+		PaymentAddress:   sample.RandAccAddress(),
+		PrimarySpAddress: sample.RandAccAddress(),
+	}
+	synPayload := append([]byte{types.OperationCreateBucket}, createSynPackage.MustSerialize()...)
+
+	// nothing is recorded before the upgrade
+	txCtx := crosschainrecord.WithPendingRecords(s.ctx)
+	appCtx := &sdk.CrossChainAppContext{SrcChainId: 56, Sequence: 3}
+	result := apps[types.BucketChannelId].ExecuteSynPackage(txCtx, appCtx, synPayload)
+	s.Require().ErrorContains(result.Err, "Invalid type of visibility")
+	crosschainrecord.WritePendingRecords(txCtx)
+
+	req := &types.QueryCrossChainPackageRequest{ChannelId: uint32(types.BucketChannelId), Sequence: 3}
+	_, err := s.queryClient.CrossChainPackage(s.ctx, req)
+	s.Require().ErrorIs(err, types.ErrNoSuchCrossChainPackage)
+
+	upgradeChecker := func(ctx sdk.Context, name string) bool {
+		return name == upgradetypes.Serengeti || name == crosschainrecord.UpgradeName
+	}
+	s.ctx = sdk.NewContext(s.ctx.MultiStore(), s.ctx.BlockHeader(), false, upgradeChecker, s.ctx.Logger())
+
+	// the failed package is recorded after the messages of the tx are executed
+	txCtx = crosschainrecord.WithPendingRecords(s.ctx)
+	result = apps[types.BucketChannelId].ExecuteSynPackage(txCtx, appCtx, synPayload)
+	s.Require().ErrorContains(result.Err, "Invalid type of visibility")
+
+	_, err = s.queryClient.CrossChainPackage(s.ctx, req)
+	s.Require().ErrorIs(err, types.ErrNoSuchCrossChainPackage)
+
+	crosschainrecord.WritePendingRecords(txCtx)
+	res, err := s.queryClient.CrossChainPackage(s.ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(types.OperationCreateBucket), res.OperationType)
+	s.Require().Equal(uint32(sdk.SynCrossChainPackageType), res.PackageType)
+	s.Require().Contains(res.Error, "Invalid type of visibility")
+	s.Require().Contains(res.Payload, `"BucketName":"bucketname"`)
+	s.Require().Contains(res.AckPayload, `"Status":1`)
+
+	// the successful package is recorded at once
+	ackPackage := types.CreateBucketAckPackage{
+		Status:    types.StatusSuccess,
+		Creator:   createSynPackage.Creator,
+		ExtraData: createSynPackage.ExtraData,
+	}
+	ackPayload := append([]byte{types.OperationCreateBucket}, ackPackage.MustSerialize()...)
+	appCtx = &sdk.CrossChainAppContext{SrcChainId: 56, Sequence: 4}
+	result = apps[types.BucketChannelId].ExecuteAckPackage(s.ctx, appCtx, ackPayload)
+	s.Require().NoError(result.Err)
+
+	res, err = s.queryClient.CrossChainPackage(s.ctx, &types.QueryCrossChainPackageRequest{
+		SrcChainId: 56,
+		ChannelId:  uint32(types.BucketChannelId),
+		Sequence:   4,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(types.OperationCreateBucket), res.OperationType)
+	s.Require().Equal(uint32(sdk.AckCrossChainPackageType), res.PackageType)
+	s.Require().Contains(res.Payload, `"Status":0`)
+	s.Require().Empty(res.AckPayload)
+	s.Require().Empty(res.Error)
+
+	// the package is not recorded on the other chains
+	_, err = s.queryClient.CrossChainPackage(s.ctx, &types.QueryCrossChainPackageRequest{
+		SrcChainId: 204,
+		ChannelId:  uint32(types.BucketChannelId),
+		Sequence:   4,
+	})
+	s.Require().ErrorIs(err, types.ErrNoSuchCrossChainPackage)
+}
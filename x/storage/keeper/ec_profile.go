@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// isDefaultECProfile returns whether the EC profile is the default profile defined by the versioned params at the time
+func (k Keeper) isDefaultECProfile(ctx sdk.Context, profile *types.ECProfile, ts int64) bool {
+	versionParams, err := k.GetVersionedParamsWithTs(ctx, ts)
+	if err != nil {
+		panic(fmt.Sprintf("get versioned params error, msg: %s", err))
+	}
+	return profile.Equal(types.NewECProfile(versionParams.RedundantDataChunkNum, versionParams.RedundantParityChunkNum))
+}
+
+// IsECProfileAllowed returns whether a bucket can choose the EC profile, which is the default profile
+// or one of the profiles approved by governance.
+func (k Keeper) IsECProfileAllowed(ctx sdk.Context, profile *types.ECProfile) bool {
+	if profile.IsEmpty() || k.isDefaultECProfile(ctx, profile, ctx.BlockTime().Unix()) {
+		return true
+	}
+	for _, approved := range k.GetParams(ctx).EcProfiles {
+		if profile.Equal(&approved) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAllowedSecondarySPNum returns whether a gvg with the number of secondary sps can store the objects
+// of the default profile or any approved profile.
+func (k Keeper) IsAllowedSecondarySPNum(ctx sdk.Context, secondarySPNum uint32) bool {
+	if secondarySPNum == k.GetExpectSecondarySPNumForECObject(ctx, ctx.BlockTime().Unix()) {
+		return true
+	}
+	for _, approved := range k.GetParams(ctx).EcProfiles {
+		if approved.SecondarySPNum() == secondarySPNum {
+			return true
+		}
+	}
+	return false
+}
+
+// GetExpectSecondarySPNum returns the number of secondary sps of the EC profile,
+// the default profile of the versioned params at the time is used if the profile is empty.
+func (k Keeper) GetExpectSecondarySPNum(ctx sdk.Context, profile *types.ECProfile, ts int64) uint32 {
+	if profile.IsEmpty() {
+		return k.GetExpectSecondarySPNumForECObject(ctx, ts)
+	}
+	return profile.SecondarySPNum()
+}
+
+// getExpectSecondarySPNumForBucket returns the number of secondary sps storing the objects of the bucket,
+// the default profile is used if the bucket does not exist, the later checks on the bucket fail anyway.
+func (k Keeper) getExpectSecondarySPNumForBucket(ctx sdk.Context, bucketName string) uint32 {
+	var profile *types.ECProfile
+	if bucketInfo, found := k.GetBucketInfo(ctx, bucketName); found {
+		profile = bucketInfo.EcProfile
+	}
+	return k.GetExpectSecondarySPNum(ctx, profile, ctx.BlockTime().Unix())
+}
+
+// getSecondaryStorePriceFactor returns the factor applied to the secondary store price for the EC profile. The price is
+// defined for the default profile, while each secondary sp stores 1/DataChunkNum of an object, so a profile with fewer
+// data chunks stores more data per secondary sp, and is charged more per secondary sp.
+func (k Keeper) getSecondaryStorePriceFactor(ctx sdk.Context, profile *types.ECProfile, ts int64) sdk.Dec {
+	if profile.IsEmpty() {
+		return sdk.OneDec()
+	}
+	versionParams, err := k.GetVersionedParamsWithTs(ctx, ts)
+	if err != nil {
+		panic(fmt.Sprintf("get versioned params error, msg: %s", err))
+	}
+	return sdk.NewDec(int64(versionParams.RedundantDataChunkNum)).QuoInt64(int64(profile.DataChunkNum))
+}
@@ -12,9 +12,11 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/bnb-chain/greenfield/internal/crosschainrecord"
 	"github.com/bnb-chain/greenfield/internal/sequence"
 	gnfd "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/types/errors"
+	"github.com/bnb-chain/greenfield/types/integrity"
 	permtypes "github.com/bnb-chain/greenfield/x/permission/types"
 	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
 	"github.com/bnb-chain/greenfield/x/storage/types"
@@ -276,6 +278,109 @@ func (k Keeper) ListObjectsByBucketId(goCtx context.Context, req *types.QueryLis
 	return &types.QueryListObjectsResponse{ObjectInfos: objectInfos, Pagination: pageRes}, nil
 }
 
+func (k Keeper) ListBucketsByGlobalVirtualGroupFamily(goCtx context.Context, req *types.QueryListBucketsByGlobalVirtualGroupFamilyRequest) (*types.QueryListBucketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Pagination != nil && req.Pagination.Limit > types.MaxPaginationLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "exceed pagination limit %d", types.MaxPaginationLimit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := query.CheckOffsetQueryNotAllowed(ctx, req.Pagination); err != nil {
+		return nil, err
+	}
+
+	var bucketInfos []*types.BucketInfo
+	bucketPrefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetBucketsByGVGFamilyPrefix(req.GlobalVirtualGroupFamilyId))
+
+	pageRes, err := query.Paginate(bucketPrefixStore, req.Pagination, func(key, value []byte) error {
+		u256Seq := sequence.Sequence[math.Uint]{}
+		bucketInfo, found := k.GetBucketInfoById(ctx, u256Seq.DecodeSequence(key))
+		if found {
+			bucketInfos = append(bucketInfos, bucketInfo)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryListBucketsResponse{BucketInfos: bucketInfos, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ListObjectsByGlobalVirtualGroup(goCtx context.Context, req *types.QueryListObjectsByGlobalVirtualGroupRequest) (*types.QueryListObjectsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Pagination != nil && req.Pagination.Limit > types.MaxPaginationLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "exceed pagination limit %d", types.MaxPaginationLimit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := query.CheckOffsetQueryNotAllowed(ctx, req.Pagination); err != nil {
+		return nil, err
+	}
+
+	var objectInfos []*types.ObjectInfo
+	objectPrefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetObjectsByGVGPrefix(req.GlobalVirtualGroupId))
+
+	pageRes, err := query.Paginate(objectPrefixStore, req.Pagination, func(key, value []byte) error {
+		_, objectID := types.ParseObjectByGVGKey(key)
+		objectInfo, found := k.GetObjectInfoById(ctx, objectID)
+		if found {
+			objectInfos = append(objectInfos, objectInfo)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryListObjectsResponse{ObjectInfos: objectInfos, Pagination: pageRes}, nil
+}
+
+func (k Keeper) VerifyPieceIntegrity(goCtx context.Context, req *types.QueryVerifyPieceIntegrityRequest) (*types.QueryVerifyPieceIntegrityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id, err := math.ParseUint(req.ObjectId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid object id")
+	}
+
+	objectInfo, found := k.GetObjectInfoById(ctx, id)
+	if !found {
+		return nil, types.ErrNoSuchObject
+	}
+
+	// the checksum of the primary sp is the first one, then the ones of the secondary sps
+	checksumIndex := int(req.RedundancyIndex) + 1
+	if checksumIndex < 0 || checksumIndex >= len(objectInfo.Checksums) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid redundancy index %d", req.RedundancyIndex)
+	}
+	integrityHash := objectInfo.Checksums[checksumIndex]
+
+	pieceHash := req.PieceHash
+	if len(req.PieceData) != 0 {
+		segmentSize, err := k.MaxSegmentSize(ctx, objectInfo.GetLatestUpdatedTime())
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if uint64(len(req.PieceData)) > segmentSize {
+			return nil, status.Errorf(codes.InvalidArgument, "piece data exceeds the max segment size %d", segmentSize)
+		}
+		pieceHash = integrity.ComputePieceHash(req.PieceData)
+	}
+
+	res := &types.QueryVerifyPieceIntegrityResponse{Valid: true, IntegrityHash: integrityHash}
+	if err = integrity.VerifyPieceHashProof(pieceHash, req.PieceIndex, req.Proof, integrityHash); err != nil {
+		res.Valid = false
+		res.Reason = err.Error()
+	}
+	return res, nil
+}
+
 func (k Keeper) HeadBucketNFT(goCtx context.Context, req *types.QueryNFTRequest) (*types.QueryBucketNFTResponse, error) {
 	id, err := validateAndGetId(req)
 	if err != nil {
@@ -342,7 +447,7 @@ func (k Keeper) QueryLockFee(c context.Context, req *types.QueryLockFeeRequest)
 		return nil, sptypes.ErrStorageProviderNotFound
 	}
 
-	amount, _, err := k.GetObjectLockFee(ctx, createAt, req.PayloadSize)
+	amount, _, err := k.GetObjectLockFee(ctx, nil, createAt, req.PayloadSize)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -350,6 +455,41 @@ func (k Keeper) QueryLockFee(c context.Context, req *types.QueryLockFeeRequest)
 	return &types.QueryLockFeeResponse{Amount: amount}, nil
 }
 
+func (k Keeper) EstimateCost(c context.Context, req *types.QueryEstimateCostRequest) (*types.QueryEstimateCostResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	timestamp := req.GetTimestamp()
+	if timestamp == 0 {
+		timestamp = ctx.BlockTime().Unix()
+	}
+
+	primaryAcc, err := sdk.AccAddressFromHexUnsafe(req.PrimarySpAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid primary storage provider address")
+	}
+
+	_, found := k.spKeeper.GetStorageProviderByOperatorAddr(ctx, primaryAcc)
+	if !found {
+		return nil, sptypes.ErrStorageProviderNotFound
+	}
+
+	ecProfile := types.NewECProfile(req.RedundantDataChunkNum, req.RedundantParityChunkNum)
+	if ecProfile != nil {
+		if err = ecProfile.ValidateBasic(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	resp, err := k.EstimateBucketCost(ctx, ecProfile, timestamp, req.ObjectSizes, req.ChargedReadQuota)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
 func (k Keeper) HeadBucketExtra(c context.Context, req *types.QueryHeadBucketExtraRequest) (*types.QueryHeadBucketExtraResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -709,3 +849,40 @@ func (k Keeper) QueryPaymentAccountBucketFlowRateLimit(goCtx context.Context, re
 		FlowRateLimit: flowRateLimit.FlowRateLimit,
 	}, nil
 }
+
+func (k Keeper) CrossChainPackage(goCtx context.Context, req *types.QueryCrossChainPackageRequest) (*types.QueryCrossChainPackageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	srcChainId := sdk.ChainID(req.SrcChainId)
+	if srcChainId == 0 {
+		srcChainId = k.crossChainKeeper.GetDestBscChainID()
+	}
+
+	record, found := k.packageRecords.GetRecord(ctx, srcChainId, sdk.ChannelID(req.ChannelId), req.Sequence)
+	if !found {
+		return nil, types.ErrNoSuchCrossChainPackage
+	}
+
+	channelId := sdk.ChannelID(record.ChannelId)
+	packageType := sdk.CrossChainPackageType(record.PackageType)
+	res := &types.QueryCrossChainPackageResponse{
+		PackageType: record.PackageType,
+		Payload: crosschainrecord.DecodePackage(ctx, record.Height, record.Payload, channelId, packageType,
+			types.DeserializeCrossChainPackage, types.DeserializeCrossChainPackageV2),
+		Error:  record.Error,
+		Height: record.Height,
+	}
+	// the first byte of the payload is the operation type of the package
+	if len(record.Payload) > 0 {
+		res.OperationType = uint32(record.Payload[0])
+	}
+	// only the execution of a syn package returns an ack package
+	if packageType == sdk.SynCrossChainPackageType {
+		res.AckPayload = crosschainrecord.DecodePackage(ctx, record.Height, record.AckPayload, channelId, sdk.AckCrossChainPackageType,
+			types.DeserializeCrossChainPackage, types.DeserializeCrossChainPackageV2)
+	}
+	return res, nil
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,6 +20,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/types/integrity"
 	"github.com/bnb-chain/greenfield/x/storage/keeper"
 	"github.com/bnb-chain/greenfield/x/storage/types"
 )
@@ -208,6 +210,67 @@ func TestHeadObjectNFT(t *testing.T) {
 	require.ErrorIs(t, err, types.ErrNoSuchObject)
 }
 
+func TestVerifyPieceIntegrity(t *testing.T) {
+	// invalid argument
+	k, ctx := makeKeeper(t)
+	_, err := k.VerifyPieceIntegrity(ctx, nil)
+	require.ErrorContains(t, err, "invalid request")
+
+	// object not exist
+	_, err = k.VerifyPieceIntegrity(ctx, &types.QueryVerifyPieceIntegrityRequest{
+		ObjectId: "1",
+	})
+	require.ErrorIs(t, err, types.ErrNoSuchObject)
+
+	pieceHashes := [][]byte{integrity.ComputePieceHash([]byte("piece0")), integrity.ComputePieceHash([]byte("piece1"))}
+	integrityHash := types.GenerateHash(pieceHashes)
+	k.SetObjectInfo(ctx, &types.ObjectInfo{
+		Id:        sdkmath.NewUint(1),
+		Checksums: [][]byte{integrityHash},
+	})
+
+	// no replica of the secondary sp
+	_, err = k.VerifyPieceIntegrity(ctx, &types.QueryVerifyPieceIntegrityRequest{
+		ObjectId:        "1",
+		RedundancyIndex: 0,
+	})
+	require.ErrorContains(t, err, "invalid redundancy index")
+
+	res, err := k.VerifyPieceIntegrity(ctx, &types.QueryVerifyPieceIntegrityRequest{
+		ObjectId:        "1",
+		RedundancyIndex: -1,
+		PieceIndex:      1,
+		PieceHash:       pieceHashes[1],
+		Proof:           pieceHashes[:1],
+	})
+	require.NoError(t, err)
+	require.True(t, res.Valid)
+	require.Equal(t, integrityHash, res.IntegrityHash)
+	require.Empty(t, res.Reason)
+
+	res, err = k.VerifyPieceIntegrity(ctx, &types.QueryVerifyPieceIntegrityRequest{
+		ObjectId:        "1",
+		RedundancyIndex: -1,
+		PieceIndex:      0,
+		PieceHash:       pieceHashes[1],
+		Proof:           pieceHashes[:1],
+	})
+	require.NoError(t, err)
+	require.False(t, res.Valid)
+	require.Contains(t, res.Reason, "do not match the integrity hash")
+
+	res, err = k.VerifyPieceIntegrity(ctx, &types.QueryVerifyPieceIntegrityRequest{
+		ObjectId:        "1",
+		RedundancyIndex: -1,
+		PieceIndex:      2,
+		PieceHash:       pieceHashes[1],
+		Proof:           pieceHashes[:1],
+	})
+	require.NoError(t, err)
+	require.False(t, res.Valid)
+	require.Contains(t, res.Reason, "out of the 2 pieces")
+}
+
 func TestHeadBucketNFT(t *testing.T) {
 	// invalid argument
 	k, ctx := makeKeeper(t)
@@ -15,6 +15,7 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/cosmos/gogoproto/proto"
 
+	"github.com/bnb-chain/greenfield/internal/crosschainrecord"
 	"github.com/bnb-chain/greenfield/internal/sequence"
 	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	types2 "github.com/bnb-chain/greenfield/types"
@@ -50,6 +51,9 @@ type (
 
 		// payment check config
 		cfg *paymentCheckConfig
+
+		// records of the cross-chain packages received on the storage channels
+		packageRecords crosschainrecord.Store
 	}
 )
 
@@ -82,6 +86,8 @@ func NewKeeper(
 		virtualGroupKeeper: virtualGroupKeeper,
 		authority:          authority,
 		cfg:                &paymentCheckConfig{Enabled: false, Interval: 0},
+
+		packageRecords: crosschainrecord.NewStore(cdc, storeKey, types.CrossChainPackageRecordPrefix),
 	}
 
 	k.bucketSeq = sequence.NewSequence[sdkmath.Uint](types.BucketSequencePrefix)
@@ -156,6 +162,25 @@ func (k Keeper) CreateBucket(
 		return sdkmath.ZeroUint(), err
 	}
 
+	var placementConstraint *types.PlacementConstraint
+	if !opts.PlacementConstraint.IsEmpty() {
+		placementConstraint = opts.PlacementConstraint
+		if err = k.checkPlacementConstraintOfFamily(ctx, placementConstraint, gvgFamily); err != nil {
+			return sdkmath.ZeroUint(), err
+		}
+	}
+
+	var ecProfile *types.ECProfile
+	if !opts.ECProfile.IsEmpty() {
+		if !k.IsECProfileAllowed(ctx, opts.ECProfile) {
+			return sdkmath.ZeroUint(), types.ErrECProfileNotAllowed.Wrapf("EC profile %s is not approved", opts.ECProfile.Format())
+		}
+		// the default profile is stored as empty, so that the bucket follows the versioned params
+		if !k.isDefaultECProfile(ctx, opts.ECProfile, ctx.BlockTime().Unix()) {
+			ecProfile = opts.ECProfile
+		}
+	}
+
 	bucketInfo := types.BucketInfo{
 		Owner:                      ownerAcc.String(),
 		BucketName:                 bucketName,
@@ -166,6 +191,8 @@ func (k Keeper) CreateBucket(
 		ChargedReadQuota:           opts.ChargedReadQuota,
 		PaymentAddress:             paymentAcc.String(),
 		GlobalVirtualGroupFamilyId: gvgFamily.Id,
+		PlacementConstraint:        placementConstraint,
+		EcProfile:                  ecProfile,
 	}
 
 	internalBucketInfo := types.InternalBucketInfo{PriceTime: ctx.BlockTime().Unix()}
@@ -186,6 +213,10 @@ func (k Keeper) CreateBucket(
 	store.Set(bucketKey, k.bucketSeq.EncodeSequence(bucketInfo.Id))
 	store.Set(types.GetBucketByIDKey(bucketInfo.Id), bz)
 	k.SetInternalBucketInfo(ctx, bucketInfo.Id, &internalBucketInfo)
+	store.Set(types.GetBucketByGVGFamilyKey(bucketInfo.GlobalVirtualGroupFamilyId, bucketInfo.Id), []byte{0x01})
+	if placementConstraint != nil {
+		k.setPlacementConstrainedBucket(ctx, bucketInfo.GlobalVirtualGroupFamilyId, bucketInfo.Id)
+	}
 
 	// emit CreateBucket Event
 	if err = ctx.EventManager().EmitTypedEvents(&types.EventCreateBucket{
@@ -200,6 +231,8 @@ func (k Keeper) CreateBucket(
 		PaymentAddress:             bucketInfo.PaymentAddress,
 		PrimarySpId:                sp.Id,
 		GlobalVirtualGroupFamilyId: bucketInfo.GlobalVirtualGroupFamilyId,
+		PlacementConstraint:        bucketInfo.PlacementConstraint,
+		EcProfile:                  bucketInfo.EcProfile,
 	}); err != nil {
 		return sdkmath.Uint{}, err
 	}
@@ -266,6 +299,8 @@ func (k Keeper) doDeleteBucket(ctx sdk.Context, operator sdk.AccAddress, bucketI
 	store.Delete(types.GetQuotaKey(bucketInfo.Id))
 	store.Delete(types.GetInternalBucketInfoKey(bucketInfo.Id))
 	store.Delete(types.GetMigrationBucketKey(bucketInfo.Id))
+	store.Delete(types.GetBucketByGVGFamilyKey(bucketInfo.GlobalVirtualGroupFamilyId, bucketInfo.Id))
+	k.deletePlacementConstrainedBucket(ctx, bucketInfo.GlobalVirtualGroupFamilyId, bucketInfo.Id)
 	if ctx.IsUpgraded(upgradetypes.Pawnee) {
 		store.Delete(types.GetLockedObjectCountKey(bucketInfo.Id))
 	}
@@ -913,7 +948,10 @@ func (k Keeper) SealObject(
 	if gvg.FamilyId != bucketInfo.GlobalVirtualGroupFamilyId || gvg.PrimarySpId != spInState.Id {
 		return types.ErrInvalidGlobalVirtualGroup.Wrapf("Global virtual group mismatch, familyID: %d, bucket family ID: %d", gvg.FamilyId, bucketInfo.GlobalVirtualGroupFamilyId)
 	}
-	expectSecondarySPNum := k.GetExpectSecondarySPNumForECObject(ctx, objectInfo.GetLatestUpdatedTime())
+	if err := k.checkPlacementConstraintOfGVG(ctx, bucketInfo.PlacementConstraint, gvg); err != nil {
+		return err
+	}
+	expectSecondarySPNum := k.GetExpectSecondarySPNum(ctx, bucketInfo.EcProfile, objectInfo.GetLatestUpdatedTime())
 	if int(expectSecondarySPNum) != len(gvg.SecondarySpIds) {
 		return types.ErrInvalidGlobalVirtualGroup.Wrapf("secondary sp num mismatch, expect (%d), but (%d)",
 			expectSecondarySPNum, len(gvg.SecondarySpIds))
@@ -940,6 +978,7 @@ func (k Keeper) SealObject(
 
 	obz := k.cdc.MustMarshal(objectInfo)
 	store.Set(types.GetObjectByIDKey(objectInfo.Id), obz)
+	k.spKeeper.RecordSpSealResult(ctx, sp.Id, false)
 
 	if isUpdate {
 		if err := ctx.EventManager().EmitTypedEvents(&types.EventUpdateObjectContentSuccess{
@@ -1364,6 +1403,7 @@ func (k Keeper) RejectSealObject(ctx sdk.Context, operator sdk.AccAddress, bucke
 	if ctx.IsUpgraded(upgradetypes.Pawnee) {
 		k.DecreaseLockedObjectCount(ctx, bucketInfo.Id)
 	}
+	k.spKeeper.RecordSpSealResult(ctx, sp.Id, true)
 
 	return ctx.EventManager().EmitTypedEvents(&types.EventRejectSealObject{
 		Operator:   operator.String(),
@@ -1768,17 +1808,19 @@ func (k Keeper) UpdateGroupExtra(ctx sdk.Context, operator sdk.AccAddress, group
 	return nil
 }
 
-func (k Keeper) VerifySPAndSignature(_ sdk.Context, sp *sptypes.StorageProvider, sigData, signature []byte, operator sdk.AccAddress) error {
+func (k Keeper) VerifySPAndSignature(ctx sdk.Context, sp *sptypes.StorageProvider, sigData, signature []byte, operator sdk.AccAddress) error {
 	if sp.Status != sptypes.STATUS_IN_SERVICE && !k.fromSpMaintenanceAcct(sp, operator) {
 		return sptypes.ErrStorageProviderNotInService
 	}
-	approvalAccAddress := sdk.MustAccAddressFromHex(sp.ApprovalAddress)
 
-	err := gnfdtypes.VerifySignature(approvalAccAddress, sdk.Keccak256(sigData), signature)
-	if err != nil {
-		return errors.Wrapf(types.ErrInvalidApproval, "verify signature error: %s", err)
+	// the replaced approval address is accepted as well during the overlap period of a key rotation
+	var err error
+	for _, approvalAccAddress := range k.spKeeper.GetSpApprovalAddresses(ctx, sp) {
+		if err = gnfdtypes.VerifySignature(approvalAccAddress, sdk.Keccak256(sigData), signature); err == nil {
+			return nil
+		}
 	}
-	return nil
+	return errors.Wrapf(types.ErrInvalidApproval, "verify signature error: %s", err)
 }
 
 func (k Keeper) VerifySP(_ sdk.Context, sp *sptypes.StorageProvider, operator sdk.AccAddress) error {
@@ -2178,6 +2220,10 @@ func (k Keeper) MigrateBucket(ctx sdk.Context, operator sdk.AccAddress, bucketNa
 			"origin SP status: %s, dst SP status: %s", srcSP.Status.String(), dstSP.Status.String())
 	}
 
+	if err := k.checkPlacementConstraintOfSP(ctx, bucketInfo.PlacementConstraint, dstSP.Id); err != nil {
+		return err
+	}
+
 	streamRecord, found := k.paymentKeeper.GetStreamRecord(ctx, sdk.MustAccAddressFromHex(bucketInfo.PaymentAddress))
 	if !found || streamRecord.Status == paymenttypes.STREAM_ACCOUNT_STATUS_FROZEN {
 		return paymenttypes.ErrInvalidStreamAccountStatus.Wrap("stream account is frozen")
@@ -2253,11 +2299,15 @@ func (k Keeper) CompleteMigrateBucket(ctx sdk.Context, operator sdk.AccAddress,
 		return types.ErrMigrationBucketFailed.Wrapf("dst sp info not match")
 	}
 
-	_, found = k.virtualGroupKeeper.GetGVGFamily(ctx, gvgFamilyID)
+	dstGvgFamily, found := k.virtualGroupKeeper.GetGVGFamily(ctx, gvgFamilyID)
 	if !found {
 		return virtualgroupmoduletypes.ErrGVGFamilyNotExist
 	}
 
+	if err := k.checkPlacementConstraintOfFamily(ctx, bucketInfo.PlacementConstraint, dstGvgFamily); err != nil {
+		return types.ErrMigrationBucketFailed.Wrapf("err: %s", err)
+	}
+
 	srcGvgFamily, found := k.virtualGroupKeeper.GetGVGFamily(ctx, bucketInfo.GlobalVirtualGroupFamilyId)
 	if !found {
 		return virtualgroupmoduletypes.ErrGVGFamilyNotExist
@@ -2281,6 +2331,13 @@ func (k Keeper) CompleteMigrateBucket(ctx sdk.Context, operator sdk.AccAddress,
 		return types.ErrMigrationBucketFailed.Wrapf("cancel charge bucket failed, err: %s", err)
 	}
 
+	if !bucketInfo.PlacementConstraint.IsEmpty() {
+		k.deletePlacementConstrainedBucket(ctx, bucketInfo.GlobalVirtualGroupFamilyId, bucketInfo.Id)
+		k.setPlacementConstrainedBucket(ctx, gvgFamilyID, bucketInfo.Id)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBucketByGVGFamilyKey(bucketInfo.GlobalVirtualGroupFamilyId, bucketInfo.Id))
+	store.Set(types.GetBucketByGVGFamilyKey(gvgFamilyID, bucketInfo.Id), []byte{0x01})
 	bucketInfo.GlobalVirtualGroupFamilyId = gvgFamilyID
 
 	// check secondary sp signature
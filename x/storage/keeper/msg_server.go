@@ -39,12 +39,14 @@ func (k msgServer) CreateBucket(goCtx context.Context, msg *types.MsgCreateBucke
 	primarySPAcc := sdk.MustAccAddressFromHex(msg.PrimarySpAddress)
 
 	id, err := k.Keeper.CreateBucket(ctx, ownerAcc, msg.BucketName, primarySPAcc, &storagetypes.CreateBucketOptions{
-		PaymentAddress:    msg.PaymentAddress,
-		Visibility:        msg.Visibility,
-		ChargedReadQuota:  msg.ChargedReadQuota,
-		SourceType:        types.SOURCE_TYPE_ORIGIN,
-		PrimarySpApproval: msg.PrimarySpApproval,
-		ApprovalMsgBytes:  msg.GetApprovalBytes(),
+		PaymentAddress:      msg.PaymentAddress,
+		Visibility:          msg.Visibility,
+		ChargedReadQuota:    msg.ChargedReadQuota,
+		SourceType:          types.SOURCE_TYPE_ORIGIN,
+		PrimarySpApproval:   msg.PrimarySpApproval,
+		ApprovalMsgBytes:    msg.GetApprovalBytes(),
+		PlacementConstraint: msg.GetPlacementConstraint(),
+		ECProfile:           msg.GetECProfile(),
 	})
 	if err != nil {
 		return nil, err
@@ -128,10 +130,10 @@ func (k msgServer) CreateObject(goCtx context.Context, msg *types.MsgCreateObjec
 
 	ownerAcc := sdk.MustAccAddressFromHex(msg.Creator)
 
-	if len(msg.ExpectChecksums) != int(1+k.GetExpectSecondarySPNumForECObject(ctx, ctx.BlockTime().Unix())) {
+	expectSecondarySPNum := k.getExpectSecondarySPNumForBucket(ctx, msg.BucketName)
+	if len(msg.ExpectChecksums) != int(1+expectSecondarySPNum) {
 		return nil, gnfderrors.ErrInvalidChecksum.Wrapf("ExpectChecksums missing, expect: %d, actual: %d",
-			1+k.Keeper.RedundantParityChunkNum(ctx)+k.Keeper.RedundantDataChunkNum(ctx),
-			len(msg.ExpectChecksums))
+			1+expectSecondarySPNum, len(msg.ExpectChecksums))
 	}
 
 	id, err := k.Keeper.CreateObject(ctx, ownerAcc, msg.BucketName, msg.ObjectName, msg.PayloadSize, storagetypes.CreateObjectOptions{
@@ -733,10 +735,10 @@ func (k msgServer) SetTag(goCtx context.Context, msg *types.MsgSetTag) (*types.M
 func (k msgServer) UpdateObjectContent(goCtx context.Context, msg *storagetypes.MsgUpdateObjectContent) (*storagetypes.MsgUpdateObjectContentResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if len(msg.ExpectChecksums) != int(1+k.GetExpectSecondarySPNumForECObject(ctx, ctx.BlockTime().Unix())) {
+	expectSecondarySPNum := k.getExpectSecondarySPNumForBucket(ctx, msg.BucketName)
+	if len(msg.ExpectChecksums) != int(1+expectSecondarySPNum) {
 		return nil, gnfderrors.ErrInvalidChecksum.Wrapf("ExpectChecksums missing, expect: %d, actual: %d",
-			1+k.Keeper.RedundantParityChunkNum(ctx)+k.Keeper.RedundantDataChunkNum(ctx),
-			len(msg.ExpectChecksums))
+			1+expectSecondarySPNum, len(msg.ExpectChecksums))
 	}
 	operatorAcc := sdk.MustAccAddressFromHex(msg.Operator)
 	err := k.Keeper.UpdateObjectContent(ctx, operatorAcc, msg.BucketName, msg.ObjectName, msg.PayloadSize, storagetypes.UpdateObjectOptions{
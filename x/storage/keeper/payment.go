@@ -16,6 +16,7 @@ import (
 
 func (k Keeper) ChargeBucketReadFee(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
 	internalBucketInfo *storagetypes.InternalBucketInfo) error {
+	ctx = types.WithStreamRecordChangeReason(ctx, types.STREAM_RECORD_CHANGE_REASON_BUCKET_FLOW_CHANGE, bucketInfo.BucketName)
 	if bucketInfo.ChargedReadQuota == 0 {
 		return nil
 	}
@@ -42,6 +43,7 @@ func (k Keeper) ChargeBucketReadFee(ctx sdk.Context, bucketInfo *storagetypes.Bu
 
 func (k Keeper) UnChargeBucketReadFee(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
 	internalBucketInfo *storagetypes.InternalBucketInfo) error {
+	ctx = types.WithStreamRecordChangeReason(ctx, types.STREAM_RECORD_CHANGE_REASON_BUCKET_FLOW_CHANGE, bucketInfo.BucketName)
 	if internalBucketInfo.TotalChargeSize > 0 {
 		return fmt.Errorf("unexpected total store charge size: %s, %d", bucketInfo.BucketName, internalBucketInfo.TotalChargeSize)
 	}
@@ -85,29 +87,37 @@ func (k Keeper) GetBucketReadBill(ctx sdk.Context, bucketInfo *storagetypes.Buck
 		return userFlows, fmt.Errorf("get storage price failed: %d %w", internalBucketInfo.PriceTime, err)
 	}
 
-	// primary sp total rate
-	primaryTotalFlowRate := price.ReadPrice.MulInt(sdkmath.NewIntFromUint64(bucketInfo.ChargedReadQuota)).TruncateInt()
+	versionedParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, internalBucketInfo.PriceTime)
+	if err != nil {
+		return userFlows, fmt.Errorf("failed to get validator tax rate: %d %w", internalBucketInfo.PriceTime, err)
+	}
+
+	userFlows.Flows = append(userFlows.Flows, k.calculateReadBill(price, versionedParams, gvgFamily, bucketInfo.ChargedReadQuota)...)
+	return userFlows, nil
+}
+
+func (k Keeper) calculateReadBill(price sptypes.GlobalSpStorePrice, params types.VersionedParams,
+	gvgFamily *vgtypes.GlobalVirtualGroupFamily, chargedReadQuota uint64) []types.OutFlow {
+	outFlows := make([]types.OutFlow, 0)
 
+	// primary sp total rate
+	primaryTotalFlowRate := price.ReadPrice.MulInt(sdkmath.NewIntFromUint64(chargedReadQuota)).TruncateInt()
 	if primaryTotalFlowRate.IsPositive() {
-		userFlows.Flows = append(userFlows.Flows, types.OutFlow{
+		outFlows = append(outFlows, types.OutFlow{
 			ToAddress: gvgFamily.VirtualPaymentAddress,
 			Rate:      primaryTotalFlowRate,
 		})
 	}
 
-	versionedParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, internalBucketInfo.PriceTime)
-	if err != nil {
-		return userFlows, fmt.Errorf("failed to get validator tax rate: %d %w", internalBucketInfo.PriceTime, err)
-	}
-	validatorTaxRate := versionedParams.ValidatorTaxRate.MulInt(primaryTotalFlowRate).TruncateInt()
+	validatorTaxRate := params.ValidatorTaxRate.MulInt(primaryTotalFlowRate).TruncateInt()
 	if validatorTaxRate.IsPositive() {
-		userFlows.Flows = append(userFlows.Flows, types.OutFlow{
+		outFlows = append(outFlows, types.OutFlow{
 			ToAddress: types.ValidatorTaxPoolAddress.String(),
 			Rate:      validatorTaxRate,
 		})
 	}
 
-	return userFlows, nil
+	return outFlows
 }
 
 func (k Keeper) UpdateBucketInfoAndCharge(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
@@ -132,8 +142,9 @@ func (k Keeper) LockShadowObjectStoreFee(ctx sdk.Context, bucketInfo *storagetyp
 }
 
 func (k Keeper) lockObjectStoreFee(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo, timestamp int64, payloadSize uint64, objectName string) error {
+	ctx = types.WithStreamRecordChangeReason(ctx, types.STREAM_RECORD_CHANGE_REASON_OBJECT_LOCK_FEE, bucketInfo.BucketName)
 	paymentAddr := sdk.MustAccAddressFromHex(bucketInfo.PaymentAddress)
-	amount, rate, err := k.GetObjectLockFee(ctx, timestamp, payloadSize)
+	amount, rate, err := k.GetObjectLockFee(ctx, bucketInfo.EcProfile, timestamp, payloadSize)
 	if err != nil {
 		return fmt.Errorf("get object store fee rate failed: %s %s %w", bucketInfo.BucketName, objectName, err)
 	}
@@ -177,7 +188,8 @@ func (k Keeper) lockObjectStoreFee(ctx sdk.Context, bucketInfo *storagetypes.Buc
 
 // UnlockObjectStoreFee unlock store fee if the object is deleted in INIT state
 func (k Keeper) UnlockObjectStoreFee(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo, objectInfo *storagetypes.ObjectInfo) error {
-	lockedBalance, _, err := k.GetObjectLockFee(ctx, objectInfo.GetLatestUpdatedTime(), objectInfo.PayloadSize)
+	ctx = types.WithStreamRecordChangeReason(ctx, types.STREAM_RECORD_CHANGE_REASON_OBJECT_LOCK_FEE, bucketInfo.BucketName)
+	lockedBalance, _, err := k.GetObjectLockFee(ctx, bucketInfo.EcProfile, objectInfo.GetLatestUpdatedTime(), objectInfo.PayloadSize)
 	if err != nil {
 		return fmt.Errorf("get object store fee rate failed: %s %s %w", bucketInfo.BucketName, objectInfo.ObjectName, err)
 	}
@@ -192,7 +204,8 @@ func (k Keeper) UnlockObjectStoreFee(ctx sdk.Context, bucketInfo *storagetypes.B
 
 // UnlockShadowObjectStoreFee unlock store fee if the object is deleted in INIT state
 func (k Keeper) UnlockShadowObjectStoreFee(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo, objectInfo *storagetypes.ShadowObjectInfo) error {
-	lockedBalance, _, err := k.GetObjectLockFee(ctx, objectInfo.GetUpdatedAt(), objectInfo.PayloadSize)
+	ctx = types.WithStreamRecordChangeReason(ctx, types.STREAM_RECORD_CHANGE_REASON_OBJECT_LOCK_FEE, bucketInfo.BucketName)
+	lockedBalance, _, err := k.GetObjectLockFee(ctx, bucketInfo.EcProfile, objectInfo.GetUpdatedAt(), objectInfo.PayloadSize)
 	if err != nil {
 		return fmt.Errorf("get shadow object store fee rate failed, objectID: %s %w", objectInfo.Id.String(), err)
 	}
@@ -240,6 +253,7 @@ func (k Keeper) IsPriceChanged(ctx sdk.Context, primarySpId uint32, priceTime in
 	return !(prePrice.ReadPrice.Equal(currentPrice.ReadPrice) &&
 			prePrice.PrimaryStorePrice.Equal(currentPrice.PrimaryStorePrice) &&
 			prePrice.SecondaryStorePrice.Equal(currentPrice.SecondaryStorePrice) &&
+			prePrice.StorePriceTiersEqual(currentPrice) &&
 			preParams.ValidatorTaxRate.Equal(currentParams.ValidatorTaxRate)),
 		&prePrice, preParams.ValidatorTaxRate, &currentPrice, currentParams.ValidatorTaxRate, nil
 }
@@ -305,6 +319,7 @@ func (k Keeper) UnChargeObjectStoreFee(ctx sdk.Context, bucketInfo *storagetypes
 }
 
 func (k Keeper) ChargeObjectStoreFeeForEarlyDeletion(ctx sdk.Context, userFlows []types.OutFlow, bucketInfo *storagetypes.BucketInfo, objectInfo *storagetypes.ObjectInfo, timeToPay int64) error {
+	ctx = types.WithStreamRecordChangeReason(ctx, types.STREAM_RECORD_CHANGE_REASON_EARLY_DELETION_FEE, bucketInfo.BucketName)
 	totalStaticBalanceChange := sdkmath.NewInt(0)
 	for _, flow := range userFlows {
 		staticBalanceChange := flow.Rate.Abs().MulRaw(timeToPay)
@@ -327,6 +342,7 @@ func (k Keeper) ChargeObjectStoreFeeForEarlyDeletion(ctx sdk.Context, userFlows
 func (k Keeper) ChargeViaBucketChange(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
 	internalBucketInfo *storagetypes.InternalBucketInfo,
 	changeFunc func(bi *storagetypes.BucketInfo, ibi *storagetypes.InternalBucketInfo) error) error {
+	ctx = types.WithStreamRecordChangeReason(ctx, types.STREAM_RECORD_CHANGE_REASON_BUCKET_FLOW_CHANGE, bucketInfo.BucketName)
 
 	// get previous bill
 	prevBill, err := k.GetBucketReadStoreBill(ctx, bucketInfo, internalBucketInfo)
@@ -387,6 +403,7 @@ func (k Keeper) ChargeViaBucketChange(ctx sdk.Context, bucketInfo *storagetypes.
 
 func (k Keeper) ChargeViaObjectChange(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
 	internalBucketInfo *storagetypes.InternalBucketInfo, objectInfo *storagetypes.ObjectInfo, chargeSize uint64, delete bool) ([]types.OutFlow, error) {
+	ctx = types.WithStreamRecordChangeReason(ctx, types.STREAM_RECORD_CHANGE_REASON_BUCKET_FLOW_CHANGE, bucketInfo.BucketName)
 	userFlows := types.UserFlows{
 		From:  sdk.MustAccAddressFromHex(bucketInfo.PaymentAddress),
 		Flows: make([]types.OutFlow, 0),
@@ -419,20 +436,41 @@ func (k Keeper) ChargeViaObjectChange(ctx sdk.Context, bucketInfo *storagetypes.
 		return nil, fmt.Errorf("failed to get validator tax rate: %w, time: %d", err, internalBucketInfo.PriceTime)
 	}
 
-	preOutFlows := k.calculateLVGStoreBill(ctx, price, versionedParams, gvgFamily, gvg, lvg)
+	secondaryPriceFactor := k.getSecondaryStorePriceFactor(ctx, bucketInfo.EcProfile, internalBucketInfo.PriceTime)
+	preTotalChargeSize := internalBucketInfo.TotalChargeSize
+	preOutFlows := k.calculateLVGStoreBill(ctx, price, secondaryPriceFactor, versionedParams, gvgFamily, gvg, lvg, preTotalChargeSize)
 	var newOutFlows []types.OutFlow
 	if !delete { // seal object
 		internalBucketInfo.TotalChargeSize = internalBucketInfo.TotalChargeSize + chargeSize
 		lvg.TotalChargeSize = lvg.TotalChargeSize + chargeSize
-		newOutFlows = k.calculateLVGStoreBill(ctx, price, versionedParams, gvgFamily, gvg, lvg)
+		newOutFlows = k.calculateLVGStoreBill(ctx, price, secondaryPriceFactor, versionedParams, gvgFamily, gvg, lvg, internalBucketInfo.TotalChargeSize)
 	} else { // delete object
 		internalBucketInfo.TotalChargeSize = internalBucketInfo.TotalChargeSize - chargeSize
 		lvg.TotalChargeSize = lvg.TotalChargeSize - chargeSize
-		newOutFlows = k.calculateLVGStoreBill(ctx, price, versionedParams, gvgFamily, gvg, lvg)
+		newOutFlows = k.calculateLVGStoreBill(ctx, price, secondaryPriceFactor, versionedParams, gvgFamily, gvg, lvg, internalBucketInfo.TotalChargeSize)
 	}
 
 	userFlows.Flows = append(userFlows.Flows, getNegFlows(preOutFlows)...)
 	userFlows.Flows = append(userFlows.Flows, newOutFlows...)
+	// the flows caused by the object itself, which are used for early deletion
+	objectFlows := userFlows.Flows
+
+	// the store price tier of the bucket is changed, the other lvgs should be charged with the new tier too
+	if price.GetStorePriceTierIndex(preTotalChargeSize) != price.GetStorePriceTierIndex(internalBucketInfo.TotalChargeSize) {
+		objectFlows = append(getNegFlows(preOutFlows),
+			k.calculateLVGStoreBill(ctx, price, secondaryPriceFactor, versionedParams, gvgFamily, gvg, lvg, preTotalChargeSize)...)
+		for _, l := range internalBucketInfo.LocalVirtualGroups {
+			if l.Id == lvg.Id {
+				continue
+			}
+			g, found := k.virtualGroupKeeper.GetGVG(ctx, l.GlobalVirtualGroupId)
+			if !found {
+				return nil, fmt.Errorf("get GVG failed: %d, %s", l.GlobalVirtualGroupId, l.String())
+			}
+			userFlows.Flows = append(userFlows.Flows, getNegFlows(k.calculateLVGStoreBill(ctx, price, secondaryPriceFactor, versionedParams, gvgFamily, g, l, preTotalChargeSize))...)
+			userFlows.Flows = append(userFlows.Flows, k.calculateLVGStoreBill(ctx, price, secondaryPriceFactor, versionedParams, gvgFamily, g, l, internalBucketInfo.TotalChargeSize)...)
+		}
+	}
 
 	if ctx.IsUpgraded(upgradetypes.Erdos) {
 		var shouldApplyFlowRate = true
@@ -479,15 +517,19 @@ func (k Keeper) ChargeViaObjectChange(ctx sdk.Context, bucketInfo *storagetypes.
 	}
 
 	// merge outflows for early deletion usage
-	return k.paymentKeeper.MergeOutFlows(userFlows.Flows), nil
+	return k.paymentKeeper.MergeOutFlows(objectFlows), nil
 }
 
-func (k Keeper) calculateLVGStoreBill(ctx sdk.Context, price sptypes.GlobalSpStorePrice, params types.VersionedParams,
-	gvgFamily *vgtypes.GlobalVirtualGroupFamily, gvg *vgtypes.GlobalVirtualGroup, lvg *storagetypes.LocalVirtualGroup) []types.OutFlow {
+// calculateLVGStoreBill calculates the store bill of a lvg, the store prices are of the tier applied to
+// the total charge size of the bucket, and the secondary store price is scaled by the factor of the EC profile.
+func (k Keeper) calculateLVGStoreBill(ctx sdk.Context, price sptypes.GlobalSpStorePrice, secondaryPriceFactor sdk.Dec, params types.VersionedParams,
+	gvgFamily *vgtypes.GlobalVirtualGroupFamily, gvg *vgtypes.GlobalVirtualGroup, lvg *storagetypes.LocalVirtualGroup,
+	bucketChargeSize uint64) []types.OutFlow {
 	outFlows := make([]types.OutFlow, 0)
+	primaryStorePrice, secondaryStorePrice := price.GetStorePrice(bucketChargeSize)
 
 	// primary sp
-	primaryStoreFlowRate := price.PrimaryStorePrice.MulInt(sdkmath.NewIntFromUint64(lvg.TotalChargeSize)).TruncateInt()
+	primaryStoreFlowRate := primaryStorePrice.MulInt(sdkmath.NewIntFromUint64(lvg.TotalChargeSize)).TruncateInt()
 	if primaryStoreFlowRate.IsPositive() {
 		outFlows = append(outFlows, types.OutFlow{
 			ToAddress: gvgFamily.VirtualPaymentAddress,
@@ -496,7 +538,7 @@ func (k Keeper) calculateLVGStoreBill(ctx sdk.Context, price sptypes.GlobalSpSto
 	}
 
 	//secondary sp
-	secondaryStoreFlowRate := price.SecondaryStorePrice.MulInt(sdkmath.NewIntFromUint64(lvg.TotalChargeSize)).TruncateInt()
+	secondaryStoreFlowRate := secondaryStorePrice.Mul(secondaryPriceFactor).MulInt(sdkmath.NewIntFromUint64(lvg.TotalChargeSize)).TruncateInt()
 	secondaryStoreFlowRate = secondaryStoreFlowRate.MulRaw(int64(len(gvg.SecondarySpIds)))
 	if secondaryStoreFlowRate.IsPositive() {
 		outFlows = append(outFlows, types.OutFlow{
@@ -557,6 +599,7 @@ func (k Keeper) GetBucketReadStoreBill(ctx sdk.Context, bucketInfo *storagetypes
 	}
 
 	// calculate store fee
+	secondaryPriceFactor := k.getSecondaryStorePriceFactor(ctx, bucketInfo.EcProfile, internalBucketInfo.PriceTime)
 	// be noted, here we split the fee calculation for each lvg, to make sure each lvg's calculation is precise
 	for _, lvg := range internalBucketInfo.LocalVirtualGroups {
 		//secondary sp
@@ -564,7 +607,7 @@ func (k Keeper) GetBucketReadStoreBill(ctx sdk.Context, bucketInfo *storagetypes
 		if !found {
 			return userFlows, fmt.Errorf("get GVG failed: %d, %s", lvg.GlobalVirtualGroupId, lvg.String())
 		}
-		outFlows := k.calculateLVGStoreBill(ctx, price, versionedParams, gvgFamily, gvg, lvg)
+		outFlows := k.calculateLVGStoreBill(ctx, price, secondaryPriceFactor, versionedParams, gvgFamily, gvg, lvg, internalBucketInfo.TotalChargeSize)
 		userFlows.Flows = append(userFlows.Flows, outFlows...)
 	}
 
@@ -573,6 +616,7 @@ func (k Keeper) GetBucketReadStoreBill(ctx sdk.Context, bucketInfo *storagetypes
 
 func (k Keeper) UnChargeBucketReadStoreFee(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
 	internalBucketInfo *storagetypes.InternalBucketInfo) error {
+	ctx = types.WithStreamRecordChangeReason(ctx, types.STREAM_RECORD_CHANGE_REASON_BUCKET_FLOW_CHANGE, bucketInfo.BucketName)
 
 	if ctx.IsUpgraded(upgradetypes.Erdos) {
 		// if the bucket's flow rate limit is set to zero, no need to uncharge, since the bucket is already uncharged
@@ -595,6 +639,7 @@ func (k Keeper) UnChargeBucketReadStoreFee(ctx sdk.Context, bucketInfo *storaget
 
 func (k Keeper) ChargeBucketReadStoreFee(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
 	internalBucketInfo *storagetypes.InternalBucketInfo) error {
+	ctx = types.WithStreamRecordChangeReason(ctx, types.STREAM_RECORD_CHANGE_REASON_BUCKET_FLOW_CHANGE, bucketInfo.BucketName)
 	internalBucketInfo.PriceTime = ctx.BlockTime().Unix()
 	bill, err := k.GetBucketReadStoreBill(ctx, bucketInfo, internalBucketInfo)
 	if err != nil {
@@ -648,7 +693,9 @@ func getNegFlows(flows []types.OutFlow) (negFlows []types.OutFlow) {
 	return negFlows
 }
 
-func (k Keeper) GetObjectLockFee(ctx sdk.Context, priceTime int64, payloadSize uint64) (amount, rate sdkmath.Int, err error) {
+// GetObjectLockFee calculates the lock fee of an object with the base store prices, which are not less than
+// the prices of any store price tier. The secondary store price is charged for each secondary sp of the EC profile.
+func (k Keeper) GetObjectLockFee(ctx sdk.Context, ecProfile *storagetypes.ECProfile, priceTime int64, payloadSize uint64) (amount, rate sdkmath.Int, err error) {
 	price, err := k.spKeeper.GetGlobalSpStorePriceByTime(ctx, priceTime)
 	if err != nil {
 		return amount, rate, fmt.Errorf("get store price failed: %d %w", priceTime, err)
@@ -660,8 +707,9 @@ func (k Keeper) GetObjectLockFee(ctx sdk.Context, priceTime int64, payloadSize u
 
 	primaryRate := price.PrimaryStorePrice.MulInt(sdkmath.NewIntFromUint64(chargeSize)).TruncateInt()
 
-	secondarySPNum := int64(k.GetExpectSecondarySPNumForECObject(ctx, priceTime))
-	secondaryRate := price.SecondaryStorePrice.MulInt(sdkmath.NewIntFromUint64(chargeSize)).TruncateInt()
+	secondarySPNum := int64(k.GetExpectSecondarySPNum(ctx, ecProfile, priceTime))
+	secondaryPriceFactor := k.getSecondaryStorePriceFactor(ctx, ecProfile, priceTime)
+	secondaryRate := price.SecondaryStorePrice.Mul(secondaryPriceFactor).MulInt(sdkmath.NewIntFromUint64(chargeSize)).TruncateInt()
 	secondaryRate = secondaryRate.MulRaw(int64(secondarySPNum))
 
 	versionedParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, priceTime)
@@ -687,3 +735,63 @@ func (k Keeper) GetObjectChargeSize(ctx sdk.Context, payloadSize uint64, ts int6
 		return payloadSize, nil
 	}
 }
+
+// SecondsPerMonth is the duration of a month (30 days) used for the monthly cost estimation.
+const SecondsPerMonth = 30 * 24 * 60 * 60
+
+// EstimateBucketCost calculates the flow rates and the balances required for a prospective bucket with the given
+// objects, using the same pricing logic as charging the real buckets.
+func (k Keeper) EstimateBucketCost(ctx sdk.Context, ecProfile *storagetypes.ECProfile, priceTime int64, objectSizes []uint64, chargedReadQuota uint64) (*storagetypes.QueryEstimateCostResponse, error) {
+	price, err := k.spKeeper.GetGlobalSpStorePriceByTime(ctx, priceTime)
+	if err != nil {
+		return nil, fmt.Errorf("get storage price failed: %d %w", priceTime, err)
+	}
+	versionedParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, priceTime)
+	if err != nil {
+		return nil, fmt.Errorf("get versioned params failed: %d %w", priceTime, err)
+	}
+
+	// the objects of a bucket are charged by the local virtual groups, here they are assumed to be in the same one
+	lvg := &storagetypes.LocalVirtualGroup{}
+	lockFee := sdkmath.ZeroInt()
+	for _, size := range objectSizes {
+		chargeSize, err := k.GetObjectChargeSize(ctx, size, priceTime)
+		if err != nil {
+			return nil, err
+		}
+		lvg.TotalChargeSize += chargeSize
+
+		amount, _, err := k.GetObjectLockFee(ctx, ecProfile, priceTime, size)
+		if err != nil {
+			return nil, err
+		}
+		lockFee = lockFee.Add(amount)
+	}
+	gvgFamily := &vgtypes.GlobalVirtualGroupFamily{}
+	gvg := &vgtypes.GlobalVirtualGroup{
+		SecondarySpIds: make([]uint32, k.GetExpectSecondarySPNum(ctx, ecProfile, priceTime)),
+	}
+
+	readRate := sdkmath.ZeroInt()
+	for _, flow := range k.calculateReadBill(price, versionedParams, gvgFamily, chargedReadQuota) {
+		readRate = readRate.Add(flow.Rate)
+	}
+	storeRate := sdkmath.ZeroInt()
+	if lvg.TotalChargeSize > 0 {
+		secondaryPriceFactor := k.getSecondaryStorePriceFactor(ctx, ecProfile, priceTime)
+		for _, flow := range k.calculateLVGStoreBill(ctx, price, secondaryPriceFactor, versionedParams, gvgFamily, gvg, lvg, lvg.TotalChargeSize) {
+			storeRate = storeRate.Add(flow.Rate)
+		}
+	}
+	rate := readRate.Add(storeRate)
+
+	return &storagetypes.QueryEstimateCostResponse{
+		ReadRate:      readRate,
+		StoreRate:     storeRate,
+		Rate:          rate,
+		MonthlyCost:   rate.MulRaw(SecondsPerMonth),
+		LockFee:       lockFee,
+		BufferBalance: rate.Mul(sdkmath.NewIntFromUint64(versionedParams.ReserveTime)),
+		ReserveTime:   versionedParams.ReserveTime,
+	}, nil
+}
@@ -128,7 +128,7 @@ Exit:
 					payloadSize = shadowObject.PayloadSize
 				}
 
-				lockAmount, _, err := k.GetObjectLockFee(ctx, priceTime, payloadSize)
+				lockAmount, _, err := k.GetObjectLockFee(ctx, bucket.EcProfile, priceTime, payloadSize)
 				if err != nil {
 					result = errors.New("get object lock fee failed")
 					ctx.Logger().Error("get object lock fee failed", "bucket", bucket.BucketName, "object", objectInfo.ObjectName, "error", err)
@@ -122,13 +122,57 @@ func (s *TestSuite) TestGetObjectLockFee() {
 	// verify lock fee calculation
 	timeNow := time.Now().Unix() + 1
 	payloadSize := int64(10 * 1024 * 1024)
-	amount, _, err := s.storageKeeper.GetObjectLockFee(s.ctx, timeNow, uint64(payloadSize))
+	amount, _, err := s.storageKeeper.GetObjectLockFee(s.ctx, nil, timeNow, uint64(payloadSize))
 	s.Require().NoError(err)
 	secondarySPNum := int64(s.storageKeeper.GetExpectSecondarySPNumForECObject(s.ctx, timeNow))
 	spRate := price.PrimaryStorePrice.Add(price.SecondaryStorePrice.MulInt64(secondarySPNum)).MulInt64(payloadSize)
 	validatorTaxRate := params.VersionedParams.ValidatorTaxRate.MulInt(spRate.TruncateInt())
 	expectedAmount := spRate.Add(validatorTaxRate).MulInt64(int64(params.VersionedParams.ReserveTime)).TruncateInt()
 	s.Require().True(amount.Equal(expectedAmount))
+
+	// the secondary store price is charged for each secondary sp of the EC profile, and scaled by the
+	// data chunk num of the default profile to that of the EC profile
+	ecProfile := &types.ECProfile{DataChunkNum: 8, ParityChunkNum: 4}
+	amount, _, err = s.storageKeeper.GetObjectLockFee(s.ctx, ecProfile, timeNow, uint64(payloadSize))
+	s.Require().NoError(err)
+	versionedParams, err := s.storageKeeper.GetVersionedParamsWithTs(s.ctx, timeNow)
+	s.Require().NoError(err)
+	secondaryPrice := price.SecondaryStorePrice.MulInt64(int64(versionedParams.RedundantDataChunkNum)).QuoInt64(8)
+	spRate = price.PrimaryStorePrice.Add(secondaryPrice.MulInt64(12)).MulInt64(payloadSize)
+	validatorTaxRate = params.VersionedParams.ValidatorTaxRate.MulInt(spRate.TruncateInt())
+	expectedAmount = spRate.Add(validatorTaxRate).MulInt64(int64(params.VersionedParams.ReserveTime)).TruncateInt()
+	s.Require().True(amount.Equal(expectedAmount))
+}
+
+func (s *TestSuite) TestEstimateBucketCost() {
+	price := sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdk.NewDec(100),
+		PrimaryStorePrice:   sdk.NewDec(1000),
+		SecondaryStorePrice: sdk.NewDec(500),
+	}
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).
+		Return(price, nil).AnyTimes()
+	params := paymenttypes.DefaultParams()
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).
+		Return(params.VersionedParams, nil).AnyTimes()
+
+	timeNow := time.Now().Unix() + 1
+	payloadSize := uint64(10 * 1024 * 1024)
+	chargedReadQuota := uint64(1000)
+	resp, err := s.storageKeeper.EstimateBucketCost(s.ctx, nil, timeNow, []uint64{payloadSize, payloadSize}, chargedReadQuota)
+	s.Require().NoError(err)
+
+	readRate := price.ReadPrice.MulInt64(int64(chargedReadQuota))
+	expectedReadRate := readRate.Add(params.VersionedParams.ValidatorTaxRate.Mul(readRate)).TruncateInt()
+	s.Require().True(resp.ReadRate.Equal(expectedReadRate))
+
+	lockFee, storeRate, err := s.storageKeeper.GetObjectLockFee(s.ctx, nil, timeNow, payloadSize)
+	s.Require().NoError(err)
+	s.Require().True(resp.StoreRate.Equal(storeRate.MulRaw(2)))
+	s.Require().True(resp.LockFee.Equal(lockFee.MulRaw(2)))
+	s.Require().True(resp.Rate.Equal(resp.ReadRate.Add(resp.StoreRate)))
+	s.Require().True(resp.MonthlyCost.Equal(resp.Rate.MulRaw(keeper.SecondsPerMonth)))
+	s.Require().True(resp.BufferBalance.Equal(resp.Rate.MulRaw(int64(params.VersionedParams.ReserveTime))))
 }
 
 func (s *TestSuite) TestGetBucketReadBill() {
@@ -312,3 +356,63 @@ func (s *TestSuite) TestGetBucketReadStoreBill() {
 	taxPoolRate = params.VersionedParams.ValidatorTaxRate.MulInt(primaryStoreRate.Add(gvg2StoreRate)).TruncateInt()
 	s.Require().Equal(flows.Flows[7].Rate, taxPoolRate)
 }
+
+func (s *TestSuite) TestGetBucketReadStoreBillWithPriceTier() {
+	gvgFamily := &virtualgroupmoduletypes.GlobalVirtualGroupFamily{
+		Id:                    1,
+		VirtualPaymentAddress: sample.RandAccAddress().String(),
+	}
+	s.virtualGroupKeeper.EXPECT().GetGVGFamily(gomock.Any(), gomock.Any()).
+		Return(gvgFamily, true).AnyTimes()
+
+	price := sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdk.NewDec(100),
+		PrimaryStorePrice:   sdk.NewDec(1000),
+		SecondaryStorePrice: sdk.NewDec(500),
+		StorePriceTiers: []sptypes.GlobalStorePriceTier{
+			{ChargeSizeThreshold: 300, PrimaryStorePrice: sdk.NewDec(800), SecondaryStorePrice: sdk.NewDec(400)},
+		},
+	}
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).
+		Return(price, nil).AnyTimes()
+	params := paymenttypes.DefaultParams()
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).
+		Return(params.VersionedParams, nil).AnyTimes()
+
+	bucketInfo := &types.BucketInfo{
+		BucketName:                 "bucketname",
+		Id:                         sdk.NewUint(1),
+		PaymentAddress:             sample.RandAccAddress().String(),
+		GlobalVirtualGroupFamilyId: gvgFamily.Id,
+	}
+	lvg := &types.LocalVirtualGroup{
+		Id:                   1,
+		TotalChargeSize:      200,
+		GlobalVirtualGroupId: 1,
+	}
+	internalBucketInfo := &types.InternalBucketInfo{
+		TotalChargeSize:    200,
+		LocalVirtualGroups: []*types.LocalVirtualGroup{lvg},
+	}
+	gvg := &virtualgroupmoduletypes.GlobalVirtualGroup{
+		Id:                    1,
+		SecondarySpIds:        []uint32{101, 102, 103, 104, 105, 106},
+		VirtualPaymentAddress: sample.RandAccAddress().String(),
+	}
+	s.virtualGroupKeeper.EXPECT().GetGVG(gomock.Any(), gvg.Id).
+		Return(gvg, true).AnyTimes()
+
+	// below the threshold, the base price is applied
+	flows, err := s.storageKeeper.GetBucketReadStoreBill(s.ctx, bucketInfo, internalBucketInfo)
+	s.Require().NoError(err)
+	s.Require().Equal(price.PrimaryStorePrice.MulInt64(200).TruncateInt(), flows.Flows[0].Rate)
+	s.Require().Equal(price.SecondaryStorePrice.MulInt64(200*6).TruncateInt(), flows.Flows[1].Rate)
+
+	// the bucket reaches the threshold, the tier price is applied
+	lvg.TotalChargeSize = 300
+	internalBucketInfo.TotalChargeSize = 300
+	flows, err = s.storageKeeper.GetBucketReadStoreBill(s.ctx, bucketInfo, internalBucketInfo)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(800*300), flows.Flows[0].Rate)
+	s.Require().Equal(sdk.NewInt(400*300*6), flows.Flows[1].Rate)
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+	virtualgroupmoduletypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+// setPlacementConstrainedBucket indexes a bucket with placement constraint by its gvg family,
+// so that the storage providers joining the family can be checked against the constraint.
+func (k Keeper) setPlacementConstrainedBucket(ctx sdk.Context, familyID uint32, bucketID sdkmath.Uint) {
+	ctx.KVStore(k.storeKey).Set(types.GetPlacementConstrainedBucketKey(familyID, bucketID), []byte{0x01})
+}
+
+func (k Keeper) deletePlacementConstrainedBucket(ctx sdk.Context, familyID uint32, bucketID sdkmath.Uint) {
+	ctx.KVStore(k.storeKey).Delete(types.GetPlacementConstrainedBucketKey(familyID, bucketID))
+}
+
+// checkPlacementConstraintOfSP checks the location of a storage provider against the placement constraint of a bucket
+func (k Keeper) checkPlacementConstraintOfSP(ctx sdk.Context, constraint *types.PlacementConstraint, spID uint32) error {
+	if constraint.IsEmpty() {
+		return nil
+	}
+	sp, found := k.spKeeper.GetStorageProvider(ctx, spID)
+	if !found {
+		return errors.Wrapf(types.ErrNoSuchStorageProvider, "sp id: %d", spID)
+	}
+	if !constraint.IsSatisfiedBy(sp.Location) {
+		return types.ErrPlacementConstraint.Wrapf("the location of sp(ID=%d), region: %s, jurisdiction: %s, is not allowed",
+			sp.Id, sp.Location.Region, sp.Location.Jurisdiction)
+	}
+	return nil
+}
+
+// checkPlacementConstraintOfGVG checks the primary sp and every secondary sp of a gvg against the placement constraint of a bucket
+func (k Keeper) checkPlacementConstraintOfGVG(ctx sdk.Context, constraint *types.PlacementConstraint, gvg *virtualgroupmoduletypes.GlobalVirtualGroup) error {
+	if constraint.IsEmpty() {
+		return nil
+	}
+	if err := k.checkPlacementConstraintOfSP(ctx, constraint, gvg.PrimarySpId); err != nil {
+		return err
+	}
+	for _, sspID := range gvg.SecondarySpIds {
+		if err := k.checkPlacementConstraintOfSP(ctx, constraint, sspID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkPlacementConstraintOfFamily checks the primary sp and every secondary sp of the gvgs in a family against the placement constraint of a bucket
+func (k Keeper) checkPlacementConstraintOfFamily(ctx sdk.Context, constraint *types.PlacementConstraint, family *virtualgroupmoduletypes.GlobalVirtualGroupFamily) error {
+	if constraint.IsEmpty() {
+		return nil
+	}
+	if err := k.checkPlacementConstraintOfSP(ctx, constraint, family.PrimarySpId); err != nil {
+		return err
+	}
+	for _, gvgID := range family.GlobalVirtualGroupIds {
+		gvg, found := k.virtualGroupKeeper.GetGVG(ctx, gvgID)
+		if !found {
+			return virtualgroupmoduletypes.ErrGVGNotExist
+		}
+		if err := k.checkPlacementConstraintOfGVG(ctx, constraint, gvg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerifySPPlacementInFamily checks whether a storage provider joining a gvg family, either as the primary sp or
+// as a secondary sp of its gvgs, would violate the placement constraint of any bucket served by the family.
+func (k Keeper) VerifySPPlacementInFamily(ctx sdk.Context, familyID uint32, spID uint32) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetPlacementConstrainedBucketsByFamilyPrefix(familyID))
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return nil
+	}
+
+	sp, found := k.spKeeper.GetStorageProvider(ctx, spID)
+	if !found {
+		return errors.Wrapf(types.ErrNoSuchStorageProvider, "sp id: %d", spID)
+	}
+	for ; iterator.Valid(); iterator.Next() {
+		bucketID := k.bucketSeq.DecodeSequence(iterator.Key())
+		bucketInfo, found := k.GetBucketInfoById(ctx, bucketID)
+		if !found {
+			continue
+		}
+		if !bucketInfo.PlacementConstraint.IsSatisfiedBy(sp.Location) {
+			return types.ErrPlacementConstraint.Wrapf("the location of sp(ID=%d) is not allowed by bucket %s", sp.Id, bucketInfo.BucketName)
+		}
+	}
+	return nil
+}
+
+// VerifySPLocation checks whether a storage provider moving to the location would violate the placement constraint
+// of any bucket it serves, either as the primary sp of the family or as a secondary sp of a gvg in the family.
+func (k Keeper) VerifySPLocation(ctx sdk.Context, spID uint32, location sptypes.SpLocation) error {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.PlacementConstrainedBucketPrefix)
+	defer iterator.Close()
+
+	served := make(map[uint32]bool)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.PlacementConstrainedBucketPrefix):]
+		familyID := binary.BigEndian.Uint32(key[:4])
+		isServed, found := served[familyID]
+		if !found {
+			isServed = k.isFamilyServedBySP(ctx, familyID, spID)
+			served[familyID] = isServed
+		}
+		if !isServed {
+			continue
+		}
+		bucketInfo, found := k.GetBucketInfoById(ctx, k.bucketSeq.DecodeSequence(key[4:]))
+		if !found {
+			continue
+		}
+		if !bucketInfo.PlacementConstraint.IsSatisfiedBy(location) {
+			return types.ErrPlacementConstraint.Wrapf("the location of sp(ID=%d), region: %s, jurisdiction: %s, is not allowed by bucket %s",
+				spID, location.Region, location.Jurisdiction, bucketInfo.BucketName)
+		}
+	}
+	return nil
+}
+
+// isFamilyServedBySP returns whether the storage provider is the primary sp of the family or a secondary sp of its gvgs
+func (k Keeper) isFamilyServedBySP(ctx sdk.Context, familyID uint32, spID uint32) bool {
+	family, found := k.virtualGroupKeeper.GetGVGFamily(ctx, familyID)
+	if !found {
+		return false
+	}
+	if family.PrimarySpId == spID {
+		return true
+	}
+	for _, gvgID := range family.GlobalVirtualGroupIds {
+		gvg, found := k.virtualGroupKeeper.GetGVG(ctx, gvgID)
+		if !found {
+			continue
+		}
+		for _, sspID := range gvg.SecondarySpIds {
+			if sspID == spID {
+				return true
+			}
+		}
+	}
+	return false
+}
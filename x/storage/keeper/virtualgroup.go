@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"cosmossdk.io/math"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/prysmaticlabs/prysm/crypto/bls"
 
+	"github.com/bnb-chain/greenfield/internal/sequence"
 	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/x/storage/types"
 	vgtypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
@@ -24,6 +26,7 @@ func (k Keeper) DeleteObjectFromVirtualGroup(ctx sdk.Context, bucketInfo *types.
 
 	lvg.StoredSize -= objectInfo.PayloadSize
 	gvg.StoredSize -= objectInfo.PayloadSize
+	ctx.KVStore(k.storeKey).Delete(types.GetObjectByGVGKey(gvg.Id, bucketInfo.Id, objectInfo.Id))
 
 	// delete lvg when total charge size is 0
 	if lvg.TotalChargeSize == 0 {
@@ -78,6 +81,13 @@ func (k Keeper) RebindingVirtualGroup(ctx sdk.Context, bucketInfo *types.BucketI
 			return types.ErrVirtualGroupOperateFailed.Wrapf("src global virtual group not found in blockchain state. ID: %d", lvg.GlobalVirtualGroupId)
 		}
 
+		// the objects are stored by the secondary sps of the dst gvg with the same redundancy as before
+		expectSecondarySPNum := k.GetExpectSecondarySPNum(ctx, bucketInfo.EcProfile, ctx.BlockTime().Unix())
+		if len(dstGVG.SecondarySpIds) != len(srcGVG.SecondarySpIds) || len(dstGVG.SecondarySpIds) != int(expectSecondarySPNum) {
+			return types.ErrInvalidGlobalVirtualGroup.Wrapf("secondary sp num of dst global virtual group %d mismatch, expect (%d), src (%d), but (%d)",
+				dstGVGID, expectSecondarySPNum, len(srcGVG.SecondarySpIds), len(dstGVG.SecondarySpIds))
+		}
+
 		err := k.virtualGroupKeeper.SettleAndDistributeGVG(ctx, srcGVG)
 		if err != nil {
 			return types.ErrVirtualGroupOperateFailed.Wrapf("fail to settle gvg. ID: %d", srcGVG.Id)
@@ -86,6 +96,7 @@ func (k Keeper) RebindingVirtualGroup(ctx sdk.Context, bucketInfo *types.BucketI
 		srcGVG.StoredSize -= lvg.StoredSize
 		dstGVG.StoredSize += lvg.StoredSize
 
+		k.moveObjectsByGVG(ctx, bucketInfo.Id, srcGVG.Id, dstGVGID)
 		lvg.GlobalVirtualGroupId = dstGVGID
 
 		if err := k.virtualGroupKeeper.SetGVGAndEmitUpdateEvent(ctx, srcGVG); err != nil {
@@ -115,20 +126,110 @@ func (k Keeper) RebindingVirtualGroup(ctx sdk.Context, bucketInfo *types.BucketI
 	return nil
 }
 
+// moveObjectsByGVG moves the index entries of the objects of a bucket from the src gvg to the dst gvg
+func (k Keeper) moveObjectsByGVG(ctx sdk.Context, bucketID math.Uint, srcGVGID, dstGVGID uint32) {
+	store := ctx.KVStore(k.storeKey)
+	srcPrefix := types.GetObjectsByGVGAndBucketPrefix(srcGVGID, bucketID)
+
+	var keys [][]byte
+	iterator := storetypes.KVStorePrefixIterator(store, srcPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	var seq sequence.Sequence[math.Uint]
+	for _, key := range keys {
+		store.Delete(key)
+		store.Set(types.GetObjectByGVGKey(dstGVGID, bucketID, seq.DecodeSequence(key[len(srcPrefix):])), []byte{0x01})
+	}
+}
+
+// BackfillGVGIndexes builds the indexes of the buckets by gvg family and the objects by gvg for the buckets and objects
+// existing before the indexes are introduced, the indexes which already exist are kept as they are.
+func (k Keeper) BackfillGVGIndexes(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	bucketIterator := storetypes.KVStorePrefixIterator(store, types.BucketByIDPrefix)
+	bucketIDs := make(map[string]math.Uint)
+	for ; bucketIterator.Valid(); bucketIterator.Next() {
+		var bucketInfo types.BucketInfo
+		k.cdc.MustUnmarshal(bucketIterator.Value(), &bucketInfo)
+		bucketIDs[bucketInfo.BucketName] = bucketInfo.Id
+		store.Set(types.GetBucketByGVGFamilyKey(bucketInfo.GlobalVirtualGroupFamilyId, bucketInfo.Id), []byte{0x01})
+	}
+	bucketIterator.Close()
+
+	internalBucketInfos := make(map[string]*types.InternalBucketInfo)
+	objectIterator := storetypes.KVStorePrefixIterator(store, types.ObjectByIDPrefix)
+	defer objectIterator.Close()
+	for ; objectIterator.Valid(); objectIterator.Next() {
+		var objectInfo types.ObjectInfo
+		k.cdc.MustUnmarshal(objectIterator.Value(), &objectInfo)
+		// when object was not sealed, the lvg id is 0 by default.
+		if objectInfo.LocalVirtualGroupId == 0 {
+			continue
+		}
+		bucketID, found := bucketIDs[objectInfo.BucketName]
+		if !found {
+			continue
+		}
+		internalBucketInfo, found := internalBucketInfos[objectInfo.BucketName]
+		if !found {
+			internalBucketInfo = k.MustGetInternalBucketInfo(ctx, bucketID)
+			internalBucketInfos[objectInfo.BucketName] = internalBucketInfo
+		}
+		lvg, found := internalBucketInfo.GetLVG(objectInfo.LocalVirtualGroupId)
+		if !found {
+			ctx.Logger().Error("lvg of object not found", "bucket", objectInfo.BucketName, "object", objectInfo.ObjectName, "lvg", objectInfo.LocalVirtualGroupId)
+			continue
+		}
+		store.Set(types.GetObjectByGVGKey(lvg.GlobalVirtualGroupId, bucketID, objectInfo.Id), []byte{0x01})
+	}
+}
+
+// maxBlsKeyCombinations limits the combinations of the bls keys tried when the secondary sps are rotating their keys
+const maxBlsKeyCombinations = 16
+
 func (k Keeper) VerifyGVGSecondarySPsBlsSignature(ctx sdk.Context, gvg *vgtypes.GlobalVirtualGroup, signHash [32]byte, signature []byte) error {
-	secondarySpBlsPubKeys := make([]bls.PublicKey, 0, len(gvg.SecondarySpIds))
+	secondarySpBlsPubKeys := make([][]bls.PublicKey, 0, len(gvg.SecondarySpIds))
+	combinations := 1
 	for _, spId := range gvg.GetSecondarySpIds() {
 		secondarySp, found := k.spKeeper.GetStorageProvider(ctx, spId)
 		if !found {
 			panic("should not happen")
 		}
-		spBlsPubKey, err := bls.PublicKeyFromBytes(secondarySp.BlsKey)
-		if err != nil {
-			return types.ErrInvalidBlsPubKey.Wrapf("BLS public key converts failed: %v", err)
+		blsKeys := k.spKeeper.GetSpBlsKeys(ctx, secondarySp)
+		if combinations*len(blsKeys) > maxBlsKeyCombinations {
+			blsKeys = blsKeys[:1]
+		}
+		combinations *= len(blsKeys)
+		spBlsPubKeys := make([]bls.PublicKey, 0, len(blsKeys))
+		for _, blsKey := range blsKeys {
+			spBlsPubKey, err := bls.PublicKeyFromBytes(blsKey)
+			if err != nil {
+				return types.ErrInvalidBlsPubKey.Wrapf("BLS public key converts failed: %v", err)
+			}
+			spBlsPubKeys = append(spBlsPubKeys, spBlsPubKey)
+		}
+		secondarySpBlsPubKeys = append(secondarySpBlsPubKeys, spBlsPubKeys)
+	}
+
+	// the signature is accepted if it is aggregated by the current keys, or by the replaced keys of the
+	// secondary sps in the overlap periods of key rotations
+	var err error
+	pubKeys := make([]bls.PublicKey, len(secondarySpBlsPubKeys))
+	for i := 0; i < combinations; i++ {
+		c := i
+		for j, spBlsPubKeys := range secondarySpBlsPubKeys {
+			pubKeys[j] = spBlsPubKeys[c%len(spBlsPubKeys)]
+			c /= len(spBlsPubKeys)
+		}
+		if err = gnfdtypes.VerifyBlsAggSignature(pubKeys, signHash, signature); err == nil {
+			return nil
 		}
-		secondarySpBlsPubKeys = append(secondarySpBlsPubKeys, spBlsPubKey)
 	}
-	return gnfdtypes.VerifyBlsAggSignature(secondarySpBlsPubKeys, signHash, signature)
+	return err
 }
 
 func (k Keeper) SealObjectOnVirtualGroup(ctx sdk.Context, bucketInfo *types.BucketInfo, gvgID uint32, objectInfo *types.ObjectInfo) (*types.LocalVirtualGroup, error) {
@@ -156,6 +257,7 @@ func (k Keeper) SealObjectOnVirtualGroup(ctx sdk.Context, bucketInfo *types.Buck
 	lvg.StoredSize += objectInfo.PayloadSize
 	gvg.StoredSize += objectInfo.PayloadSize
 	objectInfo.LocalVirtualGroupId = lvg.Id
+	ctx.KVStore(k.storeKey).Set(types.GetObjectByGVGKey(gvg.Id, bucketInfo.Id, objectInfo.Id), []byte{0x01})
 
 	if objectInfo.PayloadSize == 0 {
 		// unlock and charge store fee
@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+	virtualgroupmoduletypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func (s *TestSuite) mockGVGIndexesKeepers(gvgs map[uint32]*virtualgroupmoduletypes.GlobalVirtualGroup) {
+	s.virtualGroupKeeper.EXPECT().GetGVGFamily(gomock.Any(), gomock.Any()).
+		Return(&virtualgroupmoduletypes.GlobalVirtualGroupFamily{Id: 1, VirtualPaymentAddress: sample.RandAccAddress().String()}, true).AnyTimes()
+	s.virtualGroupKeeper.EXPECT().GetGVG(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, id uint32) (*virtualgroupmoduletypes.GlobalVirtualGroup, bool) {
+			gvg, found := gvgs[id]
+			return gvg, found
+		}).AnyTimes()
+	s.virtualGroupKeeper.EXPECT().GetGlobalVirtualGroupIfAvailable(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, id uint32, _ uint64) (*virtualgroupmoduletypes.GlobalVirtualGroup, error) {
+			return gvgs[id], nil
+		}).AnyTimes()
+	s.virtualGroupKeeper.EXPECT().SetGVGAndEmitUpdateEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.virtualGroupKeeper.EXPECT().SettleAndDistributeGVG(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).Return(sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdk.NewDec(100),
+		PrimaryStorePrice:   sdk.NewDec(1000),
+		SecondaryStorePrice: sdk.NewDec(500),
+	}, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).
+		Return(paymenttypes.DefaultParams().VersionedParams, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().ApplyUserFlowsList(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.paymentKeeper.EXPECT().MergeOutFlows(gomock.Any()).DoAndReturn(
+		func(flows []paymenttypes.OutFlow) []paymenttypes.OutFlow {
+			return flows
+		}).AnyTimes()
+}
+
+func (s *TestSuite) listObjectIDsByGVG(gvgID uint32) []math.Uint {
+	resp, err := s.queryClient.ListObjectsByGlobalVirtualGroup(s.ctx, &types.QueryListObjectsByGlobalVirtualGroupRequest{GlobalVirtualGroupId: gvgID})
+	s.Require().NoError(err)
+	ids := make([]math.Uint, 0, len(resp.ObjectInfos))
+	for _, objectInfo := range resp.ObjectInfos {
+		ids = append(ids, objectInfo.Id)
+	}
+	return ids
+}
+
+func (s *TestSuite) listBucketIDsByGVGFamily(familyID uint32) []math.Uint {
+	resp, err := s.queryClient.ListBucketsByGlobalVirtualGroupFamily(s.ctx, &types.QueryListBucketsByGlobalVirtualGroupFamilyRequest{GlobalVirtualGroupFamilyId: familyID})
+	s.Require().NoError(err)
+	ids := make([]math.Uint, 0, len(resp.BucketInfos))
+	for _, bucketInfo := range resp.BucketInfos {
+		ids = append(ids, bucketInfo.Id)
+	}
+	return ids
+}
+
+func (s *TestSuite) TestObjectByGVGIndex() {
+	// the versioned params are effective after the time they are set
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Second))
+	secondarySPNum := s.storageKeeper.GetExpectSecondarySPNumForECObject(s.ctx, s.ctx.BlockTime().Unix())
+	gvgs := map[uint32]*virtualgroupmoduletypes.GlobalVirtualGroup{
+		1: {Id: 1, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: make([]uint32, secondarySPNum)},
+		2: {Id: 2, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: make([]uint32, secondarySPNum)},
+	}
+	s.mockGVGIndexesKeepers(gvgs)
+
+	bucketInfo := &types.BucketInfo{
+		Id:                         math.NewUint(1),
+		BucketName:                 "bucket",
+		Owner:                      sample.RandAccAddress().String(),
+		PaymentAddress:             sample.RandAccAddress().String(),
+		GlobalVirtualGroupFamilyId: 1,
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+	s.storageKeeper.SetInternalBucketInfo(s.ctx, bucketInfo.Id, &types.InternalBucketInfo{PriceTime: s.ctx.BlockTime().Unix()})
+
+	// the sealed objects are indexed by the gvg
+	objectInfos := []*types.ObjectInfo{
+		{Id: math.NewUint(1), BucketName: bucketInfo.BucketName, ObjectName: "object1", CreateAt: s.ctx.BlockTime().Unix()},
+		{Id: math.NewUint(2), BucketName: bucketInfo.BucketName, ObjectName: "object2", CreateAt: s.ctx.BlockTime().Unix()},
+	}
+	for _, objectInfo := range objectInfos {
+		_, err := s.storageKeeper.SealObjectOnVirtualGroup(s.ctx, bucketInfo, 1, objectInfo)
+		s.Require().NoError(err)
+		s.storageKeeper.StoreObjectInfo(s.ctx, objectInfo)
+	}
+	s.Require().Equal([]math.Uint{objectInfos[0].Id, objectInfos[1].Id}, s.listObjectIDsByGVG(1))
+
+	// the deleted object is removed from the index
+	err := s.storageKeeper.DeleteObjectFromVirtualGroup(s.ctx, bucketInfo, objectInfos[0])
+	s.Require().NoError(err)
+	s.storageKeeper.DeleteObjectInfo(s.ctx, objectInfos[0])
+	s.Require().Equal([]math.Uint{objectInfos[1].Id}, s.listObjectIDsByGVG(1))
+
+	// the objects are moved to the dst gvg when the bucket is rebound
+	internalBucketInfo := s.storageKeeper.MustGetInternalBucketInfo(s.ctx, bucketInfo.Id)
+	err = s.storageKeeper.RebindingVirtualGroup(s.ctx, bucketInfo, internalBucketInfo, []*types.GVGMapping{
+		{SrcGlobalVirtualGroupId: 1, DstGlobalVirtualGroupId: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(s.listObjectIDsByGVG(1))
+	s.Require().Equal([]math.Uint{objectInfos[1].Id}, s.listObjectIDsByGVG(2))
+
+	// the dst gvg must have as many secondary sps as the src gvg
+	gvgs[3] = &virtualgroupmoduletypes.GlobalVirtualGroup{Id: 3, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: make([]uint32, secondarySPNum-1)}
+	internalBucketInfo = s.storageKeeper.MustGetInternalBucketInfo(s.ctx, bucketInfo.Id)
+	err = s.storageKeeper.RebindingVirtualGroup(s.ctx, bucketInfo, internalBucketInfo, []*types.GVGMapping{
+		{SrcGlobalVirtualGroupId: 2, DstGlobalVirtualGroupId: 3},
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidGlobalVirtualGroup)
+}
+
+func (s *TestSuite) TestBackfillGVGIndexes() {
+	s.mockGVGIndexesKeepers(nil)
+
+	// the buckets and objects stored before the indexes are introduced
+	bucketInfo := &types.BucketInfo{
+		Id:                         math.NewUint(1),
+		BucketName:                 "bucket",
+		GlobalVirtualGroupFamilyId: 2,
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+	s.storageKeeper.SetInternalBucketInfo(s.ctx, bucketInfo.Id, &types.InternalBucketInfo{
+		LocalVirtualGroups: []*types.LocalVirtualGroup{
+			{Id: 1, GlobalVirtualGroupId: 3},
+			{Id: 2, GlobalVirtualGroupId: 4},
+		},
+	})
+	sealedObjects := []*types.ObjectInfo{
+		{Id: math.NewUint(1), BucketName: bucketInfo.BucketName, ObjectName: "object1", LocalVirtualGroupId: 1},
+		{Id: math.NewUint(2), BucketName: bucketInfo.BucketName, ObjectName: "object2", LocalVirtualGroupId: 2},
+	}
+	for _, objectInfo := range sealedObjects {
+		s.storageKeeper.StoreObjectInfo(s.ctx, objectInfo)
+	}
+	// the object which is not sealed is not indexed
+	s.storageKeeper.StoreObjectInfo(s.ctx, &types.ObjectInfo{Id: math.NewUint(3), BucketName: bucketInfo.BucketName, ObjectName: "object3"})
+
+	s.Require().Empty(s.listBucketIDsByGVGFamily(2))
+	s.Require().Empty(s.listObjectIDsByGVG(3))
+
+	s.storageKeeper.BackfillGVGIndexes(s.ctx)
+	s.Require().Equal([]math.Uint{bucketInfo.Id}, s.listBucketIDsByGVGFamily(2))
+	s.Require().Equal([]math.Uint{sealedObjects[0].Id}, s.listObjectIDsByGVG(3))
+	s.Require().Equal([]math.Uint{sealedObjects[1].Id}, s.listObjectIDsByGVG(4))
+
+	// the backfill is idempotent
+	s.storageKeeper.BackfillGVGIndexes(s.ctx)
+	s.Require().Equal([]math.Uint{bucketInfo.Id}, s.listBucketIDsByGVGFamily(2))
+	s.Require().Equal([]math.Uint{sealedObjects[0].Id}, s.listObjectIDsByGVG(3))
+}
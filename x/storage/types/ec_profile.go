@@ -0,0 +1,103 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxECProfiles is the max number of EC profiles governance can approve
+	MaxECProfiles = 10
+	// MaxECProfileChunkNum is the max total number of data chunks and parity chunks of an EC profile
+	MaxECProfileChunkNum = 32
+)
+
+// NewECProfile creates an EC profile, it returns nil if both of the chunk nums are zero which stands for the default profile
+func NewECProfile(dataChunkNum, parityChunkNum uint32) *ECProfile {
+	if dataChunkNum == 0 && parityChunkNum == 0 {
+		return nil
+	}
+	return &ECProfile{DataChunkNum: dataChunkNum, ParityChunkNum: parityChunkNum}
+}
+
+// ParseECProfile parses an EC profile in the format of "data+parity", e.g. "4+2"
+func ParseECProfile(s string) (*ECProfile, error) {
+	parts := strings.Split(s, "+")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid EC profile %s, expect the format of data+parity, e.g. 4+2", s)
+	}
+	dataChunkNum, err := strconv.ParseUint(strings.TrimSpace(parts[0]), 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid data chunk num of EC profile %s: %w", s, err)
+	}
+	parityChunkNum, err := strconv.ParseUint(strings.TrimSpace(parts[1]), 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid parity chunk num of EC profile %s: %w", s, err)
+	}
+	profile := &ECProfile{DataChunkNum: uint32(dataChunkNum), ParityChunkNum: uint32(parityChunkNum)}
+	if err = profile.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// ValidateBasic checks the chunk nums of the EC profile
+func (p *ECProfile) ValidateBasic() error {
+	if p.DataChunkNum == 0 || p.ParityChunkNum == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "the data chunk num and parity chunk num of EC profile must be positive, data: %d, parity: %d",
+			p.DataChunkNum, p.ParityChunkNum)
+	}
+	if p.DataChunkNum+p.ParityChunkNum > MaxECProfileChunkNum {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "the total chunk num of EC profile %d+%d exceeds %d",
+			p.DataChunkNum, p.ParityChunkNum, MaxECProfileChunkNum)
+	}
+	return nil
+}
+
+// IsEmpty returns whether the EC profile is not set, which stands for the default profile
+func (p *ECProfile) IsEmpty() bool {
+	return p == nil || (p.DataChunkNum == 0 && p.ParityChunkNum == 0)
+}
+
+// Equal returns whether the two EC profiles have the same chunk nums
+func (p *ECProfile) Equal(other *ECProfile) bool {
+	if p.IsEmpty() || other.IsEmpty() {
+		return p.IsEmpty() && other.IsEmpty()
+	}
+	return p.DataChunkNum == other.DataChunkNum && p.ParityChunkNum == other.ParityChunkNum
+}
+
+// SecondarySPNum returns the number of secondary sps storing the chunks of the EC profile
+func (p *ECProfile) SecondarySPNum() uint32 {
+	return p.DataChunkNum + p.ParityChunkNum
+}
+
+// Format returns the EC profile in the format of "data+parity"
+func (p *ECProfile) Format() string {
+	return fmt.Sprintf("%d+%d", p.DataChunkNum, p.ParityChunkNum)
+}
+
+func validateECProfiles(i interface{}) error {
+	v, ok := i.([]ECProfile)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if len(v) > MaxECProfiles {
+		return fmt.Errorf("too many EC profiles: %d, max: %d", len(v), MaxECProfiles)
+	}
+	seen := make(map[string]struct{}, len(v))
+	for _, profile := range v {
+		if err := profile.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := seen[profile.Format()]; ok {
+			return fmt.Errorf("duplicated EC profile: %s", profile.Format())
+		}
+		seen[profile.Format()] = struct{}{}
+	}
+	return nil
+}
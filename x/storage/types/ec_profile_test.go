@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseECProfile(t *testing.T) {
+	profile, err := ParseECProfile("6+3")
+	require.NoError(t, err)
+	require.Equal(t, &ECProfile{DataChunkNum: 6, ParityChunkNum: 3}, profile)
+	require.Equal(t, uint32(9), profile.SecondarySPNum())
+	require.Equal(t, "6+3", profile.Format())
+
+	for _, s := range []string{"", "6", "6+", "a+3", "6+0", "0+3", "30+3"} {
+		_, err = ParseECProfile(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestECProfile_Equal(t *testing.T) {
+	require.True(t, (*ECProfile)(nil).Equal(&ECProfile{}))
+	require.True(t, NewECProfile(4, 2).Equal(&ECProfile{DataChunkNum: 4, ParityChunkNum: 2}))
+	require.False(t, NewECProfile(4, 2).Equal(NewECProfile(6, 3)))
+	require.False(t, NewECProfile(4, 2).Equal(nil))
+	require.Nil(t, NewECProfile(0, 0))
+}
+
+func TestValidateECProfiles(t *testing.T) {
+	require.NoError(t, validateECProfiles([]ECProfile{}))
+	require.NoError(t, validateECProfiles([]ECProfile{{DataChunkNum: 6, ParityChunkNum: 3}, {DataChunkNum: 8, ParityChunkNum: 4}}))
+	require.Error(t, validateECProfiles([]ECProfile{{DataChunkNum: 6, ParityChunkNum: 3}, {DataChunkNum: 6, ParityChunkNum: 3}}))
+	require.Error(t, validateECProfiles([]ECProfile{{DataChunkNum: 6}}))
+	require.Error(t, validateECProfiles(uint32(1)))
+}
@@ -42,6 +42,7 @@ var (
 	ErrInvalidOperationType     = errors.Register(ModuleName, 3002, "invalid operation type")
 	ErrInvalidId                = errors.Register(ModuleName, 3003, "id is invalid")
 	ErrChainNotSupported        = errors.Register(ModuleName, 3004, "chain is not supported")
+	ErrNoSuchCrossChainPackage  = errors.Register(ModuleName, 3005, "no such cross chain package")
 
 	ErrInvalidObjectIds          = errors.Register(ModuleName, 3101, "object ids are invalid")
 	ErrInvalidReason             = errors.Register(ModuleName, 3102, "reason is invalid")
@@ -54,6 +55,8 @@ var (
 	ErrMigrationBucketFailed     = errors.Register(ModuleName, 3202, "migrate bucket failed.")
 	ErrVirtualGroupOperateFailed = errors.Register(ModuleName, 3203, "operate virtual group failed.")
 	ErrInvalidBlsPubKey          = errors.Register(ModuleName, 3204, "invalid bls public key")
+	ErrPlacementConstraint       = errors.Register(ModuleName, 3205, "placement constraint of bucket is violated")
+	ErrECProfileNotAllowed       = errors.Register(ModuleName, 3206, "EC profile is not allowed")
 
 	ErrInvalidBucketOwner = errors.Register(ModuleName, 3300, "invalid bucket owner")
 )
@@ -54,6 +54,10 @@ type EventCreateBucket struct {
 	GlobalVirtualGroupFamilyId uint32 `protobuf:"varint,10,opt,name=global_virtual_group_family_id,json=globalVirtualGroupFamilyId,proto3" json:"global_virtual_group_family_id,omitempty"`
 	// status define the status of the bucket.
 	Status BucketStatus `protobuf:"varint,11,opt,name=status,proto3,enum=greenfield.storage.BucketStatus" json:"status,omitempty"`
+	// placement_constraint defines the allowed locations of the storage providers serving the bucket
+	PlacementConstraint *PlacementConstraint `protobuf:"bytes,12,opt,name=placement_constraint,json=placementConstraint,proto3" json:"placement_constraint,omitempty"`
+	// ec_profile defines the EC redundancy profile chosen by the bucket, empty for the default profile
+	EcProfile *ECProfile `protobuf:"bytes,13,opt,name=ec_profile,json=ecProfile,proto3" json:"ec_profile,omitempty"`
 }
 
 func (m *EventCreateBucket) Reset()         { *m = EventCreateBucket{} }
@@ -159,6 +163,20 @@ func (m *EventCreateBucket) GetStatus() BucketStatus {
 	return BUCKET_STATUS_CREATED
 }
 
+func (m *EventCreateBucket) GetPlacementConstraint() *PlacementConstraint {
+	if m != nil {
+		return m.PlacementConstraint
+	}
+	return nil
+}
+
+func (m *EventCreateBucket) GetEcProfile() *ECProfile {
+	if m != nil {
+		return m.EcProfile
+	}
+	return nil
+}
+
 // EventDeleteBucket is emitted on MsgDeleteBucket
 type EventDeleteBucket struct {
 	// operator define the account address of operator who delete the bucket
@@ -2943,139 +2961,143 @@ func init() {
 func init() { proto.RegisterFile("greenfield/storage/events.proto", fileDescriptor_946dcba4f763ddc4) }
 
 var fileDescriptor_946dcba4f763ddc4 = []byte{
-	// 2097 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcd, 0x8f, 0xe4, 0x46,
-	0x15, 0x5f, 0xf7, 0xd7, 0x74, 0xbf, 0x9e, 0xee, 0xde, 0x31, 0xc3, 0xa6, 0x99, 0xcd, 0xf6, 0x74,
-	0x8c, 0x58, 0x26, 0x11, 0x3b, 0x83, 0x36, 0x01, 0xad, 0x04, 0xd2, 0x6a, 0x3e, 0x12, 0xd4, 0x62,
-	0x93, 0x1d, 0xdc, 0x93, 0x1c, 0xb8, 0x58, 0xd5, 0x76, 0x8d, 0xd7, 0xac, 0xdb, 0x65, 0x5c, 0xd5,
-	0x33, 0xdb, 0xf9, 0x07, 0xb8, 0x80, 0x14, 0x09, 0x21, 0x01, 0x07, 0xae, 0x20, 0x71, 0xe1, 0x90,
-	0x2b, 0x9c, 0xf7, 0x98, 0x2c, 0x97, 0x10, 0xa4, 0x80, 0x76, 0x85, 0xf8, 0x90, 0x10, 0x9c, 0x39,
-	0x21, 0x57, 0x95, 0xdd, 0x76, 0xbb, 0x67, 0x7b, 0xdc, 0x9b, 0xc9, 0xcc, 0xe6, 0x34, 0xe3, 0xd7,
-	0xaf, 0xca, 0xef, 0xe3, 0xf7, 0x3e, 0xea, 0x95, 0x61, 0xdd, 0x0e, 0x30, 0xf6, 0x0e, 0x1d, 0xec,
-	0x5a, 0x5b, 0x94, 0x91, 0x00, 0xd9, 0x78, 0x0b, 0x1f, 0x61, 0x8f, 0xd1, 0x4d, 0x3f, 0x20, 0x8c,
-	0xa8, 0xea, 0x84, 0x61, 0x53, 0x32, 0xac, 0x7d, 0xc9, 0x24, 0x74, 0x48, 0xa8, 0xc1, 0x39, 0xb6,
-	0xc4, 0x83, 0x60, 0x5f, 0x5b, 0xb5, 0x89, 0x4d, 0x04, 0x3d, 0xfc, 0x4f, 0x52, 0xd7, 0x6d, 0x42,
-	0x6c, 0x17, 0x6f, 0xf1, 0xa7, 0xc1, 0xe8, 0x70, 0x8b, 0x39, 0x43, 0x4c, 0x19, 0x1a, 0xfa, 0x31,
-	0xc3, 0x44, 0x8c, 0x00, 0x53, 0x32, 0x0a, 0x4c, 0xbc, 0xc5, 0xc6, 0x3e, 0xa6, 0x33, 0x18, 0x22,
-	0x39, 0x4d, 0x32, 0x1c, 0x12, 0x4f, 0x32, 0x74, 0x66, 0x30, 0x24, 0x36, 0xd0, 0xfe, 0x58, 0x82,
-	0x95, 0xd7, 0x43, 0xc5, 0x76, 0x03, 0x8c, 0x18, 0xde, 0x19, 0x99, 0xf7, 0x31, 0x53, 0x37, 0xa1,
-	0x4c, 0x8e, 0x3d, 0x1c, 0xb4, 0x95, 0xae, 0xb2, 0x51, 0xdb, 0x69, 0x3f, 0x7a, 0xff, 0xc6, 0xaa,
-	0xd4, 0x67, 0xdb, 0xb2, 0x02, 0x4c, 0x69, 0x9f, 0x05, 0x8e, 0x67, 0xeb, 0x82, 0x4d, 0x5d, 0x87,
-	0xfa, 0x80, 0xaf, 0x34, 0x3c, 0x34, 0xc4, 0xed, 0x42, 0xb8, 0x4a, 0x07, 0x41, 0x7a, 0x0b, 0x0d,
-	0xb1, 0xba, 0x03, 0x70, 0xe4, 0x50, 0x67, 0xe0, 0xb8, 0x0e, 0x1b, 0xb7, 0x8b, 0x5d, 0x65, 0xa3,
-	0x79, 0x53, 0xdb, 0xcc, 0xda, 0x70, 0xf3, 0x9d, 0x98, 0xeb, 0x60, 0xec, 0x63, 0x3d, 0xb1, 0x4a,
-	0xbd, 0x0a, 0x35, 0x93, 0x0b, 0x69, 0x20, 0xd6, 0x2e, 0x75, 0x95, 0x8d, 0xa2, 0x5e, 0x15, 0x84,
-	0x6d, 0xa6, 0xde, 0x82, 0x9a, 0x94, 0xc0, 0xb1, 0xda, 0x65, 0x2e, 0xf5, 0xd5, 0x87, 0x9f, 0xac,
-	0x5f, 0xfa, 0xf8, 0x93, 0xf5, 0xd2, 0xdb, 0x8e, 0xc7, 0x1e, 0xbd, 0x7f, 0xa3, 0x2e, 0x35, 0x08,
-	0x1f, 0xf5, 0xaa, 0xe0, 0xee, 0x59, 0xea, 0x6d, 0xa8, 0x0b, 0xc3, 0x1a, 0xa1, 0x5d, 0xda, 0x15,
-	0x2e, 0x5b, 0x67, 0x96, 0x6c, 0x7d, 0xce, 0x26, 0xe4, 0xa2, 0xf1, 0xff, 0xea, 0xd7, 0x40, 0x35,
-	0xef, 0xa1, 0xc0, 0xc6, 0x96, 0x11, 0x60, 0x64, 0x19, 0x3f, 0x1c, 0x11, 0x86, 0xda, 0x4b, 0x5d,
-	0x65, 0xa3, 0xa4, 0x5f, 0x96, 0xbf, 0xe8, 0x18, 0x59, 0xdf, 0x0b, 0xe9, 0xea, 0x36, 0xb4, 0x7c,
-	0x34, 0x1e, 0x62, 0x8f, 0x19, 0x48, 0x98, 0xb2, 0x5d, 0x9d, 0x63, 0xe4, 0xa6, 0x5c, 0x20, 0xa9,
-	0xaa, 0x06, 0x0d, 0x3f, 0x70, 0x86, 0x28, 0x18, 0x1b, 0xd4, 0x0f, 0xf5, 0xad, 0x75, 0x95, 0x8d,
-	0x86, 0x5e, 0x97, 0xc4, 0xbe, 0xdf, 0xb3, 0xd4, 0x1d, 0xe8, 0xd8, 0x2e, 0x19, 0x20, 0xd7, 0x38,
-	0x72, 0x02, 0x36, 0x42, 0xae, 0x61, 0x07, 0x64, 0xe4, 0x1b, 0x87, 0x68, 0xe8, 0xb8, 0xe3, 0x70,
-	0x11, 0xf0, 0x45, 0x6b, 0x82, 0xeb, 0x1d, 0xc1, 0xf4, 0x9d, 0x90, 0xe7, 0x0d, 0xce, 0xd2, 0xb3,
-	0xd4, 0x5b, 0x50, 0xa1, 0x0c, 0xb1, 0x11, 0x6d, 0xd7, 0xb9, 0x51, 0xba, 0xb3, 0x8c, 0x22, 0x10,
-	0xd3, 0xe7, 0x7c, 0xba, 0xe4, 0xd7, 0x7e, 0x5e, 0x90, 0xa8, 0xda, 0xc3, 0x2e, 0x8e, 0x51, 0xf5,
-	0x1a, 0x54, 0x89, 0x8f, 0x03, 0xc4, 0xc8, 0x7c, 0x60, 0xc5, 0x9c, 0x13, 0x2c, 0x16, 0x16, 0xc2,
-	0x62, 0x31, 0x83, 0xc5, 0x14, 0x54, 0x4a, 0x79, 0xa0, 0x32, 0xdf, 0xa8, 0xe5, 0x79, 0x46, 0xd5,
-	0x7e, 0x54, 0x84, 0x2f, 0x72, 0xd3, 0xbc, 0xed, 0x5b, 0x71, 0xc0, 0xf5, 0xbc, 0x43, 0xb2, 0xa0,
-	0x79, 0xe6, 0x86, 0x5e, 0x4a, 0xdd, 0x62, 0x1e, 0x75, 0x67, 0x03, 0xbb, 0x74, 0x02, 0xb0, 0xbf,
-	0x9a, 0x05, 0x36, 0x8f, 0xc3, 0x0c, 0x7c, 0xd3, 0xb9, 0xa0, 0xb2, 0x50, 0x2e, 0x98, 0xef, 0x89,
-	0xa5, 0xb9, 0x9e, 0xf8, 0x8d, 0x02, 0x57, 0x04, 0x48, 0x1d, 0x6a, 0x12, 0x8f, 0x39, 0xde, 0x28,
-	0x42, 0x6a, 0xca, 0x66, 0x4a, 0x1e, 0x9b, 0xcd, 0x75, 0xc7, 0x15, 0xa8, 0x04, 0x18, 0x51, 0xe2,
-	0x49, 0x64, 0xca, 0xa7, 0x30, 0xbb, 0x59, 0x3c, 0x58, 0x12, 0xd9, 0x4d, 0x10, 0xb6, 0x99, 0xf6,
-	0xd3, 0x4a, 0x2a, 0x4b, 0xdf, 0x1d, 0xfc, 0x00, 0x9b, 0x4c, 0xbd, 0x09, 0x4b, 0x3c, 0xff, 0x9d,
-	0x02, 0x2f, 0x11, 0xe3, 0xa7, 0x1f, 0x4d, 0xeb, 0x50, 0x27, 0x5c, 0x1c, 0xc1, 0x50, 0x12, 0x0c,
-	0x82, 0x94, 0xc5, 0x5f, 0x25, 0x8f, 0x2d, 0x6f, 0x41, 0x4d, 0x6e, 0x2d, 0xfd, 0x39, 0x6f, 0xa5,
-	0xe0, 0xee, 0x59, 0xd9, 0x0c, 0x59, 0xcd, 0x66, 0xc8, 0x97, 0x60, 0xd9, 0x47, 0x63, 0x97, 0x20,
-	0xcb, 0xa0, 0xce, 0xbb, 0x98, 0x27, 0xd1, 0x92, 0x5e, 0x97, 0xb4, 0xbe, 0xf3, 0xee, 0x74, 0xd5,
-	0x82, 0x85, 0x90, 0xfa, 0x12, 0x2c, 0x87, 0xe0, 0x0a, 0xc3, 0x82, 0xd7, 0x97, 0x3a, 0x37, 0x50,
-	0x5d, 0xd2, 0x78, 0x01, 0x49, 0x15, 0xb6, 0xe5, 0x4c, 0x61, 0x8b, 0x92, 0x70, 0xe3, 0xe4, 0x24,
-	0x2c, 0x00, 0x91, 0x4e, 0xc2, 0xea, 0x77, 0xa1, 0x15, 0x60, 0x6b, 0xe4, 0x59, 0xc8, 0x33, 0xc7,
-	0xe2, 0xe5, 0xcd, 0x93, 0x55, 0xd0, 0x63, 0x56, 0xae, 0x42, 0x33, 0x48, 0x3d, 0x4f, 0x57, 0xc9,
-	0x56, 0xee, 0x2a, 0xf9, 0x22, 0xd4, 0xcc, 0x7b, 0xd8, 0xbc, 0x4f, 0x47, 0x43, 0xda, 0xbe, 0xdc,
-	0x2d, 0x6e, 0x2c, 0xeb, 0x13, 0x82, 0xfa, 0x2a, 0x5c, 0x71, 0x89, 0x99, 0x09, 0x67, 0xc7, 0x6a,
-	0xaf, 0x70, 0xcf, 0x7d, 0x81, 0xff, 0x9a, 0x0c, 0xe3, 0x9e, 0xa5, 0xfd, 0x47, 0x81, 0x17, 0x44,
-	0x54, 0x20, 0xcf, 0xc4, 0x6e, 0x2a, 0x36, 0xce, 0x28, 0x99, 0x4e, 0xa1, 0xbd, 0x98, 0x41, 0x7b,
-	0x06, 0x79, 0xa5, 0x2c, 0xf2, 0x52, 0xb8, 0xae, 0xe4, 0xc0, 0x75, 0x58, 0x3c, 0x5a, 0x5c, 0xe3,
-	0x3e, 0x46, 0xee, 0x39, 0x6b, 0x9a, 0xd2, 0xa2, 0x9c, 0x27, 0x3a, 0x27, 0x90, 0xae, 0xe4, 0x84,
-	0xf4, 0x37, 0xe0, 0x85, 0x99, 0x69, 0x3f, 0xce, 0xf7, 0xab, 0xd9, 0x7c, 0xdf, 0xb3, 0x9e, 0x82,
-	0xae, 0xea, 0x89, 0xe8, 0x4a, 0x03, 0xb6, 0x36, 0x05, 0x58, 0xed, 0x57, 0x91, 0x27, 0x76, 0x89,
-	0x3f, 0x7e, 0x26, 0x4f, 0x5c, 0x87, 0x16, 0x0d, 0x4c, 0x23, 0xeb, 0x8d, 0x06, 0x0d, 0xcc, 0x9d,
-	0x89, 0x43, 0x24, 0x5f, 0xd6, 0x29, 0x21, 0xdf, 0xdd, 0x89, 0x5f, 0xae, 0x43, 0xcb, 0xa2, 0x2c,
-	0xb5, 0x9f, 0x48, 0xca, 0x0d, 0x8b, 0xb2, 0xf4, 0x7e, 0x21, 0x5f, 0x72, 0xbf, 0x72, 0xcc, 0x97,
-	0xd8, 0xef, 0x36, 0x34, 0x12, 0xef, 0x3d, 0x1d, 0x62, 0xeb, 0xb1, 0x48, 0xbc, 0xc1, 0x6e, 0x24,
-	0x5e, 0x74, 0xba, 0x54, 0x5e, 0x8f, 0x65, 0x58, 0xd0, 0x7d, 0xda, 0xff, 0x94, 0x54, 0x0b, 0x7a,
-	0x91, 0x82, 0xa5, 0x94, 0x27, 0x58, 0x4e, 0x56, 0xbe, 0x7c, 0xb2, 0xf2, 0xff, 0x50, 0x64, 0x93,
-	0xa9, 0x63, 0x1e, 0x45, 0x17, 0x2c, 0x5b, 0xe4, 0x32, 0xc0, 0x35, 0x80, 0x43, 0x12, 0x18, 0x23,
-	0xde, 0x2e, 0x73, 0xa5, 0xab, 0x7a, 0xed, 0x90, 0x04, 0xa2, 0x7f, 0x9e, 0xd9, 0xc5, 0x49, 0x5d,
-	0xa7, 0xa4, 0x56, 0x66, 0xb5, 0xc6, 0x13, 0xa1, 0x0a, 0x79, 0x84, 0x5a, 0xa8, 0x8b, 0xfb, 0x49,
-	0x21, 0xd5, 0xfa, 0x4b, 0x7c, 0x9f, 0x61, 0xeb, 0x7f, 0x86, 0x5e, 0x49, 0xb7, 0x46, 0xe5, 0x45,
-	0x5a, 0x23, 0xed, 0xbf, 0x0a, 0x5c, 0x4e, 0x74, 0xb5, 0x1c, 0xbc, 0xb9, 0x47, 0x0f, 0xd7, 0x00,
-	0x44, 0x44, 0x24, 0x6c, 0x50, 0xe3, 0x14, 0xae, 0xe1, 0x37, 0xa1, 0x1a, 0x07, 0xcc, 0x29, 0x0e,
-	0x3f, 0x4b, 0xb6, 0xcc, 0xfe, 0x53, 0xfd, 0x4e, 0x29, 0x77, 0xbf, 0xb3, 0x0a, 0x65, 0xfc, 0x80,
-	0x05, 0x48, 0x26, 0x55, 0xf1, 0xa0, 0xfd, 0x22, 0x52, 0x59, 0x64, 0xa5, 0x29, 0x95, 0x0b, 0x8b,
-	0xa8, 0x5c, 0x7c, 0x9a, 0xca, 0xa5, 0xd3, 0xab, 0xac, 0xfd, 0x49, 0x91, 0x25, 0xed, 0x0e, 0x46,
-	0x47, 0x52, 0xb4, 0xdb, 0xd0, 0x1c, 0xe2, 0xe1, 0x00, 0x07, 0xf1, 0x99, 0x6e, 0x9e, 0x5b, 0x1a,
-	0x82, 0x3f, 0x3a, 0xec, 0x5d, 0x10, 0xdd, 0xfe, 0x5d, 0x90, 0x59, 0x42, 0x84, 0x1e, 0x57, 0xee,
-	0x4d, 0x2e, 0xe8, 0x67, 0x34, 0x95, 0x38, 0x1b, 0xbd, 0xd4, 0xfd, 0xc8, 0x3f, 0xd4, 0x60, 0x24,
-	0xf4, 0x51, 0xbb, 0xdc, 0x2d, 0x6e, 0xd4, 0x6f, 0xbe, 0x32, 0x0b, 0xa9, 0xdc, 0x00, 0x09, 0xd5,
-	0xf7, 0x30, 0x43, 0x8e, 0xab, 0x2f, 0xcb, 0x1d, 0x0e, 0xc8, 0xb6, 0x65, 0xa9, 0x7b, 0xb0, 0x92,
-	0xd8, 0x51, 0xe4, 0xae, 0x76, 0xa5, 0x5b, 0x7c, 0xaa, 0x92, 0xad, 0x78, 0x0b, 0x81, 0x6b, 0xed,
-	0xcf, 0x85, 0xb8, 0x00, 0x79, 0xf8, 0xf8, 0x73, 0x63, 0xee, 0xa9, 0xac, 0x50, 0xce, 0x9d, 0x15,
-	0xf6, 0x60, 0x49, 0x9a, 0x8a, 0xdb, 0x34, 0x9f, 0xa3, 0xa2, 0xa5, 0xda, 0xcf, 0xa2, 0x9a, 0x97,
-	0xe1, 0x51, 0xbf, 0x0e, 0x15, 0xc1, 0x35, 0xd7, 0xb8, 0x92, 0x4f, 0xed, 0x41, 0x0b, 0x3f, 0xf0,
-	0x9d, 0x00, 0x31, 0x87, 0x78, 0x06, 0x73, 0x64, 0x16, 0xad, 0xdf, 0x5c, 0xdb, 0x14, 0xe3, 0xe9,
-	0xcd, 0x68, 0x3c, 0xbd, 0x79, 0x10, 0x8d, 0xa7, 0x77, 0x4a, 0xef, 0xfd, 0x65, 0x5d, 0xd1, 0x9b,
-	0x93, 0x85, 0xe1, 0x4f, 0xda, 0xbf, 0x94, 0x54, 0x81, 0xe3, 0xd2, 0xbd, 0x1e, 0xe6, 0xbd, 0xe7,
-	0xdb, 0xeb, 0xb3, 0x53, 0xf9, 0xc3, 0xa8, 0xc1, 0x7c, 0xd3, 0x09, 0x02, 0x12, 0x3c, 0xd3, 0x8c,
-	0x33, 0xdf, 0x10, 0x2f, 0xd7, 0xcc, 0x52, 0x83, 0x86, 0x85, 0x29, 0x33, 0xcc, 0x7b, 0xc8, 0xf1,
-	0x26, 0x6d, 0x63, 0x3d, 0x24, 0xee, 0x86, 0xb4, 0x9e, 0xa5, 0xfd, 0x2e, 0x3a, 0x48, 0x27, 0x55,
-	0xd1, 0x31, 0x1d, 0xb9, 0x2c, 0xec, 0x74, 0xe4, 0x61, 0x4d, 0xe1, 0x0b, 0xa3, 0xa3, 0xd8, 0x39,
-	0x8b, 0xfc, 0xcf, 0xb4, 0xf5, 0x9f, 0xdb, 0xee, 0xf6, 0x34, 0xba, 0x7e, 0x98, 0x76, 0x8f, 0xd0,
-	0xf5, 0x59, 0xdd, 0x73, 0xce, 0x3a, 0xfd, 0x3e, 0x6a, 0x84, 0x84, 0x4e, 0x17, 0xaa, 0xf7, 0xcb,
-	0xc8, 0x5f, 0xca, 0xca, 0xff, 0xdb, 0x28, 0x05, 0x27, 0xe4, 0x9f, 0xe3, 0x92, 0x73, 0x94, 0xf6,
-	0x48, 0x02, 0xa8, 0xcf, 0x90, 0x8b, 0xf7, 0x89, 0xeb, 0x98, 0xe3, 0x5d, 0x17, 0x23, 0x6f, 0xe4,
-	0xab, 0x6b, 0x50, 0x1d, 0xb8, 0xc4, 0xbc, 0xff, 0xd6, 0x68, 0xc8, 0xe5, 0x2d, 0xea, 0xf1, 0x73,
-	0x58, 0xee, 0xe4, 0x69, 0xc6, 0xf1, 0x0e, 0x89, 0x2c, 0x0b, 0x33, 0xcb, 0x9d, 0x28, 0xfb, 0xe1,
-	0x59, 0x46, 0x07, 0x2b, 0xfe, 0x5f, 0xfb, 0x71, 0x01, 0x56, 0xa5, 0x95, 0x6c, 0x51, 0x27, 0x3e,
-	0xc3, 0x34, 0x99, 0xeb, 0xae, 0xe3, 0x65, 0x58, 0xb1, 0x28, 0x33, 0x66, 0xcd, 0xee, 0x9a, 0x16,
-	0x65, 0xfb, 0xa9, 0xf1, 0x5d, 0xe4, 0xdf, 0x72, 0xce, 0x6b, 0xb1, 0xbf, 0x2b, 0xb0, 0x96, 0x18,
-	0x58, 0x5e, 0x78, 0xa3, 0x4c, 0x34, 0x2d, 0xe5, 0xd4, 0xf4, 0x6f, 0x0a, 0xb4, 0x13, 0x03, 0x08,
-	0xa1, 0x29, 0xfe, 0xfc, 0xe9, 0xf9, 0x51, 0x01, 0x5e, 0x94, 0x63, 0xc0, 0xa1, 0x1f, 0xc2, 0xfe,
-	0xc2, 0xfb, 0x74, 0xfe, 0xcd, 0x59, 0x69, 0xee, 0xc5, 0xf0, 0xcb, 0xb0, 0x42, 0x03, 0x73, 0x2a,
-	0x58, 0x44, 0x92, 0x6f, 0xd2, 0xc0, 0x9c, 0x1d, 0x2c, 0x95, 0x9c, 0xa6, 0x35, 0xa0, 0x2e, 0x47,
-	0xdd, 0xec, 0x00, 0xd9, 0x61, 0x9e, 0x8a, 0xbe, 0x80, 0x90, 0x93, 0x9c, 0xf8, 0x59, 0x7d, 0x0d,
-	0x4a, 0x0c, 0xd9, 0x54, 0x26, 0xa8, 0xee, 0xec, 0xeb, 0x0d, 0xd9, 0x85, 0x23, 0x9b, 0xea, 0x9c,
-	0x5b, 0xfb, 0x75, 0x41, 0x62, 0x34, 0x39, 0x8e, 0xd9, 0x15, 0xf7, 0x32, 0x0b, 0xfa, 0x6d, 0xf1,
-	0x81, 0xd2, 0xb3, 0xdf, 0xb3, 0x4d, 0xdf, 0x67, 0x95, 0xb3, 0xf7, 0x59, 0xa9, 0x91, 0x76, 0x65,
-	0xfa, 0x0e, 0xa6, 0x0d, 0x4b, 0x47, 0x38, 0xa0, 0x0e, 0xf1, 0xf8, 0x84, 0xb6, 0xa8, 0x47, 0x8f,
-	0xda, 0x87, 0x45, 0x58, 0x3f, 0xc9, 0x52, 0xfd, 0x91, 0x69, 0x86, 0x07, 0xfd, 0xe7, 0xd2, 0x60,
-	0xa9, 0x9b, 0xb9, 0x72, 0xf6, 0x66, 0xee, 0x15, 0x58, 0xf1, 0x03, 0x7c, 0x64, 0xa4, 0x0c, 0x5b,
-	0xe1, 0x86, 0x6d, 0x85, 0x3f, 0xec, 0x27, 0x8c, 0xbb, 0x01, 0x97, 0x3d, 0x7c, 0x9c, 0x66, 0x15,
-	0x1f, 0x81, 0x34, 0x3d, 0x7c, 0x9c, 0xe4, 0xfc, 0x0a, 0x34, 0xf9, 0xae, 0x13, 0x5f, 0x54, 0xb9,
-	0x2f, 0x1a, 0x21, 0x75, 0x37, 0xf6, 0xc7, 0x97, 0xa1, 0x11, 0x6e, 0x38, 0x7d, 0x09, 0xb1, 0xec,
-	0xe1, 0xe3, 0xdd, 0x59, 0x4e, 0x83, 0x94, 0xd3, 0xc2, 0x76, 0x43, 0xcc, 0x4c, 0x2d, 0x03, 0x31,
-	0x7e, 0xed, 0x58, 0xd4, 0x6b, 0x92, 0xb2, 0xcd, 0xb4, 0x47, 0x0a, 0x74, 0x12, 0xb5, 0xe8, 0xd3,
-	0x8b, 0x81, 0x73, 0xec, 0x3c, 0xb5, 0x8f, 0x0b, 0x70, 0x35, 0x4a, 0x1a, 0x22, 0xa9, 0xbc, 0xe1,
-	0x92, 0x63, 0x1d, 0x31, 0x7c, 0xc7, 0x19, 0x3a, 0x67, 0xa6, 0xd1, 0x8c, 0x6f, 0x7a, 0x8a, 0x39,
-	0xbf, 0xe9, 0xf9, 0x16, 0x2c, 0xcb, 0x77, 0x88, 0x0e, 0xb8, 0x34, 0x67, 0xbd, 0x94, 0xe8, 0x2e,
-	0xef, 0x83, 0x2d, 0x68, 0x1d, 0xba, 0xe4, 0xd8, 0x08, 0x6b, 0xac, 0xe1, 0x86, 0x9a, 0xca, 0x0b,
-	0xb9, 0x6f, 0x4b, 0xb3, 0x5d, 0xb7, 0x1d, 0x76, 0x6f, 0x34, 0xd8, 0x34, 0xc9, 0x50, 0x7e, 0x97,
-	0x26, 0xff, 0xdc, 0xa0, 0xd6, 0x7d, 0xf9, 0x3d, 0x58, 0x8f, 0x1b, 0x16, 0xe4, 0xdb, 0x7a, 0x1e,
-	0xd3, 0x1b, 0x87, 0x49, 0xe3, 0x69, 0xbf, 0x8c, 0x10, 0x33, 0xc3, 0xb2, 0xfd, 0x99, 0xa7, 0x8e,
-	0xec, 0xc4, 0xfd, 0x1a, 0x80, 0x43, 0x85, 0x88, 0x58, 0x04, 0x7c, 0x55, 0xaf, 0x39, 0xf4, 0x8e,
-	0x20, 0x2c, 0x5e, 0xd6, 0xb4, 0x3f, 0x28, 0x70, 0x8d, 0x0b, 0x77, 0x40, 0x6c, 0xdb, 0xc5, 0xfd,
-	0xfd, 0x6d, 0x1a, 0xf6, 0xa4, 0x36, 0x47, 0xbb, 0x1d, 0xa2, 0xf9, 0x34, 0xb7, 0x01, 0x93, 0x97,
-	0x17, 0x72, 0xd6, 0x54, 0xea, 0x1b, 0x88, 0xf2, 0x71, 0x99, 0x2d, 0x42, 0x2e, 0x7c, 0xa7, 0x61,
-	0x39, 0x14, 0x0d, 0x5c, 0x2c, 0x74, 0xa9, 0xea, 0x6b, 0xd4, 0x9f, 0x16, 0x6b, 0x4f, 0x72, 0xec,
-	0xf4, 0x1e, 0x3e, 0xee, 0x28, 0x1f, 0x3c, 0xee, 0x28, 0x7f, 0x7d, 0xdc, 0x51, 0xde, 0x7b, 0xd2,
-	0xb9, 0xf4, 0xc1, 0x93, 0xce, 0xa5, 0x8f, 0x9e, 0x74, 0x2e, 0x7d, 0x7f, 0x2b, 0xe1, 0xbc, 0x81,
-	0x37, 0xb8, 0xc1, 0x1b, 0xfd, 0xad, 0xc4, 0x77, 0x7d, 0x0f, 0xd2, 0x5f, 0xf6, 0x0d, 0x2a, 0x7c,
-	0x60, 0xf3, 0xea, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x13, 0xed, 0x46, 0x18, 0xc5, 0x28, 0x00,
-	0x00,
+	// 2165 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcb, 0x6f, 0xe3, 0xc6,
+	0x19, 0x5f, 0xea, 0x65, 0x69, 0x64, 0x49, 0x6b, 0xc6, 0x4d, 0x54, 0x6f, 0x56, 0x56, 0x58, 0x74,
+	0xe3, 0x04, 0x5d, 0xbb, 0x70, 0xd2, 0x62, 0x81, 0x06, 0x58, 0xf8, 0xb1, 0x29, 0x84, 0x6e, 0xb2,
+	0x2e, 0xe5, 0xe4, 0x90, 0x0b, 0x31, 0x22, 0x47, 0x5c, 0x76, 0x29, 0x0e, 0xcb, 0x19, 0xd9, 0xab,
+	0xfc, 0x03, 0xbd, 0xb4, 0x40, 0x80, 0xa2, 0x40, 0xdb, 0x43, 0xaf, 0x2d, 0xd0, 0x4b, 0x0f, 0xb9,
+	0xb6, 0xe7, 0x3d, 0x26, 0x7b, 0x4a, 0x53, 0x20, 0x2d, 0x76, 0x51, 0xf4, 0x01, 0x04, 0xed, 0xb9,
+	0xa7, 0x62, 0x1e, 0xa4, 0x48, 0x91, 0x5e, 0x99, 0xda, 0x38, 0xf6, 0xe6, 0x64, 0xf3, 0xd3, 0x37,
+	0xc3, 0xef, 0xf7, 0xbd, 0xe7, 0x1b, 0x82, 0x75, 0x3b, 0x40, 0xc8, 0x1b, 0x3a, 0xc8, 0xb5, 0xb6,
+	0x08, 0xc5, 0x01, 0xb4, 0xd1, 0x16, 0x3a, 0x42, 0x1e, 0x25, 0x9b, 0x7e, 0x80, 0x29, 0x56, 0xd5,
+	0x29, 0xc3, 0xa6, 0x64, 0x58, 0xfb, 0xba, 0x89, 0xc9, 0x08, 0x13, 0x83, 0x73, 0x6c, 0x89, 0x07,
+	0xc1, 0xbe, 0xb6, 0x6a, 0x63, 0x1b, 0x0b, 0x3a, 0xfb, 0x4f, 0x52, 0xd7, 0x6d, 0x8c, 0x6d, 0x17,
+	0x6d, 0xf1, 0xa7, 0xc1, 0x78, 0xb8, 0x45, 0x9d, 0x11, 0x22, 0x14, 0x8e, 0xfc, 0x88, 0x61, 0x2a,
+	0x46, 0x80, 0x08, 0x1e, 0x07, 0x26, 0xda, 0xa2, 0x13, 0x1f, 0x91, 0x0c, 0x86, 0x50, 0x4e, 0x13,
+	0x8f, 0x46, 0xd8, 0x7b, 0x02, 0x83, 0x0f, 0x03, 0x38, 0x0a, 0x77, 0xe8, 0x64, 0x30, 0xc4, 0xde,
+	0xa0, 0x7d, 0x5e, 0x06, 0x2b, 0xb7, 0x18, 0xf2, 0xbd, 0x00, 0x41, 0x8a, 0x76, 0xc7, 0xe6, 0x3d,
+	0x44, 0xd5, 0x4d, 0x50, 0xc6, 0xc7, 0x1e, 0x0a, 0xda, 0x4a, 0x57, 0xd9, 0xa8, 0xed, 0xb6, 0x1f,
+	0x7e, 0x78, 0x7d, 0x55, 0x02, 0xde, 0xb1, 0xac, 0x00, 0x11, 0xd2, 0xa7, 0x81, 0xe3, 0xd9, 0xba,
+	0x60, 0x53, 0xd7, 0x41, 0x7d, 0xc0, 0x57, 0x1a, 0x1e, 0x1c, 0xa1, 0x76, 0x81, 0xad, 0xd2, 0x81,
+	0x20, 0xbd, 0x0d, 0x47, 0x48, 0xdd, 0x05, 0xe0, 0xc8, 0x21, 0xce, 0xc0, 0x71, 0x1d, 0x3a, 0x69,
+	0x17, 0xbb, 0xca, 0x46, 0x73, 0x5b, 0xdb, 0x4c, 0x2b, 0x79, 0xf3, 0xdd, 0x88, 0xeb, 0x70, 0xe2,
+	0x23, 0x3d, 0xb6, 0x4a, 0xbd, 0x02, 0x6a, 0x26, 0x17, 0xd2, 0x80, 0xb4, 0x5d, 0xea, 0x2a, 0x1b,
+	0x45, 0xbd, 0x2a, 0x08, 0x3b, 0x54, 0xbd, 0x01, 0x6a, 0x52, 0x02, 0xc7, 0x6a, 0x97, 0xb9, 0xd4,
+	0x57, 0x1e, 0x7c, 0xb6, 0x7e, 0xe9, 0xd3, 0xcf, 0xd6, 0x4b, 0xef, 0x38, 0x1e, 0x7d, 0xf8, 0xe1,
+	0xf5, 0xba, 0x44, 0xc0, 0x1e, 0xf5, 0xaa, 0xe0, 0xee, 0x59, 0xea, 0x4d, 0x50, 0x17, 0x9a, 0x37,
+	0x98, 0x5e, 0xda, 0x15, 0x2e, 0x5b, 0x27, 0x4b, 0xb6, 0x3e, 0x67, 0x13, 0x72, 0x91, 0xe8, 0x7f,
+	0xf5, 0x5b, 0x40, 0x35, 0xef, 0xc2, 0xc0, 0x46, 0x96, 0x11, 0x20, 0x68, 0x19, 0x3f, 0x1e, 0x63,
+	0x0a, 0xdb, 0x4b, 0x5d, 0x65, 0xa3, 0xa4, 0x5f, 0x96, 0xbf, 0xe8, 0x08, 0x5a, 0x3f, 0x64, 0x74,
+	0x75, 0x07, 0xb4, 0x7c, 0x38, 0x19, 0x21, 0x8f, 0x1a, 0x50, 0xa8, 0xb2, 0x5d, 0x9d, 0xa3, 0xe4,
+	0xa6, 0x5c, 0x20, 0xa9, 0xaa, 0x06, 0x1a, 0x7e, 0xe0, 0x8c, 0x60, 0x30, 0x31, 0x88, 0xcf, 0xf0,
+	0xd6, 0xba, 0xca, 0x46, 0x43, 0xaf, 0x4b, 0x62, 0xdf, 0xef, 0x59, 0xea, 0x2e, 0xe8, 0xd8, 0x2e,
+	0x1e, 0x40, 0xd7, 0x38, 0x72, 0x02, 0x3a, 0x86, 0xae, 0x61, 0x07, 0x78, 0xec, 0x1b, 0x43, 0x38,
+	0x72, 0xdc, 0x09, 0x5b, 0x04, 0xf8, 0xa2, 0x35, 0xc1, 0xf5, 0xae, 0x60, 0xfa, 0x3e, 0xe3, 0x79,
+	0x93, 0xb3, 0xf4, 0x2c, 0xf5, 0x06, 0xa8, 0x10, 0x0a, 0xe9, 0x98, 0xb4, 0xeb, 0x5c, 0x29, 0xdd,
+	0x2c, 0xa5, 0x08, 0x8f, 0xe9, 0x73, 0x3e, 0x5d, 0xf2, 0xab, 0xef, 0x81, 0x55, 0xdf, 0x85, 0x26,
+	0xe2, 0x30, 0x4d, 0xec, 0x11, 0x1a, 0x40, 0xc7, 0xa3, 0xed, 0xe5, 0xae, 0xb2, 0x51, 0xdf, 0x7e,
+	0x39, 0x6b, 0x9f, 0x83, 0x90, 0x7f, 0x2f, 0x62, 0xd7, 0x9f, 0xf3, 0xd3, 0x44, 0xf5, 0x0d, 0x00,
+	0x90, 0xc9, 0x82, 0x70, 0xe8, 0xb8, 0xa8, 0xdd, 0xe0, 0x3b, 0x5e, 0xcd, 0xda, 0xf1, 0xd6, 0xde,
+	0x81, 0x60, 0xd2, 0x6b, 0xc8, 0x94, 0xff, 0x6a, 0xbf, 0x2c, 0x48, 0x7f, 0xdf, 0x47, 0x2e, 0x8a,
+	0xfc, 0xfd, 0x75, 0x50, 0xc5, 0x3e, 0x0a, 0x20, 0xc5, 0xf3, 0x5d, 0x3e, 0xe2, 0x9c, 0x46, 0x49,
+	0x61, 0xa1, 0x28, 0x29, 0xa6, 0xa2, 0x24, 0xe1, 0xc4, 0xa5, 0x3c, 0x4e, 0x3c, 0xdf, 0xdc, 0xe5,
+	0x79, 0xe6, 0xd6, 0x7e, 0x52, 0x04, 0x5f, 0xe3, 0xaa, 0x79, 0xc7, 0xb7, 0xa2, 0x54, 0xd0, 0xf3,
+	0x86, 0x78, 0x41, 0xf5, 0xcc, 0x4d, 0x0a, 0x09, 0xb8, 0xc5, 0x3c, 0x70, 0xb3, 0x43, 0xae, 0x74,
+	0x42, 0xc8, 0xbd, 0x9c, 0x0e, 0x39, 0x9e, 0x21, 0x52, 0x81, 0x95, 0xcc, 0x52, 0x95, 0x85, 0xb2,
+	0xd4, 0x7c, 0x4b, 0x2c, 0xcd, 0xb5, 0xc4, 0xef, 0x14, 0xf0, 0xbc, 0x70, 0x52, 0x87, 0x98, 0xd8,
+	0xa3, 0x8e, 0x37, 0x0e, 0x3d, 0x35, 0xa1, 0x33, 0x25, 0x8f, 0xce, 0xe6, 0x9a, 0xe3, 0x79, 0x50,
+	0x09, 0x10, 0x24, 0xd8, 0x93, 0x9e, 0x29, 0x9f, 0x58, 0xde, 0xb5, 0x78, 0xb0, 0xc4, 0xf2, 0xae,
+	0x20, 0xec, 0x50, 0xed, 0xe7, 0x95, 0x44, 0xfd, 0xb8, 0x33, 0xf8, 0x11, 0x32, 0xa9, 0xba, 0x0d,
+	0x96, 0x78, 0x66, 0x3e, 0x85, 0xbf, 0x84, 0x8c, 0x5f, 0x7c, 0x34, 0xad, 0x83, 0x3a, 0xe6, 0xe2,
+	0x08, 0x86, 0x92, 0x60, 0x10, 0xa4, 0xb4, 0xff, 0x55, 0xf2, 0xe8, 0xf2, 0x06, 0xa8, 0xc9, 0xad,
+	0xa5, 0x3d, 0xe7, 0xad, 0x14, 0xdc, 0x3d, 0x2b, 0x9d, 0xbb, 0xab, 0xe9, 0xdc, 0xfd, 0x12, 0x58,
+	0xf6, 0xe1, 0xc4, 0xc5, 0xd0, 0x32, 0x88, 0xf3, 0x3e, 0xe2, 0xe9, 0xbd, 0xa4, 0xd7, 0x25, 0xad,
+	0xef, 0xbc, 0x3f, 0x5b, 0x4f, 0xc1, 0x42, 0x9e, 0xfa, 0x12, 0x58, 0x66, 0xce, 0xc5, 0xc2, 0x82,
+	0x57, 0xbe, 0x3a, 0x57, 0x50, 0x5d, 0xd2, 0x78, 0x69, 0x4b, 0x94, 0xdc, 0xe5, 0x54, 0xc9, 0x0d,
+	0xcb, 0x43, 0xe3, 0xe4, 0xf2, 0x20, 0x1c, 0x62, 0xa6, 0x3c, 0xfc, 0x00, 0xb4, 0x02, 0x64, 0x8d,
+	0x3d, 0x0b, 0x7a, 0xe6, 0x44, 0xbc, 0xbc, 0x79, 0x32, 0x04, 0x3d, 0x62, 0xe5, 0x10, 0x9a, 0x41,
+	0xe2, 0x79, 0xb6, 0x7e, 0xb7, 0x72, 0xd7, 0xef, 0x17, 0x41, 0xcd, 0xbc, 0x8b, 0xcc, 0x7b, 0x64,
+	0x3c, 0x22, 0xed, 0xcb, 0xdd, 0xe2, 0xc6, 0xb2, 0x3e, 0x25, 0xa8, 0xaf, 0x81, 0xe7, 0x5d, 0x6c,
+	0xa6, 0xc2, 0xd9, 0xb1, 0xda, 0x2b, 0xdc, 0x72, 0xcf, 0xf1, 0x5f, 0xe3, 0x61, 0xdc, 0xb3, 0xb4,
+	0xff, 0x28, 0xe0, 0x05, 0x11, 0x15, 0xd0, 0x33, 0x91, 0x9b, 0x88, 0x8d, 0x33, 0x4a, 0xa6, 0x33,
+	0xde, 0x5e, 0x4c, 0x79, 0x7b, 0xca, 0xf3, 0x4a, 0x69, 0xcf, 0x4b, 0xf8, 0x75, 0x25, 0x87, 0x5f,
+	0xb3, 0xe2, 0xd1, 0xe2, 0x88, 0xfb, 0x08, 0xba, 0xe7, 0x8c, 0x34, 0x81, 0xa2, 0x9c, 0x27, 0x3a,
+	0xa7, 0x2e, 0x5d, 0xc9, 0xe9, 0xd2, 0xdf, 0x01, 0x2f, 0x64, 0xa6, 0xfd, 0x28, 0xdf, 0xaf, 0xa6,
+	0xf3, 0x7d, 0xcf, 0x7a, 0x82, 0x77, 0x55, 0x4f, 0xf4, 0xae, 0xa4, 0xc3, 0xd6, 0x66, 0x1c, 0x56,
+	0xfb, 0x4d, 0x68, 0x89, 0x3d, 0xec, 0x4f, 0x9e, 0xca, 0x12, 0xd7, 0x40, 0x8b, 0x04, 0xa6, 0x91,
+	0xb6, 0x46, 0x83, 0x04, 0xe6, 0xee, 0xd4, 0x20, 0x92, 0x2f, 0x6d, 0x14, 0xc6, 0x77, 0x67, 0x6a,
+	0x97, 0x6b, 0xa0, 0x65, 0x11, 0x9a, 0xd8, 0x4f, 0x24, 0xe5, 0x86, 0x45, 0x68, 0x72, 0x3f, 0xc6,
+	0x17, 0xdf, 0xaf, 0x1c, 0xf1, 0xc5, 0xf6, 0xbb, 0x09, 0x1a, 0xb1, 0xf7, 0x9e, 0xce, 0x63, 0xeb,
+	0x91, 0x48, 0xbc, 0xf5, 0x6f, 0xc4, 0x5e, 0x74, 0xba, 0x54, 0x5e, 0x8f, 0x64, 0x58, 0xd0, 0x7c,
+	0xda, 0xff, 0x94, 0x44, 0x0b, 0x7a, 0x91, 0x82, 0xa5, 0x94, 0x27, 0x58, 0x4e, 0x06, 0x5f, 0x3e,
+	0x19, 0xfc, 0x3f, 0x15, 0xd9, 0x64, 0xea, 0x88, 0x47, 0xd1, 0x05, 0xcb, 0x16, 0xb9, 0x14, 0x70,
+	0x15, 0x80, 0x21, 0x0e, 0x8c, 0x31, 0x6f, 0x97, 0x39, 0xe8, 0xaa, 0x5e, 0x1b, 0xe2, 0x40, 0xf4,
+	0xcf, 0x99, 0x5d, 0x9c, 0xc4, 0x3a, 0x23, 0xb5, 0x92, 0xd5, 0x1a, 0x4f, 0x85, 0x2a, 0xe4, 0x11,
+	0x6a, 0xa1, 0x2e, 0xee, 0x67, 0x85, 0x44, 0xeb, 0x2f, 0xfd, 0xfb, 0x0c, 0x5b, 0xff, 0x33, 0xb4,
+	0x4a, 0xb2, 0x35, 0x2a, 0x2f, 0xd2, 0x1a, 0x69, 0xff, 0x55, 0xc0, 0xe5, 0x58, 0x57, 0xcb, 0x9d,
+	0x37, 0xf7, 0x50, 0xe4, 0x2a, 0x00, 0x22, 0x22, 0x62, 0x3a, 0xa8, 0x71, 0x0a, 0x47, 0xf8, 0x5d,
+	0x50, 0x8d, 0x02, 0xe6, 0x14, 0x87, 0x9f, 0x25, 0x5b, 0x66, 0xff, 0x99, 0x7e, 0xa7, 0x94, 0xbb,
+	0xdf, 0x59, 0x05, 0x65, 0x74, 0x9f, 0x06, 0x50, 0x26, 0x55, 0xf1, 0xa0, 0xfd, 0x2a, 0x84, 0x2c,
+	0xb2, 0xd2, 0x0c, 0xe4, 0xc2, 0x22, 0x90, 0x8b, 0x4f, 0x82, 0x5c, 0x3a, 0x3d, 0x64, 0xed, 0xcf,
+	0x8a, 0x2c, 0x69, 0xb7, 0x11, 0x3c, 0x92, 0xa2, 0xdd, 0x04, 0xcd, 0x11, 0x1a, 0x0d, 0x50, 0x10,
+	0x9d, 0xe9, 0xe6, 0x99, 0xa5, 0x21, 0xf8, 0xc3, 0xc3, 0xde, 0x05, 0xc1, 0xf6, 0x79, 0x41, 0x66,
+	0x09, 0x11, 0x7a, 0x1c, 0xdc, 0x5b, 0x5c, 0xd0, 0x2f, 0x69, 0x2a, 0x71, 0x36, 0xb8, 0xd4, 0x83,
+	0xd0, 0x3e, 0xc4, 0xa0, 0x98, 0xd9, 0xa8, 0x5d, 0xee, 0x16, 0x37, 0xea, 0xdb, 0xaf, 0x66, 0x8e,
+	0x6a, 0x98, 0x02, 0x62, 0xd0, 0xf7, 0x11, 0x85, 0x8e, 0xab, 0x2f, 0xcb, 0x1d, 0x0e, 0xf1, 0x8e,
+	0x65, 0xa9, 0xfb, 0x60, 0x25, 0xb6, 0xa3, 0xc8, 0x5d, 0xed, 0x4a, 0xb7, 0xf8, 0x44, 0x90, 0xad,
+	0x68, 0x0b, 0xe1, 0xd7, 0xda, 0x5f, 0x0a, 0x51, 0x01, 0xf2, 0xd0, 0xf1, 0x57, 0x46, 0xdd, 0x33,
+	0x59, 0xa1, 0x9c, 0x3b, 0x2b, 0xec, 0x83, 0x25, 0xa9, 0x2a, 0xae, 0xd3, 0x7c, 0x86, 0x0a, 0x97,
+	0x6a, 0xbf, 0x08, 0x6b, 0x5e, 0x8a, 0x47, 0xfd, 0x36, 0xa8, 0x08, 0xae, 0xb9, 0xca, 0x95, 0x7c,
+	0x6a, 0x0f, 0xb4, 0xd0, 0x7d, 0xdf, 0x09, 0x20, 0x75, 0xb0, 0x67, 0x50, 0x47, 0x66, 0xd1, 0xfa,
+	0xf6, 0xda, 0xa6, 0x98, 0xac, 0x6f, 0x86, 0x93, 0xf5, 0xcd, 0xc3, 0x70, 0xb2, 0xbe, 0x5b, 0xfa,
+	0xe0, 0xaf, 0xeb, 0x8a, 0xde, 0x9c, 0x2e, 0x64, 0x3f, 0x69, 0xff, 0x56, 0x12, 0x05, 0x8e, 0x4b,
+	0x77, 0x8b, 0xe5, 0xbd, 0x67, 0xdb, 0xea, 0xd9, 0xa9, 0xfc, 0x41, 0xd8, 0x60, 0xbe, 0xe5, 0x04,
+	0x01, 0x0e, 0x9e, 0x6a, 0xc6, 0x99, 0x6f, 0x88, 0x97, 0x6b, 0x66, 0xa9, 0x81, 0x86, 0x85, 0x08,
+	0x35, 0xcc, 0xbb, 0xd0, 0xf1, 0xa6, 0x6d, 0x63, 0x9d, 0x11, 0xf7, 0x18, 0xad, 0x67, 0x69, 0x7f,
+	0x08, 0x0f, 0xd2, 0x71, 0x28, 0x3a, 0x22, 0x63, 0x97, 0xb2, 0x4e, 0x47, 0x1e, 0xd6, 0x14, 0xbe,
+	0x30, 0x3c, 0x8a, 0x9d, 0xb3, 0xc8, 0xff, 0x4a, 0x6a, 0xff, 0x99, 0xed, 0x6e, 0x4f, 0x83, 0xf5,
+	0xe3, 0xa4, 0x79, 0x04, 0xd6, 0xa7, 0x35, 0xcf, 0x39, 0x63, 0xfa, 0x63, 0xd8, 0x08, 0x09, 0x4c,
+	0x17, 0xaa, 0xf7, 0x4b, 0xc9, 0x5f, 0x4a, 0xcb, 0xff, 0xfb, 0x30, 0x05, 0xc7, 0xe4, 0x9f, 0x63,
+	0x92, 0x73, 0x94, 0xf6, 0x48, 0x3a, 0x50, 0x9f, 0x42, 0x17, 0x1d, 0x60, 0xd7, 0x31, 0x27, 0x7b,
+	0x2e, 0x82, 0xde, 0xd8, 0x57, 0xd7, 0x40, 0x75, 0xe0, 0x62, 0xf3, 0xde, 0xdb, 0xe3, 0x11, 0x97,
+	0xb7, 0xa8, 0x47, 0xcf, 0xac, 0xdc, 0xc9, 0xd3, 0x8c, 0xe3, 0x0d, 0xb1, 0x2c, 0x0b, 0x99, 0xe5,
+	0x4e, 0x94, 0x7d, 0x76, 0x96, 0xd1, 0x81, 0x15, 0xfd, 0xaf, 0xfd, 0xb4, 0x00, 0x56, 0xa5, 0x96,
+	0x6c, 0x51, 0x27, 0xbe, 0xc4, 0x34, 0x99, 0xeb, 0xae, 0xe3, 0x15, 0xb0, 0x62, 0x11, 0x6a, 0x64,
+	0xcd, 0xee, 0x9a, 0x16, 0xa1, 0x07, 0x89, 0xf1, 0x5d, 0x68, 0xdf, 0x72, 0xbe, 0x0b, 0x3b, 0xed,
+	0x1f, 0x0a, 0x58, 0x8b, 0x0d, 0x2c, 0x2f, 0xbc, 0x52, 0xa6, 0x48, 0x4b, 0x39, 0x91, 0xfe, 0x5d,
+	0x01, 0xed, 0xd8, 0x00, 0x42, 0x20, 0x45, 0x5f, 0x3d, 0x9c, 0x9f, 0x14, 0xc0, 0x8b, 0x72, 0x0c,
+	0x38, 0xf2, 0x99, 0xdb, 0x5f, 0x78, 0x9b, 0xce, 0xbf, 0x39, 0x2b, 0xcd, 0xbd, 0xb2, 0x7e, 0x05,
+	0xac, 0x90, 0xc0, 0x9c, 0x09, 0x16, 0x91, 0xe4, 0x9b, 0x24, 0x30, 0xb3, 0x83, 0xa5, 0x92, 0x53,
+	0xb5, 0x06, 0xa8, 0xcb, 0x51, 0x37, 0x3d, 0x84, 0x36, 0xcb, 0x53, 0xe1, 0xc7, 0x1b, 0x72, 0x92,
+	0x13, 0x3d, 0xab, 0xaf, 0x83, 0x12, 0x85, 0x36, 0x91, 0x09, 0xaa, 0x9b, 0x7d, 0xbd, 0x21, 0xbb,
+	0x70, 0x68, 0x13, 0x9d, 0x73, 0x6b, 0xbf, 0x2d, 0x48, 0x1f, 0x8d, 0x8f, 0x63, 0xf6, 0xc4, 0xbd,
+	0xcc, 0x82, 0x76, 0x5b, 0x7c, 0xa0, 0xf4, 0xf4, 0xf7, 0x6c, 0xb3, 0xf7, 0x59, 0xe5, 0xf4, 0x7d,
+	0x56, 0x62, 0xa4, 0x5d, 0x99, 0xbd, 0x83, 0x69, 0x83, 0xa5, 0x23, 0x14, 0x10, 0x07, 0x7b, 0x7c,
+	0x42, 0x5b, 0xd4, 0xc3, 0x47, 0xed, 0xe3, 0x22, 0x58, 0x3f, 0x49, 0x53, 0xfd, 0xb1, 0x69, 0xb2,
+	0x83, 0xfe, 0x33, 0xa9, 0xb0, 0xc4, 0xcd, 0x5c, 0x39, 0x7d, 0x33, 0xf7, 0x2a, 0x58, 0xf1, 0x03,
+	0x74, 0x64, 0x24, 0x14, 0x5b, 0xe1, 0x8a, 0x6d, 0xb1, 0x1f, 0x0e, 0x62, 0xca, 0xdd, 0x00, 0x97,
+	0x3d, 0x74, 0x9c, 0x64, 0x15, 0x9f, 0xa7, 0x34, 0x3d, 0x74, 0x1c, 0xe7, 0xfc, 0x26, 0x68, 0xf2,
+	0x5d, 0xa7, 0xb6, 0xa8, 0x72, 0x5b, 0x34, 0x18, 0x75, 0x2f, 0xb2, 0xc7, 0x37, 0x40, 0x83, 0x6d,
+	0x38, 0x7b, 0x09, 0xb1, 0xec, 0xa1, 0xe3, 0xbd, 0x2c, 0xa3, 0x81, 0x84, 0xd1, 0x58, 0xbb, 0x21,
+	0x66, 0xa6, 0x96, 0x01, 0x29, 0xbf, 0x76, 0x2c, 0xea, 0x35, 0x49, 0xd9, 0xa1, 0xda, 0x43, 0x05,
+	0x74, 0x62, 0xb5, 0xe8, 0x8b, 0x8b, 0x81, 0x73, 0xec, 0x3c, 0xb5, 0x4f, 0x0b, 0xe0, 0x4a, 0x98,
+	0x34, 0x44, 0x52, 0x79, 0xd3, 0xc5, 0xc7, 0x3a, 0xa4, 0xe8, 0xb6, 0x33, 0x72, 0xce, 0x0c, 0x51,
+	0xc6, 0xd7, 0x46, 0xc5, 0x9c, 0x5f, 0x1b, 0x7d, 0x0f, 0x2c, 0xcb, 0x77, 0x88, 0x0e, 0xb8, 0x34,
+	0x67, 0xbd, 0x94, 0xe8, 0x0e, 0xef, 0x83, 0x2d, 0xd0, 0x1a, 0xba, 0xf8, 0xd8, 0x60, 0x35, 0xd6,
+	0x70, 0x19, 0x52, 0x79, 0x21, 0xf7, 0x86, 0x54, 0xdb, 0x35, 0xdb, 0xa1, 0x77, 0xc7, 0x83, 0x4d,
+	0x13, 0x8f, 0xe4, 0x27, 0x75, 0xf2, 0xcf, 0x75, 0x62, 0xdd, 0x93, 0x5f, 0xaa, 0xf5, 0xb8, 0x62,
+	0x81, 0x7c, 0x5b, 0xcf, 0xa3, 0x7a, 0x63, 0x18, 0x57, 0x9e, 0xf6, 0xeb, 0xd0, 0x63, 0x32, 0x34,
+	0xdb, 0xcf, 0x3c, 0x75, 0xa4, 0x27, 0xee, 0x57, 0x01, 0x70, 0x88, 0x10, 0x11, 0x89, 0x80, 0xaf,
+	0xea, 0x35, 0x87, 0xdc, 0x16, 0x84, 0xc5, 0xcb, 0x9a, 0xf6, 0x27, 0x05, 0x5c, 0xe5, 0xc2, 0x1d,
+	0x62, 0xdb, 0x76, 0x51, 0xff, 0x60, 0x87, 0xb0, 0x9e, 0xd4, 0xe6, 0xde, 0x6e, 0x33, 0x6f, 0x3e,
+	0xcd, 0x6d, 0xc0, 0xf4, 0xe5, 0x85, 0x9c, 0x35, 0x95, 0xf8, 0x06, 0x24, 0x7c, 0x5c, 0x66, 0x8b,
+	0x90, 0x63, 0xef, 0x34, 0x2c, 0x87, 0xc0, 0x81, 0x8b, 0x04, 0x96, 0xaa, 0xbe, 0x46, 0xfc, 0x59,
+	0xb1, 0xf6, 0x25, 0xc7, 0x6e, 0xef, 0xc1, 0xa3, 0x8e, 0xf2, 0xd1, 0xa3, 0x8e, 0xf2, 0xb7, 0x47,
+	0x1d, 0xe5, 0x83, 0xc7, 0x9d, 0x4b, 0x1f, 0x3d, 0xee, 0x5c, 0xfa, 0xe4, 0x71, 0xe7, 0xd2, 0x7b,
+	0x5b, 0x31, 0xe3, 0x0d, 0xbc, 0xc1, 0x75, 0xde, 0xe8, 0x6f, 0xc5, 0xbe, 0x38, 0xbc, 0x9f, 0xfc,
+	0xe6, 0x70, 0x50, 0xe1, 0x03, 0x9b, 0xd7, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x58, 0x5b, 0x27,
+	0xec, 0x80, 0x29, 0x00, 0x00,
 }
 
 func (m *EventCreateBucket) Marshal() (dAtA []byte, err error) {
@@ -3098,6 +3120,30 @@ func (m *EventCreateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EcProfile != nil {
+		{
+			size, err := m.EcProfile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.PlacementConstraint != nil {
+		{
+			size, err := m.PlacementConstraint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.Status != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
 		i--
@@ -4263,12 +4309,12 @@ func (m *EventGroupMemberDetail) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if m.ExpirationTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
-		if err1 != nil {
-			return 0, err1
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n1
-		i = encodeVarintEvents(dAtA, i, uint64(n1))
+		i -= n3
+		i = encodeVarintEvents(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -5407,6 +5453,14 @@ func (m *EventCreateBucket) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovEvents(uint64(m.Status))
 	}
+	if m.PlacementConstraint != nil {
+		l = m.PlacementConstraint.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.EcProfile != nil {
+		l = m.EcProfile.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -6669,6 +6723,78 @@ func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementConstraint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PlacementConstraint == nil {
+				m.PlacementConstraint = &PlacementConstraint{}
+			}
+			if err := m.PlacementConstraint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EcProfile == nil {
+				m.EcProfile = &ECProfile{}
+			}
+			if err := m.EcProfile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
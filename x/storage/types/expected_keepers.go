@@ -42,6 +42,9 @@ type SpKeeper interface {
 	GetStorageProviderBySealAddr(ctx sdk.Context, sealAddr sdk.AccAddress) (sp *sptypes.StorageProvider, found bool)
 	GetStorageProviderByGcAddr(ctx sdk.Context, gcAddr sdk.AccAddress) (sp *sptypes.StorageProvider, found bool)
 	GetGlobalSpStorePriceByTime(ctx sdk.Context, time int64) (val sptypes.GlobalSpStorePrice, err error)
+	RecordSpSealResult(ctx sdk.Context, spId uint32, rejected bool)
+	GetSpApprovalAddresses(ctx sdk.Context, sp *sptypes.StorageProvider) []sdk.AccAddress
+	GetSpBlsKeys(ctx sdk.Context, sp *sptypes.StorageProvider) [][]byte
 }
 
 type PaymentKeeper interface {
@@ -191,6 +191,34 @@ func (mr *MockSpKeeperMockRecorder) GetGlobalSpStorePriceByTime(ctx, time interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGlobalSpStorePriceByTime", reflect.TypeOf((*MockSpKeeper)(nil).GetGlobalSpStorePriceByTime), ctx, time)
 }
 
+// GetSpApprovalAddresses mocks base method.
+func (m *MockSpKeeper) GetSpApprovalAddresses(ctx types4.Context, sp *types2.StorageProvider) []types4.AccAddress {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpApprovalAddresses", ctx, sp)
+	ret0, _ := ret[0].([]types4.AccAddress)
+	return ret0
+}
+
+// GetSpApprovalAddresses indicates an expected call of GetSpApprovalAddresses.
+func (mr *MockSpKeeperMockRecorder) GetSpApprovalAddresses(ctx, sp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpApprovalAddresses", reflect.TypeOf((*MockSpKeeper)(nil).GetSpApprovalAddresses), ctx, sp)
+}
+
+// GetSpBlsKeys mocks base method.
+func (m *MockSpKeeper) GetSpBlsKeys(ctx types4.Context, sp *types2.StorageProvider) [][]byte {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpBlsKeys", ctx, sp)
+	ret0, _ := ret[0].([][]byte)
+	return ret0
+}
+
+// GetSpBlsKeys indicates an expected call of GetSpBlsKeys.
+func (mr *MockSpKeeperMockRecorder) GetSpBlsKeys(ctx, sp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpBlsKeys", reflect.TypeOf((*MockSpKeeper)(nil).GetSpBlsKeys), ctx, sp)
+}
+
 // GetStorageProvider mocks base method.
 func (m *MockSpKeeper) GetStorageProvider(ctx types4.Context, id uint32) (*types2.StorageProvider, bool) {
 	m.ctrl.T.Helper()
@@ -265,6 +293,18 @@ func (mr *MockSpKeeperMockRecorder) MustGetStorageProvider(ctx, id interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MustGetStorageProvider", reflect.TypeOf((*MockSpKeeper)(nil).MustGetStorageProvider), ctx, id)
 }
 
+// RecordSpSealResult mocks base method.
+func (m *MockSpKeeper) RecordSpSealResult(ctx types4.Context, spId uint32, rejected bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordSpSealResult", ctx, spId, rejected)
+}
+
+// RecordSpSealResult indicates an expected call of RecordSpSealResult.
+func (mr *MockSpKeeperMockRecorder) RecordSpSealResult(ctx, spId, rejected interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSpSealResult", reflect.TypeOf((*MockSpKeeper)(nil).RecordSpSealResult), ctx, spId, rejected)
+}
+
 // MockPaymentKeeper is a mock of PaymentKeeper interface.
 type MockPaymentKeeper struct {
 	ctrl     *gomock.Controller
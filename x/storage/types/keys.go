@@ -66,6 +66,13 @@ var (
 
 	BucketRateLimitPrefix       = []byte{0x71}
 	BucketRateLimitStatusPrefix = []byte{0x72}
+
+	PlacementConstrainedBucketPrefix = []byte{0x81}
+
+	BucketByGVGFamilyPrefix = []byte{0x91}
+	ObjectByGVGPrefix       = []byte{0x92}
+
+	CrossChainPackageRecordPrefix = []byte{0xa1}
 )
 
 // GetBucketKey return the bucket name store key
@@ -187,3 +194,57 @@ func GetBucketFlowRateLimitStatusKey(bucketName string) []byte {
 	bucketNameHash := sdk.Keccak256([]byte(bucketName))
 	return append(BucketRateLimitPrefix, bucketNameHash...)
 }
+
+// GetPlacementConstrainedBucketKey return the key of a bucket with placement constraint within a gvg family
+func GetPlacementConstrainedBucketKey(familyID uint32, bucketID math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(GetPlacementConstrainedBucketsByFamilyPrefix(familyID), seq.EncodeSequence(bucketID)...)
+}
+
+// GetPlacementConstrainedBucketsByFamilyPrefix return the prefix of the buckets with placement constraint within a gvg family
+func GetPlacementConstrainedBucketsByFamilyPrefix(familyID uint32) []byte {
+	familyIDBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(familyIDBytes, familyID)
+	return append(PlacementConstrainedBucketPrefix, familyIDBytes...)
+}
+
+// GetBucketByGVGFamilyKey return the key of a bucket within a gvg family
+func GetBucketByGVGFamilyKey(familyID uint32, bucketID math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(GetBucketsByGVGFamilyPrefix(familyID), seq.EncodeSequence(bucketID)...)
+}
+
+// GetBucketsByGVGFamilyPrefix return the prefix of the buckets within a gvg family
+func GetBucketsByGVGFamilyPrefix(familyID uint32) []byte {
+	familyIDBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(familyIDBytes, familyID)
+	return append(BucketByGVGFamilyPrefix, familyIDBytes...)
+}
+
+// GetObjectByGVGKey return the key of an object stored on a gvg
+func GetObjectByGVGKey(gvgID uint32, bucketID, objectID math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(GetObjectsByGVGAndBucketPrefix(gvgID, bucketID), seq.EncodeSequence(objectID)...)
+}
+
+// GetObjectsByGVGAndBucketPrefix return the prefix of the objects of a bucket stored on a gvg,
+// the bucket id is length prefixed since its encoding is not fixed-length.
+func GetObjectsByGVGAndBucketPrefix(gvgID uint32, bucketID math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	bucketIDBytes := seq.EncodeSequence(bucketID)
+	return append(append(GetObjectsByGVGPrefix(gvgID), byte(len(bucketIDBytes))), bucketIDBytes...)
+}
+
+// GetObjectsByGVGPrefix return the prefix of the objects stored on a gvg
+func GetObjectsByGVGPrefix(gvgID uint32) []byte {
+	gvgIDBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(gvgIDBytes, gvgID)
+	return append(ObjectByGVGPrefix, gvgIDBytes...)
+}
+
+// ParseObjectByGVGKey parses the bucket id and the object id from the key without the gvg prefix
+func ParseObjectByGVGKey(key []byte) (bucketID, objectID math.Uint) {
+	var seq sequence.Sequence[math.Uint]
+	bucketIDLen := int(key[0])
+	return seq.DecodeSequence(key[1 : 1+bucketIDLen]), seq.DecodeSequence(key[1+bucketIDLen:])
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectByGVGKey(t *testing.T) {
+	for _, bucketID := range []math.Uint{math.ZeroUint(), math.NewUint(1), math.NewUint(1 << 40)} {
+		objectID := math.NewUint(258)
+		key := GetObjectByGVGKey(7, bucketID, objectID)
+		require.True(t, bytes.HasPrefix(key, GetObjectsByGVGAndBucketPrefix(7, bucketID)))
+
+		parsedBucketID, parsedObjectID := ParseObjectByGVGKey(key[len(GetObjectsByGVGPrefix(7)):])
+		require.True(t, bucketID.Equal(parsedBucketID))
+		require.True(t, objectID.Equal(parsedObjectID))
+	}
+
+	// the objects of a bucket never share the prefix of another bucket whose id encoding is longer
+	require.False(t, bytes.HasPrefix(GetObjectByGVGKey(7, math.NewUint(1<<8), math.NewUint(1)), GetObjectsByGVGAndBucketPrefix(7, math.NewUint(1))))
+}
@@ -160,6 +160,19 @@ func (msg *MsgCreateBucket) GetApprovalBytes() []byte {
 	return fakeMsg.GetSignBytes()
 }
 
+// GetPlacementConstraint returns the placement constraint made up of the allowed regions and jurisdictions
+func (msg *MsgCreateBucket) GetPlacementConstraint() *PlacementConstraint {
+	return &PlacementConstraint{
+		AllowedRegions:       msg.AllowedRegions,
+		AllowedJurisdictions: msg.AllowedJurisdictions,
+	}
+}
+
+// GetECProfile returns the EC redundancy profile chosen by the bucket, nil for the default profile
+func (msg *MsgCreateBucket) GetECProfile() *ECProfile {
+	return NewECProfile(msg.RedundantDataChunkNum, msg.RedundantParityChunkNum)
+}
+
 // ValidateBasic implements the sdk.Msg interface.
 func (msg *MsgCreateBucket) ValidateBasic() error {
 	_, err := sdk.AccAddressFromHexUnsafe(msg.Creator)
@@ -191,7 +204,14 @@ func (msg *MsgCreateBucket) ValidateBasic() error {
 	if err != nil {
 		return err
 	}
-	return nil
+
+	if ecProfile := msg.GetECProfile(); ecProfile != nil {
+		if err = ecProfile.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return msg.GetPlacementConstraint().ValidateBasic()
 }
 
 // NewMsgDeleteBucket creates a new MsgDeleteBucket instance
@@ -83,6 +83,30 @@ func TestMsgCreateBucket_ValidateBasic(t *testing.T) {
 				PrimarySpApproval: &common.Approval{},
 			},
 			err: gnfderrors.ErrInvalidBucketName,
+		}, {
+			name: "valid ec profile",
+			msg: MsgCreateBucket{
+				Creator:                 sample.RandAccAddressHex(),
+				BucketName:              testBucketName,
+				Visibility:              VISIBILITY_TYPE_PUBLIC_READ,
+				PaymentAddress:          sample.RandAccAddressHex(),
+				PrimarySpAddress:        sample.RandAccAddressHex(),
+				PrimarySpApproval:       &common.Approval{},
+				RedundantDataChunkNum:   6,
+				RedundantParityChunkNum: 3,
+			},
+		}, {
+			name: "invalid ec profile",
+			msg: MsgCreateBucket{
+				Creator:               sample.RandAccAddressHex(),
+				BucketName:            testBucketName,
+				Visibility:            VISIBILITY_TYPE_PUBLIC_READ,
+				PaymentAddress:        sample.RandAccAddressHex(),
+				PrimarySpAddress:      sample.RandAccAddressHex(),
+				PrimarySpApproval:     &common.Approval{},
+				RedundantDataChunkNum: 6,
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		},
 	}
 	for _, tt := range tests {
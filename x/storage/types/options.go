@@ -9,12 +9,14 @@ import (
 )
 
 type CreateBucketOptions struct {
-	Visibility        VisibilityType
-	SourceType        SourceType
-	ChargedReadQuota  uint64
-	PaymentAddress    string
-	PrimarySpApproval *common.Approval
-	ApprovalMsgBytes  []byte
+	Visibility          VisibilityType
+	SourceType          SourceType
+	ChargedReadQuota    uint64
+	PaymentAddress      string
+	PrimarySpApproval   *common.Approval
+	ApprovalMsgBytes    []byte
+	PlacementConstraint *PlacementConstraint
+	ECProfile           *ECProfile
 }
 
 type DeleteBucketOptions struct {
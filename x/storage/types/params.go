@@ -67,6 +67,7 @@ var (
 	KeyOpMirrorGroupRelayerFee          = []byte("OpMirrorGroupRelayerFee")
 	KeyOpMirrorGroupAckRelayerFee       = []byte("OpMirrorGroupAckRelayerFee")
 	KeyMaxLocalVirtualGroupNumPerBucket = []byte("MaxLocalVirtualGroupNumPerBucket")
+	KeyECProfiles                       = []byte("ECProfiles")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -172,6 +173,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyStalePolicyCleanupMax, &p.StalePolicyCleanupMax, validateStalePolicyCleanupMax),
 		paramtypes.NewParamSetPair(KeyMinUpdateQuotaInterval, &p.MinQuotaUpdateInterval, validateMinUpdateQuotaInterval),
 		paramtypes.NewParamSetPair(KeyMaxLocalVirtualGroupNumPerBucket, &p.MaxLocalVirtualGroupNumPerBucket, validateMaxLocalVirtualGroupNumPerBucket),
+		paramtypes.NewParamSetPair(KeyECProfiles, &p.EcProfiles, validateECProfiles),
 	}
 }
 
@@ -255,6 +257,9 @@ func (p Params) Validate() error {
 	if err := validateMaxLocalVirtualGroupNumPerBucket(p.MaxLocalVirtualGroupNumPerBucket); err != nil {
 		return err
 	}
+	if err := validateECProfiles(p.EcProfiles); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -70,6 +70,9 @@ type Params struct {
 	OpMirrorGroupRelayerFee string `protobuf:"bytes,22,opt,name=op_mirror_group_relayer_fee,json=opMirrorGroupRelayerFee,proto3" json:"op_mirror_group_relayer_fee,omitempty"`
 	// Relayer fee for the ACK or FAIL_ACK package of the mirror object tx to op chain
 	OpMirrorGroupAckRelayerFee string `protobuf:"bytes,23,opt,name=op_mirror_group_ack_relayer_fee,json=opMirrorGroupAckRelayerFee,proto3" json:"op_mirror_group_ack_relayer_fee,omitempty"`
+	// ec_profiles defines the EC redundancy profiles approved by governance which a bucket can choose at creation,
+	// besides the default profile defined by the versioned params.
+	EcProfiles []ECProfile `protobuf:"bytes,24,rep,name=ec_profiles,json=ecProfiles,proto3" json:"ec_profiles"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -265,6 +268,68 @@ func (m *Params) GetOpMirrorGroupAckRelayerFee() string {
 	return ""
 }
 
+func (m *Params) GetEcProfiles() []ECProfile {
+	if m != nil {
+		return m.EcProfiles
+	}
+	return nil
+}
+
+// ECProfile defines an EC redundancy scheme, the objects are stored by the primary sp and a secondary sp for each chunk.
+type ECProfile struct {
+	// data_chunk_num is the num of data chunks of EC redundancy algorithm
+	DataChunkNum uint32 `protobuf:"varint,1,opt,name=data_chunk_num,json=dataChunkNum,proto3" json:"data_chunk_num,omitempty"`
+	// parity_chunk_num is the num of parity chunks of EC redundancy algorithm
+	ParityChunkNum uint32 `protobuf:"varint,2,opt,name=parity_chunk_num,json=parityChunkNum,proto3" json:"parity_chunk_num,omitempty"`
+}
+
+func (m *ECProfile) Reset()         { *m = ECProfile{} }
+func (m *ECProfile) String() string { return proto.CompactTextString(m) }
+func (*ECProfile) ProtoMessage()    {}
+func (*ECProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_127b8b1511d84eca, []int{1}
+}
+func (m *ECProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ECProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ECProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ECProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ECProfile.Merge(m, src)
+}
+func (m *ECProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *ECProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_ECProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ECProfile proto.InternalMessageInfo
+
+func (m *ECProfile) GetDataChunkNum() uint32 {
+	if m != nil {
+		return m.DataChunkNum
+	}
+	return 0
+}
+
+func (m *ECProfile) GetParityChunkNum() uint32 {
+	if m != nil {
+		return m.ParityChunkNum
+	}
+	return 0
+}
+
 // VersionedParams defines the parameters for the storage module with multi version, each version store with different timestamp.
 type VersionedParams struct {
 	// max_segment_size is the maximum size of a segment. default: 16M
@@ -280,7 +345,7 @@ type VersionedParams struct {
 func (m *VersionedParams) Reset()      { *m = VersionedParams{} }
 func (*VersionedParams) ProtoMessage() {}
 func (*VersionedParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_127b8b1511d84eca, []int{1}
+	return fileDescriptor_127b8b1511d84eca, []int{2}
 }
 func (m *VersionedParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -339,65 +404,70 @@ func (m *VersionedParams) GetMinChargeSize() uint64 {
 
 func init() {
 	proto.RegisterType((*Params)(nil), "greenfield.storage.Params")
+	proto.RegisterType((*ECProfile)(nil), "greenfield.storage.ECProfile")
 	proto.RegisterType((*VersionedParams)(nil), "greenfield.storage.VersionedParams")
 }
 
 func init() { proto.RegisterFile("greenfield/storage/params.proto", fileDescriptor_127b8b1511d84eca) }
 
 var fileDescriptor_127b8b1511d84eca = []byte{
-	// 828 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0x4d, 0x6f, 0xdb, 0x36,
-	0x18, 0xb6, 0x16, 0x2f, 0x5b, 0x99, 0xa5, 0xc9, 0xb4, 0xa4, 0x51, 0x92, 0xce, 0xd1, 0x3a, 0xa0,
-	0xf0, 0x65, 0x36, 0xb0, 0x0f, 0x74, 0x1f, 0x45, 0xb1, 0xc6, 0xed, 0x8a, 0x02, 0x6b, 0xe7, 0xb9,
-	0x5b, 0x06, 0xec, 0x42, 0x50, 0x14, 0xa3, 0x70, 0x96, 0x48, 0x8d, 0xa2, 0x5c, 0xbb, 0xbf, 0x62,
-	0xc7, 0x1d, 0xf7, 0x73, 0x7a, 0xec, 0x71, 0x97, 0x7d, 0x20, 0xf9, 0x23, 0x03, 0x5f, 0xaa, 0xb6,
-	0x28, 0x25, 0xbb, 0x09, 0x7c, 0x3e, 0xf8, 0xf0, 0xd5, 0xfb, 0x92, 0xe8, 0x28, 0x51, 0x8c, 0x89,
-	0x53, 0xce, 0xd2, 0x78, 0x58, 0x68, 0xa9, 0x48, 0xc2, 0x86, 0x39, 0x51, 0x24, 0x2b, 0x06, 0xb9,
-	0x92, 0x5a, 0xfa, 0xfe, 0x8a, 0x30, 0xa8, 0x08, 0x07, 0x3b, 0x89, 0x4c, 0x24, 0xc0, 0x43, 0xf3,
-	0x65, 0x99, 0xb7, 0xfe, 0xda, 0x40, 0xeb, 0x63, 0x90, 0xfa, 0x3f, 0xa0, 0xed, 0x19, 0x53, 0x05,
-	0x97, 0x82, 0xc5, 0xd8, 0xda, 0x05, 0x5e, 0xe8, 0xf5, 0x37, 0x3e, 0xfe, 0x70, 0xd0, 0xf6, 0x1b,
-	0x9c, 0xbc, 0xe6, 0x5a, 0xf9, 0x71, 0xf7, 0xe5, 0xdf, 0x47, 0x9d, 0xc9, 0xd6, 0xcc, 0x5d, 0xf6,
-	0xfb, 0x68, 0x3b, 0x23, 0x73, 0x9c, 0x93, 0x45, 0x2a, 0x49, 0x8c, 0x0b, 0xfe, 0x82, 0x05, 0x6f,
-	0x84, 0x5e, 0xbf, 0x3b, 0xb9, 0x9e, 0x91, 0xf9, 0xd8, 0x2e, 0x3f, 0xe3, 0x2f, 0x98, 0xff, 0x35,
-	0x7a, 0x3f, 0x2a, 0x28, 0xce, 0xb8, 0x52, 0x52, 0xe1, 0xa8, 0xa4, 0x53, 0xa6, 0xb1, 0x62, 0x29,
-	0x59, 0x30, 0x85, 0x4f, 0x19, 0x0b, 0xd6, 0x42, 0xaf, 0x7f, 0x6d, 0xb2, 0x1f, 0x15, 0xf4, 0x09,
-	0x70, 0x8e, 0x81, 0x32, 0xb1, 0x8c, 0x6f, 0x18, 0xf3, 0x1f, 0xa1, 0x0f, 0xda, 0x0e, 0x84, 0x4e,
-	0x1d, 0x97, 0x2e, 0xb8, 0xdc, 0x6c, 0xb8, 0xdc, 0xa7, 0xd3, 0x9a, 0x91, 0x1b, 0x45, 0x46, 0xbf,
-	0x30, 0xea, 0x46, 0x79, 0xb3, 0x11, 0xe5, 0x3b, 0xa0, 0x5c, 0x19, 0xa5, 0x72, 0x68, 0x46, 0x59,
-	0x6f, 0x44, 0xb1, 0x2e, 0x6e, 0x94, 0x7b, 0xe8, 0x66, 0xcd, 0x28, 0x51, 0xb2, 0xcc, 0x1d, 0x8f,
-	0xb7, 0xc0, 0x23, 0x58, 0x7a, 0x3c, 0x32, 0x8c, 0x9a, 0xfe, 0x21, 0x0a, 0x5b, 0xfa, 0x66, 0x8e,
-	0xb7, 0xc1, 0xe3, 0xd0, 0xf5, 0x70, 0x63, 0x7c, 0x86, 0xf6, 0xcc, 0x6f, 0xb4, 0x35, 0x2d, 0x70,
-	0xce, 0x14, 0x26, 0x94, 0xca, 0x52, 0xe8, 0xe0, 0x5a, 0xe8, 0xf5, 0x37, 0x27, 0x3b, 0x19, 0x99,
-	0xdb, 0x52, 0x16, 0x63, 0xa6, 0xee, 0x5b, 0xcc, 0xbf, 0x87, 0x0e, 0x63, 0x5e, 0x50, 0x29, 0x34,
-	0x17, 0x25, 0xc3, 0xb0, 0xc8, 0x45, 0x82, 0x9f, 0x73, 0x11, 0xcb, 0xe7, 0x01, 0x82, 0x46, 0xd8,
-	0xaf, 0x51, 0x46, 0x15, 0xe3, 0x27, 0x20, 0xf8, 0x9f, 0xa2, 0x1b, 0x75, 0x7d, 0x55, 0xc7, 0x8c,
-	0xcc, 0x83, 0x0d, 0x90, 0xee, 0xd4, 0x50, 0x5b, 0xbd, 0x27, 0x64, 0xde, 0x54, 0x55, 0x8d, 0x60,
-	0x54, 0xef, 0xb4, 0x54, 0x36, 0xb3, 0x51, 0xdd, 0x45, 0x07, 0x6e, 0x56, 0x71, 0xca, 0x55, 0x66,
-	0x8e, 0xca, 0x65, 0x1c, 0x6c, 0x86, 0x5e, 0x7f, 0x6d, 0x12, 0x38, 0x51, 0x81, 0x30, 0x06, 0xdc,
-	0xff, 0x1c, 0xd5, 0x31, 0x1c, 0xb3, 0x94, 0x69, 0x2e, 0x05, 0xec, 0x7a, 0x1d, 0x76, 0xad, 0x67,
-	0x7a, 0x50, 0xc1, 0x66, 0xdf, 0x3b, 0x28, 0x28, 0x34, 0x49, 0x19, 0xce, 0x65, 0xca, 0xe9, 0x02,
-	0xd3, 0x94, 0x11, 0x51, 0xe6, 0xa0, 0xdc, 0x02, 0xe5, 0x2e, 0xe0, 0x63, 0x80, 0x47, 0x16, 0x35,
-	0xc2, 0x2f, 0xd0, 0x7e, 0xc6, 0x05, 0xfe, 0xb5, 0x94, 0x9a, 0xe0, 0x32, 0x8f, 0x89, 0x66, 0x98,
-	0x0b, 0xcd, 0xd4, 0x8c, 0xa4, 0xc1, 0xb6, 0xdd, 0x33, 0xe3, 0xe2, 0x7b, 0x83, 0xff, 0x08, 0xf0,
-	0xe3, 0x0a, 0xf5, 0xc7, 0xe8, 0xb6, 0xf9, 0x9d, 0xa9, 0xa4, 0x24, 0xc5, 0x33, 0xae, 0x74, 0x49,
-	0xd2, 0xaa, 0x39, 0x44, 0x09, 0x67, 0xae, 0xaa, 0x16, 0xbc, 0x0b, 0x7f, 0x37, 0xcc, 0xc8, 0xfc,
-	0x5b, 0x43, 0x3e, 0xb1, 0x5c, 0xe8, 0x90, 0xa7, 0xa5, 0x39, 0xbc, 0x2d, 0xa0, 0xe9, 0x53, 0x99,
-	0xff, 0xcf, 0xf0, 0xfa, 0xb6, 0x4f, 0x65, 0x7e, 0xc5, 0xec, 0x3e, 0x44, 0x61, 0x4b, 0xdf, 0xec,
-	0xd3, 0xf7, 0x6c, 0x9f, 0xba, 0x1e, 0xad, 0x71, 0x59, 0xd9, 0x5c, 0x32, 0xb8, 0x3b, 0x6e, 0x8c,
-	0xd6, 0xdc, 0x3a, 0x31, 0xae, 0x18, 0xdb, 0x5d, 0x37, 0xc6, 0x65, 0x53, 0x7b, 0x17, 0x1d, 0xae,
-	0x6c, 0xda, 0x43, 0x7b, 0x03, 0x1c, 0xf6, 0x5e, 0x3b, 0x34, 0x67, 0x76, 0x84, 0x8e, 0x9a, 0xea,
-	0x66, 0x86, 0x3d, 0x70, 0x38, 0x70, 0x1c, 0x9c, 0x08, 0x5f, 0x76, 0x7f, 0xff, 0xe3, 0xa8, 0x73,
-	0xeb, 0x1f, 0x0f, 0x6d, 0x9d, 0x5c, 0x7e, 0x25, 0x17, 0x2c, 0xc9, 0x98, 0xd0, 0xf6, 0x4a, 0xf6,
-	0x96, 0x57, 0xf2, 0x33, 0xbb, 0x0c, 0x57, 0xf2, 0x1d, 0x14, 0x28, 0x16, 0x97, 0x22, 0x26, 0x42,
-	0xe3, 0x98, 0x68, 0x82, 0xe9, 0x59, 0x29, 0xa6, 0xa6, 0x47, 0xe0, 0x12, 0xdf, 0x9c, 0xec, 0x2e,
-	0xf1, 0x07, 0x44, 0x93, 0x91, 0x41, 0x9f, 0x96, 0x99, 0xff, 0x15, 0x3a, 0x58, 0x09, 0x73, 0xa2,
-	0xb8, 0x5e, 0xd4, 0xa4, 0x6b, 0x20, 0xdd, 0x5b, 0x32, 0xc6, 0x40, 0x58, 0x8a, 0x6f, 0xa3, 0x2d,
-	0xd3, 0xd7, 0xf4, 0x8c, 0xa8, 0x84, 0xd9, 0x78, 0x5d, 0x88, 0xb7, 0x99, 0x71, 0x31, 0x82, 0x55,
-	0x93, 0xce, 0x9e, 0xf0, 0xf8, 0xf1, 0xcb, 0xf3, 0x9e, 0xf7, 0xea, 0xbc, 0xe7, 0xfd, 0x7b, 0xde,
-	0xf3, 0x7e, 0xbb, 0xe8, 0x75, 0x5e, 0x5d, 0xf4, 0x3a, 0x7f, 0x5e, 0xf4, 0x3a, 0x3f, 0x0f, 0x13,
-	0xae, 0xcf, 0xca, 0x68, 0x40, 0x65, 0x36, 0x8c, 0x44, 0xf4, 0x11, 0x3d, 0x23, 0x5c, 0x0c, 0x6b,
-	0x6f, 0xe7, 0x7c, 0xf9, 0x7a, 0xea, 0x45, 0xce, 0x8a, 0x68, 0x1d, 0xde, 0xc4, 0x4f, 0xfe, 0x0b,
-	0x00, 0x00, 0xff, 0xff, 0xb3, 0x74, 0xe3, 0x82, 0x60, 0x07, 0x00, 0x00,
+	// 886 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x5f, 0x37, 0x4b, 0x20, 0x93, 0xbf, 0x98, 0xa4, 0x71, 0x92, 0x76, 0x63, 0x02, 0xaa, 0xf6,
+	0xc2, 0xae, 0x54, 0x40, 0xe5, 0x4f, 0x55, 0xd1, 0x6c, 0x42, 0x55, 0x89, 0x96, 0x65, 0x0b, 0x41,
+	0x82, 0xc3, 0x68, 0x76, 0x3c, 0x71, 0x86, 0xd8, 0x33, 0x66, 0x3c, 0x4e, 0x77, 0xfb, 0x29, 0x38,
+	0x72, 0x44, 0xe2, 0xcb, 0xf4, 0xd8, 0x23, 0x27, 0x40, 0xc9, 0x17, 0x41, 0xf3, 0xc6, 0xd9, 0x78,
+	0xec, 0x84, 0x9b, 0xf5, 0x7e, 0x7f, 0xe6, 0xe7, 0xf1, 0x7b, 0x7e, 0x68, 0x37, 0x56, 0x8c, 0x89,
+	0x63, 0xce, 0x92, 0xa8, 0x9f, 0x6b, 0xa9, 0x48, 0xcc, 0xfa, 0x19, 0x51, 0x24, 0xcd, 0x7b, 0x99,
+	0x92, 0x5a, 0xfa, 0xfe, 0x15, 0xa1, 0x57, 0x12, 0xb6, 0xd7, 0x63, 0x19, 0x4b, 0x80, 0xfb, 0xe6,
+	0xc9, 0x32, 0xf7, 0xfe, 0x5c, 0x42, 0xf3, 0x43, 0x90, 0xfa, 0xdf, 0xa3, 0xb5, 0x33, 0xa6, 0x72,
+	0x2e, 0x05, 0x8b, 0xb0, 0xb5, 0x0b, 0xbc, 0xd0, 0xeb, 0x2e, 0xde, 0xff, 0xa0, 0xd7, 0xf4, 0xeb,
+	0x1d, 0x5d, 0x72, 0xad, 0x7c, 0xbf, 0xfd, 0xfa, 0xef, 0xdd, 0xd6, 0x68, 0xf5, 0xcc, 0x2d, 0xfb,
+	0x5d, 0xb4, 0x96, 0x92, 0x09, 0xce, 0xc8, 0x34, 0x91, 0x24, 0xc2, 0x39, 0x7f, 0xc5, 0x82, 0x5b,
+	0xa1, 0xd7, 0x6d, 0x8f, 0x56, 0x52, 0x32, 0x19, 0xda, 0xf2, 0x0b, 0xfe, 0x8a, 0xf9, 0x5f, 0xa1,
+	0xbb, 0xe3, 0x9c, 0xe2, 0x94, 0x2b, 0x25, 0x15, 0x1e, 0x17, 0xf4, 0x94, 0x69, 0xac, 0x58, 0x42,
+	0xa6, 0x4c, 0xe1, 0x63, 0xc6, 0x82, 0xb9, 0xd0, 0xeb, 0x2e, 0x8c, 0xb6, 0xc6, 0x39, 0x7d, 0x06,
+	0x9c, 0x7d, 0xa0, 0x8c, 0x2c, 0xe3, 0x6b, 0xc6, 0xfc, 0x27, 0xe8, 0xfd, 0xa6, 0x03, 0xa1, 0xa7,
+	0x8e, 0x4b, 0x1b, 0x5c, 0xee, 0xd4, 0x5c, 0x1e, 0xd3, 0xd3, 0x8a, 0x91, 0x1b, 0x45, 0x8e, 0x7f,
+	0x61, 0xd4, 0x8d, 0xf2, 0x56, 0x2d, 0xca, 0xb7, 0x40, 0xb9, 0x31, 0x4a, 0xe9, 0x50, 0x8f, 0x32,
+	0x5f, 0x8b, 0x62, 0x5d, 0xdc, 0x28, 0x8f, 0xd0, 0x9d, 0x8a, 0x51, 0xac, 0x64, 0x91, 0x39, 0x1e,
+	0x6f, 0x83, 0x47, 0x30, 0xf3, 0x78, 0x62, 0x18, 0x15, 0xfd, 0x21, 0x0a, 0x1b, 0xfa, 0x7a, 0x8e,
+	0x77, 0xc0, 0x63, 0xc7, 0xf5, 0x70, 0x63, 0x7c, 0x8a, 0x36, 0xcd, 0x67, 0xb4, 0x77, 0x9a, 0xe3,
+	0x8c, 0x29, 0x4c, 0x28, 0x95, 0x85, 0xd0, 0xc1, 0x42, 0xe8, 0x75, 0x97, 0x47, 0xeb, 0x29, 0x99,
+	0xd8, 0xab, 0xcc, 0x87, 0x4c, 0x3d, 0xb6, 0x98, 0xff, 0x08, 0xed, 0x44, 0x3c, 0xa7, 0x52, 0x68,
+	0x2e, 0x0a, 0x86, 0xa1, 0xc8, 0x45, 0x8c, 0x5f, 0x72, 0x11, 0xc9, 0x97, 0x01, 0x82, 0x46, 0xd8,
+	0xaa, 0x50, 0x06, 0x25, 0xe3, 0x47, 0x20, 0xf8, 0x9f, 0xa0, 0xdb, 0x55, 0x7d, 0x79, 0x8f, 0x29,
+	0x99, 0x04, 0x8b, 0x20, 0x5d, 0xaf, 0xa0, 0xf6, 0xf6, 0x9e, 0x91, 0x49, 0x5d, 0x55, 0x36, 0x82,
+	0x51, 0x2d, 0x35, 0x54, 0x36, 0xb3, 0x51, 0x3d, 0x44, 0xdb, 0x6e, 0x56, 0x71, 0xcc, 0x55, 0x6a,
+	0x5e, 0x95, 0xcb, 0x28, 0x58, 0x0e, 0xbd, 0xee, 0xdc, 0x28, 0x70, 0xa2, 0x02, 0x61, 0x08, 0xb8,
+	0xff, 0x19, 0xaa, 0x62, 0x38, 0x62, 0x09, 0xd3, 0x5c, 0x0a, 0x38, 0x75, 0x05, 0x4e, 0xad, 0x66,
+	0x3a, 0x28, 0x61, 0x73, 0xee, 0x03, 0x14, 0xe4, 0x9a, 0x24, 0x0c, 0x67, 0x32, 0xe1, 0x74, 0x8a,
+	0x69, 0xc2, 0x88, 0x28, 0x32, 0x50, 0xae, 0x82, 0x72, 0x03, 0xf0, 0x21, 0xc0, 0x03, 0x8b, 0x1a,
+	0xe1, 0xe7, 0x68, 0x2b, 0xe5, 0x02, 0xff, 0x5a, 0x48, 0x4d, 0x70, 0x91, 0x45, 0x44, 0x33, 0xcc,
+	0x85, 0x66, 0xea, 0x8c, 0x24, 0xc1, 0x9a, 0x3d, 0x33, 0xe5, 0xe2, 0x3b, 0x83, 0xff, 0x00, 0xf0,
+	0xd3, 0x12, 0xf5, 0x87, 0xe8, 0x9e, 0xf9, 0x9c, 0x89, 0xa4, 0x24, 0xc1, 0x67, 0x5c, 0xe9, 0x82,
+	0x24, 0x65, 0x73, 0x88, 0x02, 0xde, 0xb9, 0xbc, 0xb5, 0xe0, 0x5d, 0xf8, 0xba, 0x61, 0x4a, 0x26,
+	0xdf, 0x18, 0xf2, 0x91, 0xe5, 0x42, 0x87, 0x3c, 0x2f, 0xcc, 0xcb, 0xdb, 0x0b, 0x34, 0x7d, 0x2a,
+	0xb3, 0xff, 0x19, 0x5e, 0xdf, 0xf6, 0xa9, 0xcc, 0x6e, 0x98, 0xdd, 0x43, 0x14, 0x36, 0xf4, 0xf5,
+	0x3e, 0x7d, 0xcf, 0xf6, 0xa9, 0xeb, 0xd1, 0x18, 0x97, 0x2b, 0x9b, 0x6b, 0x06, 0x77, 0xdd, 0x8d,
+	0xd1, 0x98, 0x5b, 0x27, 0xc6, 0x0d, 0x63, 0xbb, 0xe1, 0xc6, 0xb8, 0x6e, 0x6a, 0x1f, 0xa2, 0x9d,
+	0x2b, 0x9b, 0xe6, 0xd0, 0xde, 0x06, 0x87, 0xcd, 0x4b, 0x87, 0xfa, 0xcc, 0x0e, 0xd0, 0x6e, 0x5d,
+	0x5d, 0xcf, 0xb0, 0x09, 0x0e, 0xdb, 0x8e, 0x83, 0x1b, 0xe1, 0x00, 0x2d, 0x32, 0x8a, 0x33, 0x25,
+	0x8f, 0x79, 0xc2, 0xf2, 0x20, 0x08, 0xe7, 0xba, 0x8b, 0xf7, 0xef, 0x5e, 0xf7, 0x27, 0x3f, 0x1c,
+	0x0c, 0x2d, 0xab, 0xfc, 0x87, 0x23, 0x46, 0xcb, 0x42, 0xfe, 0x45, 0xfb, 0xf7, 0x3f, 0x76, 0x5b,
+	0x7b, 0x3f, 0xa3, 0x85, 0x19, 0xc9, 0xff, 0x10, 0xad, 0x44, 0x44, 0x13, 0x4c, 0x4f, 0x0a, 0x71,
+	0x6a, 0xda, 0x05, 0xb6, 0xc4, 0xf2, 0x68, 0xc9, 0x54, 0x07, 0xa6, 0xf8, 0xbc, 0x48, 0xcd, 0x7f,
+	0x3f, 0x23, 0x8a, 0xeb, 0x69, 0x85, 0x77, 0x0b, 0x78, 0x2b, 0xb6, 0x7e, 0xc9, 0xdc, 0xfb, 0xc7,
+	0x43, 0xab, 0x47, 0xd7, 0x6f, 0x8d, 0x9c, 0xc5, 0x29, 0x13, 0xda, 0x6e, 0x0d, 0x6f, 0xb6, 0x35,
+	0x5e, 0xd8, 0x32, 0x6c, 0x8d, 0x07, 0x28, 0x50, 0x2c, 0x2a, 0x44, 0x44, 0x84, 0xc6, 0xb5, 0x5c,
+	0xf6, 0xbc, 0x8d, 0x19, 0x7e, 0x50, 0x0d, 0xf8, 0x25, 0xda, 0xbe, 0x12, 0x36, 0xa2, 0xce, 0x81,
+	0x74, 0x73, 0xc6, 0x18, 0x3a, 0x99, 0xfd, 0x7b, 0x68, 0xd5, 0x8c, 0x1e, 0x3d, 0x21, 0x2a, 0x66,
+	0x36, 0x5e, 0x1b, 0xe2, 0x2d, 0xa7, 0x5c, 0x0c, 0xa0, 0x6a, 0xd2, 0xd9, 0xeb, 0xdb, 0x7f, 0xfa,
+	0xfa, 0xbc, 0xe3, 0xbd, 0x39, 0xef, 0x78, 0xff, 0x9e, 0x77, 0xbc, 0xdf, 0x2e, 0x3a, 0xad, 0x37,
+	0x17, 0x9d, 0xd6, 0x5f, 0x17, 0x9d, 0xd6, 0x4f, 0xfd, 0x98, 0xeb, 0x93, 0x62, 0xdc, 0xa3, 0x32,
+	0xed, 0x8f, 0xc5, 0xf8, 0x23, 0x7a, 0x42, 0xb8, 0xe8, 0x57, 0xd6, 0xfb, 0x64, 0xb6, 0xe0, 0xf5,
+	0x34, 0x63, 0xf9, 0x78, 0x1e, 0xd6, 0xf6, 0xc7, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x52, 0x3b,
+	0xf7, 0x0a, 0x03, 0x08, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -420,6 +490,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EcProfiles) > 0 {
+		for iNdEx := len(m.EcProfiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EcProfiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
 	if len(m.OpMirrorGroupAckRelayerFee) > 0 {
 		i -= len(m.OpMirrorGroupAckRelayerFee)
 		copy(dAtA[i:], m.OpMirrorGroupAckRelayerFee)
@@ -583,6 +669,39 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ECProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ECProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ECProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ParityChunkNum != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ParityChunkNum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DataChunkNum != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DataChunkNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *VersionedParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -723,6 +842,27 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovParams(uint64(l))
 	}
+	if len(m.EcProfiles) > 0 {
+		for _, e := range m.EcProfiles {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ECProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataChunkNum != 0 {
+		n += 1 + sovParams(uint64(m.DataChunkNum))
+	}
+	if m.ParityChunkNum != 0 {
+		n += 1 + sovParams(uint64(m.ParityChunkNum))
+	}
 	return n
 }
 
@@ -1389,6 +1529,128 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.OpMirrorGroupAckRelayerFee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcProfiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EcProfiles = append(m.EcProfiles, ECProfile{})
+			if err := m.EcProfiles[len(m.EcProfiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ECProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ECProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ECProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataChunkNum", wireType)
+			}
+			m.DataChunkNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataChunkNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParityChunkNum", wireType)
+			}
+			m.ParityChunkNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParityChunkNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
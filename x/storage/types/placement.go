@@ -0,0 +1,55 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+// MaxPlacementConstraintCodes is the max number of region or jurisdiction codes a placement constraint can allow
+const MaxPlacementConstraintCodes = 20
+
+// ValidateBasic checks the allowed codes of the placement constraint
+func (c *PlacementConstraint) ValidateBasic() error {
+	if len(c.AllowedRegions) > MaxPlacementConstraintCodes || len(c.AllowedJurisdictions) > MaxPlacementConstraintCodes {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "too many codes in placement constraint, max: %d", MaxPlacementConstraintCodes)
+	}
+	for _, codes := range [][]string{c.AllowedRegions, c.AllowedJurisdictions} {
+		for _, code := range codes {
+			if code == "" {
+				return errors.Wrap(sdkerrors.ErrInvalidRequest, "empty code in placement constraint")
+			}
+			if err := sptypes.ValidateLocationCode(code); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// IsEmpty returns whether the placement constraint restricts nothing
+func (c *PlacementConstraint) IsEmpty() bool {
+	return c == nil || (len(c.AllowedRegions) == 0 && len(c.AllowedJurisdictions) == 0)
+}
+
+// IsSatisfiedBy returns whether a storage provider at the location is allowed by the placement constraint,
+// a storage provider which does not declare its location never satisfies a restricted attribute.
+func (c *PlacementConstraint) IsSatisfiedBy(location sptypes.SpLocation) bool {
+	if c.IsEmpty() {
+		return true
+	}
+	return containsCode(c.AllowedRegions, location.Region) && containsCode(c.AllowedJurisdictions, location.Jurisdiction)
+}
+
+func containsCode(allowed []string, code string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, c := range allowed {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
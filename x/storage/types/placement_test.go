@@ -0,0 +1,49 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+func TestPlacementConstraint_IsSatisfiedBy(t *testing.T) {
+	euDE := sptypes.SpLocation{Region: "eu-west", Jurisdiction: "DE"}
+	usUS := sptypes.SpLocation{Region: "us-east", Jurisdiction: "US"}
+	undeclared := sptypes.SpLocation{}
+
+	tests := []struct {
+		name       string
+		constraint *PlacementConstraint
+		location   sptypes.SpLocation
+		satisfied  bool
+	}{
+		{"nil constraint", nil, undeclared, true},
+		{"empty constraint", &PlacementConstraint{}, usUS, true},
+		{"allowed region", &PlacementConstraint{AllowedRegions: []string{"eu-west", "eu-central"}}, euDE, true},
+		{"disallowed region", &PlacementConstraint{AllowedRegions: []string{"eu-west"}}, usUS, false},
+		{"undeclared location", &PlacementConstraint{AllowedJurisdictions: []string{"DE"}}, undeclared, false},
+		{"both allowed", &PlacementConstraint{AllowedRegions: []string{"eu-west"}, AllowedJurisdictions: []string{"DE"}}, euDE, true},
+		{"jurisdiction disallowed", &PlacementConstraint{AllowedRegions: []string{"eu-west"}, AllowedJurisdictions: []string{"FR"}}, euDE, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.satisfied, tt.constraint.IsSatisfiedBy(tt.location))
+		})
+	}
+}
+
+func TestPlacementConstraint_ValidateBasic(t *testing.T) {
+	require.NoError(t, (&PlacementConstraint{AllowedRegions: []string{"eu-west"}, AllowedJurisdictions: []string{"DE"}}).ValidateBasic())
+	require.Error(t, (&PlacementConstraint{AllowedRegions: []string{""}}).ValidateBasic())
+	require.Error(t, (&PlacementConstraint{AllowedJurisdictions: []string{"D E"}}).ValidateBasic())
+	require.Error(t, (&PlacementConstraint{AllowedRegions: []string{strings.Repeat("a", sptypes.MaxLocationCodeLength+1)}}).ValidateBasic())
+
+	tooMany := make([]string, MaxPlacementConstraintCodes+1)
+	for i := range tooMany {
+		tooMany[i] = "r"
+	}
+	require.Error(t, (&PlacementConstraint{AllowedRegions: tooMany}).ValidateBasic())
+}
@@ -845,6 +845,118 @@ func (m *QueryListObjectsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryListBucketsByGlobalVirtualGroupFamilyRequest struct {
+	Pagination                 *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	GlobalVirtualGroupFamilyId uint32             `protobuf:"varint,2,opt,name=global_virtual_group_family_id,json=globalVirtualGroupFamilyId,proto3" json:"global_virtual_group_family_id,omitempty"`
+}
+
+func (m *QueryListBucketsByGlobalVirtualGroupFamilyRequest) Reset() {
+	*m = QueryListBucketsByGlobalVirtualGroupFamilyRequest{}
+}
+func (m *QueryListBucketsByGlobalVirtualGroupFamilyRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryListBucketsByGlobalVirtualGroupFamilyRequest) ProtoMessage() {}
+func (*QueryListBucketsByGlobalVirtualGroupFamilyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{17}
+}
+func (m *QueryListBucketsByGlobalVirtualGroupFamilyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListBucketsByGlobalVirtualGroupFamilyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListBucketsByGlobalVirtualGroupFamilyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListBucketsByGlobalVirtualGroupFamilyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListBucketsByGlobalVirtualGroupFamilyRequest.Merge(m, src)
+}
+func (m *QueryListBucketsByGlobalVirtualGroupFamilyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListBucketsByGlobalVirtualGroupFamilyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListBucketsByGlobalVirtualGroupFamilyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListBucketsByGlobalVirtualGroupFamilyRequest proto.InternalMessageInfo
+
+func (m *QueryListBucketsByGlobalVirtualGroupFamilyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryListBucketsByGlobalVirtualGroupFamilyRequest) GetGlobalVirtualGroupFamilyId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupFamilyId
+	}
+	return 0
+}
+
+type QueryListObjectsByGlobalVirtualGroupRequest struct {
+	Pagination           *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	GlobalVirtualGroupId uint32             `protobuf:"varint,2,opt,name=global_virtual_group_id,json=globalVirtualGroupId,proto3" json:"global_virtual_group_id,omitempty"`
+}
+
+func (m *QueryListObjectsByGlobalVirtualGroupRequest) Reset() {
+	*m = QueryListObjectsByGlobalVirtualGroupRequest{}
+}
+func (m *QueryListObjectsByGlobalVirtualGroupRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryListObjectsByGlobalVirtualGroupRequest) ProtoMessage() {}
+func (*QueryListObjectsByGlobalVirtualGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{18}
+}
+func (m *QueryListObjectsByGlobalVirtualGroupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListObjectsByGlobalVirtualGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListObjectsByGlobalVirtualGroupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListObjectsByGlobalVirtualGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListObjectsByGlobalVirtualGroupRequest.Merge(m, src)
+}
+func (m *QueryListObjectsByGlobalVirtualGroupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListObjectsByGlobalVirtualGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListObjectsByGlobalVirtualGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListObjectsByGlobalVirtualGroupRequest proto.InternalMessageInfo
+
+func (m *QueryListObjectsByGlobalVirtualGroupRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryListObjectsByGlobalVirtualGroupRequest) GetGlobalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupId
+	}
+	return 0
+}
+
 type QueryNFTRequest struct {
 	TokenId string `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
 }
@@ -853,7 +965,7 @@ func (m *QueryNFTRequest) Reset()         { *m = QueryNFTRequest{} }
 func (m *QueryNFTRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNFTRequest) ProtoMessage()    {}
 func (*QueryNFTRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{17}
+	return fileDescriptor_b1b80b580af04cb0, []int{19}
 }
 func (m *QueryNFTRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -897,7 +1009,7 @@ func (m *QueryBucketNFTResponse) Reset()         { *m = QueryBucketNFTResponse{}
 func (m *QueryBucketNFTResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBucketNFTResponse) ProtoMessage()    {}
 func (*QueryBucketNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{18}
+	return fileDescriptor_b1b80b580af04cb0, []int{20}
 }
 func (m *QueryBucketNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -941,7 +1053,7 @@ func (m *QueryObjectNFTResponse) Reset()         { *m = QueryObjectNFTResponse{}
 func (m *QueryObjectNFTResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryObjectNFTResponse) ProtoMessage()    {}
 func (*QueryObjectNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{19}
+	return fileDescriptor_b1b80b580af04cb0, []int{21}
 }
 func (m *QueryObjectNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -985,7 +1097,7 @@ func (m *QueryGroupNFTResponse) Reset()         { *m = QueryGroupNFTResponse{} }
 func (m *QueryGroupNFTResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupNFTResponse) ProtoMessage()    {}
 func (*QueryGroupNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{20}
+	return fileDescriptor_b1b80b580af04cb0, []int{22}
 }
 func (m *QueryGroupNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1030,7 +1142,7 @@ func (m *QueryPolicyForAccountRequest) Reset()         { *m = QueryPolicyForAcco
 func (m *QueryPolicyForAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPolicyForAccountRequest) ProtoMessage()    {}
 func (*QueryPolicyForAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{21}
+	return fileDescriptor_b1b80b580af04cb0, []int{23}
 }
 func (m *QueryPolicyForAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1081,7 +1193,7 @@ func (m *QueryPolicyForAccountResponse) Reset()         { *m = QueryPolicyForAcc
 func (m *QueryPolicyForAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPolicyForAccountResponse) ProtoMessage()    {}
 func (*QueryPolicyForAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{22}
+	return fileDescriptor_b1b80b580af04cb0, []int{24}
 }
 func (m *QueryPolicyForAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1128,7 +1240,7 @@ func (m *QueryVerifyPermissionRequest) Reset()         { *m = QueryVerifyPermiss
 func (m *QueryVerifyPermissionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVerifyPermissionRequest) ProtoMessage()    {}
 func (*QueryVerifyPermissionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{23}
+	return fileDescriptor_b1b80b580af04cb0, []int{25}
 }
 func (m *QueryVerifyPermissionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1193,7 +1305,7 @@ func (m *QueryVerifyPermissionResponse) Reset()         { *m = QueryVerifyPermis
 func (m *QueryVerifyPermissionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVerifyPermissionResponse) ProtoMessage()    {}
 func (*QueryVerifyPermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{24}
+	return fileDescriptor_b1b80b580af04cb0, []int{26}
 }
 func (m *QueryVerifyPermissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1238,7 +1350,7 @@ func (m *QueryHeadGroupRequest) Reset()         { *m = QueryHeadGroupRequest{} }
 func (m *QueryHeadGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHeadGroupRequest) ProtoMessage()    {}
 func (*QueryHeadGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{25}
+	return fileDescriptor_b1b80b580af04cb0, []int{27}
 }
 func (m *QueryHeadGroupRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1289,7 +1401,7 @@ func (m *QueryHeadGroupResponse) Reset()         { *m = QueryHeadGroupResponse{}
 func (m *QueryHeadGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHeadGroupResponse) ProtoMessage()    {}
 func (*QueryHeadGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{26}
+	return fileDescriptor_b1b80b580af04cb0, []int{28}
 }
 func (m *QueryHeadGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1334,7 +1446,7 @@ func (m *QueryListGroupsRequest) Reset()         { *m = QueryListGroupsRequest{}
 func (m *QueryListGroupsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryListGroupsRequest) ProtoMessage()    {}
 func (*QueryListGroupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{27}
+	return fileDescriptor_b1b80b580af04cb0, []int{29}
 }
 func (m *QueryListGroupsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1386,7 +1498,7 @@ func (m *QueryListGroupsResponse) Reset()         { *m = QueryListGroupsResponse
 func (m *QueryListGroupsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryListGroupsResponse) ProtoMessage()    {}
 func (*QueryListGroupsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{28}
+	return fileDescriptor_b1b80b580af04cb0, []int{30}
 }
 func (m *QueryListGroupsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1439,7 +1551,7 @@ func (m *QueryHeadGroupMemberRequest) Reset()         { *m = QueryHeadGroupMembe
 func (m *QueryHeadGroupMemberRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHeadGroupMemberRequest) ProtoMessage()    {}
 func (*QueryHeadGroupMemberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{29}
+	return fileDescriptor_b1b80b580af04cb0, []int{31}
 }
 func (m *QueryHeadGroupMemberRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1497,7 +1609,7 @@ func (m *QueryHeadGroupMemberResponse) Reset()         { *m = QueryHeadGroupMemb
 func (m *QueryHeadGroupMemberResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHeadGroupMemberResponse) ProtoMessage()    {}
 func (*QueryHeadGroupMemberResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{30}
+	return fileDescriptor_b1b80b580af04cb0, []int{32}
 }
 func (m *QueryHeadGroupMemberResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1542,7 +1654,7 @@ func (m *QueryPolicyForGroupRequest) Reset()         { *m = QueryPolicyForGroupR
 func (m *QueryPolicyForGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPolicyForGroupRequest) ProtoMessage()    {}
 func (*QueryPolicyForGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{31}
+	return fileDescriptor_b1b80b580af04cb0, []int{33}
 }
 func (m *QueryPolicyForGroupRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1593,7 +1705,7 @@ func (m *QueryPolicyForGroupResponse) Reset()         { *m = QueryPolicyForGroup
 func (m *QueryPolicyForGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPolicyForGroupResponse) ProtoMessage()    {}
 func (*QueryPolicyForGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{32}
+	return fileDescriptor_b1b80b580af04cb0, []int{34}
 }
 func (m *QueryPolicyForGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1637,7 +1749,7 @@ func (m *QueryPolicyByIdRequest) Reset()         { *m = QueryPolicyByIdRequest{}
 func (m *QueryPolicyByIdRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPolicyByIdRequest) ProtoMessage()    {}
 func (*QueryPolicyByIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{33}
+	return fileDescriptor_b1b80b580af04cb0, []int{35}
 }
 func (m *QueryPolicyByIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1681,7 +1793,7 @@ func (m *QueryPolicyByIdResponse) Reset()         { *m = QueryPolicyByIdResponse
 func (m *QueryPolicyByIdResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPolicyByIdResponse) ProtoMessage()    {}
 func (*QueryPolicyByIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{34}
+	return fileDescriptor_b1b80b580af04cb0, []int{36}
 }
 func (m *QueryPolicyByIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1730,7 +1842,7 @@ func (m *QueryLockFeeRequest) Reset()         { *m = QueryLockFeeRequest{} }
 func (m *QueryLockFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLockFeeRequest) ProtoMessage()    {}
 func (*QueryLockFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{35}
+	return fileDescriptor_b1b80b580af04cb0, []int{37}
 }
 func (m *QueryLockFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1788,7 +1900,7 @@ func (m *QueryLockFeeResponse) Reset()         { *m = QueryLockFeeResponse{} }
 func (m *QueryLockFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLockFeeResponse) ProtoMessage()    {}
 func (*QueryLockFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{36}
+	return fileDescriptor_b1b80b580af04cb0, []int{38}
 }
 func (m *QueryLockFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1825,7 +1937,7 @@ func (m *QueryHeadBucketExtraRequest) Reset()         { *m = QueryHeadBucketExtr
 func (m *QueryHeadBucketExtraRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHeadBucketExtraRequest) ProtoMessage()    {}
 func (*QueryHeadBucketExtraRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{37}
+	return fileDescriptor_b1b80b580af04cb0, []int{39}
 }
 func (m *QueryHeadBucketExtraRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1869,7 +1981,7 @@ func (m *QueryHeadBucketExtraResponse) Reset()         { *m = QueryHeadBucketExt
 func (m *QueryHeadBucketExtraResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHeadBucketExtraResponse) ProtoMessage()    {}
 func (*QueryHeadBucketExtraResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{38}
+	return fileDescriptor_b1b80b580af04cb0, []int{40}
 }
 func (m *QueryHeadBucketExtraResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1913,7 +2025,7 @@ func (m *QueryIsPriceChangedRequest) Reset()         { *m = QueryIsPriceChangedR
 func (m *QueryIsPriceChangedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryIsPriceChangedRequest) ProtoMessage()    {}
 func (*QueryIsPriceChangedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{39}
+	return fileDescriptor_b1b80b580af04cb0, []int{41}
 }
 func (m *QueryIsPriceChangedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1965,7 +2077,7 @@ func (m *QueryIsPriceChangedResponse) Reset()         { *m = QueryIsPriceChanged
 func (m *QueryIsPriceChangedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryIsPriceChangedResponse) ProtoMessage()    {}
 func (*QueryIsPriceChangedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{40}
+	return fileDescriptor_b1b80b580af04cb0, []int{42}
 }
 func (m *QueryIsPriceChangedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2009,7 +2121,7 @@ func (m *QueryQuoteUpdateTimeRequest) Reset()         { *m = QueryQuoteUpdateTim
 func (m *QueryQuoteUpdateTimeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryQuoteUpdateTimeRequest) ProtoMessage()    {}
 func (*QueryQuoteUpdateTimeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{41}
+	return fileDescriptor_b1b80b580af04cb0, []int{43}
 }
 func (m *QueryQuoteUpdateTimeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2053,7 +2165,7 @@ func (m *QueryQuoteUpdateTimeResponse) Reset()         { *m = QueryQuoteUpdateTi
 func (m *QueryQuoteUpdateTimeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryQuoteUpdateTimeResponse) ProtoMessage()    {}
 func (*QueryQuoteUpdateTimeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{42}
+	return fileDescriptor_b1b80b580af04cb0, []int{44}
 }
 func (m *QueryQuoteUpdateTimeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2098,7 +2210,7 @@ func (m *QueryGroupMembersExistRequest) Reset()         { *m = QueryGroupMembers
 func (m *QueryGroupMembersExistRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupMembersExistRequest) ProtoMessage()    {}
 func (*QueryGroupMembersExistRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{43}
+	return fileDescriptor_b1b80b580af04cb0, []int{45}
 }
 func (m *QueryGroupMembersExistRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2149,7 +2261,7 @@ func (m *QueryGroupMembersExistResponse) Reset()         { *m = QueryGroupMember
 func (m *QueryGroupMembersExistResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupMembersExistResponse) ProtoMessage()    {}
 func (*QueryGroupMembersExistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{44}
+	return fileDescriptor_b1b80b580af04cb0, []int{46}
 }
 func (m *QueryGroupMembersExistResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2194,7 +2306,7 @@ func (m *QueryGroupsExistRequest) Reset()         { *m = QueryGroupsExistRequest
 func (m *QueryGroupsExistRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupsExistRequest) ProtoMessage()    {}
 func (*QueryGroupsExistRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{45}
+	return fileDescriptor_b1b80b580af04cb0, []int{47}
 }
 func (m *QueryGroupsExistRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2245,7 +2357,7 @@ func (m *QueryGroupsExistByIdRequest) Reset()         { *m = QueryGroupsExistByI
 func (m *QueryGroupsExistByIdRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupsExistByIdRequest) ProtoMessage()    {}
 func (*QueryGroupsExistByIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{46}
+	return fileDescriptor_b1b80b580af04cb0, []int{48}
 }
 func (m *QueryGroupsExistByIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2289,7 +2401,7 @@ func (m *QueryGroupsExistResponse) Reset()         { *m = QueryGroupsExistRespon
 func (m *QueryGroupsExistResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupsExistResponse) ProtoMessage()    {}
 func (*QueryGroupsExistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{47}
+	return fileDescriptor_b1b80b580af04cb0, []int{49}
 }
 func (m *QueryGroupsExistResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2339,7 +2451,7 @@ func (m *QueryPaymentAccountBucketFlowRateLimitRequest) String() string {
 }
 func (*QueryPaymentAccountBucketFlowRateLimitRequest) ProtoMessage() {}
 func (*QueryPaymentAccountBucketFlowRateLimitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{48}
+	return fileDescriptor_b1b80b580af04cb0, []int{50}
 }
 func (m *QueryPaymentAccountBucketFlowRateLimitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2402,7 +2514,7 @@ func (m *QueryPaymentAccountBucketFlowRateLimitResponse) String() string {
 }
 func (*QueryPaymentAccountBucketFlowRateLimitResponse) ProtoMessage() {}
 func (*QueryPaymentAccountBucketFlowRateLimitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{49}
+	return fileDescriptor_b1b80b580af04cb0, []int{51}
 }
 func (m *QueryPaymentAccountBucketFlowRateLimitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2438,1730 +2550,2080 @@ func (m *QueryPaymentAccountBucketFlowRateLimitResponse) GetIsSet() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "greenfield.storage.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "greenfield.storage.QueryParamsResponse")
-	proto.RegisterType((*QueryParamsByTimestampRequest)(nil), "greenfield.storage.QueryParamsByTimestampRequest")
-	proto.RegisterType((*QueryParamsByTimestampResponse)(nil), "greenfield.storage.QueryParamsByTimestampResponse")
-	proto.RegisterType((*QueryHeadBucketRequest)(nil), "greenfield.storage.QueryHeadBucketRequest")
-	proto.RegisterType((*QueryHeadBucketByIdRequest)(nil), "greenfield.storage.QueryHeadBucketByIdRequest")
-	proto.RegisterType((*QueryHeadBucketResponse)(nil), "greenfield.storage.QueryHeadBucketResponse")
-	proto.RegisterType((*QueryHeadObjectRequest)(nil), "greenfield.storage.QueryHeadObjectRequest")
-	proto.RegisterType((*QueryHeadObjectByIdRequest)(nil), "greenfield.storage.QueryHeadObjectByIdRequest")
-	proto.RegisterType((*QueryHeadShadowObjectRequest)(nil), "greenfield.storage.QueryHeadShadowObjectRequest")
-	proto.RegisterType((*QueryHeadObjectResponse)(nil), "greenfield.storage.QueryHeadObjectResponse")
-	proto.RegisterType((*QueryHeadShadowObjectResponse)(nil), "greenfield.storage.QueryHeadShadowObjectResponse")
-	proto.RegisterType((*QueryListBucketsRequest)(nil), "greenfield.storage.QueryListBucketsRequest")
-	proto.RegisterType((*QueryListBucketsResponse)(nil), "greenfield.storage.QueryListBucketsResponse")
-	proto.RegisterType((*QueryListObjectsRequest)(nil), "greenfield.storage.QueryListObjectsRequest")
-	proto.RegisterType((*QueryListObjectsByBucketIdRequest)(nil), "greenfield.storage.QueryListObjectsByBucketIdRequest")
-	proto.RegisterType((*QueryListObjectsResponse)(nil), "greenfield.storage.QueryListObjectsResponse")
-	proto.RegisterType((*QueryNFTRequest)(nil), "greenfield.storage.QueryNFTRequest")
-	proto.RegisterType((*QueryBucketNFTResponse)(nil), "greenfield.storage.QueryBucketNFTResponse")
-	proto.RegisterType((*QueryObjectNFTResponse)(nil), "greenfield.storage.QueryObjectNFTResponse")
-	proto.RegisterType((*QueryGroupNFTResponse)(nil), "greenfield.storage.QueryGroupNFTResponse")
-	proto.RegisterType((*QueryPolicyForAccountRequest)(nil), "greenfield.storage.QueryPolicyForAccountRequest")
-	proto.RegisterType((*QueryPolicyForAccountResponse)(nil), "greenfield.storage.QueryPolicyForAccountResponse")
-	proto.RegisterType((*QueryVerifyPermissionRequest)(nil), "greenfield.storage.QueryVerifyPermissionRequest")
-	proto.RegisterType((*QueryVerifyPermissionResponse)(nil), "greenfield.storage.QueryVerifyPermissionResponse")
-	proto.RegisterType((*QueryHeadGroupRequest)(nil), "greenfield.storage.QueryHeadGroupRequest")
-	proto.RegisterType((*QueryHeadGroupResponse)(nil), "greenfield.storage.QueryHeadGroupResponse")
-	proto.RegisterType((*QueryListGroupsRequest)(nil), "greenfield.storage.QueryListGroupsRequest")
-	proto.RegisterType((*QueryListGroupsResponse)(nil), "greenfield.storage.QueryListGroupsResponse")
-	proto.RegisterType((*QueryHeadGroupMemberRequest)(nil), "greenfield.storage.QueryHeadGroupMemberRequest")
-	proto.RegisterType((*QueryHeadGroupMemberResponse)(nil), "greenfield.storage.QueryHeadGroupMemberResponse")
-	proto.RegisterType((*QueryPolicyForGroupRequest)(nil), "greenfield.storage.QueryPolicyForGroupRequest")
-	proto.RegisterType((*QueryPolicyForGroupResponse)(nil), "greenfield.storage.QueryPolicyForGroupResponse")
-	proto.RegisterType((*QueryPolicyByIdRequest)(nil), "greenfield.storage.QueryPolicyByIdRequest")
-	proto.RegisterType((*QueryPolicyByIdResponse)(nil), "greenfield.storage.QueryPolicyByIdResponse")
-	proto.RegisterType((*QueryLockFeeRequest)(nil), "greenfield.storage.QueryLockFeeRequest")
-	proto.RegisterType((*QueryLockFeeResponse)(nil), "greenfield.storage.QueryLockFeeResponse")
-	proto.RegisterType((*QueryHeadBucketExtraRequest)(nil), "greenfield.storage.QueryHeadBucketExtraRequest")
-	proto.RegisterType((*QueryHeadBucketExtraResponse)(nil), "greenfield.storage.QueryHeadBucketExtraResponse")
-	proto.RegisterType((*QueryIsPriceChangedRequest)(nil), "greenfield.storage.QueryIsPriceChangedRequest")
-	proto.RegisterType((*QueryIsPriceChangedResponse)(nil), "greenfield.storage.QueryIsPriceChangedResponse")
-	proto.RegisterType((*QueryQuoteUpdateTimeRequest)(nil), "greenfield.storage.QueryQuoteUpdateTimeRequest")
-	proto.RegisterType((*QueryQuoteUpdateTimeResponse)(nil), "greenfield.storage.QueryQuoteUpdateTimeResponse")
-	proto.RegisterType((*QueryGroupMembersExistRequest)(nil), "greenfield.storage.QueryGroupMembersExistRequest")
-	proto.RegisterType((*QueryGroupMembersExistResponse)(nil), "greenfield.storage.QueryGroupMembersExistResponse")
-	proto.RegisterMapType((map[string]bool)(nil), "greenfield.storage.QueryGroupMembersExistResponse.ExistsEntry")
-	proto.RegisterType((*QueryGroupsExistRequest)(nil), "greenfield.storage.QueryGroupsExistRequest")
-	proto.RegisterType((*QueryGroupsExistByIdRequest)(nil), "greenfield.storage.QueryGroupsExistByIdRequest")
-	proto.RegisterType((*QueryGroupsExistResponse)(nil), "greenfield.storage.QueryGroupsExistResponse")
-	proto.RegisterMapType((map[string]bool)(nil), "greenfield.storage.QueryGroupsExistResponse.ExistsEntry")
-	proto.RegisterType((*QueryPaymentAccountBucketFlowRateLimitRequest)(nil), "greenfield.storage.QueryPaymentAccountBucketFlowRateLimitRequest")
-	proto.RegisterType((*QueryPaymentAccountBucketFlowRateLimitResponse)(nil), "greenfield.storage.QueryPaymentAccountBucketFlowRateLimitResponse")
+type QueryEstimateCostRequest struct {
+	// primary_sp_address is the address of the primary sp.
+	PrimarySpAddress string `protobuf:"bytes,1,opt,name=primary_sp_address,json=primarySpAddress,proto3" json:"primary_sp_address,omitempty"`
+	// object_sizes are the payload sizes of the objects to be stored in the bucket
+	ObjectSizes []uint64 `protobuf:"varint,2,rep,packed,name=object_sizes,json=objectSizes,proto3" json:"object_sizes,omitempty"`
+	// charged_read_quota is the read quota of the bucket to be charged
+	ChargedReadQuota uint64 `protobuf:"varint,4,opt,name=charged_read_quota,json=chargedReadQuota,proto3" json:"charged_read_quota,omitempty"`
+	// timestamp is the block timestamp to pick the prices, the current block time is used if it is not set
+	Timestamp int64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// redundant_data_chunk_num and redundant_parity_chunk_num define the EC redundancy profile of the bucket,
+	// the default profile is used if both of them are zero
+	RedundantDataChunkNum   uint32 `protobuf:"varint,6,opt,name=redundant_data_chunk_num,json=redundantDataChunkNum,proto3" json:"redundant_data_chunk_num,omitempty"`
+	RedundantParityChunkNum uint32 `protobuf:"varint,7,opt,name=redundant_parity_chunk_num,json=redundantParityChunkNum,proto3" json:"redundant_parity_chunk_num,omitempty"`
+}
+
+func (m *QueryEstimateCostRequest) Reset()         { *m = QueryEstimateCostRequest{} }
+func (m *QueryEstimateCostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateCostRequest) ProtoMessage()    {}
+func (*QueryEstimateCostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{52}
+}
+func (m *QueryEstimateCostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateCostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateCostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateCostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateCostRequest.Merge(m, src)
+}
+func (m *QueryEstimateCostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateCostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateCostRequest.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("greenfield/storage/query.proto", fileDescriptor_b1b80b580af04cb0) }
+var xxx_messageInfo_QueryEstimateCostRequest proto.InternalMessageInfo
 
-var fileDescriptor_b1b80b580af04cb0 = []byte{
-	// 2854 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xdb, 0x6f, 0x1c, 0x49,
-	0xd5, 0x4f, 0xdb, 0x89, 0x63, 0x97, 0xbd, 0x89, 0xbf, 0x5a, 0xef, 0xae, 0x77, 0x92, 0x38, 0x9b,
-	0xde, 0xfd, 0xbc, 0xd9, 0x24, 0x9e, 0xce, 0x15, 0xc5, 0xb9, 0x21, 0x7b, 0x6d, 0x07, 0xa3, 0x5c,
-	0x9c, 0xb1, 0x31, 0x22, 0x02, 0x35, 0x35, 0xd3, 0x35, 0x93, 0x5e, 0xcf, 0x74, 0x4f, 0xba, 0x7b,
-	0xe2, 0xcc, 0x5a, 0x23, 0xc4, 0xbe, 0xc0, 0x23, 0x62, 0x85, 0x84, 0xc4, 0x45, 0x08, 0xc4, 0x55,
-	0x42, 0x08, 0x76, 0x85, 0xc4, 0x13, 0x0f, 0x80, 0xb4, 0x12, 0x42, 0x0a, 0xcb, 0x0b, 0xda, 0x87,
-	0x15, 0x24, 0xfc, 0x21, 0xa8, 0xab, 0x4e, 0x75, 0x57, 0x5f, 0xa6, 0xbb, 0x1d, 0x0f, 0x4f, 0x9e,
-	0xa9, 0xa9, 0x73, 0xce, 0xef, 0x5c, 0xea, 0xd4, 0xa9, 0x73, 0x8c, 0x66, 0x1a, 0x0e, 0xa5, 0x56,
-	0xdd, 0xa4, 0x4d, 0x43, 0x73, 0x3d, 0xdb, 0x21, 0x0d, 0xaa, 0x3d, 0xec, 0x50, 0xa7, 0x5b, 0x6e,
-	0x3b, 0xb6, 0x67, 0x63, 0x1c, 0xfe, 0x5e, 0x86, 0xdf, 0x4b, 0xa7, 0x6a, 0xb6, 0xdb, 0xb2, 0x5d,
-	0xad, 0x4a, 0x5c, 0xd8, 0xac, 0x3d, 0x3a, 0x57, 0xa5, 0x1e, 0x39, 0xa7, 0xb5, 0x49, 0xc3, 0xb4,
-	0x88, 0x67, 0xda, 0x16, 0xa7, 0x2f, 0xbd, 0xca, 0xf7, 0xea, 0xec, 0x9b, 0xc6, 0xbf, 0xc0, 0x4f,
-	0x53, 0x0d, 0xbb, 0x61, 0xf3, 0x75, 0xff, 0x13, 0xac, 0x1e, 0x6d, 0xd8, 0x76, 0xa3, 0x49, 0x35,
-	0xd2, 0x36, 0x35, 0x62, 0x59, 0xb6, 0xc7, 0xb8, 0x09, 0x1a, 0x55, 0x82, 0xdb, 0xa6, 0x4e, 0xcb,
-	0x74, 0x5d, 0xd3, 0xb6, 0xb4, 0x9a, 0xdd, 0x6a, 0x05, 0x22, 0x4f, 0xa4, 0xef, 0xf1, 0xba, 0x6d,
-	0x2a, 0xd8, 0x1c, 0x4f, 0xd1, 0xba, 0x4d, 0x1c, 0xd2, 0x12, 0x1b, 0xd2, 0xcc, 0x22, 0x33, 0x78,
-	0x5d, 0xfa, 0xfd, 0x91, 0xe9, 0x78, 0x1d, 0xd2, 0x6c, 0x38, 0x76, 0xa7, 0x2d, 0x6f, 0x52, 0xa7,
-	0x10, 0xbe, 0xe7, 0x5b, 0x67, 0x8d, 0x71, 0xae, 0xd0, 0x87, 0x1d, 0xea, 0x7a, 0xea, 0x5d, 0xf4,
-	0x62, 0x64, 0xd5, 0x6d, 0xdb, 0x96, 0x4b, 0xf1, 0x65, 0x34, 0xc2, 0x11, 0x4c, 0x2b, 0xaf, 0x29,
-	0x27, 0xc7, 0xcf, 0x97, 0xca, 0x49, 0xcb, 0x97, 0x39, 0xcd, 0xe2, 0xfe, 0x8f, 0x3e, 0x3d, 0xbe,
-	0xaf, 0x02, 0xfb, 0xd5, 0xeb, 0xe8, 0x98, 0xc4, 0x70, 0xb1, 0xbb, 0x61, 0xb6, 0xa8, 0xeb, 0x91,
-	0x56, 0x1b, 0x24, 0xe2, 0xa3, 0x68, 0xcc, 0x13, 0x6b, 0x8c, 0xfb, 0x70, 0x25, 0x5c, 0x50, 0xef,
-	0xa3, 0x99, 0x7e, 0xe4, 0x7b, 0x86, 0x36, 0x8f, 0x5e, 0x66, 0xbc, 0x3f, 0x47, 0x89, 0xb1, 0xd8,
-	0xa9, 0x6d, 0x51, 0x4f, 0x60, 0x3a, 0x8e, 0xc6, 0xab, 0x6c, 0x41, 0xb7, 0x48, 0x8b, 0x32, 0xc6,
-	0x63, 0x15, 0xc4, 0x97, 0xee, 0x90, 0x16, 0x55, 0xe7, 0x51, 0x29, 0x46, 0xba, 0xd8, 0x5d, 0x35,
-	0x04, 0xf9, 0x11, 0x34, 0x06, 0xe4, 0xa6, 0x01, 0xc4, 0xa3, 0x7c, 0x61, 0xd5, 0x50, 0x7f, 0xa8,
-	0xa0, 0x57, 0x12, 0x62, 0x41, 0x97, 0xcf, 0x06, 0x72, 0x4d, 0xab, 0x6e, 0x83, 0x42, 0x33, 0x69,
-	0x0a, 0x71, 0xc2, 0x55, 0xab, 0x6e, 0x0b, 0x5c, 0xfe, 0x67, 0xbc, 0x88, 0x10, 0x7d, 0xec, 0x39,
-	0x84, 0xd3, 0x0f, 0x31, 0xfa, 0xd7, 0xfb, 0xd3, 0x2f, 0xfb, 0x7b, 0x19, 0x93, 0x31, 0x2a, 0x3e,
-	0xaa, 0xf7, 0x25, 0xb3, 0xdc, 0xad, 0xbe, 0x43, 0x6b, 0x85, 0xcd, 0xe2, 0x6f, 0xb0, 0x19, 0x05,
-	0xdf, 0x30, 0xc4, 0x37, 0xf0, 0xa5, 0x84, 0xdd, 0x38, 0xef, 0x98, 0xdd, 0x80, 0x3c, 0xb4, 0x1b,
-	0x5f, 0x58, 0x35, 0xd4, 0xaf, 0xa2, 0xa3, 0x01, 0xe9, 0xfa, 0x03, 0x62, 0xd8, 0xdb, 0x83, 0x06,
-	0xf7, 0x07, 0xd9, 0x33, 0x82, 0x79, 0xe8, 0x19, 0x01, 0x2d, 0xc7, 0x33, 0x9c, 0x90, 0x7b, 0xc6,
-	0x0e, 0x3e, 0xe3, 0xaf, 0xa0, 0xa9, 0x46, 0xd3, 0xae, 0x92, 0xa6, 0x0e, 0x27, 0x52, 0x67, 0x47,
-	0x12, 0x7c, 0x74, 0x5a, 0xe6, 0x24, 0x1f, 0xd9, 0xf2, 0x4d, 0x46, 0xb4, 0xc9, 0x97, 0x6e, 0xfa,
-	0x4b, 0x15, 0xdc, 0x48, 0xac, 0xa9, 0x75, 0x38, 0x66, 0x49, 0xeb, 0x80, 0x02, 0xcb, 0x69, 0x0a,
-	0xbc, 0x91, 0xa6, 0x80, 0x4c, 0x1e, 0x57, 0x43, 0x25, 0x60, 0xa2, 0x5b, 0xa6, 0xeb, 0xf1, 0x18,
-	0x12, 0xa9, 0x03, 0xaf, 0x20, 0x14, 0x26, 0x58, 0x10, 0x30, 0x5b, 0x86, 0xa4, 0xea, 0x67, 0xe3,
-	0x32, 0x4f, 0xdd, 0x90, 0x8d, 0xcb, 0x6b, 0xa4, 0x41, 0x81, 0xb6, 0x22, 0x51, 0xaa, 0x3f, 0x53,
-	0xd0, 0x74, 0x52, 0x06, 0xa8, 0xb1, 0x80, 0x26, 0xa4, 0x13, 0xe2, 0x9f, 0xf9, 0xe1, 0x02, 0x47,
-	0x64, 0x3c, 0x3c, 0x22, 0x2e, 0xbe, 0x19, 0xc1, 0xc9, 0xed, 0xff, 0x66, 0x2e, 0x4e, 0x2e, 0x3f,
-	0x02, 0xf4, 0x3d, 0x45, 0x32, 0x06, 0xb7, 0xd7, 0xa0, 0x8d, 0x11, 0x8f, 0xea, 0xa1, 0x44, 0x26,
-	0xfa, 0xa6, 0x82, 0x4e, 0xc4, 0x41, 0x2c, 0x76, 0x41, 0x77, 0x63, 0xd0, 0x70, 0x22, 0x99, 0x6d,
-	0x28, 0x96, 0xd9, 0x22, 0x8e, 0x0b, 0xec, 0x11, 0x3a, 0x4e, 0x8a, 0xbf, 0x4c, 0xc7, 0x49, 0xa1,
-	0x37, 0x1e, 0x86, 0xde, 0x00, 0x1d, 0x77, 0x06, 0x1d, 0x66, 0x38, 0xef, 0xac, 0x6c, 0x08, 0x03,
-	0xbd, 0x8a, 0x46, 0x3d, 0x7b, 0x8b, 0x5a, 0x61, 0xe6, 0x39, 0xc8, 0xbe, 0xaf, 0x1a, 0xea, 0x97,
-	0x20, 0x1f, 0x72, 0x9b, 0x32, 0x9a, 0x20, 0x29, 0x8c, 0xb5, 0xa8, 0x47, 0x74, 0x83, 0x78, 0x04,
-	0x8c, 0xaa, 0xf6, 0x8f, 0xc4, 0xdb, 0xd4, 0x23, 0x4b, 0xc4, 0x23, 0x95, 0xd1, 0x16, 0x7c, 0x0a,
-	0x58, 0x73, 0x8d, 0x9f, 0x87, 0x35, 0xa7, 0x4c, 0x61, 0xfd, 0x45, 0xf4, 0x12, 0x63, 0xcd, 0xd2,
-	0x83, 0xcc, 0xf9, 0x46, 0x92, 0xf3, 0x89, 0x34, 0xce, 0x8c, 0x30, 0x85, 0xf1, 0xd7, 0x15, 0x48,
-	0xc4, 0x6b, 0x76, 0xd3, 0xac, 0x75, 0x57, 0x6c, 0x67, 0xa1, 0x56, 0xb3, 0x3b, 0x56, 0x90, 0x88,
-	0x4b, 0x68, 0xd4, 0xa1, 0xae, 0xdd, 0x71, 0x6a, 0x22, 0x0b, 0x07, 0xdf, 0xf1, 0x32, 0xfa, 0xbf,
-	0xb6, 0x63, 0x5a, 0x35, 0xb3, 0x4d, 0x9a, 0x3a, 0x31, 0x0c, 0x87, 0xba, 0x2e, 0x8f, 0xa3, 0xc5,
-	0xe9, 0x8f, 0x3f, 0x9c, 0x9b, 0x02, 0x67, 0x2e, 0xf0, 0x5f, 0xd6, 0x3d, 0xc7, 0xb4, 0x1a, 0x95,
-	0xc9, 0x80, 0x04, 0xd6, 0xd5, 0x4d, 0x51, 0x54, 0x24, 0x20, 0x80, 0x92, 0x97, 0xd0, 0x48, 0x9b,
-	0xfd, 0x06, 0x1a, 0x1e, 0x93, 0x35, 0x0c, 0xcb, 0xae, 0x32, 0x67, 0x50, 0x81, 0xcd, 0xea, 0x27,
-	0x42, 0xb7, 0x4d, 0xea, 0x98, 0xf5, 0xee, 0x5a, 0xb0, 0x51, 0xe8, 0x76, 0x11, 0x8d, 0xda, 0x6d,
-	0xea, 0x10, 0xcf, 0x76, 0xb8, 0x6e, 0x19, 0xb0, 0x83, 0x9d, 0xb9, 0x87, 0x38, 0x7e, 0x35, 0x0d,
-	0xc7, 0xaf, 0x26, 0xbc, 0x88, 0xc6, 0x49, 0xcd, 0x8f, 0x5d, 0xdd, 0x2f, 0xe1, 0xa6, 0xf7, 0xbf,
-	0xa6, 0x9c, 0x3c, 0x14, 0x75, 0x9b, 0xa4, 0xd4, 0x02, 0xdb, 0xb9, 0xd1, 0x6d, 0xd3, 0x0a, 0x22,
-	0xc1, 0xe7, 0xc0, 0x68, 0x49, 0xdd, 0x42, 0xa3, 0xd1, 0x7a, 0x9d, 0xd6, 0x3c, 0xa6, 0xda, 0xa1,
-	0xbe, 0x46, 0x5b, 0x66, 0x9b, 0x2a, 0xb0, 0x59, 0x7d, 0x08, 0x91, 0xe6, 0x5f, 0x3d, 0xfc, 0x82,
-	0x02, 0x63, 0xcd, 0xa3, 0x71, 0x76, 0x87, 0xe9, 0xf6, 0xb6, 0x45, 0xf3, 0xed, 0x85, 0xd8, 0xe6,
-	0xbb, 0xfe, 0x5e, 0x7c, 0x0c, 0xf1, 0x6f, 0xb2, 0xc1, 0xc6, 0xd8, 0x0a, 0x4b, 0x7a, 0x9b, 0x52,
-	0x89, 0x02, 0x22, 0x41, 0x87, 0x6b, 0x82, 0x50, 0xba, 0xe5, 0x8e, 0xf5, 0x0d, 0x6f, 0x5e, 0xfa,
-	0x34, 0xc4, 0x47, 0xf5, 0x7b, 0x0a, 0x30, 0xf6, 0x33, 0x18, 0xdb, 0x31, 0xf0, 0x84, 0x1e, 0x33,
-	0xca, 0x50, 0x71, 0xa3, 0xa8, 0x3f, 0x96, 0xef, 0x1b, 0x81, 0x0e, 0xf4, 0xbe, 0x99, 0x02, 0xef,
-	0x79, 0x72, 0x23, 0xbe, 0x21, 0xf0, 0xf1, 0x34, 0x3d, 0xc4, 0xd2, 0x74, 0x8e, 0x05, 0x51, 0x60,
-	0x41, 0x57, 0xfd, 0xa5, 0x82, 0x8e, 0x44, 0x7d, 0x73, 0x9b, 0xb6, 0xaa, 0xd4, 0x11, 0x76, 0x3c,
-	0x8b, 0x46, 0x5a, 0x6c, 0x21, 0x37, 0x1e, 0x60, 0xdf, 0x1e, 0x2c, 0x16, 0x0b, 0xa3, 0xe1, 0x78,
-	0x18, 0x51, 0xa9, 0xa4, 0x8c, 0x40, 0x0d, 0x6a, 0xa6, 0x09, 0x4e, 0x2e, 0x21, 0x8e, 0xe5, 0x61,
-	0xe9, 0x58, 0xc8, 0x1c, 0x38, 0x62, 0xfe, 0x45, 0xad, 0x43, 0xd1, 0x1b, 0x64, 0xab, 0xc8, 0x29,
-	0xc9, 0x4a, 0x97, 0x67, 0x10, 0x0e, 0xd3, 0x25, 0xb8, 0x45, 0xdc, 0xbb, 0x61, 0x56, 0xe4, 0x8e,
-	0x30, 0xd4, 0x0d, 0xb0, 0x7c, 0x5c, 0xce, 0xde, 0x72, 0xe2, 0x25, 0x38, 0x12, 0x7c, 0x39, 0x56,
-	0xae, 0xf3, 0x3d, 0x52, 0xb9, 0xce, 0x17, 0x56, 0x0d, 0x75, 0x0d, 0x62, 0x55, 0x26, 0xdb, 0x1b,
-	0x90, 0x1f, 0x28, 0xf0, 0x36, 0xbd, 0x65, 0xd7, 0xb6, 0x56, 0x28, 0x0d, 0x4f, 0xa6, 0x6f, 0xa4,
-	0x16, 0x71, 0xba, 0xba, 0xdb, 0x0e, 0x2e, 0x15, 0xa5, 0xc0, 0xa5, 0xe2, 0xd3, 0xac, 0xb7, 0x61,
-	0xdd, 0x57, 0xa7, 0xe6, 0x50, 0xe2, 0x51, 0x9d, 0x78, 0xcc, 0xc6, 0xc3, 0x95, 0x51, 0xbe, 0xb0,
-	0xe0, 0xe1, 0x13, 0x68, 0xa2, 0x4d, 0xba, 0x4d, 0x9b, 0x18, 0xba, 0x6b, 0xbe, 0xcb, 0x63, 0x69,
-	0x7f, 0x65, 0x1c, 0xd6, 0xd6, 0xcd, 0x77, 0xa9, 0xda, 0x44, 0x53, 0x51, 0x78, 0xa0, 0xee, 0x06,
-	0x1a, 0x21, 0x2d, 0xff, 0x76, 0x02, 0x4c, 0xd7, 0xfc, 0x47, 0xe8, 0x27, 0x9f, 0x1e, 0x9f, 0x6d,
-	0x98, 0xde, 0x83, 0x4e, 0xb5, 0x5c, 0xb3, 0x5b, 0xd0, 0x7a, 0x80, 0x3f, 0x73, 0xae, 0xb1, 0x05,
-	0x4f, 0xf5, 0x55, 0xcb, 0xfb, 0xf8, 0xc3, 0x39, 0x04, 0x1a, 0xac, 0x5a, 0x5e, 0x05, 0x78, 0xa9,
-	0x37, 0xa4, 0x63, 0x26, 0x3d, 0xe6, 0x0a, 0xbf, 0x60, 0xe5, 0xd8, 0x8f, 0xd0, 0x07, 0xb1, 0x2f,
-	0xbf, 0x24, 0x45, 0xbe, 0x4b, 0x49, 0x03, 0xab, 0x96, 0x47, 0x1d, 0x8b, 0x34, 0xa5, 0x72, 0x5b,
-	0x7a, 0x4c, 0x5e, 0x87, 0xd8, 0x5f, 0x75, 0xd7, 0x1c, 0xb3, 0x46, 0xdf, 0x7e, 0x40, 0xac, 0x06,
-	0x35, 0x0a, 0xa3, 0xfc, 0xf7, 0x41, 0x50, 0x33, 0x4e, 0x0f, 0x28, 0xa7, 0xd1, 0xc1, 0x1a, 0x5f,
-	0x62, 0xc4, 0xa3, 0x15, 0xf1, 0x15, 0xbf, 0x83, 0x70, 0xad, 0xe3, 0x38, 0xd4, 0xf2, 0x74, 0x87,
-	0x12, 0x43, 0x6f, 0xfb, 0xe4, 0x90, 0x3c, 0x76, 0xe3, 0x81, 0x25, 0x5a, 0x93, 0x3c, 0xb0, 0x44,
-	0x6b, 0x95, 0x49, 0xe0, 0x5b, 0xa1, 0xc4, 0x60, 0xa0, 0xf0, 0x0e, 0x3a, 0x22, 0x64, 0x05, 0x91,
-	0xe8, 0xd9, 0x0e, 0x05, 0xa1, 0xc3, 0x03, 0x10, 0x3a, 0x0d, 0x02, 0xd6, 0x20, 0x6a, 0x7d, 0xf6,
-	0x5c, 0xf8, 0xd7, 0xd0, 0x31, 0x21, 0xdc, 0xa5, 0x35, 0xdb, 0x32, 0xe2, 0xe2, 0xf7, 0x0f, 0x40,
-	0x7c, 0x09, 0x44, 0xac, 0x0b, 0x09, 0x12, 0x80, 0x2e, 0x12, 0xbf, 0xea, 0x8f, 0x48, 0xd3, 0x34,
-	0xfc, 0x92, 0x47, 0xf7, 0xc8, 0x63, 0xdd, 0x21, 0x1e, 0x9d, 0x3e, 0x30, 0x00, 0xe9, 0xaf, 0x00,
-	0xff, 0x4d, 0xc1, 0x7e, 0x83, 0x3c, 0xae, 0x10, 0x8f, 0xe2, 0x2a, 0x3a, 0x64, 0xd1, 0x6d, 0xd9,
-	0xc1, 0x23, 0x03, 0x10, 0x37, 0x61, 0xd1, 0xed, 0xd0, 0xb9, 0x2e, 0x7a, 0xc5, 0x97, 0x91, 0xe6,
-	0xd8, 0x83, 0x03, 0x10, 0x36, 0x65, 0xd1, 0xed, 0xa4, 0x53, 0xb7, 0xd1, 0xab, 0xbe, 0xd0, 0x74,
-	0x87, 0x8e, 0x0e, 0x40, 0xec, 0xcb, 0x16, 0xdd, 0x4e, 0x73, 0xe6, 0x43, 0xe4, 0xff, 0x92, 0xe6,
-	0xc8, 0xb1, 0x01, 0x48, 0x7d, 0xd1, 0xa2, 0xdb, 0x71, 0x27, 0x06, 0x99, 0xec, 0x5e, 0xc7, 0xf6,
-	0xe8, 0x17, 0xda, 0x06, 0xf1, 0xe8, 0x86, 0xd9, 0xa2, 0x85, 0x73, 0xc4, 0x55, 0xc8, 0x64, 0x09,
-	0x7a, 0xc8, 0x11, 0x47, 0xd0, 0x58, 0x87, 0xad, 0xfa, 0x79, 0x7d, 0x84, 0xe7, 0x75, 0xbe, 0xb0,
-	0xe0, 0xa9, 0x16, 0x14, 0xc5, 0xd2, 0xe5, 0xed, 0x2e, 0x3f, 0x36, 0x5d, 0x4f, 0x7a, 0x18, 0x06,
-	0x17, 0x2f, 0x3c, 0x0c, 0x79, 0xb5, 0x63, 0xe0, 0xf3, 0xe8, 0x20, 0x2f, 0x0c, 0x78, 0x99, 0x94,
-	0x75, 0xdb, 0x88, 0x8d, 0xea, 0x07, 0x0a, 0x34, 0x34, 0x53, 0x04, 0x02, 0xde, 0x4d, 0x34, 0x42,
-	0xfd, 0x05, 0xf1, 0x46, 0xbe, 0x91, 0x96, 0x75, 0xb3, 0x79, 0x94, 0xd9, 0x37, 0x77, 0xd9, 0xf2,
-	0x9c, 0x6e, 0x05, 0xb8, 0x95, 0xe6, 0xd1, 0xb8, 0xb4, 0x8c, 0x27, 0xd1, 0xf0, 0x16, 0xed, 0x82,
-	0x4e, 0xfe, 0x47, 0x3c, 0x85, 0x0e, 0x3c, 0x22, 0xcd, 0x0e, 0xcf, 0x92, 0xa3, 0x15, 0xfe, 0xe5,
-	0xca, 0xd0, 0x65, 0x45, 0xed, 0xc0, 0x65, 0xce, 0x8b, 0xce, 0x88, 0x7d, 0xf6, 0x50, 0xe4, 0x1f,
-	0x17, 0xa4, 0xbe, 0x63, 0xc1, 0x86, 0xb0, 0xc1, 0x77, 0xac, 0xab, 0x5e, 0x81, 0xc8, 0x90, 0xc4,
-	0xc6, 0xea, 0x0f, 0xe1, 0x1a, 0x6e, 0xab, 0xb1, 0xca, 0x28, 0xf8, 0xc6, 0x55, 0x7f, 0x2e, 0x9a,
-	0x11, 0x11, 0xcc, 0x60, 0xe2, 0xb5, 0x98, 0x89, 0x2f, 0x67, 0x9b, 0xf8, 0x7f, 0x6b, 0xdc, 0x27,
-	0x0a, 0x9a, 0x83, 0x1e, 0x77, 0xb7, 0x45, 0x2d, 0x0f, 0xde, 0xb2, 0xfc, 0x3e, 0x5d, 0x69, 0xda,
-	0xdb, 0xfe, 0x29, 0xb9, 0x65, 0xb6, 0xcc, 0xc0, 0xe6, 0x0b, 0xe8, 0x70, 0x9b, 0xef, 0xd5, 0x09,
-	0xdf, 0x9c, 0x6b, 0xf7, 0x43, 0xed, 0x08, 0x73, 0x7c, 0x35, 0xe8, 0xa3, 0x15, 0xab, 0xaa, 0xe1,
-	0x0c, 0x06, 0x8e, 0x93, 0x8f, 0xe4, 0x70, 0xe2, 0x48, 0xfe, 0x5a, 0x41, 0xe5, 0xa2, 0x2a, 0x81,
-	0x4b, 0x5e, 0x42, 0x23, 0xa6, 0xab, 0xbb, 0xd4, 0x83, 0x8b, 0xfc, 0x80, 0xe9, 0xae, 0x53, 0x0f,
-	0x1b, 0xe8, 0x70, 0xbd, 0x69, 0x6f, 0xb3, 0x14, 0xa4, 0x37, 0x7d, 0x8a, 0xe7, 0xb8, 0xc3, 0x93,
-	0x55, 0xd4, 0x0b, 0x75, 0x19, 0xc4, 0xf9, 0xbf, 0xcf, 0xa2, 0x03, 0x0c, 0x2f, 0xee, 0xa1, 0x11,
-	0x3e, 0x2b, 0xc0, 0xb3, 0x7d, 0x63, 0x22, 0x32, 0x31, 0x29, 0xbd, 0x99, 0xbb, 0x8f, 0x6b, 0xa8,
-	0xaa, 0xef, 0xfd, 0xe3, 0x3f, 0xef, 0x0f, 0x1d, 0xc5, 0x25, 0xad, 0xef, 0x7c, 0x07, 0xff, 0x46,
-	0x3c, 0x40, 0x13, 0xf3, 0x0e, 0x7c, 0x2e, 0x47, 0x4e, 0x72, 0xb4, 0x52, 0x3a, 0xbf, 0x1b, 0x12,
-	0x40, 0x59, 0x66, 0x28, 0x4f, 0xe2, 0xd9, 0xfe, 0x28, 0xb5, 0x9d, 0x60, 0x3e, 0xd3, 0xc3, 0xdf,
-	0x57, 0x10, 0x0a, 0x6b, 0x48, 0x7c, 0xaa, 0xaf, 0xc8, 0xc4, 0x94, 0xa5, 0x74, 0xba, 0xd0, 0x5e,
-	0xc0, 0x75, 0x89, 0xe1, 0xd2, 0xf0, 0x5c, 0x1a, 0xae, 0x07, 0x7e, 0x01, 0xc0, 0xe3, 0x4f, 0xdb,
-	0x91, 0x42, 0xb3, 0x87, 0x7f, 0xa1, 0xa0, 0x43, 0xd1, 0x21, 0x0d, 0x2e, 0x17, 0x10, 0x2b, 0xa5,
-	0x99, 0xdd, 0xc1, 0x9c, 0x67, 0x30, 0x2f, 0xe0, 0x73, 0x39, 0x30, 0xf5, 0xaa, 0xff, 0x6a, 0x0a,
-	0xc0, 0x9a, 0x46, 0x0f, 0x7f, 0x57, 0x41, 0x2f, 0x84, 0x1c, 0xef, 0xac, 0x6c, 0xe0, 0xd7, 0xfb,
-	0x4a, 0x0e, 0x3b, 0x97, 0xa5, 0xfe, 0x16, 0x4f, 0x34, 0x2c, 0xd5, 0xcf, 0x30, 0x74, 0x67, 0x71,
-	0x39, 0x0f, 0x9d, 0x55, 0xf7, 0xb4, 0x1d, 0xd1, 0x10, 0xed, 0xe1, 0x5f, 0x81, 0x93, 0x79, 0xb7,
-	0x31, 0xc7, 0xc9, 0x91, 0xb1, 0x4c, 0x8e, 0xf5, 0xa2, 0x43, 0x0a, 0xf5, 0x6d, 0x86, 0xef, 0x3a,
-	0xbe, 0xda, 0x17, 0x1f, 0xef, 0x89, 0x45, 0x9d, 0xac, 0xed, 0x48, 0xcd, 0xb3, 0xd0, 0xe5, 0xe1,
-	0x7c, 0x29, 0xc7, 0xe5, 0x89, 0x41, 0xd4, 0xee, 0x40, 0xe7, 0xbb, 0x1c, 0xe0, 0x81, 0xcb, 0x83,
-	0x11, 0x57, 0x0f, 0xff, 0x49, 0x41, 0x93, 0xf1, 0x89, 0x0d, 0x3e, 0x9b, 0x29, 0x3c, 0x65, 0xf4,
-	0x55, 0x3a, 0xb7, 0x0b, 0x0a, 0x00, 0xfd, 0x79, 0x06, 0x7a, 0x09, 0x2f, 0xf6, 0x05, 0xed, 0x32,
-	0xb2, 0x22, 0x06, 0x17, 0x81, 0x1b, 0x74, 0xb1, 0xf7, 0x1a, 0xb8, 0x89, 0x76, 0x78, 0x81, 0xc0,
-	0x15, 0x88, 0xa2, 0x81, 0xfb, 0x6d, 0x05, 0x8d, 0x4b, 0x63, 0x24, 0xdc, 0xdf, 0xb1, 0xc9, 0x81,
-	0x56, 0xe9, 0x4c, 0xb1, 0xcd, 0x00, 0xf1, 0x24, 0x83, 0xa8, 0xe2, 0xd7, 0xd2, 0x20, 0x36, 0x4d,
-	0xd7, 0x83, 0xb3, 0xe5, 0xe2, 0x1f, 0x01, 0x28, 0x18, 0x91, 0xe4, 0x80, 0x8a, 0x0e, 0x96, 0x72,
-	0x40, 0xc5, 0xa6, 0x2e, 0xd9, 0x76, 0x63, 0xa0, 0xb8, 0xdd, 0xdc, 0x58, 0xda, 0xfc, 0xa3, 0x82,
-	0x5e, 0x4a, 0x1d, 0x28, 0xe1, 0x4b, 0x45, 0xe4, 0x27, 0x06, 0x50, 0xbb, 0x84, 0xbd, 0xc0, 0x60,
-	0x5f, 0xc5, 0xf3, 0x79, 0xb0, 0xfd, 0x33, 0x15, 0xa4, 0xd0, 0x48, 0x36, 0xfd, 0x8e, 0x82, 0x26,
-	0x82, 0xbe, 0x5e, 0xe1, 0x98, 0x7c, 0x2b, 0xbb, 0x10, 0x94, 0x43, 0x32, 0xff, 0x42, 0x82, 0xe2,
-	0x36, 0x1a, 0x91, 0x7f, 0x55, 0xa0, 0x5d, 0x1e, 0x9f, 0x5d, 0x64, 0x9c, 0xfb, 0x3e, 0x93, 0x96,
-	0x8c, 0x73, 0xdf, 0x6f, 0x30, 0xa2, 0xde, 0x66, 0xa8, 0x6f, 0xe2, 0xe5, 0xd4, 0xeb, 0x9d, 0x77,
-	0xf3, 0xea, 0xb6, 0x23, 0xea, 0x4a, 0x6d, 0x47, 0xf4, 0x22, 0x7b, 0xda, 0x4e, 0x62, 0x72, 0xd3,
-	0xc3, 0x7f, 0x53, 0xd0, 0x64, 0x7c, 0x9e, 0x90, 0xa1, 0x48, 0x9f, 0xb1, 0x4a, 0x86, 0x22, 0xfd,
-	0x86, 0x15, 0xea, 0x06, 0x53, 0xe4, 0x0e, 0xbe, 0x95, 0xa6, 0xc8, 0x23, 0x46, 0xa5, 0x4b, 0xff,
-	0x5f, 0xb3, 0x23, 0x86, 0x31, 0xbd, 0x78, 0x2a, 0x93, 0xe6, 0x2a, 0x3d, 0xfc, 0x53, 0x05, 0x8d,
-	0x05, 0x51, 0x83, 0xdf, 0xca, 0xcc, 0xab, 0x72, 0x17, 0xb7, 0x74, 0xaa, 0xc8, 0xd6, 0x22, 0xd1,
-	0x1d, 0x46, 0x8e, 0xb6, 0x23, 0x3d, 0xac, 0x7a, 0xe2, 0x1b, 0x3f, 0x9f, 0x7e, 0xd5, 0x15, 0x4e,
-	0x01, 0x32, 0x2e, 0xe4, 0xc4, 0x20, 0xa3, 0x74, 0xba, 0xd0, 0xde, 0x22, 0x41, 0xce, 0x0e, 0x22,
-	0x43, 0xe5, 0x46, 0xb1, 0xe2, 0x9f, 0x28, 0xe8, 0x70, 0xac, 0xa9, 0x8e, 0xb5, 0x7c, 0x0b, 0x45,
-	0x26, 0x05, 0xa5, 0xb3, 0xc5, 0x09, 0x00, 0xed, 0x1c, 0x43, 0xfb, 0x26, 0xfe, 0xff, 0x9c, 0x23,
-	0x09, 0x83, 0x85, 0x3f, 0x8b, 0x86, 0x72, 0xb4, 0x61, 0x9e, 0x51, 0x2d, 0xa4, 0x76, 0xf0, 0x4b,
-	0x5a, 0xe1, 0xfd, 0x80, 0xf3, 0x16, 0xc3, 0xb9, 0x82, 0x97, 0x72, 0x0e, 0x21, 0x84, 0x41, 0xea,
-	0x11, 0x14, 0x2f, 0xdf, 0x9e, 0x7f, 0x9d, 0x1c, 0x8e, 0xb5, 0xda, 0x33, 0x02, 0x22, 0xd1, 0xc6,
-	0xcf, 0x08, 0x88, 0x64, 0xef, 0x5e, 0xbd, 0xc8, 0xa0, 0x97, 0xf1, 0x99, 0x0c, 0xe8, 0x50, 0xe7,
-	0x04, 0xb3, 0x81, 0x1e, 0xfe, 0x86, 0x82, 0x26, 0xe4, 0xde, 0x38, 0xee, 0xff, 0x68, 0x8a, 0x36,
-	0xf7, 0x4b, 0x27, 0xf3, 0x37, 0x02, 0xb2, 0x37, 0x18, 0xb2, 0x19, 0x7c, 0x34, 0x35, 0x54, 0xed,
-	0xda, 0x96, 0x5e, 0xa7, 0x14, 0xff, 0x16, 0x22, 0x53, 0x6a, 0x79, 0xe7, 0x44, 0x66, 0xb2, 0xb9,
-	0x9e, 0x13, 0x99, 0x29, 0xdd, 0x74, 0xf5, 0x2a, 0x03, 0x77, 0x09, 0x5f, 0xc8, 0x2b, 0xbc, 0x59,
-	0xe7, 0x3c, 0x76, 0x19, 0xff, 0x4e, 0xc4, 0x69, 0xb4, 0x09, 0x9e, 0x11, 0xa7, 0xa9, 0xdd, 0xf6,
-	0x8c, 0x38, 0x4d, 0xef, 0xae, 0xab, 0x57, 0x18, 0xea, 0x8b, 0xf8, 0x7c, 0x1a, 0x6a, 0xd3, 0xe5,
-	0xed, 0x48, 0x1d, 0x3a, 0xee, 0x31, 0xd0, 0xbf, 0x57, 0x60, 0x1c, 0x72, 0xaf, 0x63, 0x7b, 0x24,
-	0x6c, 0xcb, 0x65, 0x58, 0x3b, 0xbd, 0x01, 0x98, 0x61, 0xed, 0x3e, 0x1d, 0xbf, 0x6c, 0x6b, 0x3f,
-	0xf4, 0xf1, 0xe8, 0xd0, 0x11, 0xf4, 0x1f, 0xb2, 0x31, 0xe0, 0x7f, 0x11, 0x4f, 0xf0, 0x44, 0x77,
-	0x2d, 0xe3, 0x09, 0xde, 0xaf, 0x7d, 0x98, 0xf1, 0x04, 0xef, 0xdb, 0xbc, 0x53, 0x97, 0x18, 0xfc,
-	0x1b, 0xf8, 0x5a, 0x1a, 0x7c, 0x39, 0x83, 0xb9, 0x3a, 0xeb, 0x3e, 0x89, 0xe4, 0x6b, 0x1a, 0x3d,
-	0x6d, 0x07, 0x7e, 0xe9, 0xe1, 0x0f, 0x14, 0x34, 0x19, 0x6f, 0x61, 0x65, 0x94, 0x9a, 0xc9, 0xd6,
-	0x5e, 0x46, 0xcd, 0x96, 0xd2, 0x15, 0x2b, 0x80, 0x3a, 0x06, 0x37, 0x79, 0xaf, 0xb9, 0x3d, 0xff,
-	0x7c, 0x4e, 0xa5, 0xf5, 0xfc, 0x32, 0xc2, 0x26, 0xbd, 0x3b, 0xb8, 0x4b, 0xf4, 0x99, 0xa1, 0x2e,
-	0xa3, 0x17, 0xd9, 0x2d, 0xe8, 0x3c, 0xf6, 0xf0, 0xfb, 0x43, 0x68, 0xb6, 0x58, 0xb7, 0x0b, 0x2f,
-	0x64, 0x74, 0x64, 0x8a, 0x35, 0xff, 0x4a, 0x8b, 0x7b, 0x61, 0x01, 0xda, 0x56, 0x99, 0xb6, 0x5f,
-	0xc6, 0xf7, 0xd3, 0x9b, 0x3c, 0x91, 0xd6, 0xa2, 0xc8, 0x4c, 0xb1, 0x36, 0x9c, 0xb6, 0x13, 0xdb,
-	0x17, 0x2b, 0xac, 0x16, 0x57, 0x3f, 0x7a, 0x3a, 0xa3, 0x3c, 0x79, 0x3a, 0xa3, 0xfc, 0xeb, 0xe9,
-	0x8c, 0xf2, 0xad, 0x67, 0x33, 0xfb, 0x9e, 0x3c, 0x9b, 0xd9, 0xf7, 0xcf, 0x67, 0x33, 0xfb, 0xee,
-	0x6b, 0x52, 0xcb, 0xae, 0x6a, 0x55, 0xe7, 0x6a, 0x0f, 0x88, 0x69, 0xc9, 0x48, 0x1e, 0x47, 0xff,
-	0xab, 0xb9, 0x3a, 0xc2, 0xfe, 0x63, 0xf9, 0xc2, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x4c, 0x93,
-	0xb9, 0xe9, 0x0f, 0x2e, 0x00, 0x00,
+func (m *QueryEstimateCostRequest) GetPrimarySpAddress() string {
+	if m != nil {
+		return m.PrimarySpAddress
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryEstimateCostRequest) GetObjectSizes() []uint64 {
+	if m != nil {
+		return m.ObjectSizes
+	}
+	return nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryEstimateCostRequest) GetChargedReadQuota() uint64 {
+	if m != nil {
+		return m.ChargedReadQuota
+	}
+	return 0
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Parameters queries the parameters of the module.
-	QueryParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error)
-	// Queries a bucket with specify name.
-	HeadBucket(ctx context.Context, in *QueryHeadBucketRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error)
-	// Queries a bucket by id
-	HeadBucketById(ctx context.Context, in *QueryHeadBucketByIdRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error)
-	// Queries a bucket with EIP712 standard metadata info
-	HeadBucketNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryBucketNFTResponse, error)
-	// Queries a object with specify name.
-	HeadObject(ctx context.Context, in *QueryHeadObjectRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error)
-	// Queries an object by id
-	HeadObjectById(ctx context.Context, in *QueryHeadObjectByIdRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error)
-	// Queries a shadow object with specify name.
-	HeadShadowObject(ctx context.Context, in *QueryHeadShadowObjectRequest, opts ...grpc.CallOption) (*QueryHeadShadowObjectResponse, error)
-	// Queries a object with EIP712 standard metadata info
-	HeadObjectNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryObjectNFTResponse, error)
-	// Queries a list of bucket items.
-	ListBuckets(ctx context.Context, in *QueryListBucketsRequest, opts ...grpc.CallOption) (*QueryListBucketsResponse, error)
-	// Queries a list of object items under the bucket.
-	ListObjects(ctx context.Context, in *QueryListObjectsRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error)
-	// Queries a list of object items under the bucket.
-	ListObjectsByBucketId(ctx context.Context, in *QueryListObjectsByBucketIdRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error)
-	// Queries a group with EIP712 standard metadata info
-	HeadGroupNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryGroupNFTResponse, error)
-	// Queries a policy which grants permission to account
-	QueryPolicyForAccount(ctx context.Context, in *QueryPolicyForAccountRequest, opts ...grpc.CallOption) (*QueryPolicyForAccountResponse, error)
-	// Queries a list of VerifyPermission items.
-	VerifyPermission(ctx context.Context, in *QueryVerifyPermissionRequest, opts ...grpc.CallOption) (*QueryVerifyPermissionResponse, error)
-	// Queries a group with specify owner and name .
-	HeadGroup(ctx context.Context, in *QueryHeadGroupRequest, opts ...grpc.CallOption) (*QueryHeadGroupResponse, error)
-	// Queries a list of ListGroup items.
-	ListGroups(ctx context.Context, in *QueryListGroupsRequest, opts ...grpc.CallOption) (*QueryListGroupsResponse, error)
-	// Queries a list of HeadGroupMember items.
-	HeadGroupMember(ctx context.Context, in *QueryHeadGroupMemberRequest, opts ...grpc.CallOption) (*QueryHeadGroupMemberResponse, error)
-	// Queries a policy that grants permission to a group
-	QueryPolicyForGroup(ctx context.Context, in *QueryPolicyForGroupRequest, opts ...grpc.CallOption) (*QueryPolicyForGroupResponse, error)
-	// Queries a policy by policy id
-	QueryPolicyById(ctx context.Context, in *QueryPolicyByIdRequest, opts ...grpc.CallOption) (*QueryPolicyByIdResponse, error)
-	// Queries lock fee for storing an object
-	QueryLockFee(ctx context.Context, in *QueryLockFeeRequest, opts ...grpc.CallOption) (*QueryLockFeeResponse, error)
-	// Queries a bucket extra info (with gvg bindings and price time) with specify name.
-	HeadBucketExtra(ctx context.Context, in *QueryHeadBucketExtraRequest, opts ...grpc.CallOption) (*QueryHeadBucketExtraResponse, error)
-	// Queries whether read and storage prices changed for the bucket.
-	QueryIsPriceChanged(ctx context.Context, in *QueryIsPriceChangedRequest, opts ...grpc.CallOption) (*QueryIsPriceChangedResponse, error)
-	// Queries whether read and storage prices changed for the bucket.
-	QueryQuotaUpdateTime(ctx context.Context, in *QueryQuoteUpdateTimeRequest, opts ...grpc.CallOption) (*QueryQuoteUpdateTimeResponse, error)
-	// Queries whether some members are in the group.
-	QueryGroupMembersExist(ctx context.Context, in *QueryGroupMembersExistRequest, opts ...grpc.CallOption) (*QueryGroupMembersExistResponse, error)
-	// Queries whether some groups are exist.
-	QueryGroupsExist(ctx context.Context, in *QueryGroupsExistRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error)
-	// Queries whether some groups are exist by id.
-	QueryGroupsExistById(ctx context.Context, in *QueryGroupsExistByIdRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error)
-	// Queries the flow rate limit of a bucket for a payment account
-	QueryPaymentAccountBucketFlowRateLimit(ctx context.Context, in *QueryPaymentAccountBucketFlowRateLimitRequest, opts ...grpc.CallOption) (*QueryPaymentAccountBucketFlowRateLimitResponse, error)
+func (m *QueryEstimateCostRequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryEstimateCostRequest) GetRedundantDataChunkNum() uint32 {
+	if m != nil {
+		return m.RedundantDataChunkNum
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryEstimateCostRequest) GetRedundantParityChunkNum() uint32 {
+	if m != nil {
+		return m.RedundantParityChunkNum
+	}
+	return 0
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryEstimateCostResponse struct {
+	// read_rate is the flow rate for the charged read quota, including the validator tax
+	ReadRate github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=read_rate,json=readRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"read_rate"`
+	// store_rate is the flow rate for storing the objects, including the validator tax
+	StoreRate github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=store_rate,json=storeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"store_rate"`
+	// rate is the total flow rate of the bucket per second
+	Rate github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"rate"`
+	// monthly_cost is the total cost of the bucket for 30 days
+	MonthlyCost github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=monthly_cost,json=monthlyCost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"monthly_cost"`
+	// lock_fee is the fee to be locked when the objects are created and not sealed yet
+	LockFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=lock_fee,json=lockFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"lock_fee"`
+	// buffer_balance is the balance to be reserved in the payment account for the rate
+	BufferBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=buffer_balance,json=bufferBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"buffer_balance"`
+	// reserve_time is the duration in seconds the buffer balance is reserved for
+	ReserveTime uint64 `protobuf:"varint,7,opt,name=reserve_time,json=reserveTime,proto3" json:"reserve_time,omitempty"`
+}
+
+func (m *QueryEstimateCostResponse) Reset()         { *m = QueryEstimateCostResponse{} }
+func (m *QueryEstimateCostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateCostResponse) ProtoMessage()    {}
+func (*QueryEstimateCostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{53}
+}
+func (m *QueryEstimateCostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateCostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateCostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryEstimateCostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateCostResponse.Merge(m, src)
+}
+func (m *QueryEstimateCostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateCostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateCostResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error) {
-	out := new(QueryParamsByTimestampResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryParamsByTimestamp", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEstimateCostResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateCostResponse) GetReserveTime() uint64 {
+	if m != nil {
+		return m.ReserveTime
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) HeadBucket(ctx context.Context, in *QueryHeadBucketRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error) {
-	out := new(QueryHeadBucketResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadBucket", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryVerifyPieceIntegrityRequest struct {
+	// object_id is the id of the object
+	ObjectId string `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	// redundancy_index is the redundancy index of the replica, -1 stands for the replica of the primary sp
+	RedundancyIndex int32 `protobuf:"varint,2,opt,name=redundancy_index,json=redundancyIndex,proto3" json:"redundancy_index,omitempty"`
+	// piece_index is the index of the segment/piece in the replica
+	PieceIndex uint32 `protobuf:"varint,3,opt,name=piece_index,json=pieceIndex,proto3" json:"piece_index,omitempty"`
+	// piece_hash is the sha256 hash of the piece, it is ignored if piece_data is provided
+	PieceHash []byte `protobuf:"bytes,4,opt,name=piece_hash,json=pieceHash,proto3" json:"piece_hash,omitempty"`
+	// piece_data is the data of the piece
+	PieceData []byte `protobuf:"bytes,5,opt,name=piece_data,json=pieceData,proto3" json:"piece_data,omitempty"`
+	// proof is the hashes of the other pieces of the replica, in the order of the pieces
+	Proof [][]byte `protobuf:"bytes,6,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *QueryVerifyPieceIntegrityRequest) Reset()         { *m = QueryVerifyPieceIntegrityRequest{} }
+func (m *QueryVerifyPieceIntegrityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyPieceIntegrityRequest) ProtoMessage()    {}
+func (*QueryVerifyPieceIntegrityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{54}
+}
+func (m *QueryVerifyPieceIntegrityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyPieceIntegrityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyPieceIntegrityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryVerifyPieceIntegrityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyPieceIntegrityRequest.Merge(m, src)
+}
+func (m *QueryVerifyPieceIntegrityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyPieceIntegrityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyPieceIntegrityRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) HeadBucketById(ctx context.Context, in *QueryHeadBucketByIdRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error) {
-	out := new(QueryHeadBucketResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadBucketById", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVerifyPieceIntegrityRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyPieceIntegrityRequest) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) HeadBucketNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryBucketNFTResponse, error) {
-	out := new(QueryBucketNFTResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadBucketNFT", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVerifyPieceIntegrityRequest) GetRedundancyIndex() int32 {
+	if m != nil {
+		return m.RedundancyIndex
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) HeadObject(ctx context.Context, in *QueryHeadObjectRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error) {
-	out := new(QueryHeadObjectResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadObject", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVerifyPieceIntegrityRequest) GetPieceIndex() uint32 {
+	if m != nil {
+		return m.PieceIndex
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) HeadObjectById(ctx context.Context, in *QueryHeadObjectByIdRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error) {
-	out := new(QueryHeadObjectResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadObjectById", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVerifyPieceIntegrityRequest) GetPieceHash() []byte {
+	if m != nil {
+		return m.PieceHash
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) HeadShadowObject(ctx context.Context, in *QueryHeadShadowObjectRequest, opts ...grpc.CallOption) (*QueryHeadShadowObjectResponse, error) {
-	out := new(QueryHeadShadowObjectResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadShadowObject", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVerifyPieceIntegrityRequest) GetPieceData() []byte {
+	if m != nil {
+		return m.PieceData
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) HeadObjectNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryObjectNFTResponse, error) {
-	out := new(QueryObjectNFTResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadObjectNFT", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVerifyPieceIntegrityRequest) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ListBuckets(ctx context.Context, in *QueryListBucketsRequest, opts ...grpc.CallOption) (*QueryListBucketsResponse, error) {
-	out := new(QueryListBucketsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/ListBuckets", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryVerifyPieceIntegrityResponse struct {
+	// valid is whether the piece matches the integrity hash of the replica
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// integrity_hash is the integrity hash of the replica stored on-chain
+	IntegrityHash []byte `protobuf:"bytes,2,opt,name=integrity_hash,json=integrityHash,proto3" json:"integrity_hash,omitempty"`
+	// reason is why the piece is not valid, it is empty if the piece is valid
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (c *queryClient) ListObjects(ctx context.Context, in *QueryListObjectsRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error) {
-	out := new(QueryListObjectsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/ListObjects", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVerifyPieceIntegrityResponse) Reset()         { *m = QueryVerifyPieceIntegrityResponse{} }
+func (m *QueryVerifyPieceIntegrityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyPieceIntegrityResponse) ProtoMessage()    {}
+func (*QueryVerifyPieceIntegrityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{55}
+}
+func (m *QueryVerifyPieceIntegrityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyPieceIntegrityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyPieceIntegrityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryVerifyPieceIntegrityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyPieceIntegrityResponse.Merge(m, src)
+}
+func (m *QueryVerifyPieceIntegrityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyPieceIntegrityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyPieceIntegrityResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListObjectsByBucketId(ctx context.Context, in *QueryListObjectsByBucketIdRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error) {
-	out := new(QueryListObjectsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/ListObjectsByBucketId", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVerifyPieceIntegrityResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyPieceIntegrityResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) HeadGroupNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryGroupNFTResponse, error) {
-	out := new(QueryGroupNFTResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadGroupNFT", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVerifyPieceIntegrityResponse) GetIntegrityHash() []byte {
+	if m != nil {
+		return m.IntegrityHash
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryPolicyForAccount(ctx context.Context, in *QueryPolicyForAccountRequest, opts ...grpc.CallOption) (*QueryPolicyForAccountResponse, error) {
-	out := new(QueryPolicyForAccountResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryPolicyForAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVerifyPieceIntegrityResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) VerifyPermission(ctx context.Context, in *QueryVerifyPermissionRequest, opts ...grpc.CallOption) (*QueryVerifyPermissionResponse, error) {
-	out := new(QueryVerifyPermissionResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/VerifyPermission", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryCrossChainPackageRequest struct {
+	// src_chain_id is the id of the chain the package is sent from, it defaults to the BSC chain id if it is 0
+	SrcChainId uint32 `protobuf:"varint,1,opt,name=src_chain_id,json=srcChainId,proto3" json:"src_chain_id,omitempty"`
+	// channel_id is the id of the channel the package is received on
+	ChannelId uint32 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the receive sequence of the package in the channel
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (c *queryClient) HeadGroup(ctx context.Context, in *QueryHeadGroupRequest, opts ...grpc.CallOption) (*QueryHeadGroupResponse, error) {
-	out := new(QueryHeadGroupResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCrossChainPackageRequest) Reset()         { *m = QueryCrossChainPackageRequest{} }
+func (m *QueryCrossChainPackageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCrossChainPackageRequest) ProtoMessage()    {}
+func (*QueryCrossChainPackageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{56}
+}
+func (m *QueryCrossChainPackageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCrossChainPackageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCrossChainPackageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCrossChainPackageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCrossChainPackageRequest.Merge(m, src)
+}
+func (m *QueryCrossChainPackageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCrossChainPackageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCrossChainPackageRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListGroups(ctx context.Context, in *QueryListGroupsRequest, opts ...grpc.CallOption) (*QueryListGroupsResponse, error) {
-	out := new(QueryListGroupsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/ListGroups", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCrossChainPackageRequest proto.InternalMessageInfo
+
+func (m *QueryCrossChainPackageRequest) GetSrcChainId() uint32 {
+	if m != nil {
+		return m.SrcChainId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) HeadGroupMember(ctx context.Context, in *QueryHeadGroupMemberRequest, opts ...grpc.CallOption) (*QueryHeadGroupMemberResponse, error) {
-	out := new(QueryHeadGroupMemberResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadGroupMember", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCrossChainPackageRequest) GetChannelId() uint32 {
+	if m != nil {
+		return m.ChannelId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) QueryPolicyForGroup(ctx context.Context, in *QueryPolicyForGroupRequest, opts ...grpc.CallOption) (*QueryPolicyForGroupResponse, error) {
-	out := new(QueryPolicyForGroupResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryPolicyForGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCrossChainPackageRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) QueryPolicyById(ctx context.Context, in *QueryPolicyByIdRequest, opts ...grpc.CallOption) (*QueryPolicyByIdResponse, error) {
-	out := new(QueryPolicyByIdResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryPolicyById", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryCrossChainPackageResponse struct {
+	// operation_type is the operation type of the package, e.g. mirror bucket or create bucket
+	OperationType uint32 `protobuf:"varint,1,opt,name=operation_type,json=operationType,proto3" json:"operation_type,omitempty"`
+	// package_type is the type of the package, i.e. syn, ack or fail ack
+	PackageType uint32 `protobuf:"varint,2,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
+	// payload is the decoded payload of the package in json, or the hex of the payload if it fails to be decoded
+	Payload string `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	// ack_payload is the decoded payload of the ack package returned by the execution of a syn package in json,
+	// or the hex of the payload if it fails to be decoded
+	AckPayload string `protobuf:"bytes,4,opt,name=ack_payload,json=ackPayload,proto3" json:"ack_payload,omitempty"`
+	// error is the error returned by the execution, it is empty if the package is executed successfully
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// height is the block height at which the package is executed
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryCrossChainPackageResponse) Reset()         { *m = QueryCrossChainPackageResponse{} }
+func (m *QueryCrossChainPackageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCrossChainPackageResponse) ProtoMessage()    {}
+func (*QueryCrossChainPackageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{57}
+}
+func (m *QueryCrossChainPackageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) QueryLockFee(ctx context.Context, in *QueryLockFeeRequest, opts ...grpc.CallOption) (*QueryLockFeeResponse, error) {
-	out := new(QueryLockFeeResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryLockFee", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCrossChainPackageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCrossChainPackageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCrossChainPackageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCrossChainPackageResponse.Merge(m, src)
+}
+func (m *QueryCrossChainPackageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCrossChainPackageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCrossChainPackageResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) HeadBucketExtra(ctx context.Context, in *QueryHeadBucketExtraRequest, opts ...grpc.CallOption) (*QueryHeadBucketExtraResponse, error) {
-	out := new(QueryHeadBucketExtraResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadBucketExtra", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCrossChainPackageResponse proto.InternalMessageInfo
+
+func (m *QueryCrossChainPackageResponse) GetOperationType() uint32 {
+	if m != nil {
+		return m.OperationType
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) QueryIsPriceChanged(ctx context.Context, in *QueryIsPriceChangedRequest, opts ...grpc.CallOption) (*QueryIsPriceChangedResponse, error) {
-	out := new(QueryIsPriceChangedResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryIsPriceChanged", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCrossChainPackageResponse) GetPackageType() uint32 {
+	if m != nil {
+		return m.PackageType
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) QueryQuotaUpdateTime(ctx context.Context, in *QueryQuoteUpdateTimeRequest, opts ...grpc.CallOption) (*QueryQuoteUpdateTimeResponse, error) {
-	out := new(QueryQuoteUpdateTimeResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryQuotaUpdateTime", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCrossChainPackageResponse) GetPayload() string {
+	if m != nil {
+		return m.Payload
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryGroupMembersExist(ctx context.Context, in *QueryGroupMembersExistRequest, opts ...grpc.CallOption) (*QueryGroupMembersExistResponse, error) {
-	out := new(QueryGroupMembersExistResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryGroupMembersExist", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCrossChainPackageResponse) GetAckPayload() string {
+	if m != nil {
+		return m.AckPayload
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryGroupsExist(ctx context.Context, in *QueryGroupsExistRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error) {
-	out := new(QueryGroupsExistResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryGroupsExist", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCrossChainPackageResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryGroupsExistById(ctx context.Context, in *QueryGroupsExistByIdRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error) {
-	out := new(QueryGroupsExistResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryGroupsExistById", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCrossChainPackageResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) QueryPaymentAccountBucketFlowRateLimit(ctx context.Context, in *QueryPaymentAccountBucketFlowRateLimitRequest, opts ...grpc.CallOption) (*QueryPaymentAccountBucketFlowRateLimitResponse, error) {
-	out := new(QueryPaymentAccountBucketFlowRateLimitResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryPaymentAccountBucketFlowRateLimit", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "greenfield.storage.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "greenfield.storage.QueryParamsResponse")
+	proto.RegisterType((*QueryParamsByTimestampRequest)(nil), "greenfield.storage.QueryParamsByTimestampRequest")
+	proto.RegisterType((*QueryParamsByTimestampResponse)(nil), "greenfield.storage.QueryParamsByTimestampResponse")
+	proto.RegisterType((*QueryHeadBucketRequest)(nil), "greenfield.storage.QueryHeadBucketRequest")
+	proto.RegisterType((*QueryHeadBucketByIdRequest)(nil), "greenfield.storage.QueryHeadBucketByIdRequest")
+	proto.RegisterType((*QueryHeadBucketResponse)(nil), "greenfield.storage.QueryHeadBucketResponse")
+	proto.RegisterType((*QueryHeadObjectRequest)(nil), "greenfield.storage.QueryHeadObjectRequest")
+	proto.RegisterType((*QueryHeadObjectByIdRequest)(nil), "greenfield.storage.QueryHeadObjectByIdRequest")
+	proto.RegisterType((*QueryHeadShadowObjectRequest)(nil), "greenfield.storage.QueryHeadShadowObjectRequest")
+	proto.RegisterType((*QueryHeadObjectResponse)(nil), "greenfield.storage.QueryHeadObjectResponse")
+	proto.RegisterType((*QueryHeadShadowObjectResponse)(nil), "greenfield.storage.QueryHeadShadowObjectResponse")
+	proto.RegisterType((*QueryListBucketsRequest)(nil), "greenfield.storage.QueryListBucketsRequest")
+	proto.RegisterType((*QueryListBucketsResponse)(nil), "greenfield.storage.QueryListBucketsResponse")
+	proto.RegisterType((*QueryListObjectsRequest)(nil), "greenfield.storage.QueryListObjectsRequest")
+	proto.RegisterType((*QueryListObjectsByBucketIdRequest)(nil), "greenfield.storage.QueryListObjectsByBucketIdRequest")
+	proto.RegisterType((*QueryListObjectsResponse)(nil), "greenfield.storage.QueryListObjectsResponse")
+	proto.RegisterType((*QueryListBucketsByGlobalVirtualGroupFamilyRequest)(nil), "greenfield.storage.QueryListBucketsByGlobalVirtualGroupFamilyRequest")
+	proto.RegisterType((*QueryListObjectsByGlobalVirtualGroupRequest)(nil), "greenfield.storage.QueryListObjectsByGlobalVirtualGroupRequest")
+	proto.RegisterType((*QueryNFTRequest)(nil), "greenfield.storage.QueryNFTRequest")
+	proto.RegisterType((*QueryBucketNFTResponse)(nil), "greenfield.storage.QueryBucketNFTResponse")
+	proto.RegisterType((*QueryObjectNFTResponse)(nil), "greenfield.storage.QueryObjectNFTResponse")
+	proto.RegisterType((*QueryGroupNFTResponse)(nil), "greenfield.storage.QueryGroupNFTResponse")
+	proto.RegisterType((*QueryPolicyForAccountRequest)(nil), "greenfield.storage.QueryPolicyForAccountRequest")
+	proto.RegisterType((*QueryPolicyForAccountResponse)(nil), "greenfield.storage.QueryPolicyForAccountResponse")
+	proto.RegisterType((*QueryVerifyPermissionRequest)(nil), "greenfield.storage.QueryVerifyPermissionRequest")
+	proto.RegisterType((*QueryVerifyPermissionResponse)(nil), "greenfield.storage.QueryVerifyPermissionResponse")
+	proto.RegisterType((*QueryHeadGroupRequest)(nil), "greenfield.storage.QueryHeadGroupRequest")
+	proto.RegisterType((*QueryHeadGroupResponse)(nil), "greenfield.storage.QueryHeadGroupResponse")
+	proto.RegisterType((*QueryListGroupsRequest)(nil), "greenfield.storage.QueryListGroupsRequest")
+	proto.RegisterType((*QueryListGroupsResponse)(nil), "greenfield.storage.QueryListGroupsResponse")
+	proto.RegisterType((*QueryHeadGroupMemberRequest)(nil), "greenfield.storage.QueryHeadGroupMemberRequest")
+	proto.RegisterType((*QueryHeadGroupMemberResponse)(nil), "greenfield.storage.QueryHeadGroupMemberResponse")
+	proto.RegisterType((*QueryPolicyForGroupRequest)(nil), "greenfield.storage.QueryPolicyForGroupRequest")
+	proto.RegisterType((*QueryPolicyForGroupResponse)(nil), "greenfield.storage.QueryPolicyForGroupResponse")
+	proto.RegisterType((*QueryPolicyByIdRequest)(nil), "greenfield.storage.QueryPolicyByIdRequest")
+	proto.RegisterType((*QueryPolicyByIdResponse)(nil), "greenfield.storage.QueryPolicyByIdResponse")
+	proto.RegisterType((*QueryLockFeeRequest)(nil), "greenfield.storage.QueryLockFeeRequest")
+	proto.RegisterType((*QueryLockFeeResponse)(nil), "greenfield.storage.QueryLockFeeResponse")
+	proto.RegisterType((*QueryHeadBucketExtraRequest)(nil), "greenfield.storage.QueryHeadBucketExtraRequest")
+	proto.RegisterType((*QueryHeadBucketExtraResponse)(nil), "greenfield.storage.QueryHeadBucketExtraResponse")
+	proto.RegisterType((*QueryIsPriceChangedRequest)(nil), "greenfield.storage.QueryIsPriceChangedRequest")
+	proto.RegisterType((*QueryIsPriceChangedResponse)(nil), "greenfield.storage.QueryIsPriceChangedResponse")
+	proto.RegisterType((*QueryQuoteUpdateTimeRequest)(nil), "greenfield.storage.QueryQuoteUpdateTimeRequest")
+	proto.RegisterType((*QueryQuoteUpdateTimeResponse)(nil), "greenfield.storage.QueryQuoteUpdateTimeResponse")
+	proto.RegisterType((*QueryGroupMembersExistRequest)(nil), "greenfield.storage.QueryGroupMembersExistRequest")
+	proto.RegisterType((*QueryGroupMembersExistResponse)(nil), "greenfield.storage.QueryGroupMembersExistResponse")
+	proto.RegisterMapType((map[string]bool)(nil), "greenfield.storage.QueryGroupMembersExistResponse.ExistsEntry")
+	proto.RegisterType((*QueryGroupsExistRequest)(nil), "greenfield.storage.QueryGroupsExistRequest")
+	proto.RegisterType((*QueryGroupsExistByIdRequest)(nil), "greenfield.storage.QueryGroupsExistByIdRequest")
+	proto.RegisterType((*QueryGroupsExistResponse)(nil), "greenfield.storage.QueryGroupsExistResponse")
+	proto.RegisterMapType((map[string]bool)(nil), "greenfield.storage.QueryGroupsExistResponse.ExistsEntry")
+	proto.RegisterType((*QueryPaymentAccountBucketFlowRateLimitRequest)(nil), "greenfield.storage.QueryPaymentAccountBucketFlowRateLimitRequest")
+	proto.RegisterType((*QueryPaymentAccountBucketFlowRateLimitResponse)(nil), "greenfield.storage.QueryPaymentAccountBucketFlowRateLimitResponse")
+	proto.RegisterType((*QueryEstimateCostRequest)(nil), "greenfield.storage.QueryEstimateCostRequest")
+	proto.RegisterType((*QueryEstimateCostResponse)(nil), "greenfield.storage.QueryEstimateCostResponse")
+	proto.RegisterType((*QueryVerifyPieceIntegrityRequest)(nil), "greenfield.storage.QueryVerifyPieceIntegrityRequest")
+	proto.RegisterType((*QueryVerifyPieceIntegrityResponse)(nil), "greenfield.storage.QueryVerifyPieceIntegrityResponse")
+	proto.RegisterType((*QueryCrossChainPackageRequest)(nil), "greenfield.storage.QueryCrossChainPackageRequest")
+	proto.RegisterType((*QueryCrossChainPackageResponse)(nil), "greenfield.storage.QueryCrossChainPackageResponse")
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
+func init() { proto.RegisterFile("greenfield/storage/query.proto", fileDescriptor_b1b80b580af04cb0) }
+
+var fileDescriptor_b1b80b580af04cb0 = []byte{
+	// 3663 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5b, 0xdd, 0x6f, 0x1b, 0xc7,
+	0xb5, 0xf7, 0xea, 0x5b, 0x23, 0x59, 0x56, 0x26, 0x72, 0x2c, 0xd3, 0xb6, 0x6c, 0xaf, 0x63, 0xc7,
+	0x8e, 0x2d, 0xd2, 0x76, 0xec, 0x5c, 0x3b, 0x4e, 0x1c, 0x48, 0xb6, 0xe4, 0x30, 0x70, 0x1c, 0x85,
+	0xd6, 0x75, 0x10, 0xdf, 0x7b, 0xb1, 0x19, 0xee, 0x0e, 0xa9, 0x8d, 0xc8, 0x5d, 0x7a, 0x77, 0x69,
+	0x99, 0xd1, 0x25, 0x2e, 0x6e, 0x1e, 0xee, 0xed, 0x63, 0xd1, 0xa0, 0x45, 0x81, 0x7e, 0xa0, 0x68,
+	0xd1, 0x4f, 0xb4, 0x28, 0xda, 0x04, 0x05, 0xfa, 0x94, 0x87, 0xb6, 0x40, 0x80, 0xa2, 0x40, 0x90,
+	0xbc, 0x14, 0x79, 0x48, 0xdb, 0xa4, 0x40, 0x5f, 0x8a, 0xfe, 0x0d, 0xc5, 0xcc, 0x9c, 0x59, 0xce,
+	0x7e, 0x70, 0x49, 0xd9, 0xec, 0x93, 0x38, 0xb3, 0x73, 0xce, 0xfc, 0xce, 0x99, 0x33, 0xe7, 0x9c,
+	0x99, 0x33, 0x42, 0x0b, 0x55, 0x8f, 0x52, 0xa7, 0x62, 0xd3, 0x9a, 0x55, 0xf0, 0x03, 0xd7, 0x23,
+	0x55, 0x5a, 0xb8, 0xd7, 0xa4, 0x5e, 0x2b, 0xdf, 0xf0, 0xdc, 0xc0, 0xc5, 0xb8, 0xf3, 0x3d, 0x0f,
+	0xdf, 0x73, 0x4f, 0x9b, 0xae, 0x5f, 0x77, 0xfd, 0x42, 0x99, 0xf8, 0x30, 0xb8, 0x70, 0xff, 0x5c,
+	0x99, 0x06, 0xe4, 0x5c, 0xa1, 0x41, 0xaa, 0xb6, 0x43, 0x02, 0xdb, 0x75, 0x04, 0x7d, 0x6e, 0xbf,
+	0x18, 0x6b, 0xf0, 0x56, 0x41, 0x34, 0xe0, 0xd3, 0x5c, 0xd5, 0xad, 0xba, 0xa2, 0x9f, 0xfd, 0x82,
+	0xde, 0x83, 0x55, 0xd7, 0xad, 0xd6, 0x68, 0x81, 0x34, 0xec, 0x02, 0x71, 0x1c, 0x37, 0xe0, 0xdc,
+	0x24, 0x8d, 0xae, 0xc0, 0x6d, 0x50, 0xaf, 0x6e, 0xfb, 0xbe, 0xed, 0x3a, 0x05, 0xd3, 0xad, 0xd7,
+	0xc3, 0x29, 0x8f, 0xa6, 0x8f, 0x09, 0x5a, 0x0d, 0x2a, 0xd9, 0x1c, 0x4e, 0x91, 0x3a, 0xc2, 0x23,
+	0x6d, 0x40, 0x83, 0x78, 0xa4, 0x2e, 0x39, 0xa4, 0xe9, 0x4d, 0x9d, 0xe1, 0x98, 0xf2, 0xfd, 0xbe,
+	0xed, 0x05, 0x4d, 0x52, 0xab, 0x7a, 0x6e, 0xb3, 0xa1, 0x0e, 0xd2, 0xe7, 0x10, 0x7e, 0x8d, 0xa9,
+	0x6f, 0x8d, 0x73, 0x2e, 0xd1, 0x7b, 0x4d, 0xea, 0x07, 0xfa, 0xab, 0xe8, 0xf1, 0x48, 0xaf, 0xdf,
+	0x70, 0x1d, 0x9f, 0xe2, 0x4b, 0x68, 0x4c, 0x20, 0x98, 0xd7, 0x8e, 0x68, 0x27, 0xa7, 0xce, 0xe7,
+	0xf2, 0xc9, 0xa5, 0xc9, 0x0b, 0x9a, 0xe5, 0x91, 0x0f, 0x3f, 0x3b, 0xbc, 0xab, 0x04, 0xe3, 0xf5,
+	0x17, 0xd0, 0x21, 0x85, 0xe1, 0x72, 0x6b, 0xdd, 0xae, 0x53, 0x3f, 0x20, 0xf5, 0x06, 0xcc, 0x88,
+	0x0f, 0xa2, 0xc9, 0x40, 0xf6, 0x71, 0xee, 0xc3, 0xa5, 0x4e, 0x87, 0x7e, 0x17, 0x2d, 0x74, 0x23,
+	0x7f, 0x64, 0x68, 0x97, 0xd1, 0x13, 0x9c, 0xf7, 0x4b, 0x94, 0x58, 0xcb, 0x4d, 0x73, 0x93, 0x06,
+	0x12, 0xd3, 0x61, 0x34, 0x55, 0xe6, 0x1d, 0x86, 0x43, 0xea, 0x94, 0x33, 0x9e, 0x2c, 0x21, 0xd1,
+	0x75, 0x8b, 0xd4, 0xa9, 0x7e, 0x19, 0xe5, 0x62, 0xa4, 0xcb, 0xad, 0xa2, 0x25, 0xc9, 0x0f, 0xa0,
+	0x49, 0x20, 0xb7, 0x2d, 0x20, 0x9e, 0x10, 0x1d, 0x45, 0x4b, 0xff, 0xb6, 0x86, 0xf6, 0x25, 0xa6,
+	0x05, 0x59, 0x5e, 0x0c, 0xe7, 0xb5, 0x9d, 0x8a, 0x0b, 0x02, 0x2d, 0xa4, 0x09, 0x24, 0x08, 0x8b,
+	0x4e, 0xc5, 0x95, 0xb8, 0xd8, 0x6f, 0xbc, 0x8c, 0x10, 0x7d, 0x10, 0x78, 0x44, 0xd0, 0x0f, 0x71,
+	0xfa, 0x63, 0xdd, 0xe9, 0x57, 0xd8, 0x58, 0xce, 0x64, 0x92, 0xca, 0x9f, 0xfa, 0x5d, 0x45, 0x2d,
+	0xaf, 0x96, 0xdf, 0xa2, 0x66, 0xdf, 0x6a, 0x61, 0x03, 0x5c, 0x4e, 0x21, 0x06, 0x0c, 0x89, 0x01,
+	0xa2, 0x2b, 0xa1, 0x37, 0xc1, 0x3b, 0xa6, 0x37, 0x20, 0xef, 0xe8, 0x4d, 0x74, 0x14, 0x2d, 0xfd,
+	0x4d, 0x74, 0x30, 0x24, 0xbd, 0xbd, 0x41, 0x2c, 0x77, 0x6b, 0xd0, 0xe0, 0x7e, 0xad, 0xae, 0x8c,
+	0x64, 0xde, 0x59, 0x19, 0x09, 0xad, 0xc7, 0xca, 0x08, 0x42, 0xb1, 0x32, 0x6e, 0xf8, 0x1b, 0xff,
+	0x17, 0x9a, 0xab, 0xd6, 0xdc, 0x32, 0xa9, 0x19, 0xb0, 0x23, 0x0d, 0xbe, 0x25, 0x61, 0x8d, 0x4e,
+	0xab, 0x9c, 0xd4, 0x2d, 0x9b, 0xbf, 0xc1, 0x89, 0xee, 0x88, 0xae, 0x1b, 0xac, 0xab, 0x84, 0xab,
+	0x89, 0x3e, 0xbd, 0x02, 0xdb, 0x2c, 0xa9, 0x1d, 0x10, 0x60, 0x25, 0x4d, 0x80, 0x27, 0xd3, 0x04,
+	0x50, 0xc9, 0xe3, 0x62, 0xe8, 0x04, 0x54, 0x74, 0xd3, 0xf6, 0x03, 0x61, 0x43, 0xd2, 0x75, 0xe0,
+	0x55, 0x84, 0x3a, 0x1e, 0x18, 0x26, 0x38, 0x91, 0x07, 0xaf, 0xcb, 0xdc, 0x75, 0x5e, 0xf8, 0x76,
+	0x70, 0xd7, 0xf9, 0x35, 0x52, 0xa5, 0x40, 0x5b, 0x52, 0x28, 0xf5, 0x1f, 0x68, 0x68, 0x3e, 0x39,
+	0x07, 0x88, 0xb1, 0x84, 0xa6, 0x95, 0x1d, 0xc2, 0xf6, 0xfc, 0x70, 0x1f, 0x5b, 0x64, 0xaa, 0xb3,
+	0x45, 0x7c, 0x7c, 0x23, 0x82, 0x53, 0xe8, 0xff, 0xa9, 0x9e, 0x38, 0xc5, 0xfc, 0x11, 0xa0, 0xef,
+	0x68, 0x8a, 0x32, 0x84, 0xbe, 0x06, 0xad, 0x8c, 0xb8, 0x55, 0x0f, 0x25, 0x3c, 0xd1, 0x97, 0x34,
+	0x74, 0x34, 0x0e, 0x62, 0xb9, 0x05, 0xb2, 0x5b, 0x83, 0x86, 0x13, 0xf1, 0x6c, 0x43, 0x31, 0xcf,
+	0x16, 0x59, 0xb8, 0x50, 0x1f, 0x9d, 0x85, 0x53, 0xec, 0x2f, 0x73, 0xe1, 0x14, 0xd3, 0x9b, 0xea,
+	0x98, 0xde, 0x00, 0x17, 0xee, 0x03, 0x0d, 0x9d, 0x8b, 0x5b, 0xd8, 0x72, 0x2b, 0xb9, 0xd3, 0x56,
+	0x49, 0xdd, 0xae, 0xb5, 0x06, 0xad, 0xc3, 0x65, 0xb4, 0x90, 0xe6, 0x09, 0x8c, 0x0a, 0x9f, 0x4d,
+	0x2a, 0x76, 0x77, 0x29, 0x57, 0xed, 0x02, 0xa8, 0x68, 0xe9, 0x3f, 0xd5, 0xd0, 0xe9, 0xe4, 0xaa,
+	0xa7, 0xf8, 0x8a, 0x01, 0x63, 0xbf, 0x88, 0xf6, 0xa5, 0x62, 0x0f, 0x41, 0xcf, 0x25, 0x41, 0x17,
+	0x2d, 0xfd, 0x0c, 0xda, 0xc3, 0xd1, 0xde, 0x5a, 0x5d, 0x97, 0x88, 0xf6, 0xa3, 0x89, 0xc0, 0xdd,
+	0xa4, 0x4e, 0xc7, 0xd5, 0x8f, 0xf3, 0x76, 0xd1, 0xd2, 0xdf, 0x80, 0x00, 0x24, 0x56, 0x86, 0xd3,
+	0x84, 0x5e, 0x78, 0xb2, 0x4e, 0x03, 0x62, 0x58, 0x24, 0x20, 0x20, 0x85, 0xde, 0x7d, 0xeb, 0xbf,
+	0x42, 0x03, 0x72, 0x9d, 0x04, 0xa4, 0x34, 0x51, 0x87, 0x5f, 0x21, 0x6b, 0xa1, 0xb2, 0x87, 0x61,
+	0x2d, 0x28, 0x53, 0x58, 0xbf, 0x8e, 0xf6, 0x72, 0xd6, 0x5c, 0x66, 0x95, 0xf3, 0xd5, 0x24, 0xe7,
+	0xa3, 0x69, 0x9c, 0x39, 0x61, 0x0a, 0xe3, 0xff, 0xd5, 0x20, 0xf2, 0xad, 0xb9, 0x35, 0xdb, 0x6c,
+	0xad, 0xba, 0xde, 0x92, 0x69, 0xba, 0x4d, 0x27, 0x8c, 0x7c, 0x39, 0x34, 0xe1, 0x51, 0xdf, 0x6d,
+	0x7a, 0xa6, 0x0c, 0x7b, 0x61, 0x1b, 0xaf, 0xa0, 0xc7, 0x1a, 0x9e, 0xed, 0x98, 0x76, 0x83, 0xd4,
+	0x0c, 0x62, 0x59, 0x1e, 0xf5, 0x7d, 0xb1, 0x71, 0x97, 0xe7, 0x3f, 0x7e, 0x7f, 0x71, 0x0e, 0x4c,
+	0x60, 0x49, 0x7c, 0xb9, 0x1d, 0x78, 0xb6, 0x53, 0x2d, 0xcd, 0x86, 0x24, 0xd0, 0xaf, 0xdf, 0x91,
+	0x59, 0x5c, 0x02, 0x02, 0x08, 0x79, 0x11, 0x8d, 0x35, 0xf8, 0x37, 0x90, 0xf0, 0x90, 0x2a, 0x61,
+	0x27, 0x11, 0xce, 0x0b, 0x06, 0x25, 0x18, 0xac, 0x7f, 0x2a, 0x65, 0xbb, 0x43, 0x3d, 0xbb, 0xd2,
+	0x5a, 0x0b, 0x07, 0x4a, 0xd9, 0x2e, 0xa0, 0x09, 0xb7, 0x41, 0x3d, 0x12, 0xb8, 0x9e, 0x90, 0x2d,
+	0x03, 0x76, 0x38, 0xb2, 0xa7, 0xd7, 0x8c, 0xe7, 0x02, 0xc3, 0xf1, 0x5c, 0x00, 0x2f, 0xa3, 0x29,
+	0x62, 0x32, 0x93, 0x37, 0x58, 0xce, 0x3c, 0x3f, 0x72, 0x44, 0x3b, 0x39, 0x13, 0x5d, 0x36, 0x45,
+	0xa8, 0x25, 0x3e, 0x72, 0xbd, 0xd5, 0xa0, 0x25, 0x44, 0xc2, 0xdf, 0xa1, 0xd2, 0x92, 0xb2, 0x75,
+	0x94, 0x46, 0x2b, 0x15, 0x6a, 0x06, 0x5c, 0xb4, 0x99, 0xae, 0x4a, 0x5b, 0xe1, 0x83, 0x4a, 0x30,
+	0x58, 0xbf, 0x07, 0x96, 0xc6, 0x62, 0x7d, 0x64, 0x97, 0x5f, 0x46, 0x53, 0x62, 0x3b, 0xba, 0x5b,
+	0x0e, 0xed, 0xad, 0x2f, 0xc4, 0x07, 0xbf, 0xca, 0xc6, 0xe2, 0x43, 0x48, 0xb4, 0x54, 0x85, 0x4d,
+	0xf2, 0x1e, 0x1e, 0x65, 0xee, 0x28, 0x39, 0x21, 0x4c, 0x09, 0x32, 0x3c, 0x2f, 0x09, 0x95, 0xb4,
+	0xe2, 0x50, 0x57, 0xf3, 0x16, 0xb9, 0x66, 0x55, 0xfe, 0xd4, 0xbf, 0xa1, 0x01, 0x63, 0xe6, 0xc7,
+	0xf8, 0x88, 0x81, 0x47, 0xd0, 0x98, 0x52, 0x86, 0xfa, 0x57, 0x8a, 0xfe, 0x5d, 0x35, 0xc0, 0x4b,
+	0x74, 0x20, 0xf7, 0x8d, 0x14, 0x78, 0x0f, 0x13, 0x8c, 0xf0, 0x55, 0x89, 0x4f, 0xc4, 0xc5, 0x21,
+	0x1e, 0x17, 0x7b, 0x68, 0x10, 0x85, 0x1a, 0xf4, 0xf5, 0x1f, 0x6b, 0xe8, 0x40, 0x74, 0x6d, 0x5e,
+	0xa1, 0xf5, 0x32, 0xf5, 0xa4, 0x1e, 0xcf, 0xa2, 0xb1, 0x3a, 0xef, 0xe8, 0x69, 0x0f, 0x30, 0xee,
+	0x11, 0x34, 0x16, 0x33, 0xa3, 0xe1, 0xb8, 0x19, 0x51, 0x25, 0x87, 0x8f, 0x40, 0x0d, 0x93, 0xd4,
+	0x69, 0x41, 0xae, 0x20, 0x8e, 0xf9, 0x61, 0x65, 0x5b, 0xa8, 0x1c, 0x04, 0x62, 0xd1, 0xd0, 0x2b,
+	0x70, 0xca, 0x08, 0xbd, 0x55, 0x64, 0x97, 0x64, 0xb9, 0xcb, 0x33, 0x08, 0x77, 0xdc, 0x65, 0x24,
+	0xb4, 0x4d, 0x2a, 0x5e, 0x51, 0x86, 0xb5, 0x75, 0xd0, 0x7c, 0x7c, 0x9e, 0x47, 0xf3, 0x89, 0x17,
+	0x61, 0x4b, 0x88, 0xee, 0xd8, 0xf9, 0x48, 0x8c, 0x51, 0xce, 0x47, 0xa2, 0xa3, 0x68, 0xe9, 0x6b,
+	0x60, 0xab, 0x2a, 0xd9, 0xa3, 0x01, 0xf9, 0x96, 0x06, 0x97, 0x01, 0x37, 0x5d, 0x73, 0x73, 0x95,
+	0xd2, 0xce, 0xce, 0x64, 0x4a, 0xaa, 0x13, 0xaf, 0x65, 0xf8, 0x8d, 0x30, 0xa8, 0x68, 0x7d, 0x04,
+	0x15, 0x46, 0x73, 0xbb, 0x01, 0xfd, 0x4c, 0x1c, 0xd3, 0xa3, 0x24, 0xa0, 0x06, 0x09, 0xb8, 0x8e,
+	0x87, 0x4b, 0x13, 0xa2, 0x63, 0x29, 0xc0, 0x47, 0xd1, 0x74, 0x83, 0xb4, 0x6a, 0x2e, 0xb1, 0x0c,
+	0xdf, 0x7e, 0x5b, 0xd8, 0xd2, 0x48, 0x69, 0x0a, 0xfa, 0x6e, 0xdb, 0x6f, 0x53, 0xbd, 0x86, 0xe6,
+	0xa2, 0xf0, 0x40, 0xdc, 0x75, 0x34, 0x46, 0xea, 0x2c, 0x3a, 0x01, 0xa6, 0xe7, 0xd9, 0xa9, 0xff,
+	0xd3, 0xcf, 0x0e, 0x9f, 0xa8, 0xda, 0xc1, 0x46, 0xb3, 0x9c, 0x37, 0xdd, 0x3a, 0x5c, 0x06, 0xc1,
+	0x9f, 0x45, 0xdf, 0xda, 0x84, 0xbb, 0x91, 0xa2, 0x13, 0x7c, 0xfc, 0xfe, 0x22, 0x02, 0x09, 0x8a,
+	0x4e, 0x50, 0x02, 0x5e, 0xfa, 0x55, 0x65, 0x9b, 0x29, 0xa7, 0xe7, 0xbe, 0xaf, 0x0c, 0x54, 0xdb,
+	0x8f, 0xd0, 0x87, 0xb6, 0xaf, 0x1e, 0xdd, 0xa5, 0xbf, 0x4b, 0x71, 0x03, 0x45, 0x27, 0xa0, 0x9e,
+	0x43, 0x6a, 0xca, 0xf9, 0x46, 0x39, 0xbd, 0xbf, 0x00, 0xb6, 0x5f, 0xf4, 0xd7, 0x3c, 0xdb, 0xa4,
+	0xd7, 0x36, 0x88, 0x53, 0xa5, 0x56, 0xdf, 0x28, 0xff, 0x32, 0x0e, 0x62, 0xc6, 0xe9, 0x01, 0xe5,
+	0x3c, 0x1a, 0x37, 0x45, 0x17, 0x27, 0x9e, 0x28, 0xc9, 0x26, 0x7e, 0x0b, 0x61, 0xb3, 0xe9, 0x79,
+	0xd4, 0x09, 0x0c, 0x8f, 0x12, 0xcb, 0x68, 0x30, 0x72, 0x70, 0x1e, 0x3b, 0x59, 0x81, 0xeb, 0xd4,
+	0x54, 0x56, 0xe0, 0x3a, 0x35, 0x4b, 0xb3, 0xc0, 0xb7, 0x44, 0x89, 0xc5, 0x41, 0xe1, 0x6d, 0x74,
+	0x40, 0xce, 0x15, 0x5a, 0x62, 0xe0, 0x7a, 0x14, 0x26, 0x1d, 0x1e, 0xc0, 0xa4, 0xf3, 0x30, 0xc1,
+	0x1a, 0x58, 0x2d, 0x63, 0x2f, 0x26, 0xff, 0x1f, 0x74, 0x48, 0x4e, 0xee, 0x53, 0xd3, 0x75, 0xac,
+	0xf8, 0xf4, 0x23, 0x03, 0x98, 0x3e, 0x07, 0x53, 0xdc, 0x96, 0x33, 0x28, 0x00, 0x5a, 0x48, 0x7e,
+	0x35, 0xee, 0x93, 0x9a, 0x6d, 0xb1, 0x94, 0xc7, 0x08, 0xc8, 0x03, 0xc3, 0x23, 0x01, 0x9d, 0x1f,
+	0x1d, 0xc0, 0xec, 0xfb, 0x80, 0xff, 0x1d, 0xc9, 0x7e, 0x9d, 0x3c, 0x28, 0x91, 0x80, 0xe2, 0x32,
+	0x9a, 0x71, 0xe8, 0x96, 0xba, 0xc0, 0x63, 0x03, 0x98, 0x6e, 0xda, 0xa1, 0x5b, 0x9d, 0xc5, 0xf5,
+	0xd1, 0x3e, 0x36, 0x47, 0xda, 0xc2, 0x8e, 0x0f, 0x60, 0xb2, 0x39, 0x87, 0x6e, 0x25, 0x17, 0x75,
+	0x0b, 0xed, 0x67, 0x93, 0xa6, 0x2f, 0xe8, 0xc4, 0x00, 0xa6, 0x7d, 0xc2, 0xa1, 0x5b, 0x69, 0x8b,
+	0x79, 0x0f, 0xb1, 0x2f, 0x69, 0x0b, 0x39, 0x39, 0x80, 0x59, 0x1f, 0x77, 0xe8, 0x56, 0x7c, 0x11,
+	0x43, 0x4f, 0xf6, 0x5a, 0xd3, 0x0d, 0xe8, 0xbf, 0x37, 0x2c, 0x12, 0xd0, 0x75, 0xbb, 0x4e, 0xfb,
+	0xf6, 0x11, 0x57, 0xc0, 0x93, 0x25, 0xe8, 0xc1, 0x47, 0x1c, 0x40, 0x93, 0x4d, 0xde, 0xcb, 0xfc,
+	0xfa, 0x98, 0xf0, 0xeb, 0xa2, 0x63, 0x29, 0xd0, 0x1d, 0x48, 0x8a, 0x95, 0xe0, 0xed, 0xaf, 0x3c,
+	0xb0, 0xfd, 0x40, 0x39, 0x18, 0x86, 0x81, 0x17, 0x0e, 0x86, 0x22, 0xdb, 0xb1, 0xf0, 0x79, 0x34,
+	0x2e, 0x12, 0x03, 0x91, 0x26, 0x65, 0x45, 0x1b, 0x39, 0x50, 0x7f, 0x4f, 0x83, 0x1b, 0xe4, 0x94,
+	0x09, 0x01, 0xef, 0x1d, 0x34, 0x46, 0x59, 0x87, 0xbc, 0x94, 0xb8, 0x9a, 0xe6, 0x75, 0xb3, 0x79,
+	0xe4, 0x79, 0xcb, 0x5f, 0x71, 0x02, 0xaf, 0x55, 0x02, 0x6e, 0xb9, 0xcb, 0x68, 0x4a, 0xe9, 0xc6,
+	0xb3, 0x68, 0x78, 0x93, 0xb6, 0x40, 0x26, 0xf6, 0x13, 0xcf, 0xa1, 0xd1, 0xfb, 0xa4, 0xd6, 0x14,
+	0x5e, 0x72, 0xa2, 0x24, 0x1a, 0xcf, 0x0d, 0x5d, 0xd2, 0xf4, 0x26, 0x04, 0x73, 0x91, 0x74, 0x46,
+	0xf4, 0xf3, 0x08, 0x49, 0xfe, 0x61, 0x49, 0xca, 0x16, 0x16, 0x74, 0x08, 0x03, 0xd8, 0xc2, 0xfa,
+	0xfa, 0x73, 0x60, 0x19, 0xca, 0xb4, 0xb1, 0xfc, 0x43, 0x2e, 0x8d, 0xd0, 0xd5, 0x64, 0x69, 0x02,
+	0xd6, 0xc6, 0xd7, 0x7f, 0x28, 0x6f, 0x7f, 0x22, 0x98, 0x41, 0xc5, 0x6b, 0x31, 0x15, 0x5f, 0xca,
+	0x56, 0xf1, 0xbf, 0x56, 0xb9, 0x1f, 0x69, 0x68, 0x11, 0x8a, 0x0a, 0xad, 0x3a, 0x75, 0x02, 0x38,
+	0xcb, 0x8a, 0x78, 0xba, 0x5a, 0x73, 0xb7, 0xd8, 0x2e, 0xb9, 0x69, 0xd7, 0xed, 0x50, 0xe7, 0x4b,
+	0x68, 0x4f, 0x43, 0x8c, 0x35, 0x88, 0x18, 0xdc, 0x53, 0xef, 0x33, 0x8d, 0x08, 0x73, 0x7c, 0x25,
+	0xbc, 0xb8, 0xec, 0x2f, 0xab, 0x86, 0x3d, 0x18, 0x2e, 0x9c, 0xba, 0x25, 0x87, 0x13, 0x5b, 0xf2,
+	0x67, 0x1a, 0xca, 0xf7, 0x2b, 0x12, 0x2c, 0xc9, 0x5e, 0x34, 0x66, 0xfb, 0x86, 0x4f, 0x03, 0x08,
+	0xe4, 0xa3, 0xb6, 0x7f, 0x9b, 0x06, 0xd8, 0x42, 0x7b, 0x2a, 0x35, 0x77, 0x8b, 0xbb, 0x20, 0xa3,
+	0xc6, 0x28, 0x1e, 0x22, 0x86, 0x27, 0xb3, 0xa8, 0xdd, 0x15, 0x15, 0x84, 0xfe, 0xd9, 0x10, 0x18,
+	0xcb, 0x8a, 0x1f, 0xd8, 0x75, 0x12, 0xd0, 0x6b, 0x6e, 0xc7, 0xc2, 0x07, 0x95, 0x5f, 0x1e, 0x0d,
+	0xaf, 0x1c, 0x59, 0x06, 0x29, 0xec, 0x7d, 0x44, 0x5e, 0x29, 0xb2, 0x0c, 0xd2, 0x67, 0xf9, 0xbe,
+	0xb9, 0x41, 0xbc, 0x2a, 0xb5, 0x44, 0x4c, 0xbb, 0xd7, 0x74, 0x03, 0xc2, 0x03, 0xf8, 0x48, 0x69,
+	0x16, 0xbe, 0xb0, 0xc8, 0xc4, 0x5c, 0x1d, 0x89, 0x96, 0xaa, 0x46, 0x63, 0xa5, 0x2a, 0xfc, 0x6f,
+	0x68, 0xde, 0xa3, 0x56, 0xd3, 0xb1, 0x88, 0x13, 0xf0, 0xcb, 0x1e, 0xc3, 0xdc, 0x68, 0x3a, 0x9b,
+	0x86, 0xd3, 0xac, 0x73, 0x2f, 0xb8, 0xbb, 0xb4, 0x37, 0xfc, 0x7e, 0x9d, 0x04, 0xe4, 0x1a, 0xfb,
+	0x7a, 0xab, 0x59, 0xc7, 0x57, 0x50, 0xae, 0x43, 0xd8, 0x20, 0x9e, 0x1d, 0xb4, 0x14, 0xd2, 0x71,
+	0x4e, 0xba, 0x2f, 0x1c, 0xb1, 0xc6, 0x07, 0x48, 0xe2, 0x97, 0x47, 0x26, 0x86, 0x67, 0x47, 0x4a,
+	0x7b, 0xe4, 0x67, 0xb3, 0xc5, 0x2f, 0x2c, 0xf4, 0xbf, 0x8d, 0xa0, 0xfd, 0x29, 0x0a, 0x86, 0xb5,
+	0x7f, 0x03, 0x4d, 0x72, 0x71, 0x79, 0x9c, 0x19, 0x44, 0x92, 0x3c, 0xc1, 0xd8, 0xf1, 0x0c, 0xe1,
+	0x3f, 0x10, 0x12, 0xa1, 0x93, 0xf3, 0x1e, 0x84, 0xe9, 0x4c, 0x72, 0x7e, 0x9c, 0xf9, 0x1a, 0x1a,
+	0xe1, 0x6c, 0x87, 0x07, 0xc0, 0x96, 0x73, 0xc2, 0x06, 0x9a, 0xae, 0xbb, 0x4e, 0xb0, 0x51, 0x6b,
+	0x19, 0xa6, 0xeb, 0x07, 0x0f, 0x91, 0xbb, 0x25, 0x39, 0x4f, 0x01, 0x47, 0xa6, 0x72, 0xfc, 0x3a,
+	0x9a, 0xa8, 0xb9, 0xe6, 0xa6, 0x51, 0xa1, 0x0f, 0x93, 0x9a, 0x25, 0x99, 0x8f, 0xd7, 0xc4, 0x69,
+	0x07, 0x9b, 0x68, 0xa6, 0xdc, 0xac, 0x54, 0xa8, 0x67, 0x94, 0x49, 0x8d, 0x38, 0x0f, 0x95, 0x8a,
+	0xa5, 0xec, 0x53, 0xc1, 0x73, 0x59, 0xb0, 0x64, 0x5b, 0xc8, 0xa3, 0x3e, 0xf5, 0xee, 0x53, 0x83,
+	0x19, 0x3a, 0x37, 0xc6, 0x91, 0xd2, 0x14, 0xf4, 0xb1, 0xa8, 0xaf, 0xff, 0x49, 0x43, 0x47, 0xd4,
+	0x6b, 0x2e, 0x9b, 0x9a, 0x94, 0x1d, 0x51, 0xaa, 0xcc, 0x4c, 0xfb, 0xa9, 0xec, 0xe1, 0x53, 0x68,
+	0x56, 0x31, 0x5f, 0xdb, 0xb1, 0xe8, 0x03, 0x6e, 0x38, 0xa3, 0xaa, 0x59, 0x17, 0x59, 0x37, 0x73,
+	0x84, 0x0d, 0x36, 0x01, 0x8c, 0x1a, 0xe6, 0x7b, 0x03, 0x35, 0xc4, 0x9c, 0x6c, 0xc0, 0x21, 0x24,
+	0x5a, 0xc6, 0x06, 0xf1, 0x37, 0xf8, 0x6a, 0x4e, 0x97, 0x26, 0x79, 0xcf, 0x4b, 0xc4, 0xdf, 0xe8,
+	0x7c, 0xe6, 0x97, 0xb1, 0xa3, 0xca, 0x67, 0xb6, 0x23, 0x59, 0xcc, 0x68, 0x78, 0xae, 0x5b, 0x99,
+	0x1f, 0x3b, 0x32, 0x7c, 0x72, 0xba, 0x24, 0x1a, 0xfa, 0x03, 0xa8, 0xb0, 0xa4, 0x0b, 0x08, 0x5b,
+	0x4a, 0x84, 0x1b, 0x5b, 0x1e, 0x8b, 0x44, 0x03, 0x1f, 0x47, 0x33, 0xb6, 0x1c, 0x2a, 0x20, 0x0d,
+	0xf1, 0x39, 0x77, 0x87, 0xbd, 0x1c, 0xd6, 0x13, 0x68, 0xcc, 0xa3, 0xc4, 0x77, 0x1d, 0x70, 0xed,
+	0xd0, 0xd2, 0xff, 0x1b, 0x92, 0xa5, 0x6b, 0x9e, 0xeb, 0xfb, 0xd7, 0x36, 0x88, 0xed, 0xac, 0x11,
+	0x73, 0xb3, 0x73, 0xd1, 0x85, 0x8f, 0xa0, 0x69, 0xdf, 0x33, 0x0d, 0x93, 0x7d, 0x92, 0xaa, 0xdd,
+	0x5d, 0x42, 0xbe, 0x67, 0xf2, 0xd1, 0x45, 0x8b, 0x49, 0xcc, 0x4e, 0x68, 0x0e, 0xad, 0x75, 0x2e,
+	0xe9, 0x27, 0xa1, 0xa7, 0x68, 0xe1, 0x1c, 0x9a, 0xf0, 0x19, 0x2f, 0xc7, 0x94, 0x47, 0xec, 0xb0,
+	0xad, 0x7f, 0x22, 0x53, 0xa7, 0x94, 0xe9, 0x41, 0xea, 0xe3, 0x68, 0x46, 0x5c, 0xba, 0x86, 0x37,
+	0xa5, 0x02, 0xc1, 0xee, 0xb0, 0x77, 0xbd, 0xd5, 0xa0, 0xe2, 0x30, 0xcf, 0x29, 0xc5, 0x20, 0x01,
+	0x63, 0x0a, 0xfa, 0xf8, 0x90, 0x79, 0x34, 0x0e, 0x67, 0x7b, 0xd0, 0x81, 0x6c, 0xb2, 0x35, 0x27,
+	0xe6, 0xa6, 0x21, 0xbf, 0x8e, 0x88, 0xe0, 0x47, 0xcc, 0xcd, 0x35, 0x18, 0x30, 0x87, 0x46, 0xa9,
+	0xe7, 0xb9, 0x9e, 0xd8, 0x5f, 0x25, 0xd1, 0x60, 0x3a, 0xdd, 0xa0, 0x76, 0x75, 0x43, 0xa6, 0xa0,
+	0xd0, 0x3a, 0xff, 0x8f, 0x3c, 0x1a, 0xe5, 0x52, 0xe1, 0x36, 0x1a, 0x13, 0xef, 0x02, 0xf0, 0x89,
+	0xae, 0xe9, 0x48, 0xe4, 0x75, 0x44, 0xee, 0xa9, 0x9e, 0xe3, 0x84, 0x5e, 0x74, 0xfd, 0x9d, 0x4f,
+	0xfe, 0xfa, 0xee, 0xd0, 0x41, 0x9c, 0x2b, 0x74, 0x7d, 0xcb, 0x81, 0x7f, 0x2e, 0xef, 0x3e, 0x13,
+	0x6f, 0x1b, 0xf0, 0xb9, 0x1e, 0xf3, 0x24, 0x9f, 0x51, 0xe4, 0xce, 0xef, 0x84, 0x04, 0x50, 0xe6,
+	0x39, 0xca, 0x93, 0xf8, 0x44, 0x77, 0x94, 0x85, 0xed, 0x30, 0xc0, 0xb5, 0xf1, 0x37, 0x35, 0x84,
+	0x3a, 0xd7, 0x17, 0xf8, 0xe9, 0xae, 0x53, 0x26, 0x5e, 0x54, 0xe4, 0x4e, 0xf7, 0x35, 0x16, 0x70,
+	0x5d, 0xe4, 0xb8, 0x0a, 0x78, 0x31, 0x0d, 0xd7, 0x06, 0x0b, 0x5c, 0x22, 0xf5, 0x29, 0x6c, 0x2b,
+	0x59, 0x51, 0x1b, 0xff, 0x48, 0x43, 0x33, 0xd1, 0x07, 0x19, 0x38, 0xdf, 0xc7, 0xb4, 0x4a, 0x86,
+	0xbb, 0x33, 0x98, 0x97, 0x39, 0xcc, 0x67, 0xf0, 0xb9, 0x1e, 0x30, 0x8d, 0x72, 0xcb, 0xb0, 0xad,
+	0x10, 0xac, 0x6d, 0xb5, 0xf1, 0xd7, 0x35, 0xb4, 0xbb, 0xc3, 0xf1, 0xd6, 0xea, 0x3a, 0x3e, 0xd6,
+	0x75, 0xe6, 0x4e, 0xd1, 0x2c, 0xd7, 0x5d, 0xe3, 0x89, 0x5a, 0x99, 0xfe, 0x2c, 0x47, 0x77, 0x16,
+	0xe7, 0x7b, 0xa1, 0x73, 0x2a, 0x41, 0x61, 0x5b, 0xd6, 0xe2, 0xda, 0xf8, 0x27, 0xb0, 0xc8, 0xa2,
+	0xd0, 0xd5, 0x63, 0x91, 0x23, 0x4f, 0x30, 0x7a, 0x68, 0x2f, 0xfa, 0x20, 0x41, 0xbf, 0xc6, 0xf1,
+	0xbd, 0x80, 0xaf, 0x74, 0xc5, 0x27, 0x02, 0x44, 0x74, 0x91, 0x0b, 0xdb, 0x4a, 0xdd, 0xa6, 0xb3,
+	0xe4, 0x9d, 0xb7, 0x24, 0x3d, 0x96, 0x3c, 0xf1, 0xe8, 0x64, 0x67, 0xa0, 0x7b, 0x2f, 0x39, 0xc0,
+	0x83, 0x25, 0x0f, 0x83, 0x5e, 0x1b, 0xff, 0x46, 0x43, 0xb3, 0xf1, 0xd7, 0x19, 0xf8, 0x6c, 0xe6,
+	0xe4, 0x29, 0xcf, 0x5c, 0x72, 0xe7, 0x76, 0x40, 0x01, 0xa0, 0x5f, 0xe6, 0xa0, 0xaf, 0xe3, 0xe5,
+	0xae, 0xa0, 0x7d, 0x4e, 0xd6, 0x8f, 0xc2, 0xa5, 0xe1, 0x86, 0x05, 0xd4, 0x47, 0x35, 0xdc, 0x44,
+	0x25, 0xb6, 0x0f, 0xc3, 0x95, 0x88, 0xa2, 0x86, 0xfb, 0x15, 0x0d, 0x4d, 0x29, 0x05, 0x7d, 0xdc,
+	0x7d, 0x61, 0x93, 0x8f, 0x57, 0x72, 0x67, 0xfa, 0x1b, 0x0c, 0x10, 0x4f, 0x72, 0x88, 0x3a, 0x3e,
+	0x92, 0x06, 0xb1, 0x66, 0xfb, 0x01, 0xec, 0x2d, 0x1f, 0x7f, 0x07, 0x40, 0x41, 0x8d, 0xbe, 0x07,
+	0xa8, 0xe8, 0x23, 0x92, 0x1e, 0xa0, 0x62, 0x2f, 0x2c, 0xb2, 0xf5, 0xc6, 0x41, 0x09, 0xbd, 0xf9,
+	0x31, 0xb7, 0xf9, 0x81, 0x86, 0xf6, 0xa6, 0x3e, 0x1e, 0xc1, 0x17, 0xfb, 0x99, 0x3f, 0xf1, 0xd8,
+	0x64, 0x87, 0xb0, 0x97, 0x38, 0xec, 0x2b, 0xf8, 0x72, 0x2f, 0xd8, 0x6c, 0x4f, 0x85, 0x2e, 0x34,
+	0xe2, 0x4d, 0xbf, 0xaa, 0xa1, 0xe9, 0xb0, 0xa4, 0xd4, 0xb7, 0x4d, 0x9e, 0xca, 0xbe, 0x83, 0x50,
+	0x4d, 0xb2, 0x77, 0x40, 0x82, 0x7b, 0x95, 0xa8, 0x45, 0xfe, 0x5e, 0x83, 0x4a, 0x6d, 0xbc, 0x6c,
+	0x9e, 0xb1, 0xef, 0xbb, 0x14, 0xf9, 0x33, 0xf6, 0x7d, 0xb7, 0x9a, 0xbc, 0xfe, 0x0a, 0x47, 0x7d,
+	0x03, 0xaf, 0xa4, 0x86, 0x77, 0x51, 0x48, 0xaa, 0xb8, 0x9e, 0xbc, 0xd2, 0x28, 0x6c, 0xcb, 0x32,
+	0x58, 0xbb, 0xb0, 0x9d, 0x78, 0x34, 0xd0, 0xc6, 0x7f, 0xd0, 0xd0, 0x6c, 0xbc, 0x94, 0x9d, 0x21,
+	0x48, 0x97, 0x8a, 0x7e, 0x86, 0x20, 0xdd, 0xea, 0xe4, 0xfa, 0x3a, 0x17, 0xe4, 0x16, 0xbe, 0x99,
+	0x26, 0xc8, 0x7d, 0x4e, 0x65, 0x28, 0x8f, 0x6d, 0xb7, 0xe5, 0x3b, 0x80, 0x76, 0xdc, 0x95, 0x29,
+	0x25, 0xfd, 0x36, 0xfe, 0xbe, 0x86, 0x26, 0x43, 0xab, 0xc1, 0xa7, 0x32, 0xfd, 0xaa, 0x5a, 0x40,
+	0xcc, 0x3d, 0xdd, 0xcf, 0xd0, 0x7e, 0xac, 0xbb, 0x63, 0x39, 0x85, 0x6d, 0xe5, 0x4e, 0xaf, 0x2d,
+	0x5b, 0x62, 0x7f, 0xb2, 0xac, 0xab, 0x53, 0x80, 0xce, 0x08, 0xc8, 0x89, 0x1a, 0x7a, 0xee, 0x74,
+	0x5f, 0x63, 0xfb, 0x31, 0x72, 0xbe, 0x11, 0x39, 0x2a, 0x3f, 0x8a, 0x15, 0x7f, 0x4f, 0x43, 0x7b,
+	0x62, 0xf5, 0x5c, 0x5c, 0xe8, 0xad, 0xa1, 0x48, 0x91, 0x3a, 0x77, 0xb6, 0x7f, 0x02, 0x40, 0xbb,
+	0xc8, 0xd1, 0x3e, 0x85, 0x8f, 0xf7, 0xd8, 0x92, 0x50, 0xd3, 0xfe, 0xad, 0xac, 0x65, 0x46, 0x6b,
+	0xb5, 0x19, 0xd9, 0x42, 0x6a, 0xf1, 0x38, 0x57, 0xe8, 0x7b, 0x3c, 0xe0, 0xbc, 0xc9, 0x71, 0xae,
+	0xe2, 0xeb, 0x3d, 0x36, 0x21, 0x98, 0x41, 0xea, 0x16, 0x94, 0x97, 0xae, 0x6d, 0x16, 0x4e, 0xf6,
+	0xc4, 0xaa, 0xbc, 0x19, 0x06, 0x91, 0xa8, 0x20, 0x67, 0x18, 0x44, 0xb2, 0x6c, 0xac, 0x5f, 0xe0,
+	0xd0, 0xf3, 0xf8, 0x4c, 0x06, 0x74, 0xc8, 0x73, 0xc2, 0xb2, 0x74, 0x1b, 0xff, 0xbf, 0x86, 0xa6,
+	0xd5, 0xb2, 0x2c, 0xee, 0x7e, 0x68, 0x8a, 0xd6, 0x95, 0x73, 0x27, 0x7b, 0x0f, 0x04, 0x64, 0x4f,
+	0x72, 0x64, 0x0b, 0xf8, 0x60, 0xaa, 0xa9, 0xc2, 0x75, 0x0b, 0xfe, 0x05, 0x58, 0xa6, 0x52, 0x6d,
+	0xed, 0x61, 0x99, 0xc9, 0xba, 0x6e, 0x0f, 0xcb, 0x4c, 0x29, 0xe4, 0xea, 0x57, 0x38, 0xb8, 0x8b,
+	0xf8, 0x99, 0x5e, 0x89, 0x37, 0x2f, 0xda, 0xc6, 0x82, 0xf1, 0x2f, 0xa5, 0x9d, 0x46, 0xeb, 0xaf,
+	0x19, 0x76, 0x9a, 0x5a, 0xe8, 0xcd, 0xb0, 0xd3, 0xf4, 0xc2, 0xae, 0xfe, 0x1c, 0x47, 0x7d, 0x01,
+	0x9f, 0x4f, 0x43, 0x6d, 0xfb, 0xa2, 0x12, 0x66, 0x40, 0xb1, 0x37, 0x06, 0xfa, 0x57, 0x1a, 0x54,
+	0xe2, 0xf9, 0x35, 0x69, 0xa7, 0x22, 0x94, 0xa1, 0xed, 0xf4, 0xda, 0x53, 0x86, 0xb6, 0xbb, 0x14,
+	0x9b, 0xb2, 0xb5, 0xcd, 0xaf, 0x73, 0x0d, 0x28, 0x46, 0xb1, 0x83, 0x6c, 0x0c, 0xf8, 0xef, 0xe4,
+	0x11, 0x3c, 0x51, 0xd8, 0xc9, 0x38, 0x82, 0x77, 0xab, 0x5c, 0x65, 0x1c, 0xc1, 0xbb, 0xd6, 0x8d,
+	0xf4, 0xeb, 0x1c, 0xfe, 0x55, 0xfc, 0x7c, 0x1a, 0x7c, 0xd5, 0x83, 0xf9, 0x06, 0x2f, 0x7c, 0x48,
+	0xe7, 0x6b, 0x5b, 0xed, 0xc2, 0x36, 0x7c, 0x69, 0xe3, 0xf7, 0x34, 0x34, 0x1b, 0xaf, 0x9e, 0x64,
+	0xa4, 0x9a, 0xc9, 0xaa, 0x52, 0x46, 0xce, 0x96, 0x52, 0x90, 0xe9, 0x03, 0x75, 0x0c, 0x6e, 0x32,
+	0xae, 0xf9, 0x6d, 0xb6, 0x3f, 0xe7, 0xd2, 0xca, 0x4d, 0x19, 0x66, 0x93, 0x5e, 0x98, 0xda, 0x21,
+	0xfa, 0x4c, 0x53, 0x57, 0xd1, 0x4b, 0xef, 0x16, 0x16, 0xbd, 0xda, 0xf8, 0xdd, 0x21, 0x74, 0xa2,
+	0xbf, 0x42, 0x0b, 0x5e, 0xca, 0xb8, 0x91, 0xe9, 0xaf, 0xee, 0x94, 0x5b, 0x7e, 0x14, 0x16, 0x20,
+	0x6d, 0x99, 0x4b, 0xfb, 0x9f, 0xf8, 0x6e, 0xfa, 0x25, 0x4f, 0xa4, 0xaa, 0x25, 0x3d, 0x53, 0xac,
+	0x02, 0x54, 0xd8, 0x8e, 0x8d, 0x8b, 0x25, 0x56, 0xf8, 0x6b, 0x1a, 0x9a, 0x56, 0x0b, 0x0d, 0xb8,
+	0xfb, 0x82, 0xa4, 0x14, 0x7c, 0x72, 0x8b, 0x7d, 0x8e, 0x06, 0x89, 0x4e, 0x71, 0x89, 0x8e, 0xe1,
+	0xa3, 0x69, 0x12, 0x51, 0xa0, 0xe0, 0xd7, 0xf9, 0xf8, 0xff, 0x86, 0xd0, 0xf1, 0xbe, 0x1e, 0x79,
+	0xe3, 0x95, 0x7e, 0x0e, 0x80, 0x3d, 0x1f, 0x89, 0xef, 0xf0, 0x1c, 0xb9, 0xc1, 0x25, 0x29, 0xe3,
+	0x37, 0x7b, 0x9d, 0x23, 0x99, 0x25, 0x66, 0x3c, 0x1a, 0x2f, 0x6c, 0x67, 0xbf, 0x28, 0x6f, 0xe3,
+	0xbf, 0x6b, 0xe8, 0x70, 0x8f, 0xb7, 0xe2, 0xf8, 0xc5, 0xfe, 0x8e, 0x7b, 0x5d, 0x5f, 0x99, 0xef,
+	0xf0, 0xe0, 0x77, 0x97, 0x0b, 0xbf, 0x8e, 0x4b, 0xfd, 0x1c, 0xfc, 0xd2, 0xe4, 0xeb, 0x22, 0x35,
+	0x5c, 0xb6, 0xcc, 0xa5, 0x5d, 0xd7, 0xe3, 0x0b, 0xbd, 0x4e, 0x1f, 0x69, 0xe5, 0x8b, 0xdc, 0xc5,
+	0x1d, 0x52, 0x81, 0x84, 0x57, 0xb9, 0x84, 0x97, 0xf0, 0xb3, 0x59, 0xe7, 0x16, 0x28, 0x67, 0x00,
+	0x6d, 0xfc, 0xca, 0xe8, 0xb1, 0xc4, 0xdd, 0x7b, 0x46, 0x64, 0xea, 0x56, 0x26, 0xc8, 0x88, 0x4c,
+	0x5d, 0xaf, 0xf6, 0xf5, 0x1b, 0x1c, 0xfc, 0x12, 0x7e, 0x31, 0x0d, 0xbc, 0xc9, 0xc8, 0xa0, 0xec,
+	0x00, 0xb7, 0xf8, 0x85, 0xed, 0x4e, 0x9d, 0xa1, 0x5d, 0xd8, 0x96, 0x55, 0x84, 0xf6, 0x72, 0xf1,
+	0xc3, 0xcf, 0x17, 0xb4, 0x8f, 0x3e, 0x5f, 0xd0, 0xfe, 0xfc, 0xf9, 0x82, 0xf6, 0xe5, 0x2f, 0x16,
+	0x76, 0x7d, 0xf4, 0xc5, 0xc2, 0xae, 0x3f, 0x7e, 0xb1, 0xb0, 0xeb, 0x6e, 0x41, 0x29, 0x51, 0x95,
+	0x9d, 0xf2, 0x22, 0xe7, 0xa7, 0x4e, 0xf7, 0x20, 0xfa, 0xef, 0x8d, 0xe5, 0x31, 0xfe, 0xaf, 0x8b,
+	0xcf, 0xfc, 0x33, 0x00, 0x00, 0xff, 0xff, 0x3f, 0x65, 0x16, 0xe3, 0x39, 0x3a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
 	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Parameters queries the parameters of the module.
-	QueryParamsByTimestamp(context.Context, *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error)
+	QueryParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error)
 	// Queries a bucket with specify name.
-	HeadBucket(context.Context, *QueryHeadBucketRequest) (*QueryHeadBucketResponse, error)
+	HeadBucket(ctx context.Context, in *QueryHeadBucketRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error)
 	// Queries a bucket by id
-	HeadBucketById(context.Context, *QueryHeadBucketByIdRequest) (*QueryHeadBucketResponse, error)
+	HeadBucketById(ctx context.Context, in *QueryHeadBucketByIdRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error)
 	// Queries a bucket with EIP712 standard metadata info
-	HeadBucketNFT(context.Context, *QueryNFTRequest) (*QueryBucketNFTResponse, error)
+	HeadBucketNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryBucketNFTResponse, error)
 	// Queries a object with specify name.
-	HeadObject(context.Context, *QueryHeadObjectRequest) (*QueryHeadObjectResponse, error)
+	HeadObject(ctx context.Context, in *QueryHeadObjectRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error)
 	// Queries an object by id
-	HeadObjectById(context.Context, *QueryHeadObjectByIdRequest) (*QueryHeadObjectResponse, error)
+	HeadObjectById(ctx context.Context, in *QueryHeadObjectByIdRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error)
 	// Queries a shadow object with specify name.
-	HeadShadowObject(context.Context, *QueryHeadShadowObjectRequest) (*QueryHeadShadowObjectResponse, error)
+	HeadShadowObject(ctx context.Context, in *QueryHeadShadowObjectRequest, opts ...grpc.CallOption) (*QueryHeadShadowObjectResponse, error)
 	// Queries a object with EIP712 standard metadata info
-	HeadObjectNFT(context.Context, *QueryNFTRequest) (*QueryObjectNFTResponse, error)
+	HeadObjectNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryObjectNFTResponse, error)
 	// Queries a list of bucket items.
-	ListBuckets(context.Context, *QueryListBucketsRequest) (*QueryListBucketsResponse, error)
+	ListBuckets(ctx context.Context, in *QueryListBucketsRequest, opts ...grpc.CallOption) (*QueryListBucketsResponse, error)
 	// Queries a list of object items under the bucket.
-	ListObjects(context.Context, *QueryListObjectsRequest) (*QueryListObjectsResponse, error)
+	ListObjects(ctx context.Context, in *QueryListObjectsRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error)
 	// Queries a list of object items under the bucket.
-	ListObjectsByBucketId(context.Context, *QueryListObjectsByBucketIdRequest) (*QueryListObjectsResponse, error)
+	ListObjectsByBucketId(ctx context.Context, in *QueryListObjectsByBucketIdRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error)
 	// Queries a group with EIP712 standard metadata info
-	HeadGroupNFT(context.Context, *QueryNFTRequest) (*QueryGroupNFTResponse, error)
+	HeadGroupNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryGroupNFTResponse, error)
 	// Queries a policy which grants permission to account
-	QueryPolicyForAccount(context.Context, *QueryPolicyForAccountRequest) (*QueryPolicyForAccountResponse, error)
+	QueryPolicyForAccount(ctx context.Context, in *QueryPolicyForAccountRequest, opts ...grpc.CallOption) (*QueryPolicyForAccountResponse, error)
 	// Queries a list of VerifyPermission items.
-	VerifyPermission(context.Context, *QueryVerifyPermissionRequest) (*QueryVerifyPermissionResponse, error)
+	VerifyPermission(ctx context.Context, in *QueryVerifyPermissionRequest, opts ...grpc.CallOption) (*QueryVerifyPermissionResponse, error)
 	// Queries a group with specify owner and name .
-	HeadGroup(context.Context, *QueryHeadGroupRequest) (*QueryHeadGroupResponse, error)
+	HeadGroup(ctx context.Context, in *QueryHeadGroupRequest, opts ...grpc.CallOption) (*QueryHeadGroupResponse, error)
 	// Queries a list of ListGroup items.
-	ListGroups(context.Context, *QueryListGroupsRequest) (*QueryListGroupsResponse, error)
+	ListGroups(ctx context.Context, in *QueryListGroupsRequest, opts ...grpc.CallOption) (*QueryListGroupsResponse, error)
 	// Queries a list of HeadGroupMember items.
-	HeadGroupMember(context.Context, *QueryHeadGroupMemberRequest) (*QueryHeadGroupMemberResponse, error)
+	HeadGroupMember(ctx context.Context, in *QueryHeadGroupMemberRequest, opts ...grpc.CallOption) (*QueryHeadGroupMemberResponse, error)
 	// Queries a policy that grants permission to a group
-	QueryPolicyForGroup(context.Context, *QueryPolicyForGroupRequest) (*QueryPolicyForGroupResponse, error)
+	QueryPolicyForGroup(ctx context.Context, in *QueryPolicyForGroupRequest, opts ...grpc.CallOption) (*QueryPolicyForGroupResponse, error)
 	// Queries a policy by policy id
-	QueryPolicyById(context.Context, *QueryPolicyByIdRequest) (*QueryPolicyByIdResponse, error)
+	QueryPolicyById(ctx context.Context, in *QueryPolicyByIdRequest, opts ...grpc.CallOption) (*QueryPolicyByIdResponse, error)
 	// Queries lock fee for storing an object
-	QueryLockFee(context.Context, *QueryLockFeeRequest) (*QueryLockFeeResponse, error)
+	QueryLockFee(ctx context.Context, in *QueryLockFeeRequest, opts ...grpc.CallOption) (*QueryLockFeeResponse, error)
 	// Queries a bucket extra info (with gvg bindings and price time) with specify name.
-	HeadBucketExtra(context.Context, *QueryHeadBucketExtraRequest) (*QueryHeadBucketExtraResponse, error)
+	HeadBucketExtra(ctx context.Context, in *QueryHeadBucketExtraRequest, opts ...grpc.CallOption) (*QueryHeadBucketExtraResponse, error)
 	// Queries whether read and storage prices changed for the bucket.
-	QueryIsPriceChanged(context.Context, *QueryIsPriceChangedRequest) (*QueryIsPriceChangedResponse, error)
+	QueryIsPriceChanged(ctx context.Context, in *QueryIsPriceChangedRequest, opts ...grpc.CallOption) (*QueryIsPriceChangedResponse, error)
 	// Queries whether read and storage prices changed for the bucket.
-	QueryQuotaUpdateTime(context.Context, *QueryQuoteUpdateTimeRequest) (*QueryQuoteUpdateTimeResponse, error)
+	QueryQuotaUpdateTime(ctx context.Context, in *QueryQuoteUpdateTimeRequest, opts ...grpc.CallOption) (*QueryQuoteUpdateTimeResponse, error)
 	// Queries whether some members are in the group.
-	QueryGroupMembersExist(context.Context, *QueryGroupMembersExistRequest) (*QueryGroupMembersExistResponse, error)
+	QueryGroupMembersExist(ctx context.Context, in *QueryGroupMembersExistRequest, opts ...grpc.CallOption) (*QueryGroupMembersExistResponse, error)
 	// Queries whether some groups are exist.
-	QueryGroupsExist(context.Context, *QueryGroupsExistRequest) (*QueryGroupsExistResponse, error)
+	QueryGroupsExist(ctx context.Context, in *QueryGroupsExistRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error)
 	// Queries whether some groups are exist by id.
-	QueryGroupsExistById(context.Context, *QueryGroupsExistByIdRequest) (*QueryGroupsExistResponse, error)
+	QueryGroupsExistById(ctx context.Context, in *QueryGroupsExistByIdRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error)
 	// Queries the flow rate limit of a bucket for a payment account
-	QueryPaymentAccountBucketFlowRateLimit(context.Context, *QueryPaymentAccountBucketFlowRateLimitRequest) (*QueryPaymentAccountBucketFlowRateLimitResponse, error)
+	QueryPaymentAccountBucketFlowRateLimit(ctx context.Context, in *QueryPaymentAccountBucketFlowRateLimitRequest, opts ...grpc.CallOption) (*QueryPaymentAccountBucketFlowRateLimitResponse, error)
+	// Queries the estimated cost of a prospective bucket with its objects.
+	EstimateCost(ctx context.Context, in *QueryEstimateCostRequest, opts ...grpc.CallOption) (*QueryEstimateCostResponse, error)
+	// Queries a list of buckets served by the global virtual group family.
+	ListBucketsByGlobalVirtualGroupFamily(ctx context.Context, in *QueryListBucketsByGlobalVirtualGroupFamilyRequest, opts ...grpc.CallOption) (*QueryListBucketsResponse, error)
+	// Queries a list of objects stored on the global virtual group.
+	ListObjectsByGlobalVirtualGroup(ctx context.Context, in *QueryListObjectsByGlobalVirtualGroupRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error)
+	// Verifies a piece of an object against the integrity hash of the replica stored on-chain.
+	VerifyPieceIntegrity(ctx context.Context, in *QueryVerifyPieceIntegrityRequest, opts ...grpc.CallOption) (*QueryVerifyPieceIntegrityResponse, error)
+	// Queries a cross-chain package received by the storage channels, decoded with the result of its execution.
+	CrossChainPackage(ctx context.Context, in *QueryCrossChainPackageRequest, opts ...grpc.CallOption) (*QueryCrossChainPackageResponse, error)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) QueryParamsByTimestamp(ctx context.Context, req *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryParamsByTimestamp not implemented")
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
-func (*UnimplementedQueryServer) HeadBucket(ctx context.Context, req *QueryHeadBucketRequest) (*QueryHeadBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadBucket not implemented")
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedQueryServer) HeadBucketById(ctx context.Context, req *QueryHeadBucketByIdRequest) (*QueryHeadBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadBucketById not implemented")
+
+func (c *queryClient) QueryParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error) {
+	out := new(QueryParamsByTimestampResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryParamsByTimestamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedQueryServer) HeadBucketNFT(ctx context.Context, req *QueryNFTRequest) (*QueryBucketNFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadBucketNFT not implemented")
+
+func (c *queryClient) HeadBucket(ctx context.Context, in *QueryHeadBucketRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error) {
+	out := new(QueryHeadBucketResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadBucket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedQueryServer) HeadObject(ctx context.Context, req *QueryHeadObjectRequest) (*QueryHeadObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadObject not implemented")
-}
-func (*UnimplementedQueryServer) HeadObjectById(ctx context.Context, req *QueryHeadObjectByIdRequest) (*QueryHeadObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadObjectById not implemented")
-}
-func (*UnimplementedQueryServer) HeadShadowObject(ctx context.Context, req *QueryHeadShadowObjectRequest) (*QueryHeadShadowObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadShadowObject not implemented")
-}
-func (*UnimplementedQueryServer) HeadObjectNFT(ctx context.Context, req *QueryNFTRequest) (*QueryObjectNFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadObjectNFT not implemented")
-}
-func (*UnimplementedQueryServer) ListBuckets(ctx context.Context, req *QueryListBucketsRequest) (*QueryListBucketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListBuckets not implemented")
-}
-func (*UnimplementedQueryServer) ListObjects(ctx context.Context, req *QueryListObjectsRequest) (*QueryListObjectsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListObjects not implemented")
-}
-func (*UnimplementedQueryServer) ListObjectsByBucketId(ctx context.Context, req *QueryListObjectsByBucketIdRequest) (*QueryListObjectsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListObjectsByBucketId not implemented")
-}
-func (*UnimplementedQueryServer) HeadGroupNFT(ctx context.Context, req *QueryNFTRequest) (*QueryGroupNFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadGroupNFT not implemented")
-}
-func (*UnimplementedQueryServer) QueryPolicyForAccount(ctx context.Context, req *QueryPolicyForAccountRequest) (*QueryPolicyForAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPolicyForAccount not implemented")
-}
-func (*UnimplementedQueryServer) VerifyPermission(ctx context.Context, req *QueryVerifyPermissionRequest) (*QueryVerifyPermissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VerifyPermission not implemented")
-}
-func (*UnimplementedQueryServer) HeadGroup(ctx context.Context, req *QueryHeadGroupRequest) (*QueryHeadGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadGroup not implemented")
-}
-func (*UnimplementedQueryServer) ListGroups(ctx context.Context, req *QueryListGroupsRequest) (*QueryListGroupsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListGroups not implemented")
-}
-func (*UnimplementedQueryServer) HeadGroupMember(ctx context.Context, req *QueryHeadGroupMemberRequest) (*QueryHeadGroupMemberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadGroupMember not implemented")
-}
-func (*UnimplementedQueryServer) QueryPolicyForGroup(ctx context.Context, req *QueryPolicyForGroupRequest) (*QueryPolicyForGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPolicyForGroup not implemented")
-}
-func (*UnimplementedQueryServer) QueryPolicyById(ctx context.Context, req *QueryPolicyByIdRequest) (*QueryPolicyByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPolicyById not implemented")
-}
-func (*UnimplementedQueryServer) QueryLockFee(ctx context.Context, req *QueryLockFeeRequest) (*QueryLockFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryLockFee not implemented")
-}
-func (*UnimplementedQueryServer) HeadBucketExtra(ctx context.Context, req *QueryHeadBucketExtraRequest) (*QueryHeadBucketExtraResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadBucketExtra not implemented")
-}
-func (*UnimplementedQueryServer) QueryIsPriceChanged(ctx context.Context, req *QueryIsPriceChangedRequest) (*QueryIsPriceChangedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryIsPriceChanged not implemented")
-}
-func (*UnimplementedQueryServer) QueryQuotaUpdateTime(ctx context.Context, req *QueryQuoteUpdateTimeRequest) (*QueryQuoteUpdateTimeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryQuotaUpdateTime not implemented")
-}
-func (*UnimplementedQueryServer) QueryGroupMembersExist(ctx context.Context, req *QueryGroupMembersExistRequest) (*QueryGroupMembersExistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryGroupMembersExist not implemented")
-}
-func (*UnimplementedQueryServer) QueryGroupsExist(ctx context.Context, req *QueryGroupsExistRequest) (*QueryGroupsExistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryGroupsExist not implemented")
-}
-func (*UnimplementedQueryServer) QueryGroupsExistById(ctx context.Context, req *QueryGroupsExistByIdRequest) (*QueryGroupsExistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryGroupsExistById not implemented")
-}
-func (*UnimplementedQueryServer) QueryPaymentAccountBucketFlowRateLimit(ctx context.Context, req *QueryPaymentAccountBucketFlowRateLimitRequest) (*QueryPaymentAccountBucketFlowRateLimitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPaymentAccountBucketFlowRateLimit not implemented")
+
+func (c *queryClient) HeadBucketById(ctx context.Context, in *QueryHeadBucketByIdRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error) {
+	out := new(QueryHeadBucketResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadBucketById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (c *queryClient) HeadBucketNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryBucketNFTResponse, error) {
+	out := new(QueryBucketNFTResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadBucketNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) HeadObject(ctx context.Context, in *QueryHeadObjectRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error) {
+	out := new(QueryHeadObjectResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadObject", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryParamsByTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsByTimestampRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) HeadObjectById(ctx context.Context, in *QueryHeadObjectByIdRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error) {
+	out := new(QueryHeadObjectResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadObjectById", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryParamsByTimestamp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/QueryParamsByTimestamp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryParamsByTimestamp(ctx, req.(*QueryParamsByTimestampRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_HeadBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadBucketRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) HeadShadowObject(ctx context.Context, in *QueryHeadShadowObjectRequest, opts ...grpc.CallOption) (*QueryHeadShadowObjectResponse, error) {
+	out := new(QueryHeadShadowObjectResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadShadowObject", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadBucket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/HeadBucket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadBucket(ctx, req.(*QueryHeadBucketRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_HeadBucketById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadBucketByIdRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) HeadObjectNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryObjectNFTResponse, error) {
+	out := new(QueryObjectNFTResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadObjectNFT", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadBucketById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/HeadBucketById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadBucketById(ctx, req.(*QueryHeadBucketByIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_HeadBucketNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNFTRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ListBuckets(ctx context.Context, in *QueryListBucketsRequest, opts ...grpc.CallOption) (*QueryListBucketsResponse, error) {
+	out := new(QueryListBucketsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/ListBuckets", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadBucketNFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/HeadBucketNFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadBucketNFT(ctx, req.(*QueryNFTRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_HeadObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadObjectRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ListObjects(ctx context.Context, in *QueryListObjectsRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error) {
+	out := new(QueryListObjectsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/ListObjects", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/HeadObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadObject(ctx, req.(*QueryHeadObjectRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_HeadObjectById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadObjectByIdRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ListObjectsByBucketId(ctx context.Context, in *QueryListObjectsByBucketIdRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error) {
+	out := new(QueryListObjectsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/ListObjectsByBucketId", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadObjectById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/HeadObjectById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadObjectById(ctx, req.(*QueryHeadObjectByIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_HeadShadowObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadShadowObjectRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) HeadGroupNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryGroupNFTResponse, error) {
+	out := new(QueryGroupNFTResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadGroupNFT", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadShadowObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/HeadShadowObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadShadowObject(ctx, req.(*QueryHeadShadowObjectRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_HeadObjectNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNFTRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryPolicyForAccount(ctx context.Context, in *QueryPolicyForAccountRequest, opts ...grpc.CallOption) (*QueryPolicyForAccountResponse, error) {
+	out := new(QueryPolicyForAccountResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryPolicyForAccount", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadObjectNFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/HeadObjectNFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadObjectNFT(ctx, req.(*QueryNFTRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ListBuckets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListBucketsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) VerifyPermission(ctx context.Context, in *QueryVerifyPermissionRequest, opts ...grpc.CallOption) (*QueryVerifyPermissionResponse, error) {
+	out := new(QueryVerifyPermissionResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/VerifyPermission", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListBuckets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/ListBuckets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListBuckets(ctx, req.(*QueryListBucketsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ListObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListObjectsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) HeadGroup(ctx context.Context, in *QueryHeadGroupRequest, opts ...grpc.CallOption) (*QueryHeadGroupResponse, error) {
+	out := new(QueryHeadGroupResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadGroup", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListObjects(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/ListObjects",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListObjects(ctx, req.(*QueryListObjectsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ListObjectsByBucketId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListObjectsByBucketIdRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ListGroups(ctx context.Context, in *QueryListGroupsRequest, opts ...grpc.CallOption) (*QueryListGroupsResponse, error) {
+	out := new(QueryListGroupsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/ListGroups", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListObjectsByBucketId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/ListObjectsByBucketId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListObjectsByBucketId(ctx, req.(*QueryListObjectsByBucketIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_HeadGroupNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNFTRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) HeadGroupMember(ctx context.Context, in *QueryHeadGroupMemberRequest, opts ...grpc.CallOption) (*QueryHeadGroupMemberResponse, error) {
+	out := new(QueryHeadGroupMemberResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadGroupMember", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadGroupNFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/HeadGroupNFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadGroupNFT(ctx, req.(*QueryNFTRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryPolicyForAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPolicyForAccountRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryPolicyForGroup(ctx context.Context, in *QueryPolicyForGroupRequest, opts ...grpc.CallOption) (*QueryPolicyForGroupResponse, error) {
+	out := new(QueryPolicyForGroupResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryPolicyForGroup", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPolicyForAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/QueryPolicyForAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPolicyForAccount(ctx, req.(*QueryPolicyForAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_VerifyPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVerifyPermissionRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryPolicyById(ctx context.Context, in *QueryPolicyByIdRequest, opts ...grpc.CallOption) (*QueryPolicyByIdResponse, error) {
+	out := new(QueryPolicyByIdResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryPolicyById", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).VerifyPermission(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/VerifyPermission",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VerifyPermission(ctx, req.(*QueryVerifyPermissionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_HeadGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadGroupRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryLockFee(ctx context.Context, in *QueryLockFeeRequest, opts ...grpc.CallOption) (*QueryLockFeeResponse, error) {
+	out := new(QueryLockFeeResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryLockFee", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/HeadGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadGroup(ctx, req.(*QueryHeadGroupRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListGroupsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) HeadBucketExtra(ctx context.Context, in *QueryHeadBucketExtraRequest, opts ...grpc.CallOption) (*QueryHeadBucketExtraResponse, error) {
+	out := new(QueryHeadBucketExtraResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadBucketExtra", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListGroups(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/ListGroups",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListGroups(ctx, req.(*QueryListGroupsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_HeadGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadGroupMemberRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryIsPriceChanged(ctx context.Context, in *QueryIsPriceChangedRequest, opts ...grpc.CallOption) (*QueryIsPriceChangedResponse, error) {
+	out := new(QueryIsPriceChangedResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryIsPriceChanged", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadGroupMember(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/HeadGroupMember",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadGroupMember(ctx, req.(*QueryHeadGroupMemberRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryPolicyForGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPolicyForGroupRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryQuotaUpdateTime(ctx context.Context, in *QueryQuoteUpdateTimeRequest, opts ...grpc.CallOption) (*QueryQuoteUpdateTimeResponse, error) {
+	out := new(QueryQuoteUpdateTimeResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryQuotaUpdateTime", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPolicyForGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/QueryPolicyForGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPolicyForGroup(ctx, req.(*QueryPolicyForGroupRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryPolicyById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPolicyByIdRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryGroupMembersExist(ctx context.Context, in *QueryGroupMembersExistRequest, opts ...grpc.CallOption) (*QueryGroupMembersExistResponse, error) {
+	out := new(QueryGroupMembersExistResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryGroupMembersExist", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPolicyById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/QueryPolicyById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPolicyById(ctx, req.(*QueryPolicyByIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryLockFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLockFeeRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryGroupsExist(ctx context.Context, in *QueryGroupsExistRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error) {
+	out := new(QueryGroupsExistResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryGroupsExist", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryLockFee(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/QueryLockFee",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryLockFee(ctx, req.(*QueryLockFeeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_HeadBucketExtra_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadBucketExtraRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryGroupsExistById(ctx context.Context, in *QueryGroupsExistByIdRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error) {
+	out := new(QueryGroupsExistResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryGroupsExistById", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadBucketExtra(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/HeadBucketExtra",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadBucketExtra(ctx, req.(*QueryHeadBucketExtraRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryIsPriceChanged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIsPriceChangedRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryPaymentAccountBucketFlowRateLimit(ctx context.Context, in *QueryPaymentAccountBucketFlowRateLimitRequest, opts ...grpc.CallOption) (*QueryPaymentAccountBucketFlowRateLimitResponse, error) {
+	out := new(QueryPaymentAccountBucketFlowRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryPaymentAccountBucketFlowRateLimit", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryIsPriceChanged(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/QueryIsPriceChanged",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryIsPriceChanged(ctx, req.(*QueryIsPriceChangedRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryQuotaUpdateTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryQuoteUpdateTimeRequest)
+func (c *queryClient) EstimateCost(ctx context.Context, in *QueryEstimateCostRequest, opts ...grpc.CallOption) (*QueryEstimateCostResponse, error) {
+	out := new(QueryEstimateCostResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/EstimateCost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListBucketsByGlobalVirtualGroupFamily(ctx context.Context, in *QueryListBucketsByGlobalVirtualGroupFamilyRequest, opts ...grpc.CallOption) (*QueryListBucketsResponse, error) {
+	out := new(QueryListBucketsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/ListBucketsByGlobalVirtualGroupFamily", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListObjectsByGlobalVirtualGroup(ctx context.Context, in *QueryListObjectsByGlobalVirtualGroupRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error) {
+	out := new(QueryListObjectsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/ListObjectsByGlobalVirtualGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyPieceIntegrity(ctx context.Context, in *QueryVerifyPieceIntegrityRequest, opts ...grpc.CallOption) (*QueryVerifyPieceIntegrityResponse, error) {
+	out := new(QueryVerifyPieceIntegrityResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/VerifyPieceIntegrity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CrossChainPackage(ctx context.Context, in *QueryCrossChainPackageRequest, opts ...grpc.CallOption) (*QueryCrossChainPackageResponse, error) {
+	out := new(QueryCrossChainPackageResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/CrossChainPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Parameters queries the parameters of the module.
+	QueryParamsByTimestamp(context.Context, *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error)
+	// Queries a bucket with specify name.
+	HeadBucket(context.Context, *QueryHeadBucketRequest) (*QueryHeadBucketResponse, error)
+	// Queries a bucket by id
+	HeadBucketById(context.Context, *QueryHeadBucketByIdRequest) (*QueryHeadBucketResponse, error)
+	// Queries a bucket with EIP712 standard metadata info
+	HeadBucketNFT(context.Context, *QueryNFTRequest) (*QueryBucketNFTResponse, error)
+	// Queries a object with specify name.
+	HeadObject(context.Context, *QueryHeadObjectRequest) (*QueryHeadObjectResponse, error)
+	// Queries an object by id
+	HeadObjectById(context.Context, *QueryHeadObjectByIdRequest) (*QueryHeadObjectResponse, error)
+	// Queries a shadow object with specify name.
+	HeadShadowObject(context.Context, *QueryHeadShadowObjectRequest) (*QueryHeadShadowObjectResponse, error)
+	// Queries a object with EIP712 standard metadata info
+	HeadObjectNFT(context.Context, *QueryNFTRequest) (*QueryObjectNFTResponse, error)
+	// Queries a list of bucket items.
+	ListBuckets(context.Context, *QueryListBucketsRequest) (*QueryListBucketsResponse, error)
+	// Queries a list of object items under the bucket.
+	ListObjects(context.Context, *QueryListObjectsRequest) (*QueryListObjectsResponse, error)
+	// Queries a list of object items under the bucket.
+	ListObjectsByBucketId(context.Context, *QueryListObjectsByBucketIdRequest) (*QueryListObjectsResponse, error)
+	// Queries a group with EIP712 standard metadata info
+	HeadGroupNFT(context.Context, *QueryNFTRequest) (*QueryGroupNFTResponse, error)
+	// Queries a policy which grants permission to account
+	QueryPolicyForAccount(context.Context, *QueryPolicyForAccountRequest) (*QueryPolicyForAccountResponse, error)
+	// Queries a list of VerifyPermission items.
+	VerifyPermission(context.Context, *QueryVerifyPermissionRequest) (*QueryVerifyPermissionResponse, error)
+	// Queries a group with specify owner and name .
+	HeadGroup(context.Context, *QueryHeadGroupRequest) (*QueryHeadGroupResponse, error)
+	// Queries a list of ListGroup items.
+	ListGroups(context.Context, *QueryListGroupsRequest) (*QueryListGroupsResponse, error)
+	// Queries a list of HeadGroupMember items.
+	HeadGroupMember(context.Context, *QueryHeadGroupMemberRequest) (*QueryHeadGroupMemberResponse, error)
+	// Queries a policy that grants permission to a group
+	QueryPolicyForGroup(context.Context, *QueryPolicyForGroupRequest) (*QueryPolicyForGroupResponse, error)
+	// Queries a policy by policy id
+	QueryPolicyById(context.Context, *QueryPolicyByIdRequest) (*QueryPolicyByIdResponse, error)
+	// Queries lock fee for storing an object
+	QueryLockFee(context.Context, *QueryLockFeeRequest) (*QueryLockFeeResponse, error)
+	// Queries a bucket extra info (with gvg bindings and price time) with specify name.
+	HeadBucketExtra(context.Context, *QueryHeadBucketExtraRequest) (*QueryHeadBucketExtraResponse, error)
+	// Queries whether read and storage prices changed for the bucket.
+	QueryIsPriceChanged(context.Context, *QueryIsPriceChangedRequest) (*QueryIsPriceChangedResponse, error)
+	// Queries whether read and storage prices changed for the bucket.
+	QueryQuotaUpdateTime(context.Context, *QueryQuoteUpdateTimeRequest) (*QueryQuoteUpdateTimeResponse, error)
+	// Queries whether some members are in the group.
+	QueryGroupMembersExist(context.Context, *QueryGroupMembersExistRequest) (*QueryGroupMembersExistResponse, error)
+	// Queries whether some groups are exist.
+	QueryGroupsExist(context.Context, *QueryGroupsExistRequest) (*QueryGroupsExistResponse, error)
+	// Queries whether some groups are exist by id.
+	QueryGroupsExistById(context.Context, *QueryGroupsExistByIdRequest) (*QueryGroupsExistResponse, error)
+	// Queries the flow rate limit of a bucket for a payment account
+	QueryPaymentAccountBucketFlowRateLimit(context.Context, *QueryPaymentAccountBucketFlowRateLimitRequest) (*QueryPaymentAccountBucketFlowRateLimitResponse, error)
+	// Queries the estimated cost of a prospective bucket with its objects.
+	EstimateCost(context.Context, *QueryEstimateCostRequest) (*QueryEstimateCostResponse, error)
+	// Queries a list of buckets served by the global virtual group family.
+	ListBucketsByGlobalVirtualGroupFamily(context.Context, *QueryListBucketsByGlobalVirtualGroupFamilyRequest) (*QueryListBucketsResponse, error)
+	// Queries a list of objects stored on the global virtual group.
+	ListObjectsByGlobalVirtualGroup(context.Context, *QueryListObjectsByGlobalVirtualGroupRequest) (*QueryListObjectsResponse, error)
+	// Verifies a piece of an object against the integrity hash of the replica stored on-chain.
+	VerifyPieceIntegrity(context.Context, *QueryVerifyPieceIntegrityRequest) (*QueryVerifyPieceIntegrityResponse, error)
+	// Queries a cross-chain package received by the storage channels, decoded with the result of its execution.
+	CrossChainPackage(context.Context, *QueryCrossChainPackageRequest) (*QueryCrossChainPackageResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) QueryParamsByTimestamp(ctx context.Context, req *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryParamsByTimestamp not implemented")
+}
+func (*UnimplementedQueryServer) HeadBucket(ctx context.Context, req *QueryHeadBucketRequest) (*QueryHeadBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadBucket not implemented")
+}
+func (*UnimplementedQueryServer) HeadBucketById(ctx context.Context, req *QueryHeadBucketByIdRequest) (*QueryHeadBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadBucketById not implemented")
+}
+func (*UnimplementedQueryServer) HeadBucketNFT(ctx context.Context, req *QueryNFTRequest) (*QueryBucketNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadBucketNFT not implemented")
+}
+func (*UnimplementedQueryServer) HeadObject(ctx context.Context, req *QueryHeadObjectRequest) (*QueryHeadObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadObject not implemented")
+}
+func (*UnimplementedQueryServer) HeadObjectById(ctx context.Context, req *QueryHeadObjectByIdRequest) (*QueryHeadObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadObjectById not implemented")
+}
+func (*UnimplementedQueryServer) HeadShadowObject(ctx context.Context, req *QueryHeadShadowObjectRequest) (*QueryHeadShadowObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadShadowObject not implemented")
+}
+func (*UnimplementedQueryServer) HeadObjectNFT(ctx context.Context, req *QueryNFTRequest) (*QueryObjectNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadObjectNFT not implemented")
+}
+func (*UnimplementedQueryServer) ListBuckets(ctx context.Context, req *QueryListBucketsRequest) (*QueryListBucketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBuckets not implemented")
+}
+func (*UnimplementedQueryServer) ListObjects(ctx context.Context, req *QueryListObjectsRequest) (*QueryListObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListObjects not implemented")
+}
+func (*UnimplementedQueryServer) ListObjectsByBucketId(ctx context.Context, req *QueryListObjectsByBucketIdRequest) (*QueryListObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListObjectsByBucketId not implemented")
+}
+func (*UnimplementedQueryServer) HeadGroupNFT(ctx context.Context, req *QueryNFTRequest) (*QueryGroupNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadGroupNFT not implemented")
+}
+func (*UnimplementedQueryServer) QueryPolicyForAccount(ctx context.Context, req *QueryPolicyForAccountRequest) (*QueryPolicyForAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPolicyForAccount not implemented")
+}
+func (*UnimplementedQueryServer) VerifyPermission(ctx context.Context, req *QueryVerifyPermissionRequest) (*QueryVerifyPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyPermission not implemented")
+}
+func (*UnimplementedQueryServer) HeadGroup(ctx context.Context, req *QueryHeadGroupRequest) (*QueryHeadGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadGroup not implemented")
+}
+func (*UnimplementedQueryServer) ListGroups(ctx context.Context, req *QueryListGroupsRequest) (*QueryListGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroups not implemented")
+}
+func (*UnimplementedQueryServer) HeadGroupMember(ctx context.Context, req *QueryHeadGroupMemberRequest) (*QueryHeadGroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadGroupMember not implemented")
+}
+func (*UnimplementedQueryServer) QueryPolicyForGroup(ctx context.Context, req *QueryPolicyForGroupRequest) (*QueryPolicyForGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPolicyForGroup not implemented")
+}
+func (*UnimplementedQueryServer) QueryPolicyById(ctx context.Context, req *QueryPolicyByIdRequest) (*QueryPolicyByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPolicyById not implemented")
+}
+func (*UnimplementedQueryServer) QueryLockFee(ctx context.Context, req *QueryLockFeeRequest) (*QueryLockFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryLockFee not implemented")
+}
+func (*UnimplementedQueryServer) HeadBucketExtra(ctx context.Context, req *QueryHeadBucketExtraRequest) (*QueryHeadBucketExtraResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadBucketExtra not implemented")
+}
+func (*UnimplementedQueryServer) QueryIsPriceChanged(ctx context.Context, req *QueryIsPriceChangedRequest) (*QueryIsPriceChangedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryIsPriceChanged not implemented")
+}
+func (*UnimplementedQueryServer) QueryQuotaUpdateTime(ctx context.Context, req *QueryQuoteUpdateTimeRequest) (*QueryQuoteUpdateTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryQuotaUpdateTime not implemented")
+}
+func (*UnimplementedQueryServer) QueryGroupMembersExist(ctx context.Context, req *QueryGroupMembersExistRequest) (*QueryGroupMembersExistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryGroupMembersExist not implemented")
+}
+func (*UnimplementedQueryServer) QueryGroupsExist(ctx context.Context, req *QueryGroupsExistRequest) (*QueryGroupsExistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryGroupsExist not implemented")
+}
+func (*UnimplementedQueryServer) QueryGroupsExistById(ctx context.Context, req *QueryGroupsExistByIdRequest) (*QueryGroupsExistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryGroupsExistById not implemented")
+}
+func (*UnimplementedQueryServer) QueryPaymentAccountBucketFlowRateLimit(ctx context.Context, req *QueryPaymentAccountBucketFlowRateLimitRequest) (*QueryPaymentAccountBucketFlowRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPaymentAccountBucketFlowRateLimit not implemented")
+}
+func (*UnimplementedQueryServer) EstimateCost(ctx context.Context, req *QueryEstimateCostRequest) (*QueryEstimateCostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateCost not implemented")
+}
+func (*UnimplementedQueryServer) ListBucketsByGlobalVirtualGroupFamily(ctx context.Context, req *QueryListBucketsByGlobalVirtualGroupFamilyRequest) (*QueryListBucketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBucketsByGlobalVirtualGroupFamily not implemented")
+}
+func (*UnimplementedQueryServer) ListObjectsByGlobalVirtualGroup(ctx context.Context, req *QueryListObjectsByGlobalVirtualGroupRequest) (*QueryListObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListObjectsByGlobalVirtualGroup not implemented")
+}
+func (*UnimplementedQueryServer) VerifyPieceIntegrity(ctx context.Context, req *QueryVerifyPieceIntegrityRequest) (*QueryVerifyPieceIntegrityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyPieceIntegrity not implemented")
+}
+func (*UnimplementedQueryServer) CrossChainPackage(ctx context.Context, req *QueryCrossChainPackageRequest) (*QueryCrossChainPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrossChainPackage not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).QueryQuotaUpdateTime(ctx, in)
+		return srv.(QueryServer).Params(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/QueryQuotaUpdateTime",
+		FullMethod: "/greenfield.storage.Query/Params",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryQuotaUpdateTime(ctx, req.(*QueryQuoteUpdateTimeRequest))
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_QueryGroupMembersExist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGroupMembersExistRequest)
+func _Query_QueryParamsByTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsByTimestampRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).QueryGroupMembersExist(ctx, in)
+		return srv.(QueryServer).QueryParamsByTimestamp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/QueryGroupMembersExist",
+		FullMethod: "/greenfield.storage.Query/QueryParamsByTimestamp",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryGroupMembersExist(ctx, req.(*QueryGroupMembersExistRequest))
+		return srv.(QueryServer).QueryParamsByTimestamp(ctx, req.(*QueryParamsByTimestampRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_QueryGroupsExist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGroupsExistRequest)
+func _Query_HeadBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadBucketRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).QueryGroupsExist(ctx, in)
+		return srv.(QueryServer).HeadBucket(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/QueryGroupsExist",
+		FullMethod: "/greenfield.storage.Query/HeadBucket",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryGroupsExist(ctx, req.(*QueryGroupsExistRequest))
+		return srv.(QueryServer).HeadBucket(ctx, req.(*QueryHeadBucketRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_QueryGroupsExistById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGroupsExistByIdRequest)
+func _Query_HeadBucketById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadBucketByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).QueryGroupsExistById(ctx, in)
+		return srv.(QueryServer).HeadBucketById(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/QueryGroupsExistById",
+		FullMethod: "/greenfield.storage.Query/HeadBucketById",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryGroupsExistById(ctx, req.(*QueryGroupsExistByIdRequest))
+		return srv.(QueryServer).HeadBucketById(ctx, req.(*QueryHeadBucketByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_QueryPaymentAccountBucketFlowRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaymentAccountBucketFlowRateLimitRequest)
+func _Query_HeadBucketNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).QueryPaymentAccountBucketFlowRateLimit(ctx, in)
+		return srv.(QueryServer).HeadBucketNFT(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/QueryPaymentAccountBucketFlowRateLimit",
+		FullMethod: "/greenfield.storage.Query/HeadBucketNFT",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPaymentAccountBucketFlowRateLimit(ctx, req.(*QueryPaymentAccountBucketFlowRateLimitRequest))
+		return srv.(QueryServer).HeadBucketNFT(ctx, req.(*QueryNFTRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "greenfield.storage.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "QueryParamsByTimestamp",
-			Handler:    _Query_QueryParamsByTimestamp_Handler,
-		},
-		{
-			MethodName: "HeadBucket",
-			Handler:    _Query_HeadBucket_Handler,
-		},
-		{
-			MethodName: "HeadBucketById",
-			Handler:    _Query_HeadBucketById_Handler,
-		},
-		{
-			MethodName: "HeadBucketNFT",
-			Handler:    _Query_HeadBucketNFT_Handler,
-		},
-		{
-			MethodName: "HeadObject",
-			Handler:    _Query_HeadObject_Handler,
-		},
-		{
-			MethodName: "HeadObjectById",
-			Handler:    _Query_HeadObjectById_Handler,
-		},
-		{
-			MethodName: "HeadShadowObject",
-			Handler:    _Query_HeadShadowObject_Handler,
-		},
-		{
-			MethodName: "HeadObjectNFT",
-			Handler:    _Query_HeadObjectNFT_Handler,
-		},
-		{
-			MethodName: "ListBuckets",
-			Handler:    _Query_ListBuckets_Handler,
-		},
-		{
-			MethodName: "ListObjects",
-			Handler:    _Query_ListObjects_Handler,
-		},
-		{
-			MethodName: "ListObjectsByBucketId",
-			Handler:    _Query_ListObjectsByBucketId_Handler,
-		},
-		{
-			MethodName: "HeadGroupNFT",
-			Handler:    _Query_HeadGroupNFT_Handler,
-		},
-		{
-			MethodName: "QueryPolicyForAccount",
-			Handler:    _Query_QueryPolicyForAccount_Handler,
-		},
-		{
-			MethodName: "VerifyPermission",
-			Handler:    _Query_VerifyPermission_Handler,
-		},
-		{
-			MethodName: "HeadGroup",
-			Handler:    _Query_HeadGroup_Handler,
-		},
-		{
-			MethodName: "ListGroups",
-			Handler:    _Query_ListGroups_Handler,
-		},
-		{
-			MethodName: "HeadGroupMember",
-			Handler:    _Query_HeadGroupMember_Handler,
-		},
-		{
-			MethodName: "QueryPolicyForGroup",
-			Handler:    _Query_QueryPolicyForGroup_Handler,
-		},
-		{
-			MethodName: "QueryPolicyById",
-			Handler:    _Query_QueryPolicyById_Handler,
-		},
-		{
-			MethodName: "QueryLockFee",
-			Handler:    _Query_QueryLockFee_Handler,
-		},
-		{
-			MethodName: "HeadBucketExtra",
-			Handler:    _Query_HeadBucketExtra_Handler,
-		},
-		{
-			MethodName: "QueryIsPriceChanged",
-			Handler:    _Query_QueryIsPriceChanged_Handler,
-		},
-		{
-			MethodName: "QueryQuotaUpdateTime",
-			Handler:    _Query_QueryQuotaUpdateTime_Handler,
-		},
-		{
-			MethodName: "QueryGroupMembersExist",
-			Handler:    _Query_QueryGroupMembersExist_Handler,
-		},
-		{
-			MethodName: "QueryGroupsExist",
-			Handler:    _Query_QueryGroupsExist_Handler,
-		},
-		{
-			MethodName: "QueryGroupsExistById",
-			Handler:    _Query_QueryGroupsExistById_Handler,
-		},
-		{
-			MethodName: "QueryPaymentAccountBucketFlowRateLimit",
-			Handler:    _Query_QueryPaymentAccountBucketFlowRateLimit_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "greenfield/storage/query.proto",
-}
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_HeadObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadObjectRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).HeadObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/HeadObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadObject(ctx, req.(*QueryHeadObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_HeadObjectById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadObjectByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeadObjectById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/HeadObjectById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadObjectById(ctx, req.(*QueryHeadObjectByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_HeadShadowObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadShadowObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeadShadowObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/HeadShadowObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadShadowObject(ctx, req.(*QueryHeadShadowObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_HeadObjectNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).HeadObjectNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/HeadObjectNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadObjectNFT(ctx, req.(*QueryNFTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ListBuckets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListBucketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListBuckets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/ListBuckets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListBuckets(ctx, req.(*QueryListBucketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_ListObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ListObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/ListObjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListObjects(ctx, req.(*QueryListObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsByTimestampRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ListObjectsByBucketId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListObjectsByBucketIdRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ListObjectsByBucketId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/ListObjectsByBucketId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListObjectsByBucketId(ctx, req.(*QueryListObjectsByBucketIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsByTimestampRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsByTimestampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x8
+func _Query_HeadGroupNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).HeadGroupNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/HeadGroupNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadGroupNFT(ctx, req.(*QueryNFTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsByTimestampResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryPolicyForAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPolicyForAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsByTimestampResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPolicyForAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryPolicyForAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPolicyForAccount(ctx, req.(*QueryPolicyForAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsByTimestampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_VerifyPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/VerifyPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyPermission(ctx, req.(*QueryVerifyPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadBucketRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_HeadGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadGroupRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).HeadGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/HeadGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadGroup(ctx, req.(*QueryHeadGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadBucketRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/ListGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListGroups(ctx, req.(*QueryListGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadBucketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0xa
+func _Query_HeadGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadGroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).HeadGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/HeadGroupMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadGroupMember(ctx, req.(*QueryHeadGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadBucketByIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryPolicyForGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPolicyForGroupRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPolicyForGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryPolicyForGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPolicyForGroup(ctx, req.(*QueryPolicyForGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadBucketByIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryPolicyById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPolicyByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPolicyById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryPolicyById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPolicyById(ctx, req.(*QueryPolicyByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadBucketByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BucketId) > 0 {
-		i -= len(m.BucketId)
-		copy(dAtA[i:], m.BucketId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketId)))
-		i--
-		dAtA[i] = 0xa
+func _Query_QueryLockFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryLockFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryLockFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryLockFee(ctx, req.(*QueryLockFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadBucketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_HeadBucketExtra_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadBucketExtraRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).HeadBucketExtra(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/HeadBucketExtra",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadBucketExtra(ctx, req.(*QueryHeadBucketExtraRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadBucketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryIsPriceChanged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsPriceChangedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryIsPriceChanged(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryIsPriceChanged",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryIsPriceChanged(ctx, req.(*QueryIsPriceChangedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExtraInfo != nil {
-		{
-			size, err := m.ExtraInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_QueryQuotaUpdateTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteUpdateTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.BucketInfo != nil {
-		{
-			size, err := m.BucketInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).QueryQuotaUpdateTime(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryQuotaUpdateTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryQuotaUpdateTime(ctx, req.(*QueryQuoteUpdateTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadObjectRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryGroupMembersExist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupMembersExistRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryHeadObjectRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryHeadObjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).QueryGroupMembersExist(ctx, in)
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryGroupMembersExist",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryGroupMembersExist(ctx, req.(*QueryGroupMembersExistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadObjectByIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryGroupsExist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupsExistRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryGroupsExist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryGroupsExist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryGroupsExist(ctx, req.(*QueryGroupsExistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadObjectByIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryGroupsExistById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupsExistByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryGroupsExistById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryGroupsExistById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryGroupsExistById(ctx, req.(*QueryGroupsExistByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadObjectByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ObjectId) > 0 {
-		i -= len(m.ObjectId)
-		copy(dAtA[i:], m.ObjectId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectId)))
-		i--
-		dAtA[i] = 0xa
+func _Query_QueryPaymentAccountBucketFlowRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentAccountBucketFlowRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPaymentAccountBucketFlowRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryPaymentAccountBucketFlowRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPaymentAccountBucketFlowRateLimit(ctx, req.(*QueryPaymentAccountBucketFlowRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadShadowObjectRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_EstimateCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateCostRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/EstimateCost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateCost(ctx, req.(*QueryEstimateCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadShadowObjectRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ListBucketsByGlobalVirtualGroupFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListBucketsByGlobalVirtualGroupFamilyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListBucketsByGlobalVirtualGroupFamily(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/ListBucketsByGlobalVirtualGroupFamily",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListBucketsByGlobalVirtualGroupFamily(ctx, req.(*QueryListBucketsByGlobalVirtualGroupFamilyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadShadowObjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x12
+func _Query_ListObjectsByGlobalVirtualGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListObjectsByGlobalVirtualGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).ListObjectsByGlobalVirtualGroup(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/ListObjectsByGlobalVirtualGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListObjectsByGlobalVirtualGroup(ctx, req.(*QueryListObjectsByGlobalVirtualGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadObjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_VerifyPieceIntegrity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyPieceIntegrityRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyPieceIntegrity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/VerifyPieceIntegrity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyPieceIntegrity(ctx, req.(*QueryVerifyPieceIntegrityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadObjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_CrossChainPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCrossChainPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CrossChainPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/CrossChainPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CrossChainPackage(ctx, req.(*QueryCrossChainPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GlobalVirtualGroup != nil {
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "greenfield.storage.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			size, err := m.GlobalVirtualGroup.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ObjectInfo != nil {
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 		{
-			size, err := m.ObjectInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+			MethodName: "QueryParamsByTimestamp",
+			Handler:    _Query_QueryParamsByTimestamp_Handler,
+		},
+		{
+			MethodName: "HeadBucket",
+			Handler:    _Query_HeadBucket_Handler,
+		},
+		{
+			MethodName: "HeadBucketById",
+			Handler:    _Query_HeadBucketById_Handler,
+		},
+		{
+			MethodName: "HeadBucketNFT",
+			Handler:    _Query_HeadBucketNFT_Handler,
+		},
+		{
+			MethodName: "HeadObject",
+			Handler:    _Query_HeadObject_Handler,
+		},
+		{
+			MethodName: "HeadObjectById",
+			Handler:    _Query_HeadObjectById_Handler,
+		},
+		{
+			MethodName: "HeadShadowObject",
+			Handler:    _Query_HeadShadowObject_Handler,
+		},
+		{
+			MethodName: "HeadObjectNFT",
+			Handler:    _Query_HeadObjectNFT_Handler,
+		},
+		{
+			MethodName: "ListBuckets",
+			Handler:    _Query_ListBuckets_Handler,
+		},
+		{
+			MethodName: "ListObjects",
+			Handler:    _Query_ListObjects_Handler,
+		},
+		{
+			MethodName: "ListObjectsByBucketId",
+			Handler:    _Query_ListObjectsByBucketId_Handler,
+		},
+		{
+			MethodName: "HeadGroupNFT",
+			Handler:    _Query_HeadGroupNFT_Handler,
+		},
+		{
+			MethodName: "QueryPolicyForAccount",
+			Handler:    _Query_QueryPolicyForAccount_Handler,
+		},
+		{
+			MethodName: "VerifyPermission",
+			Handler:    _Query_VerifyPermission_Handler,
+		},
+		{
+			MethodName: "HeadGroup",
+			Handler:    _Query_HeadGroup_Handler,
+		},
+		{
+			MethodName: "ListGroups",
+			Handler:    _Query_ListGroups_Handler,
+		},
+		{
+			MethodName: "HeadGroupMember",
+			Handler:    _Query_HeadGroupMember_Handler,
+		},
+		{
+			MethodName: "QueryPolicyForGroup",
+			Handler:    _Query_QueryPolicyForGroup_Handler,
+		},
+		{
+			MethodName: "QueryPolicyById",
+			Handler:    _Query_QueryPolicyById_Handler,
+		},
+		{
+			MethodName: "QueryLockFee",
+			Handler:    _Query_QueryLockFee_Handler,
+		},
+		{
+			MethodName: "HeadBucketExtra",
+			Handler:    _Query_HeadBucketExtra_Handler,
+		},
+		{
+			MethodName: "QueryIsPriceChanged",
+			Handler:    _Query_QueryIsPriceChanged_Handler,
+		},
+		{
+			MethodName: "QueryQuotaUpdateTime",
+			Handler:    _Query_QueryQuotaUpdateTime_Handler,
+		},
+		{
+			MethodName: "QueryGroupMembersExist",
+			Handler:    _Query_QueryGroupMembersExist_Handler,
+		},
+		{
+			MethodName: "QueryGroupsExist",
+			Handler:    _Query_QueryGroupsExist_Handler,
+		},
+		{
+			MethodName: "QueryGroupsExistById",
+			Handler:    _Query_QueryGroupsExistById_Handler,
+		},
+		{
+			MethodName: "QueryPaymentAccountBucketFlowRateLimit",
+			Handler:    _Query_QueryPaymentAccountBucketFlowRateLimit_Handler,
+		},
+		{
+			MethodName: "EstimateCost",
+			Handler:    _Query_EstimateCost_Handler,
+		},
+		{
+			MethodName: "ListBucketsByGlobalVirtualGroupFamily",
+			Handler:    _Query_ListBucketsByGlobalVirtualGroupFamily_Handler,
+		},
+		{
+			MethodName: "ListObjectsByGlobalVirtualGroup",
+			Handler:    _Query_ListObjectsByGlobalVirtualGroup_Handler,
+		},
+		{
+			MethodName: "VerifyPieceIntegrity",
+			Handler:    _Query_VerifyPieceIntegrity_Handler,
+		},
+		{
+			MethodName: "CrossChainPackage",
+			Handler:    _Query_CrossChainPackage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "greenfield/storage/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadShadowObjectResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4171,32 +4633,30 @@ func (m *QueryHeadShadowObjectResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHeadShadowObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadShadowObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ObjectInfo != nil {
-		{
-			size, err := m.ObjectInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListBucketsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsByTimestampRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4206,32 +4666,25 @@ func (m *QueryListBucketsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListBucketsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListBucketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListBucketsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsByTimestampResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4241,46 +4694,30 @@ func (m *QueryListBucketsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListBucketsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListBucketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BucketInfos) > 0 {
-		for iNdEx := len(m.BucketInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BucketInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListObjectsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadBucketRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4290,12 +4727,12 @@ func (m *QueryListObjectsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadBucketRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListObjectsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadBucketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4305,24 +4742,12 @@ func (m *QueryListObjectsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		copy(dAtA[i:], m.BucketName)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListObjectsByBucketIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadBucketByIdRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4332,12 +4757,12 @@ func (m *QueryListObjectsByBucketIdRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListObjectsByBucketIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadBucketByIdRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListObjectsByBucketIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadBucketByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4347,24 +4772,12 @@ func (m *QueryListObjectsByBucketIdRequest) MarshalToSizedBuffer(dAtA []byte) (i
 		copy(dAtA[i:], m.BucketId)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketId)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListObjectsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadBucketResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4374,19 +4787,19 @@ func (m *QueryListObjectsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadBucketResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListObjectsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if m.ExtraInfo != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExtraInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4396,24 +4809,22 @@ func (m *QueryListObjectsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.ObjectInfos) > 0 {
-		for iNdEx := len(m.ObjectInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ObjectInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.BucketInfo != nil {
+		{
+			size, err := m.BucketInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryNFTRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadObjectRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4423,27 +4834,34 @@ func (m *QueryNFTRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryNFTRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadObjectRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryNFTRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadObjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TokenId) > 0 {
-		i -= len(m.TokenId)
-		copy(dAtA[i:], m.TokenId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBucketNFTResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadObjectByIdRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4453,32 +4871,27 @@ func (m *QueryBucketNFTResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBucketNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadObjectByIdRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBucketNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadObjectByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MetaData != nil {
-		{
-			size, err := m.MetaData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryObjectNFTResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadShadowObjectRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4488,32 +4901,34 @@ func (m *QueryObjectNFTResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryObjectNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadShadowObjectRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryObjectNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadShadowObjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MetaData != nil {
-		{
-			size, err := m.MetaData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupNFTResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadObjectResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4523,19 +4938,31 @@ func (m *QueryGroupNFTResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadObjectResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MetaData != nil {
+	if m.GlobalVirtualGroup != nil {
 		{
-			size, err := m.MetaData.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.GlobalVirtualGroup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ObjectInfo != nil {
+		{
+			size, err := m.ObjectInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4548,7 +4975,7 @@ func (m *QueryGroupNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPolicyForAccountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadShadowObjectResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4558,34 +4985,32 @@ func (m *QueryPolicyForAccountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPolicyForAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadShadowObjectResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPolicyForAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadShadowObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PrincipalAddress) > 0 {
-		i -= len(m.PrincipalAddress)
-		copy(dAtA[i:], m.PrincipalAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrincipalAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Resource) > 0 {
-		i -= len(m.Resource)
-		copy(dAtA[i:], m.Resource)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Resource)))
+	if m.ObjectInfo != nil {
+		{
+			size, err := m.ObjectInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPolicyForAccountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryListBucketsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4595,19 +5020,19 @@ func (m *QueryPolicyForAccountResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPolicyForAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListBucketsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPolicyForAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListBucketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Policy != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4620,7 +5045,7 @@ func (m *QueryPolicyForAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryVerifyPermissionRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryListBucketsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4630,46 +5055,46 @@ func (m *QueryVerifyPermissionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVerifyPermissionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListBucketsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVerifyPermissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListBucketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ActionType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ActionType))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.BucketInfos) > 0 {
+		for iNdEx := len(m.BucketInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BucketInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryVerifyPermissionResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryListObjectsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4679,25 +5104,39 @@ func (m *QueryVerifyPermissionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVerifyPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVerifyPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListObjectsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Effect != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Effect))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadGroupRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryListObjectsByBucketIdRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4707,34 +5146,39 @@ func (m *QueryHeadGroupRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHeadGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListObjectsByBucketIdRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListObjectsByBucketIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupName)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.BucketId) > 0 {
+		i -= len(m.BucketId)
+		copy(dAtA[i:], m.BucketId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.GroupOwner) > 0 {
-		i -= len(m.GroupOwner)
-		copy(dAtA[i:], m.GroupOwner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadGroupResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryListObjectsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4744,19 +5188,19 @@ func (m *QueryHeadGroupResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHeadGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListObjectsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GroupInfo != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.GroupInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4764,12 +5208,26 @@ func (m *QueryHeadGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.ObjectInfos) > 0 {
+		for iNdEx := len(m.ObjectInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObjectInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListGroupsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryListBucketsByGlobalVirtualGroupFamilyRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4779,22 +5237,20 @@ func (m *QueryListGroupsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListBucketsByGlobalVirtualGroupFamilyRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListBucketsByGlobalVirtualGroupFamilyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GroupOwner) > 0 {
-		i -= len(m.GroupOwner)
-		copy(dAtA[i:], m.GroupOwner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if m.Pagination != nil {
 		{
@@ -4811,7 +5267,7 @@ func (m *QueryListGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListGroupsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryListObjectsByGlobalVirtualGroupRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4821,29 +5277,20 @@ func (m *QueryListGroupsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListObjectsByGlobalVirtualGroupRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListObjectsByGlobalVirtualGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GroupInfos) > 0 {
-		for iNdEx := len(m.GroupInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GroupInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.Pagination != nil {
 		{
@@ -4860,7 +5307,7 @@ func (m *QueryListGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadGroupMemberRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryNFTRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4870,41 +5317,27 @@ func (m *QueryHeadGroupMemberRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHeadGroupMemberRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryNFTRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadGroupMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryNFTRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.GroupOwner) > 0 {
-		i -= len(m.GroupOwner)
-		copy(dAtA[i:], m.GroupOwner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Member) > 0 {
-		i -= len(m.Member)
-		copy(dAtA[i:], m.Member)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Member)))
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadGroupMemberResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBucketNFTResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4914,19 +5347,19 @@ func (m *QueryHeadGroupMemberResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHeadGroupMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBucketNFTResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadGroupMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBucketNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GroupMember != nil {
+	if m.MetaData != nil {
 		{
-			size, err := m.GroupMember.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MetaData.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4939,7 +5372,7 @@ func (m *QueryHeadGroupMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPolicyForGroupRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryObjectNFTResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4949,34 +5382,32 @@ func (m *QueryPolicyForGroupRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPolicyForGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryObjectNFTResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPolicyForGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryObjectNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PrincipalGroupId) > 0 {
-		i -= len(m.PrincipalGroupId)
-		copy(dAtA[i:], m.PrincipalGroupId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrincipalGroupId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Resource) > 0 {
-		i -= len(m.Resource)
-		copy(dAtA[i:], m.Resource)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Resource)))
+	if m.MetaData != nil {
+		{
+			size, err := m.MetaData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPolicyForGroupResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGroupNFTResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4986,19 +5417,19 @@ func (m *QueryPolicyForGroupResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPolicyForGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGroupNFTResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPolicyForGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGroupNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Policy != nil {
+	if m.MetaData != nil {
 		{
-			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MetaData.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5011,7 +5442,7 @@ func (m *QueryPolicyForGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPolicyByIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPolicyForAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5021,27 +5452,34 @@ func (m *QueryPolicyByIdRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPolicyByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPolicyForAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPolicyByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPolicyForAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PolicyId) > 0 {
-		i -= len(m.PolicyId)
-		copy(dAtA[i:], m.PolicyId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PolicyId)))
+	if len(m.PrincipalAddress) > 0 {
+		i -= len(m.PrincipalAddress)
+		copy(dAtA[i:], m.PrincipalAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrincipalAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Resource)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPolicyByIdResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPolicyForAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5051,12 +5489,12 @@ func (m *QueryPolicyByIdResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPolicyByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPolicyForAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPolicyByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPolicyForAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5076,7 +5514,7 @@ func (m *QueryPolicyByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLockFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryVerifyPermissionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5086,37 +5524,46 @@ func (m *QueryLockFeeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLockFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVerifyPermissionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLockFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVerifyPermissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PayloadSize != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PayloadSize))
+	if m.ActionType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActionType))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x20
 	}
-	if m.CreateAt != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CreateAt))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
-	if len(m.PrimarySpAddress) > 0 {
-		i -= len(m.PrimarySpAddress)
-		copy(dAtA[i:], m.PrimarySpAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrimarySpAddress)))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLockFeeResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryVerifyPermissionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5126,30 +5573,25 @@ func (m *QueryLockFeeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLockFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVerifyPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLockFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVerifyPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Effect != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Effect))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadBucketExtraRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadGroupRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5159,27 +5601,34 @@ func (m *QueryHeadBucketExtraRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHeadBucketExtraRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadGroupRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadBucketExtraRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GroupOwner) > 0 {
+		i -= len(m.GroupOwner)
+		copy(dAtA[i:], m.GroupOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadBucketExtraResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadGroupResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5189,19 +5638,19 @@ func (m *QueryHeadBucketExtraResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHeadBucketExtraResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadGroupResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadBucketExtraResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ExtraInfo != nil {
+	if m.GroupInfo != nil {
 		{
-			size, err := m.ExtraInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.GroupInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5214,7 +5663,7 @@ func (m *QueryHeadBucketExtraResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsPriceChangedRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryListGroupsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5224,27 +5673,39 @@ func (m *QueryIsPriceChangedRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryIsPriceChangedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIsPriceChangedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+	if len(m.GroupOwner) > 0 {
+		i -= len(m.GroupOwner)
+		copy(dAtA[i:], m.GroupOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsPriceChangedResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryListGroupsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5254,110 +5715,46 @@ func (m *QueryIsPriceChangedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryIsPriceChangedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIsPriceChangedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.NewValidatorTaxRate.Size()
-		i -= size
-		if _, err := m.NewValidatorTaxRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.NewSecondaryStorePrice.Size()
-		i -= size
-		if _, err := m.NewSecondaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.NewPrimaryStorePrice.Size()
-		i -= size
-		if _, err := m.NewPrimaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.NewReadPrice.Size()
-		i -= size
-		if _, err := m.NewReadPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.CurrentValidatorTaxRate.Size()
-		i -= size
-		if _, err := m.CurrentValidatorTaxRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.CurrentSecondaryStorePrice.Size()
-		i -= size
-		if _, err := m.CurrentSecondaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.CurrentPrimaryStorePrice.Size()
-		i -= size
-		if _, err := m.CurrentPrimaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.CurrentReadPrice.Size()
-		i -= size
-		if _, err := m.CurrentReadPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.GroupInfos) > 0 {
+		for iNdEx := len(m.GroupInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.Changed {
-		i--
-		if m.Changed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryQuoteUpdateTimeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadGroupMemberRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5367,27 +5764,41 @@ func (m *QueryQuoteUpdateTimeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryQuoteUpdateTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadGroupMemberRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryQuoteUpdateTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadGroupMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GroupOwner) > 0 {
+		i -= len(m.GroupOwner)
+		copy(dAtA[i:], m.GroupOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Member)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryQuoteUpdateTimeResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadGroupMemberResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5397,25 +5808,32 @@ func (m *QueryQuoteUpdateTimeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryQuoteUpdateTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadGroupMemberResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryQuoteUpdateTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadGroupMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UpdateAt != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.UpdateAt))
+	if m.GroupMember != nil {
+		{
+			size, err := m.GroupMember.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupMembersExistRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPolicyForGroupRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5425,36 +5843,34 @@ func (m *QueryGroupMembersExistRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupMembersExistRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPolicyForGroupRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupMembersExistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPolicyForGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Members[iNdEx])
-			copy(dAtA[i:], m.Members[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Members[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.PrincipalGroupId) > 0 {
+		i -= len(m.PrincipalGroupId)
+		copy(dAtA[i:], m.PrincipalGroupId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrincipalGroupId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.GroupId) > 0 {
-		i -= len(m.GroupId)
-		copy(dAtA[i:], m.GroupId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupId)))
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Resource)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupMembersExistResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPolicyForGroupResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5464,42 +5880,32 @@ func (m *QueryGroupMembersExistResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupMembersExistResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPolicyForGroupResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupMembersExistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPolicyForGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Exists) > 0 {
-		for k := range m.Exists {
-			v := m.Exists[k]
-			baseI := i
-			i--
-			if v {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupsExistRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPolicyByIdRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5509,36 +5915,27 @@ func (m *QueryGroupsExistRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupsExistRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPolicyByIdRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupsExistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPolicyByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GroupNames) > 0 {
-		for iNdEx := len(m.GroupNames) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.GroupNames[iNdEx])
-			copy(dAtA[i:], m.GroupNames[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupNames[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.GroupOwner) > 0 {
-		i -= len(m.GroupOwner)
-		copy(dAtA[i:], m.GroupOwner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
+	if len(m.PolicyId) > 0 {
+		i -= len(m.PolicyId)
+		copy(dAtA[i:], m.PolicyId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PolicyId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupsExistByIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPolicyByIdResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5548,29 +5945,32 @@ func (m *QueryGroupsExistByIdRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupsExistByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPolicyByIdResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupsExistByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPolicyByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GroupIds) > 0 {
-		for iNdEx := len(m.GroupIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.GroupIds[iNdEx])
-			copy(dAtA[i:], m.GroupIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupsExistResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryLockFeeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5580,42 +5980,70 @@ func (m *QueryGroupsExistResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupsExistResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLockFeeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupsExistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLockFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Exists) > 0 {
-		for k := range m.Exists {
-			v := m.Exists[k]
-			baseI := i
-			i--
-			if v {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+	if m.PayloadSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CreateAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreateAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PrimarySpAddress) > 0 {
+		i -= len(m.PrimarySpAddress)
+		copy(dAtA[i:], m.PrimarySpAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrimarySpAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadBucketExtraRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5625,12 +6053,12 @@ func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Marshal() (dAtA []byte,
 	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentAccountBucketFlowRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadBucketExtraRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentAccountBucketFlowRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadBucketExtraRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5640,26 +6068,12 @@ func (m *QueryPaymentAccountBucketFlowRateLimitRequest) MarshalToSizedBuffer(dAt
 		copy(dAtA[i:], m.BucketName)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BucketOwner) > 0 {
-		i -= len(m.BucketOwner)
-		copy(dAtA[i:], m.BucketOwner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketOwner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PaymentAccount) > 0 {
-		i -= len(m.PaymentAccount)
-		copy(dAtA[i:], m.PaymentAccount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PaymentAccount)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadBucketExtraResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5669,792 +6083,1262 @@ func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Marshal() (dAtA []byte,
 	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentAccountBucketFlowRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadBucketExtraResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentAccountBucketFlowRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadBucketExtraResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.FlowRateLimit.Size()
-		i -= size
-		if _, err := m.FlowRateLimit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.IsSet {
-		i--
-		if m.IsSet {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.ExtraInfo != nil {
+		{
+			size, err := m.ExtraInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIsPriceChangedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryIsPriceChangedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsByTimestampRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryIsPriceChangedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovQuery(uint64(m.Timestamp))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsByTimestampResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIsPriceChangedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryHeadBucketRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryIsPriceChangedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadBucketByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryIsPriceChangedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BucketId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.NewValidatorTaxRate.Size()
+		i -= size
+		if _, err := m.NewValidatorTaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *QueryHeadBucketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.NewSecondaryStorePrice.Size()
+		i -= size
+		if _, err := m.NewSecondaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if m.BucketInfo != nil {
-		l = m.BucketInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.NewPrimaryStorePrice.Size()
+		i -= size
+		if _, err := m.NewPrimaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.ExtraInfo != nil {
-		l = m.ExtraInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.NewReadPrice.Size()
+		i -= size
+		if _, err := m.NewReadPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *QueryHeadObjectRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.CurrentValidatorTaxRate.Size()
+		i -= size
+		if _, err := m.CurrentValidatorTaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CurrentSecondaryStorePrice.Size()
+		i -= size
+		if _, err := m.CurrentSecondaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CurrentPrimaryStorePrice.Size()
+		i -= size
+		if _, err := m.CurrentPrimaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *QueryHeadObjectByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CurrentReadPrice.Size()
+		i -= size
+		if _, err := m.CurrentReadPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.ObjectId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if m.Changed {
+		i--
+		if m.Changed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadShadowObjectRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryQuoteUpdateTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryHeadObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ObjectInfo != nil {
-		l = m.ObjectInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.GlobalVirtualGroup != nil {
-		l = m.GlobalVirtualGroup.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryQuoteUpdateTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadShadowObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryQuoteUpdateTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ObjectInfo != nil {
-		l = m.ObjectInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListBucketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryQuoteUpdateTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteUpdateTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteUpdateTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.UpdateAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpdateAt))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListBucketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGroupMembersExistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupMembersExistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupMembersExistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BucketInfos) > 0 {
-		for _, e := range m.BucketInfos {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.GroupId) > 0 {
+		i -= len(m.GroupId)
+		copy(dAtA[i:], m.GroupId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListObjectsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGroupMembersExistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryListObjectsByBucketIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGroupMembersExistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupMembersExistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.BucketId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Exists) > 0 {
+		for k := range m.Exists {
+			v := m.Exists[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListObjectsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGroupsExistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupsExistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupsExistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ObjectInfos) > 0 {
-		for _, e := range m.ObjectInfos {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.GroupNames) > 0 {
+		for iNdEx := len(m.GroupNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GroupNames[iNdEx])
+			copy(dAtA[i:], m.GroupNames[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupNames[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.GroupOwner) > 0 {
+		i -= len(m.GroupOwner)
+		copy(dAtA[i:], m.GroupOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryNFTRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TokenId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGroupsExistByIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBucketNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MetaData != nil {
-		l = m.MetaData.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryGroupsExistByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryObjectNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGroupsExistByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MetaData != nil {
-		l = m.MetaData.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.GroupIds) > 0 {
+		for iNdEx := len(m.GroupIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GroupIds[iNdEx])
+			copy(dAtA[i:], m.GroupIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MetaData != nil {
-		l = m.MetaData.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGroupsExistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPolicyForAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Resource)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.PrincipalAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryGroupsExistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPolicyForAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGroupsExistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Policy != nil {
-		l = m.Policy.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Exists) > 0 {
+		for k := range m.Exists {
+			v := m.Exists[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryVerifyPermissionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentAccountBucketFlowRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAccountBucketFlowRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ActionType != 0 {
-		n += 1 + sovQuery(uint64(m.ActionType))
+	if len(m.BucketOwner) > 0 {
+		i -= len(m.BucketOwner)
+		copy(dAtA[i:], m.BucketOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketOwner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.PaymentAccount) > 0 {
+		i -= len(m.PaymentAccount)
+		copy(dAtA[i:], m.PaymentAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PaymentAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryVerifyPermissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Effect != 0 {
-		n += 1 + sovQuery(uint64(m.Effect))
+func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryHeadGroupRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPaymentAccountBucketFlowRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAccountBucketFlowRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.GroupOwner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.FlowRateLimit.Size()
+		i -= size
+		if _, err := m.FlowRateLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if m.IsSet {
+		i--
+		if m.IsSet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GroupInfo != nil {
-		l = m.GroupInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryEstimateCostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryListGroupsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEstimateCostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateCostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.RedundantParityChunkNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RedundantParityChunkNum))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.GroupOwner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.RedundantDataChunkNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RedundantDataChunkNum))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ChargedReadQuota != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChargedReadQuota))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ObjectSizes) > 0 {
+		dAtA27 := make([]byte, len(m.ObjectSizes)*10)
+		var j26 int
+		for _, num := range m.ObjectSizes {
+			for num >= 1<<7 {
+				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j26++
+			}
+			dAtA27[j26] = uint8(num)
+			j26++
+		}
+		i -= j26
+		copy(dAtA[i:], dAtA27[:j26])
+		i = encodeVarintQuery(dAtA, i, uint64(j26))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PrimarySpAddress) > 0 {
+		i -= len(m.PrimarySpAddress)
+		copy(dAtA[i:], m.PrimarySpAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrimarySpAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListGroupsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEstimateCostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateCostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateCostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ReserveTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReserveTime))
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.GroupInfos) > 0 {
-		for _, e := range m.GroupInfos {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.BufferBalance.Size()
+		i -= size
+		if _, err := m.BufferBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *QueryHeadGroupMemberRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.LockFee.Size()
+		i -= size
+		if _, err := m.LockFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.Member)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MonthlyCost.Size()
+		i -= size
+		if _, err := m.MonthlyCost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.GroupOwner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StoreRate.Size()
+		i -= size
+		if _, err := m.StoreRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ReadRate.Size()
+		i -= size
+		if _, err := m.ReadRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadGroupMemberResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GroupMember != nil {
-		l = m.GroupMember.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryVerifyPieceIntegrityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPolicyForGroupRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Resource)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.PrincipalGroupId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryVerifyPieceIntegrityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPolicyForGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryVerifyPieceIntegrityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Policy != nil {
-		l = m.Policy.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	return n
+	if len(m.PieceData) > 0 {
+		i -= len(m.PieceData)
+		copy(dAtA[i:], m.PieceData)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PieceData)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PieceHash) > 0 {
+		i -= len(m.PieceHash)
+		copy(dAtA[i:], m.PieceHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PieceHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PieceIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PieceIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RedundancyIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RedundancyIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPolicyByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVerifyPieceIntegrityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyPieceIntegrityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyPieceIntegrityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PolicyId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.IntegrityHash) > 0 {
+		i -= len(m.IntegrityHash)
+		copy(dAtA[i:], m.IntegrityHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IntegrityHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPolicyByIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCrossChainPackageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCrossChainPackageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCrossChainPackageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Policy != nil {
-		l = m.Policy.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.ChannelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SrcChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SrcChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLockFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCrossChainPackageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCrossChainPackageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCrossChainPackageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PrimarySpAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.CreateAt != 0 {
-		n += 1 + sovQuery(uint64(m.CreateAt))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.PayloadSize != 0 {
-		n += 1 + sovQuery(uint64(m.PayloadSize))
+	if len(m.AckPayload) > 0 {
+		i -= len(m.AckPayload)
+		copy(dAtA[i:], m.AckPayload)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AckPayload)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PackageType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PackageType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OperationType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OperationType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLockFeeResponse) Size() (n int) {
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryHeadBucketExtraRequest) Size() (n int) {
+func (m *QueryParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryHeadBucketExtraResponse) Size() (n int) {
+func (m *QueryParamsByTimestampRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ExtraInfo != nil {
-		l = m.ExtraInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
 	}
 	return n
 }
 
-func (m *QueryIsPriceChangedRequest) Size() (n int) {
+func (m *QueryParamsByTimestampResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryIsPriceChangedResponse) Size() (n int) {
+func (m *QueryHeadBucketRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Changed {
-		n += 2
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = m.CurrentReadPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.CurrentPrimaryStorePrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.CurrentSecondaryStorePrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.CurrentValidatorTaxRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.NewReadPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.NewPrimaryStorePrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.NewSecondaryStorePrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.NewValidatorTaxRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryQuoteUpdateTimeRequest) Size() (n int) {
+func (m *QueryHeadBucketByIdRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.BucketName)
+	l = len(m.BucketId)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryQuoteUpdateTimeResponse) Size() (n int) {
+func (m *QueryHeadBucketResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.UpdateAt != 0 {
-		n += 1 + sovQuery(uint64(m.UpdateAt))
+	if m.BucketInfo != nil {
+		l = m.BucketInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExtraInfo != nil {
+		l = m.ExtraInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryGroupMembersExistRequest) Size() (n int) {
+func (m *QueryHeadObjectRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.GroupId)
+	l = len(m.BucketName)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if len(m.Members) > 0 {
-		for _, s := range m.Members {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryGroupMembersExistResponse) Size() (n int) {
+func (m *QueryHeadObjectByIdRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Exists) > 0 {
-		for k, v := range m.Exists {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + 1
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
-		}
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryGroupsExistRequest) Size() (n int) {
+func (m *QueryHeadShadowObjectRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.GroupOwner)
+	l = len(m.BucketName)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if len(m.GroupNames) > 0 {
-		for _, s := range m.GroupNames {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryGroupsExistByIdRequest) Size() (n int) {
+func (m *QueryHeadObjectResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.GroupIds) > 0 {
-		for _, s := range m.GroupIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.ObjectInfo != nil {
+		l = m.ObjectInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GlobalVirtualGroup != nil {
+		l = m.GlobalVirtualGroup.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryGroupsExistResponse) Size() (n int) {
+func (m *QueryHeadShadowObjectResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Exists) > 0 {
-		for k, v := range m.Exists {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + 1
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+	if m.ObjectInfo != nil {
+		l = m.ObjectInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListBucketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListBucketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BucketInfos) > 0 {
+		for _, e := range m.BucketInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Size() (n int) {
+func (m *QueryListObjectsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.PaymentAccount)
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BucketName)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.BucketOwner)
+	return n
+}
+
+func (m *QueryListObjectsByBucketIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BucketId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListObjectsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ObjectInfos) > 0 {
+		for _, e := range m.ObjectInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListBucketsByGlobalVirtualGroupFamilyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovQuery(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	return n
+}
+
+func (m *QueryListObjectsByGlobalVirtualGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GlobalVirtualGroupId))
+	}
+	return n
+}
+
+func (m *QueryNFTRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBucketNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetaData != nil {
+		l = m.MetaData.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryObjectNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetaData != nil {
+		l = m.MetaData.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetaData != nil {
+		l = m.MetaData.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPolicyForAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PrincipalAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPolicyForAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyPermissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
@@ -6462,58 +7346,1983 @@ func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ActionType != 0 {
+		n += 1 + sovQuery(uint64(m.ActionType))
+	}
 	return n
 }
 
-func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Size() (n int) {
+func (m *QueryVerifyPermissionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.IsSet {
-		n += 2
+	if m.Effect != 0 {
+		n += 1 + sovQuery(uint64(m.Effect))
 	}
-	l = m.FlowRateLimit.Size()
-	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryHeadGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryHeadGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupInfo != nil {
+		l = m.GroupInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (m *QueryListGroupsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GroupOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListGroupsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.GroupInfos) > 0 {
+		for _, e := range m.GroupInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryHeadGroupMemberRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GroupOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadGroupMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupMember != nil {
+		l = m.GroupMember.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPolicyForGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PrincipalGroupId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPolicyForGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPolicyByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPolicyByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrimarySpAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CreateAt != 0 {
+		n += 1 + sovQuery(uint64(m.CreateAt))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovQuery(uint64(m.PayloadSize))
+	}
+	return n
+}
+
+func (m *QueryLockFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHeadBucketExtraRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadBucketExtraResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtraInfo != nil {
+		l = m.ExtraInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsPriceChangedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsPriceChangedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Changed {
+		n += 2
+	}
+	l = m.CurrentReadPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentPrimaryStorePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentSecondaryStorePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentValidatorTaxRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NewReadPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NewPrimaryStorePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NewSecondaryStorePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NewValidatorTaxRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryQuoteUpdateTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteUpdateTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateAt != 0 {
+		n += 1 + sovQuery(uint64(m.UpdateAt))
+	}
+	return n
+}
+
+func (m *QueryGroupMembersExistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGroupMembersExistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Exists) > 0 {
+		for k, v := range m.Exists {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryGroupsExistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.GroupNames) > 0 {
+		for _, s := range m.GroupNames {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGroupsExistByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GroupIds) > 0 {
+		for _, s := range m.GroupIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGroupsExistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Exists) > 0 {
+		for k, v := range m.Exists {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PaymentAccount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BucketOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsSet {
+		n += 2
+	}
+	l = m.FlowRateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateCostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrimarySpAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ObjectSizes) > 0 {
+		l = 0
+		for _, e := range m.ObjectSizes {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.ChargedReadQuota != 0 {
+		n += 1 + sovQuery(uint64(m.ChargedReadQuota))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	if m.RedundantDataChunkNum != 0 {
+		n += 1 + sovQuery(uint64(m.RedundantDataChunkNum))
+	}
+	if m.RedundantParityChunkNum != 0 {
+		n += 1 + sovQuery(uint64(m.RedundantParityChunkNum))
+	}
+	return n
+}
+
+func (m *QueryEstimateCostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReadRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.StoreRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MonthlyCost.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LockFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BufferBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ReserveTime != 0 {
+		n += 1 + sovQuery(uint64(m.ReserveTime))
+	}
+	return n
+}
+
+func (m *QueryVerifyPieceIntegrityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RedundancyIndex != 0 {
+		n += 1 + sovQuery(uint64(m.RedundancyIndex))
+	}
+	if m.PieceIndex != 0 {
+		n += 1 + sovQuery(uint64(m.PieceIndex))
+	}
+	l = len(m.PieceHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PieceData)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVerifyPieceIntegrityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.IntegrityHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCrossChainPackageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SrcChainId != 0 {
+		n += 1 + sovQuery(uint64(m.SrcChainId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovQuery(uint64(m.ChannelId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryCrossChainPackageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperationType != 0 {
+		n += 1 + sovQuery(uint64(m.OperationType))
+	}
+	if m.PackageType != 0 {
+		n += 1 + sovQuery(uint64(m.PackageType))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AckPayload)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByTimestampRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByTimestampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByTimestampResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByTimestampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadBucketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadBucketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadBucketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadBucketByIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadBucketByIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadBucketByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadBucketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadBucketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BucketInfo == nil {
+				m.BucketInfo = &BucketInfo{}
+			}
+			if err := m.BucketInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExtraInfo == nil {
+				m.ExtraInfo = &BucketExtraInfo{}
+			}
+			if err := m.ExtraInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadObjectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadObjectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadObjectByIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadObjectByIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadObjectByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadShadowObjectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadShadowObjectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadShadowObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadObjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadObjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ObjectInfo == nil {
+				m.ObjectInfo = &ObjectInfo{}
+			}
+			if err := m.ObjectInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GlobalVirtualGroup == nil {
+				m.GlobalVirtualGroup = &types.GlobalVirtualGroup{}
+			}
+			if err := m.GlobalVirtualGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadShadowObjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadShadowObjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadShadowObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ObjectInfo == nil {
+				m.ObjectInfo = &ShadowObjectInfo{}
+			}
+			if err := m.ObjectInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListBucketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListBucketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListBucketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListBucketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListBucketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListBucketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketInfos = append(m.BucketInfos, &BucketInfo{})
+			if err := m.BucketInfos[len(m.BucketInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListObjectsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListObjectsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListObjectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6535,7 +9344,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListObjectsByBucketIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6558,15 +9367,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListObjectsByBucketIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListObjectsByBucketIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6593,10 +9402,45 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6618,7 +9462,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListObjectsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6641,17 +9485,17 @@ func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByTimestampRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListObjectsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByTimestampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListObjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectInfos", wireType)
 			}
-			m.Timestamp = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6661,11 +9505,62 @@ func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectInfos = append(m.ObjectInfos, &ObjectInfo{})
+			if err := m.ObjectInfos[len(m.ObjectInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6687,7 +9582,7 @@ func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListBucketsByGlobalVirtualGroupFamilyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6710,15 +9605,15 @@ func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByTimestampResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListBucketsByGlobalVirtualGroupFamilyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByTimestampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListBucketsByGlobalVirtualGroupFamilyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6745,10 +9640,32 @@ func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6770,7 +9687,7 @@ func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadBucketRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListObjectsByGlobalVirtualGroupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6793,17 +9710,17 @@ func (m *QueryHeadBucketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadBucketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListObjectsByGlobalVirtualGroupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadBucketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListObjectsByGlobalVirtualGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6813,24 +9730,47 @@ func (m *QueryHeadBucketRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6852,7 +9792,7 @@ func (m *QueryHeadBucketRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadBucketByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6875,15 +9815,15 @@ func (m *QueryHeadBucketByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadBucketByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadBucketByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6911,7 +9851,7 @@ func (m *QueryHeadBucketByIdRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketId = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6934,7 +9874,7 @@ func (m *QueryHeadBucketByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadBucketResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBucketNFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6956,52 +9896,16 @@ func (m *QueryHeadBucketResponse) Unmarshal(dAtA []byte) error {
 		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadBucketResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BucketInfo == nil {
-				m.BucketInfo = &BucketInfo{}
-			}
-			if err := m.BucketInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBucketNFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBucketNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7028,10 +9932,10 @@ func (m *QueryHeadBucketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExtraInfo == nil {
-				m.ExtraInfo = &BucketExtraInfo{}
+			if m.MetaData == nil {
+				m.MetaData = &BucketMetaData{}
 			}
-			if err := m.ExtraInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MetaData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7056,7 +9960,7 @@ func (m *QueryHeadBucketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadObjectRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryObjectNFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7079,17 +9983,17 @@ func (m *QueryHeadObjectRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadObjectRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryObjectNFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryObjectNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7099,55 +10003,27 @@ func (m *QueryHeadObjectRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.MetaData == nil {
+				m.MetaData = &ObjectMetaData{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.MetaData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7170,7 +10046,7 @@ func (m *QueryHeadObjectRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadObjectByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupNFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7193,17 +10069,17 @@ func (m *QueryHeadObjectByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadObjectByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupNFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadObjectByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7213,23 +10089,27 @@ func (m *QueryHeadObjectByIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			if m.MetaData == nil {
+				m.MetaData = &GroupMetaData{}
+			}
+			if err := m.MetaData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7252,7 +10132,7 @@ func (m *QueryHeadObjectByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadShadowObjectRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPolicyForAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7275,15 +10155,15 @@ func (m *QueryHeadShadowObjectRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadShadowObjectRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPolicyForAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadShadowObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPolicyForAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7311,11 +10191,11 @@ func (m *QueryHeadShadowObjectRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.Resource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrincipalAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7343,7 +10223,7 @@ func (m *QueryHeadShadowObjectRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.PrincipalAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7366,7 +10246,7 @@ func (m *QueryHeadShadowObjectRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPolicyForAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7389,51 +10269,15 @@ func (m *QueryHeadObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPolicyForAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPolicyForAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ObjectInfo == nil {
-				m.ObjectInfo = &ObjectInfo{}
-			}
-			if err := m.ObjectInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7460,10 +10304,10 @@ func (m *QueryHeadObjectResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GlobalVirtualGroup == nil {
-				m.GlobalVirtualGroup = &types.GlobalVirtualGroup{}
+			if m.Policy == nil {
+				m.Policy = &types1.Policy{}
 			}
-			if err := m.GlobalVirtualGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7488,7 +10332,7 @@ func (m *QueryHeadObjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadShadowObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyPermissionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7511,17 +10355,17 @@ func (m *QueryHeadShadowObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadShadowObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyPermissionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadShadowObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyPermissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7531,83 +10375,61 @@ func (m *QueryHeadShadowObjectResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ObjectInfo == nil {
-				m.ObjectInfo = &ShadowObjectInfo{}
-			}
-			if err := m.ObjectInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryListBucketsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListBucketsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListBucketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7617,28 +10439,43 @@ func (m *QueryListBucketsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionType", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ActionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionType |= types1.ActionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7660,7 +10497,7 @@ func (m *QueryListBucketsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListBucketsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyPermissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7683,51 +10520,17 @@ func (m *QueryListBucketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListBucketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyPermissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListBucketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketInfos", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketInfos = append(m.BucketInfos, &BucketInfo{})
-			if err := m.BucketInfos[len(m.BucketInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Effect", wireType)
 			}
-			var msglen int
+			m.Effect = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7737,28 +10540,11 @@ func (m *QueryListBucketsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Effect |= types1.Effect(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7780,7 +10566,7 @@ func (m *QueryListBucketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListObjectsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadGroupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7803,17 +10589,17 @@ func (m *QueryListObjectsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListObjectsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadGroupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListObjectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7823,31 +10609,27 @@ func (m *QueryListObjectsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GroupOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7875,7 +10657,7 @@ func (m *QueryListObjectsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7898,7 +10680,7 @@ func (m *QueryListObjectsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListObjectsByBucketIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7921,15 +10703,15 @@ func (m *QueryListObjectsByBucketIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListObjectsByBucketIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListObjectsByBucketIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7956,45 +10738,13 @@ func (m *QueryListObjectsByBucketIdRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.GroupInfo == nil {
+				m.GroupInfo = &GroupInfo{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GroupInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8016,7 +10766,7 @@ func (m *QueryListObjectsByBucketIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListObjectsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListGroupsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8039,47 +10789,13 @@ func (m *QueryListObjectsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListObjectsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListGroupsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListObjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectInfos", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObjectInfos = append(m.ObjectInfos, &ObjectInfo{})
-			if err := m.ObjectInfos[len(m.ObjectInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -8108,66 +10824,16 @@ func (m *QueryListObjectsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryNFTRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNFTRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8195,7 +10861,7 @@ func (m *QueryNFTRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
+			m.GroupOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8218,7 +10884,7 @@ func (m *QueryNFTRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBucketNFTResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListGroupsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8241,15 +10907,15 @@ func (m *QueryBucketNFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBucketNFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListGroupsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBucketNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8276,10 +10942,44 @@ func (m *QueryBucketNFTResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MetaData == nil {
-				m.MetaData = &BucketMetaData{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.MetaData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupInfos = append(m.GroupInfos, &GroupInfo{})
+			if err := m.GroupInfos[len(m.GroupInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8304,7 +11004,7 @@ func (m *QueryBucketNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryObjectNFTResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadGroupMemberRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8327,17 +11027,17 @@ func (m *QueryObjectNFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryObjectNFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadGroupMemberRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryObjectNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadGroupMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8347,27 +11047,87 @@ func (m *QueryObjectNFTResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MetaData == nil {
-				m.MetaData = &ObjectMetaData{}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
 			}
-			if err := m.MetaData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8390,7 +11150,7 @@ func (m *QueryObjectNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupNFTResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadGroupMemberResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8413,15 +11173,15 @@ func (m *QueryGroupNFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupNFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadGroupMemberResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadGroupMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupMember", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8448,10 +11208,10 @@ func (m *QueryGroupNFTResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MetaData == nil {
-				m.MetaData = &GroupMetaData{}
+			if m.GroupMember == nil {
+				m.GroupMember = &types1.GroupMember{}
 			}
-			if err := m.MetaData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GroupMember.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8476,7 +11236,7 @@ func (m *QueryGroupNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPolicyForAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPolicyForGroupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8499,10 +11259,10 @@ func (m *QueryPolicyForAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPolicyForAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPolicyForGroupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPolicyForAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPolicyForGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8539,7 +11299,7 @@ func (m *QueryPolicyForAccountRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrincipalAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrincipalGroupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8567,7 +11327,7 @@ func (m *QueryPolicyForAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrincipalAddress = string(dAtA[iNdEx:postIndex])
+			m.PrincipalGroupId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8590,7 +11350,7 @@ func (m *QueryPolicyForAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPolicyForAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPolicyForGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8613,10 +11373,10 @@ func (m *QueryPolicyForAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPolicyForAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPolicyForGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPolicyForAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPolicyForGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8676,7 +11436,7 @@ func (m *QueryPolicyForAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVerifyPermissionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPolicyByIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8699,79 +11459,15 @@ func (m *QueryVerifyPermissionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVerifyPermissionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPolicyByIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVerifyPermissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPolicyByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8799,27 +11495,8 @@ func (m *QueryVerifyPermissionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.PolicyId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionType", wireType)
-			}
-			m.ActionType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ActionType |= types1.ActionType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8841,7 +11518,7 @@ func (m *QueryVerifyPermissionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVerifyPermissionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPolicyByIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8864,17 +11541,17 @@ func (m *QueryVerifyPermissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVerifyPermissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPolicyByIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVerifyPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPolicyByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Effect", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			m.Effect = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8884,11 +11561,28 @@ func (m *QueryVerifyPermissionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Effect |= types1.Effect(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policy == nil {
+				m.Policy = &types1.Policy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8910,7 +11604,7 @@ func (m *QueryVerifyPermissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadGroupRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLockFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8933,15 +11627,15 @@ func (m *QueryHeadGroupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadGroupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLockFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLockFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8969,13 +11663,13 @@ func (m *QueryHeadGroupRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			m.PrimarySpAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
 			}
-			var stringLen uint64
+			m.CreateAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8985,24 +11679,30 @@ func (m *QueryHeadGroupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CreateAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9024,7 +11724,7 @@ func (m *QueryHeadGroupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLockFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9047,17 +11747,17 @@ func (m *QueryHeadGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLockFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLockFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9067,25 +11767,23 @@ func (m *QueryHeadGroupResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GroupInfo == nil {
-				m.GroupInfo = &GroupInfo{}
-			}
-			if err := m.GroupInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9110,7 +11808,7 @@ func (m *QueryHeadGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListGroupsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadBucketExtraRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9133,51 +11831,15 @@ func (m *QueryListGroupsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListGroupsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadBucketExtraRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadBucketExtraRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9205,7 +11867,7 @@ func (m *QueryListGroupsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9228,7 +11890,7 @@ func (m *QueryListGroupsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListGroupsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadBucketExtraResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9251,15 +11913,15 @@ func (m *QueryListGroupsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListGroupsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadBucketExtraResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadBucketExtraResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9286,18 +11948,68 @@ func (m *QueryListGroupsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.ExtraInfo == nil {
+				m.ExtraInfo = &InternalBucketInfo{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExtraInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsPriceChangedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsPriceChangedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsPriceChangedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9307,25 +12019,23 @@ func (m *QueryListGroupsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupInfos = append(m.GroupInfos, &GroupInfo{})
-			if err := m.GroupInfos[len(m.GroupInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9348,7 +12058,7 @@ func (m *QueryListGroupsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadGroupMemberRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9371,15 +12081,35 @@ func (m *QueryHeadGroupMemberRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadGroupMemberRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsPriceChangedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadGroupMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsPriceChangedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Changed = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReadPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9407,11 +12137,13 @@ func (m *QueryHeadGroupMemberRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Member = string(dAtA[iNdEx:postIndex])
+			if err := m.CurrentReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPrimaryStorePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9439,11 +12171,13 @@ func (m *QueryHeadGroupMemberRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			if err := m.CurrentPrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSecondaryStorePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9471,63 +12205,15 @@ func (m *QueryHeadGroupMemberRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.CurrentSecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryHeadGroupMemberResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadGroupMemberResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadGroupMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupMember", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentValidatorTaxRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9537,81 +12223,63 @@ func (m *QueryHeadGroupMemberResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GroupMember == nil {
-				m.GroupMember = &types1.GroupMember{}
-			}
-			if err := m.GroupMember.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentValidatorTaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewReadPrice", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPolicyForGroupRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.NewReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPolicyForGroupRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPolicyForGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPrimaryStorePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9639,11 +12307,13 @@ func (m *QueryPolicyForGroupRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resource = string(dAtA[iNdEx:postIndex])
+			if err := m.NewPrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrincipalGroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSecondaryStorePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9671,63 +12341,15 @@ func (m *QueryPolicyForGroupRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrincipalGroupId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.NewSecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPolicyForGroupResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPolicyForGroupResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPolicyForGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValidatorTaxRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9737,25 +12359,23 @@ func (m *QueryPolicyForGroupResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Policy == nil {
-				m.Policy = &types1.Policy{}
-			}
-			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewValidatorTaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9780,7 +12400,7 @@ func (m *QueryPolicyForGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPolicyByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryQuoteUpdateTimeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9803,15 +12423,15 @@ func (m *QueryPolicyByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPolicyByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuoteUpdateTimeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPolicyByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuoteUpdateTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9839,7 +12459,7 @@ func (m *QueryPolicyByIdRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PolicyId = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9862,7 +12482,7 @@ func (m *QueryPolicyByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPolicyByIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryQuoteUpdateTimeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9885,17 +12505,17 @@ func (m *QueryPolicyByIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPolicyByIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuoteUpdateTimeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPolicyByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuoteUpdateTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAt", wireType)
 			}
-			var msglen int
+			m.UpdateAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9905,28 +12525,11 @@ func (m *QueryPolicyByIdResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UpdateAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Policy == nil {
-				m.Policy = &types1.Policy{}
-			}
-			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9948,7 +12551,7 @@ func (m *QueryPolicyByIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLockFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupMembersExistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9971,15 +12574,15 @@ func (m *QueryLockFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupMembersExistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupMembersExistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10007,99 +12610,11 @@ func (m *QueryLockFeeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrimarySpAddress = string(dAtA[iNdEx:postIndex])
+			m.GroupId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
-			}
-			m.CreateAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreateAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
-			}
-			m.PayloadSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PayloadSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryLockFeeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockFeeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10127,9 +12642,7 @@ func (m *QueryLockFeeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10152,7 +12665,7 @@ func (m *QueryLockFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadBucketExtraRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupMembersExistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10175,17 +12688,17 @@ func (m *QueryHeadBucketExtraRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadBucketExtraRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupMembersExistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadBucketExtraRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupMembersExistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10195,23 +12708,106 @@ func (m *QueryHeadBucketExtraRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Exists == nil {
+				m.Exists = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Exists[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10234,7 +12830,7 @@ func (m *QueryHeadBucketExtraRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadBucketExtraResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupsExistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10257,17 +12853,17 @@ func (m *QueryHeadBucketExtraResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadBucketExtraResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupsExistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadBucketExtraResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupsExistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10277,27 +12873,55 @@ func (m *QueryHeadBucketExtraResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExtraInfo == nil {
-				m.ExtraInfo = &InternalBucketInfo{}
+			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupNames", wireType)
 			}
-			if err := m.ExtraInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupNames = append(m.GroupNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10320,7 +12944,7 @@ func (m *QueryHeadBucketExtraResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsPriceChangedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupsExistByIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10343,15 +12967,15 @@ func (m *QueryIsPriceChangedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsPriceChangedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupsExistByIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsPriceChangedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupsExistByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10379,7 +13003,7 @@ func (m *QueryIsPriceChangedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.GroupIds = append(m.GroupIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10402,7 +13026,7 @@ func (m *QueryIsPriceChangedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupsExistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10425,37 +13049,17 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsPriceChangedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupsExistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsPriceChangedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupsExistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Changed = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReadPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10465,63 +13069,160 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPrimaryStorePrice", wireType)
+			if m.Exists == nil {
+				m.Exists = make(map[string]bool)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			m.Exists[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentPrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSecondaryStorePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10549,13 +13250,11 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentSecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PaymentAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentValidatorTaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10583,13 +13282,11 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentValidatorTaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BucketOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewReadPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10617,49 +13314,63 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPrimaryStorePrice", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewPrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewSecondaryStorePrice", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSet", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10669,29 +13380,15 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.NewSecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+			m.IsSet = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewValidatorTaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowRateLimit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10719,7 +13416,7 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewValidatorTaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FlowRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10744,7 +13441,7 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryQuoteUpdateTimeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateCostRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10767,17 +13464,163 @@ func (m *QueryQuoteUpdateTimeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryQuoteUpdateTimeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateCostRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryQuoteUpdateTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateCostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimarySpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ObjectSizes = append(m.ObjectSizes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ObjectSizes) == 0 {
+					m.ObjectSizes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ObjectSizes = append(m.ObjectSizes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectSizes", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
+			}
+			m.ChargedReadQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargedReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundantDataChunkNum", wireType)
+			}
+			m.RedundantDataChunkNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10787,79 +13630,16 @@ func (m *QueryQuoteUpdateTimeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RedundantDataChunkNum |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryQuoteUpdateTimeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryQuoteUpdateTimeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryQuoteUpdateTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 6:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundantParityChunkNum", wireType)
 			}
-			m.UpdateAt = 0
+			m.RedundantParityChunkNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10869,7 +13649,7 @@ func (m *QueryQuoteUpdateTimeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdateAt |= int64(b&0x7F) << shift
+				m.RedundantParityChunkNum |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10895,7 +13675,7 @@ func (m *QueryQuoteUpdateTimeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupMembersExistRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateCostResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10918,15 +13698,15 @@ func (m *QueryGroupMembersExistRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupMembersExistRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateCostResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupMembersExistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateCostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10954,11 +13734,13 @@ func (m *QueryGroupMembersExistRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupId = string(dAtA[iNdEx:postIndex])
+			if err := m.ReadRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10986,63 +13768,15 @@ func (m *QueryGroupMembersExistRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.StoreRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGroupMembersExistResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupMembersExistResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupMembersExistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11052,160 +13786,63 @@ func (m *QueryGroupMembersExistResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Exists == nil {
-				m.Exists = make(map[string]bool)
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthlyCost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Exists[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGroupsExistRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.MonthlyCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupsExistRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupsExistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11233,11 +13870,13 @@ func (m *QueryGroupsExistRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			if err := m.LockFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupNames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11265,8 +13904,29 @@ func (m *QueryGroupsExistRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupNames = append(m.GroupNames, string(dAtA[iNdEx:postIndex]))
+			if err := m.BufferBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveTime", wireType)
+			}
+			m.ReserveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReserveTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11288,7 +13948,7 @@ func (m *QueryGroupsExistRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupsExistByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyPieceIntegrityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11311,15 +13971,15 @@ func (m *QueryGroupsExistByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupsExistByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyPieceIntegrityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupsExistByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyPieceIntegrityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11347,63 +14007,51 @@ func (m *QueryGroupsExistByIdRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupIds = append(m.GroupIds, string(dAtA[iNdEx:postIndex]))
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyIndex", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGroupsExistResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.RedundancyIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PieceIndex", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PieceIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PieceIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupsExistResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupsExistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PieceHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11413,106 +14061,91 @@ func (m *QueryGroupsExistResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Exists == nil {
-				m.Exists = make(map[string]bool)
+			m.PieceHash = append(m.PieceHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.PieceHash == nil {
+				m.PieceHash = []byte{}
 			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PieceData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PieceData = append(m.PieceData[:0], dAtA[iNdEx:postIndex]...)
+			if m.PieceData == nil {
+				m.PieceData = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Exists[mapkey] = mapvalue
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11535,7 +14168,7 @@ func (m *QueryGroupsExistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyPieceIntegrityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11558,17 +14191,37 @@ func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyPieceIntegrityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyPieceIntegrityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegrityHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11578,27 +14231,29 @@ func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentAccount = string(dAtA[iNdEx:postIndex])
+			m.IntegrityHash = append(m.IntegrityHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.IntegrityHash == nil {
+				m.IntegrityHash = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11626,13 +14281,63 @@ func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Unmarshal(dAtA []byte) e
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketOwner = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCrossChainPackageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCrossChainPackageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCrossChainPackageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcChainId", wireType)
+			}
+			m.SrcChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11642,24 +14347,49 @@ func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SrcChainId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11681,7 +14411,7 @@ func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCrossChainPackageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11704,17 +14434,17 @@ func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCrossChainPackageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCrossChainPackageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationType", wireType)
 			}
-			var v int
+			m.OperationType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11724,15 +14454,33 @@ func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Unmarshal(dAtA []byte)
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.OperationType |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsSet = bool(v != 0)
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackageType", wireType)
+			}
+			m.PackageType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PackageType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlowRateLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11760,10 +14508,91 @@ func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Unmarshal(dAtA []byte)
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FlowRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Payload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckPayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckPayload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
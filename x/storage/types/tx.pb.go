@@ -55,6 +55,15 @@ type MsgCreateBucket struct {
 	// The available read data for each user is the sum of the free read data provided by SP and
 	// the ChargeReadQuota specified here.
 	ChargedReadQuota uint64 `protobuf:"varint,7,opt,name=charged_read_quota,json=chargedReadQuota,proto3" json:"charged_read_quota,omitempty"`
+	// allowed_regions defines the region codes the primary sp and the secondary sps serving the bucket must be located in.
+	// The placement constraint is flattened into the message, since an empty nested message can not be encoded in EIP712.
+	AllowedRegions []string `protobuf:"bytes,8,rep,name=allowed_regions,json=allowedRegions,proto3" json:"allowed_regions,omitempty"`
+	// allowed_jurisdictions defines the jurisdiction codes the primary sp and the secondary sps serving the bucket must be subject to.
+	AllowedJurisdictions []string `protobuf:"bytes,9,rep,name=allowed_jurisdictions,json=allowedJurisdictions,proto3" json:"allowed_jurisdictions,omitempty"`
+	// redundant_data_chunk_num and redundant_parity_chunk_num choose the EC redundancy profile of the bucket among the
+	// profiles approved by governance, the default profile is used if both of them are zero.
+	RedundantDataChunkNum   uint32 `protobuf:"varint,10,opt,name=redundant_data_chunk_num,json=redundantDataChunkNum,proto3" json:"redundant_data_chunk_num,omitempty"`
+	RedundantParityChunkNum uint32 `protobuf:"varint,11,opt,name=redundant_parity_chunk_num,json=redundantParityChunkNum,proto3" json:"redundant_parity_chunk_num,omitempty"`
 }
 
 func (m *MsgCreateBucket) Reset()         { *m = MsgCreateBucket{} }
@@ -139,6 +148,34 @@ func (m *MsgCreateBucket) GetChargedReadQuota() uint64 {
 	return 0
 }
 
+func (m *MsgCreateBucket) GetAllowedRegions() []string {
+	if m != nil {
+		return m.AllowedRegions
+	}
+	return nil
+}
+
+func (m *MsgCreateBucket) GetAllowedJurisdictions() []string {
+	if m != nil {
+		return m.AllowedJurisdictions
+	}
+	return nil
+}
+
+func (m *MsgCreateBucket) GetRedundantDataChunkNum() uint32 {
+	if m != nil {
+		return m.RedundantDataChunkNum
+	}
+	return 0
+}
+
+func (m *MsgCreateBucket) GetRedundantParityChunkNum() uint32 {
+	if m != nil {
+		return m.RedundantParityChunkNum
+	}
+	return 0
+}
+
 type MsgCreateBucketResponse struct {
 	BucketId Uint `protobuf:"bytes,1,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
 }
@@ -4115,180 +4152,186 @@ func init() {
 func init() { proto.RegisterFile("greenfield/storage/tx.proto", fileDescriptor_ddb71b028305a3cc) }
 
 var fileDescriptor_ddb71b028305a3cc = []byte{
-	// 2757 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0x4d, 0x6c, 0x1c, 0x57,
-	0x1d, 0xcf, 0xec, 0xae, 0xed, 0xf8, 0xbf, 0xfe, 0x48, 0x26, 0x6e, 0xb2, 0x59, 0x93, 0xf5, 0x66,
-	0xd3, 0xa6, 0xce, 0x97, 0x9d, 0xba, 0x69, 0x08, 0xa6, 0x42, 0xd8, 0x2e, 0x09, 0xab, 0xc6, 0x8d,
-	0x3b, 0x76, 0x8c, 0x54, 0x84, 0xb6, 0xe3, 0x9d, 0xe7, 0xc9, 0x90, 0xdd, 0x99, 0x61, 0x66, 0xd6,
-	0x8e, 0x8b, 0xd4, 0x03, 0x20, 0xf5, 0x54, 0x29, 0x52, 0x39, 0x70, 0x40, 0x08, 0x21, 0x21, 0x71,
-	0x42, 0x08, 0xf5, 0x8c, 0xb8, 0x54, 0x8a, 0x10, 0x87, 0xa8, 0x07, 0x84, 0x38, 0x84, 0x2a, 0x41,
-	0xaa, 0xb8, 0x72, 0xe1, 0x8a, 0xde, 0xc7, 0xbc, 0x79, 0x3b, 0x9f, 0xeb, 0xcd, 0xba, 0xb1, 0xc4,
-	0x29, 0xd9, 0x79, 0xbf, 0xf7, 0x7f, 0xff, 0xef, 0xf7, 0x7f, 0xff, 0xf7, 0x0c, 0xd3, 0xba, 0x83,
-	0x90, 0xb9, 0x6d, 0xa0, 0x96, 0x36, 0xef, 0x7a, 0x96, 0xa3, 0xea, 0x68, 0xde, 0x7b, 0x30, 0x67,
-	0x3b, 0x96, 0x67, 0xc9, 0x72, 0x30, 0x38, 0xc7, 0x06, 0xcb, 0xa7, 0x9a, 0x96, 0xdb, 0xb6, 0xdc,
-	0xf9, 0xb6, 0xab, 0xcf, 0xef, 0xbc, 0x86, 0xff, 0xa1, 0xe0, 0xf2, 0x69, 0x3a, 0xd0, 0x20, 0xbf,
-	0xe6, 0xe9, 0x0f, 0x36, 0x34, 0xa5, 0x5b, 0xba, 0x45, 0xbf, 0xe3, 0xff, 0xb1, 0xaf, 0x33, 0xba,
-	0x65, 0xe9, 0x2d, 0x34, 0x4f, 0x7e, 0x6d, 0x75, 0xb6, 0xe7, 0x3d, 0xa3, 0x8d, 0x5c, 0x4f, 0x6d,
-	0xdb, 0x0c, 0x50, 0x15, 0x78, 0x6b, 0x5a, 0xed, 0xb6, 0x65, 0xce, 0xab, 0xb6, 0xed, 0x58, 0x3b,
-	0x6a, 0x8b, 0x93, 0x88, 0x20, 0x76, 0x1d, 0xd5, 0xb6, 0x91, 0xc3, 0x00, 0x35, 0x01, 0x60, 0x23,
-	0xa7, 0x6d, 0xb8, 0xae, 0x61, 0x99, 0x0c, 0x1b, 0x43, 0xc4, 0x57, 0x41, 0x26, 0xc0, 0x56, 0x1d,
-	0xb5, 0xed, 0xcb, 0x57, 0x89, 0x53, 0xe2, 0x9e, 0x8d, 0xd8, 0x78, 0xed, 0x4f, 0x79, 0x98, 0x5c,
-	0x75, 0xf5, 0x15, 0x07, 0xa9, 0x1e, 0x5a, 0xee, 0x34, 0xef, 0x23, 0x4f, 0x5e, 0x80, 0x91, 0x26,
-	0xfe, 0x6d, 0x39, 0x25, 0xa9, 0x2a, 0xcd, 0x8e, 0x2e, 0x97, 0x3e, 0xff, 0xf4, 0xca, 0x14, 0x53,
-	0xdb, 0x92, 0xa6, 0x39, 0xc8, 0x75, 0xd7, 0x3d, 0xc7, 0x30, 0x75, 0xc5, 0x07, 0xca, 0x33, 0x50,
-	0xdc, 0x22, 0xb3, 0x1b, 0xa6, 0xda, 0x46, 0xa5, 0x1c, 0x9e, 0xa7, 0x00, 0xfd, 0xf4, 0x8e, 0xda,
-	0x46, 0xf2, 0x32, 0xc0, 0x8e, 0xe1, 0x1a, 0x5b, 0x46, 0xcb, 0xf0, 0xf6, 0x4a, 0xf9, 0xaa, 0x34,
-	0x3b, 0xb1, 0x50, 0x9b, 0x8b, 0x5a, 0x71, 0x6e, 0x93, 0xa3, 0x36, 0xf6, 0x6c, 0xa4, 0x08, 0xb3,
-	0xe4, 0x25, 0x98, 0xb4, 0xd5, 0xbd, 0x36, 0x32, 0xbd, 0x86, 0x4a, 0xd9, 0x28, 0x15, 0x32, 0x18,
-	0x9c, 0x60, 0x13, 0xd8, 0x57, 0xf9, 0x26, 0xc8, 0xb6, 0x63, 0xb4, 0x55, 0x67, 0xaf, 0xe1, 0xda,
-	0x9c, 0xca, 0x50, 0x06, 0x95, 0x63, 0x6c, 0xce, 0xba, 0xed, 0xd3, 0x79, 0x1b, 0x4e, 0x88, 0x74,
-	0x98, 0xed, 0x4b, 0xc3, 0x55, 0x69, 0xb6, 0xb8, 0x30, 0x2d, 0xca, 0xc5, 0xec, 0xb5, 0xc4, 0x20,
-	0xca, 0xf1, 0x80, 0x16, 0xfb, 0x24, 0x5f, 0x06, 0xb9, 0x79, 0x4f, 0x75, 0x74, 0xa4, 0x35, 0x1c,
-	0xa4, 0x6a, 0x8d, 0x1f, 0x75, 0x2c, 0x4f, 0x2d, 0x8d, 0x54, 0xa5, 0xd9, 0x82, 0x72, 0x8c, 0x8d,
-	0x28, 0x48, 0xd5, 0xde, 0xc5, 0xdf, 0x17, 0xc7, 0x7e, 0xf2, 0xe5, 0x1f, 0x2e, 0xfa, 0x8a, 0xaf,
-	0xad, 0xc3, 0xa9, 0x90, 0xfd, 0x14, 0xe4, 0xda, 0x96, 0xe9, 0x22, 0xf9, 0x06, 0x8c, 0x32, 0x9b,
-	0x18, 0x1a, 0xb3, 0xe4, 0xf4, 0xa3, 0x27, 0x33, 0x47, 0xfe, 0xf1, 0x64, 0xa6, 0x70, 0xd7, 0x30,
-	0xbd, 0xcf, 0x3f, 0xbd, 0x52, 0x64, 0xe2, 0xe2, 0x9f, 0xca, 0x51, 0x8a, 0xae, 0x6b, 0xb5, 0x5d,
-	0xe2, 0x14, 0x6f, 0xa1, 0x16, 0xe2, 0x4e, 0x71, 0x0d, 0x8e, 0x5a, 0x36, 0x72, 0x7a, 0xf2, 0x0a,
-	0x8e, 0xcc, 0x74, 0x8b, 0xc5, 0x71, 0x2c, 0x0c, 0xc7, 0xd7, 0x4e, 0x13, 0x69, 0xc4, 0x85, 0x7d,
-	0x69, 0x6a, 0x3f, 0x97, 0x60, 0x0a, 0x8f, 0x19, 0x6e, 0xd3, 0x32, 0x3d, 0xc3, 0xec, 0x1c, 0x2c,
-	0x67, 0xf2, 0x49, 0x18, 0x76, 0x90, 0xea, 0x5a, 0x26, 0x71, 0xd6, 0x51, 0x85, 0xfd, 0x0a, 0x73,
-	0x5c, 0x81, 0xaf, 0xc5, 0x71, 0xc5, 0xd9, 0xfe, 0x97, 0x18, 0x60, 0x77, 0xb6, 0x7e, 0x88, 0x9a,
-	0x07, 0x14, 0x60, 0x33, 0x50, 0xb4, 0x08, 0x79, 0x0a, 0xa0, 0x4c, 0x03, 0xfd, 0x44, 0x00, 0x67,
-	0x61, 0xcc, 0x56, 0xf7, 0x5a, 0x96, 0xaa, 0x35, 0x5c, 0xe3, 0x03, 0x44, 0x42, 0xa7, 0xa0, 0x14,
-	0xd9, 0xb7, 0x75, 0xe3, 0x83, 0x70, 0x90, 0x0e, 0xf5, 0x15, 0xa4, 0x67, 0x61, 0x0c, 0xab, 0x02,
-	0x07, 0x29, 0x4e, 0x34, 0x24, 0x24, 0x46, 0x95, 0x22, 0xfb, 0x86, 0xe1, 0x49, 0xc1, 0x33, 0xd2,
-	0x57, 0xf0, 0x5c, 0x80, 0x63, 0xe8, 0x81, 0x8d, 0xe5, 0x6e, 0xde, 0x43, 0xcd, 0xfb, 0x6e, 0xa7,
-	0xed, 0x96, 0x8e, 0x56, 0xf3, 0xb3, 0x63, 0xca, 0x24, 0xfd, 0xbe, 0xe2, 0x7f, 0x96, 0xdf, 0x86,
-	0x49, 0x07, 0x69, 0x1d, 0x53, 0x53, 0xcd, 0xe6, 0x1e, 0xe5, 0x6e, 0x34, 0x59, 0x46, 0x85, 0x43,
-	0x89, 0x8c, 0x13, 0x4e, 0xd7, 0xef, 0x94, 0x30, 0xa4, 0x56, 0x16, 0xc3, 0x90, 0x19, 0xa6, 0xc7,
-	0x30, 0xa4, 0xe8, 0xba, 0x56, 0xfb, 0x24, 0x07, 0xe3, 0xab, 0xae, 0xbe, 0x8e, 0xd4, 0x16, 0xf3,
-	0x9c, 0x03, 0xf2, 0xf5, 0x4c, 0xdf, 0x79, 0x03, 0x4e, 0xe9, 0x2d, 0x6b, 0x4b, 0x6d, 0x35, 0x76,
-	0x0c, 0xc7, 0xeb, 0xa8, 0xad, 0x86, 0xee, 0x58, 0x1d, 0x1b, 0x4b, 0x84, 0xdd, 0x68, 0x5c, 0x99,
-	0xa2, 0xc3, 0x9b, 0x74, 0xf4, 0x16, 0x1e, 0xac, 0x6b, 0xf2, 0x5b, 0x30, 0xe3, 0xa2, 0xa6, 0x65,
-	0x6a, 0xcc, 0xd4, 0x5b, 0x2d, 0xb7, 0xa1, 0xea, 0x7a, 0xc3, 0x35, 0x74, 0x53, 0xf5, 0x3a, 0x0e,
-	0xa2, 0xa9, 0x77, 0x4c, 0x99, 0xe6, 0xb0, 0x75, 0x7b, 0xb9, 0xe5, 0x2e, 0xe9, 0xfa, 0x3a, 0x87,
-	0x84, 0x23, 0xee, 0x14, 0xbc, 0xd4, 0xa5, 0x14, 0x1e, 0x6a, 0x7f, 0xce, 0x91, 0x50, 0x0b, 0x46,
-	0x36, 0x17, 0xfe, 0x2f, 0x15, 0x16, 0x1b, 0x12, 0xc3, 0xb1, 0x21, 0x11, 0x9f, 0x7f, 0x45, 0x0d,
-	0x72, 0xed, 0xfe, 0x52, 0x82, 0x13, 0xab, 0xae, 0xae, 0x20, 0xfc, 0xfd, 0xc5, 0xbb, 0x64, 0x98,
-	0xf3, 0x33, 0x30, 0x1d, 0xc3, 0x1d, 0xe7, 0xfe, 0xf7, 0x34, 0x94, 0x56, 0x2c, 0x7b, 0x8f, 0xf1,
-	0x5d, 0x0e, 0xf3, 0x2d, 0x70, 0x77, 0x1e, 0x26, 0x5d, 0xa7, 0xd9, 0x88, 0x72, 0x38, 0xee, 0x3a,
-	0xcd, 0xe5, 0x80, 0xc9, 0xf3, 0x30, 0xa9, 0xb9, 0x5e, 0x17, 0x8e, 0x32, 0x3a, 0xae, 0xb9, 0x5e,
-	0x37, 0x0e, 0xd3, 0x13, 0x05, 0x2a, 0x70, 0x7a, 0x77, 0x02, 0xaf, 0x61, 0xf4, 0x44, 0xdc, 0x10,
-	0xa7, 0x27, 0xe0, 0x14, 0x38, 0x85, 0x71, 0x7d, 0x56, 0x20, 0x53, 0x9a, 0xeb, 0xad, 0x85, 0xf3,
-	0x68, 0x58, 0x9f, 0xef, 0x92, 0x28, 0x0b, 0xf4, 0x35, 0x80, 0x74, 0xf6, 0x0b, 0x49, 0x28, 0x2b,
-	0x0e, 0x97, 0xf7, 0x88, 0x75, 0x47, 0xc8, 0x73, 0x1e, 0x47, 0xea, 0x8e, 0x83, 0x65, 0x7d, 0x11,
-	0x80, 0xeb, 0xd7, 0x2d, 0xe5, 0xab, 0xf9, 0x2c, 0x05, 0x8f, 0xfa, 0x0a, 0x76, 0x85, 0x9a, 0xa5,
-	0xb0, 0xaf, 0x9a, 0x25, 0x24, 0xf2, 0x47, 0x12, 0x4c, 0xf0, 0xdd, 0x8c, 0xa4, 0xa6, 0xbe, 0x4a,
-	0x96, 0x33, 0x00, 0x34, 0xe9, 0x09, 0x92, 0x8e, 0x92, 0x2f, 0x44, 0xd0, 0x29, 0x18, 0x42, 0x0f,
-	0x3c, 0x47, 0x65, 0xd6, 0xa1, 0x3f, 0x42, 0xdb, 0xea, 0x1a, 0x9c, 0xec, 0x66, 0x84, 0xbb, 0xe1,
-	0x75, 0x38, 0xca, 0x33, 0x6a, 0x0f, 0x5e, 0x38, 0xa2, 0xd3, 0x0c, 0x5b, 0xf3, 0x88, 0x68, 0xd4,
-	0xd2, 0x54, 0xb4, 0xfe, 0xec, 0x98, 0x2e, 0x5c, 0x58, 0xe3, 0x25, 0x22, 0x87, 0xb0, 0x2a, 0xd7,
-	0xf5, 0x67, 0x39, 0xe2, 0x5e, 0x77, 0x6d, 0xcd, 0x17, 0x71, 0x15, 0xb5, 0xb7, 0x90, 0xd3, 0x27,
-	0x5b, 0xdf, 0x80, 0x22, 0x65, 0xcb, 0xda, 0x35, 0x91, 0x43, 0xf9, 0x4a, 0x99, 0x48, 0x65, 0xb8,
-	0x83, 0xb1, 0x21, 0x89, 0xf2, 0x61, 0x73, 0x7d, 0x17, 0x26, 0xda, 0x84, 0x33, 0xb7, 0xe1, 0x59,
-	0xf8, 0xe4, 0x54, 0x2a, 0x54, 0xf3, 0xb3, 0xc5, 0xf8, 0xda, 0x69, 0xd5, 0xd5, 0x05, 0x59, 0x94,
-	0x31, 0x36, 0x73, 0xc3, 0x5a, 0xd2, 0xf0, 0x26, 0x77, 0x5c, 0xa0, 0xa4, 0x11, 0xa5, 0x94, 0x86,
-	0x88, 0xa3, 0x27, 0x73, 0x3a, 0xc9, 0x49, 0x50, 0x2d, 0xc6, 0xfb, 0x74, 0x44, 0x8d, 0x5c, 0xcf,
-	0xff, 0xf1, 0xb7, 0x2f, 0x13, 0xed, 0x1e, 0x66, 0x35, 0xbf, 0x09, 0x23, 0x4c, 0xd2, 0x7d, 0xe8,
-	0xd7, 0x9f, 0x92, 0xb4, 0x29, 0x76, 0xcb, 0xcc, 0x75, 0xf2, 0x31, 0x8d, 0x73, 0x51, 0x1d, 0x57,
-	0x61, 0x98, 0xd2, 0xca, 0x54, 0x06, 0xc3, 0xc9, 0x75, 0xc0, 0x45, 0x85, 0xe1, 0xa8, 0x9e, 0x61,
-	0x99, 0x0d, 0xcf, 0x60, 0xd1, 0x50, 0x5c, 0x28, 0xcf, 0xd1, 0x2e, 0xca, 0x9c, 0xdf, 0x45, 0x99,
-	0xdb, 0xf0, 0xbb, 0x28, 0xcb, 0x85, 0x87, 0xff, 0x9c, 0x91, 0x94, 0x89, 0x60, 0x22, 0x1e, 0xaa,
-	0xfd, 0x85, 0xda, 0x48, 0x30, 0xe2, 0x77, 0x70, 0x4e, 0x38, 0x74, 0x36, 0xe2, 0x99, 0xab, 0x20,
-	0x66, 0xae, 0x58, 0xdd, 0x87, 0x65, 0xe1, 0xba, 0xff, 0x9d, 0x44, 0x0a, 0x92, 0xdb, 0x48, 0xdd,
-	0x61, 0x79, 0x68, 0xff, 0xaa, 0x3f, 0x30, 0x09, 0x17, 0x8b, 0x58, 0x16, 0xb6, 0x0c, 0x2b, 0xb8,
-	0x03, 0x4e, 0x83, 0xad, 0x31, 0x27, 0xd8, 0x8b, 0x96, 0x3b, 0x75, 0x73, 0xdb, 0x3a, 0xa8, 0x9d,
-	0xf1, 0x76, 0x6c, 0x9b, 0x24, 0x4f, 0x9c, 0xad, 0x12, 0x53, 0xf0, 0xdc, 0xad, 0x9b, 0xde, 0xf5,
-	0x6b, 0x9b, 0x6a, 0xab, 0x83, 0xa2, 0x6d, 0x94, 0x41, 0x34, 0x93, 0x06, 0x70, 0x5c, 0x4e, 0xf3,
-	0x9a, 0x40, 0xa3, 0x5c, 0xe3, 0xbf, 0x92, 0x68, 0x59, 0xa6, 0x9a, 0x4d, 0xd4, 0xea, 0xea, 0x29,
-	0x1c, 0x92, 0x42, 0x6a, 0x06, 0xce, 0xc4, 0xf2, 0x27, 0x1e, 0xd2, 0xc6, 0x56, 0x5d, 0x7d, 0xad,
-	0xe3, 0xad, 0x59, 0x2d, 0xa3, 0xb9, 0xd7, 0x27, 0xe3, 0xdf, 0x82, 0x51, 0xdb, 0x31, 0xcc, 0xa6,
-	0x61, 0xab, 0x2d, 0x96, 0x6f, 0xaa, 0xa2, 0xe6, 0x83, 0x8e, 0xea, 0xdc, 0x9a, 0x8f, 0x53, 0x82,
-	0x29, 0xb8, 0xfa, 0x77, 0x90, 0x6b, 0x75, 0x9c, 0xa6, 0x2f, 0x14, 0xff, 0x2d, 0x7f, 0x1b, 0xc0,
-	0xf5, 0x54, 0x0f, 0x61, 0x53, 0xfb, 0x59, 0x38, 0x89, 0xf8, 0xba, 0x0f, 0x54, 0x84, 0x39, 0xf2,
-	0x6a, 0x34, 0x27, 0x8e, 0x64, 0xe6, 0xc4, 0xa3, 0x8f, 0x9e, 0xcc, 0x48, 0x71, 0x79, 0x31, 0xac,
-	0xe3, 0x35, 0x52, 0x31, 0x70, 0x0d, 0x8a, 0x95, 0xb9, 0x4d, 0xbe, 0xf8, 0xa7, 0xcc, 0xac, 0xca,
-	0x9c, 0xa2, 0xeb, 0x5a, 0xed, 0x8f, 0x62, 0x65, 0x7e, 0x58, 0xed, 0x12, 0x56, 0xc3, 0xba, 0x50,
-	0xb3, 0x0f, 0x4c, 0x13, 0xff, 0xa6, 0x9a, 0x58, 0x35, 0x1c, 0xc7, 0x72, 0x9e, 0x2b, 0xb4, 0x2e,
-	0x41, 0xce, 0xd0, 0x58, 0x4e, 0x4e, 0x5d, 0x3c, 0x67, 0x68, 0xe1, 0x38, 0xcc, 0x67, 0xc5, 0x61,
-	0x21, 0xd2, 0x70, 0xa8, 0xc1, 0xb8, 0x86, 0x5c, 0x7c, 0xe2, 0x57, 0x0d, 0x13, 0x8b, 0x3d, 0x44,
-	0xda, 0x0c, 0x45, 0xfc, 0x71, 0x05, 0x7f, 0xab, 0x6b, 0xf1, 0x87, 0x1e, 0x51, 0x54, 0x1e, 0xa5,
-	0x8f, 0x44, 0x35, 0x3c, 0x57, 0x9f, 0x75, 0xb0, 0x6a, 0x88, 0x48, 0x59, 0xc8, 0x94, 0x52, 0xcc,
-	0xa8, 0x54, 0xca, 0xae, 0x8c, 0xfa, 0x85, 0x58, 0x73, 0x04, 0xe3, 0x2f, 0xac, 0x71, 0xd4, 0xbd,
-	0xa7, 0x14, 0x06, 0xb1, 0xa7, 0x88, 0x76, 0x0e, 0x75, 0xa7, 0x3f, 0xa3, 0x15, 0x20, 0x1d, 0x7b,
-	0x9e, 0xe3, 0xd0, 0xbe, 0xcc, 0x9c, 0x51, 0x5e, 0xf5, 0x61, 0x64, 0x7a, 0xbe, 0x12, 0xc4, 0xe0,
-	0x12, 0x7e, 0x42, 0x3d, 0x99, 0xda, 0x77, 0x8d, 0x5c, 0x8d, 0xc9, 0xd7, 0x61, 0x54, 0xed, 0x78,
-	0xf7, 0x2c, 0x07, 0xab, 0x38, 0x4b, 0xc6, 0x00, 0x2a, 0xdf, 0x80, 0x61, 0x7a, 0xb9, 0x16, 0x54,
-	0xb8, 0x51, 0xbb, 0xd0, 0x35, 0x96, 0x0b, 0x58, 0x09, 0x0a, 0xc3, 0x2f, 0x4e, 0x60, 0x76, 0x03,
-	0x4a, 0xcc, 0x24, 0x22, 0x53, 0x9c, 0xe1, 0xff, 0x4a, 0x70, 0x8c, 0xc8, 0xa2, 0x3b, 0xea, 0x01,
-	0xdf, 0xbe, 0xc8, 0x17, 0xe0, 0x78, 0xa8, 0x8f, 0x64, 0x68, 0xc4, 0x1e, 0xe3, 0xca, 0x84, 0xd8,
-	0x24, 0xaa, 0x6b, 0x69, 0x2d, 0xa7, 0xc2, 0x80, 0x5a, 0x4e, 0x65, 0x28, 0x85, 0x05, 0x0f, 0x5a,
-	0x12, 0x39, 0x32, 0xb8, 0x62, 0xb5, 0x6d, 0x9c, 0xef, 0xbf, 0x12, 0xed, 0x2c, 0x43, 0x25, 0xb6,
-	0x87, 0xbb, 0xad, 0xb6, 0x8d, 0xd6, 0x5e, 0xa0, 0xaa, 0x72, 0xb4, 0x95, 0x7b, 0x93, 0x40, 0xea,
-	0x9a, 0xbc, 0x04, 0x63, 0xfa, 0x8e, 0xde, 0x68, 0xab, 0xb6, 0x6d, 0x98, 0xba, 0x5f, 0x4d, 0x54,
-	0xe2, 0x1c, 0xe7, 0xd6, 0xe6, 0xad, 0x55, 0x0a, 0x53, 0x8a, 0xfa, 0x8e, 0xce, 0xfe, 0x1f, 0x39,
-	0xd3, 0xd5, 0xa0, 0x9a, 0xa4, 0x08, 0xae, 0xad, 0x0f, 0x69, 0xdb, 0x84, 0x54, 0x61, 0x5f, 0x85,
-	0xaa, 0xc2, 0x3c, 0x56, 0xa1, 0x12, 0xbf, 0x7e, 0x88, 0x43, 0xda, 0xae, 0x7d, 0x71, 0x1c, 0xc6,
-	0xac, 0xcf, 0x39, 0xfc, 0x8d, 0x04, 0xa3, 0xa4, 0x17, 0xee, 0x6d, 0xa8, 0x7a, 0x9f, 0x5c, 0x89,
-	0xd5, 0x4c, 0x2e, 0x54, 0x65, 0x5e, 0x83, 0x82, 0xa7, 0xea, 0x2e, 0x3b, 0xbf, 0x54, 0xe3, 0x6f,
-	0xa0, 0x28, 0x76, 0x43, 0xd5, 0x5d, 0x85, 0xa0, 0xc3, 0x62, 0x9c, 0x80, 0xe3, 0x9c, 0x47, 0xce,
-	0xf9, 0xc3, 0x1c, 0x51, 0xae, 0xb8, 0xa5, 0xad, 0xd0, 0xdb, 0xb7, 0x17, 0xb6, 0xab, 0xf5, 0x70,
-	0xf7, 0x18, 0xbe, 0x37, 0x1c, 0x8a, 0xde, 0x1b, 0xf6, 0x7f, 0xaf, 0x41, 0xcd, 0x1d, 0xa3, 0x11,
-	0xae, 0xb4, 0xdf, 0x4a, 0xa4, 0x81, 0x44, 0x7d, 0xf6, 0x10, 0xa9, 0x2e, 0x2c, 0xc9, 0x79, 0x78,
-	0x39, 0x8d, 0x4d, 0x2e, 0xcf, 0xdf, 0xf2, 0xbc, 0x3c, 0xd6, 0x55, 0x0f, 0x0d, 0xe0, 0xac, 0x28,
-	0xb4, 0x80, 0x73, 0x7d, 0xde, 0x5a, 0xf7, 0x51, 0xd7, 0x86, 0x3d, 0x67, 0x28, 0xdb, 0x73, 0x62,
-	0x6e, 0x9c, 0xbb, 0xab, 0xaa, 0x91, 0xbe, 0x2e, 0xb6, 0x5f, 0xd4, 0x45, 0x73, 0xc8, 0x01, 0xbe,
-	0x0f, 0x33, 0x09, 0x76, 0x1d, 0xc0, 0x15, 0xcd, 0x5f, 0x73, 0x24, 0x50, 0x7c, 0xea, 0x83, 0x8b,
-	0x83, 0x05, 0x18, 0xe9, 0x10, 0x62, 0x3d, 0x38, 0x0f, 0x03, 0x1e, 0x1a, 0xe7, 0x89, 0x33, 0xfc,
-	0x48, 0x4f, 0x69, 0x67, 0x16, 0xce, 0xa7, 0x6b, 0x93, 0x87, 0xeb, 0x4f, 0x25, 0x72, 0x4c, 0xd9,
-	0xb0, 0x74, 0xbd, 0x85, 0xd6, 0xd7, 0x96, 0x5c, 0x7f, 0x92, 0xb6, 0xa4, 0x1f, 0x5c, 0xf6, 0x09,
-	0xf3, 0xfb, 0x0a, 0x9c, 0x4b, 0x61, 0x82, 0x33, 0xfb, 0x65, 0x0e, 0x4e, 0xd3, 0x6d, 0x87, 0xee,
-	0x99, 0x37, 0x5b, 0xd6, 0xae, 0xa2, 0x7a, 0xe8, 0xb6, 0xd1, 0x36, 0x0e, 0x2c, 0x51, 0x7e, 0x13,
-	0xc6, 0x18, 0x80, 0x76, 0x3b, 0xf3, 0x19, 0xa4, 0x19, 0x39, 0xda, 0xee, 0x1c, 0x40, 0xb3, 0x4f,
-	0x83, 0xc9, 0xed, 0x96, 0xb5, 0xdb, 0xc0, 0xa5, 0x42, 0xa3, 0x85, 0x25, 0x65, 0xcf, 0xc6, 0xde,
-	0x64, 0xa1, 0x75, 0x5e, 0x37, 0xbc, 0x7b, 0x9d, 0x2d, 0x5c, 0xfb, 0xb2, 0x37, 0x86, 0xec, 0x9f,
-	0x2b, 0xae, 0x76, 0x9f, 0x3d, 0xba, 0xab, 0x93, 0xe0, 0x03, 0xb6, 0x60, 0xdd, 0xf4, 0x94, 0xf1,
-	0x6d, 0x51, 0x79, 0x61, 0x83, 0x9c, 0x83, 0xb3, 0x89, 0x8a, 0xf6, 0xcd, 0xb1, 0xf0, 0xeb, 0x0a,
-	0xe4, 0x57, 0x5d, 0x5d, 0x7e, 0x1f, 0xc6, 0xba, 0xde, 0xf1, 0x9d, 0x4b, 0xb8, 0x39, 0x10, 0x41,
-	0xe5, 0x4b, 0x3d, 0x80, 0x78, 0x62, 0x79, 0x1f, 0xc6, 0xba, 0x1e, 0x85, 0x25, 0xad, 0x20, 0x82,
-	0x12, 0x57, 0x88, 0x7b, 0xe5, 0x25, 0xb7, 0xe0, 0x58, 0xa4, 0x9d, 0xfc, 0x6a, 0x02, 0x81, 0x30,
-	0xb0, 0x3c, 0xdf, 0x23, 0x50, 0x94, 0xa7, 0xab, 0xc5, 0x91, 0x24, 0x8f, 0x08, 0x4a, 0x94, 0x27,
-	0xee, 0x80, 0x2d, 0x5b, 0x70, 0x3c, 0xfa, 0x62, 0x6d, 0x36, 0x49, 0x23, 0x61, 0x64, 0xf9, 0x6a,
-	0xaf, 0x48, 0xbe, 0xe0, 0xcf, 0x24, 0x28, 0x25, 0x66, 0x91, 0x24, 0x05, 0x25, 0x4d, 0x28, 0x7f,
-	0x7d, 0x9f, 0x13, 0x44, 0xcd, 0x76, 0x95, 0x1c, 0xe9, 0xbe, 0x48, 0x41, 0x19, 0xbe, 0x18, 0xda,
-	0xe4, 0xde, 0x03, 0x10, 0x5e, 0xa1, 0x9c, 0x4d, 0x98, 0x1a, 0x40, 0xca, 0x17, 0x32, 0x21, 0x22,
-	0xf7, 0x5d, 0xaf, 0x88, 0xce, 0x65, 0x4e, 0xdd, 0x5c, 0x48, 0xe4, 0x3e, 0xee, 0x35, 0x0d, 0xf6,
-	0xf3, 0xc8, 0x4b, 0x9a, 0x24, 0x3f, 0x0f, 0x03, 0x13, 0xfd, 0x3c, 0xe9, 0xf5, 0x0b, 0xd6, 0x95,
-	0xf0, 0xf2, 0x25, 0x49, 0x57, 0x01, 0x24, 0x51, 0x57, 0x31, 0xef, 0x41, 0x78, 0x4e, 0xc8, 0xb0,
-	0xb4, 0x08, 0xca, 0xc8, 0x09, 0xa1, 0x15, 0x1c, 0x90, 0x63, 0x2e, 0x3c, 0x12, 0x59, 0x8c, 0x40,
-	0xcb, 0xaf, 0xf5, 0x0c, 0x8d, 0x66, 0x86, 0x0c, 0xa9, 0x44, 0x50, 0x46, 0x66, 0x08, 0xad, 0xd0,
-	0x9d, 0x19, 0xd8, 0x32, 0x3d, 0x64, 0x06, 0xb6, 0xd6, 0xd5, 0x5e, 0x91, 0xd1, 0xd4, 0x2a, 0x74,
-	0x39, 0xd3, 0x53, 0x6b, 0x00, 0xcc, 0x48, 0xad, 0xd1, 0xbe, 0xaa, 0xdc, 0x81, 0x13, 0x71, 0xd5,
-	0xe3, 0xc5, 0x1e, 0xe8, 0x30, 0x6c, 0x79, 0xa1, 0x77, 0x2c, 0x5f, 0xf6, 0x23, 0x09, 0x4e, 0x27,
-	0x9f, 0xe1, 0xae, 0xa6, 0x3a, 0x42, 0x1c, 0x0f, 0x37, 0xf6, 0x3b, 0x83, 0x73, 0xf2, 0x00, 0xa6,
-	0x62, 0x0f, 0x5f, 0x69, 0xae, 0x1f, 0x06, 0x97, 0x5f, 0xdf, 0x07, 0x98, 0xaf, 0xfc, 0xb1, 0x04,
-	0xd3, 0x69, 0x15, 0xfc, 0x42, 0x06, 0xd1, 0x38, 0x3d, 0x2c, 0xee, 0x7f, 0x0e, 0xe7, 0xe7, 0x07,
-	0x50, 0x14, 0x9f, 0x12, 0xd5, 0x52, 0xb3, 0x3c, 0xc1, 0x94, 0x2f, 0x66, 0x63, 0x44, 0xf2, 0xe2,
-	0x73, 0x9e, 0x5a, 0x6a, 0x6a, 0x49, 0x27, 0x1f, 0xf3, 0x40, 0x07, 0xc7, 0x69, 0xf4, 0x71, 0xce,
-	0x6c, 0xaa, 0x6b, 0x0a, 0xc8, 0xc4, 0x38, 0x4d, 0x7c, 0xa9, 0x12, 0xc4, 0xa9, 0xf0, 0x02, 0xe2,
-	0xd5, 0x6c, 0x2a, 0x04, 0x98, 0x11, 0xa7, 0xd1, 0x77, 0x08, 0x78, 0x6b, 0x10, 0xde, 0x20, 0x24,
-	0x6d, 0x0d, 0x01, 0x24, 0x71, 0x6b, 0x88, 0xbe, 0x0f, 0xc0, 0x96, 0x11, 0x6f, 0x16, 0x6a, 0xa9,
-	0xe9, 0x31, 0xdd, 0x32, 0x31, 0xad, 0x7d, 0xba, 0x87, 0x86, 0x9e, 0xf3, 0x24, 0xef, 0xa1, 0xdd,
-	0xc0, 0x94, 0x3d, 0x34, 0xfe, 0xb1, 0x8c, 0xfc, 0x3d, 0x18, 0x0d, 0x2e, 0xad, 0xab, 0x09, 0xb3,
-	0x39, 0xa2, 0x3c, 0x9b, 0x85, 0x88, 0x6e, 0xa0, 0x8c, 0x76, 0xfa, 0x06, 0xca, 0xc8, 0x5f, 0xea,
-	0x01, 0x24, 0xae, 0xd0, 0x75, 0xff, 0x71, 0x2e, 0xd5, 0x49, 0x28, 0x28, 0x71, 0x85, 0xb8, 0x4b,
-	0x0b, 0xb9, 0x09, 0xe3, 0xdd, 0x5d, 0xdc, 0x97, 0x13, 0xed, 0x28, 0xa0, 0xca, 0x97, 0x7b, 0x41,
-	0xf1, 0x45, 0x7e, 0x0c, 0x2f, 0xc5, 0xf7, 0xff, 0x2f, 0x27, 0x56, 0x2b, 0x31, 0xe8, 0xf2, 0xb5,
-	0xfd, 0xa0, 0xc5, 0xfd, 0x2c, 0xae, 0x9f, 0x7e, 0x31, 0x75, 0x7f, 0xe8, 0x5e, 0x78, 0xa1, 0x77,
-	0xac, 0xb8, 0x6c, 0x5c, 0x93, 0xfc, 0x62, 0x6a, 0x05, 0xd8, 0xdb, 0xb2, 0x29, 0xcd, 0x6f, 0xf9,
-	0x1d, 0x18, 0x66, 0x8d, 0xef, 0x33, 0x89, 0x55, 0x2d, 0x1e, 0x2e, 0xbf, 0x92, 0x3a, 0xcc, 0xe9,
-	0x7d, 0x08, 0x27, 0x13, 0xba, 0x05, 0x57, 0x92, 0x09, 0xc4, 0xc0, 0xcb, 0x6f, 0xec, 0x0b, 0xee,
-	0xaf, 0xbf, 0x5c, 0x7f, 0xf4, 0xb4, 0x22, 0x3d, 0x7e, 0x5a, 0x91, 0xbe, 0x78, 0x5a, 0x91, 0x1e,
-	0x3e, 0xab, 0x1c, 0x79, 0xfc, 0xac, 0x72, 0xe4, 0xef, 0xcf, 0x2a, 0x47, 0xde, 0x9b, 0x17, 0x4e,
-	0xed, 0x5b, 0xe6, 0xd6, 0x15, 0x72, 0xe9, 0x38, 0x2f, 0xfc, 0xd5, 0xdc, 0x83, 0xee, 0xbf, 0x9b,
-	0xdb, 0x1a, 0x26, 0x4f, 0x37, 0x5e, 0xff, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x80, 0x48, 0xd6,
-	0x34, 0x9f, 0x38, 0x00, 0x00,
+	// 2864 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x52, 0x94, 0x64, 0x3e, 0xea, 0xc3, 0x5e, 0xcb, 0x11, 0x4d, 0xd5, 0x14, 0x4d, 0x27,
+	0x8e, 0xe2, 0xc4, 0x92, 0x23, 0x3b, 0x8e, 0xab, 0x04, 0x45, 0x25, 0xb9, 0x76, 0xd9, 0x58, 0xb6,
+	0xb2, 0x92, 0x55, 0x20, 0x45, 0xc1, 0x8c, 0xb8, 0xa3, 0xf5, 0xc6, 0xcb, 0xdd, 0xed, 0xee, 0x52,
+	0xb2, 0x52, 0x20, 0x87, 0xb6, 0x40, 0x4e, 0x01, 0x8c, 0xa6, 0x87, 0x1e, 0x8a, 0xa2, 0x28, 0x50,
+	0xa0, 0xa7, 0xa2, 0x28, 0xf2, 0x07, 0xf4, 0x12, 0xc0, 0x28, 0x7a, 0x30, 0x72, 0x28, 0x8a, 0x1e,
+	0xdc, 0xc0, 0x2e, 0x10, 0xf4, 0xda, 0x4b, 0xaf, 0xc5, 0xce, 0xcc, 0xce, 0x0e, 0x97, 0xfb, 0x41,
+	0xd1, 0x52, 0x2c, 0xa0, 0x27, 0x69, 0x67, 0x7e, 0xf3, 0xe6, 0x7d, 0xcd, 0x9b, 0x37, 0x6f, 0x86,
+	0x30, 0xa5, 0x39, 0x18, 0x9b, 0x5b, 0x3a, 0x36, 0xd4, 0x39, 0xd7, 0xb3, 0x1c, 0xa4, 0xe1, 0x39,
+	0xef, 0xfe, 0xac, 0xed, 0x58, 0x9e, 0x25, 0xcb, 0x61, 0xe7, 0x2c, 0xeb, 0x2c, 0x4f, 0x36, 0x2d,
+	0xb7, 0x65, 0xb9, 0x73, 0x2d, 0x57, 0x9b, 0xdb, 0x7e, 0xdd, 0xff, 0x43, 0xc1, 0xe5, 0x53, 0xb4,
+	0xa3, 0x41, 0xbe, 0xe6, 0xe8, 0x07, 0xeb, 0x9a, 0xd0, 0x2c, 0xcd, 0xa2, 0xed, 0xfe, 0x7f, 0xac,
+	0x75, 0x5a, 0xb3, 0x2c, 0xcd, 0xc0, 0x73, 0xe4, 0x6b, 0xb3, 0xbd, 0x35, 0xe7, 0xe9, 0x2d, 0xec,
+	0x7a, 0xa8, 0x65, 0x33, 0x40, 0x55, 0xe0, 0xad, 0x69, 0xb5, 0x5a, 0x96, 0x39, 0x87, 0x6c, 0xdb,
+	0xb1, 0xb6, 0x91, 0xc1, 0x49, 0x74, 0x21, 0x76, 0x1c, 0x64, 0xdb, 0xd8, 0x61, 0x80, 0x9a, 0x00,
+	0xb0, 0xb1, 0xd3, 0xd2, 0x5d, 0x57, 0xb7, 0x4c, 0x86, 0x8d, 0x21, 0x12, 0xa8, 0x20, 0x13, 0x60,
+	0x23, 0x07, 0xb5, 0x02, 0xf9, 0x2a, 0x71, 0x4a, 0xdc, 0xb5, 0x31, 0xeb, 0xaf, 0xfd, 0x7c, 0x10,
+	0xc6, 0x57, 0x5c, 0x6d, 0xd9, 0xc1, 0xc8, 0xc3, 0x4b, 0xed, 0xe6, 0x3d, 0xec, 0xc9, 0xf3, 0x30,
+	0xdc, 0xf4, 0xbf, 0x2d, 0xa7, 0x24, 0x55, 0xa5, 0x99, 0xc2, 0x52, 0xe9, 0x8b, 0xcf, 0x2e, 0x4c,
+	0x30, 0xb5, 0x2d, 0xaa, 0xaa, 0x83, 0x5d, 0x77, 0xcd, 0x73, 0x74, 0x53, 0x53, 0x02, 0xa0, 0x3c,
+	0x0d, 0xc5, 0x4d, 0x32, 0xba, 0x61, 0xa2, 0x16, 0x2e, 0xe5, 0xfc, 0x71, 0x0a, 0xd0, 0xa6, 0x5b,
+	0xa8, 0x85, 0xe5, 0x25, 0x80, 0x6d, 0xdd, 0xd5, 0x37, 0x75, 0x43, 0xf7, 0x76, 0x4b, 0x03, 0x55,
+	0x69, 0x66, 0x6c, 0xbe, 0x36, 0xdb, 0x6d, 0xc5, 0xd9, 0x0d, 0x8e, 0x5a, 0xdf, 0xb5, 0xb1, 0x22,
+	0x8c, 0x92, 0x17, 0x61, 0xdc, 0x46, 0xbb, 0x2d, 0x6c, 0x7a, 0x0d, 0x44, 0xd9, 0x28, 0xe5, 0x33,
+	0x18, 0x1c, 0x63, 0x03, 0x58, 0xab, 0x7c, 0x1d, 0x64, 0xdb, 0xd1, 0x5b, 0xc8, 0xd9, 0x6d, 0xb8,
+	0x36, 0xa7, 0x32, 0x98, 0x41, 0xe5, 0x18, 0x1b, 0xb3, 0x66, 0x07, 0x74, 0xde, 0x81, 0x13, 0x22,
+	0x1d, 0x66, 0xfb, 0xd2, 0x50, 0x55, 0x9a, 0x29, 0xce, 0x4f, 0x89, 0x72, 0x31, 0x7b, 0x2d, 0x32,
+	0x88, 0x72, 0x3c, 0xa4, 0xc5, 0x9a, 0xe4, 0xd7, 0x40, 0x6e, 0xde, 0x45, 0x8e, 0x86, 0xd5, 0x86,
+	0x83, 0x91, 0xda, 0xf8, 0x51, 0xdb, 0xf2, 0x50, 0x69, 0xb8, 0x2a, 0xcd, 0xe4, 0x95, 0x63, 0xac,
+	0x47, 0xc1, 0x48, 0x7d, 0xd7, 0x6f, 0x97, 0x5f, 0x86, 0x71, 0x64, 0x18, 0xd6, 0x0e, 0x41, 0x6b,
+	0xba, 0x65, 0xba, 0xa5, 0xa3, 0xd5, 0x81, 0x99, 0x82, 0x32, 0xc6, 0x9a, 0x15, 0xda, 0x2a, 0x5f,
+	0x82, 0x93, 0x01, 0xf0, 0x83, 0xb6, 0xa3, 0xbb, 0xaa, 0xde, 0xf4, 0x08, 0xbc, 0x40, 0xe0, 0x13,
+	0xac, 0xf3, 0x7b, 0x62, 0x9f, 0xfc, 0x26, 0x94, 0x1c, 0xac, 0xb6, 0x4d, 0x15, 0x99, 0x5e, 0x43,
+	0x45, 0x1e, 0x6a, 0x34, 0xef, 0xb6, 0xcd, 0x7b, 0x0d, 0xb3, 0xdd, 0x2a, 0x41, 0x55, 0x9a, 0x19,
+	0x55, 0x4e, 0xf2, 0xfe, 0x6b, 0xc8, 0x43, 0xcb, 0x7e, 0xef, 0xad, 0x76, 0x4b, 0x7e, 0x0b, 0xca,
+	0xe1, 0x40, 0x1b, 0x39, 0xba, 0xb7, 0x2b, 0x0c, 0x2d, 0x92, 0xa1, 0x93, 0x1c, 0xb1, 0x4a, 0x00,
+	0xc1, 0xe0, 0x85, 0x91, 0x9f, 0x7c, 0xf5, 0xc7, 0xf3, 0x81, 0x33, 0xd5, 0xd6, 0x60, 0x32, 0xe2,
+	0x93, 0x0a, 0x76, 0x6d, 0xcb, 0x74, 0xb1, 0x7c, 0x15, 0x0a, 0xcc, 0xcf, 0x74, 0x95, 0x79, 0xe7,
+	0xd4, 0xc3, 0xc7, 0xd3, 0x47, 0xfe, 0xf1, 0x78, 0x3a, 0x7f, 0x47, 0x37, 0xbd, 0x2f, 0x3e, 0xbb,
+	0x50, 0x64, 0x26, 0xf4, 0x3f, 0x95, 0xa3, 0x14, 0x5d, 0x57, 0x6b, 0x3b, 0xc4, 0xd1, 0xaf, 0x61,
+	0x03, 0x73, 0x47, 0xbf, 0x0c, 0x47, 0x2d, 0x1b, 0x3b, 0x3d, 0x79, 0x3a, 0x47, 0x66, 0xba, 0xfa,
+	0xc2, 0xa8, 0x2f, 0x0c, 0xc7, 0xd7, 0x4e, 0x11, 0x69, 0xc4, 0x89, 0x03, 0x69, 0x6a, 0xbf, 0x90,
+	0x60, 0xc2, 0xef, 0xd3, 0xdd, 0xa6, 0x65, 0x7a, 0xba, 0xd9, 0x3e, 0x58, 0xce, 0xe4, 0x17, 0x60,
+	0xc8, 0xc1, 0xc8, 0xb5, 0x4c, 0xb2, 0x00, 0x0b, 0x0a, 0xfb, 0x8a, 0x72, 0x5c, 0x81, 0x6f, 0xc4,
+	0x71, 0xc5, 0xd9, 0xfe, 0xd7, 0x80, 0x10, 0x34, 0x6e, 0x6f, 0x7e, 0x80, 0x9b, 0x07, 0x14, 0x34,
+	0xa6, 0xa1, 0x68, 0x11, 0xf2, 0x14, 0x40, 0x99, 0x06, 0xda, 0x44, 0x00, 0x67, 0x60, 0xc4, 0x46,
+	0xbb, 0x86, 0x85, 0xd4, 0x86, 0xab, 0x7f, 0x88, 0x49, 0x38, 0xc8, 0x2b, 0x45, 0xd6, 0xb6, 0xa6,
+	0x7f, 0x18, 0x0d, 0x3c, 0x83, 0x7d, 0x05, 0x9e, 0x33, 0x30, 0xe2, 0xab, 0xc2, 0x0f, 0x3c, 0x7e,
+	0xf0, 0x24, 0xcb, 0xbc, 0xa0, 0x14, 0x59, 0x9b, 0x0f, 0x4f, 0x0a, 0x08, 0xc3, 0x7d, 0x05, 0x84,
+	0x57, 0xe0, 0x18, 0xbe, 0x6f, 0xfb, 0x72, 0x37, 0xef, 0xe2, 0xe6, 0x3d, 0xb7, 0xdd, 0xa2, 0x6b,
+	0x7c, 0x44, 0x19, 0xa7, 0xed, 0xcb, 0x41, 0xb3, 0xfc, 0x0e, 0x8c, 0x07, 0x8b, 0xaa, 0xb9, 0x4b,
+	0xb9, 0x2b, 0x24, 0xcb, 0xa8, 0x70, 0x28, 0x91, 0x71, 0xcc, 0xe9, 0xf8, 0x4e, 0x59, 0x86, 0xd4,
+	0xca, 0xe2, 0x32, 0x64, 0x86, 0xe9, 0x71, 0x19, 0x52, 0x74, 0x5d, 0xad, 0x7d, 0x9a, 0x83, 0xd1,
+	0x15, 0x57, 0x5b, 0xc3, 0xc8, 0x60, 0x9e, 0x73, 0x40, 0xbe, 0x9e, 0xe9, 0x3b, 0x6f, 0xc0, 0xa4,
+	0x66, 0x58, 0x9b, 0xc8, 0x68, 0x6c, 0xeb, 0x8e, 0xd7, 0x46, 0x46, 0x43, 0x73, 0xac, 0xb6, 0xed,
+	0x4b, 0x94, 0x27, 0xd1, 0x6a, 0x82, 0x76, 0x6f, 0xd0, 0xde, 0x1b, 0x7e, 0x67, 0x5d, 0x95, 0xaf,
+	0xc1, 0xb4, 0x8b, 0x9b, 0x96, 0xa9, 0x32, 0x53, 0x6f, 0x1a, 0x6e, 0x03, 0x69, 0x5a, 0xc3, 0xd5,
+	0x35, 0x13, 0x79, 0x6d, 0x07, 0xd3, 0xed, 0x64, 0x44, 0x99, 0xe2, 0xb0, 0x35, 0x7b, 0xc9, 0x70,
+	0x17, 0x35, 0x6d, 0x8d, 0x43, 0xa2, 0x2b, 0x6e, 0x12, 0x4e, 0x76, 0x28, 0x85, 0x2f, 0xb5, 0x3f,
+	0xe7, 0xc8, 0x52, 0x0b, 0x7b, 0x36, 0xe6, 0xff, 0x2f, 0x15, 0x16, 0xbb, 0x24, 0x86, 0x62, 0x97,
+	0x44, 0x7c, 0xfc, 0x15, 0x35, 0xc8, 0xb5, 0xfb, 0x2b, 0x09, 0x4e, 0xac, 0xb8, 0x9a, 0x82, 0xfd,
+	0xf6, 0xe7, 0xef, 0x92, 0x51, 0xce, 0x4f, 0xc3, 0x54, 0x0c, 0x77, 0x9c, 0xfb, 0x3f, 0xd0, 0xa5,
+	0xb4, 0x6c, 0xd9, 0xbb, 0x8c, 0xef, 0x72, 0x94, 0x6f, 0x81, 0xbb, 0x73, 0x30, 0xee, 0x3a, 0xcd,
+	0x46, 0x37, 0x87, 0xa3, 0xae, 0xd3, 0x5c, 0x0a, 0x99, 0x3c, 0x07, 0xe3, 0xaa, 0xeb, 0x75, 0xe0,
+	0x28, 0xa3, 0xa3, 0xaa, 0xeb, 0x75, 0xe2, 0x7c, 0x7a, 0xa2, 0x40, 0x79, 0x4e, 0xef, 0x76, 0xe8,
+	0x35, 0x8c, 0x9e, 0x88, 0x1b, 0xe4, 0xf4, 0x04, 0x9c, 0x02, 0x93, 0x3e, 0xae, 0xcf, 0xac, 0x6a,
+	0x42, 0x75, 0xbd, 0xd5, 0x68, 0x1c, 0x8d, 0xea, 0xf3, 0x5d, 0xb2, 0xca, 0x42, 0x7d, 0xed, 0x43,
+	0x38, 0xfb, 0xa5, 0x24, 0xa4, 0x15, 0x87, 0xcb, 0x7b, 0xc4, 0xbc, 0x23, 0xe2, 0x39, 0x8f, 0xba,
+	0xf2, 0x8e, 0x83, 0x65, 0x7d, 0x01, 0x80, 0xeb, 0xd7, 0x2d, 0x0d, 0xf8, 0xe9, 0x67, 0xba, 0x82,
+	0x0b, 0x81, 0x82, 0x5d, 0x21, 0x67, 0xc9, 0xef, 0x29, 0x67, 0x89, 0x88, 0xfc, 0xb1, 0x04, 0x63,
+	0x7c, 0x37, 0x23, 0xa1, 0xa9, 0xaf, 0x94, 0xe5, 0x34, 0x00, 0x0d, 0x7a, 0x82, 0xa4, 0x05, 0xd2,
+	0x42, 0x04, 0x9d, 0x80, 0x41, 0x7c, 0xdf, 0x73, 0x10, 0xb3, 0x0e, 0xfd, 0x88, 0x6c, 0xab, 0xab,
+	0xf0, 0x42, 0x27, 0x23, 0xdc, 0x0d, 0xaf, 0xc0, 0x51, 0x1e, 0x51, 0x7b, 0xf0, 0xc2, 0x61, 0x8d,
+	0x46, 0xd8, 0x9a, 0x47, 0x44, 0xa3, 0x96, 0xa6, 0xa2, 0xf5, 0x67, 0xc7, 0x74, 0xe1, 0xa2, 0x1a,
+	0x2f, 0x11, 0x39, 0x84, 0x59, 0xb9, 0xae, 0x3f, 0xcf, 0x11, 0xf7, 0xba, 0x63, 0xab, 0x81, 0x88,
+	0x2b, 0xb8, 0xb5, 0x89, 0x9d, 0x3e, 0xd9, 0xfa, 0x26, 0x14, 0x29, 0x5b, 0xd6, 0x8e, 0x89, 0x1d,
+	0xca, 0x57, 0xca, 0x40, 0x2a, 0xc3, 0x6d, 0x1f, 0x1b, 0x91, 0x68, 0x20, 0x6a, 0xae, 0xef, 0xc2,
+	0x58, 0x8b, 0x70, 0xe6, 0x36, 0x3c, 0xcb, 0x3f, 0x0d, 0x96, 0xf2, 0xd5, 0x81, 0x99, 0x62, 0x7c,
+	0xee, 0xb4, 0xe2, 0x6a, 0x82, 0x2c, 0xca, 0x08, 0x1b, 0xb9, 0x6e, 0x2d, 0xaa, 0xfe, 0x26, 0x77,
+	0x5c, 0xa0, 0xa4, 0x12, 0xa5, 0x94, 0x06, 0x89, 0xa3, 0x27, 0x73, 0x3a, 0xce, 0x49, 0x50, 0x2d,
+	0xc6, 0xfb, 0x74, 0x97, 0x1a, 0xb9, 0x9e, 0xff, 0x13, 0x6c, 0x5f, 0x26, 0xde, 0x39, 0xcc, 0x6a,
+	0x7e, 0x1b, 0x86, 0x99, 0xa4, 0x7b, 0xd0, 0x6f, 0x30, 0x24, 0x69, 0x53, 0xec, 0x94, 0x99, 0xeb,
+	0xe4, 0x13, 0xba, 0xce, 0x45, 0x75, 0x5c, 0x84, 0x21, 0x4a, 0x2b, 0x53, 0x19, 0x0c, 0x27, 0xd7,
+	0xc1, 0x4f, 0x2a, 0x74, 0x07, 0xf9, 0x67, 0xe2, 0x86, 0xa7, 0xb3, 0xd5, 0x50, 0x9c, 0x2f, 0xcf,
+	0xd2, 0xca, 0xd0, 0x6c, 0x50, 0x19, 0x9a, 0x5d, 0x0f, 0x2a, 0x43, 0x4b, 0xf9, 0x07, 0xff, 0x9c,
+	0x96, 0x94, 0xb1, 0x70, 0xa0, 0xdf, 0x55, 0xfb, 0x0b, 0xb5, 0x91, 0x60, 0xc4, 0xef, 0xf8, 0x31,
+	0xe1, 0xd0, 0xd9, 0x88, 0x47, 0xae, 0xbc, 0x18, 0xb9, 0x62, 0x75, 0x1f, 0x95, 0x85, 0xeb, 0xfe,
+	0xf7, 0x12, 0x49, 0x48, 0x6e, 0x62, 0xb4, 0xcd, 0xe2, 0xd0, 0xde, 0x55, 0x7f, 0x60, 0x12, 0x2e,
+	0x14, 0x7d, 0x59, 0xd8, 0x34, 0x2c, 0xe1, 0x0e, 0x39, 0x0d, 0xb7, 0xc6, 0x9c, 0x60, 0x2f, 0x9a,
+	0xee, 0xd4, 0xcd, 0x2d, 0xeb, 0xa0, 0x76, 0xc6, 0x9b, 0xb1, 0xa5, 0x9f, 0x01, 0xe2, 0x6c, 0x95,
+	0x98, 0x84, 0xe7, 0x4e, 0xdd, 0xf4, 0xae, 0x5c, 0xde, 0x40, 0x46, 0x1b, 0xc7, 0x94, 0x86, 0xf6,
+	0xa1, 0x40, 0xb6, 0x0f, 0xc7, 0xe5, 0x34, 0xaf, 0x09, 0x35, 0xca, 0x35, 0xfe, 0x6b, 0x89, 0xa6,
+	0x65, 0xc8, 0x6c, 0x62, 0xa3, 0xa3, 0xa6, 0x70, 0x48, 0x12, 0xa9, 0x69, 0x38, 0x1d, 0xcb, 0x9f,
+	0x78, 0x48, 0x1b, 0x59, 0x71, 0xb5, 0xd5, 0xb6, 0xb7, 0x6a, 0x19, 0x7a, 0x73, 0xb7, 0x4f, 0xc6,
+	0xbf, 0x05, 0x05, 0xdb, 0xd1, 0xcd, 0xa6, 0x6e, 0x23, 0x83, 0xc5, 0x9b, 0xaa, 0xa8, 0xf9, 0xb0,
+	0x4a, 0x3c, 0xbb, 0x1a, 0xe0, 0x94, 0x70, 0x88, 0x9f, 0xfd, 0x3b, 0xd8, 0xb5, 0xda, 0x4e, 0x33,
+	0x10, 0x8a, 0x7f, 0xcb, 0xdf, 0x06, 0x70, 0x3d, 0xe4, 0x61, 0xdf, 0xd4, 0x41, 0x14, 0x4e, 0x22,
+	0xbe, 0x16, 0x00, 0x15, 0x61, 0x8c, 0xbc, 0xd2, 0x1d, 0x13, 0x87, 0x33, 0x63, 0xe2, 0xd1, 0x87,
+	0x8f, 0xa7, 0xa5, 0xb8, 0xb8, 0x18, 0xd5, 0xf1, 0x2a, 0xc9, 0x18, 0xb8, 0x06, 0xc5, 0xcc, 0xdc,
+	0x26, 0x2d, 0xc1, 0x29, 0x33, 0x2b, 0x33, 0xa7, 0xe8, 0xba, 0x5a, 0xfb, 0x93, 0x98, 0x99, 0x1f,
+	0x56, 0xbb, 0x44, 0xd5, 0xb0, 0x26, 0xe4, 0xec, 0xfb, 0xa6, 0x89, 0x7f, 0x53, 0x4d, 0xac, 0xe8,
+	0x8e, 0x63, 0x39, 0xcf, 0xb4, 0xb4, 0x5e, 0x85, 0x9c, 0xae, 0xb2, 0x98, 0x9c, 0x3a, 0x79, 0x4e,
+	0x57, 0xa3, 0xeb, 0x70, 0x20, 0x6b, 0x1d, 0xe6, 0xbb, 0x0a, 0x0e, 0x35, 0x18, 0x55, 0xb1, 0xeb,
+	0x9f, 0xf8, 0x91, 0x6e, 0xfa, 0x62, 0x0f, 0x92, 0x32, 0x43, 0xd1, 0x6f, 0x5c, 0xf6, 0xdb, 0xea,
+	0x6a, 0xfc, 0xa1, 0x47, 0x14, 0x95, 0xaf, 0xd2, 0x87, 0xa2, 0x1a, 0x9e, 0xa9, 0xce, 0xba, 0xbf,
+	0x6a, 0xe8, 0x92, 0x32, 0x9f, 0x29, 0xa5, 0x18, 0x51, 0xa9, 0x94, 0x1d, 0x11, 0xf5, 0x4b, 0x31,
+	0xe7, 0x08, 0xfb, 0x9f, 0x5b, 0xe1, 0xa8, 0x73, 0x4f, 0xc9, 0xef, 0xc7, 0x9e, 0x22, 0xda, 0x39,
+	0x52, 0x9d, 0xfe, 0x9c, 0x66, 0x80, 0xb4, 0xef, 0x59, 0x8e, 0x43, 0x7b, 0x32, 0x73, 0x46, 0x7a,
+	0xd5, 0x87, 0x91, 0xe9, 0xf9, 0x4a, 0x10, 0x83, 0x4b, 0xf8, 0x29, 0xf5, 0x64, 0x6a, 0xdf, 0x55,
+	0x72, 0xdd, 0x27, 0x5f, 0x81, 0x02, 0x6a, 0x7b, 0x77, 0x2d, 0xc7, 0x57, 0x71, 0x96, 0x8c, 0x21,
+	0x54, 0xbe, 0x0a, 0x43, 0xf4, 0xc2, 0x30, 0xcc, 0x70, 0xbb, 0xed, 0x42, 0xe7, 0x58, 0xca, 0xfb,
+	0x4a, 0x50, 0x18, 0x7e, 0x61, 0xcc, 0x67, 0x37, 0xa4, 0xc4, 0x4c, 0x22, 0x32, 0xc5, 0x19, 0xfe,
+	0xaf, 0x04, 0xc7, 0x88, 0x2c, 0x9a, 0x83, 0x0e, 0xf8, 0xf6, 0x45, 0x7e, 0x05, 0x8e, 0x47, 0xea,
+	0x48, 0xba, 0x4a, 0xec, 0x31, 0xaa, 0x8c, 0x89, 0x45, 0xa2, 0xba, 0x9a, 0x56, 0x72, 0xca, 0xef,
+	0x53, 0xc9, 0xa9, 0x0c, 0xa5, 0xa8, 0xe0, 0x61, 0x49, 0x22, 0x47, 0x3a, 0x97, 0xad, 0x96, 0xed,
+	0xc7, 0xfb, 0xaf, 0x45, 0x3b, 0x4b, 0x50, 0x89, 0xad, 0xe1, 0x6e, 0xa1, 0x96, 0x6e, 0xec, 0x86,
+	0xaa, 0x2a, 0x77, 0x97, 0x72, 0xaf, 0x13, 0x48, 0x5d, 0x95, 0x17, 0x61, 0x44, 0xdb, 0xd6, 0x1a,
+	0x2d, 0x64, 0xdb, 0xba, 0xa9, 0x05, 0xd9, 0x44, 0x25, 0xce, 0x71, 0x6e, 0x6c, 0xdc, 0x58, 0xa1,
+	0x30, 0xa5, 0xa8, 0x6d, 0x6b, 0xec, 0xff, 0xae, 0x33, 0x5d, 0x0d, 0xaa, 0x49, 0x8a, 0xe0, 0xda,
+	0xfa, 0x88, 0x96, 0x4d, 0x48, 0x16, 0xf6, 0x75, 0xa8, 0x2a, 0xca, 0x63, 0x15, 0x2a, 0xf1, 0xf3,
+	0x47, 0x38, 0xa4, 0xe5, 0xda, 0xe7, 0xc7, 0x61, 0xcc, 0xfc, 0x9c, 0xc3, 0xdf, 0x4a, 0x50, 0x20,
+	0xb5, 0x70, 0x6f, 0x1d, 0x69, 0x7d, 0x72, 0x25, 0x66, 0x33, 0xb9, 0x48, 0x96, 0x79, 0x19, 0xf2,
+	0x1e, 0xd2, 0x5c, 0x76, 0x7e, 0xa9, 0xc6, 0xdf, 0x40, 0x51, 0xec, 0x3a, 0xd2, 0x5c, 0x85, 0xa0,
+	0xa3, 0x62, 0x9c, 0x80, 0xe3, 0x9c, 0x47, 0xce, 0xf9, 0x83, 0x1c, 0x51, 0xae, 0xb8, 0xa5, 0x2d,
+	0xd3, 0xdb, 0xb7, 0xe7, 0xb6, 0xab, 0xf5, 0x70, 0xf7, 0x18, 0xbd, 0x37, 0x1c, 0xec, 0xbe, 0x37,
+	0xec, 0xff, 0x5e, 0x83, 0x9a, 0x3b, 0x46, 0x23, 0x5c, 0x69, 0xbf, 0x93, 0x48, 0x01, 0x89, 0xfa,
+	0xec, 0x21, 0x52, 0x5d, 0x54, 0x92, 0x73, 0xf0, 0x62, 0x1a, 0x9b, 0x5c, 0x9e, 0xbf, 0x0d, 0xf0,
+	0xf4, 0x58, 0x43, 0x1e, 0xde, 0x87, 0xb3, 0xa2, 0x50, 0x02, 0xce, 0xf5, 0x79, 0x6b, 0xdd, 0x47,
+	0x5e, 0x1b, 0xf5, 0x9c, 0xc1, 0x6c, 0xcf, 0x89, 0xb9, 0x71, 0xee, 0xcc, 0xaa, 0x86, 0xfb, 0xba,
+	0xd8, 0x7e, 0x5e, 0x17, 0xcd, 0x11, 0x07, 0xf8, 0x01, 0x4c, 0x27, 0xd8, 0x75, 0x1f, 0xae, 0x68,
+	0xfe, 0x9a, 0x23, 0x0b, 0x25, 0xa0, 0xbe, 0x7f, 0xeb, 0x60, 0x1e, 0x86, 0xdb, 0x84, 0x58, 0x0f,
+	0xce, 0xc3, 0x80, 0x87, 0xc6, 0x79, 0xe2, 0x0c, 0x3f, 0xdc, 0x53, 0xd8, 0x99, 0x81, 0x73, 0xe9,
+	0xda, 0xe4, 0xcb, 0xf5, 0xa7, 0x12, 0x39, 0xa6, 0xac, 0x5b, 0x9a, 0x66, 0xe0, 0xb5, 0xd5, 0x45,
+	0x37, 0x18, 0xa4, 0x2e, 0x6a, 0x07, 0x17, 0x7d, 0xa2, 0xfc, 0xbe, 0x04, 0x67, 0x53, 0x98, 0xe0,
+	0xcc, 0x7e, 0x95, 0x83, 0x53, 0x74, 0xdb, 0xa1, 0x7b, 0xe6, 0x75, 0xc3, 0xda, 0x51, 0x90, 0x87,
+	0x6f, 0xea, 0x2d, 0xfd, 0xc0, 0x02, 0xe5, 0x5b, 0x30, 0xc2, 0x00, 0xb4, 0xda, 0x39, 0x90, 0x41,
+	0x9a, 0x91, 0xa3, 0xe5, 0xce, 0x7d, 0x28, 0xf6, 0xa9, 0x30, 0xbe, 0x65, 0x58, 0x3b, 0x0d, 0x3f,
+	0x55, 0x68, 0x18, 0xbe, 0xa4, 0xec, 0x29, 0xdc, 0xdb, 0x6c, 0x69, 0x9d, 0xd3, 0x74, 0xef, 0x6e,
+	0x7b, 0xd3, 0xcf, 0x7d, 0xd9, 0xbb, 0x49, 0xf6, 0xe7, 0x82, 0xab, 0xde, 0x63, 0x0f, 0x09, 0xeb,
+	0x64, 0xf1, 0x01, 0x9b, 0xb0, 0x6e, 0x7a, 0xca, 0xe8, 0x96, 0xa8, 0xbc, 0xa8, 0x41, 0xce, 0xc2,
+	0x99, 0x44, 0x45, 0x07, 0xe6, 0x98, 0xff, 0x4d, 0x05, 0x06, 0x56, 0x5c, 0x4d, 0x7e, 0x1f, 0x46,
+	0x3a, 0xde, 0x26, 0x9e, 0x4d, 0xb8, 0x39, 0x10, 0x41, 0xe5, 0x57, 0x7b, 0x00, 0xf1, 0xc0, 0xf2,
+	0x3e, 0x8c, 0x74, 0x3c, 0x0a, 0x4b, 0x9a, 0x41, 0x04, 0x25, 0xce, 0x10, 0xf7, 0xca, 0x4b, 0x36,
+	0xe0, 0x58, 0x57, 0x39, 0xf9, 0xe5, 0x04, 0x02, 0x51, 0x60, 0x79, 0xae, 0x47, 0xa0, 0x28, 0x4f,
+	0x47, 0x89, 0x23, 0x49, 0x1e, 0x11, 0x94, 0x28, 0x4f, 0xdc, 0x01, 0x5b, 0xb6, 0xe0, 0x78, 0xf7,
+	0x8b, 0xb5, 0x99, 0x24, 0x8d, 0x44, 0x91, 0xe5, 0x8b, 0xbd, 0x22, 0xf9, 0x84, 0x3f, 0x93, 0xa0,
+	0x94, 0x18, 0x45, 0x92, 0x14, 0x94, 0x34, 0xa0, 0xfc, 0xe6, 0x1e, 0x07, 0x88, 0x9a, 0xed, 0x48,
+	0x39, 0xd2, 0x7d, 0x91, 0x82, 0x32, 0x7c, 0x31, 0xb2, 0xc9, 0xbd, 0x07, 0x20, 0xbc, 0x42, 0x39,
+	0x93, 0x30, 0x34, 0x84, 0x94, 0x5f, 0xc9, 0x84, 0x88, 0xdc, 0x77, 0xbc, 0x22, 0x3a, 0x9b, 0x39,
+	0x74, 0x63, 0x3e, 0x91, 0xfb, 0xb8, 0xd7, 0x34, 0xbe, 0x9f, 0x77, 0xbd, 0xa4, 0x49, 0xf2, 0xf3,
+	0x28, 0x30, 0xd1, 0xcf, 0x93, 0x5e, 0xbf, 0xf8, 0xba, 0x12, 0x5e, 0xbe, 0x24, 0xe9, 0x2a, 0x84,
+	0x24, 0xea, 0x2a, 0xe6, 0x3d, 0x08, 0x8f, 0x09, 0x19, 0x96, 0x16, 0x41, 0x19, 0x31, 0x21, 0x32,
+	0x83, 0x03, 0x72, 0xcc, 0x85, 0x47, 0x22, 0x8b, 0x5d, 0xd0, 0xf2, 0xeb, 0x3d, 0x43, 0xbb, 0x23,
+	0x43, 0x86, 0x54, 0x22, 0x28, 0x23, 0x32, 0x44, 0x66, 0xe8, 0x8c, 0x0c, 0x6c, 0x9a, 0x1e, 0x22,
+	0x03, 0x9b, 0xeb, 0x62, 0xaf, 0xc8, 0xee, 0xd0, 0x2a, 0x54, 0x39, 0xd3, 0x43, 0x6b, 0x08, 0xcc,
+	0x08, 0xad, 0xdd, 0x75, 0x55, 0xb9, 0x0d, 0x27, 0xe2, 0xb2, 0xc7, 0xf3, 0x3d, 0xd0, 0x61, 0xd8,
+	0xf2, 0x7c, 0xef, 0x58, 0x3e, 0xed, 0xc7, 0x12, 0x9c, 0x4a, 0x3e, 0xc3, 0x5d, 0x4c, 0x75, 0x84,
+	0x38, 0x1e, 0xae, 0xee, 0x75, 0x04, 0xe7, 0xe4, 0x3e, 0x4c, 0xc4, 0x1e, 0xbe, 0xd2, 0x5c, 0x3f,
+	0x0a, 0x2e, 0x5f, 0xda, 0x03, 0x98, 0xcf, 0xfc, 0x89, 0x04, 0x53, 0x69, 0x19, 0xfc, 0x7c, 0x06,
+	0xd1, 0x38, 0x3d, 0x2c, 0xec, 0x7d, 0x0c, 0xe7, 0xe7, 0x87, 0x50, 0x14, 0x9f, 0x12, 0xd5, 0x52,
+	0xa3, 0x3c, 0xc1, 0x94, 0xcf, 0x67, 0x63, 0x44, 0xf2, 0xe2, 0x73, 0x9e, 0x5a, 0x6a, 0x68, 0x49,
+	0x27, 0x1f, 0xf3, 0x40, 0xc7, 0x5f, 0xa7, 0xdd, 0x8f, 0x73, 0x66, 0x52, 0x5d, 0x53, 0x40, 0x26,
+	0xae, 0xd3, 0xc4, 0x97, 0x2a, 0xe1, 0x3a, 0x15, 0x5e, 0x40, 0xbc, 0x9c, 0x4d, 0x85, 0x00, 0x33,
+	0xd6, 0x69, 0xf7, 0x3b, 0x04, 0x7f, 0x6b, 0x10, 0xde, 0x20, 0x24, 0x6d, 0x0d, 0x21, 0x24, 0x71,
+	0x6b, 0xe8, 0x7e, 0x1f, 0xe0, 0x5b, 0x46, 0xbc, 0x59, 0xa8, 0xa5, 0x86, 0xc7, 0x74, 0xcb, 0xc4,
+	0x94, 0xf6, 0xe9, 0x1e, 0x1a, 0x79, 0xce, 0x93, 0xbc, 0x87, 0x76, 0x02, 0x53, 0xf6, 0xd0, 0xf8,
+	0xc7, 0x32, 0xf2, 0xf7, 0xa1, 0x10, 0x5e, 0x5a, 0x57, 0x13, 0x46, 0x73, 0x44, 0x79, 0x26, 0x0b,
+	0xd1, 0xbd, 0x81, 0x32, 0xda, 0xe9, 0x1b, 0x28, 0x23, 0xff, 0x6a, 0x0f, 0x20, 0x71, 0x86, 0x8e,
+	0xfb, 0x8f, 0xb3, 0xa9, 0x4e, 0x42, 0x41, 0x89, 0x33, 0xc4, 0x5d, 0x5a, 0xc8, 0x4d, 0x18, 0xed,
+	0xac, 0xe2, 0xbe, 0x98, 0x68, 0x47, 0x01, 0x55, 0x7e, 0xad, 0x17, 0x14, 0x9f, 0xe4, 0xc7, 0x70,
+	0x32, 0xbe, 0xfe, 0xff, 0x5a, 0x62, 0xb6, 0x12, 0x83, 0x2e, 0x5f, 0xde, 0x0b, 0x5a, 0xdc, 0xcf,
+	0xe2, 0xea, 0xe9, 0xe7, 0x53, 0xf7, 0x87, 0xce, 0x89, 0xe7, 0x7b, 0xc7, 0x8a, 0xd3, 0xc6, 0x15,
+	0xc9, 0xcf, 0xa7, 0x66, 0x80, 0xbd, 0x4d, 0x9b, 0x52, 0xfc, 0x96, 0x6f, 0xc1, 0x10, 0x2b, 0x7c,
+	0x9f, 0x4e, 0xcc, 0x6a, 0xfd, 0xee, 0xf2, 0x4b, 0xa9, 0xdd, 0x9c, 0xde, 0x47, 0xf0, 0x42, 0x42,
+	0xb5, 0xe0, 0x42, 0x32, 0x81, 0x18, 0x78, 0xf9, 0x8d, 0x3d, 0xc1, 0x83, 0xf9, 0x97, 0xea, 0x0f,
+	0x9f, 0x54, 0xa4, 0x47, 0x4f, 0x2a, 0xd2, 0x97, 0x4f, 0x2a, 0xd2, 0x83, 0xa7, 0x95, 0x23, 0x8f,
+	0x9e, 0x56, 0x8e, 0xfc, 0xfd, 0x69, 0xe5, 0xc8, 0x7b, 0x73, 0xc2, 0xa9, 0x7d, 0xd3, 0xdc, 0xbc,
+	0x40, 0x2e, 0x1d, 0xe7, 0x84, 0x5f, 0x02, 0xde, 0xef, 0xfc, 0x2d, 0xe0, 0xe6, 0x10, 0x79, 0xba,
+	0x71, 0xe9, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x60, 0xe5, 0x01, 0x83, 0x73, 0x39, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5663,6 +5706,34 @@ func (m *MsgCreateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RedundantParityChunkNum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RedundantParityChunkNum))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.RedundantDataChunkNum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RedundantDataChunkNum))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.AllowedJurisdictions) > 0 {
+		for iNdEx := len(m.AllowedJurisdictions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedJurisdictions[iNdEx])
+			copy(dAtA[i:], m.AllowedJurisdictions[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedJurisdictions[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AllowedRegions) > 0 {
+		for iNdEx := len(m.AllowedRegions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRegions[iNdEx])
+			copy(dAtA[i:], m.AllowedRegions[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedRegions[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.ChargedReadQuota != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.ChargedReadQuota))
 		i--
@@ -8576,6 +8647,24 @@ func (m *MsgCreateBucket) Size() (n int) {
 	if m.ChargedReadQuota != 0 {
 		n += 1 + sovTx(uint64(m.ChargedReadQuota))
 	}
+	if len(m.AllowedRegions) > 0 {
+		for _, s := range m.AllowedRegions {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AllowedJurisdictions) > 0 {
+		for _, s := range m.AllowedJurisdictions {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.RedundantDataChunkNum != 0 {
+		n += 1 + sovTx(uint64(m.RedundantDataChunkNum))
+	}
+	if m.RedundantParityChunkNum != 0 {
+		n += 1 + sovTx(uint64(m.RedundantParityChunkNum))
+	}
 	return n
 }
 
@@ -10057,6 +10146,108 @@ func (m *MsgCreateBucket) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRegions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRegions = append(m.AllowedRegions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedJurisdictions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedJurisdictions = append(m.AllowedJurisdictions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundantDataChunkNum", wireType)
+			}
+			m.RedundantDataChunkNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundantDataChunkNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundantParityChunkNum", wireType)
+			}
+			m.RedundantParityChunkNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundantParityChunkNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
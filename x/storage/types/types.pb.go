@@ -55,6 +55,11 @@ type BucketInfo struct {
 	// sp_as_delegated_agent_disabled indicates that whether bucket owner disable SP as the upload agent.
 	// when a bucket is created, by default, this is false, means SP is allowed to create object for delegator
 	SpAsDelegatedAgentDisabled bool `protobuf:"varint,12,opt,name=sp_as_delegated_agent_disabled,json=spAsDelegatedAgentDisabled,proto3" json:"sp_as_delegated_agent_disabled,omitempty"`
+	// placement_constraint restricts the locations of the storage providers which store the data of the bucket
+	PlacementConstraint *PlacementConstraint `protobuf:"bytes,13,opt,name=placement_constraint,json=placementConstraint,proto3" json:"placement_constraint,omitempty"`
+	// ec_profile is the EC redundancy profile of the objects in the bucket, the default profile of the versioned params is
+	// used if it is not set.
+	EcProfile *ECProfile `protobuf:"bytes,14,opt,name=ec_profile,json=ecProfile,proto3" json:"ec_profile,omitempty"`
 }
 
 func (m *BucketInfo) Reset()         { *m = BucketInfo{} }
@@ -167,6 +172,76 @@ func (m *BucketInfo) GetSpAsDelegatedAgentDisabled() bool {
 	return false
 }
 
+func (m *BucketInfo) GetPlacementConstraint() *PlacementConstraint {
+	if m != nil {
+		return m.PlacementConstraint
+	}
+	return nil
+}
+
+func (m *BucketInfo) GetEcProfile() *ECProfile {
+	if m != nil {
+		return m.EcProfile
+	}
+	return nil
+}
+
+// PlacementConstraint defines the allowed locations of the storage providers serving a bucket.
+// An empty list means no restriction on the attribute.
+type PlacementConstraint struct {
+	// allowed_regions defines the region codes the storage providers must be located in
+	AllowedRegions []string `protobuf:"bytes,1,rep,name=allowed_regions,json=allowedRegions,proto3" json:"allowed_regions,omitempty"`
+	// allowed_jurisdictions defines the jurisdiction codes the storage providers must be subject to
+	AllowedJurisdictions []string `protobuf:"bytes,2,rep,name=allowed_jurisdictions,json=allowedJurisdictions,proto3" json:"allowed_jurisdictions,omitempty"`
+}
+
+func (m *PlacementConstraint) Reset()         { *m = PlacementConstraint{} }
+func (m *PlacementConstraint) String() string { return proto.CompactTextString(m) }
+func (*PlacementConstraint) ProtoMessage()    {}
+func (*PlacementConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf95fa2efdc74d97, []int{1}
+}
+func (m *PlacementConstraint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlacementConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlacementConstraint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlacementConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlacementConstraint.Merge(m, src)
+}
+func (m *PlacementConstraint) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlacementConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlacementConstraint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlacementConstraint proto.InternalMessageInfo
+
+func (m *PlacementConstraint) GetAllowedRegions() []string {
+	if m != nil {
+		return m.AllowedRegions
+	}
+	return nil
+}
+
+func (m *PlacementConstraint) GetAllowedJurisdictions() []string {
+	if m != nil {
+		return m.AllowedJurisdictions
+	}
+	return nil
+}
+
 type InternalBucketInfo struct {
 	// the time of the payment price, used to calculate the charge rate of the bucket
 	PriceTime int64 `protobuf:"varint,1,opt,name=price_time,json=priceTime,proto3" json:"price_time,omitempty"`
@@ -182,7 +257,7 @@ func (m *InternalBucketInfo) Reset()         { *m = InternalBucketInfo{} }
 func (m *InternalBucketInfo) String() string { return proto.CompactTextString(m) }
 func (*InternalBucketInfo) ProtoMessage()    {}
 func (*InternalBucketInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{1}
+	return fileDescriptor_bf95fa2efdc74d97, []int{2}
 }
 func (m *InternalBucketInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -284,7 +359,7 @@ func (m *ObjectInfo) Reset()         { *m = ObjectInfo{} }
 func (m *ObjectInfo) String() string { return proto.CompactTextString(m) }
 func (*ObjectInfo) ProtoMessage()    {}
 func (*ObjectInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{2}
+	return fileDescriptor_bf95fa2efdc74d97, []int{3}
 }
 func (m *ObjectInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -458,7 +533,7 @@ func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
 func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupInfo) ProtoMessage()    {}
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{3}
+	return fileDescriptor_bf95fa2efdc74d97, []int{4}
 }
 func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -531,7 +606,7 @@ func (m *Trait) Reset()         { *m = Trait{} }
 func (m *Trait) String() string { return proto.CompactTextString(m) }
 func (*Trait) ProtoMessage()    {}
 func (*Trait) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{4}
+	return fileDescriptor_bf95fa2efdc74d97, []int{5}
 }
 func (m *Trait) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -591,7 +666,7 @@ func (m *BucketMetaData) Reset()         { *m = BucketMetaData{} }
 func (m *BucketMetaData) String() string { return proto.CompactTextString(m) }
 func (*BucketMetaData) ProtoMessage()    {}
 func (*BucketMetaData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{5}
+	return fileDescriptor_bf95fa2efdc74d97, []int{6}
 }
 func (m *BucketMetaData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -672,7 +747,7 @@ func (m *ObjectMetaData) Reset()         { *m = ObjectMetaData{} }
 func (m *ObjectMetaData) String() string { return proto.CompactTextString(m) }
 func (*ObjectMetaData) ProtoMessage()    {}
 func (*ObjectMetaData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{6}
+	return fileDescriptor_bf95fa2efdc74d97, []int{7}
 }
 func (m *ObjectMetaData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -753,7 +828,7 @@ func (m *GroupMetaData) Reset()         { *m = GroupMetaData{} }
 func (m *GroupMetaData) String() string { return proto.CompactTextString(m) }
 func (*GroupMetaData) ProtoMessage()    {}
 func (*GroupMetaData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{7}
+	return fileDescriptor_bf95fa2efdc74d97, []int{8}
 }
 func (m *GroupMetaData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -826,7 +901,7 @@ func (m *Ids) Reset()         { *m = Ids{} }
 func (m *Ids) String() string { return proto.CompactTextString(m) }
 func (*Ids) ProtoMessage()    {}
 func (*Ids) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{8}
+	return fileDescriptor_bf95fa2efdc74d97, []int{9}
 }
 func (m *Ids) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -865,7 +940,7 @@ func (m *DeleteInfo) Reset()         { *m = DeleteInfo{} }
 func (m *DeleteInfo) String() string { return proto.CompactTextString(m) }
 func (*DeleteInfo) ProtoMessage()    {}
 func (*DeleteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{9}
+	return fileDescriptor_bf95fa2efdc74d97, []int{10}
 }
 func (m *DeleteInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -927,7 +1002,7 @@ func (m *MigrationBucketInfo) Reset()         { *m = MigrationBucketInfo{} }
 func (m *MigrationBucketInfo) String() string { return proto.CompactTextString(m) }
 func (*MigrationBucketInfo) ProtoMessage()    {}
 func (*MigrationBucketInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{10}
+	return fileDescriptor_bf95fa2efdc74d97, []int{11}
 }
 func (m *MigrationBucketInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -986,7 +1061,7 @@ func (m *ResourceTags) Reset()         { *m = ResourceTags{} }
 func (m *ResourceTags) String() string { return proto.CompactTextString(m) }
 func (*ResourceTags) ProtoMessage()    {}
 func (*ResourceTags) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{11}
+	return fileDescriptor_bf95fa2efdc74d97, []int{12}
 }
 func (m *ResourceTags) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1031,7 +1106,7 @@ func (m *ResourceTags_Tag) Reset()         { *m = ResourceTags_Tag{} }
 func (m *ResourceTags_Tag) String() string { return proto.CompactTextString(m) }
 func (*ResourceTags_Tag) ProtoMessage()    {}
 func (*ResourceTags_Tag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{11, 0}
+	return fileDescriptor_bf95fa2efdc74d97, []int{12, 0}
 }
 func (m *ResourceTags_Tag) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1095,7 +1170,7 @@ func (m *ShadowObjectInfo) Reset()         { *m = ShadowObjectInfo{} }
 func (m *ShadowObjectInfo) String() string { return proto.CompactTextString(m) }
 func (*ShadowObjectInfo) ProtoMessage()    {}
 func (*ShadowObjectInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{12}
+	return fileDescriptor_bf95fa2efdc74d97, []int{13}
 }
 func (m *ShadowObjectInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1176,7 +1251,7 @@ func (m *BucketExtraInfo) Reset()         { *m = BucketExtraInfo{} }
 func (m *BucketExtraInfo) String() string { return proto.CompactTextString(m) }
 func (*BucketExtraInfo) ProtoMessage()    {}
 func (*BucketExtraInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{13}
+	return fileDescriptor_bf95fa2efdc74d97, []int{14}
 }
 func (m *BucketExtraInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1214,6 +1289,7 @@ func (m *BucketExtraInfo) GetIsRateLimited() bool {
 
 func init() {
 	proto.RegisterType((*BucketInfo)(nil), "greenfield.storage.BucketInfo")
+	proto.RegisterType((*PlacementConstraint)(nil), "greenfield.storage.PlacementConstraint")
 	proto.RegisterType((*InternalBucketInfo)(nil), "greenfield.storage.InternalBucketInfo")
 	proto.RegisterType((*ObjectInfo)(nil), "greenfield.storage.ObjectInfo")
 	proto.RegisterType((*GroupInfo)(nil), "greenfield.storage.GroupInfo")
@@ -1233,102 +1309,109 @@ func init() {
 func init() { proto.RegisterFile("greenfield/storage/types.proto", fileDescriptor_bf95fa2efdc74d97) }
 
 var fileDescriptor_bf95fa2efdc74d97 = []byte{
-	// 1507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdf, 0x6e, 0x1b, 0x45,
-	0x17, 0xcf, 0x7a, 0xed, 0xc4, 0x3e, 0x8e, 0xe3, 0x64, 0x1b, 0x7d, 0xdd, 0xa6, 0xaa, 0xe3, 0x5a,
-	0xdf, 0x57, 0x59, 0x1f, 0x24, 0x56, 0xd3, 0xaa, 0x20, 0x54, 0x51, 0xc5, 0xf4, 0x8f, 0x2c, 0x5a,
-	0x10, 0x9b, 0xb4, 0x48, 0xdc, 0xac, 0xc6, 0xbb, 0x93, 0xcd, 0x90, 0xf5, 0x8e, 0x99, 0x99, 0x4d,
-	0xe3, 0x4a, 0x3c, 0x00, 0x5c, 0x71, 0xc1, 0x13, 0x20, 0xf1, 0x02, 0xa8, 0x0f, 0x51, 0x21, 0x21,
-	0x55, 0xbd, 0x42, 0x5c, 0x54, 0xa8, 0x7d, 0x03, 0x2e, 0xb8, 0x46, 0xf3, 0xc7, 0xee, 0xc6, 0x76,
-	0x9a, 0xa4, 0xb4, 0x57, 0xf1, 0x9c, 0xf9, 0x9d, 0x39, 0x73, 0x7e, 0xe7, 0xdf, 0x6c, 0xa0, 0x16,
-	0x31, 0x8c, 0x93, 0x1d, 0x82, 0xe3, 0xb0, 0xc5, 0x05, 0x65, 0x28, 0xc2, 0x2d, 0x31, 0xe8, 0x63,
-	0xbe, 0xde, 0x67, 0x54, 0x50, 0xc7, 0x79, 0xb5, 0xbf, 0x6e, 0xf6, 0x57, 0x6a, 0x01, 0xe5, 0x3d,
-	0xca, 0x5b, 0x5d, 0xc4, 0x71, 0x6b, 0xff, 0x72, 0x17, 0x0b, 0x74, 0xb9, 0x15, 0x50, 0x92, 0x68,
-	0x9d, 0x95, 0x73, 0x7a, 0xdf, 0x57, 0xab, 0x96, 0x5e, 0x98, 0xad, 0xe5, 0x88, 0x46, 0x54, 0xcb,
-	0xe5, 0x2f, 0x23, 0xbd, 0x98, 0xb9, 0x44, 0x1f, 0x0d, 0x7a, 0x38, 0x11, 0x2d, 0x9a, 0x0a, 0x7f,
-	0x27, 0xa6, 0x0f, 0x0d, 0xe4, 0xd2, 0x14, 0x08, 0x17, 0x0c, 0xa3, 0x9e, 0xcf, 0x70, 0x40, 0x59,
-	0x68, 0x70, 0xab, 0x53, 0xfc, 0x09, 0x68, 0xaf, 0x47, 0xcd, 0xe5, 0x1a, 0x3f, 0x15, 0x00, 0xda,
-	0x69, 0xb0, 0x87, 0x45, 0x27, 0xd9, 0xa1, 0xce, 0x3a, 0x14, 0xe8, 0xc3, 0x04, 0x33, 0xd7, 0xaa,
-	0x5b, 0xcd, 0x52, 0xdb, 0x7d, 0xf6, 0x78, 0x6d, 0xd9, 0xdc, 0x78, 0x33, 0x0c, 0x19, 0xe6, 0x7c,
-	0x4b, 0x30, 0x92, 0x44, 0x9e, 0x86, 0x39, 0xab, 0x50, 0xee, 0x2a, 0x6d, 0x3f, 0x41, 0x3d, 0xec,
-	0xe6, 0xa4, 0x96, 0x07, 0x5a, 0xf4, 0x19, 0xea, 0x61, 0xa7, 0x0d, 0xb0, 0x4f, 0x38, 0xe9, 0x92,
-	0x98, 0x88, 0x81, 0x6b, 0xd7, 0xad, 0xe6, 0xc2, 0x46, 0x63, 0x7d, 0x92, 0xc5, 0xf5, 0x07, 0x23,
-	0xd4, 0xf6, 0xa0, 0x8f, 0xbd, 0x8c, 0x96, 0xf3, 0x1e, 0xe4, 0x48, 0xe8, 0xe6, 0xd5, 0x8d, 0xce,
-	0x3f, 0x79, 0xbe, 0x3a, 0xf3, 0xc7, 0xf3, 0xd5, 0xfc, 0x7d, 0x92, 0x88, 0x67, 0x8f, 0xd7, 0xca,
-	0xe6, 0x76, 0x72, 0xe9, 0xe5, 0x48, 0xe8, 0xdc, 0x80, 0x32, 0xa7, 0x29, 0x0b, 0xb0, 0x2f, 0xe3,
-	0xe6, 0x16, 0x94, 0xc5, 0xda, 0x34, 0x8b, 0x5b, 0x0a, 0xa6, 0xad, 0xf1, 0xd1, 0x6f, 0xe7, 0x3c,
-	0x94, 0x02, 0x86, 0x91, 0xc0, 0x3e, 0x12, 0xee, 0x6c, 0xdd, 0x6a, 0xda, 0x5e, 0x51, 0x0b, 0x36,
-	0x85, 0xb3, 0x09, 0x55, 0x43, 0xb7, 0x8f, 0x34, 0x1f, 0xee, 0xdc, 0x31, 0x4c, 0x2d, 0x18, 0x05,
-	0x23, 0x75, 0xda, 0x50, 0x8b, 0x62, 0xda, 0x45, 0xb1, 0xbf, 0x4f, 0x98, 0x48, 0x51, 0xec, 0x47,
-	0x8c, 0xa6, 0x7d, 0x7f, 0x07, 0xf5, 0x48, 0x3c, 0xf0, 0x49, 0xe8, 0x16, 0xeb, 0x56, 0xb3, 0xe2,
-	0xad, 0x68, 0xd4, 0x03, 0x0d, 0xba, 0x23, 0x31, 0xb7, 0x15, 0xa4, 0x13, 0x3a, 0xef, 0x83, 0x13,
-	0xec, 0x22, 0x16, 0xe1, 0xd0, 0x67, 0x18, 0x85, 0xfe, 0x37, 0x29, 0x15, 0xc8, 0x2d, 0xd5, 0xad,
-	0x66, 0xde, 0x5b, 0x34, 0x3b, 0x1e, 0x46, 0xe1, 0x17, 0x52, 0xee, 0xdc, 0x82, 0x8a, 0x09, 0x12,
-	0x17, 0x48, 0xa4, 0xdc, 0x05, 0x45, 0x4a, 0x7d, 0x1a, 0x29, 0x3a, 0x17, 0xb6, 0x14, 0xce, 0x9b,
-	0xef, 0x66, 0x56, 0xce, 0x55, 0xc8, 0x0b, 0x14, 0x71, 0xb7, 0x5c, 0xb7, 0x9a, 0xe5, 0xe9, 0xda,
-	0x1e, 0x36, 0x44, 0xa2, 0x88, 0x7b, 0x0a, 0x2d, 0xdd, 0xe5, 0x7d, 0x1f, 0x71, 0x3f, 0xc4, 0x31,
-	0x8e, 0x90, 0xc0, 0xa1, 0x8f, 0x22, 0xc9, 0x5f, 0x48, 0x38, 0xea, 0xc6, 0x38, 0x74, 0xe7, 0xeb,
-	0x56, 0xb3, 0xe8, 0xad, 0xf0, 0xfe, 0x26, 0xbf, 0x39, 0xc4, 0x6c, 0x4a, 0xc8, 0x4d, 0x83, 0x68,
-	0xfc, 0x6d, 0x81, 0xd3, 0x49, 0x04, 0x66, 0x09, 0x8a, 0x33, 0xc9, 0x7a, 0x01, 0xa0, 0xcf, 0x88,
-	0x8c, 0x34, 0xe9, 0x61, 0x95, 0xb1, 0xb6, 0x57, 0x52, 0x92, 0x6d, 0xd2, 0xc3, 0xce, 0xff, 0x61,
-	0x49, 0x50, 0x81, 0x62, 0x5f, 0x13, 0xe2, 0x73, 0xf2, 0x48, 0x67, 0x68, 0xde, 0xab, 0xaa, 0x8d,
-	0x4f, 0x94, 0x7c, 0x8b, 0x3c, 0xc2, 0xce, 0x97, 0xb0, 0x1c, 0xd3, 0x60, 0x3c, 0x26, 0xdc, 0xb5,
-	0xeb, 0x76, 0xb3, 0xbc, 0xf1, 0xbf, 0x69, 0xbe, 0xde, 0x95, 0xf8, 0x6c, 0x74, 0x3c, 0x27, 0x1e,
-	0x17, 0x71, 0xe7, 0x3a, 0x9c, 0x4f, 0xf0, 0x81, 0xf0, 0xa7, 0x9c, 0xee, 0x9b, 0xa4, 0xae, 0x78,
-	0x67, 0x25, 0x64, 0xe2, 0xbc, 0x4e, 0xd8, 0xf8, 0x7e, 0x0e, 0xe0, 0xf3, 0xee, 0xd7, 0x38, 0x78,
-	0xb3, 0xea, 0xdc, 0x80, 0x39, 0x95, 0xb9, 0x94, 0xe9, 0xca, 0x7c, 0x8d, 0xc6, 0x10, 0x38, 0x5e,
-	0xd1, 0xf6, 0x44, 0x45, 0xaf, 0x42, 0x99, 0xaa, 0x2b, 0x69, 0x40, 0x5e, 0x03, 0xb4, 0x48, 0x01,
-	0x74, 0xb9, 0x16, 0x4e, 0x56, 0xae, 0x57, 0xe0, 0x3f, 0x47, 0x50, 0x33, 0xab, 0xa8, 0x39, 0x13,
-	0x4f, 0xd2, 0xe2, 0x5c, 0x84, 0xf9, 0x3e, 0x1a, 0xc4, 0x14, 0x85, 0x3a, 0xa8, 0x73, 0x2a, 0xa8,
-	0x65, 0x23, 0x53, 0x01, 0x3d, 0xdc, 0x77, 0x8a, 0x6f, 0xd4, 0x77, 0x2e, 0xc2, 0x7c, 0x40, 0x13,
-	0x21, 0x93, 0x55, 0xf5, 0x92, 0x92, 0x72, 0xb5, 0x6c, 0x64, 0x93, 0xcd, 0x02, 0xc6, 0x9a, 0xc5,
-	0x2d, 0xa8, 0x18, 0xa6, 0x4c, 0xdd, 0x95, 0x8f, 0xae, 0x3b, 0x1d, 0xe5, 0x61, 0xdd, 0xd1, 0xcc,
-	0xca, 0xf9, 0x14, 0xaa, 0x0c, 0x87, 0x69, 0x12, 0xa2, 0x24, 0x18, 0xe8, 0x9b, 0xcc, 0x1f, 0xed,
-	0x8f, 0x37, 0x82, 0x2a, 0x7f, 0x16, 0xd8, 0xa1, 0xf5, 0x78, 0x7b, 0xac, 0x9c, 0xba, 0x3d, 0xb6,
-	0xa0, 0x14, 0xec, 0xe2, 0x60, 0x8f, 0xa7, 0x3d, 0xee, 0x2e, 0xd4, 0xed, 0xe6, 0x7c, 0x7b, 0xe9,
-	0xaf, 0xe7, 0xab, 0x15, 0xc1, 0x10, 0x11, 0xfc, 0xa3, 0x06, 0xed, 0x11, 0xd1, 0xf0, 0x5e, 0x61,
-	0x46, 0x6d, 0xa3, 0x7a, 0xaa, 0xb6, 0xb1, 0x0a, 0x65, 0xc2, 0xfd, 0xb4, 0x1f, 0x22, 0x41, 0x92,
-	0xc8, 0x5d, 0x54, 0x3d, 0x02, 0x08, 0xbf, 0x6f, 0x24, 0xb2, 0xf8, 0xd5, 0xae, 0xec, 0x27, 0xc2,
-	0x5d, 0xd2, 0xc5, 0x6f, 0x24, 0x9b, 0xc2, 0xf9, 0xe0, 0xd5, 0x76, 0x77, 0xe0, 0x3a, 0xc7, 0x64,
-	0xff, 0x50, 0xb1, 0x3d, 0x70, 0x5c, 0x98, 0xdb, 0xc7, 0x8c, 0x13, 0x9a, 0xb8, 0x67, 0xd4, 0xa1,
-	0xc3, 0x65, 0xe3, 0xc7, 0x1c, 0x94, 0x74, 0x06, 0xbe, 0x49, 0x2d, 0x5e, 0x00, 0xd0, 0xa9, 0x9d,
-	0x19, 0x94, 0x25, 0x25, 0x51, 0x45, 0x33, 0x16, 0x17, 0xfb, 0xd4, 0x71, 0x39, 0xd5, 0x90, 0x5c,
-	0x86, 0x02, 0x3e, 0x10, 0x0c, 0xe9, 0x2a, 0xf5, 0xf4, 0x62, 0x14, 0xa9, 0xd9, 0xd3, 0x44, 0xaa,
-	0x71, 0x1d, 0x0a, 0xdb, 0x32, 0xf6, 0xd2, 0x43, 0x95, 0x04, 0xda, 0x03, 0x4b, 0x7b, 0xa8, 0x24,
-	0xea, 0x82, 0xcb, 0x50, 0xd8, 0x47, 0x71, 0x3a, 0xf4, 0x5d, 0x2f, 0x1a, 0xbf, 0x59, 0xb0, 0xa0,
-	0x5b, 0xfa, 0x3d, 0x2c, 0xd0, 0x4d, 0x24, 0x90, 0x53, 0x87, 0x72, 0x88, 0x79, 0xc0, 0x48, 0x5f,
-	0xc8, 0x28, 0xe8, 0x83, 0xb2, 0x22, 0x59, 0x98, 0xf8, 0x40, 0x8f, 0x03, 0x3f, 0x65, 0xb1, 0x39,
-	0xb1, 0x3c, 0x94, 0xdd, 0x67, 0xf1, 0xf1, 0x6d, 0x6c, 0x19, 0x0a, 0xa4, 0x87, 0xa2, 0x61, 0x03,
-	0xd3, 0x0b, 0xe7, 0x06, 0x00, 0x12, 0x82, 0x91, 0x6e, 0x2a, 0x30, 0x77, 0x0b, 0xaa, 0xfb, 0x9f,
-	0x9b, 0x46, 0x84, 0x72, 0xb9, 0x9d, 0x97, 0x44, 0x7b, 0x19, 0x15, 0xe5, 0x8f, 0xae, 0xe5, 0xb7,
-	0xee, 0x4f, 0xb6, 0xeb, 0xda, 0x13, 0x5d, 0xf7, 0x1d, 0xf9, 0xf3, 0xab, 0x05, 0x15, 0x95, 0xf4,
-	0x6f, 0xd7, 0x9d, 0xc3, 0xd5, 0x60, 0x8f, 0x57, 0xc3, 0x3b, 0x72, 0x66, 0x03, 0xec, 0x4e, 0xc8,
-	0x4d, 0xa9, 0x58, 0x75, 0xfb, 0x04, 0xa5, 0xd2, 0xf8, 0xc5, 0x02, 0x90, 0xcf, 0x12, 0x81, 0x55,
-	0xd9, 0x5f, 0x03, 0x93, 0x44, 0x3e, 0x09, 0xb9, 0x72, 0xbe, 0xbc, 0x71, 0x76, 0xda, 0x1d, 0x3a,
-	0x21, 0xf7, 0x4a, 0x1a, 0x2a, 0x6d, 0x5e, 0x03, 0x13, 0x2c, 0xa5, 0x97, 0x3b, 0x46, 0x4f, 0x43,
-	0xa5, 0xde, 0x55, 0x28, 0x0d, 0x27, 0x22, 0x57, 0x3c, 0xbd, 0x46, 0xad, 0x18, 0xe9, 0xf9, 0xc8,
-	0x1b, 0xcf, 0x2c, 0x38, 0x73, 0x8f, 0x44, 0x0c, 0xc9, 0x78, 0x64, 0x5e, 0x4c, 0x2b, 0x50, 0xe2,
-	0x2c, 0xf0, 0xb9, 0x1a, 0xb0, 0x96, 0x1a, 0xb0, 0x73, 0x9c, 0x05, 0x5b, 0x72, 0xa8, 0x76, 0xa0,
-	0x21, 0xf7, 0x8e, 0x79, 0x9b, 0xe6, 0x94, 0xd2, 0x05, 0xce, 0x82, 0x3b, 0x47, 0x3f, 0x4f, 0x57,
-	0xa0, 0x14, 0x72, 0x61, 0xcc, 0xd8, 0xda, 0x4c, 0xc8, 0x85, 0x32, 0xf3, 0x21, 0x94, 0x46, 0x04,
-	0x9e, 0xa4, 0x5d, 0x15, 0x87, 0x1c, 0x36, 0xbe, 0x85, 0xf9, 0x6c, 0xfb, 0x71, 0x3e, 0x36, 0xed,
-	0xca, 0x52, 0x89, 0xf0, 0xdf, 0xe3, 0xda, 0xd5, 0xfa, 0x36, 0x8a, 0x4c, 0x4e, 0x28, 0xbd, 0x95,
-	0x35, 0xb0, 0xb7, 0x51, 0xe4, 0x2c, 0x82, 0xbd, 0x87, 0x07, 0x26, 0x8f, 0xe5, 0xcf, 0x23, 0x3a,
-	0xd5, 0xcf, 0x39, 0x58, 0xdc, 0xda, 0x45, 0x21, 0x7d, 0x98, 0x79, 0x91, 0x5d, 0x85, 0x22, 0xed,
-	0x63, 0xa6, 0x9e, 0x58, 0xc7, 0x0d, 0x82, 0x11, 0xd2, 0x24, 0x60, 0xee, 0x64, 0xbd, 0x7a, 0xfc,
-	0x15, 0x62, 0x4f, 0xbe, 0x42, 0xc6, 0xdf, 0x43, 0xf9, 0xc9, 0xf7, 0xd0, 0xa1, 0xb1, 0x5d, 0x38,
-	0xc1, 0xd8, 0x3e, 0x3c, 0x5f, 0x67, 0xc7, 0xe7, 0x6b, 0x66, 0x4c, 0xce, 0x1d, 0x1e, 0x93, 0xdf,
-	0xe5, 0xa0, 0xaa, 0x53, 0xee, 0x96, 0x9c, 0x2a, 0x8a, 0xa6, 0x4b, 0x50, 0x25, 0xdc, 0x67, 0xf2,
-	0x9d, 0x14, 0x93, 0x1e, 0x11, 0x58, 0x67, 0x5f, 0xd1, 0xab, 0x10, 0xee, 0x21, 0x81, 0xef, 0x6a,
-	0xa1, 0x13, 0x42, 0x55, 0x7e, 0xe4, 0x66, 0x90, 0x86, 0xa5, 0xeb, 0x86, 0xa5, 0x4b, 0x11, 0x11,
-	0xbb, 0x69, 0x77, 0x3d, 0xa0, 0x3d, 0xf3, 0x25, 0x6d, 0xfe, 0xac, 0xf1, 0x70, 0xcf, 0x7c, 0xa9,
-	0x77, 0x14, 0x8f, 0x60, 0x78, 0xec, 0x24, 0xc2, 0xab, 0xc8, 0x43, 0x47, 0x76, 0x9c, 0x5d, 0x58,
-	0x0a, 0x52, 0xc6, 0x24, 0xa3, 0x23, 0x6b, 0x9a, 0xd6, 0x7f, 0x69, 0xa7, 0x6a, 0x8e, 0xbd, 0x6d,
-	0xcc, 0xb5, 0x3b, 0x4f, 0x5e, 0xd4, 0xac, 0xa7, 0x2f, 0x6a, 0xd6, 0x9f, 0x2f, 0x6a, 0xd6, 0x0f,
-	0x2f, 0x6b, 0x33, 0x4f, 0x5f, 0xd6, 0x66, 0x7e, 0x7f, 0x59, 0x9b, 0xf9, 0xaa, 0x95, 0x31, 0xd0,
-	0x4d, 0xba, 0x6b, 0xc1, 0x2e, 0x22, 0x49, 0x2b, 0xf3, 0xb5, 0x7e, 0x70, 0xf8, 0xff, 0x0f, 0xdd,
-	0x59, 0xf5, 0xbd, 0x7e, 0xe5, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xaa, 0x6e, 0xef, 0x57, 0xa2,
-	0x10, 0x00, 0x00,
+	// 1628 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x72, 0x49, 0x89, 0x7c, 0x14, 0x45, 0x7b, 0xc4, 0x36, 0x1b, 0x19, 0xa6, 0x68, 0xa2,
+	0x75, 0x88, 0xb6, 0x12, 0x11, 0xd9, 0x48, 0x8b, 0xc2, 0x68, 0x20, 0xda, 0x4e, 0xc0, 0x36, 0x69,
+	0xd3, 0x95, 0x9c, 0x02, 0xb9, 0x2c, 0x86, 0xbb, 0xa3, 0xd5, 0xc4, 0xbb, 0x3b, 0xec, 0xcc, 0xac,
+	0x64, 0x06, 0xe8, 0x07, 0x68, 0x4f, 0x3d, 0xf4, 0x2b, 0xf4, 0x0b, 0x14, 0x39, 0xf6, 0x03, 0x04,
+	0x05, 0x0a, 0x04, 0x3e, 0x15, 0x3d, 0x18, 0x85, 0xfd, 0x0d, 0x7a, 0xe8, 0xb9, 0x98, 0x3f, 0xa4,
+	0x57, 0x24, 0x65, 0x4a, 0x4e, 0x72, 0x22, 0xe7, 0xcd, 0xef, 0xcd, 0xcc, 0xfb, 0xf7, 0x7b, 0x0f,
+	0x0b, 0xed, 0x98, 0x13, 0x92, 0x1d, 0x53, 0x92, 0x44, 0x7d, 0x21, 0x19, 0xc7, 0x31, 0xe9, 0xcb,
+	0xc9, 0x98, 0x88, 0xbd, 0x31, 0x67, 0x92, 0x21, 0xf4, 0x6a, 0x7f, 0xcf, 0xee, 0x6f, 0xb7, 0x43,
+	0x26, 0x52, 0x26, 0xfa, 0x23, 0x2c, 0x48, 0xff, 0xf4, 0xdd, 0x11, 0x91, 0xf8, 0xdd, 0x7e, 0xc8,
+	0x68, 0x66, 0x74, 0xb6, 0xdf, 0x36, 0xfb, 0x81, 0x5e, 0xf5, 0xcd, 0xc2, 0x6e, 0xb5, 0x62, 0x16,
+	0x33, 0x23, 0x57, 0xff, 0xac, 0xf4, 0x76, 0xe1, 0x11, 0x63, 0x3c, 0x49, 0x49, 0x26, 0xfb, 0x2c,
+	0x97, 0xc1, 0x71, 0xc2, 0xce, 0x2c, 0xe4, 0xce, 0x12, 0x88, 0x90, 0x9c, 0xe0, 0x34, 0xe0, 0x24,
+	0x64, 0x3c, 0xb2, 0xb8, 0x9d, 0x25, 0xf6, 0x84, 0x2c, 0x4d, 0x59, 0xf6, 0x1a, 0xc0, 0x18, 0x73,
+	0x9c, 0xda, 0x27, 0x76, 0xff, 0xbe, 0x06, 0x30, 0xc8, 0xc3, 0x27, 0x44, 0x0e, 0xb3, 0x63, 0x86,
+	0xf6, 0xa0, 0xc2, 0xce, 0x32, 0xc2, 0x3d, 0xa7, 0xe3, 0xf4, 0x6a, 0x03, 0xef, 0xd9, 0x97, 0xbb,
+	0x2d, 0x6b, 0xd2, 0x41, 0x14, 0x71, 0x22, 0xc4, 0xa1, 0xe4, 0x34, 0x8b, 0x7d, 0x03, 0x43, 0x3b,
+	0x50, 0x1f, 0x69, 0xed, 0x20, 0xc3, 0x29, 0xf1, 0x4a, 0x4a, 0xcb, 0x07, 0x23, 0xfa, 0x35, 0x4e,
+	0x09, 0x1a, 0x00, 0x9c, 0x52, 0x41, 0x47, 0x34, 0xa1, 0x72, 0xe2, 0xb9, 0x1d, 0xa7, 0xb7, 0xb9,
+	0xdf, 0xdd, 0x5b, 0x74, 0xf3, 0xde, 0xa7, 0x33, 0xd4, 0xd1, 0x64, 0x4c, 0xfc, 0x82, 0x16, 0xfa,
+	0x31, 0x94, 0x68, 0xe4, 0x95, 0xf5, 0x8b, 0x6e, 0x7e, 0xf5, 0x7c, 0xe7, 0xda, 0xbf, 0x9f, 0xef,
+	0x94, 0x1f, 0xd3, 0x4c, 0x3e, 0xfb, 0x72, 0xb7, 0x6e, 0x5f, 0xa7, 0x96, 0x7e, 0x89, 0x46, 0xe8,
+	0x7d, 0xa8, 0x0b, 0x96, 0xf3, 0x90, 0x04, 0x2a, 0xb0, 0x5e, 0x45, 0xdf, 0xd8, 0x5e, 0x76, 0xe3,
+	0xa1, 0x86, 0x99, 0xdb, 0xc4, 0xec, 0x3f, 0xba, 0x09, 0xb5, 0x90, 0x13, 0x2c, 0x49, 0x80, 0xa5,
+	0xb7, 0xd6, 0x71, 0x7a, 0xae, 0x5f, 0x35, 0x82, 0x03, 0x89, 0x0e, 0xa0, 0x69, 0xe3, 0x11, 0x60,
+	0xe3, 0x0f, 0x6f, 0x7d, 0x85, 0xa7, 0x36, 0xad, 0x82, 0x95, 0xa2, 0x01, 0xb4, 0xe3, 0x84, 0x8d,
+	0x70, 0x12, 0x9c, 0x52, 0x2e, 0x73, 0x9c, 0x04, 0x31, 0x67, 0xf9, 0x38, 0x38, 0xc6, 0x29, 0x4d,
+	0x26, 0x01, 0x8d, 0xbc, 0x6a, 0xc7, 0xe9, 0x35, 0xfc, 0x6d, 0x83, 0xfa, 0xd4, 0x80, 0x3e, 0x54,
+	0x98, 0x0f, 0x34, 0x64, 0x18, 0xa1, 0x9f, 0x00, 0x0a, 0x4f, 0x30, 0x8f, 0x49, 0x14, 0x70, 0x82,
+	0xa3, 0xe0, 0xf7, 0x39, 0x93, 0xd8, 0xab, 0x75, 0x9c, 0x5e, 0xd9, 0xbf, 0x6e, 0x77, 0x7c, 0x82,
+	0xa3, 0xdf, 0x2a, 0x39, 0x7a, 0x04, 0x0d, 0x1b, 0x24, 0x21, 0xb1, 0xcc, 0x85, 0x07, 0xda, 0x29,
+	0x9d, 0x65, 0x4e, 0x31, 0xb9, 0x70, 0xa8, 0x71, 0xfe, 0xc6, 0xa8, 0xb0, 0x42, 0xf7, 0xa0, 0x2c,
+	0x71, 0x2c, 0xbc, 0x7a, 0xc7, 0xe9, 0xd5, 0x97, 0x6b, 0xfb, 0xc4, 0x3a, 0x12, 0xc7, 0xc2, 0xd7,
+	0x68, 0x65, 0xae, 0x18, 0x07, 0x58, 0x04, 0x11, 0x49, 0x48, 0x8c, 0x25, 0x89, 0x02, 0x1c, 0x2b,
+	0xff, 0x45, 0x54, 0xe0, 0x51, 0x42, 0x22, 0x6f, 0xa3, 0xe3, 0xf4, 0xaa, 0xfe, 0xb6, 0x18, 0x1f,
+	0x88, 0x87, 0x53, 0xcc, 0x81, 0x82, 0x3c, 0xb4, 0x08, 0xf4, 0x19, 0xb4, 0xc6, 0x09, 0x0e, 0x89,
+	0xf6, 0x7b, 0xc8, 0x32, 0x21, 0x39, 0xa6, 0x99, 0xf4, 0x1a, 0xfa, 0x25, 0xef, 0x2c, 0x7b, 0xc9,
+	0x27, 0x53, 0xfc, 0x83, 0x19, 0xdc, 0xdf, 0x1a, 0x2f, 0x0a, 0xd1, 0x7d, 0x00, 0x12, 0xaa, 0xe2,
+	0x3d, 0xa6, 0x09, 0xf1, 0x36, 0xf5, 0x89, 0xb7, 0x96, 0x9d, 0xf8, 0xe8, 0xc1, 0x27, 0x06, 0xe4,
+	0xd7, 0x48, 0x68, 0xff, 0x76, 0x05, 0x6c, 0x2d, 0xb9, 0x09, 0xbd, 0x03, 0x4d, 0x9c, 0x24, 0xec,
+	0x4c, 0xc7, 0x27, 0xa6, 0x2c, 0x13, 0x9e, 0xd3, 0x71, 0x7b, 0x35, 0x7f, 0xd3, 0x8a, 0x7d, 0x23,
+	0x45, 0x77, 0xe1, 0x7b, 0x53, 0xe0, 0xe7, 0x39, 0xa7, 0x22, 0xa2, 0xa1, 0xd4, 0xf0, 0x92, 0x86,
+	0xb7, 0xec, 0xe6, 0x2f, 0x8b, 0x7b, 0xdd, 0xff, 0x39, 0x80, 0x86, 0x99, 0x24, 0x3c, 0xc3, 0x49,
+	0xa1, 0x76, 0x6f, 0x01, 0x8c, 0x39, 0x55, 0x89, 0x4f, 0x53, 0xa2, 0x0b, 0xd8, 0xf5, 0x6b, 0x5a,
+	0x72, 0x44, 0x53, 0x82, 0x7e, 0x04, 0x37, 0x24, 0x93, 0x38, 0x09, 0x4c, 0x7e, 0x04, 0x82, 0x7e,
+	0x61, 0x0a, 0xb6, 0xec, 0x37, 0xf5, 0xc6, 0x03, 0x2d, 0x3f, 0xa4, 0x5f, 0x10, 0xf4, 0x3b, 0x68,
+	0x25, 0x2c, 0x9c, 0x4f, 0x51, 0xe1, 0xb9, 0x1d, 0xb7, 0x57, 0xdf, 0xff, 0xe1, 0x32, 0xf7, 0x7c,
+	0xa4, 0xf0, 0xc5, 0x64, 0xf5, 0x51, 0x32, 0x2f, 0x12, 0xe8, 0x3e, 0xdc, 0xcc, 0xc8, 0x53, 0x19,
+	0x2c, 0x39, 0x3d, 0xb0, 0x35, 0xde, 0xf0, 0xdf, 0x52, 0x90, 0x85, 0xf3, 0x86, 0x51, 0xf7, 0x4f,
+	0xeb, 0x00, 0xbf, 0x19, 0x7d, 0x4e, 0xc2, 0x37, 0x23, 0xab, 0x7d, 0x58, 0xd7, 0x85, 0xcc, 0xb8,
+	0x21, 0xaa, 0xd7, 0x68, 0x4c, 0x81, 0xf3, 0x04, 0xe7, 0x2e, 0x10, 0xdc, 0x0e, 0xd4, 0x99, 0x7e,
+	0x92, 0x01, 0x94, 0x0d, 0xc0, 0x88, 0x34, 0xc0, 0xb0, 0x57, 0xe5, 0x72, 0xec, 0x75, 0x17, 0xbe,
+	0x7f, 0x81, 0x6b, 0xd6, 0xb4, 0x6b, 0xb6, 0x92, 0x45, 0xb7, 0xa0, 0xdb, 0xb0, 0x31, 0xc6, 0x93,
+	0x84, 0xe1, 0xc8, 0x04, 0x75, 0x5d, 0x07, 0xb5, 0x6e, 0x65, 0x3a, 0xa0, 0xe7, 0x69, 0xb8, 0xfa,
+	0x46, 0x34, 0x7c, 0x1b, 0x36, 0x42, 0x96, 0x49, 0x55, 0x83, 0x9a, 0x5a, 0x6b, 0xda, 0xd4, 0xba,
+	0x95, 0x2d, 0x72, 0x27, 0xcc, 0x71, 0xe7, 0x23, 0x68, 0x58, 0x4f, 0x59, 0x1a, 0xaa, 0x5f, 0x4c,
+	0x43, 0x26, 0xca, 0x53, 0x1a, 0x62, 0x85, 0x15, 0xfa, 0x15, 0x34, 0x39, 0x89, 0xf2, 0x2c, 0xc2,
+	0x59, 0x38, 0x31, 0x2f, 0xd9, 0xb8, 0xd8, 0x1e, 0x7f, 0x06, 0xd5, 0xf6, 0x6c, 0xf2, 0x73, 0xeb,
+	0xf9, 0x6e, 0xd1, 0xb8, 0x72, 0xb7, 0xe8, 0x43, 0x2d, 0x3c, 0x21, 0xe1, 0x13, 0x91, 0xa7, 0xc2,
+	0xdb, 0xec, 0xb8, 0xbd, 0x8d, 0xc1, 0x8d, 0xff, 0x3e, 0xdf, 0x69, 0x28, 0x22, 0x90, 0xe2, 0xe7,
+	0x5d, 0x96, 0x52, 0xd9, 0xf5, 0x5f, 0x61, 0x66, 0x2c, 0xda, 0xbc, 0x12, 0x8b, 0xee, 0x40, 0x9d,
+	0x8a, 0x20, 0x1f, 0x47, 0x58, 0xd2, 0x2c, 0xf6, 0xae, 0x6b, 0xca, 0x04, 0x2a, 0x1e, 0x5b, 0x89,
+	0x2a, 0x7e, 0xbd, 0xab, 0xe8, 0x55, 0x7a, 0x37, 0x4c, 0xf1, 0x5b, 0xc9, 0x81, 0x44, 0x3f, 0x7d,
+	0xb5, 0x3d, 0x9a, 0x78, 0x68, 0x45, 0xf6, 0x4f, 0x15, 0x07, 0x13, 0xe4, 0xc1, 0xfa, 0x29, 0xe1,
+	0x82, 0xb2, 0xcc, 0xdb, 0xd2, 0x87, 0x4e, 0x97, 0xdd, 0xbf, 0x94, 0xa0, 0x66, 0x32, 0xf0, 0x4d,
+	0x6a, 0xf1, 0x16, 0x80, 0x49, 0xed, 0xc2, 0xdc, 0x50, 0xd3, 0x12, 0x5d, 0x34, 0x73, 0x71, 0x71,
+	0xaf, 0x1c, 0x97, 0x2b, 0xcd, 0x0c, 0x2d, 0xa8, 0x90, 0xa7, 0x92, 0x63, 0x53, 0xa5, 0xbe, 0x59,
+	0xcc, 0x22, 0xb5, 0x76, 0x95, 0x48, 0x75, 0xef, 0x43, 0xe5, 0x48, 0xc5, 0x5e, 0x59, 0xa8, 0x93,
+	0xc0, 0x58, 0xe0, 0x18, 0x0b, 0xb5, 0x44, 0x3f, 0xb0, 0x05, 0x95, 0x53, 0x9c, 0xe4, 0x53, 0xdb,
+	0xcd, 0xa2, 0xfb, 0x4f, 0x07, 0x36, 0x0d, 0xa5, 0x7f, 0x4c, 0x24, 0x7e, 0x88, 0x25, 0x46, 0x1d,
+	0xa8, 0x47, 0x44, 0x84, 0x9c, 0x8e, 0x15, 0xfb, 0xdb, 0x83, 0x8a, 0x22, 0x55, 0x98, 0xe4, 0xa9,
+	0x69, 0x07, 0x41, 0xce, 0x13, 0x7b, 0x62, 0x7d, 0x2a, 0x7b, 0xcc, 0x93, 0xd5, 0x34, 0xd6, 0x82,
+	0x0a, 0x4d, 0x71, 0x3c, 0x25, 0x30, 0xb3, 0x40, 0xef, 0x03, 0x60, 0x29, 0x39, 0x1d, 0xe5, 0x92,
+	0x08, 0xaf, 0xa2, 0xd9, 0xff, 0xed, 0x65, 0x8e, 0xd0, 0x26, 0x0f, 0xca, 0xca, 0xd1, 0x7e, 0x41,
+	0x45, 0xdb, 0x63, 0x6a, 0xf9, 0x5b, 0xb7, 0xa7, 0xc8, 0xba, 0xee, 0x02, 0xeb, 0x7e, 0x47, 0xf6,
+	0xfc, 0xc3, 0x81, 0x86, 0x4e, 0xfa, 0x6f, 0xd7, 0x9c, 0xf3, 0xd5, 0xe0, 0xce, 0x57, 0xc3, 0x77,
+	0x64, 0xcc, 0x3e, 0xb8, 0xc3, 0x48, 0xd8, 0x52, 0xd1, 0xf3, 0xc9, 0xca, 0x52, 0xe9, 0xfe, 0xcd,
+	0x01, 0x50, 0x53, 0x9a, 0x24, 0xba, 0xec, 0xdf, 0x03, 0x9b, 0x44, 0x01, 0x8d, 0x84, 0x36, 0xbe,
+	0xbe, 0xff, 0xd6, 0xb2, 0x37, 0x0c, 0x23, 0xe1, 0xd7, 0x0c, 0x54, 0xdd, 0xf9, 0x1e, 0xd8, 0x60,
+	0x69, 0xbd, 0xd2, 0x0a, 0x3d, 0x03, 0x55, 0x7a, 0xf7, 0xa0, 0x36, 0xed, 0x88, 0x42, 0xfb, 0xe9,
+	0x35, 0x6a, 0xd5, 0xd8, 0xf4, 0x47, 0xd1, 0x7d, 0xe6, 0xc0, 0xd6, 0xc7, 0x34, 0xe6, 0x58, 0xc5,
+	0xa3, 0x30, 0x31, 0x6d, 0x43, 0x4d, 0xf0, 0x30, 0x10, 0xba, 0xc1, 0x3a, 0xba, 0xc1, 0xae, 0x0b,
+	0x1e, 0x1e, 0xaa, 0xa6, 0x3a, 0x84, 0xae, 0xda, 0x5b, 0x31, 0xaa, 0x97, 0xb4, 0xd2, 0x2d, 0xc1,
+	0xc3, 0x0f, 0x2f, 0x9e, 0xd6, 0xb7, 0xa1, 0x16, 0x09, 0x69, 0xaf, 0x71, 0xcd, 0x35, 0x91, 0x90,
+	0xfa, 0x9a, 0x9f, 0x41, 0x6d, 0xe6, 0xc0, 0xcb, 0xd0, 0x55, 0x75, 0xea, 0xc3, 0xee, 0x1f, 0x60,
+	0xa3, 0x48, 0x3f, 0xe8, 0x17, 0x96, 0xae, 0x1c, 0x9d, 0x08, 0x3f, 0x58, 0x45, 0x57, 0x7b, 0x47,
+	0x38, 0xb6, 0x39, 0xa1, 0xf5, 0xb6, 0x77, 0xc1, 0x3d, 0xc2, 0x31, 0xba, 0x0e, 0xee, 0x13, 0x32,
+	0xb1, 0x79, 0xac, 0xfe, 0x5e, 0xc0, 0x54, 0x7f, 0x2d, 0xc1, 0xf5, 0xc3, 0x13, 0x1c, 0xb1, 0xb3,
+	0xc2, 0x44, 0x76, 0x0f, 0xaa, 0x6c, 0x4c, 0xb8, 0x1e, 0xb1, 0x56, 0x35, 0x82, 0x19, 0xd2, 0x26,
+	0x60, 0xe9, 0x72, 0x5c, 0x3d, 0x3f, 0x85, 0xb8, 0x8b, 0x53, 0xc8, 0xfc, 0x3c, 0x54, 0x5e, 0x9c,
+	0x87, 0xce, 0xb5, 0xed, 0xca, 0x25, 0xda, 0xf6, 0xf9, 0xfe, 0xba, 0x36, 0xdf, 0x5f, 0x0b, 0x6d,
+	0x72, 0xfd, 0x7c, 0x9b, 0xfc, 0x63, 0x09, 0x9a, 0x26, 0xe5, 0x1e, 0xa9, 0xae, 0xa2, 0xdd, 0x74,
+	0x07, 0x9a, 0x54, 0x04, 0x5c, 0xcd, 0x49, 0x09, 0x4d, 0xa9, 0x24, 0x26, 0xfb, 0xaa, 0x7e, 0x83,
+	0x0a, 0x1f, 0x4b, 0xf2, 0x91, 0x11, 0xa2, 0x08, 0x9a, 0xc7, 0x09, 0x3b, 0x2b, 0x20, 0xad, 0x97,
+	0xee, 0x5b, 0x2f, 0xdd, 0x89, 0xa9, 0x3c, 0xc9, 0x47, 0x7b, 0x21, 0x4b, 0xed, 0x97, 0x07, 0xfb,
+	0xb3, 0x2b, 0xa2, 0x27, 0xf6, 0xcb, 0xc6, 0x50, 0xfb, 0x11, 0xac, 0x1f, 0x87, 0x99, 0xf4, 0x1b,
+	0xea, 0xd0, 0xd9, 0x3d, 0xe8, 0x04, 0x6e, 0x84, 0x39, 0xe7, 0xca, 0xa3, 0xb3, 0xdb, 0x8c, 0x5b,
+	0xbf, 0xe1, 0x3d, 0x4d, 0x7b, 0xec, 0x07, 0xf6, 0xba, 0xc1, 0xf0, 0xab, 0x17, 0x6d, 0xe7, 0xeb,
+	0x17, 0x6d, 0xe7, 0x3f, 0x2f, 0xda, 0xce, 0x9f, 0x5f, 0xb6, 0xaf, 0x7d, 0xfd, 0xb2, 0x7d, 0xed,
+	0x5f, 0x2f, 0xdb, 0xd7, 0x3e, 0xeb, 0x17, 0x2e, 0x18, 0x65, 0xa3, 0xdd, 0xf0, 0x04, 0xd3, 0xac,
+	0x5f, 0xf8, 0x78, 0xf1, 0xf4, 0xfc, 0xf7, 0x9a, 0xd1, 0x9a, 0xfe, 0x7c, 0x71, 0xf7, 0xff, 0x01,
+	0x00, 0x00, 0xff, 0xff, 0x6c, 0xb3, 0xf5, 0x38, 0xd2, 0x11, 0x00, 0x00,
 }
 
 func (m *BucketInfo) Marshal() (dAtA []byte, err error) {
@@ -1351,6 +1434,30 @@ func (m *BucketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EcProfile != nil {
+		{
+			size, err := m.EcProfile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.PlacementConstraint != nil {
+		{
+			size, err := m.PlacementConstraint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	if m.SpAsDelegatedAgentDisabled {
 		i--
 		if m.SpAsDelegatedAgentDisabled {
@@ -1437,6 +1544,47 @@ func (m *BucketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PlacementConstraint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlacementConstraint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlacementConstraint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedJurisdictions) > 0 {
+		for iNdEx := len(m.AllowedJurisdictions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedJurisdictions[iNdEx])
+			copy(dAtA[i:], m.AllowedJurisdictions[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AllowedJurisdictions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedRegions) > 0 {
+		for iNdEx := len(m.AllowedRegions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRegions[iNdEx])
+			copy(dAtA[i:], m.AllowedRegions[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AllowedRegions[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *InternalBucketInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2349,6 +2497,35 @@ func (m *BucketInfo) Size() (n int) {
 	if m.SpAsDelegatedAgentDisabled {
 		n += 2
 	}
+	if m.PlacementConstraint != nil {
+		l = m.PlacementConstraint.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.EcProfile != nil {
+		l = m.EcProfile.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *PlacementConstraint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedRegions) > 0 {
+		for _, s := range m.AllowedRegions {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.AllowedJurisdictions) > 0 {
+		for _, s := range m.AllowedJurisdictions {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3064,6 +3241,192 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SpAsDelegatedAgentDisabled = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementConstraint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PlacementConstraint == nil {
+				m.PlacementConstraint = &PlacementConstraint{}
+			}
+			if err := m.PlacementConstraint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EcProfile == nil {
+				m.EcProfile = &ECProfile{}
+			}
+			if err := m.EcProfile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlacementConstraint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlacementConstraint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlacementConstraint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRegions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRegions = append(m.AllowedRegions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedJurisdictions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedJurisdictions = append(m.AllowedJurisdictions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -0,0 +1,12 @@
+package virtualgroup
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/virtualgroup/keeper"
+)
+
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.ProcessExpiredGVGRepairs(ctx)
+	k.ProcessExpiredSwapIns(ctx)
+}
@@ -27,6 +27,8 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdGlobalVirtualGroupFamily())
 	cmd.AddCommand(CmdGlobalVirtualGroupFamilies())
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdFamilyRebalance())
+	cmd.AddCommand(CmdUnhealthyGlobalVirtualGroups())
 
 	return cmd
 }
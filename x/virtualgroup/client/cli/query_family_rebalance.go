@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func CmdFamilyRebalance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "family-rebalance [rebalance-id]",
+		Short: "query the progress of a GVG family rebalance.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			rebalanceID, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil || rebalanceID == 0 {
+				return fmt.Errorf("invalid rebalance id %s", args[0])
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryFamilyRebalanceRequest{
+				RebalanceId: uint32(rebalanceID),
+			}
+
+			res, err := queryClient.FamilyRebalance(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -13,6 +13,11 @@ import (
 	"github.com/bnb-chain/greenfield/x/virtualgroup/types"
 )
 
+const (
+	FlagFamilyIds   = "family-ids"
+	FlagTargetSpIds = "target-sp-ids"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -24,6 +29,8 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdSettle())
+	cmd.AddCommand(CmdRebalanceFamilies())
+	cmd.AddCommand(CmdOpenGVGRepair())
 
 	return cmd
 }
@@ -72,3 +79,102 @@ If none zero is provided for GVG family, then the provided GVG family will be se
 
 	return cmd
 }
+
+func CmdRebalanceFamilies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebalance-families [sp id]",
+		Short: "Move the GVG families of a primary SP to the less loaded SPs",
+		Long: `Rebalance-families will reserve the swap in of the GVG families served by the SP as primary SP for the SPs
+serving the fewest families. All the families of the SP are moved if --family-ids is not provided, and all the in service SPs
+are the candidates if --target-sp-ids is not provided. The sender should be the operator of the SP or the gov module account.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			spID, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid SP id %s", args[0])
+			}
+			familyIDsStr, _ := cmd.Flags().GetString(FlagFamilyIds)
+			familyIDs, err := parseUint32List(familyIDsStr)
+			if err != nil {
+				return fmt.Errorf("invalid GVG family ids %s", familyIDsStr)
+			}
+			targetSPIDsStr, _ := cmd.Flags().GetString(FlagTargetSpIds)
+			targetSPIDs, err := parseUint32List(targetSPIDsStr)
+			if err != nil {
+				return fmt.Errorf("invalid target SP ids %s", targetSPIDsStr)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRebalanceGlobalVirtualGroupFamilies(
+				clientCtx.GetFromAddress(),
+				uint32(spID),
+				familyIDs,
+				targetSPIDs,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagFamilyIds, "", "comma separated ids of the GVG families to move")
+	cmd.Flags().String(FlagTargetSpIds, "", "comma separated ids of the SPs which can take over the families")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdOpenGVGRepair() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-gvg-repair [gvg id]",
+		Short: "Open a repair window for a GVG whose secondary SPs are jailed, exiting or in maintenance",
+		Long: `Open-gvg-repair will open a repair window for the GVG. Within the window, any in service SP can reserve the swap in
+of the unhealthy secondary SPs. The primary SP of the GVG will be slashed if the GVG is not repaired before the deadline,
+and the slashed amount is rewarded to the sender.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			gvgID, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid GVG id %s", args[0])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgOpenGVGRepair(
+				clientCtx.GetFromAddress(),
+				uint32(gvgID),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseUint32List(s string) ([]uint32, error) {
+	var ids []uint32
+	if s == "" {
+		return ids, nil
+	}
+	for _, split := range strings.Split(s, ",") {
+		id, err := strconv.ParseUint(strings.TrimSpace(split), 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, uint32(id))
+	}
+	return ids, nil
+}
@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
 	"github.com/bnb-chain/greenfield/x/virtualgroup/types"
 )
 
@@ -219,6 +220,9 @@ func (k Keeper) QuerySpOptimalGlobalVirtualGroupFamily(goCtx context.Context, re
 			if !found {
 				return nil, types.ErrGVGFamilyNotExist
 			}
+			if k.isGVGFamilyServedByJailedSP(ctx, gvgFamily) {
+				continue
+			}
 			totalStakingSize, stored, err := k.GetGlobalVirtualFamilyTotalStakingAndStoredSize(ctx, gvgFamily)
 			if err != nil {
 				return nil, err
@@ -238,6 +242,9 @@ func (k Keeper) QuerySpOptimalGlobalVirtualGroupFamily(goCtx context.Context, re
 			if !found {
 				return nil, types.ErrGVGFamilyNotExist
 			}
+			if k.isGVGFamilyServedByJailedSP(ctx, gvgFamily) {
+				continue
+			}
 			totalStakingSize, stored, err := k.GetGlobalVirtualFamilyTotalStakingAndStoredSize(ctx, gvgFamily)
 			if err != nil {
 				return nil, err
@@ -248,6 +255,35 @@ func (k Keeper) QuerySpOptimalGlobalVirtualGroupFamily(goCtx context.Context, re
 				freeStoreSize = currentFreeStoreSize
 			}
 		}
+	case types.Strategy_Highest_Secondary_Sp_Score:
+		var highestScore uint32
+		picked := false
+		for _, gvgfID := range stats.GlobalVirtualGroupFamilyIds {
+			gvgFamily, found := k.GetGVGFamily(ctx, gvgfID)
+			if !found {
+				return nil, types.ErrGVGFamilyNotExist
+			}
+			if k.isGVGFamilyServedByJailedSP(ctx, gvgFamily) {
+				continue
+			}
+			totalStakingSize, stored, err := k.GetGlobalVirtualFamilyTotalStakingAndStoredSize(ctx, gvgFamily)
+			if err != nil {
+				return nil, err
+			}
+			// only the families which still have free store size are considered
+			if float64(stored) >= math.Min(float64(totalStakingSize), float64(k.MaxStoreSizePerFamily(ctx))) || uint32(len(gvgFamily.GlobalVirtualGroupIds)) >= k.MaxGlobalVirtualGroupNumPerFamily(ctx) {
+				continue
+			}
+			score, err := k.GetGlobalVirtualFamilySecondarySpScore(ctx, gvgFamily)
+			if err != nil {
+				return nil, err
+			}
+			if !picked || score > highestScore {
+				familyID = gvgFamily.Id
+				highestScore = score
+				picked = true
+			}
+		}
 	case types.Strategy_Oldest_Create_Time:
 		if len(stats.GlobalVirtualGroupFamilyIds) != 0 {
 			familyID = stats.GlobalVirtualGroupFamilyIds[0]
@@ -264,3 +300,51 @@ func (k Keeper) QuerySpOptimalGlobalVirtualGroupFamily(goCtx context.Context, re
 		GlobalVirtualGroupFamilyId: familyID,
 	}, nil
 }
+
+func (k Keeper) FamilyRebalance(goCtx context.Context, req *types.QueryFamilyRebalanceRequest) (*types.QueryFamilyRebalanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rebalance, found := k.GetFamilyRebalance(ctx, req.RebalanceId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "rebalance %d not found", req.RebalanceId)
+	}
+	return &types.QueryFamilyRebalanceResponse{Rebalance: rebalance}, nil
+}
+
+func (k Keeper) UnhealthyGlobalVirtualGroups(goCtx context.Context, req *types.QueryUnhealthyGlobalVirtualGroupsRequest) (*types.QueryUnhealthyGlobalVirtualGroupsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var gvgs []types.UnhealthyGlobalVirtualGroup
+	spStatuses := make(map[uint32]sptypes.Status)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GVGKey)
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var gvg types.GlobalVirtualGroup
+		k.cdc.MustUnmarshal(value, &gvg)
+		unhealthy := k.GetUnhealthySecondarySPs(ctx, &gvg, spStatuses)
+		if len(unhealthy) == 0 {
+			return false, nil
+		}
+		if accumulate {
+			item := types.UnhealthyGlobalVirtualGroup{
+				GlobalVirtualGroupId: gvg.Id,
+				PrimarySpId:          gvg.PrimarySpId,
+				UnhealthySpIds:       unhealthy,
+			}
+			if repair, found := k.GetGVGRepair(ctx, gvg.Id); found {
+				item.RepairDeadline = repair.Deadline
+			}
+			gvgs = append(gvgs, item)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryUnhealthyGlobalVirtualGroupsResponse{GlobalVirtualGroups: gvgs, Pagination: pageRes}, nil
+}
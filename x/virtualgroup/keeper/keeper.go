@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	math2 "math"
@@ -34,6 +35,7 @@ type (
 		// sequence
 		gvgSequence       sequence.Sequence[uint32]
 		gvgFamilySequence sequence.Sequence[uint32]
+		rebalanceSequence sequence.Sequence[uint32]
 	}
 )
 
@@ -61,6 +63,7 @@ func NewKeeper(
 
 	k.gvgSequence = sequence.NewSequence[uint32](types.GVGSequencePrefix)
 	k.gvgFamilySequence = sequence.NewSequence[uint32](types.GVGFamilySequencePrefix)
+	k.rebalanceSequence = sequence.NewSequence[uint32](types.RebalanceSequencePrefix)
 
 	return &k
 }
@@ -154,8 +157,20 @@ func (k Keeper) DeleteGVG(ctx sdk.Context, primarySp *sptypes.StorageProvider, g
 		gvgStatisticsWithinSP.SecondaryCount--
 		k.SetGVGStatisticsWithSP(ctx, gvgStatisticsWithinSP)
 	}
+	k.removeFamilySecondarySPs(ctx, gvg.FamilyId, gvg.SecondarySpIds...)
+
+	// release the staked size of the gvg committed by its sps
+	stakingSize := k.GetTotalStakingStoreSize(ctx, gvg)
+	k.spKeeper.ReleaseSpCapacity(ctx, gvg.PrimarySpId, stakingSize)
+	for _, secondarySPID := range gvg.SecondarySpIds {
+		k.spKeeper.ReleaseSpCapacity(ctx, secondarySPID, stakingSize)
+	}
 
 	store.Delete(types.GetGVGKey(gvg.Id))
+	store.Delete(types.GetGVGSwappedHeightKey(gvg.Id))
+	if repair, found := k.GetGVGRepair(ctx, gvg.Id); found {
+		k.deleteGVGRepair(ctx, repair)
+	}
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventDeleteGlobalVirtualGroup{
 		Id:          gvg.Id,
 		PrimarySpId: gvg.PrimarySpId,
@@ -181,6 +196,22 @@ func (k Keeper) DeleteGVG(ctx sdk.Context, primarySp *sptypes.StorageProvider, g
 	return nil
 }
 
+// setGVGSwappedHeight records the current height as the height at which an sp of the gvg was swapped last
+func (k Keeper) setGVGSwappedHeight(ctx sdk.Context, gvgID uint32) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(ctx.BlockHeight()))
+	ctx.KVStore(k.storeKey).Set(types.GetGVGSwappedHeightKey(gvgID), bz)
+}
+
+// GetGVGSwappedHeight returns the height at which an sp of the gvg was swapped last
+func (k Keeper) GetGVGSwappedHeight(ctx sdk.Context, gvgID uint32) (uint64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetGVGSwappedHeightKey(gvgID))
+	if bz == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(bz), true
+}
+
 func (k Keeper) GetGVG(ctx sdk.Context, gvgID uint32) (*types.GlobalVirtualGroup, bool) {
 	store := ctx.KVStore(k.storeKey)
 
@@ -231,15 +262,150 @@ func (k Keeper) GetAndCheckGVGFamilyAvailableForNewBucket(ctx sdk.Context, famil
 		return nil, types.ErrGVGFamilyNotExist
 	}
 
+	// a family with jailed sps can not serve new buckets until the sps are unjailed
+	if k.isGVGFamilyServedByJailedSP(ctx, gvgFamily) {
+		return nil, sptypes.ErrStorageProviderWrongStatus.Wrapf("The family(ID=%d) is served by jailed sp and can't serve more buckets.", familyID)
+	}
+
 	// check the maximum store size for a family
 	// If yes, no more buckets will be served
 	storeSize := k.GetStoreSizeOfFamily(ctx, gvgFamily)
 	if storeSize >= k.MaxStoreSizePerFamily(ctx) {
 		return nil, types.ErrLimitationExceed.Wrapf("The storage size within the family exceeds the limit and can't serve more buckets.. Current: %d, now: %d", k.MaxStoreSizePerFamily(ctx), storeSize)
 	}
+
+	// the primary sp should not be over-committed beyond its declared capacity: once the staked size of the family
+	// is used up, the primary sp must have free capacity for one more gvg of the average staked size of the family.
+	if capacity, found := k.spKeeper.GetSpCapacity(ctx, gvgFamily.PrimarySpId); found {
+		totalStakingSize, storedSize, err := k.GetGlobalVirtualFamilyTotalStakingAndStoredSize(ctx, gvgFamily)
+		if err != nil {
+			return nil, err
+		}
+		if storedSize >= totalStakingSize {
+			var requiredSize uint64 = 1
+			if len(gvgFamily.GlobalVirtualGroupIds) != 0 && totalStakingSize != 0 {
+				requiredSize = totalStakingSize / uint64(len(gvgFamily.GlobalVirtualGroupIds))
+			}
+			if capacity.FreeCapacity < requiredSize {
+				return nil, sptypes.ErrStorageProviderCapacityExceeded.Wrapf("The primary sp(ID=%d) has free capacity %d less than the required %d and can't serve more buckets.",
+					gvgFamily.PrimarySpId, capacity.FreeCapacity, requiredSize)
+			}
+		}
+	}
 	return gvgFamily, nil
 }
 
+// isGVGFamilyServedByJailedSP returns whether the primary sp of the family or a secondary sp of its gvgs is jailed,
+// the secondary sps are looked up in the index of the family instead of loading all its gvgs.
+func (k Keeper) isGVGFamilyServedByJailedSP(ctx sdk.Context, gvgFamily *types.GlobalVirtualGroupFamily) bool {
+	if k.isSPJailed(ctx, gvgFamily.PrimarySpId) {
+		return true
+	}
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetFamilySecondarySPPrefix(gvgFamily.Id))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if k.isSPJailed(ctx, binary.BigEndian.Uint32(iterator.Key()[len(types.FamilySecondarySPKey)+4:])) {
+			return true
+		}
+	}
+	return false
+}
+
+func (k Keeper) isSPJailed(ctx sdk.Context, spID uint32) bool {
+	sp, found := k.spKeeper.GetStorageProvider(ctx, spID)
+	return found && sp.Status == sptypes.STATUS_IN_JAILED
+}
+
+// addFamilySecondarySPs counts the gvg served by the secondary sps in the index of the family
+func (k Keeper) addFamilySecondarySPs(ctx sdk.Context, familyID uint32, spIDs ...uint32) {
+	store := ctx.KVStore(k.storeKey)
+	for _, spID := range spIDs {
+		key := types.GetFamilySecondarySPKey(familyID, spID)
+		var count uint32
+		if bz := store.Get(key); bz != nil {
+			count = binary.BigEndian.Uint32(bz)
+		}
+		store.Set(key, binary.BigEndian.AppendUint32(nil, count+1))
+	}
+}
+
+// removeFamilySecondarySPs uncounts the gvg served by the secondary sps in the index of the family
+func (k Keeper) removeFamilySecondarySPs(ctx sdk.Context, familyID uint32, spIDs ...uint32) {
+	store := ctx.KVStore(k.storeKey)
+	for _, spID := range spIDs {
+		key := types.GetFamilySecondarySPKey(familyID, spID)
+		bz := store.Get(key)
+		if bz == nil {
+			continue
+		}
+		if count := binary.BigEndian.Uint32(bz); count > 1 {
+			store.Set(key, binary.BigEndian.AppendUint32(nil, count-1))
+		} else {
+			store.Delete(key)
+		}
+	}
+}
+
+// BackfillFamilySecondarySPs rebuilds the index of the secondary sps serving the gvgs of each family from all the gvgs,
+// it is idempotent.
+func (k Keeper) BackfillFamilySecondarySPs(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.FamilySecondarySPKey)
+	var keys [][]byte
+	indexIterator := indexStore.Iterator(nil, nil)
+	for ; indexIterator.Valid(); indexIterator.Next() {
+		keys = append(keys, indexIterator.Key())
+	}
+	indexIterator.Close()
+	for _, key := range keys {
+		indexStore.Delete(key)
+	}
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.GVGKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var gvg types.GlobalVirtualGroup
+		k.cdc.MustUnmarshal(iterator.Value(), &gvg)
+		k.addFamilySecondarySPs(ctx, gvg.FamilyId, gvg.SecondarySpIds...)
+	}
+}
+
+// checkSwapOutSuccessorPlacement checks the successor sp taking over the family or the gvgs would not violate
+// the placement constraints of the buckets served by the families.
+func (k Keeper) checkSwapOutSuccessorPlacement(ctx sdk.Context, gvgFamilyID uint32, gvgIDs []uint32, successorSPID uint32) error {
+	if gvgFamilyID != types.NoSpecifiedFamilyId {
+		return k.storageKeeper.VerifySPPlacementInFamily(ctx, gvgFamilyID, successorSPID)
+	}
+	checked := make(map[uint32]struct{})
+	for _, gvgID := range gvgIDs {
+		gvg, found := k.GetGVG(ctx, gvgID)
+		if !found {
+			return types.ErrGVGNotExist
+		}
+		if _, ok := checked[gvg.FamilyId]; ok {
+			continue
+		}
+		checked[gvg.FamilyId] = struct{}{}
+		if err := k.storageKeeper.VerifySPPlacementInFamily(ctx, gvg.FamilyId, successorSPID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkSpFamilyLimit checks the sp can serve one more family as primary sp within its declared maximum number of families
+func (k Keeper) checkSpFamilyLimit(ctx sdk.Context, spID uint32) error {
+	capacity, found := k.spKeeper.GetSpCapacity(ctx, spID)
+	if !found || capacity.MaxGvgFamilies == 0 {
+		return nil
+	}
+	stat, found := k.GetGVGFamilyStatisticsWithinSP(ctx, spID)
+	if found && uint32(len(stat.GlobalVirtualGroupFamilyIds)) >= capacity.MaxGvgFamilies {
+		return sptypes.ErrStorageProviderCapacityExceeded.Wrapf("The sp(ID=%d) already serves %d families, max: %d", spID, len(stat.GlobalVirtualGroupFamilyIds), capacity.MaxGvgFamilies)
+	}
+	return nil
+}
+
 func (k Keeper) GetOrCreateEmptyGVGFamily(ctx sdk.Context, familyID uint32, primarySPID uint32) (*types.GlobalVirtualGroupFamily, error) {
 	store := ctx.KVStore(k.storeKey)
 	var gvgFamily types.GlobalVirtualGroupFamily
@@ -302,6 +468,7 @@ func (k Keeper) SwapAsPrimarySP(ctx sdk.Context, primarySP, successorSP *sptypes
 	}
 
 	var gvgs []*types.GlobalVirtualGroup
+	var stakingSize uint64
 	for _, gvgID := range family.GlobalVirtualGroupIds {
 		gvg, found := k.GetGVG(ctx, gvgID)
 		if !found {
@@ -337,12 +504,21 @@ func (k Keeper) SwapAsPrimarySP(ctx sdk.Context, primarySP, successorSP *sptypes
 
 		gvg.PrimarySpId = successorSP.Id
 		gvgs = append(gvgs, gvg)
+		stakingSize += k.GetTotalStakingStoreSize(ctx, gvg)
 		srcStat.PrimaryCount--
 		dstStat.PrimaryCount++
 	}
 
 	family.PrimarySpId = successorSP.Id
 
+	// the capacity of the successor sp is reserved when the swap in is reserved
+	if !swapIn {
+		if err := k.spKeeper.ReserveSpCapacity(ctx, successorSP.Id, stakingSize); err != nil {
+			return err
+		}
+	}
+	k.spKeeper.ReleaseSpCapacity(ctx, primarySP.Id, stakingSize)
+
 	// settlement
 	err := k.SettleAndDistributeGVGFamily(ctx, primarySP, family)
 	if err != nil {
@@ -360,6 +536,7 @@ func (k Keeper) SwapAsPrimarySP(ctx sdk.Context, primarySP, successorSP *sptypes
 	}
 
 	for _, gvg := range gvgs {
+		k.setGVGSwappedHeight(ctx, gvg.Id)
 		if err := k.SetGVGAndEmitUpdateEvent(ctx, gvg); err != nil {
 			if swapIn {
 				return types.ErrSwapInFailed.Wrapf("failed to set gvg and emit update event, err: %s", err)
@@ -375,7 +552,7 @@ func (k Keeper) SwapAsPrimarySP(ctx sdk.Context, primarySP, successorSP *sptypes
 		k.SetGVGFamilyStatisticsWithinSP(ctx, dstVGFStat)
 	}
 
-	return nil
+	return k.onFamilyPrimarySwapped(ctx, family.Id, successorSP.Id)
 }
 
 func (k Keeper) SwapOutAsSecondarySP(ctx sdk.Context, secondarySP, successorSP *sptypes.StorageProvider, gvgID uint32) error {
@@ -410,6 +587,8 @@ func (k Keeper) SwapOutAsSecondarySP(ctx sdk.Context, secondarySP, successorSP *
 		panic("secondary sp found but the index is not correct when swap out as secondary sp")
 	}
 	gvg.SecondarySpIds[secondarySPIndex] = successorSP.Id
+	k.removeFamilySecondarySPs(ctx, gvg.FamilyId, secondarySP.Id)
+	k.addFamilySecondarySPs(ctx, gvg.FamilyId, successorSP.Id)
 	origin := k.MustGetGVGStatisticsWithinSP(ctx, secondarySP.Id)
 	successor, found := k.GetGVGStatisticsWithinSP(ctx, successorSP.Id)
 	if !found {
@@ -420,11 +599,18 @@ func (k Keeper) SwapOutAsSecondarySP(ctx sdk.Context, secondarySP, successorSP *
 	k.SetGVGStatisticsWithSP(ctx, origin)
 	k.SetGVGStatisticsWithSP(ctx, successor)
 
+	stakingSize := k.GetTotalStakingStoreSize(ctx, gvg)
+	if err := k.spKeeper.ReserveSpCapacity(ctx, successorSP.Id, stakingSize); err != nil {
+		return err
+	}
+	k.spKeeper.ReleaseSpCapacity(ctx, secondarySP.Id, stakingSize)
+
 	if err := k.SetGVGAndEmitUpdateEvent(ctx, gvg); err != nil {
 		return err
 	}
 
-	return nil
+	k.setGVGSwappedHeight(ctx, gvg.Id)
+	return k.onGVGSecondarySwapped(ctx, gvg)
 }
 
 func (k Keeper) GetOrCreateGVGStatisticsWithinSP(ctx sdk.Context, spID uint32) *types.GVGStatisticsWithinSP {
@@ -610,6 +796,28 @@ func (k Keeper) GetGlobalVirtualFamilyTotalStakingAndStoredSize(ctx sdk.Context,
 	return familyTotalStakingSize, familyStoredSize, nil
 }
 
+// GetGlobalVirtualFamilySecondarySpScore returns the lowest score of the secondary SPs in the family,
+// a family without any GVG has the full score.
+func (k Keeper) GetGlobalVirtualFamilySecondarySpScore(ctx sdk.Context, gvgFamily *types.GlobalVirtualGroupFamily) (uint32, error) {
+	familyScore := sptypes.MaxSpScore
+	for _, gvgID := range gvgFamily.GlobalVirtualGroupIds {
+		gvg, found := k.GetGVG(ctx, gvgID)
+		if !found {
+			return 0, types.ErrGVGNotExist
+		}
+		for _, spID := range gvg.SecondarySpIds {
+			score, found := k.spKeeper.GetStorageProviderScore(ctx, spID)
+			if !found {
+				return 0, sptypes.ErrStorageProviderNotFound
+			}
+			if score.Score < familyScore {
+				familyScore = score.Score
+			}
+		}
+	}
+	return familyScore, nil
+}
+
 func (k Keeper) GetGlobalVirtualGroupIfAvailable(ctx sdk.Context, gvgID uint32, expectedStoreSize uint64) (*types.GlobalVirtualGroup, error) {
 	gvg, found := k.GetGVG(ctx, gvgID)
 	if !found {
@@ -772,7 +980,7 @@ func (k Keeper) SwapIn(ctx sdk.Context, gvgFamilyID uint32, gvgID uint32, succes
 		if family.PrimarySpId != targetSP.Id {
 			return types.ErrSwapInFailed.Wrapf("the family(ID: %d) primary SP(ID: %d) does not match the target SP(ID: %d) which need to be swapped", family.Id, family.PrimarySpId, targetSP.Id)
 		}
-		return k.setSwapInInfo(ctx, types.GetSwapInFamilyKey(gvgFamilyID), successorSPID, targetSP.Id, expirationTime)
+		return k.reserveFamilySwapIn(ctx, family, successorSPID, targetSP.Id, expirationTime)
 	}
 
 	// swapIn GVG as secondary SP when there is secondary SP exiting
@@ -795,8 +1003,24 @@ func (k Keeper) SwapIn(ctx sdk.Context, gvgFamilyID uint32, gvgID uint32, succes
 	if !exist {
 		return types.ErrSwapInFailed.Wrapf("The sp(ID: %d) that needs swap out is not one of the secondary sps of gvg gvg(%s).", targetSP.Id, gvg.String())
 	}
+	if err := k.storageKeeper.VerifySPPlacementInFamily(ctx, gvg.FamilyId, successorSPID); err != nil {
+		return err
+	}
+	stakingSize := k.GetTotalStakingStoreSize(ctx, gvg)
+	if err := k.spKeeper.ReserveSpCapacity(ctx, successorSPID, stakingSize); err != nil {
+		return err
+	}
 	if targetSP.Status == sptypes.STATUS_GRACEFUL_EXITING || targetSP.Status == sptypes.STATUS_FORCED_EXITING {
-		return k.setSwapInInfo(ctx, types.GetSwapInGVGKey(gvgID), successorSPID, targetSP.Id, expirationTime)
+		return k.setSwapInInfo(ctx, types.GetSwapInGVGKey(gvgID), successorSPID, targetSP.Id, expirationTime, stakingSize)
+	}
+
+	// swap into GVG under repair, the jailed or maintaining secondary SP can be swapped by any in service SP.
+	if _, found := k.GetGVGRepair(ctx, gvgID); found && isUnhealthySPStatus(targetSP.Status) {
+		successorSP, found := k.spKeeper.GetStorageProvider(ctx, successorSPID)
+		if !found || !successorSP.IsInService() {
+			return types.ErrSwapInFailed.Wrapf("The SP(ID=%d) is not in service, can not repair GVG(ID=%d)", successorSPID, gvgID)
+		}
+		return k.setSwapInInfo(ctx, types.GetSwapInGVGKey(gvgID), successorSPID, targetSP.Id, expirationTime, stakingSize)
 	}
 
 	// swap into GVG that no SP exiting but not fulfil redundancy requirement. e.g. [1|2,3,4,5,6,1]
@@ -810,19 +1034,42 @@ func (k Keeper) SwapIn(ctx sdk.Context, gvgFamilyID uint32, gvgID uint32, succes
 	if !breakRedundancyReqmt {
 		return types.ErrSwapInFailed.Wrap("can not swap into GVG which all SP are unique")
 	}
-	return k.setSwapInInfo(ctx, types.GetSwapInGVGKey(gvgID), successorSPID, targetSP.Id, expirationTime)
+	return k.setSwapInInfo(ctx, types.GetSwapInGVGKey(gvgID), successorSPID, targetSP.Id, expirationTime, stakingSize)
+}
+
+// reserveFamilySwapIn checks the successor sp is able to serve the family as the primary sp,
+// reserves its capacity for the family and records the swap in info.
+func (k Keeper) reserveFamilySwapIn(ctx sdk.Context, family *types.GlobalVirtualGroupFamily, successorSPID, targetSPID uint32, expirationTime int64) error {
+	if err := k.checkSpFamilyLimit(ctx, successorSPID); err != nil {
+		return err
+	}
+	if err := k.storageKeeper.VerifySPPlacementInFamily(ctx, family.Id, successorSPID); err != nil {
+		return err
+	}
+	familyStakingSize, _, err := k.GetGlobalVirtualFamilyTotalStakingAndStoredSize(ctx, family)
+	if err != nil {
+		return err
+	}
+	if err = k.spKeeper.ReserveSpCapacity(ctx, successorSPID, familyStakingSize); err != nil {
+		return err
+	}
+	return k.setSwapInInfo(ctx, types.GetSwapInFamilyKey(family.Id), successorSPID, targetSPID, expirationTime, familyStakingSize)
 }
 
-func (k Keeper) setSwapInInfo(ctx sdk.Context, key []byte, successorSPID, targetSPID uint32, expirationTime int64) error {
+// setSwapInInfo records the swap in info with the capacity reserved from the successor sp, the stale swap in info
+// of the previous successor sp is overridden and its reserved capacity is released.
+func (k Keeper) setSwapInInfo(ctx sdk.Context, key []byte, successorSPID, targetSPID uint32, expirationTime int64, reservedSize uint64) error {
 	store := ctx.KVStore(k.storeKey)
 	swapInInfo := &types.SwapInInfo{
 		SuccessorSpId:  successorSPID,
 		TargetSpId:     targetSPID,
 		ExpirationTime: uint64(expirationTime),
+		ReservedSize:   reservedSize,
 	}
 	bz := store.Get(key)
 	if bz == nil {
 		store.Set(key, k.cdc.MustMarshal(swapInInfo))
+		store.Set(types.GetSwapInExpirationKey(swapInInfo.ExpirationTime, key), []byte{})
 		return nil
 	}
 	curSwapInInfo := &types.SwapInInfo{}
@@ -834,10 +1081,62 @@ func (k Keeper) setSwapInInfo(ctx sdk.Context, key []byte, successorSPID, target
 	if curSwapInInfo.SuccessorSpId == successorSPID {
 		return types.ErrSwapInFailed.Wrapf("already tried to swap in but expired")
 	}
+	k.removeSwapInInfo(ctx, key, curSwapInInfo)
+	k.spKeeper.ReleaseSpCapacity(ctx, curSwapInInfo.SuccessorSpId, curSwapInInfo.ReservedSize)
 	store.Set(key, k.cdc.MustMarshal(swapInInfo))
+	store.Set(types.GetSwapInExpirationKey(swapInInfo.ExpirationTime, key), []byte{})
 	return nil
 }
 
+// removeSwapInInfo deletes the swap in info and its entry in the expiration queue
+func (k Keeper) removeSwapInInfo(ctx sdk.Context, key []byte, swapInInfo *types.SwapInInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(key)
+	store.Delete(types.GetSwapInExpirationKey(swapInInfo.ExpirationTime, key))
+}
+
+// ProcessExpiredSwapIns releases the capacity reserved by the swap ins reaching their expiration time. The expired
+// swap in info is kept, so that it can still be completed until another sp overrides it, unless it is reserved by
+// a rebalance, whose entry moving the family is canceled.
+func (k Keeper) ProcessExpiredSwapIns(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	now := uint64(ctx.BlockTime().Unix())
+
+	iterator := store.Iterator(types.SwapInExpirationKey, types.GetSwapInExpirationKey(now+1, nil))
+	var queueKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		queueKeys = append(queueKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, queueKey := range queueKeys {
+		store.Delete(queueKey)
+		key := queueKey[len(types.SwapInExpirationKey)+8:]
+		bz := store.Get(key)
+		if bz == nil {
+			continue
+		}
+		if bytes.HasPrefix(key, types.SwapInFamilyKey) {
+			var uint32Seq sequence.Sequence[uint32]
+			familyID := uint32Seq.DecodeSequence(key[len(types.SwapInFamilyKey):])
+			if _, err := k.cancelStaleFamilyRebalance(ctx, familyID); err != nil {
+				ctx.Logger().Error("fail to cancel the rebalance of expired swap in", "family", familyID, "err", err)
+			}
+			if bz = store.Get(key); bz == nil {
+				continue
+			}
+		}
+		swapInInfo := &types.SwapInInfo{}
+		k.cdc.MustUnmarshal(bz, swapInInfo)
+		if swapInInfo.ReservedSize == 0 {
+			continue
+		}
+		k.spKeeper.ReleaseSpCapacity(ctx, swapInInfo.SuccessorSpId, swapInInfo.ReservedSize)
+		swapInInfo.ReservedSize = 0
+		store.Set(key, k.cdc.MustMarshal(swapInInfo))
+	}
+}
+
 func (k Keeper) DeleteSwapInInfo(ctx sdk.Context, gvgFamilyID, gvgID uint32, successorSPID uint32) error {
 	store := ctx.KVStore(k.storeKey)
 
@@ -851,7 +1150,8 @@ func (k Keeper) DeleteSwapInInfo(ctx sdk.Context, gvgFamilyID, gvgID uint32, suc
 		if swapInInfo.SuccessorSpId != successorSPID {
 			return sptypes.ErrStorageProviderNotFound.Wrapf("spID(%d) is different from the spID(%d) in swapInInfo", successorSPID, swapInInfo.SuccessorSpId)
 		}
-		store.Delete(key)
+		k.removeSwapInInfo(ctx, key, &swapInInfo)
+		k.spKeeper.ReleaseSpCapacity(ctx, swapInInfo.SuccessorSpId, swapInInfo.ReservedSize)
 		return nil
 	}
 
@@ -859,6 +1159,9 @@ func (k Keeper) DeleteSwapInInfo(ctx sdk.Context, gvgFamilyID, gvgID uint32, suc
 		if err := deleteSwapInfo(types.GetSwapInFamilyKey(gvgFamilyID)); err != nil {
 			return err
 		}
+		if err := k.onFamilySwapInCanceled(ctx, gvgFamilyID, successorSPID); err != nil {
+			return err
+		}
 	} else {
 		if err := deleteSwapInfo(types.GetSwapInGVGKey(gvgID)); err != nil {
 			return err
@@ -897,7 +1200,7 @@ func (k Keeper) CompleteSwapIn(ctx sdk.Context, gvgFamilyID uint32, gvgID uint32
 		if err := k.SwapAsPrimarySP(ctx, targetPrimarySP, successorSP, gvgFamilyID, true); err != nil {
 			return err
 		}
-		store.Delete(key)
+		k.removeSwapInInfo(ctx, key, &swapInInfo)
 	} else {
 		key := types.GetSwapInGVGKey(gvgID)
 		bz := store.Get(key)
@@ -915,7 +1218,7 @@ func (k Keeper) CompleteSwapIn(ctx sdk.Context, gvgFamilyID uint32, gvgID uint32
 		if err := k.completeSwapInGVG(ctx, successorSP.Id, targetSecondarySP.Id, gvgID); err != nil {
 			return err
 		}
-		store.Delete(key)
+		k.removeSwapInInfo(ctx, key, &swapInInfo)
 	}
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventCompleteSwapIn{
 		StorageProviderId:          successorSP.Id,
@@ -952,6 +1255,8 @@ func (k Keeper) completeSwapInGVG(ctx sdk.Context, successorSPID, targetSecondar
 		panic("secondary sp found but the index is not correct when swap out as secondary sp")
 	}
 	gvg.SecondarySpIds[secondarySPIndex] = successorSPID
+	k.removeFamilySecondarySPs(ctx, gvg.FamilyId, targetSecondarySPID)
+	k.addFamilySecondarySPs(ctx, gvg.FamilyId, successorSPID)
 	origin := k.MustGetGVGStatisticsWithinSP(ctx, targetSecondarySPID)
 	successor, found := k.GetGVGStatisticsWithinSP(ctx, successorSPID)
 	if !found {
@@ -964,5 +1269,11 @@ func (k Keeper) completeSwapInGVG(ctx sdk.Context, successorSPID, targetSecondar
 	successor.SecondaryCount++
 	k.SetGVGStatisticsWithSP(ctx, origin)
 	k.SetGVGStatisticsWithSP(ctx, successor)
-	return k.SetGVGAndEmitUpdateEvent(ctx, gvg)
+	// the capacity of the successor sp is reserved when the swap in is reserved
+	k.spKeeper.ReleaseSpCapacity(ctx, targetSecondarySPID, k.GetTotalStakingStoreSize(ctx, gvg))
+	if err := k.SetGVGAndEmitUpdateEvent(ctx, gvg); err != nil {
+		return err
+	}
+	k.setGVGSwappedHeight(ctx, gvg.Id)
+	return k.onGVGSecondarySwapped(ctx, gvg)
 }
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestGVGFamilyServedByJailedSP() {
+	sps := map[uint32]*sptypes.StorageProvider{
+		1: {Id: 1, Status: sptypes.STATUS_IN_SERVICE},
+		2: {Id: 2, Status: sptypes.STATUS_IN_SERVICE},
+		3: {Id: 3, Status: sptypes.STATUS_IN_JAILED},
+		4: {Id: 4, Status: sptypes.STATUS_IN_SERVICE},
+	}
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, id uint32) (*sptypes.StorageProvider, bool) {
+			sp, found := sps[id]
+			return sp, found
+		}).AnyTimes()
+	s.spKeeper.EXPECT().GetSpCapacity(gomock.Any(), gomock.Any()).Return(sptypes.SpCapacity{}, false).AnyTimes()
+	s.spKeeper.EXPECT().ReserveSpCapacity(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.spKeeper.EXPECT().ReleaseSpCapacity(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	s.paymentKeeper.EXPECT().QueryDynamicBalance(gomock.Any(), gomock.Any()).Return(math.ZeroInt(), nil).AnyTimes()
+
+	// the gvgs stored before the index is introduced are indexed by the backfill
+	s.virtualgroupKeeper.SetGVGFamily(s.ctx, &types.GlobalVirtualGroupFamily{Id: 1, PrimarySpId: 1, GlobalVirtualGroupIds: []uint32{1, 2}})
+	s.virtualgroupKeeper.SetGVG(s.ctx, &types.GlobalVirtualGroup{Id: 1, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: []uint32{2, 3}, TotalDeposit: math.ZeroInt(), VirtualPaymentAddress: sample.RandAccAddressHex()})
+	s.virtualgroupKeeper.SetGVG(s.ctx, &types.GlobalVirtualGroup{Id: 2, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: []uint32{3, 2}, TotalDeposit: math.ZeroInt(), VirtualPaymentAddress: sample.RandAccAddressHex()})
+	s.virtualgroupKeeper.SetGVGStatisticsWithSP(s.ctx, &types.GVGStatisticsWithinSP{StorageProviderId: 3, SecondaryCount: 2})
+	_, err := s.virtualgroupKeeper.GetAndCheckGVGFamilyAvailableForNewBucket(s.ctx, 1)
+	require.NoError(s.T(), err)
+
+	s.virtualgroupKeeper.BackfillFamilySecondarySPs(s.ctx)
+	s.virtualgroupKeeper.BackfillFamilySecondarySPs(s.ctx)
+	_, err = s.virtualgroupKeeper.GetAndCheckGVGFamilyAvailableForNewBucket(s.ctx, 1)
+	require.ErrorIs(s.T(), err, sptypes.ErrStorageProviderWrongStatus)
+
+	// the family is still served by the jailed sp until it is swapped out of all the gvgs
+	require.NoError(s.T(), s.virtualgroupKeeper.SwapOutAsSecondarySP(s.ctx, sps[3], sps[4], 1))
+	_, err = s.virtualgroupKeeper.GetAndCheckGVGFamilyAvailableForNewBucket(s.ctx, 1)
+	require.ErrorIs(s.T(), err, sptypes.ErrStorageProviderWrongStatus)
+	require.NoError(s.T(), s.virtualgroupKeeper.SwapOutAsSecondarySP(s.ctx, sps[3], sps[4], 2))
+	_, err = s.virtualgroupKeeper.GetAndCheckGVGFamilyAvailableForNewBucket(s.ctx, 1)
+	require.NoError(s.T(), err)
+
+	// the jailed primary sp is checked as well
+	sps[1].Status = sptypes.STATUS_IN_JAILED
+	_, err = s.virtualgroupKeeper.GetAndCheckGVGFamilyAvailableForNewBucket(s.ctx, 1)
+	require.ErrorIs(s.T(), err, sptypes.ErrStorageProviderWrongStatus)
+}
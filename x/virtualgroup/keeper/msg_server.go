@@ -55,9 +55,10 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 func (k msgServer) CreateGlobalVirtualGroup(goCtx context.Context, req *types.MsgCreateGlobalVirtualGroup) (*types.MsgCreateGlobalVirtualGroupResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if ctx.IsUpgraded(upgradetypes.Pampas) {
-		expectSecondarySPNum := int(k.storageKeeper.GetExpectSecondarySPNumForECObject(ctx, ctx.BlockTime().Unix()))
-		if len(req.GetSecondarySpIds()) != expectSecondarySPNum {
-			return nil, types.ErrInvalidSecondarySPCount.Wrapf("the number of secondary sp in the Global virtual group should be %d", expectSecondarySPNum)
+		// the gvg can serve the buckets with the default EC profile or any EC profile approved by governance
+		if !k.storageKeeper.IsAllowedSecondarySPNum(ctx, uint32(len(req.GetSecondarySpIds()))) {
+			return nil, types.ErrInvalidSecondarySPCount.Wrapf("the number of secondary sp in the Global virtual group should be %d, or match an approved EC profile",
+				k.storageKeeper.GetExpectSecondarySPNumForECObject(ctx, ctx.BlockTime().Unix()))
 		}
 		spIdSet := make(map[uint32]struct{}, len(req.GetSecondarySpIds()))
 		for _, spId := range req.GetSecondarySpIds() {
@@ -101,6 +102,12 @@ func (k msgServer) CreateGlobalVirtualGroup(goCtx context.Context, req *types.Ms
 		gvgStatisticsWithinSPs = append(gvgStatisticsWithinSPs, gvgStatisticsWithinSP)
 	}
 
+	if req.FamilyId == types.NoSpecifiedFamilyId {
+		if err := k.checkSpFamilyLimit(ctx, sp.Id); err != nil {
+			return nil, err
+		}
+	}
+
 	gvgFamily, err := k.GetOrCreateEmptyGVGFamily(ctx, req.FamilyId, sp.Id)
 	if err != nil {
 		return nil, err
@@ -146,11 +153,23 @@ func (k msgServer) CreateGlobalVirtualGroup(goCtx context.Context, req *types.Ms
 		TotalDeposit:          req.Deposit.Amount,
 	}
 
+	// the staked size is committed by every sp in the gvg, which should not exceed the declared capacity
+	stakingStoreSize := k.GetTotalStakingStoreSize(ctx, gvg)
+	if err = k.spKeeper.ReserveSpCapacity(ctx, sp.Id, stakingStoreSize); err != nil {
+		return nil, err
+	}
+	for _, sspID := range secondarySpIds {
+		if err = k.spKeeper.ReserveSpCapacity(ctx, sspID, stakingStoreSize); err != nil {
+			return nil, err
+		}
+	}
+
 	gvgFamily.AppendGVG(gvg.Id)
 
 	k.SetGVG(ctx, gvg)
 	k.SetGVGFamily(ctx, gvgFamily)
 	k.BatchSetGVGStatisticsWithinSP(ctx, gvgStatisticsWithinSPs)
+	k.addFamilySecondarySPs(ctx, gvg.FamilyId, gvg.SecondarySpIds...)
 
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventCreateGlobalVirtualGroup{
 		Id:                    gvg.Id,
@@ -230,7 +249,16 @@ func (k msgServer) Deposit(goCtx context.Context, req *types.MsgDeposit) (*types
 		return nil, err
 	}
 
+	// the increased staked size is committed by every sp in the gvg
+	prevStakingSize := k.GetTotalStakingStoreSize(ctx, gvg)
 	gvg.TotalDeposit = gvg.TotalDeposit.Add(req.Deposit.Amount)
+	if stakingSize := k.GetTotalStakingStoreSize(ctx, gvg); stakingSize > prevStakingSize {
+		for _, spID := range append([]uint32{gvg.PrimarySpId}, gvg.SecondarySpIds...) {
+			if err := k.spKeeper.ReserveSpCapacity(ctx, spID, stakingSize-prevStakingSize); err != nil {
+				return nil, err
+			}
+		}
+	}
 	k.SetGVG(ctx, gvg)
 
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventUpdateGlobalVirtualGroup{
@@ -321,8 +349,18 @@ func (k msgServer) SwapOut(goCtx context.Context, msg *types.MsgSwapOut) (*types
 	if !successorSP.IsInService() {
 		return nil, sptypes.ErrStorageProviderNotInService.Wrapf("successor sp is not in service, status: %s", sp.Status.String())
 	}
-	// verify the approval
-	err := gnfdtypes.VerifySignature(sdk.MustAccAddressFromHex(successorSP.ApprovalAddress), sdk.Keccak256(msg.GetApprovalBytes()), msg.SuccessorSpApproval.Sig)
+	// verify the approval, the replaced approval address is accepted during the overlap period of a key rotation
+	var err error
+	for _, approvalAddr := range k.spKeeper.GetSpApprovalAddresses(ctx, successorSP) {
+		if err = gnfdtypes.VerifySignature(approvalAddr, sdk.Keccak256(msg.GetApprovalBytes()), msg.SuccessorSpApproval.Sig); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.checkSwapOutSuccessorPlacement(ctx, msg.GlobalVirtualGroupFamilyId, msg.GlobalVirtualGroupIds, successorSP.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -331,6 +369,7 @@ func (k msgServer) SwapOut(goCtx context.Context, msg *types.MsgSwapOut) (*types
 	if err != nil {
 		return nil, err
 	}
+	k.spKeeper.RecordSpSwapOut(ctx, sp.Id)
 
 	if err = ctx.EventManager().EmitTypedEvents(&types.EventSwapOut{
 		StorageProviderId:          sp.Id,
@@ -485,6 +524,7 @@ func (k msgServer) StorageProviderExit(goCtx context.Context, msg *types.MsgStor
 	sp.Status = sptypes.STATUS_GRACEFUL_EXITING
 
 	k.spKeeper.SetStorageProvider(ctx, sp)
+	k.spKeeper.RecordSpExit(ctx, sp.Id, false)
 
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventStorageProviderExit{
 		StorageProviderId: sp.Id,
@@ -648,6 +688,7 @@ func (k msgServer) StorageProviderForcedExit(goCtx context.Context, msg *types.M
 	// Governance can put an SP into force exiting status no matter what status it is in.
 	sp.Status = sptypes.STATUS_FORCED_EXITING
 	k.spKeeper.SetStorageProvider(ctx, sp)
+	k.spKeeper.RecordSpExit(ctx, sp.Id, true)
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventStorageProviderForcedExit{
 		StorageProviderId: sp.Id,
 	}); err != nil {
@@ -655,3 +696,32 @@ func (k msgServer) StorageProviderForcedExit(goCtx context.Context, msg *types.M
 	}
 	return &types.MsgStorageProviderForcedExitResponse{}, nil
 }
+
+func (k msgServer) RebalanceGlobalVirtualGroupFamilies(goCtx context.Context, msg *types.MsgRebalanceGlobalVirtualGroupFamilies) (*types.MsgRebalanceGlobalVirtualGroupFamiliesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sp, found := k.spKeeper.GetStorageProvider(ctx, msg.StorageProviderId)
+	if !found {
+		return nil, sptypes.ErrStorageProviderNotFound.Wrapf("The SP(ID=%d) not found", msg.StorageProviderId)
+	}
+	// Either the governance or the sp itself can rebalance the families of the sp.
+	if k.GetAuthority() != msg.Operator && !sp.GetOperatorAccAddress().Equals(sdk.MustAccAddressFromHex(msg.Operator)) {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid operator; expected %s or %s, got %s", k.GetAuthority(), sp.OperatorAddress, msg.Operator)
+	}
+
+	rebalance, err := k.RebalanceFamilies(ctx, msg.Operator, sp, msg.GlobalVirtualGroupFamilyIds, msg.TargetSpIds)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRebalanceGlobalVirtualGroupFamiliesResponse{RebalanceId: rebalance.Id}, nil
+}
+
+func (k msgServer) OpenGVGRepair(goCtx context.Context, msg *types.MsgOpenGVGRepair) (*types.MsgOpenGVGRepairResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	repair, err := k.Keeper.OpenGVGRepair(ctx, msg.Operator, msg.GlobalVirtualGroupId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgOpenGVGRepairResponse{Deadline: repair.Deadline}, nil
+}
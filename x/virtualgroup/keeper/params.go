@@ -37,6 +37,19 @@ func (k Keeper) SpConcurrentExitNum(ctx sdk.Context) (res uint32) {
 	return uint32(params.SpConcurrentExitNum.Uint64())
 }
 
+func (k Keeper) RedundancyRepairPeriod(ctx sdk.Context) (res uint64) {
+	params := k.GetParams(ctx)
+	return params.RedundancyRepairPeriod
+}
+
+func (k Keeper) RedundancyRepairSlashAmount(ctx sdk.Context) (res math.Int) {
+	params := k.GetParams(ctx)
+	if params.RedundancyRepairSlashAmount == nil || params.RedundancyRepairSlashAmount.IsNil() {
+		return math.ZeroInt()
+	}
+	return *params.RedundancyRepairSlashAmount
+}
+
 // GetParams returns the current sp module parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
 	store := ctx.KVStore(k.storeKey)
@@ -0,0 +1,282 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/internal/sequence"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func (k Keeper) GetFamilyRebalance(ctx sdk.Context, rebalanceID uint32) (*types.FamilyRebalance, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetFamilyRebalanceKey(rebalanceID))
+	if bz == nil {
+		return nil, false
+	}
+
+	var rebalance types.FamilyRebalance
+	k.cdc.MustUnmarshal(bz, &rebalance)
+	return &rebalance, true
+}
+
+func (k Keeper) setFamilyRebalance(ctx sdk.Context, rebalance *types.FamilyRebalance) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(rebalance)
+	store.Set(types.GetFamilyRebalanceKey(rebalance.Id), bz)
+}
+
+// getPendingFamilyRebalance returns the rebalance which is moving the family, if any
+func (k Keeper) getPendingFamilyRebalance(ctx sdk.Context, familyID uint32) (*types.FamilyRebalance, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetFamilyRebalanceByFamilyKey(familyID))
+	if bz == nil {
+		return nil, false
+	}
+	var uint32Seq sequence.Sequence[uint32]
+	return k.GetFamilyRebalance(ctx, uint32Seq.DecodeSequence(bz))
+}
+
+// RebalanceFamilies moves the families of a primary sp to other sps. For each family, it picks the candidate sp
+// serving the fewest families as the target and reserves the swap in of the family for the target sp,
+// the target sp completes the move by MsgCompleteSwapIn after it has fetched the data of the family.
+func (k Keeper) RebalanceFamilies(ctx sdk.Context, initiator string, srcSP *sptypes.StorageProvider, familyIDs, candidateSPIDs []uint32) (*types.FamilyRebalance, error) {
+	if len(familyIDs) == 0 {
+		if stat, found := k.GetGVGFamilyStatisticsWithinSP(ctx, srcSP.Id); found {
+			familyIDs = stat.GlobalVirtualGroupFamilyIds
+		}
+	}
+	if len(familyIDs) == 0 {
+		return nil, types.ErrFamilyRebalanceFailed.Wrapf("the sp(ID: %d) has no family to rebalance", srcSP.Id)
+	}
+	if len(familyIDs) > types.MaxFamiliesPerRebalance {
+		return nil, types.ErrFamilyRebalanceFailed.Wrapf("the sp(ID: %d) has %d families, at most %d families can be moved in a rebalance",
+			srcSP.Id, len(familyIDs), types.MaxFamiliesPerRebalance)
+	}
+
+	candidates := k.getRebalanceCandidates(ctx, srcSP.Id, candidateSPIDs)
+	if len(candidates) == 0 {
+		return nil, types.ErrFamilyRebalanceFailed.Wrap("no in service sp can be the target")
+	}
+	familyCounts := make(map[uint32]int, len(candidates))
+	for _, candidate := range candidates {
+		if stat, found := k.GetGVGFamilyStatisticsWithinSP(ctx, candidate); found {
+			familyCounts[candidate] = len(stat.GlobalVirtualGroupFamilyIds)
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	expirationTime := ctx.BlockTime().Unix() + int64(k.SwapInValidityPeriod(ctx))
+	rebalance := &types.FamilyRebalance{
+		Id:                k.rebalanceSequence.NextVal(store),
+		Initiator:         initiator,
+		StorageProviderId: srcSP.Id,
+		CreateTime:        ctx.BlockTime().Unix(),
+	}
+	for _, familyID := range familyIDs {
+		family, found := k.GetGVGFamily(ctx, familyID)
+		if !found {
+			return nil, types.ErrGVGFamilyNotExist.Wrapf("family(ID: %d)", familyID)
+		}
+		if family.PrimarySpId != srcSP.Id {
+			return nil, types.ErrFamilyRebalanceFailed.Wrapf("the family(ID: %d) is not owned by sp(ID: %d)", familyID, srcSP.Id)
+		}
+		if store.Has(types.GetSwapOutFamilyKey(familyID)) {
+			return nil, types.ErrFamilyRebalanceFailed.Wrapf("the family(ID: %d) is being swapped out", familyID)
+		}
+		rebalancing, err := k.cancelStaleFamilyRebalance(ctx, familyID)
+		if err != nil {
+			return nil, err
+		}
+		if rebalancing {
+			return nil, types.ErrFamilyRebalanceFailed.Wrapf("the family(ID: %d) is being rebalanced", familyID)
+		}
+
+		targetSPID, err := k.pickRebalanceTarget(ctx, family, srcSP.Id, candidates, familyCounts, expirationTime)
+		if err != nil {
+			return nil, err
+		}
+		familyCounts[targetSPID]++
+
+		rebalance.Entries = append(rebalance.Entries, types.FamilyRebalanceEntry{
+			GlobalVirtualGroupFamilyId: familyID,
+			TargetSpId:                 targetSPID,
+			Status:                     types.FAMILY_REBALANCE_STATUS_PENDING,
+		})
+		var uint32Seq sequence.Sequence[uint32]
+		store.Set(types.GetFamilyRebalanceByFamilyKey(familyID), uint32Seq.EncodeSequence(rebalance.Id))
+		if err = ctx.EventManager().EmitTypedEvents(&types.EventReserveSwapIn{
+			StorageProviderId:          targetSPID,
+			GlobalVirtualGroupFamilyId: familyID,
+			TargetSpId:                 srcSP.Id,
+			ExpirationTime:             uint64(expirationTime),
+		}); err != nil {
+			return nil, err
+		}
+	}
+	k.setFamilyRebalance(ctx, rebalance)
+
+	if err := ctx.EventManager().EmitTypedEvents(&types.EventStartFamilyRebalance{
+		RebalanceId:       rebalance.Id,
+		Initiator:         rebalance.Initiator,
+		StorageProviderId: rebalance.StorageProviderId,
+		Entries:           rebalance.Entries,
+	}); err != nil {
+		return nil, err
+	}
+	return rebalance, nil
+}
+
+// getRebalanceCandidates returns the ids of the in service sps which can be the targets of a rebalance
+func (k Keeper) getRebalanceCandidates(ctx sdk.Context, srcSPID uint32, candidateSPIDs []uint32) []uint32 {
+	var candidates []uint32
+	if len(candidateSPIDs) == 0 {
+		for _, sp := range k.spKeeper.GetAllStorageProviders(ctx) {
+			if sp.Id != srcSPID && sp.IsInService() {
+				candidates = append(candidates, sp.Id)
+			}
+		}
+		return candidates
+	}
+	for _, spID := range candidateSPIDs {
+		sp, found := k.spKeeper.GetStorageProvider(ctx, spID)
+		if found && sp.Id != srcSPID && sp.IsInService() {
+			candidates = append(candidates, sp.Id)
+		}
+	}
+	return candidates
+}
+
+// pickRebalanceTarget reserves the swap in of the family for the candidate serving the fewest families,
+// the candidates which are the secondary sps of the family or not able to take the family are skipped.
+func (k Keeper) pickRebalanceTarget(ctx sdk.Context, family *types.GlobalVirtualGroupFamily, srcSPID uint32,
+	candidates []uint32, familyCounts map[uint32]int, expirationTime int64,
+) (uint32, error) {
+	secondarySPs := make(map[uint32]struct{})
+	for _, gvgID := range family.GlobalVirtualGroupIds {
+		gvg, found := k.GetGVG(ctx, gvgID)
+		if !found {
+			return 0, types.ErrGVGNotExist
+		}
+		for _, sspID := range gvg.SecondarySpIds {
+			secondarySPs[sspID] = struct{}{}
+		}
+	}
+
+	sorted := append([]uint32(nil), candidates...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if familyCounts[sorted[i]] != familyCounts[sorted[j]] {
+			return familyCounts[sorted[i]] < familyCounts[sorted[j]]
+		}
+		return sorted[i] < sorted[j]
+	})
+	for _, candidate := range sorted {
+		if _, ok := secondarySPs[candidate]; ok {
+			continue
+		}
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.reserveFamilySwapIn(cacheCtx, family, candidate, srcSPID, expirationTime); err != nil {
+			ctx.Logger().Debug("skip rebalance target", "family", family.Id, "sp", candidate, "err", err)
+			continue
+		}
+		write()
+		return candidate, nil
+	}
+	return 0, types.ErrFamilyRebalanceFailed.Wrapf("no sp is able to take over the family(ID: %d)", family.Id)
+}
+
+// onFamilyPrimarySwapped updates the rebalance moving the family after the primary sp of the family is swapped,
+// the family is completed if it is moved to the target sp, otherwise the reservation of the target sp is dropped.
+func (k Keeper) onFamilyPrimarySwapped(ctx sdk.Context, familyID, successorSPID uint32) error {
+	rebalance, found := k.getPendingFamilyRebalance(ctx, familyID)
+	if !found {
+		return nil
+	}
+	for i, entry := range rebalance.Entries {
+		if entry.GlobalVirtualGroupFamilyId != familyID {
+			continue
+		}
+		if entry.TargetSpId == successorSPID {
+			return k.updateFamilyRebalanceEntry(ctx, rebalance, i, types.FAMILY_REBALANCE_STATUS_COMPLETED)
+		}
+		store := ctx.KVStore(k.storeKey)
+		key := types.GetSwapInFamilyKey(familyID)
+		if bz := store.Get(key); bz != nil {
+			var swapInInfo types.SwapInInfo
+			k.cdc.MustUnmarshal(bz, &swapInInfo)
+			if swapInInfo.SuccessorSpId == entry.TargetSpId {
+				k.removeSwapInInfo(ctx, key, &swapInInfo)
+				k.spKeeper.ReleaseSpCapacity(ctx, swapInInfo.SuccessorSpId, swapInInfo.ReservedSize)
+			}
+		}
+		return k.updateFamilyRebalanceEntry(ctx, rebalance, i, types.FAMILY_REBALANCE_STATUS_CANCELED)
+	}
+	return nil
+}
+
+// cancelStaleFamilyRebalance cancels the pending rebalance entry moving the family if the swap in of its target sp
+// is missing or expired, and releases the capacity reserved for the target sp. It returns whether the family is
+// still being rebalanced.
+func (k Keeper) cancelStaleFamilyRebalance(ctx sdk.Context, familyID uint32) (bool, error) {
+	rebalance, found := k.getPendingFamilyRebalance(ctx, familyID)
+	if !found {
+		return false, nil
+	}
+	for i, entry := range rebalance.Entries {
+		if entry.GlobalVirtualGroupFamilyId != familyID || entry.Status != types.FAMILY_REBALANCE_STATUS_PENDING {
+			continue
+		}
+		swapInInfo, found := k.GetSwapInInfo(ctx, familyID, types.NoSpecifiedGVGId)
+		if found && swapInInfo.SuccessorSpId == entry.TargetSpId {
+			if uint64(ctx.BlockTime().Unix()) < swapInInfo.ExpirationTime {
+				return true, nil
+			}
+			k.removeSwapInInfo(ctx, types.GetSwapInFamilyKey(familyID), swapInInfo)
+			k.spKeeper.ReleaseSpCapacity(ctx, swapInInfo.SuccessorSpId, swapInInfo.ReservedSize)
+		}
+		return false, k.updateFamilyRebalanceEntry(ctx, rebalance, i, types.FAMILY_REBALANCE_STATUS_CANCELED)
+	}
+	return false, nil
+}
+
+// onFamilySwapInCanceled updates the rebalance moving the family after the target sp cancels the swap in
+func (k Keeper) onFamilySwapInCanceled(ctx sdk.Context, familyID, successorSPID uint32) error {
+	rebalance, found := k.getPendingFamilyRebalance(ctx, familyID)
+	if !found {
+		return nil
+	}
+	for i, entry := range rebalance.Entries {
+		if entry.GlobalVirtualGroupFamilyId == familyID && entry.TargetSpId == successorSPID {
+			return k.updateFamilyRebalanceEntry(ctx, rebalance, i, types.FAMILY_REBALANCE_STATUS_CANCELED)
+		}
+	}
+	return nil
+}
+
+func (k Keeper) updateFamilyRebalanceEntry(ctx sdk.Context, rebalance *types.FamilyRebalance, index int, status types.FamilyRebalanceStatus) error {
+	entry := &rebalance.Entries[index]
+	entry.Status = status
+	ctx.KVStore(k.storeKey).Delete(types.GetFamilyRebalanceByFamilyKey(entry.GlobalVirtualGroupFamilyId))
+
+	rebalance.Finished = true
+	for _, e := range rebalance.Entries {
+		if e.Status == types.FAMILY_REBALANCE_STATUS_PENDING {
+			rebalance.Finished = false
+			break
+		}
+	}
+	k.setFamilyRebalance(ctx, rebalance)
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventUpdateFamilyRebalance{
+		RebalanceId:                rebalance.Id,
+		GlobalVirtualGroupFamilyId: entry.GlobalVirtualGroupFamilyId,
+		TargetSpId:                 entry.TargetSpId,
+		Status:                     status,
+		Finished:                   rebalance.Finished,
+	})
+}
@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestRebalanceFamilies() {
+	storageKeeper := types.NewMockStorageKeeper(gomock.NewController(s.T()))
+	s.virtualgroupKeeper.SetStorageKeeper(storageKeeper)
+	storageKeeper.EXPECT().VerifySPPlacementInFamily(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	sps := []sptypes.StorageProvider{
+		{Id: 1, Status: sptypes.STATUS_IN_SERVICE},
+		{Id: 2, Status: sptypes.STATUS_IN_SERVICE},
+		{Id: 3, Status: sptypes.STATUS_IN_SERVICE},
+		{Id: 4, Status: sptypes.STATUS_IN_SERVICE},
+		{Id: 5, Status: sptypes.STATUS_IN_MAINTENANCE},
+	}
+	s.spKeeper.EXPECT().GetAllStorageProviders(gomock.Any()).Return(sps).AnyTimes()
+	s.spKeeper.EXPECT().GetSpCapacity(gomock.Any(), gomock.Any()).Return(sptypes.SpCapacity{}, false).AnyTimes()
+	s.spKeeper.EXPECT().ReserveSpCapacity(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.spKeeper.EXPECT().ReleaseSpCapacity(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	// family 1 and 2 are served by sp 1, sp 3 already serves another family
+	s.virtualgroupKeeper.SetGVG(s.ctx, &types.GlobalVirtualGroup{Id: 1, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: []uint32{2}, TotalDeposit: math.ZeroInt()})
+	s.virtualgroupKeeper.SetGVG(s.ctx, &types.GlobalVirtualGroup{Id: 2, FamilyId: 2, PrimarySpId: 1, SecondarySpIds: []uint32{3}, TotalDeposit: math.ZeroInt()})
+	s.virtualgroupKeeper.SetGVGFamily(s.ctx, &types.GlobalVirtualGroupFamily{Id: 1, PrimarySpId: 1, GlobalVirtualGroupIds: []uint32{1}})
+	s.virtualgroupKeeper.SetGVGFamily(s.ctx, &types.GlobalVirtualGroupFamily{Id: 2, PrimarySpId: 1, GlobalVirtualGroupIds: []uint32{2}})
+	s.virtualgroupKeeper.SetGVGFamilyStatisticsWithinSP(s.ctx, &types.GVGFamilyStatisticsWithinSP{SpId: 1, GlobalVirtualGroupFamilyIds: []uint32{1, 2}})
+	s.virtualgroupKeeper.SetGVGFamilyStatisticsWithinSP(s.ctx, &types.GVGFamilyStatisticsWithinSP{SpId: 3, GlobalVirtualGroupFamilyIds: []uint32{10}})
+
+	rebalance, err := s.virtualgroupKeeper.RebalanceFamilies(s.ctx, "operator", &sps[0], nil, nil)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), uint32(1), rebalance.Id)
+	require.False(s.T(), rebalance.Finished)
+	// sp 2 is the secondary sp of family 1, so family 1 goes to sp 4 and family 2 goes to sp 2
+	require.Equal(s.T(), []types.FamilyRebalanceEntry{
+		{GlobalVirtualGroupFamilyId: 1, TargetSpId: 4, Status: types.FAMILY_REBALANCE_STATUS_PENDING},
+		{GlobalVirtualGroupFamilyId: 2, TargetSpId: 2, Status: types.FAMILY_REBALANCE_STATUS_PENDING},
+	}, rebalance.Entries)
+	swapInInfo, found := s.virtualgroupKeeper.GetSwapInInfo(s.ctx, 1, 0)
+	require.True(s.T(), found)
+	require.Equal(s.T(), uint32(4), swapInInfo.SuccessorSpId)
+	require.Equal(s.T(), uint32(1), swapInInfo.TargetSpId)
+
+	// a family can not be moved by two rebalances at the same time, the state of a failed rebalance is discarded
+	cacheCtx, _ := s.ctx.CacheContext()
+	_, err = s.virtualgroupKeeper.RebalanceFamilies(cacheCtx, "operator", &sps[0], []uint32{1}, nil)
+	require.ErrorIs(s.T(), err, types.ErrFamilyRebalanceFailed)
+
+	// the target sp cancels the swap in of family 1
+	err = s.virtualgroupKeeper.DeleteSwapInInfo(s.ctx, 1, 0, 4)
+	require.NoError(s.T(), err)
+	rebalance, found = s.virtualgroupKeeper.GetFamilyRebalance(s.ctx, 1)
+	require.True(s.T(), found)
+	require.Equal(s.T(), types.FAMILY_REBALANCE_STATUS_CANCELED, rebalance.Entries[0].Status)
+	require.Equal(s.T(), types.FAMILY_REBALANCE_STATUS_PENDING, rebalance.Entries[1].Status)
+	require.False(s.T(), rebalance.Finished)
+
+	// only in service sps can be the targets
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), uint32(5)).Return(&sps[4], true)
+	_, err = s.virtualgroupKeeper.RebalanceFamilies(s.ctx, "operator", &sps[0], []uint32{1}, []uint32{5})
+	require.ErrorIs(s.T(), err, types.ErrFamilyRebalanceFailed)
+
+	// family 1 can be moved again once its entry is settled
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), uint32(3)).Return(&sps[2], true)
+	rebalance2, err := s.virtualgroupKeeper.RebalanceFamilies(s.ctx, "operator", &sps[0], []uint32{1}, []uint32{3})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), uint32(2), rebalance2.Id)
+	require.Equal(s.T(), uint32(3), rebalance2.Entries[0].TargetSpId)
+
+	res, err := s.virtualgroupKeeper.FamilyRebalance(s.ctx, &types.QueryFamilyRebalanceRequest{RebalanceId: 1})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), rebalance, res.Rebalance)
+	_, err = s.virtualgroupKeeper.FamilyRebalance(s.ctx, &types.QueryFamilyRebalanceRequest{RebalanceId: 3})
+	require.Error(s.T(), err)
+}
+
+func (s *TestSuite) TestSwapInCapacity() {
+	storageKeeper := types.NewMockStorageKeeper(gomock.NewController(s.T()))
+	s.virtualgroupKeeper.SetStorageKeeper(storageKeeper)
+	storageKeeper.EXPECT().VerifySPPlacementInFamily(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	stakingPerBytes := s.virtualgroupKeeper.GVGStakingPerBytes(s.ctx)
+	gvg := &types.GlobalVirtualGroup{Id: 1, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: []uint32{2}, TotalDeposit: stakingPerBytes.MulRaw(1000)}
+	s.virtualgroupKeeper.SetGVG(s.ctx, gvg)
+	exitingSP := &sptypes.StorageProvider{Id: 2, Status: sptypes.STATUS_GRACEFUL_EXITING}
+
+	// the staked size of the gvg is reserved from the successor sp
+	s.spKeeper.EXPECT().ReserveSpCapacity(gomock.Any(), uint32(4), uint64(1000)).Return(nil).Times(1)
+	expirationTime := s.ctx.BlockTime().Unix() + 100
+	err := s.virtualgroupKeeper.SwapIn(s.ctx, 0, 1, 4, exitingSP, expirationTime)
+	require.NoError(s.T(), err)
+	swapInInfo, found := s.virtualgroupKeeper.GetSwapInInfo(s.ctx, 0, 1)
+	require.True(s.T(), found)
+	require.Equal(s.T(), uint64(1000), swapInInfo.ReservedSize)
+
+	// nothing is released before the expiration
+	s.virtualgroupKeeper.ProcessExpiredSwapIns(s.ctx)
+
+	// the reserved capacity is released once the swap in expires, the swap in can still be completed
+	s.spKeeper.EXPECT().ReleaseSpCapacity(gomock.Any(), uint32(4), uint64(1000)).Times(1)
+	ctx := s.ctx.WithBlockTime(time.Unix(expirationTime, 0))
+	s.virtualgroupKeeper.ProcessExpiredSwapIns(ctx)
+	swapInInfo, found = s.virtualgroupKeeper.GetSwapInInfo(ctx, 0, 1)
+	require.True(s.T(), found)
+	require.Equal(s.T(), uint64(0), swapInInfo.ReservedSize)
+	s.virtualgroupKeeper.ProcessExpiredSwapIns(ctx)
+
+	// another sp overrides the expired swap in, and releases its reservation once it cancels the swap in
+	s.spKeeper.EXPECT().ReserveSpCapacity(gomock.Any(), uint32(5), uint64(1000)).Return(nil).Times(1)
+	s.spKeeper.EXPECT().ReleaseSpCapacity(gomock.Any(), uint32(4), uint64(0)).Times(1)
+	err = s.virtualgroupKeeper.SwapIn(ctx, 0, 1, 5, exitingSP, expirationTime+100)
+	require.NoError(s.T(), err)
+	s.spKeeper.EXPECT().ReleaseSpCapacity(gomock.Any(), uint32(5), uint64(1000)).Times(1)
+	err = s.virtualgroupKeeper.DeleteSwapInInfo(ctx, 0, 1, 5)
+	require.NoError(s.T(), err)
+	_, found = s.virtualgroupKeeper.GetSwapInInfo(ctx, 0, 1)
+	require.False(s.T(), found)
+
+	// the canceled swap in is removed from the expiration queue
+	s.virtualgroupKeeper.ProcessExpiredSwapIns(ctx.WithBlockTime(time.Unix(expirationTime+100, 0)))
+}
+
+func (s *TestSuite) TestRebalanceSwapInExpired() {
+	storageKeeper := types.NewMockStorageKeeper(gomock.NewController(s.T()))
+	s.virtualgroupKeeper.SetStorageKeeper(storageKeeper)
+	storageKeeper.EXPECT().VerifySPPlacementInFamily(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	sps := []sptypes.StorageProvider{
+		{Id: 1, Status: sptypes.STATUS_IN_SERVICE},
+		{Id: 2, Status: sptypes.STATUS_IN_SERVICE},
+		{Id: 3, Status: sptypes.STATUS_IN_SERVICE},
+	}
+	s.spKeeper.EXPECT().GetAllStorageProviders(gomock.Any()).Return(sps).AnyTimes()
+	s.spKeeper.EXPECT().GetSpCapacity(gomock.Any(), gomock.Any()).Return(sptypes.SpCapacity{}, false).AnyTimes()
+	s.spKeeper.EXPECT().ReserveSpCapacity(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	s.virtualgroupKeeper.SetGVG(s.ctx, &types.GlobalVirtualGroup{Id: 1, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: []uint32{2}, TotalDeposit: math.ZeroInt()})
+	s.virtualgroupKeeper.SetGVG(s.ctx, &types.GlobalVirtualGroup{Id: 2, FamilyId: 2, PrimarySpId: 1, SecondarySpIds: []uint32{2}, TotalDeposit: math.ZeroInt()})
+	s.virtualgroupKeeper.SetGVGFamily(s.ctx, &types.GlobalVirtualGroupFamily{Id: 1, PrimarySpId: 1, GlobalVirtualGroupIds: []uint32{1}})
+	s.virtualgroupKeeper.SetGVGFamily(s.ctx, &types.GlobalVirtualGroupFamily{Id: 2, PrimarySpId: 1, GlobalVirtualGroupIds: []uint32{2}})
+
+	rebalance, err := s.virtualgroupKeeper.RebalanceFamilies(s.ctx, "operator", &sps[0], []uint32{1, 2}, nil)
+	require.NoError(s.T(), err)
+	expirationTime := s.ctx.BlockTime().Unix() + int64(s.virtualgroupKeeper.SwapInValidityPeriod(s.ctx))
+
+	// the families are being rebalanced before the swap ins expire
+	ctx := s.ctx.WithBlockTime(time.Unix(expirationTime-1, 0))
+	_, err = s.virtualgroupKeeper.RebalanceFamilies(ctx, "operator", &sps[0], []uint32{2}, nil)
+	require.ErrorIs(s.T(), err, types.ErrFamilyRebalanceFailed)
+
+	// the expired swap ins cancel the rebalance entries and release the capacity reserved for the target sp
+	ctx = s.ctx.WithBlockTime(time.Unix(expirationTime, 0))
+	s.spKeeper.EXPECT().ReleaseSpCapacity(gomock.Any(), uint32(3), gomock.Any()).Times(2)
+	s.virtualgroupKeeper.ProcessExpiredSwapIns(ctx)
+	rebalance, _ = s.virtualgroupKeeper.GetFamilyRebalance(ctx, rebalance.Id)
+	require.Equal(s.T(), types.FAMILY_REBALANCE_STATUS_CANCELED, rebalance.Entries[0].Status)
+	require.Equal(s.T(), types.FAMILY_REBALANCE_STATUS_CANCELED, rebalance.Entries[1].Status)
+	require.True(s.T(), rebalance.Finished)
+	_, found := s.virtualgroupKeeper.GetSwapInInfo(ctx, 2, 0)
+	require.False(s.T(), found)
+
+	_, err = s.virtualgroupKeeper.RebalanceFamilies(ctx, "operator", &sps[0], []uint32{1, 2}, nil)
+	require.NoError(s.T(), err)
+}
@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"golang.org/x/exp/slices"
+
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+// isUnhealthySPStatus returns whether a secondary sp in the status can not serve the gvg reliably
+func isUnhealthySPStatus(status sptypes.Status) bool {
+	return status == sptypes.STATUS_IN_JAILED ||
+		status == sptypes.STATUS_GRACEFUL_EXITING ||
+		status == sptypes.STATUS_FORCED_EXITING ||
+		status == sptypes.STATUS_IN_MAINTENANCE
+}
+
+// GetUnhealthySecondarySPs returns the secondary sps of the gvg which are jailed, exiting or in maintenance. The primary
+// sp serving as a secondary sp of the gvg is not counted, which is fixed by the swap in of the broken redundancy.
+// The statuses of the looked up sps are cached in spStatuses if it is not nil.
+func (k Keeper) GetUnhealthySecondarySPs(ctx sdk.Context, gvg *types.GlobalVirtualGroup, spStatuses map[uint32]sptypes.Status) []uint32 {
+	var unhealthy []uint32
+	for _, sspID := range gvg.SecondarySpIds {
+		if sspID == gvg.PrimarySpId {
+			continue
+		}
+		status, found := spStatuses[sspID]
+		if !found {
+			sp, found := k.spKeeper.GetStorageProvider(ctx, sspID)
+			if !found {
+				unhealthy = append(unhealthy, sspID)
+				continue
+			}
+			status = sp.Status
+			if spStatuses != nil {
+				spStatuses[sspID] = status
+			}
+		}
+		if isUnhealthySPStatus(status) {
+			unhealthy = append(unhealthy, sspID)
+		}
+	}
+	return unhealthy
+}
+
+func (k Keeper) GetGVGRepair(ctx sdk.Context, gvgID uint32) (*types.GVGRepair, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetGVGRepairKey(gvgID))
+	if bz == nil {
+		return nil, false
+	}
+
+	var repair types.GVGRepair
+	k.cdc.MustUnmarshal(bz, &repair)
+	return &repair, true
+}
+
+func (k Keeper) deleteGVGRepair(ctx sdk.Context, repair *types.GVGRepair) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetGVGRepairKey(repair.GlobalVirtualGroupId))
+	store.Delete(types.GetGVGRepairDeadlineKey(repair.Deadline, repair.GlobalVirtualGroupId))
+}
+
+// OpenGVGRepair opens a repair window for the gvg whose secondary sps are unhealthy. Within the window,
+// the unhealthy secondary sps can be swapped by any in service sp, the primary sp of the gvg will be slashed
+// if the gvg is still unhealthy at the deadline.
+func (k Keeper) OpenGVGRepair(ctx sdk.Context, reporter string, gvgID uint32) (*types.GVGRepair, error) {
+	period := k.RedundancyRepairPeriod(ctx)
+	if period == 0 {
+		return nil, types.ErrGVGRepairFailed.Wrap("the redundancy repair is disabled")
+	}
+	gvg, found := k.GetGVG(ctx, gvgID)
+	if !found {
+		return nil, types.ErrGVGNotExist
+	}
+	if repair, found := k.GetGVGRepair(ctx, gvgID); found {
+		return nil, types.ErrGVGRepairFailed.Wrapf("the repair of GVG(ID=%d) is already open until %d", gvgID, repair.Deadline)
+	}
+	unhealthy := k.GetUnhealthySecondarySPs(ctx, gvg, nil)
+	if len(unhealthy) == 0 {
+		return nil, types.ErrGVGRepairFailed.Wrapf("the secondary sps of GVG(ID=%d) are healthy", gvgID)
+	}
+
+	repair := &types.GVGRepair{
+		GlobalVirtualGroupId: gvgID,
+		PrimarySpId:          gvg.PrimarySpId,
+		UnhealthySpIds:       unhealthy,
+		Deadline:             ctx.BlockTime().Unix() + int64(period),
+		Reporter:             reporter,
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetGVGRepairKey(gvgID), k.cdc.MustMarshal(repair))
+	store.Set(types.GetGVGRepairDeadlineKey(repair.Deadline, gvgID), []byte{})
+
+	if err := ctx.EventManager().EmitTypedEvents(&types.EventOpenGVGRepair{
+		GlobalVirtualGroupId: gvgID,
+		PrimarySpId:          repair.PrimarySpId,
+		UnhealthySpIds:       repair.UnhealthySpIds,
+		Deadline:             repair.Deadline,
+		Reporter:             reporter,
+	}); err != nil {
+		return nil, err
+	}
+	return repair, nil
+}
+
+// onGVGSecondarySwapped closes the repair of the gvg once all of its secondary sps are healthy
+func (k Keeper) onGVGSecondarySwapped(ctx sdk.Context, gvg *types.GlobalVirtualGroup) error {
+	repair, found := k.GetGVGRepair(ctx, gvg.Id)
+	if !found {
+		return nil
+	}
+	if len(k.GetUnhealthySecondarySPs(ctx, gvg, nil)) != 0 {
+		return nil
+	}
+	k.deleteGVGRepair(ctx, repair)
+	return ctx.EventManager().EmitTypedEvents(&types.EventCloseGVGRepair{
+		GlobalVirtualGroupId: gvg.Id,
+		PrimarySpId:          gvg.PrimarySpId,
+		Repaired:             true,
+		SlashAmount:          math.ZeroInt(),
+	})
+}
+
+// ProcessExpiredGVGRepairs closes the repairs reaching their deadlines, and slashes the secondary sps which were
+// reported unhealthy and are still unhealthy, except those in a pre-announced maintenance window.
+func (k Keeper) ProcessExpiredGVGRepairs(ctx sdk.Context) {
+	now := ctx.BlockTime().Unix()
+
+	iterator := ctx.KVStore(k.storeKey).Iterator(types.GVGRepairDeadlineKey, types.GetGVGRepairDeadlineKey(now+1, 0))
+	var gvgIDs []uint32
+	for ; iterator.Valid(); iterator.Next() {
+		gvgIDs = append(gvgIDs, binary.BigEndian.Uint32(iterator.Key()[len(types.GVGRepairDeadlineKey)+8:]))
+	}
+	iterator.Close()
+
+	for _, gvgID := range gvgIDs {
+		repair, found := k.GetGVGRepair(ctx, gvgID)
+		if !found {
+			continue
+		}
+		k.deleteGVGRepair(ctx, repair)
+
+		gvg, found := k.GetGVG(ctx, gvgID)
+		if !found {
+			continue
+		}
+		unhealthy := k.GetUnhealthySecondarySPs(ctx, gvg, nil)
+		event := &types.EventCloseGVGRepair{
+			GlobalVirtualGroupId: gvgID,
+			PrimarySpId:          gvg.PrimarySpId,
+			Repaired:             len(unhealthy) == 0,
+			SlashAmount:          math.ZeroInt(),
+		}
+		for _, spID := range unhealthy {
+			if !slices.Contains(repair.UnhealthySpIds, spID) || k.spKeeper.IsSpInScheduledMaintenance(ctx, spID) {
+				continue
+			}
+			cacheCtx, write := ctx.CacheContext()
+			slashAmount, err := k.slashForUnrepairedGVG(cacheCtx, spID, repair.Reporter)
+			if err != nil {
+				ctx.Logger().Error("fail to slash the secondary sp for unrepaired gvg", "gvg", gvgID, "sp", spID, "err", err)
+				continue
+			}
+			write()
+			event.SlashAmount = event.SlashAmount.Add(slashAmount)
+			event.SlashedSpIds = append(event.SlashedSpIds, spID)
+		}
+		if err := ctx.EventManager().EmitTypedEvents(event); err != nil {
+			ctx.Logger().Error("fail to emit close gvg repair event", "gvg", gvgID, "err", err)
+		}
+	}
+}
+
+// slashForUnrepairedGVG slashes the sp by the redundancy repair slash amount, which is capped by its deposit,
+// the slashed amount is rewarded to the reporter of the repair.
+func (k Keeper) slashForUnrepairedGVG(ctx sdk.Context, spID uint32, reporter string) (math.Int, error) {
+	amount := k.RedundancyRepairSlashAmount(ctx)
+	sp, found := k.spKeeper.GetStorageProvider(ctx, spID)
+	if !found {
+		return math.ZeroInt(), sptypes.ErrStorageProviderNotFound
+	}
+	amount = math.MinInt(amount, sp.TotalDeposit)
+	if !amount.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+	err := k.spKeeper.Slash(ctx, spID, []sptypes.RewardInfo{{
+		Address: reporter,
+		Amount:  sdk.NewCoin(k.spKeeper.DepositDenomForSP(ctx), amount),
+	}})
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	return amount, nil
+}
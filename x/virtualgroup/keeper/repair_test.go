@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestGVGRepair() {
+	storageKeeper := types.NewMockStorageKeeper(gomock.NewController(s.T()))
+	s.virtualgroupKeeper.SetStorageKeeper(storageKeeper)
+	storageKeeper.EXPECT().VerifySPPlacementInFamily(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	sps := map[uint32]*sptypes.StorageProvider{
+		1: {Id: 1, Status: sptypes.STATUS_IN_SERVICE, TotalDeposit: math.NewInt(10000)},
+		2: {Id: 2, Status: sptypes.STATUS_IN_SERVICE},
+		3: {Id: 3, Status: sptypes.STATUS_IN_MAINTENANCE},
+		4: {Id: 4, Status: sptypes.STATUS_IN_SERVICE},
+		5: {Id: 5, Status: sptypes.STATUS_IN_JAILED, TotalDeposit: math.NewInt(10000)},
+		6: {Id: 6, Status: sptypes.STATUS_IN_MAINTENANCE, TotalDeposit: math.NewInt(10000)},
+	}
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, id uint32) (*sptypes.StorageProvider, bool) {
+			sp, found := sps[id]
+			return sp, found
+		}).AnyTimes()
+	s.spKeeper.EXPECT().ReserveSpCapacity(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.spKeeper.EXPECT().ReleaseSpCapacity(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	s.spKeeper.EXPECT().DepositDenomForSP(gomock.Any()).Return("BNB").AnyTimes()
+	// sp 6 is in a pre-announced maintenance window
+	s.spKeeper.EXPECT().IsSpInScheduledMaintenance(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, id uint32) bool {
+			return id == 6
+		}).AnyTimes()
+	s.paymentKeeper.EXPECT().QueryDynamicBalance(gomock.Any(), gomock.Any()).Return(math.ZeroInt(), nil).AnyTimes()
+
+	s.virtualgroupKeeper.SetGVG(s.ctx, &types.GlobalVirtualGroup{Id: 1, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: []uint32{2, 3}, TotalDeposit: math.ZeroInt(), VirtualPaymentAddress: sample.RandAccAddressHex()})
+	// the primary sp serving as a secondary sp is fixed by the swap in of the broken redundancy instead of a repair
+	s.virtualgroupKeeper.SetGVG(s.ctx, &types.GlobalVirtualGroup{Id: 2, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: []uint32{1, 4}, TotalDeposit: math.ZeroInt(), VirtualPaymentAddress: sample.RandAccAddressHex()})
+	s.virtualgroupKeeper.SetGVG(s.ctx, &types.GlobalVirtualGroup{Id: 3, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: []uint32{5, 6}, TotalDeposit: math.ZeroInt(), VirtualPaymentAddress: sample.RandAccAddressHex()})
+	s.virtualgroupKeeper.SetGVGStatisticsWithSP(s.ctx, &types.GVGStatisticsWithinSP{StorageProviderId: 3, SecondaryCount: 1})
+
+	// the repair is disabled by default
+	reporter := sample.RandAccAddressHex()
+	_, err := s.virtualgroupKeeper.OpenGVGRepair(s.ctx, reporter, 1)
+	require.ErrorIs(s.T(), err, types.ErrGVGRepairFailed)
+
+	params := types.DefaultParams()
+	params.RedundancyRepairPeriod = 100
+	slashAmount := math.NewInt(1000)
+	params.RedundancyRepairSlashAmount = &slashAmount
+	require.NoError(s.T(), s.virtualgroupKeeper.SetParams(s.ctx, params))
+
+	now := s.ctx.BlockTime().Unix()
+	repair, err := s.virtualgroupKeeper.OpenGVGRepair(s.ctx, reporter, 1)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []uint32{3}, repair.UnhealthySpIds)
+	require.Equal(s.T(), now+100, repair.Deadline)
+	_, err = s.virtualgroupKeeper.OpenGVGRepair(s.ctx, reporter, 1)
+	require.ErrorIs(s.T(), err, types.ErrGVGRepairFailed)
+	_, err = s.virtualgroupKeeper.OpenGVGRepair(s.ctx, reporter, 2)
+	require.ErrorIs(s.T(), err, types.ErrGVGRepairFailed)
+
+	res, err := s.virtualgroupKeeper.UnhealthyGlobalVirtualGroups(s.ctx, &types.QueryUnhealthyGlobalVirtualGroupsRequest{})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []types.UnhealthyGlobalVirtualGroup{
+		{GlobalVirtualGroupId: 1, PrimarySpId: 1, UnhealthySpIds: []uint32{3}, RepairDeadline: now + 100},
+		{GlobalVirtualGroupId: 3, PrimarySpId: 1, UnhealthySpIds: []uint32{5, 6}},
+	}, res.GlobalVirtualGroups)
+
+	// only the in service sps can repair the gvg
+	expiration := now + int64(s.virtualgroupKeeper.SwapInValidityPeriod(s.ctx))
+	err = s.virtualgroupKeeper.SwapIn(s.ctx, 0, 1, 5, sps[3], expiration)
+	require.ErrorIs(s.T(), err, types.ErrSwapInFailed)
+	err = s.virtualgroupKeeper.SwapIn(s.ctx, 0, 1, 4, sps[3], expiration)
+	require.NoError(s.T(), err)
+	// the gvg without an open repair can not be swapped
+	err = s.virtualgroupKeeper.SwapIn(s.ctx, 0, 3, 2, sps[5], expiration)
+	require.ErrorIs(s.T(), err, types.ErrSwapInFailed)
+
+	// the repair is closed once the gvg is healthy
+	err = s.virtualgroupKeeper.CompleteSwapIn(s.ctx, 0, 1, sps[4])
+	require.NoError(s.T(), err)
+	_, found := s.virtualgroupKeeper.GetGVGRepair(s.ctx, 1)
+	require.False(s.T(), found)
+	// the swap is recorded for the challenge sampling
+	swappedHeight, found := s.virtualgroupKeeper.GetGVGSwappedHeight(s.ctx, 1)
+	require.True(s.T(), found)
+	require.Equal(s.T(), uint64(s.ctx.BlockHeight()), swappedHeight)
+
+	// the unhealthy secondary sps are slashed if the gvg is not repaired before the deadline, except those in
+	// a pre-announced maintenance window, the primary sp is not slashed
+	_, err = s.virtualgroupKeeper.OpenGVGRepair(s.ctx, reporter, 3)
+	require.NoError(s.T(), err)
+	s.virtualgroupKeeper.ProcessExpiredGVGRepairs(s.ctx)
+	_, found = s.virtualgroupKeeper.GetGVGRepair(s.ctx, 3)
+	require.True(s.T(), found)
+
+	s.spKeeper.EXPECT().Slash(gomock.Any(), uint32(5), []sptypes.RewardInfo{{
+		Address: reporter,
+		Amount:  sdk.NewCoin("BNB", slashAmount),
+	}}).Return(nil)
+	s.virtualgroupKeeper.ProcessExpiredGVGRepairs(s.ctx.WithBlockTime(time.Unix(now+100, 0)))
+	_, found = s.virtualgroupKeeper.GetGVGRepair(s.ctx, 3)
+	require.False(s.T(), found)
+}
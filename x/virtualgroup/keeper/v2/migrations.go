@@ -21,7 +21,9 @@ func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.Binar
 		oldParams.MaxGlobalVirtualGroupNumPerFamily,
 		oldParams.MaxStoreSizePerFamily,
 		types.DefaultSwapInValidityPeriod,
-		types.DefaultSPConcurrentExitNum)
+		types.DefaultSPConcurrentExitNum,
+		types.DefaultRedundancyRepairPeriod,
+		types.DefaultRedundancyRepairSlashAmount)
 	store.Set(types.ParamsKey, cdc.MustMarshal(&newParams))
 
 	return nil
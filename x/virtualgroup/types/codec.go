@@ -12,6 +12,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCompleteStorageProviderExit{}, "virtualgroup/CompleteStorageProviderExit", nil)
 	cdc.RegisterConcrete(&MsgCompleteSwapOut{}, "virtualgroup/CompleteSwapOut", nil)
 	cdc.RegisterConcrete(&MsgCancelSwapOut{}, "virtualgroup/CancelSwapOut", nil)
+	cdc.RegisterConcrete(&MsgRebalanceGlobalVirtualGroupFamilies{}, "virtualgroup/RebalanceGlobalVirtualGroupFamilies", nil)
+	cdc.RegisterConcrete(&MsgOpenGVGRepair{}, "virtualgroup/OpenGVGRepair", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -48,6 +50,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCancelSwapOut{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRebalanceGlobalVirtualGroupFamilies{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgOpenGVGRepair{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -25,10 +25,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type PickVGFStrategy int32
 
 const (
-	Strategy_Maximize_Free_Store_Size PickVGFStrategy = 0
-	Strategy_Minimal_Free_Store_Size  PickVGFStrategy = 1
-	Strategy_Oldest_Create_Time       PickVGFStrategy = 2
-	Strategy_Recentest_Create_Time    PickVGFStrategy = 3
+	Strategy_Maximize_Free_Store_Size   PickVGFStrategy = 0
+	Strategy_Minimal_Free_Store_Size    PickVGFStrategy = 1
+	Strategy_Oldest_Create_Time         PickVGFStrategy = 2
+	Strategy_Recentest_Create_Time      PickVGFStrategy = 3
+	Strategy_Highest_Secondary_Sp_Score PickVGFStrategy = 4
 )
 
 var PickVGFStrategy_name = map[int32]string{
@@ -36,13 +37,15 @@ var PickVGFStrategy_name = map[int32]string{
 	1: "Strategy_Minimal_Free_Store_Size",
 	2: "Strategy_Oldest_Create_Time",
 	3: "Strategy_Recentest_Create_Time",
+	4: "Strategy_Highest_Secondary_Sp_Score",
 }
 
 var PickVGFStrategy_value = map[string]int32{
-	"Strategy_Maximize_Free_Store_Size": 0,
-	"Strategy_Minimal_Free_Store_Size":  1,
-	"Strategy_Oldest_Create_Time":       2,
-	"Strategy_Recentest_Create_Time":    3,
+	"Strategy_Maximize_Free_Store_Size":   0,
+	"Strategy_Minimal_Free_Store_Size":    1,
+	"Strategy_Oldest_Create_Time":         2,
+	"Strategy_Recentest_Create_Time":      3,
+	"Strategy_Highest_Secondary_Sp_Score": 4,
 }
 
 func (x PickVGFStrategy) String() string {
@@ -62,22 +65,23 @@ func init() {
 }
 
 var fileDescriptor_95d96763aeb17687 = []byte{
-	// 264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0xd0, 0x3d, 0x4a, 0x03, 0x41,
-	0x14, 0xc0, 0xf1, 0x5d, 0x15, 0x8b, 0x69, 0x0c, 0x8b, 0x20, 0x44, 0x18, 0x3f, 0x88, 0x8d, 0xe0,
-	0x4e, 0xa1, 0x27, 0x50, 0x88, 0x95, 0x1f, 0xb8, 0x62, 0x61, 0x33, 0xcc, 0x4e, 0x9e, 0x93, 0x87,
-	0x3b, 0x33, 0xcb, 0xe4, 0xad, 0x24, 0x39, 0x81, 0xa5, 0x77, 0xb0, 0xf2, 0x26, 0x96, 0x29, 0x2d,
-	0x65, 0xf7, 0x22, 0x62, 0xc4, 0x24, 0x9a, 0xee, 0x5f, 0xfc, 0xaa, 0x3f, 0xeb, 0x98, 0x00, 0xe0,
-	0x1e, 0x10, 0x8a, 0x9e, 0x78, 0xc2, 0x40, 0x95, 0x2a, 0x4c, 0xf0, 0x55, 0x29, 0xb4, 0xb7, 0xd6,
-	0xbb, 0xb4, 0x0c, 0x9e, 0x7c, 0xb2, 0x35, 0x57, 0xe9, 0xa2, 0x6a, 0x6f, 0x1a, 0x6f, 0xfc, 0xd4,
-	0x88, 0xef, 0xfa, 0xe1, 0x87, 0x6f, 0x31, 0xdb, 0xb8, 0x46, 0xfd, 0x78, 0x77, 0xde, 0xcd, 0x28,
-	0x28, 0x02, 0x33, 0x4a, 0x0e, 0xd8, 0xde, 0x6f, 0xcb, 0x0b, 0x35, 0x44, 0x8b, 0x63, 0x90, 0xdd,
-	0x00, 0x20, 0x33, 0xf2, 0x01, 0x64, 0x86, 0x63, 0x68, 0x45, 0x49, 0x87, 0xed, 0xce, 0x19, 0x3a,
-	0xb4, 0xaa, 0x58, 0x52, 0x71, 0xb2, 0xc3, 0xb6, 0x67, 0xea, 0xaa, 0xe8, 0xc1, 0x80, 0xe4, 0x59,
-	0x00, 0x45, 0x20, 0x6f, 0xd1, 0x42, 0x6b, 0x25, 0xd9, 0x67, 0x7c, 0x06, 0x6e, 0x40, 0x83, 0xa3,
-	0xff, 0x66, 0xb5, 0xbd, 0xf6, 0xfc, 0xca, 0xa3, 0xd3, 0xcb, 0xf7, 0x9a, 0xc7, 0x93, 0x9a, 0xc7,
-	0x9f, 0x35, 0x8f, 0x5f, 0x1a, 0x1e, 0x4d, 0x1a, 0x1e, 0x7d, 0x34, 0x3c, 0xba, 0x3f, 0x31, 0x48,
-	0xfd, 0x2a, 0x4f, 0xb5, 0xb7, 0x22, 0x77, 0xf9, 0x91, 0xee, 0x2b, 0x74, 0x62, 0xe1, 0xd7, 0xf0,
-	0xef, 0x31, 0x1a, 0x95, 0x30, 0xc8, 0xd7, 0xa7, 0x0b, 0x8e, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xb5, 0xa5, 0xf9, 0x65, 0x59, 0x01, 0x00, 0x00,
+	// 287 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0xd0, 0xbf, 0x4a, 0x03, 0x31,
+	0x1c, 0xc0, 0xf1, 0x3b, 0x2d, 0x0e, 0x59, 0x2c, 0x41, 0x10, 0x2a, 0xc4, 0x7f, 0x15, 0x41, 0xb0,
+	0x37, 0xe8, 0x13, 0x28, 0x54, 0x17, 0xff, 0x60, 0xc4, 0xc1, 0x25, 0xe4, 0xd2, 0x9f, 0xe9, 0x0f,
+	0x2f, 0xc9, 0x91, 0xa6, 0xd2, 0xeb, 0x13, 0x38, 0xfa, 0x0e, 0xbe, 0x8c, 0x63, 0x71, 0x72, 0x94,
+	0xbb, 0x17, 0x91, 0x56, 0xbc, 0x56, 0xdd, 0xbe, 0xc3, 0x67, 0xfa, 0x92, 0xb6, 0xf6, 0x00, 0xf6,
+	0x01, 0x21, 0xeb, 0x25, 0x4f, 0xe8, 0xc3, 0x50, 0x66, 0xda, 0xbb, 0x61, 0x9e, 0x28, 0x67, 0x8c,
+	0xb3, 0x9d, 0xdc, 0xbb, 0xe0, 0xe8, 0xfa, 0x5c, 0x75, 0x16, 0x55, 0x6b, 0x4d, 0x3b, 0xed, 0x66,
+	0x26, 0x99, 0xd6, 0x37, 0x3f, 0x78, 0x8f, 0xc9, 0xea, 0x35, 0xaa, 0xc7, 0xbb, 0xb3, 0x2e, 0x0f,
+	0x5e, 0x06, 0xd0, 0x05, 0xdd, 0x23, 0xdb, 0x3f, 0x2d, 0x2e, 0xe4, 0x08, 0x0d, 0x8e, 0x41, 0x74,
+	0x3d, 0x80, 0xe0, 0xc1, 0x79, 0x10, 0x1c, 0xc7, 0xd0, 0x8c, 0x68, 0x9b, 0x6c, 0xcd, 0x19, 0x5a,
+	0x34, 0x32, 0xfb, 0xa7, 0x62, 0xba, 0x49, 0x36, 0x6a, 0x75, 0x95, 0xf5, 0x60, 0x10, 0xc4, 0xa9,
+	0x07, 0x19, 0x40, 0xdc, 0xa2, 0x81, 0xe6, 0x12, 0xdd, 0x21, 0xac, 0x06, 0x37, 0xa0, 0xc0, 0x86,
+	0xbf, 0x66, 0x99, 0xee, 0x93, 0xdd, 0xda, 0x9c, 0xa3, 0xee, 0x4f, 0x05, 0x07, 0xe5, 0x6c, 0x4f,
+	0xfa, 0x42, 0xf0, 0x5c, 0x70, 0xe5, 0x3c, 0x34, 0x1b, 0xad, 0xc6, 0xf3, 0x2b, 0x8b, 0x4e, 0x2e,
+	0xdf, 0x4a, 0x16, 0x4f, 0x4a, 0x16, 0x7f, 0x96, 0x2c, 0x7e, 0xa9, 0x58, 0x34, 0xa9, 0x58, 0xf4,
+	0x51, 0xb1, 0xe8, 0xfe, 0x58, 0x63, 0xe8, 0x0f, 0xd3, 0x8e, 0x72, 0x26, 0x49, 0x6d, 0x7a, 0xa8,
+	0xfa, 0x12, 0x6d, 0xb2, 0x30, 0x76, 0xf4, 0x7b, 0x6d, 0x28, 0x72, 0x18, 0xa4, 0x2b, 0xb3, 0x57,
+	0x47, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x70, 0xf2, 0xe0, 0xab, 0x82, 0x01, 0x00, 0x00,
 }
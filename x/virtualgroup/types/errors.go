@@ -27,6 +27,9 @@ var (
 	ErrSwapInInfoNotExist          = errors.Register(ModuleName, 1128, "swap in info not exist.")
 	ErrGVGStatisticsNotExist       = errors.Register(ModuleName, 1129, "global virtual group statistics not exist.")
 	ErrGVGFamilyStatisticsNotExist = errors.Register(ModuleName, 1130, "global virtual group family statistics not exist.")
+	ErrFamilyRebalanceFailed       = errors.Register(ModuleName, 1131, "global virtual group family rebalance failed.")
+	ErrFamilyRebalanceNotExist     = errors.Register(ModuleName, 1132, "global virtual group family rebalance not exist.")
+	ErrGVGRepairFailed             = errors.Register(ModuleName, 1133, "global virtual group repair failed.")
 
 	ErrInvalidDenom = errors.Register(ModuleName, 2000, "Invalid denom.")
 )
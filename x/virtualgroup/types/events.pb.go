@@ -1358,6 +1358,314 @@ func (m *EventSettleGlobalVirtualGroup) GetSpFundingAddresses() []string {
 	return nil
 }
 
+type EventStartFamilyRebalance struct {
+	// The id of the rebalance
+	RebalanceId uint32 `protobuf:"varint,1,opt,name=rebalance_id,json=rebalanceId,proto3" json:"rebalance_id,omitempty"`
+	// The address who starts the rebalance
+	Initiator string `protobuf:"bytes,2,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	// The id of the source primary sp
+	StorageProviderId uint32 `protobuf:"varint,3,opt,name=storage_provider_id,json=storageProviderId,proto3" json:"storage_provider_id,omitempty"`
+	// The families and the picked target sps
+	Entries []FamilyRebalanceEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *EventStartFamilyRebalance) Reset()         { *m = EventStartFamilyRebalance{} }
+func (m *EventStartFamilyRebalance) String() string { return proto.CompactTextString(m) }
+func (*EventStartFamilyRebalance) ProtoMessage()    {}
+func (*EventStartFamilyRebalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ece39ea12016bd5b, []int{20}
+}
+func (m *EventStartFamilyRebalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventStartFamilyRebalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventStartFamilyRebalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventStartFamilyRebalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStartFamilyRebalance.Merge(m, src)
+}
+func (m *EventStartFamilyRebalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventStartFamilyRebalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStartFamilyRebalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventStartFamilyRebalance proto.InternalMessageInfo
+
+func (m *EventStartFamilyRebalance) GetRebalanceId() uint32 {
+	if m != nil {
+		return m.RebalanceId
+	}
+	return 0
+}
+
+func (m *EventStartFamilyRebalance) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
+	}
+	return ""
+}
+
+func (m *EventStartFamilyRebalance) GetStorageProviderId() uint32 {
+	if m != nil {
+		return m.StorageProviderId
+	}
+	return 0
+}
+
+func (m *EventStartFamilyRebalance) GetEntries() []FamilyRebalanceEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type EventUpdateFamilyRebalance struct {
+	// The id of the rebalance
+	RebalanceId uint32 `protobuf:"varint,1,opt,name=rebalance_id,json=rebalanceId,proto3" json:"rebalance_id,omitempty"`
+	// The id of the gvg family
+	GlobalVirtualGroupFamilyId uint32 `protobuf:"varint,2,opt,name=global_virtual_group_family_id,json=globalVirtualGroupFamilyId,proto3" json:"global_virtual_group_family_id,omitempty"`
+	// The id of the target sp of the family
+	TargetSpId uint32 `protobuf:"varint,3,opt,name=target_sp_id,json=targetSpId,proto3" json:"target_sp_id,omitempty"`
+	// The new status of the family
+	Status FamilyRebalanceStatus `protobuf:"varint,4,opt,name=status,proto3,enum=greenfield.virtualgroup.FamilyRebalanceStatus" json:"status,omitempty"`
+	// Whether all the families of the rebalance are completed or canceled
+	Finished bool `protobuf:"varint,5,opt,name=finished,proto3" json:"finished,omitempty"`
+}
+
+func (m *EventUpdateFamilyRebalance) Reset()         { *m = EventUpdateFamilyRebalance{} }
+func (m *EventUpdateFamilyRebalance) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateFamilyRebalance) ProtoMessage()    {}
+func (*EventUpdateFamilyRebalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ece39ea12016bd5b, []int{21}
+}
+func (m *EventUpdateFamilyRebalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateFamilyRebalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateFamilyRebalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateFamilyRebalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateFamilyRebalance.Merge(m, src)
+}
+func (m *EventUpdateFamilyRebalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateFamilyRebalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateFamilyRebalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateFamilyRebalance proto.InternalMessageInfo
+
+func (m *EventUpdateFamilyRebalance) GetRebalanceId() uint32 {
+	if m != nil {
+		return m.RebalanceId
+	}
+	return 0
+}
+
+func (m *EventUpdateFamilyRebalance) GetGlobalVirtualGroupFamilyId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupFamilyId
+	}
+	return 0
+}
+
+func (m *EventUpdateFamilyRebalance) GetTargetSpId() uint32 {
+	if m != nil {
+		return m.TargetSpId
+	}
+	return 0
+}
+
+func (m *EventUpdateFamilyRebalance) GetStatus() FamilyRebalanceStatus {
+	if m != nil {
+		return m.Status
+	}
+	return FAMILY_REBALANCE_STATUS_PENDING
+}
+
+func (m *EventUpdateFamilyRebalance) GetFinished() bool {
+	if m != nil {
+		return m.Finished
+	}
+	return false
+}
+
+type EventOpenGVGRepair struct {
+	// The id of the gvg to be repaired
+	GlobalVirtualGroupId uint32 `protobuf:"varint,1,opt,name=global_virtual_group_id,json=globalVirtualGroupId,proto3" json:"global_virtual_group_id,omitempty"`
+	// The id of the primary sp responsible for the repair
+	PrimarySpId uint32 `protobuf:"varint,2,opt,name=primary_sp_id,json=primarySpId,proto3" json:"primary_sp_id,omitempty"`
+	// The ids of the unhealthy secondary sps
+	UnhealthySpIds []uint32 `protobuf:"varint,3,rep,packed,name=unhealthy_sp_ids,json=unhealthySpIds,proto3" json:"unhealthy_sp_ids,omitempty"`
+	// The deadline of the repair
+	Deadline int64 `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// The address who opens the repair
+	Reporter string `protobuf:"bytes,5,opt,name=reporter,proto3" json:"reporter,omitempty"`
+}
+
+func (m *EventOpenGVGRepair) Reset()         { *m = EventOpenGVGRepair{} }
+func (m *EventOpenGVGRepair) String() string { return proto.CompactTextString(m) }
+func (*EventOpenGVGRepair) ProtoMessage()    {}
+func (*EventOpenGVGRepair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ece39ea12016bd5b, []int{22}
+}
+func (m *EventOpenGVGRepair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOpenGVGRepair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOpenGVGRepair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOpenGVGRepair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOpenGVGRepair.Merge(m, src)
+}
+func (m *EventOpenGVGRepair) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOpenGVGRepair) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOpenGVGRepair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOpenGVGRepair proto.InternalMessageInfo
+
+func (m *EventOpenGVGRepair) GetGlobalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupId
+	}
+	return 0
+}
+
+func (m *EventOpenGVGRepair) GetPrimarySpId() uint32 {
+	if m != nil {
+		return m.PrimarySpId
+	}
+	return 0
+}
+
+func (m *EventOpenGVGRepair) GetUnhealthySpIds() []uint32 {
+	if m != nil {
+		return m.UnhealthySpIds
+	}
+	return nil
+}
+
+func (m *EventOpenGVGRepair) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *EventOpenGVGRepair) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+type EventCloseGVGRepair struct {
+	// The id of the repaired gvg
+	GlobalVirtualGroupId uint32 `protobuf:"varint,1,opt,name=global_virtual_group_id,json=globalVirtualGroupId,proto3" json:"global_virtual_group_id,omitempty"`
+	// The id of the primary sp of the gvg
+	PrimarySpId uint32 `protobuf:"varint,2,opt,name=primary_sp_id,json=primarySpId,proto3" json:"primary_sp_id,omitempty"`
+	// Whether the gvg is repaired before the deadline
+	Repaired bool `protobuf:"varint,3,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	// The total amount slashed from the unhealthy secondary sps
+	SlashAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=slash_amount,json=slashAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"slash_amount"`
+	// The ids of the unhealthy secondary sps which are slashed
+	SlashedSpIds []uint32 `protobuf:"varint,5,rep,packed,name=slashed_sp_ids,json=slashedSpIds,proto3" json:"slashed_sp_ids,omitempty"`
+}
+
+func (m *EventCloseGVGRepair) Reset()         { *m = EventCloseGVGRepair{} }
+func (m *EventCloseGVGRepair) String() string { return proto.CompactTextString(m) }
+func (*EventCloseGVGRepair) ProtoMessage()    {}
+func (*EventCloseGVGRepair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ece39ea12016bd5b, []int{23}
+}
+func (m *EventCloseGVGRepair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCloseGVGRepair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCloseGVGRepair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCloseGVGRepair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCloseGVGRepair.Merge(m, src)
+}
+func (m *EventCloseGVGRepair) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCloseGVGRepair) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCloseGVGRepair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCloseGVGRepair proto.InternalMessageInfo
+
+func (m *EventCloseGVGRepair) GetGlobalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupId
+	}
+	return 0
+}
+
+func (m *EventCloseGVGRepair) GetPrimarySpId() uint32 {
+	if m != nil {
+		return m.PrimarySpId
+	}
+	return 0
+}
+
+func (m *EventCloseGVGRepair) GetRepaired() bool {
+	if m != nil {
+		return m.Repaired
+	}
+	return false
+}
+
+func (m *EventCloseGVGRepair) GetSlashedSpIds() []uint32 {
+	if m != nil {
+		return m.SlashedSpIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventCreateGlobalVirtualGroup)(nil), "greenfield.virtualgroup.EventCreateGlobalVirtualGroup")
 	proto.RegisterType((*EventUpdateGlobalVirtualGroup)(nil), "greenfield.virtualgroup.EventUpdateGlobalVirtualGroup")
@@ -1379,6 +1687,10 @@ func init() {
 	proto.RegisterType((*EventStorageProviderForcedExit)(nil), "greenfield.virtualgroup.EventStorageProviderForcedExit")
 	proto.RegisterType((*EventSettleGlobalVirtualGroupFamily)(nil), "greenfield.virtualgroup.EventSettleGlobalVirtualGroupFamily")
 	proto.RegisterType((*EventSettleGlobalVirtualGroup)(nil), "greenfield.virtualgroup.EventSettleGlobalVirtualGroup")
+	proto.RegisterType((*EventStartFamilyRebalance)(nil), "greenfield.virtualgroup.EventStartFamilyRebalance")
+	proto.RegisterType((*EventUpdateFamilyRebalance)(nil), "greenfield.virtualgroup.EventUpdateFamilyRebalance")
+	proto.RegisterType((*EventOpenGVGRepair)(nil), "greenfield.virtualgroup.EventOpenGVGRepair")
+	proto.RegisterType((*EventCloseGVGRepair)(nil), "greenfield.virtualgroup.EventCloseGVGRepair")
 }
 
 func init() {
@@ -1386,71 +1698,87 @@ func init() {
 }
 
 var fileDescriptor_ece39ea12016bd5b = []byte{
-	// 1016 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xae, 0x9d, 0x10, 0xbf, 0xc4, 0x49, 0xba, 0x71, 0xf0, 0x92, 0x12, 0xc7, 0x5a, 0x50,
-	0xf1, 0x25, 0xf6, 0x01, 0x2a, 0x90, 0xe0, 0x42, 0xda, 0xa6, 0x32, 0x42, 0x10, 0xd9, 0x2d, 0x12,
-	0x5c, 0x56, 0xeb, 0x9d, 0xc9, 0x66, 0x54, 0xef, 0xce, 0x6a, 0x66, 0x1c, 0x92, 0xfe, 0x04, 0x2e,
-	0x20, 0x7e, 0x4b, 0x7f, 0x00, 0xc7, 0x1e, 0xab, 0x9e, 0x10, 0x87, 0xaa, 0x4a, 0x38, 0xc0, 0x09,
-	0xce, 0x48, 0x08, 0xb4, 0x33, 0x63, 0xc7, 0xf6, 0xda, 0xae, 0xeb, 0x24, 0x82, 0xf6, 0x94, 0xec,
-	0xdb, 0x99, 0x37, 0xef, 0xfb, 0xde, 0x37, 0xef, 0x3d, 0x2f, 0xbc, 0x1b, 0x30, 0x8c, 0xa3, 0x03,
-	0x82, 0xdb, 0xa8, 0x76, 0x44, 0x98, 0xe8, 0x78, 0xed, 0x80, 0xd1, 0x4e, 0x5c, 0xc3, 0x47, 0x38,
-	0x12, 0xbc, 0x1a, 0x33, 0x2a, 0xa8, 0x55, 0x3c, 0x5f, 0x55, 0xed, 0x5f, 0xb5, 0xf9, 0x96, 0x4f,
-	0x79, 0x48, 0xb9, 0x2b, 0x97, 0xd5, 0xd4, 0x83, 0xda, 0xb3, 0x59, 0x08, 0x68, 0x40, 0x95, 0x3d,
-	0xf9, 0x4f, 0x59, 0x9d, 0x3f, 0x4d, 0xd8, 0xba, 0x93, 0xb8, 0xbe, 0xc5, 0xb0, 0x27, 0xf0, 0xdd,
-	0x36, 0x6d, 0x79, 0xed, 0xaf, 0x94, 0xcb, 0xbb, 0x89, 0x4b, 0x6b, 0x05, 0x4c, 0x82, 0x6c, 0xa3,
-	0x6c, 0x54, 0xf2, 0x0d, 0x93, 0x20, 0xeb, 0x3a, 0xe4, 0x0e, 0xbc, 0x90, 0xb4, 0x4f, 0x5c, 0x82,
-	0x6c, 0x53, 0x9a, 0x17, 0x95, 0xa1, 0x8e, 0x2c, 0x07, 0xf2, 0x31, 0x23, 0xa1, 0xc7, 0x4e, 0x5c,
-	0x1e, 0x27, 0x0b, 0x32, 0x72, 0xc1, 0x92, 0x36, 0x36, 0xe3, 0x3a, 0xb2, 0x2a, 0xb0, 0xc6, 0xb1,
-	0x4f, 0x23, 0xd4, 0x5b, 0xc5, 0xed, 0x6c, 0x39, 0x53, 0xc9, 0x37, 0x56, 0x7a, 0xf6, 0x64, 0x21,
-	0xb7, 0xb6, 0x61, 0x89, 0x0b, 0xca, 0x30, 0x72, 0x39, 0x79, 0x88, 0xed, 0xf9, 0xb2, 0x51, 0xc9,
-	0x36, 0x40, 0x99, 0x9a, 0xe4, 0x21, 0xb6, 0xf6, 0xa1, 0xa8, 0xe1, 0xbb, 0xb1, 0x77, 0x12, 0xe2,
-	0x48, 0xb8, 0x1e, 0x42, 0x0c, 0x73, 0x6e, 0x2f, 0x94, 0x8d, 0x4a, 0x6e, 0xd7, 0x7e, 0xfa, 0x68,
-	0xa7, 0xa0, 0x69, 0xf8, 0x54, 0xbd, 0x69, 0x0a, 0x46, 0xa2, 0xa0, 0xb1, 0xa1, 0x37, 0xee, 0xab,
-	0x7d, 0xfa, 0xa5, 0xe5, 0x41, 0x5e, 0x50, 0xe1, 0xb5, 0x5d, 0x84, 0x63, 0xca, 0x89, 0xb0, 0xdf,
-	0x90, 0x7e, 0x3e, 0x79, 0xfc, 0x6c, 0x7b, 0xee, 0x97, 0x67, 0xdb, 0x37, 0x02, 0x22, 0x0e, 0x3b,
-	0xad, 0xaa, 0x4f, 0x43, 0xcd, 0xae, 0xfe, 0xb3, 0xc3, 0xd1, 0x83, 0x9a, 0x38, 0x89, 0x31, 0xaf,
-	0xd6, 0x23, 0xf1, 0xf4, 0xd1, 0x0e, 0xe8, 0x53, 0xeb, 0x91, 0x68, 0x2c, 0x4b, 0x97, 0xb7, 0x95,
-	0x47, 0xe7, 0x1f, 0x43, 0x53, 0x7e, 0x3f, 0x46, 0xd3, 0x51, 0xbe, 0x05, 0x0a, 0xb4, 0xa2, 0xc1,
-	0x94, 0x34, 0xe4, 0xa4, 0x45, 0xb2, 0x90, 0x8a, 0x39, 0x73, 0xd9, 0x31, 0xa7, 0xf3, 0x9a, 0x9d,
-	0x2e, 0xaf, 0xf3, 0xa3, 0xf2, 0xea, 0x34, 0x35, 0x01, 0xb7, 0x71, 0x1b, 0x4f, 0x45, 0x40, 0xea,
-	0x78, 0x33, 0x75, 0xbc, 0xf3, 0xab, 0x01, 0xef, 0x4c, 0x54, 0xf2, 0x9e, 0x14, 0xe9, 0x2c, 0xbe,
-	0x27, 0xe9, 0x2c, 0x33, 0x9b, 0xce, 0x3e, 0x04, 0x3b, 0x90, 0x11, 0xba, 0x5d, 0xc7, 0xf2, 0x02,
-	0xf7, 0x5d, 0x86, 0x8d, 0x20, 0x85, 0x20, 0xe1, 0xee, 0xc7, 0x2e, 0xcc, 0x71, 0xea, 0xb9, 0x00,
-	0xcc, 0x49, 0x41, 0x65, 0x26, 0x05, 0xf5, 0xb5, 0x8e, 0x69, 0x5c, 0x42, 0x67, 0x8f, 0xc9, 0xf9,
-	0xc9, 0x80, 0xb7, 0xfb, 0xd2, 0xfa, 0x39, 0xf5, 0x5f, 0xa0, 0x95, 0x8f, 0x20, 0xd7, 0xea, 0xf8,
-	0x0f, 0xb0, 0xe8, 0x3a, 0xcc, 0xed, 0x5e, 0xd7, 0x37, 0x21, 0x7b, 0x9f, 0x48, 0x9d, 0x2f, 0xe9,
-	0x4c, 0x25, 0x8f, 0x8d, 0x45, 0xb5, 0xba, 0x8e, 0xac, 0x9b, 0x50, 0x1c, 0x03, 0x5f, 0x97, 0xb1,
-	0xc2, 0x28, 0xf4, 0xc3, 0x55, 0x2a, 0x3b, 0x5c, 0xa5, 0xce, 0x21, 0xa8, 0x94, 0xbd, 0x8a, 0x10,
-	0x0e, 0x35, 0x02, 0x95, 0xe0, 0x2b, 0x44, 0xe0, 0x9c, 0x19, 0xb0, 0x2c, 0x8f, 0x6a, 0x7e, 0xeb,
-	0xc5, 0x5f, 0x76, 0x84, 0x55, 0x85, 0xf5, 0x24, 0x10, 0x2f, 0xc0, 0x49, 0x57, 0x3b, 0x22, 0x08,
-	0x33, 0xb7, 0x77, 0xd6, 0x35, 0xfd, 0x6a, 0x5f, 0xbf, 0xa9, 0x23, 0x6b, 0x17, 0x4a, 0x23, 0x29,
-	0x18, 0x6e, 0x5a, 0x9b, 0xc1, 0x18, 0x99, 0x5e, 0xe0, 0x22, 0x58, 0x37, 0x60, 0x95, 0x77, 0x7c,
-	0x1f, 0x73, 0x4e, 0xd9, 0x40, 0xa5, 0xcc, 0xf7, 0xcc, 0x52, 0xd5, 0x7f, 0x19, 0x50, 0x50, 0xaa,
-	0xa6, 0x61, 0x9c, 0x50, 0x3a, 0x2b, 0xda, 0x9b, 0x50, 0xe4, 0xcc, 0x77, 0x47, 0xed, 0x51, 0x30,
-	0x0b, 0x9c, 0xf9, 0xcd, 0x19, 0x48, 0xca, 0x5c, 0x88, 0xa4, 0x89, 0x25, 0xec, 0x77, 0x03, 0x2c,
-	0x05, 0xde, 0x8b, 0x7c, 0xdc, 0x7e, 0xad, 0x13, 0xfd, 0xbd, 0x01, 0xb6, 0x92, 0xf3, 0x60, 0xfc,
-	0x77, 0x8e, 0xc9, 0xcb, 0x23, 0xbe, 0x05, 0x6b, 0x34, 0xc6, 0xcc, 0x13, 0x94, 0xf5, 0xfa, 0x8f,
-	0xf9, 0x82, 0xfe, 0xb3, 0xda, 0xdd, 0xa1, 0xcd, 0xce, 0x1f, 0x26, 0x94, 0x07, 0xa5, 0xf7, 0x3f,
-	0x89, 0xcc, 0x6a, 0x80, 0x9d, 0x3a, 0x74, 0xda, 0x36, 0xfb, 0xe6, 0x50, 0x4c, 0x63, 0xe7, 0xb9,
-	0xec, 0xa5, 0xcf, 0x46, 0xdb, 0xb0, 0x74, 0x40, 0x99, 0x8f, 0x91, 0x8b, 0x8f, 0x89, 0x90, 0x53,
-	0xea, 0x62, 0x03, 0x94, 0x29, 0x21, 0xd3, 0xf9, 0xce, 0xd4, 0x7a, 0x6f, 0x60, 0x8e, 0xd9, 0x91,
-	0xbc, 0xeb, 0xf5, 0xe8, 0x3f, 0xd1, 0xfb, 0x8c, 0xfd, 0xa1, 0x0c, 0xcb, 0xc2, 0x63, 0x01, 0x16,
-	0x03, 0x52, 0x07, 0x65, 0x93, 0xa3, 0xc3, 0x7b, 0xb0, 0x8a, 0x8f, 0x63, 0xc2, 0x3c, 0x41, 0x68,
-	0xe4, 0x0a, 0x12, 0x76, 0xc7, 0xf5, 0x95, 0x73, 0xf3, 0x3d, 0x12, 0x62, 0xe7, 0x6f, 0x03, 0xd6,
-	0x53, 0x95, 0x6f, 0x06, 0x36, 0x3e, 0x86, 0xcd, 0x6e, 0x48, 0x63, 0x6b, 0x5f, 0x51, 0x07, 0x78,
-	0x25, 0xe5, 0x6f, 0x02, 0x95, 0xd9, 0xf1, 0x54, 0x3a, 0xcf, 0x0d, 0xb8, 0x36, 0x54, 0xfc, 0x5e,
-	0x33, 0x2d, 0x38, 0xfb, 0x50, 0x1a, 0x55, 0xf2, 0xf6, 0x7a, 0x37, 0xe2, 0x65, 0xe1, 0x3a, 0xbf,
-	0x75, 0x87, 0xde, 0x26, 0x16, 0xa2, 0x3d, 0xfd, 0x80, 0xb9, 0x0e, 0xf3, 0xfd, 0x83, 0x65, 0x96,
-	0x27, 0x00, 0xf6, 0xc0, 0xe2, 0xb1, 0x7b, 0xd0, 0x89, 0x10, 0x89, 0x82, 0xa9, 0x0b, 0xcc, 0x1a,
-	0x8f, 0xf7, 0xd4, 0x96, 0x6e, 0x69, 0xb9, 0x07, 0x0b, 0x5e, 0x48, 0x3b, 0xd1, 0xe5, 0xd4, 0x14,
-	0xed, 0x2b, 0x81, 0xba, 0x35, 0x11, 0x6a, 0x0a, 0xe4, 0x06, 0x2c, 0xe8, 0x5f, 0x5b, 0xa6, 0xec,
-	0x58, 0xf3, 0x5c, 0x76, 0xa8, 0xcf, 0xa0, 0x90, 0x86, 0x89, 0x55, 0x5b, 0x9b, 0x04, 0xd4, 0x1a,
-	0x06, 0x8a, 0xaf, 0x08, 0xea, 0xee, 0x17, 0x8f, 0x4f, 0x4b, 0xc6, 0x93, 0xd3, 0x92, 0xf1, 0xfc,
-	0xb4, 0x64, 0xfc, 0x70, 0x56, 0x9a, 0x7b, 0x72, 0x56, 0x9a, 0xfb, 0xf9, 0xac, 0x34, 0xf7, 0xcd,
-	0x07, 0x7d, 0x7e, 0x5b, 0x51, 0x6b, 0xc7, 0x3f, 0xf4, 0x48, 0x54, 0xeb, 0xfb, 0x34, 0x72, 0x3c,
-	0xf8, 0x71, 0x44, 0x9e, 0xd4, 0x5a, 0x90, 0x9f, 0x34, 0xde, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff,
-	0x4c, 0x7d, 0xe7, 0x95, 0x44, 0x11, 0x00, 0x00,
+	// 1270 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0xae, 0x9d, 0x34, 0x7e, 0xed, 0xb8, 0xe9, 0xd6, 0x25, 0xae, 0xdb, 0x3a, 0x66, 0x5b,
+	0x15, 0x5f, 0xe2, 0x48, 0x85, 0x0a, 0x24, 0xb8, 0x34, 0x6d, 0x13, 0x19, 0x01, 0x8d, 0xd6, 0x6d,
+	0x25, 0xb8, 0xac, 0xc6, 0xbb, 0x93, 0xf5, 0xa8, 0xeb, 0xdd, 0xd5, 0xcc, 0x38, 0x24, 0xfd, 0x07,
+	0x70, 0x01, 0xc1, 0x5f, 0xe9, 0x0f, 0xe0, 0xd8, 0x63, 0xe9, 0x09, 0x81, 0x54, 0x55, 0x0d, 0x07,
+	0x38, 0xc1, 0x19, 0x09, 0x81, 0x76, 0x66, 0x76, 0xe3, 0xf8, 0xab, 0xae, 0x9b, 0xf2, 0xd1, 0x93,
+	0x3d, 0xef, 0x7c, 0xbd, 0xcf, 0xf3, 0x7e, 0xee, 0xc0, 0x25, 0x8f, 0x62, 0x1c, 0xec, 0x10, 0xec,
+	0xbb, 0xeb, 0xbb, 0x84, 0xf2, 0x1e, 0xf2, 0x3d, 0x1a, 0xf6, 0xa2, 0x75, 0xbc, 0x8b, 0x03, 0xce,
+	0x1a, 0x11, 0x0d, 0x79, 0x68, 0xac, 0x1c, 0xae, 0x6a, 0xf4, 0xaf, 0xaa, 0x9c, 0x75, 0x42, 0xd6,
+	0x0d, 0x99, 0x2d, 0x96, 0xad, 0xcb, 0x81, 0xdc, 0x53, 0x29, 0x79, 0xa1, 0x17, 0x4a, 0x79, 0xfc,
+	0x4f, 0x49, 0x2f, 0x8e, 0xbb, 0x8f, 0xef, 0x47, 0x58, 0x6d, 0x35, 0x7f, 0xd7, 0xe1, 0xc2, 0xcd,
+	0xf8, 0xfe, 0xeb, 0x14, 0x23, 0x8e, 0xb7, 0xfc, 0xb0, 0x8d, 0xfc, 0xbb, 0x72, 0xf5, 0x56, 0xbc,
+	0xda, 0x28, 0x82, 0x4e, 0xdc, 0xb2, 0x56, 0xd3, 0xea, 0x4b, 0x96, 0x4e, 0x5c, 0xe3, 0x1c, 0xe4,
+	0x76, 0x50, 0x97, 0xf8, 0xfb, 0x36, 0x71, 0xcb, 0xba, 0x10, 0x2f, 0x4a, 0x41, 0xd3, 0x35, 0x4c,
+	0x58, 0x8a, 0x28, 0xe9, 0x22, 0xba, 0x6f, 0xb3, 0x28, 0x5e, 0x90, 0x11, 0x0b, 0xf2, 0x4a, 0xd8,
+	0x8a, 0x9a, 0xae, 0x51, 0x87, 0x65, 0x86, 0x9d, 0x30, 0x70, 0xd3, 0x55, 0xac, 0x9c, 0xad, 0x65,
+	0xea, 0x4b, 0x56, 0x31, 0x95, 0xc7, 0x0b, 0x99, 0xb1, 0x0a, 0x79, 0xc6, 0x43, 0x8a, 0x5d, 0x9b,
+	0x91, 0xfb, 0xb8, 0x3c, 0x5f, 0xd3, 0xea, 0x59, 0x0b, 0xa4, 0xa8, 0x45, 0xee, 0x63, 0x63, 0x1b,
+	0x56, 0x14, 0x32, 0x3b, 0x42, 0xfb, 0x5d, 0x1c, 0x70, 0x1b, 0xb9, 0x2e, 0xc5, 0x8c, 0x95, 0x17,
+	0x6a, 0x5a, 0x3d, 0xb7, 0x51, 0x7e, 0xfc, 0x60, 0xad, 0xa4, 0xb8, 0xba, 0x26, 0x67, 0x5a, 0x9c,
+	0x92, 0xc0, 0xb3, 0xce, 0xa8, 0x8d, 0xdb, 0x72, 0x9f, 0x9a, 0x34, 0x10, 0x2c, 0xf1, 0x90, 0x23,
+	0xdf, 0x76, 0x71, 0x14, 0x32, 0xc2, 0xcb, 0x27, 0xc4, 0x39, 0x1f, 0x3c, 0x7c, 0xb2, 0x3a, 0xf7,
+	0xe3, 0x93, 0xd5, 0xcb, 0x1e, 0xe1, 0x9d, 0x5e, 0xbb, 0xe1, 0x84, 0x5d, 0x65, 0x02, 0xf5, 0xb3,
+	0xc6, 0xdc, 0x7b, 0x8a, 0xd8, 0x66, 0xc0, 0x1f, 0x3f, 0x58, 0x03, 0x75, 0x6b, 0x33, 0xe0, 0x56,
+	0x41, 0x1c, 0x79, 0x43, 0x9e, 0x68, 0xfe, 0xa5, 0x29, 0xca, 0xef, 0x44, 0xee, 0x74, 0x94, 0x5f,
+	0x00, 0x09, 0x5a, 0xd2, 0xa0, 0x0b, 0x1a, 0x72, 0x42, 0x22, 0x58, 0x18, 0xd2, 0x39, 0x73, 0xdc,
+	0x3a, 0x0f, 0xdb, 0x35, 0x3b, 0x9d, 0x5d, 0xe7, 0x47, 0xd9, 0xd5, 0x6c, 0x29, 0x02, 0x6e, 0x60,
+	0x1f, 0x4f, 0x45, 0xc0, 0xd0, 0xf5, 0xfa, 0xd0, 0xf5, 0xe6, 0xcf, 0x1a, 0x5c, 0x9c, 0xe8, 0xc9,
+	0x9b, 0xc2, 0x49, 0x67, 0x39, 0x7b, 0x92, 0x9f, 0x65, 0x66, 0xf3, 0xb3, 0x77, 0xa1, 0xec, 0x09,
+	0x0d, 0xed, 0xe4, 0x60, 0x11, 0x9b, 0x7d, 0xc1, 0x70, 0xc6, 0x1b, 0x42, 0x10, 0x73, 0xf7, 0x4d,
+	0x02, 0x73, 0x9c, 0xf7, 0xbc, 0x04, 0xcc, 0x49, 0x4a, 0x65, 0x26, 0x29, 0xf5, 0xa9, 0xd2, 0x69,
+	0x9c, 0x41, 0x67, 0xd7, 0xc9, 0xfc, 0x4e, 0x83, 0xf3, 0x7d, 0x66, 0xfd, 0x28, 0x74, 0x9e, 0xe3,
+	0x2b, 0xef, 0x41, 0xae, 0xdd, 0x73, 0xee, 0x61, 0x9e, 0x1c, 0x98, 0xdb, 0x38, 0xa7, 0x22, 0x21,
+	0x7b, 0x87, 0x08, 0x3f, 0xcf, 0x2b, 0x4b, 0xc5, 0x43, 0x6b, 0x51, 0xae, 0x6e, 0xba, 0xc6, 0x55,
+	0x58, 0x19, 0x03, 0x5f, 0xa5, 0xb1, 0xd2, 0x28, 0xf4, 0x83, 0x59, 0x2a, 0x3b, 0x98, 0xa5, 0x0e,
+	0x21, 0x48, 0x93, 0xfd, 0x1f, 0x21, 0x74, 0x14, 0x02, 0x69, 0xe0, 0x57, 0x88, 0xc0, 0x3c, 0xd0,
+	0xa0, 0x20, 0xae, 0x6a, 0x7d, 0x8e, 0xa2, 0x5b, 0x3d, 0x6e, 0x34, 0xe0, 0x74, 0xac, 0x08, 0xf2,
+	0x70, 0x5c, 0xfa, 0x76, 0x89, 0x8b, 0xa9, 0x9d, 0xde, 0x75, 0x4a, 0x4d, 0x6d, 0xab, 0x99, 0xa6,
+	0x6b, 0x6c, 0x40, 0x75, 0x24, 0x05, 0x83, 0x45, 0xab, 0xe2, 0x8d, 0x71, 0xd3, 0x97, 0x08, 0x04,
+	0xe3, 0x32, 0x9c, 0x64, 0x3d, 0xc7, 0xc1, 0x8c, 0x85, 0xf4, 0x48, 0xa6, 0x5c, 0x4a, 0xc5, 0xc2,
+	0xab, 0xff, 0xd0, 0xa0, 0x24, 0xbd, 0x3a, 0xec, 0x46, 0x31, 0xa5, 0xb3, 0xa2, 0xbd, 0x0a, 0x2b,
+	0x8c, 0x3a, 0xf6, 0xa8, 0x3d, 0x12, 0x66, 0x89, 0x51, 0xa7, 0x35, 0x03, 0x49, 0x99, 0x97, 0x22,
+	0x69, 0x62, 0x0a, 0xfb, 0x55, 0x03, 0x43, 0x82, 0x47, 0x81, 0x83, 0xfd, 0xd7, 0xda, 0xd0, 0x5f,
+	0x69, 0x50, 0x96, 0xee, 0x7c, 0x54, 0xff, 0x9b, 0x7b, 0xe4, 0xc5, 0x11, 0x5f, 0x87, 0xe5, 0x30,
+	0xc2, 0x14, 0xf1, 0x90, 0xa6, 0xf5, 0x47, 0x7f, 0x4e, 0xfd, 0x39, 0x99, 0xec, 0x50, 0x62, 0xf3,
+	0x37, 0x1d, 0x6a, 0x47, 0x5d, 0xef, 0x3f, 0xa2, 0x99, 0x61, 0x41, 0x79, 0xe8, 0xd2, 0x69, 0xcb,
+	0xec, 0x1b, 0x03, 0x3a, 0x8d, 0xed, 0xe7, 0xb2, 0xc7, 0xde, 0x1b, 0xad, 0x42, 0x7e, 0x27, 0xa4,
+	0x0e, 0x76, 0x6d, 0xbc, 0x47, 0xb8, 0xe8, 0x52, 0x17, 0x2d, 0x90, 0xa2, 0x98, 0x4c, 0xf3, 0x4b,
+	0x5d, 0xf9, 0xbb, 0x85, 0x19, 0xa6, 0xbb, 0x22, 0xd6, 0x9b, 0xc1, 0xbf, 0xe2, 0xef, 0x33, 0xd6,
+	0x87, 0x1a, 0x14, 0x38, 0xa2, 0x1e, 0xe6, 0x47, 0x5c, 0x1d, 0xa4, 0x4c, 0xb4, 0x0e, 0x6f, 0xc1,
+	0x49, 0xbc, 0x17, 0x11, 0x8a, 0x38, 0x09, 0x03, 0x9b, 0x93, 0x6e, 0xd2, 0xae, 0x17, 0x0f, 0xc5,
+	0xb7, 0x49, 0x17, 0x9b, 0x7f, 0x6a, 0x70, 0x7a, 0x28, 0xf3, 0xcd, 0xc0, 0xc6, 0xfb, 0x50, 0x49,
+	0x54, 0x1a, 0x9b, 0xfb, 0x56, 0x94, 0x82, 0xaf, 0x24, 0xfd, 0x4d, 0xa0, 0x32, 0x3b, 0x9e, 0x4a,
+	0xf3, 0xa9, 0x06, 0xa7, 0x06, 0x92, 0xdf, 0x6b, 0xe6, 0x0b, 0xe6, 0x36, 0x54, 0x47, 0xa5, 0xbc,
+	0xcd, 0x34, 0x22, 0x5e, 0x14, 0xae, 0xf9, 0x4b, 0xd2, 0xf4, 0xb6, 0x30, 0xe7, 0xfe, 0xf4, 0x0d,
+	0xe6, 0x69, 0x98, 0xef, 0x6f, 0x2c, 0xb3, 0x2c, 0x06, 0xb0, 0x09, 0x06, 0x8b, 0xec, 0x9d, 0x5e,
+	0xe0, 0x92, 0xc0, 0x9b, 0x3a, 0xc1, 0x2c, 0xb3, 0x68, 0x53, 0x6e, 0x49, 0x52, 0xcb, 0x6d, 0x58,
+	0x40, 0xdd, 0xb0, 0x17, 0x1c, 0x4f, 0x4e, 0x51, 0x67, 0xc5, 0x50, 0x2f, 0x4c, 0x84, 0x3a, 0x04,
+	0xf2, 0x0c, 0x2c, 0xa8, 0xaf, 0x2d, 0x5d, 0x54, 0xac, 0x79, 0x26, 0x2a, 0xd4, 0x87, 0x50, 0x1a,
+	0x86, 0x89, 0x65, 0x59, 0x9b, 0x04, 0xd4, 0x18, 0x04, 0x8a, 0x5f, 0x15, 0xd4, 0x9f, 0x34, 0x38,
+	0xab, 0x1c, 0x05, 0x51, 0x2e, 0x4d, 0x68, 0xe1, 0x36, 0xf2, 0xe3, 0xc8, 0x30, 0xde, 0x84, 0x02,
+	0x4d, 0x06, 0x87, 0xce, 0x91, 0x4f, 0x65, 0x4d, 0xd7, 0x38, 0x0f, 0x39, 0x12, 0x10, 0x4e, 0xe2,
+	0x22, 0x22, 0xcb, 0x8d, 0x75, 0x28, 0x18, 0xe7, 0x64, 0x99, 0x71, 0x31, 0xf5, 0x31, 0x9c, 0xc0,
+	0x01, 0xa7, 0x04, 0xcb, 0xf6, 0x25, 0x7f, 0x65, 0xad, 0x31, 0xe6, 0x2d, 0xa6, 0x31, 0xa0, 0xeb,
+	0xcd, 0x80, 0xd3, 0xfd, 0x8d, 0x6c, 0x4c, 0x8a, 0x95, 0x9c, 0x61, 0x7e, 0xa1, 0x43, 0xa5, 0xaf,
+	0xeb, 0x9f, 0x01, 0xde, 0x71, 0x04, 0xf9, 0x60, 0xb4, 0x66, 0x86, 0x32, 0xf7, 0x26, 0x2c, 0x30,
+	0x8e, 0x78, 0x8f, 0x09, 0xdb, 0x16, 0xaf, 0x34, 0xa6, 0x45, 0xdd, 0x12, 0xbb, 0x2c, 0xb5, 0xdb,
+	0xa8, 0xc0, 0xe2, 0x0e, 0x09, 0x08, 0xeb, 0x60, 0x57, 0xd5, 0xc0, 0x74, 0x6c, 0x7e, 0x9f, 0x74,
+	0x7c, 0xb7, 0x22, 0x1c, 0x6c, 0xdd, 0xdd, 0xb2, 0x70, 0x84, 0x08, 0x9d, 0x94, 0x81, 0xb4, 0x09,
+	0x19, 0x68, 0x9a, 0x4f, 0xd9, 0x3a, 0x2c, 0xf7, 0x82, 0x0e, 0x46, 0x3e, 0xef, 0xa4, 0x8f, 0x11,
+	0xb2, 0xa1, 0x2b, 0xa6, 0x72, 0xf9, 0xc8, 0x54, 0x81, 0x45, 0x17, 0x23, 0xd7, 0x27, 0x81, 0xfc,
+	0xf0, 0xc9, 0x58, 0xe9, 0x38, 0x9e, 0xa3, 0x38, 0x0a, 0x29, 0xc7, 0x54, 0x60, 0xca, 0x59, 0xe9,
+	0xd8, 0xfc, 0x56, 0x4f, 0x0a, 0x99, 0x1f, 0x32, 0xfc, 0x8f, 0x80, 0x92, 0xea, 0x20, 0x42, 0xb1,
+	0x34, 0xe4, 0xa2, 0x95, 0x8e, 0x0d, 0x1b, 0x0a, 0xcc, 0x47, 0xac, 0x63, 0x1f, 0x63, 0xa0, 0xe6,
+	0xc5, 0x89, 0xd7, 0xc4, 0x81, 0xc6, 0x25, 0x28, 0x8a, 0x61, 0xfc, 0x91, 0xd8, 0xff, 0xb8, 0x53,
+	0x50, 0x52, 0xc1, 0xe6, 0xc6, 0x27, 0x0f, 0x9f, 0x55, 0xb5, 0x47, 0xcf, 0xaa, 0xda, 0xd3, 0x67,
+	0x55, 0xed, 0xeb, 0x83, 0xea, 0xdc, 0xa3, 0x83, 0xea, 0xdc, 0x0f, 0x07, 0xd5, 0xb9, 0xcf, 0xde,
+	0xe9, 0x53, 0xa1, 0x1d, 0xb4, 0xd7, 0x9c, 0x0e, 0x22, 0xc1, 0x7a, 0xdf, 0x1b, 0xe5, 0xde, 0x88,
+	0x57, 0xca, 0xf6, 0x82, 0x78, 0xa6, 0x7c, 0xfb, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x42,
+	0x68, 0x1e, 0x3d, 0x15, 0x00, 0x00,
 }
 
 func (m *EventCreateGlobalVirtualGroup) Marshal() (dAtA []byte, err error) {
@@ -2448,61 +2776,302 @@ func (m *EventSettleGlobalVirtualGroup) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventStartFamilyRebalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventCreateGlobalVirtualGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventStartFamilyRebalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStartFamilyRebalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovEvents(uint64(m.Id))
-	}
-	if m.FamilyId != 0 {
-		n += 1 + sovEvents(uint64(m.FamilyId))
-	}
-	if m.PrimarySpId != 0 {
-		n += 1 + sovEvents(uint64(m.PrimarySpId))
-	}
-	if len(m.SecondarySpIds) > 0 {
-		l = 0
-		for _, e := range m.SecondarySpIds {
-			l += sovEvents(uint64(e))
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		n += 1 + sovEvents(uint64(l)) + l
 	}
-	if m.StoredSize != 0 {
-		n += 1 + sovEvents(uint64(m.StoredSize))
+	if m.StorageProviderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.VirtualPaymentAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Initiator) > 0 {
+		i -= len(m.Initiator)
+		copy(dAtA[i:], m.Initiator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Initiator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.TotalDeposit.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	if m.RebalanceId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RebalanceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateGlobalVirtualGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovEvents(uint64(m.Id))
+func (m *EventUpdateFamilyRebalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.StoreSize != 0 {
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateFamilyRebalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateFamilyRebalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Finished {
+		i--
+		if m.Finished {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TargetSpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TargetSpId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RebalanceId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RebalanceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOpenGVGRepair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOpenGVGRepair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOpenGVGRepair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.UnhealthySpIds) > 0 {
+		dAtA18 := make([]byte, len(m.UnhealthySpIds)*10)
+		var j17 int
+		for _, num := range m.UnhealthySpIds {
+			for num >= 1<<7 {
+				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA18[j17] = uint8(num)
+			j17++
+		}
+		i -= j17
+		copy(dAtA[i:], dAtA18[:j17])
+		i = encodeVarintEvents(dAtA, i, uint64(j17))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PrimarySpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCloseGVGRepair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCloseGVGRepair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCloseGVGRepair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashedSpIds) > 0 {
+		dAtA20 := make([]byte, len(m.SlashedSpIds)*10)
+		var j19 int
+		for _, num := range m.SlashedSpIds {
+			for num >= 1<<7 {
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA20[j19] = uint8(num)
+			j19++
+		}
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintEvents(dAtA, i, uint64(j19))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.SlashAmount.Size()
+		i -= size
+		if _, err := m.SlashAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Repaired {
+		i--
+		if m.Repaired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PrimarySpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCreateGlobalVirtualGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	if m.FamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.FamilyId))
+	}
+	if m.PrimarySpId != 0 {
+		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	}
+	if len(m.SecondarySpIds) > 0 {
+		l = 0
+		for _, e := range m.SecondarySpIds {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	if m.StoredSize != 0 {
+		n += 1 + sovEvents(uint64(m.StoredSize))
+	}
+	l = len(m.VirtualPaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.TotalDeposit.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventUpdateGlobalVirtualGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	if m.StoreSize != 0 {
 		n += 1 + sovEvents(uint64(m.StoreSize))
 	}
 	l = m.TotalDeposit.Size()
@@ -2894,42 +3463,147 @@ func (m *EventSettleGlobalVirtualGroup) Size() (n int) {
 	return n
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EventStartFamilyRebalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RebalanceId != 0 {
+		n += 1 + sovEvents(uint64(m.RebalanceId))
+	}
+	l = len(m.Initiator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.StorageProviderId != 0 {
+		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
 }
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *EventUpdateFamilyRebalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RebalanceId != 0 {
+		n += 1 + sovEvents(uint64(m.RebalanceId))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if m.TargetSpId != 0 {
+		n += 1 + sovEvents(uint64(m.TargetSpId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	if m.Finished {
+		n += 2
+	}
+	return n
 }
-func (m *EventCreateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreateGlobalVirtualGroup: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreateGlobalVirtualGroup: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+
+func (m *EventOpenGVGRepair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupId))
+	}
+	if m.PrimarySpId != 0 {
+		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	}
+	if len(m.UnhealthySpIds) > 0 {
+		l = 0
+		for _, e := range m.UnhealthySpIds {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovEvents(uint64(m.Deadline))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCloseGVGRepair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupId))
+	}
+	if m.PrimarySpId != 0 {
+		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	}
+	if m.Repaired {
+		n += 2
+	}
+	l = m.SlashAmount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.SlashedSpIds) > 0 {
+		l = 0
+		for _, e := range m.SlashedSpIds {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCreateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateGlobalVirtualGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateGlobalVirtualGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
@@ -5974,6 +6648,739 @@ func (m *EventSettleGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventStartFamilyRebalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStartFamilyRebalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStartFamilyRebalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceId", wireType)
+			}
+			m.RebalanceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RebalanceId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Initiator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
+			}
+			m.StorageProviderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageProviderId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, FamilyRebalanceEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateFamilyRebalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateFamilyRebalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateFamilyRebalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceId", wireType)
+			}
+			m.RebalanceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RebalanceId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSpId", wireType)
+			}
+			m.TargetSpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetSpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= FamilyRebalanceStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finished = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOpenGVGRepair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOpenGVGRepair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOpenGVGRepair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			}
+			m.PrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnhealthySpIds = append(m.UnhealthySpIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnhealthySpIds) == 0 {
+					m.UnhealthySpIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnhealthySpIds = append(m.UnhealthySpIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnhealthySpIds", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCloseGVGRepair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCloseGVGRepair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCloseGVGRepair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			}
+			m.PrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repaired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Repaired = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SlashedSpIds = append(m.SlashedSpIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SlashedSpIds) == 0 {
+					m.SlashedSpIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SlashedSpIds = append(m.SlashedSpIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedSpIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
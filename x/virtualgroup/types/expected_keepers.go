@@ -16,6 +16,15 @@ type SpKeeper interface {
 	Exit(ctx sdk.Context, sp *sptypes.StorageProvider) error
 	DepositDenomForSP(ctx sdk.Context) (res string)
 	GetAllStorageProviders(ctx sdk.Context) (sps []sptypes.StorageProvider)
+	RecordSpSwapOut(ctx sdk.Context, spId uint32)
+	RecordSpExit(ctx sdk.Context, spId uint32, forced bool)
+	GetStorageProviderScore(ctx sdk.Context, spId uint32) (sptypes.StorageProviderScore, bool)
+	GetSpCapacity(ctx sdk.Context, spId uint32) (sptypes.SpCapacity, bool)
+	ReserveSpCapacity(ctx sdk.Context, spId uint32, size uint64) error
+	ReleaseSpCapacity(ctx sdk.Context, spId uint32, size uint64)
+	Slash(ctx sdk.Context, spID uint32, rewardInfos []sptypes.RewardInfo) error
+	IsSpInScheduledMaintenance(ctx sdk.Context, spId uint32) bool
+	GetSpApprovalAddresses(ctx sdk.Context, sp *sptypes.StorageProvider) []sdk.AccAddress
 }
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -48,4 +57,6 @@ type PaymentKeeper interface {
 
 type StorageKeeper interface {
 	GetExpectSecondarySPNumForECObject(ctx sdk.Context, time int64) (res uint32)
+	IsAllowedSecondarySPNum(ctx sdk.Context, secondarySPNum uint32) bool
+	VerifySPPlacementInFamily(ctx sdk.Context, familyID uint32, spID uint32) error
 }
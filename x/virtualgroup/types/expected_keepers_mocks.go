@@ -79,6 +79,35 @@ func (mr *MockSpKeeperMockRecorder) GetAllStorageProviders(ctx interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllStorageProviders", reflect.TypeOf((*MockSpKeeper)(nil).GetAllStorageProviders), ctx)
 }
 
+// GetSpApprovalAddresses mocks base method.
+func (m *MockSpKeeper) GetSpApprovalAddresses(ctx types0.Context, sp *types.StorageProvider) []types0.AccAddress {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpApprovalAddresses", ctx, sp)
+	ret0, _ := ret[0].([]types0.AccAddress)
+	return ret0
+}
+
+// GetSpApprovalAddresses indicates an expected call of GetSpApprovalAddresses.
+func (mr *MockSpKeeperMockRecorder) GetSpApprovalAddresses(ctx, sp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpApprovalAddresses", reflect.TypeOf((*MockSpKeeper)(nil).GetSpApprovalAddresses), ctx, sp)
+}
+
+// GetSpCapacity mocks base method.
+func (m *MockSpKeeper) GetSpCapacity(ctx types0.Context, spId uint32) (types.SpCapacity, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpCapacity", ctx, spId)
+	ret0, _ := ret[0].(types.SpCapacity)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetSpCapacity indicates an expected call of GetSpCapacity.
+func (mr *MockSpKeeperMockRecorder) GetSpCapacity(ctx, spId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpCapacity", reflect.TypeOf((*MockSpKeeper)(nil).GetSpCapacity), ctx, spId)
+}
+
 // GetStorageProvider mocks base method.
 func (m *MockSpKeeper) GetStorageProvider(ctx types0.Context, id uint32) (*types.StorageProvider, bool) {
 	m.ctrl.T.Helper()
@@ -124,6 +153,85 @@ func (mr *MockSpKeeperMockRecorder) GetStorageProviderByOperatorAddr(ctx, addr i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageProviderByOperatorAddr", reflect.TypeOf((*MockSpKeeper)(nil).GetStorageProviderByOperatorAddr), ctx, addr)
 }
 
+// GetStorageProviderScore mocks base method.
+func (m *MockSpKeeper) GetStorageProviderScore(ctx types0.Context, spId uint32) (types.StorageProviderScore, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageProviderScore", ctx, spId)
+	ret0, _ := ret[0].(types.StorageProviderScore)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetStorageProviderScore indicates an expected call of GetStorageProviderScore.
+func (mr *MockSpKeeperMockRecorder) GetStorageProviderScore(ctx, spId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageProviderScore", reflect.TypeOf((*MockSpKeeper)(nil).GetStorageProviderScore), ctx, spId)
+}
+
+// IsSpInScheduledMaintenance mocks base method.
+func (m *MockSpKeeper) IsSpInScheduledMaintenance(ctx types0.Context, spId uint32) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSpInScheduledMaintenance", ctx, spId)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsSpInScheduledMaintenance indicates an expected call of IsSpInScheduledMaintenance.
+func (mr *MockSpKeeperMockRecorder) IsSpInScheduledMaintenance(ctx, spId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSpInScheduledMaintenance", reflect.TypeOf((*MockSpKeeper)(nil).IsSpInScheduledMaintenance), ctx, spId)
+}
+
+// RecordSpExit mocks base method.
+func (m *MockSpKeeper) RecordSpExit(ctx types0.Context, spId uint32, forced bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordSpExit", ctx, spId, forced)
+}
+
+// RecordSpExit indicates an expected call of RecordSpExit.
+func (mr *MockSpKeeperMockRecorder) RecordSpExit(ctx, spId, forced interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSpExit", reflect.TypeOf((*MockSpKeeper)(nil).RecordSpExit), ctx, spId, forced)
+}
+
+// RecordSpSwapOut mocks base method.
+func (m *MockSpKeeper) RecordSpSwapOut(ctx types0.Context, spId uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordSpSwapOut", ctx, spId)
+}
+
+// RecordSpSwapOut indicates an expected call of RecordSpSwapOut.
+func (mr *MockSpKeeperMockRecorder) RecordSpSwapOut(ctx, spId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSpSwapOut", reflect.TypeOf((*MockSpKeeper)(nil).RecordSpSwapOut), ctx, spId)
+}
+
+// ReleaseSpCapacity mocks base method.
+func (m *MockSpKeeper) ReleaseSpCapacity(ctx types0.Context, spId uint32, size uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReleaseSpCapacity", ctx, spId, size)
+}
+
+// ReleaseSpCapacity indicates an expected call of ReleaseSpCapacity.
+func (mr *MockSpKeeperMockRecorder) ReleaseSpCapacity(ctx, spId, size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseSpCapacity", reflect.TypeOf((*MockSpKeeper)(nil).ReleaseSpCapacity), ctx, spId, size)
+}
+
+// ReserveSpCapacity mocks base method.
+func (m *MockSpKeeper) ReserveSpCapacity(ctx types0.Context, spId uint32, size uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveSpCapacity", ctx, spId, size)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReserveSpCapacity indicates an expected call of ReserveSpCapacity.
+func (mr *MockSpKeeperMockRecorder) ReserveSpCapacity(ctx, spId, size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveSpCapacity", reflect.TypeOf((*MockSpKeeper)(nil).ReserveSpCapacity), ctx, spId, size)
+}
+
 // SetStorageProvider mocks base method.
 func (m *MockSpKeeper) SetStorageProvider(ctx types0.Context, sp *types.StorageProvider) {
 	m.ctrl.T.Helper()
@@ -136,6 +244,20 @@ func (mr *MockSpKeeperMockRecorder) SetStorageProvider(ctx, sp interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStorageProvider", reflect.TypeOf((*MockSpKeeper)(nil).SetStorageProvider), ctx, sp)
 }
 
+// Slash mocks base method.
+func (m *MockSpKeeper) Slash(ctx types0.Context, spID uint32, rewardInfos []types.RewardInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Slash", ctx, spID, rewardInfos)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Slash indicates an expected call of Slash.
+func (mr *MockSpKeeperMockRecorder) Slash(ctx, spID, rewardInfos interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Slash", reflect.TypeOf((*MockSpKeeper)(nil).Slash), ctx, spID, rewardInfos)
+}
+
 // MockAccountKeeper is a mock of AccountKeeper interface.
 type MockAccountKeeper struct {
 	ctrl     *gomock.Controller
@@ -448,3 +570,31 @@ func (mr *MockStorageKeeperMockRecorder) GetExpectSecondarySPNumForECObject(ctx,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpectSecondarySPNumForECObject", reflect.TypeOf((*MockStorageKeeper)(nil).GetExpectSecondarySPNumForECObject), ctx, time)
 }
+
+// IsAllowedSecondarySPNum mocks base method.
+func (m *MockStorageKeeper) IsAllowedSecondarySPNum(ctx types0.Context, secondarySPNum uint32) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAllowedSecondarySPNum", ctx, secondarySPNum)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsAllowedSecondarySPNum indicates an expected call of IsAllowedSecondarySPNum.
+func (mr *MockStorageKeeperMockRecorder) IsAllowedSecondarySPNum(ctx, secondarySPNum interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAllowedSecondarySPNum", reflect.TypeOf((*MockStorageKeeper)(nil).IsAllowedSecondarySPNum), ctx, secondarySPNum)
+}
+
+// VerifySPPlacementInFamily mocks base method.
+func (m *MockStorageKeeper) VerifySPPlacementInFamily(ctx types0.Context, familyID, spID uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifySPPlacementInFamily", ctx, familyID, spID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifySPPlacementInFamily indicates an expected call of VerifySPPlacementInFamily.
+func (mr *MockStorageKeeperMockRecorder) VerifySPPlacementInFamily(ctx, familyID, spID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifySPPlacementInFamily", reflect.TypeOf((*MockStorageKeeper)(nil).VerifySPPlacementInFamily), ctx, familyID, spID)
+}
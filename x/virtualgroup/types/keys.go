@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/binary"
+
 	"github.com/bnb-chain/greenfield/internal/sequence"
 )
 
@@ -30,16 +32,21 @@ const (
 	NoSpecifiedFamilyId = uint32(0)
 
 	NoSpecifiedGVGId = uint32(0)
+
+	// MaxFamiliesPerRebalance defines the max number of families moved in a rebalance
+	MaxFamiliesPerRebalance = 100
 )
 
 var (
 	ParamsKey = []byte{0x01}
 
-	GVGKey       = []byte{0x21}
-	GVGFamilyKey = []byte{0x22}
+	GVGKey               = []byte{0x21}
+	GVGFamilyKey         = []byte{0x22}
+	FamilySecondarySPKey = []byte{0x23}
 
 	GVGSequencePrefix       = []byte{0x32}
 	GVGFamilySequencePrefix = []byte{0x33}
+	RebalanceSequencePrefix = []byte{0x34}
 
 	GVGStatisticsWithinSPKey       = []byte{0x41}
 	GVGFamilyStatisticsWithinSPKey = []byte{0x42}
@@ -49,6 +56,16 @@ var (
 
 	SwapInFamilyKey = []byte{0x52}
 	SwapInGVGKey    = []byte{0x62}
+
+	GVGSwappedHeightKey = []byte{0x63}
+
+	SwapInExpirationKey = []byte{0x53}
+
+	FamilyRebalanceKey         = []byte{0x71}
+	FamilyRebalanceByFamilyKey = []byte{0x72}
+
+	GVGRepairKey         = []byte{0x81}
+	GVGRepairDeadlineKey = []byte{0x82}
 )
 
 func GetGVGKey(gvgID uint32) []byte {
@@ -90,3 +107,55 @@ func GetSwapInGVGKey(globalVirtualGroupID uint32) []byte {
 	var uint32Seq sequence.Sequence[uint32]
 	return append(SwapInGVGKey, uint32Seq.EncodeSequence(globalVirtualGroupID)...)
 }
+
+// GetSwapInExpirationKey returns the key of the queue of the reserved swap ins, which is ordered by the expiration time first
+func GetSwapInExpirationKey(expirationTime uint64, swapInKey []byte) []byte {
+	key := make([]byte, len(SwapInExpirationKey)+8, len(SwapInExpirationKey)+8+len(swapInKey))
+	copy(key, SwapInExpirationKey)
+	binary.BigEndian.PutUint64(key[len(SwapInExpirationKey):], expirationTime)
+	return append(key, swapInKey...)
+}
+
+// GetGVGSwappedHeightKey returns the key of the height at which an sp of the gvg was swapped last
+func GetGVGSwappedHeightKey(globalVirtualGroupID uint32) []byte {
+	var uint32Seq sequence.Sequence[uint32]
+	return append(GVGSwappedHeightKey, uint32Seq.EncodeSequence(globalVirtualGroupID)...)
+}
+
+func GetFamilyRebalanceKey(rebalanceID uint32) []byte {
+	var uint32Seq sequence.Sequence[uint32]
+	return append(FamilyRebalanceKey, uint32Seq.EncodeSequence(rebalanceID)...)
+}
+
+// GetFamilyRebalanceByFamilyKey returns the key of the index from a family to the pending rebalance which moves it
+func GetFamilyRebalanceByFamilyKey(globalVirtualGroupFamilyID uint32) []byte {
+	var uint32Seq sequence.Sequence[uint32]
+	return append(FamilyRebalanceByFamilyKey, uint32Seq.EncodeSequence(globalVirtualGroupFamilyID)...)
+}
+
+func GetGVGRepairKey(globalVirtualGroupID uint32) []byte {
+	var uint32Seq sequence.Sequence[uint32]
+	return append(GVGRepairKey, uint32Seq.EncodeSequence(globalVirtualGroupID)...)
+}
+
+// GetGVGRepairDeadlineKey returns the key of the queue of the open repairs, which is ordered by the deadline first
+func GetGVGRepairDeadlineKey(deadline int64, globalVirtualGroupID uint32) []byte {
+	key := make([]byte, len(GVGRepairDeadlineKey)+12)
+	copy(key, GVGRepairDeadlineKey)
+	binary.BigEndian.PutUint64(key[len(GVGRepairDeadlineKey):], uint64(deadline))
+	binary.BigEndian.PutUint32(key[len(GVGRepairDeadlineKey)+8:], globalVirtualGroupID)
+	return key
+}
+
+// GetFamilySecondarySPPrefix returns the prefix of the index of the secondary sps serving the gvgs of a family
+func GetFamilySecondarySPPrefix(globalVirtualGroupFamilyID uint32) []byte {
+	key := make([]byte, len(FamilySecondarySPKey)+4)
+	copy(key, FamilySecondarySPKey)
+	binary.BigEndian.PutUint32(key[len(FamilySecondarySPKey):], globalVirtualGroupFamilyID)
+	return key
+}
+
+// GetFamilySecondarySPKey returns the key of the number of the gvgs of a family served by a secondary sp
+func GetFamilySecondarySPKey(globalVirtualGroupFamilyID, spID uint32) []byte {
+	return binary.BigEndian.AppendUint32(GetFamilySecondarySPPrefix(globalVirtualGroupFamilyID), spID)
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgOpenGVGRepair = "open_gvg_repair"
+
+var _ sdk.Msg = &MsgOpenGVGRepair{}
+
+func NewMsgOpenGVGRepair(operator sdk.AccAddress, gvgID uint32) *MsgOpenGVGRepair {
+	return &MsgOpenGVGRepair{
+		Operator:             operator.String(),
+		GlobalVirtualGroupId: gvgID,
+	}
+}
+
+func (msg *MsgOpenGVGRepair) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgOpenGVGRepair) Type() string {
+	return TypeMsgOpenGVGRepair
+}
+
+func (msg *MsgOpenGVGRepair) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgOpenGVGRepair) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgOpenGVGRepair) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.Operator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if msg.GlobalVirtualGroupId == NoSpecifiedGVGId {
+		return ErrGVGNotExist.Wrap("the gvg id should not be zero")
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+)
+
+func TestMsgOpenGVGRepair_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgOpenGVGRepair
+		err  error
+	}{
+		{
+			name: "valid",
+			msg:  *NewMsgOpenGVGRepair(sample.RandAccAddress(), 1),
+		},
+		{
+			name: "invalid address",
+			msg: MsgOpenGVGRepair{
+				Operator:             "invalid_address",
+				GlobalVirtualGroupId: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "zero gvg id",
+			msg: MsgOpenGVGRepair{
+				Operator:             sample.RandAccAddressHex(),
+				GlobalVirtualGroupId: 0,
+			},
+			err: ErrGVGNotExist,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
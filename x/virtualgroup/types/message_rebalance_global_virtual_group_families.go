@@ -0,0 +1,66 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRebalanceGlobalVirtualGroupFamilies = "rebalance_global_virtual_group_families"
+
+var _ sdk.Msg = &MsgRebalanceGlobalVirtualGroupFamilies{}
+
+func NewMsgRebalanceGlobalVirtualGroupFamilies(operator sdk.AccAddress, spID uint32, familyIDs, targetSPIDs []uint32) *MsgRebalanceGlobalVirtualGroupFamilies {
+	return &MsgRebalanceGlobalVirtualGroupFamilies{
+		Operator:                    operator.String(),
+		StorageProviderId:           spID,
+		GlobalVirtualGroupFamilyIds: familyIDs,
+		TargetSpIds:                 targetSPIDs,
+	}
+}
+
+func (msg *MsgRebalanceGlobalVirtualGroupFamilies) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRebalanceGlobalVirtualGroupFamilies) Type() string {
+	return TypeMsgRebalanceGlobalVirtualGroupFamilies
+}
+
+func (msg *MsgRebalanceGlobalVirtualGroupFamilies) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgRebalanceGlobalVirtualGroupFamilies) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRebalanceGlobalVirtualGroupFamilies) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.Operator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if len(msg.GlobalVirtualGroupFamilyIds) > MaxFamiliesPerRebalance {
+		return ErrInvalidGVGCount.Wrapf("at most %d families can be moved in a rebalance", MaxFamiliesPerRebalance)
+	}
+	familyIDs := make(map[uint32]struct{}, len(msg.GlobalVirtualGroupFamilyIds))
+	for _, familyID := range msg.GlobalVirtualGroupFamilyIds {
+		if familyID == NoSpecifiedFamilyId {
+			return ErrGVGFamilyNotExist.Wrap("the family id should not be zero")
+		}
+		if _, ok := familyIDs[familyID]; ok {
+			return ErrFamilyRebalanceFailed.Wrapf("duplicate family id %d", familyID)
+		}
+		familyIDs[familyID] = struct{}{}
+	}
+	for _, targetSPID := range msg.TargetSpIds {
+		if targetSPID == msg.StorageProviderId {
+			return ErrFamilyRebalanceFailed.Wrap("the source sp can not be a target sp")
+		}
+	}
+	return nil
+}
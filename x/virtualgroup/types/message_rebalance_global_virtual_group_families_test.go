@@ -0,0 +1,91 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+)
+
+func TestMsgRebalanceGlobalVirtualGroupFamilies_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRebalanceGlobalVirtualGroupFamilies
+		err  error
+	}{
+		{
+			name: "valid all families",
+			msg: *NewMsgRebalanceGlobalVirtualGroupFamilies(
+				sample.RandAccAddress(),
+				1,
+				nil,
+				nil,
+			),
+		},
+		{
+			name: "valid specified families and targets",
+			msg: *NewMsgRebalanceGlobalVirtualGroupFamilies(
+				sample.RandAccAddress(),
+				1,
+				[]uint32{1, 2},
+				[]uint32{2, 3},
+			),
+		},
+		{
+			name: "invalid address",
+			msg: MsgRebalanceGlobalVirtualGroupFamilies{
+				Operator:          "invalid_address",
+				StorageProviderId: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "zero family id",
+			msg: MsgRebalanceGlobalVirtualGroupFamilies{
+				Operator:                    sample.RandAccAddressHex(),
+				StorageProviderId:           1,
+				GlobalVirtualGroupFamilyIds: []uint32{0},
+			},
+			err: ErrGVGFamilyNotExist,
+		},
+		{
+			name: "duplicate family ids",
+			msg: MsgRebalanceGlobalVirtualGroupFamilies{
+				Operator:                    sample.RandAccAddressHex(),
+				StorageProviderId:           1,
+				GlobalVirtualGroupFamilyIds: []uint32{1, 1},
+			},
+			err: ErrFamilyRebalanceFailed,
+		},
+		{
+			name: "too many families",
+			msg: MsgRebalanceGlobalVirtualGroupFamilies{
+				Operator:                    sample.RandAccAddressHex(),
+				StorageProviderId:           1,
+				GlobalVirtualGroupFamilyIds: make([]uint32, MaxFamiliesPerRebalance+1),
+			},
+			err: ErrInvalidGVGCount,
+		},
+		{
+			name: "source sp as target",
+			msg: MsgRebalanceGlobalVirtualGroupFamilies{
+				Operator:          sample.RandAccAddressHex(),
+				StorageProviderId: 1,
+				TargetSpIds:       []uint32{2, 1},
+			},
+			err: ErrFamilyRebalanceFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -23,6 +23,8 @@ var (
 	DefaultMaxStoreSizePerFamily             = uint64(64) * 1024 * 1024 * 1024 * 1024 //64T
 	DefaultSwapInValidityPeriod              = math.NewInt(60 * 60 * 24 * 7)          // 7 days
 	DefaultSPConcurrentExitNum               = math.NewInt(1)
+	DefaultRedundancyRepairPeriod            = uint64(0) // disabled
+	DefaultRedundancyRepairSlashAmount       = math.ZeroInt()
 
 	KeyDepositDenom                      = []byte("DepositDenom")
 	KeyGVGStakingPerBytes                = []byte("GVGStakingPerBytes")
@@ -30,6 +32,8 @@ var (
 	KeyMaxStoreSizePerFamily             = []byte("MaxStoreSizePerFamily")
 	KeySwapInValidityPeriod              = []byte("SwapInValidityPeriod")
 	KeySPConcurrentExitNum               = []byte("SPConcurrentExitNum")
+	KeyRedundancyRepairPeriod            = []byte("RedundancyRepairPeriod")
+	KeyRedundancyRepairSlashAmount       = []byte("RedundancyRepairSlashAmount")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -41,7 +45,8 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 // NewParams creates a new Params instance
 func NewParams(depositDenom string, gvgStakingPerBytes math.Int, maxGlobalVirtualGroupPerFamily uint32,
-	maxStoreSizePerFamily uint64, swapInValidityPeriod, spConcurrentExitNum math.Int) Params {
+	maxStoreSizePerFamily uint64, swapInValidityPeriod, spConcurrentExitNum math.Int,
+	redundancyRepairPeriod uint64, redundancyRepairSlashAmount math.Int) Params {
 	return Params{
 		DepositDenom:                      depositDenom,
 		GvgStakingPerBytes:                gvgStakingPerBytes,
@@ -49,6 +54,8 @@ func NewParams(depositDenom string, gvgStakingPerBytes math.Int, maxGlobalVirtua
 		MaxStoreSizePerFamily:             maxStoreSizePerFamily,
 		SwapInValidityPeriod:              &swapInValidityPeriod,
 		SpConcurrentExitNum:               &spConcurrentExitNum,
+		RedundancyRepairPeriod:            redundancyRepairPeriod,
+		RedundancyRepairSlashAmount:       &redundancyRepairSlashAmount,
 	}
 }
 
@@ -59,7 +66,9 @@ func DefaultParams() Params {
 		DefaultMaxGlobalVirtualGroupNumPerFamily,
 		DefaultMaxStoreSizePerFamily,
 		DefaultSwapInValidityPeriod,
-		DefaultSPConcurrentExitNum)
+		DefaultSPConcurrentExitNum,
+		DefaultRedundancyRepairPeriod,
+		DefaultRedundancyRepairSlashAmount)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -71,6 +80,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMaxStoreSizePerFamily, &p.MaxStoreSizePerFamily, validateMaxStoreSizePerFamily),
 		paramtypes.NewParamSetPair(KeySwapInValidityPeriod, &p.SwapInValidityPeriod, validateSwapInValidityPeriod),
 		paramtypes.NewParamSetPair(KeySPConcurrentExitNum, &p.SpConcurrentExitNum, validateSPConcurrentExitNum),
+		paramtypes.NewParamSetPair(KeyRedundancyRepairPeriod, &p.RedundancyRepairPeriod, validateRedundancyRepairPeriod),
+		paramtypes.NewParamSetPair(KeyRedundancyRepairSlashAmount, &p.RedundancyRepairSlashAmount, validateRedundancyRepairSlashAmount),
 	}
 }
 
@@ -94,6 +105,12 @@ func (p Params) Validate() error {
 	if err := validateSPConcurrentExitNum(p.SpConcurrentExitNum); err != nil {
 		return err
 	}
+	if err := validateRedundancyRepairPeriod(p.RedundancyRepairPeriod); err != nil {
+		return err
+	}
+	if err := validateRedundancyRepairSlashAmount(p.RedundancyRepairSlashAmount); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -184,3 +201,25 @@ func validateSPConcurrentExitNum(i interface{}) error {
 	}
 	return nil
 }
+
+func validateRedundancyRepairPeriod(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateRedundancyRepairSlashAmount(i interface{}) error {
+	v, ok := i.(*math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != nil && !v.IsNil() {
+		if v.IsNegative() {
+			return fmt.Errorf("redundancy repair slash amount can not be negative: %s", v)
+		}
+	}
+	return nil
+}
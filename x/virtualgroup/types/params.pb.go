@@ -41,6 +41,11 @@ type Params struct {
 	SwapInValidityPeriod *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=swap_in_validity_period,json=swapInValidityPeriod,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"swap_in_validity_period,omitempty"`
 	// the the number of sp allowed to exit concurrently.
 	SpConcurrentExitNum *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=sp_concurrent_exit_num,json=spConcurrentExitNum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"sp_concurrent_exit_num,omitempty"`
+	// the period in seconds to repair a gvg whose secondary sps are unhealthy once the repair is opened,
+	// 0 disables the repair workflow.
+	RedundancyRepairPeriod uint64 `protobuf:"varint,8,opt,name=redundancy_repair_period,json=redundancyRepairPeriod,proto3" json:"redundancy_repair_period,omitempty"`
+	// the amount slashed from each unhealthy secondary sp if a gvg is not repaired before the deadline, in the deposit denom.
+	RedundancyRepairSlashAmount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=redundancy_repair_slash_amount,json=redundancyRepairSlashAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"redundancy_repair_slash_amount,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -103,6 +108,13 @@ func (m *Params) GetMaxStoreSizePerFamily() uint64 {
 	return 0
 }
 
+func (m *Params) GetRedundancyRepairPeriod() uint64 {
+	if m != nil {
+		return m.RedundancyRepairPeriod
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "greenfield.virtualgroup.Params")
 }
@@ -112,38 +124,41 @@ func init() {
 }
 
 var fileDescriptor_d8ecf89dd5128885 = []byte{
-	// 481 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xc7, 0x6d, 0x08, 0x01, 0x4e, 0x74, 0x31, 0x2d, 0x75, 0x3b, 0x38, 0xe1, 0x45, 0x25, 0x4b,
-	0xe2, 0x01, 0x86, 0x0a, 0x31, 0x85, 0x97, 0x2a, 0x12, 0x8a, 0x22, 0x47, 0xea, 0xc0, 0x72, 0x3a,
-	0xdb, 0xd7, 0xeb, 0x29, 0xbe, 0x17, 0xdd, 0x9d, 0x83, 0xd3, 0x4f, 0xc1, 0xc8, 0xd8, 0x0f, 0xc1,
-	0x87, 0xe8, 0x58, 0x31, 0x21, 0x86, 0x0a, 0x25, 0x4b, 0x3f, 0x06, 0xba, 0xb3, 0x45, 0x82, 0x10,
-	0x0b, 0x9d, 0x6c, 0x3f, 0xfe, 0x3d, 0xbf, 0xfb, 0x3f, 0xa7, 0x3b, 0xf0, 0x8c, 0x28, 0x8c, 0xf9,
-	0x09, 0xc5, 0x45, 0x1e, 0xcf, 0xa9, 0x32, 0x25, 0x2a, 0x88, 0x12, 0xa5, 0x8c, 0x25, 0x52, 0x88,
-	0xe9, 0x81, 0x54, 0xc2, 0x88, 0x60, 0x77, 0x4d, 0x0d, 0x36, 0xa9, 0xfd, 0xbd, 0x4c, 0x68, 0x26,
-	0x34, 0x74, 0x58, 0x5c, 0x7f, 0xd4, 0x3d, 0xfb, 0xdb, 0x44, 0x10, 0x51, 0xd7, 0xed, 0x5b, 0x5d,
-	0x7d, 0x72, 0xdd, 0x02, 0xed, 0x89, 0x53, 0x07, 0x4f, 0xc1, 0x56, 0x8e, 0xa5, 0xd0, 0xd4, 0xc0,
-	0x1c, 0x73, 0xc1, 0x42, 0xbf, 0xeb, 0xf7, 0xee, 0x27, 0x0f, 0x9a, 0xe2, 0x5b, 0x5b, 0x0b, 0x04,
-	0xd8, 0x21, 0x73, 0x02, 0xb5, 0x41, 0x33, 0xca, 0x09, 0x94, 0x58, 0xc1, 0x74, 0x61, 0xb0, 0x0e,
-	0x6f, 0x59, 0x78, 0xf8, 0xfa, 0xe2, 0xaa, 0xe3, 0xfd, 0xb8, 0xea, 0x1c, 0x10, 0x6a, 0x4e, 0xcb,
-	0x74, 0x90, 0x09, 0xd6, 0xa4, 0x68, 0x1e, 0x7d, 0x9d, 0xcf, 0x62, 0xb3, 0x90, 0x58, 0x0f, 0x46,
-	0xdc, 0x7c, 0xfb, 0xda, 0x07, 0x4d, 0xc8, 0x11, 0x37, 0x49, 0x40, 0xe6, 0x64, 0x5a, 0x9b, 0x27,
-	0x58, 0x0d, 0xad, 0x37, 0x98, 0x80, 0x03, 0x86, 0x2a, 0x58, 0x88, 0x0c, 0x15, 0xb0, 0x99, 0x15,
-	0xba, 0x61, 0x21, 0x2f, 0x59, 0x1d, 0xa0, 0xcc, 0x66, 0xd8, 0x84, 0xb7, 0xbb, 0x7e, 0x6f, 0x2b,
-	0xe9, 0x32, 0x54, 0x7d, 0xb0, 0xf0, 0x71, 0xcd, 0x1e, 0x59, 0x74, 0x5c, 0x32, 0x2b, 0x74, 0x5c,
-	0x90, 0x80, 0xe7, 0xd6, 0x48, 0x0a, 0x91, 0xfe, 0x53, 0x79, 0x82, 0x18, 0x2d, 0x16, 0x61, 0xcb,
-	0x29, 0x1f, 0x33, 0x54, 0x1d, 0x39, 0xfa, 0x6f, 0xe7, 0x7b, 0x07, 0x06, 0x87, 0x60, 0xcf, 0x3a,
-	0xb5, 0x11, 0x0a, 0x43, 0x4d, 0xcf, 0xf0, 0xa6, 0xe5, 0x4e, 0xd7, 0xef, 0xb5, 0x92, 0x1d, 0x86,
-	0xaa, 0xa9, 0xfd, 0x3f, 0xa5, 0x67, 0x78, 0xdd, 0x29, 0xc0, 0xae, 0xfe, 0x84, 0x24, 0xa4, 0x1c,
-	0xce, 0x51, 0x41, 0x73, 0x6a, 0x16, 0xb6, 0x97, 0x8a, 0x3c, 0x6c, 0xbb, 0x2d, 0x3d, 0xfc, 0xef,
-	0xed, 0xdc, 0xb6, 0xe2, 0x11, 0x3f, 0x6e, 0xb4, 0x13, 0x67, 0x0d, 0x18, 0x78, 0xa4, 0x25, 0xcc,
-	0x04, 0xcf, 0x4a, 0xa5, 0x30, 0x37, 0x10, 0x57, 0xd4, 0xd8, 0xc1, 0xc3, 0xbb, 0x37, 0x5c, 0xef,
-	0xa1, 0x96, 0x6f, 0x7e, 0x6b, 0xdf, 0x55, 0xd4, 0x8c, 0x4b, 0xf6, 0xea, 0xde, 0x97, 0xf3, 0x8e,
-	0x77, 0x7d, 0xde, 0xf1, 0x87, 0xe3, 0x8b, 0x65, 0xe4, 0x5f, 0x2e, 0x23, 0xff, 0xe7, 0x32, 0xf2,
-	0x3f, 0xaf, 0x22, 0xef, 0x72, 0x15, 0x79, 0xdf, 0x57, 0x91, 0xf7, 0xf1, 0xe5, 0xc6, 0x72, 0x29,
-	0x4f, 0xfb, 0xd9, 0x29, 0xa2, 0x3c, 0xde, 0xb8, 0x09, 0xd5, 0x9f, 0x77, 0xc1, 0x05, 0x48, 0xdb,
-	0xee, 0x04, 0xbf, 0xf8, 0x15, 0x00, 0x00, 0xff, 0xff, 0x54, 0x16, 0x3f, 0x9d, 0x33, 0x03, 0x00,
-	0x00,
+	// 538 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0x63, 0x28, 0xa1, 0x5d, 0xd1, 0x8b, 0xe9, 0x87, 0x5b, 0x24, 0x27, 0x7c, 0xa8, 0xe4,
+	0x92, 0xe4, 0x00, 0x87, 0x08, 0x71, 0x21, 0x7c, 0x54, 0x91, 0x50, 0x14, 0x39, 0x52, 0x0f, 0x5c,
+	0x56, 0x6b, 0x7b, 0xbb, 0x59, 0xc5, 0xbb, 0x6b, 0xed, 0xae, 0x83, 0x5d, 0x89, 0x77, 0xe0, 0xc8,
+	0xb1, 0xbc, 0x03, 0x0f, 0xd1, 0x63, 0xc5, 0x09, 0x71, 0xa8, 0x50, 0x72, 0xe1, 0x31, 0xd0, 0xae,
+	0x0d, 0x09, 0x54, 0x5c, 0xda, 0x93, 0xbd, 0x33, 0xff, 0xf9, 0xcd, 0x7f, 0x46, 0x1a, 0xf0, 0x88,
+	0x48, 0x8c, 0xf9, 0x31, 0xc5, 0x49, 0xdc, 0x9d, 0x51, 0xa9, 0x33, 0x94, 0x10, 0x29, 0xb2, 0xb4,
+	0x9b, 0x22, 0x89, 0x98, 0xea, 0xa4, 0x52, 0x68, 0xe1, 0xee, 0x2e, 0x55, 0x9d, 0x55, 0xd5, 0xfe,
+	0x5e, 0x24, 0x14, 0x13, 0x0a, 0x5a, 0x59, 0xb7, 0x7c, 0x94, 0x35, 0xfb, 0x5b, 0x44, 0x10, 0x51,
+	0xc6, 0xcd, 0x5f, 0x19, 0x7d, 0xf0, 0xb9, 0x0e, 0xea, 0x23, 0x8b, 0x76, 0x1f, 0x82, 0xcd, 0x18,
+	0xa7, 0x42, 0x51, 0x0d, 0x63, 0xcc, 0x05, 0xf3, 0x9c, 0xa6, 0xd3, 0xda, 0x08, 0xee, 0x54, 0xc1,
+	0x57, 0x26, 0xe6, 0x0a, 0xb0, 0x4d, 0x66, 0x04, 0x2a, 0x8d, 0xa6, 0x94, 0x13, 0x98, 0x62, 0x09,
+	0xc3, 0x42, 0x63, 0xe5, 0xdd, 0x30, 0xe2, 0xfe, 0xf3, 0xb3, 0x8b, 0x46, 0xed, 0xfb, 0x45, 0xe3,
+	0x80, 0x50, 0x3d, 0xc9, 0xc2, 0x4e, 0x24, 0x58, 0xe5, 0xa2, 0xfa, 0xb4, 0x55, 0x3c, 0xed, 0xea,
+	0x22, 0xc5, 0xaa, 0x33, 0xe0, 0xfa, 0xeb, 0x97, 0x36, 0xa8, 0x4c, 0x0e, 0xb8, 0x0e, 0x5c, 0x32,
+	0x23, 0xe3, 0x92, 0x3c, 0xc2, 0xb2, 0x6f, 0xb8, 0xee, 0x08, 0x1c, 0x30, 0x94, 0xc3, 0x44, 0x44,
+	0x28, 0x81, 0xd5, 0xac, 0xd0, 0x0e, 0x0b, 0x79, 0xc6, 0x4a, 0x03, 0x59, 0x34, 0xc5, 0xda, 0xbb,
+	0xd9, 0x74, 0x5a, 0x9b, 0x41, 0x93, 0xa1, 0xfc, 0xad, 0x11, 0x1f, 0x95, 0xda, 0x43, 0x23, 0x1d,
+	0x66, 0xcc, 0x00, 0xad, 0xce, 0x0d, 0xc0, 0x63, 0x43, 0x24, 0x89, 0x08, 0xff, 0x8b, 0x3c, 0x46,
+	0x8c, 0x26, 0x85, 0xb7, 0x66, 0x91, 0xf7, 0x19, 0xca, 0x0f, 0xad, 0xfa, 0x32, 0xf3, 0x8d, 0x15,
+	0xba, 0x3d, 0xb0, 0x67, 0x98, 0x4a, 0x0b, 0x89, 0xa1, 0xa2, 0x27, 0x78, 0x95, 0x72, 0xab, 0xe9,
+	0xb4, 0xd6, 0x82, 0x6d, 0x86, 0xf2, 0xb1, 0xc9, 0x8f, 0xe9, 0x09, 0x5e, 0x56, 0x0a, 0xb0, 0xab,
+	0xde, 0xa3, 0x14, 0x52, 0x0e, 0x67, 0x28, 0xa1, 0x31, 0xd5, 0x85, 0xa9, 0xa5, 0x22, 0xf6, 0xea,
+	0x76, 0xa5, 0xbd, 0x2b, 0xaf, 0x73, 0xcb, 0x80, 0x07, 0xfc, 0xa8, 0xc2, 0x8e, 0x2c, 0xd5, 0x65,
+	0x60, 0x47, 0xa5, 0x30, 0x12, 0x3c, 0xca, 0xa4, 0xc4, 0x5c, 0x43, 0x9c, 0x53, 0x6d, 0x06, 0xf7,
+	0x6e, 0x5f, 0xb3, 0xdf, 0x5d, 0x95, 0xbe, 0xfc, 0x83, 0x7d, 0x9d, 0x53, 0x3d, 0xcc, 0x98, 0xdb,
+	0x03, 0x9e, 0xc4, 0x71, 0xc6, 0x63, 0xc4, 0xa3, 0x02, 0x4a, 0x9c, 0x22, 0x2a, 0x7f, 0x0f, 0xb8,
+	0x6e, 0x17, 0xb3, 0xb3, 0xcc, 0x07, 0x36, 0x5d, 0x19, 0xfd, 0x00, 0xfc, 0xcb, 0x95, 0x2a, 0x41,
+	0x6a, 0x02, 0x11, 0x13, 0x19, 0xd7, 0xde, 0xc6, 0x35, 0x0d, 0xdf, 0xfb, 0xb7, 0xf3, 0xd8, 0xd0,
+	0x5f, 0x58, 0xf8, 0xb3, 0xf5, 0x4f, 0xa7, 0x8d, 0xda, 0xcf, 0xd3, 0x86, 0xd3, 0x1f, 0x9e, 0xcd,
+	0x7d, 0xe7, 0x7c, 0xee, 0x3b, 0x3f, 0xe6, 0xbe, 0xf3, 0x71, 0xe1, 0xd7, 0xce, 0x17, 0x7e, 0xed,
+	0xdb, 0xc2, 0xaf, 0xbd, 0x7b, 0xba, 0xd2, 0x36, 0xe4, 0x61, 0x3b, 0x9a, 0x20, 0xca, 0xbb, 0x2b,
+	0x27, 0x9c, 0xff, 0x7d, 0xc4, 0xd6, 0x48, 0x58, 0xb7, 0xa7, 0xf7, 0xe4, 0x57, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0x87, 0xd5, 0xce, 0x62, 0xec, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -194,6 +209,16 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if !this.SpConcurrentExitNum.Equal(*that1.SpConcurrentExitNum) {
 		return false
 	}
+	if this.RedundancyRepairPeriod != that1.RedundancyRepairPeriod {
+		return false
+	}
+	if that1.RedundancyRepairSlashAmount == nil {
+		if this.RedundancyRepairSlashAmount != nil {
+			return false
+		}
+	} else if !this.RedundancyRepairSlashAmount.Equal(*that1.RedundancyRepairSlashAmount) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -216,6 +241,23 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RedundancyRepairSlashAmount != nil {
+		{
+			size := m.RedundancyRepairSlashAmount.Size()
+			i -= size
+			if _, err := m.RedundancyRepairSlashAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.RedundancyRepairPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RedundancyRepairPeriod))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.SpConcurrentExitNum != nil {
 		{
 			size := m.SpConcurrentExitNum.Size()
@@ -315,6 +357,13 @@ func (m *Params) Size() (n int) {
 		l = m.SpConcurrentExitNum.Size()
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.RedundancyRepairPeriod != 0 {
+		n += 1 + sovParams(uint64(m.RedundancyRepairPeriod))
+	}
+	if m.RedundancyRepairSlashAmount != nil {
+		l = m.RedundancyRepairSlashAmount.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -548,6 +597,61 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyRepairPeriod", wireType)
+			}
+			m.RedundancyRepairPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyRepairPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyRepairSlashAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.RedundancyRepairSlashAmount = &v
+			if err := m.RedundancyRepairSlashAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -150,6 +150,50 @@ func TestMaxStoreSizePerFamily(t *testing.T) {
 	}
 }
 
+func TestRedundancyRepairSlashAmount(t *testing.T) {
+	zero := math.ZeroInt()
+	positive := math.NewInt(1)
+	negative := math.NewInt(-1)
+	tests := []struct {
+		name   string
+		amount interface{}
+		err    string
+	}{
+		{
+			name:   "valid zero",
+			amount: &zero,
+		},
+		{
+			name:   "valid positive",
+			amount: &positive,
+		},
+		{
+			name:   "valid nil",
+			amount: (*math.Int)(nil),
+		},
+		{
+			name:   "invalid type",
+			amount: positive,
+			err:    "invalid parameter type",
+		},
+		{
+			name:   "invalid amount",
+			amount: &negative,
+			err:    "redundancy repair slash amount can not be negative",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRedundancyRepairSlashAmount(tt.amount)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestValidateParams(t *testing.T) {
 	err := DefaultParams().Validate()
 	require.NoError(t, err)
@@ -963,6 +963,196 @@ func (m *QuerySpOptimalGlobalVirtualGroupFamilyResponse) GetGlobalVirtualGroupFa
 	return 0
 }
 
+type QueryFamilyRebalanceRequest struct {
+	RebalanceId uint32 `protobuf:"varint,1,opt,name=rebalance_id,json=rebalanceId,proto3" json:"rebalance_id,omitempty"`
+}
+
+func (m *QueryFamilyRebalanceRequest) Reset()         { *m = QueryFamilyRebalanceRequest{} }
+func (m *QueryFamilyRebalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFamilyRebalanceRequest) ProtoMessage()    {}
+func (*QueryFamilyRebalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83cd53fc415e00e7, []int{20}
+}
+func (m *QueryFamilyRebalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFamilyRebalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFamilyRebalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFamilyRebalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFamilyRebalanceRequest.Merge(m, src)
+}
+func (m *QueryFamilyRebalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFamilyRebalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFamilyRebalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFamilyRebalanceRequest proto.InternalMessageInfo
+
+func (m *QueryFamilyRebalanceRequest) GetRebalanceId() uint32 {
+	if m != nil {
+		return m.RebalanceId
+	}
+	return 0
+}
+
+type QueryFamilyRebalanceResponse struct {
+	Rebalance *FamilyRebalance `protobuf:"bytes,1,opt,name=rebalance,proto3" json:"rebalance,omitempty"`
+}
+
+func (m *QueryFamilyRebalanceResponse) Reset()         { *m = QueryFamilyRebalanceResponse{} }
+func (m *QueryFamilyRebalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFamilyRebalanceResponse) ProtoMessage()    {}
+func (*QueryFamilyRebalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83cd53fc415e00e7, []int{21}
+}
+func (m *QueryFamilyRebalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFamilyRebalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFamilyRebalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFamilyRebalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFamilyRebalanceResponse.Merge(m, src)
+}
+func (m *QueryFamilyRebalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFamilyRebalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFamilyRebalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFamilyRebalanceResponse proto.InternalMessageInfo
+
+func (m *QueryFamilyRebalanceResponse) GetRebalance() *FamilyRebalance {
+	if m != nil {
+		return m.Rebalance
+	}
+	return nil
+}
+
+type QueryUnhealthyGlobalVirtualGroupsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUnhealthyGlobalVirtualGroupsRequest) Reset() {
+	*m = QueryUnhealthyGlobalVirtualGroupsRequest{}
+}
+func (m *QueryUnhealthyGlobalVirtualGroupsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnhealthyGlobalVirtualGroupsRequest) ProtoMessage()    {}
+func (*QueryUnhealthyGlobalVirtualGroupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83cd53fc415e00e7, []int{22}
+}
+func (m *QueryUnhealthyGlobalVirtualGroupsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnhealthyGlobalVirtualGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnhealthyGlobalVirtualGroupsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnhealthyGlobalVirtualGroupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnhealthyGlobalVirtualGroupsRequest.Merge(m, src)
+}
+func (m *QueryUnhealthyGlobalVirtualGroupsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnhealthyGlobalVirtualGroupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnhealthyGlobalVirtualGroupsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnhealthyGlobalVirtualGroupsRequest proto.InternalMessageInfo
+
+func (m *QueryUnhealthyGlobalVirtualGroupsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryUnhealthyGlobalVirtualGroupsResponse struct {
+	GlobalVirtualGroups []UnhealthyGlobalVirtualGroup `protobuf:"bytes,1,rep,name=global_virtual_groups,json=globalVirtualGroups,proto3" json:"global_virtual_groups"`
+	Pagination          *query.PageResponse           `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUnhealthyGlobalVirtualGroupsResponse) Reset() {
+	*m = QueryUnhealthyGlobalVirtualGroupsResponse{}
+}
+func (m *QueryUnhealthyGlobalVirtualGroupsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryUnhealthyGlobalVirtualGroupsResponse) ProtoMessage() {}
+func (*QueryUnhealthyGlobalVirtualGroupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83cd53fc415e00e7, []int{23}
+}
+func (m *QueryUnhealthyGlobalVirtualGroupsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnhealthyGlobalVirtualGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnhealthyGlobalVirtualGroupsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnhealthyGlobalVirtualGroupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnhealthyGlobalVirtualGroupsResponse.Merge(m, src)
+}
+func (m *QueryUnhealthyGlobalVirtualGroupsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnhealthyGlobalVirtualGroupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnhealthyGlobalVirtualGroupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnhealthyGlobalVirtualGroupsResponse proto.InternalMessageInfo
+
+func (m *QueryUnhealthyGlobalVirtualGroupsResponse) GetGlobalVirtualGroups() []UnhealthyGlobalVirtualGroup {
+	if m != nil {
+		return m.GlobalVirtualGroups
+	}
+	return nil
+}
+
+func (m *QueryUnhealthyGlobalVirtualGroupsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "greenfield.virtualgroup.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "greenfield.virtualgroup.QueryParamsResponse")
@@ -984,6 +1174,10 @@ func init() {
 	proto.RegisterType((*QuerySPAvailableGlobalVirtualGroupFamiliesResponse)(nil), "greenfield.virtualgroup.QuerySPAvailableGlobalVirtualGroupFamiliesResponse")
 	proto.RegisterType((*QuerySpOptimalGlobalVirtualGroupFamilyRequest)(nil), "greenfield.virtualgroup.QuerySpOptimalGlobalVirtualGroupFamilyRequest")
 	proto.RegisterType((*QuerySpOptimalGlobalVirtualGroupFamilyResponse)(nil), "greenfield.virtualgroup.QuerySpOptimalGlobalVirtualGroupFamilyResponse")
+	proto.RegisterType((*QueryFamilyRebalanceRequest)(nil), "greenfield.virtualgroup.QueryFamilyRebalanceRequest")
+	proto.RegisterType((*QueryFamilyRebalanceResponse)(nil), "greenfield.virtualgroup.QueryFamilyRebalanceResponse")
+	proto.RegisterType((*QueryUnhealthyGlobalVirtualGroupsRequest)(nil), "greenfield.virtualgroup.QueryUnhealthyGlobalVirtualGroupsRequest")
+	proto.RegisterType((*QueryUnhealthyGlobalVirtualGroupsResponse)(nil), "greenfield.virtualgroup.QueryUnhealthyGlobalVirtualGroupsResponse")
 }
 
 func init() {
@@ -991,77 +1185,87 @@ func init() {
 }
 
 var fileDescriptor_83cd53fc415e00e7 = []byte{
-	// 1120 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0x4f, 0x73, 0xdb, 0xc4,
-	0x1b, 0xc7, 0xb3, 0xf9, 0xb5, 0x99, 0xf6, 0x69, 0xf3, 0x03, 0x36, 0x81, 0x64, 0x94, 0x8c, 0x0d,
-	0x4a, 0xdb, 0x84, 0xb4, 0x91, 0x88, 0x49, 0x4a, 0x87, 0xe9, 0xbf, 0x38, 0x69, 0xdc, 0x90, 0x19,
-	0x08, 0x4e, 0x26, 0x9d, 0x61, 0x86, 0x11, 0x6b, 0x47, 0xde, 0xec, 0xc4, 0x96, 0x54, 0x4b, 0x76,
-	0x31, 0x27, 0xa6, 0xe7, 0x1e, 0x18, 0x7a, 0x82, 0x03, 0xaf, 0x81, 0x57, 0xc0, 0x39, 0xc7, 0xce,
-	0x70, 0x80, 0x13, 0x30, 0x09, 0x17, 0x78, 0x09, 0x9c, 0x18, 0xad, 0x56, 0xb1, 0x8d, 0xb5, 0xb2,
-	0xe4, 0xf8, 0xa6, 0x51, 0xf6, 0xf9, 0x3e, 0xdf, 0xcf, 0xb3, 0x8f, 0x9e, 0xdd, 0x18, 0xe6, 0x68,
-	0xdd, 0x34, 0xad, 0x0a, 0x33, 0xab, 0x07, 0x7a, 0x93, 0xd5, 0xbd, 0x06, 0xa9, 0xd2, 0xba, 0xdd,
-	0x70, 0xf4, 0xa7, 0x0d, 0xb3, 0xde, 0xd2, 0x9c, 0xba, 0xed, 0xd9, 0x78, 0xaa, 0xbd, 0x48, 0xeb,
-	0x5c, 0xa4, 0x2c, 0x96, 0x6d, 0xb7, 0x66, 0xbb, 0x7a, 0x89, 0xb8, 0x66, 0x10, 0xa1, 0x37, 0x97,
-	0x4b, 0xa6, 0x47, 0x96, 0x75, 0x87, 0x50, 0x66, 0x11, 0x8f, 0xd9, 0x56, 0x20, 0xa2, 0x4c, 0x52,
-	0x9b, 0xda, 0xfc, 0x51, 0xf7, 0x9f, 0xc4, 0xdb, 0x59, 0x6a, 0xdb, 0xb4, 0x6a, 0xea, 0xc4, 0x61,
-	0x3a, 0xb1, 0x2c, 0xdb, 0xe3, 0x21, 0xae, 0xf8, 0xeb, 0x35, 0x99, 0xbb, 0xb2, 0x5d, 0xab, 0x9d,
-	0x29, 0x4b, 0x57, 0x39, 0xa4, 0x4e, 0x6a, 0xa1, 0x96, 0x94, 0xd4, 0x6b, 0x39, 0xa6, 0x58, 0xa4,
-	0x4e, 0x02, 0xfe, 0xd4, 0xc7, 0xd8, 0xe1, 0x91, 0x45, 0xf3, 0x69, 0xc3, 0x74, 0x3d, 0x75, 0x0f,
-	0x26, 0xba, 0xde, 0xba, 0x8e, 0x6d, 0xb9, 0x26, 0xbe, 0x07, 0x63, 0x41, 0x86, 0x69, 0xf4, 0x36,
-	0x5a, 0xb8, 0x92, 0xcb, 0x6a, 0x92, 0x3a, 0x69, 0x41, 0x60, 0xfe, 0xc2, 0xf1, 0x6f, 0xd9, 0x91,
-	0xa2, 0x08, 0x52, 0x9f, 0x40, 0x86, 0xab, 0x16, 0xaa, 0x76, 0x89, 0x54, 0xf7, 0x83, 0xf5, 0x05,
-	0x7f, 0xbd, 0xc8, 0x8b, 0x57, 0x61, 0x8a, 0xf2, 0x3f, 0x1a, 0x42, 0xcd, 0xe0, 0x72, 0x06, 0x3b,
-	0xe0, 0x19, 0xc7, 0x8b, 0x93, 0xb4, 0x27, 0x76, 0xeb, 0x40, 0xfd, 0x1a, 0x41, 0x56, 0xaa, 0x2c,
-	0xbc, 0x7f, 0x0e, 0x93, 0x51, 0xd2, 0x82, 0xe4, 0xa6, 0x94, 0x24, 0x42, 0x12, 0xf7, 0x9a, 0x50,
-	0x2d, 0x58, 0x90, 0x38, 0xc8, 0xb7, 0x36, 0x49, 0x8d, 0x55, 0x5b, 0x5b, 0x1b, 0x21, 0x65, 0x1e,
-	0x32, 0x91, 0x94, 0x15, 0xbe, 0xae, 0x0d, 0xab, 0xf4, 0xe6, 0x11, 0x52, 0x07, 0xea, 0x0b, 0x04,
-	0xef, 0x26, 0x48, 0x28, 0xe0, 0x0d, 0x78, 0x33, 0x2a, 0xa3, 0xbf, 0x8f, 0xff, 0x4b, 0x4b, 0x3f,
-	0xd1, 0xeb, 0xca, 0x55, 0xd7, 0xe1, 0x9a, 0xc4, 0x4d, 0xe0, 0x25, 0x44, 0x9f, 0x81, 0xcb, 0xff,
-	0xa5, 0xbc, 0x54, 0x09, 0x99, 0xbe, 0x43, 0x70, 0xbd, 0x8f, 0x8a, 0xe0, 0x71, 0x60, 0x26, 0xa6,
-	0x82, 0x62, 0x4f, 0x97, 0x53, 0x50, 0x09, 0xfd, 0x69, 0x59, 0xc5, 0x55, 0x07, 0x6e, 0xc4, 0x59,
-	0x63, 0x66, 0xf8, 0xed, 0xe0, 0x4d, 0x80, 0xf6, 0x28, 0x10, 0x56, 0x6e, 0x68, 0xc1, 0xdc, 0xd0,
-	0xfc, 0xb9, 0xa1, 0x05, 0x93, 0x46, 0xcc, 0x0d, 0x6d, 0x87, 0x50, 0x53, 0xc4, 0x16, 0x3b, 0x22,
-	0xd5, 0x63, 0x04, 0xf3, 0x7d, 0x53, 0x8a, 0x7a, 0xec, 0xc1, 0x55, 0xda, 0xa4, 0x01, 0x3e, 0x33,
-	0xc3, 0x6d, 0x1d, 0xa0, 0x00, 0x57, 0x68, 0x93, 0x86, 0xea, 0xb8, 0xd0, 0x45, 0x32, 0xca, 0x49,
-	0xe6, 0xfb, 0x92, 0x04, 0x96, 0xba, 0x50, 0xea, 0xb0, 0xb8, 0xd6, 0x24, 0xac, 0x4a, 0x4a, 0x55,
-	0xb3, 0x7f, 0x01, 0x37, 0x20, 0x1b, 0xff, 0x79, 0x04, 0x7c, 0xe3, 0xc5, 0x19, 0xf9, 0xf7, 0xe1,
-	0xaa, 0x2e, 0xdc, 0x4c, 0x94, 0x53, 0x54, 0x70, 0x38, 0x49, 0x5f, 0x22, 0x78, 0x8b, 0xef, 0xd9,
-	0xee, 0x33, 0xe2, 0x6c, 0x59, 0x5b, 0x56, 0xc5, 0x1e, 0xe2, 0x47, 0x1f, 0x37, 0x1e, 0x47, 0x63,
-	0xc6, 0xe3, 0x17, 0x30, 0xd5, 0x63, 0x4a, 0x60, 0x3f, 0x82, 0xab, 0xee, 0x33, 0xe2, 0x18, 0xcc,
-	0x32, 0x98, 0x55, 0xb1, 0x45, 0xbb, 0xce, 0x49, 0x1b, 0xa7, 0x43, 0x02, 0xdc, 0xb3, 0x67, 0x35,
-	0x07, 0x33, 0x41, 0x86, 0x9d, 0xc2, 0x7e, 0x61, 0xd7, 0x3f, 0xd2, 0x5c, 0x8f, 0x95, 0xcf, 0x76,
-	0x74, 0x02, 0x2e, 0xba, 0x1d, 0x43, 0xfc, 0x82, 0xeb, 0xbb, 0x3a, 0x82, 0xd9, 0xe8, 0x18, 0x61,
-	0x6d, 0x1b, 0x2e, 0xfb, 0x3d, 0xed, 0x7a, 0xc4, 0x0b, 0xcf, 0x1b, 0x4d, 0xde, 0xd0, 0x9d, 0x12,
-	0x4f, 0x98, 0x77, 0xc8, 0xac, 0xdd, 0x9d, 0xe2, 0x25, 0xda, 0xa4, 0xfe, 0x6b, 0x57, 0x7d, 0x0c,
-	0xcb, 0x22, 0x59, 0x8a, 0x46, 0x8c, 0xb4, 0xfd, 0x15, 0xe4, 0xd2, 0x28, 0x0d, 0xb5, 0xbd, 0xbe,
-	0x47, 0xb0, 0x14, 0x24, 0x77, 0x3e, 0x71, 0x3c, 0x56, 0x23, 0xd5, 0x7e, 0xf3, 0x36, 0x0a, 0x01,
-	0xef, 0xc1, 0x1b, 0x0e, 0x2b, 0x1f, 0x19, 0x4d, 0x5a, 0x31, 0x5c, 0xaf, 0x4e, 0x3c, 0x93, 0xb6,
-	0x78, 0x03, 0xfd, 0x3f, 0xb7, 0x20, 0x3f, 0xd1, 0x59, 0xf9, 0x68, 0xbf, 0xb0, 0xb9, 0x2b, 0xd6,
-	0x17, 0x5f, 0xf3, 0x25, 0xf6, 0x69, 0x25, 0x7c, 0xa1, 0x7a, 0xa0, 0x25, 0xf5, 0x26, 0x8a, 0x32,
-	0x84, 0x4f, 0x22, 0xf7, 0xf7, 0xeb, 0x70, 0x91, 0xa7, 0xc5, 0x2f, 0x10, 0x8c, 0x05, 0xd7, 0x0e,
-	0x2c, 0x3f, 0xcf, 0x7a, 0xef, 0x3a, 0xca, 0xad, 0x64, 0x8b, 0x03, 0xcf, 0xea, 0xfc, 0xf3, 0x9f,
-	0xff, 0x7c, 0x39, 0xfa, 0x0e, 0xce, 0xea, 0xf1, 0x77, 0x30, 0xfc, 0x13, 0x02, 0xdc, 0x5b, 0x01,
-	0xfc, 0x41, 0x7c, 0x36, 0xe9, 0xd5, 0x48, 0xb9, 0x93, 0x3e, 0x50, 0x58, 0x5e, 0xe5, 0x96, 0x75,
-	0xbc, 0x24, 0xb5, 0x1c, 0xb5, 0x0b, 0xf8, 0x2f, 0x04, 0xb3, 0x71, 0x97, 0x0b, 0xbc, 0x96, 0xd6,
-	0x51, 0xcf, 0x4d, 0x48, 0xc9, 0x9f, 0x47, 0x42, 0xe0, 0xe5, 0x39, 0xde, 0x5d, 0xfc, 0x61, 0x2a,
-	0x3c, 0xa3, 0xd4, 0x6a, 0xf7, 0x19, 0xfe, 0x05, 0xc1, 0xb4, 0xac, 0x5d, 0xf1, 0xbd, 0xb4, 0x26,
-	0xbb, 0x3e, 0x41, 0xe5, 0xfe, 0xa0, 0xe1, 0x82, 0xef, 0x2e, 0xe7, 0xbb, 0x8d, 0x57, 0xd2, 0xf1,
-	0x05, 0x70, 0xf8, 0x77, 0x04, 0x8a, 0x7c, 0x3e, 0xe1, 0x07, 0x03, 0x99, 0x6b, 0xcf, 0x48, 0xe5,
-	0xe1, 0xe0, 0x02, 0x82, 0xef, 0x3e, 0xe7, 0xbb, 0x83, 0x6f, 0x0f, 0xc0, 0xe7, 0x23, 0xfc, 0x83,
-	0x60, 0x2e, 0xc1, 0x28, 0xc6, 0xeb, 0x52, 0xa7, 0xc9, 0x8f, 0x04, 0x65, 0xe3, 0x7c, 0x22, 0x02,
-	0xf9, 0x31, 0x47, 0xce, 0xe3, 0x87, 0x52, 0x64, 0x12, 0xaa, 0x19, 0xf1, 0xf0, 0x3f, 0x20, 0x80,
-	0xf6, 0x99, 0x8c, 0xf5, 0xf8, 0xdd, 0xe8, 0xb9, 0x95, 0x28, 0xef, 0x25, 0x0f, 0x10, 0xde, 0x97,
-	0xb8, 0xf7, 0x79, 0x7c, 0x5d, 0xea, 0xbd, 0xf3, 0x42, 0x81, 0x7f, 0x44, 0x30, 0xde, 0x75, 0x38,
-	0xe3, 0x95, 0x3e, 0x29, 0x23, 0xaf, 0x10, 0xca, 0x6a, 0xca, 0x28, 0xe1, 0x36, 0xc7, 0xdd, 0xde,
-	0xc2, 0x8b, 0x72, 0xb7, 0x8e, 0x11, 0x5e, 0x33, 0x84, 0xc1, 0x6f, 0x47, 0x61, 0x51, 0x9c, 0x64,
-	0x49, 0xfa, 0xea, 0xa3, 0x7e, 0xce, 0x52, 0xb4, 0xd7, 0xf6, 0x50, 0xb4, 0x04, 0xfb, 0x36, 0x67,
-	0x7f, 0x84, 0xd7, 0xe3, 0xd8, 0x93, 0x36, 0xda, 0xf3, 0x51, 0xf1, 0x0f, 0x50, 0xdf, 0xe3, 0x1d,
-	0x6f, 0xf6, 0x81, 0x48, 0x78, 0x77, 0x51, 0x0a, 0xe7, 0xd6, 0x11, 0x85, 0x28, 0xf0, 0x42, 0xac,
-	0xe1, 0x07, 0x71, 0x85, 0xb0, 0x03, 0xb1, 0x98, 0x32, 0xb4, 0xf2, 0x1f, 0x1f, 0x9f, 0x64, 0xd0,
-	0xab, 0x93, 0x0c, 0xfa, 0xe3, 0x24, 0x83, 0xbe, 0x39, 0xcd, 0x8c, 0xbc, 0x3a, 0xcd, 0x8c, 0xfc,
-	0x7a, 0x9a, 0x19, 0xf9, 0x6c, 0x85, 0x32, 0xef, 0xb0, 0x51, 0xd2, 0xca, 0x76, 0x4d, 0x2f, 0x59,
-	0xa5, 0xa5, 0xf2, 0x21, 0x61, 0x56, 0x67, 0xba, 0x2f, 0x23, 0x7e, 0x82, 0x29, 0x8d, 0xf1, 0xdf,
-	0x60, 0xde, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0x6a, 0x1e, 0x4e, 0x52, 0x94, 0x12, 0x00, 0x00,
+	// 1269 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xdf, 0x4f, 0x1c, 0x55,
+	0x14, 0x66, 0xb0, 0x6d, 0xda, 0x53, 0xb0, 0xf1, 0x82, 0x42, 0x06, 0xb2, 0xd8, 0xa1, 0x2d, 0x48,
+	0xcb, 0x8e, 0xac, 0x50, 0x49, 0xd3, 0x1f, 0xb0, 0x50, 0xb6, 0x48, 0xa2, 0xb8, 0x20, 0x4d, 0x4c,
+	0xcc, 0x78, 0x77, 0x99, 0x1d, 0x26, 0xcc, 0xce, 0x4c, 0x77, 0x66, 0xb7, 0xae, 0xc6, 0xc4, 0xf4,
+	0xb9, 0x0f, 0xc6, 0x3e, 0x18, 0x7d, 0xf0, 0x6f, 0xf0, 0x2f, 0x30, 0x3e, 0xf2, 0xd8, 0xc4, 0x07,
+	0x7d, 0x52, 0x03, 0x4d, 0x8c, 0xff, 0x82, 0x4f, 0x66, 0xee, 0x9c, 0xd9, 0x5d, 0xd8, 0xb9, 0xf3,
+	0x03, 0xf6, 0x6d, 0x32, 0xdc, 0xf3, 0x9d, 0xef, 0x3b, 0xf7, 0xcc, 0x39, 0x1f, 0x0b, 0x93, 0x5a,
+	0x4d, 0x55, 0xcd, 0x8a, 0xae, 0x1a, 0xbb, 0x72, 0x43, 0xaf, 0xb9, 0x75, 0x6a, 0x68, 0x35, 0xab,
+	0x6e, 0xcb, 0x4f, 0xea, 0x6a, 0xad, 0x99, 0xb5, 0x6b, 0x96, 0x6b, 0x91, 0x91, 0xf6, 0xa1, 0x6c,
+	0xe7, 0x21, 0x71, 0xa6, 0x6c, 0x39, 0x55, 0xcb, 0x91, 0x4b, 0xd4, 0x51, 0xfd, 0x08, 0xb9, 0x31,
+	0x57, 0x52, 0x5d, 0x3a, 0x27, 0xdb, 0x54, 0xd3, 0x4d, 0xea, 0xea, 0x96, 0xe9, 0x83, 0x88, 0xc3,
+	0x9a, 0xa5, 0x59, 0xec, 0x51, 0xf6, 0x9e, 0xf0, 0xed, 0xb8, 0x66, 0x59, 0x9a, 0xa1, 0xca, 0xd4,
+	0xd6, 0x65, 0x6a, 0x9a, 0x96, 0xcb, 0x42, 0x1c, 0xfc, 0xeb, 0x35, 0x1e, 0xbb, 0xb2, 0x55, 0xad,
+	0xb6, 0x90, 0xb9, 0xa7, 0x6c, 0x5a, 0xa3, 0xd5, 0x00, 0x8b, 0xab, 0xd4, 0x6d, 0xda, 0x2a, 0x1e,
+	0x92, 0x86, 0x81, 0x7c, 0xec, 0xc9, 0xd8, 0x64, 0x91, 0x45, 0xf5, 0x49, 0x5d, 0x75, 0x5c, 0x69,
+	0x1b, 0x86, 0x8e, 0xbd, 0x75, 0x6c, 0xcb, 0x74, 0x54, 0x72, 0x0f, 0x2e, 0xf8, 0x19, 0x46, 0x85,
+	0xb7, 0x85, 0xe9, 0xcb, 0xb9, 0x89, 0x2c, 0xa7, 0x4e, 0x59, 0x3f, 0x30, 0x7f, 0xee, 0xe0, 0xcf,
+	0x89, 0xbe, 0x22, 0x06, 0x49, 0x8f, 0x21, 0xc3, 0x50, 0x0b, 0x86, 0x55, 0xa2, 0xc6, 0x8e, 0x7f,
+	0xbe, 0xe0, 0x9d, 0xc7, 0xbc, 0x64, 0x01, 0x46, 0x34, 0xf6, 0x47, 0x05, 0xd1, 0x14, 0x06, 0xa7,
+	0xe8, 0xbb, 0x2c, 0xe3, 0x60, 0x71, 0x58, 0xeb, 0x8a, 0x5d, 0xdf, 0x95, 0xbe, 0x11, 0x60, 0x82,
+	0x8b, 0x8c, 0xdc, 0x3f, 0x83, 0xe1, 0x30, 0x68, 0x54, 0x72, 0x93, 0xab, 0x24, 0x04, 0x92, 0x74,
+	0x93, 0x90, 0x4c, 0x98, 0xe6, 0x30, 0xc8, 0x37, 0xd7, 0x68, 0x55, 0x37, 0x9a, 0xeb, 0xab, 0x81,
+	0xca, 0x3c, 0x64, 0x42, 0x55, 0x56, 0xd8, 0xb9, 0xb6, 0x58, 0xb1, 0x3b, 0x0f, 0x42, 0xed, 0x4a,
+	0xcf, 0x05, 0x78, 0x27, 0x41, 0x42, 0x14, 0xaf, 0xc0, 0x9b, 0x61, 0x19, 0xbd, 0x7b, 0x7c, 0x2d,
+	0xad, 0xfa, 0xa1, 0x6e, 0x56, 0x8e, 0xb4, 0x02, 0xd7, 0x38, 0x6c, 0x7c, 0x2e, 0x81, 0xf4, 0x31,
+	0xb8, 0x74, 0x52, 0xe5, 0xc5, 0x4a, 0xa0, 0xe9, 0x07, 0x01, 0xae, 0xc7, 0xa0, 0xa0, 0x1e, 0x1b,
+	0xc6, 0x22, 0x2a, 0x88, 0x77, 0x3a, 0x97, 0x42, 0x15, 0xe2, 0x8f, 0xf2, 0x2a, 0x2e, 0xd9, 0x70,
+	0x23, 0x8a, 0x9a, 0xae, 0x06, 0xdf, 0x0e, 0x59, 0x03, 0x68, 0x8f, 0x02, 0xa4, 0x72, 0x23, 0xeb,
+	0xcf, 0x8d, 0xac, 0x37, 0x37, 0xb2, 0xfe, 0xa4, 0xc1, 0xb9, 0x91, 0xdd, 0xa4, 0x9a, 0x8a, 0xb1,
+	0xc5, 0x8e, 0x48, 0xe9, 0x40, 0x80, 0xa9, 0xd8, 0x94, 0x58, 0x8f, 0x6d, 0x18, 0xd0, 0x1a, 0x9a,
+	0x2f, 0x5f, 0x57, 0x83, 0x6b, 0x3d, 0x45, 0x01, 0x2e, 0x6b, 0x0d, 0x2d, 0x40, 0x27, 0x85, 0x63,
+	0x4a, 0xfa, 0x99, 0x92, 0xa9, 0x58, 0x25, 0x3e, 0xa5, 0x63, 0x52, 0x6a, 0x30, 0xb3, 0xdc, 0xa0,
+	0xba, 0x41, 0x4b, 0x86, 0x1a, 0x5f, 0xc0, 0x55, 0x98, 0x88, 0xfe, 0x3c, 0x7c, 0x7d, 0x83, 0xc5,
+	0x31, 0xfe, 0xf7, 0xe1, 0x48, 0x0e, 0xdc, 0x4c, 0x94, 0x13, 0x2b, 0xd8, 0x9b, 0xa4, 0x2f, 0x04,
+	0x78, 0x8b, 0xdd, 0xd9, 0xd6, 0x53, 0x6a, 0xaf, 0x9b, 0xeb, 0x66, 0xc5, 0xea, 0xe1, 0x47, 0x1f,
+	0x35, 0x1e, 0xfb, 0x23, 0xc6, 0xe3, 0xe7, 0x30, 0xd2, 0x45, 0x0a, 0x65, 0x3f, 0x84, 0x01, 0xe7,
+	0x29, 0xb5, 0x15, 0xdd, 0x54, 0x74, 0xb3, 0x62, 0x61, 0xbb, 0x4e, 0x72, 0x1b, 0xa7, 0x03, 0x02,
+	0x9c, 0xd6, 0xb3, 0x94, 0x83, 0x31, 0x3f, 0xc3, 0x66, 0x61, 0xa7, 0xb0, 0xe5, 0xad, 0x34, 0xc7,
+	0xd5, 0xcb, 0xad, 0x1b, 0x1d, 0x82, 0xf3, 0x4e, 0xc7, 0x10, 0x3f, 0xe7, 0x78, 0xac, 0xf6, 0x61,
+	0x3c, 0x3c, 0x06, 0xa9, 0x6d, 0xc0, 0x25, 0xaf, 0xa7, 0x1d, 0x97, 0xba, 0xc1, 0xbe, 0xc9, 0xf2,
+	0x1b, 0xba, 0x13, 0xe2, 0xb1, 0xee, 0xee, 0xe9, 0xe6, 0xd6, 0x66, 0xf1, 0xa2, 0xd6, 0xd0, 0xbc,
+	0xd7, 0x8e, 0xf4, 0x08, 0xe6, 0x30, 0x59, 0x8a, 0x46, 0x0c, 0xa5, 0xfd, 0x25, 0xe4, 0xd2, 0x20,
+	0xf5, 0xb4, 0xbd, 0x7e, 0x14, 0x60, 0xd6, 0x4f, 0x6e, 0x7f, 0x64, 0xbb, 0x7a, 0x95, 0x1a, 0x71,
+	0xf3, 0x36, 0x4c, 0x02, 0xd9, 0x86, 0x37, 0x6c, 0xbd, 0xbc, 0xaf, 0x34, 0xb4, 0x8a, 0xe2, 0xb8,
+	0x35, 0xea, 0xaa, 0x5a, 0x93, 0x35, 0xd0, 0xeb, 0xb9, 0x69, 0xfe, 0x46, 0xd7, 0xcb, 0xfb, 0x3b,
+	0x85, 0xb5, 0x2d, 0x3c, 0x5f, 0xbc, 0xe2, 0x41, 0xec, 0x68, 0x95, 0xe0, 0x85, 0xe4, 0x42, 0x36,
+	0x29, 0x37, 0x2c, 0x4a, 0x2f, 0xf6, 0xe0, 0x12, 0x76, 0x5e, 0x00, 0x5d, 0xa2, 0x06, 0x35, 0xcb,
+	0xc1, 0x40, 0x25, 0x57, 0x61, 0xa0, 0x16, 0xbc, 0x6b, 0x03, 0x5e, 0x6e, 0xbd, 0x5b, 0xdf, 0x95,
+	0x2a, 0xd8, 0x87, 0x5d, 0x08, 0xc8, 0x72, 0x0d, 0x2e, 0xb5, 0x8e, 0x63, 0x1f, 0xf2, 0xab, 0x74,
+	0x12, 0xa4, 0x1d, 0x2a, 0xd5, 0xd0, 0x21, 0x7c, 0x62, 0xee, 0xa9, 0xd4, 0x70, 0xf7, 0x42, 0xe6,
+	0x7a, 0xcf, 0x77, 0xc8, 0xab, 0xc0, 0x25, 0x44, 0x27, 0x45, 0xa5, 0x66, 0xb4, 0x4b, 0x98, 0xe7,
+	0xaa, 0x8e, 0x40, 0x47, 0x0b, 0x18, 0x66, 0x1a, 0x7a, 0xb6, 0x5f, 0x72, 0xdf, 0x0f, 0xc3, 0x79,
+	0x26, 0x93, 0x3c, 0x17, 0xe0, 0x82, 0xef, 0x3d, 0x09, 0xdf, 0xd4, 0x74, 0x1b, 0x5e, 0xf1, 0x56,
+	0xb2, 0xc3, 0x7e, 0x6e, 0x69, 0xea, 0xd9, 0x6f, 0xaf, 0x5e, 0xf4, 0x5f, 0x25, 0x13, 0x72, 0xb4,
+	0x11, 0x27, 0xbf, 0x08, 0x40, 0xba, 0x6b, 0x42, 0xde, 0x8f, 0xce, 0xc6, 0xf5, 0xc7, 0xe2, 0x62,
+	0xfa, 0x40, 0xa4, 0xbc, 0xc0, 0x28, 0xcb, 0x64, 0x96, 0x4b, 0x39, 0xec, 0xea, 0xc9, 0xbf, 0x02,
+	0x8c, 0x47, 0x39, 0x4c, 0xb2, 0x9c, 0x96, 0x51, 0x97, 0x1d, 0x16, 0xf3, 0x67, 0x81, 0x40, 0x79,
+	0x79, 0x26, 0xef, 0x2e, 0xb9, 0x93, 0x4a, 0x9e, 0x52, 0x6a, 0xb6, 0x87, 0x0d, 0xf9, 0x5d, 0x80,
+	0x51, 0xde, 0xcc, 0x22, 0xf7, 0xd2, 0x92, 0x3c, 0x36, 0x87, 0xc5, 0xfb, 0xa7, 0x0d, 0x47, 0x7d,
+	0x77, 0x99, 0xbe, 0xdb, 0x64, 0x3e, 0x9d, 0x3e, 0x5f, 0x1c, 0xf9, 0x4b, 0x00, 0x91, 0xbf, 0xa4,
+	0xc8, 0x83, 0x53, 0x91, 0x6b, 0x2f, 0x4a, 0x71, 0xe9, 0xf4, 0x00, 0xa8, 0xef, 0x3e, 0xd3, 0xb7,
+	0x48, 0x6e, 0x9f, 0x42, 0x9f, 0x27, 0xe1, 0x3f, 0x01, 0x26, 0x13, 0xec, 0x63, 0xb2, 0xc2, 0x65,
+	0x9a, 0xdc, 0x17, 0x88, 0xab, 0x67, 0x03, 0x41, 0xc9, 0x8f, 0x98, 0xe4, 0x3c, 0x59, 0xe2, 0x4a,
+	0xa6, 0x01, 0x9a, 0x12, 0x2d, 0xfe, 0x27, 0x01, 0xa0, 0x6d, 0xcc, 0x88, 0x1c, 0x7d, 0x1b, 0x5d,
+	0xd6, 0x54, 0x7c, 0x37, 0x79, 0x00, 0x72, 0x9f, 0x65, 0xdc, 0xa7, 0xc8, 0x75, 0x2e, 0xf7, 0x4e,
+	0x57, 0x49, 0x7e, 0x16, 0x60, 0xf0, 0x98, 0x43, 0x23, 0xf3, 0x31, 0x29, 0x43, 0x7d, 0xa4, 0xb8,
+	0x90, 0x32, 0x0a, 0xd9, 0xe6, 0x18, 0xdb, 0x5b, 0x64, 0x86, 0xcf, 0xd6, 0x56, 0x02, 0xaf, 0x89,
+	0x04, 0xbf, 0xeb, 0x87, 0x19, 0xb4, 0x33, 0x49, 0xfa, 0xea, 0x83, 0x38, 0x66, 0x29, 0xda, 0x6b,
+	0xa3, 0x27, 0x58, 0xa8, 0x7d, 0x83, 0x69, 0x7f, 0x48, 0x56, 0xa2, 0xb4, 0x27, 0x6d, 0xb4, 0x67,
+	0xfd, 0xf8, 0x5f, 0x70, 0xac, 0xc7, 0x23, 0x6b, 0x31, 0x22, 0x12, 0x1a, 0x58, 0xb1, 0x70, 0x66,
+	0x1c, 0x2c, 0x44, 0x81, 0x15, 0x62, 0x99, 0x3c, 0x88, 0x2a, 0x84, 0xe5, 0x83, 0x29, 0x51, 0xc3,
+	0xf4, 0x57, 0x01, 0xae, 0x9c, 0xb0, 0x79, 0x71, 0xed, 0x1c, 0x6e, 0x4e, 0xe3, 0xda, 0x99, 0x63,
+	0x48, 0xa5, 0x25, 0xa6, 0xe4, 0x0e, 0x59, 0xe4, 0x2a, 0xc1, 0x9d, 0xd6, 0xf2, 0x9e, 0xf2, 0x57,
+	0x9d, 0x26, 0xf8, 0x6b, 0xf2, 0x8f, 0x00, 0xe3, 0x51, 0x8e, 0x30, 0x6e, 0xab, 0x27, 0xb0, 0xb0,
+	0x71, 0x5b, 0x3d, 0x89, 0x21, 0x4d, 0xa0, 0xb4, 0x1e, 0xc0, 0x84, 0x5e, 0x99, 0x93, 0xff, 0xf0,
+	0xe0, 0x30, 0x23, 0xbc, 0x3c, 0xcc, 0x08, 0x7f, 0x1f, 0x66, 0x84, 0x6f, 0x8f, 0x32, 0x7d, 0x2f,
+	0x8f, 0x32, 0x7d, 0x7f, 0x1c, 0x65, 0xfa, 0x3e, 0x9d, 0xd7, 0x74, 0x77, 0xaf, 0x5e, 0xca, 0x96,
+	0xad, 0xaa, 0x5c, 0x32, 0x4b, 0xb3, 0xe5, 0x3d, 0xaa, 0x9b, 0x9d, 0x79, 0xbe, 0x08, 0xf9, 0xd1,
+	0xb4, 0x74, 0x81, 0xfd, 0x6a, 0xfa, 0xde, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x62, 0x32, 0x89,
+	0x41, 0x46, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1096,6 +1300,10 @@ type QueryClient interface {
 	QuerySpAvailableGlobalVirtualGroupFamilies(ctx context.Context, in *QuerySPAvailableGlobalVirtualGroupFamiliesRequest, opts ...grpc.CallOption) (*QuerySPAvailableGlobalVirtualGroupFamiliesResponse, error)
 	// QuerySpOptimalGlobalVirtualGroupFamily filters the optimal GlobalVirtualGroupFamily under a certain SP that is qualified to create a bucket on
 	QuerySpOptimalGlobalVirtualGroupFamily(ctx context.Context, in *QuerySpOptimalGlobalVirtualGroupFamilyRequest, opts ...grpc.CallOption) (*QuerySpOptimalGlobalVirtualGroupFamilyResponse, error)
+	// FamilyRebalance queries the progress of a global virtual group family rebalance
+	FamilyRebalance(ctx context.Context, in *QueryFamilyRebalanceRequest, opts ...grpc.CallOption) (*QueryFamilyRebalanceResponse, error)
+	// UnhealthyGlobalVirtualGroups lists the global virtual groups whose secondary sps are jailed, exiting or in maintenance
+	UnhealthyGlobalVirtualGroups(ctx context.Context, in *QueryUnhealthyGlobalVirtualGroupsRequest, opts ...grpc.CallOption) (*QueryUnhealthyGlobalVirtualGroupsResponse, error)
 }
 
 type queryClient struct {
@@ -1196,6 +1404,24 @@ func (c *queryClient) QuerySpOptimalGlobalVirtualGroupFamily(ctx context.Context
 	return out, nil
 }
 
+func (c *queryClient) FamilyRebalance(ctx context.Context, in *QueryFamilyRebalanceRequest, opts ...grpc.CallOption) (*QueryFamilyRebalanceResponse, error) {
+	out := new(QueryFamilyRebalanceResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Query/FamilyRebalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnhealthyGlobalVirtualGroups(ctx context.Context, in *QueryUnhealthyGlobalVirtualGroupsRequest, opts ...grpc.CallOption) (*QueryUnhealthyGlobalVirtualGroupsResponse, error) {
+	out := new(QueryUnhealthyGlobalVirtualGroupsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Query/UnhealthyGlobalVirtualGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -1218,6 +1444,10 @@ type QueryServer interface {
 	QuerySpAvailableGlobalVirtualGroupFamilies(context.Context, *QuerySPAvailableGlobalVirtualGroupFamiliesRequest) (*QuerySPAvailableGlobalVirtualGroupFamiliesResponse, error)
 	// QuerySpOptimalGlobalVirtualGroupFamily filters the optimal GlobalVirtualGroupFamily under a certain SP that is qualified to create a bucket on
 	QuerySpOptimalGlobalVirtualGroupFamily(context.Context, *QuerySpOptimalGlobalVirtualGroupFamilyRequest) (*QuerySpOptimalGlobalVirtualGroupFamilyResponse, error)
+	// FamilyRebalance queries the progress of a global virtual group family rebalance
+	FamilyRebalance(context.Context, *QueryFamilyRebalanceRequest) (*QueryFamilyRebalanceResponse, error)
+	// UnhealthyGlobalVirtualGroups lists the global virtual groups whose secondary sps are jailed, exiting or in maintenance
+	UnhealthyGlobalVirtualGroups(context.Context, *QueryUnhealthyGlobalVirtualGroupsRequest) (*QueryUnhealthyGlobalVirtualGroupsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1254,6 +1484,12 @@ func (*UnimplementedQueryServer) QuerySpAvailableGlobalVirtualGroupFamilies(ctx
 func (*UnimplementedQueryServer) QuerySpOptimalGlobalVirtualGroupFamily(ctx context.Context, req *QuerySpOptimalGlobalVirtualGroupFamilyRequest) (*QuerySpOptimalGlobalVirtualGroupFamilyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QuerySpOptimalGlobalVirtualGroupFamily not implemented")
 }
+func (*UnimplementedQueryServer) FamilyRebalance(ctx context.Context, req *QueryFamilyRebalanceRequest) (*QueryFamilyRebalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FamilyRebalance not implemented")
+}
+func (*UnimplementedQueryServer) UnhealthyGlobalVirtualGroups(ctx context.Context, req *QueryUnhealthyGlobalVirtualGroupsRequest) (*QueryUnhealthyGlobalVirtualGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnhealthyGlobalVirtualGroups not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1439,6 +1675,42 @@ func _Query_QuerySpOptimalGlobalVirtualGroupFamily_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FamilyRebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFamilyRebalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FamilyRebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.virtualgroup.Query/FamilyRebalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FamilyRebalance(ctx, req.(*QueryFamilyRebalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnhealthyGlobalVirtualGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnhealthyGlobalVirtualGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnhealthyGlobalVirtualGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.virtualgroup.Query/UnhealthyGlobalVirtualGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnhealthyGlobalVirtualGroups(ctx, req.(*QueryUnhealthyGlobalVirtualGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "greenfield.virtualgroup.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1483,6 +1755,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QuerySpOptimalGlobalVirtualGroupFamily",
 			Handler:    _Query_QuerySpOptimalGlobalVirtualGroupFamily_Handler,
 		},
+		{
+			MethodName: "FamilyRebalance",
+			Handler:    _Query_FamilyRebalance_Handler,
+		},
+		{
+			MethodName: "UnhealthyGlobalVirtualGroups",
+			Handler:    _Query_UnhealthyGlobalVirtualGroups_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "greenfield/virtualgroup/query.proto",
@@ -2162,90 +2442,237 @@ func (m *QuerySpOptimalGlobalVirtualGroupFamilyResponse) MarshalToSizedBuffer(dA
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFamilyRebalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryFamilyRebalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGlobalVirtualGroupRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFamilyRebalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GlobalVirtualGroupId != 0 {
-		n += 1 + sovQuery(uint64(m.GlobalVirtualGroupId))
+	if m.RebalanceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RebalanceId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGlobalVirtualGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GlobalVirtualGroup != nil {
-		l = m.GlobalVirtualGroup.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFamilyRebalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGlobalVirtualGroupByFamilyIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovQuery(uint64(m.GlobalVirtualGroupFamilyId))
-	}
-	return n
+func (m *QueryFamilyRebalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGlobalVirtualGroupByFamilyIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFamilyRebalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.GlobalVirtualGroups) > 0 {
-		for _, e := range m.GlobalVirtualGroups {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Rebalance != nil {
+		{
+			size, err := m.Rebalance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGlobalVirtualGroupFamilyRequest) Size() (n int) {
+func (m *QueryUnhealthyGlobalVirtualGroupsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnhealthyGlobalVirtualGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnhealthyGlobalVirtualGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnhealthyGlobalVirtualGroupsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnhealthyGlobalVirtualGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnhealthyGlobalVirtualGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GlobalVirtualGroups) > 0 {
+		for iNdEx := len(m.GlobalVirtualGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GlobalVirtualGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GlobalVirtualGroupId))
+	}
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroup != nil {
+		l = m.GlobalVirtualGroup.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupByFamilyIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovQuery(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupByFamilyIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GlobalVirtualGroups) > 0 {
+		for _, e := range m.GlobalVirtualGroups {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupFamilyRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2442,6 +2869,63 @@ func (m *QuerySpOptimalGlobalVirtualGroupFamilyResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFamilyRebalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RebalanceId != 0 {
+		n += 1 + sovQuery(uint64(m.RebalanceId))
+	}
+	return n
+}
+
+func (m *QueryFamilyRebalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rebalance != nil {
+		l = m.Rebalance.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnhealthyGlobalVirtualGroupsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnhealthyGlobalVirtualGroupsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GlobalVirtualGroups) > 0 {
+		for _, e := range m.GlobalVirtualGroups {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4183,6 +4667,367 @@ func (m *QuerySpOptimalGlobalVirtualGroupFamilyResponse) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
+func (m *QueryFamilyRebalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFamilyRebalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFamilyRebalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceId", wireType)
+			}
+			m.RebalanceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RebalanceId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFamilyRebalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFamilyRebalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFamilyRebalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rebalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rebalance == nil {
+				m.Rebalance = &FamilyRebalance{}
+			}
+			if err := m.Rebalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnhealthyGlobalVirtualGroupsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnhealthyGlobalVirtualGroupsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnhealthyGlobalVirtualGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnhealthyGlobalVirtualGroupsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnhealthyGlobalVirtualGroupsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnhealthyGlobalVirtualGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalVirtualGroups = append(m.GlobalVirtualGroups, UnhealthyGlobalVirtualGroup{})
+			if err := m.GlobalVirtualGroups[len(m.GlobalVirtualGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1531,6 +1531,231 @@ func (m *MsgStorageProviderForcedExitResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgStorageProviderForcedExitResponse proto.InternalMessageInfo
 
+// MsgRebalanceGlobalVirtualGroupFamilies defines a message to move the families of a primary sp to other sps,
+// it reserves the swap in of the families for the picked target sps, which complete the move by MsgCompleteSwapIn.
+type MsgRebalanceGlobalVirtualGroupFamilies struct {
+	// operator is the address of the gov module account or the operator of the source sp.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// storage_provider_id is the id of the source primary sp.
+	StorageProviderId uint32 `protobuf:"varint,2,opt,name=storage_provider_id,json=storageProviderId,proto3" json:"storage_provider_id,omitempty"`
+	// global_virtual_group_family_ids are the families to be moved, empty means all the families of the source sp.
+	GlobalVirtualGroupFamilyIds []uint32 `protobuf:"varint,3,rep,packed,name=global_virtual_group_family_ids,json=globalVirtualGroupFamilyIds,proto3" json:"global_virtual_group_family_ids,omitempty"`
+	// target_sp_ids are the candidates of the target sps, empty means all the in service sps.
+	TargetSpIds []uint32 `protobuf:"varint,4,rep,packed,name=target_sp_ids,json=targetSpIds,proto3" json:"target_sp_ids,omitempty"`
+}
+
+func (m *MsgRebalanceGlobalVirtualGroupFamilies) Reset() {
+	*m = MsgRebalanceGlobalVirtualGroupFamilies{}
+}
+func (m *MsgRebalanceGlobalVirtualGroupFamilies) String() string { return proto.CompactTextString(m) }
+func (*MsgRebalanceGlobalVirtualGroupFamilies) ProtoMessage()    {}
+func (*MsgRebalanceGlobalVirtualGroupFamilies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478f7001009bf3f2, []int{30}
+}
+func (m *MsgRebalanceGlobalVirtualGroupFamilies) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRebalanceGlobalVirtualGroupFamilies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRebalanceGlobalVirtualGroupFamilies.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRebalanceGlobalVirtualGroupFamilies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRebalanceGlobalVirtualGroupFamilies.Merge(m, src)
+}
+func (m *MsgRebalanceGlobalVirtualGroupFamilies) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRebalanceGlobalVirtualGroupFamilies) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRebalanceGlobalVirtualGroupFamilies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRebalanceGlobalVirtualGroupFamilies proto.InternalMessageInfo
+
+func (m *MsgRebalanceGlobalVirtualGroupFamilies) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgRebalanceGlobalVirtualGroupFamilies) GetStorageProviderId() uint32 {
+	if m != nil {
+		return m.StorageProviderId
+	}
+	return 0
+}
+
+func (m *MsgRebalanceGlobalVirtualGroupFamilies) GetGlobalVirtualGroupFamilyIds() []uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupFamilyIds
+	}
+	return nil
+}
+
+func (m *MsgRebalanceGlobalVirtualGroupFamilies) GetTargetSpIds() []uint32 {
+	if m != nil {
+		return m.TargetSpIds
+	}
+	return nil
+}
+
+type MsgRebalanceGlobalVirtualGroupFamiliesResponse struct {
+	// rebalance_id is the id to track the progress of the rebalance.
+	RebalanceId uint32 `protobuf:"varint,1,opt,name=rebalance_id,json=rebalanceId,proto3" json:"rebalance_id,omitempty"`
+}
+
+func (m *MsgRebalanceGlobalVirtualGroupFamiliesResponse) Reset() {
+	*m = MsgRebalanceGlobalVirtualGroupFamiliesResponse{}
+}
+func (m *MsgRebalanceGlobalVirtualGroupFamiliesResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgRebalanceGlobalVirtualGroupFamiliesResponse) ProtoMessage() {}
+func (*MsgRebalanceGlobalVirtualGroupFamiliesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478f7001009bf3f2, []int{31}
+}
+func (m *MsgRebalanceGlobalVirtualGroupFamiliesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRebalanceGlobalVirtualGroupFamiliesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRebalanceGlobalVirtualGroupFamiliesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRebalanceGlobalVirtualGroupFamiliesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRebalanceGlobalVirtualGroupFamiliesResponse.Merge(m, src)
+}
+func (m *MsgRebalanceGlobalVirtualGroupFamiliesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRebalanceGlobalVirtualGroupFamiliesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRebalanceGlobalVirtualGroupFamiliesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRebalanceGlobalVirtualGroupFamiliesResponse proto.InternalMessageInfo
+
+func (m *MsgRebalanceGlobalVirtualGroupFamiliesResponse) GetRebalanceId() uint32 {
+	if m != nil {
+		return m.RebalanceId
+	}
+	return 0
+}
+
+type MsgOpenGVGRepair struct {
+	// operator is the address of the account who opens the repair, anyone can open a repair for an unhealthy gvg.
+	// The operator receives the amount slashed from the unhealthy secondary sps if the gvg is not repaired in time.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// global_virtual_group_id is the id of the gvg to be repaired.
+	GlobalVirtualGroupId uint32 `protobuf:"varint,2,opt,name=global_virtual_group_id,json=globalVirtualGroupId,proto3" json:"global_virtual_group_id,omitempty"`
+}
+
+func (m *MsgOpenGVGRepair) Reset()         { *m = MsgOpenGVGRepair{} }
+func (m *MsgOpenGVGRepair) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenGVGRepair) ProtoMessage()    {}
+func (*MsgOpenGVGRepair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478f7001009bf3f2, []int{32}
+}
+func (m *MsgOpenGVGRepair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOpenGVGRepair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOpenGVGRepair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOpenGVGRepair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenGVGRepair.Merge(m, src)
+}
+func (m *MsgOpenGVGRepair) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOpenGVGRepair) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenGVGRepair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOpenGVGRepair proto.InternalMessageInfo
+
+func (m *MsgOpenGVGRepair) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgOpenGVGRepair) GetGlobalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupId
+	}
+	return 0
+}
+
+type MsgOpenGVGRepairResponse struct {
+	// deadline is the unix timestamp before which the gvg should be repaired.
+	Deadline int64 `protobuf:"varint,1,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *MsgOpenGVGRepairResponse) Reset()         { *m = MsgOpenGVGRepairResponse{} }
+func (m *MsgOpenGVGRepairResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenGVGRepairResponse) ProtoMessage()    {}
+func (*MsgOpenGVGRepairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478f7001009bf3f2, []int{33}
+}
+func (m *MsgOpenGVGRepairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOpenGVGRepairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOpenGVGRepairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOpenGVGRepairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenGVGRepairResponse.Merge(m, src)
+}
+func (m *MsgOpenGVGRepairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOpenGVGRepairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenGVGRepairResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOpenGVGRepairResponse proto.InternalMessageInfo
+
+func (m *MsgOpenGVGRepairResponse) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "greenfield.virtualgroup.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "greenfield.virtualgroup.MsgUpdateParamsResponse")
@@ -1562,86 +1787,101 @@ func init() {
 	proto.RegisterType((*MsgCancelSwapInResponse)(nil), "greenfield.virtualgroup.MsgCancelSwapInResponse")
 	proto.RegisterType((*MsgStorageProviderForcedExit)(nil), "greenfield.virtualgroup.MsgStorageProviderForcedExit")
 	proto.RegisterType((*MsgStorageProviderForcedExitResponse)(nil), "greenfield.virtualgroup.MsgStorageProviderForcedExitResponse")
+	proto.RegisterType((*MsgRebalanceGlobalVirtualGroupFamilies)(nil), "greenfield.virtualgroup.MsgRebalanceGlobalVirtualGroupFamilies")
+	proto.RegisterType((*MsgRebalanceGlobalVirtualGroupFamiliesResponse)(nil), "greenfield.virtualgroup.MsgRebalanceGlobalVirtualGroupFamiliesResponse")
+	proto.RegisterType((*MsgOpenGVGRepair)(nil), "greenfield.virtualgroup.MsgOpenGVGRepair")
+	proto.RegisterType((*MsgOpenGVGRepairResponse)(nil), "greenfield.virtualgroup.MsgOpenGVGRepairResponse")
 }
 
 func init() { proto.RegisterFile("greenfield/virtualgroup/tx.proto", fileDescriptor_478f7001009bf3f2) }
 
 var fileDescriptor_478f7001009bf3f2 = []byte{
-	// 1178 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc6, 0x25, 0x4d, 0x5e, 0xeb, 0x24, 0x6c, 0x12, 0xe2, 0x6c, 0x2a, 0xd7, 0x4a, 0x43,
-	0x64, 0x52, 0xea, 0x25, 0x69, 0x4a, 0x45, 0xa1, 0x42, 0x4d, 0xa0, 0x95, 0x0f, 0xa1, 0x95, 0x23,
-	0xfe, 0x08, 0x24, 0xac, 0xb1, 0x77, 0xba, 0x59, 0x64, 0xef, 0xac, 0x66, 0xd6, 0x4e, 0x72, 0x42,
-	0xe2, 0x8a, 0x84, 0xe0, 0x04, 0xdf, 0x81, 0x4b, 0x85, 0xf8, 0x10, 0x3d, 0x56, 0x9c, 0x10, 0x12,
-	0x15, 0x4a, 0x90, 0x8a, 0x40, 0x7c, 0x02, 0x2e, 0x68, 0x67, 0x67, 0xc7, 0xeb, 0x3f, 0x3b, 0xb6,
-	0x23, 0x57, 0x95, 0x72, 0x4a, 0x3c, 0xf3, 0x7b, 0x6f, 0xde, 0xef, 0x37, 0x6f, 0xde, 0xcc, 0x5b,
-	0xc8, 0xd9, 0x14, 0x63, 0xf7, 0xa1, 0x83, 0x6b, 0x96, 0xd9, 0x74, 0xa8, 0xdf, 0x40, 0x35, 0x9b,
-	0x92, 0x86, 0x67, 0xfa, 0x87, 0x05, 0x8f, 0x12, 0x9f, 0xe8, 0x8b, 0x2d, 0x44, 0x21, 0x8e, 0x30,
-	0xb2, 0x55, 0xc2, 0xea, 0x84, 0x99, 0x15, 0xc4, 0xb0, 0xd9, 0xdc, 0xa8, 0x60, 0x1f, 0x6d, 0x98,
-	0x55, 0xe2, 0xb8, 0xa1, 0xa1, 0xb1, 0x28, 0xe6, 0xeb, 0xcc, 0x36, 0x9b, 0x1b, 0xc1, 0x1f, 0x31,
-	0xb1, 0x14, 0x4e, 0x94, 0xf9, 0x2f, 0x33, 0xfc, 0x21, 0xa6, 0xe6, 0x6d, 0x62, 0x93, 0x70, 0x3c,
-	0xf8, 0x4f, 0x8c, 0xc6, 0x83, 0xac, 0x92, 0x7a, 0x9d, 0xb8, 0x26, 0xf2, 0x3c, 0x4a, 0x9a, 0xa8,
-	0x26, 0x10, 0xab, 0x49, 0x34, 0x3c, 0x44, 0x51, 0x5d, 0x78, 0x5f, 0xf9, 0x41, 0x83, 0x99, 0x5d,
-	0x66, 0x7f, 0xe8, 0x59, 0xc8, 0xc7, 0x0f, 0xf8, 0x8c, 0xfe, 0x26, 0x4c, 0xa1, 0x86, 0xbf, 0x4f,
-	0xa8, 0xe3, 0x1f, 0x65, 0xb4, 0x9c, 0x96, 0x9f, 0xda, 0xce, 0xfc, 0xf2, 0xf3, 0xb5, 0x79, 0x11,
-	0xd6, 0x1d, 0xcb, 0xa2, 0x98, 0xb1, 0x3d, 0x9f, 0x3a, 0xae, 0x5d, 0x6a, 0x41, 0xf5, 0xdb, 0x30,
-	0x11, 0xfa, 0xce, 0x8c, 0xe7, 0xb4, 0xfc, 0x85, 0xcd, 0xcb, 0x85, 0x04, 0x9d, 0x0a, 0xe1, 0x42,
-	0xdb, 0xe7, 0x1e, 0x3f, 0xbd, 0x3c, 0x56, 0x12, 0x46, 0xb7, 0xa6, 0xbf, 0x7a, 0xf6, 0x68, 0xbd,
-	0xe5, 0x6e, 0x65, 0x09, 0x16, 0x3b, 0x22, 0x2b, 0x61, 0xe6, 0x11, 0x97, 0xe1, 0x95, 0xff, 0x34,
-	0x58, 0xde, 0x65, 0xf6, 0x0e, 0xc5, 0xc8, 0xc7, 0xf7, 0x6a, 0xa4, 0x82, 0x6a, 0x1f, 0x85, 0xfe,
-	0xef, 0x05, 0xfe, 0xf5, 0x1d, 0x98, 0x65, 0x3e, 0xa1, 0xc8, 0xc6, 0x81, 0xa2, 0x4d, 0xc7, 0xc2,
-	0xb4, 0x2f, 0x91, 0x19, 0x61, 0xf1, 0x40, 0x18, 0xe8, 0xcb, 0x30, 0xf5, 0x10, 0xd5, 0x9d, 0xda,
-	0x51, 0xd9, 0xb1, 0x38, 0xa3, 0x74, 0x69, 0x32, 0x1c, 0x28, 0x5a, 0x7a, 0x1e, 0x66, 0x19, 0xae,
-	0x12, 0xd7, 0x42, 0xf4, 0xa8, 0xcc, 0xbc, 0xb2, 0x63, 0xb1, 0x4c, 0x2a, 0x97, 0xca, 0xa7, 0x4b,
-	0xd3, 0x72, 0x7c, 0xcf, 0x2b, 0x5a, 0x4c, 0x7f, 0x0b, 0xce, 0x5b, 0xd8, 0x23, 0xcc, 0xf1, 0x33,
-	0xe7, 0xb8, 0x2c, 0x4b, 0x05, 0xb1, 0x7e, 0x90, 0x25, 0x05, 0x91, 0x25, 0x85, 0x1d, 0xe2, 0xb8,
-	0x42, 0x90, 0x08, 0x7f, 0x6b, 0x21, 0x50, 0xa4, 0x8b, 0xc9, 0xca, 0xab, 0x70, 0x45, 0x41, 0x5e,
-	0x8a, 0xf4, 0x28, 0x14, 0xe9, 0x3d, 0x5c, 0xc3, 0xcf, 0x4f, 0xa4, 0x1b, 0xb0, 0x68, 0x73, 0xd7,
-	0x65, 0xb1, 0xc1, 0x65, 0xbe, 0xc3, 0x2d, 0xc9, 0xe6, 0xed, 0xae, 0x95, 0x8b, 0x96, 0x9a, 0x59,
-	0x52, 0xc4, 0x92, 0xd9, 0x6f, 0x1a, 0x00, 0xc7, 0x71, 0x99, 0x5e, 0x24, 0x91, 0xf8, 0xee, 0xa6,
-	0x46, 0xb3, 0xbb, 0xf3, 0xa0, 0xb7, 0xb8, 0x49, 0xca, 0xbf, 0x6b, 0x70, 0x61, 0x97, 0xd9, 0x1f,
-	0x3b, 0xfe, 0xbe, 0x45, 0xd1, 0xc1, 0x0b, 0xe5, 0xfc, 0x36, 0x4c, 0x1e, 0x88, 0x38, 0x06, 0x25,
-	0x2d, 0x0d, 0x92, 0x58, 0x2f, 0xc0, 0x5c, 0x8c, 0x9e, 0xa4, 0xfd, 0x74, 0x9c, 0xef, 0xf4, 0xde,
-	0x01, 0xf2, 0xee, 0x37, 0x46, 0xb4, 0xd3, 0xdb, 0x90, 0xed, 0xc9, 0xba, 0xf3, 0xb0, 0x1b, 0xdd,
-	0xe4, 0xef, 0x46, 0xc7, 0xff, 0x26, 0x64, 0x12, 0x94, 0x8b, 0xca, 0xc0, 0x42, 0x2f, 0xe9, 0x98,
-	0xbe, 0x06, 0x33, 0xac, 0x51, 0xad, 0x62, 0xc6, 0x08, 0x0d, 0xeb, 0x06, 0xaf, 0x0a, 0xe9, 0x52,
-	0x5a, 0x0e, 0x07, 0x65, 0x43, 0xbf, 0x0f, 0x0b, 0x6d, 0xb8, 0xa8, 0xb8, 0x67, 0x5e, 0xe2, 0x82,
-	0x2f, 0xc7, 0x4b, 0x6b, 0x58, 0xff, 0x0b, 0x77, 0x04, 0xa4, 0x34, 0x17, 0x73, 0x15, 0x0d, 0xaa,
-	0xb3, 0x4d, 0xe8, 0x2b, 0x65, 0xff, 0x57, 0xe3, 0xc3, 0x3b, 0xa4, 0xee, 0x05, 0x47, 0xf1, 0xcc,
-	0xc8, 0x9f, 0xa4, 0xc2, 0x25, 0x30, 0xba, 0xe9, 0x4a, 0x35, 0xfe, 0xd1, 0x60, 0x36, 0x98, 0x46,
-	0x6e, 0x15, 0xd7, 0xce, 0xbc, 0x16, 0x06, 0x64, 0x3a, 0xc9, 0x4a, 0x25, 0xfe, 0xd4, 0x60, 0x2a,
-	0x48, 0x17, 0xec, 0xfb, 0x35, 0x7c, 0x76, 0x25, 0x98, 0x83, 0x97, 0x25, 0x4b, 0xc9, 0xdd, 0x87,
-	0x57, 0x82, 0xc1, 0xf6, 0xf0, 0xdf, 0x3f, 0x1c, 0xd1, 0xfd, 0x93, 0x14, 0x4a, 0x0e, 0xb2, 0xbd,
-	0x57, 0x95, 0x71, 0xfd, 0xa4, 0x71, 0x88, 0x4c, 0xde, 0xe7, 0x14, 0xa0, 0xbe, 0x05, 0x93, 0xc4,
-	0xc3, 0x14, 0xf9, 0x84, 0xf2, 0x2d, 0x51, 0x19, 0x4b, 0x64, 0x12, 0xad, 0x3c, 0xac, 0xa9, 0x63,
-	0x96, 0xf4, 0xbe, 0x1e, 0xe7, 0x87, 0xaf, 0x84, 0x19, 0xa6, 0x4d, 0x7e, 0x34, 0x8b, 0xee, 0x68,
-	0x08, 0xe5, 0xe0, 0xa2, 0x8f, 0xa8, 0x8d, 0x7d, 0x51, 0x87, 0xc3, 0x3c, 0x83, 0x70, 0x8c, 0x17,
-	0xe1, 0xfe, 0xb9, 0x99, 0xea, 0x9b, 0x9b, 0x8a, 0x3b, 0xf6, 0xdc, 0xf0, 0x0f, 0xa4, 0xf0, 0x70,
-	0xb6, 0x89, 0x21, 0x95, 0xfa, 0x5b, 0xe3, 0x69, 0x1b, 0xaf, 0x62, 0xa3, 0x92, 0x6a, 0x14, 0x87,
-	0x54, 0x21, 0x44, 0x6a, 0x78, 0x21, 0x96, 0x61, 0xa9, 0x8b, 0xab, 0x54, 0xe2, 0xaf, 0xb0, 0xa9,
-	0x69, 0xd5, 0xb0, 0xb3, 0xab, 0x43, 0xd8, 0x24, 0xc5, 0x99, 0x4a, 0x15, 0x7e, 0xd4, 0xe0, 0x52,
-	0x77, 0xed, 0xb8, 0x4b, 0x68, 0x15, 0x5b, 0xbc, 0x2c, 0x9c, 0xb6, 0xcf, 0xeb, 0x25, 0xe5, 0xf8,
-	0xb0, 0xf5, 0xae, 0xb3, 0xdb, 0x5b, 0x83, 0x55, 0x55, 0xb0, 0x11, 0xab, 0xcd, 0x67, 0x69, 0x48,
-	0xed, 0x32, 0x5b, 0xff, 0x46, 0x83, 0x4c, 0x62, 0xff, 0xb7, 0x95, 0xd8, 0x79, 0x2a, 0x1a, 0x27,
-	0xe3, 0x9d, 0xd3, 0x58, 0x45, 0x81, 0xf1, 0x80, 0x12, 0x7b, 0x2d, 0x65, 0x40, 0x49, 0x56, 0xea,
-	0x80, 0xfa, 0x75, 0x49, 0xfa, 0x67, 0x70, 0x3e, 0xea, 0x90, 0xae, 0xa8, 0x1d, 0x71, 0x90, 0x71,
-	0x75, 0x00, 0x90, 0x74, 0xfe, 0x39, 0x4c, 0xca, 0x5e, 0x64, 0x55, 0x65, 0x18, 0xa1, 0x8c, 0xd7,
-	0x07, 0x41, 0xc5, 0x83, 0x8f, 0x5e, 0x5a, 0xca, 0xe0, 0x05, 0x48, 0x1d, 0x7c, 0xc7, 0x33, 0x46,
-	0xff, 0x04, 0x26, 0xc4, 0x13, 0x66, 0x45, 0x69, 0xc6, 0x31, 0xc6, 0x7a, 0x7f, 0x8c, 0xf4, 0xfc,
-	0x05, 0x5c, 0x6c, 0xfb, 0x94, 0x92, 0x57, 0xd9, 0xc6, 0x91, 0xc6, 0x1b, 0x83, 0x22, 0xe5, 0x5a,
-	0x5f, 0xc2, 0x5c, 0xaf, 0xcb, 0xde, 0x54, 0x86, 0xdb, 0x6d, 0x60, 0xdc, 0x1c, 0xd2, 0x40, 0x06,
-	0xf0, 0xbd, 0x06, 0xcb, 0xaa, 0x67, 0x87, 0xd2, 0xb1, 0xc2, 0xd0, 0x78, 0xf7, 0x94, 0x86, 0x32,
-	0x32, 0x06, 0x33, 0x9d, 0xbd, 0xcb, 0xd5, 0x81, 0x7c, 0x8a, 0x6c, 0xba, 0x3e, 0x04, 0x58, 0x2e,
-	0x5a, 0x87, 0x74, 0x7b, 0x8b, 0xf0, 0x9a, 0xd2, 0x4b, 0x1c, 0x6a, 0x6c, 0x0c, 0x0c, 0x8d, 0x2f,
-	0xd7, 0xfe, 0x28, 0x52, 0x2e, 0xd7, 0x06, 0x55, 0x2f, 0xd7, 0xf3, 0x75, 0x11, 0x64, 0x76, 0xdb,
-	0x7d, 0x9a, 0x1f, 0x2c, 0xe2, 0xa2, 0xab, 0xce, 0xec, 0x5e, 0x37, 0x97, 0xee, 0xc1, 0x74, 0xc7,
-	0x2b, 0x66, 0x7d, 0xd0, 0x0d, 0x29, 0xba, 0xc6, 0xe6, 0xe0, 0x58, 0xb9, 0xe2, 0x77, 0x1a, 0x2c,
-	0x25, 0x5f, 0x94, 0x37, 0x86, 0x38, 0x21, 0x2d, 0x33, 0xe3, 0xf6, 0xa9, 0xcc, 0xa2, 0x98, 0xb6,
-	0x3f, 0x78, 0x7c, 0x9c, 0xd5, 0x9e, 0x1c, 0x67, 0xb5, 0x3f, 0x8e, 0xb3, 0xda, 0xb7, 0x27, 0xd9,
-	0xb1, 0x27, 0x27, 0xd9, 0xb1, 0x5f, 0x4f, 0xb2, 0x63, 0x9f, 0x6e, 0xd9, 0x8e, 0xbf, 0xdf, 0xa8,
-	0x04, 0x8d, 0xbf, 0x59, 0x71, 0x2b, 0xd7, 0xaa, 0xfb, 0xc8, 0x71, 0xcd, 0xd8, 0xf7, 0xde, 0xc3,
-	0x8e, 0x0f, 0xd7, 0x47, 0x1e, 0x66, 0x95, 0x09, 0xfe, 0xc5, 0xf7, 0xfa, 0xff, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xa9, 0xb5, 0xca, 0xe4, 0xe0, 0x16, 0x00, 0x00,
+	// 1346 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xc6, 0xa1, 0x4d, 0x5e, 0xea, 0x26, 0xdd, 0x24, 0xc4, 0x59, 0x57, 0xae, 0x71, 0x43,
+	0x64, 0x52, 0x6a, 0x93, 0x34, 0x6d, 0x45, 0xa0, 0xaa, 0x9a, 0x94, 0x5a, 0x3e, 0x84, 0x54, 0x8e,
+	0x28, 0x08, 0x24, 0xac, 0xb1, 0x77, 0xba, 0x59, 0x64, 0xef, 0xac, 0x76, 0xd6, 0x4e, 0x72, 0x42,
+	0xe2, 0x02, 0x12, 0x52, 0x05, 0x27, 0xf8, 0x0f, 0x1c, 0xa8, 0x10, 0x3f, 0xa2, 0xc7, 0x8a, 0x13,
+	0x42, 0xa2, 0x42, 0x09, 0x12, 0x08, 0xc4, 0x2f, 0xe0, 0x82, 0x76, 0x76, 0x3d, 0xde, 0xb5, 0xbd,
+	0xe3, 0xb5, 0x71, 0x55, 0x29, 0xa7, 0xc4, 0xb3, 0xef, 0xbd, 0xf9, 0xbe, 0x6f, 0xde, 0x3c, 0xbf,
+	0xe7, 0x85, 0xb4, 0x66, 0x61, 0x6c, 0x3c, 0xd4, 0x71, 0x4d, 0xcd, 0x37, 0x75, 0xcb, 0x6e, 0xa0,
+	0x9a, 0x66, 0x91, 0x86, 0x99, 0xb7, 0x0f, 0x73, 0xa6, 0x45, 0x6c, 0x22, 0x2f, 0xb6, 0x2d, 0x72,
+	0x7e, 0x0b, 0x25, 0x55, 0x25, 0xb4, 0x4e, 0x68, 0xbe, 0x82, 0x28, 0xce, 0x37, 0xd7, 0x2a, 0xd8,
+	0x46, 0x6b, 0xf9, 0x2a, 0xd1, 0x0d, 0xd7, 0x51, 0x59, 0xf4, 0x9e, 0xd7, 0xa9, 0x96, 0x6f, 0xae,
+	0x39, 0x7f, 0xbc, 0x07, 0x4b, 0xee, 0x83, 0x32, 0xfb, 0x94, 0x77, 0x3f, 0x78, 0x8f, 0xe6, 0x35,
+	0xa2, 0x11, 0x77, 0xdd, 0xf9, 0xcf, 0x5b, 0xf5, 0x83, 0xac, 0x92, 0x7a, 0x9d, 0x18, 0x79, 0x64,
+	0x9a, 0x16, 0x69, 0xa2, 0x9a, 0x67, 0xb1, 0x1c, 0x46, 0xc3, 0x44, 0x16, 0xaa, 0x7b, 0xd1, 0x33,
+	0xdf, 0x4a, 0x30, 0xb3, 0x43, 0xb5, 0xf7, 0x4c, 0x15, 0xd9, 0xf8, 0x3e, 0x7b, 0x22, 0xdf, 0x80,
+	0x29, 0xd4, 0xb0, 0xf7, 0x89, 0xa5, 0xdb, 0x47, 0x09, 0x29, 0x2d, 0x65, 0xa7, 0xb6, 0x12, 0x3f,
+	0xfd, 0x78, 0x75, 0xde, 0x83, 0x75, 0x47, 0x55, 0x2d, 0x4c, 0xe9, 0x9e, 0x6d, 0xe9, 0x86, 0x56,
+	0x6a, 0x9b, 0xca, 0xb7, 0xe0, 0x8c, 0x1b, 0x3b, 0x31, 0x9e, 0x96, 0xb2, 0xd3, 0xeb, 0x97, 0x72,
+	0x21, 0x3a, 0xe5, 0xdc, 0x8d, 0xb6, 0x26, 0x9e, 0x3c, 0xbb, 0x34, 0x56, 0xf2, 0x9c, 0x36, 0xcf,
+	0x7f, 0xf6, 0xc7, 0xe3, 0xd5, 0x76, 0xb8, 0xcc, 0x12, 0x2c, 0x76, 0x20, 0x2b, 0x61, 0x6a, 0x12,
+	0x83, 0xe2, 0xcc, 0xbf, 0x12, 0x24, 0x77, 0xa8, 0xb6, 0x6d, 0x61, 0x64, 0xe3, 0x42, 0x8d, 0x54,
+	0x50, 0xed, 0x81, 0x1b, 0xbf, 0xe0, 0xc4, 0x97, 0xb7, 0x61, 0x96, 0xda, 0xc4, 0x42, 0x1a, 0x76,
+	0x14, 0x6d, 0xea, 0x2a, 0xb6, 0xfa, 0x12, 0x99, 0xf1, 0x3c, 0xee, 0x7b, 0x0e, 0x72, 0x12, 0xa6,
+	0x1e, 0xa2, 0xba, 0x5e, 0x3b, 0x2a, 0xeb, 0x2a, 0x63, 0x14, 0x2f, 0x4d, 0xba, 0x0b, 0x45, 0x55,
+	0xce, 0xc2, 0x2c, 0xc5, 0x55, 0x62, 0xa8, 0xc8, 0x3a, 0x2a, 0x53, 0xb3, 0xac, 0xab, 0x34, 0x11,
+	0x4b, 0xc7, 0xb2, 0xf1, 0xd2, 0x79, 0xbe, 0xbe, 0x67, 0x16, 0x55, 0x2a, 0xbf, 0x09, 0x67, 0x55,
+	0x6c, 0x12, 0xaa, 0xdb, 0x89, 0x09, 0x26, 0xcb, 0x52, 0xce, 0xdb, 0xdf, 0xc9, 0x92, 0x9c, 0x97,
+	0x25, 0xb9, 0x6d, 0xa2, 0x1b, 0x9e, 0x20, 0x2d, 0xfb, 0xcd, 0x05, 0x47, 0x91, 0x2e, 0x26, 0x99,
+	0x57, 0xe1, 0xb2, 0x80, 0x3c, 0x17, 0xe9, 0xb1, 0x2b, 0xd2, 0x5d, 0x5c, 0xc3, 0xcf, 0x4f, 0xa4,
+	0xeb, 0xb0, 0xa8, 0xb1, 0xd0, 0x65, 0xef, 0x80, 0xcb, 0xec, 0x84, 0xdb, 0x92, 0xcd, 0x6b, 0x5d,
+	0x3b, 0x17, 0x55, 0x31, 0xb3, 0x30, 0xc4, 0x9c, 0xd9, 0x2f, 0x12, 0x00, 0xb3, 0x63, 0x32, 0xbd,
+	0x48, 0x22, 0xfe, 0xd3, 0x8d, 0x8d, 0xe6, 0x74, 0xe7, 0x41, 0x6e, 0x73, 0xe3, 0x94, 0x7f, 0x95,
+	0x60, 0x7a, 0x87, 0x6a, 0xef, 0xeb, 0xf6, 0xbe, 0x6a, 0xa1, 0x83, 0x17, 0xca, 0xf9, 0x2d, 0x98,
+	0x3c, 0xf0, 0x70, 0x44, 0x25, 0xcd, 0x1d, 0xc2, 0x58, 0x2f, 0xc0, 0x9c, 0x8f, 0x1e, 0xa7, 0xfd,
+	0x6c, 0x9c, 0x9d, 0xf4, 0xde, 0x01, 0x32, 0x77, 0x1b, 0x23, 0x3a, 0xe9, 0x2d, 0x48, 0xf5, 0x64,
+	0xdd, 0x79, 0xd9, 0x95, 0x6e, 0xf2, 0xf7, 0x5a, 0xd7, 0xff, 0x26, 0x24, 0x42, 0x94, 0x6b, 0x95,
+	0x81, 0x85, 0x5e, 0xd2, 0x51, 0x79, 0x05, 0x66, 0x68, 0xa3, 0x5a, 0xc5, 0x94, 0x12, 0xcb, 0xad,
+	0x1b, 0xac, 0x2a, 0xc4, 0x4b, 0x71, 0xbe, 0xec, 0x94, 0x0d, 0x79, 0x17, 0x16, 0x02, 0x76, 0xad,
+	0xe2, 0x9e, 0x78, 0x89, 0x09, 0x9e, 0xf4, 0x97, 0x56, 0xb7, 0xfe, 0xe7, 0xee, 0x78, 0x26, 0xa5,
+	0x39, 0x5f, 0xa8, 0xd6, 0xa2, 0x38, 0xdb, 0x3c, 0x7d, 0xb9, 0xec, 0xff, 0x48, 0x6c, 0x79, 0x9b,
+	0xd4, 0x4d, 0xe7, 0x2a, 0x9e, 0x1a, 0xf9, 0xc3, 0x54, 0xb8, 0x08, 0x4a, 0x37, 0x5d, 0xae, 0xc6,
+	0xdf, 0x12, 0xcc, 0x3a, 0x8f, 0x91, 0x51, 0xc5, 0xb5, 0x53, 0xaf, 0x85, 0x02, 0x89, 0x4e, 0xb2,
+	0x5c, 0x89, 0xdf, 0x25, 0x98, 0x72, 0xd2, 0x05, 0xdb, 0x76, 0x0d, 0x9f, 0x5e, 0x09, 0xe6, 0xe0,
+	0x02, 0x67, 0xc9, 0xb9, 0xdb, 0xf0, 0xb2, 0xb3, 0x18, 0x84, 0xff, 0xce, 0xe1, 0x88, 0xbe, 0x7f,
+	0xc2, 0xa0, 0xa4, 0x21, 0xd5, 0x7b, 0x57, 0x8e, 0xeb, 0x07, 0x89, 0x99, 0xf0, 0xe4, 0x7d, 0x4e,
+	0x00, 0xe5, 0x0d, 0x98, 0x24, 0x26, 0xb6, 0x90, 0x4d, 0x2c, 0x76, 0x24, 0x22, 0x67, 0x6e, 0x19,
+	0x46, 0x2b, 0x0b, 0x2b, 0x62, 0xcc, 0x9c, 0xde, 0x97, 0xe3, 0xec, 0xf2, 0x95, 0x30, 0xc5, 0x56,
+	0x93, 0x5d, 0xcd, 0xa2, 0x31, 0x1a, 0x42, 0x69, 0x38, 0x67, 0x23, 0x4b, 0xc3, 0xb6, 0x57, 0x87,
+	0xdd, 0x3c, 0x03, 0x77, 0x8d, 0x15, 0xe1, 0xfe, 0xb9, 0x19, 0xeb, 0x9b, 0x9b, 0x82, 0xef, 0xd8,
+	0x89, 0xc1, 0x1b, 0x24, 0xf7, 0x72, 0x06, 0xc4, 0xe0, 0x4a, 0xfd, 0x25, 0xb1, 0xb4, 0xf5, 0x57,
+	0xb1, 0x51, 0x49, 0x35, 0x8a, 0x4b, 0x2a, 0x10, 0x22, 0x36, 0xb8, 0x10, 0x49, 0x58, 0xea, 0xe2,
+	0xca, 0x95, 0xf8, 0xd3, 0x1d, 0x6a, 0xda, 0x35, 0xec, 0xf4, 0xea, 0xe0, 0x0e, 0x49, 0x7e, 0xa6,
+	0x5c, 0x85, 0xef, 0x24, 0xb8, 0xd8, 0x5d, 0x3b, 0xee, 0x11, 0xab, 0x8a, 0x55, 0x56, 0x16, 0x86,
+	0x9d, 0xf3, 0x7a, 0x49, 0x39, 0x3e, 0x68, 0xbd, 0xeb, 0x9c, 0xf6, 0x56, 0x60, 0x59, 0x04, 0x96,
+	0xb3, 0xfa, 0x7c, 0x9c, 0x95, 0x8e, 0x12, 0xae, 0xa0, 0x9a, 0xc3, 0xba, 0xd0, 0x5b, 0x69, 0x1d,
+	0xd3, 0x40, 0xc5, 0x92, 0xa2, 0x56, 0x2c, 0x39, 0x07, 0x73, 0x9d, 0xec, 0xda, 0x07, 0x7b, 0xa1,
+	0x83, 0x46, 0x51, 0x95, 0xef, 0xc2, 0x25, 0x71, 0x4e, 0xb4, 0xbe, 0x83, 0x92, 0xe1, 0x49, 0x41,
+	0xe5, 0x0c, 0xc4, 0xfd, 0xc5, 0x88, 0x26, 0x26, 0x98, 0xcf, 0x74, 0xbb, 0x1a, 0xd1, 0xcd, 0xb8,
+	0x23, 0x19, 0x07, 0x9a, 0xd9, 0x83, 0x5c, 0x34, 0x21, 0x5a, 0xda, 0xc9, 0xaf, 0xc0, 0x39, 0xab,
+	0x65, 0xee, 0x70, 0x92, 0x18, 0xa7, 0x69, 0xbe, 0x56, 0x54, 0x33, 0x8f, 0xdc, 0x5e, 0x67, 0xd7,
+	0xc4, 0x46, 0xe1, 0x41, 0xa1, 0x84, 0x4d, 0xa4, 0x5b, 0x43, 0x0a, 0x39, 0xe4, 0x68, 0xd8, 0xc1,
+	0xf2, 0x06, 0xab, 0x78, 0x01, 0x3c, 0x9c, 0x8f, 0x02, 0x93, 0x2a, 0x46, 0x6a, 0x4d, 0x37, 0x30,
+	0xc3, 0x15, 0x2b, 0xf1, 0xcf, 0xeb, 0x5f, 0xcc, 0x42, 0x6c, 0x87, 0x6a, 0xf2, 0x23, 0x09, 0x12,
+	0xa1, 0xbf, 0x13, 0x6c, 0x84, 0xfe, 0x42, 0x21, 0x18, 0xb0, 0x95, 0xb7, 0x87, 0xf1, 0xe2, 0xa0,
+	0x1d, 0x40, 0xa1, 0x33, 0xb9, 0x10, 0x50, 0x98, 0x97, 0x18, 0x50, 0xbf, 0x69, 0x5a, 0xfe, 0x08,
+	0xce, 0xb6, 0x26, 0xe9, 0xcb, 0xe2, 0x40, 0xcc, 0x48, 0xb9, 0x12, 0xc1, 0x88, 0x07, 0xff, 0x18,
+	0x26, 0xf9, 0xcc, 0xba, 0x2c, 0x72, 0x6c, 0x59, 0x29, 0xaf, 0x47, 0xb1, 0xf2, 0x83, 0x6f, 0x75,
+	0xe4, 0x42, 0xf0, 0x9e, 0x91, 0x18, 0x7c, 0x47, 0xbb, 0x2b, 0x7f, 0x00, 0x67, 0xbc, 0x56, 0x37,
+	0x23, 0x74, 0x63, 0x36, 0xca, 0x6a, 0x7f, 0x1b, 0x1e, 0xf9, 0x13, 0x38, 0x17, 0xf8, 0xc9, 0x2d,
+	0x2b, 0xf2, 0xf5, 0x5b, 0x2a, 0x6f, 0x44, 0xb5, 0xe4, 0x7b, 0x7d, 0x0a, 0x73, 0xbd, 0x9a, 0xc2,
+	0xbc, 0x10, 0x6e, 0xb7, 0x83, 0x72, 0x73, 0x40, 0x07, 0x0e, 0xe0, 0x1b, 0x09, 0x92, 0xa2, 0xf6,
+	0x54, 0x18, 0x58, 0xe0, 0xa8, 0xdc, 0x1e, 0xd2, 0x91, 0x23, 0xa3, 0x30, 0xd3, 0x39, 0xe3, 0x5e,
+	0x89, 0x14, 0xd3, 0xcb, 0xa6, 0x6b, 0x03, 0x18, 0xf3, 0x4d, 0xeb, 0x10, 0x0f, 0x8e, 0x92, 0xaf,
+	0x09, 0xa3, 0xf8, 0x4d, 0x95, 0xb5, 0xc8, 0xa6, 0xfe, 0xed, 0x82, 0xcd, 0xb3, 0x70, 0xbb, 0x80,
+	0xa9, 0x78, 0xbb, 0x9e, 0x5d, 0xa8, 0x93, 0xd9, 0x81, 0xbe, 0x2b, 0x1b, 0x0d, 0x71, 0xd1, 0x10,
+	0x67, 0x76, 0xaf, 0x0e, 0x47, 0x36, 0xe1, 0x7c, 0x47, 0xb7, 0xbb, 0x1a, 0xf5, 0x40, 0x8a, 0x86,
+	0xb2, 0x1e, 0xdd, 0x96, 0xef, 0xf8, 0xb5, 0x04, 0x4b, 0xe1, 0x0d, 0xd5, 0xf5, 0x01, 0x6e, 0x48,
+	0xdb, 0x4d, 0xb9, 0x35, 0x94, 0x1b, 0xc7, 0xf4, 0xbd, 0x04, 0x97, 0xa3, 0xb4, 0x43, 0xb7, 0xc5,
+	0x87, 0xd9, 0x37, 0x80, 0x52, 0xf8, 0x9f, 0x01, 0xfc, 0x29, 0x19, 0x6c, 0x30, 0x84, 0x29, 0x19,
+	0x30, 0x15, 0xa7, 0x64, 0xcf, 0x36, 0x61, 0xeb, 0xdd, 0x27, 0xc7, 0x29, 0xe9, 0xe9, 0x71, 0x4a,
+	0xfa, 0xed, 0x38, 0x25, 0x7d, 0x75, 0x92, 0x1a, 0x7b, 0x7a, 0x92, 0x1a, 0xfb, 0xf9, 0x24, 0x35,
+	0xf6, 0xe1, 0x86, 0xa6, 0xdb, 0xfb, 0x8d, 0x4a, 0xae, 0x4a, 0xea, 0xf9, 0x8a, 0x51, 0xb9, 0x5a,
+	0xdd, 0x47, 0xba, 0x91, 0xf7, 0xbd, 0x38, 0x39, 0xec, 0x78, 0x03, 0x74, 0x64, 0x62, 0x5a, 0x39,
+	0xc3, 0x5e, 0x9d, 0x5c, 0xfb, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x10, 0x21, 0x2e, 0x37, 0x29, 0x1a,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1674,6 +1914,10 @@ type MsgClient interface {
 	// StorageProviderForcedExit defines a governance operation for a SP to be forced to exit
 	// The authority is defined in the keeper.
 	StorageProviderForcedExit(ctx context.Context, in *MsgStorageProviderForcedExit, opts ...grpc.CallOption) (*MsgStorageProviderForcedExitResponse, error)
+	RebalanceGlobalVirtualGroupFamilies(ctx context.Context, in *MsgRebalanceGlobalVirtualGroupFamilies, opts ...grpc.CallOption) (*MsgRebalanceGlobalVirtualGroupFamiliesResponse, error)
+	// OpenGVGRepair opens a repair window for a gvg whose secondary sps are unhealthy, the unhealthy secondary sps can be
+	// swapped by any in service sp within the window, otherwise the primary sp of the gvg will be slashed.
+	OpenGVGRepair(ctx context.Context, in *MsgOpenGVGRepair, opts ...grpc.CallOption) (*MsgOpenGVGRepairResponse, error)
 }
 
 type msgClient struct {
@@ -1819,6 +2063,24 @@ func (c *msgClient) StorageProviderForcedExit(ctx context.Context, in *MsgStorag
 	return out, nil
 }
 
+func (c *msgClient) RebalanceGlobalVirtualGroupFamilies(ctx context.Context, in *MsgRebalanceGlobalVirtualGroupFamilies, opts ...grpc.CallOption) (*MsgRebalanceGlobalVirtualGroupFamiliesResponse, error) {
+	out := new(MsgRebalanceGlobalVirtualGroupFamiliesResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Msg/RebalanceGlobalVirtualGroupFamilies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OpenGVGRepair(ctx context.Context, in *MsgOpenGVGRepair, opts ...grpc.CallOption) (*MsgOpenGVGRepairResponse, error) {
+	out := new(MsgOpenGVGRepairResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Msg/OpenGVGRepair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateGlobalVirtualGroup(context.Context, *MsgCreateGlobalVirtualGroup) (*MsgCreateGlobalVirtualGroupResponse, error)
@@ -1839,6 +2101,10 @@ type MsgServer interface {
 	// StorageProviderForcedExit defines a governance operation for a SP to be forced to exit
 	// The authority is defined in the keeper.
 	StorageProviderForcedExit(context.Context, *MsgStorageProviderForcedExit) (*MsgStorageProviderForcedExitResponse, error)
+	RebalanceGlobalVirtualGroupFamilies(context.Context, *MsgRebalanceGlobalVirtualGroupFamilies) (*MsgRebalanceGlobalVirtualGroupFamiliesResponse, error)
+	// OpenGVGRepair opens a repair window for a gvg whose secondary sps are unhealthy, the unhealthy secondary sps can be
+	// swapped by any in service sp within the window, otherwise the primary sp of the gvg will be slashed.
+	OpenGVGRepair(context.Context, *MsgOpenGVGRepair) (*MsgOpenGVGRepairResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1890,6 +2156,12 @@ func (*UnimplementedMsgServer) CompleteSwapIn(ctx context.Context, req *MsgCompl
 func (*UnimplementedMsgServer) StorageProviderForcedExit(ctx context.Context, req *MsgStorageProviderForcedExit) (*MsgStorageProviderForcedExitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageProviderForcedExit not implemented")
 }
+func (*UnimplementedMsgServer) RebalanceGlobalVirtualGroupFamilies(ctx context.Context, req *MsgRebalanceGlobalVirtualGroupFamilies) (*MsgRebalanceGlobalVirtualGroupFamiliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalanceGlobalVirtualGroupFamilies not implemented")
+}
+func (*UnimplementedMsgServer) OpenGVGRepair(ctx context.Context, req *MsgOpenGVGRepair) (*MsgOpenGVGRepairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenGVGRepair not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2165,6 +2437,42 @@ func _Msg_StorageProviderForcedExit_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RebalanceGlobalVirtualGroupFamilies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRebalanceGlobalVirtualGroupFamilies)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RebalanceGlobalVirtualGroupFamilies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.virtualgroup.Msg/RebalanceGlobalVirtualGroupFamilies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RebalanceGlobalVirtualGroupFamilies(ctx, req.(*MsgRebalanceGlobalVirtualGroupFamilies))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OpenGVGRepair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOpenGVGRepair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OpenGVGRepair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.virtualgroup.Msg/OpenGVGRepair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OpenGVGRepair(ctx, req.(*MsgOpenGVGRepair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "greenfield.virtualgroup.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2229,6 +2537,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StorageProviderForcedExit",
 			Handler:    _Msg_StorageProviderForcedExit_Handler,
 		},
+		{
+			MethodName: "RebalanceGlobalVirtualGroupFamilies",
+			Handler:    _Msg_RebalanceGlobalVirtualGroupFamilies_Handler,
+		},
+		{
+			MethodName: "OpenGVGRepair",
+			Handler:    _Msg_OpenGVGRepair_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "greenfield/virtualgroup/tx.proto",
@@ -3265,55 +3581,217 @@ func (m *MsgStorageProviderForcedExitResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRebalanceGlobalVirtualGroupFamilies) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRebalanceGlobalVirtualGroupFamilies) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRebalanceGlobalVirtualGroupFamilies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.TargetSpIds) > 0 {
+		dAtA17 := make([]byte, len(m.TargetSpIds)*10)
+		var j16 int
+		for _, num := range m.TargetSpIds {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintTx(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
+		dAtA19 := make([]byte, len(m.GlobalVirtualGroupFamilyIds)*10)
+		var j18 int
+		for _, num := range m.GlobalVirtualGroupFamilyIds {
+			for num >= 1<<7 {
+				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j18++
+			}
+			dAtA19[j18] = uint8(num)
+			j18++
+		}
+		i -= j18
+		copy(dAtA[i:], dAtA19[:j18])
+		i = encodeVarintTx(dAtA, i, uint64(j18))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StorageProviderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRebalanceGlobalVirtualGroupFamiliesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateGlobalVirtualGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRebalanceGlobalVirtualGroupFamiliesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRebalanceGlobalVirtualGroupFamiliesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.StorageProvider)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.FamilyId != 0 {
-		n += 1 + sovTx(uint64(m.FamilyId))
+	if m.RebalanceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RebalanceId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.SecondarySpIds) > 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenGVGRepair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenGVGRepair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenGVGRepair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenGVGRepairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenGVGRepairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenGVGRepairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateGlobalVirtualGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StorageProvider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FamilyId != 0 {
+		n += 1 + sovTx(uint64(m.FamilyId))
+	}
+	if len(m.SecondarySpIds) > 0 {
 		l = 0
 		for _, e := range m.SecondarySpIds {
 			l += sovTx(uint64(e))
@@ -3709,6 +4187,76 @@ func (m *MsgStorageProviderForcedExitResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRebalanceGlobalVirtualGroupFamilies) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StorageProviderId != 0 {
+		n += 1 + sovTx(uint64(m.StorageProviderId))
+	}
+	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
+		l = 0
+		for _, e := range m.GlobalVirtualGroupFamilyIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.TargetSpIds) > 0 {
+		l = 0
+		for _, e := range m.TargetSpIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgRebalanceGlobalVirtualGroupFamiliesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RebalanceId != 0 {
+		n += 1 + sovTx(uint64(m.RebalanceId))
+	}
+	return n
+}
+
+func (m *MsgOpenGVGRepair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovTx(uint64(m.GlobalVirtualGroupId))
+	}
+	return n
+}
+
+func (m *MsgOpenGVGRepairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Deadline != 0 {
+		n += 1 + sovTx(uint64(m.Deadline))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6611,6 +7159,498 @@ func (m *MsgStorageProviderForcedExitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRebalanceGlobalVirtualGroupFamilies) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRebalanceGlobalVirtualGroupFamilies: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRebalanceGlobalVirtualGroupFamilies: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
+			}
+			m.StorageProviderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageProviderId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GlobalVirtualGroupFamilyIds) == 0 {
+					m.GlobalVirtualGroupFamilyIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyIds", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TargetSpIds = append(m.TargetSpIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TargetSpIds) == 0 {
+					m.TargetSpIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TargetSpIds = append(m.TargetSpIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSpIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRebalanceGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRebalanceGlobalVirtualGroupFamiliesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRebalanceGlobalVirtualGroupFamiliesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceId", wireType)
+			}
+			m.RebalanceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RebalanceId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenGVGRepair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenGVGRepair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenGVGRepair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenGVGRepairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenGVGRepairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenGVGRepairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -25,6 +25,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// FamilyRebalanceStatus represents the progress of moving a family in a rebalance
+type FamilyRebalanceStatus int32
+
+const (
+	// the swap in of the target sp is reserved, waiting for the target sp to complete it
+	FAMILY_REBALANCE_STATUS_PENDING FamilyRebalanceStatus = 0
+	// the target sp has become the primary sp of the family
+	FAMILY_REBALANCE_STATUS_COMPLETED FamilyRebalanceStatus = 1
+	// the swap in is canceled by the target sp, or the family is moved to another sp
+	FAMILY_REBALANCE_STATUS_CANCELED FamilyRebalanceStatus = 2
+)
+
+var FamilyRebalanceStatus_name = map[int32]string{
+	0: "FAMILY_REBALANCE_STATUS_PENDING",
+	1: "FAMILY_REBALANCE_STATUS_COMPLETED",
+	2: "FAMILY_REBALANCE_STATUS_CANCELED",
+}
+
+var FamilyRebalanceStatus_value = map[string]int32{
+	"FAMILY_REBALANCE_STATUS_PENDING":   0,
+	"FAMILY_REBALANCE_STATUS_COMPLETED": 1,
+	"FAMILY_REBALANCE_STATUS_CANCELED":  2,
+}
+
+func (x FamilyRebalanceStatus) String() string {
+	return proto.EnumName(FamilyRebalanceStatus_name, int32(x))
+}
+
+func (FamilyRebalanceStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1fe6fc664532d0c3, []int{0}
+}
+
 // A global virtual group consists of one primary SP (SP) and multiple secondary SP.
 // Every global virtual group must belong to a GVG family, and the objects of each
 // bucket must be stored in a GVG within a group family.
@@ -443,6 +475,9 @@ type SwapInInfo struct {
 	TargetSpId uint32 `protobuf:"varint,2,opt,name=target_sp_id,json=targetSpId,proto3" json:"target_sp_id,omitempty"`
 	// expiration_time is the expiration of epoch time for the swapInInfo
 	ExpirationTime uint64 `protobuf:"varint,3,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+	// reserved_size is the capacity reserved from the successor sp for the swap in, it is released
+	// once the swap in is canceled or expired.
+	ReservedSize uint64 `protobuf:"varint,4,opt,name=reserved_size,json=reservedSize,proto3" json:"reserved_size,omitempty"`
 }
 
 func (m *SwapInInfo) Reset()         { *m = SwapInInfo{} }
@@ -499,7 +534,326 @@ func (m *SwapInInfo) GetExpirationTime() uint64 {
 	return 0
 }
 
+func (m *SwapInInfo) GetReservedSize() uint64 {
+	if m != nil {
+		return m.ReservedSize
+	}
+	return 0
+}
+
+// FamilyRebalanceEntry is the progress of moving a family to its target sp in a rebalance
+type FamilyRebalanceEntry struct {
+	// global_virtual_group_family_id is the id of the family to be moved
+	GlobalVirtualGroupFamilyId uint32 `protobuf:"varint,1,opt,name=global_virtual_group_family_id,json=globalVirtualGroupFamilyId,proto3" json:"global_virtual_group_family_id,omitempty"`
+	// target_sp_id is the id of the sp picked as the new primary sp of the family
+	TargetSpId uint32 `protobuf:"varint,2,opt,name=target_sp_id,json=targetSpId,proto3" json:"target_sp_id,omitempty"`
+	// status is the progress of the family
+	Status FamilyRebalanceStatus `protobuf:"varint,3,opt,name=status,proto3,enum=greenfield.virtualgroup.FamilyRebalanceStatus" json:"status,omitempty"`
+}
+
+func (m *FamilyRebalanceEntry) Reset()         { *m = FamilyRebalanceEntry{} }
+func (m *FamilyRebalanceEntry) String() string { return proto.CompactTextString(m) }
+func (*FamilyRebalanceEntry) ProtoMessage()    {}
+func (*FamilyRebalanceEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fe6fc664532d0c3, []int{7}
+}
+func (m *FamilyRebalanceEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FamilyRebalanceEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FamilyRebalanceEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FamilyRebalanceEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FamilyRebalanceEntry.Merge(m, src)
+}
+func (m *FamilyRebalanceEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *FamilyRebalanceEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_FamilyRebalanceEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FamilyRebalanceEntry proto.InternalMessageInfo
+
+func (m *FamilyRebalanceEntry) GetGlobalVirtualGroupFamilyId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupFamilyId
+	}
+	return 0
+}
+
+func (m *FamilyRebalanceEntry) GetTargetSpId() uint32 {
+	if m != nil {
+		return m.TargetSpId
+	}
+	return 0
+}
+
+func (m *FamilyRebalanceEntry) GetStatus() FamilyRebalanceStatus {
+	if m != nil {
+		return m.Status
+	}
+	return FAMILY_REBALANCE_STATUS_PENDING
+}
+
+// FamilyRebalance is a batch of families moved out of a primary sp
+type FamilyRebalance struct {
+	// id is the unique id of the rebalance
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// initiator is the address of the gov module account or the operator of the source sp
+	Initiator string `protobuf:"bytes,2,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	// storage_provider_id is the id of the source primary sp
+	StorageProviderId uint32 `protobuf:"varint,3,opt,name=storage_provider_id,json=storageProviderId,proto3" json:"storage_provider_id,omitempty"`
+	// create_time is the unix timestamp when the rebalance is started
+	CreateTime int64 `protobuf:"varint,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// entries are the progress of the families in the rebalance
+	Entries []FamilyRebalanceEntry `protobuf:"bytes,5,rep,name=entries,proto3" json:"entries"`
+	// finished is true when none of the families is pending
+	Finished bool `protobuf:"varint,6,opt,name=finished,proto3" json:"finished,omitempty"`
+}
+
+func (m *FamilyRebalance) Reset()         { *m = FamilyRebalance{} }
+func (m *FamilyRebalance) String() string { return proto.CompactTextString(m) }
+func (*FamilyRebalance) ProtoMessage()    {}
+func (*FamilyRebalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fe6fc664532d0c3, []int{8}
+}
+func (m *FamilyRebalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FamilyRebalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FamilyRebalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FamilyRebalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FamilyRebalance.Merge(m, src)
+}
+func (m *FamilyRebalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *FamilyRebalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_FamilyRebalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FamilyRebalance proto.InternalMessageInfo
+
+func (m *FamilyRebalance) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FamilyRebalance) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
+	}
+	return ""
+}
+
+func (m *FamilyRebalance) GetStorageProviderId() uint32 {
+	if m != nil {
+		return m.StorageProviderId
+	}
+	return 0
+}
+
+func (m *FamilyRebalance) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+func (m *FamilyRebalance) GetEntries() []FamilyRebalanceEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *FamilyRebalance) GetFinished() bool {
+	if m != nil {
+		return m.Finished
+	}
+	return false
+}
+
+// GVGRepair is an open repair window of a gvg which has unhealthy secondary sps
+type GVGRepair struct {
+	// global_virtual_group_id is the id of the gvg to be repaired
+	GlobalVirtualGroupId uint32 `protobuf:"varint,1,opt,name=global_virtual_group_id,json=globalVirtualGroupId,proto3" json:"global_virtual_group_id,omitempty"`
+	// primary_sp_id is the id of the primary sp of the gvg
+	PrimarySpId uint32 `protobuf:"varint,2,opt,name=primary_sp_id,json=primarySpId,proto3" json:"primary_sp_id,omitempty"`
+	// unhealthy_sp_ids are the secondary sps which were unhealthy when the repair is opened, which are slashed
+	// if they are still unhealthy at the deadline
+	UnhealthySpIds []uint32 `protobuf:"varint,3,rep,packed,name=unhealthy_sp_ids,json=unhealthySpIds,proto3" json:"unhealthy_sp_ids,omitempty"`
+	// deadline is the unix timestamp before which the gvg should be repaired
+	Deadline int64 `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// reporter is the address who opens the repair, which receives the slashed amount if the gvg is not repaired in time
+	Reporter string `protobuf:"bytes,5,opt,name=reporter,proto3" json:"reporter,omitempty"`
+}
+
+func (m *GVGRepair) Reset()         { *m = GVGRepair{} }
+func (m *GVGRepair) String() string { return proto.CompactTextString(m) }
+func (*GVGRepair) ProtoMessage()    {}
+func (*GVGRepair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fe6fc664532d0c3, []int{9}
+}
+func (m *GVGRepair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GVGRepair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GVGRepair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GVGRepair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GVGRepair.Merge(m, src)
+}
+func (m *GVGRepair) XXX_Size() int {
+	return m.Size()
+}
+func (m *GVGRepair) XXX_DiscardUnknown() {
+	xxx_messageInfo_GVGRepair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GVGRepair proto.InternalMessageInfo
+
+func (m *GVGRepair) GetGlobalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupId
+	}
+	return 0
+}
+
+func (m *GVGRepair) GetPrimarySpId() uint32 {
+	if m != nil {
+		return m.PrimarySpId
+	}
+	return 0
+}
+
+func (m *GVGRepair) GetUnhealthySpIds() []uint32 {
+	if m != nil {
+		return m.UnhealthySpIds
+	}
+	return nil
+}
+
+func (m *GVGRepair) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *GVGRepair) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+// UnhealthyGlobalVirtualGroup is a gvg whose secondary sps are jailed, exiting or in maintenance
+type UnhealthyGlobalVirtualGroup struct {
+	// global_virtual_group_id is the id of the gvg
+	GlobalVirtualGroupId uint32 `protobuf:"varint,1,opt,name=global_virtual_group_id,json=globalVirtualGroupId,proto3" json:"global_virtual_group_id,omitempty"`
+	// primary_sp_id is the id of the primary sp of the gvg
+	PrimarySpId uint32 `protobuf:"varint,2,opt,name=primary_sp_id,json=primarySpId,proto3" json:"primary_sp_id,omitempty"`
+	// unhealthy_sp_ids are the ids of the unhealthy secondary sps
+	UnhealthySpIds []uint32 `protobuf:"varint,3,rep,packed,name=unhealthy_sp_ids,json=unhealthySpIds,proto3" json:"unhealthy_sp_ids,omitempty"`
+	// repair_deadline is the deadline of the open repair of the gvg, 0 if no repair is open
+	RepairDeadline int64 `protobuf:"varint,4,opt,name=repair_deadline,json=repairDeadline,proto3" json:"repair_deadline,omitempty"`
+}
+
+func (m *UnhealthyGlobalVirtualGroup) Reset()         { *m = UnhealthyGlobalVirtualGroup{} }
+func (m *UnhealthyGlobalVirtualGroup) String() string { return proto.CompactTextString(m) }
+func (*UnhealthyGlobalVirtualGroup) ProtoMessage()    {}
+func (*UnhealthyGlobalVirtualGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fe6fc664532d0c3, []int{10}
+}
+func (m *UnhealthyGlobalVirtualGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnhealthyGlobalVirtualGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnhealthyGlobalVirtualGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnhealthyGlobalVirtualGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnhealthyGlobalVirtualGroup.Merge(m, src)
+}
+func (m *UnhealthyGlobalVirtualGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnhealthyGlobalVirtualGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnhealthyGlobalVirtualGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnhealthyGlobalVirtualGroup proto.InternalMessageInfo
+
+func (m *UnhealthyGlobalVirtualGroup) GetGlobalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupId
+	}
+	return 0
+}
+
+func (m *UnhealthyGlobalVirtualGroup) GetPrimarySpId() uint32 {
+	if m != nil {
+		return m.PrimarySpId
+	}
+	return 0
+}
+
+func (m *UnhealthyGlobalVirtualGroup) GetUnhealthySpIds() []uint32 {
+	if m != nil {
+		return m.UnhealthySpIds
+	}
+	return nil
+}
+
+func (m *UnhealthyGlobalVirtualGroup) GetRepairDeadline() int64 {
+	if m != nil {
+		return m.RepairDeadline
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("greenfield.virtualgroup.FamilyRebalanceStatus", FamilyRebalanceStatus_name, FamilyRebalanceStatus_value)
 	proto.RegisterType((*GlobalVirtualGroup)(nil), "greenfield.virtualgroup.GlobalVirtualGroup")
 	proto.RegisterType((*GlobalVirtualGroupFamily)(nil), "greenfield.virtualgroup.GlobalVirtualGroupFamily")
 	proto.RegisterType((*GlobalVirtualGroupsBindingOnBucket)(nil), "greenfield.virtualgroup.GlobalVirtualGroupsBindingOnBucket")
@@ -507,6 +861,10 @@ func init() {
 	proto.RegisterType((*GVGFamilyStatisticsWithinSP)(nil), "greenfield.virtualgroup.GVGFamilyStatisticsWithinSP")
 	proto.RegisterType((*SwapOutInfo)(nil), "greenfield.virtualgroup.SwapOutInfo")
 	proto.RegisterType((*SwapInInfo)(nil), "greenfield.virtualgroup.SwapInInfo")
+	proto.RegisterType((*FamilyRebalanceEntry)(nil), "greenfield.virtualgroup.FamilyRebalanceEntry")
+	proto.RegisterType((*FamilyRebalance)(nil), "greenfield.virtualgroup.FamilyRebalance")
+	proto.RegisterType((*GVGRepair)(nil), "greenfield.virtualgroup.GVGRepair")
+	proto.RegisterType((*UnhealthyGlobalVirtualGroup)(nil), "greenfield.virtualgroup.UnhealthyGlobalVirtualGroup")
 }
 
 func init() {
@@ -514,54 +872,76 @@ func init() {
 }
 
 var fileDescriptor_1fe6fc664532d0c3 = []byte{
-	// 744 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x4f, 0x1b, 0x39,
-	0x18, 0xce, 0x84, 0xc0, 0x12, 0x87, 0xc0, 0xee, 0x00, 0x62, 0x96, 0xac, 0x92, 0x68, 0x90, 0x20,
-	0x97, 0x24, 0x87, 0xdd, 0xd5, 0xee, 0xa1, 0x97, 0xa6, 0x88, 0x28, 0x3d, 0x94, 0x68, 0xd2, 0x52,
-	0xa9, 0x97, 0x91, 0x33, 0x36, 0x83, 0x45, 0xc6, 0x9e, 0xda, 0x9e, 0x94, 0x70, 0xe9, 0x5f, 0xe8,
-	0x8f, 0xe1, 0x47, 0x70, 0xaa, 0x10, 0xa7, 0xb6, 0x52, 0x51, 0x05, 0xea, 0xff, 0xa8, 0xc6, 0x36,
-	0x09, 0x34, 0x29, 0x95, 0x7a, 0x4a, 0xe6, 0x79, 0x9f, 0xf7, 0xb1, 0xde, 0xe7, 0xfd, 0x00, 0x5b,
-	0x21, 0xc7, 0x98, 0x1e, 0x12, 0x3c, 0x40, 0xcd, 0x21, 0xe1, 0x32, 0x81, 0x83, 0x90, 0xb3, 0x24,
-	0x6e, 0xca, 0x51, 0x8c, 0x45, 0x23, 0xe6, 0x4c, 0x32, 0x7b, 0x63, 0x42, 0x6a, 0xdc, 0x25, 0x6d,
-	0xfe, 0x19, 0x30, 0x11, 0x31, 0xe1, 0x2b, 0x5a, 0x53, 0x7f, 0xe8, 0x9c, 0xcd, 0xb5, 0x90, 0x85,
-	0x4c, 0xe3, 0xe9, 0x3f, 0x8d, 0xba, 0x5f, 0xb3, 0xc0, 0x6e, 0x0f, 0x58, 0x1f, 0x0e, 0x0e, 0xb4,
-	0x4e, 0x3b, 0xd5, 0xb1, 0x97, 0x41, 0x96, 0x20, 0xc7, 0xaa, 0x5a, 0xb5, 0xa2, 0x97, 0x25, 0xc8,
-	0x2e, 0x81, 0xfc, 0x21, 0x8c, 0xc8, 0x60, 0xe4, 0x13, 0xe4, 0x64, 0x15, 0xbc, 0xa8, 0x81, 0x0e,
-	0xb2, 0x5d, 0x50, 0x8c, 0x39, 0x89, 0x20, 0x1f, 0xf9, 0x22, 0x4e, 0x09, 0x73, 0x8a, 0x50, 0x30,
-	0x60, 0x2f, 0xee, 0x20, 0xbb, 0x06, 0x7e, 0x17, 0x38, 0x60, 0x14, 0x8d, 0x59, 0xc2, 0xc9, 0x55,
-	0xe7, 0x6a, 0x45, 0x6f, 0x79, 0x8c, 0xa7, 0x44, 0x61, 0x57, 0x40, 0x41, 0x48, 0xc6, 0x31, 0xf2,
-	0x05, 0x39, 0xc5, 0xce, 0x7c, 0xd5, 0xaa, 0xe5, 0x3c, 0xa0, 0xa1, 0x1e, 0x39, 0xc5, 0x76, 0x17,
-	0x6c, 0x98, 0x9a, 0xfd, 0x18, 0x8e, 0x22, 0x4c, 0xa5, 0x0f, 0x11, 0xe2, 0x58, 0x08, 0x67, 0xa1,
-	0x6a, 0xd5, 0xf2, 0x2d, 0xe7, 0xf2, 0xac, 0xbe, 0x66, 0x6a, 0x7f, 0xac, 0x23, 0x3d, 0xc9, 0x09,
-	0x0d, 0xbd, 0x75, 0x93, 0xd8, 0xd5, 0x79, 0x26, 0x68, 0x43, 0x50, 0x94, 0x4c, 0xc2, 0x81, 0x8f,
-	0x70, 0xcc, 0x04, 0x91, 0xce, 0x6f, 0x4a, 0xe7, 0xd1, 0xf9, 0x55, 0x25, 0xf3, 0xe9, 0xaa, 0xb2,
-	0x1d, 0x12, 0x79, 0x94, 0xf4, 0x1b, 0x01, 0x8b, 0x8c, 0xa5, 0xe6, 0xa7, 0x2e, 0xd0, 0xb1, 0xe9,
-	0x4b, 0x87, 0xca, 0xcb, 0xb3, 0x3a, 0x30, 0xaf, 0x76, 0xa8, 0xf4, 0x96, 0x94, 0xe4, 0xae, 0x56,
-	0x74, 0x3f, 0x5a, 0xc0, 0x99, 0xf6, 0x79, 0x4f, 0x59, 0x38, 0xe5, 0xf6, 0x94, 0xa1, 0xd9, 0x69,
-	0x43, 0xff, 0x03, 0x4e, 0xa8, 0xf4, 0xfc, 0x5b, 0x33, 0xd4, 0x04, 0x28, 0x63, 0xe7, 0x94, 0xb1,
-	0xeb, 0xe1, 0xd4, 0x7b, 0xa9, 0xbf, 0x0f, 0xd8, 0x97, 0xfb, 0x25, 0xfb, 0xdc, 0xf7, 0x16, 0x70,
-	0xa7, 0x6b, 0x13, 0x2d, 0x42, 0x11, 0xa1, 0xe1, 0x3e, 0x6d, 0x25, 0xc1, 0x31, 0x96, 0xf6, 0xff,
-	0x20, 0xdf, 0x57, 0xff, 0x7c, 0x53, 0x6c, 0xbe, 0x55, 0x32, 0x0e, 0xe7, 0x5e, 0x10, 0xe5, 0x5f,
-	0xc1, 0x3c, 0x9b, 0x7e, 0x7a, 0x8b, 0x9a, 0xfd, 0x93, 0x5a, 0xb3, 0x0f, 0xd5, 0xfa, 0x2f, 0xd8,
-	0x18, 0xb0, 0xe0, 0x01, 0x8f, 0xd6, 0x54, 0xf8, 0xbb, 0x34, 0xf7, 0xb3, 0x05, 0xd6, 0xdb, 0x07,
-	0xed, 0x9e, 0x84, 0x92, 0x08, 0x49, 0x02, 0xf1, 0x92, 0xc8, 0x23, 0x42, 0x7b, 0x5d, 0xbb, 0x01,
-	0x56, 0xd3, 0x49, 0x84, 0x21, 0x4e, 0x57, 0x6c, 0x48, 0x10, 0xe6, 0xfe, 0xb8, 0x75, 0x7f, 0x98,
-	0x50, 0xd7, 0x44, 0x3a, 0xc8, 0xde, 0x9a, 0x74, 0x32, 0x60, 0x09, 0x95, 0xa6, 0x93, 0x4b, 0x06,
-	0x7c, 0x92, 0x62, 0xf6, 0x0e, 0x58, 0x99, 0xec, 0x86, 0xa6, 0xe9, 0x0d, 0x9a, 0xac, 0x86, 0x26,
-	0xee, 0x81, 0x6a, 0x9f, 0x63, 0x78, 0xec, 0x73, 0x8c, 0x12, 0x8a, 0x20, 0x0d, 0x46, 0x3e, 0xc7,
-	0xaf, 0x23, 0xe9, 0x87, 0xc3, 0xd0, 0x64, 0xe6, 0x54, 0xe6, 0x5f, 0x8a, 0xe7, 0x8d, 0x69, 0x5e,
-	0xca, 0x6a, 0x0f, 0x43, 0xa5, 0xe3, 0x9e, 0x80, 0x52, 0xfb, 0xa0, 0xad, 0x87, 0x6f, 0x46, 0x91,
-	0xab, 0x60, 0x5e, 0x8f, 0x9d, 0x2e, 0x2b, 0x27, 0xd2, 0x79, 0xdb, 0x05, 0x95, 0x99, 0x3d, 0x18,
-	0x9f, 0x85, 0xdb, 0x56, 0x94, 0xc2, 0x1f, 0x8c, 0x79, 0xea, 0xec, 0x53, 0x50, 0xe8, 0xbd, 0x81,
-	0xf1, 0x7e, 0x22, 0x3b, 0xf4, 0x90, 0xcd, 0x7e, 0x69, 0x1b, 0xac, 0x88, 0x24, 0x08, 0xb0, 0x10,
-	0x8c, 0xdf, 0x9b, 0xff, 0xe2, 0x18, 0x4e, 0x37, 0xc0, 0x7d, 0x0b, 0x40, 0xaa, 0xd5, 0xa1, 0x4a,
-	0x6a, 0x46, 0x96, 0x35, 0x23, 0xcb, 0xae, 0x82, 0x25, 0x09, 0x79, 0x88, 0xe5, 0x3d, 0x69, 0xa0,
-	0x31, 0xc5, 0xd8, 0x01, 0x2b, 0xf8, 0x24, 0x26, 0x1c, 0x4a, 0xc2, 0xa8, 0x2f, 0x49, 0x84, 0x55,
-	0x3b, 0x72, 0xde, 0xf2, 0x04, 0x7e, 0x4e, 0x22, 0xdc, 0x7a, 0x76, 0x7e, 0x5d, 0xb6, 0x2e, 0xae,
-	0xcb, 0xd6, 0x97, 0xeb, 0xb2, 0xf5, 0xee, 0xa6, 0x9c, 0xb9, 0xb8, 0x29, 0x67, 0x3e, 0xdc, 0x94,
-	0x33, 0xaf, 0xfe, 0xb9, 0x73, 0x31, 0xfa, 0xb4, 0x5f, 0x0f, 0x8e, 0x20, 0xa1, 0xcd, 0x3b, 0x97,
-	0xfd, 0x64, 0xc6, 0x6d, 0xef, 0x2f, 0xa8, 0x93, 0xfc, 0xf7, 0xb7, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xee, 0xc6, 0xf3, 0x35, 0x03, 0x06, 0x00, 0x00,
+	// 1090 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcb, 0x6e, 0x23, 0x45,
+	0x14, 0x75, 0xdb, 0x9e, 0x8c, 0x7d, 0x9d, 0x38, 0xa1, 0x27, 0x51, 0x8c, 0x83, 0x6c, 0xd3, 0x81,
+	0x89, 0x85, 0x14, 0x47, 0x1a, 0x86, 0xc7, 0x82, 0x4d, 0x1c, 0x3b, 0x96, 0x51, 0x1e, 0x56, 0x3b,
+	0x09, 0x82, 0x4d, 0xab, 0xdc, 0x5d, 0x69, 0x97, 0x62, 0x57, 0x37, 0x55, 0xe5, 0x10, 0xcf, 0x17,
+	0xb0, 0x83, 0x0d, 0x5f, 0x00, 0x9f, 0x30, 0x9f, 0xc0, 0x62, 0x24, 0x24, 0x34, 0x9a, 0x15, 0x20,
+	0x31, 0x42, 0x89, 0xf8, 0x0f, 0xd4, 0x55, 0xed, 0x76, 0x26, 0x7e, 0x10, 0xb1, 0x62, 0x65, 0xd7,
+	0xa9, 0x73, 0x6f, 0xf5, 0x3d, 0xf7, 0x51, 0x05, 0x9b, 0x2e, 0xc3, 0x98, 0x9e, 0x13, 0xdc, 0x73,
+	0x76, 0x2e, 0x09, 0x13, 0x03, 0xd4, 0x73, 0x99, 0x37, 0xf0, 0x77, 0xc4, 0xd0, 0xc7, 0xbc, 0xe2,
+	0x33, 0x4f, 0x78, 0xfa, 0xfa, 0x98, 0x54, 0xb9, 0x4d, 0xca, 0xbf, 0x6d, 0x7b, 0xbc, 0xef, 0x71,
+	0x4b, 0xd2, 0x76, 0xd4, 0x42, 0xd9, 0xe4, 0x57, 0x5d, 0xcf, 0xf5, 0x14, 0x1e, 0xfc, 0x53, 0xa8,
+	0xf1, 0x77, 0x1c, 0xf4, 0x46, 0xcf, 0xeb, 0xa0, 0xde, 0x99, 0xf2, 0xd3, 0x08, 0xfc, 0xe8, 0x59,
+	0x88, 0x13, 0x27, 0xa7, 0x95, 0xb4, 0xf2, 0x92, 0x19, 0x27, 0x8e, 0xbe, 0x01, 0xe9, 0x73, 0xd4,
+	0x27, 0xbd, 0xa1, 0x45, 0x9c, 0x5c, 0x5c, 0xc2, 0x29, 0x05, 0x34, 0x1d, 0xdd, 0x80, 0x25, 0x9f,
+	0x91, 0x3e, 0x62, 0x43, 0x8b, 0xfb, 0x01, 0x21, 0x21, 0x09, 0x99, 0x10, 0x6c, 0xfb, 0x4d, 0x47,
+	0x2f, 0xc3, 0x0a, 0xc7, 0xb6, 0x47, 0x9d, 0x88, 0xc5, 0x73, 0xc9, 0x52, 0xa2, 0xbc, 0x64, 0x66,
+	0x23, 0x3c, 0x20, 0x72, 0xbd, 0x08, 0x19, 0x2e, 0x3c, 0x86, 0x1d, 0x8b, 0x93, 0x67, 0x38, 0xf7,
+	0xa0, 0xa4, 0x95, 0x93, 0x26, 0x28, 0xa8, 0x4d, 0x9e, 0x61, 0xbd, 0x05, 0xeb, 0x61, 0xcc, 0x96,
+	0x8f, 0x86, 0x7d, 0x4c, 0x85, 0x85, 0x1c, 0x87, 0x61, 0xce, 0x73, 0x0b, 0x25, 0xad, 0x9c, 0xae,
+	0xe6, 0x5e, 0x3d, 0xdf, 0x5e, 0x0d, 0x63, 0xdf, 0x55, 0x3b, 0x6d, 0xc1, 0x08, 0x75, 0xcd, 0xb5,
+	0xd0, 0xb0, 0xa5, 0xec, 0xc2, 0x4d, 0x1d, 0xc1, 0x92, 0xf0, 0x04, 0xea, 0x59, 0x0e, 0xf6, 0x3d,
+	0x4e, 0x44, 0xee, 0xa1, 0xf4, 0xf3, 0xd9, 0x8b, 0xd7, 0xc5, 0xd8, 0x1f, 0xaf, 0x8b, 0x8f, 0x5d,
+	0x22, 0xba, 0x83, 0x4e, 0xc5, 0xf6, 0xfa, 0xa1, 0xa4, 0xe1, 0xcf, 0x36, 0x77, 0x2e, 0xc2, 0xbc,
+	0x34, 0xa9, 0x78, 0xf5, 0x7c, 0x1b, 0xc2, 0x53, 0x9b, 0x54, 0x98, 0x8b, 0xd2, 0x65, 0x4d, 0x79,
+	0x34, 0x7e, 0xd7, 0x20, 0x37, 0xa9, 0xf3, 0xbe, 0x94, 0x70, 0x42, 0xed, 0x09, 0x41, 0xe3, 0x93,
+	0x82, 0x7e, 0x02, 0x39, 0x57, 0xfa, 0xb3, 0x46, 0x62, 0xc8, 0x0a, 0x90, 0xc2, 0x26, 0xa4, 0xb0,
+	0x6b, 0xee, 0xc4, 0x79, 0x81, 0xbe, 0x73, 0xe4, 0x4b, 0xfe, 0x27, 0xf9, 0x8c, 0x5f, 0x35, 0x30,
+	0x26, 0x63, 0xe3, 0x55, 0x42, 0x1d, 0x42, 0xdd, 0x63, 0x5a, 0x1d, 0xd8, 0x17, 0x58, 0xe8, 0x9f,
+	0x42, 0xba, 0x23, 0xff, 0x59, 0x61, 0xb0, 0xe9, 0xea, 0x46, 0xa8, 0x70, 0xf2, 0x94, 0x48, 0xfd,
+	0x32, 0xe1, 0xb1, 0xc1, 0xd2, 0x4c, 0x29, 0xf6, 0xbf, 0xc4, 0x1a, 0x9f, 0x17, 0xeb, 0x47, 0xb0,
+	0xde, 0xf3, 0xec, 0x39, 0x1a, 0xad, 0xca, 0xed, 0x3b, 0x66, 0xc6, 0x9f, 0x1a, 0xac, 0x35, 0xce,
+	0x1a, 0x6d, 0x81, 0x04, 0xe1, 0x82, 0xd8, 0xfc, 0x0b, 0x22, 0xba, 0x84, 0xb6, 0x5b, 0x7a, 0x05,
+	0x1e, 0x05, 0x95, 0x88, 0x5c, 0x1c, 0xb4, 0xd8, 0x25, 0x71, 0x30, 0xb3, 0xa2, 0xd4, 0xbd, 0x15,
+	0x6e, 0xb5, 0xc2, 0x9d, 0xa6, 0xa3, 0x6f, 0x8e, 0x33, 0x69, 0x7b, 0x03, 0x2a, 0xc2, 0x4c, 0x2e,
+	0x86, 0xe0, 0x5e, 0x80, 0xe9, 0x5b, 0xb0, 0x3c, 0xee, 0x0d, 0x45, 0x53, 0x1d, 0x34, 0x6e, 0x0d,
+	0x45, 0xdc, 0x87, 0x52, 0x87, 0x61, 0x74, 0x61, 0x31, 0xec, 0x0c, 0xa8, 0x83, 0xa8, 0x3d, 0xb4,
+	0x18, 0xfe, 0xba, 0x2f, 0x2c, 0xf7, 0xd2, 0x0d, 0x2d, 0x93, 0xd2, 0xf2, 0x1d, 0xc9, 0x33, 0x23,
+	0x9a, 0x19, 0xb0, 0x1a, 0x97, 0xae, 0xf4, 0x63, 0x5c, 0xc1, 0x46, 0xe3, 0xac, 0xa1, 0x8a, 0x6f,
+	0x4a, 0x90, 0x8f, 0xe0, 0x81, 0x2a, 0x3b, 0x15, 0x56, 0x92, 0x07, 0xf5, 0x56, 0x83, 0xe2, 0xd4,
+	0x1c, 0x44, 0x63, 0x61, 0x94, 0x8a, 0x0d, 0x77, 0x46, 0x99, 0x07, 0xca, 0x7e, 0x0e, 0x99, 0xf6,
+	0x37, 0xc8, 0x3f, 0x1e, 0x88, 0x26, 0x3d, 0xf7, 0xa6, 0x9f, 0xf4, 0x18, 0x96, 0xf9, 0xc0, 0xb6,
+	0x31, 0xe7, 0x1e, 0x7b, 0xa3, 0xfe, 0x97, 0x22, 0x38, 0xe8, 0x00, 0xe3, 0x27, 0x0d, 0x20, 0x70,
+	0xd6, 0xa4, 0xd2, 0xd7, 0x14, 0x33, 0x6d, 0x8a, 0x99, 0x5e, 0x82, 0x45, 0x81, 0x98, 0x8b, 0xc5,
+	0x1b, 0xbe, 0x41, 0x61, 0x92, 0xb1, 0x05, 0xcb, 0xf8, 0xca, 0x27, 0x0c, 0x09, 0xe2, 0x51, 0x4b,
+	0x90, 0x3e, 0x96, 0xf9, 0x48, 0x9a, 0xd9, 0x31, 0x7c, 0x42, 0xfa, 0x38, 0xc8, 0x2e, 0xc3, 0x1c,
+	0xb3, 0xcb, 0xd1, 0xb0, 0x4a, 0x4a, 0xda, 0xe2, 0x08, 0x0c, 0xc6, 0x95, 0xf1, 0xb3, 0x06, 0xab,
+	0x4a, 0x00, 0x13, 0x77, 0x50, 0x0f, 0x51, 0x1b, 0xd7, 0xa9, 0x60, 0x43, 0xbd, 0x0a, 0x85, 0xf9,
+	0x8a, 0x86, 0xdf, 0x9f, 0x9f, 0x2d, 0xe8, 0x3d, 0x82, 0xd9, 0x87, 0x05, 0x2e, 0x90, 0x18, 0x70,
+	0x19, 0x43, 0xf6, 0x49, 0xa5, 0x32, 0xe3, 0xee, 0xa8, 0xdc, 0xf9, 0xc8, 0xb6, 0xb4, 0x32, 0x43,
+	0x6b, 0xe3, 0x87, 0x38, 0x2c, 0xdf, 0x61, 0x4c, 0xcc, 0xad, 0x8f, 0x21, 0x4d, 0x28, 0x11, 0x04,
+	0x09, 0x8f, 0xc9, 0x4f, 0x99, 0x37, 0x4c, 0xc6, 0xd4, 0x59, 0x5d, 0x95, 0x98, 0xd5, 0x55, 0x45,
+	0xc8, 0xd8, 0x0c, 0x23, 0x81, 0x55, 0x72, 0x02, 0xd5, 0x13, 0x26, 0x28, 0x48, 0x26, 0xe6, 0x10,
+	0x1e, 0x62, 0x2a, 0x18, 0xc1, 0x3c, 0xf7, 0xa0, 0x94, 0x28, 0x67, 0x9e, 0x6c, 0xdf, 0x37, 0x6a,
+	0x99, 0x9a, 0x6a, 0x32, 0x98, 0x4b, 0xe6, 0xc8, 0x87, 0x9e, 0x87, 0xd4, 0x39, 0xa1, 0x84, 0x77,
+	0xb1, 0x23, 0xaf, 0x98, 0x94, 0x19, 0xad, 0x8d, 0x6b, 0x0d, 0xd2, 0x8d, 0xb3, 0x86, 0x89, 0x7d,
+	0x44, 0x58, 0x30, 0x70, 0x66, 0x4c, 0xaa, 0x50, 0xa6, 0xd5, 0x69, 0x83, 0xea, 0x5e, 0x03, 0xbf,
+	0x0c, 0x2b, 0x03, 0xda, 0xc5, 0xa8, 0x27, 0xba, 0xd1, 0x0d, 0xaa, 0x86, 0x58, 0x36, 0xc2, 0xd5,
+	0x0d, 0x9a, 0x87, 0x94, 0x83, 0x91, 0xd3, 0x23, 0x74, 0xa4, 0x4d, 0xb4, 0xd6, 0x9f, 0x42, 0x8a,
+	0x61, 0xdf, 0x63, 0x02, 0x33, 0x79, 0xb5, 0xce, 0xcb, 0x50, 0xc4, 0x34, 0x7e, 0xd1, 0x60, 0xe3,
+	0x74, 0x74, 0xc8, 0x94, 0xe7, 0xc2, 0xff, 0x22, 0xec, 0x2d, 0x58, 0x66, 0x32, 0x0b, 0xd6, 0x9d,
+	0xe8, 0xb3, 0x0a, 0xae, 0x85, 0xe8, 0x07, 0xdf, 0x69, 0xb0, 0x36, 0xb5, 0xd8, 0xf5, 0x4d, 0x28,
+	0xee, 0xef, 0x1e, 0x36, 0x0f, 0xbe, 0xb4, 0xcc, 0x7a, 0x75, 0xf7, 0x60, 0xf7, 0x68, 0xaf, 0x6e,
+	0xb5, 0x4f, 0x76, 0x4f, 0x4e, 0xdb, 0x56, 0xab, 0x7e, 0x54, 0x6b, 0x1e, 0x35, 0x56, 0x62, 0xfa,
+	0xfb, 0xf0, 0xee, 0x2c, 0xd2, 0xde, 0xf1, 0x61, 0xeb, 0xa0, 0x7e, 0x52, 0xaf, 0xad, 0x68, 0xfa,
+	0x7b, 0x50, 0x9a, 0x49, 0x0b, 0x16, 0x07, 0xf5, 0xda, 0x4a, 0x3c, 0x9f, 0xfc, 0xf6, 0xc7, 0x42,
+	0xac, 0x7a, 0xf4, 0xe2, 0xba, 0xa0, 0xbd, 0xbc, 0x2e, 0x68, 0x7f, 0x5d, 0x17, 0xb4, 0xef, 0x6f,
+	0x0a, 0xb1, 0x97, 0x37, 0x85, 0xd8, 0x6f, 0x37, 0x85, 0xd8, 0x57, 0x4f, 0x6f, 0xbd, 0x3d, 0x3a,
+	0xb4, 0xb3, 0x6d, 0x77, 0x11, 0xa1, 0x3b, 0xb7, 0xde, 0x88, 0x57, 0x53, 0x5e, 0x89, 0x9d, 0x05,
+	0xf9, 0xb8, 0xfb, 0xf0, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82, 0xe4, 0x46, 0x57, 0x4d, 0x0a,
+	0x00, 0x00,
 }
 
 func (m *GlobalVirtualGroup) Marshal() (dAtA []byte, err error) {
@@ -911,6 +1291,11 @@ func (m *SwapInInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReservedSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ReservedSize))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.ExpirationTime != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.ExpirationTime))
 		i--
@@ -929,55 +1314,281 @@ func (m *SwapInInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FamilyRebalanceEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GlobalVirtualGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *FamilyRebalanceEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FamilyRebalanceEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTypes(uint64(m.Id))
-	}
-	if m.FamilyId != 0 {
-		n += 1 + sovTypes(uint64(m.FamilyId))
-	}
-	if m.PrimarySpId != 0 {
-		n += 1 + sovTypes(uint64(m.PrimarySpId))
-	}
-	if len(m.SecondarySpIds) > 0 {
-		l = 0
-		for _, e := range m.SecondarySpIds {
-			l += sovTypes(uint64(e))
-		}
-		n += 1 + sovTypes(uint64(l)) + l
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.StoredSize != 0 {
-		n += 1 + sovTypes(uint64(m.StoredSize))
+	if m.TargetSpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TargetSpId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.VirtualPaymentAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.TotalDeposit.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GlobalVirtualGroupFamily) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *FamilyRebalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FamilyRebalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FamilyRebalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Finished {
+		i--
+		if m.Finished {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.CreateTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CreateTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StorageProviderId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Initiator) > 0 {
+		i -= len(m.Initiator)
+		copy(dAtA[i:], m.Initiator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Initiator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GVGRepair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GVGRepair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GVGRepair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.UnhealthySpIds) > 0 {
+		dAtA12 := make([]byte, len(m.UnhealthySpIds)*10)
+		var j11 int
+		for _, num := range m.UnhealthySpIds {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintTypes(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PrimarySpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PrimarySpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnhealthyGlobalVirtualGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnhealthyGlobalVirtualGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnhealthyGlobalVirtualGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RepairDeadline != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RepairDeadline))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.UnhealthySpIds) > 0 {
+		dAtA14 := make([]byte, len(m.UnhealthySpIds)*10)
+		var j13 int
+		for _, num := range m.UnhealthySpIds {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintTypes(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PrimarySpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PrimarySpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GlobalVirtualGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.FamilyId != 0 {
+		n += 1 + sovTypes(uint64(m.FamilyId))
+	}
+	if m.PrimarySpId != 0 {
+		n += 1 + sovTypes(uint64(m.PrimarySpId))
+	}
+	if len(m.SecondarySpIds) > 0 {
+		l = 0
+		for _, e := range m.SecondarySpIds {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if m.StoredSize != 0 {
+		n += 1 + sovTypes(uint64(m.StoredSize))
+	}
+	l = len(m.VirtualPaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.TotalDeposit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *GlobalVirtualGroupFamily) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if m.Id != 0 {
@@ -1095,6 +1706,112 @@ func (m *SwapInInfo) Size() (n int) {
 	if m.ExpirationTime != 0 {
 		n += 1 + sovTypes(uint64(m.ExpirationTime))
 	}
+	if m.ReservedSize != 0 {
+		n += 1 + sovTypes(uint64(m.ReservedSize))
+	}
+	return n
+}
+
+func (m *FamilyRebalanceEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovTypes(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if m.TargetSpId != 0 {
+		n += 1 + sovTypes(uint64(m.TargetSpId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *FamilyRebalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Initiator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.StorageProviderId != 0 {
+		n += 1 + sovTypes(uint64(m.StorageProviderId))
+	}
+	if m.CreateTime != 0 {
+		n += 1 + sovTypes(uint64(m.CreateTime))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Finished {
+		n += 2
+	}
+	return n
+}
+
+func (m *GVGRepair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovTypes(uint64(m.GlobalVirtualGroupId))
+	}
+	if m.PrimarySpId != 0 {
+		n += 1 + sovTypes(uint64(m.PrimarySpId))
+	}
+	if len(m.UnhealthySpIds) > 0 {
+		l = 0
+		for _, e := range m.UnhealthySpIds {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTypes(uint64(m.Deadline))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *UnhealthyGlobalVirtualGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovTypes(uint64(m.GlobalVirtualGroupId))
+	}
+	if m.PrimarySpId != 0 {
+		n += 1 + sovTypes(uint64(m.PrimarySpId))
+	}
+	if len(m.UnhealthySpIds) > 0 {
+		l = 0
+		for _, e := range m.UnhealthySpIds {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if m.RepairDeadline != 0 {
+		n += 1 + sovTypes(uint64(m.RepairDeadline))
+	}
 	return n
 }
 
@@ -2249,6 +2966,723 @@ func (m *SwapInInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedSize", wireType)
+			}
+			m.ReservedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReservedSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FamilyRebalanceEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FamilyRebalanceEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FamilyRebalanceEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSpId", wireType)
+			}
+			m.TargetSpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetSpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= FamilyRebalanceStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FamilyRebalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FamilyRebalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FamilyRebalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Initiator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
+			}
+			m.StorageProviderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageProviderId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			m.CreateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, FamilyRebalanceEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finished = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GVGRepair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GVGRepair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GVGRepair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			}
+			m.PrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnhealthySpIds = append(m.UnhealthySpIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnhealthySpIds) == 0 {
+					m.UnhealthySpIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnhealthySpIds = append(m.UnhealthySpIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnhealthySpIds", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnhealthyGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnhealthyGlobalVirtualGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnhealthyGlobalVirtualGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			}
+			m.PrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnhealthySpIds = append(m.UnhealthySpIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnhealthySpIds) == 0 {
+					m.UnhealthySpIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnhealthySpIds = append(m.UnhealthySpIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnhealthySpIds", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepairDeadline", wireType)
+			}
+			m.RepairDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepairDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])